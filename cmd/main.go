@@ -1,12 +1,31 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
+	"net/url"
 	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+	// time zone database for systems without one (e.g. Windows or minimal containers)
+	_ "time/tzdata"
 
+	"github.com/asdine/storm/v3"
 	"github.com/mchmarny/followme/internal/app"
+	"github.com/mchmarny/followme/internal/archive"
 	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/internal/email"
+	"github.com/mchmarny/followme/internal/notify"
+	"github.com/mchmarny/followme/internal/twitter"
 	"github.com/mchmarny/followme/internal/worker"
+	"github.com/mchmarny/followme/pkg/date"
+	"github.com/mchmarny/followme/pkg/envelope"
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/mchmarny/followme/pkg/schedule"
 	"github.com/pkg/errors"
 	"github.com/urfave/cli/v2"
 )
@@ -14,31 +33,119 @@ import (
 var (
 	// Version is the app version set at build time.
 	Version string = "v0.0.1-default"
+
+	keyFlag = &cli.StringFlag{
+		Name:     "key",
+		Aliases:  []string{"k"},
+		Usage:    "Twitter API Key",
+		EnvVars:  []string{"TWITTER_CONSUMER_KEY"},
+		Required: true,
+	}
+
+	secretFlag = &cli.StringFlag{
+		Name:     "secret",
+		Aliases:  []string{"s"},
+		Usage:    "Twitter API Secret",
+		EnvVars:  []string{"TWITTER_CONSUMER_SECRET"},
+		Required: true,
+	}
+
+	fileFlag = &cli.StringFlag{
+		Name:    "file",
+		Aliases: []string{"f"},
+		Usage:   "Data file path",
+		EnvVars: []string{"DATA_FILE_PATH"},
+		Value:   data.GetDefaultDBFilePath(),
+	}
+
+	apiFlag = &cli.StringFlag{
+		Name:    "api",
+		Usage:   "Twitter API base URL",
+		EnvVars: []string{"TWITTER_API_URL"},
+		Value:   twitter.DefaultAPIURL,
+	}
+
+	encryptionKeyFlag = &cli.StringFlag{
+		Name:    "encryption-key",
+		Usage:   "Key used to encrypt stored access tokens",
+		EnvVars: []string{"FOLLOWME_ENCRYPTION_KEY"},
+	}
+
+	encryptionKeyFileFlag = &cli.StringFlag{
+		Name:    "encryption-key-file",
+		Usage:   "Path to file with key used to encrypt stored access tokens",
+		EnvVars: []string{"FOLLOWME_ENCRYPTION_KEY_FILE"},
+	}
+
+	profileTTLFlag = &cli.DurationFlag{
+		Name:    "profile-ttl",
+		Usage:   "Refresh locally cached Twitter profiles older than this period",
+		EnvVars: []string{"PROFILE_TTL"},
+		Value:   data.DefaultProfileTTL,
+	}
+
+	snapshotIntervalFlag = &cli.DurationFlag{
+		Name:    "snapshot-every",
+		Usage:   "Group changes found by worker runs into periods of the day of this length (e.g. 1h, 6h, or 24h)",
+		EnvVars: []string{"SNAPSHOT_EVERY"},
+		Value:   data.DefaultSnapshotInterval,
+	}
+
+	rateLimitWaitFlag = &cli.DurationFlag{
+		Name:    "rate-limit-wait",
+		Usage:   "Max time worker waits for Twitter API rate limit reset before leaving the rest for the next run",
+		EnvVars: []string{"RATE_LIMIT_WAIT"},
+		Value:   15 * time.Minute,
+	}
+
+	smtpHostFlag = &cli.StringFlag{
+		Name:    "smtp-host",
+		Usage:   "SMTP server host used to send email digests after worker runs (digests disabled when not set)",
+		EnvVars: []string{"SMTP_HOST"},
+	}
+
+	smtpPortFlag = &cli.IntFlag{
+		Name:    "smtp-port",
+		Usage:   "SMTP server port (STARTTLS used when supported by the server)",
+		EnvVars: []string{"SMTP_PORT"},
+		Value:   email.DefaultPort,
+	}
+
+	smtpUsernameFlag = &cli.StringFlag{
+		Name:    "smtp-username",
+		Usage:   "SMTP user (no authentication when not set)",
+		EnvVars: []string{"SMTP_USERNAME"},
+	}
+
+	smtpPasswordFlag = &cli.StringFlag{
+		Name:    "smtp-password",
+		Usage:   "SMTP user password",
+		EnvVars: []string{"SMTP_PASSWORD"},
+	}
+
+	smtpFromFlag = &cli.StringFlag{
+		Name:    "smtp-from",
+		Usage:   "Sender address of email digests (e.g. \"followme <followme@example.com>\")",
+		EnvVars: []string{"SMTP_FROM"},
+	}
 )
 
 func main() {
 	flags := []cli.Flag{
-		&cli.StringFlag{
-			Name:     "key",
-			Aliases:  []string{"k"},
-			Usage:    "Twitter API Key",
-			EnvVars:  []string{"TWITTER_CONSUMER_KEY"},
-			Required: true,
-		},
-		&cli.StringFlag{
-			Name:     "secret",
-			Aliases:  []string{"s"},
-			Usage:    "Twitter API Secret",
-			EnvVars:  []string{"TWITTER_CONSUMER_SECRET"},
-			Required: true,
-		},
-		&cli.StringFlag{
-			Name:    "file",
-			Aliases: []string{"f"},
-			Usage:   "Data file path",
-			EnvVars: []string{"DATA_FILE_PATH"},
-			Value:   data.GetDefaultDBFilePath(),
-		},
+		keyFlag,
+		secretFlag,
+		fileFlag,
+		apiFlag,
+		encryptionKeyFlag,
+		encryptionKeyFileFlag,
+		profileTTLFlag,
+		rateLimitWaitFlag,
+		snapshotIntervalFlag,
+		smtpHostFlag,
+		smtpPortFlag,
+		smtpUsernameFlag,
+		smtpPasswordFlag,
+		smtpFromFlag,
 	}
 
 	appCmd := &cli.App{
@@ -55,10 +162,7 @@ func main() {
 			{
 				Name:  "app",
 				Usage: "run app",
-				Flags: []cli.Flag{
-					flags[0],
-					flags[1],
-					flags[2],
+				Flags: append(flags,
 					&cli.IntFlag{
 						Name:    "port",
 						Aliases: []string{"p"},
@@ -80,10 +184,51 @@ func main() {
 						EnvVars: []string{"DEV_MODE"},
 						Value:   false,
 					},
-				},
+					&cli.BoolFlag{
+						Name:    "metrics",
+						Usage:   "Serve Prometheus metrics at /metrics (no login required)",
+						EnvVars: []string{"APP_METRICS"},
+					},
+					&cli.BoolFlag{
+						Name:    "headless",
+						Usage:   "Don't open the app in browser (e.g. when running in a container)",
+						EnvVars: []string{"APP_HEADLESS"},
+					},
+					&cli.StringFlag{
+						Name:    "worker-every",
+						Usage:   "Run worker inside of the app on this schedule (e.g. 6h, @daily, or \"0 */6 * * *\")",
+						EnvVars: []string{"WORKER_EVERY"},
+					},
+				),
 				Action: func(c *cli.Context) error {
-					a, err := app.NewApp(c.String("file"), c.String("key"),
-						c.String("secret"), c.String("url"), Version, c.Int("port"), c.Bool("dev"))
+					encKey, err := getEncryptionKey(c, encryptionKeyFlag.Name, encryptionKeyFileFlag.Name)
+					if err != nil {
+						return err
+					}
+					var sched schedule.Schedule
+					if v := c.String("worker-every"); v != "" {
+						if sched, err = schedule.Parse(v); err != nil {
+							return errors.Wrap(err, "error parsing worker schedule")
+						}
+					}
+					a, err := app.NewApp(&app.Config{
+						DBPath:           c.String(fileFlag.Name),
+						Key:              c.String(keyFlag.Name),
+						Secret:           c.String(secretFlag.Name),
+						AppURL:           c.String("url"),
+						APIURL:           c.String(apiFlag.Name),
+						EncryptionKey:    encKey,
+						Version:          Version,
+						Port:             c.Int("port"),
+						DevMode:          c.Bool("dev"),
+						WorkerSchedule:   sched,
+						ProfileTTL:       c.Duration(profileTTLFlag.Name),
+						RateLimitWait:    c.Duration(rateLimitWaitFlag.Name),
+						SnapshotInterval: c.Duration(snapshotIntervalFlag.Name),
+						Mail:             getMailConfig(c),
+						Headless:         c.Bool("headless"),
+						Metrics:          c.Bool("metrics"),
+					})
 					if err != nil {
 						return errors.Wrap(err, "error creating new app service")
 					}
@@ -93,16 +238,617 @@ func main() {
 			{
 				Name:  "worker",
 				Usage: "run worker",
-				Flags: flags,
+				Flags: append(flags,
+					&cli.StringFlag{
+						Name:    "every",
+						Usage:   "Run worker as daemon on this schedule (e.g. 6h, @daily, or \"0 */6 * * *\")",
+						EnvVars: []string{"WORKER_EVERY"},
+					},
+					&cli.DurationFlag{
+						Name:    "jitter",
+						Usage:   "Max random delay added to each scheduled run",
+						EnvVars: []string{"WORKER_JITTER"},
+					},
+					&cli.DurationFlag{
+						Name:    "fresh",
+						Usage:   "Skip users whose data was updated within this period",
+						EnvVars: []string{"WORKER_FRESH"},
+					},
+					&cli.IntFlag{
+						Name:    "concurrency",
+						Usage:   "Max number of users updated in parallel",
+						EnvVars: []string{"WORKER_CONCURRENCY"},
+						Value:   1,
+					},
+					&cli.DurationFlag{
+						Name:    "user-timeout",
+						Usage:   "Max time to update a single user, no limit when not set",
+						EnvVars: []string{"WORKER_USER_TIMEOUT"},
+					},
+					&cli.StringFlag{
+						Name:    "metrics-textfile",
+						Usage:   "Write Prometheus metrics to this file after each run (e.g. for node_exporter textfile collector)",
+						EnvVars: []string{"WORKER_METRICS_TEXTFILE"},
+					},
+					&cli.StringFlag{
+						Name:    "metrics-push-url",
+						Usage:   "Push Prometheus metrics to this Pushgateway URL after each run",
+						EnvVars: []string{"WORKER_METRICS_PUSH_URL"},
+					},
+					&cli.StringFlag{
+						Name:    "app-url",
+						Usage:   "App URL with port (e.g. http://127.0.0.1:8080) linked from email digest",
+						EnvVars: []string{"WORKER_APP_URL"},
+					},
+				),
 				Action: func(c *cli.Context) error {
-					w, err := worker.NewWorker(c.String("file"), c.String("key"),
-						c.String("secret"), c.String("url"), Version)
+					encKey, err := getEncryptionKey(c, encryptionKeyFlag.Name, encryptionKeyFileFlag.Name)
+					if err != nil {
+						return err
+					}
+					var sched schedule.Schedule
+					if v := c.String("every"); v != "" {
+						if sched, err = schedule.Parse(v); err != nil {
+							return errors.Wrap(err, "error parsing worker schedule")
+						}
+					}
+					var afterRun func(ctx context.Context, db *storm.DB) error
+					if mc := getMailConfig(c); mc != nil {
+						d, err := app.NewDigest(&app.DigestConfig{
+							Mail:   mc,
+							AppURL: app.GetDashboardURL(c.String("app-url")),
+						})
+						if err != nil {
+							return errors.Wrap(err, "error creating email digest")
+						}
+						afterRun = d.AfterRun()
+					}
+					w, err := worker.NewWorker(&worker.Config{
+						DBPath:           c.String(fileFlag.Name),
+						Key:              c.String(keyFlag.Name),
+						Secret:           c.String(secretFlag.Name),
+						APIURL:           c.String(apiFlag.Name),
+						EncryptionKey:    encKey,
+						Version:          Version,
+						Freshness:        c.Duration("fresh"),
+						ProfileTTL:       c.Duration(profileTTLFlag.Name),
+						RateLimitWait:    c.Duration(rateLimitWaitFlag.Name),
+						Concurrency:      c.Int("concurrency"),
+						UserTimeout:      c.Duration("user-timeout"),
+						AfterRun:         afterRun,
+						MetricsTextfile:  c.String("metrics-textfile"),
+						MetricsPushURL:   c.String("metrics-push-url"),
+						SnapshotInterval: c.Duration(snapshotIntervalFlag.Name),
+					})
 					if err != nil {
 						return errors.Wrap(err, "error creating new worker service")
 					}
+					if sched != nil {
+						return w.RunDaemon(sched, c.Duration("jitter"))
+					}
 					return w.Run()
 				},
 			},
+			{
+				Name:  "rotate-key",
+				Usage: "re-encrypt stored access tokens with new encryption key",
+				Flags: []cli.Flag{
+					fileFlag,
+					encryptionKeyFlag,
+					encryptionKeyFileFlag,
+					&cli.StringFlag{
+						Name:    "new-encryption-key",
+						Usage:   "New key used to encrypt stored access tokens (decrypts tokens when not set)",
+						EnvVars: []string{"FOLLOWME_NEW_ENCRYPTION_KEY"},
+					},
+					&cli.StringFlag{
+						Name:    "new-encryption-key-file",
+						Usage:   "Path to file with new key used to encrypt stored access tokens",
+						EnvVars: []string{"FOLLOWME_NEW_ENCRYPTION_KEY_FILE"},
+					},
+				},
+				Action: func(c *cli.Context) error {
+					oldKey, err := getEncryptionKey(c, encryptionKeyFlag.Name, encryptionKeyFileFlag.Name)
+					if err != nil {
+						return err
+					}
+					newKey, err := getEncryptionKey(c, "new-encryption-key", "new-encryption-key-file")
+					if err != nil {
+						return err
+					}
+					db, err := data.GetDB(c.String(fileFlag.Name))
+					if err != nil {
+						return errors.Wrap(err, "error getting DB")
+					}
+					defer db.Close()
+					n, err := data.RotateUserKeys(db, oldKey, newKey)
+					if err != nil {
+						return errors.Wrap(err, "error rotating encryption key")
+					}
+					log.Printf("Rotated encryption key for %d users", n)
+					return nil
+				},
+			},
+			{
+				Name:  "migrate",
+				Usage: "convert stored daily states to periodic snapshots and daily deltas",
+				Flags: []cli.Flag{
+					fileFlag,
+				},
+				Action: func(c *cli.Context) error {
+					db, err := data.GetDB(c.String(fileFlag.Name))
+					if err != nil {
+						return errors.Wrap(err, "error getting DB")
+					}
+					defer db.Close()
+					n, err := data.MigrateStates(db)
+					if err != nil {
+						return errors.Wrap(err, "error migrating daily states")
+					}
+					log.Printf("Migrated %d daily states", n)
+					return nil
+				},
+			},
+			{
+				Name:  "compact",
+				Usage: "remove old follower/friend lists (keeps daily counts) and shrink data file",
+				Flags: []cli.Flag{
+					fileFlag,
+					&cli.StringFlag{
+						Name:  "keep-daily",
+						Usage: "Keep all data for this period (e.g. 90d, 12w, 1y)",
+						Value: "90d",
+					},
+					&cli.StringFlag{
+						Name:  "keep-weekly",
+						Usage: "Keep weekly follower/friend lists for this period (e.g. 2y)",
+						Value: "2y",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					keepDaily, err := date.ParseDays(c.String("keep-daily"))
+					if err != nil {
+						return errors.Wrap(err, "error parsing daily retention")
+					}
+					keepWeekly, err := date.ParseDays(c.String("keep-weekly"))
+					if err != nil {
+						return errors.Wrap(err, "error parsing weekly retention")
+					}
+					dbPath := c.String(fileFlag.Name)
+					db, err := data.GetDB(dbPath)
+					if err != nil {
+						return errors.Wrap(err, "error getting DB")
+					}
+					n, err := data.CompactStates(db, keepDaily, keepWeekly, time.Now().UTC())
+					if err != nil {
+						db.Close()
+						return errors.Wrap(err, "error compacting daily states")
+					}
+					if err := db.Close(); err != nil {
+						return errors.Wrap(err, "error closing DB")
+					}
+					log.Printf("Compacted %d daily states", n)
+					before, after, err := data.CompactDB(dbPath)
+					if err != nil {
+						return errors.Wrap(err, "error compacting DB file")
+					}
+					log.Printf("Data file size reduced from %d to %d bytes", before, after)
+					return nil
+				},
+			},
+			{
+				Name:  "export",
+				Usage: "export daily counts and follow events into files",
+				Flags: []cli.Flag{
+					fileFlag,
+					&cli.StringFlag{
+						Name:  "user",
+						Usage: "Username to export (all users when not set)",
+					},
+					&cli.StringFlag{
+						Name:  "from",
+						Usage: "First day to export (YYYY-MM-DD)",
+					},
+					&cli.StringFlag{
+						Name:  "to",
+						Usage: "Last day to export (YYYY-MM-DD)",
+					},
+					&cli.StringFlag{
+						Name:  "format",
+						Usage: fmt.Sprintf("Export file format (%s)", strings.Join(archive.Formats, ", ")),
+						Value: archive.CSVFormat,
+					},
+					&cli.StringFlag{
+						Name:  "output",
+						Usage: "Directory where the export files will be written",
+						Value: ".",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					from, err := parseDateFlag(c, "from")
+					if err != nil {
+						return err
+					}
+					to, err := parseDateFlag(c, "to")
+					if err != nil {
+						return err
+					}
+					db, err := data.GetDB(c.String(fileFlag.Name))
+					if err != nil {
+						return errors.Wrap(err, "error getting DB")
+					}
+					defer db.Close()
+					r, err := archive.Export(db, &archive.ExportOptions{
+						Username: c.String("user"),
+						From:     from,
+						To:       to,
+						Format:   c.String("format"),
+						Dir:      c.String("output"),
+					})
+					if err != nil {
+						return errors.Wrap(err, "error exporting data")
+					}
+					log.Printf("Exported %d days to %s and %d events to %s", r.Days, r.DaysFile, r.Events, r.EventsFile)
+					return nil
+				},
+			},
+			{
+				Name:      "import",
+				Usage:     "merge users, profiles, and daily states from export directory or another data file",
+				ArgsUsage: "<export-dir|data-file>",
+				Flags: []cli.Flag{
+					fileFlag,
+					encryptionKeyFlag,
+					encryptionKeyFileFlag,
+					&cli.StringFlag{
+						Name:    "source-encryption-key",
+						Usage:   "Key used to encrypt access tokens in the imported data file (same as encryption-key when not set)",
+						EnvVars: []string{"FOLLOWME_SOURCE_ENCRYPTION_KEY"},
+					},
+					&cli.StringFlag{
+						Name:    "source-encryption-key-file",
+						Usage:   "Path to file with key used to encrypt access tokens in the imported data file",
+						EnvVars: []string{"FOLLOWME_SOURCE_ENCRYPTION_KEY_FILE"},
+					},
+					&cli.BoolFlag{
+						Name:  "dry-run",
+						Usage: "Only report what would change",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					src := c.Args().First()
+					if src == "" {
+						return errors.New("import source required (export directory or data file)")
+					}
+					encKey, err := getEncryptionKey(c, encryptionKeyFlag.Name, encryptionKeyFileFlag.Name)
+					if err != nil {
+						return err
+					}
+					srcKey, err := getEncryptionKey(c, "source-encryption-key", "source-encryption-key-file")
+					if err != nil {
+						return err
+					}
+					if srcKey == nil {
+						srcKey = encKey
+					}
+					db, err := data.GetDB(c.String(fileFlag.Name))
+					if err != nil {
+						return errors.Wrap(err, "error getting DB")
+					}
+					defer db.Close()
+					dryRun := c.Bool("dry-run")
+					r, err := archive.Import(db, src, srcKey, encKey, dryRun)
+					if err != nil {
+						return errors.Wrap(err, "error importing data")
+					}
+					if dryRun {
+						for _, change := range r.Changes {
+							log.Println(change)
+						}
+					}
+					log.Printf("Users (added:%d, updated:%d, unchanged:%d)", r.Users.Added, r.Users.Updated, r.Users.Unchanged)
+					log.Printf("Profiles (added:%d, updated:%d, unchanged:%d)", r.Profiles.Added, r.Profiles.Updated, r.Profiles.Unchanged)
+					log.Printf("States (added:%d, updated:%d, unchanged:%d)", r.States.Added, r.States.Updated, r.States.Unchanged)
+					for _, username := range r.Relogin {
+						log.Printf("Access tokens of %s can't be decrypted with the source encryption key, log in again as %s", username, username)
+					}
+					if dryRun {
+						log.Println("Dry run, nothing was saved")
+					}
+					return nil
+				},
+			},
+			{
+				Name:  "status",
+				Usage: "show results of the most recent worker runs",
+				Flags: []cli.Flag{
+					fileFlag,
+					&cli.IntFlag{
+						Name:  "limit",
+						Usage: "Number of runs to show",
+						Value: 5,
+					},
+					&cli.StringFlag{
+						Name:  "user",
+						Usage: "Show only this username",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					db, err := data.GetDB(c.String(fileFlag.Name))
+					if err != nil {
+						return errors.Wrap(err, "error getting DB")
+					}
+					defer db.Close()
+					reports, err := data.GetRunReports(db, c.Int("limit"))
+					if err != nil {
+						return errors.Wrap(err, "error getting worker runs")
+					}
+					if len(reports) == 0 {
+						log.Println("No worker runs recorded yet")
+						return nil
+					}
+					return printRunReports(os.Stdout, reports, c.String("user"))
+				},
+			},
+			{
+				Name:  "webhook",
+				Usage: "manage webhooks notified of follower churn",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "add",
+						Usage: "add webhook for user",
+						Flags: []cli.Flag{
+							fileFlag,
+							encryptionKeyFlag,
+							encryptionKeyFileFlag,
+							&cli.StringFlag{
+								Name:     "user",
+								Usage:    "Username to notify about",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "url",
+								Usage:    "Webhook URL",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "format",
+								Usage: fmt.Sprintf("Payload format (one of %s)", strings.Join(notify.Formats, ", ")),
+								Value: notify.JSONFormat,
+							},
+							&cli.StringSliceFlag{
+								Name:  "events",
+								Usage: fmt.Sprintf("Event types to send (any of %s)", strings.Join(notify.EventTypes, ", ")),
+								Value: cli.NewStringSlice(notify.DefaultEvents...),
+							},
+							&cli.StringFlag{
+								Name:    "secret",
+								Usage:   "Secret used to sign payloads (HMAC-SHA256 in X-Followme-Signature header)",
+								EnvVars: []string{"FOLLOWME_WEBHOOK_SECRET"},
+							},
+							&cli.StringFlag{
+								Name:  "template",
+								Usage: "Go text/template of slack and discord messages",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							hook, err := getWebhook(c)
+							if err != nil {
+								return err
+							}
+							key, err := getEncryptionKey(c, encryptionKeyFlag.Name, encryptionKeyFileFlag.Name)
+							if err != nil {
+								return err
+							}
+							db, err := data.GetDB(c.String(fileFlag.Name))
+							if err != nil {
+								return errors.Wrap(err, "error getting DB")
+							}
+							defer db.Close()
+							if err := data.SaveWebhook(db, hook, key); err != nil {
+								return err
+							}
+							log.Printf("Added webhook %d for %s", hook.ID, hook.Username)
+							return nil
+						},
+					},
+					{
+						Name:  "list",
+						Usage: "list webhooks",
+						Flags: []cli.Flag{
+							fileFlag,
+						},
+						Action: func(c *cli.Context) error {
+							db, err := data.GetDB(c.String(fileFlag.Name))
+							if err != nil {
+								return errors.Wrap(err, "error getting DB")
+							}
+							defer db.Close()
+							hooks, err := data.GetAllWebhooks(db)
+							if err != nil {
+								return err
+							}
+							return printWebhooks(os.Stdout, hooks)
+						},
+					},
+					{
+						Name:  "remove",
+						Usage: "remove webhook",
+						Flags: []cli.Flag{
+							fileFlag,
+							&cli.IntFlag{
+								Name:     "id",
+								Usage:    "Webhook ID (see webhook list)",
+								Required: true,
+							},
+						},
+						Action: func(c *cli.Context) error {
+							db, err := data.GetDB(c.String(fileFlag.Name))
+							if err != nil {
+								return errors.Wrap(err, "error getting DB")
+							}
+							defer db.Close()
+							if err := data.DeleteWebhook(db, c.Int("id")); err != nil {
+								return err
+							}
+							log.Printf("Removed webhook %d", c.Int("id"))
+							return nil
+						},
+					},
+				},
+			},
+			{
+				Name:  "timezone",
+				Usage: "manage time zones days of the tracked users start in (UTC by default)",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "set",
+						Usage: "set time zone of user and move existing daily states to the days in that time zone",
+						Flags: []cli.Flag{
+							fileFlag,
+							&cli.StringFlag{
+								Name:     "user",
+								Usage:    "Username to set the time zone for",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "zone",
+								Usage:    "IANA time zone name (e.g. America/Los_Angeles, or UTC)",
+								Required: true,
+							},
+						},
+						Action: func(c *cli.Context) error {
+							db, err := data.GetDB(c.String(fileFlag.Name))
+							if err != nil {
+								return errors.Wrap(err, "error getting DB")
+							}
+							defer db.Close()
+							username := format.NormalizeString(c.String("user"))
+							n, err := data.SetUserTimezone(db, username, c.String("zone"))
+							if err != nil {
+								return err
+							}
+							log.Printf("Set time zone of %s to %s (moved %d daily states)", username, c.String("zone"), n)
+							return nil
+						},
+					},
+					{
+						Name:  "list",
+						Usage: "list time zones of users",
+						Flags: []cli.Flag{
+							fileFlag,
+						},
+						Action: func(c *cli.Context) error {
+							db, err := data.GetDB(c.String(fileFlag.Name))
+							if err != nil {
+								return errors.Wrap(err, "error getting DB")
+							}
+							defer db.Close()
+							var users []data.User
+							if err := db.All(&users); err != nil && err != storm.ErrNotFound {
+								return errors.Wrap(err, "error getting users")
+							}
+							w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+							fmt.Fprintln(w, "USER\tTIME ZONE")
+							for _, u := range users {
+								s, err := data.GetUserSettings(db, u.Username)
+								if err != nil {
+									return err
+								}
+								zone := s.Timezone
+								if zone == "" {
+									zone = "UTC"
+								}
+								fmt.Fprintf(w, "%s\t%s\n", u.Username, zone)
+							}
+							return w.Flush()
+						},
+					},
+				},
+			},
+			{
+				Name:  "token",
+				Usage: "manage personal tokens of the /api/v1 API",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "create",
+						Usage: "create API token for user, the token is printed only once",
+						Flags: []cli.Flag{
+							fileFlag,
+							&cli.StringFlag{
+								Name:     "user",
+								Usage:    "Username the token authenticates as",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:  "name",
+								Usage: "Name to tell the token apart (e.g. script or host using it)",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							db, err := data.GetDB(c.String(fileFlag.Name))
+							if err != nil {
+								return errors.Wrap(err, "error getting DB")
+							}
+							defer db.Close()
+							token, t, err := data.CreateAPIToken(db, format.NormalizeString(c.String("user")), c.String("name"))
+							if err != nil {
+								return err
+							}
+							log.Printf("Created token %d for %s, store it now, it can't be shown again", t.ID, t.Username)
+							fmt.Println(token)
+							return nil
+						},
+					},
+					{
+						Name:  "list",
+						Usage: "list API tokens",
+						Flags: []cli.Flag{
+							fileFlag,
+							&cli.StringFlag{
+								Name:  "user",
+								Usage: "List only tokens of this user",
+							},
+						},
+						Action: func(c *cli.Context) error {
+							db, err := data.GetDB(c.String(fileFlag.Name))
+							if err != nil {
+								return errors.Wrap(err, "error getting DB")
+							}
+							defer db.Close()
+							tokens, err := data.GetAPITokens(db, format.NormalizeString(c.String("user")))
+							if err != nil {
+								return err
+							}
+							return printAPITokens(os.Stdout, tokens)
+						},
+					},
+					{
+						Name:  "revoke",
+						Usage: "revoke API token",
+						Flags: []cli.Flag{
+							fileFlag,
+							&cli.IntFlag{
+								Name:     "id",
+								Usage:    "Token ID (see token list)",
+								Required: true,
+							},
+						},
+						Action: func(c *cli.Context) error {
+							db, err := data.GetDB(c.String(fileFlag.Name))
+							if err != nil {
+								return errors.Wrap(err, "error getting DB")
+							}
+							defer db.Close()
+							if err := data.DeleteAPIToken(db, c.Int("id")); err != nil {
+								return err
+							}
+							log.Printf("Revoked token %d", c.Int("id"))
+							return nil
+						},
+					},
+				},
+			},
 		},
 	}
 
@@ -111,3 +857,146 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+// getEncryptionKey returns key from either the value or file flag, nil if neither is set
+func getEncryptionKey(c *cli.Context, valFlag, fileFlag string) ([]byte, error) {
+	val := c.String(valFlag)
+	if p := c.String(fileFlag); p != "" {
+		if val != "" {
+			return nil, errors.Errorf("only one of %s or %s can be set", valFlag, fileFlag)
+		}
+		b, err := ioutil.ReadFile(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading key file: %s", p)
+		}
+		val = strings.TrimSpace(string(b))
+		if val == "" {
+			return nil, errors.Errorf("key file is empty: %s", p)
+		}
+	}
+	return envelope.DeriveKey(val), nil
+}
+
+// getMailConfig returns SMTP configuration from the flags, nil when host is not set
+func getMailConfig(c *cli.Context) *email.Config {
+	if c.String(smtpHostFlag.Name) == "" {
+		return nil
+	}
+	return &email.Config{
+		Host:     c.String(smtpHostFlag.Name),
+		Port:     c.Int(smtpPortFlag.Name),
+		Username: c.String(smtpUsernameFlag.Name),
+		Password: c.String(smtpPasswordFlag.Name),
+		From:     c.String(smtpFromFlag.Name),
+	}
+}
+
+// parseDateFlag parses optional ISO date flag, zero time when not set
+func parseDateFlag(c *cli.Context, name string) (time.Time, error) {
+	v := c.String(name)
+	if v == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(format.ISODateLayout, v)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "invalid %s date, expected YYYY-MM-DD", name)
+	}
+	return t, nil
+}
+
+// printRunReports writes worker run reports as tables, only rows of the username when set
+func printRunReports(out io.Writer, reports []*data.RunReport, username string) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, r := range reports {
+		fmt.Fprintf(w, "Run %d: %s (version: %s, duration: %v, updated: %d, skipped: %d, failed: %d)\n",
+			r.ID, r.StartedAt.Local().Format(time.RFC1123), r.Version, r.Duration().Round(time.Millisecond),
+			r.Count(data.UserRunUpdated), r.Count(data.UserRunSkipped), r.Count(data.UserRunFailed))
+		if r.Error != "" {
+			fmt.Fprintf(w, "Error: %s\n", r.Error)
+		}
+		fmt.Fprintln(w, "USER\tSTATUS\tDURATION\tFOLLOWERS\t+\t-\tFRIENDS\t+\t-\tERROR")
+		for _, u := range r.Users {
+			if username != "" && u.Username != format.NormalizeString(username) {
+				continue
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%d\t%d\t%d\t%s\n",
+				u.Username, u.Status, u.FormattedDuration(),
+				u.FollowerCount, u.NewFollowerCount, u.NewUnfollowerCount,
+				u.FriendCount, u.NewFriendCount, u.NewUnfriendedCount, u.Error)
+		}
+		fmt.Fprintln(w)
+	}
+	return w.Flush()
+}
+
+// getWebhook returns webhook from the add command flags
+func getWebhook(c *cli.Context) (*data.Webhook, error) {
+	u, err := url.Parse(c.String("url"))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.Errorf("invalid webhook URL: %s", c.String("url"))
+	}
+	f := c.String("format")
+	if !notify.IsValidFormat(f) {
+		return nil, errors.Errorf("invalid format %s, expected one of %v", f, notify.Formats)
+	}
+	events := make([]string, 0)
+	for _, v := range c.StringSlice("events") {
+		for _, e := range strings.Split(v, ",") {
+			e = strings.TrimSpace(e)
+			if !notify.IsValidEventType(e) {
+				return nil, errors.Errorf("invalid event type %s, expected any of %v", e, notify.EventTypes)
+			}
+			events = append(events, e)
+		}
+	}
+	if _, err := notify.ParseTemplate(c.String("template")); err != nil {
+		return nil, err
+	}
+	return &data.Webhook{
+		Username:  format.NormalizeString(c.String("user")),
+		URL:       u.String(),
+		Format:    f,
+		Events:    events,
+		Template:  c.String("template"),
+		Secret:    c.String("secret"),
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// printWebhooks writes webhooks as table, secrets and URL paths (chat webhook URLs carry tokens) are not shown
+func printWebhooks(out io.Writer, hooks []*data.Webhook) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tUSER\tFORMAT\tEVENTS\tSIGNED\tURL")
+	for _, h := range hooks {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%t\t%s\n",
+			h.ID, h.Username, h.Format, strings.Join(h.Events, ","), h.Secret != "", maskURL(h.URL))
+	}
+	return w.Flush()
+}
+
+// maskURL returns scheme and host of the URL
+func maskURL(v string) string {
+	u, err := url.Parse(v)
+	if err != nil || u.Host == "" {
+		return "***"
+	}
+	if u.Path == "" && u.RawQuery == "" {
+		return u.Scheme + "://" + u.Host
+	}
+	return u.Scheme + "://" + u.Host + "/***"
+}
+
+// printAPITokens writes tokens as table, only the token hints are shown
+func printAPITokens(out io.Writer, tokens []*data.APIToken) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tUSER\tNAME\tTOKEN\tCREATED\tLAST USED")
+	for _, t := range tokens {
+		lastUsed := "never"
+		if !t.LastUsedAt.IsZero() {
+			lastUsed = t.LastUsedAt.Local().Format(time.RFC822)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n",
+			t.ID, t.Username, t.Name, t.Hint, t.CreatedAt.Local().Format(time.RFC822), lastUsed)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,228 @@
+// Package api defines the stable response types of the versioned JSON API.
+// Fields are only ever added to these types, never renamed or removed within a version.
+package api
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mchmarny/followme/internal/data"
+)
+
+const (
+	// Version is the current API version, also the path prefix of its routes
+	Version = "v1"
+)
+
+// Profile is a Twitter user profile
+type Profile struct {
+	ID            int64     `json:"id" doc:"Twitter user ID"`
+	IDStr         string    `json:"id_str" doc:"Twitter user ID as string, for clients without 64-bit integers"`
+	Username      string    `json:"username" doc:"Twitter screen name"`
+	Name          string    `json:"name"`
+	Description   string    `json:"description"`
+	ProfileImage  string    `json:"profile_image" doc:"URL of the profile image"`
+	Location      string    `json:"location"`
+	Lang          string    `json:"lang"`
+	CreatedAt     time.Time `json:"created_at"`
+	FollowerCount int       `json:"follower_count"`
+	FriendCount   int       `json:"friend_count"`
+	PostCount     int       `json:"post_count"`
+	ListedCount   int       `json:"listed_count"`
+	UpdatedAt     time.Time `json:"updated_at" doc:"Time the profile was last refreshed from Twitter"`
+}
+
+// NewProfile creates API profile from the stored one
+func NewProfile(p *data.Profile) *Profile {
+	return &Profile{
+		ID:            p.ID,
+		IDStr:         formatID(p.ID),
+		Username:      p.Username,
+		Name:          p.Name,
+		Description:   p.Description,
+		ProfileImage:  p.ProfileImage,
+		Location:      p.Location,
+		Lang:          p.Lang,
+		CreatedAt:     p.CreatedAt,
+		FollowerCount: p.FollowerCount,
+		FriendCount:   p.FriendCount,
+		PostCount:     p.PostCount,
+		ListedCount:   p.ListedCount,
+		UpdatedAt:     p.UpdatedAt,
+	}
+}
+
+// User is the authenticated user
+type User struct {
+	Username  string    `json:"username"`
+	UpdatedAt time.Time `json:"updated_at" doc:"Time the user data was last updated by worker"`
+	Profile   *Profile  `json:"profile"`
+}
+
+// Day is the follower and friend state of the user on a day
+type Day struct {
+	Date              string    `json:"date" doc:"ISO date (YYYY-MM-DD)"`
+	HasData           bool      `json:"has_data" doc:"False when worker didn't run that day, counts are 0"`
+	FollowerCount     int       `json:"follower_count"`
+	FriendCount       int       `json:"friend_count"`
+	NewFollowerCount  int       `json:"new_follower_count" doc:"Number of users who followed on that day"`
+	LostFollowerCount int       `json:"lost_follower_count" doc:"Number of users who unfollowed on that day"`
+	NewFriendCount    int       `json:"new_friend_count" doc:"Number of users followed on that day"`
+	LostFriendCount   int       `json:"lost_friend_count" doc:"Number of users unfollowed on that day"`
+	UpdatedAt         time.Time `json:"updated_at,omitempty"`
+}
+
+// NewDay creates API day from the stored state
+func NewDay(s *data.DailyState) *Day {
+	d := &Day{
+		Date:    s.StateOn,
+		HasData: s.HasData(),
+	}
+	if !d.HasData {
+		return d
+	}
+	d.FollowerCount = s.FollowerCount
+	d.FriendCount = s.FriendsCount
+	d.NewFollowerCount = s.NewFollowerCount
+	d.LostFollowerCount = s.NewUnfollowerCount
+	d.NewFriendCount = s.NewFriendsCount
+	d.LostFriendCount = s.NewUnfriendedCount
+	d.UpdatedAt = s.UpdatedOn
+	return d
+}
+
+// DayList is a list of days in ascending date order
+type DayList struct {
+	Days []*Day `json:"days"`
+}
+
+// ProfilePage is a page of profiles
+type ProfilePage struct {
+	Items      []*Profile `json:"items"`
+	NextCursor string     `json:"next_cursor,omitempty" doc:"Cursor of the next page, empty on the last page"`
+}
+
+// Error is returned with all non 2xx responses
+type Error struct {
+	Code    int    `json:"code" doc:"HTTP status code"`
+	Message string `json:"message"`
+}
+
+func formatID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+// Snapshot is the changes within a period of the day, between worker runs
+type Snapshot struct {
+	PeriodStart     time.Time `json:"period_start" doc:"Start of the period in the time zone of the user"`
+	UpdatedAt       time.Time `json:"updated_at" doc:"Time of the last worker run in the period"`
+	Runs            int       `json:"runs" doc:"Number of worker runs in the period"`
+	FollowerCount   int       `json:"follower_count"`
+	FriendCount     int       `json:"friend_count"`
+	NewFollowerIDs  []int64   `json:"new_follower_ids"`
+	LostFollowerIDs []int64   `json:"lost_follower_ids"`
+	NewFriendIDs    []int64   `json:"new_friend_ids"`
+	LostFriendIDs   []int64   `json:"lost_friend_ids"`
+}
+
+// NewSnapshot creates API snapshot from the stored one
+func NewSnapshot(s *data.Snapshot) *Snapshot {
+	return &Snapshot{
+		PeriodStart:     s.PeriodStart,
+		UpdatedAt:       s.UpdatedOn,
+		Runs:            s.Runs,
+		FollowerCount:   s.FollowerCount,
+		FriendCount:     s.FriendsCount,
+		NewFollowerIDs:  nonNil(s.NewFollowers),
+		LostFollowerIDs: nonNil(s.NewUnfollowers),
+		NewFriendIDs:    nonNil(s.NewFriends),
+		LostFriendIDs:   nonNil(s.NewUnfriended),
+	}
+}
+
+// SnapshotList is a list of snapshots of a day in time order
+type SnapshotList struct {
+	Date      string      `json:"date" doc:"ISO date (YYYY-MM-DD)"`
+	Snapshots []*Snapshot `json:"snapshots"`
+}
+
+// nonNil returns empty list instead of nil so lists are never null in JSON
+func nonNil(ids []int64) []int64 {
+	if ids == nil {
+		return []int64{}
+	}
+	return ids
+}
+
+// TimelineEvent is a change of the relationship with an account
+type TimelineEvent struct {
+	Date string `json:"date" doc:"ISO date (YYYY-MM-DD) the change was recorded on"`
+	Type string `json:"type" doc:"followed, unfollowed or refollowed (by the account), friended or unfriended (by the user)"`
+}
+
+// Timeline is the history of the relationship of the user with an account
+type Timeline struct {
+	ID               int64            `json:"id" doc:"Twitter user ID of the account"`
+	IDStr            string           `json:"id_str" doc:"Twitter user ID as string, for clients without 64-bit integers"`
+	Profile          *Profile         `json:"profile,omitempty" doc:"Profile of the account, missing when it can't be loaded"`
+	TrackedSince     string           `json:"tracked_since" doc:"ISO date of the first state with full follower and friend lists"`
+	UpdatedOn        string           `json:"updated_on" doc:"ISO date of the last state"`
+	ExistingFollower bool             `json:"existing_follower" doc:"True when the account already followed the user on tracked_since"`
+	ExistingFriend   bool             `json:"existing_friend" doc:"True when the user already followed the account on tracked_since"`
+	Follower         bool             `json:"follower" doc:"True when the account currently follows the user"`
+	Friend           bool             `json:"friend" doc:"True when the user currently follows the account"`
+	MutualDays       int              `json:"mutual_days" doc:"Number of days the user and the account followed each other"`
+	Events           []*TimelineEvent `json:"events" doc:"Changes in date order"`
+}
+
+// NewTimeline creates API timeline from the stored one and the account profile (optional)
+func NewTimeline(t *data.Timeline, p *data.Profile) *Timeline {
+	tl := &Timeline{
+		ID:               t.ID,
+		IDStr:            formatID(t.ID),
+		TrackedSince:     t.TrackedSince,
+		UpdatedOn:        t.UpdatedOn,
+		ExistingFollower: t.ExistingFollower,
+		ExistingFriend:   t.ExistingFriend,
+		Follower:         t.Follower,
+		Friend:           t.Friend,
+		MutualDays:       t.MutualDays,
+		Events:           make([]*TimelineEvent, 0, len(t.Events)),
+	}
+	if p != nil {
+		tl.Profile = NewProfile(p)
+	}
+	for _, e := range t.Events {
+		tl.Events = append(tl.Events, &TimelineEvent{Date: e.EventDate, Type: e.EventType})
+	}
+	return tl
+}
+
+// SearchResult is an account matching the search
+type SearchResult struct {
+	Profile        *Profile `json:"profile"`
+	Follower       bool     `json:"follower" doc:"True when the account currently follows the user"`
+	Friend         bool     `json:"friend" doc:"True when the user currently follows the account"`
+	FormerFollower bool     `json:"former_follower" doc:"True when the account followed the user before but doesn't anymore"`
+	LastEventOn    string   `json:"last_event_on" doc:"ISO date of the most recent event of the account, empty when the relationship existed before tracking started"`
+	LastEventType  string   `json:"last_event_type" doc:"followed, unfollowed, refollowed, friended or unfriended"`
+}
+
+// NewSearchResult creates API search result from the stored match
+func NewSearchResult(m *data.AccountMatch) *SearchResult {
+	return &SearchResult{
+		Profile:        NewProfile(m.Profile),
+		Follower:       m.Follower,
+		Friend:         m.Friend,
+		FormerFollower: m.FormerFollower,
+		LastEventOn:    m.LastEventOn,
+		LastEventType:  m.LastEventType,
+	}
+}
+
+// SearchPage is a page of search results, most recent event first
+type SearchPage struct {
+	Items      []*SearchResult `json:"items"`
+	Total      int             `json:"total" doc:"Number of matching accounts in all pages"`
+	NextCursor string          `json:"next_cursor,omitempty" doc:"Cursor of the next page, empty on the last page"`
+}
@@ -0,0 +1,558 @@
+package app
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mchmarny/followme/internal/api"
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/mchmarny/followme/pkg/openapi"
+	"github.com/pkg/errors"
+)
+
+const (
+	apiPathPrefix = "/api/" + api.Version
+
+	// apiDefaultDays is the number of days listed when the range isn't set
+	apiDefaultDays = 30
+	// apiMaxDays is the max number of days in single list
+	apiMaxDays = 366
+
+	apiDefaultLimit = 100
+	apiMaxLimit     = 200
+
+	// apiTokenTouchInterval limits how often the token last used time is saved
+	apiTokenTouchInterval = time.Minute
+
+	bearerAuthScheme = "bearerAuth"
+)
+
+// apiRoute describes API operation, used to register the handler and to generate the OpenAPI spec
+type apiRoute struct {
+	method   string
+	path     string
+	id       string
+	summary  string
+	params   []*openapi.Parameter
+	response interface{}
+	public   bool
+	handler  gin.HandlerFunc
+}
+
+var (
+	dateParam = &openapi.Parameter{
+		Name: "date", In: "path", Required: true,
+		Description: "ISO date (YYYY-MM-DD)",
+		Schema:      &openapi.Schema{Type: "string", Format: "date"},
+	}
+	listParam = &openapi.Parameter{
+		Name: "list", In: "path", Required: true,
+		Description: "Users who followed, unfollowed, were followed (friended) or unfollowed (unfriended) by the user on that day",
+		Schema: &openapi.Schema{Type: "string", Enum: []string{
+			data.FollowedEventType, data.UnfollowedEventType, data.FriendedEventType, data.UnfriendedEventType,
+		}},
+	}
+	cursorParam = &openapi.Parameter{
+		Name: "cursor", In: "query",
+		Description: "Cursor returned as next_cursor by the previous page",
+		Schema:      &openapi.Schema{Type: "string"},
+	}
+	limitParam = &openapi.Parameter{
+		Name: "limit", In: "query",
+		Description: fmt.Sprintf("Max number of items in page (default %d, max %d)", apiDefaultLimit, apiMaxLimit),
+		Schema:      &openapi.Schema{Type: "integer", Format: "int32"},
+	}
+)
+
+func (a *App) apiRoutes() []*apiRoute {
+	return []*apiRoute{
+		{
+			method: http.MethodGet, path: "/me", id: "getMe",
+			summary:  "Returns the authenticated user",
+			response: api.User{},
+			handler:  a.apiMeHandler,
+		},
+		{
+			method: http.MethodGet, path: "/days", id: "listDays",
+			summary: "Returns follower and friend counts for each day in the range",
+			params: []*openapi.Parameter{
+				{
+					Name: "from", In: "query",
+					Description: fmt.Sprintf("First ISO date of the range (default %d days before to)", apiDefaultDays-1),
+					Schema:      &openapi.Schema{Type: "string", Format: "date"},
+				},
+				{
+					Name: "to", In: "query",
+					Description: "Last ISO date of the range (default today)",
+					Schema:      &openapi.Schema{Type: "string", Format: "date"},
+				},
+			},
+			response: api.DayList{},
+			handler:  a.apiDaysHandler,
+		},
+		{
+			method: http.MethodGet, path: "/days/:date", id: "getDay",
+			summary:  "Returns follower and friend counts on the day",
+			params:   []*openapi.Parameter{dateParam},
+			response: api.Day{},
+			handler:  a.apiDayHandler,
+		},
+		{
+			method: http.MethodGet, path: "/days/:date/:list", id: "listDayProfiles",
+			summary:  "Returns profiles of users in the day list, newest first",
+			params:   []*openapi.Parameter{dateParam, listParam, cursorParam, limitParam},
+			response: api.ProfilePage{},
+			handler:  a.apiDayListHandler,
+		},
+		{
+			method: http.MethodGet, path: "/snapshots/:date", id: "listSnapshots",
+			summary:  "Returns changes between worker runs grouped by period of the day",
+			params:   []*openapi.Parameter{dateParam},
+			response: api.SnapshotList{},
+			handler:  a.apiSnapshotsHandler,
+		},
+		{
+			method: http.MethodGet, path: "/users/:id/timeline", id: "getUserTimeline",
+			summary: "Returns history of the relationship with the account",
+			params: []*openapi.Parameter{
+				{
+					Name: "id", In: "path", Required: true,
+					Description: "Twitter user ID of the account",
+					Schema:      &openapi.Schema{Type: "string"},
+				},
+			},
+			response: api.Timeline{},
+			handler:  a.apiTimelineHandler,
+		},
+		{
+			method: http.MethodGet, path: "/search", id: "searchAccounts",
+			summary: "Returns accounts with cached profile matching the search, most recent event first",
+			params: []*openapi.Parameter{
+				{
+					Name: "q", In: "query",
+					Description: "Text matched against username, name, description and location",
+					Schema:      &openapi.Schema{Type: "string"},
+				},
+				{
+					Name: "relation", In: "query",
+					Description: "Current relationship: follower, friend, mutual or former (follower)",
+					Schema:      &openapi.Schema{Type: "string"},
+				},
+				{
+					Name: "from", In: "query",
+					Description: "First ISO date of the last change",
+					Schema:      &openapi.Schema{Type: "string", Format: "date"},
+				},
+				{
+					Name: "to", In: "query",
+					Description: "Last ISO date of the last change",
+					Schema:      &openapi.Schema{Type: "string", Format: "date"},
+				},
+				cursorParam, limitParam,
+			},
+			response: api.SearchPage{},
+			handler:  a.apiSearchHandler,
+		},
+		{
+			method: http.MethodGet, path: "/openapi.json", id: "getSpec",
+			summary:  "Returns the OpenAPI spec of this API",
+			response: map[string]interface{}{},
+			public:   true,
+			handler:  a.apiSpecHandler,
+		},
+	}
+}
+
+// setAPIRoutes registers the API routes, all except the public ones require API token
+func (a *App) setAPIRoutes(r *gin.Engine) {
+	g := r.Group(apiPathPrefix)
+	for _, rt := range a.apiRoutes() {
+		if rt.public {
+			g.Handle(rt.method, rt.path, rt.handler)
+			continue
+		}
+		g.Handle(rt.method, rt.path, a.apiTokenRequired, rt.handler)
+	}
+}
+
+// getAPISpec generates the OpenAPI spec from the API routes and response types
+func (a *App) getAPISpec() *openapi.Document {
+	doc := openapi.NewDocument(&openapi.Info{
+		Title:       "followme API",
+		Description: "Follower and friend data of the authenticated Twitter user",
+		Version:     a.appVersion,
+	})
+	doc.Components.SecuritySchemes[bearerAuthScheme] = &openapi.SecurityScheme{
+		Type:        "http",
+		Scheme:      "bearer",
+		Description: "Personal API token created with the followme token command",
+	}
+
+	for _, rt := range a.apiRoutes() {
+		op := &openapi.Operation{
+			OperationID: rt.id,
+			Summary:     rt.summary,
+			Parameters:  rt.params,
+			Responses: map[string]*openapi.Response{
+				"200":     doc.JSONResponse("Success", rt.response),
+				"default": doc.JSONResponse("Error", api.Error{}),
+			},
+		}
+		if !rt.public {
+			op.Security = []map[string][]string{{bearerAuthScheme: {}}}
+			op.Responses["401"] = doc.JSONResponse("Missing or invalid API token", api.Error{})
+		}
+		doc.AddOperation(apiPathPrefix+toOpenAPIPath(rt.path), rt.method, op)
+	}
+	return doc
+}
+
+// toOpenAPIPath converts gin path parameters (:name) to the OpenAPI format ({name})
+func toOpenAPIPath(p string) string {
+	parts := strings.Split(p, "/")
+	for i, s := range parts {
+		if strings.HasPrefix(s, ":") {
+			parts[i] = "{" + s[1:] + "}"
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+// apiTokenRequired authenticates requests with the API token in the Authorization header,
+// session cookies are not accepted by the API
+func (a *App) apiTokenRequired(c *gin.Context) {
+	h := c.GetHeader("Authorization")
+	if !strings.HasPrefix(h, "Bearer ") {
+		a.apiErrorAndAbort(c, http.StatusUnauthorized, "API token required in the Authorization header")
+		return
+	}
+
+	t, err := data.GetAPITokenByValue(a.db, strings.TrimSpace(strings.TrimPrefix(h, "Bearer ")))
+	if err != nil {
+		a.apiServerErrorAndAbort(c, err)
+		return
+	}
+	if t == nil {
+		a.apiErrorAndAbort(c, http.StatusUnauthorized, "Invalid API token")
+		return
+	}
+
+	if now := time.Now().UTC(); now.Sub(t.LastUsedAt) >= apiTokenTouchInterval {
+		if err := data.TouchAPIToken(a.db, t, now); err != nil {
+			a.logger.Printf("error updating API token last use: %v", err)
+		}
+	}
+
+	c.Set(userContextKey, t.Username)
+	c.Next()
+}
+
+// apiErrorAndAbort writes API error with the status code and message
+func (a *App) apiErrorAndAbort(c *gin.Context, code int, msg string) {
+	c.AbortWithStatusJSON(code, &api.Error{Code: code, Message: msg})
+}
+
+// apiServerErrorAndAbort logs the error and writes API error, Twitter rate limits are returned as 429
+func (a *App) apiServerErrorAndAbort(c *gin.Context, err error) {
+	a.logger.Printf("error while processing API request: %v", err)
+	var rateErr *data.RateLimitError
+	if errors.As(err, &rateErr) {
+		c.Header("Retry-After", strconv.Itoa(int(time.Until(rateErr.ResetAt).Seconds())+1))
+		a.apiErrorAndAbort(c, http.StatusTooManyRequests, "Twitter API limit reached, please try again later")
+		return
+	}
+	a.apiErrorAndAbort(c, http.StatusInternalServerError, "Internal server error")
+}
+
+func (a *App) apiMeHandler(c *gin.Context) {
+	forUser, err := a.getUser(c)
+	if err != nil {
+		a.apiServerErrorAndAbort(c, err)
+		return
+	}
+
+	var profile data.Profile
+	if err := a.db.One("Username", forUser.Username, &profile); err != nil {
+		a.apiServerErrorAndAbort(c, errors.Wrapf(err, "error getting user profile for %s", forUser.Username))
+		return
+	}
+
+	c.JSON(http.StatusOK, &api.User{
+		Username:  forUser.Username,
+		UpdatedAt: forUser.UpdatedAt,
+		Profile:   api.NewProfile(&profile),
+	})
+}
+
+// parseAPILimit parses the limit query value, returns apiDefaultLimit when not set
+func parseAPILimit(c *gin.Context) (int, error) {
+	v := c.Query("limit")
+	if v == "" {
+		return apiDefaultLimit, nil
+	}
+	limit, err := strconv.Atoi(v)
+	if err != nil || limit < 1 || limit > apiMaxLimit {
+		return 0, errors.Errorf("limit must be between 1 and %d", apiMaxLimit)
+	}
+	return limit, nil
+}
+
+// parseAPIDate parses ISO date query value, returns def when the value is empty
+func parseAPIDate(v string, def time.Time) (time.Time, error) {
+	if v == "" {
+		return def, nil
+	}
+	d, err := time.Parse(format.ISODateLayout, v)
+	if err != nil {
+		return d, errors.Errorf("invalid date %q, expected YYYY-MM-DD", v)
+	}
+	return d, nil
+}
+
+func (a *App) apiDaysHandler(c *gin.Context) {
+	username := c.GetString(userContextKey)
+
+	now, err := a.getUserNow(username)
+	if err != nil {
+		a.apiServerErrorAndAbort(c, err)
+		return
+	}
+	today, _ := time.Parse(format.ISODateLayout, format.ToISODate(now))
+	to, err := parseAPIDate(c.Query("to"), today)
+	if err != nil {
+		a.apiErrorAndAbort(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	from, err := parseAPIDate(c.Query("from"), to.AddDate(0, 0, -(apiDefaultDays-1)))
+	if err != nil {
+		a.apiErrorAndAbort(c, http.StatusBadRequest, err.Error())
+		return
+	}
+	if from.After(to) {
+		a.apiErrorAndAbort(c, http.StatusBadRequest, "from must not be after to")
+		return
+	}
+	if days := int(to.Sub(from).Hours()/24) + 1; days > apiMaxDays {
+		a.apiErrorAndAbort(c, http.StatusBadRequest, fmt.Sprintf("range of %d days exceeds max of %d", days, apiMaxDays))
+		return
+	}
+
+	list := &api.DayList{Days: make([]*api.Day, 0)}
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		s, err := a.getState(username, format.ToISODate(d))
+		if err != nil {
+			a.apiServerErrorAndAbort(c, err)
+			return
+		}
+		list.Days = append(list.Days, api.NewDay(s))
+	}
+
+	c.JSON(http.StatusOK, list)
+}
+
+func (a *App) apiDayHandler(c *gin.Context) {
+	d, err := parseAPIDate(c.Param("date"), time.Time{})
+	if err != nil {
+		a.apiErrorAndAbort(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s, err := a.getState(c.GetString(userContextKey), format.ToISODate(d))
+	if err != nil {
+		a.apiServerErrorAndAbort(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, api.NewDay(s))
+}
+
+func (a *App) apiDayListHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+	forUser, err := a.getUser(c)
+	if err != nil {
+		a.apiServerErrorAndAbort(c, err)
+		return
+	}
+
+	d, err := parseAPIDate(c.Param("date"), time.Time{})
+	if err != nil {
+		a.apiErrorAndAbort(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limit, err := parseAPILimit(c)
+	if err != nil {
+		a.apiErrorAndAbort(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	s, err := a.getState(forUser.Username, format.ToISODate(d))
+	if err != nil {
+		a.apiServerErrorAndAbort(c, err)
+		return
+	}
+
+	var ids []int64
+	switch c.Param("list") {
+	case data.FollowedEventType:
+		ids = s.NewFollowers
+	case data.UnfollowedEventType:
+		ids = s.NewUnfollowers
+	case data.FriendedEventType:
+		ids = s.NewFriends
+	case data.UnfriendedEventType:
+		ids = s.NewUnfriended
+	default:
+		a.apiErrorAndAbort(c, http.StatusBadRequest, fmt.Sprintf("invalid list: %s", c.Param("list")))
+		return
+	}
+
+	pageIDs, next, err := getCursorPage(ids, c.Query("cursor"), limit)
+	if err != nil {
+		a.apiErrorAndAbort(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	profiles, err := a.getProfiles(ctx, forUser, pageIDs)
+	if err != nil {
+		a.apiServerErrorAndAbort(c, errors.Wrap(err, "error getting user details"))
+		return
+	}
+
+	page := &api.ProfilePage{Items: make([]*api.Profile, 0, len(profiles)), NextCursor: next}
+	for _, p := range profiles {
+		page.Items = append(page.Items, api.NewProfile(p))
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+func (a *App) apiSnapshotsHandler(c *gin.Context) {
+	d, err := parseAPIDate(c.Param("date"), time.Time{})
+	if err != nil {
+		a.apiErrorAndAbort(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	isoDate := format.ToISODate(d)
+	snapshots, err := data.GetSnapshots(a.db, c.GetString(userContextKey), isoDate)
+	if err != nil {
+		a.apiServerErrorAndAbort(c, err)
+		return
+	}
+
+	list := &api.SnapshotList{Date: isoDate, Snapshots: make([]*api.Snapshot, 0, len(snapshots))}
+	for _, s := range snapshots {
+		list.Snapshots = append(list.Snapshots, api.NewSnapshot(s))
+	}
+
+	c.JSON(http.StatusOK, list)
+}
+
+func (a *App) apiTimelineHandler(c *gin.Context) {
+	forUser, err := a.getUser(c)
+	if err != nil {
+		a.apiServerErrorAndAbort(c, err)
+		return
+	}
+
+	t, account, err := a.getTimeline(c.Request.Context(), forUser, c.Param("id"))
+	switch err {
+	case nil:
+	case errInvalidAccountID:
+		a.apiErrorAndAbort(c, http.StatusBadRequest, err.Error())
+		return
+	case errNoAccountHistory:
+		a.apiErrorAndAbort(c, http.StatusNotFound, err.Error())
+		return
+	default:
+		a.apiServerErrorAndAbort(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, api.NewTimeline(t, account))
+}
+
+func (a *App) apiSearchHandler(c *gin.Context) {
+	q, err := parseAccountQuery(c)
+	if err != nil {
+		a.apiErrorAndAbort(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limit, err := parseAPILimit(c)
+	if err != nil {
+		a.apiErrorAndAbort(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	results, err := data.SearchAccounts(a.db, c.GetString(userContextKey), q)
+	if err != nil {
+		a.apiServerErrorAndAbort(c, err)
+		return
+	}
+
+	ids := make([]int64, 0, len(results))
+	byID := make(map[int64]*data.AccountMatch, len(results))
+	for _, m := range results {
+		ids = append(ids, m.Profile.ID)
+		byID[m.Profile.ID] = m
+	}
+
+	pageIDs, next, err := getCursorPage(ids, c.Query("cursor"), limit)
+	if err != nil {
+		a.apiErrorAndAbort(c, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	page := &api.SearchPage{Items: make([]*api.SearchResult, 0, len(pageIDs)), Total: len(results), NextCursor: next}
+	for _, id := range pageIDs {
+		page.Items = append(page.Items, api.NewSearchResult(byID[id]))
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+func (a *App) apiSpecHandler(c *gin.Context) {
+	c.JSON(http.StatusOK, a.getAPISpec())
+}
+
+// getCursorPage returns up to limit IDs following the cursor and the cursor of the next page.
+// Cursor encodes the last ID of the previous page so pages stay consistent when IDs are added
+// to the beginning of the list (e.g. today's list updated by worker).
+func getCursorPage(ids []int64, cursor string, limit int) ([]int64, string, error) {
+	start := 0
+	if cursor != "" {
+		b, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err != nil {
+			return nil, "", errors.New("invalid cursor")
+		}
+		last, err := strconv.ParseInt(string(b), 10, 64)
+		if err != nil {
+			return nil, "", errors.New("invalid cursor")
+		}
+		start = -1
+		for i, id := range ids {
+			if id == last {
+				start = i + 1
+				break
+			}
+		}
+		if start < 0 {
+			return nil, "", errors.New("cursor no longer valid, start from the first page")
+		}
+	}
+
+	end := start + limit
+	if end >= len(ids) {
+		return ids[start:], "", nil
+	}
+	next := base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(ids[end-1], 10)))
+	return ids[start:end], next, nil
+}
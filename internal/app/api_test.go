@@ -0,0 +1,263 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/mchmarny/followme/internal/api"
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/internal/twitter/twittertest"
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/stretchr/testify/assert"
+)
+
+func serveAPI(r http.Handler, target, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestAPI(t *testing.T) {
+	s := twittertest.NewServer()
+	defer s.Close()
+	s.AddProfile(&twittertest.Profile{ID: 1, Username: "tester", Name: "Tester"})
+	s.SetLoginUser("tester")
+
+	followers := make([]int64, 0)
+	for id := int64(26); id >= 2; id-- {
+		s.AddProfile(&twittertest.Profile{ID: id, Username: fmt.Sprintf("user%d", id)})
+		followers = append(followers, id)
+	}
+
+	a, r := getTestApp(t, s)
+	defer a.db.Close()
+	cookies := login(t, s, r)
+
+	now := time.Now().UTC()
+	today := format.ToISODate(now)
+	assert.NoError(t, a.db.Save(&data.DailyState{
+		Key:              data.GetDailyStateKey("tester", now),
+		Username:         "tester",
+		StateOn:          today,
+		UpdatedOn:        now,
+		SnapshotOn:       today,
+		Followers:        followers,
+		FollowerCount:    len(followers),
+		NewFollowers:     followers,
+		NewFollowerCount: len(followers),
+	}))
+
+	token, _, err := data.CreateAPIToken(a.db, "tester", "test")
+	assert.NoError(t, err)
+
+	t.Run("auth", func(t *testing.T) {
+		w := serveAPI(r, "/api/v1/me", "")
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		var e api.Error
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &e))
+		assert.Equal(t, http.StatusUnauthorized, e.Code)
+
+		assert.Equal(t, http.StatusUnauthorized, serveAPI(r, "/api/v1/me", "fm_invalid").Code)
+
+		// session cookie isn't accepted
+		w = serve(r, http.MethodGet, "/api/v1/me", cookies...)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		w = serveAPI(r, "/api/v1/me", token)
+		assert.Equal(t, http.StatusOK, w.Code)
+		var u api.User
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &u))
+		assert.Equal(t, "tester", u.Username)
+		assert.Equal(t, "1", u.Profile.IDStr)
+
+		list, err := data.GetAPITokens(a.db, "tester")
+		assert.NoError(t, err)
+		assert.False(t, list[0].LastUsedAt.IsZero())
+	})
+
+	t.Run("days", func(t *testing.T) {
+		w := serveAPI(r, "/api/v1/days", token)
+		assert.Equal(t, http.StatusOK, w.Code)
+		var list api.DayList
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+		assert.Len(t, list.Days, apiDefaultDays)
+		last := list.Days[len(list.Days)-1]
+		assert.Equal(t, today, last.Date)
+		assert.True(t, last.HasData)
+		assert.Equal(t, 25, last.NewFollowerCount)
+		assert.False(t, list.Days[0].HasData)
+
+		w = serveAPI(r, "/api/v1/days?from=2020-01-02&to=2020-01-01", token)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		w = serveAPI(r, "/api/v1/days?from=2019-01-01&to=2021-01-01", token)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = serveAPI(r, "/api/v1/days/"+today, token)
+		assert.Equal(t, http.StatusOK, w.Code)
+		var d api.Day
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &d))
+		assert.Equal(t, 25, d.FollowerCount)
+
+		assert.Equal(t, http.StatusBadRequest, serveAPI(r, "/api/v1/days/today", token).Code)
+	})
+
+	t.Run("paging", func(t *testing.T) {
+		ids := make([]int64, 0)
+		cursor := ""
+		for pages := 0; pages < 5; pages++ {
+			target := fmt.Sprintf("/api/v1/days/%s/%s?limit=10&cursor=%s",
+				today, data.FollowedEventType, url.QueryEscape(cursor))
+			w := serveAPI(r, target, token)
+			assert.Equal(t, http.StatusOK, w.Code)
+			var page api.ProfilePage
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &page))
+			for _, p := range page.Items {
+				ids = append(ids, p.ID)
+			}
+			if cursor = page.NextCursor; cursor == "" {
+				break
+			}
+		}
+		assert.Equal(t, followers, ids)
+
+		w := serveAPI(r, fmt.Sprintf("/api/v1/days/%s/%s?cursor=bad", today, data.FollowedEventType), token)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		w = serveAPI(r, fmt.Sprintf("/api/v1/days/%s/%s?limit=1000", today, data.FollowedEventType), token)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		w = serveAPI(r, fmt.Sprintf("/api/v1/days/%s/invalid", today), token)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("snapshots", func(t *testing.T) {
+		for _, h := range []int{6, 12} {
+			start := time.Date(now.Year(), now.Month(), now.Day(), h, 0, 0, 0, time.UTC)
+			assert.NoError(t, a.db.Save(&data.Snapshot{
+				Key:          data.GetSnapshotKey("tester", start),
+				Username:     "tester",
+				StateOn:      today,
+				PeriodStart:  start,
+				Runs:         1,
+				NewFollowers: []int64{int64(h)},
+			}))
+		}
+
+		w := serveAPI(r, "/api/v1/snapshots/"+today, token)
+		assert.Equal(t, http.StatusOK, w.Code)
+		var list api.SnapshotList
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &list))
+		assert.Equal(t, today, list.Date)
+		assert.Len(t, list.Snapshots, 2)
+		assert.Equal(t, []int64{12}, list.Snapshots[1].NewFollowerIDs)
+		assert.NotNil(t, list.Snapshots[1].LostFollowerIDs)
+
+		w = serve(r, http.MethodGet, "/view/day/"+today, cookies...)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "snapshots-table")
+	})
+
+	t.Run("timeline", func(t *testing.T) {
+		w := serveAPI(r, "/api/v1/users/5/timeline", token)
+		assert.Equal(t, http.StatusOK, w.Code)
+		var tl api.Timeline
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &tl))
+		assert.Equal(t, "5", tl.IDStr)
+		assert.Equal(t, "user5", tl.Profile.Username)
+		assert.True(t, tl.Follower)
+		assert.False(t, tl.Friend)
+		assert.Equal(t, []*api.TimelineEvent{{Date: today, Type: data.FollowedEventType}}, tl.Events)
+
+		assert.Equal(t, http.StatusNotFound, serveAPI(r, "/api/v1/users/999/timeline", token).Code)
+		assert.Equal(t, http.StatusBadRequest, serveAPI(r, "/api/v1/users/me/timeline", token).Code)
+
+		w = serve(r, http.MethodGet, "/view/user/5", cookies...)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "timeline-table")
+		assert.Contains(t, w.Body.String(), "they followed you")
+	})
+
+	t.Run("search", func(t *testing.T) {
+		assert.NoError(t, a.db.Save(&data.Profile{ID: 7, Username: "user7", Location: "Lisbon"}))
+		assert.NoError(t, a.db.Save(&data.Profile{ID: 8, Username: "user8", Location: "Lisbon, Portugal"}))
+
+		w := serveAPI(r, "/api/v1/search?q=lisbon&relation=follower&limit=1", token)
+		assert.Equal(t, http.StatusOK, w.Code)
+		var page api.SearchPage
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &page))
+		assert.Equal(t, 2, page.Total)
+		assert.Len(t, page.Items, 1)
+		assert.Equal(t, "user8", page.Items[0].Profile.Username)
+		assert.Equal(t, today, page.Items[0].LastEventOn)
+		assert.NotEmpty(t, page.NextCursor)
+
+		w = serveAPI(r, "/api/v1/search?q=lisbon&relation=follower&limit=1&cursor="+page.NextCursor, token)
+		assert.Equal(t, http.StatusOK, w.Code)
+		page = api.SearchPage{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &page))
+		assert.Equal(t, "user7", page.Items[0].Profile.Username)
+		assert.Empty(t, page.NextCursor)
+
+		w = serveAPI(r, "/api/v1/search?q=lisbon&relation=former", token)
+		page = api.SearchPage{}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &page))
+		assert.Equal(t, 0, page.Total)
+
+		assert.Equal(t, http.StatusBadRequest, serveAPI(r, "/api/v1/search?relation=enemy", token).Code)
+		assert.Equal(t, http.StatusBadRequest, serveAPI(r, "/api/v1/search?from=yesterday", token).Code)
+
+		w = serve(r, http.MethodGet, "/view/search?q=portugal", cookies...)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "/view/user/8")
+		assert.Contains(t, w.Body.String(), "/view/day/"+today)
+		assert.NotContains(t, w.Body.String(), "/view/user/7")
+	})
+
+	t.Run("spec", func(t *testing.T) {
+		w := serveAPI(r, "/api/v1/openapi.json", "")
+		assert.Equal(t, http.StatusOK, w.Code)
+		var doc struct {
+			OpenAPI    string                     `json:"openapi"`
+			Paths      map[string]json.RawMessage `json:"paths"`
+			Components struct {
+				Schemas map[string]json.RawMessage `json:"schemas"`
+			} `json:"components"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+		assert.Equal(t, "3.0.3", doc.OpenAPI)
+		assert.Contains(t, doc.Paths, "/api/v1/days/{date}/{list}")
+		assert.Contains(t, doc.Paths, "/api/v1/users/{id}/timeline")
+		assert.Contains(t, doc.Paths, "/api/v1/search")
+		for _, name := range []string{"User", "Profile", "Day", "DayList", "ProfilePage", "Timeline", "SearchPage", "SearchResult", "Error"} {
+			assert.Contains(t, doc.Components.Schemas, name)
+		}
+	})
+}
+
+func TestCursorPage(t *testing.T) {
+	ids := []int64{5, 4, 3, 2, 1}
+
+	page, next, err := getCursorPage(ids, "", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{5, 4}, page)
+
+	// new IDs added to the beginning don't shift the next page
+	page, next, err = getCursorPage(append([]int64{7, 6}, ids...), next, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3, 2}, page)
+
+	page, next, err = getCursorPage(ids, next, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1}, page)
+	assert.Empty(t, next)
+
+	_, _, err = getCursorPage([]int64{9}, "Mw", 2)
+	assert.Error(t, err)
+}
@@ -2,14 +2,17 @@ package app
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"html/template"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"path"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -17,62 +20,159 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/kurrik/oauth1a"
 	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/internal/email"
+	"github.com/mchmarny/followme/internal/metrics"
 	"github.com/mchmarny/followme/internal/twitter"
+	"github.com/mchmarny/followme/internal/worker"
+	"github.com/mchmarny/followme/pkg/schedule"
 	"github.com/mchmarny/followme/pkg/url"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
+const (
+	// defaultShutdownTimeout is the max time to wait for requests in progress on shutdown
+	defaultShutdownTimeout = 10 * time.Second
+)
+
+// Config represents the app configuration
+type Config struct {
+	// DBPath is the path to the data file
+	DBPath string
+	// Key is the Twitter API key
+	Key string
+	// Secret is the Twitter API secret
+	Secret string
+	// AppURL is the app server base URL (without port)
+	AppURL string
+	// APIURL is the Twitter API base URL
+	APIURL string
+	// EncryptionKey is the master key used to encrypt stored access tokens
+	EncryptionKey []byte
+	// Version is the app version
+	Version string
+	// Port is the app server port
+	Port int
+	// DevMode loads static resources from the file system
+	DevMode bool
+	// WorkerSchedule runs worker inside of the app on this schedule when set
+	WorkerSchedule schedule.Schedule
+	// ProfileTTL is the time after which cached profiles are refreshed (data.DefaultProfileTTL when not set)
+	ProfileTTL time.Duration
+	// RateLimitWait is the max time worker waits for Twitter API rate limit reset
+	RateLimitWait time.Duration
+	// SnapshotInterval is the period of the day changes found by worker are grouped by (data.DefaultSnapshotInterval when not set)
+	SnapshotInterval time.Duration
+	// Mail is the SMTP server used to send email digests after worker runs, digests are disabled when not set
+	Mail *email.Config
+	// Headless doesn't open the app in browser on start (e.g. when running in a container)
+	Headless bool
+	// Metrics serves Prometheus metrics at /metrics, the route doesn't require login
+	Metrics bool
+}
+
 // NewApp creates a new instance of the app
-func NewApp(dbPath, key, secret, url, version string, port int, dev bool) (*App, error) {
-	if key == "" || secret == "" || version == "" {
+func NewApp(cfg *Config) (*App, error) {
+	if cfg == nil || cfg.Key == "" || cfg.Secret == "" || cfg.Version == "" {
 		return nil, errors.New("key, secret, and version required")
 	}
+	if cfg.SnapshotInterval != 0 {
+		if err := data.ValidateSnapshotInterval(cfg.SnapshotInterval); err != nil {
+			return nil, err
+		}
+	}
 
 	// log
 	logger := log.New(os.Stdout, "", 0)
 
 	// data
-	db, err := data.GetDB(dbPath)
+	db, err := data.GetDB(cfg.DBPath)
 	if err != nil {
 		return nil, errors.Wrap(err, "error getting DB")
 	}
 
+	// session
+	sessionSecret, err := getSessionSecret(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting session secret")
+	}
+
 	// twitter
-	t := twitter.NewTwitter(key, secret, logger)
+	apiURL := strings.TrimSuffix(cfg.APIURL, "/")
+	if apiURL == "" {
+		apiURL = twitter.DefaultAPIURL
+	}
+	t, err := twitter.NewTwitter(cfg.Key, cfg.Secret, apiURL, data.NewCursorStore(db), data.NewRateLimitStore(db), logger)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating Twitter client")
+	}
 
 	// oauth
 	as := &oauth1a.Service{
-		RequestURL:   "https://api.twitter.com/oauth/request_token",
-		AuthorizeURL: "https://api.twitter.com/oauth/authorize",
-		AccessURL:    "https://api.twitter.com/oauth/access_token",
+		RequestURL:   fmt.Sprintf("%s/oauth/request_token", apiURL),
+		AuthorizeURL: fmt.Sprintf("%s/oauth/authorize", apiURL),
+		AccessURL:    fmt.Sprintf("%s/oauth/access_token", apiURL),
 		ClientConfig: &oauth1a.ClientConfig{
-			ConsumerKey:    key,
-			ConsumerSecret: secret,
-			CallbackURL:    fmt.Sprintf("%s:%d/auth/callback", url, port),
+			ConsumerKey:    cfg.Key,
+			ConsumerSecret: cfg.Secret,
+			CallbackURL:    fmt.Sprintf("%s:%d/auth/callback", cfg.AppURL, cfg.Port),
 		},
 		Signer: new(oauth1a.HmacSha1Signer),
 	}
 
+	profileTTL := cfg.ProfileTTL
+	if profileTTL <= 0 {
+		profileTTL = data.DefaultProfileTTL
+	}
+
+	// metrics
+	metricsRegistry, err := metrics.NewRegistry(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating metrics registry")
+	}
+
+	appURL := fmt.Sprintf("%s:%d", cfg.AppURL, cfg.Port)
+
+	var digest *Digest
+	if cfg.Mail != nil {
+		if digest, err = NewDigest(&DigestConfig{Mail: cfg.Mail, AppURL: GetDashboardURL(appURL)}); err != nil {
+			return nil, errors.Wrap(err, "error creating email digest")
+		}
+	}
+
 	return &App{
 		db:                 db,
-		twClient:           t,
+		graph:              t,
 		authService:        as,
 		logger:             logger,
-		appVersion:         version,
-		hostPort:           fmt.Sprintf("0.0.0.0:%d", port),
+		appVersion:         cfg.Version,
+		hostPort:           fmt.Sprintf("0.0.0.0:%d", cfg.Port),
 		pageSize:           10,                // TODO: parameterize
 		userCookieDuration: 60 * 60 * 24 * 30, // month in sec
 		maxSessionAge:      5.0,               // min
 		sessionCookieAge:   5 * 60,            // maxSessionAge in secs
-		appURL:             fmt.Sprintf("%s:%d", url, port),
-		devMode:            dev,
+		appURL:             appURL,
+		devMode:            cfg.DevMode,
+		sessionSecret:      sessionSecret,
+		secureCookies:      strings.HasPrefix(strings.ToLower(cfg.AppURL), "https://"),
+		encryptionKey:      cfg.EncryptionKey,
+		workerSchedule:     cfg.WorkerSchedule,
+		profileTTL:         profileTTL,
+		rateLimitWait:      cfg.RateLimitWait,
+		snapshotInterval:   cfg.SnapshotInterval,
+		digest:             digest,
+		metrics:            metricsRegistry,
+		metricsEnabled:     cfg.Metrics,
+		headless:           cfg.Headless,
+		shutdownTimeout:    defaultShutdownTimeout,
 	}, nil
 }
 
 // App represents the app
 type App struct {
 	db                 *storm.DB
-	twClient           *twitter.Twitter
+	graph              data.GraphProvider
 	logger             *log.Logger
 	authService        *oauth1a.Service
 	hostPort           string
@@ -83,26 +183,159 @@ type App struct {
 	sessionCookieAge   int
 	appURL             string
 	devMode            bool
+	sessionSecret      []byte
+	secureCookies      bool
+	encryptionKey      []byte
+	workerSchedule     schedule.Schedule
+	profileTTL         time.Duration
+	rateLimitWait      time.Duration
+	snapshotInterval   time.Duration
+	digest             *Digest
+	metrics            *prometheus.Registry
+	metricsEnabled     bool
+	headless           bool
+	shutdownTimeout    time.Duration
+	// ready is 1 while the server accepts requests, accessed atomically
+	ready int32
 }
 
-// Run starts the app and blocks while running.
+// Run starts the app and blocks until SIGINT or SIGTERM is received,
+// then stops accepting new requests and waits for the ones in progress to complete.
 func (a *App) Run() error {
-	gin.SetMode(gin.ReleaseMode)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// signals
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(done)
+
+	go func() {
+		select {
+		case sig := <-done:
+			a.logger.Printf("\nClosing: %v", sig)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return a.serve(ctx)
+}
 
+// serve runs the app server (and the worker when scheduled) until the context is canceled
+func (a *App) serve(ctx context.Context) error {
 	// cleanup
 	defer a.db.Close()
 
+	r, err := a.getRouter()
+	if err != nil {
+		return err
+	}
+
+	// worker, stopped after the server so requests in progress can still read its data
+	workerCtx, cancelWorker := context.WithCancel(context.Background())
+	workerDone, err := a.startWorker(workerCtx)
+	if err != nil {
+		cancelWorker()
+		return err
+	}
+	defer func() {
+		cancelWorker()
+		<-workerDone
+	}()
+
+	// start
+	ln, err := net.Listen("tcp", a.hostPort)
+	if err != nil {
+		return errors.Wrapf(err, "error listening on %s", a.hostPort)
+	}
+	srv := &http.Server{Handler: r}
+	serverErr := make(chan error, 1)
+	go func() {
+		a.logger.Printf("Listening: %s \n", a.hostPort)
+		if err := srv.Serve(ln); err != nil && err != http.ErrServerClosed {
+			serverErr <- errors.Wrap(err, "error while running app server")
+		}
+	}()
+	atomic.StoreInt32(&a.ready, 1)
+
+	if !a.headless {
+		a.logger.Printf("Opening: %s", a.appURL)
+		if err := url.Open(a.appURL); err != nil {
+			// server keeps running, the URL can be opened manually
+			a.logger.Printf("error opening browser (use --headless to skip): %v", err)
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+	case err := <-serverErr:
+		atomic.StoreInt32(&a.ready, 0)
+		return err
+	}
+
+	// stop
+	atomic.StoreInt32(&a.ready, 0)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), a.shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return errors.Wrap(err, "error shutting down app server")
+	}
+	a.logger.Println("App server stopped")
+	return nil
+}
+
+// startWorker runs worker on the app DB when schedule is set,
+// returned channel is closed after worker stops
+func (a *App) startWorker(ctx context.Context) (<-chan struct{}, error) {
+	done := make(chan struct{})
+	if a.workerSchedule == nil {
+		close(done)
+		return done, nil
+	}
+
+	w, err := worker.NewWorkerWithProvider(a.db, a.graph, &worker.Config{
+		EncryptionKey:    a.encryptionKey,
+		Version:          a.appVersion,
+		ProfileTTL:       a.profileTTL,
+		RateLimitWait:    a.rateLimitWait,
+		AfterRun:         a.digest.AfterRun(),
+		SnapshotInterval: a.snapshotInterval,
+	})
+	if err != nil {
+		close(done)
+		return done, errors.Wrap(err, "error creating worker")
+	}
+
+	go func() {
+		defer close(done)
+		if err := w.Start(ctx, a.workerSchedule, 0); err != nil {
+			a.logger.Printf("error while running worker: %v", err)
+		}
+	}()
+
+	return done, nil
+}
+
+func (a *App) getRouter() (*gin.Engine, error) {
+	gin.SetMode(gin.ReleaseMode)
+
 	// router
 	r := gin.New()
 	r.Use(gin.Recovery())
 
 	// templates
 	if err := a.setStaticContent(r); err != nil {
-		return err
+		return nil, err
 	}
 
 	// routes
 	r.GET("/", a.defaultHandler)
+	if a.metricsEnabled {
+		r.GET("/metrics", gin.WrapH(promhttp.HandlerFor(a.metrics, promhttp.HandlerOpts{})))
+	}
+	r.GET("/healthz", a.healthHandler)
+	r.GET("/readyz", a.readyHandler)
 
 	// auth (authing itself)
 	auth := r.Group("/auth")
@@ -114,49 +347,30 @@ func (a *App) Run() error {
 
 	// authenticated routes
 	view := r.Group("/view")
-	view.Use(authRequired(false))
+	view.Use(a.authRequired(false))
 	{
 		view.GET("/dash", a.dashboardHandler)
 		view.GET("/day/:day", a.dayHandler)
 		view.GET("/report", a.reportHandler)
+		view.GET("/status", a.statusHandler)
+		view.GET("/user/:id", a.userTimelineHandler)
+		view.GET("/search", a.searchHandler)
 	}
 
 	data := r.Group("/data")
-	data.Use(authRequired(true))
+	data.Use(a.authRequired(true))
 	{
 		data.GET("/dash", a.dashboardQueryHandler)
 		data.GET("/day/:day/list/:list/page/:page", a.dayQueryHandler)
 		data.GET("/report/:id", a.reportDataHandler)
+		data.GET("/digest", a.digestQueryHandler)
+		data.POST("/digest", a.digestUpdateHandler)
 	}
 
-	// signals
-	done := make(chan os.Signal, 1)
-	serverErr := make(chan error, 1)
-	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
+	// API (token authenticated)
+	a.setAPIRoutes(r)
 
-	// start
-	go func() {
-		a.logger.Printf("Listening: %s \n", a.hostPort)
-		if err := r.Run(a.hostPort); err != nil {
-			serverErr <- errors.Wrap(err, "error while running app server")
-		}
-	}()
-
-	time.Sleep(2 * time.Second)
-	a.logger.Printf("Opening: %s", a.appURL)
-	if err := url.Open(a.appURL); err != nil {
-		return errors.Wrap(err, "error opening URL")
-	}
-
-	for {
-		select {
-		case sig := <-done:
-			a.logger.Printf("\nClosing: %v", sig)
-			return nil
-		case err := <-serverErr:
-			return err
-		}
-	}
+	return r, nil
 }
 
 func (a *App) setStaticContent(r *gin.Engine) error {
@@ -169,25 +383,11 @@ func (a *App) setStaticContent(r *gin.Engine) error {
 	}
 
 	// templates
-	templateFiles, err := AssetDir("web/template")
+	mt, err := parseTemplates()
 	if err != nil {
-		return errors.Wrap(err, "error laoding tempalates")
-	}
-
-	mt := template.New("")
-	for _, f := range templateFiles {
-		p := path.Join("web/template", f)
-		// a.logger.Printf("loading template: %s", p)
-		b, err := Asset(p)
-		if err != nil {
-			return errors.Wrapf(err, "error getting asset from: %s", p)
-		}
-		t, err := mt.New(f).Parse(string(b))
-		if err != nil {
-			return errors.Wrapf(err, "error parsing tempalate: %s", p)
-		}
-		r.SetHTMLTemplate(t)
+		return err
 	}
+	r.SetHTMLTemplate(mt)
 
 	// static
 	r.StaticFS("/static", AssetFile())
@@ -208,6 +408,33 @@ func (a *App) setStaticContent(r *gin.Engine) error {
 	return nil
 }
 
+// parseTemplates parses the embedded templates
+func parseTemplates() (*template.Template, error) {
+	templateFiles, err := AssetDir("web/template")
+	if err != nil {
+		return nil, errors.Wrap(err, "error laoding tempalates")
+	}
+
+	mt := template.New("")
+	for _, f := range templateFiles {
+		p := path.Join("web/template", f)
+		// a.logger.Printf("loading template: %s", p)
+		b, err := Asset(p)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting asset from: %s", p)
+		}
+		if _, err := mt.New(f).Parse(string(b)); err != nil {
+			return nil, errors.Wrapf(err, "error parsing tempalate: %s", p)
+		}
+	}
+	return mt, nil
+}
+
+// getUserNow returns the current time in the time zone of the user
+func (a *App) getUserNow(username string) (time.Time, error) {
+	return data.GetUserNow(a.db, username, time.Now())
+}
+
 func (a *App) getState(username, isoDate string) (*data.DailyState, error) {
 	key := data.GetDailyStateKeyISO(username, isoDate)
 	var s data.DailyState
@@ -224,6 +451,26 @@ func (a *App) getState(username, isoDate string) (*data.DailyState, error) {
 	return &s, nil
 }
 
+// getProfiles returns profiles from local cache, refreshes the ones older than profile TTL
+func (a *App) getProfiles(ctx context.Context, forUser *data.User, ids []int64) ([]*data.Profile, error) {
+	profiles, err := data.GetProfiles(ctx, a.db, a.graph, forUser, ids, a.profileTTL, time.Now().UTC())
+	if err != nil {
+		if len(profiles) == 0 {
+			return nil, err
+		}
+		a.logger.Printf("error refreshing profiles, using cached: %v", err)
+	}
+	return profiles, nil
+}
+
+// badRequestJSONAndAbort throws JSON error of invalid request with the message
+func (a *App) badRequestJSONAndAbort(c *gin.Context, msg string) {
+	c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{
+		"message": msg,
+		"status":  "Error",
+	})
+}
+
 // errJSONAndAbort throws JSON error and abort prevents pending handlers from being called
 func (a *App) errJSONAndAbort(c *gin.Context, err error) {
 	a.logger.Printf("error while processing JSON request: %v", err)
@@ -241,6 +488,13 @@ func (a *App) errJSONAndAbort(c *gin.Context, err error) {
 		msg = "Too Many Requests, please wait a few minutes and try again."
 	}
 
+	var rateErr *data.RateLimitError
+	if errors.As(err, &rateErr) {
+		code = http.StatusTooManyRequests
+		msg = fmt.Sprintf("Twitter API limit reached, please try again after %s.",
+			rateErr.ResetAt.Local().Format(time.Kitchen))
+	}
+
 	c.AbortWithStatusJSON(code, gin.H{
 		"message": msg,
 		"status":  "Error",
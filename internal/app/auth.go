@@ -17,8 +17,9 @@ import (
 )
 
 const (
-	userIDCookieName = "user_id"
-	authIDCookieName = "auth_id"
+	sessionIDCookieName = "session_id"
+	authIDCookieName    = "auth_id"
+	userContextKey      = "username"
 )
 
 // AuthSession represents the authenticated user session
@@ -29,8 +30,7 @@ type AuthSession struct {
 }
 
 func (a *App) authLoginHandler(c *gin.Context) {
-	uid, _ := c.Cookie(userIDCookieName)
-	if uid != "" {
+	if _, err := a.getSessionUsername(c); err == nil {
 		c.Redirect(http.StatusSeeOther, "/view/dash")
 		return
 	}
@@ -58,7 +58,7 @@ func (a *App) authLoginHandler(c *gin.Context) {
 		return
 	}
 
-	c.SetCookie(authIDCookieName, authSession.ID, a.sessionCookieAge, "/", c.Request.Host, false, true)
+	a.setCookie(c, authIDCookieName, authSession.ID, a.sessionCookieAge)
 	c.Redirect(http.StatusFound, AuthURL)
 }
 
@@ -105,7 +105,7 @@ func (a *App) authCallbackHandler(c *gin.Context) {
 		return
 	}
 
-	c.SetCookie(authIDCookieName, "", 0, "/", c.Request.Host, false, true)
+	a.setCookie(c, authIDCookieName, "", -1)
 
 	u := &data.User{
 		Username:          format.NormalizeString(userConfig.AccessValues.Get("screen_name")),
@@ -114,12 +114,12 @@ func (a *App) authCallbackHandler(c *gin.Context) {
 		UpdatedAt:         time.Now().UTC(),
 	}
 
-	if err = a.db.Save(u); err != nil {
-		a.viewErrorHandler(c, http.StatusInternalServerError, err, "Error saving authenticated user")
+	if err = data.SaveUser(a.db, u, a.encryptionKey); err != nil {
+		a.viewErrorHandler(c, http.StatusInternalServerError, err, fmt.Sprintf("Error saving authenticated user: %v", err))
 		return
 	}
 
-	p, err := a.twClient.GetUserDetails(ctx, u)
+	p, err := a.graph.GetUserDetails(ctx, u)
 	if err != nil {
 		a.viewErrorHandler(c, http.StatusInternalServerError, err, "Error getting user twitter details")
 		return
@@ -130,15 +130,32 @@ func (a *App) authCallbackHandler(c *gin.Context) {
 		return
 	}
 
-	c.SetCookie(userIDCookieName, u.Username, a.userCookieDuration, "/", c.Request.Host, false, true)
+	sessionCookie, err := a.newSession(u.Username)
+	if err != nil {
+		a.viewErrorHandler(c, http.StatusInternalServerError, err, "Error creating user session")
+		return
+	}
+
+	a.setCookie(c, sessionIDCookieName, sessionCookie, a.userCookieDuration)
 	c.Redirect(http.StatusSeeOther, "/view/dash")
 }
 
 func (a *App) logOutHandler(c *gin.Context) {
-	c.SetCookie(userIDCookieName, "", -1, "/", c.Request.Host, false, true)
+	if v, _ := c.Cookie(sessionIDCookieName); v != "" {
+		if err := a.deleteSession(v); err != nil {
+			a.logger.Printf("error deleting session: %v", err)
+		}
+	}
+	a.setCookie(c, sessionIDCookieName, "", -1)
 	c.Redirect(http.StatusSeeOther, "/")
 }
 
+// setCookie sets HTTP-only, same-site cookie, secure when app is served over HTTPS
+func (a *App) setCookie(c *gin.Context, name, value string, maxAge int) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(name, value, maxAge, "/", c.Request.Host, a.secureCookies, true)
+}
+
 func userConfigToString(config *oauth1a.UserConfig) string {
 	b, _ := json.Marshal(config)
 	return hex.EncodeToString(b)
@@ -156,10 +173,10 @@ func userConfigFromString(content string) (conf *oauth1a.UserConfig, err error)
 	return
 }
 
-func authRequired(isJSON bool) gin.HandlerFunc {
+func (a *App) authRequired(isJSON bool) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		username, _ := c.Cookie(userIDCookieName)
-		if username == "" {
+		username, err := a.getSessionUsername(c)
+		if err != nil {
 			if isJSON {
 				c.JSON(http.StatusUnauthorized, gin.H{
 					"message": "User not authenticated",
@@ -171,20 +188,34 @@ func authRequired(isJSON bool) gin.HandlerFunc {
 			c.Abort()
 			return
 		}
+		c.Set(userContextKey, username)
 		c.Next()
 	}
 }
 
+// getSessionUsername returns username from valid session cookie
+func (a *App) getSessionUsername(c *gin.Context) (string, error) {
+	v, _ := c.Cookie(sessionIDCookieName)
+	if v == "" {
+		return "", errors.New("nil session cookie")
+	}
+	s, err := a.getSession(v)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid session")
+	}
+	return s.Username, nil
+}
+
 func (a *App) getUser(c *gin.Context) (*data.User, error) {
-	username, _ := c.Cookie(userIDCookieName)
+	username := c.GetString(userContextKey)
 	if username == "" {
-		return nil, errors.New("nil auth cookie")
+		return nil, errors.New("nil authenticated user")
 	}
 
-	var usr data.User
-	if err := a.db.One("Username", username, &usr); err != nil || usr.Username == "" {
+	usr, err := data.GetUser(a.db, username, a.encryptionKey)
+	if err != nil || usr.Username == "" {
 		return nil, errors.Wrapf(err, "error getting authenticated user: %s", username)
 	}
 
-	return &usr, nil
+	return usr, nil
 }
@@ -0,0 +1,166 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/internal/twitter/twittertest"
+	"github.com/mchmarny/followme/pkg/envelope"
+	"github.com/stretchr/testify/assert"
+)
+
+func getTestApp(t *testing.T, s *twittertest.Server) (*App, *gin.Engine) {
+	a, err := NewApp(&Config{
+		DBPath:        path.Join(t.TempDir(), "test.db"),
+		Key:           "key",
+		Secret:        "secret",
+		AppURL:        "http://127.0.0.1",
+		APIURL:        s.URL(),
+		EncryptionKey: envelope.DeriveKey("test"),
+		Version:       "v0.0.1-test",
+		Port:          8080,
+	})
+	assert.NoError(t, err)
+	r, err := a.getRouter()
+	assert.NoError(t, err)
+	return a, r
+}
+
+func serve(r http.Handler, method, target string, cookies ...*http.Cookie) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, nil)
+	for _, c := range cookies {
+		req.AddCookie(c)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func getCookie(w *httptest.ResponseRecorder, name string) *http.Cookie {
+	for _, c := range w.Result().Cookies() {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+func login(t *testing.T, s *twittertest.Server, r http.Handler) []*http.Cookie {
+	// login, redirects to authorize
+	w := serve(r, http.MethodGet, "/auth/login")
+	assert.Equal(t, http.StatusFound, w.Code)
+	authCookie := getCookie(w, authIDCookieName)
+	assert.NotNil(t, authCookie)
+
+	// authorize, redirects to callback
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get(w.Header().Get("Location"))
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+	callback, err := url.Parse(resp.Header.Get("Location"))
+	assert.NoError(t, err)
+
+	// callback, redirects to dashboard
+	w = serve(r, http.MethodGet, callback.RequestURI(), authCookie)
+	assert.Equal(t, http.StatusSeeOther, w.Code)
+	assert.Equal(t, "/view/dash", w.Header().Get("Location"))
+
+	sessionCookie := getCookie(w, sessionIDCookieName)
+	assert.NotNil(t, sessionCookie)
+	assert.True(t, sessionCookie.HttpOnly)
+	assert.Equal(t, http.SameSiteLaxMode, sessionCookie.SameSite)
+	return []*http.Cookie{sessionCookie}
+}
+
+func TestAuth(t *testing.T) {
+	s := twittertest.NewServer()
+	defer s.Close()
+	s.AddProfile(&twittertest.Profile{ID: 1, Username: "tester", Name: "Tester"})
+	s.SetLoginUser("tester")
+
+	a, r := getTestApp(t, s)
+	defer a.db.Close()
+
+	t.Run("anonymous", func(t *testing.T) {
+		w := serve(r, http.MethodGet, "/view/dash")
+		assert.Equal(t, http.StatusSeeOther, w.Code)
+		w = serve(r, http.MethodGet, "/data/dash")
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("login", func(t *testing.T) {
+		cookies := login(t, s, r)
+
+		var u data.User
+		assert.NoError(t, a.db.One("Username", "tester", &u))
+		assert.True(t, u.IsEncrypted())
+		assert.NotEqual(t, "tester-token", u.AccessTokenKey)
+
+		du, err := data.GetUser(a.db, "tester", a.encryptionKey)
+		assert.NoError(t, err)
+		assert.Equal(t, "tester-token", du.AccessTokenKey)
+		assert.Equal(t, "tester-secret", du.AccessTokenSecret)
+
+		var p data.Profile
+		assert.NoError(t, a.db.One("Username", "tester", &p))
+		assert.Equal(t, int64(1), p.ID)
+
+		w := serve(r, http.MethodGet, "/view/dash", cookies...)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = serve(r, http.MethodGet, "/data/dash", cookies...)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"key":"tester-`)
+		assert.NotContains(t, w.Body.String(), "jey")
+	})
+
+	t.Run("forged", func(t *testing.T) {
+		w := serve(r, http.MethodGet, "/data/dash", &http.Cookie{Name: "user_id", Value: "tester"})
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		w = serve(r, http.MethodGet, "/data/dash", &http.Cookie{Name: sessionIDCookieName, Value: "tester"})
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+		cookies := login(t, s, r)
+		tampered := *cookies[0]
+		tampered.Value = strings.Replace(tampered.Value, ".", "x.", 1)
+		w = serve(r, http.MethodGet, "/data/dash", &tampered)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("logout", func(t *testing.T) {
+		cookies := login(t, s, r)
+		w := serve(r, http.MethodGet, "/auth/logout", cookies...)
+		assert.Equal(t, http.StatusSeeOther, w.Code)
+
+		w = serve(r, http.MethodGet, "/view/dash", cookies...)
+		assert.Equal(t, http.StatusSeeOther, w.Code)
+		assert.Equal(t, "/", w.Header().Get("Location"))
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		cookies := login(t, s, r)
+		id, err := a.verifySessionID(cookies[0].Value)
+		assert.NoError(t, err)
+
+		var us UserSession
+		assert.NoError(t, a.db.One("ID", id, &us))
+		us.ExpiresOn = time.Now().UTC().Add(-time.Minute)
+		assert.NoError(t, a.db.Save(&us))
+
+		w := serve(r, http.MethodGet, "/data/dash", cookies...)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
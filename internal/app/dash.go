@@ -12,15 +12,105 @@ import (
 	"github.com/pkg/errors"
 )
 
+// dashboardSeries holds per day chart data, days without state (gaps) are null
 type dashboardSeries struct {
-	AllFollowers  map[string]int     `json:"all_followers"`
-	NewFollowers  map[string]int     `json:"new_followers"`
-	LostFollowers map[string]int     `json:"lost_followers"`
-	AvgFollowers  map[string]float32 `json:"avg_followers"`
-	AvgTotal      map[string]float32 `json:"avg_total"`
-	AllFriends    map[string]int     `json:"all_friends"`
-	NewFriends    map[string]int     `json:"new_friends"`
-	LostFriends   map[string]int     `json:"lost_friends"`
+	AllFollowers  map[string]*int     `json:"all_followers"`
+	NewFollowers  map[string]*int     `json:"new_followers"`
+	LostFollowers map[string]*int     `json:"lost_followers"`
+	AvgFollowers  map[string]*float32 `json:"avg_followers"`
+	AvgTotal      map[string]*float32 `json:"avg_total"`
+	AllFriends    map[string]*int     `json:"all_friends"`
+	NewFriends    map[string]*int     `json:"new_friends"`
+	LostFriends   map[string]*int     `json:"lost_friends"`
+	Gaps          []string            `json:"gaps"`
+}
+
+// dashboardState is the latest user state shown on the dashboard, counts only
+type dashboardState struct {
+	Key                string    `json:"key"`
+	Username           string    `json:"username"`
+	StateOn            string    `json:"date"`
+	UpdatedOn          time.Time `json:"updated_on"`
+	FollowerCount      int       `json:"follower_count"`
+	NewFollowerCount   int       `json:"new_follower_count"`
+	NewUnfollowerCount int       `json:"new_unfollower_count"`
+	FriendsCount       int       `json:"friend_count"`
+	NewFriendsCount    int       `json:"new_friend_count"`
+	NewUnfriendedCount int       `json:"new_unfriended_count"`
+}
+
+func newDashboardState(s *data.DailyState) *dashboardState {
+	return &dashboardState{
+		Key:                s.Key,
+		Username:           s.Username,
+		StateOn:            s.StateOn,
+		UpdatedOn:          s.UpdatedOn,
+		FollowerCount:      s.FollowerCount,
+		NewFollowerCount:   s.NewFollowerCount,
+		NewUnfollowerCount: s.NewUnfollowerCount,
+		FriendsCount:       s.FriendsCount,
+		NewFriendsCount:    s.NewFriendsCount,
+		NewUnfriendedCount: s.NewUnfriendedCount,
+	}
+}
+
+// rateLimitStatus describes Twitter API limits delaying refresh of the user data
+type rateLimitStatus struct {
+	Endpoints []string `json:"endpoints"`
+	Pending   []string `json:"pending"`
+	ResetAt   string   `json:"reset_at"`
+}
+
+// getRateLimitStatus returns rate limits reached by the user and lists left for the next worker run to finish,
+// nil when there are none
+func (a *App) getRateLimitStatus(username string, now time.Time) (*rateLimitStatus, error) {
+	s := &rateLimitStatus{Endpoints: []string{}, Pending: []string{}}
+	var resetAt time.Time
+
+	// saved by the app and by the worker, which may run in a different process
+	limits, err := data.GetRateLimits(a.db, username)
+	if err != nil {
+		return nil, err
+	}
+	for _, l := range limits {
+		if !l.IsExhausted(now) {
+			continue
+		}
+		s.Endpoints = append(s.Endpoints, l.Endpoint)
+		if l.ResetAt.After(resetAt) {
+			resetAt = l.ResetAt
+		}
+	}
+
+	cursors, err := data.GetCursors(a.db, username)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range cursors {
+		if now.Sub(c.UpdatedOn) >= data.MaxCursorAge {
+			continue
+		}
+		s.Pending = append(s.Pending, c.List)
+		if c.ResumeAfter.After(resetAt) {
+			resetAt = c.ResumeAfter
+		}
+	}
+
+	if len(s.Endpoints) == 0 && len(s.Pending) == 0 {
+		return nil, nil
+	}
+	if resetAt.After(now) {
+		s.ResetAt = resetAt.Local().Format(time.RFC1123)
+	}
+	return s, nil
+}
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func floatPtr(v float32) *float32 {
+	return &v
 }
 
 func (a *App) dashboardHandler(c *gin.Context) {
@@ -35,6 +125,7 @@ func (a *App) dashboardHandler(c *gin.Context) {
 		"user":    profile,
 		"version": a.appVersion,
 		"refresh": c.Query("refresh"),
+		"digest":  a.digest != nil,
 	})
 }
 
@@ -61,66 +152,95 @@ func (a *App) dashboardQueryHandler(c *gin.Context) {
 		return
 	}
 
-	state, err := a.getState(forUser.Username, format.ToISODate(time.Now().UTC()))
+	now, err := a.getUserNow(forUser.Username)
+	if err != nil {
+		a.errJSONAndAbort(c, err)
+		return
+	}
+
+	// latest existing state, today's unless the worker hasn't run yet today
+	state, err := data.GetLatestState(a.db, forUser.Username, now)
 	if err != nil {
 		a.errJSONAndAbort(c, errors.Wrap(err, "error getting current user state"))
 		return
 	}
+	if state == nil {
+		if state, err = a.getState(forUser.Username, format.ToISODate(now)); err != nil {
+			a.errJSONAndAbort(c, errors.Wrap(err, "error getting current user state"))
+			return
+		}
+	}
 
 	series := &dashboardSeries{
-		AllFollowers:  map[string]int{},
-		NewFollowers:  map[string]int{},
-		LostFollowers: map[string]int{},
-		AvgFollowers:  map[string]float32{},
-		AvgTotal:      map[string]float32{},
-		AllFriends:    map[string]int{},
-		NewFriends:    map[string]int{},
-		LostFriends:   map[string]int{},
+		AllFollowers:  map[string]*int{},
+		NewFollowers:  map[string]*int{},
+		LostFollowers: map[string]*int{},
+		AvgFollowers:  map[string]*float32{},
+		AvgTotal:      map[string]*float32{},
+		AllFriends:    map[string]*int{},
+		NewFriends:    map[string]*int{},
+		LostFriends:   map[string]*int{},
+		Gaps:          []string{},
 	}
 
 	var runSum float32 = 0
 	var totalAvg float32 = 0
+	var day float32 = 0
 
-	for i, date := range date.GetDateRange(time.Now().UTC().AddDate(0, 0, -days)) {
-		day := i + 1
-		dayState, err := a.getState(forUser.Username, format.ToISODate(date))
+	for _, date := range date.GetDateRange(now.AddDate(0, 0, -days)) {
+		isoDate := format.ToISODate(date)
+		dayState, err := a.getState(forUser.Username, isoDate)
 		if err != nil {
 			a.errJSONAndAbort(c, errors.Wrapf(err, "error getting user state for %v", date))
 			return
 		}
 
+		// gap, worker didn't run that day
+		if !dayState.HasData() {
+			series.AllFollowers[isoDate] = nil
+			series.NewFollowers[isoDate] = nil
+			series.LostFollowers[isoDate] = nil
+			series.AvgFollowers[isoDate] = nil
+			series.AvgTotal[isoDate] = nil
+			series.AllFriends[isoDate] = nil
+			series.NewFriends[isoDate] = nil
+			series.LostFriends[isoDate] = nil
+			series.Gaps = append(series.Gaps, isoDate)
+			continue
+		}
+		day++
+
 		// total
-		series.AllFollowers[dayState.StateOn] = dayState.FollowerCount
+		series.AllFollowers[isoDate] = intPtr(dayState.FollowerCount)
+		series.AllFriends[isoDate] = intPtr(dayState.FriendsCount)
 		// followers (+/-)
-		series.NewFollowers[dayState.StateOn] = dayState.NewFollowerCount
-		series.LostFollowers[dayState.StateOn] = -dayState.NewUnfollowerCount
+		series.NewFollowers[isoDate] = intPtr(dayState.NewFollowerCount)
+		series.LostFollowers[isoDate] = intPtr(-dayState.NewUnfollowerCount)
 		// friend (+/-)
-		series.NewFriends[dayState.StateOn] = dayState.NewFriendsCount
-		series.LostFriends[dayState.StateOn] = -dayState.NewUnfriendedCount
+		series.NewFriends[isoDate] = intPtr(dayState.NewFriendsCount)
+		series.LostFriends[isoDate] = intPtr(-dayState.NewUnfriendedCount)
 		// avg
 		runSum += float32(dayState.NewFollowerCount - dayState.NewUnfollowerCount)
-		series.AvgFollowers[dayState.StateOn] = runSum / float32(day)
+		series.AvgFollowers[isoDate] = floatPtr(runSum / day)
 
 		// total avg
 		totalAvg += float32(dayState.FollowerCount)
-		series.AvgTotal[dayState.StateOn] = totalAvg / float32(day)
+		series.AvgTotal[isoDate] = floatPtr(totalAvg / day)
+	}
 
-		// a.logger.Printf("day[%d] +:%d -%d a:%f ra:%f f+:%d f-:%d",
-		// 	day,
-		// 	dayState.NewFollowerCount,
-		// 	dayState.NewUnfollowerCount,
-		// 	runSum,
-		// 	series.AvgFollowers[dayState.StateOn],
-		// 	dayState.NewFriendsCount,
-		// 	dayState.NewUnfriendedCount)
+	rateLimit, err := a.getRateLimitStatus(forUser.Username, time.Now().UTC())
+	if err != nil {
+		a.errJSONAndAbort(c, errors.Wrap(err, "error getting rate limit status"))
+		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"user":       profile,
-		"state":      state,
+		"state":      newDashboardState(state),
 		"version":    a.appVersion,
 		"updated_on": state.UpdatedOn.Format(time.RFC1123),
 		"days":       days,
 		"series":     series,
+		"rate_limit": rateLimit,
 	})
 }
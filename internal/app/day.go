@@ -42,11 +42,18 @@ func (a *App) dayHandler(c *gin.Context) {
 		return
 	}
 
+	snapshots, err := data.GetSnapshots(a.db, forUser.Username, isoDate)
+	if err != nil {
+		a.viewErrorHandler(c, http.StatusInternalServerError, err, "Error getting user snapshots")
+		return
+	}
+
 	data := gin.H{
 		"user":      profile,
 		"version":   a.appVersion,
 		"days":      isoDate,
 		"listTypes": listTypes,
+		"snapshots": snapshots,
 	}
 
 	c.HTML(http.StatusOK, "day", data)
@@ -0,0 +1,353 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"html/template"
+	"log"
+	"net/http"
+	"net/mail"
+	"os"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/gin-gonic/gin"
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/internal/email"
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxDigestProfiles is the max number of profiles listed per digest section
+	maxDigestProfiles = 50
+
+	digestTextTemplatePath = "web/template/digest.txt"
+)
+
+// DigestConfig represents the email digest configuration
+type DigestConfig struct {
+	// Mail is the SMTP server configuration
+	Mail *email.Config
+	// AppURL is the dashboard URL linked from the digest, no link when not set
+	AppURL string
+}
+
+// GetDashboardURL returns the dashboard URL of the app at the base URL (with port), empty when base URL is not set
+func GetDashboardURL(appURL string) string {
+	if appURL == "" {
+		return ""
+	}
+	return strings.TrimSuffix(appURL, "/") + "/view/dash"
+}
+
+// NewDigest creates a new instance of the email digest sender
+func NewDigest(cfg *DigestConfig) (*Digest, error) {
+	if cfg == nil {
+		return nil, errors.New("digest config required")
+	}
+	sender, err := email.NewSender(cfg.Mail)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating email sender")
+	}
+
+	html, err := parseTemplates()
+	if err != nil {
+		return nil, err
+	}
+	b, err := Asset(digestTextTemplatePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting asset from: %s", digestTextTemplatePath)
+	}
+	text, err := texttemplate.New("").Parse(string(b))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing tempalate: %s", digestTextTemplatePath)
+	}
+
+	return &Digest{
+		sender: sender,
+		html:   html,
+		text:   text,
+		appURL: cfg.AppURL,
+		logger: log.New(os.Stdout, "digest: ", 0),
+		now:    time.Now,
+	}, nil
+}
+
+// Digest sends daily or weekly email summary of follower changes
+type Digest struct {
+	sender *email.Sender
+	html   *template.Template
+	text   *texttemplate.Template
+	appURL string
+	logger *log.Logger
+	now    func() time.Time
+}
+
+// digestList is a digest section listing profiles of one type of change
+type digestList struct {
+	Title    string
+	Count    int
+	Profiles []*data.Profile
+	More     int
+}
+
+// digestView is the data of the digest templates
+type digestView struct {
+	Username      string
+	Frequency     string
+	From          string
+	To            string
+	AppURL        string
+	FollowerCount int
+	FriendCount   int
+	NewFollowers  *digestList
+	LostFollowers *digestList
+	NewFriends    *digestList
+	LostFriends   *digestList
+}
+
+// HasChanges indicates if there were any changes in the digest period
+func (v *digestView) HasChanges() bool {
+	return v.NewFollowers.Count+v.LostFollowers.Count+v.NewFriends.Count+v.LostFriends.Count > 0
+}
+
+// Period is a template helper
+func (v *digestView) Period() string {
+	if v.From == v.To {
+		return v.To
+	}
+	return fmt.Sprintf("%s to %s", v.From, v.To)
+}
+
+// Subject is a template helper
+func (v *digestView) Subject() string {
+	return fmt.Sprintf("@%s followers +%d/-%d (%s)",
+		v.Username, v.NewFollowers.Count, v.LostFollowers.Count, v.Period())
+}
+
+// AfterRun returns worker after run func sending the due digests, nil when digest isn't configured
+func (d *Digest) AfterRun() func(ctx context.Context, db *storm.DB) error {
+	if d == nil {
+		return nil
+	}
+	return func(ctx context.Context, db *storm.DB) error {
+		_, err := d.Send(ctx, db)
+		return err
+	}
+}
+
+// Send sends the digests due now, returns the number of sent digests.
+// Digests which failed are retried on the next call, periods without changes are skipped.
+func (d *Digest) Send(ctx context.Context, db *storm.DB) (int, error) {
+	list, err := data.GetAllDigestSettings(db)
+	if err != nil {
+		return 0, err
+	}
+
+	now := d.now()
+	var sent, failed int
+	for _, s := range list {
+		// periods are complete days in the time zone of the user
+		userNow, err := data.GetUserNow(db, s.Username, now)
+		if err != nil {
+			d.logger.Printf("error getting digest period for %s: %v", s.Username, err)
+			failed++
+			continue
+		}
+		from, to, due := s.GetDuePeriod(userNow)
+		if !due {
+			continue
+		}
+		if ctx.Err() != nil {
+			return sent, ctx.Err()
+		}
+
+		v, err := d.getView(db, s, from, to)
+		if err != nil {
+			d.logger.Printf("error getting digest for %s: %v", s.Username, err)
+			failed++
+			continue
+		}
+		if v.HasChanges() {
+			if err := d.send(s, v); err != nil {
+				d.logger.Printf("error sending digest to %s: %v", s.Username, err)
+				failed++
+				continue
+			}
+			sent++
+		}
+
+		s.SentThrough = format.ToISODate(to)
+		if err := data.SaveDigestSettings(db, s); err != nil {
+			return sent, err
+		}
+	}
+
+	if sent > 0 || failed > 0 {
+		d.logger.Printf("Sent %d digests (failed: %d)", sent, failed)
+	}
+	if failed > 0 {
+		return sent, errors.Errorf("%d digest errors, see logs for details", failed)
+	}
+	return sent, nil
+}
+
+// send renders and sends the digest
+func (d *Digest) send(s *data.DigestSettings, v *digestView) error {
+	var html, text bytes.Buffer
+	if err := d.html.ExecuteTemplate(&html, "digest", v); err != nil {
+		return errors.Wrap(err, "error rendering HTML digest")
+	}
+	if err := d.text.ExecuteTemplate(&text, "digest-text", v); err != nil {
+		return errors.Wrap(err, "error rendering text digest")
+	}
+	return d.sender.Send(&email.Message{
+		To:      s.Email,
+		Subject: v.Subject(),
+		Text:    text.String(),
+		HTML:    html.String(),
+	})
+}
+
+// getView returns changes of the user between from and to days (inclusive) with the cached profiles
+func (d *Digest) getView(db *storm.DB, s *data.DigestSettings, from, to time.Time) (*digestView, error) {
+	v := &digestView{
+		Username:  s.Username,
+		Frequency: s.Frequency,
+		From:      format.ToISODate(from),
+		To:        format.ToISODate(to),
+		AppURL:    d.appURL,
+	}
+
+	var newFollowers, lostFollowers, newFriends, lostFriends []int64
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		var state data.DailyState
+		if err := db.One("Key", data.GetDailyStateKey(s.Username, day), &state); err != nil {
+			if err == storm.ErrNotFound {
+				continue
+			}
+			return nil, errors.Wrapf(err, "error getting state for %s on %s", s.Username, format.ToISODate(day))
+		}
+		v.FollowerCount = state.FollowerCount
+		v.FriendCount = state.FriendsCount
+		newFollowers = append(newFollowers, state.NewFollowers...)
+		lostFollowers = append(lostFollowers, state.NewUnfollowers...)
+		newFriends = append(newFriends, state.NewFriends...)
+		lostFriends = append(lostFriends, state.NewUnfriended...)
+	}
+
+	ids := make([]int64, 0)
+	for _, l := range [][]int64{newFollowers, lostFollowers, newFriends, lostFriends} {
+		ids = append(ids, limitIDs(l)...)
+	}
+	profiles, err := data.GetCachedProfiles(db, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	v.NewFollowers = newDigestList("New followers", newFollowers, profiles)
+	v.LostFollowers = newDigestList("Unfollowed you", lostFollowers, profiles)
+	v.NewFriends = newDigestList("You followed", newFriends, profiles)
+	v.LostFriends = newDigestList("You unfollowed", lostFriends, profiles)
+	return v, nil
+}
+
+func limitIDs(ids []int64) []int64 {
+	if len(ids) > maxDigestProfiles {
+		return ids[:maxDigestProfiles]
+	}
+	return ids
+}
+
+// newDigestList creates digest section, IDs without cached profile have only the ID set
+func newDigestList(title string, ids []int64, profiles map[int64]*data.Profile) *digestList {
+	l := &digestList{
+		Title:    title,
+		Count:    len(ids),
+		Profiles: make([]*data.Profile, 0),
+		More:     len(ids) - len(limitIDs(ids)),
+	}
+	for _, id := range limitIDs(ids) {
+		p, ok := profiles[id]
+		if !ok {
+			p = &data.Profile{ID: id}
+		}
+		l.Profiles = append(l.Profiles, p)
+	}
+	return l
+}
+
+func (a *App) digestQueryHandler(c *gin.Context) {
+	forUser, err := a.getUser(c)
+	if err != nil {
+		a.errJSONAndAbort(c, err)
+		return
+	}
+
+	s, err := data.GetDigestSettings(a.db, forUser.Username)
+	if err != nil {
+		a.errJSONAndAbort(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"enabled":     a.digest != nil,
+		"settings":    s,
+		"frequencies": data.DigestFrequencies,
+	})
+}
+
+func (a *App) digestUpdateHandler(c *gin.Context) {
+	forUser, err := a.getUser(c)
+	if err != nil {
+		a.errJSONAndAbort(c, err)
+		return
+	}
+
+	var req struct {
+		Email     string `json:"email"`
+		Frequency string `json:"frequency"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.badRequestJSONAndAbort(c, "Invalid digest settings.")
+		return
+	}
+	if !data.IsValidDigestFrequency(req.Frequency) {
+		a.badRequestJSONAndAbort(c, fmt.Sprintf("Invalid frequency, expected one of %v.", data.DigestFrequencies))
+		return
+	}
+	if req.Email != "" {
+		addr, err := mail.ParseAddress(req.Email)
+		if err != nil {
+			a.badRequestJSONAndAbort(c, "Invalid email address.")
+			return
+		}
+		req.Email = addr.Address
+	}
+	if req.Email == "" && req.Frequency != data.DigestOff {
+		a.badRequestJSONAndAbort(c, "Email address required.")
+		return
+	}
+
+	s, err := data.GetDigestSettings(a.db, forUser.Username)
+	if err != nil {
+		a.errJSONAndAbort(c, err)
+		return
+	}
+	s.Email = req.Email
+	s.Frequency = req.Frequency
+	s.UpdatedAt = time.Now().UTC()
+	if err := data.SaveDigestSettings(a.db, s); err != nil {
+		a.errJSONAndAbort(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"enabled":  a.digest != nil,
+		"settings": s,
+	})
+}
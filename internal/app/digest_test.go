@@ -0,0 +1,135 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/internal/email"
+	"github.com/mchmarny/followme/internal/email/emailtest"
+	"github.com/mchmarny/followme/internal/twitter/twittertest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigest(t *testing.T) {
+	s := emailtest.NewServer()
+	defer s.Close()
+
+	db, err := data.GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	d, err := NewDigest(&DigestConfig{
+		Mail:   &email.Config{Host: s.Host(), Port: s.Port(), From: "followme@example.com"},
+		AppURL: "http://127.0.0.1:8080/view/dash",
+	})
+	assert.NoError(t, err)
+	now := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+	d.now = func() time.Time { return now }
+
+	yesterday := now.AddDate(0, 0, -1)
+	assert.NoError(t, db.Save(&data.DailyState{
+		Key:            data.GetDailyStateKey("tester", yesterday),
+		Username:       "tester",
+		FollowerCount:  10,
+		FriendsCount:   5,
+		NewFollowers:   []int64{2},
+		NewUnfollowers: []int64{3},
+	}))
+	assert.NoError(t, data.SaveProfiles(db, []*data.Profile{
+		{ID: 2, Username: "follower", Name: "Follower <2>", ProfileImage: "https://example.com/2.png"},
+	}))
+	assert.NoError(t, data.SaveDigestSettings(db, &data.DigestSettings{
+		Username: "tester", Email: "tester@example.com", Frequency: data.DigestDaily,
+	}))
+	assert.NoError(t, data.SaveDigestSettings(db, &data.DigestSettings{
+		Username: "quiet", Email: "quiet@example.com", Frequency: data.DigestWeekly,
+	}))
+
+	n, err := d.Send(context.Background(), db)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	list := s.Messages()
+	assert.Len(t, list, 1)
+	assert.Equal(t, []string{"tester@example.com"}, list[0].To)
+	m, err := list[0].Parse()
+	assert.NoError(t, err)
+	assert.Equal(t, "@tester followers +1/-1 (2021-01-09)", m.Header.Get("Subject"))
+
+	parts, err := list[0].Parts()
+	assert.NoError(t, err)
+	text, html := parts["text/plain"], parts["text/html"]
+	assert.Contains(t, text, "@follower (Follower <2>)")
+	assert.Contains(t, text, "User 3")
+	assert.Contains(t, text, "Dashboard: http://127.0.0.1:8080/view/dash")
+	assert.Contains(t, html, "<b>Follower &lt;2&gt;</b> @follower")
+	assert.Contains(t, html, `<img src="https://example.com/2.png"`)
+	assert.Contains(t, html, `<a href="http://127.0.0.1:8080/view/dash"`)
+
+	// both marked as sent, periods without changes are skipped
+	for _, u := range []string{"tester", "quiet"} {
+		ds, err := data.GetDigestSettings(db, u)
+		assert.NoError(t, err)
+		assert.Equal(t, "2021-01-09", ds.SentThrough)
+	}
+
+	n, err = d.Send(context.Background(), db)
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+	assert.Len(t, s.Messages(), 1)
+}
+
+func TestDigestSettingsHandler(t *testing.T) {
+	s := twittertest.NewServer()
+	defer s.Close()
+	s.AddProfile(&twittertest.Profile{ID: 1, Username: "tester", Name: "Tester"})
+	s.SetLoginUser("tester")
+
+	a, r := getTestApp(t, s)
+	defer a.db.Close()
+	cookies := login(t, s, r)
+
+	post := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/data/digest", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		for _, c := range cookies {
+			req.AddCookie(c)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	w := serve(r, http.MethodGet, "/data/digest", cookies...)
+	assert.Equal(t, http.StatusOK, w.Code)
+	var resp struct {
+		Enabled  bool                 `json:"enabled"`
+		Settings *data.DigestSettings `json:"settings"`
+	}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.False(t, resp.Enabled)
+	assert.Equal(t, data.DigestOff, resp.Settings.Frequency)
+
+	assert.Equal(t, http.StatusBadRequest, post(`{"frequency":"hourly","email":"tester@example.com"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, post(`{"frequency":"daily","email":""}`).Code)
+	assert.Equal(t, http.StatusBadRequest, post(`{"frequency":"daily","email":"invalid"}`).Code)
+	assert.Equal(t, http.StatusOK, post(`{"frequency":"weekly","email":"Tester <tester@example.com>"}`).Code)
+
+	ds, err := data.GetDigestSettings(a.db, "tester")
+	assert.NoError(t, err)
+	assert.Equal(t, data.DigestWeekly, ds.Frequency)
+	assert.Equal(t, "tester@example.com", ds.Email)
+}
+
+func TestGetDashboardURL(t *testing.T) {
+	assert.Equal(t, "", GetDashboardURL(""))
+	assert.Equal(t, "http://127.0.0.1:8080/view/dash", GetDashboardURL("http://127.0.0.1:8080"))
+	assert.Equal(t, "https://followme.example.com/view/dash", GetDashboardURL("https://followme.example.com/"))
+}
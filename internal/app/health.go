@@ -0,0 +1,43 @@
+package app
+
+import (
+	"net/http"
+	"sync/atomic"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/pkg/errors"
+)
+
+// checkDB verifies the data file can be read
+func (a *App) checkDB() error {
+	if _, err := a.db.Count(&data.User{}); err != nil {
+		return errors.Wrap(err, "error reading data file")
+	}
+	return nil
+}
+
+// healthHandler reports if the app is running and can read its data
+func (a *App) healthHandler(c *gin.Context) {
+	if err := a.checkDB(); err != nil {
+		a.logger.Printf("health check failed: %v", err)
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"status":  "Error",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "OK"})
+}
+
+// readyHandler reports if the app can serve requests, not while it's starting or shutting down
+func (a *App) readyHandler(c *gin.Context) {
+	if atomic.LoadInt32(&a.ready) == 0 {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"status":  "Error",
+			"message": "app server not ready",
+		})
+		return
+	}
+	a.healthHandler(c)
+}
@@ -0,0 +1,80 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mchmarny/followme/internal/twitter/twittertest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealth(t *testing.T) {
+	s := twittertest.NewServer()
+	defer s.Close()
+
+	a, r := getTestApp(t, s)
+
+	w := serve(r, http.MethodGet, "/healthz")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// not started
+	w = serve(r, http.MethodGet, "/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	a.ready = 1
+	w = serve(r, http.MethodGet, "/readyz")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	assert.NoError(t, a.db.Close())
+	w = serve(r, http.MethodGet, "/healthz")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	w = serve(r, http.MethodGet, "/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+func TestServe(t *testing.T) {
+	s := twittertest.NewServer()
+	defer s.Close()
+
+	a, _ := getTestApp(t, s)
+	a.headless = true
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	a.hostPort = ln.Addr().String()
+	assert.NoError(t, ln.Close())
+	readyURL := fmt.Sprintf("http://%s/readyz", a.hostPort)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- a.serve(ctx)
+	}()
+
+	ready := false
+	for i := 0; i < 50 && !ready; i++ {
+		if resp, err := http.Get(readyURL); err == nil {
+			resp.Body.Close()
+			ready = resp.StatusCode == http.StatusOK
+		}
+		if !ready {
+			time.Sleep(20 * time.Millisecond)
+		}
+	}
+	assert.True(t, ready)
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("app server didn't stop")
+	}
+
+	_, err = http.Get(readyURL)
+	assert.Error(t, err)
+}
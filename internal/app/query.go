@@ -98,7 +98,7 @@ func (a *App) dayQueryHandler(c *gin.Context) {
 		return
 	}
 
-	users, err := a.twClient.GetUserDetailsFromIDs(ctx, forUser, idPager.Next())
+	users, err := a.getProfiles(ctx, forUser, idPager.Next())
 	if err != nil {
 		a.errJSONAndAbort(c, errors.Wrap(err, "error getting user details"))
 		return
@@ -106,25 +106,39 @@ func (a *App) dayQueryHandler(c *gin.Context) {
 
 	// a.logger.Printf("users:%d", len(users))
 
+	userIDs := make([]int64, len(users))
+	for i, u := range users {
+		userIDs[i] = u.ID
+	}
+	rels, err := a.graph.GetRelationships(ctx, forUser, userIDs)
+	if err != nil {
+		a.errJSONAndAbort(c, errors.Wrap(err, "error getting user relationships"))
+		return
+	}
+	relByID := make(map[int64]*data.Relationship, len(rels))
+	for _, r := range rels {
+		relByID[r.TargetID] = r
+	}
+
 	var events []*data.UserEvent
 	for _, u := range users {
 		event := &data.UserEvent{
 			Profile:   u,
+			IDStr:     strconv.FormatInt(u.ID, 10),
 			EventDate: isoDate,
 			EventType: eventType,
 			EventUser: forUser.Username,
 		}
 
-		rel, err := a.twClient.GetRelationship(ctx, forUser, profile.ID, u.ID)
-		if err != nil {
-			a.errJSONAndAbort(c, errors.Wrap(err, "error getting user relationship"))
-			return
+		rel, ok := relByID[u.ID]
+		if !ok {
+			rel = &data.Relationship{TargetID: u.ID}
 		}
 
 		if eventType == data.FollowedEventType || eventType == data.UnfollowedEventType {
-			event.HasRelationship = format.ToYesNo(rel.Source.Following)
+			event.HasRelationship = format.ToYesNo(rel.Following)
 		} else {
-			event.HasRelationship = format.ToYesNo(rel.Target.Following)
+			event.HasRelationship = format.ToYesNo(rel.FollowedBy)
 		}
 
 		events = append(events, event)
@@ -139,7 +153,7 @@ func (a *App) dayQueryHandler(c *gin.Context) {
 		"updated_on": forUser.UpdatedAt,
 		"days":       isoDate,
 		"events":     events,
-		"listTyep":   listType,
+		"listType":   listType,
 		"pageNum":    pageNum,
 		"pagePrev":   idPager.GetPrevPage(),
 		"pageNext":   idPager.GetNextPage(),
@@ -4,11 +4,11 @@ import (
 	"net/http"
 	"sort"
 	"strconv"
-	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/mchmarny/followme/internal/data"
 	"github.com/mchmarny/followme/pkg/format"
+	"github.com/mchmarny/followme/pkg/list"
 	"github.com/pkg/errors"
 )
 
@@ -47,17 +47,29 @@ func (a *App) reportDataHandler(c *gin.Context) {
 		startIDStr = "0"
 	}
 	var pageErr error
-	startID, pageErr := strconv.ParseInt(startIDStr, 10, 32)
+	startID, pageErr := strconv.ParseInt(startIDStr, 10, 64)
 	if pageErr != nil {
 		a.errJSONAndAbort(c, errors.Wrap(pageErr, "error parsing start ID number"))
 		return
 	}
 
-	state, err := a.getState(forUser.Username, format.ToISODate(time.Now().UTC()))
+	now, err := a.getUserNow(forUser.Username)
+	if err != nil {
+		a.errJSONAndAbort(c, err)
+		return
+	}
+
+	state, err := data.GetFullState(a.db, forUser.Username, now)
 	if err != nil {
 		a.errJSONAndAbort(c, errors.Wrap(err, "error getting current user state"))
 		return
 	}
+	if state == nil {
+		if state, err = a.getState(forUser.Username, format.ToISODate(now)); err != nil {
+			a.errJSONAndAbort(c, errors.Wrap(err, "error getting current user state"))
+			return
+		}
+	}
 
 	var profile data.Profile
 	if err := a.db.One("Username", forUser.Username, &profile); err != nil || profile.Username == "" {
@@ -65,43 +77,31 @@ func (a *App) reportDataHandler(c *gin.Context) {
 		return
 	}
 
-	// sort IDs
-	friendIDs := state.Friends
-	sort.Slice(friendIDs, func(i, j int) bool {
-		return friendIDs[i] < friendIDs[j]
+	// friends who don't follow back, sorted so pages are stable
+	notFollowingIDs := list.GetDiff(state.Followers, state.Friends)
+	sort.Slice(notFollowingIDs, func(i, j int) bool {
+		return notFollowingIDs[i] < notFollowingIDs[j]
 	})
 
-	noFollowIDs := make([]int64, 0)
-	for _, id := range friendIDs {
-		// skip the ones already paged
-		if startID > 0 && id <= startID {
-			continue
-		}
-		// get relationship
-		rel, err := a.twClient.GetRelationship(ctx, forUser, profile.ID, id)
-		if err != nil {
-			a.errJSONAndAbort(c, errors.Wrap(err, "error getting user relationship"))
-			return
-		}
-		// add if not follows
-		if !rel.Target.Following {
-			noFollowIDs = append(noFollowIDs, id)
-		}
-		// finish on page size
-		if len(noFollowIDs) >= a.pageSize {
-			break
-		}
+	// skip the ones already paged
+	start := sort.Search(len(notFollowingIDs), func(i int) bool {
+		return notFollowingIDs[i] > startID
+	})
+	noFollowIDs := notFollowingIDs[start:]
+	hasMore := len(noFollowIDs) > a.pageSize
+	if hasMore {
+		noFollowIDs = noFollowIDs[:a.pageSize]
 	}
 
-	users, err := a.twClient.GetUserDetailsFromIDs(ctx, forUser, noFollowIDs)
+	users, err := a.getProfiles(ctx, forUser, noFollowIDs)
 	if err != nil {
 		a.errJSONAndAbort(c, errors.Wrap(err, "error getting user details"))
 		return
 	}
 
 	var lastID int64 = 0
-	if len(users) > 0 {
-		lastID = users[len(users)-1].ID
+	if len(noFollowIDs) > 0 {
+		lastID = noFollowIDs[len(noFollowIDs)-1]
 	}
 
 	c.JSON(http.StatusOK, gin.H{
@@ -111,7 +111,7 @@ func (a *App) reportDataHandler(c *gin.Context) {
 		"updated_on": forUser.UpdatedAt,
 		"list":       users,
 		"startID":    startID,
-		"hasMore":    len(noFollowIDs) >= a.pageSize,
+		"hasMore":    hasMore,
 		"lastID":     lastID,
 	})
 }
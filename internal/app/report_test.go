@@ -0,0 +1,74 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/internal/twitter/twittertest"
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReport(t *testing.T) {
+	s := twittertest.NewServer()
+	defer s.Close()
+	s.AddProfile(&twittertest.Profile{ID: 1, Username: "tester", Name: "Tester"})
+	s.SetLoginUser("tester")
+
+	// friends 2-25, only 2 and 3 follow back
+	friends := make([]int64, 0)
+	for id := int64(25); id >= 2; id-- {
+		s.AddProfile(&twittertest.Profile{ID: id, Username: fmt.Sprintf("user%d", id)})
+		friends = append(friends, id)
+	}
+
+	a, r := getTestApp(t, s)
+	defer a.db.Close()
+	cookies := login(t, s, r)
+
+	now := time.Now().UTC()
+	assert.NoError(t, a.db.Save(&data.DailyState{
+		Key:        data.GetDailyStateKey("tester", now),
+		Username:   "tester",
+		StateOn:    format.ToISODate(now),
+		SnapshotOn: format.ToISODate(now),
+		Followers:  []int64{2, 3},
+		Friends:    friends,
+	}))
+
+	getPage := func(startID int64) (ids []int64, hasMore bool, lastID int64) {
+		w := serve(r, http.MethodGet, fmt.Sprintf("/data/report/%d", startID), cookies...)
+		assert.Equal(t, http.StatusOK, w.Code)
+		var resp struct {
+			List    []*data.Profile `json:"list"`
+			HasMore bool            `json:"hasMore"`
+			LastID  int64           `json:"lastID"`
+		}
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		for _, p := range resp.List {
+			ids = append(ids, p.ID)
+		}
+		return ids, resp.HasMore, resp.LastID
+	}
+
+	ids, hasMore, lastID := getPage(0)
+	assert.Equal(t, []int64{4, 5, 6, 7, 8, 9, 10, 11, 12, 13}, ids)
+	assert.True(t, hasMore)
+	assert.Equal(t, int64(13), lastID)
+
+	ids, hasMore, lastID = getPage(lastID)
+	assert.Equal(t, []int64{14, 15, 16, 17, 18, 19, 20, 21, 22, 23}, ids)
+	assert.True(t, hasMore)
+
+	ids, hasMore, _ = getPage(lastID)
+	assert.Equal(t, []int64{24, 25}, ids)
+	assert.False(t, hasMore)
+
+	// computed from the stored state, no relationship lookups
+	assert.Zero(t, s.Calls("/1.1/friendships/show.json"))
+	assert.Zero(t, s.Calls("/1.1/friendships/lookup.json"))
+}
@@ -0,0 +1,94 @@
+package app
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/pkg/errors"
+)
+
+const (
+	searchViewLimit = 200
+)
+
+// searchRelation is a relation filter option of the search page
+type searchRelation struct {
+	Value string
+	Label string
+}
+
+var searchRelations = []*searchRelation{
+	{Value: "", Label: "Any"},
+	{Value: data.FollowerRelation, Label: "Follows me"},
+	{Value: data.FriendRelation, Label: "I follow"},
+	{Value: data.MutualRelation, Label: "Mutual"},
+	{Value: data.FormerFollowerRelation, Label: "Former follower"},
+}
+
+// parseAccountQuery reads the search query (q, relation, from, to) of the request
+func parseAccountQuery(c *gin.Context) (*data.AccountQuery, error) {
+	q := &data.AccountQuery{
+		Text:        c.Query("q"),
+		Relation:    c.Query("relation"),
+		ChangedFrom: c.Query("from"),
+		ChangedTo:   c.Query("to"),
+	}
+	if !data.IsValidRelation(q.Relation) {
+		return nil, errors.Errorf("invalid relation: %s", q.Relation)
+	}
+	if _, err := parseAPIDate(q.ChangedFrom, time.Time{}); err != nil {
+		return nil, err
+	}
+	if _, err := parseAPIDate(q.ChangedTo, time.Time{}); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+func (a *App) searchHandler(c *gin.Context) {
+	forUser, err := a.getUser(c)
+	if err != nil {
+		a.logger.Printf("error getting user from context: %v", err)
+		a.logOutHandler(c)
+		return
+	}
+
+	q, err := parseAccountQuery(c)
+	if err != nil {
+		a.viewErrorHandler(c, http.StatusBadRequest, err, err.Error())
+		return
+	}
+
+	var profile data.Profile
+	if err := a.db.One("Username", forUser.Username, &profile); err != nil || profile.Username == "" {
+		a.viewErrorHandler(c, http.StatusBadRequest, err, "Error getting user profile")
+		return
+	}
+
+	// no results until the user searches for something
+	var results []*data.AccountMatch
+	total := 0
+	if c.Request.URL.RawQuery != "" {
+		if results, err = data.SearchAccounts(a.db, forUser.Username, q); err != nil {
+			a.viewErrorHandler(c, http.StatusInternalServerError, err, "Error searching accounts")
+			return
+		}
+		total = len(results)
+		if total > searchViewLimit {
+			results = results[:searchViewLimit]
+		}
+	}
+
+	c.HTML(http.StatusOK, "search", gin.H{
+		"user":      profile,
+		"version":   a.appVersion,
+		"query":     q,
+		"relations": searchRelations,
+		"searched":  c.Request.URL.RawQuery != "",
+		"results":   results,
+		"total":     total,
+		"limit":     searchViewLimit,
+	})
+}
@@ -0,0 +1,118 @@
+package app
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/mchmarny/followme/pkg/id"
+	"github.com/pkg/errors"
+)
+
+const (
+	configBucket     = "config"
+	sessionSecretKey = "session_secret"
+	sessionSecretLen = 32
+)
+
+// UserSession represents server-issued session of an authenticated user
+type UserSession struct {
+	ID        string    `storm:"id" json:"id"`
+	Username  string    `storm:"index" json:"username"`
+	CreatedOn time.Time `json:"created_on"`
+	ExpiresOn time.Time `json:"expires_on"`
+}
+
+// getSessionSecret returns server secret used to sign session cookies, creates one if none exists
+func getSessionSecret(db *storm.DB) ([]byte, error) {
+	var secret []byte
+	err := db.Get(configBucket, sessionSecretKey, &secret)
+	if err == nil && len(secret) == sessionSecretLen {
+		return secret, nil
+	}
+	if err != nil && err != storm.ErrNotFound {
+		return nil, errors.Wrap(err, "error getting session secret")
+	}
+
+	secret = make([]byte, sessionSecretLen)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errors.Wrap(err, "error generating session secret")
+	}
+	if err := db.Set(configBucket, sessionSecretKey, secret); err != nil {
+		return nil, errors.Wrap(err, "error saving session secret")
+	}
+	return secret, nil
+}
+
+// newSession creates and saves a new session for user, returns signed cookie value
+func (a *App) newSession(username string) (string, error) {
+	now := time.Now().UTC()
+	s := &UserSession{
+		ID:        id.NewID(),
+		Username:  username,
+		CreatedOn: now,
+		ExpiresOn: now.Add(time.Duration(a.userCookieDuration) * time.Second),
+	}
+	if err := a.db.Save(s); err != nil {
+		return "", errors.Wrapf(err, "error saving session for %s", username)
+	}
+	return a.signSessionID(s.ID), nil
+}
+
+// getSession validates signed cookie value and returns the active session it represents
+func (a *App) getSession(cookieValue string) (*UserSession, error) {
+	sessionID, err := a.verifySessionID(cookieValue)
+	if err != nil {
+		return nil, err
+	}
+
+	var s UserSession
+	if err := a.db.One("ID", sessionID, &s); err != nil {
+		return nil, errors.Wrapf(err, "error getting session: %s", sessionID)
+	}
+
+	if time.Now().UTC().After(s.ExpiresOn) {
+		if err := a.db.DeleteStruct(&s); err != nil {
+			a.logger.Printf("error deleting expired session %s: %v", s.ID, err)
+		}
+		return nil, errors.Errorf("session expired on %v", s.ExpiresOn)
+	}
+
+	return &s, nil
+}
+
+// deleteSession revokes the session represented by signed cookie value
+func (a *App) deleteSession(cookieValue string) error {
+	s, err := a.getSession(cookieValue)
+	if err != nil {
+		return err
+	}
+	return a.db.DeleteStruct(s)
+}
+
+func (a *App) signSessionID(sessionID string) string {
+	return fmt.Sprintf("%s.%s", sessionID, a.getSessionSignature(sessionID))
+}
+
+func (a *App) verifySessionID(cookieValue string) (string, error) {
+	parts := strings.Split(cookieValue, ".")
+	if len(parts) != 2 || parts[0] == "" {
+		return "", errors.New("invalid session format")
+	}
+	expected := a.getSessionSignature(parts[0])
+	if !hmac.Equal([]byte(expected), []byte(parts[1])) {
+		return "", errors.New("invalid session signature")
+	}
+	return parts[0], nil
+}
+
+func (a *App) getSessionSignature(sessionID string) string {
+	mac := hmac.New(sha256.New, a.sessionSecret)
+	mac.Write([]byte(sessionID))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -10,11 +10,16 @@
 // web/static/js/lib.js
 // web/template/dash.html
 // web/template/day.html
+// web/template/digest.html
+// web/template/digest.txt
 // web/template/error.html
 // web/template/footer.html
 // web/template/header.html
 // web/template/index.html
 // web/template/report.html
+// web/template/search.html
+// web/template/status.html
+// web/template/user.html
 package app
 
 import (
@@ -179,7 +184,7 @@ func AssetFile() http.FileSystem {
 	return &assetOperator{}
 }
 
-var _cssAppCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\x5b\x8f\x9b\xb8\x17\x7f\x86\x4f\x61\x75\x54\xa9\xa9\xe2\x14\x72\x69\x66\xc8\xd3\xff\xa2\xee\xcb\x3e\xed\xc3\xbe\x1b\x38\x24\xde\x31\x36\x32\x66\x68\x5a\xf5\xbb\xaf\x6c\x0c\x31\x60\x66\xa6\xdd\xd1\x6a\x34\x89\x30\xf6\xb9\xfc\xce\xef\x5c\x9c\x4f\x1f\xc3\xe0\x7f\x4c\x34\x39\xfa\xa3\xe1\x28\x13\x4c\xc8\x3a\x0c\x7e\xa7\xe7\x8b\x42\xff\x65\x0d\x24\xe8\x6e\x7f\xbc\xff\xfc\x25\x0a\xc3\x8f\x9f\xc2\x30\x23\xfc\x89\xd4\xe8\x7b\x18\xe0\x52\x7c\xc3\x4d\x0d\x12\xd7\xc0\x20\x53\x09\xe2\x82\xc3\x29\x0c\x70\x0b\xe9\x23\x55\xfe\x77\x65\xed\x5b\xff\x11\x86\x17\x55\xb2\x75\x98\x8a\xfc\xaa\x85\x5f\x40\x1b\x90\xa0\x38\x8a\xde\x9f\xc2\xa0\x10\x5c\xe1\x82\x94\x94\x5d\x13\xf4\x27\xc8\x9c\x70\xb2\x46\xbf\x01\x87\x27\xb2\x46\x35\xe1\x35\xae\x41\xd2\xe2\x14\x06\x25\x91\x67\xca\x13\x14\x9d\xc2\xa0\x22\x79\x4e\xf9\xb9\x7b\x48\x49\xf6\x78\x96\xa2\xe1\x39\x36\x5e\x6a\xc7\xf6\xfb\x53\x88\x10\x42\xfd\x02\xec\xf5\x9f\xb1\x87\xa0\xef\xe1\xed\x45\xfc\xff\xff\xc4\x5f\xb6\x9d\xa1\x3b\x6d\xa0\xb1\xa8\xa6\xdf\x20\x41\xf1\xe6\x00\x65\x6f\x64\x6b\x0d\x4f\x05\xcb\x4f\x61\x60\xcf\xcb\x73\xfa\x21\x8e\x1e\xd6\x28\x8e\x23\xf3\xb1\x32\xa2\xee\x5a\x49\xaa\x0a\xa4\x16\x38\xb2\xd5\x75\x62\x02\x85\x82\xaf\x0a\x13\x46\xcf\x3c\x41\x19\x70\x05\xf2\x14\x06\x2d\xcd\x45\x5b\x3f\xbb\x47\xab\xab\xc8\x19\xf0\x05\x48\x3e\x51\x79\xa8\xbe\xa2\x6d\x54\x7d\x9d\x68\xf6\x20\xb6\xdb\xed\x26\xf2\x19\x14\xca\xb1\xf2\xd8\x89\xc9\x69\x5d\x31\x72\x4d\x50\xca\x44\xf6\xd8\x69\x67\xe2\x2c\xb4\xda\x96\xe6\xea\x32\xec\x9c\x1c\x4c\x85\xcc\x41\x76\xfa\x0b\x26\x88\xea\x35\x68\x09\x9d\xe9\x58\x51\xc5\xe0\x27\x82\x30\x92\x33\x55\xd8\x3b\xac\xfd\x47\x11\x8a\x50\xdc\xad\x3f\x13\x39\xaf\x77\xd6\x36\x4e\x9e\xb4\x65\xd3\x1d\x41\x25\x6a\xaa\xa8\xe0\x09\x22\x69\x2d\x58\xa3\x74\x36\x28\x51\x25\x28\xde\x19\x7d\xd2\x5a\x75\x30\x4f\x2e\xc2\xe6\xcd\x29\x0c\x18\xe5\x3a\x7a\x96\x0d\x07\x1d\x69\x47\x71\x45\xb3\x7f\xa4\x38\x36\x8a\x07\x96\x14\x42\xa8\x09\x4b\x2c\x42\x7b\xf3\x65\xb6\x86\x77\xbc\x29\x53\x90\x3a\xfb\x32\xad\xc4\xb7\x3f\x32\xb1\xb4\x41\xbf\x5f\xe6\x70\x2a\x81\x3c\x62\x52\x28\x1d\x7e\xc2\x5a\x72\xad\x87\xd5\x14\x0a\x21\xc1\x59\x1e\xdc\xa4\xdc\xc0\x52\x30\xd0\xbe\xfc\xd5\xd4\x8a\x16\x57\x9c\x09\xae\x80\xab\x31\xfb\xa7\xb6\x6e\xba\x05\x4c\x15\x94\xda\xf0\x39\xdd\xbd\xc1\x77\xde\xed\xa3\x35\xea\xfe\x57\xba\x8e\x58\xf2\x62\x49\x72\xda\xd4\x09\xda\x6b\x44\x11\xba\x21\xd2\x61\x3c\xa6\x6d\xbc\x48\x5b\x03\xd0\xdc\x95\x09\x9d\x3b\xfe\x62\x1b\x45\x9b\xc5\x16\xed\x6d\xf7\xa8\x9d\xcf\xc9\xd5\xd6\x5c\x21\xd7\xe8\x8e\xd1\x5a\x0d\xcf\xaf\x76\x3e\xf0\xd9\xee\x81\x36\x31\x51\xb4\x05\xd4\x9a\xff\xee\x9d\xc6\x62\x88\x9b\x22\x29\x03\xbd\x92\x31\x20\x52\xfb\xac\x2e\x0b\x61\xca\x89\x22\x43\xb6\x2f\xd7\xd7\xed\x71\x8d\xba\xff\xd5\x18\xe3\xad\x41\xf8\x16\x84\x68\x44\x74\x9b\xb0\x5e\x4a\xf6\xb5\x41\x1f\x41\xbb\x8e\xce\xbb\x68\x1e\xc3\x4e\xc3\x94\x93\x4e\x71\x28\x41\x11\x5c\x11\x0e\xec\x45\x5d\xa3\xac\x71\xb5\x58\xac\xc3\xbb\x92\xe6\x39\x03\x37\xe5\x9e\x11\x88\x4d\xaa\x0f\x3c\xd8\x64\x17\x22\x15\x76\xfa\xce\x28\x31\xfd\x39\xb8\xe0\xd8\xad\x57\xa0\xc8\x9a\xdd\x79\x5b\x08\xc6\x44\x0b\x12\x1b\x65\x6e\x3b\xdf\x45\x03\xf8\xb7\x4d\xa2\xe1\x6a\xbe\x35\x3e\x0c\x36\x1b\xb2\x1a\xc2\xbc\xa9\xe1\x38\x15\x4a\x89\x32\x41\xdb\xbe\xf4\x6d\x2a\x29\x0a\xea\x55\x73\x7c\x4b\x35\x1e\x87\xe6\x53\x85\x8e\x37\x42\x23\x86\x1f\xa2\x35\xba\x7d\x44\x9b\xfd\xea\x34\xee\xe2\x4e\x50\x0e\x37\x1a\x8d\x55\x3a\x4e\xd9\x71\x61\x71\xf4\x70\x9b\xb1\xa7\xe7\x8f\x18\xd6\x3b\x17\x6e\x2e\xf4\x7c\x61\x66\x7e\xfc\x6e\x86\x2b\x7f\x69\x99\x39\xb3\x5d\xcd\x2c\x55\xb9\x29\x4d\x8e\x15\xb6\xc2\xf6\x90\xc6\x95\x42\xb5\x60\x34\x47\x77\x0f\x0f\x0f\xae\x2b\xc7\xe9\x34\x33\x15\x7d\x59\x4c\x9c\x97\x10\xd7\x92\xcc\x0c\x4b\xcb\xf3\x54\x48\xdf\xab\x2d\xc0\x87\x21\xe4\xe6\x00\x27\xe5\x74\x6e\x89\x36\xf7\xa6\x78\x3c\x81\x54\x34\x23\xac\x17\xa4\x44\x75\x73\xa7\x83\xd8\x23\x8b\xcc\xa6\xa0\xed\x52\x3b\x19\x1f\xcc\xa9\xa9\x7a\x6e\x04\x77\x23\xf1\x7d\xd1\xf5\x22\x34\xb2\xff\xc1\x68\xec\x19\xd5\xf1\x60\xc8\xe2\xc3\x68\x98\x72\xe7\x6b\x47\x8d\x35\xc6\x3b\xba\xb9\xbe\xed\x8d\xa6\x17\x6c\x4e\x67\x00\x1f\x97\x8f\xb5\x84\x2a\xcc\x04\xc9\x17\x70\xfc\x49\x7e\xc4\xab\xe9\xa4\xf3\x4b\x05\x63\x52\xb2\xa5\xcd\x25\x1f\xd1\x0c\xab\xa1\x98\xbd\x1e\x66\xe6\x4d\x21\x64\x89\x49\xd7\x2a\x28\xaf\x1a\xb5\x46\xa3\xb5\xb4\x51\xaa\xeb\x22\x2f\xe5\x56\x1f\xc4\xee\x69\xf1\x72\xe0\x99\x6e\xc6\x33\x8a\x1d\xb0\x47\xb7\x8f\xa1\x29\x87\x9b\x1a\x88\xcc\x2e\x38\x93\x54\x81\xa4\x82\xfb\xb2\x26\x5e\x68\xe9\x0b\x87\xa7\x9c\x88\x37\x3b\x57\xc0\xe0\xf2\x71\x51\x0c\xf1\xf6\x5a\x27\x08\x4b\x27\xe6\x19\xba\x5b\x1c\xf8\x3c\xf7\x31\x2d\x9a\x43\x8b\x67\xe2\x19\xe5\x8f\xd3\x0c\x3e\xb8\xb3\xdf\xe7\x5f\xe0\x9f\xaf\x92\x4d\x40\xf6\xd4\x2e\x13\x35\xd5\x02\x28\xfc\x44\xa1\x1d\x4c\xf3\x49\x7b\x7d\xad\xea\x04\x6a\x19\xde\xd8\x8f\x2f\x43\x5b\xe3\xec\xa4\xdf\x1e\x17\x4b\x4f\xdf\xe6\x69\x49\xce\xe0\xb6\x18\x87\xf3\xfd\xfe\xa0\xa0\xcc\xa0\x77\x96\xe4\x5a\x67\x84\xc1\x87\xfb\xe8\xfd\xea\x99\xb6\xa9\x35\x38\x59\x35\xcf\x93\xfe\xc7\x84\x41\xad\xfd\x71\x64\x72\x7d\x88\xb7\xae\x03\xed\x85\x9a\xab\xdb\xe8\x7a\xb5\x54\xa2\xcc\x62\x0e\x99\x90\xa4\xbb\xfb\x59\x0d\x4b\x81\xf7\x5c\xa4\x7b\x87\xf6\x9a\x8b\xdd\x45\xc6\x40\xa7\x67\xe6\xb7\xf0\xee\x79\xe7\xe6\xb7\xe0\x37\xf1\x6d\xe4\xd9\x56\xd7\x9d\x3e\xc9\xe0\x09\xb8\x1a\xba\xde\x6d\xb2\xdd\xde\x46\x7f\x0f\x99\x96\xaa\x81\x23\xcd\xd4\x9e\x17\xd2\xb0\x37\xf5\xb5\xb3\xde\x40\xf1\xdd\x70\x95\xb1\x8c\xce\x04\x6b\x4a\x5b\x69\xfd\x3d\x61\x18\x4d\xa2\xf1\xef\x2f\xa4\x51\x62\x7a\xb9\xb4\x21\xb7\xb2\x7b\x70\x10\x1a\xdd\x8b\xcc\xc7\x36\x1a\x8a\x78\x30\xfc\x6a\x76\xc3\xc9\x23\xe8\x5f\xc1\x65\x03\x52\x0a\x89\xcb\xda\x0c\x6a\xbd\x18\x98\x4e\x16\x86\x16\xaf\xb4\xe5\xd9\xcb\x5b\x64\x06\x1e\xfb\x75\x0a\x7f\xfc\x1d\x00\x00\xff\xff\x1b\x48\x26\xba\x5d\x15\x00\x00")
+var _cssAppCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x58\xdf\x6f\xa3\x38\x10\x7e\x0e\x7f\xc5\x68\xab\x4a\xdb\x55\xc8\x42\x12\xb6\x6d\xf2\x74\x3f\xb4\xf7\x72\x4f\xf7\x70\xef\x06\x86\xc4\x57\x63\x23\x63\x9a\x76\xa3\xfd\xdf\x4f\x36\x86\x18\x30\x6d\xf7\xae\x77\xaa\x9a\x28\xc6\xcc\xcc\xf7\xcd\x37\xe3\x81\xcf\x9f\x82\xc5\x2f\x4c\x34\x39\xfc\xd1\x70\xc8\x04\x13\xb2\x0e\x16\xbf\xd3\xc3\x51\xc1\xcf\xac\xc1\x1d\x5c\x6d\x6f\xef\xbe\x7c\x8d\x82\xe0\xd3\xe7\x20\xc8\x08\x7f\x24\x35\x9c\x83\x45\x58\x8a\x6f\x61\x53\xa3\x0c\x6b\x64\x98\xa9\x1d\x70\xc1\x71\x1f\x2c\xc2\x13\xa6\x0f\x54\xf9\xaf\x95\xb5\x6f\xfd\x7b\x10\x1c\x55\xc9\x96\x41\x2a\xf2\x67\x6d\xfc\x88\x3a\x80\x1d\xc4\x51\x74\xbd\x0f\x16\x85\xe0\x2a\x2c\x48\x49\xd9\xf3\x0e\xfe\x44\x99\x13\x4e\x96\xf0\x1b\x72\x7c\x24\x4b\xa8\x09\xaf\xc3\x1a\x25\x2d\xf6\xc1\xa2\x24\xf2\x40\xf9\x0e\xa2\x7d\xb0\xa8\x48\x9e\x53\x7e\x68\x7f\xa4\x24\x7b\x38\x48\xd1\xf0\x3c\x34\x28\x35\xb0\xed\x76\x1f\x00\x00\x74\x0b\xb8\xd5\x7f\x26\x1e\x02\xe7\xe0\x72\x21\xfe\xf5\xa7\xf8\xeb\xda\x5c\x38\x6e\x74\x80\x26\xa2\x9a\x7e\xc3\x1d\xc4\xab\x04\xcb\x2e\xc8\x93\x0d\x3c\x15\x2c\xdf\x07\x0b\x7b\xbf\x3c\xa4\x1f\xe3\xe8\x7e\x09\x71\x1c\x99\x8f\x1b\x63\xea\xea\x24\x49\x55\xa1\x84\xf3\x28\x56\x17\xc4\x88\x0a\x85\x4f\x2a\x24\x8c\x1e\xf8\x0e\x32\xe4\x0a\xe5\x3e\x58\x9c\x68\x2e\x4e\xf5\x8b\x7b\xb4\xbb\x8a\x1c\x30\x3c\x22\xc9\x47\x2e\x93\xea\x09\xd6\x51\xf5\x34\xf2\xec\x61\x6c\xb3\xd9\x8c\xec\x33\x2c\x94\x13\xe5\x6d\x6b\x26\xa7\x75\xc5\xc8\xf3\x0e\x52\x26\xb2\x87\x16\x2c\x13\x07\xa1\xdd\x9e\x68\xae\x8e\xfd\xce\xd1\x8d\xa9\x90\x39\xca\x16\x79\xc1\x04\x51\x9d\x07\x6d\xa1\x0d\x3d\x54\x54\x31\xfc\x81\x24\x0c\xec\x8c\x23\xed\x00\x6b\xfc\x10\x41\x04\x71\xbb\xfe\x42\xe6\xbc\xe8\x6c\x6c\x9c\x3c\xc2\x79\xba\x63\x51\x89\x9a\x2a\x2a\xf8\x0e\x48\x5a\x0b\xd6\x28\x5d\x29\x4a\x54\x3b\x88\x37\xc6\x9f\xb4\x51\x25\xd5\xd3\x88\x61\x73\x65\x1f\x2c\x18\xe5\x18\xf6\x6a\x48\xa2\xeb\x81\xe3\x1a\x89\xcc\x8e\x40\x79\xd5\x28\x87\xe5\xf8\x8b\x81\xe3\x6c\xac\x68\xf6\xaf\x22\x8c\x93\xce\xa0\x91\x53\x21\x84\x1a\xc9\xc9\x52\xb9\x35\x5f\x26\xc8\xe0\x8a\x37\x65\x8a\x52\x97\x69\xa6\x69\xf0\xed\x8f\x4c\xd2\x6d\xdc\x77\xf3\x62\x4f\x25\x92\x87\x90\x14\x4a\xeb\x84\xb0\x13\x79\xae\xfb\xd5\x14\x0b\x21\xd1\x59\xee\x61\x52\x6e\xf8\x2b\x18\x6a\x2c\x7f\x35\xb5\xa2\xc5\x73\x98\x09\xae\x90\xab\x61\x99\x8c\x63\x5d\xb5\x0b\x21\x55\x58\xc2\xd9\x57\x17\x5e\x95\x38\xd7\xb6\xd1\x12\xda\xff\x1b\xdd\x70\xac\xca\x43\x49\x72\xda\xd4\x3b\xd8\x6a\x46\x01\x2e\x8c\xb4\x1c\x0f\xf5\x1d\xcf\xea\xdb\x10\x34\x85\x32\xac\x1f\x2b\xf4\xd0\x66\xd1\x96\xbb\x65\x7b\xbd\xee\x55\x92\x93\x67\xdb\x9c\x85\x5c\xc2\x15\xa3\xb5\xea\x7f\xbf\x19\xfc\xc2\x17\xbb\x87\xda\x9d\xc9\xa2\xed\xb4\x36\xfc\x0f\x1f\x34\x17\x7d\xde\x14\x49\x19\xea\x95\x8c\x21\x91\x1a\xb3\x3a\xce\xa4\x29\x27\x8a\xc0\xd9\xcf\x91\x13\xeb\xfa\x76\x09\xed\xff\xcd\x90\xe3\xb5\x8e\xd2\x49\x42\xcf\x89\x11\xba\xad\x6c\xaf\x24\xbb\x26\xa2\x7b\x07\x6c\xf4\x47\x64\xbe\x86\xf6\xe3\x55\xeb\x61\xac\x49\x5b\x81\x1a\x53\x89\x8a\x84\x15\xe1\xc8\x5e\xf5\x35\xa8\x1a\xd7\x4b\xc7\x75\x4e\x0f\x58\xab\x37\x5a\xd3\xe5\xb7\xb6\x05\xeb\xb3\x16\x5c\x95\x34\xcf\x19\xf6\x6c\xbf\x6c\x30\x34\x8d\xa3\x57\xd5\x2a\x3b\x12\xa9\x42\xe7\xb8\x1b\x94\xb9\xbf\xa2\x67\x68\xf2\x86\xac\xf1\x16\x82\x31\x71\x42\x19\x1a\x67\xee\x14\xb1\x89\xfa\x54\x5e\x36\x89\x86\xab\xe9\xd6\x38\xe9\x63\x36\xd2\x37\xf2\x7b\xd7\xc0\xc3\x54\x28\x25\xca\x1d\xac\xbb\x46\xba\xaa\xa4\x28\xa8\xd7\xcd\xed\x7b\xba\xf1\x00\x9a\x0e\x33\x5a\x3d\x00\xee\xf1\xb7\x4e\xa2\x25\x5c\x3e\xa2\xd5\xf6\xc6\x2d\x93\x64\x70\x8c\x26\x17\x51\x0e\x5d\x3a\xa0\xec\x94\x32\x3b\xf1\xb8\x33\x80\xab\xb1\x61\x23\x6b\x15\xd6\x81\x0b\x56\x47\x7a\x38\x32\x9d\x44\xd3\x4e\x00\xfc\x8d\x6a\x02\x66\x7d\x33\x89\x54\xe5\x70\x1e\x46\x61\xfb\x75\x47\x69\x5c\x29\xa8\x05\xa3\x39\x5c\xdd\xdf\xdf\xbb\x50\x6e\x07\x64\x4c\x49\x50\xc7\xd9\x4a\x7c\x8d\x71\x4d\xa7\x19\x9d\x69\x79\x80\xb3\x7f\x44\xb0\x04\x27\x7d\xca\xcd\x0d\x9c\x94\xe3\x71\x29\x5a\xdd\xe9\x44\x2f\x1e\x51\x2a\x9a\x11\xd6\x19\x52\xa2\xba\xc0\x69\x8b\xd8\x63\x8b\x8c\x45\xb3\x5a\xcf\x1d\x4e\xc3\x1b\x73\x6a\x7a\xa8\x9b\xc1\xcd\xc0\x7c\xd7\xc2\xbd\x0c\x0d\xe2\xbf\xc7\xf2\x02\x38\x6e\x45\xde\x57\x71\x32\x98\xe1\xdc\xb1\xde\x71\x63\x83\xf1\x9d\x16\x03\x6c\x5b\x2c\x47\xaa\xf3\xc4\x9c\x8e\x28\x89\x56\xb7\xf3\xb7\x9d\x08\x55\x21\x13\x24\x87\xb3\xf7\x80\xf8\x41\x7d\xc4\x37\xfb\xf7\xe8\x4b\xa3\x96\x2d\x6d\x2d\xf9\x84\xa6\x41\xe8\x6a\x1c\x5f\xee\x47\xf5\x55\x21\x64\x19\x92\xf6\x60\x36\x33\xe9\x12\x06\x6b\x69\xa3\x54\x7b\x8a\xbc\x56\x5b\x5d\x12\xdb\x5f\xd3\xaa\xb6\xcf\x24\x03\x1a\x63\x2c\x27\x13\x8f\x9d\xeb\xfb\x52\xd5\x9d\x2a\xee\xd0\x06\xab\x76\x7e\x0e\x33\x49\x15\x4a\x2a\xb8\xaf\x6a\xe2\x99\x01\x61\xe6\xe6\xb1\x26\xe2\xd5\xc6\x35\xd0\x43\xbe\x9d\x35\x63\xc9\x9a\x4f\xc2\xdc\x1d\xd3\x0a\xdd\xcc\x55\xa8\xef\x31\x50\x9b\xe6\x78\x0a\x27\xe6\x19\xe5\x0f\xe3\x0a\x6e\x4b\xcf\xea\xef\xcb\x3f\xd0\x9f\x07\xdb\x25\x28\x9b\xb6\x69\xef\x32\x59\x53\x27\x44\x15\x3e\x52\x3c\xf5\xa1\xf9\xac\xbd\xbd\x57\xb5\x06\xb5\x8d\x31\x83\x58\x4e\x9e\xc1\xd6\xd1\xf5\x45\x66\x83\x6c\x7a\x5b\x4f\x77\xcc\xd3\x92\x1c\xd0\x3d\x62\x1c\xcd\x77\xfb\x17\x05\x65\x66\x1c\x3a\x48\xf2\x5c\x67\x84\xe1\xc7\xbb\xe8\xfa\xe6\x85\x63\x53\x47\xef\x54\xd5\xb4\x4e\xba\x77\x18\xbd\x5b\xfb\x4e\x66\xf4\x30\x12\xaf\x5d\x00\xa7\x23\x55\xe8\x7a\xb5\x0f\x0e\xde\x16\x65\x16\x73\xcc\x84\x24\x5a\xb7\xbd\x87\xb9\xc4\x0f\xd2\x92\x38\x35\x6b\x1e\x89\xc0\x04\x02\xa0\x81\x99\x09\xfc\x3d\xd0\xbd\x0c\x2e\xf9\x6f\xb0\x0d\x90\xad\x75\xdf\xe9\x8a\x0c\x1f\x91\xab\xfe\xd4\xeb\xb6\xd8\xb9\x76\xd3\xcd\xe3\x63\x31\xf9\x34\x3e\xb2\x66\x7a\xcf\x2b\x65\xd8\x85\x3a\x7f\xb2\x0c\x67\xbd\x5e\xe2\xdd\x41\x76\xd5\x29\x3a\x13\xac\x29\x6d\xa7\xf5\x9f\x09\x5d\x7f\x48\xa2\xe1\x6b\x1f\xd2\x28\x31\x7e\x54\x05\x70\xab\xa5\x23\x07\xa0\xa3\x47\xb7\x04\xd3\x7c\x5b\x9a\x6c\x87\xe8\x5f\xd6\x5d\x78\xf2\x18\xfa\x5f\x78\x59\xa1\x94\x42\x86\x65\x6d\x06\xb5\xce\x0c\x8e\x27\x0b\x23\x8b\x37\xc6\xe2\xd5\xe4\x45\x2f\x7a\xe0\x81\x08\x92\xa8\x7a\xda\x07\xdf\xff\x1e\x00\xa4\xa1\xee\x7a\xd4\x15\x00\x00")
 
 func cssAppCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -194,12 +199,12 @@ func cssAppCss() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "css/app.css", size: 5469, mode: os.FileMode(420), modTime: time.Unix(1609623037, 0)}
+	info := bindataFileInfo{name: "css/app.css", size: 5588, mode: os.FileMode(436), modTime: time.Unix(1792318717, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _cssChartCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x4f\x4b\x4e\xc3\x30\x10\xbd\x4a\x37\xec\x70\xe5\xb0\xab\x2d\x21\xae\xe2\xd6\x13\x3c\x34\x9e\xb1\xc6\x43\xfa\x89\x72\x77\xd4\xd0\x0f\xa0\x04\xaf\xfc\x9e\xfd\x7e\x6f\x7b\x38\xb5\x12\x32\xd4\xd5\x2e\x05\xd1\x8f\x6a\x04\x28\x82\x98\x40\x98\x83\x22\xd3\xd0\x0a\xe7\x81\x4b\xd8\xa1\x9e\xdc\x7a\xb3\x19\x95\xef\xb0\x19\xc7\xf5\x1f\x61\x66\x42\x65\x19\xee\x06\x6e\xc9\x79\xd5\xe4\xfa\x90\x57\x3c\xc3\x4d\xfc\x3c\xcb\x1a\x38\x96\x40\x71\xe1\xb1\x26\x41\xda\x0f\x85\x2b\x4e\xa9\x61\x5b\xb9\xfb\x54\xf0\x11\x05\x76\x13\xd5\xa9\xf8\x0e\x5a\x75\xd6\x2b\x17\x67\xbd\xe0\x7b\xba\xa0\x2d\xab\x72\x76\xd6\x73\x0f\xd2\x76\x7c\x70\x09\x63\x04\xf2\x85\x91\x14\xc4\x40\x0f\xa4\xd5\x11\x13\xf8\x1e\x2b\x6e\xb1\xbb\xcc\xbf\xfe\x3a\x1b\xa4\x08\x47\x67\x9a\xf9\x39\xd7\xe2\xaf\x11\xfb\x99\x7e\x07\x8c\x9a\x5c\x63\xa7\x53\x8e\x3e\xc1\xd4\xea\x41\xfc\xac\xbc\x10\xf0\x3d\xfe\xdf\x80\x17\x6b\x9f\x6e\xde\xd3\xfd\x97\xed\x57\x00\x00\x00\xff\xff\xd5\x66\xe8\x78\x09\x02\x00\x00")
+var _cssChartCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\xcd\x6e\xc3\x20\x10\x84\x5f\x25\x97\xde\x4a\x84\x7b\x0b\x48\x55\x5f\x85\x84\x75\xd9\xc6\xec\xa2\x65\xeb\xfc\x58\x7e\xf7\xca\x6e\x93\xb4\x55\x1c\x4e\xec\xc0\x7c\x33\xf0\xb6\x87\x53\x2b\x21\x43\x5d\xed\x52\x10\xfd\xa8\x46\x80\x22\x88\x09\x84\x39\x28\x32\x0d\xad\x70\x1e\xb8\x84\x1d\xea\xc9\xad\x37\x9b\x51\xf9\x3a\x36\xe3\xb8\xfe\x67\xcc\x4c\xa8\x2c\xc3\x15\xe0\x96\xc8\xab\x26\xd7\x9b\xbd\xe2\x19\x2e\xe6\xe7\xbb\xaa\x81\x63\x09\x14\x17\x0e\x6b\x12\xa4\xfd\x50\xb8\xe2\x04\x77\x61\x5b\xb9\xfb\x54\xf0\x11\x05\x76\xb3\xd4\xa9\xf8\x0e\x5a\x75\xd6\x2b\x17\x67\xbd\xe0\x7b\x9a\xa6\x2d\xab\x72\x76\xd6\x73\x0f\xd2\x76\x7c\x70\x09\x63\x04\xf2\x85\x91\x14\xc4\x40\x0f\xa4\xd5\x11\x13\xf8\x1e\x2b\x6e\xb1\x9b\x7e\xe3\xe7\xd6\xd9\x20\x45\x38\x3a\xd3\x8c\x8f\x8a\xbf\x46\xec\xef\xf4\x3b\x60\xd4\xe4\x1a\x3b\xaf\x72\xf4\x09\xe6\x56\x37\xe1\x77\xe5\x85\x80\xef\xc7\x3f\x0c\x78\xb1\xf6\xe9\xc2\x9e\xf7\x7f\xb0\x5f\x03\x00\xd5\x66\xe8\x78\x09\x02\x00\x00")
 
 func cssChartCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -214,12 +219,12 @@ func cssChartCss() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "css/chart.css", size: 521, mode: os.FileMode(420), modTime: time.Unix(1609252321, 0)}
+	info := bindataFileInfo{name: "css/chart.css", size: 521, mode: os.FileMode(436), modTime: time.Unix(1610636534, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _imgFaviconIco = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x93\x5d\x4c\x5b\x65\x18\xc7\xdf\xc5\x45\x8d\x66\x0e\xb7\xf8\x71\xb9\x4b\x63\xb2\x68\x64\xed\xde\xd6\xb7\x1a\x63\xe2\xbd\x06\xbd\x98\x51\x4b\x4f\xbf\x2c\xcc\xb2\xd2\xba\x42\x21\x5b\x3f\x38\x67\x65\x73\x8b\xba\x18\x51\x02\xa4\x3d\xb5\x94\x52\x5b\x02\x45\x6c\x4c\x34\x12\x21\xbd\xf2\x0a\x6d\xa4\x98\x54\x2a\x54\xe1\xa2\x49\x81\x73\x0e\x27\xe5\x31\x4f\x4b\xaf\x84\xec\x82\x7f\xf2\xcf\x9b\x9c\xfc\x7e\x39\xc9\xfb\x3c\x2f\x21\xa7\xc8\x29\xd2\xd6\x86\xe7\x05\x62\x3f\x4d\xc8\xd3\x84\x90\xe7\x08\x21\x6d\x84\x90\x0b\xa4\xf9\xbd\x91\xd3\x84\x9c\x7b\xbc\xd9\xa3\xc2\x78\x4a\xd8\x10\x7d\x94\xf1\xf4\x19\xc6\xd3\x33\x4c\xa0\xe7\x19\x4f\x9f\x64\x02\x3d\xcb\x78\xfa\x14\x13\xe8\x63\x4c\xa0\x47\xcb\x84\x10\xdd\x90\x86\xe8\x82\x9a\x76\x16\xa6\xf7\xf4\xbc\xb6\x83\x0d\x53\x9f\x9e\xd7\x3a\xd8\x30\xe5\x5e\x16\x2e\x07\xd8\x6d\xfa\x06\xbb\xfd\x7f\xbf\xb6\x5b\x23\xb5\x9d\xda\xc3\xa5\x4a\xe9\xe2\xdd\xf9\x3b\xf6\xab\x71\x47\x6e\x30\xd5\xcf\xbb\xa6\x9c\x09\xcf\x94\x6b\xe4\xe3\xe9\xde\xfb\x1f\x7d\xd3\xf5\x6d\x68\x2e\xd0\xbf\x5e\x59\xbf\x88\x2c\x3a\xad\xac\xfe\xbd\x4a\x0a\x7f\x15\x5e\x0c\xce\xf9\x4b\xd6\x28\xb7\x67\x13\xcd\xaa\x55\xe4\x24\xab\xc8\x29\x56\x91\x93\x07\x66\xfa\x65\xf7\xf4\xb5\x7d\x9b\x68\x96\x42\x59\x7f\x09\x59\x74\x30\x86\xb0\xee\x91\x76\xfe\x85\xe7\x3f\xff\xe1\x53\x93\x55\xe4\x76\x2d\xd1\x4e\x30\x47\x3b\xc1\x22\x9a\x1a\x27\x17\x35\x42\x20\x7b\x03\x6e\xcc\x0e\x80\x2d\x66\x06\x4b\xd4\xb4\x8b\x2c\x3a\xe8\x1a\x6e\xe9\x9e\xd5\xdf\xd2\x7e\xd6\x1d\xfb\xf0\xc7\xae\xb8\x5d\x75\x4f\x5f\x83\x81\x99\x3e\x70\xc4\x6d\xd0\x15\xb7\xc3\xd5\x49\x47\xa3\xe8\x5a\x45\x13\xd8\x63\x16\x15\x59\x74\xd0\xc5\x7b\xd6\x04\x5e\x7a\xdb\x97\xf4\x86\xad\x51\x93\xf4\xde\xf8\x15\xb8\x39\x3b\x08\xbe\x19\x6f\xa3\xde\xb4\x07\xae\xa7\xdd\xe0\x49\xb9\xe0\x9d\xaf\xdf\xc2\xff\x4b\xc8\xa2\xd3\x9a\x91\x3e\xac\xed\x73\x4e\x76\x27\x42\x59\xbf\xf2\xdb\xe6\x0a\x48\x8a\x04\x85\x3f\x0a\x50\xde\x28\x43\xf1\xcf\x22\xfc\xbb\xfd\x0f\xa8\x75\x15\x0a\x95\xdf\x61\x68\x3e\xa8\x20\x8b\xce\xe1\x7c\x9f\xb8\x1c\x6a\xbf\xe2\x4e\xb8\x3e\x19\xfb\x65\x54\x96\x54\x09\x0e\x0e\x0e\x20\x97\xcb\x41\x3e\x9f\x87\x74\x3a\x0d\x8b\x8b\x8b\x80\x91\x55\x19\x26\x96\xc6\x64\x64\xd1\x41\x97\x09\xba\x73\xfa\xb0\xc6\xd9\x3b\xd5\x33\xee\x4a\xf6\x28\xdf\xaf\x7c\x07\xf5\x7a\x1d\x16\x16\x16\x1a\x7e\x26\x93\x81\xe5\xe5\x65\x90\xf6\x25\x48\xff\x9a\x82\xde\x64\x8f\x82\x2c\x3a\xe8\x32\x9e\x9e\xa7\xfc\x25\x8f\x6b\xd2\x19\x33\x45\x3e\x50\xde\x9f\x78\x17\xb6\x6b\x5b\x50\xd9\xac\x40\xb5\x5a\x85\xf2\x66\x19\xd6\x36\xd6\x40\xcc\x47\xe0\xb5\xbb\x06\x9c\x89\x82\x2c\x3a\xe8\x1a\x04\xdd\x19\x1a\xbe\xd4\x31\x98\xf1\x09\x5c\xc4\x28\xbd\x72\x47\x0f\x5f\xfe\xfc\x05\x6c\xed\x6c\x35\x3a\xb6\x34\x0a\x1d\x5f\xbd\x09\xaf\xdf\x7b\x15\xf4\xc3\x5a\x30\x47\x8c\x12\xb2\xe8\xa0\x8b\x3b\x50\x3c\xdc\x9f\xd0\x9c\xbf\xc4\x45\x8c\x7b\xf6\x98\x59\x0d\x66\x6f\x4a\xc1\xac\x5f\xb1\xc7\x2c\x32\x17\x31\xca\x96\x68\xe7\x3e\xba\xc8\x20\x5b\x3c\xdc\x9f\xe3\xf6\xb7\x2f\x79\x9d\xc7\x7b\xf6\x24\x5c\x23\xde\x94\xe7\xbe\x33\xde\x7d\xec\xfe\x9e\xf4\xfd\x9c\xe4\xfd\x56\xcf\x36\xfb\xd3\x43\xcd\x3e\x28\x2d\xae\xe5\xfd\x17\x00\x00\xff\xff\x08\x43\x61\x32\x7e\x04\x00\x00")
+var _imgFaviconIco = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x93\x5d\x68\x5b\x65\x18\xc7\xdf\xe1\x50\x51\x66\xea\x86\x1f\x97\xbb\x14\x61\x28\x76\xc9\xde\xc4\x37\x8a\x08\xde\x2b\xd5\x8b\x89\x9a\xe6\xe4\xcb\xb4\x33\x5d\x9a\xb8\xb4\x69\xd9\xf2\xd1\x73\x96\x6e\x6e\xa8\x43\x9c\x8e\xae\x24\x27\xa6\x69\x1a\x93\xd2\xa6\xd6\x20\x28\x16\x5b\x72\xe5\x55\x35\xd8\x54\x88\x4d\xdb\x68\x7b\x11\x48\xdb\x73\x4e\x0f\xe9\x23\x4f\xd2\x5c\xd5\x8f\x8b\xfe\xe1\xcf\x0b\x87\xdf\x8f\x03\xef\xf3\xbc\x84\x9c\x20\x27\x48\x47\x07\x9e\x67\x89\xe3\x24\x21\x4f\x12\x42\x9e\x21\x84\x74\x10\x42\xce\x92\xd6\xf7\x66\x4e\x12\x72\xfa\xd1\x56\xff\x29\x8c\xa7\x84\x8d\xd0\x87\x19\x4f\x9f\x62\x3c\x3d\xc5\x04\x7a\x86\xf1\xf4\x71\x26\x50\x0d\xe3\xe9\x13\x4c\xa0\x8f\x30\x81\xb6\xf1\x23\xd1\x8f\x68\x89\x3e\xa4\xed\x64\x11\x7a\xdb\xc0\xeb\xba\xd8\x28\xf5\x1b\x78\x9d\x93\x8d\x52\xee\x45\xe1\x42\x90\xdd\xa0\xaf\xb1\x1b\x47\xfd\xfa\x6e\x9d\xd4\x77\xea\x0f\x96\xab\xe5\x73\xb7\xe6\x6e\x3a\x2e\x25\x9c\xf9\xe1\xf4\x20\xef\x9e\x74\x25\xbd\x93\xee\xbb\x1f\x4e\xf5\xdf\xf9\xe0\xab\x9e\xaf\xc3\xb3\xc1\xc1\xb5\xea\xda\x39\x64\xd1\x69\x67\x65\x7d\x85\x14\xff\x28\x3e\x1f\x9a\x0d\x94\x6d\x31\x6e\xcf\x2e\x5a\x54\x9b\xc8\x49\x36\x91\x53\x6c\x22\x27\x0f\x4d\x0f\xca\x9e\xa9\xcb\xfb\x76\xd1\x22\x85\x73\x81\x32\xb2\xe8\xa0\x6b\x8c\xe8\x1f\xea\xe4\x9f\x7b\xf6\xd3\xef\x3e\x36\xdb\x44\x6e\xd7\x1a\xeb\x06\x4b\xac\x1b\xac\xa2\xb9\x79\x72\x31\x13\x04\x73\x57\xe1\xea\xcc\x10\xd8\xe3\x16\xb0\xc6\xcc\xbb\xc8\xa2\x83\xae\xf1\xba\xfe\x69\xc3\x75\xdd\x27\xbd\xf1\xf7\xbf\xef\x49\x38\x54\xcf\xd4\x65\x18\x9a\x1e\x00\x67\xc2\x0e\x3d\x09\x07\x5c\x9a\x70\x36\x8b\xae\x4d\x34\x83\x23\x6e\x55\x91\x45\x07\x5d\xbc\x67\x6d\xf0\x85\x37\xfd\x29\x5f\xc4\x16\x33\x4b\xef\xdc\xbf\x08\xd7\x66\x86\xc1\x3f\xed\x6b\xd6\x97\xf1\xc2\x95\x8c\x07\xbc\x69\x37\xbc\xf5\xe5\x1b\xf8\x7f\x09\x59\x74\xda\x33\x32\x44\x74\x03\xae\x89\xde\x64\x38\x17\x50\x7e\xd9\x5c\x06\x49\x91\xa0\xf8\x5b\x11\x2a\x1b\x15\x28\xfd\x5e\x82\xbf\xb6\xff\x04\xb5\xa1\x42\xb1\xfa\x2b\x8c\xcc\x85\x14\x64\xd1\x39\x9c\xef\x63\x17\xc2\x9d\x17\x3d\x49\xf7\x47\x63\x3f\xdd\x93\x25\x55\x82\x83\x83\x03\xc8\xe7\xf3\x50\x28\x14\x20\x93\xc9\xc0\xc2\xc2\x02\x60\x64\x55\x86\xf1\xc5\x31\x19\x59\x74\xd0\x65\x82\xfe\xb4\x21\xa2\x75\xf5\x4f\xf6\xdd\x77\xa7\xfa\x94\x6f\x97\xbf\x81\x46\xa3\x01\xf3\xf3\xf3\x4d\x3f\x9b\xcd\xc2\xd2\xd2\x12\x48\xfb\x12\x64\x7e\x4e\x43\x7f\xaa\x4f\x41\x16\x1d\x74\x19\x4f\xcf\x50\xfe\xbc\xd7\x3d\xe1\x8a\x9b\xa3\xef\x29\xef\x8e\xbf\x0d\xdb\xf5\x2d\xa8\x6e\x56\xa1\x56\xab\x41\x65\xb3\x02\xab\x1b\xab\x20\x16\xa2\xf0\xca\x2d\x23\xce\x44\x41\x16\x1d\x74\x8d\x82\xfe\x14\x8d\x9c\xef\x1a\xce\xfa\x05\x2e\x6a\x92\x5e\xba\x69\x80\xcf\x7f\xfc\x0c\xb6\x76\xb6\x9a\x1d\x5b\xbc\x07\x5d\x5f\xbc\x0e\xaf\xde\x7e\x19\x0c\xa3\x3a\xb0\x44\x4d\x12\xb2\xe8\xa0\x8b\x3b\x50\x3a\xdc\x9f\xf0\x6c\xa0\xcc\x45\x4d\x7b\x8e\xb8\x45\x0d\xe5\xae\x49\xa1\x5c\x40\x71\xc4\xad\x32\x17\x35\xc9\xd6\x58\xf7\x3e\xba\xc8\xe0\xfe\x94\xd6\x57\xfe\x73\x7f\x07\x52\x57\x78\xbc\x67\x6f\xd2\x7d\xd7\x97\xf6\xde\x71\x25\x7a\xff\x75\x7f\x8f\xfb\x7e\x8e\xf3\x7e\x6b\x9a\x56\x7f\x78\xa0\xd5\xff\x4b\x9b\xab\x69\x08\xa9\x69\x08\xf9\x7b\x00\x08\x43\x61\x32\x7e\x04\x00\x00")
 
 func imgFaviconIcoBytes() ([]byte, error) {
 	return bindataRead(
@@ -234,12 +239,12 @@ func imgFaviconIco() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "img/favicon.ico", size: 1150, mode: os.FileMode(420), modTime: time.Unix(1609252321, 0)}
+	info := bindataFileInfo{name: "img/favicon.ico", size: 1150, mode: os.FileMode(436), modTime: time.Unix(1610636534, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _imgSignInPng = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x0e\x0b\xf1\xf4\x89\x50\x4e\x47\x0d\x0a\x1a\x0a\x00\x00\x00\x0d\x49\x48\x44\x52\x00\x00\x00\x9e\x00\x00\x00\x1c\x08\x06\x00\x00\x00\x90\xfd\x06\xa8\x00\x00\x00\x19\x74\x45\x58\x74\x53\x6f\x66\x74\x77\x61\x72\x65\x00\x41\x64\x6f\x62\x65\x20\x49\x6d\x61\x67\x65\x52\x65\x61\x64\x79\x71\xc9\x65\x3c\x00\x00\x0a\xb0\x49\x44\x41\x54\x78\xda\xec\x5b\x69\x4c\x54\x59\x16\x3e\xaf\xa8\x2a\x28\x96\x2a\x90\x65\x10\x10\x54\x6c\x45\xdb\x1d\xb5\x71\xdf\x68\x82\x31\x9a\x38\x1a\x4c\xfb\xc3\xc4\xb8\x8c\x4b\x14\x6d\xa3\x19\xa5\xd5\x19\xa7\x45\x1d\x8d\x31\x1a\x8d\x31\x1a\x97\xe8\x0f\x97\xb8\xeb\xd8\xa0\xc6\x85\xb8\xc7\x18\x75\x64\x69\x75\x44\xb4\x55\xc4\x05\x2c\x0a\x59\x0a\xe6\x7d\x07\x6f\xcd\xe3\x51\x45\x15\x13\xca\x76\x26\xef\x4b\xae\xef\xdd\xf7\xde\xbd\xf7\xdc\x73\xbf\x7b\x96\x5b\x28\xdd\xbe\x7d\x9b\x64\x7c\x23\x97\x35\x72\x49\x96\x8b\x99\x34\x68\x68\x79\x94\xc9\xe5\x9c\x5c\xfe\x9c\x98\x98\xf8\xab\x24\x13\xaf\x93\x5c\xb9\x1e\x13\x13\x13\x1c\x1a\x1a\x4a\x3e\x3e\x3e\x9a\x8a\x34\xb4\x38\xec\x76\x3b\xbd\x7d\xfb\x96\x9e\x3f\x7f\xfe\x41\xae\x26\xe9\xe5\x7f\x32\x41\xba\x88\x88\x08\x4d\x3b\x1a\xbc\x06\x18\xb4\xcf\x1c\x0b\x96\xc9\x97\x09\xe2\x25\xb7\x6a\xd5\x8a\xea\xea\xea\x34\xed\x68\xf0\x3a\xe0\x55\x65\xe2\xa5\x80\x78\x66\x9d\x4e\xa7\x11\x4f\xc3\x17\x81\x24\x49\xb8\x04\x82\x78\x1a\xe9\x34\x7c\x71\x30\xf1\x6a\x6b\x6b\x35\x4d\x68\xf8\xf2\xc4\x73\x67\xf1\xce\xfe\xfa\x1b\xa5\x7e\x13\xe5\xf2\xfd\x3f\x8b\x4b\x69\xda\x99\xbb\x54\x54\x5e\x83\xde\x28\x29\x32\x88\xfe\x3a\x38\x81\xbe\x8d\xb0\xd0\xb5\xa2\x12\xfe\xa6\x7f\x9b\x30\x4d\xdb\x1a\x3c\x27\xde\xb5\xe7\x6f\xe9\xc7\x4b\x05\x54\x27\xe9\x28\x35\xfe\x0f\x8d\xde\x17\x95\xd9\x28\xed\x1f\xb9\x64\x35\x85\x93\x3e\xd8\x84\xce\xe8\x56\x65\x05\xa5\xfd\x92\x4f\x31\x7e\xec\xcf\xe9\xc7\xc4\x38\xcd\x9d\x6b\x68\x9e\xab\x7d\xf8\xfe\x13\x95\x07\x84\xd2\x9f\xae\x3c\xa3\x9f\x4a\x6d\x34\xb5\x67\x5c\x83\xf7\x87\x0a\x8a\xc9\x1a\x18\x4a\x3a\x73\x08\x49\x3e\x06\x7e\x56\x57\x53\x4d\xe5\x95\x36\xca\xaf\xae\xa2\x20\xc9\x4e\xbf\x14\x95\x52\x72\x5c\xa8\xd3\xfe\xef\xdc\xb9\x43\xaf\x5f\xbf\xe6\xfb\xae\x5d\xbb\x52\x9b\x36\x6d\xf8\xbe\xa2\xa2\x82\x0a\x0b\x0b\x29\x3c\x3c\x9c\x33\xa1\x96\x04\xce\x93\xde\xbc\x79\x43\x71\x71\x71\x64\x32\x99\xbc\xa2\x58\x77\xf2\x37\x67\x7e\x42\x5e\x57\x48\x48\x48\xf8\xaa\xe6\xde\x22\x16\x2f\xb5\x7d\x38\xfd\x5c\x58\x4b\x52\xa0\x85\x7e\x7e\x58\x42\x59\xcf\xde\xd1\xfc\x5e\x6d\x28\x29\xa6\x5e\x59\x79\xd6\x5a\xd2\xf9\x07\x91\xa4\x37\xfe\x27\x73\x31\x18\xe5\xba\x9e\xea\xec\x35\x34\x3d\xce\x40\xe9\x9d\x82\xa9\xba\xba\xba\x91\xe2\x57\xac\x58\x41\x37\x6e\xdc\x68\xf0\x3c\x25\x25\x85\x32\x32\x32\x78\x51\x66\xcc\x98\x41\x4b\x96\x2c\xa1\xd4\xd4\xd4\x16\x9d\xf4\xcd\x9b\x37\x69\xf5\xea\xd5\xb4\x7d\xfb\x76\xea\xd4\xa9\x93\x57\x14\xab\x96\xff\xca\x95\x2b\x74\xf9\xf2\x65\x9a\x3c\x79\x32\x6f\xae\xe6\xcc\x4f\xc8\xeb\x0a\x97\x2e\x5d\xfa\xaf\xe7\x7e\xf8\xf0\x61\xca\xcf\xcf\x67\x9d\x0b\xac\x5a\xb5\x8a\xdf\x4d\x98\x30\xe1\xf7\x23\x5e\x4d\x4d\x0d\x6d\xe8\x15\x46\x0b\x73\x6d\xe4\x13\x1a\x49\xb7\xac\xa5\xf4\xc3\x85\x7f\x51\xb4\xfe\x11\x25\x45\x87\xd0\x47\x5d\x00\x91\x8f\xde\x59\xe2\x4c\xfd\x2d\x3a\x9a\xd7\xd1\x42\x55\x55\x55\x8d\xde\x1e\x38\x70\x80\x49\xb7\x7c\xf9\x72\x1a\x3d\x7a\x34\x95\x94\x94\xf0\x84\xb3\xb2\xb2\x68\xf0\xe0\xc1\xd4\xaf\x5f\x3f\xda\xbd\x7b\x37\x85\x84\x84\xb4\xb8\x9b\x4e\x4a\x4a\xe2\xbe\x5b\xb7\x6e\xed\xb5\x10\x00\x16\x45\x29\x3f\x36\x1a\xe6\x86\xc5\x54\x8e\xe9\xc9\x51\x56\x7c\x7c\x3c\xeb\x89\x3d\xcc\xa1\x43\x94\x9b\x9b\x4b\xd3\xa7\x4f\x67\xf9\x9b\x7b\x2a\xa1\x9e\x7b\x41\x41\x01\xcb\xb5\x74\xe9\x52\xc7\x37\xa8\xe3\xd8\xc3\x9b\xe1\x91\x8f\xbc\xeb\xfe\x22\x0e\x90\x9d\x95\xac\xa7\x25\xf4\xb7\xfb\x6f\xc9\xcf\x3f\x80\xaa\xf4\xbe\x24\xf9\x9a\x48\x67\x0a\x20\xab\x8f\x2f\xe5\x56\x10\xbd\xa8\x33\x92\xce\xe0\x0b\x0d\x36\xec\xb9\xd6\x4e\x09\xfa\x4a\x4a\x89\x30\x3a\xed\xf7\xec\xd9\xb3\xf4\xf8\xf1\x63\x1a\x38\x70\x20\x5b\x00\xa3\xd1\x48\xed\xdb\xb7\xa7\xee\xdd\xbb\x53\x54\x54\x14\x55\x56\x56\xd2\xfe\xfd\xfb\xc9\xcf\xcf\x8f\xdf\xa3\x0d\x76\xe7\x9c\x39\x73\xe8\xd4\xa9\x53\x14\x19\x19\x49\xfb\xf6\xed\x63\x37\xdd\xb9\x73\x67\x7e\x77\xec\xd8\x31\x1e\x7a\xe1\xc2\x85\xb4\x75\xeb\x56\x6e\xd3\xa3\x47\x8f\x46\x63\xe3\xf7\xe9\xd3\xa7\x4f\xf3\x82\x7e\xf8\xf0\x81\xb6\x6c\xd9\x42\xe5\xe5\xe5\x74\xf0\xe0\x41\x5e\xe0\xab\x57\xaf\x52\x87\x0e\x1d\xd8\x05\x2a\xdb\xe5\xe5\xe5\xb1\xa5\xc0\xb7\x68\x8b\xcd\xb2\x71\xe3\x46\x87\x0c\xf8\x26\x33\x33\x93\xee\xde\xbd\xcb\x32\x0b\xf9\x61\xdd\xb0\xd1\xe0\xe6\x8a\x8b\x8b\xc9\xd7\xd7\x97\x02\x03\x03\xe9\xe4\xc9\x93\xd4\xab\x57\x2f\x3a\x72\xe4\x88\x63\x5c\xcc\xdf\x6c\x36\x37\x18\x37\x2c\x2c\x8c\xe5\xc1\x98\xf7\xee\xdd\xa3\x47\x8f\x1e\xd1\xec\xd9\xb3\x79\x73\xe2\xd9\x99\x33\x67\x58\xf6\x80\x80\x00\xd6\x0b\xc2\x97\x5d\xbb\x76\xb1\x2e\x20\xc7\xb3\x67\xcf\x1c\x73\x7c\xff\xfe\xbd\x63\xee\x20\xd8\xf9\xf3\xe7\xf9\xf9\x8b\x17\x2f\x78\xb3\xe0\xbb\x27\x4f\x9e\xd0\xc7\x8f\x1f\x99\x94\x83\x06\x0d\x22\x9b\xcd\xc6\x73\x59\xb0\x60\x01\x93\x16\x32\x75\xec\xd8\x91\xf4\xb2\x57\x83\x25\xc7\x3a\x08\x4b\x09\xf9\xd0\xc6\x15\x9f\x50\xde\xbd\x7b\x47\x3a\xb1\x63\x5c\x15\xb8\x5a\x8b\xbf\x1f\x95\xd5\xd4\x5b\x31\xb8\x54\xc9\x14\x48\x3a\x8b\x1c\xd7\x59\xc2\x48\xe7\x27\x5b\x3c\x5d\xe3\xdf\x77\xeb\xe4\xb8\xb1\x73\xa0\x8f\xcb\x7e\xfb\xf4\xe9\xc3\xdf\x61\xa1\x66\xce\x9c\xc9\x8a\xc2\xe4\x21\x34\x26\x85\xc9\x42\x31\xb8\x0a\xa2\x42\x29\xa3\x46\x8d\xa2\x59\xb3\x66\xb1\x22\xb2\xb3\xb3\x79\x11\xf0\x1e\x57\xd4\xa1\x54\xbc\xef\xdf\xbf\x3f\xed\xd9\xb3\x87\x15\xa3\x1e\x5b\xd9\x37\x2c\x11\xda\xad\x5d\xbb\x96\xba\x74\xe9\xc2\xca\x85\xc2\x41\x28\x75\xbb\xb6\x6d\xdb\xf2\xb7\x20\x38\xea\xb0\x3c\xa8\x43\x2e\x41\x4c\xd4\x83\x83\x83\x1b\x8c\x01\x32\xb4\x6b\xd7\xce\x11\xc7\xa2\x2e\xac\xc9\xa6\x4d\x9b\x1a\x8c\x8b\xc5\x53\x8f\x0b\xaf\x03\xaf\x81\x22\xda\xe1\xb7\x4f\xf1\x0c\xd6\x0b\xe3\x3e\x78\xf0\x80\xdf\xe3\x8a\x7a\x4e\x4e\x4e\x03\x39\x41\x68\xa5\x5c\xd1\xd1\xd1\x04\xa3\x03\x80\xc4\x20\x2e\xae\x00\x9e\xe3\x1e\xed\x77\xee\xdc\xc9\xba\x84\x8c\x28\xb8\x17\xfa\x11\xfa\xc3\xc6\xc1\x46\xc5\x5c\x9a\xe2\x93\x90\xdf\x6d\x72\x61\x92\x4d\xee\x81\xc1\xd1\xb4\xe8\xa1\x95\xae\x97\xd5\x31\xf9\x08\xa7\xcf\x92\x0f\x49\xba\x26\xfe\xa0\x40\x8e\xef\xc6\xc5\x9a\x5d\xf6\x8d\x58\x0e\x6e\x06\x96\x0a\x8a\x81\xd2\x01\xec\xee\x75\xeb\xd6\x35\x30\xf3\xe8\x03\xc4\x84\x55\x41\x2c\x02\xa5\xf7\xed\xdb\x97\xc6\x8e\x1d\x4b\x6a\xf9\xf1\xde\x62\xb1\x70\xd0\x7e\xed\xda\x35\x56\x8c\x2b\x19\x94\x63\x4c\x9b\x36\x8d\xc6\x8f\x1f\xcf\xf7\xd7\xaf\x5f\xe7\xb6\xea\x76\x06\x83\x81\x26\x4e\x9c\xc8\xd6\x0b\x01\x3a\x2c\x03\x7e\x7f\x84\x15\x03\xe9\x60\x59\x00\x61\x65\x05\x60\xad\x12\x13\x13\x79\xf3\xc0\xd5\xc1\xda\x20\xae\xf2\x74\x5c\x67\xc0\x37\xe2\x3b\x8c\x07\x39\x60\x31\x27\x4d\x9a\xc4\x57\xd4\x41\xb0\x45\x8b\x16\xb1\x05\x04\x7a\xf7\xee\xcd\x71\xa6\x98\xfb\x80\x01\x03\xb8\x0e\xd9\xb1\x1e\x20\x38\xae\x20\x3f\x36\x19\xee\x5f\xbe\x7c\xc9\x16\x19\x72\x8e\x1b\x37\x8e\xdb\xc2\x1a\xee\xd8\xb1\x83\xc7\x12\x80\x5e\xe6\xcd\x9b\xc7\x6b\x03\x6f\xe5\x0e\x3a\xe5\x24\x9c\x15\x74\x64\x2b\xb7\x52\x82\xb1\x9a\xbe\x33\xeb\xf8\xb8\xc4\x2d\xe4\x6f\x92\x2c\x12\x45\x18\x5c\xf7\xfd\xe9\xd3\x27\x1a\x39\x72\x24\x4f\x00\x0b\xb9\x6c\xd9\x32\xce\xce\xe0\xbe\x4e\x9c\x38\xe1\x58\x38\x5c\xf1\x3d\x16\x3a\x36\x36\x96\xdd\x02\xda\x2a\x33\x32\xe5\x42\xe1\x39\xde\x83\x24\xea\x45\x12\x45\xd9\xb7\xb8\x07\x51\x41\x52\x14\x58\x06\x67\xed\xa0\x0b\xb8\x42\x4e\xaa\xe4\xc5\x02\x49\x90\x20\x60\x91\xe1\x4e\xb1\xc0\xb8\xef\xd6\xad\x5b\x83\x31\x90\x58\xa1\x2d\x80\x7b\x94\xe6\x8c\xab\x2c\xca\x4d\x23\x9e\xc1\xea\x8d\x19\x33\x86\xc9\x0c\xb9\x70\x85\x5c\x42\x4e\x10\x30\x2d\x2d\x8d\x7f\xa8\x57\xca\x05\x82\x88\x3a\x74\x06\xe2\xe1\x2a\xde\xe3\x1e\xe1\x01\x80\x75\x1a\x32\x64\x08\x17\xdc\x8b\x04\x51\xb4\xc7\x5a\x5a\xad\x56\xc7\x46\x77\x37\x07\x8f\x0e\x90\xa3\xfc\x0d\x64\xaf\x94\x2d\xde\x9b\x1a\x92\xfc\x4c\xf5\x56\xaf\x29\xde\xd9\xab\x29\x23\x21\xc8\xa1\x6c\xa7\xd9\xf2\xe7\x4c\xee\xe8\xd1\xa3\x6c\xd6\x47\x8c\x18\xc1\xbb\x6c\xea\xd4\xa9\x4c\x2e\xb5\x65\x02\x29\xb1\xb0\x70\x11\x20\x17\xac\xa4\x33\xcb\x25\xc6\x54\x3e\xf3\x24\x48\x56\x92\xb0\xa9\x76\x3d\x7b\xf6\xe4\x2b\xe2\x2a\x2c\x70\x4c\x4c\x0c\x0d\x1b\x36\x8c\x2e\x5e\xbc\xc8\x19\x23\x16\x58\x9d\x4c\x35\xd5\xaf\xa7\xe3\xba\xb3\xd8\x70\xe1\xc0\xde\xbd\x7b\x1d\x44\x40\x3c\x2a\xea\xb0\xb2\x6a\x4b\xea\x4e\x47\x78\x06\xf7\x2b\x2c\x33\xe2\x71\x25\xe0\x9d\x84\x95\x07\x69\x9b\x93\x8c\xb8\xb5\x78\x28\xe8\x34\xa3\x47\x04\xe5\x0c\x0f\xa7\xf4\x58\x3d\xc5\x18\x6b\x5d\x5b\x3e\x39\xa9\x58\x16\xef\x4b\x6d\x8d\x4d\xf7\x09\x82\x01\x9b\x37\x6f\xe6\x60\x1f\x41\xe9\xb6\x6d\xdb\xf8\x19\xdc\x92\x50\x92\xd8\xd9\x70\x47\xb0\x7a\xc8\xe6\x10\x8f\xad\x5c\xb9\xb2\xc1\x7b\x31\x69\xf5\xce\x52\x5a\x06\xb5\xc5\x73\xf5\x9d\xb3\xbe\x44\x41\x78\x80\xf8\x11\x24\x03\x90\x10\x61\x51\x45\x1d\x16\xd1\x59\xbf\xa2\x4f\x58\x1f\x10\xb6\xb9\xe3\xba\xfb\x06\xee\x1c\xb8\x75\xeb\x16\x6f\x6a\x58\x1e\x6c\x08\xd4\x85\x9b\x75\xd6\x5e\xd4\xe1\x4e\xe1\x6d\x84\x5c\xd8\xe4\x90\x15\xf1\x23\x36\xfd\xf1\xe3\xc7\x59\xff\x28\x48\xdc\xe0\xc2\x95\x96\xdb\x1d\x87\xd4\xeb\xe2\x36\xb9\x50\x06\xe4\x65\x72\x06\x58\x6d\xb3\x52\x69\x45\x15\xff\x34\xe6\x24\xa3\xa0\x29\xad\x75\x34\x29\xca\xe0\xd8\x01\xae\x0a\x88\x04\x05\x5d\xb8\x70\x81\xcf\xb2\xd2\xd3\xd3\x59\x49\xf3\xe7\xcf\xa7\xe4\xe4\xe4\x46\x16\x01\x16\x71\xcd\x9a\x35\x4c\x4a\x04\xb1\x20\x9f\x27\x16\xa4\xa9\x00\xd7\x99\xc5\x73\xd7\x16\x8a\x1b\x3e\x7c\xb8\xc3\x6a\x43\xf9\xc8\x4c\x05\xd4\xf1\x9d\x68\x87\xf8\x14\x0b\x88\xc4\xa4\xa8\xa8\xa8\xd9\xe3\xba\xb2\x8c\xa2\xc0\x8d\xc2\xda\x02\x90\x07\xcf\xe0\xf2\xf9\xe7\x4a\x79\xa3\x20\x8b\x76\x36\xce\xd0\xa1\x43\x39\x3c\x80\x75\x44\xb6\x89\x67\x58\x03\x10\x6c\xc3\x86\x0d\xbc\x8e\x38\x6f\x85\xce\x17\x2f\x5e\xcc\xe5\xe9\xd3\xa7\x7c\x72\x80\x8c\xdd\x53\xb9\xd5\x73\xc0\x5f\x20\xd7\x21\xbb\x71\x85\x9f\x72\x1e\xd1\x6f\xb6\x6a\x7a\x51\x61\xa7\x97\x76\x3d\xff\x42\xa1\x0b\x0a\x21\xc9\xe8\x57\x9f\x64\x7c\x86\x59\xb2\xd3\xdf\x3b\x9b\x68\x68\xb0\xc4\x24\x75\x07\x9c\x13\x41\x19\x58\x38\x61\xae\x11\xc3\xc1\xa2\xa0\x3d\x62\xb4\xa0\xa0\x20\xbe\x47\xac\x71\xee\xdc\x39\xba\x7f\xff\x3e\x07\xb1\xc8\x7a\x71\x3f\x77\xee\x5c\x9a\x32\x65\x0a\x93\x18\xdf\xa2\x0d\x94\x27\x12\x01\x65\x7b\x25\xa0\x30\x7f\x7f\x7f\x0e\x92\x01\xf5\x77\xea\xbe\xd4\x10\xed\x21\x3b\xfa\x80\xcc\xc8\x64\x01\x1c\x57\x40\xb9\xea\xf1\x71\xf4\x80\xf9\x8a\xf9\x21\x24\x68\xee\xb8\xca\x6f\x30\xae\xfa\x50\x1e\xfd\xe3\x58\x4a\xf4\x89\x63\x1b\xb8\x4a\x90\xa7\xac\xac\xac\xd1\xdc\xd1\x1e\xdf\xa0\x8e\xf5\x10\xcf\x50\xc7\x77\x00\x64\x11\xb2\x8b\x04\x50\xfc\xba\x84\x18\x51\xdd\x9f\x27\xc0\xe9\x05\x13\x4f\x1c\x44\x3a\x03\x06\x3c\x5a\x58\x4a\xe7\x8b\x2b\x29\xfb\x5d\x2d\x9f\xe3\x49\x7a\x43\xfd\x11\x8a\xac\xe0\xef\xcc\x12\x8d\x8f\x34\xd0\xf7\x11\x46\xd2\x55\xda\x9c\x1e\x16\xbb\x23\x20\x26\xe6\x2e\x4e\x40\x90\x8b\xdd\x86\xf7\x88\x67\x70\x64\x80\xb6\xeb\xd7\xaf\xe7\x43\x5a\x0d\xde\x87\x48\xd8\x9a\x1b\xcf\xa9\x81\x4c\x99\x89\x87\x73\xa5\xa6\x00\x56\x63\x27\xa1\xa8\x01\x96\x83\xf9\xca\x0c\xc9\x2b\x3f\xb1\xc8\xe4\xc4\x84\x11\x4b\xc1\x5a\x20\x03\xc4\x59\x20\xc6\xc7\x73\x0d\xff\x3b\x78\xf5\xea\x95\x67\x59\xad\x48\xf7\x7f\x4f\x80\x60\x88\x63\x10\x93\x88\x60\x56\x99\xce\x6b\xf8\x3f\xfb\xeb\x94\xaf\x09\x90\xd3\xd3\x38\x42\xc3\xd7\x4f\xbc\x32\x79\x41\xcd\x92\x24\x69\xda\xd0\xe0\x75\x7c\xf6\x50\x56\x10\xef\x9c\x9c\x01\xfd\x51\x64\x31\x1a\x34\x78\x13\x9f\x33\xf8\x2c\x10\x2f\xa3\xbc\xbc\x7c\x84\xcc\xc4\x60\xa4\xd6\x9a\xe5\xd3\xe0\x2d\x4b\x87\x04\xd4\x66\xb3\xe1\x3f\x74\x67\x80\x78\x79\x72\x49\x92\x1f\x64\xca\xe5\x7b\xf9\x3e\x48\x53\x93\x06\x2f\x00\x87\xa6\xd9\x72\x59\x9a\x98\x98\x98\xff\x6f\x01\x06\x00\x73\x80\x36\x91\x5f\x89\x3c\xc0\x00\x00\x00\x00\x49\x45\x4e\x44\xae\x42\x60\x82\x01\x00\x00\xff\xff\x1f\x55\xaf\xdf\x0e\x0b\x00\x00")
+var _imgSignInPng = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x0e\x0b\xf1\xf4\x89\x50\x4e\x47\x0d\x0a\x1a\x0a\x00\x00\x00\x0d\x49\x48\x44\x52\x00\x00\x00\x9e\x00\x00\x00\x1c\x08\x06\x00\x00\x00\x90\xfd\x06\xa8\x00\x00\x00\x19\x74\x45\x58\x74\x53\x6f\x66\x74\x77\x61\x72\x65\x00\x41\x64\x6f\x62\x65\x20\x49\x6d\x61\x67\x65\x52\x65\x61\x64\x79\x71\xc9\x65\x3c\x00\x00\x0a\xb0\x49\x44\x41\x54\x78\xda\xec\x5b\x69\x4c\x54\x59\x16\x3e\xaf\xa8\x2a\x28\x96\x2a\x90\x65\x10\x10\x54\x6c\x45\xdb\x1d\xb5\x71\xdf\x68\x82\x31\x9a\x38\x1a\x4c\xfb\xc3\xc4\xb8\x8c\x4b\x14\x6d\xa3\x19\xa5\xd5\x19\xa7\x45\x1d\x8d\x31\x1a\x8d\x31\x1a\x97\xe8\x0f\x97\xb8\xeb\xd8\xa0\xc6\x85\xb8\xc7\x18\x75\x64\x69\x75\x44\xb4\x55\xc4\x05\x2c\x0a\x59\x0a\xe6\x7d\x07\x6f\xcd\xe3\x51\x45\x15\x13\xca\x76\x26\xef\x4b\xae\xef\xdd\xf7\xde\xbd\xf7\xdc\x73\xbf\x7b\x96\x5b\x28\xdd\xbe\x7d\x9b\x64\x7c\x23\x97\x35\x72\x49\x96\x8b\x99\x34\x68\x68\x79\x94\xc9\xe5\x9c\x5c\xfe\x9c\x98\x98\xf8\xab\x24\x13\xaf\x93\x5c\xb9\x1e\x13\x13\x13\x1c\x1a\x1a\x4a\x3e\x3e\x3e\x9a\x8a\x34\xb4\x38\xec\x76\x3b\xbd\x7d\xfb\x96\x9e\x3f\x7f\xfe\x41\xae\x26\xe9\xe5\x7f\x32\x41\xba\x88\x88\x08\x4d\x3b\x1a\xbc\x06\x18\xb4\xcf\x1c\x0b\x96\xc9\x97\x09\xe2\x25\xb7\x6a\xd5\x8a\xea\xea\xea\x34\xed\x68\xf0\x3a\xe0\x55\x65\xe2\xa5\x80\x78\x66\x9d\x4e\xa7\x11\x4f\xc3\x17\x81\x24\x49\xb8\x04\x82\x78\x1a\xe9\x34\x7c\x71\x30\xf1\x6a\x6b\x6b\x35\x4d\x68\xf8\xf2\xc4\x73\x67\xf1\xce\xfe\xfa\x1b\xa5\x7e\x13\xe5\xf2\xfd\x3f\x8b\x4b\x69\xda\x99\xbb\x54\x54\x5e\x83\xde\x28\x29\x32\x88\xfe\x3a\x38\x81\xbe\x8d\xb0\xd0\xb5\xa2\x12\xfe\xa6\x7f\x9b\x30\x4d\xdb\x1a\x3c\x27\xde\xb5\xe7\x6f\xe9\xc7\x4b\x05\x54\x27\xe9\x28\x35\xfe\x0f\x8d\xde\x17\x95\xd9\x28\xed\x1f\xb9\x64\x35\x85\x93\x3e\xd8\x84\xce\xe8\x56\x65\x05\xa5\xfd\x92\x4f\x31\x7e\xec\xcf\xe9\xc7\xc4\x38\xcd\x9d\x6b\x68\x9e\xab\x7d\xf8\xfe\x13\x95\x07\x84\xd2\x9f\xae\x3c\xa3\x9f\x4a\x6d\x34\xb5\x67\x5c\x83\xf7\x87\x0a\x8a\xc9\x1a\x18\x4a\x3a\x73\x08\x49\x3e\x06\x7e\x56\x57\x53\x4d\xe5\x95\x36\xca\xaf\xae\xa2\x20\xc9\x4e\xbf\x14\x95\x52\x72\x5c\xa8\xd3\xfe\xef\xdc\xb9\x43\xaf\x5f\xbf\xe6\xfb\xae\x5d\xbb\x52\x9b\x36\x6d\xf8\xbe\xa2\xa2\x82\x0a\x0b\x0b\x29\x3c\x3c\x9c\x33\xa1\x96\x04\xce\x93\xde\xbc\x79\x43\x71\x71\x71\x64\x32\x99\xbc\xa2\x58\x77\xf2\x37\x67\x7e\x42\x5e\x57\x48\x48\x48\xf8\xaa\xe6\xde\x22\x16\x2f\xb5\x7d\x38\xfd\x5c\x58\x4b\x52\xa0\x85\x7e\x7e\x58\x42\x59\xcf\xde\xd1\xfc\x5e\x6d\x28\x29\xa6\x5e\x59\x79\xd6\x5a\xd2\xf9\x07\x91\xa4\x37\xfe\x27\x73\x31\x18\xe5\xba\x9e\xea\xec\x35\x34\x3d\xce\x40\xe9\x9d\x82\xa9\xba\xba\xba\x91\xe2\x57\xac\x58\x41\x37\x6e\xdc\x68\xf0\x3c\x25\x25\x85\x32\x32\x32\x78\x51\x66\xcc\x98\x41\x4b\x96\x2c\xa1\xd4\xd4\xd4\x16\x9d\xf4\xcd\x9b\x37\x69\xf5\xea\xd5\xb4\x7d\xfb\x76\xea\xd4\xa9\x93\x57\x14\xab\x96\xff\xca\x95\x2b\x74\xf9\xf2\x65\x9a\x3c\x79\x32\x6f\xae\xe6\xcc\x4f\xc8\xeb\x0a\x97\x2e\x5d\xfa\xaf\xe7\x7e\xf8\xf0\x61\xca\xcf\xcf\x67\x9d\x0b\xac\x5a\xb5\x8a\xdf\x4d\x98\x30\xe1\xf7\x23\x5e\x4d\x4d\x0d\x6d\xe8\x15\x46\x0b\x73\x6d\xe4\x13\x1a\x49\xb7\xac\xa5\xf4\xc3\x85\x7f\x51\xb4\xfe\x11\x25\x45\x87\xd0\x47\x5d\x00\x91\x8f\xde\x59\xe2\x4c\xfd\x2d\x3a\x9a\xd7\xd1\x42\x55\x55\x55\x8d\xde\x1e\x38\x70\x80\x49\xb7\x7c\xf9\x72\x1a\x3d\x7a\x34\x95\x94\x94\xf0\x84\xb3\xb2\xb2\x68\xf0\xe0\xc1\xd4\xaf\x5f\x3f\xda\xbd\x7b\x37\x85\x84\x84\xb4\xb8\x9b\x4e\x4a\x4a\xe2\xbe\x5b\xb7\x6e\xed\xb5\x10\x00\x16\x45\x29\x3f\x36\x1a\xe6\x86\xc5\x54\x8e\xe9\xc9\x51\x56\x7c\x7c\x3c\xeb\x89\x3d\xcc\xa1\x43\x94\x9b\x9b\x4b\xd3\xa7\x4f\x67\xf9\x9b\x7b\x2a\xa1\x9e\x7b\x41\x41\x01\xcb\xb5\x74\xe9\x52\xc7\x37\xa8\xe3\xd8\xc3\x9b\xe1\x91\x8f\xbc\xeb\xfe\x22\x0e\x90\x9d\x95\xac\xa7\x25\xf4\xb7\xfb\x6f\xc9\xcf\x3f\x80\xaa\xf4\xbe\x24\xf9\x9a\x48\x67\x0a\x20\xab\x8f\x2f\xe5\x56\x10\xbd\xa8\x33\x92\xce\xe0\x0b\x0d\x36\xec\xb9\xd6\x4e\x09\xfa\x4a\x4a\x89\x30\x3a\xed\xf7\xec\xd9\xb3\xf4\xf8\xf1\x63\x1a\x38\x70\x20\x5b\x00\xa3\xd1\x48\xed\xdb\xb7\xa7\xee\xdd\xbb\x53\x54\x54\x14\x55\x56\x56\xd2\xfe\xfd\xfb\xc9\xcf\xcf\x8f\xdf\xa3\x0d\x76\xe7\x9c\x39\x73\xe8\xd4\xa9\x53\x14\x19\x19\x49\xfb\xf6\xed\x63\x37\xdd\xb9\x73\x67\x7e\x77\xec\xd8\x31\x1e\x7a\xe1\xc2\x85\xb4\x75\xeb\x56\x6e\xd3\xa3\x47\x8f\x46\x63\xe3\xf7\xe9\xd3\xa7\x4f\xf3\x82\x7e\xf8\xf0\x81\xb6\x6c\xd9\x42\xe5\xe5\xe5\x74\xf0\xe0\x41\x5e\xe0\xab\x57\xaf\x52\x87\x0e\x1d\xd8\x05\x2a\xdb\xe5\xe5\xe5\xb1\xa5\xc0\xb7\x68\x8b\xcd\xb2\x71\xe3\x46\x87\x0c\xf8\x26\x33\x33\x93\xee\xde\xbd\xcb\x32\x0b\xf9\x61\xdd\xb0\xd1\xe0\xe6\x8a\x8b\x8b\xc9\xd7\xd7\x97\x02\x03\x03\xe9\xe4\xc9\x93\xd4\xab\x57\x2f\x3a\x72\xe4\x88\x63\x5c\xcc\xdf\x6c\x36\x37\x18\x37\x2c\x2c\x8c\xe5\xc1\x98\xf7\xee\xdd\xa3\x47\x8f\x1e\xd1\xec\xd9\xb3\x79\x73\xe2\xd9\x99\x33\x67\x58\xf6\x80\x80\x00\xd6\x0b\xc2\x97\x5d\xbb\x76\xb1\x2e\x20\xc7\xb3\x67\xcf\x1c\x73\x7c\xff\xfe\xbd\x63\xee\x20\xd8\xf9\xf3\xe7\xf9\xf9\x8b\x17\x2f\x78\xb3\xe0\xbb\x27\x4f\x9e\xd0\xc7\x8f\x1f\x99\x94\x83\x06\x0d\x22\x9b\xcd\xc6\x73\x59\xb0\x60\x01\x93\x16\x32\x75\xec\xd8\x91\xf4\xb2\x57\x83\x25\xc7\x3a\x08\x4b\x09\xf9\xd0\xc6\x15\x9f\x50\xde\xbd\x7b\x47\x3a\xb1\x63\x5c\x15\xb8\x5a\x8b\xbf\x1f\x95\xd5\xd4\x5b\x31\xb8\x54\xc9\x14\x48\x3a\x8b\x1c\xd7\x59\xc2\x48\xe7\x27\x5b\x3c\x5d\xe3\xdf\x77\xeb\xe4\xb8\xb1\x73\xa0\x8f\xcb\x7e\xfb\xf4\xe9\xc3\xdf\x61\xa1\x66\xce\x9c\xc9\x8a\xc2\xe4\x21\x34\x26\x85\xc9\x42\x31\xb8\x0a\xa2\x42\x29\xa3\x46\x8d\xa2\x59\xb3\x66\xb1\x22\xb2\xb3\xb3\x79\x11\xf0\x1e\x57\xd4\xa1\x54\xbc\xef\xdf\xbf\x3f\xed\xd9\xb3\x87\x15\xa3\x1e\x5b\xd9\x37\x2c\x11\xda\xad\x5d\xbb\x96\xba\x74\xe9\xc2\xca\x85\xc2\x41\x28\x75\xbb\xb6\x6d\xdb\xf2\xb7\x20\x38\xea\xb0\x3c\xa8\x43\x2e\x41\x4c\xd4\x83\x83\x83\x1b\x8c\x01\x32\xb4\x6b\xd7\xce\x11\xc7\xa2\x2e\xac\xc9\xa6\x4d\x9b\x1a\x8c\x8b\xc5\x53\x8f\x0b\xaf\x03\xaf\x81\x22\xda\xe1\xb7\x4f\xf1\x0c\xd6\x0b\xe3\x3e\x78\xf0\x80\xdf\xe3\x8a\x7a\x4e\x4e\x4e\x03\x39\x41\x68\xa5\x5c\xd1\xd1\xd1\x04\xa3\x03\x80\xc4\x20\x2e\xae\x00\x9e\xe3\x1e\xed\x77\xee\xdc\xc9\xba\x84\x8c\x28\xb8\x17\xfa\x11\xfa\xc3\xc6\xc1\x46\xc5\x5c\x9a\xe2\x93\x90\xdf\x6d\x72\x61\x92\x4d\xee\x81\xc1\xd1\xb4\xe8\xa1\x95\xae\x97\xd5\x31\xf9\x08\xa7\xcf\x92\x0f\x49\xba\x26\xfe\xa0\x40\x8e\xef\xc6\xc5\x9a\x5d\xf6\x8d\x58\x0e\x6e\x06\x96\x0a\x8a\x81\xd2\x01\xec\xee\x75\xeb\xd6\x35\x30\xf3\xe8\x03\xc4\x84\x55\x41\x2c\x02\xa5\xf7\xed\xdb\x97\xc6\x8e\x1d\x4b\x6a\xf9\xf1\xde\x62\xb1\x70\xd0\x7e\xed\xda\x35\x56\x8c\x2b\x19\x94\x63\x4c\x9b\x36\x8d\xc6\x8f\x1f\xcf\xf7\xd7\xaf\x5f\xe7\xb6\xea\x76\x06\x83\x81\x26\x4e\x9c\xc8\xd6\x0b\x01\x3a\x2c\x03\x7e\x7f\x84\x15\x03\xe9\x60\x59\x00\x61\x65\x05\x60\xad\x12\x13\x13\x79\xf3\xc0\xd5\xc1\xda\x20\xae\xf2\x74\x5c\x67\xc0\x37\xe2\x3b\x8c\x07\x39\x60\x31\x27\x4d\x9a\xc4\x57\xd4\x41\xb0\x45\x8b\x16\xb1\x05\x04\x7a\xf7\xee\xcd\x71\xa6\x98\xfb\x80\x01\x03\xb8\x0e\xd9\xb1\x1e\x20\x38\xae\x20\x3f\x36\x19\xee\x5f\xbe\x7c\xc9\x16\x19\x72\x8e\x1b\x37\x8e\xdb\xc2\x1a\xee\xd8\xb1\x83\xc7\x12\x80\x5e\xe6\xcd\x9b\xc7\x6b\x03\x6f\xe5\x0e\x3a\xe5\x24\x9c\x15\x74\x64\x2b\xb7\x52\x82\xb1\x9a\xbe\x33\xeb\xf8\xb8\xc4\x2d\xe4\x6f\x92\x2c\x12\x45\x18\x5c\xf7\xfd\xe9\xd3\x27\x1a\x39\x72\x24\x4f\x00\x0b\xb9\x6c\xd9\x32\xce\xce\xe0\xbe\x4e\x9c\x38\xe1\x58\x38\x5c\xf1\x3d\x16\x3a\x36\x36\x96\xdd\x02\xda\x2a\x33\x32\xe5\x42\xe1\x39\xde\x83\x24\xea\x45\x12\x45\xd9\xb7\xb8\x07\x51\x41\x52\x14\x58\x06\x67\xed\xa0\x0b\xb8\x42\x4e\xaa\xe4\xc5\x02\x49\x90\x20\x60\x91\xe1\x4e\xb1\xc0\xb8\xef\xd6\xad\x5b\x83\x31\x90\x58\xa1\x2d\x80\x7b\x94\xe6\x8c\xab\x2c\xca\x4d\x23\x9e\xc1\xea\x8d\x19\x33\x86\xc9\x0c\xb9\x70\x85\x5c\x42\x4e\x10\x30\x2d\x2d\x8d\x7f\xa8\x57\xca\x05\x82\x88\x3a\x74\x06\xe2\xe1\x2a\xde\xe3\x1e\xe1\x01\x80\x75\x1a\x32\x64\x08\x17\xdc\x8b\x04\x51\xb4\xc7\x5a\x5a\xad\x56\xc7\x46\x77\x37\x07\x8f\x0e\x90\xa3\xfc\x0d\x64\xaf\x94\x2d\xde\x9b\x1a\x92\xfc\x4c\xf5\x56\xaf\x29\xde\xd9\xab\x29\x23\x21\xc8\xa1\x6c\xa7\xd9\xf2\xe7\x4c\xee\xe8\xd1\xa3\x6c\xd6\x47\x8c\x18\xc1\xbb\x6c\xea\xd4\xa9\x4c\x2e\xb5\x65\x02\x29\xb1\xb0\x70\x11\x20\x17\xac\xa4\x33\xcb\x25\xc6\x54\x3e\xf3\x24\x48\x56\x92\xb0\xa9\x76\x3d\x7b\xf6\xe4\x2b\xe2\x2a\x2c\x70\x4c\x4c\x0c\x0d\x1b\x36\x8c\x2e\x5e\xbc\xc8\x19\x23\x16\x58\x9d\x4c\x35\xd5\xaf\xa7\xe3\xba\xb3\xd8\x70\xe1\xc0\xde\xbd\x7b\x1d\x44\x40\x3c\x2a\xea\xb0\xb2\x6a\x4b\xea\x4e\x47\x78\x06\xf7\x2b\x2c\x33\xe2\x71\x25\xe0\x9d\x84\x95\x07\x69\x9b\x93\x8c\xb8\xb5\x78\x28\xe8\x34\xa3\x47\x04\xe5\x0c\x0f\xa7\xf4\x58\x3d\xc5\x18\x6b\x5d\x5b\x3e\x39\xa9\x58\x16\xef\x4b\x6d\x8d\x4d\xf7\x09\x82\x01\x9b\x37\x6f\xe6\x60\x1f\x41\xe9\xb6\x6d\xdb\xf8\x19\xdc\x92\x50\x92\xd8\xd9\x70\x47\xb0\x7a\xc8\xe6\x10\x8f\xad\x5c\xb9\xb2\xc1\x7b\x31\x69\xf5\xce\x52\x5a\x06\xb5\xc5\x73\xf5\x9d\xb3\xbe\x44\x41\x78\x80\xf8\x11\x24\x03\x90\x10\x61\x51\x45\x1d\x16\xd1\x59\xbf\xa2\x4f\x58\x1f\x10\xb6\xb9\xe3\xba\xfb\x06\xee\x1c\xb8\x75\xeb\x16\x6f\x6a\x58\x1e\x6c\x08\xd4\x85\x9b\x75\xd6\x5e\xd4\xe1\x4e\xe1\x6d\x84\x5c\xd8\xe4\x90\x15\xf1\x23\x36\xfd\xf1\xe3\xc7\x59\xff\x28\x48\xdc\xe0\xc2\x95\x96\xdb\x1d\x87\xd4\xeb\xe2\x36\xb9\x50\x06\xe4\x65\x72\x06\x58\x6d\xb3\x52\x69\x45\x15\xff\x34\xe6\x24\xa3\xa0\x29\xad\x75\x34\x29\xca\xe0\xd8\x01\xae\x0a\x88\x04\x05\x5d\xb8\x70\x81\xcf\xb2\xd2\xd3\xd3\x59\x49\xf3\xe7\xcf\xa7\xe4\xe4\xe4\x46\x16\x01\x16\x71\xcd\x9a\x35\x4c\x4a\x04\xb1\x20\x9f\x27\x16\xa4\xa9\x00\xd7\x99\xc5\x73\xd7\x16\x8a\x1b\x3e\x7c\xb8\xc3\x6a\x43\xf9\xc8\x4c\x05\xd4\xf1\x9d\x68\x87\xf8\x14\x0b\x88\xc4\xa4\xa8\xa8\xa8\xd9\xe3\xba\xb2\x8c\xa2\xc0\x8d\xc2\xda\x02\x90\x07\xcf\xe0\xf2\xf9\xe7\x4a\x79\xa3\x20\x8b\x76\x36\xce\xd0\xa1\x43\x39\x3c\x80\x75\x44\xb6\x89\x67\x58\x03\x10\x6c\xc3\x86\x0d\xbc\x8e\x38\x6f\x85\xce\x17\x2f\x5e\xcc\xe5\xe9\xd3\xa7\x7c\x72\x80\x8c\xdd\x53\xb9\xd5\x73\xc0\x5f\x20\xd7\x21\xbb\x71\x85\x9f\x72\x1e\xd1\x6f\xb6\x6a\x7a\x51\x61\xa7\x97\x76\x3d\xff\x42\xa1\x0b\x0a\x21\xc9\xe8\x57\x9f\x64\x7c\x86\x59\xb2\xd3\xdf\x3b\x9b\x68\x68\xb0\xc4\x24\x75\x07\x9c\x13\x41\x19\x58\x38\x61\xae\x11\xc3\xc1\xa2\xa0\x3d\x62\xb4\xa0\xa0\x20\xbe\x47\xac\x71\xee\xdc\x39\xba\x7f\xff\x3e\x07\xb1\xc8\x7a\x71\x3f\x77\xee\x5c\x9a\x32\x65\x0a\x93\x18\xdf\xa2\x0d\x94\x27\x12\x01\x65\x7b\x25\xa0\x30\x7f\x7f\x7f\x0e\x92\x01\xf5\x77\xea\xbe\xd4\x10\xed\x21\x3b\xfa\x80\xcc\xc8\x64\x01\x1c\x57\x40\xb9\xea\xf1\x71\xf4\x80\xf9\x8a\xf9\x21\x24\x68\xee\xb8\xca\x6f\x30\xae\xfa\x50\x1e\xfd\xe3\x58\x4a\xf4\x89\x63\x1b\xb8\x4a\x90\xa7\xac\xac\xac\xd1\xdc\xd1\x1e\xdf\xa0\x8e\xf5\x10\xcf\x50\xc7\x77\x00\x64\x11\xb2\x8b\x04\x50\xfc\xba\x84\x18\x51\xdd\x9f\x27\xc0\xe9\x05\x13\x4f\x1c\x44\x3a\x03\x06\x3c\x5a\x58\x4a\xe7\x8b\x2b\x29\xfb\x5d\x2d\x9f\xe3\x49\x7a\x43\xfd\x11\x8a\xac\xe0\xef\xcc\x12\x8d\x8f\x34\xd0\xf7\x11\x46\xd2\x55\xda\x9c\x1e\x16\xbb\x23\x20\x26\xe6\x2e\x4e\x40\x90\x8b\xdd\x86\xf7\x88\x67\x70\x64\x80\xb6\xeb\xd7\xaf\xe7\x43\x5a\x0d\xde\x87\x48\xd8\x9a\x1b\xcf\xa9\x81\x4c\x99\x89\x87\x73\xa5\xa6\x00\x56\x63\x27\xa1\xa8\x01\x96\x83\xf9\xca\x0c\xc9\x2b\x3f\xb1\xc8\xe4\xc4\x84\x11\x4b\xc1\x5a\x20\x03\xc4\x59\x20\xc6\xc7\x73\x0d\xff\x3b\x78\xf5\xea\x95\x67\x59\xad\x48\xf7\x7f\x4f\x80\x60\x88\x63\x10\x93\x88\x60\x56\x99\xce\x6b\xf8\x3f\xfb\xeb\x94\xaf\x09\x90\xd3\xd3\x38\x42\xc3\xd7\x4f\xbc\x32\x79\x41\xcd\x92\x24\x69\xda\xd0\xe0\x75\x7c\xf6\x50\x56\x10\xef\x9c\x9c\x01\xfd\x51\x64\x31\x1a\x34\x78\x13\x9f\x33\xf8\x2c\x10\x2f\xa3\xbc\xbc\x7c\x84\xcc\xc4\x60\xa4\xd6\x9a\xe5\xd3\xe0\x2d\x4b\x87\x04\xd4\x66\xb3\xe1\x3f\x74\x67\x80\x78\x79\x72\x49\x92\x1f\x64\xca\xe5\x7b\xf9\x3e\x48\x53\x93\x06\x2f\x00\x87\xa6\xd9\x72\x59\x9a\x98\x98\x98\xff\x6f\x01\x06\x00\x73\x80\x36\x91\x5f\x89\x3c\xc0\x00\x00\x00\x00\x49\x45\x4e\x44\xae\x42\x60\x82\x03\x00\x1f\x55\xaf\xdf\x0e\x0b\x00\x00")
 
 func imgSignInPngBytes() ([]byte, error) {
 	return bindataRead(
@@ -254,12 +259,12 @@ func imgSignInPng() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "img/sign-in.png", size: 2830, mode: os.FileMode(493), modTime: time.Unix(1609252321, 0)}
+	info := bindataFileInfo{name: "img/sign-in.png", size: 2830, mode: os.FileMode(509), modTime: time.Unix(1610636534, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _imgTweethingzLogoSvg = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x54\x5d\x6f\x1b\x37\x10\xfc\x2b\xc2\xf5\x75\x39\xe2\x7e\x71\xc9\x54\x52\xe0\xe6\xa5\x0f\xe9\x1f\xc8\x4b\x21\xc8\x8a\x63\x40\xb6\x03\x4b\x90\x1d\x14\xfd\xef\xc5\xde\x29\x01\x8a\xc3\x91\x77\x58\xee\x72\x66\x67\xc8\xcd\xc7\xf7\xa7\xd3\xea\x7a\x7c\x3d\x3f\xbe\x3c\x6f\x27\x46\x9d\x56\x1f\x77\x9b\xf3\xf5\x61\x75\xbf\xbf\xec\xcb\xf3\xfe\xe9\xb8\x9d\x3e\xef\x7f\x1c\x5f\x57\x3c\xad\x1e\xef\x6f\x3f\x7f\xf3\xb4\xba\x3e\x1e\xdf\xfe\x78\x79\xdf\x4e\x75\x55\x57\x5e\xe7\x77\x5a\xbd\x3f\x9d\x9e\xcf\xdb\xe9\xdb\xe5\xf2\xfd\xc3\x7a\xfd\xf6\xf6\x86\x37\xc5\xcb\xeb\xc3\x5a\x6a\xad\xeb\xf3\xf5\x61\xda\x6d\xee\x8f\x5f\xcf\xbb\xcd\xf9\xf2\xe3\x74\xdc\xe1\x70\x3a\x17\xfe\xe7\xeb\xe3\xe9\xf4\xe1\x37\xe9\xf9\xfc\xfe\xef\x66\xbd\x04\x37\xeb\x65\xe9\xe5\xf1\x72\x3a\xae\x77\x9b\xef\xfb\xcb\xb7\xd5\xe1\xb4\x3f\x9f\xb7\xd3\x9c\x37\xad\xee\xb7\xd3\x5f\xd6\x0d\xdd\x89\xc5\x10\xb6\x67\x33\x70\xd0\x6d\xaa\xf9\x14\x81\x4a\x11\x87\x8c\x43\x61\x0c\x2b\x5c\xc1\xa3\x18\x5a\x14\xa9\x60\x29\x03\xee\x45\x06\x54\xef\xb8\x32\xba\xd1\x6d\x9a\x0b\x90\xb9\x42\x07\x99\xed\x47\x80\x8d\x96\x71\x29\x6e\x82\x68\x45\x0c\x76\x28\x6c\xe8\x5a\xac\x68\x2d\x86\x6e\xc5\x1c\xc2\xf9\x29\x7b\x58\x83\xb5\x39\x87\x0b\xea\x28\x10\xfd\x93\xd5\xe0\xe3\x50\x09\xb5\x53\x25\x70\x2e\x80\x68\xe9\x68\x0e\x6e\x85\x03\x2a\xb9\x5a\x1c\x43\x88\xc1\xed\x8e\x45\xb3\xd2\x6d\x5a\x00\x76\x26\x91\x1b\x32\xeb\xb7\xf1\x86\xdd\x20\x4e\xd6\x20\x6d\xc6\xed\xb7\x71\x89\xf2\x40\xeb\xd4\x07\x38\x0e\x45\x31\x8c\xd8\x10\x92\x98\x49\x06\x42\x67\xf4\x59\xa4\xfb\xe7\x0c\x91\x36\x47\x1b\xd7\x7a\x80\x74\xe2\x0a\x73\x68\x90\x30\x09\x58\x49\x19\xda\x88\xd1\x83\x98\xc9\xe0\x46\xc2\x08\xa6\x81\x66\x19\x6d\x63\xee\xb0\x06\xdd\xa6\x05\x87\x1b\x22\xc8\x9a\xee\x07\x27\xd3\x65\x5c\x62\xd2\xa1\x4c\xec\x50\x3f\xb0\x83\x85\x0c\xbd\x93\x56\x84\x50\x43\xcc\xec\xdc\xa9\xa5\x60\x03\xbd\xa3\xb6\x24\x16\x96\x79\x32\xd0\x04\x12\x14\x8c\xb0\x02\x25\x36\x85\x0d\xb8\x90\xb0\x67\xb7\x61\xb6\xe7\x36\xa0\x42\xb7\xe9\xd7\xc6\xa2\x45\xef\x86\xa3\x31\x2d\xe3\x4f\x43\xe4\x3b\x12\x4e\xf4\x94\xbd\x76\x92\x06\xd3\x92\x0d\x48\x9e\x62\xc5\x53\x39\x52\xb0\x17\x56\x78\x7e\x0e\x2d\x12\xd0\x4e\x86\x1a\xc5\x18\xc2\xd7\x92\x06\xfa\x64\xdd\x33\xb1\x31\x7a\xa3\xd9\xd2\x83\x58\x22\x29\xfd\xcf\xe0\x5f\x9e\xca\xa8\xe4\x0c\xf7\xb3\xc3\x94\x62\xa0\x8d\xa2\x9e\xfd\x63\x69\x50\x3d\xcc\x9e\xef\x73\x4b\x2a\x38\xca\x88\x6c\x74\xaf\x09\x27\x63\x03\x96\xc5\x53\x5d\x4e\x05\xdb\xac\xe3\x2c\x45\xc9\xae\x0e\x98\x97\x18\xc9\x41\xe6\x0c\x4a\xcd\x1b\x05\x52\xb5\x40\x44\xd2\x5c\xd6\x5b\xcb\xa3\x94\x09\x2d\x60\x51\xac\x63\x49\x11\x49\xc5\x19\x9d\x49\x05\xad\x48\x1a\x30\x33\x7c\x6e\xd8\xb0\xe2\x01\xe7\x12\x9a\x4b\xe6\x8c\x8a\xae\x09\xa5\x07\xcd\xf5\xfb\xcd\xa7\xc5\xb5\x68\x2f\xa2\x79\x4a\x93\xcc\xe2\xde\xa8\xe0\x4e\xd1\x11\x83\xd8\x67\xfb\x87\xfd\xdc\x85\x05\xad\x17\x37\x68\x36\x10\xa3\x74\x81\x2d\xfb\xb8\xa2\xf7\xc2\x0e\xa3\x68\x69\x03\x0e\xc8\x12\x52\x43\x8f\xa2\x79\x75\x2c\xc4\xb8\x41\x17\xc5\x53\xe2\x19\x5b\xf6\x44\x47\xde\x07\xbf\x68\x96\xd9\xf5\x1a\x48\x6e\xf5\x93\xe5\xc9\xb0\x9a\xa5\x4d\x02\x4e\xec\x06\x6f\xa4\xc3\x30\x88\xa3\x41\xc6\x97\x69\xbd\xdb\xe4\x8d\xb8\xfb\x2f\x00\x00\xff\xff\x61\xf1\x42\xc6\x86\x05\x00\x00")
+var _imgTweethingzLogoSvg = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x54\xdb\x6e\x1b\x37\x10\xfd\x15\x61\xfb\x3a\x73\xc4\xb9\x90\x43\xa6\x92\x02\x37\x2f\x7d\x48\x7f\x20\x2f\x85\x20\x2b\x8e\x01\xd9\x0e\x2c\x41\x76\x50\xf4\xdf\x8b\x59\x6d\x8a\x16\xc6\x92\xeb\x9d\x0b\xe7\x5c\xa8\xcd\xc7\xf7\xa7\xd3\xea\x7a\x7c\x3d\x3f\xbe\x3c\x6f\x27\x41\x99\x56\x1f\x77\x9b\xf3\xf5\x61\x75\xbf\xbf\xec\xf9\x79\xff\x74\xdc\x4e\x9f\xf7\x3f\x8e\xaf\x2b\x99\x56\x8f\xf7\xcb\x3f\x7f\xca\xb4\xba\x3e\x1e\xdf\x7e\x7b\x79\xdf\x4e\x65\x55\x56\xb5\xcc\xcf\xb4\x7a\x7f\x3a\x3d\x9f\xb7\xd3\xb7\xcb\xe5\xfb\x87\xf5\xfa\xed\xed\x0d\x6f\x86\x97\xd7\x87\xb5\x96\x52\xd6\xe7\xeb\xc3\xb4\xdb\xdc\x1f\xbf\x9e\x77\x9b\xf3\xe5\xc7\xe9\xb8\xc3\xe1\x74\x66\xf9\xeb\xeb\xe3\xe9\xf4\xe1\x17\xed\xf9\xf7\xeb\xdf\x9b\xf5\x2d\xb8\x59\xdf\x52\x2f\x8f\x97\xd3\x71\xbd\xdb\x7c\xdf\x5f\xbe\xad\x0e\xa7\xfd\xf9\xbc\x9d\xe6\xba\x69\x75\xbf\x9d\xfe\xf0\xee\xe8\x95\x44\x1d\xe1\x7b\x71\x87\x04\x2d\x5b\xa1\x42\x85\x15\xa6\xac\x15\x3a\x0e\x2c\x18\xce\x52\x20\x83\x1d\x2d\x58\x0b\x44\x79\xa0\x56\xd6\x01\xb3\x3b\x29\x82\xee\xb4\x6c\x73\x03\xf2\x6a\xb0\x41\xee\xfb\x11\x10\xa7\xdb\x9a\x91\xc2\xae\x88\xc6\xea\xf0\x03\x8b\xa3\x1b\x3b\x5b\x61\x47\x77\xf6\x0a\x95\x7c\xd5\x3d\xbc\xc1\x5b\x56\x90\x30\xca\x60\xa8\xfd\x2e\xe6\xa8\xe3\x50\x08\xa5\x53\x21\x48\x26\x40\x8d\x3b\x5a\x85\x34\x96\x80\x69\x66\x6b\xc5\x50\x12\x48\xbb\x13\xb5\xec\xb4\x6c\xd9\xb0\x50\x17\x52\x5d\x26\xf3\xbe\xac\xcb\xec\x0e\xad\xe4\x0d\xda\xf2\xbb\xd4\x65\xcd\x58\x21\x19\x68\x9d\xfa\x80\xc4\x81\x0d\xc3\x49\x1c\xa1\x39\x33\xe9\x40\x58\xbe\x29\x79\x52\xfc\x39\x43\x64\xad\xa2\x8d\x6b\x39\x40\x3b\x49\x81\x57\x58\x90\x0a\x29\xc4\xc8\x04\xd6\x48\xd0\x83\x44\xc8\x51\x9d\x54\x10\x42\x03\xcd\x33\xda\xc6\xcc\xb0\x05\x2d\x5b\x4e\x51\xa8\x3a\x22\xc8\x9b\xed\x87\x24\xd2\xdb\x9a\x91\x42\xda\x61\x42\x52\x61\xf5\x20\x15\xa2\xe4\xe8\x9d\xac\x20\x94\x1a\x62\x46\x57\x2b\xb5\xd4\x6d\xa0\x77\x94\x96\xc0\xc2\xb3\x4e\x07\x9a\x42\x83\x42\x10\xce\x30\x12\x37\xf8\x40\x55\x52\xa9\xc9\x36\xdc\xf7\xd2\x06\x4c\x69\xd9\xfe\x3d\x58\x8d\xed\x6e\x54\x34\xa1\xdb\xfa\xd3\x10\xf9\x8c\x1c\x27\x7a\xca\x5e\x3a\x69\x83\x1b\x27\x01\x89\x53\x9d\x6b\x2a\x47\x06\xa9\x2c\x86\x3a\xc8\x30\x8c\x35\x60\x9d\x1c\x25\xd8\x05\x2a\x57\x4e\x03\x7d\xf2\x5e\xb3\xb0\x09\x7a\xa3\xd9\xd2\x83\x44\x23\x21\xfd\xcf\xe0\x5f\x9e\x78\x14\xaa\x82\x5a\xcf\x15\x6e\x14\x03\x6d\xb0\xd5\xe4\x4f\xb4\xc1\xec\x90\xb6\xd4\x3e\x53\x52\x20\xc1\x23\x92\xe8\x5e\x72\x9c\x8c\x0d\x78\x36\x4f\x75\x25\x15\x6c\xb3\x8e\xb3\x14\x9c\xac\x0e\x78\xe5\x18\x50\x67\x9d\x2b\x28\x35\x6f\x14\x48\xd5\x02\x11\x09\xf3\x96\xef\x2d\xaf\x52\x16\xb4\x80\x07\x7b\xc7\xad\x44\x35\x15\x17\x74\x21\x53\x34\xd6\x34\x60\x56\xd4\x99\xb0\xe1\x5c\x03\x55\x38\x2c\x53\xe6\x8a\x82\x6e\x39\x4a\x8f\x64\x30\xa8\x2f\x3e\xe5\x6a\x6c\x9d\xd5\xf2\x96\x26\x98\xf9\x2b\x45\x81\x74\x8a\x8e\x18\x24\x75\xb6\x7f\xf8\xcf\x53\x44\xd1\x3a\x57\x87\x25\x81\x18\xdc\x15\x7e\x3b\xa7\x1a\x7a\x67\xa9\x70\x8a\x96\x36\x90\x80\xde\x42\xe6\xe8\xc1\x96\xbf\x20\x37\x60\xd2\x60\x73\x64\x96\x78\x9e\x2d\x39\xb1\x01\xfb\x0f\x4c\x9e\x5d\x6f\x81\xc4\x56\x3e\x79\xde\x0c\x2f\xd9\xda\x35\x50\x49\xaa\xa3\x36\xb2\xe1\x18\x24\xd1\xa0\xe3\xcb\xb4\xde\x6d\xd6\xe7\xeb\xc3\xee\x9f\x01\x00\x61\xf1\x42\xc6\x86\x05\x00\x00")
 
 func imgTweethingzLogoSvgBytes() ([]byte, error) {
 	return bindataRead(
@@ -274,12 +279,12 @@ func imgTweethingzLogoSvg() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "img/tweethingz-logo.svg", size: 1414, mode: os.FileMode(420), modTime: time.Unix(1609252321, 0)}
+	info := bindataFileInfo{name: "img/tweethingz-logo.svg", size: 1414, mode: os.FileMode(436), modTime: time.Unix(1610636534, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _jsAppJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\xef\x72\xdb\xb8\x11\xff\xee\xa7\xd8\xe2\x3c\x63\x72\x22\x51\xb6\x9b\xcb\x4d\x15\xcb\x69\xce\xee\x4c\xaf\xe3\xde\x5d\x93\x5c\xaf\xd3\x24\xe3\x83\xc8\x15\x89\x33\x04\x30\x00\x24\x59\xbd\xf1\xbb\x77\x00\x92\xe2\x1f\x91\xb2\x64\xb9\x93\xa6\x35\x3d\xe3\x91\x80\xdd\xdf\x2e\x76\x17\xbb\x0b\x92\x3a\xf4\x26\x33\x11\x1a\x26\x05\x78\x3e\xfc\x76\x00\x00\x70\xe8\x91\x00\x95\x92\xaa\x3f\xd5\x31\xf1\x83\x84\x45\xe8\xf9\x2f\x0f\xdc\x24\x9b\x80\x77\xe8\x91\xaf\xc4\x6c\x3a\x46\xa5\xfb\x1a\x1d\x37\xf1\x03\x8e\x22\x36\x49\x01\x62\x2f\x2e\x69\x74\x49\x75\x32\x96\x54\x45\x1e\x39\x25\xfe\xcb\xd5\x9c\xc5\x88\xe8\xb2\xaf\x91\x63\x68\xa4\x22\x7e\x10\x26\x54\xc4\xb8\x52\xc8\xf3\x4b\xa4\x75\xb4\x43\xcf\x24\x4c\xfb\xc1\x9c\x72\xcf\xaf\xe0\xde\xe5\x9f\xef\x1a\xfa\x72\xa6\x4d\x55\xd8\xba\xb6\x6d\x54\x5b\xa9\xb4\xac\x2b\xd3\x83\x63\xff\x25\x0c\x06\x20\x05\x64\xfc\x6b\xca\x55\x0d\xe0\x44\xa6\x0a\xe7\x3d\x28\xbf\x0b\xbc\x35\x56\x3c\x67\xe1\x4d\x29\x1d\x1b\xe2\x31\xb0\x7c\x28\xcc\x25\x4e\xe8\x8c\x1b\xaf\x02\x5f\x57\x6f\x7d\x65\xb9\xaa\x85\xe6\x11\x35\xd4\x23\x29\x8d\x91\xf8\xa5\xf6\x56\x7c\x4d\xf9\xd5\x97\x39\x55\x30\x53\xfc\x47\xaa\xe8\x54\xc3\x08\x04\x2e\xe0\xa7\x37\x57\x6f\x91\xaa\x30\xc9\x46\xbd\x05\x13\x91\x5c\x04\x5c\x86\xd4\xaa\x1f\x68\x37\x59\xd1\xd1\xfa\x66\x05\x12\x24\x54\x7b\xe4\x93\x21\x7e\xd5\x29\x5d\x8e\xb1\xea\x97\xac\x31\x9a\x9c\xb5\xdd\x00\x2d\x94\xce\x4b\xe5\xda\x90\x6b\xec\xf2\x6d\xa7\xf1\x6a\x08\xad\x51\xa7\x30\x95\xca\xf4\x0d\x1d\x73\xec\x0c\xba\x9c\xc8\x09\x99\x4a\x85\x8f\xe3\xf8\x37\x0e\xd5\xdb\xe0\xcc\x36\xea\x9c\xd8\x0e\x15\x0b\x72\x7e\x5f\x65\x09\x8d\x66\x96\x5e\x52\x43\xdf\xd9\x35\xd5\x72\x86\x90\x7d\xce\xc4\xcd\x66\xf5\xbb\x54\x5f\x45\x97\x45\x9a\x69\x54\x7d\x1b\x92\x7d\x25\x17\xc4\x0f\xa4\xf0\xc8\x54\xce\x34\xca\x39\x2a\xd2\x83\x72\x3f\xd6\x4c\x99\x85\x72\xc8\xa5\x46\x6d\x3c\x62\xac\xab\x68\x14\x5d\x70\xaa\xb5\x47\x12\x16\x27\x9c\xc5\x89\xa9\xa7\xa1\x26\x53\xee\xaa\x09\x13\x51\x53\x93\xa1\x30\x49\x3f\x4c\x18\x8f\x3c\x02\xcf\x60\xb5\xed\x99\x88\xf0\xd6\xf3\xe1\x19\x9c\xd8\x7f\xc4\xdf\x2c\x77\xcb\xa5\xce\xcc\x6e\x2b\x55\x38\x95\x73\xfc\x2c\x8b\xdd\x2c\x7a\xe3\x7a\x1b\xa1\x52\x89\x94\x3c\x7d\xc8\x14\x85\x47\x12\x63\x52\x3d\x1c\x0c\xcc\x82\x19\x83\x2a\x08\xe5\x74\x60\xd5\xaa\xe5\xaf\x23\x0b\x7e\xe4\xf7\x80\x5c\x8f\x39\xb5\x91\x58\x2a\x70\x77\x50\x09\xe2\x5a\xc4\x67\x12\x6d\x42\x73\xe6\x80\x11\x34\x77\x2e\x98\xb1\x8c\x96\x05\x9a\xa5\xb4\xdb\xf4\xdb\x99\x31\x52\xd4\xc9\x2b\x7b\x38\x23\x76\xfc\x01\x4e\x53\xb3\x2c\x22\xfd\xd3\x0c\xd5\xf2\xa7\x37\x57\x30\x02\x32\xb0\x7a\x0f\x32\x66\xb7\x9e\x12\x38\x4f\xc9\x2c\x2a\xa0\x42\x29\xb4\xe4\x18\x70\x19\x7b\xe4\x6f\x16\xc4\x66\xdc\x21\x58\xb6\x02\x33\x27\x3d\x74\x79\xae\x18\x2c\xa3\x08\x3c\x0b\x5a\x0d\xa5\xc1\xa0\x86\xeb\xa6\x2b\x69\xbe\x4d\x9d\x1e\xd8\x8f\x01\xa7\xda\x7c\x77\x59\x25\xb6\x49\xcf\x4d\x25\x54\xff\x55\x2a\x6c\xe6\xf1\x0a\x98\x4e\xe4\xc2\xdb\x9c\x7f\x2b\xd4\x45\x03\x52\xcf\xb5\xf6\x2a\x43\x3b\x40\x1a\x26\x99\x78\xeb\x84\xca\xce\x51\x72\xf1\x9d\x8d\xd9\x1e\xac\x69\xd4\x58\x3d\x51\x72\xf1\xde\x9a\xb3\x60\xb1\xf1\xfd\x31\xb3\x30\xba\xc8\x15\x74\x8a\x8d\x5c\x6b\xc3\x41\xc9\x85\x8b\x83\x5f\xce\x8c\x82\xd0\x6e\x83\x11\xa9\x07\xba\xb3\x59\xdf\x8e\x8d\xc8\xe1\x6f\x25\xd8\x1d\x19\x9c\xff\xd2\x40\x54\x72\x11\xd0\x34\x45\x11\x59\xc0\xa8\x06\xc8\xa6\x31\x39\xaf\x51\x17\xd7\x19\x85\x44\xe1\x64\x44\xbe\x22\x05\x47\x91\x90\xa1\x95\xc1\x46\x27\x33\x1c\x33\x85\x22\xd4\xa1\x62\xa9\xb5\xd8\x1d\xf4\xc1\x9b\xa5\x11\x35\x18\x0d\xc1\x69\x9b\x7d\xb9\xa6\xe6\xce\xef\x10\xef\x54\x60\xd3\x18\xb4\x0a\x33\xc4\x54\xc9\x09\xe3\x78\xcd\xa6\x34\xc6\xbb\x95\x52\xf9\x70\xdf\x0d\x13\x18\x74\xac\x66\x40\xd7\x27\xce\x06\x26\xda\xc9\x5a\xd6\xc2\x2d\xfa\xee\x6a\xaa\x47\x34\xd3\x1f\x6b\xce\xb7\xab\x3c\x8b\xd8\xfc\xdc\x8e\x66\x23\x63\x05\x03\xf7\xb5\x68\x9b\xee\xce\x06\x96\x62\x7f\x63\xd8\x08\x24\xa5\xb8\x89\x62\x28\xa2\xeb\x50\xce\x84\xc9\x65\x14\x90\xf0\x60\x4c\xc9\xb9\x5c\xa0\xd2\x8f\x0b\x9b\x4a\x6d\x1e\x17\xd1\xa6\x08\xdc\x66\xf1\x59\xfe\xce\x51\x95\x5c\x34\x4e\x1a\xab\x2f\xcd\xb6\x28\x23\xcb\xab\x4f\x30\xa1\x8c\x97\x35\xee\xd7\x4f\xff\xf8\xf3\x9b\x6a\x2a\x4a\xa8\x88\x38\xfe\xc9\x1e\xb9\xf2\xc9\x6a\xd9\xaa\x55\x2d\xdb\x91\x5a\xe5\xdf\x2d\x53\xec\x81\xed\xd7\xab\x05\x2c\x6b\x51\x31\xba\xa4\xa6\xa8\x63\xd5\xa1\xa2\x73\x7d\xd9\x5a\xf1\x5c\x57\xa6\xbb\x2a\x5e\xe6\xda\xbf\xa3\x1a\xe7\xe4\xe5\xc0\x4e\xb5\x2e\xa2\x4b\x57\xe8\x6a\xaa\x3e\x03\x32\xb0\xab\x72\x33\xc5\xf2\xdc\xa8\x5d\xa1\x1b\xb5\x1f\x3e\x5f\x1d\x2c\x17\x1b\x24\x66\xca\xb3\x3a\x53\x0e\x36\x4f\x46\xb6\xc5\xbd\x62\xe2\x26\x37\x55\xed\x98\x57\x6d\xc8\x2c\xad\x3d\xea\xb5\xd1\x66\x47\xc0\x0a\x70\x01\x5a\x3d\xab\xe5\xb5\xd8\x7e\xfe\x51\xe1\xbc\x02\x5d\xc0\x76\x50\x7f\x8f\xb7\xa6\xab\x76\x3b\xa4\x46\xa5\x5c\x09\xdf\xa2\x72\xaf\x68\xd7\xeb\x76\xab\x3c\xa7\x4b\x43\xde\x4a\xfd\x2d\xe4\xad\x68\x77\xec\x13\xb2\x80\x7f\xea\x14\x9e\x3a\x85\xa7\x4e\x61\x53\xb1\x4c\xa8\xbe\x56\xc8\xab\x32\xf6\xef\x14\x9e\xba\x8f\x2f\xb2\xfb\x28\x6e\xbf\x46\x74\xa9\x0b\x90\x66\x6e\x4c\x51\x31\x19\x81\x25\xc9\x32\xa2\x23\xae\x56\xe4\x55\x2f\xa0\x93\x57\x76\x72\x54\x50\xed\x51\xa2\x07\x03\xc8\xef\x49\xd7\x6e\xae\x15\x41\xd1\x77\xd6\x06\x57\x0e\x89\x1f\x18\xbc\x35\x59\x15\xd0\x86\x9a\x32\x76\x32\xa7\xf8\x41\xc4\x62\x66\xb4\xd7\xb8\x5f\x9b\x45\xed\x7d\x50\x95\xd0\xee\x04\x2a\xb4\x8a\x29\x13\x78\x1f\xa2\xc0\xc5\xf5\x96\x0a\x16\xb8\x5c\x6a\xb3\x05\xea\x4c\x6c\x87\x9b\x45\x6c\x27\xa0\x0d\xf2\x5a\x54\x77\xe1\xa4\x9b\xd4\x72\x28\xe5\x6e\xeb\xc2\x98\xa2\xad\x8f\x59\x02\xed\xbb\x27\x0f\x15\x88\x3c\xaf\x4a\x51\x0d\x8d\x43\x8f\x04\x0b\xca\x4c\xdf\xc6\x70\xf3\x81\x46\x1e\x3b\x85\x1d\x20\xd3\x2e\x4c\xa8\x32\xed\xa6\x75\x6d\x43\x5f\xa3\x62\xa8\x57\xf7\xbe\x3a\x1d\xe1\x80\x88\x5f\x6c\xe2\xa3\xb3\x90\x8a\x39\xd5\xc0\xa2\x11\x69\x87\x3c\x3f\x1b\x64\x34\xe7\x47\x8d\x56\xb1\xa0\xbf\xb0\x98\xf9\x4d\x77\xf7\xd9\xdb\xa0\xe0\xfb\xe3\x8f\x76\xcf\x5d\x48\xe1\xcc\x44\x4e\x23\xe2\xf7\x1a\xdd\x8d\x59\xa6\x38\x84\xa3\x31\x55\x47\xbd\xda\x84\x35\xea\xb0\x41\x6c\x2f\x4e\xc7\xc8\xf5\x10\x7e\x18\xff\x8a\xa1\x09\x6e\x70\xa9\xf3\xd0\x72\x52\x6b\x11\xab\xfd\xde\x1a\xbf\xa5\xd5\x68\xf4\x10\xde\xaf\x83\xaf\x04\x0c\xe1\x68\x15\xa0\xd1\xd1\x3a\x4a\xa9\x61\xae\xc7\x9c\xf2\x19\xd6\x35\xb1\x1b\x61\xa3\x2a\xf6\x1a\xd3\xf0\x26\x56\x72\x26\xa2\x0b\xc9\xa5\x1a\xc2\x91\x8a\xc7\xd4\x3b\x3d\x7e\xd1\x83\x93\xe7\x7f\xe8\xc1\xc9\x8b\x17\xbd\xe3\xe0\xc4\xef\x50\x62\x2c\x55\x84\x6a\x23\xef\xd7\x9b\x79\x7f\x66\x91\x49\x86\x70\xd2\x4e\x33\x65\xe2\x5b\xaa\xae\xdc\xd3\x83\x21\x9c\xae\x13\xdd\xad\x0f\x6d\xb6\xeb\x9e\x56\xbd\xcf\xbf\x1b\x8c\x7a\x72\xfa\x4d\x0f\x4e\x8f\x4f\xac\x75\x7e\xbf\xa3\x51\x9b\xbc\xff\x6d\x46\x75\x99\x7f\x3f\xa3\x3a\x88\x3d\x4d\xfa\x7c\x0f\x93\x7e\xf3\x88\x26\x6d\xb1\xe8\x3d\x16\x9c\x89\x3d\x6d\x98\x6d\xf7\x87\x18\xb1\xb9\x61\x77\x31\x62\x93\xf7\x3f\x6d\xc4\x1d\xe3\x92\xce\x51\xd1\x18\x3b\x74\xca\x93\x3f\x67\xa2\x8b\x62\xc2\x38\x1f\xc2\x84\x72\x8d\x0f\xf3\x0a\x9d\xc7\x0f\xce\xc1\x5f\x7f\xdd\x83\xec\xdf\xf1\xf3\x5d\xdd\xb2\x1b\x6f\xee\x96\xb6\x4c\xb0\x36\xf2\xb1\x36\xd2\xc8\x14\xd2\x9d\xfa\x74\x5b\xe1\x54\xa8\x53\x29\x34\x9b\xe3\x10\x8c\x9a\xb5\xd8\x73\x4a\x99\x30\x94\x89\xd7\x3a\xc5\xd0\xbc\xb1\xa7\xae\x4e\xdb\xbb\xe3\x66\x9b\x18\x7b\x45\x4c\xa7\x9c\x2e\xbb\xe4\x38\x7e\xbc\x35\x43\x38\xfa\x39\x91\xe0\xcd\x84\x5f\x14\x05\xa0\x22\x82\x45\x22\xa7\xb0\x94\xb3\x6c\x26\xdf\x95\xd0\xcf\x9e\x23\xdb\x66\x1d\x26\x52\x41\x84\x86\x32\xae\xbb\x02\x47\x0a\xd3\xf0\xc8\xb1\xf5\x48\xf1\x6f\x43\x06\xb7\xac\x6f\xd9\xbf\x70\x08\x27\x2f\xb6\x4a\xcd\x1c\x63\x14\xd1\x1e\xc6\x48\xa5\x66\xd6\x6f\xb6\x11\x92\xc6\xc8\x69\x87\x66\x45\xef\xd3\x2e\xa8\xa9\x7b\x2b\xd1\x7a\x3c\xb5\x2c\x48\x87\x94\x63\xa7\x9c\xe5\xeb\x5b\x3b\xf9\xbe\x53\x89\x6e\xf5\xc0\x45\x4e\x78\xb3\x71\x0d\xc5\x35\xc6\x98\x89\xd7\xe6\x9f\xa8\xba\xc3\xb0\x79\xed\xe1\xf6\x26\x4c\x6e\xc6\xe7\xf7\x53\x4f\xe9\xed\x3b\xbb\xa8\x2b\x36\x65\x66\x08\xdf\xdc\xcf\x91\x2a\x0c\x99\x76\x1e\x3f\xde\x48\xdc\xe2\x9c\xea\xa5\x0d\x0d\x6f\x30\xca\x62\xab\x93\x72\xdd\xe7\xf6\xfa\xd8\x0e\x7d\xfb\xff\xe5\xdd\xcf\x66\xfd\x6d\xf6\xa1\x14\x17\x36\xe5\x0d\xc1\xc3\xb9\xe9\x01\x33\x38\xf5\x61\x74\xde\x61\x5d\x36\x01\xcf\x92\xb4\xbc\xeb\xd3\xbc\xb2\x17\x09\x22\xe4\x30\x72\xa8\xf6\xa4\x76\xed\x06\x5e\x76\xf2\x34\xef\xb5\x5c\x52\x83\x43\x20\xbd\x0c\xc8\xef\x66\x3c\xf4\x8a\xdb\x84\x7e\x40\x8d\x51\x1e\x49\x14\x4e\x48\x0f\xc8\x60\xce\x70\xb1\x7a\x2e\xe3\x70\x02\x97\xe3\xe0\x19\x90\x57\x9f\xcc\xa8\x1c\xce\x8f\x6d\x57\x76\xb6\x43\x56\x4b\x6a\x3b\x68\xff\x76\xf7\xe0\x13\xb8\x23\xd8\xfe\x04\xee\xa8\xb7\x3a\x87\xd7\x80\xf7\x3e\x87\xd7\xd5\xdc\xe1\x1c\xde\xd2\x8a\x3d\xf4\x20\x4e\x39\x7f\x9c\x83\xf8\x85\x5d\xcc\x03\x9b\xf2\xfb\x94\x80\x0d\x47\x9b\x63\xdb\x54\x9f\x1c\xe7\xff\x76\x3c\xdb\xd4\x99\x3b\x4f\x9a\xf7\x9e\x04\x61\xad\x75\x7f\x84\xae\xfc\xf5\xc6\xae\xfc\x51\x7a\x6e\x23\x0d\xe5\x5f\x62\xbf\xfd\x3f\xd5\x5e\xbf\xb3\x5e\xd0\xf9\xdd\x69\x90\x93\x55\xfe\xd0\x90\xa2\xb2\xad\xf4\x97\xd3\x3e\x3b\x4b\x3d\x75\xaf\x5f\x54\xf7\xfa\xd4\x92\x3e\x7a\x4b\xba\x7f\x9f\xb9\xa9\x29\x3a\xc8\xfa\xa2\x7d\x1f\xdd\x1d\x06\x13\x91\x3f\x43\x81\x11\xac\xff\xce\x43\xa1\x99\x29\x01\x26\x61\x3a\x7b\x2b\x62\x9d\x04\x2a\x6f\x0d\xbb\xb6\xa5\xf6\xc5\xb6\x5e\x29\xa7\x21\x7a\x03\xef\x43\xe4\x7b\xaf\x46\xde\x87\xc8\xfe\xf9\xcf\xbc\x57\xbf\xfb\x10\xf9\xfe\x20\xee\x01\x39\x3c\xe9\xad\xde\xcc\x6f\x3e\x55\x5c\x5f\x41\x26\xb8\xda\xe4\x66\xe3\x19\xbf\x6d\xaf\xd7\xcc\xb0\x1a\x74\x4f\xb6\x66\x1a\x46\x23\x78\x7e\x7c\xe2\x37\x7f\x46\xd0\xd9\x01\xd3\x99\x49\x06\x5c\xc6\x72\x56\x7b\x5f\xba\x34\xd2\xc1\xba\xa7\x4a\xa1\x79\xd1\xc1\xbf\xbc\xfd\xe1\xfb\x96\xdf\x2e\xd4\x7f\x4b\x63\xa6\xbc\x85\x2d\x98\xa2\xd6\x34\x46\x7f\xed\x7d\x97\x52\x87\xb5\xdf\x1a\x1c\x74\x0a\x20\xce\xa0\xee\x91\x2d\x13\xb1\x6d\x15\xd0\xf5\x0b\x3d\xd0\x88\xc0\x65\xac\xab\x37\x6f\x02\x52\x88\x3d\xb8\xfb\x77\x00\x00\x00\xff\xff\x22\xc2\xf6\x76\x10\x34\x00\x00")
+var _jsAppJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5b\xef\x72\xdb\xb8\x11\xff\xee\xa7\xd8\xf2\x3c\x63\x72\x22\x91\x76\x9a\xcb\x4d\x65\x2b\x69\x2e\xe9\x4c\xaf\x93\xde\xa5\x49\xae\xd7\x69\x2e\xe3\x83\xc4\x15\x85\x18\x04\x14\x00\x92\xac\x66\xf4\xee\x9d\x05\xff\x88\x94\x48\x5a\xb2\x9d\xb9\x6b\x2f\xa6\xc6\x23\x01\x8b\xdf\x2e\x76\x17\xbb\x0b\x90\x3c\xf6\x27\x73\x39\xb6\x5c\x49\xf0\x03\xf8\x74\x04\x00\x70\xec\x7b\x21\x6a\xad\x74\x3f\x35\x89\x17\x84\x53\x1e\xa3\x1f\x9c\x1f\xb9\x4e\x3e\x01\xff\xd8\xf7\xbe\x92\xf3\x74\x84\xda\xf4\x0d\xba\xd1\x5e\x10\x0a\x94\x89\x9d\x16\x20\x74\x09\xc5\xe2\x17\xcc\x4c\x47\x8a\xe9\xd8\xf7\x1e\x7a\xc1\x79\xd9\x47\x18\x31\x5b\xf5\x0d\x0a\x1c\x5b\xa5\xbd\x20\x1c\x4f\x99\x4c\xb0\x14\xc8\x0f\x36\x48\xbb\x68\xc7\xbe\x9d\x72\x13\x84\x0b\x26\xfc\xa0\x82\xbb\xce\xbf\xaf\xb7\xe4\x15\xdc\xd8\x2a\xb3\x5d\x69\x9b\xa8\xf6\x12\x69\x55\x17\xa6\x07\xa7\xc1\x39\x44\x11\x28\x09\xd9\xf8\x1d\xe1\xaa\x0a\x70\x2c\x67\x1a\x17\x3d\xd8\xfc\x96\x78\x6d\x49\x23\x82\x8f\xaf\x36\xdc\x71\x8b\x3d\x86\x34\x0e\xa5\x7d\x81\x13\x36\x17\xd6\x0f\xce\x5b\xc4\xdb\x9d\x59\x2e\x6a\x21\x79\xcc\x2c\xf3\xbd\x19\x4b\xd0\x0b\x36\xd2\x13\xfb\x12\x71\x5d\xb8\x00\x7d\x16\x4c\xc3\x5c\x8b\x57\x4c\xb3\xd4\xc0\x10\x24\x2e\xe1\xc7\xd7\x2f\xdf\x20\xd3\xe3\x69\xd6\xea\x2f\xb9\x8c\xd5\x32\x14\x6a\xcc\xc8\x45\x42\xe3\x3a\x2b\x32\x92\x2f\x95\x20\xe1\x94\x19\xdf\xfb\x68\xbd\xa0\x6a\x94\x36\xc3\x90\xf8\x9b\xa1\x09\xda\x7c\x68\xb3\x02\x1a\x28\x9d\x95\x4a\xe2\x35\x0a\x83\x9f\x1a\xc7\x76\x28\xaf\x86\xd0\xe8\x75\x31\x4f\xd0\xd8\xfe\x44\xe9\xb4\x7d\x85\x38\x9a\xaa\xed\x76\x47\x9a\xf9\x28\xe5\xf6\xf6\x9e\x60\xd8\x02\x77\xf9\xb4\xad\x15\x8d\x33\xa5\x6d\xdf\xb2\x91\xc0\xd6\xa5\x92\x13\x39\xd5\xa4\x4a\xe3\xfd\xb8\xeb\x6b\x87\xea\x77\xb8\x60\x13\x75\x4e\x4c\x4d\xc5\x84\x68\x6e\x47\x85\x28\x60\xd0\xce\x67\x2f\x98\x65\x6f\x69\x4e\xb5\x48\x27\x55\x5f\x70\x79\xd5\x2d\x7e\x9b\xe8\xe5\x9a\xa0\x98\x39\x37\xa8\xfb\xb4\x90\xfa\x5a\x2d\xbd\x20\x54\xd2\xf7\x52\x35\x37\xa8\x16\xa8\xbd\x1e\x94\xc8\x75\x55\x66\x0b\x70\x2c\x94\x21\x37\xf0\x2c\x39\x18\x8b\xe3\xe7\x82\x19\xe3\x7b\x53\x9e\x4c\x05\x4f\xa6\xb6\x1e\x3c\xb7\x07\xe5\xa6\x9a\x70\x19\x6f\x4b\x32\x90\x76\xda\x1f\x4f\xb9\x88\x7d\x0f\x1e\x40\x19\xac\xb8\x8c\xf1\xda\x0f\xe0\x01\x9c\xd1\x3f\x2f\xe8\xe6\xbb\xe7\x54\xe7\xf6\xb0\x99\x6a\x4c\xd5\x02\x7f\x95\xc9\x76\xb3\xee\x9c\xef\x96\xab\x54\x3c\x25\x0f\x7a\x6a\x86\xd2\xf7\xa6\xd6\xce\xcc\x20\x8a\xec\x92\x5b\x8b\x3a\x1c\xab\x34\x22\xb1\x0a\xa9\x08\xd1\x3f\xa1\x09\x9c\x04\x3d\xf0\x2e\x47\x82\xc9\xab\xaa\x00\xeb\xa3\x8a\x13\xd7\x3c\x3e\xe3\x48\x61\xd8\x2d\x53\x18\x56\x17\x65\xd6\x64\x47\x2a\x5e\x15\x68\x44\x49\xcb\xf4\xdb\xb9\xb5\x4a\xc2\xb0\x65\x0d\x67\xc4\x6e\x7c\x88\xe9\xcc\xae\x0a\x4f\xff\x38\x47\xbd\xfa\xf1\xf5\x4b\x18\x82\x17\x91\xdc\x51\x16\x00\xdc\x7c\x36\xc0\x79\x22\xe1\x71\x01\x35\x56\xd2\x28\x81\xa1\x50\x89\xef\xfd\x83\x40\x28\x4f\x0c\x80\x86\x15\x98\x39\xe9\xb1\x8b\xce\x45\xe3\xc6\x8b\xc0\x27\xd0\xaa\x2b\x45\x51\x0d\xd7\x75\xe7\xd6\xa2\x4f\x93\x38\x3d\x20\xaa\x50\x30\x63\xbf\x7b\x51\x98\xb6\x08\x7a\xae\x6b\xca\xcc\xdf\x95\xc6\x2a\x9f\x2d\x30\x33\x55\x4b\xbf\x3b\x6b\x54\xa8\x8b\xb2\xa9\xe8\x5a\x97\xdf\xca\x2f\xc7\x21\xb2\xf1\xd4\x4d\x2f\x24\x23\x54\x56\x8e\x56\xcb\xef\xc8\x67\x7b\xb0\x23\xd1\xd6\xec\x3d\xad\x96\xef\x48\x9d\xc5\x10\x5a\xcc\xef\x33\x0d\xa3\xf3\x5c\xc9\x52\xac\x08\x52\xb8\x83\x56\x4b\xe7\x07\xbf\x5c\x58\x0d\x63\x5a\x81\x43\xaf\xee\xe8\x4e\x67\x7d\x6a\x1b\x7a\xc7\x9f\x36\x60\x6b\x2f\x7a\xf2\xcb\x16\xa2\x56\xcb\x90\xcd\x66\x28\x63\xff\x97\x0b\x1b\xd7\x00\x79\x9a\x78\x4f\x6a\xd4\xc5\x75\xc1\x60\xaa\x71\x32\xf4\xbe\xf2\x8a\x11\x45\x40\x86\xc6\x01\x00\x60\xb9\x15\x98\x09\x14\xa3\x19\x6b\x3e\x23\x8d\xad\xa1\x0f\xfe\x7c\x16\x33\x8b\xf1\x00\xa8\x33\xff\x71\xc9\xec\x3a\x68\x61\x4f\x9f\x0b\x9e\x26\x60\xf4\x38\x43\x9c\x69\x35\xe1\x02\x2f\x79\xca\x12\x5c\x97\x42\xe5\xcd\x7d\xd7\xec\x41\xd4\x32\x9b\x88\xed\x76\x5c\x44\x36\x3e\x48\x5b\xa4\xe1\x06\x79\x0f\x55\xd5\x3d\xaa\xe9\xcf\x35\xe3\x5f\x44\xec\xc9\x45\xcc\x17\x4f\xa8\x35\x6b\x19\x69\x88\xdc\xcf\xa2\xd8\x5b\x5f\x44\x44\x71\x77\x65\x90\x07\x7a\x1b\x76\x13\xcd\x51\xc6\x97\x63\x35\x97\x36\xe7\x51\x40\xc2\xad\x31\x95\x10\x6a\x89\xda\xdc\x2f\xec\x4c\x19\x7b\xbf\x88\x14\x22\x70\x9f\xc9\x67\xf1\x3b\x47\xd5\x6a\x59\xd1\x77\x99\xd6\xe8\xb3\x5d\x16\x65\x64\x79\xf6\x09\x27\x8c\x8b\x4d\x8e\xfb\xf0\xf1\x5f\x7f\x7d\x5d\x0d\x45\x53\x26\x63\x81\x7f\xa1\x8d\x62\xde\x59\x4d\x5b\xc5\xb8\xb2\x8e\x26\xe1\xdf\xae\x66\xd8\x03\xda\x65\x14\x40\x14\x87\xb2\x5d\x19\xc6\x2f\x98\xc5\x3c\x31\x55\x9b\x8a\x7a\xfb\xbc\x31\xe3\xb9\xaa\xcc\xb4\x65\xbc\xcc\xb4\xff\x44\x3d\xca\xc9\x37\x0d\x07\xe5\xba\x98\xad\x5c\xa2\xab\x89\xfa\x00\xbc\x88\x66\xe5\x7a\x8a\xe9\x51\xf0\x8d\x68\x86\xae\x95\xbe\xfc\x7a\x79\x70\x33\xd9\x70\x6a\x53\xe1\x86\xe7\xde\x4e\x8d\x55\x52\x52\x16\x95\xb8\x2f\xb9\xbc\x82\xe1\xee\xe6\xb4\xd0\x56\x41\x4b\x1b\xd4\x26\x5a\x6a\xf7\xaa\xc0\x05\x68\x9e\x89\x49\x21\x45\x2e\xa6\xef\xaf\x34\x2e\x2a\xd0\x05\x6c\x0b\xf5\xf7\x78\x6d\xdb\x72\xb7\x43\xaa\xe8\xa6\xc6\x7c\x8f\xcc\x5d\xd2\xee\xe6\xed\x46\x7e\x4e\x96\x2d\x7e\xa5\xf8\x7b\xf0\x2b\x69\x0f\xac\x13\x32\x87\xff\x52\x29\x7c\xa9\x14\x6e\xae\x14\xa2\x05\xc7\x65\x44\xb6\x88\x68\x9a\x3c\xbe\x34\x56\xaf\x7f\x47\xe5\xc2\x94\x99\x4b\x8d\xa2\xca\xa3\x80\x84\xdb\x62\x7e\x29\x41\xfe\x37\x4b\x90\xe2\xe4\x38\x66\x2b\x53\x80\x6c\x07\xc8\x19\x6a\xae\x62\x20\x92\x2c\x35\xd3\xb7\x5c\xa6\x6c\x7b\x5a\x16\x04\x66\xfa\x94\x3a\x87\x05\xd5\x1d\xf2\x74\x14\x41\x7e\x9c\x5e\x36\x6d\x6a\x15\xd4\x7d\xa7\x6d\x70\x39\xd1\x0b\x42\x8b\xd7\xd6\x41\x84\xc6\x32\xbb\xf1\x9d\xcc\x23\x83\x30\xe6\x09\xb7\xa6\x9a\x51\x1c\x98\xf3\xda\x9b\xa0\x2a\xae\xdd\x0a\x54\x48\x95\x30\x2e\xf1\x26\x44\x89\xcb\xcb\x3d\x05\x2c\x70\x85\x32\x76\x0f\xd4\xb9\xdc\x0f\x97\x0a\xb3\x0e\x31\x69\x35\xd6\xbc\xba\x0d\x67\xd6\x25\x96\x43\xd9\xac\xb6\x36\x8c\x14\x2d\xeb\xe7\x01\xb4\xaf\x64\x1d\x22\x8f\xab\x4a\x6e\xb9\x06\x39\x17\xd8\x29\xc2\x52\xe9\x2b\xd4\x10\xf3\x58\x9e\x58\xd0\x73\xd9\xa3\xfb\x0b\xda\x1a\xa0\x72\x83\x48\x52\x60\x06\x46\x1a\xd9\x95\xd9\xad\x59\x0c\x6a\x8e\x26\x4c\xd8\xcc\x34\x1c\xe9\xd6\x44\x24\x9a\x9a\x70\xd5\xb1\x1f\x14\x97\xbe\xd7\x83\x9d\xd3\xf6\xda\xf0\xfe\x8c\x49\x14\x5e\xb0\x4f\x29\xd4\x3c\xb0\xa3\x0e\x8b\x22\x78\x9b\x1d\xa9\xc1\xb3\x57\xdf\x81\xe0\x29\xb7\x06\x34\x1d\xa6\x60\x9c\xd5\x8b\xa0\x71\xa2\xd1\x4c\x31\x86\xd1\x0a\x18\x08\x66\x51\x17\x2a\xd4\x73\xb9\xab\x1f\xcd\x2c\x5e\x3a\xa8\x6d\xbd\x50\x09\x94\x9a\x84\x8e\xbe\x76\xd8\x16\x5c\xbd\x8d\xa0\x2d\xa8\x21\x85\x42\x2e\x93\x16\xe5\xd3\x27\x67\xf2\x8a\x69\xcb\x99\x10\x2b\xb7\x79\xc2\x38\x8f\x44\xcd\x68\x1b\x73\xd4\x25\x58\xdf\x28\x8f\x46\x83\xf6\x92\xd9\x36\x49\x1e\x0c\xc1\xeb\x15\x7a\x04\x36\xa1\x79\x37\x49\x52\xe0\x6c\xf1\x07\xba\xa7\xb2\x17\xb4\x92\xae\x1a\x86\x6c\x65\x78\x5d\xf3\x28\x5d\xc5\xb1\x2e\x7c\x34\x35\x49\x70\xde\x41\x77\x2b\x67\xac\x8f\xec\xf0\x46\x3a\xfb\x5f\x32\x6e\xfb\x64\xad\x0a\x69\x49\x10\x45\xf9\x56\x0c\x35\xb8\x18\x93\xad\xdb\xe6\xf0\xe7\xea\xfb\x7e\xb6\xe0\xca\x43\xea\xd6\x60\xe9\x80\xe8\xe0\x3e\xab\x33\x4e\x2e\xc6\x4c\x2e\x98\x01\x1e\x0f\xbd\x66\xc8\x27\x17\x51\x46\xf3\xe4\xa4\x02\xba\xd9\x2c\xa3\x7e\x4e\x98\xf9\x3d\x3d\xf7\xdd\xef\x10\xf0\xdd\xe9\x7b\xca\x8b\xcf\x95\x74\x79\xc9\x7b\x18\x7b\x41\x6f\xcb\xea\x76\x35\xc3\x01\x9c\x8c\x98\x3e\xe9\xd5\x3a\xc8\x93\x06\x0d\x2e\x22\xd8\x08\x85\x19\xc0\x0f\xa3\x0f\x38\xb6\xe1\x15\xae\x4c\x2d\x0e\x55\xb3\x8a\x09\xea\x98\x05\xae\x41\x6b\x06\xf0\x6e\x17\xbc\x64\x30\x80\x93\x32\x89\xc4\x27\xbd\x46\x42\x42\x2a\xe5\x58\x30\x31\xc7\xba\x24\x94\xac\x3a\x45\xa1\xcf\x88\x8d\xaf\x12\xad\xe6\x32\x7e\xae\x84\xd2\x03\x38\xd1\xc9\x88\xf9\x0f\x4f\x1f\xf7\xe0\xec\xd1\x9f\x7a\x70\xf6\xf8\x71\xef\x34\x3c\x0b\x5a\x84\x18\x29\x1d\xa3\xee\x1c\xfb\x75\xf7\xd8\x9f\x78\x6c\xa7\x03\x38\x6b\xa6\x49\xb9\xfc\x96\xe9\x97\x2e\x2c\x0d\xe0\xe1\x2e\xd1\x7a\xb7\xa9\x5b\xaf\x77\xd4\xea\x4d\xf6\xed\x50\xea\xd9\xc3\x6f\x7a\xf0\xf0\xf4\x8c\xb4\xf3\xc7\x03\x95\xba\x3d\xf6\xb7\xa6\x54\x57\x9d\xdd\x4d\xa9\x0e\xe2\x8e\x2a\x7d\x74\x07\x95\x7e\x73\x8f\x2a\xdd\xa1\x59\x6f\x07\x9e\x6d\x0d\xce\xe5\x1d\x75\x98\x2d\xf7\xdb\x28\x71\x7b\xc1\x3e\xba\xc3\x62\xff\xdc\x4a\x3c\xd0\x2f\xd9\x02\x35\x4b\xb0\x45\xa6\x3c\xf8\x0b\x2e\xdb\x28\x26\x5c\x88\x01\x4c\x98\x30\x78\x3b\xab\xb0\x45\x72\xeb\x18\xfc\xf5\xd7\x3d\xc8\xfe\x9d\x3e\x3a\xd4\x2c\x87\x8d\xcd\xcd\xd2\x14\x09\x76\x5a\xde\x1f\x75\x44\x0a\xe5\xee\x77\x99\xa6\xc4\xa9\xd1\xcc\x94\x34\x7c\x81\x03\xb0\x7a\xde\xa0\xcf\x94\x71\x69\x19\x97\xcf\xcc\x0c\xc7\xf6\x35\x9d\x8c\xb4\xea\xde\x1d\x09\x35\xb1\xa1\x2b\xe6\x66\x26\xd8\xaa\x8d\x0f\x5d\x54\x11\x0c\xe0\xe4\xa7\xa9\x02\x7f\x2e\x83\xdc\x44\x31\x30\x19\xc3\x72\xaa\x52\x58\xa9\x79\xd6\x93\xaf\x4a\xe8\x67\x0f\x7c\xd0\x06\x1c\x26\x4a\x43\x8c\x96\x71\x61\x5a\xf4\x3a\x51\xd2\x6e\x59\xe4\xb4\x07\x9b\x7f\x1d\x11\x9c\x86\xbe\xe1\xff\xc1\x01\x9c\x3d\xde\x2b\x34\x0b\x4c\x50\xc6\x77\x50\xc6\x4c\x19\x4e\x76\xa3\x42\x48\x59\xab\xd2\x16\xc9\x8a\xda\xa7\x99\xd1\xb6\xec\x8d\x44\xeb\x7d\x26\x64\xc6\x4c\x60\x2b\x9f\xd5\xb3\x6b\xea\x7c\xd7\xd8\xd9\x1e\x12\x8a\x3f\xcb\xc7\x57\x9d\x73\x28\xae\x11\x26\x5c\x3e\xb3\xff\x46\xdd\xee\x86\xf7\x68\xf6\x16\x35\x3e\xba\x99\x3a\x65\xd7\x6f\x69\x52\x2f\x69\x57\x30\x80\x6f\x6e\x1e\x31\xd3\x38\xe6\xc6\x59\xfc\xb4\x93\xb8\xc1\x38\xd5\xcb\x58\x36\xbe\xa2\xd3\x57\x5a\x68\xad\x94\xbb\x36\xa7\xeb\x7d\x33\xf4\xf5\xef\xcb\xba\xbf\x9a\xf6\x8f\xf6\x60\xa6\xe4\x73\x0a\x79\x03\xf0\x71\x61\x7b\xc0\x2d\xa6\x01\x0c\x9f\xb4\x68\x97\xf6\xf1\x44\xd2\x71\x88\x50\x5c\xb4\xa5\x4b\x55\x8c\x02\x86\x0e\x95\x76\x6a\x97\xae\xe1\xfc\xa8\x71\x40\xc3\x79\x28\xdd\xe7\x1c\xd0\x29\x80\x1b\x57\xd9\x2f\x6e\x5f\xc7\x7e\x71\x94\x1f\x84\xcc\x5a\xed\x7b\x74\x07\x82\x4e\x89\xb2\x7b\x10\xc5\x0d\x54\x87\x13\xba\x18\x47\x37\x9f\x9e\x7e\xb4\xc3\x4d\x73\xbe\x6d\x7b\xc9\x46\xad\xbc\xd6\x47\xdd\x2d\xeb\xe6\x53\xe6\x83\x76\xe0\x8e\x60\xff\x1d\xb8\xa3\xde\x6b\x1f\x5e\x03\xbe\xf3\x3e\xbc\x2e\xe6\x01\xfb\xf0\x86\x52\xec\xb6\x1b\x71\x26\xc4\xfd\x6c\xc4\x9f\xd3\x64\x4e\x6e\x59\xfe\x09\x71\xdb\xf2\xef\xec\x94\x76\xd0\x67\xa7\xf9\xbf\xc3\xea\xbf\xad\xc1\xad\x3b\xcd\xad\x6d\xcb\x1e\x35\xe2\xd9\x3d\x54\xe5\xcf\x3a\xab\xf2\x7b\xa9\xb9\xad\xb2\x4c\x1c\xa6\xf0\x03\x6b\xe6\xcf\x54\x6f\xff\x5f\x95\xd7\x6f\xc9\x0a\x26\xbf\x83\x04\x6a\x52\xc6\x0f\x03\x33\xba\x5d\xc0\x56\x2d\x6a\xba\x43\xa6\xfd\x5c\xe5\xb3\x73\xc8\x7d\xf0\xbe\x54\xaf\xbf\x99\xea\xb5\xb5\x77\x7d\xd4\xd0\xf8\xa5\x24\xdd\xa3\x24\x3d\x54\xa5\x47\xdd\x74\x9b\x5f\xae\x28\x3a\x3a\xfa\x0c\xb7\xd7\xf3\x97\x4b\xe0\x53\xc3\xbd\x72\xd7\xe7\x75\xdd\x20\xaf\xbe\xf1\xa2\xf1\xe3\x1c\xe5\x78\x95\x3f\xf7\x97\x27\x1d\x6b\xb9\x4c\x4c\x58\xf6\x06\xe7\x4d\x83\x31\x65\x5c\x34\x0e\x74\x3d\xc1\xf9\xfd\xce\xbc\xfa\x5a\x4d\x3e\xba\x22\x4c\xf6\xf6\x1c\x45\x69\xdf\x2b\xee\xce\x1d\x87\xec\x03\xbb\xf6\x37\x9c\xe6\x5a\x0c\x60\x4b\x51\x65\x67\x56\xb0\x79\xaf\x7e\x78\xf3\xb6\xd2\x3a\xa6\x1a\x4f\xba\xa7\x0d\x07\xe0\xb1\xd9\x4c\xf0\xac\xf4\x8e\x3e\x18\x25\x2b\x84\xa4\x81\x01\xfc\xed\xcd\x0f\xdf\x87\xc6\x6a\x2e\x13\x3e\x59\x55\x58\xd3\xa7\xd4\xe7\xa0\xf6\xd6\xd1\x96\x0d\xb6\x72\xbc\xd3\xe5\xa0\x4d\xef\x41\x49\xbb\x2e\xb4\x16\xc6\x4a\xa2\xbf\x97\xf9\xf7\xb3\x60\x87\xa6\xdf\xb0\x05\xc6\xde\xde\x96\xa6\x0d\x95\x6b\x0c\xf3\xe4\x8e\xa4\xaf\x2a\x45\x3b\xaf\xdd\x71\x61\x8a\xc6\xd0\xe3\xad\x1b\x31\xe9\xd2\x68\xe7\x5a\x9e\x1f\xed\x2e\xc8\x6e\x47\x3b\x0e\x27\x32\x7f\x94\x00\x86\x95\xe5\x53\x88\x97\xe1\x02\xbd\x4c\x92\x3d\x21\xb8\x4b\x52\x7d\x83\xc6\xb9\x62\xed\x07\xed\x6e\x66\x82\x8d\xd1\x8f\xfc\x9f\xe3\xc0\x7f\x3a\xf4\x7f\x8e\xe9\x0a\x1e\xf8\x4f\xff\xf0\x73\x1c\x04\x51\xd2\x03\xef\xf8\xac\x57\xde\xed\xdf\x5e\x03\xbb\x33\xc8\x18\x57\xf7\x91\x59\xfb\x79\xf9\x92\x59\x87\x15\xe8\x99\x96\xb9\x81\xe1\x10\x1e\x9d\x9e\x55\xde\xab\xb9\x61\x93\xc9\xe6\x76\x1a\x09\x95\xa8\xb9\xf5\x1a\x95\xdf\xa0\xfb\xbd\x4d\x5f\x7f\x1b\x96\x9e\xa4\xed\xb0\xfc\xce\x3d\xe6\x8d\x0c\x9b\xb6\xcc\x01\xd6\x47\xad\x0c\x3c\xa7\x50\x17\x5a\xb9\x4c\xa8\x1a\x47\xfa\xc7\x7a\x60\x10\x41\xa8\xc4\x54\xcf\x47\x43\x2f\x08\xcd\x54\x2d\xfd\xe0\x68\xfd\xdf\x01\x00\xac\xe9\x82\x75\xd2\x3b\x00\x00")
 
 func jsAppJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -294,12 +299,12 @@ func jsAppJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "js/app.js", size: 13328, mode: os.FileMode(420), modTime: time.Unix(1609631324, 0)}
+	info := bindataFileInfo{name: "js/app.js", size: 15314, mode: os.FileMode(436), modTime: time.Unix(1792318553, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _jsChartJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xfd\x7d\x77\xdb\x38\xb2\x20\x8c\xff\xbf\x9f\xc2\xe6\xbd\xad\x21\x44\x88\x22\x29\xcb\x71\x28\x23\x3a\xe9\xf4\xf4\x24\x77\xfb\x6d\x3a\x3d\x6f\x57\xad\xcd\x81\x25\xd8\xc2\x84\x06\xd5\x24\x64\x5b\x6d\x69\x3f\xfb\xef\xa0\x00\x90\x20\x45\x39\x4e\xcf\xfc\x9e\xdd\xe7\x3c\x9d\x73\x62\x91\x78\x2d\x14\x0a\x85\xaa\x42\x15\x38\xec\x9f\xfe\x8f\x93\xfe\xc9\x9b\x15\x2d\x64\xf8\xcf\xf2\xe4\x2e\x09\x5f\x86\x67\x2a\x69\x25\xe5\xba\x4c\x87\xc3\xfb\xfb\xfb\x70\xa1\xb2\xff\x59\x86\x79\x71\xa3\xb2\xfc\x05\x3a\x49\xa2\x24\xaa\xab\xbd\xc9\x85\x2c\xf8\xd5\x46\xe6\x45\xa9\x4a\xfc\xc8\x32\x46\x4b\xb6\x3c\xd9\x88\x25\x2b\x4e\xe4\x8a\x9d\x7c\xfb\xee\xa7\x93\x6f\xf8\x82\x89\x92\xfd\x8f\x93\xfe\xf0\x7f\x9c\x5e\x6f\xc4\x42\xf2\x5c\xf8\x12\x33\xf4\xe8\xe5\x57\xff\x64\x0b\xe9\x11\x22\xb7\x6b\x96\x5f\x9f\xb0\x87\x75\x5e\xc8\xb2\xd7\xf3\x54\x1b\xd7\x5c\xb0\xa5\x77\x6a\x33\x6f\xf3\xe5\x26\x63\x53\xfd\x13\x9a\xa2\x84\xf9\x55\x9b\xe8\x51\x16\xdb\xc7\x82\xc9\x4d\x21\x4e\x0a\xf6\xcb\x86\x17\xcc\xf7\x6e\xf3\x5b\x26\xa4\x87\xf6\x0b\x2a\x17\x2b\x5f\xa2\xc7\xfd\xde\x47\x28\xf5\x6c\xbd\xba\x7b\xdd\x65\xaf\xa7\x7f\x43\x7a\xbb\x9c\xea\x47\x7f\xe6\x99\xf6\xbc\x39\xae\x3b\x94\xc8\xf6\x76\x0c\x0a\x79\xb4\xff\x3d\x42\xa9\x2f\x89\xdc\xed\x4a\x96\x5d\xa3\x10\xd0\x4a\x98\x2f\x43\x5d\x01\xed\x7d\xb9\xe2\x65\xb3\x37\x6f\x53\xb2\x93\x52\x16\x7c\x21\xbd\x89\x24\xb2\xd7\x93\xe1\x8a\x96\xdf\xdf\x8b\x1f\x8a\x7c\xcd\x0a\xb9\xf5\xbd\x25\xbb\xa6\x9b\x4c\x7a\x68\x2a\x43\xf3\x9c\xca\xc9\x1d\x2d\x4e\x18\x79\xa4\x19\x5f\xb0\xab\x6c\xc3\xd2\x59\x72\x16\xe1\xe4\xec\x02\x27\xe3\xf1\x1c\x53\x21\xf9\x2f\x1b\x76\xbf\xe2\x52\x65\x8d\x23\x9c\x8c\xc6\x38\x89\x55\xd6\x2f\x1b\x9a\xce\x22\x55\xce\x94\xfd\x65\x43\x6f\x69\xc1\x05\x4b\x67\x71\xf2\x42\x67\xc4\xc9\x1c\xd3\x5f\x37\x85\x6d\xd8\x16\xbe\x62\xfc\x06\xd2\xc6\x18\xfe\x27\xd1\x1c\x5f\xf1\xf2\x17\x80\x40\x15\x4a\x2e\x70\xfc\xf2\x7c\x8e\xaf\x32\xba\xf8\xa8\xfa\x89\x70\x04\x6f\x62\xb1\x62\x4b\x9a\xdd\xe6\x62\x69\x8a\x2a\x88\x22\xd5\x26\xc0\xaf\x4a\xea\x2e\xb2\x0d\xbb\xe3\x79\xc6\x64\x3a\x8b\x47\x17\xf8\x6c\x84\x93\x44\xb5\x58\xe4\xf7\x22\x9d\xc5\xe7\x63\x7c\x96\xe0\xb3\x64\x8e\xaf\x36\x45\xb6\xbd\xcf\x73\xd5\x60\x92\xe0\xf8\xe2\x0c\xc7\xa3\xf1\x1c\x2f\xe8\x92\x49\xdd\xea\xcb\x31\x8e\xc7\x17\x38\x3e\x8f\xe6\x18\x56\x40\xc1\x36\xa5\x33\x50\x48\xce\x17\x79\x46\x01\x51\x71\x84\xe3\x68\x8c\x47\x2a\x39\x2f\x68\xa6\x21\x55\x85\x2f\x74\x92\xb8\xce\xf2\x7b\x56\xe8\xc6\xe3\x28\xc2\xf1\xd9\x4b\x9c\x8c\x5e\xe8\xcc\x92\x67\x1f\xcd\xe0\xd4\x4c\x28\xe4\x2c\x0a\x7e\x5b\xe6\x42\x41\x18\xe1\x24\xc2\x00\xc8\x96\x8a\xc6\x14\x2c\x69\xf1\xb1\xc6\x42\x3c\x7a\xa9\x93\x6c\xb9\x78\xf4\xb2\x4e\xbc\xc9\xb3\x25\x13\x85\x1a\xb4\x1e\xf0\x19\x8e\x63\x93\x55\xd0\xad\x42\xd0\x4b\x6c\xfe\xdb\x64\xc6\x74\x3b\x11\x4c\x86\x49\xeb\x2a\xfa\x71\x45\x3f\x72\xd5\xf0\x4b\x1c\x5f\x8c\x70\x1c\xbd\xd0\xe9\xb7\xf4\x86\x09\x49\xd5\x84\xbc\x74\x21\xcc\x33\x7e\xc7\x4c\xfb\x17\x63\x55\x1e\x9f\x99\x2a\x79\x41\xc5\x8d\xa1\x8a\xf8\xac\xea\x38\x2f\x16\x2b\xae\x60\x1f\x8f\xb0\x22\xcb\xe8\x4c\xa7\x17\x6c\x69\x5b\x37\x25\x4b\x20\x96\x74\x96\x8c\x46\x38\x1e\x47\x38\x4e\x12\x93\xc1\xa8\xe9\x32\x3e\x1b\xe1\xf8\xe2\x02\xc7\x67\x23\x93\xa5\xe6\x51\x63\xf2\x45\x82\xcf\xe3\x1a\x52\xc8\xd1\x08\x3a\x7b\x81\x5f\xbc\xc4\x2f\x9a\x19\xec\x20\x43\x6e\x8a\x5f\x36\x39\x2f\x61\x56\x92\xe8\x1c\x27\x91\xc9\xa9\xa8\xf3\xec\x42\x11\x2d\xa0\x9f\xb1\xf5\x9a\x0b\x3b\xfb\x8a\x2e\x5e\xe8\xd4\xf2\xe3\xd6\x4e\x6d\xfc\x32\x36\xf3\xcd\x6f\xcd\x5c\x45\x63\x6c\xfe\x9b\x54\x76\x90\x9a\x2f\x6f\x2c\xc1\x8d\x54\xbb\x67\xba\x8d\x6b\x5e\xb0\xab\x82\xab\x45\x16\xbf\xb8\xc0\xa3\x33\x3c\x3a\x9b\xe3\xeb\x4c\x91\x6d\xb5\xee\x15\x7d\x29\xb6\x10\xcd\xf1\x75\x5e\xb0\x52\x1a\xc4\x29\xa2\x19\xbd\xd4\x35\x36\x8b\x55\xc9\xa9\x2e\x6d\x96\xe0\x0d\xe5\xa2\xbc\xca\x8b\xdc\x90\xad\xfe\x3f\xc7\x37\xab\xbc\x94\xb6\x71\x45\xe1\x96\xdf\x28\xa2\x34\xfd\xc5\xb0\xa8\x1c\x2a\x4d\x62\xb5\xfe\xc6\x78\x94\xcc\xb1\x19\xb5\x62\x12\xfa\xbf\x4a\xb2\xd4\x99\x5c\x40\x4d\xf5\xbe\x65\x59\x96\xdf\xab\x91\x8d\x60\x95\x28\x64\x1a\xdc\xb8\x75\x57\xb9\x60\xdb\x25\xbb\x77\x98\x94\x1a\xea\x2a\x97\xf5\x5c\x00\x22\xd5\xea\xe5\x62\xc9\xa9\x00\x3a\x4b\xa2\x31\x7e\x99\xe0\x97\x89\x4e\xbd\xc9\xd3\xd9\x8b\x31\xd0\xb5\x2a\x77\x97\x17\x5b\x8b\x3c\xd3\xa2\x59\x17\xd0\x0b\x4c\x42\x34\xc7\x19\xbd\x63\x6a\x67\x54\x14\xaa\x93\x93\xb1\x93\x7c\x95\x6d\xca\x95\x65\x06\x6a\x0e\xc6\x2a\xef\x5e\x58\xd2\x4d\xd4\x3c\x26\x6a\xc4\x19\xbb\xcd\xc5\x62\xc5\xaf\xaf\x81\xdc\xed\xa4\xa9\xc9\xcf\xf8\xcd\xca\x70\x32\x40\x45\x7c\xae\x3a\x32\xe9\x96\x43\x9d\x45\x35\x46\x74\x06\xf0\x8d\x04\x7a\x30\x1c\x06\xd2\xab\x49\xb1\xe8\x85\x7e\xd4\xff\xd8\xb6\xa9\x27\x28\x89\x63\x6c\xfe\x57\xe9\x66\xc1\x9d\xe1\x64\xa4\x16\xdc\x59\x9d\xd1\x59\xc1\x99\x80\x8b\x04\xc7\x2f\x47\x26\xbd\x5a\xd5\x2a\xe7\xdc\xac\x6a\x9d\x53\x2d\xeb\x51\x82\x15\x49\xc7\x2f\x2c\x54\xd5\x1a\x8a\x61\xcf\x38\x37\x98\x86\xac\x7a\x5d\xc7\xb1\x62\x93\xe7\x38\x1e\x8f\x9a\x99\xac\x3b\x53\x32\x96\x59\xdc\x9e\xab\x5d\x0b\x27\x15\x30\x35\x86\x0c\x15\x24\x30\xe0\x5b\x66\xf9\x76\xa4\x5f\x0d\xc4\x7a\xbe\xb0\x86\x4a\x30\x61\x50\xab\xc9\x65\x8e\x2b\xf6\xe9\xac\xb1\x5b\x5a\xe4\xb9\xd0\x04\x0d\x3c\xef\x96\x2d\xf9\xe6\xb6\xb1\x1b\x47\x09\x34\x0b\x88\xd0\xd9\xce\x5e\xa9\xe8\x43\x27\x56\x3c\xf5\xe2\x1c\x5f\x8c\xf5\x24\xe8\x9c\xf5\xa6\x58\x67\xaa\xa5\xb3\x17\x38\x8e\x13\x9c\xc4\x2f\x6d\x56\x8d\x6e\x35\x0b\x2f\x5e\xe2\x38\x1e\x55\x79\x35\x1b\x8d\x13\xb5\x0d\xc0\xac\x57\xb9\xeb\x82\x8b\x9b\x6a\xdd\xaa\x91\xc6\xe3\x33\x9b\xeb\xb0\xcd\x17\x0a\xfc\x97\x9a\xc7\xeb\x4c\xcd\x39\x35\xaf\x7f\xf9\x12\x27\x8a\x41\xab\x5e\xf9\x52\xd4\x94\x9e\x28\x94\x2b\x70\x55\x86\x90\x8b\x82\xd1\x5b\x23\x72\xe8\xb5\xa1\xd2\x4b\xb9\x2d\xf2\xd2\x91\x3a\x92\x44\xe1\x23\x5f\x2c\x68\xc9\x85\x23\x8c\x5c\xc4\x73\x2c\xe8\x1d\xfd\x67\xee\x72\x45\x25\x2e\xbc\x18\x41\xce\xd6\xec\xba\x6a\xf9\xe4\xd9\x32\xa3\x0b\x28\x34\xd2\x02\x8e\x9a\x3e\xd8\xe5\x6a\xd6\x63\x53\x96\x05\xbd\x52\x93\xf4\x02\xc7\x67\x09\x56\x82\x47\x63\xcf\x3b\x07\x22\xd1\x49\x9a\xeb\x8c\xc7\xf8\xfc\xa5\x4e\xd4\x13\x06\xac\x11\xa6\xe5\x6c\x8e\xd7\x34\x63\x2e\xdb\x1c\x5d\xe0\x04\x56\x42\x64\xf2\xcc\x12\x1c\x27\x38\x19\xc7\x38\x1e\x27\x3a\xdd\x41\x78\xfc\x62\x8c\x75\xbd\x0b\x9d\xe7\xe0\x3b\x51\x0b\x20\x4e\xf4\xc6\xb4\xa6\x6b\xba\xa5\xf7\x2b\xbe\xb6\xb2\x98\x9a\x8c\xd1\x1c\xaf\x19\x5d\xac\xd6\x9b\xeb\x6b\xcb\xcd\x15\x06\xc7\x2a\xbd\xd8\x68\xbe\x19\x8f\x46\xf8\x5c\x95\xac\x17\xf8\x4b\x35\xcf\x2a\x29\xdb\xa8\x89\x52\xb3\x7a\xae\x76\x8c\x78\x8e\xd7\xf9\xfd\xb2\x12\x97\x5e\xa8\x15\x76\xa6\x71\x5a\x51\x26\x90\x3f\x20\xbf\x60\x57\x6c\xb1\xa0\x55\x4e\x94\x60\x18\xe7\x48\x65\x2d\xed\xe2\x89\xe6\xb8\xc8\xcb\xad\x95\x06\xf5\xee\xaf\x25\x80\x22\xdf\x52\xb3\xa2\xcf\x35\xe3\x07\xa2\x28\xe9\x72\x99\x31\x5b\x61\xf4\x52\x4d\x82\x5a\x07\x35\x2b\x32\x0c\x54\xcd\x42\x49\xc5\xd2\x36\x9e\x9c\x9d\xe1\xf8\xfc\x0c\x2b\x79\xb6\x5e\x2e\x67\xe7\xb0\x77\x5e\xbc\x80\xc4\x72\xc5\xb2\xcc\xb2\xf9\xb1\xc6\x7c\xc9\x99\x10\x4a\x58\x3a\x8f\xf0\x45\x82\x15\xe7\x2f\x79\x76\xa7\xb6\x0a\x85\x2b\xf3\x7f\x8e\x0f\xd9\x9a\x22\x22\x77\xf1\x45\xe7\xf8\xa5\x59\xea\x0d\x46\x97\x68\x80\x15\x17\x6e\xf0\x38\x37\x5d\xd4\xfc\xcb\x6c\x4d\x07\x0b\x17\x44\xdb\x39\x76\x38\xe1\x0b\xd8\x04\xf5\x86\x29\x61\x17\x89\xe1\x4d\x6f\x79\x92\xa9\x0d\xc7\xd9\x6e\x94\x4a\x23\x33\x90\x9c\xcf\xb5\x78\x13\x9f\xcf\xb1\xcc\x6f\xa9\xcc\x75\xe7\x2f\x5f\xe2\x17\xf1\x1c\x3b\x44\x7a\x7e\xa6\xa9\x20\xba\x98\x63\x2b\x49\xc1\x9e\x02\xbb\xe8\xc5\x1c\xdf\xaf\x18\x95\x66\xc1\xc3\x3a\x7d\xa9\xd2\x1c\x89\xc6\xec\x69\x90\x56\xde\xe6\x1f\x5d\x85\x44\x21\xbb\xcd\xbc\x23\x9b\x54\x2d\xa1\x33\xcb\xae\xf7\x58\x90\xa6\x12\x5b\x69\x7a\x8c\x3c\x1a\xa5\x34\x7d\xdc\xef\x71\xa5\xa2\xa2\xfa\x71\xef\x37\xd4\x39\xa5\x94\x09\xf2\xb8\x9f\x5c\xe7\x85\xaf\x5e\xf8\x09\x17\x27\x0c\xb1\xb6\x52\xc7\x51\xaf\xe7\x8b\x19\x9b\xf1\xf9\x9c\x70\x04\xda\x1c\x25\xb2\x52\x82\x1f\x8b\x9b\xab\xf4\x71\xb1\xa2\x42\xb0\xac\x4c\x47\x38\xa3\x57\xea\xc1\x2b\x6e\xae\xbc\x3d\x5e\x95\x59\x67\xee\xaa\xcc\x20\xf7\xee\x48\xee\x9d\xca\xbd\xef\x6e\x79\x75\xaf\x5a\x5e\xdc\x6e\x3f\x3a\xd9\x67\x55\xb6\xca\xf0\xf6\xf8\x61\xfb\x6b\x67\xed\x87\xed\xaf\xde\x5e\xbd\x75\xe6\x66\x54\xb5\x9d\x2d\x56\xdd\xb9\x8b\x95\x82\x8b\x3d\x38\xb9\xb1\xcd\x9d\x79\x2b\xf6\xe0\xcd\xf7\xf8\x23\xdb\xde\xe7\xc5\xb2\xbb\x8c\xc9\x54\xe5\xa8\x28\x79\x7c\xde\x5d\x4c\xe7\xd9\x52\xc9\xf8\x89\x62\xc9\x18\xca\xad\x16\x37\x5d\x30\xcf\xbc\x95\x87\xbd\x85\x87\xbd\x1b\x68\x6d\xad\x18\x56\x67\xb9\x22\x3e\x57\xa5\xe0\xef\x95\xee\x1b\xd6\x70\x67\xc7\x2a\xc7\x9b\xef\x6b\xfa\x29\x14\xfd\x50\xc4\xaf\x7d\xda\x26\xa1\x02\xa1\x47\x7e\xed\x9f\xfa\x9e\x6d\xc9\x53\x65\x67\xc5\x1c\x21\xb9\x2a\xf2\xfb\x13\xc1\xee\x4f\xfe\x58\x14\x79\xe1\x7b\xb7\xbc\x2c\xb9\xb8\x39\xb1\x45\x4f\xd6\xa6\x99\xf4\xc4\x0b\x0a\x34\xd1\x0d\x69\x38\x9e\xdf\xcc\x89\xae\xd0\xd1\x98\xaa\x1f\xea\xdc\x30\x63\xe2\x46\xae\x4e\x09\x81\x44\x0b\xc1\x61\xe3\xb6\x51\x2a\x96\xba\xe1\x93\x45\xbe\x11\xb2\x3c\xb9\xe5\xe5\x2d\x95\x8b\x95\x69\x5e\xe1\x25\x6f\x36\x86\x4b\xe2\xf4\x38\x59\xb2\x8c\x49\x76\xd2\x2c\xe2\x26\xea\x72\xf8\x7b\xb0\x56\x85\xda\x24\x54\x21\x56\x95\xc0\x35\x52\xf1\xe3\x1d\x55\x9c\x31\xdf\xa3\x27\x2b\x18\xe4\xd9\xe2\xe5\x1e\xed\x69\x58\xdc\x5c\x85\xab\x32\x23\x6d\x26\xc1\xb0\xc0\x9c\xc8\x59\x34\x1f\x2a\xfe\x44\x89\x9c\xc5\xfa\xb1\x20\x72\x96\xe8\xc7\x9c\x7c\x4b\xe5\x2a\xbc\xe5\xc2\xe7\x98\xe2\x02\xe1\xd2\xa4\xd0\x07\x9b\x92\x91\x72\x90\x4f\x0c\xcb\x2a\x09\x21\xf9\x94\x91\x28\xe5\x84\x90\x72\xca\x88\x4f\x07\x05\x1a\x66\x29\x35\xef\x49\xe0\x17\x03\xae\x52\x0a\x95\xd2\xeb\xf9\x8c\x9c\x05\x3e\x1f\x50\x34\xcc\x10\xf6\x59\xdd\xe5\x79\xd4\x67\x78\x74\x1e\x21\x74\x19\xa9\x72\x01\x51\x2f\x58\x10\x3f\x0f\x4a\x34\x4c\xf0\x8c\xe1\x38\x8a\xfa\xbe\xee\x35\x4a\xc5\x25\x09\xc7\xd3\x6c\xe8\x97\x41\x8e\xd2\x6c\xe8\x27\x83\x72\x90\x23\x04\xa5\x84\x5a\x25\x06\x1d\x77\xdd\xe8\x50\x03\xc2\x79\x8d\x94\xb2\x46\x4a\x56\x23\x65\x53\xa3\x20\xc7\x25\xce\x10\x5e\x92\xcd\xa0\x82\xda\xa4\xad\xc8\xa1\x4d\xcf\xdf\x0c\x24\x1a\x9e\x0f\x97\x41\x38\xde\x5b\x94\x45\x84\x90\xe5\x94\x92\x82\x44\xa9\x5f\x90\xe5\x70\x83\x19\x59\xf9\xb9\x1a\xe8\xca\x2f\x11\xe6\x64\xe5\x67\x08\xe7\x84\x90\xcd\x94\x12\x3e\x10\x69\x69\x9e\xe3\xe1\x28\x60\x03\x9e\x66\xea\xbd\xd7\xf3\x29\x49\x86\xa3\x40\x0c\x18\xc2\xf4\x32\x9a\xd2\x80\xc4\x29\x7d\x15\xab\x9c\x01\x89\x11\xc2\xb3\xd1\x79\xd4\xa7\x80\x8f\x02\xfe\x6e\x6a\xac\xdc\x5f\x1d\x62\x05\x70\x81\x05\xe0\x01\xc8\x25\x99\x1b\xb8\x67\x15\x69\xf9\x12\xa9\x42\x30\x13\xb1\xc2\x50\xbf\xc2\x05\xc3\xd5\xa3\xc0\x1c\x99\x99\xf0\x39\x89\x07\x6e\x49\xfa\x50\x95\xa4\x0f\xa6\x64\x05\x97\x62\xfb\x5d\xd3\x55\x4f\x13\xaf\xa7\x89\x56\xd3\x64\xc1\x84\x0e\xfd\x78\x20\x06\x2e\x69\xc5\x03\x81\xe3\x01\xc7\xf1\x80\x22\x84\x86\x7e\x3c\x60\x68\xb7\x8b\x0c\x7c\x7e\x3c\xe0\x03\xd6\x95\x4c\x0f\x93\x59\x05\xa9\xd9\x02\x0e\x80\xe5\x44\xcc\xe4\x5c\x31\x25\x8e\xcc\xa4\x73\xbd\xdf\x2a\x72\xc3\x25\x89\x87\x51\xc5\x6f\x33\xbd\x5f\xf3\x6b\xff\x60\xcb\xce\x90\x6e\x6f\x43\xd8\x2c\x9b\xe3\x25\xf1\x0b\x22\x71\x4e\x36\x1a\x77\xeb\xfc\xde\x2f\x66\xd1\x7c\x90\xab\xe9\x48\x50\xe0\xa4\xc6\x2a\x35\x6e\xa7\x26\x2a\x35\x51\xa9\x68\xb2\xbc\x54\x6b\xb1\x24\x4b\x4c\x49\x86\xf6\x06\x4e\xaa\xc6\x66\xc6\xa5\xc6\xd8\x41\xd4\x27\x6c\x26\x2b\x14\x3c\x6c\x7f\x3d\x42\x44\x30\x3d\xa2\x9e\x29\xde\x3d\x53\xe1\x59\x9c\x9c\xf5\x7d\x46\xd8\xab\x30\x3a\x8b\xce\xc6\xd3\x0a\x5e\x9f\x05\x61\x34\x1e\xa3\x61\xac\x7e\x70\x12\x9e\xa1\x94\x0d\xe3\x24\x7c\x99\xa0\x20\x1c\x8d\x5f\x9c\xf7\x7d\x41\xc4\x61\x3d\x71\x58\x4f\x54\xf5\xe2\x8b\x68\xac\x28\x92\x1f\xd6\xe3\x87\xf5\xb8\xa9\x67\x28\x22\x4c\xe2\xe4\xbc\xcf\x82\xf0\x45\x3c\x4e\xfa\xaa\x9f\x17\x49\xd2\xe7\x36\x37\x8a\x5f\x8e\x54\x6e\x1c\xbf\x84\xdc\x97\xe3\x68\xdc\xe7\x35\x69\x67\xb4\x6b\xc9\x55\x98\xf4\xa5\xe2\x01\x4c\xcd\x26\x27\x4c\x4d\x5f\x41\x58\xbd\x02\x4f\xf8\x90\xc4\x51\x84\x0b\xf5\x73\x11\x5e\x5c\x8c\x14\x6b\x14\x43\xf2\x72\x1c\x46\x67\x2f\xd0\xab\x30\x8a\x2e\x2e\xc6\xe7\xf5\x88\x04\x8e\x87\x23\x94\xbe\x08\x5f\x5c\xbc\xe8\x8b\x20\x3e\x1f\xc6\xf1\x39\x9e\xc5\xf1\xb9\xc5\x40\xab\x02\x77\x2b\x70\x53\x01\x0d\xe2\x73\x3c\x56\x03\x14\x03\x8e\x70\x02\x2b\x7a\xe0\x17\xa4\x38\x6c\xa0\x70\x1b\x28\x6c\x03\x1a\x03\xab\x32\x3b\x20\xa9\x4e\x56\x3c\x3a\x8f\x2c\x2b\x56\xe3\x35\xac\x38\x8e\x22\xb5\xa4\x14\xfb\x2c\xcd\xb2\x9a\x15\x44\x31\x95\x0c\x17\xb8\x98\x4f\x18\x49\xfa\xd9\xc0\x17\x24\xbb\x54\xbb\x42\xdf\x8f\x83\x12\xa5\x59\x50\x0e\xb2\x7e\x89\x30\x25\xe6\x64\xa0\x5a\x7a\x1b\x12\x4d\x36\x97\xa3\xc9\x26\x08\x90\xcf\x49\x1e\xc4\xc3\x51\x7f\xe0\x6f\x06\xb1\xde\x81\x78\x10\x60\xae\xb8\x29\x1f\x0c\x70\x41\xce\xfb\xfc\x32\x9e\xb2\x40\x91\xdd\x80\xa1\x3e\x4f\x13\x48\x11\xe9\xa8\xcf\x2f\x93\x29\x0b\x74\xba\x9f\x0c\x47\x03\x8e\xfa\xe7\x29\xc3\x74\xb6\x99\x03\x8c\xc5\xc4\x5d\x61\x0a\x15\x9d\xbb\x92\xcb\x7f\x61\xf0\xbc\x1a\x3c\xa6\x44\xe0\xc2\xdd\x8e\xc3\x28\x46\xb6\x59\xd1\x27\x3e\xef\x93\x04\x5d\x92\x78\xca\xd3\x64\xc0\x31\xed\x93\x42\xbd\x15\x69\x32\x28\xaa\xbd\x53\xe1\x8f\x4f\x93\x3e\x1d\xfa\x45\x40\x51\x9a\xf4\xc5\xd0\xe7\x81\xb0\x24\x0e\xcf\xc3\xc4\x4e\xd9\x5d\xf7\x94\xe9\x89\x3a\x8f\x8e\x82\x0a\x60\x5e\x67\x79\x5e\xf8\x0c\x7d\x71\xae\x28\x79\xd0\x48\xc3\x39\xe0\x85\xf7\x15\x6f\x56\x72\x46\xfd\xd6\x07\x29\xc3\x79\xf7\xe3\x41\x81\xd0\xa4\xbc\xe7\x72\xb1\x82\x61\x2a\xd6\x8f\x1e\x17\xb4\x64\x27\x51\x6a\x88\x81\xe3\x0c\xe7\xf3\x09\x24\xc6\x36\xb1\xc4\xbc\x4a\x4c\x6c\x62\x8e\x39\xce\x4c\xe2\xa8\x4e\x2c\x31\x37\x89\x67\x36\x31\xc3\x79\x95\x38\xae\x3b\xca\x71\x39\xdf\x5b\x04\x1d\x95\xb6\x60\x77\x8a\xe6\x20\x60\x19\x1c\xe5\x35\x8e\x4a\x57\xac\x70\xa7\x92\x13\x3f\x19\x14\xa8\x9f\x63\x41\x8a\x7e\x89\x67\x7a\xff\xf6\xf5\x62\xf7\x99\xc9\x2e\x61\xaa\x59\x9a\x34\xf6\x2c\x3e\x24\x76\xee\xee\xaf\x3e\xb5\xdc\x94\xe4\x52\x2f\xb9\x4d\x0d\xe6\xb2\x06\x73\x45\x36\xc1\xd2\xa2\x7e\x05\xb2\xc5\x66\x48\x56\x78\x39\x24\x2b\x25\xab\x9c\xab\x35\xc7\xdc\xf9\x3e\xef\x67\x08\xe1\xe8\x94\xf8\x71\x8f\x29\xad\x53\x6d\xff\x5c\x2d\xc0\x4d\xc0\xfb\xbe\x2f\x48\x3c\x58\xa2\xc1\x06\x29\xd5\xd7\x1e\x2f\x02\x86\xcf\x53\x3b\xa3\x44\xe0\x9c\x50\x5c\x92\xcd\xe4\xaa\x60\xf4\x63\x35\xab\x84\xe2\x9c\x88\x76\x46\x92\x16\x64\x63\x32\xa8\x9b\x31\x32\x19\xaa\x29\xe1\x66\x9c\x99\xa6\x36\xed\x8c\xb1\xe9\x5c\x65\x50\xb3\x2d\xce\x60\x05\x2b\xbd\xbe\x9f\xc3\xdf\x12\x30\xac\x24\x95\xa7\x96\x87\x42\xdf\xb1\xf5\x21\x67\x23\xcd\xd2\x9c\x1e\xfc\xb8\x96\x2a\x63\xcc\xd4\x3b\x45\x01\x45\x08\x1f\xe6\x8a\x27\x73\x79\x9d\x0b\x90\x3e\x6c\x7f\x7d\x8a\x16\x6a\x70\x3b\x49\xb5\xe2\x31\x64\x10\xbe\x3c\xbf\x78\xd9\xa7\x41\x1c\x5e\xbc\x18\x5f\xf4\x8b\x20\x8c\xce\x62\x85\x15\x4e\xd4\xa6\xf9\xa2\x4f\x83\x41\x98\x44\x67\x8a\xf7\x87\xd1\xf8\x45\x3f\xc7\x4c\x91\xec\x28\x4c\xce\xa2\x73\x95\x1b\x87\xe3\xd1\x8b\xa4\x5f\x04\x83\xf0\xec\xe5\xc5\x79\x3f\x87\x7d\x6b\x14\x8f\xa2\x8b\x29\x6c\xbc\xfd\x6a\x33\x61\x38\x1e\xaa\x5d\x78\xa0\x92\x53\xd8\x88\xfb\x4a\x10\x14\x47\x6b\x88\x8e\x1a\x4a\xed\xe1\x47\x6b\xf0\x8e\x1a\x1c\xeb\xe9\x70\xe4\xc7\x6a\xa9\x46\x98\x21\x1c\x5b\xa4\x8b\xce\x12\xc2\x29\xc1\x3b\x4b\x70\x28\x51\x4d\x4d\xb7\x5c\x70\x54\x14\x3f\x11\x5a\x10\xe0\xb5\x20\xa0\x90\xfc\x84\x20\xc0\xdc\x6d\x99\x35\x05\x01\x83\xcf\x67\x48\x0e\xb5\x20\xc0\x14\xcb\x4e\xb4\x48\xf0\x39\x92\x84\x1e\x72\x46\x8f\x08\x8e\x0e\x35\x4e\x98\xa6\xc4\x71\x14\x05\x8a\xf3\xd1\x20\x3e\x47\xd0\x06\xe6\x44\x0c\xb4\x2c\x19\x45\x20\x56\x9b\x4d\x51\xc3\x3d\x42\x56\x8b\xd5\x03\x1f\x55\x3a\xac\x06\x6b\x54\x6f\x99\x8e\x00\x53\xa4\xbe\x18\x18\x20\x87\x00\x34\x66\x24\xaf\xb2\xf3\xd4\x67\xad\x6c\x4e\xca\x2a\xbb\x4c\x7d\xde\xca\x9e\xb1\xbe\x99\x0e\x2c\xfa\x7a\xb6\xfa\x76\xb6\x2a\x24\x64\x8b\xd5\x51\x4d\xc7\x68\x39\x46\xc3\x31\x30\xab\x95\x74\x1e\x69\xea\xa5\x92\x8a\xc4\xa7\x98\xa3\x61\x32\x84\x94\x1f\xde\x35\x15\xe7\x99\xd0\x8a\x42\xf9\x4b\x21\x7d\xde\xe7\x01\xed\x53\x84\xb5\xfe\x92\x2d\x56\xdd\x64\xd7\xec\xde\xe2\x8a\x69\x66\xa0\x3a\x4f\xfa\xa6\x33\xd5\x3e\xd7\x2f\x8b\xbc\x54\x5b\xba\x79\x2b\x95\x26\x58\x4b\xbd\xda\x04\xf6\x2c\x02\xc7\x05\x89\xc1\xfe\x54\xdc\x6c\x6e\x99\x90\xe5\xb4\x7a\x9a\xc5\xf3\xb4\x52\xe7\x95\x0a\x9a\xcc\xad\x38\xe8\x1b\x02\x28\xf2\x8d\x58\xfa\xc5\x70\x1c\x21\x64\x15\xaf\x51\x64\xac\x37\xa3\x28\xf0\x9d\x52\x5c\x69\x22\xe8\xf2\x32\xd9\x39\x89\xc2\x24\xc6\x6e\x22\x83\xc4\x8a\x6a\x12\x42\x48\xd1\xeb\xf9\x79\x40\x14\x8e\x73\x2b\x05\x74\x8c\xd2\x4a\x7c\x0e\x16\xfc\x4a\xa6\x52\xc2\xbe\x1a\x32\x72\xd1\x94\x8c\x9f\x87\xa7\x7a\x5a\x08\x11\xbd\x9e\x00\x99\x8e\x5d\x5e\x4c\xe3\xf3\x94\xbd\x4a\xce\x2e\xa6\xc9\x28\x4e\x9d\x41\xf8\x6c\x70\x81\x86\xc9\xd9\x8b\x7e\x72\x86\x82\x64\x94\xa4\xf1\x79\x30\x3a\xef\xb7\xc7\xd9\x1f\xa3\xa0\x91\x2a\x6c\x6a\x1b\x77\xfd\x31\x40\xae\x87\x75\x6c\x17\xfc\x22\xae\x64\x76\xb6\xdb\xbd\x50\x3f\x76\x62\xe4\xab\xb1\xa5\xd5\x70\x8c\xf0\x8c\x11\x36\x8c\xa3\x70\xdc\x57\xb2\x1d\xc3\x6c\x3e\xd1\xa6\xed\x50\xed\x6d\xc1\xff\xfe\xdf\xbe\xaa\x50\x4d\xc3\x0c\x64\x8b\xbe\x80\xd2\x3e\x7b\xf5\x2a\xee\xc5\xee\x6b\x52\xbd\xce\x2d\x94\xc9\xf8\x10\x4c\x7e\xed\xcb\x57\x24\x19\x25\x16\xe2\x38\xea\xfb\x72\xa0\x12\x82\x0b\xdb\x15\x03\x70\xf6\x00\x8e\x45\xbc\x1c\x10\xc5\x40\x1d\xa9\x47\x0e\x47\xe7\x68\xa8\xc1\x77\x92\x7d\x41\xe4\x17\x2a\xa7\xca\x14\x5f\x9c\xeb\xa7\xda\x12\xc3\x1e\x3a\x90\xe7\xfb\x7e\x32\x1e\xf7\x1c\xbc\x2b\x3a\x40\x8a\x3c\xcf\x51\xd0\x91\x19\x43\xe6\x05\x0a\x0e\xb3\x92\x39\x42\x28\x94\xf9\x7b\x59\x70\x71\xe3\xc7\xe7\xea\xe5\x2f\xeb\x35\x2b\xde\xd0\x92\xf9\x16\xab\x5e\x04\xff\xbc\xb0\xdc\x5c\x95\xba\x28\x33\xc6\x54\x14\x30\xa0\x74\xf6\x70\x6c\xae\x9b\xcd\x83\xf9\xd4\x1f\xce\xe8\xe0\x3a\x1a\xbc\x9c\x3f\x9e\xef\x77\xd5\xf3\x68\x3f\xe4\xda\x00\x6c\xa9\xc1\x6a\x66\x7a\xca\x95\x06\x3c\x19\x29\x62\x99\x45\x73\xd3\x7f\xaf\xa7\x36\xaa\xb0\x5c\x67\x5c\xfa\x9e\xa7\x3a\x58\xfb\x5d\x6e\x74\x32\x90\x7b\x84\xc2\x7f\xe6\x5c\xa8\x72\xda\x78\xcb\xc9\x9a\x16\x25\x7b\x27\xa4\xda\xd9\xce\x2b\x2a\xe2\xaf\x5e\xc5\xe7\x3d\x30\x4f\xbc\x7a\x75\xd1\x33\x87\x37\x3d\x5e\x4f\xcd\xe2\xe6\x37\xd8\xa2\x5c\x35\xad\x61\xe6\xc2\x14\xb9\x36\xd6\x86\xb1\x4c\xe5\x95\xa4\xa8\x6d\xab\x8c\x94\x97\x24\x9a\x46\x60\x38\x15\x53\x6d\x32\x2d\xbf\x38\x87\x77\x3e\x4d\x02\x9f\x0e\x04\x1a\x96\xe9\x59\x00\x9a\xf9\xb0\x0c\xce\x30\x1b\x92\x73\x6d\xf5\xf3\xd9\x17\x24\xd6\x5a\x41\x69\xcc\xa6\x97\xf1\x34\x1f\xfa\xf1\xa0\x44\x69\x54\xeb\xe4\x9d\x63\xac\xe5\x40\x51\x8b\xad\x9c\xc4\x98\x12\x2b\x0f\xfa\x9c\x08\xa5\x69\x27\x7d\xd6\x17\xa9\xfa\x0b\xaa\x1c\xba\x04\xfb\x23\xf1\xc5\x20\x1c\xf7\x39\x98\xcf\x38\x42\x78\x26\xad\xd5\x90\xc3\x5f\x5a\x69\x98\x9f\x03\x00\xeb\x0b\x07\x84\x13\x5e\x77\xf6\xc9\x9e\x16\x37\x4f\x09\xeb\xa3\x63\xca\xac\x65\x60\x02\x39\xa2\x3a\xc7\xce\xdf\xb9\x35\xee\x59\x0b\x03\xce\x09\xfb\x22\xee\x9f\xe3\x92\xe4\x5f\xc4\x38\x23\xf1\xa0\xb4\x2a\x94\xc3\x1b\x72\x54\xab\xb0\xb3\x68\x4e\x62\x5c\xcc\xe2\x39\x29\x71\x31\x4b\xe6\x24\x6a\x69\x3e\xaa\x44\xa6\x4b\xc4\x1d\x25\x12\x5d\x22\x6a\x94\x28\x5b\xba\x90\x53\x22\xd3\x25\xe2\x96\x52\xa4\x4a\x94\xba\x44\xd4\x2c\x61\x35\x35\x17\x54\x53\x24\xab\xcc\x87\x04\x48\xa0\x92\xa0\x45\x5f\x95\x0e\xa8\x15\x97\xfb\xaa\x56\xe3\x35\x51\xaf\xd5\xfc\x1c\xb3\x89\x54\x94\xc0\x02\x3b\x2d\x7d\xb0\xca\x62\x5e\xd3\x82\x78\x15\x81\xb2\xc9\x86\xe2\x90\x06\x84\xd3\x47\x87\xba\xde\x45\x6d\xba\x87\x20\x1c\xf7\xd9\x41\x37\x40\xf8\xaa\x2b\x3f\xe9\x0b\x94\x8a\x57\x24\x1c\xab\xd4\xd8\x40\xe0\x27\x66\x31\x3c\x09\xc9\x11\x0b\xfc\xd1\xd1\x5a\xb6\x55\xb5\x08\x06\x27\x63\x89\x1f\x88\x5a\xef\xff\xe4\x8a\x8a\x07\xce\x9a\x12\x03\xf6\x1b\xd7\x14\x9c\x42\x1e\xb1\x13\xcf\xb4\x89\x68\x3c\x1e\xe9\x7d\x0f\x7a\x77\x5f\x13\xe7\xb5\x16\x16\x55\x8b\x47\x5b\x53\x64\x03\x75\xb0\xe5\xba\xd5\x6b\xe2\xbc\x42\x6b\x37\x05\xdd\x3e\x09\x9a\x1a\x86\x86\xe4\xc8\x4b\xdd\x8c\xa2\x99\xea\xf1\xae\xab\x45\xb5\xf0\x55\x5d\xa7\xce\x7d\x67\xd7\xda\x25\xb6\x59\xf4\xe0\xc8\xc3\xdd\x15\x5b\x65\xdb\x42\xbb\x4b\x12\x8a\xf7\xd4\xfd\x77\x4a\x15\x1d\x22\x85\x1d\x2e\x9c\xb4\xf9\xcc\xc8\x17\x0c\x44\x09\xf6\xdb\xa4\x06\x51\x49\x0d\xc2\x4e\xac\x82\xa9\x0b\x70\x00\x21\x50\xd3\x09\xc4\x8e\x86\x23\x98\xc7\x38\x8a\xe6\xfb\x3d\x42\x13\xa1\x6a\x63\xa1\xa6\x00\xfe\xde\xa9\xbf\xf7\x2a\x45\x61\x0d\x0b\xa5\x49\x62\xa1\xd0\xa2\xfe\x2e\x56\x2a\x9b\x3d\x60\x61\x4f\x31\xb0\x30\x32\xaa\x79\x48\xc6\xea\x69\xb5\xb8\x51\xef\x8a\xd8\xb0\x00\xd8\x26\x16\xb6\x13\x5e\x63\xcb\xf1\xc8\xb7\x46\x63\x49\x1e\xf7\x98\x11\x73\x2e\xfb\x91\x6d\x4b\x5f\x28\x36\x64\x05\x25\x58\x49\xf4\x92\x4f\x68\x10\x20\x39\x63\x33\x3a\x9f\x93\xc7\x25\x2f\x25\x15\x0b\x96\x0e\x62\xbc\xa6\x05\x13\x32\x15\x9b\x2c\xab\x0e\x05\xe5\xde\x57\x8d\xcc\xa4\xb6\x4e\xb3\x99\x9c\x87\xb6\x0e\x89\x26\xdc\x34\x3e\x41\x16\x0c\x4a\x78\xb8\xce\xd7\x3e\xc2\x45\x13\x96\x19\x9d\x2b\x19\xa3\xb0\xe0\x94\x24\x9a\x94\x97\xf9\xa4\x0c\x02\x3d\xaa\x8c\x14\xb3\x72\x8e\xf5\xa1\xd2\x64\x10\x13\x42\x36\x55\x5f\xbd\x9e\x5f\xbf\x10\x05\x7c\xf5\x16\xc4\x78\x13\x6a\xd8\x09\xc5\x3c\xdc\x88\x72\xc5\xaf\xa5\x9f\xa1\xea\xf4\x88\xed\x2b\x24\xd2\x86\x67\x4b\x85\x47\xe1\x84\x3b\x48\x5f\x20\xb4\xaf\xab\x14\xba\x4a\xe5\xc8\x42\x66\x80\x06\xdd\x25\x96\x4a\x39\x14\x6e\xd2\x7c\x26\x61\xa7\xad\x53\x26\x6c\x96\x57\xcf\xa8\x06\xd1\x49\x56\xf8\xa2\xe0\x07\x93\xd7\xed\xe4\x73\x5c\x20\x68\xaa\xae\x6e\x63\x40\xc2\x45\x2e\xee\x58\x51\xf2\x5c\x10\x8e\x8b\xbd\xd6\x26\x1f\xf7\x93\x26\x05\x84\xd7\x79\xf1\x47\xba\x58\x35\x65\xd2\x7c\x26\xe7\x8a\x5e\xba\x4f\xf1\x55\x6e\xc7\xb1\xbf\x50\x03\xb2\xa9\x47\x5d\x00\x74\xe5\x96\x0b\x00\x54\xd5\x69\x7b\x34\x69\xd1\xb0\xac\x71\xcb\x08\xd7\x87\x4d\x8f\x7b\x4c\x1b\xf4\x03\x86\x79\xfa\x04\xf1\xd0\x59\x39\x9f\x28\xda\x3d\x25\x40\x41\x06\x5f\xe0\x5c\x94\xcd\x49\xe1\x67\x98\xd5\x14\x21\xf6\xbe\x44\x93\x66\x07\x1d\xa8\x12\xf6\xe0\x94\xcd\xc4\x7c\xa2\x86\x36\x13\xf3\x0e\xee\x55\xa5\x30\xd0\xdd\x14\x18\x8e\x4a\xc9\x26\x95\xb5\xc0\x0c\x00\xcc\xd5\x8c\xbc\x2e\x14\x43\x5c\x17\xb9\xcc\xe5\x76\xcd\xc2\x32\xe3\x0b\x16\x2e\x68\x96\xf9\x55\x0d\xa3\x29\x08\x22\x7d\x06\xca\xc9\x41\x64\x91\x40\x35\x69\x8a\xae\xd5\xae\xd6\x9e\x6b\x89\x80\xb5\x58\x89\x0d\x76\xad\x7b\x35\x41\x79\x5c\x9c\x28\xcc\x31\x97\xc8\xa4\xf3\x82\x30\xdb\xfb\x1c\x61\x83\x91\xb0\xa0\xf7\x9f\xc0\x8a\xed\x4d\x63\x66\xca\x52\xff\x5f\xc2\x09\x56\xd8\x40\xff\x02\xe8\x7b\x08\x53\x02\xd4\x96\x24\xc7\xd9\xef\xf1\x43\xbf\xc7\x0f\xfd\x1e\x3f\xf4\x7b\xfc\xd0\xef\xf1\x43\xbf\xc7\x0f\xfd\x1e\x3f\xf4\x7b\xfc\xd0\xef\xf1\x43\xbf\xc7\x0f\xfd\x1e\x3f\xf4\x7b\xfc\xd0\xef\xf1\x43\x9f\x11\x3f\xb4\x21\x8f\x37\x4c\xfe\x78\x73\x45\xd3\x25\xbe\x61\xf2\x6d\x99\xd1\x74\x85\x75\x5a\x7a\xa8\x20\x2e\x95\x12\x6e\x95\xe4\x5e\xcf\x28\x7b\x7e\x84\x47\x68\x6f\xea\x77\xd4\x5a\x7d\xaa\xd6\xfd\x55\xba\x50\x0f\xaf\xb3\xf5\x8a\x1e\xeb\x96\x5f\xfb\xb5\x82\x3e\x1b\xc1\xa1\xbd\x6e\xfb\x30\x75\xd1\x4a\x85\x10\x1d\x6d\x7d\x4c\x9b\x11\x53\x8c\xdc\xe5\x7c\x79\x12\x9d\x12\xc2\x7a\xbd\x11\x21\x44\x1a\xf5\x76\xca\x52\xa9\x1a\x34\x1a\xeb\x7f\x78\xc1\x9d\x3e\x38\x85\xdf\xd8\xfc\x26\x73\x14\xf8\xec\x15\x89\x7a\x3d\x76\x19\x4f\xef\x5c\xd7\x80\x64\x3c\xee\x33\x84\x52\xcf\x43\x7b\x5c\xdc\x5c\x75\x02\xa0\xe0\xbd\x8c\x77\x3b\xd5\x57\xaf\xa7\xfe\x5e\xc6\x16\xf4\x6b\x28\x62\x21\x28\x6e\xae\x7c\x2f\x00\x2b\xa7\x87\x4f\x3c\x6d\xea\x34\x4f\xc9\x3c\xf0\x90\x07\xbd\x50\xdb\x8d\xe2\x5b\x0b\x26\xe4\x67\x77\x7b\xa3\xbb\xd5\x56\x8c\xb6\x8d\xd7\xd8\x54\x11\xe6\xa4\x75\x68\x5c\x67\x51\xd2\x3a\x34\xae\xb2\x9a\x63\x11\x81\xf7\x85\x82\x9f\x9b\x5f\x1a\x78\x5f\xa8\x51\x18\xc0\xed\x48\x6e\xf0\xaa\xcc\x3e\x7b\x14\xeb\x06\xf2\x56\x65\xd6\x81\xbc\x2f\x6a\xec\x41\xc7\xab\x32\xb3\x9d\xae\xf1\xea\xbe\x7b\xc6\x34\xc1\x10\x20\x18\xdf\xa1\x1f\xd5\xfd\x54\xfd\x49\xe3\xba\xdb\xfb\xae\x39\x73\xbb\xf5\x02\xdf\xa5\xc0\x58\xfd\x4c\xa1\x2c\x53\x84\xa3\xa7\xd5\x06\x90\x75\x1c\x53\x5f\xcd\xa4\xb3\x9e\x20\x00\xcb\xda\x41\x97\xd6\x33\xc1\xae\x23\x6b\x91\x10\x24\xc6\x9c\x48\x7b\xb6\xfe\xbf\xfe\xc3\x9f\xd1\xc1\xf5\xeb\xc1\xd7\xfa\x54\x1d\x9f\xed\xd1\x7f\x0e\xc1\xd1\xd2\xf3\x74\xf4\xc1\x23\x25\x3e\x27\x5c\x91\xbd\x5a\x13\x55\x8c\x17\x89\x26\xc5\xa5\xb5\x58\x4f\x8a\x20\x40\x6c\x56\xcc\xeb\x43\x72\x3e\x2b\xe6\x81\xfa\x03\x87\xe5\x14\x0e\xdf\x1d\xda\xa8\xca\xd1\x80\xaa\x12\x15\x9d\x0c\xd5\x9f\x3d\xcb\x4a\x76\xa2\xfa\x3f\x0a\xeb\xf9\xbe\xf1\x9a\xec\xd1\x14\x40\x57\x00\xf3\x99\x52\x71\x00\x68\x00\xf8\xd3\xc0\x1a\x44\x26\xfd\x02\x27\xfd\x22\x48\xd0\xa7\xa0\x7e\x26\xcc\x6a\x55\x4e\x7f\xf6\x7f\x2e\xfb\xfe\x2c\x18\xcc\xa7\x3f\x2f\x03\xf4\x73\xd9\xc7\x9f\x4c\xf0\xa7\x69\x9d\x36\xfb\x79\xf9\x73\x38\x87\x74\x34\xfd\xd9\x0c\xf3\x79\x03\x9b\x15\x41\x3c\x47\x13\xa1\x93\xbe\xce\x72\xaa\x12\xcf\xe6\xcf\x03\xb7\xea\xf8\x8b\x06\x8c\xcf\x49\xfe\xd7\x06\xe0\xf2\xd2\x70\x3c\xee\x37\xa0\x87\x21\x3d\x77\x4c\xfe\xcf\xf7\x01\x1a\xea\xb0\x43\x4f\x16\x54\x94\xda\xda\xec\x11\x4d\xd4\x8d\x73\xb2\x68\xae\x83\x0a\x19\xc9\x66\x2a\x77\x6e\xf7\x92\xfd\x53\xc0\xde\xfa\xea\x47\x6b\x38\x8e\x6b\xa0\xd8\xed\x22\x42\xc4\xf4\xd6\x17\x4a\xd6\x43\x69\x8c\xd5\x8e\x44\x04\x66\xce\x91\xc5\xaa\xbd\x54\xeb\xd9\x50\x3c\xa9\x4d\x3c\xfe\x34\x5d\xb2\x1b\x34\xed\x44\x7c\xf7\x74\x7c\x6a\x36\x86\x66\x87\x35\x81\xc0\x0e\x52\x99\x42\xaa\x3d\x31\xbe\xad\xa9\x9f\x29\xc4\xe1\x08\x02\xed\xb0\x49\xb7\x35\x12\xc8\x81\xcd\xa0\x95\x33\x72\x73\x78\xf9\x1d\xfd\xce\x17\x68\x1a\xa7\x1a\x3b\xf3\xbd\x83\x94\xc5\x13\x48\xb9\xbf\xfa\xff\x24\x4a\xae\x1b\xa7\x61\xcf\xd9\x89\xb0\xda\x6b\xe9\x93\x82\x03\x6c\x36\xb0\xd1\x54\xfd\xdc\xb8\xfd\xd8\x16\x8e\x89\x02\x66\x43\x3b\x26\x0e\x74\x65\x27\x07\xd9\x3e\xd3\xdb\xf8\x6e\x17\xa3\x26\x2c\xeb\xdf\x30\x66\xb5\x68\x3e\xb1\xf1\x76\x8c\xfa\x56\xf5\x84\xeb\xa3\xc5\x43\x8f\x6b\x86\x25\xc2\x02\xd5\x55\xee\x8e\x3a\xae\x75\x9d\x70\x9f\x58\xb6\x71\x99\x4c\xbd\x48\x6d\xf1\x0c\xce\x01\xaf\xdc\xc0\xfb\x87\x13\x2e\x4e\x32\x74\x35\xcb\x66\x0f\xf3\x39\x79\x00\x41\xac\xeb\xc8\xfb\x44\x9e\x70\xa1\xcf\x54\xf3\xeb\x93\xed\x54\xa6\x4a\x25\x69\xa6\xc1\xc5\x6d\xe1\x1d\xcd\xf8\x92\x9c\xc6\xd8\xbe\x6d\x98\x09\xd4\xb7\x22\xc1\xaa\xcc\x9c\xe7\xbb\xfa\xf9\xbe\x2e\x03\x01\xf6\x15\x8f\xc4\x14\xa4\xf5\x78\x8f\xd5\x1c\xf8\x9e\x3e\xb1\xaf\x4f\xb9\xe4\xd4\x67\x64\x13\x1a\xfd\x42\x09\xe4\x53\xe8\xbd\x64\xf2\xaf\x00\x80\x0f\x37\x02\x60\x86\x52\x5b\x50\xa9\x1f\x9d\x05\x57\x65\xd6\x2c\x78\x7f\xa5\xca\xf5\x7a\x07\x05\xef\x75\x8b\x07\x67\x6e\xb2\xd7\xab\xe5\x2c\x9f\x11\x89\xc2\x62\xb7\xab\x25\xaf\xb0\x60\xcb\xa3\xf0\x39\xc5\xb2\x46\x25\x30\x97\x09\x56\x96\x47\x21\x76\x0a\xdf\x35\xaa\xc2\x24\x74\x54\xbb\x6b\x57\xbb\x6f\x54\x03\xbd\xaf\xbb\xc7\x7b\x17\x58\xb5\x40\xc2\x45\xaf\xe7\xbe\x6d\xa9\xd8\xed\xda\xd5\xe0\x72\x04\xcc\x10\x42\x28\x15\xec\xfe\x64\xab\x74\x2d\x20\xe8\xfd\xc4\x39\xd6\x23\x8f\xbc\xfc\xab\xa2\xa2\xd4\x71\x65\xb0\x64\x58\x91\x18\x28\x20\xc7\x4a\x94\x4c\xbe\x5f\xd3\x05\x33\x78\xad\x0f\x07\xf5\xa5\x10\x9f\xac\x05\x28\x6d\xd6\xba\x7b\x4e\xad\xbb\x56\xad\xfb\x67\x40\x08\xc8\x74\x6b\x01\xed\x7f\xb2\x9a\x46\xa6\x5b\xaf\xb8\xb9\x82\x03\xd2\x27\xd0\xb6\x61\x65\x58\xdc\x5c\x01\x16\x9e\x53\x76\x55\x66\x30\xf6\xe7\x95\xbd\x83\x11\x1f\x94\xd5\x1e\x28\x4e\x51\xcb\xa1\x94\x02\x22\x43\x58\xdb\x53\x09\x3e\x60\x8b\x5c\x2c\xa8\xf4\x67\x26\x75\x8e\x52\x48\xd7\x38\x79\x0e\x10\xaa\x9c\x56\x4d\x9f\x0f\x86\xd4\x21\xdc\xed\xae\xb5\x8a\xf6\x39\xcd\xac\xca\xac\xab\x19\x7a\x60\x6a\x68\xef\x2f\x72\xea\x8e\x41\x97\xf7\xdb\xab\x07\x92\x3d\xb5\x29\xa8\x1c\x35\xdf\xac\x53\x4b\xb3\xf5\xde\x68\x6f\x0c\xb3\x04\x22\x2c\xd1\xde\x1c\x02\x3d\xbb\x52\x0c\x95\xc0\x62\xf5\xec\x3a\x09\xd4\x59\x1d\xa9\xd2\xeb\xf9\x92\xf8\xf2\x0b\x08\xf6\xb8\x8c\xa6\xa3\xf3\x28\x90\xa9\x19\x53\xa3\x31\x58\x82\x1a\xea\x92\xca\x4d\x41\x55\x53\xcf\x02\x03\x6a\x6a\xd0\x2b\xa6\xf9\xfc\x8a\x49\xab\xcb\xbb\x67\x56\xbd\xb3\x7d\x56\x6c\xf3\x79\x15\xef\x1d\x3c\xd3\xc5\xc7\xcf\xab\xa8\x81\xd5\x7e\x34\xcf\x06\x53\x57\x82\xc3\xab\xe7\xd4\xd1\x8c\x46\xcf\x84\x3d\x60\x79\x7e\x3d\x3d\x34\x63\x29\x7c\x7e\xb5\xa4\xc6\xc8\x67\xd4\x1a\x69\xda\x3b\xb4\xbf\x55\x15\x37\x61\x95\xeb\xb7\x98\x62\xb7\xe1\xcc\xa9\x59\xe5\xb6\x6b\xe2\x83\xd5\x8b\x9a\xe6\xb1\xee\xc6\xe8\x67\xb4\x76\xc4\xbe\xe6\x34\xd8\x28\xf1\xac\x36\x3b\x2c\x5d\x2e\x9e\x6c\xae\xdf\xda\x10\x8e\xb5\xf5\xd4\x68\xeb\xec\xe7\xb5\x76\x68\x0e\x73\x1b\xbb\xef\x9a\x87\xd5\x7d\xf7\x28\x0f\xec\x59\x4e\x4b\x26\xef\xb9\xf3\xf9\xdd\xe6\xf6\x8a\x15\x4f\x6e\x06\xaa\x7a\xb5\x21\xcc\xa2\xf9\xe5\x65\x7c\xbe\x53\x0a\xc1\xe5\xe5\xc5\x4e\x69\x03\x7b\x9c\x6d\x6e\xb9\xc8\x4b\x2e\x1b\xdb\x4a\xed\xaa\xd9\x06\x86\x91\xd9\x1c\x0b\x12\x4d\xc4\xa5\xb5\x17\x4f\x84\xf5\x6d\xe3\x44\xce\x84\xbe\xa1\x82\xcd\xc4\x9c\xf0\x4b\x12\x46\xa3\x97\xc9\xc5\xd4\xdc\x02\x91\x3e\x75\x55\x84\xf1\x78\x33\x37\x44\x28\x05\x46\x5f\x12\xa1\x74\x4b\x73\x4f\x04\x03\x98\x17\xb9\x90\x05\x2d\x65\x87\xc1\x1c\xc0\xad\xc7\xe4\x23\x2c\x88\x6c\x24\x54\x0a\xc9\x2b\x31\xd5\xf7\x63\xa0\xa1\xbe\xef\x02\xa5\xe6\x77\x68\xd2\xf7\x38\x63\x77\xac\xcb\xb0\x0f\xdd\x58\x30\x5c\x23\xff\x2b\xf2\x22\x8c\xa7\xde\xeb\xd7\xaf\xbd\x94\xbd\x22\x67\xe1\x58\xbd\x78\xa9\xe7\xed\xc1\x89\xe4\xb9\xd3\xe5\x27\x2f\x5f\xf6\x41\x89\x1b\x5f\xbc\xe8\x83\x0e\x17\xc7\x67\x7d\xed\xdf\x1b\xb3\xd1\x65\x9c\x5c\x98\x0d\xe5\x90\x96\x4e\xa1\x4d\xd5\x9d\x8f\xf6\x58\xb0\x1b\x2a\x59\xf7\xec\xce\xe6\x98\x91\x68\xc2\x2e\x47\x13\xa6\x9d\x6d\xe1\xae\x85\x41\x0b\xa8\x19\xab\xe2\xe5\x3a\x35\x05\xb3\x63\x1a\x80\x5a\x7b\xba\x83\xb1\x7a\xa1\x55\x08\x53\x2a\x29\x5c\xd1\xd1\x97\xf8\x88\x2a\x61\xda\x56\xe3\xf9\xdc\xa6\x07\xcf\x6b\xda\xec\xaf\xec\x73\x1a\x8f\x01\xee\xf8\x19\x70\xb3\xdf\xd6\xfc\xe0\x79\xcd\xeb\x0d\xfe\x53\x4d\xdf\x5f\x3d\x0f\x72\xad\x4a\x99\xa6\x61\xc3\xfb\xbc\xb6\x9f\x9a\xcd\x46\xdb\x37\x05\xdb\x96\x0b\x9a\xb1\x4f\xae\x08\xcc\x48\x38\xd2\x6b\x21\x1c\xbf\xd4\x6b\x21\x8c\xe3\xbe\x1b\xc5\xd9\x49\x95\x26\xec\xd0\xf6\xb8\xc8\x18\x2d\x5c\x86\xd9\x39\x1a\x60\xb0\xc7\x9a\x35\x42\x2f\x1b\xb0\x7e\x45\xf2\xf9\x9a\xfe\xb2\xf9\xf7\x34\x1b\x38\xcd\x16\xb9\x7c\x16\xc5\x60\x41\x7c\xe0\x95\x12\x7d\x31\x3a\x8f\xea\x99\x88\xe6\x44\x18\x91\x56\xa4\xe2\x13\x44\x74\xcb\x1f\xda\x67\x3d\xda\x93\x17\x73\x52\x2b\xd2\xd3\x70\x9c\x32\x4c\x49\xd2\xe7\x83\x18\x17\x1a\x14\x00\xde\x47\x03\x61\x9f\x70\x4e\x7c\x9f\xf6\x0b\x42\x06\xf1\x94\xa6\x3e\x0d\x0a\x34\xf4\xe3\x80\xf6\x0b\x84\x82\x18\x0d\x13\x5c\x92\xb8\x0e\xd2\x83\x56\x8a\x9b\x2b\x3f\xef\xeb\x47\xb6\xf4\x51\x50\xf6\x85\x7e\xc2\x26\x19\x94\x05\x93\x61\x9e\x6d\x96\x52\x09\x4c\x8e\x7e\x44\x06\x16\x17\xc0\x3e\x0f\x2a\x10\xfb\x3a\x24\x67\x8f\x65\xfe\x5f\xef\xbf\xff\xee\x98\x16\xa7\x80\x52\xb2\x69\x96\x8b\x43\x32\xc5\x70\x8f\x02\xbb\x3f\xd9\x62\xee\x4e\x0a\xa6\x44\x58\x5d\xac\x71\x23\x20\x47\xfc\xf0\x3a\x40\xd0\x40\xe0\x98\xc8\x9b\x69\xcb\xcd\x09\xe8\x78\x73\x8f\x80\xb5\xe6\x71\x5f\xd9\xd6\xb4\x67\xb1\x44\x68\x4a\x67\xc5\x9c\x54\x87\x5f\x28\xad\xaa\x6a\x91\x00\xea\x32\x53\x2a\x5d\xe4\xa2\xcc\x33\x16\x32\x7d\x61\xdf\x46\xb0\x87\x35\x5b\x48\xb6\x3c\x59\xe4\x59\x5e\x9c\x68\x71\x5d\xf1\x70\xc7\xd1\x7a\x8f\x1b\x8e\xcd\x4a\xc7\xb7\x66\x33\xaf\x60\x4b\x0f\x7b\x30\x05\x1e\xf6\x14\xc2\x3d\x63\x45\xf3\x56\x1b\xe6\x61\xaf\xd6\x56\x3c\xec\x55\x3a\x8f\x67\xcc\x6b\x5d\x85\x00\x06\xcf\xd8\xdc\x4c\x81\x4a\x71\x81\x4e\x8c\x2e\xe2\x59\x5b\x9c\xa7\x14\x06\x0f\x7b\x46\x05\xf0\xb0\xa7\x85\x7a\x5b\xd8\x9b\x37\x87\x70\x4b\x1f\xaa\x11\x34\xdc\x09\x00\xf0\xd1\xb9\x76\x93\x8d\x23\x6b\x04\x6c\xa6\x28\xb0\x1a\x29\x1a\x08\xf3\x5a\x25\x37\xbb\xbc\xb1\x8b\xed\x48\xfc\x80\x4b\x33\x10\x46\xc0\x49\x34\xe1\xb5\x54\xc5\xc1\x1f\x5e\xc2\x27\x40\x5e\x4b\x9f\xa3\x39\x04\x6a\xcc\xf8\xdc\xb5\x58\x30\x4d\xd3\xbd\x9e\x2f\x42\x6a\xdf\x10\x16\xad\x19\xec\x80\xa5\x5a\xe5\xfa\xc6\x08\x07\x1a\xb3\xb2\xf5\xb4\xe3\x5c\xbf\x01\x06\x71\x46\xe2\x09\xbf\x6e\xd8\x56\x23\x6c\x18\x18\x21\x44\xa2\x8c\xb0\x89\x3d\x86\xaa\x22\x9f\xe9\x4c\xce\x49\xed\xfd\x60\x87\x88\x70\xa6\x86\x64\xde\xe6\x55\xbd\xda\xea\x57\x0f\x3f\x42\x73\x8d\xbc\x0e\xd9\x93\x9a\x68\x07\xa7\xb8\x40\xf3\x49\xa6\xd0\xb1\xef\x68\xb4\x50\xe5\xa3\xf9\xdc\xde\x0d\x57\xd8\xa0\xa1\x27\xdb\xde\xcc\xc4\xdc\x34\xaa\x86\xbb\x87\xfb\x3d\xe1\x91\x38\xf7\x92\x38\x57\xc8\x54\x8c\x33\x9b\x9a\x82\x69\x86\x50\x03\x5b\x46\x5e\x8b\x8f\xf5\xce\x3b\x9b\x36\xb1\x0c\xd8\xc0\x86\x90\x7d\x72\xcf\x0d\x39\xaa\xd8\xcf\x52\xb1\x9f\x02\x2d\x4f\x09\x91\xbd\x9e\x4f\x67\xcb\x39\x29\x55\x8d\xe5\xdc\x57\x35\xab\x95\x7f\x1a\x1d\x90\x0d\xd8\xf7\xba\xa8\x46\xc7\x98\xb7\x6d\x47\x42\xdb\x8e\x2a\xda\xf7\x25\x4a\x7d\x4f\x00\x5f\x72\xe2\x40\xe0\x60\xf9\x89\xf8\x09\x86\x50\x7b\xc7\x92\x58\x54\xb6\xa6\x16\x8c\x46\xef\x6e\x40\x89\xab\x80\x18\x87\xb2\xd5\x3c\x1f\x42\xcc\x67\x6c\x9e\xc2\x05\x10\x33\x36\x07\xf8\x53\x5f\x3d\x92\x83\x5d\x53\x62\x5e\xc1\xd0\xf5\x71\x9c\x7b\x2e\x96\xf9\x7d\xaf\xe7\xeb\x87\xf0\x8d\xe2\xb0\x64\xab\xcd\xca\x1f\x88\x13\x21\xf7\xb1\x36\x1f\x40\xfc\xd8\xcc\xfb\xf0\x01\xc6\xf4\xe1\x83\x87\xbd\x6a\x74\x1e\xf6\x14\xff\x96\xc5\x66\x21\xf3\xc2\x9b\x87\x5c\x2c\xd9\xc3\xf7\xd7\xbe\x44\x70\x7c\x72\x8f\xbf\x25\x8f\x22\xcf\xd7\xee\xf6\xb4\xc7\x1b\xbe\x4c\xfd\x7b\x12\xe1\xc3\x8d\xed\x3e\x08\xf6\x08\xf3\xf2\xbb\x4d\x96\x7d\x5f\xfc\x45\x8d\xa1\xcb\xa4\xa1\xa3\x5f\xe4\x1e\xf3\xb2\x65\x73\xd4\x27\x93\x7a\xee\x4c\x66\xaf\xd7\x78\xad\x5d\x90\x4e\x23\x13\x41\x65\xc2\x97\xea\x59\x6b\x6f\x6a\xd6\x6d\x64\x56\x1d\x5f\x28\x7e\x01\xd1\x90\x7e\x84\x5f\xa0\x5e\xcf\xab\xf7\xc5\x2a\x67\x70\x8e\x14\x84\xba\xf5\x63\xc3\xd0\x54\x5f\xed\x91\xba\x30\xb4\xf3\x49\xa8\x54\xe3\x5f\x73\xc1\x65\x97\x3d\xeb\x90\xac\xe5\x6e\xd7\x38\x98\xd2\xdb\x31\xea\xf5\x6c\x2b\x7e\x65\x1f\xfb\xbe\xf8\xca\x04\x83\x37\x97\xd6\xa1\x2d\x96\xa5\xd2\xd4\x79\x2d\xdf\xc1\xec\x77\x56\x75\x0f\xef\xc2\x66\x17\xfe\xb7\xce\xcc\x4c\x95\x8e\x97\xaa\xc5\xb4\xc7\x6a\x94\x57\x4d\x9b\x96\x6e\x48\x71\xf8\x5e\xaf\xe3\xdb\x4c\x12\x30\x53\x5d\x40\x02\xe1\x9f\x5b\x5f\x60\x86\xf6\x98\xd1\xc5\xaa\xd5\x12\xe6\x7a\x11\xc2\x55\x69\x6a\xdf\x70\x21\x41\xfc\xda\x2f\x2a\xa7\x33\xcc\x21\x36\x8c\x92\x62\x10\x4f\xe8\x2b\x12\x4d\xe8\x60\x80\x0c\x3b\x10\x58\xce\xe8\x1c\x53\xa4\xb7\x08\x5d\x30\x9a\xd0\xcb\x02\xa2\xc6\xba\x4b\x99\xee\xf4\x24\xab\xfe\xb4\x0f\x83\x9f\x37\xa2\xf5\x24\x42\xcd\x40\xb4\x83\x26\xf3\x19\x9d\xcf\xb1\xfa\x8b\xf6\x98\x2a\xe0\xff\xf8\xcb\x86\x66\x65\x97\xc0\xac\xaf\x85\x03\x27\x0a\xb9\xdb\x9d\xb2\xdd\x4e\xd6\x57\x29\x57\xdb\x61\x9b\xeb\x83\x4b\x92\xe5\xfa\x97\x7c\x12\x04\x02\x6e\x8f\x06\x6b\x0a\xdc\x60\xa9\x98\xbd\x4b\x59\x66\xd9\x15\x07\x69\xfa\x7a\xe9\x6f\x43\x07\x4e\x9f\xe2\xa2\x5a\x90\x71\xb5\x1f\xd2\x53\x42\x8a\x1a\x94\x7a\x03\x68\x89\xbb\x7a\xbd\x7f\x7b\xb8\xb8\x4f\x24\xdc\x20\xf2\x6d\x08\x15\xd0\xe4\x00\xdf\x8e\xac\x63\x30\xbe\x28\x18\x85\x55\x80\x45\x6b\x12\x70\x47\x40\x60\x10\x50\xc4\x66\x02\xc2\x7f\x4d\x2f\xbe\xd4\xef\xb5\xc1\x65\x5f\x85\xff\xe2\x0f\xb7\xac\xb8\x69\x68\x62\x96\x06\xf9\xb5\xff\x11\x20\xd2\x71\xbf\x4a\x8c\x82\x50\x58\x39\x9f\x38\x20\x53\xd4\xeb\x39\xaf\x05\x9a\x7e\x1b\x42\x93\x0a\x83\x98\xa3\x54\xd5\xab\x20\x29\xd0\xbe\xea\xf2\xdd\x75\xd7\x12\xaa\xbb\xe4\xba\x4b\x7a\xd0\x25\x6f\x76\x49\xab\x2e\xdf\x5d\xfb\x1c\x53\x94\x1e\xdc\x68\x2b\xd1\x6e\xe7\x37\x00\xa1\x48\x41\x02\xb5\x0e\xa0\x80\xce\x9d\x9b\x0a\xeb\x69\x64\x68\xca\xd2\x19\x9b\xe3\x0d\xc9\x2a\x89\x13\x48\xc7\x9d\x42\x8b\x5c\xa0\x54\x4e\x7c\xf0\xea\x79\xdc\x23\x0d\x64\xb1\xdb\x7d\x1b\x1a\x14\x98\x59\xdb\xc0\xac\x81\x2b\x6a\xa6\x16\xa3\xd3\x1c\xd3\x2b\xb0\x24\x91\xd2\x10\x8b\x56\xcc\xac\x5d\x85\x10\x2c\x1b\x04\x25\xe2\x3e\x04\x59\xeb\x81\xd4\x41\xde\xd8\xa0\xa7\x9b\x6f\xda\x09\x53\xb5\x1e\x0d\x39\x54\x20\xbe\xbb\xde\x2b\x36\xf5\x20\x99\x58\xa6\xa6\x77\x5a\x96\xfc\x46\xec\x76\x1d\x7b\x47\xdd\xd6\x6c\xde\x19\xdb\x89\x63\x54\x75\xd2\x46\x3c\xcc\x90\x9a\xed\xbd\xea\x93\x8b\x15\x2b\xb8\x2c\x8f\xa8\xf3\x58\x74\x7f\x44\xce\xdd\xfa\xd1\x14\x42\x43\xed\x7b\x87\xca\xca\x0c\x37\x86\x16\xdd\x93\x58\xee\x06\xe1\x5b\xcb\xa5\x6d\x88\x54\x51\xb5\x27\xdc\x39\xf4\x66\xf5\x33\x16\x4e\xba\x52\x78\x39\x16\xa1\xc6\xa2\xa2\x27\x33\x34\x2c\x15\x25\xeb\x64\xdf\xa9\x01\x6e\x23\xe1\x87\x0f\xe5\x66\xcd\x8a\x0f\x1f\x9a\x0d\xef\xf1\x87\x25\x5b\x17\x6c\x41\x65\xe3\xf4\xb0\xda\x3b\x1c\xff\x50\xab\xc7\xde\xd3\x42\xf8\x32\xf8\x43\x7a\xe2\xfd\x21\x10\xc1\x1f\xbc\x13\x5e\x9e\xd4\xad\x84\x27\x3f\xc0\x07\x10\x4f\x36\x25\x53\x25\x38\x94\x10\xa5\x64\x74\xf9\x07\xb5\x50\xde\x93\x6f\x27\xdf\xc2\x4c\xbe\x31\xdb\x1f\xd1\xaf\xea\x51\xd1\xab\x96\xb0\x0e\x84\x49\x83\xa4\xb6\xd0\x6a\x8a\x1b\x89\x01\x8a\xee\xf1\xb7\x95\x00\x5c\x6d\xc2\xa4\xbd\x2b\x3b\x85\xda\x1b\xbb\x2d\xdb\x4e\x07\x61\xea\x0d\x79\xcc\xb8\x60\xb4\xe8\x3c\x89\x52\xdb\x70\xc9\xde\x89\x3f\x6f\x68\xf7\x71\x6c\xdf\x14\xf9\x7e\x23\x8f\x94\x19\x48\xb8\xc0\x0b\xd9\xa6\x8e\x97\xf4\xe5\x90\x84\x63\x74\x19\x4f\xc3\x71\x5f\xf6\x65\x3a\x08\xc7\x7d\x7f\x60\x1b\x18\xc4\x55\x1b\x6f\x36\x57\x7c\x71\x04\x1e\x07\xa2\x63\xc5\x7c\x39\x20\x31\x52\x45\x83\xd8\x01\xeb\x78\xf1\x26\x5c\x7d\x99\x2a\xc0\x54\x2b\x89\x6e\xa5\x1e\xdd\x9f\x37\xb4\xe8\x14\x1d\x65\xbf\x09\xdb\xb1\x82\x03\xbf\x86\xae\x2f\x9d\x31\x3f\x51\xe7\x10\x40\x8b\xbc\x1a\xc6\xbe\x3b\x07\x7f\xde\x70\xf1\x14\x94\x0d\x38\xbb\x8b\xba\x50\xb6\xf0\x78\xbc\xca\x21\x98\x07\xb8\x6c\xe1\xf3\x3d\x17\x9d\x87\xbf\xe6\x72\xd5\x45\x5e\xfa\xb2\xef\x9b\xfb\x0f\x87\x09\x42\x15\xe0\xc7\x6a\x56\x17\x22\x76\xd5\x03\xf8\x8f\xd4\x04\x84\x56\xbd\x9a\xaa\x7d\xe9\xd2\xe5\x1f\x1f\xd6\x79\x57\x9f\x5a\xf4\x8e\xea\xe3\xaa\x04\xeb\x7b\xed\x62\x07\xe0\x63\x95\x63\xa8\x1c\xa7\x66\xc8\xba\xfa\x20\x8e\xfa\xd2\x05\xfa\x53\x5d\xdb\x56\x1a\x93\xd0\x05\x0f\xcc\x47\xd2\xee\x6b\x30\x90\x35\x8e\xde\xf0\xa2\x7b\xf5\xbd\x22\xf1\x54\xa6\x03\xbf\xbe\xe4\x32\x1e\x48\x17\x45\x6a\x95\x1d\xa9\xec\xd6\xb1\xc4\xe5\x0e\xf0\x48\xbd\x7a\x3c\xf5\xfc\x34\x7b\x4e\xdb\xe9\x96\xd8\x50\xe0\xcc\x5c\x46\x4b\xd9\x5a\xfd\xe6\x02\xc2\xf0\x45\x14\x8f\x2f\xb0\x16\xac\xe3\xc9\x31\xbc\x8a\xdd\xce\x17\x24\x1c\x21\xcc\x2f\xe3\xa9\xcf\x49\x8c\x19\x11\xc3\x33\x94\xaa\x1f\xbf\xba\xa4\x13\x99\xcb\x42\x15\x09\xc6\x43\x8e\xf0\x80\x1f\xce\x03\x89\x11\xaa\xaf\xee\xf4\xa5\xbe\xc5\xbc\x6a\x62\x28\x5c\xba\xf9\x3f\x07\x7a\x03\x72\x4d\x92\x4f\x43\x1d\x34\xf8\xd9\x6f\x86\x3c\x21\xc4\xcc\xbb\x03\xfe\xd9\xf8\xb3\xe1\x97\x97\xf1\xf4\x37\x61\xbf\x3f\x08\xc7\xe9\xc1\xe8\x9f\x57\x35\x1c\x3b\x58\xf8\xb2\xed\x03\xd2\x18\xff\xc4\x61\xcb\xbe\xcf\x02\xb5\x26\x06\xac\x9e\xfa\x67\xd5\xae\x38\xb5\x6d\x21\x60\xa8\xc1\xaf\x9f\xd9\x8a\x5d\x67\x1a\x98\x38\xf0\x59\x9f\xc4\xe1\x38\x19\x23\x0d\x55\x3f\x1c\x37\x79\xf9\x41\x21\xd5\x71\xcd\xda\xbf\xcc\x37\x62\x71\x84\xb9\xbf\x09\xed\x08\xa1\x90\x5a\xcc\xce\xa8\x8f\x56\x94\x97\xf1\x30\x09\x5f\x8c\xa7\x2f\xc2\xf1\x79\xa2\x85\x08\x79\x99\x34\xd2\x00\x1d\xe1\x18\xd2\x14\x4c\xe1\x8b\xb1\x2a\x63\x52\xdc\x52\x49\x98\x38\xc5\x5e\x8e\x5e\x8c\xd3\x46\xee\x79\x23\xfb\xe2\x6c\xf4\x62\xec\x62\xf5\x09\x28\xc3\xb1\xe2\xbe\x7a\x90\x16\x11\x7e\xd2\x97\xc0\xab\xda\x63\x4f\x40\x0c\x08\xc2\xf1\x7e\x8f\x7f\x20\x8f\xec\xfa\x9a\x2d\x64\x99\xbe\xd9\x4f\xde\xab\x92\x5c\xdc\xfc\x51\x27\x91\x37\x20\xd1\xbd\x26\xf6\x3a\xe0\xaf\xc8\xeb\x61\x7c\x11\xe1\x9f\x48\xd2\x7f\x8d\xdf\x91\xd7\xc3\x04\x7f\x4d\x5e\x0f\xcf\xf0\xf7\x2a\x65\x38\xc2\xdf\x90\x47\x38\xc7\x6c\x80\x29\xc3\x85\x7c\x08\x21\xfd\x47\xa5\x69\xc1\x95\x67\xe1\x3d\x5f\xca\x15\x96\xe1\x8a\xf1\x9b\x95\x9a\x0d\xb0\x0b\xb3\xe5\x8f\x4d\x5b\x19\xae\x6e\xb3\x07\xa5\xb5\xd0\xd4\xe4\x5c\xb1\x59\x60\x3a\x4c\x30\x1f\x26\x08\x97\x84\x05\x39\xce\x88\x08\x72\xbc\x21\x2c\xe0\x83\x1c\x2f\x89\x08\xe8\x20\x9f\xc8\xf0\x36\xbf\x63\x3f\xe5\x3e\xc3\x19\xc2\xe5\xe5\xa6\xd7\xcb\x2e\x97\x53\x5f\x86\xb4\x58\xf8\x25\xce\x70\x8e\x07\xaf\xf1\xe0\x1d\xc2\x3a\x69\xa3\x93\xde\xe1\xa8\x4e\x59\xe2\x1c\x47\xb8\x2a\x52\x42\xc2\x3b\xfc\x1a\xa1\xb4\xbc\xdc\x4c\xfd\xaa\x93\x12\xac\xc1\xcd\x76\x9c\x6a\x99\xae\x16\xbc\x43\x28\xed\x82\x22\x6a\xf6\x10\x41\x0f\xad\x32\xaf\x55\x99\x45\x96\x97\xec\x07\x2a\x57\xbe\x7a\xab\x46\x28\x4c\xac\x8d\x0c\x0b\x50\x6d\x35\x0a\xd1\x1e\x2f\x0b\x7a\xff\x43\xde\x14\xa7\x5c\x0c\x03\x6e\x95\x2a\x8e\xd5\x60\x57\xc4\x2f\x76\xbb\x08\xf5\xbf\x82\x78\x8b\x5e\xef\xf0\x5b\xec\xbd\x9e\x5f\x99\x2e\xdf\xfe\xf4\xed\x37\xef\x6e\xe9\x0d\xfb\x63\xc6\x94\x72\xa7\x0f\x09\x73\x52\x1b\x2e\x7d\x84\x76\xbb\x46\xf9\x37\x54\xdc\xd1\xd2\xad\x90\x3b\x06\x9c\x92\xde\x31\x18\x19\x44\x06\x65\x54\x32\x30\x3b\x60\x19\xea\xf3\x67\x7f\xa5\x9e\xd5\xa0\xa0\x5f\x9f\xe1\x01\xd3\x84\x35\x4c\xd4\xa3\xa6\xad\x61\x82\x4d\x2a\xb6\x49\x48\x9f\x7e\x28\xfc\x94\x32\x2f\x98\x6f\x3e\x57\x66\x83\x3c\x76\x3b\x71\x49\x22\x84\x1e\xcd\x9d\x9e\x32\xbc\x62\x37\x5c\x18\x4c\x3b\x5f\x35\xd0\x93\xa2\xb0\x27\x70\x84\x7f\x6a\xcd\x89\x73\xf5\xa6\x27\x0b\x4e\xc5\x4d\xc6\xbc\xb4\x9a\x28\x1e\x54\x9c\x7d\x85\xfa\x02\xd3\x5a\x08\x55\xef\x08\xaf\x02\xf2\x3d\x96\xa1\x52\xa8\xfe\xff\x50\xfc\x28\xa8\x8a\x68\x7e\xd4\xab\xd1\x4b\x37\x44\x0c\xfc\x25\x09\xc7\xf1\x79\x5f\x54\x57\xad\x43\x2b\xc1\xd7\xa8\xbf\xc1\x19\xa9\xfb\xd1\x29\x06\x29\x83\x12\xd3\x41\xa6\xe8\x68\xf0\x1a\xaf\xea\x95\xc5\x83\x0c\xd3\x41\x09\x19\xef\xf0\xaa\x4e\x2e\x31\x0d\xa0\x3c\x5e\x05\x75\xe9\x41\x86\x69\x00\xa5\x83\x77\x78\x15\xb4\xe9\xbe\x0d\xb8\x97\xaa\x99\x2c\xd0\xa3\xf9\xaa\xd7\xfb\x3f\xff\xf8\x53\xfc\x21\xe9\x0b\x6c\x56\x03\x1f\x6c\x30\x1d\x6c\x70\xd2\x87\xff\xa6\x81\xfd\x2a\x20\x5f\xbb\xc3\x97\x5e\xea\x8e\x55\x61\xd0\x1d\x29\x82\x06\xed\x44\xea\xa1\x22\x17\xf9\x30\xc6\x46\x0a\x0c\xcf\x4d\xd1\x23\x7b\x62\x3c\x8b\x22\x2f\x4b\x00\xa5\x86\x0e\xd2\xfe\x45\xd8\x2a\x48\x2a\x42\x3c\x80\xd6\xc0\xe6\x42\x53\x4a\x5a\xfc\x3f\xd6\xb1\x22\xe6\xaf\xf1\xff\x81\x51\xaa\xac\x7f\xcb\x28\xdd\x46\x97\xb4\x5c\xb9\x0b\x5f\x33\xb1\xe6\x42\xad\xfa\xa2\xcd\x85\x8b\xf6\x32\xbc\xe6\x59\x06\x8c\xb0\x94\x45\xfe\x91\xf9\x60\xdc\xe5\x25\xb0\xf2\x77\xe2\x75\xc1\x68\xb7\xc1\x51\x86\x0f\xaf\x58\x98\xb1\x6b\x39\x88\xd9\xe0\xbc\xd7\x93\xe1\xc3\x25\x0b\x0b\xc5\x03\x03\x9b\xb2\x7d\xa5\x38\xf4\xba\x2a\xb1\xbd\x64\xe1\x55\x2e\x65\x7e\x0b\x45\xf6\x78\x91\xf1\x75\x47\x1f\x0e\x7f\x76\xb9\xa3\xdd\x74\xa0\x5b\x0c\x4d\x63\xd3\xe5\xa0\x4a\xd4\xed\x0f\x20\x57\x2f\x00\xbe\xf6\xd1\x1e\x6f\x44\x47\x67\xaa\xab\x8a\x51\xef\xb1\x46\xdb\xb1\xb3\x1c\x22\xc2\x52\xb2\xf5\x9a\x2d\xbf\xe1\x82\xc1\x87\x23\x75\x74\xe9\x2d\x5f\x2e\x33\xa6\xb6\x18\xaa\x8b\x16\xc4\x67\xe1\x43\x20\xc2\x07\x34\x4c\x26\xd5\x74\x14\x98\x4f\x45\xb8\x4d\x59\xb8\x75\x26\x49\xa5\xb2\x70\x9b\x8a\x70\xab\x37\x57\x8f\x5e\x4b\x38\x5d\x23\xb4\xd7\x3b\xe5\xbb\x9d\x49\x38\x85\x04\x3e\xad\x6a\xb2\xf0\x01\xab\x5a\x69\x95\x22\xc2\x07\xac\x5a\x9f\x34\x52\xaa\x96\x4f\x44\x28\x99\x28\x79\x2e\xa6\x0a\xb3\xbf\x72\x56\xbc\xd9\x14\x9a\x70\xa6\x4c\xfb\x75\xe6\x19\xcc\xfd\x0f\x05\xbb\xe3\xf9\xa6\xfc\x7b\xda\x4c\xff\x8e\x3d\xc8\xbf\xe3\x63\xa5\xff\xd1\x51\xfa\x1f\x30\xea\x83\x36\x52\xd1\xdd\x5f\x67\xe9\x7f\x1c\x29\xfd\x0f\x2c\x3a\x71\x00\x23\xde\xe3\x1f\xc9\x37\x93\xf7\x5a\x3e\x24\xdf\xe8\x5f\xfc\x1e\x76\xf5\x1f\x6b\x91\x10\xf6\x4e\xd2\xa4\x09\x97\xea\xbe\x09\x1d\xf9\xd1\x98\x9a\xbf\x71\xed\xcc\x20\xcb\xfe\x4a\x1e\x3f\x94\xec\xc8\xa9\xe6\x7b\x6b\x51\x5f\xf1\x72\x26\xe7\xbb\x9d\x7d\x22\x8f\x7b\xb5\xe9\xef\xf7\x93\x5f\x43\x55\xdd\xf7\x6e\xb2\xfc\x8a\x66\x1e\xb6\x72\x00\x9c\xa4\xa7\x3a\x1e\xd3\xc4\xe1\x85\x31\xf2\xb0\xc9\xfe\x3a\x17\xb6\xc8\x7f\x9c\x9f\x9f\x37\xd2\xbf\xa6\xb7\x3c\xdb\xa6\xde\x1f\xde\xb2\xec\x8e\x49\xbe\xa0\x27\xdf\xb1\x0d\xfb\x03\x3e\xa9\x53\xd4\xcb\xeb\x82\xd3\xec\x0f\xf8\xa4\xa4\xa2\x1c\x94\xac\xe0\xd7\x8d\x66\xde\xf3\x5f\x59\x1a\x27\x8d\x24\xb9\xcd\x58\xea\x89\xbc\xb8\x55\xb0\x9a\x1c\xb5\x2a\xde\x82\x10\x94\xc6\x61\x82\xcb\x55\x7e\xaf\x92\xca\xf4\x34\x32\x37\x8a\x7e\x47\x7e\xc5\x5f\x92\xf7\x2d\x83\x30\x64\xfd\x91\x3c\xca\xdc\x69\xa1\xcb\xf1\xc2\xf7\xbc\x40\x56\xdf\x07\xf8\x5f\xbe\xee\x7f\xe7\xff\xbc\x0c\xfc\x69\xfa\x73\xf8\xf3\x32\x40\x53\xe4\xaf\x1f\x76\xec\x76\xf7\x05\xdc\x0b\xa0\xa5\x2f\xb1\xdb\x59\x60\x09\x21\xa2\x0e\xff\x3e\x89\xc3\xa4\xcf\xec\xfd\xea\x92\x04\x62\x96\xcc\xb1\x98\x8d\xe6\xfa\x7a\x75\x6f\xfd\xe0\xa5\xd5\x59\x10\xa4\x7c\xe1\xa5\x12\xbe\xa1\x53\xdd\x5c\xdb\x97\x7b\x2c\xf3\x1f\xe8\x72\xd9\xbc\x39\xa2\xf1\x65\x9a\x49\x45\x0a\xce\x31\xd3\xd4\x67\x24\x90\x8a\x53\xed\x76\x11\x16\xea\x19\xb8\x99\x7a\xe3\xea\x4d\x33\x33\xf5\x4a\xd5\xab\x62\x71\x4a\x6c\x4e\x19\x11\x84\x13\x95\xa6\xf2\x1e\x65\xbe\x4e\x19\x86\xaa\xa9\xc0\xba\x52\xca\xb1\x2a\x9e\x52\xac\x05\xd3\x94\x05\x1c\x83\xac\x9a\xd2\x40\x28\x2e\xaf\x23\x92\x73\xd1\xe5\x4e\xfe\x5d\xa8\x09\x11\x0b\xf2\xa5\x2f\xc3\x6b\x43\x07\x98\x85\x2d\xca\x40\x98\x93\xc7\x6b\x4d\x69\xb6\xa4\x26\xbc\x66\x59\x9d\x86\x80\xc5\x9a\x29\x7e\x1f\xe6\x6b\xd5\x6b\x19\xba\x33\xef\xab\x56\xea\x52\x75\x2b\x75\x11\xa4\x74\xa0\x52\x91\xa5\xc0\x25\x90\x62\x05\xa3\x7a\x6b\x01\xa9\x92\x10\xbe\xd7\x7d\x8a\x4d\x96\x61\x1d\xb7\x9a\x7a\x9e\x73\x02\xa4\xd3\x3a\x42\x6e\x4f\xe5\x6e\xa7\x26\xcd\xf1\x1b\xf1\x65\xa8\x7a\x47\x5d\x19\x1a\x15\x68\xaa\x3a\x4a\x55\x41\xd5\xfd\xd4\xfc\x06\xde\x89\x07\x57\x7c\xf8\x32\xd4\x00\x4d\xed\x43\x95\xa5\xdb\x0e\xbc\xf5\xc3\x89\x17\xd8\xf6\xe0\x26\x57\xbe\xc7\x05\x2b\xf3\xec\xae\x7d\xdc\xd9\xf0\x36\xc0\x25\x39\xd5\x9f\x08\xa5\x04\x3e\xca\x65\xcf\x17\xe9\x65\x61\xcf\x28\xeb\xb0\xd8\x9c\xc8\x19\x9d\x23\x37\x54\x96\x75\x3a\x40\xe4\xbd\x9e\x9f\x93\xdc\x67\x4a\x50\x3f\x8d\x8d\x9a\xa3\xca\x8b\x5e\xef\x7d\x75\xba\x9a\x23\x5d\x6e\x26\xe6\xed\x72\xb5\xfa\xc5\x7b\xbd\xd3\xb2\xd7\xf3\x79\xb8\xa0\x8b\x15\xa3\x57\x19\x83\xa2\xf9\x7e\x8f\xff\x46\x1e\x3f\x5c\xd3\x85\xcc\x0b\x35\xbf\x1d\x8b\x89\xcc\xe6\xf6\x22\x1a\xb0\x92\x4a\xed\xee\xc5\x48\x3c\x61\x97\x5c\x7b\xf3\x7f\xc1\x08\x89\xc0\x33\x70\xbd\x29\x57\x0a\x66\xf3\x24\x87\x0c\x39\x1f\xcb\x23\xc4\x8f\x76\x1c\xf5\x7a\x26\x9b\xab\x82\x65\x5e\x48\xf7\x2a\x66\x57\xe8\x19\xb0\x3d\x42\xe6\xf2\x6c\xb1\xc7\x59\x7e\x13\x1b\x5b\x39\x3c\x36\x8f\x53\xf5\x4a\xb2\xb9\xbe\xb5\x38\x7e\xf3\xfd\x9f\xe2\xe8\x8f\xb8\x71\xbf\x08\xab\x0f\x7a\x09\xa9\x3f\x39\x15\x47\x58\xa0\xa9\x48\xd9\x7e\x8f\xff\x4a\xfe\x36\x79\xaf\xbb\x21\x7f\xd3\xbf\xc0\x38\xdf\x92\xf7\xf8\x9f\xe4\x07\xfc\x0b\xf9\x11\xff\x85\xfc\x11\xff\x83\xfc\x15\xff\x49\x5f\xf2\x24\xb3\xd7\x4b\xba\x96\x1d\xa7\xb4\xb6\xb3\x69\xd7\x30\x1f\x1d\x97\x68\xe1\x7e\x1c\x25\x60\x03\xb1\xc7\x25\x93\x7f\x03\x36\xe2\x8e\x95\x11\xc5\x04\xd9\x83\x7c\x9d\xf1\x9b\xae\x80\x36\x6f\xc1\x84\x91\x6a\xe4\x54\xa6\x1e\x30\x2b\xfd\xe6\x29\x3e\xe5\xd9\xa4\x3d\x7e\xf8\x21\xdb\xb4\x9d\x4c\x9c\x09\x00\xb6\xf6\x75\x5e\x7c\x23\x8b\xe3\x85\xf6\x7b\x30\x56\xa4\xee\x58\x1a\xa7\x85\x9d\xa3\x78\x7a\x08\xaa\xda\x53\xb0\x05\xcf\x81\x6d\xbf\xdf\xe3\xfc\x8e\x15\x05\x5f\xb2\x9f\xd8\x83\xfc\x8a\x2b\x99\xb6\x19\x53\xe9\x78\xd5\x4c\xbc\x4c\x82\xe4\xa7\x56\x65\x21\x33\x78\xdc\xed\x7c\x4e\x66\x3e\x5c\x9a\x0c\x56\x0e\xcd\x5d\x50\x78\xc3\xa4\x3d\x44\x87\xf3\x55\xdf\x5b\xda\xe6\x3d\x45\xda\x4e\xfe\x0f\x05\xcf\x0b\x2e\xb7\x8d\x22\x73\x45\xfe\x75\x19\x37\x0f\x33\xec\xf1\xdb\x75\x5e\x48\x2a\xa4\xa7\xc4\xd7\x75\xc1\xee\x1a\x23\x20\x1c\xa2\x61\x41\x9a\x3e\x36\xb4\xfa\xe6\x84\x83\xea\x13\x97\x03\xf9\xe6\x03\xe8\x1d\xe5\x70\x73\xd4\xc7\x01\x9e\x45\x73\x0c\xd7\x73\x28\xf9\xea\xad\xb1\x40\x92\x7f\xe2\xb7\xa6\x3e\xf9\x05\xbf\xb5\x5b\x10\xf9\x0b\x7e\xab\x04\x8b\x15\xf9\x07\x7e\x1b\x16\x32\x23\x7f\x82\xd5\xf5\xf7\xc6\x8e\xf0\xd6\x9e\xf3\x83\xab\x81\x0d\x97\xe5\x82\x4b\x4e\x33\xfe\xeb\x31\x47\x84\x49\x55\xef\xef\x8e\x13\xc0\xe3\x07\xf5\x63\x82\xf7\x71\xdd\x48\xda\x76\x5a\x58\xf1\xe5\x92\x09\x72\x1a\xef\xf1\x9a\xdf\xe5\xb2\x3b\x70\xa4\x8e\x7f\xfe\x70\xc7\xd9\xbd\x92\x31\xf5\x13\xa9\x7a\x57\xf4\x1d\x7e\xb8\xcd\x97\x2c\x43\x6a\x06\x3f\x28\xbd\x1b\x3e\x4a\xa0\x16\x6f\x41\x45\xc9\x8f\x4d\x97\x76\xd6\x60\xa6\x36\x7c\xb3\x40\xd7\xc6\x54\x3d\xaa\x7e\x2a\x27\x7a\x7d\xa5\x95\x9c\xfa\x74\xb7\xf3\xab\x6c\x10\x75\x39\x90\x2e\xab\x7b\x46\xf8\xc9\xad\xcc\x9a\x0b\xf1\x02\x5f\xb7\xbe\x9b\xe0\x6c\x71\xd7\x1d\x5b\xdc\x86\x88\x59\x4e\xae\xc1\x85\xed\xc0\x97\x28\xd7\xbe\x44\xf9\x9c\x6c\x10\xf6\x4b\xb8\xbc\x1f\x9d\xc2\x77\xd1\xbd\x0f\x6a\x89\xe5\xb3\x68\xae\xbd\x01\x3b\xab\x4a\x55\xb5\x44\xd8\x5f\xda\xed\x71\x83\x88\xdd\x2a\xfd\x8c\xa8\x7c\x70\xf3\xab\xaf\xc8\x20\x4b\x68\xcf\x5f\x91\x0f\x7e\x86\x90\xf6\x21\xef\xf5\xfc\x05\xf9\xe0\x6f\xec\x3b\x7a\x04\xa0\x16\xe1\x2d\x7f\xf0\x57\x98\x23\x27\xd8\x15\x4d\x94\x02\xc4\xc5\x86\xed\x2b\x57\xb6\xb7\x61\xe5\x60\x99\xa1\x5e\xcf\x79\xdd\x20\xd3\x56\x16\xf8\x9b\x41\x86\xfa\xbc\xae\xae\x07\xbe\xdf\x1b\xe3\xa2\x44\xfa\x9e\x8d\x0e\x6a\x11\x08\x57\x73\x05\x92\x13\x83\xd0\x91\x3c\x93\x7c\xfd\x43\xde\xa6\x16\x67\xff\x00\xba\xd5\xb4\x12\x3e\xe0\x6d\xe3\x7d\xbb\xdf\xe3\x15\x2d\xff\xda\x8c\x96\xae\x38\xe4\x5b\x10\xa9\x6e\xaf\x58\xe1\x37\x5a\x31\xe3\xeb\xc8\x52\xaa\x1e\xc2\x7f\xb7\xae\x39\x6f\x2b\xd7\x1c\x58\xc1\xff\x93\xfc\x1d\xff\x37\xf9\x9f\xd5\xa8\xe0\x5a\x74\x2d\x07\x2e\x36\x45\x01\xb1\xba\x6c\x9d\x46\x58\x6c\x6e\xd5\x53\x99\x9e\x47\x58\xf3\x8a\xd4\xf3\x70\xa1\x2f\x3d\x86\xf2\xb9\x78\x2d\xf8\x2d\xc4\x6e\xfc\x50\xe4\x37\x05\x2b\xcb\x83\x8c\x37\xf9\xed\x5a\x71\x2e\xfd\x8d\x0e\x84\xff\x93\xfc\xf7\xa4\xfb\x2b\x0c\xff\xed\xf0\x02\x8f\xda\xfa\xba\xac\x87\xd5\x06\x7e\x24\x3c\x67\x7f\xf4\xc3\x0e\x8d\x26\x61\xa0\xef\x8c\x9b\xe4\x93\x0d\x42\xec\x80\xde\x13\x9b\xb6\x91\x2a\x8f\x48\xd5\xe9\x77\x6d\x1d\xb5\x82\x3a\x7d\x5c\xda\xab\x01\x62\x36\xaa\xd0\xe7\x7a\x90\x78\xd8\xc1\xda\xdb\x50\xe4\xf9\x1a\x3b\xe8\xd2\x29\x7b\xa3\x28\xfe\x17\xa9\xdb\x2e\xd3\xd9\x1c\x17\xec\x97\x0d\x2b\xcd\xbc\xd1\xe5\xb2\xc2\xf7\x53\xa2\xb0\x89\xd3\xaa\x1a\xd2\x82\xa2\x1d\x11\x66\x21\x50\xf6\x4f\xfc\x96\x91\xaf\xa8\x64\xa1\xc8\xef\x7d\x45\xf1\x76\x2c\x44\x00\xc3\x92\xb6\x09\x71\x43\x4e\xe1\xae\x43\xc5\x74\xf2\x0e\xa6\x93\xcf\xe8\xdc\x34\x5f\x07\x39\x80\x4f\x33\x64\x11\x86\x26\x79\x25\x92\xc6\x84\x54\x8d\x98\x6b\x6d\xcc\x28\xab\xc1\x7d\x5d\xd0\x5b\x30\x4c\x2d\xd4\x1c\x66\x5d\x83\xb6\x1c\xfa\x6d\x78\xcd\xc5\x12\xdc\xa6\xfc\xd6\xb0\x71\x2d\xcc\x32\xc7\x69\xae\x82\x73\x8f\xd0\x64\x10\x9b\x7d\xb7\x55\x17\xbe\x9a\xb7\x60\x3e\xc3\x31\x98\xcd\x6b\x44\xc4\xb0\xd9\x77\xc0\x7b\x64\x7b\xd2\x8e\xf5\x44\xda\x41\xaa\xbe\xec\x33\x79\xeb\x0e\x1d\x5a\xd1\x7e\x65\x3a\x9c\xc0\x19\x80\x36\xd8\xe9\x4a\x40\x0b\x52\x4f\xe2\x57\xfc\x86\x95\xd2\x47\x7b\x84\xd0\x1e\x3b\x49\x07\xbb\x29\x5d\xde\x29\x64\xfa\x66\xf3\x76\x86\x6a\xbe\x71\x11\x7d\x6a\x32\x4c\x0b\x9d\x81\xc5\xb8\xfa\x10\x6d\x6b\x0e\xf4\x1d\x72\xb4\xfa\x18\x0f\x23\xbe\x24\x74\x56\xcc\x91\x9e\x08\x08\xd7\xb6\x2c\xc8\x2a\x38\xe6\x3b\x90\x35\x71\x0e\x64\x4d\xb3\x68\x28\x2b\x4a\xed\x0b\x14\xc4\x4a\x30\xaa\x39\x5a\x7d\x8e\xc9\x15\x1f\x7f\x5b\xb9\xfa\x01\xda\x15\x53\xc3\x33\x86\xe5\x1c\xb3\x56\x66\x07\x97\xc3\x33\x5d\xae\xd1\xc1\x2b\x22\xa6\xfe\xb1\x9a\x76\x5d\xdb\x9a\xac\x41\x3a\x98\x5a\xba\x2a\x70\x8c\x50\x1a\x04\xc5\x7e\x8f\xff\x4c\x2a\x71\x2c\x34\x8a\x2e\x96\x92\xcc\x3c\xb5\x62\x3c\xec\xad\xf3\xb5\x87\x3d\xf8\x2c\x8f\xfa\x85\x06\x3c\xec\x99\x2f\xf5\x78\xf3\x3a\xc8\x84\xc9\x46\x2c\x67\xf8\x01\x50\xfc\xcf\x72\xc2\xaf\xeb\xef\xc5\x88\x30\xe3\xa5\x64\x82\x15\x25\xa6\x84\x57\x11\x26\xcc\xac\x06\xda\xeb\x71\x0b\x26\x55\xe4\xcf\x2b\x0a\x51\x4c\x41\x76\x7c\x8b\x06\x8e\xef\xb4\xdc\x3a\x63\xf3\x3d\x42\xb8\x12\x63\x2d\x08\x68\xaf\xbf\x06\x2a\x5b\xa1\x45\x2d\x49\x12\x12\x1d\xc1\x51\x48\x57\x72\x5c\x52\x49\x4b\x26\xcd\xe1\xe6\x4f\x4a\x8c\x84\xc5\xa0\xd2\x0f\x12\x3f\x34\x4b\x7f\xbf\x36\x4c\xd5\x53\xb3\x76\x03\xfa\x27\x58\x10\x3d\xec\x5d\xe5\xc5\x92\x15\x6f\xe8\x1a\x8c\x26\x75\x42\x23\xfb\x2b\x0a\xb3\x51\xbf\x7c\x7f\x7d\x5d\x32\x59\x25\xfd\x57\xce\x45\xb3\x3e\x28\x5a\xde\x5c\x43\xf2\x7c\x30\x1a\x6f\xba\x0d\x45\x04\xdc\xd8\x74\xbc\x79\xa7\xd0\xec\x4e\x3c\xf0\x9d\x8a\xdf\x0b\x3d\xc5\x84\x61\x35\xf5\xe2\xe3\xfb\x05\xcd\x58\xa9\x34\xf9\x90\x2e\x97\x06\x2e\xfd\xfe\x41\xfb\xfc\x2a\x85\xe9\x5b\x26\xa9\x8f\x42\x95\xb0\xc7\x9b\xf5\x92\x4a\x06\x5c\xf6\x70\xa7\xd4\xad\x4b\x38\x46\x30\x8d\x1f\x89\xf1\xae\x9a\xc5\xf6\x46\x05\xc3\x02\x58\x08\xa1\xe1\xa5\x8d\xac\xbd\x61\xf2\x2b\x3d\x7b\x3e\x02\x49\xdb\xae\x0f\x5d\xcc\x7e\x4c\x49\x86\x0f\xaf\x1f\x78\xf9\xee\x2b\x38\x86\xd1\x8f\x27\x1c\x44\xf1\x53\x6e\x13\x60\x27\x33\xcf\xc4\x49\xa5\xea\x91\x95\xb3\x68\x1e\xf2\x25\xc2\x55\x93\xdb\xba\xc9\x6d\xbb\xc9\xad\xd3\xe4\xb6\x6a\x72\x5b\x37\xb9\x75\x9a\x84\x1b\x9f\xcd\x30\x9e\x94\x40\x42\x45\x1f\xa1\x21\xd7\x72\x56\x23\x75\x0e\x4d\x28\x94\x3d\x5d\xbf\xee\x07\xd0\x5b\x37\xa0\x61\x80\x39\xf9\x3a\x2f\xde\x1d\xbf\xb0\x49\xb7\xa3\xb1\x3b\x93\xf3\x3d\xfe\x60\x7d\x95\xa1\xf2\xbb\xa3\x97\xa1\xd5\x94\x62\xb0\xa0\xab\x02\xa9\x3c\xbb\xea\x83\x5b\xb5\xee\xf5\x89\x8a\xf5\x90\x8c\x48\xdc\x02\xd7\x57\xdb\x61\x13\x90\xcf\x6c\xcd\x1d\x01\xb4\x56\xb0\xd6\x3c\xea\xa2\x7a\x69\xf8\xa7\x11\x82\x9b\x1f\x64\x91\x6f\x0f\x0b\xa9\xb9\xed\xf5\x14\x7b\xae\x5e\x6d\x58\xa1\x0e\x91\x51\x88\xe8\x20\x15\x67\xed\x1c\xf2\xbe\x4a\xf3\xed\xf5\x04\xbb\x3f\x91\xfe\xa3\x66\xb6\xa9\xb3\xb4\x2c\x13\xd4\x4b\xb7\x26\x8c\xfd\x41\xcf\xc7\x6e\x1e\x68\xf1\x56\xe7\x76\x73\xd5\x29\xfb\x9c\x4e\xf1\x07\xae\x53\xf6\x20\x4d\x54\xac\xa7\x3b\xd4\xbe\xc5\x31\x3a\x38\x03\xc0\xb6\xdb\xcd\x20\xd0\x06\x5e\x40\xd2\x95\x24\xc2\x8c\x54\xdf\xff\x93\x97\x6c\x12\x04\x52\xc7\x24\x53\x38\x86\xd2\xb0\x36\x86\xef\x4b\x34\x11\x16\xc9\xa4\x7a\xea\x2c\x0b\x9d\xbb\x23\x78\x2d\x96\x3f\xb2\xb6\x16\xe1\x5e\x1c\x73\xd0\x55\x6b\x01\x00\x03\x30\x7b\xae\xc4\x91\xbd\xa7\x21\xd4\xc4\x65\x7a\xf1\x19\x96\x18\xe8\xec\x6a\xc3\xb3\xe5\xf7\xc5\x5f\xdc\xdc\x27\xb0\x08\xb1\x56\x2d\x96\xca\x0d\xf2\x7c\xfd\x40\x66\x73\x35\x7e\x98\x39\x2d\x03\xf8\xc2\x21\x5b\xf3\xac\x04\x2a\xda\xeb\x19\x25\x8c\x97\x7f\x7c\x80\x63\xd5\xab\x8c\x41\x2c\x95\xcf\x88\xc0\x4a\xbc\x43\xd5\xb6\x3f\xad\x25\x80\x5a\xec\xb0\xaa\x40\xea\x77\xab\x73\x12\x7b\xb6\x92\x87\x1f\x17\xb9\xb8\xe6\x37\x9b\x82\x5e\x65\x2c\x3d\x8d\x30\x13\x9b\x5b\x66\xde\x62\x73\xf9\xd8\x63\xd5\x76\xaa\x04\x90\x3d\xc2\xc7\xe4\x14\xbd\x43\x7a\xb9\x50\xc8\xf0\x02\x56\x87\x9b\x37\xaf\x31\x0d\x6c\xf4\x7a\x0c\xa4\x37\x63\xf3\x23\xea\x2c\x44\x1e\x3d\x07\xc6\xd6\xf4\x3c\xf3\x73\x72\x30\x55\x8e\x79\xad\xb6\x98\xbf\x0d\x19\x05\x47\xaa\x43\x04\xe3\xc6\x17\x15\xbb\xc2\x3a\x67\x02\x6e\x91\x17\x73\xdb\xb6\x12\xbb\x10\xc2\x74\xbf\x07\x5d\x02\x84\x01\x20\x0c\xaa\x1e\x0b\x56\x6e\xc5\xa2\x16\x15\xf6\xf8\x83\x1d\xf2\xa1\xdd\xce\x64\x91\xb7\xe6\xf4\xb7\x19\x0b\xa8\xf6\x59\x84\x67\xce\xae\x63\xf7\xf6\xe6\x06\x08\xa2\x88\xfe\xf4\x44\x83\x76\xe7\x47\x43\xb0\xbc\x0f\xb7\x4c\x52\xcf\x84\xff\xaa\xd6\xcc\xf3\x5b\x1b\x10\x66\xe3\x75\x14\xff\x31\x8c\xfb\x50\x9c\xa9\x47\x66\x75\xa5\x0f\x70\x5c\xb3\x54\x20\x7c\xbf\x96\xa5\x51\xc0\xea\xf5\x09\x51\xbe\xa6\x39\xa3\xe1\x1f\xb1\x2a\xb6\x2e\x83\xa8\xb9\x9b\x12\x81\x2a\x6e\x76\x24\x34\x52\x28\x2d\xbb\x6e\x58\xb1\x11\x66\x91\xd6\xeb\x55\x8f\xcd\x22\xda\x43\xf1\x39\xf2\x58\xdd\xbf\xa8\xbf\xcc\xca\x89\x3e\x53\x93\x75\x47\xd5\x23\x38\x14\xf8\x68\xc2\x2f\xc5\x24\x08\x38\x62\x33\x3e\x37\x69\x5a\xec\x80\x23\xca\xae\xa3\xac\x03\xd6\x5e\xf1\xdb\xc6\x1d\x31\x8d\x99\xe0\xce\x0d\xaf\x72\x6a\x10\xf8\xc1\xe8\x4b\x5f\x75\x89\xfa\x3e\x87\xd0\xc5\xd4\x97\x04\x8e\x8b\x3b\xea\xb4\x2a\x68\x30\x60\x8b\x78\xdc\x63\x89\x10\x3e\xd5\x57\x6e\x5c\xf3\x2c\xeb\xf5\xec\x27\x3c\xe1\x75\xb7\xf3\x59\xd8\x92\xe1\x09\x0b\x1d\x19\x1e\x61\xb6\xc7\x4f\x42\x78\x3c\xa2\xd8\x18\x71\x70\x49\x72\xb3\xa9\x66\x24\xb7\x08\xc1\x1b\xa2\x34\xd2\x12\x8e\xc8\x1f\xf7\x78\x49\xca\x6a\x11\x31\xb3\x46\x67\x79\x87\xc4\xe0\x30\x1b\xbd\xbc\xa0\xfa\x4a\xb5\xdc\xa9\x2c\xe1\x05\x79\xdc\xe3\x6b\x62\x8c\x87\x25\x36\xa5\xd2\xbc\xb9\x9d\x34\xf6\xfb\xdc\x6c\xf6\xab\xfc\x8e\x15\x29\xdb\x3b\x41\xcf\xab\x76\xd0\x33\x25\x2b\x13\xf1\x3c\xf5\xa0\xbc\x17\xd0\xa3\x1c\x99\x56\x1c\x39\xa5\x78\x31\xa3\x73\xf2\x67\x7f\xb6\x99\x15\x73\x9c\xa9\x3f\xcb\x59\x31\x9f\xe3\xeb\x8a\x3f\x2e\x9a\xc8\xff\x34\xe6\xed\x5e\x09\x34\xae\xd1\x0b\x82\x45\x6b\xf9\x83\x03\x94\xd2\x06\xac\x9d\x8b\x82\xe9\xae\xb2\xdb\x13\xa3\x79\xe1\x25\xd4\xd5\x33\xb6\x22\x9b\xc3\x29\x12\x6d\xe1\xaa\x7b\x7e\x16\xc4\xf0\xe2\xd6\xe4\x5c\xab\xc9\xb9\xb1\x93\xb3\x81\x59\xd0\x53\xc0\xaa\x89\x12\x4f\x4c\x94\xd1\x0b\xf7\x78\x4d\x1e\xab\x03\xe9\xf4\x94\xef\xe1\x43\x18\x04\x56\x0f\x7e\x5b\x1d\x71\x2f\x74\x38\x6f\x4e\x22\x5c\x92\x85\x9d\xc9\xfc\xb2\x9c\x04\x41\x8e\xae\x67\x19\x59\xcc\xf2\x39\x4c\x0a\x9f\x65\x6a\x3e\xb2\x39\x5e\xcd\xb2\xf9\x1c\xdf\x60\x86\xd7\x4e\x2c\xbf\x6e\xa3\x15\x12\xbc\xa8\x43\x82\xdd\x46\x8b\x56\xa3\x0b\x68\xf1\xb0\x71\x33\x19\xeb\xfa\x6c\x5d\xcb\x30\x2d\xde\x6d\x7a\xbc\x2e\x18\xfb\x95\xf9\xd7\x08\xe1\x6b\x25\xda\xdf\xe6\x77\xec\xad\x22\xc0\x43\xa6\x65\xb7\x31\x7b\x84\x84\x65\xf8\x9f\x6b\xe3\x87\x05\xa5\x81\xcb\xd4\x16\x8f\x66\x26\x58\x97\x8f\xb4\xec\x4a\x88\x5d\x7a\x60\xd8\x10\xa4\xe7\x60\x2a\xd3\x22\x34\x5c\x20\xe0\x70\x00\x4a\x2a\xe8\x0a\xf2\x16\xee\x0d\x57\x5d\x02\x1b\x9a\xb4\x41\x22\x8f\x2d\xb6\x95\xd2\x36\x23\xc3\x0e\x1b\x53\xb9\xf5\x1b\x76\xcc\x12\x55\x0e\xbc\xed\xf1\x41\x33\x30\x6d\x21\x2c\xec\x2f\x5b\x1d\xb0\xee\xe4\xc2\x3f\x68\x04\xcd\x8d\x2f\x05\x48\x9f\x2e\x2c\x6e\xf3\x0e\x84\xec\x30\x09\x9a\x75\x38\x73\x57\x93\x30\x88\x83\x26\xff\x66\xbd\xdc\xdb\x49\x8d\x6a\x4e\x7b\xc0\x75\x14\x39\x99\x55\xd7\x35\xf7\xdd\x9b\x70\xb5\x07\xca\xca\x12\xdb\x24\x4b\xd8\xce\x3f\x94\xd5\x82\x7e\xa2\xe9\x3a\x04\xc1\x1e\x07\x1c\x74\x83\x4b\xf2\x08\x4b\x3d\xb7\x9f\x26\xc9\x2d\x0d\xd1\xe7\xec\xae\xb9\xd2\x45\xb0\x56\xb6\x7c\xde\x58\xca\xb4\x5e\xca\x56\xfb\x2a\x67\x82\xf0\x99\x9c\xcf\x49\x01\x1c\x7f\x26\x94\x32\x26\xe6\x93\xbc\x4d\x9d\xe5\x1e\x74\x6d\x47\xde\x7c\xae\x35\xe9\x40\x37\x84\x15\x03\x8b\xaa\x12\x67\xaa\xaf\x99\xf0\x4b\x31\x95\x0d\xc5\x8b\x63\x31\xe0\x28\xe5\xaf\x84\xc1\x3f\x17\x25\x2b\x64\x25\xf5\x0a\xcc\x07\x02\x82\xfb\xdd\xe4\xd6\x4e\x62\x25\x3c\x7d\xb7\x13\x83\x5d\xce\xd8\xdf\xdb\xda\xa2\x2f\x03\xd5\x9c\x56\x45\x7e\xd8\x94\xab\xc3\x71\xb6\x3f\x44\x3d\xe9\x82\xab\x7b\xec\xa6\xc6\x40\xc2\xa5\xc4\xa6\x93\xe6\xbd\x41\x5d\x4a\x28\xf8\xf8\xd8\xf2\xef\x57\xfc\xfa\xd0\xe4\xd1\x56\x5b\xe1\x7b\xf1\x75\x1d\x40\x66\xdb\xb1\xf9\x29\x75\x97\x55\xfe\x04\xcd\x61\xe1\xf6\xe8\x21\x3e\x5a\xf7\xf2\x17\x6d\xfc\x3e\x80\xad\xd5\x46\x74\xd0\x06\x9c\x99\x0a\x79\xe4\xd0\x94\x4b\x22\x24\xa6\x92\x54\x01\x81\xb5\x71\xbd\x68\x18\xd7\xcd\x21\xda\x6b\x71\x93\x31\x20\x2b\x26\x96\xfa\x85\x12\x16\xae\xf9\x03\xcb\xbe\xa5\xc5\x0d\x17\xb8\x20\x74\xc8\xc2\x7c\x23\x59\xf1\x23\x5d\xf2\x4d\x89\x73\xc2\xc2\x07\x5c\x12\x16\x6e\x27\x6d\x4f\x6f\x5a\x2c\x7c\x25\x48\x34\x6b\x88\x41\x81\x79\xa0\x24\xca\x90\x0b\x61\x93\x5f\xd1\xa9\xaf\x5b\x77\x12\x1b\x6d\x38\xe9\x03\xaa\x5a\x80\x96\x4e\xa3\x2a\xf8\x49\x15\x53\x19\x55\x9c\x35\x16\x83\x3a\xe6\xfa\x20\x1e\xca\x38\x95\x57\x38\xc9\x65\xf3\xea\x2d\x0f\x3a\xd4\x57\x36\x69\xf6\x08\x4e\x45\x13\x3e\x35\xce\x8d\x9a\xc9\x26\x7d\xe6\x72\x4f\xe3\x13\xfe\x5f\x39\x17\xc4\x03\xc6\xef\x29\xa9\xdd\xa9\x70\xbc\xf8\x15\xbb\x63\x19\x38\xf9\x5c\x6f\xb2\xec\x0d\x2f\x16\x19\x2b\x7b\xbd\xa3\xa7\xa3\x44\x54\x33\xa5\xef\x38\x01\x29\xc1\x26\x81\xab\xbb\x9d\xd6\x80\x4a\x0c\x93\x2e\x54\xf3\x55\x91\xc2\x7d\x21\x8d\x1a\xbd\x5e\x0b\x0c\xa7\xe9\x80\x50\xd9\x84\x72\x30\x40\x87\x9e\xfe\x6a\x52\x8c\x27\x37\x18\xee\xeb\x79\x6d\x83\xe6\xbe\x63\x73\x3e\x3b\xa1\x97\x8d\x2e\x40\x6f\xac\x63\x1c\x8c\x2a\x77\xbc\xc7\x16\xd5\xb9\x3d\xb4\xba\x7f\x4e\x77\xfb\x0a\xfb\x4a\x7b\xb3\xa8\xfc\xcd\xfd\x5b\x4c\x7e\x0a\x4d\xd5\x4a\x3c\xc0\x50\x9b\x9c\x2b\x40\x0f\x4e\xf8\xad\x8c\x9e\x3e\xd2\x62\x91\x1e\x08\x4c\xd6\x4d\x38\x74\xbd\xd5\x1b\x42\x93\xf7\x1f\xd7\xd7\xd7\x5e\x43\x5a\x4a\xb0\xb3\x24\x52\xeb\x18\xb8\xb7\x67\xff\xa5\x74\xbc\x35\xb4\x8f\x94\x47\x8b\x85\x87\xb9\xf8\x86\x5e\xb1\xec\x47\xf8\xe8\xec\x11\xf9\xd1\xf5\x44\x3a\x3d\x65\xbd\x5e\xe5\x4b\x29\x07\x8a\xd9\x24\xe8\xb2\x4a\x61\x61\x01\xa8\x0a\x8c\x54\x63\x10\x97\xc0\xd6\xd6\xee\xa5\xa9\x1d\x99\x7e\xf4\x71\xa2\xdd\xea\xb8\x96\x38\x01\xcd\x5f\x17\xf9\x2d\x44\x2a\xf8\x02\x3f\x3e\xa4\x12\x6f\x53\xb6\x37\x46\x2c\x98\x86\x82\xf0\x70\xc9\xb5\x73\x06\xce\x1b\xab\x07\x97\xee\xe2\x2c\x2f\xf3\x09\x2a\xd5\xaa\x01\xaa\x9d\xd0\x57\xe5\x04\xd1\x41\xfd\xae\xf2\x29\xe4\x2b\x20\x32\x42\x5f\x91\xbc\xd7\xa3\x97\xa4\xc4\x1b\x52\xbc\x22\x0d\xb2\xe8\xf5\x8a\x4b\x22\x5c\xea\xb2\x0a\x43\xd6\xeb\x6d\xf6\xd5\x65\x59\xf8\x86\xc9\x37\x30\x31\xad\xa0\xce\x86\xe4\x01\x58\x50\x62\x8f\x74\x17\x85\xac\x29\x54\xf1\x51\x95\xeb\x40\x10\x48\xb7\x77\x34\x4c\x26\xb5\xd7\x50\xf8\x50\x07\x2c\x31\xd4\x17\x78\x9b\xca\x70\x5b\x07\x2d\xa9\xb4\x3d\xc0\xd6\x8c\xe3\x39\x04\x6a\xe2\xde\xd5\xf0\xc3\xbb\xbe\xef\xd7\x50\x0d\x5c\x68\x91\x1b\x75\x8f\xcc\xf5\x0b\x40\x2f\x8d\x25\x98\xa0\x81\x93\xe3\xae\xb3\x04\x3d\xed\x13\xd5\x81\xae\x06\xb6\x8e\x03\x66\x70\xe7\x80\x31\x68\x74\x8d\x86\x49\xd0\x48\xf8\x7c\x4c\x76\xda\xc4\x2a\x03\x91\x56\xc6\x16\xf2\x01\xbb\x54\x8f\xdd\xed\x4d\xb8\xdb\xdb\x34\x1c\x8d\xd2\x08\x53\xf2\xa8\x94\xea\x07\x0c\x31\x4d\xd8\x01\x30\x6d\xb2\x28\x67\x64\x69\x75\xdd\x67\x83\x38\x07\x1c\x47\x08\x3b\x52\x44\xca\x71\x8d\xa2\xb4\xb1\x6a\x2c\x16\x53\x87\xf3\x39\x3c\x39\x75\x3c\x2a\x44\xb8\xe0\xc5\x62\x73\x7b\xcd\x0a\x26\x16\x6c\x48\x25\x02\x0d\x80\xd9\xb8\x33\x6d\xda\xd2\x72\xb8\x38\x50\x0a\x99\x61\x98\x55\xbe\xa3\x61\x51\x77\x1f\xd0\xbc\x81\xd6\xdb\x24\x6d\xed\x5d\xac\x19\xfd\x0b\xec\x9c\x86\x0f\x98\x86\x5b\x4c\x1b\x04\xe8\xd6\xc4\xd4\xd9\x03\xda\x95\x5c\x04\x53\x47\x1a\x6b\xef\x01\xac\xb9\x07\x90\x68\x22\x2f\x69\x6b\x13\x93\x88\x99\x00\xc1\xc9\xb1\x41\xb4\x50\xf9\x05\x95\xfb\xff\x6b\x06\x65\x81\xc7\xc2\x15\x9b\x7a\xbd\x5c\x07\xae\x53\x55\xa2\x8e\xfe\xdb\x23\x9c\x49\xf2\xb6\x7d\x5b\xd5\x46\x92\xce\x5d\x6e\xf2\xc4\x5e\xa9\xc4\xb2\xf4\xd1\x84\xda\xa5\xe1\x19\x6e\xef\x9b\x1b\xd9\xd8\x11\x47\x8d\x2d\xb3\xca\xb4\x8e\x18\xa9\x77\xb5\x91\xd2\x6e\xa2\x5f\xd1\x72\x95\xce\xe6\xb8\xed\x80\x91\x46\xb8\xe5\x80\x91\x7a\xb7\x5c\x6d\xad\x78\x41\xd7\x5f\x42\xb4\x1f\x30\xf3\x32\x3d\x8d\xb0\xc2\x4c\x7a\x1a\x55\xbb\xee\xb2\x63\xd7\x85\x80\xd5\x63\x3c\x42\xc9\x33\xda\x52\xa8\x36\x35\xcd\x19\xd4\xa6\xe6\x70\x8d\x5c\x91\xca\x9a\x8a\x3f\xd1\x75\x89\x4b\x9d\xc7\xb3\x65\xc1\x84\xb1\x60\x22\x9c\xd5\x91\x46\x1b\x92\x55\x26\x41\x10\x6c\xf1\x92\x0c\x62\xbc\x52\xf5\xb2\x3c\x5f\xab\x05\x5a\x69\x2f\x8f\xfc\xda\x37\xe9\x7a\x91\x69\x02\xae\xf4\x43\x45\xbb\x70\x19\xdf\xdb\xd0\x6a\xd5\xef\x24\xbb\xf5\xc1\x6b\xfb\xb4\x9c\xc9\xb9\x06\x39\x2c\x3f\xf2\x75\xaf\xc7\x9c\x37\xf4\x58\x92\xd2\x00\x28\x91\xfd\x92\x54\x59\x5f\xe2\x8c\x10\x5e\x91\xdc\x06\x77\xf7\x7a\xe6\x1c\xaf\x9c\x45\x73\xa4\xbf\x17\x6a\xd9\x48\x01\xe3\x78\x43\xd7\xa4\x32\xbb\x98\x29\xdd\xed\x36\xad\x14\x5c\x84\x25\x83\x20\x27\x35\xa5\xbd\x5e\xe3\xb5\x32\xdb\xa8\x97\xba\xae\x7a\xb3\xbd\xd4\x84\x40\x32\xd9\x28\xaf\x53\xf1\xe6\x20\xc9\x56\x05\x0d\xc2\xd6\xa8\xa8\xa7\xee\xa6\x4a\x32\xe5\xb5\x2e\xe2\xf4\xa2\xd5\x91\x8d\xfb\xa6\xda\x76\xb9\x64\xc3\xee\xb4\xdb\xb5\x24\xc6\xa2\xc1\x32\x7c\x41\xc0\x77\x04\xe6\x04\xc1\xa4\xec\x76\x7e\x61\x63\x9c\x6d\x80\x27\x5e\x12\x30\xbc\xc4\xed\x89\x17\xa4\x9e\x60\xcc\x08\xdc\x33\xbc\x9c\x76\x50\x42\x5a\xce\x96\x10\xf3\xa0\x7b\x80\x7b\xa2\x07\x71\xaf\x77\x9a\xef\x76\xa6\xd6\x41\xaf\xa9\x0d\x1f\xa8\xe3\x77\x0d\xf5\x28\xa1\x7e\x49\x24\x9a\xc0\xec\xb9\x8c\xa8\xa8\x24\x6c\x5c\xb8\x1c\x67\x8f\xf0\xaa\x83\xe5\x2c\x8e\xb1\x9c\x4a\xdf\xbc\x96\xcf\x92\x7b\xe9\x55\xa9\xe5\x5e\x74\xe9\x0a\xbb\x5c\x6a\x56\xfa\x94\xb8\x0f\x7e\x56\xe9\xa3\xae\x94\x8e\x70\xed\x77\x95\x7a\x0b\xd8\x20\xbc\x03\xae\xb6\x90\x0d\x3e\xb6\x68\x32\xb9\x18\x57\x1d\xab\xe7\x5a\xe2\x4e\xcf\x70\xdb\xaa\x98\xc6\x15\x63\xba\xe9\x60\x4c\x00\x8c\xf7\x5c\x29\xdd\x62\x45\x34\xb4\x01\x01\xda\x40\x50\x6b\x03\x03\x25\xac\xb8\xfa\x81\xa8\x31\x15\x08\x83\x3e\xa3\x1d\xb8\x8a\x88\xc4\x5c\xfc\xdd\x79\xfe\xc7\x6f\x50\x50\xec\x44\x6d\x8f\x4c\xd4\x67\x88\xe3\x0d\x21\x50\x8b\x7d\xdd\x22\x73\x4b\x42\xae\x51\x53\x35\xe5\x8e\xf9\x33\x24\xdc\x0e\x08\xf0\xda\x44\xc7\x4a\x3b\x3a\xd9\x30\x99\xb7\x25\xd1\x0e\x9c\x55\x32\x68\xbd\xbf\x70\xc2\xc2\x9a\x2e\xc1\xe0\x04\xb7\xb7\xf0\x5c\xe0\x82\x58\x44\xba\x66\xa6\xf6\x7e\xd3\x58\x5d\xcc\xf2\x81\xfa\x7c\x75\xb7\xab\x56\x7b\xf3\x52\x04\xf8\x2c\x2c\x02\xc3\x86\xcb\xe5\x58\x93\xcb\x6d\xb4\xa7\xa1\x61\x98\x2b\xe9\x37\xed\x37\xce\x7e\x07\xc3\x68\xf2\x4f\xe1\xc8\xa0\x07\x27\xac\xaa\xed\x0a\xb4\xea\xee\x1d\x5f\x60\x6e\x02\x67\xe0\x72\x5c\x84\xd7\x9f\x64\x25\xb7\xb2\xf9\x61\xc0\xfa\xf3\xba\xfa\x66\x1b\xe7\xc3\xb7\xb2\x15\xd3\x8c\x0b\xab\x66\xdd\xea\x68\xe6\xc2\x56\x1a\x26\xa0\xe6\x3c\x0c\x0a\x30\x3d\x3f\x04\x85\x75\x69\xbe\xe5\xc2\x57\x04\x21\xc3\x2b\x5a\x32\xa5\x11\x57\x92\xbf\x93\x8c\x52\x68\xab\xbe\x65\xc7\xad\xfc\x50\x55\x16\x6e\xe5\x3a\x99\x13\x19\x6e\x07\x05\x9c\x06\x6d\x83\x02\xe1\x47\x88\x84\x66\x58\xe6\xeb\x94\xb7\xe3\xa4\xa9\xf3\xb1\xe5\x2b\xd9\x8e\x90\x84\x2f\x8a\x88\x54\xc2\x85\xf9\x2c\x95\x75\xd9\x07\xd9\x79\xd9\x30\x91\xcd\x39\x26\x5d\xc1\x6f\xba\xc4\xfb\x8f\x7c\xbd\x66\x4b\xf8\x08\x46\xe5\x4f\x36\xf5\x65\xb8\xca\x0b\xfe\x6b\x2e\x24\xcd\xa6\x7a\x4c\xaf\x64\xf8\x00\x7e\x45\x57\x4a\x74\xd5\x01\x93\xd8\x04\x4c\x82\x0d\x53\x15\xba\x94\xe1\xd6\x29\xa4\x87\xe7\x61\x4f\xe6\x6b\x0f\x21\x2c\x66\x6c\x4e\x4e\x23\x2c\x50\x2a\xf6\xce\xa7\xbb\xde\xd6\x11\xe9\x25\x9a\xfa\x9c\x04\xa5\x8d\x48\xa7\xea\xb9\x8a\x48\x2f\xd4\x5b\x1d\x91\x9e\xab\xd7\x2a\x22\x9d\x13\x4a\x0a\xa2\xd2\x74\x44\x7a\x9a\xe9\x46\xf8\x65\x34\x8d\x52\xfe\x4a\x4c\x95\xee\x56\xa4\x99\x6d\x8f\x42\x06\x7d\xc5\xa6\x2c\xa5\xf8\x2a\xcd\xaa\xa6\x0b\xc8\x29\xa0\x4a\x81\xb3\x34\x33\xbd\xe4\x90\x9e\x43\x8d\xdc\x99\xb4\x6d\xcb\xf8\x6a\x22\x0d\x14\x43\x30\x8f\x8a\x1d\x9c\xc2\xcd\xe4\xbc\xd7\xa3\xa8\xd7\xbb\x73\xbf\x5d\x56\xf4\x7a\x3e\xdf\xed\xd8\x2b\x52\x40\x4f\xbd\x1e\xbb\x24\x85\x86\x53\x2d\x71\xba\xdb\x09\x95\x27\xf3\x75\xaf\x27\x54\x96\x86\xf4\x49\x73\x59\x61\x6f\x96\x38\x34\x9a\xad\x9b\xdb\x64\xf5\x6a\xc8\x21\xad\x66\xce\xdd\x3d\x6b\x49\xfd\x43\xc7\x86\x58\xf5\x76\x44\x5c\x3f\x60\x9d\x2d\xce\x7a\x48\xa3\x80\x21\xf0\x80\x69\xdc\xaf\xc2\x49\xf3\x86\x15\x4c\x09\xac\x03\x61\x6e\x4a\xb3\xbc\x1f\xb4\xbc\xf4\xf1\x21\x35\xf5\xb6\xa9\x2e\x7e\x9f\x0a\xbc\x4a\xf9\x5e\x5b\x05\xea\x02\x01\x0d\x33\x5b\x28\xa0\xa1\x54\x05\x07\x34\xcc\x06\x34\x2c\x54\x85\x01\x0d\xe5\x80\x86\x57\x10\xd8\xab\x3d\x65\xa0\x0b\x70\x4c\x80\xb6\x26\xf2\x29\x7e\x89\x75\x2e\xdc\x12\xc7\xc3\x07\xcc\xc3\x2d\xe6\xe1\x3d\xe6\xe1\x0a\xa9\x07\x42\x08\x0d\xef\x7b\x3d\x1e\xae\xe0\x71\x05\xae\xcd\x4f\xdd\x42\x73\xd0\x8a\x3d\x15\xc0\x2d\x40\x1c\xe3\x80\xa9\x5a\xeb\xb5\xf7\x98\xea\xaa\xa0\xa8\x7a\xec\x8e\x89\x7c\xb9\xf4\x74\x27\x46\x4c\x84\x4f\x69\xb6\xbf\x77\x77\xcc\xde\xa5\x39\xc1\x40\x6d\xfa\x47\xc4\x23\x53\x70\x2b\x9d\x8d\x1e\x83\xdb\xdb\x31\x03\xeb\x13\x42\x95\x62\xfc\x02\x4d\xb7\x6a\xe7\x91\xe0\x3a\x8e\x52\x78\xa9\xa2\x05\x6b\xd9\xe8\xd0\xe3\xba\x0d\x03\xd4\xdf\x77\x8b\x50\x9d\x55\xb4\x03\x1a\xfa\xa4\x78\x54\xfb\xd1\x76\x00\xef\x4b\x02\xd7\xe6\x10\x5f\x84\xdb\x40\xe8\x3d\x64\x98\x80\xbf\x94\x92\xfa\xeb\x24\xcc\x08\xe8\x1d\x95\x41\xf7\xb3\x4c\x91\x7a\x8f\x34\x1b\x64\xbf\x16\xfb\xc2\xed\xc0\x6c\x5c\xa9\xdd\xf1\xdc\xdc\x07\x9b\xfb\xaf\xca\x60\xa0\x6e\x7c\x84\x70\xdf\x7b\x49\x4a\x89\xbf\x95\x64\x29\xf1\x7b\x49\x6e\x24\x7e\x23\xc9\x07\x39\xf9\x28\xc3\xd7\xc5\x82\xdc\x4b\xfc\x51\x86\x4a\xf7\x24\xdf\xc2\x23\x60\x95\xbc\x87\xe7\xfa\xca\x9c\x37\xda\xe2\xfc\x83\xd2\x60\x9c\xeb\xcc\xf1\xeb\x43\x95\xa6\x16\x37\xbe\x3a\xdc\x35\xc1\xc2\x56\xfc\xb4\xe2\xfa\x53\x57\x20\xaf\x95\x92\x2e\x3e\xbe\xc9\x37\x42\x82\xe0\x06\x16\x40\xa5\xd4\xe1\x8c\xbc\x6d\xdd\xb9\x51\xa0\xe9\x93\xee\x63\xe1\x07\xa3\x1a\xea\x80\xe1\x18\x44\xc3\x56\xec\x9e\x73\xeb\x63\x8e\x2b\xe4\xb3\x19\x9d\x0f\xd8\x8c\x0e\xe2\x39\x42\x8d\x2b\x35\x6e\x98\xfc\x89\x2f\x3e\x96\x3e\x3a\x88\x02\xd4\xb9\x3f\x28\x80\xbf\xce\x0b\x55\xca\xa7\x08\xe7\x84\xbe\x8a\xa6\x5d\x9d\xf0\x81\x40\x28\xcd\xb1\x20\xdc\x52\x4a\xbe\xf7\x4d\x20\x08\xb6\x43\x47\xe9\x20\x9e\x34\x22\x64\x1b\x08\xf0\x39\xc9\xfa\x22\x54\xf8\xbf\xc9\x8b\xed\x0f\xfa\x23\xb4\xf4\x06\xf6\x40\x85\xdd\x3a\x05\xa5\x3e\x27\x45\x3f\xc7\x94\xc4\x08\x3f\x2e\x56\x1b\xf1\x31\xe5\xc3\x1c\x43\x24\x59\x4a\xb5\x7d\x35\x2d\x07\x7c\x98\xec\xab\x1d\xee\x8a\x16\x1e\x7e\xd4\xae\x6c\x8f\xb7\xf9\x92\xa5\x5e\xa6\x78\x85\xb7\xc7\x3a\x58\x22\x7d\x84\x28\x92\x74\xf6\xa8\x77\x24\x0b\x8a\x87\x73\x6d\x9d\x3a\x8d\xf0\x4d\xc1\x97\xfa\x46\xa0\x47\x9d\xf8\xa7\x2a\xe1\x34\xda\xef\xe7\x78\xdb\x68\x42\xdf\xf6\xee\xed\xe7\x9d\xb1\xa7\xd6\x3d\x48\xed\xaf\x45\xfa\x78\x38\xf4\x34\xbc\xc0\x8d\x91\xa7\xe1\xcb\x6a\x13\xfd\x49\x12\x2e\xab\x5d\xb4\x1d\x37\xe5\x92\xf9\xbf\x14\xb5\x64\xb6\xf4\x83\x28\xa6\x06\x5c\xfa\xbd\xa2\x7f\x0f\x7b\x87\x83\x81\x2f\xbe\x3d\x7c\xd9\x2c\x76\xcb\xc5\x97\xb4\xf8\x06\x08\xf0\x48\x34\x54\x9b\x01\x4e\xb8\x6c\xdc\xe1\xdf\xba\xb9\x40\x38\xd7\x16\x60\x60\x8c\x95\xb3\x02\xd2\x6b\xb2\xe5\x41\xaf\x13\x41\xf2\x2e\x94\x70\xa9\x38\x64\x33\x6a\xc4\x47\xd6\xe1\x0f\xff\xa0\x09\xe9\x04\xc4\x10\x0f\xb3\x26\x61\x62\x4f\x53\x52\x38\x7b\x18\x6e\xe7\x8a\x12\xc2\x36\x9a\xac\xc3\x6d\x33\x1d\x75\x36\x5c\xf3\x93\xce\x76\x5d\x3c\x3a\xcd\xd6\xc9\x1d\xad\x76\x2c\xaf\xc3\xb6\x3b\xe7\xce\xf6\xd0\x91\x79\xd0\x8f\x68\x05\xf1\xd4\x08\x0c\xdd\x09\xef\xe8\xba\x41\x0f\x75\xa7\x8d\xe4\x8e\x61\xb5\x08\xab\xab\xe1\x03\xd2\xab\xda\x6e\xe5\xd4\x0e\xe0\x9d\xf7\x60\x75\xb9\x60\xe9\xc3\x78\xed\x67\xb5\xc9\x58\x51\x95\xf9\x51\xbd\x81\x57\x53\xa4\xb8\xa3\xe5\xb4\x0c\xdc\xac\x19\xea\x08\x0c\xe1\x33\x36\xc7\x0c\xd7\x6e\xe8\x26\xe3\xc0\x45\xbe\xfe\x40\x1a\x58\xaf\x6b\x0f\xaa\x42\xbf\xd5\x9e\xa2\x39\xe1\x4f\x79\x4a\xab\x0d\x67\x22\xc3\x0f\x9a\xd6\x75\x65\x27\x8a\x8a\xda\x90\x2e\xb8\x6e\x63\x7b\xac\xd0\xd6\x29\xe4\xba\x3a\xda\xa8\x54\x6c\xfd\x1d\x09\xab\x6e\xf0\x38\xf4\x5e\xcc\x9f\xf4\x5e\xcc\x3b\xbc\x17\xad\xc6\x91\xb7\x14\x52\x57\xf1\xc8\x1b\xea\xac\x81\x0e\xe4\xc4\xb4\x08\x61\x0f\xc0\xf0\x37\xe5\xae\xf3\x26\x24\x95\x33\x36\x77\x3d\x67\x0b\xed\x56\xce\xe0\xbe\x29\x3b\x8e\x66\xd7\x44\x87\x45\x70\x1b\x4f\x66\xd0\xfd\x27\x96\xdf\x32\x59\x6c\x8d\x23\x45\x0e\xf7\xcf\xf0\xbb\x1c\xfc\xad\xba\x8b\x1e\xbd\xc5\x11\x66\xbd\xa8\x1d\x45\x73\x42\x0f\x56\x1c\xf8\x9b\xdf\x30\xf9\x25\x2d\x19\x6c\xe5\x70\x54\x91\x87\xbc\x7c\x5b\xa9\xe6\x3e\xc2\x1b\x55\x15\x88\x76\xb7\xa3\x2e\xc9\x2e\x09\x0d\x17\x34\x5b\x6c\x32\x2a\xd9\x97\xb4\x80\xb6\xa1\xa1\xd2\xa7\x66\x4e\x19\xe6\x08\xaf\x5a\x05\x61\xda\x0f\x0a\x6e\x30\x47\x93\xc2\x31\x0b\x90\x0c\x17\x20\x15\x12\x31\x2d\xd3\x25\x3c\xe2\x22\x7c\x20\xd9\x54\x27\xac\x18\x5d\xa6\xab\x50\xfb\x56\xe0\x22\xdc\x92\x6c\x6a\x5f\xd3\xba\x08\x2e\x8c\xc4\x09\xd9\x70\xa5\x9a\x71\xe0\x2c\xb4\x9c\x4a\xb2\xa9\x4e\x48\x75\xb6\x0e\x26\x7c\xaf\x98\x7e\xc7\x47\x7d\xea\x25\xde\xde\x03\xf4\x21\x91\x5a\x67\x54\x2e\x56\x5c\xdc\xfc\x95\x43\xbc\x94\x5a\x76\xc6\x73\x0f\x54\x59\xbd\x02\xab\x28\x57\xd5\x0f\x5b\xc2\x14\x19\xcf\xc5\x92\xcc\xe6\xe6\xc2\xaf\x68\xc2\x2e\x73\xf8\xa0\xa2\xa2\x29\xec\x9f\xc6\x84\x90\xc2\x58\xee\xcb\x2a\x9e\x5b\xe8\x66\xd0\x6e\x57\xd9\xf5\x0a\x27\x86\xc2\x66\x57\xe7\x38\x36\xc1\xc6\x0b\x9f\x12\x22\x11\x70\x1c\x2b\x80\x95\x26\xac\xc3\x8a\xa8\xc7\x22\x2a\x6b\x54\x55\x72\x62\x5d\xb3\x1d\x44\xdc\xd6\xb2\xea\xba\xf0\x91\xb0\xfa\x72\xa4\xa9\x70\x42\xd5\x6b\xb1\x10\x46\xcd\xa7\x36\x62\x66\x10\x2b\x2d\xdb\x92\xe4\x53\x1a\x51\x7b\xa6\xb8\xc5\xb0\x76\x69\xed\x72\x59\x6c\xf9\xb4\x72\x8b\x38\x47\xf0\xd5\xf7\x4f\x69\x05\x0d\x3b\x81\xb8\x95\x85\x40\xcb\xb5\xf6\x3c\x3f\x15\xe6\x9e\x19\xa8\x8f\x99\x58\xaa\x14\x26\x96\xfa\xbd\x56\x07\xd2\x2a\x56\xb5\x4a\x52\xcb\x15\x02\x5b\xc5\x1e\x3e\xed\xd7\xb5\xea\xba\x77\x00\xdc\xb8\x66\xc8\x0d\xc5\x5e\x91\x8e\x70\x5a\x1f\xe1\x05\x59\xb5\x99\xc0\x35\x59\x36\x1d\xd6\xf1\x0d\x59\x3d\x8f\xd6\xd7\xaa\x20\x5c\xc3\xa8\xd1\x75\x3d\x93\xf3\x8a\x47\xe2\x5b\x22\x9a\x3b\xfe\x1d\x59\x1d\x2c\x8d\xab\xf6\x04\x69\x81\xec\xa1\xfe\x7a\xf5\x5a\x1f\x92\x4f\xa3\x74\xad\xb6\xeb\x57\x24\xea\xf5\xd6\xaa\xe1\x57\x24\x9a\xc2\x83\xce\xc0\xdb\xc3\x3a\xeb\x50\xcd\x44\x77\x3d\xfa\x30\xa8\x6a\x73\x31\xd0\x6d\x7c\x20\x37\xce\x47\xd5\xee\x9c\x35\x77\xe7\x98\x96\xaf\x90\xfe\xac\x5a\x34\xe1\x97\x1f\x7a\x3d\x9f\x92\x9b\x19\x9f\xa3\x7a\xbd\x41\x1c\x15\x35\x12\x27\x21\x57\xbd\x9e\x5f\xd4\xd0\xf9\x9b\x03\xbc\x19\x5e\x59\x63\x0f\x99\x11\x6c\x60\x04\x1b\x03\x77\xaf\xb7\x31\x63\x99\xc2\x83\xce\xc0\x3e\x0c\xe1\x32\xea\xf5\x8a\xcb\x68\xb7\xab\xc7\x5b\xbc\x8a\xd4\x5e\xf5\x10\x90\xa2\xbe\x37\x30\x27\xab\x43\x4a\x7f\x50\x1b\x84\x5f\x76\x66\x05\x5b\x84\x06\x79\x7d\x13\xe2\xad\x73\xea\x93\xa1\xcb\xdb\x5e\xcf\xcf\xc8\x2d\x2e\xc9\x16\x3a\x3d\x5d\xec\x76\x5b\x05\xcc\x62\x9a\x0f\x6e\xd3\x3c\xb8\x45\xf8\x11\x78\x73\x86\x15\xa3\x4f\x73\x0c\xec\xbd\xc4\x86\x9f\x97\x41\xa6\x74\x35\x7c\x64\x23\x39\xba\x13\x7a\xd7\x19\x7b\xf0\x14\xdb\x68\x88\xbe\xd3\x23\x6b\xa5\xd2\xc3\x71\x01\xf1\xc3\x4a\xb9\x7e\x15\x4d\xe9\x4c\x0e\xe2\xb9\xb9\x59\x93\xc8\xea\x8e\x93\x41\xac\xb2\x02\x9b\x95\x91\x2e\xfd\xb4\xba\x34\x4c\x1b\x6a\xf5\x2d\x93\xc5\xc0\x37\xef\xe5\x14\x1c\x8a\x07\xc6\xcb\x38\x2d\x07\x05\xd2\x57\x14\xa8\xcc\x5e\xcf\x2f\x49\x11\x14\x83\x5c\x71\xad\x62\xe0\x17\x03\x47\xc3\x2e\x11\x1a\x26\xfd\xcc\xaa\xb8\x15\xc6\xcb\x41\x0e\x19\xc3\x86\x95\x41\x6b\xbf\x2d\x5d\xd9\xf0\x26\xae\x6f\x13\xc4\x1c\xa5\x5f\x99\x6f\x1e\x20\x5c\xcb\xa9\x35\x3b\xf7\x25\xee\x5a\x8d\x48\x7b\x42\xa8\xb6\x20\xac\x6b\x23\x3e\xf6\x0b\xfb\x04\x5f\x85\xaf\xc0\x7e\x2d\x7d\x7e\x20\x90\xc7\xc3\x08\x61\x5b\x91\x86\x00\x6a\xc5\x47\x35\x45\x0c\xca\x61\xa2\xa9\x22\x0f\xd4\xa3\xa1\x8c\x5c\x5f\x93\x5a\x1e\x73\xf5\x72\x59\x1e\x3b\xc2\xf2\xda\x61\x8a\x3a\xa2\xa0\xfb\x82\x8a\x2a\x5e\xb3\x30\xf1\x92\xd5\x19\x95\xbd\x7c\xdb\xd7\xf6\x67\xfd\xbd\x6a\xb0\x9d\xa1\x49\x71\x49\x27\x41\x50\x7d\x80\x83\x35\xd6\xb6\x8e\xcb\x9e\x15\x73\x34\xd1\xdf\x50\xc8\x15\xa2\xd0\x6e\x57\xbd\xd1\x07\xb4\xdb\x89\x59\x51\x05\x5d\x56\x9d\xd6\x77\x7e\xeb\x6e\xd1\xf3\x42\xe1\x5c\xe4\x60\xd6\xb8\x11\xad\xa1\x3e\x1f\x6f\xab\x8a\x26\xae\xd5\x69\xa4\xc3\x96\x8e\xe9\xc6\xdc\x64\x76\xe9\x7d\xd5\xd9\x50\x93\x3a\xc9\x6b\xe9\xb7\x09\xb6\x55\x04\xb5\x74\xe1\xaa\x4a\x4d\x5c\xcd\x02\xa8\x53\xcd\xd5\xd5\x3a\xd4\xdf\xae\xc2\xe8\x50\xa5\xd4\xf5\xdb\x64\x7d\x50\x0c\x6a\x3a\x1b\x1e\xd1\xab\xc1\xdd\x02\x9b\x05\x10\x66\xfb\x3d\xc2\xef\x3a\x7c\x28\xbe\x96\x87\xb7\x09\x55\x2e\x5b\x57\x9b\xab\xab\x8c\xb5\x0d\x5a\x25\x87\x13\x94\x63\x16\x2d\x63\x8e\xc2\x6b\x6b\x7e\xad\xce\x69\xf8\x32\xf5\x1e\x06\xf4\x81\x97\x83\xc8\x3b\x66\xc6\x72\xea\xe9\xe3\x3b\x55\x6b\x5b\xd7\xaa\xcc\xbb\x65\xfa\x68\xef\x54\x2a\xd3\x47\xc9\x65\xd7\x95\x1d\x9e\xb7\x37\x6a\x57\x97\x04\xc9\xdc\xe0\xb9\x46\xec\x9c\x56\xcc\x76\x3b\xcf\x83\xa3\x9c\xa3\xc5\x74\xfc\xaf\xd9\x54\x2b\x26\x1d\x78\xe9\x89\xef\x05\x32\x7c\x00\x05\x30\xf0\x30\xdc\x51\xbc\x75\xde\x78\x58\x04\x1e\xf2\xf6\x95\xa5\xed\xfb\x4f\x59\xda\xc0\xb8\xfc\x6f\xb9\x1b\xa8\x2b\x92\xae\x4a\x6e\xd4\x6b\xfb\x9b\xd8\x24\xed\x6e\xa1\xde\xac\xeb\x45\xf3\xca\x21\xec\x59\x07\x03\x6f\x7e\xd4\xd0\x51\xdf\x96\xd9\x32\x74\x98\x9b\x0a\x84\x73\x2d\x01\xec\xc6\xac\x65\xc6\x10\x98\x63\x89\xf6\xe8\x5f\x31\x63\x34\xc2\x22\xf3\xa7\x0c\x13\xe5\x53\x06\x89\xec\xd3\xe6\x0f\xbc\x69\xd9\x4c\xac\x0c\x86\x97\x95\xfd\x62\x45\xc4\x34\x77\x25\xa3\xaf\xd8\x82\xdf\xd2\xcc\x0f\xc7\x28\xcd\x0f\x45\xa6\x83\xcf\x01\x6d\xa6\x9b\xf4\x3b\xfa\x1d\x66\x78\xa9\x84\x70\x31\x2d\x5b\xea\x79\x5a\x1e\xb6\xb2\x81\xe2\x8e\x79\x26\x77\xac\x30\xa5\x7a\xb6\xb7\xbf\x66\x07\x96\x97\xe5\xf3\x6c\x2e\xd9\x93\x36\x97\xec\x68\xc4\x68\xd6\xb0\xaa\xd4\x8e\x51\x59\xed\xf4\xe3\xba\x5c\x65\xae\x9f\x8b\x25\xc1\x34\x73\xdc\x5d\x8c\x7f\xf5\x34\x52\xa9\xba\x7e\xf9\x91\xaf\xd3\xc2\x38\xb5\xe8\xdd\x72\x55\xed\x9b\x0b\x84\x1f\xd2\x15\xde\xa6\x8b\xbd\x6b\x4b\xf9\x64\x90\xae\xb5\x9a\xc0\x66\x56\x6f\x5e\x9f\x1f\x6b\x7b\x78\x44\xeb\x62\x8e\x1d\xc5\x5c\xd3\x85\xc6\x8c\xdb\xfa\xfa\xec\xf1\xe1\x5d\x04\xef\xd4\xde\xd3\x19\x64\xcb\xfd\x03\xdf\x6e\x04\x3b\xa6\x13\x5a\xeb\xd4\x76\x00\x82\x9a\x4e\x1c\x6d\x5d\x4b\xbb\xfa\xb4\x6b\x69\x60\x45\xd3\xc1\xc7\x02\x4d\xac\x73\x59\x20\xdc\x40\x94\xdf\x1a\xb6\x6f\xc3\xee\x69\xfb\xb2\x9b\x03\xa6\x40\xed\x4a\x85\xf7\x92\x7c\x9e\x70\xd3\x38\x2b\xc8\x6c\xf8\x3d\xef\x0c\xbf\xa7\xdd\x11\xf7\xd5\xc6\x72\x10\x9e\x6e\xa5\x7b\x57\xf6\x52\x32\x54\x69\x71\xf6\xb5\xf4\x67\x45\xe5\xe1\x15\x16\xb8\xba\x63\xc0\x26\xf2\x83\x8b\x06\x8c\x97\x95\xce\x9f\xe3\x4c\x31\x2f\xb8\x4d\xf5\x9b\x0e\x01\xe2\x47\x59\x7d\x9d\xee\x57\x49\x92\xfe\x8f\x12\x7f\x27\xc9\x8f\x72\x98\x54\x62\xc4\x32\xdf\xdc\xac\xc4\x46\x36\x6f\x42\xd5\x8f\xec\x47\xf8\x8e\x58\x7a\x1a\x61\x93\xa0\x2f\xdf\x3a\x8d\xf7\xb8\x5b\xea\xc8\xd8\x0d\x13\x4b\xfb\x6d\xe6\x63\xdf\x91\x20\xcb\x7c\x01\x48\x37\xf7\xc8\xd8\x3d\xc3\xdb\x64\x9e\x9e\x62\x10\xcf\x73\x52\xd4\xf6\x88\x92\x18\x4b\xad\xbe\xb3\x21\x2c\x99\x7c\x2d\x65\xc1\xaf\x36\x92\xf9\xde\x22\xa3\x65\xe9\x61\x19\xf2\x65\xe0\x0d\x34\x14\x1e\xc2\xf6\x6a\x52\x64\x8c\x6d\x58\xc0\xad\xc8\x0d\x03\x90\x35\xc5\xfb\x9c\x50\x45\x15\x0a\xfe\x15\xcf\x96\xfe\x31\x20\x33\xee\x21\x84\x9e\x55\xb4\x5c\x53\xe1\x81\x1a\xbf\xcd\x0e\x17\x35\x80\xd2\x4a\x54\x5b\x4e\x39\x63\xf3\x5e\x8f\x3f\xd5\xc3\x4f\xec\x41\x7e\x97\x2f\x99\x5f\x82\x9d\xc0\xd2\xa0\x89\x4c\x78\xfb\xd3\xb7\xdf\xd8\xb9\x48\x1f\x35\xd6\xd2\xc7\x1b\x26\x58\x41\x25\xfb\x46\xbf\x77\xf1\x45\xd8\xe1\x2b\x41\x5d\x57\xac\x6e\x77\xad\x25\x2d\x93\x34\xad\x8a\xdc\xd2\xb5\xdf\x92\x07\x8c\x9d\xba\x7d\x9d\x5d\xa4\xe6\x97\xda\xef\xe0\x64\xac\x08\xed\xe5\x32\x3e\xaf\xd4\x41\xc9\x1e\x64\x2a\x70\xe5\x81\x92\x16\x07\x7c\xd6\x71\x58\x54\xb9\x0e\x5b\xab\x7c\x15\xab\x74\xbb\x3f\x2d\x97\x4c\xa4\x7a\xe7\x70\xa4\x46\x43\x0e\x33\x3e\x47\xbb\x1d\xb5\xcf\xe6\xc2\x72\xac\x2f\x39\xe3\xfb\x3d\x42\xe9\x6c\xbe\xdf\xe3\x5c\xbc\xc9\x78\x83\xb4\x5b\xc7\xf5\xc4\x7c\x56\xdc\xc6\xea\x03\x4b\x71\xee\x4d\xf1\xf3\xa6\xa5\x6d\xb7\x9b\xcd\x51\xfb\x2e\x15\x9f\x92\xbc\x8d\x3a\x81\x90\x55\x23\x7b\x3d\x9f\xda\xe7\xea\x6a\xf5\x76\x0a\x9a\xe4\xf6\x4e\x23\xb4\xdf\xe3\xc5\x46\xe6\x1b\xe9\x9c\x24\x8f\xa3\x7a\x17\x1e\x7c\x27\x71\x23\x06\x26\xfd\x55\xfe\x5b\x65\x79\x73\x96\x62\xa5\x71\xcc\x89\x97\x9e\x78\xc1\xe7\x4a\xef\xf5\x39\x8c\x40\x53\x5f\x90\x2a\x08\x03\xcd\xa2\x79\x40\x78\x2a\x02\xc2\xb1\xa8\x25\xf7\x2f\x3f\x25\xb9\xbf\x2e\x16\xee\x5d\x97\xe6\xca\xa7\x7f\x87\x24\xef\x04\x95\xfd\x6b\x72\xfd\x1c\x4c\xe0\x5c\xdc\x1c\xde\xd9\x59\xdf\x41\xa5\x78\x5b\x34\x11\x97\xb2\xbe\xa5\x40\x1f\x65\xf1\xd2\x10\x91\xb1\xe0\xfa\x02\xf5\x7a\x41\x50\x5f\xfe\xf7\xf4\x69\x27\x90\x34\xec\xca\x8a\x1d\xeb\x5d\xb9\xba\xc8\xe8\x75\xc1\x28\x9c\x29\x59\x21\x6a\x43\x62\xbc\x24\x31\x5e\x91\x08\x2f\x48\x84\xaf\x49\xe1\x48\xf4\x37\xe4\x5a\x73\xf6\x35\xc9\xc2\x36\x3d\x0e\xe3\x28\xda\xed\x22\x7c\xab\xf2\x5c\x52\xc4\x77\xa4\x00\xb3\x82\x42\xc1\xdf\xf4\xf7\x75\x0a\x63\x89\x57\x7b\xc1\xed\xe5\xaf\x06\xe0\x2b\x52\x4b\x93\x5f\xfc\x2a\xf1\x03\xf1\xaf\x02\x72\xf5\x8a\xfc\x28\xa7\x83\x5f\x65\x7a\x75\x39\xf8\x51\x4e\x7f\x95\x69\x84\x82\x5b\xbc\xad\x3f\x62\x77\x85\xf0\x87\xfa\x13\x76\x57\x08\x7f\xac\xf3\x1e\x10\xbe\xaf\xf3\x1e\x10\xfe\x96\x5c\x5d\x92\xa8\xd7\x7b\x78\x45\xa2\xdd\xee\xe1\x15\xf9\x55\xe2\xf7\x2a\xed\x3b\x09\x89\xdf\x49\x93\x1a\x7c\x27\xf1\x1b\x95\x31\x30\x39\x03\x93\xf5\x23\x64\xfd\x40\xae\x08\x21\x83\x1f\x6d\xda\x74\x10\xa7\x95\x0d\x6d\x8b\xb7\xfd\x35\xfe\x88\x3f\xf6\xd7\x08\xbf\x26\x6f\x1a\x99\x1f\xf0\x87\xfe\x1a\xdf\xe3\x7b\x95\xf9\x15\xf9\x76\x1a\xd7\xa1\x87\x8d\x8a\x3f\x91\xf7\x6e\x9e\x5b\x6f\xb2\x21\xfe\x57\x83\x1f\xd0\x30\xc1\x4b\xe2\xff\x34\x78\xad\x9e\x56\x64\xe0\x7f\x15\x40\xe2\x82\x0c\xfc\x9f\x02\x95\xba\x37\x76\x6e\x4c\x1d\xbb\x38\x58\xbb\x38\xba\x51\xac\xb2\xd2\x40\x8a\xa7\xd4\x2c\x55\x14\x73\xed\x82\xd4\x38\x8f\x35\x24\x42\x1f\x1c\x9a\x87\x13\x73\xdf\xf8\xed\x0e\xb4\x8b\xee\xa0\x51\x0b\x0d\x37\x58\xa8\x22\xc6\x93\x13\xfc\x7d\xdb\x45\x96\x38\x77\x63\xf5\x6a\x4f\xeb\x0a\x95\x4a\x05\x1d\x26\x38\x52\xb2\x82\x13\xa0\x57\x97\x6c\x34\xd0\x5f\xeb\x92\x5a\xfa\x32\x06\x9e\x66\x91\x41\xde\x0c\x6f\xf5\x35\xed\x9a\xc5\x67\x96\xa2\xa6\xe2\x9f\x72\x38\x7c\xd9\xed\x62\xd5\xa6\xf6\x26\xfa\x3b\x59\xf5\xf3\xe6\x05\x13\x26\xe7\x1f\x64\xd1\xca\xb9\x0e\xa5\x6a\x41\x2d\x4b\x6b\x34\x37\x4d\xe2\xa2\x31\xec\x36\x80\x2e\xf8\xfd\xf6\xda\xd2\x61\x55\xd5\x0a\xc3\x45\x37\x5e\x8a\xa7\xda\xbc\x53\x68\x3a\x42\x32\x45\xcb\x50\xa0\xe9\xe2\xf3\xfd\x1d\x34\x2b\x02\x61\xa2\x62\x45\x4a\x1d\xb0\xac\x48\x0d\xbb\x92\x68\x71\x46\x7c\xed\x18\x1d\x58\xa7\xe8\x61\x82\x37\x2a\x51\xe6\xeb\xa0\xf2\x86\x86\xd5\x90\xd7\x2a\xe9\xca\x7d\x59\xb4\xfd\x2a\xae\x89\xe8\xf5\xec\x0d\xe9\x46\x58\x9e\x46\xa9\x34\x1b\xf0\x34\x4a\x79\x3d\x35\x6f\x5c\x9e\xe6\x2f\xaa\x43\x9e\xbe\x9f\xb7\x22\x79\x7f\x95\x8a\x55\xba\x4d\xc3\xe6\x04\xcd\xb9\x41\xa4\xeb\x23\x65\x5c\x1a\xb9\x75\x54\x5c\xa5\x21\xd5\xb7\x71\x4b\xfc\xd8\xbc\xdb\x95\x37\x2f\x76\x65\x58\x6b\xc9\x87\xbe\xdf\xb7\x4f\x6a\xbd\xb7\x47\xb5\xde\xdb\x86\x3c\xe6\xde\x9f\x70\xeb\x46\x60\xe3\x87\x34\x0b\xa8\x5d\x0f\x78\x9b\x6e\xaa\xb7\x7f\xb8\x81\xd3\xcb\x3a\x5a\x7a\xd5\x12\x5e\xae\x1b\x61\xd9\xeb\x46\xf8\xf6\x8d\x91\x53\x8c\xb2\xf4\x5a\x8f\xbe\xd2\x99\xfc\x85\x71\x08\x61\x98\xb6\xdc\x40\x90\x15\x2a\xee\x2a\x13\xc2\xe4\x90\x00\x76\x3b\xff\xce\x09\xe9\x25\x11\x44\x5f\xd4\x64\x94\xf2\x96\x49\x6d\xc6\x06\xf1\xdc\x3a\x92\x54\x61\xc1\x77\x75\xac\xb2\xdb\x5c\x70\xd7\x24\x96\x86\x13\x49\x93\x0d\x1c\x8d\x89\x6f\xd0\x70\xc7\x05\x8e\x51\xeb\x56\x52\x7d\xb5\x5d\xc3\xe0\x47\xd1\xa3\x34\xa6\xd7\x19\x9d\x63\x2d\x54\x4b\xb4\xdb\x09\x43\xfb\xbb\x9d\xcf\x03\x52\xbb\x00\x23\xb8\x91\x94\x3b\x30\xbe\x69\xce\x58\x77\xd4\x94\x73\x77\xb9\x1a\x52\x25\xb3\xbc\x8a\x7a\xbd\x53\xdb\xeb\xf4\x57\x69\xee\x3c\xd0\x5d\x0d\x19\x4a\x23\x7d\xdb\x76\x63\x53\x39\x2e\xe0\x54\xd7\xfd\x45\x8d\x13\x74\xf8\xee\xa2\x74\x94\xc7\xd6\x21\x79\x5b\x85\xe4\xd7\xfe\xf2\x50\xde\x62\x48\x21\xcb\xe7\x64\xd9\x96\xe8\x99\x91\xe8\x31\x3b\x25\xa4\xf6\x2f\x80\x83\x62\xee\xe8\x48\xf6\x23\xcc\x1a\x1c\x83\x83\x68\x52\xc3\x25\xdb\x90\x50\xb8\xe5\x16\x17\x53\xb5\xfd\xb8\x37\x6f\xe6\x4f\x6f\xd2\x14\x33\x84\x52\xf0\x26\xb2\x7c\xd4\xdc\x93\x70\x4a\x48\xee\xae\x52\xb0\x6c\x34\xfd\xaa\x36\xc4\x57\xb2\x60\x5b\x7e\x45\xaf\xfc\x0d\x29\x5f\x6d\xa6\x65\xba\x41\xd3\x2c\xdd\x54\xea\xea\xe6\xff\x75\xb6\xba\x2e\xdb\xdc\x37\xff\x92\x6d\xee\x9b\xdf\x64\x9b\x6b\xd7\xea\xb2\xcd\x69\x37\xa7\xf6\xc6\xfe\x6f\x51\x1f\x7c\x16\xd4\x07\xad\x8e\x58\x2e\x6a\x53\x04\x6b\x77\xdf\x15\x09\x51\x49\x13\xdf\x98\x90\x88\xce\xab\x13\xe7\xe6\xf3\x92\x38\x46\x38\x32\xc3\x3a\x2a\x4f\x3d\xe5\xc7\x74\x20\xe4\x1c\xeb\xd3\xb9\x5f\xac\x32\x94\xd5\x8e\x6b\x5f\x1e\xfa\x91\xc3\xba\xf5\x30\x7d\xe0\x65\xea\x6d\x3f\xff\xf8\xed\xe0\xc0\xad\x76\x3d\x6f\x1f\xb9\x3d\xdf\x15\x7d\x8f\xbb\x43\xb9\x9a\x81\x5a\xd0\xea\xde\x3d\xb9\xeb\x1e\xd2\xd3\xfe\xeb\x0d\xec\x7c\xb6\x27\xfb\x1f\x25\xf9\xa9\xd6\xd2\x3f\xff\x73\x05\x0f\xbf\xfd\x73\x05\xf6\x4b\x07\x7b\x84\xff\xd6\x61\x41\xfd\x6b\xc7\x11\x2c\x7e\xab\x12\x3b\x43\x6a\xeb\x18\x91\x7f\x36\x3f\xdf\xd2\xeb\xd5\x77\x63\x4b\xbe\xf8\x08\xc2\x18\x18\xba\x0b\x76\xc7\x8a\x92\x61\x4a\x1c\x77\xbe\x5b\x2e\xa6\x2c\x8d\x70\xd1\x48\xa4\x0f\x2a\xd1\x5a\xc9\x8c\x5f\xc7\xb4\x48\x29\xf8\x9a\xf1\x29\x4d\x0b\x27\xa4\xf0\x97\x76\x48\xe1\x30\xc1\x94\x00\x5c\xe0\xfa\xf1\x4f\xe9\x33\xec\x18\xdd\xf2\x75\x5a\x28\xa1\xc3\xc4\x96\xc2\x15\x25\x36\xbe\xb4\xd0\x22\x88\xf9\x1a\xaf\x7e\x71\xba\xfa\x4b\x3b\xaa\xb6\x23\x2e\x53\x7f\x29\x18\x3e\x14\x0c\xcc\x1c\x7a\x01\x97\x01\xdd\x07\x44\xba\xaa\xf6\xeb\x2f\x03\xcb\x4f\x7c\x15\xb8\x0e\xed\xd0\xb7\x7c\x3c\xba\x9f\x6b\xc6\xf6\xd6\x8e\xf4\x34\xc6\xbf\x29\xe8\xe3\x79\x47\xe2\xed\x23\x70\x43\xd2\xff\x38\x30\x3c\xb5\x3e\x76\x63\xa2\x93\x0e\xbe\x77\xd3\x3c\x4d\xfe\xbf\xe0\x9b\x37\xd8\x5b\x6c\xae\xf8\xe2\x9d\x90\xac\x58\xe7\x19\x08\xb1\xdf\xe6\x4b\x55\xec\x9a\x67\xd9\x91\x6f\xe2\x1c\xec\xbf\xfa\x08\xfa\xc0\x02\xd6\xb8\x67\x4d\x17\x71\x61\x77\x77\x62\x37\xdb\x80\x56\x1f\x3a\xea\xcc\xb7\xf5\x91\x77\xd7\x8e\x6c\x4b\x75\xda\xe2\xda\x9b\x70\xa3\xb0\x0b\xd3\xc1\x45\x0f\x07\x05\x2d\x74\xce\xfd\x10\x4e\x19\x0b\xa1\x7b\x1d\x85\x7b\x3e\x5f\xb8\x35\x6c\xe1\x4a\x7d\x30\xc9\xf6\x08\xff\x19\xb1\x0a\x07\xe7\xea\xd5\x46\x57\x9d\x9f\xc1\x55\xf1\xa5\xd5\xaa\x2b\x1d\x1a\x4e\xce\xab\x7b\xca\x79\xf8\xc1\x2e\x30\xf2\x37\xe9\x67\xa1\x7d\xc3\x65\xf5\x58\x6a\x03\x0f\x7f\x4e\x34\x01\x7f\x4e\x34\xc1\xc6\xfd\xac\x73\x66\x3f\xfd\xef\xf8\x3e\x67\xfa\x0e\x13\x9b\xee\x37\xde\xeb\x1a\x08\x17\xe1\x87\xd2\xf4\x66\xfa\x55\x49\x9d\xa1\x0a\x45\x7d\xcb\x0f\xc9\xd5\x9b\x3e\x39\xe7\x9f\xb8\x45\xb7\x50\x9d\x18\x6d\xcc\x86\x7c\xe4\x07\x4a\x42\xcb\xfe\x91\xdb\x78\x0f\x40\xdc\xa6\xd7\x53\x03\xb5\x5d\xd6\xe3\xb5\xd5\xf4\x1d\x48\x6f\xb4\x6e\xa0\xaf\x42\xf2\x8f\xf6\xa5\xda\xae\xd5\xc3\x67\x98\x57\x9a\xd6\xde\x9a\x66\xca\xe6\xb1\x6b\xa6\x33\x6b\x2d\x72\x43\x8c\xbd\x08\x2f\x49\x56\xf9\x4e\xac\xd4\x38\x34\x1d\xe0\x85\x7a\x36\x68\xbf\x26\xd5\x85\xf6\x56\xbc\xbf\x79\x5a\x2f\x81\x10\x15\xde\xe5\x98\x7a\xe0\x65\xb1\x9c\x2e\x8d\x97\xc5\x06\xbc\xf9\xa6\x8b\xb6\x97\x85\x63\x2f\x03\x04\xfe\xc3\x5f\xea\xd2\xb5\x93\xc5\xca\x71\xb2\x58\xb8\x4e\x16\x37\x07\x4e\x16\x9b\x6e\x27\x8b\x87\x94\xe3\x6d\x4a\xb5\x03\x43\xd9\x70\x60\xe0\x95\x03\x03\x45\x76\xb1\x57\x27\xbe\x0e\x53\xb8\xe9\x74\x98\xb8\xa9\x0d\x52\x6d\x16\x7b\xf3\xa4\x8a\x73\x73\x54\xc5\xb9\x69\xde\xc8\x6a\xae\xfc\xfa\x9b\xf4\x4b\x4b\x7f\xf8\x7a\x7a\x6d\x9f\xd3\x08\xe1\x52\x32\x25\x3d\xaa\x15\x9f\x9e\x9e\x5e\xf7\x7a\xd7\xa1\x93\xe4\xb0\xe5\x1b\xe7\x02\x98\xe7\x7f\x40\xe1\xf0\x93\xbd\x86\x09\x1d\x5c\x95\xce\x5a\x1c\x4b\x31\xb7\xe6\x05\x5c\xf9\x71\x97\x80\x03\x10\x8c\x57\x00\x73\xbc\x02\x2a\x87\xe9\xea\x16\x30\xc5\xfb\x44\x7d\x27\x58\x7d\x3d\x18\xc2\xb9\xc5\x91\x2e\xe3\x30\x22\x5c\xd4\x8c\x28\x77\x71\x45\xfe\x2a\xfd\x19\x6f\x60\x4f\x34\xde\x0a\xfb\x36\x57\x35\x17\x19\x5f\x93\xbf\x48\x1f\xda\x57\x2f\xf8\x17\xe9\xb3\x6a\x95\xb1\x6a\x8d\x35\x2d\xe1\x08\xe1\xdc\xb1\xbd\x68\xb2\xff\xad\xf1\x04\x5b\xbb\xa2\x23\x7c\x4d\xa2\x09\xbf\xf6\x0f\x5c\xfa\xb5\x6a\x59\x92\x00\x56\xec\x8f\x4a\x7c\xd3\xcb\x55\x22\x6d\x26\x58\xea\xe0\x90\xbc\x90\x6c\xd9\xd4\xe5\x74\x84\x41\x75\xe9\x38\xd6\x1e\xf6\x1b\x30\x8a\x64\x0d\x0f\x7b\xfd\xa5\x12\xda\xb6\xca\xcc\x0a\x7b\xc8\xa8\xc5\x42\xa2\xd8\xa9\x9a\xfb\x5e\xaf\xa8\x3e\xf4\x46\xc8\x2a\x84\x6f\x17\xfb\xf9\x21\x90\xb4\xf6\xc0\xbf\x8c\xa6\xd7\x01\xc9\x77\xbb\x28\x5d\xe8\xdf\x3a\x82\xe6\x32\x9a\x76\x30\xa4\xeb\xa0\x44\x69\x47\xfa\x22\x28\x91\xb9\x9b\xf4\xa4\x23\xbb\xb6\x7f\x77\x70\xf8\xa3\x57\xc2\xbb\xd3\x53\xb4\x2d\x79\x8e\xff\x84\x65\xb3\x65\xc3\x5c\x9e\x99\x02\x6a\xf7\xaf\x35\x97\x4d\xeb\xfa\x98\xc3\x43\x13\xb8\xfa\x97\xa1\x3d\xbf\xf6\xeb\x65\xf1\xff\x63\xef\x5d\xb8\xdb\x36\xae\x85\xd1\xbf\x62\xf1\xf6\xe3\xc1\x98\x43\x88\x54\x1e\x6d\x41\x8f\x79\x1d\x27\x8e\xdd\x26\x8e\x4f\xec\x9e\x26\xe5\xe1\xd5\x82\xc8\xa1\x38\x35\x34\x60\x81\xa1\x44\x46\xc4\x7f\xbf\x6b\xf6\x9e\x27\x08\xca\x4a\xda\xf3\x7d\xdf\x3a\xeb\x24\xcb\x22\x30\x2f\xcc\x73\xcf\x7e\x6f\x30\x00\x28\xd2\x95\x28\x14\xaf\x92\xe4\x98\xda\x3f\x73\xd6\x72\x1a\x18\x36\x7a\x4f\xf6\x6e\x4a\x59\xaa\x12\x17\xa9\x4c\xbb\xf1\x4c\xf2\x1a\xdc\x8b\x4b\xfe\x72\x5b\xdd\xf2\xef\x4d\x8d\xa4\x08\x62\x50\xa0\x95\x4f\xd1\xb6\xe9\x91\xac\x00\x1f\x6a\x36\xde\x77\xd4\x50\xd2\xf2\xa5\x56\x50\x45\x1c\xb3\x89\xbe\x4e\x25\xdf\xa9\xa3\x8c\xd2\x9f\x62\x69\x19\x74\xb0\x44\xef\x4c\x53\x3f\x31\xe1\x9a\x05\x67\x6b\x5d\x85\x7e\x0e\x0b\xed\x5b\x85\xde\xf2\x9d\xd2\xad\xe8\xef\x1f\xb5\xa0\x33\x7f\xb6\x99\x7b\xd4\x84\x39\xd2\x10\xd2\x43\x4c\x5b\xee\x12\xc9\xa3\xa7\xe9\xb5\x4a\x24\xad\xc1\xba\xf1\xf9\xa8\xdf\x7f\xad\x92\x02\x8c\x23\xdc\x16\x42\x8f\x51\xdd\x83\xdf\x9e\xca\xa1\x28\xc3\xa3\x46\xa4\x77\x6a\xfa\x7e\x3e\xd9\xc2\xcf\x14\x24\x7c\xd4\x0a\xfc\x08\xa1\xea\x59\xe1\xcc\x34\x6c\x47\x07\x0f\x76\x14\xe7\xb6\xfd\x09\x48\xfd\x44\x07\x71\xe2\xbb\x6a\x1e\x77\xec\x54\x9c\xa6\x76\xa0\xcc\x13\x91\x93\x4c\x10\x3c\x1e\x1c\xd4\x4a\xbf\x01\xbf\x81\x62\xb8\x15\x67\x48\xec\xed\x8e\x2d\x06\xae\x17\xce\xc7\x60\xb2\x47\x0e\xae\x8a\x63\x43\x06\x0e\x86\x0c\xe8\x5a\x0a\x4c\x0f\x91\x04\x9f\x8e\xb2\x1c\x65\xae\xf8\x6e\x08\x70\x53\x02\x5e\xa6\xc6\xb8\x32\xcb\xf1\x7d\x60\x29\x7a\x4d\xb1\x9b\x82\xaa\xdc\x40\x4b\xaa\xdc\x0c\x91\xf0\x37\x04\xbc\xc9\xc7\xb7\xa9\xb5\xdf\x84\x10\x24\x3a\x65\x60\xb3\x1a\xbd\x0c\x71\x84\x2a\xda\x65\x18\x01\xc3\x20\xc4\xc5\x9a\x11\xb3\xd2\x18\x52\xe4\xff\x3d\xf5\x41\xff\xfa\x4f\xf1\x9c\xff\xfa\x9b\x78\xce\xed\x5a\x0f\xeb\x83\xfa\xd2\xce\x97\x3b\xe6\x80\x4b\xb7\x6f\x1f\xb2\x6c\xd0\xc0\xbf\xd2\xeb\xda\xa3\xf7\x68\x14\x69\x58\x2e\xba\x81\xbc\xf8\xce\x30\x5e\x80\x9d\x69\xf8\x9f\x4b\x51\x6f\x8a\x7c\x0f\x9a\x89\x01\x5b\x14\x44\x65\x45\x5e\x65\x67\xa3\x06\x31\x6b\xa3\xfc\x16\x55\x00\x7e\x5c\x76\x0f\xfe\x91\x5e\xa8\xbf\xf1\xaa\x04\xfe\x29\x7d\xbc\x3e\xe4\xa8\x09\x05\x93\xc3\xf4\x0b\xeb\x8d\xfb\x7f\x14\x22\xff\x47\x21\xf2\x7f\x14\x22\xff\xeb\x15\x22\x4f\x2a\x41\xc6\x3a\x90\xff\x0a\x8d\xc6\xd6\x6e\x70\x2a\x8a\x03\x50\x68\xb4\x86\x44\x5e\x03\xf1\xa7\xff\x16\x1a\x88\x8f\x15\xac\x04\xb1\xa7\x53\xb1\x7c\x7c\xe8\xe9\x76\xbd\x87\x55\x12\xad\xff\x8b\xbc\xed\xe7\x24\x8f\x78\x56\x79\x2b\x28\xa9\x87\xd1\x87\xc3\x88\x16\x2c\x37\xf6\xfa\x35\x9b\xcd\xd1\x0f\x06\xdc\x29\xf0\xba\x34\x3c\x2a\x74\x99\x65\x5d\x79\xe0\x5d\x96\x00\x25\x5e\x6e\xa5\x82\x93\xbb\x95\x56\x1c\x19\xc4\x5f\x47\xe0\x5a\x1d\x41\x56\x3e\x18\x90\x62\xc6\xe7\x4c\x63\x18\x79\x7a\xb9\x28\x6f\x36\x5b\xc5\xa1\x57\x09\x27\x74\xab\xf3\x04\xad\x07\x4c\x4c\x42\xc5\x83\x16\x70\x5e\xce\x78\xa0\x78\x97\x3f\xc4\x3b\x5b\x02\x83\x91\xd0\xbc\xc5\x78\x5c\x7a\x47\x33\xd1\xe8\x4e\xda\x90\xaa\x20\xc4\xbb\xc7\x4d\x85\x8f\xe8\xee\x1c\xa1\x0a\x99\x48\xa3\xbb\x27\x11\x81\x94\x56\x4f\x4f\x6a\x3c\x90\x4c\x78\xb7\x5e\x5e\x8e\xba\x78\xbc\x5b\xe7\x4c\x1c\x29\x8d\x4e\xa3\x76\xce\xc7\xa3\xd1\xd3\xe3\x42\xd9\x18\xdb\x8c\xb5\xf6\xa2\x9a\x43\x1e\x6b\xed\x81\xd9\x5b\xcc\x97\xb0\x6e\x1a\xa2\x60\x16\xac\xdd\x4a\xa4\x06\xa7\x9c\xe7\x9b\x70\x38\xea\x81\x2a\xbf\x51\x07\xee\xc8\xdf\xcf\x69\x35\xb8\xdc\x38\x65\xdb\xb2\x48\xbd\x09\xf8\xb6\x3b\xf4\x3f\x48\xd7\xac\x48\xf7\xe6\x79\xc1\xca\x30\x40\xc1\x8a\x05\xda\x6d\x05\x7c\xd6\x04\x80\x79\x55\x95\x37\x58\xc7\xf1\x34\xbc\xaf\x1c\x7a\x0d\xd2\x03\x38\x6c\x7a\xdb\x6d\xd8\xf5\x20\x89\xf4\xe4\xcc\xd1\x33\x4e\x23\x8e\xb4\xda\x7e\xc5\xa7\x6e\xff\x19\x95\x37\xc4\x37\x8b\x93\xba\x6f\xb7\x0f\x62\xf8\xb7\x27\x31\xfc\xdb\x93\xba\x6f\xb7\x2d\xdd\xb7\x25\xdd\x67\xeb\x48\x63\x6d\x14\x69\xb3\xc9\xe9\x4d\xb6\x8a\x02\x88\x1c\xeb\x20\x2e\xb2\xeb\x20\x94\x48\x57\xfe\xe6\x13\x5a\x70\x5b\xca\x01\x14\x01\xb6\x1e\xaa\x98\x75\x40\xbb\xd3\x21\xfd\xfc\x96\xec\x08\x92\xdc\x56\x33\xe3\x6d\x35\x33\xae\xd1\x26\xa3\xe4\x15\xa0\x24\xdc\xa9\x98\xc9\xc1\xa0\x21\x84\xca\x7f\x19\x89\x47\xf3\x63\xaf\x8f\xff\x07\x35\x8b\xf2\x7f\x86\xc8\xcb\x7f\x0b\x8d\x97\x3f\x4a\xad\x28\xbc\xb3\x4e\x73\xed\x5b\x8a\x16\xb0\x73\xe0\xb6\x68\xf9\x5b\x08\x4c\x8c\x21\x58\x2d\xca\x48\x6c\xf4\x66\x8f\x83\x2a\x67\xd8\xe2\x34\xe0\x20\x56\xaf\xb5\xda\xe3\x36\x54\xaf\xb3\xdd\x53\xce\x76\x4f\xc4\xb6\x7b\xbc\x65\xbb\xf7\xad\x4a\x66\x2d\x49\x82\x67\xa7\xe5\xd5\xc2\xc4\xbb\x72\x41\x96\xce\xe5\x9c\x56\xfa\xe6\x0c\xf4\x83\x36\x82\xf7\xe8\xeb\x74\x51\x94\x92\x27\x6f\x53\x6b\x57\x47\xbc\xda\x0c\x94\xb8\x3f\xba\x9d\x46\x06\x51\xfc\xb3\x62\x5f\x29\xfa\xb7\x0e\xc7\xa3\xb6\x81\x2a\x5f\x82\xea\x51\xa8\xd9\x70\x9a\x40\x0e\xd5\x7f\x30\x98\x0b\xc4\x4b\xe9\x01\xe8\xe8\x39\x31\x8f\x77\xca\xfc\xbb\x7f\x5a\x65\xe1\x04\x1a\xfb\x6b\x94\x18\x62\xdc\xf5\x13\x0a\x0e\xbf\x46\xa5\xe1\x7f\x74\x15\xfe\x2f\xd0\x55\xf8\xbf\x89\x8c\xf8\x8d\xba\x11\x88\x3e\x05\x9a\x11\x80\xa6\xff\x8b\x55\x14\xea\xc7\xab\x26\x14\x65\xb9\x61\x67\xa3\xdf\xa6\xa5\xf0\xdb\x94\x14\xfe\xf7\xe8\x22\xd8\xf5\x89\x24\xbe\x9d\xbe\x2d\xc3\x85\x31\x4e\x0f\x51\x76\x60\x54\x27\x1d\x96\xc8\x69\x80\x27\xfe\x0a\xb7\x10\x71\xb0\x65\x8b\x4b\x2c\xc1\x21\x84\x41\x9a\xb3\x3a\xb5\x2e\x22\xf6\x2e\x65\x3f\x51\x76\x45\xcb\x87\x5d\x35\x3c\xe8\x24\xd3\x22\x85\xa0\x4b\x90\x47\xba\x04\x4b\xe2\xdd\x22\xd8\xc3\x58\x74\xe8\x12\x7c\xd2\xf9\xc2\x7f\x8d\x53\x08\x7b\xc9\xfc\x4d\x25\xb9\xd3\x25\xd8\x4e\xb7\xa1\x2e\x41\xa7\xe3\x88\xc7\x2b\x0b\x1c\x11\xa9\x56\x57\x40\x5a\x82\x35\xf0\x4f\xf4\xab\x55\x01\x54\x87\x2a\x80\xf0\xaa\x00\x7f\x53\x09\xf7\xaa\x00\x32\x50\x05\x10\x4e\x15\x00\xca\x84\xaa\x00\xb2\x25\xaa\x73\xc7\x5f\xfc\x76\x11\x70\xe4\x36\xc5\x43\xc2\xd8\x34\xea\x58\xd6\x5b\x3f\x5a\xd6\x8b\x6c\x90\x96\x54\x29\x90\xfd\x96\xac\xfc\x75\xb2\x5f\x14\x46\x96\xc7\xc2\xc8\xf2\xf1\x32\xdb\x92\x2a\x7a\x36\xea\x16\xdb\xb6\xf3\x3e\x2d\xb9\xad\x93\x48\x76\x5b\x19\xd1\xd7\xa7\x24\x96\x51\xb5\x3d\x05\x8b\x32\xea\x2c\xca\x3a\xa5\xbb\xba\x8a\x91\xef\x3e\xfc\x15\x14\x3b\xba\xe2\x47\xad\xff\xb7\x14\x6e\xfd\xed\x9f\x12\x6e\xfd\xed\x37\x09\xb7\xda\xb5\x1e\x16\x6e\xfd\xed\x21\xe1\x96\x43\xd7\xeb\x45\xae\x20\x74\xe1\x23\x5d\x74\x05\xfa\xc6\xe3\x1e\x7d\xbc\xc5\x40\xa0\x81\xfc\x40\x3d\xd4\xf3\xff\x17\xb8\xe8\x72\x79\x0f\x78\xd1\x32\xce\xb3\x1e\xb2\x18\x30\xb3\x93\x39\xa5\xed\xec\xcc\x07\x4c\xfb\x93\x62\xe0\x12\xff\x83\xa2\xe8\xe7\x2c\xfb\x41\x51\x4b\x52\x65\x5f\x29\x1a\xdb\x1b\x7c\x03\xa4\x07\xcf\x7e\x56\xd4\x09\x0f\xb3\x9f\x14\xdd\x08\x9e\xfd\x59\x51\xa0\x9b\xb2\xdf\x29\x6a\xbf\xf9\xb3\x6a\x3c\x0c\xfc\x77\x15\x31\xf6\x55\x2a\x73\x25\x6e\xf9\x34\x8a\x3b\x91\xc1\x79\xf9\x91\x17\x90\x67\x11\x0b\xa8\xe8\xd5\xe0\x15\xef\x08\xde\x40\x6b\x5a\x18\xbf\x78\x0f\xa8\x3b\x4d\xac\x91\x75\xe5\xf5\x33\x04\x84\x63\x10\xc4\x06\x6d\x28\x59\x22\x41\x05\x0a\xc0\x70\x10\xb5\xa1\x84\xa8\x0d\x49\xcd\xe4\x2c\x9f\x93\x20\x0c\xe4\xe1\xc0\x93\x3a\xe8\x20\x0f\x3b\xc8\x66\xce\xad\x02\x74\x3c\x02\xdb\x2a\x35\x91\x57\x12\x9e\xee\x28\x4f\xf7\xa4\xdf\x97\xe8\x22\x17\xbc\x7a\x51\xe9\x5b\x95\xbc\xed\x94\xf3\xed\xf6\xe6\x8a\x57\xe9\xbb\x1f\xde\xbf\xf9\xf0\xe6\x3f\xbe\xb9\x7c\xf3\xf6\xd5\x9b\xb7\x6f\x3e\xfc\x4c\xab\x07\x3f\x2a\x56\xc9\x99\x3c\x1c\x8e\xbf\x6d\xbd\x2a\x82\xf4\x2c\x08\x92\x02\x5c\x7e\x91\x70\x5a\x92\x49\xfd\x0c\x22\xe8\xcf\xd4\x9c\xe6\xac\x26\x59\xcd\x18\xcb\xfb\xfd\xca\x75\x5a\xf7\xba\xf2\xbd\x16\xdc\x03\xc9\xe1\x18\x62\x85\x59\x97\xc4\xbd\x5d\x8f\x50\x79\x94\xba\xef\x39\x14\x20\x40\x58\xdd\xa0\xf9\x34\x8a\x78\x22\xd2\x1d\x01\x0b\x0e\x39\x8d\xe2\xa4\x88\x74\x4f\x9c\x11\x07\x5e\xb8\xf5\x3f\x2a\xe5\x83\x36\xe7\x51\x48\xbf\x0a\x82\x33\xfb\x6e\xe7\x3c\x46\x92\xff\x5d\x41\x18\xb7\x89\x4c\x35\x00\x60\xf8\x73\x38\xf4\x76\xbd\x09\x76\x4b\xf0\x04\x13\x09\x44\x29\x11\x7a\xf2\x6a\xbe\x50\x53\xd8\x0c\x82\x64\xb0\x7c\x82\x9e\x8d\x29\xc4\xf8\xf0\x0b\x54\x59\xb1\x66\xf2\xc9\xed\x9b\xae\xca\xea\x9b\x7c\xb1\x8e\xef\xfe\x50\x8a\x3a\xab\x20\x1a\xa6\x71\xe7\xc1\xfb\xfd\x33\x1e\x45\x2f\x2d\x71\x9d\x38\x6c\xae\x12\x64\x8b\xc0\xdd\xe1\x0c\xc0\xa6\x06\x18\xa2\xc5\x6f\xf2\x3b\xd9\x4c\x02\xba\x7d\x7e\x68\x7b\xf9\xad\x65\x43\x61\x12\x87\xd4\x99\x7d\x42\x05\x58\xaa\xba\x50\xa9\x63\x62\x41\x60\xce\x8d\xf4\x33\xf7\x4e\xa1\xba\x29\x97\x93\x8b\xb2\xff\x4d\xab\xd2\x5e\x90\xe7\x23\xd0\x86\x3c\x92\x25\xe3\x14\x87\x74\x05\xd2\x2c\x7a\xdf\x53\x73\xb5\xf4\xba\xc5\x99\x66\x5b\xdd\xbb\x8e\x68\x68\x4c\x8c\xd2\xc4\x09\x09\xa8\xae\x62\x46\x4a\x1a\xaa\xef\x1b\x5e\xff\x2b\x66\xc4\x79\xd4\xc2\x29\x08\x66\x87\xe6\xa4\xa1\xbb\x87\x3f\x41\x73\x36\x9b\xd3\x8a\x9d\x8d\x1f\x86\x6e\x18\xd2\x29\xd1\xc7\xb4\xdf\xcf\xdd\xea\xfb\x1d\x62\x82\x63\x11\x98\xeb\xb3\x11\x42\x3d\xdf\x97\x7e\xff\xac\x02\xb7\xcf\xb3\x39\xa1\x79\x43\xdb\xce\xfa\x7f\x63\xb7\x7e\xb6\x1f\xdf\xff\x4b\xba\xd5\x34\xb4\xf4\xde\x5f\x03\xc4\x9f\x47\x6b\xf9\x3a\xbd\x5b\xf3\xea\xa8\x43\xee\x4a\xdc\x94\x1a\x9c\xf2\x86\x04\x97\x49\xc1\x5b\xf7\x66\x5d\x56\x2a\x04\x00\x7e\x0e\x20\xfa\x20\xd5\x20\x52\x65\xd2\x93\x51\x68\xd6\x89\x61\xcb\xe0\x71\x6a\xc8\xe0\xa1\x71\x85\x9d\xd9\x32\x43\x5b\x22\xea\xc1\x36\xb8\x78\xda\x04\x8c\x9a\xc9\x39\xe5\x33\x39\x37\xb0\x14\xd3\x84\x4e\x13\xf3\xa0\x8d\x25\xef\x8c\xe5\x54\x82\xba\x82\xae\xf5\x0e\xa3\x7e\x4e\x04\x78\xe1\x17\xbf\x80\x82\xdd\x4c\xea\x29\x99\x0f\x19\x26\xe9\x15\xd0\xbf\x4c\x86\x61\x0f\x9d\x5e\x9b\x51\x95\xa3\xb6\xda\xc0\x54\xa3\xa0\xec\x61\x3e\x80\x1d\xa8\x59\x98\x96\x90\x49\xa9\x11\xfe\xd0\x1d\x09\xea\x1a\xaa\x72\x83\xda\x3c\x2b\x15\x66\x1a\x15\x46\x30\xa3\x03\x15\x70\x8d\x2b\x86\x05\x8c\xad\x9d\x53\x56\xa4\x25\x12\x1e\x61\x19\xd4\xe3\xb3\x8a\x90\x8d\x58\x25\xc2\xca\x39\x01\x11\x1e\x6e\x39\x50\x57\xad\x10\x8e\xc0\xca\x47\x75\x1b\x5c\x33\x5b\x4c\x95\x9b\x9e\x0b\xe5\x48\xa8\xc0\xb8\x9c\x87\x43\x0e\x0f\x6b\xbd\xef\xef\x98\xa0\x2a\x5d\xb3\x1c\x80\x41\x11\xcf\xe3\x4c\xe7\xdd\xcd\xb3\x59\xae\xcb\x58\x00\x7f\x96\x14\xb3\xd1\x9c\x31\x56\xcc\xc6\x73\xcb\x04\xd1\x49\xa4\xdf\xb7\x2f\xe3\x79\x74\x77\xae\x79\xec\x94\x2a\x58\x5d\x8f\x16\xd8\x8b\x4b\x30\x54\x8b\x1c\x81\x46\xe3\xc8\xa8\x40\x8e\xac\x0a\xe3\xa8\xf1\xe1\xea\x3a\x2f\x3f\x31\x53\x73\x3f\xab\x5c\xe3\x25\x72\xa6\xe6\xc6\x0f\x82\x3d\x03\x89\x9a\xce\xe2\x79\x9c\x67\xb3\x78\xc6\xc2\xdd\xba\x38\xde\xad\xce\x77\x81\x89\x78\x80\x6e\x57\x54\xdb\xed\x4a\x52\x32\x7d\x65\x80\x02\xfd\x55\xb9\x23\x56\x2d\xc6\xec\xcd\xc3\x81\xa7\x77\x2e\x92\x06\x08\xd9\xe8\x5a\xe7\xfa\x75\xa0\x9c\x10\xba\xe4\xe0\x3b\xbc\x22\xa0\xee\x7d\x36\xa2\x5b\xa7\x0e\x95\xd4\x1a\x1c\xe9\x1d\xb5\xda\x16\xc5\x5f\xb1\xd5\x2d\x42\xb0\xca\x6b\xcd\xf7\xfb\x0b\xf4\x6a\x2a\xc9\xe1\x50\xf8\xa1\xad\xba\x87\xc6\x64\x6a\xe2\xee\xd2\x02\x62\xe0\x6e\x19\x4f\xf7\x0f\x8d\x34\x1e\x28\x0d\x87\x30\x35\x47\x84\x05\x7d\x9c\xe2\x71\xb1\x41\x1e\xed\x79\x08\x4b\xc8\x70\xf3\x9b\x73\x61\x63\x3e\xea\x69\xc3\x33\xba\xf5\x07\x6e\x3b\x28\xcd\x4c\xd2\xd2\xc4\x20\x31\xed\x0e\xcd\x19\xdd\x32\x5b\x98\x64\xb6\x53\x85\xfb\x78\x31\x30\xd5\x4c\xd3\x18\x77\xd2\x35\x8f\x11\x26\xf5\x12\xd9\xcf\xb8\xd6\x86\x08\x20\x0a\xfb\x3d\x32\xe1\xe9\x8e\x15\x1a\x8b\x66\xdb\xe3\x58\x9f\x45\xbe\x2f\xb7\x2a\xbb\xb7\xa1\x8d\xef\xbb\xf7\x3a\xda\xdb\x7a\x49\xd1\x35\xa7\x1b\xce\xee\x4d\x30\xde\x3a\xbb\x6f\x68\xbe\x5c\x7e\x55\xb6\x63\x81\x28\xbd\x02\xbc\x86\x38\x94\xf0\x04\xb7\x12\xf7\x53\xcb\x78\xb8\x59\xce\xc6\x94\xa7\x96\x62\x65\xfe\xf1\x70\xc0\x13\xc1\xed\x8d\x61\x1f\x0e\x87\x11\xe5\xe9\x65\x91\xef\x79\x55\x33\xf7\x74\x38\x1c\x11\xb2\xb3\xfb\x5f\xb2\xd1\x91\x5a\x36\x07\x25\xe1\x0e\xab\x1f\xb0\x9e\x37\x7d\x76\x88\x29\xad\xf8\x4d\x79\xcb\x8f\x87\x69\x6c\xbc\xb1\xf8\xd4\x56\x8b\x03\x9c\x00\x31\x21\xfb\x7d\x9b\x5b\x6f\x00\xbf\x94\x80\x5f\x3a\x0f\x1d\x47\x38\x84\xf5\xd0\x20\x34\xfe\xd0\x73\x73\x05\x1e\x9d\x71\x72\x7a\xb4\x87\x93\xd1\x9b\x83\xf8\xc2\x98\xcd\x94\x6c\x34\x29\x81\x80\x2c\x89\x60\xf9\xac\x9c\x53\x99\xae\xf3\xfa\x87\x3b\xf9\xae\x2a\x37\xbc\x52\xfb\x44\x80\x2b\x87\x99\x98\x33\x09\x97\xe2\xb1\xb8\x04\x3b\xa1\x11\x67\xc7\x94\x77\x02\x52\xdc\x3b\xc6\x0e\xcb\x6b\xf3\xaa\xd2\x5e\x5e\xc2\x9e\x5c\x8d\xe3\x72\x7d\x85\xe3\x96\x66\x72\x98\xdb\xe3\x51\x77\x84\x6d\x3d\xa5\xf4\xe5\x83\xe6\x50\xc9\x12\x15\xe9\x09\x3a\xc1\x05\x3a\x3e\x31\x88\xd3\xbd\xd5\xeb\xb8\x2a\x77\x99\xa0\x9b\xb2\xce\x84\xdb\x55\x01\x0f\x21\x13\xed\x80\x28\x38\xa5\x0e\xff\x68\xbc\x46\x69\x83\x31\x65\x0b\x9e\xd4\xdc\xc5\x31\x26\xf4\x0c\x3c\x5e\xb9\x54\x1b\xd6\x98\xe6\x3e\x0d\x62\x18\x43\xc1\xca\x27\xda\x6b\xd1\xe9\x7a\xea\xf6\x5e\xc8\xe5\x87\x72\x93\x01\x2f\x70\x91\x2b\xb0\x13\xd4\x0d\xbe\x90\xcb\xaf\x8c\x09\xbc\xcd\xaa\x08\x75\x4c\xde\x0c\x9b\x74\xef\x3d\x42\x6f\x79\xa5\xc4\x22\x2f\x7c\x5b\x82\x84\x03\xcf\x7d\x3b\x4d\x63\x4f\x29\xa1\x05\xab\x53\x5b\x95\x6e\x59\x1d\x5e\x03\x4b\x86\x16\xfd\xe9\xaa\xe2\xfc\x17\x9e\xdc\x7b\xe0\x98\x71\x1a\x20\x00\x99\x74\x21\xd3\x73\x9a\xdf\xe6\xa2\xc8\xaf\x0a\xab\x8a\x4a\x6f\xbf\x2a\x77\xdf\xe7\x3b\xf3\x7a\x7e\x71\x6e\xf9\x1e\x74\x8d\x39\xa6\x91\xf2\xfc\xa2\x21\x74\xcd\x4a\x9e\xdc\xfb\x0b\x3b\xd3\xaf\x8d\xa6\x58\xef\xb2\x8a\xae\xb3\x92\xee\x8c\xc9\x02\xdd\xa3\xc5\x81\xce\x9c\x9c\x9d\xd0\x4a\x0d\xd4\x4f\x55\x87\xaa\xa9\x46\x1e\x89\x01\xdc\x79\x78\x7f\xe4\x7a\x82\x3c\xc8\xea\xf7\x79\xda\x1a\x18\x4f\xc3\x81\x51\xbb\xd1\xa3\x66\x74\xb5\x68\x90\x4d\x52\xd8\x75\xd8\x12\xf0\x0e\xce\x93\x82\xae\xcd\xd3\x16\x9e\xe0\xde\x34\x89\x5d\x44\x76\x17\x32\x23\x13\x87\x82\x07\x98\x88\x9c\x0f\x01\x3b\xf6\xa6\x6c\xfa\x75\xc0\x04\x15\x8d\x4a\xf7\x03\x26\x13\xb3\x57\x55\xba\x83\x37\xb3\xc7\x65\xe2\x70\x3d\x99\xb8\x9d\xdb\x24\x6b\x42\x57\x3c\xc1\x7b\x14\xb7\x2e\x76\x73\xad\xab\xaf\xd3\x3b\xba\xd6\xad\xae\xd3\x35\x16\x8b\xb7\x32\x16\x0d\x44\x15\x06\xef\x5a\xe3\x6a\xea\x0b\x69\x8d\x4c\x6f\xd8\x0e\x98\x3c\xd0\xad\x9a\xdb\x09\x72\x07\xeb\x74\xdd\x50\xa3\x49\x54\x07\x72\x8f\x40\x9d\xc8\x23\x7e\x57\xe5\x6e\x52\x6a\xe0\x1b\x86\xf0\xa0\xd2\xe2\x44\xd8\xe5\x35\x78\xaf\x6f\x1a\x7a\xc3\x59\x72\xcd\xdb\xbb\xfe\xf2\x12\x24\x49\x97\x97\x18\x1a\xc6\xdc\x85\x59\xef\xff\xfd\xc8\xf7\xab\x2a\xbf\xe1\x35\x46\x27\xfc\x7b\x3d\xac\xb8\x5c\xf2\x6a\xe8\xd4\xf0\xee\x57\x55\x79\x73\x5f\x6e\xf2\x85\x50\xfb\x2c\xfd\xe3\x1f\x1b\x55\xba\xd7\x71\xd3\xa4\xad\x8a\x37\xa5\x14\xaa\xac\x02\x07\xcd\xa7\x5a\x7e\x32\xbe\xa9\x7d\x75\x4d\x63\xd8\xca\xb4\x33\x75\xc8\x77\x9b\x5c\x2e\x4f\x64\xd6\xeb\x4a\xc8\x8f\xf7\x8e\x87\x9c\x5f\xd5\x65\xb1\x55\x7c\xb2\x14\x15\x5f\xa0\x04\x51\x55\x13\x44\x0e\x26\x80\x3a\x4c\x0c\xea\x30\xb1\xa8\xc3\xa4\xbc\xe5\xd5\xaa\x28\xef\x32\xa3\x90\x0d\x1c\x05\x5e\x0d\xf9\x2d\xa8\xa6\xc8\x52\xf2\xc9\xad\xa8\xc5\x95\x28\xf4\xf0\x4d\xa9\x5f\x86\x08\xba\x87\xe3\xee\xe1\x98\x8e\x3f\x5f\x8a\xdb\x8e\xfe\xa1\x65\xd2\x78\x04\xff\x6d\x76\x13\x43\x87\xf9\x84\xb0\xcb\x27\x3e\x80\x83\x7f\xf0\x03\x17\xa3\xd1\xff\xb2\x6d\xc3\x73\xd4\x6c\xaf\x21\xa4\xdf\xbf\xe6\xa9\xd9\x1a\x87\xc3\x35\xa7\xb7\x9c\xf5\x7e\x67\xbe\xd7\xa3\x57\x9c\xf5\xba\x3e\xde\xa3\xbb\x20\x27\xde\x05\x3d\xba\x3f\xce\x73\x1b\xa0\x47\x2f\x39\x9b\xf5\xdc\xbb\x51\xf6\xe9\xdd\xf1\xab\x8f\x42\xbd\xb0\xc9\xef\x21\x79\x4e\x3f\x72\x76\xaf\xca\xed\x62\x8d\xce\x64\x7a\x37\xe5\xb6\xe6\xcb\xf2\x4e\xf6\x28\x24\x6b\x7c\xc7\xa4\xea\x47\x93\xca\xe5\xd2\x24\x6e\x37\x46\x35\x84\x57\x28\xf3\xc6\x64\x78\x76\x39\xba\xbd\xa8\x69\x93\x7e\xd4\xb8\x49\xdf\x6e\x8e\x5b\x2f\x78\xee\x0a\x97\x5b\xe5\xd2\x35\xf6\xea\x53\x03\xae\xfe\x5d\x44\xeb\xbd\xf6\x56\x15\x20\xd7\x17\x1a\x15\x93\xe9\x4d\xae\x16\xeb\xe4\xfc\xff\x4b\xfe\x73\x39\x20\xc9\x7f\xa6\xfa\x67\xba\xd9\xfd\xee\xdc\xcb\x7a\xa7\xc8\xcb\x4e\x84\x26\x26\x4d\x60\x41\xe0\x4c\x7e\xcf\xd9\xd9\xd9\x91\x14\xfa\x6c\x3c\x51\xd5\xde\x00\x65\x03\x31\x96\x7c\x25\x24\x77\x58\xd7\x7d\x43\x7b\x9b\xbc\xae\x85\x1e\xf2\xfd\x35\x8f\x42\xef\x29\x76\x36\xd2\x58\xf6\x9d\x90\xcb\xf2\x2e\xcd\x97\xcb\x6f\xf4\x39\xf9\x4e\xd4\x8a\x4b\x5e\x25\x3d\xde\x73\x71\xc9\x17\xd0\x7b\x45\xee\x2d\x35\xa9\x9a\x84\xf4\xfb\xf7\xa6\xf1\xec\x6c\x14\xcc\xc7\x7b\x47\x5e\xa9\xe3\x56\x35\x86\xf5\x7d\x28\xc9\x78\x19\x94\x46\xac\xf7\x13\x15\xde\x39\x56\x0c\xcd\x2d\xce\x8d\x4a\x69\x8a\x1a\xf5\x3c\x8a\x72\x95\x2c\x3f\x1c\x60\x04\xbb\xcc\x29\xcd\xc8\xa9\x44\x28\xba\xf7\x69\x62\x2a\x20\x2d\xa0\xdf\x5f\x04\x2c\xfb\x53\x16\x46\x4b\x71\xeb\xd9\xf4\x3c\x05\x3b\xa8\xb7\xf9\x0d\x67\x0a\x02\xc6\x70\xdf\xda\xd7\x27\x08\x4e\x35\xbb\xe5\x73\x4d\xb2\xe8\x5f\x76\xdf\x20\x36\x54\x71\x7d\x40\xab\x0e\x74\xf5\x05\x4f\xae\xb8\x46\x09\xe1\x61\xd0\x33\xa0\xa9\xa7\x77\x99\x4d\x42\x60\xd2\x03\xfe\x67\x60\xc4\xf4\x82\x27\xc0\x6b\x3e\x4e\xe3\x51\x9a\x6c\x27\x08\x9d\x70\x59\xf1\x9a\x2b\x16\xec\x1e\x99\xd6\x8b\xaa\x2c\x8a\xef\x34\x39\x39\xe6\x5f\x52\x9b\xf0\xa1\xdc\xc0\xbb\x68\x17\x10\x71\x81\xc6\x30\x63\x23\x1a\x09\xbf\x93\x10\xaa\x12\xe2\xb8\x1e\xef\xf5\xf5\xd9\xc3\xca\x3d\x9a\xa7\x57\x42\x2e\x75\x8a\x1d\x3c\xa1\xef\x79\x22\x8e\x4a\xe8\x14\x33\x17\x84\xf2\x26\x49\x44\xf8\x2d\xb1\x4a\xdc\x4c\x3b\xb7\x55\x3e\x72\x70\x2e\xa4\xca\x85\x7c\x51\x6f\xf8\x42\xfd\xa8\x01\x99\xa6\xa7\x36\xb9\x86\x3d\x6f\xcb\x25\xa7\xb9\xde\x35\xe9\xa2\x10\x5c\x2a\x44\xc7\x46\x13\x9e\xbc\xe3\x49\x0f\x1b\xed\x51\xa9\xe7\xbb\xdf\x8f\x0a\x3d\xcb\x35\x2c\x40\xab\xd2\x7e\xff\xa8\x7c\xd3\x00\xdb\x16\x44\x23\x34\xe8\x6c\xc9\xc0\x13\x79\xa0\x50\x02\x22\x83\x74\x91\x17\x45\x12\x84\x95\xc8\x59\x7e\x38\xa0\xc1\xca\xe1\x00\x3c\x5b\xfa\x3a\xad\xf8\x3f\xb6\xbc\x06\x80\xfc\x4a\x23\x0b\x58\x0b\x4f\x7d\x80\xb3\x90\x7b\xf8\xb4\x30\x24\x6a\x4e\x4b\x8d\x94\xe8\x7f\x9d\xa8\x6d\xc7\xde\x45\x17\x63\xfa\x92\x78\x57\x95\xbb\x3d\x8b\xf9\xcd\xee\x9e\x80\x23\xc2\xd8\x9e\xeb\x09\x20\x8d\x0d\xf3\x73\xc9\x63\x04\xca\x40\x11\x61\xb8\xce\x15\xd7\x37\x3b\x3b\x3b\x53\xc6\x41\xeb\x3b\x58\x0b\x8d\x56\xe9\x73\xa7\xc1\x85\x06\x36\xc9\x8e\x93\x26\xe2\x2d\x77\x2d\xb5\x46\x62\xfd\x5a\x4e\x74\x4f\x40\x9f\xce\xa7\x69\xbc\x59\xc8\x9a\x57\xea\x2b\xbe\x2a\x2b\x8d\x0e\xf3\x74\x25\xaa\x5a\xc1\xa9\x20\xb4\x70\x7b\xb5\x89\x18\xc5\x1f\x02\xc0\x61\xa6\xe8\xbe\x01\x3b\x16\xd3\x83\xc9\x92\x17\x5c\xf1\x27\x2e\xa1\x0b\xc5\x6e\x55\x74\x73\x3a\x91\xfd\x7e\x72\x62\xc2\x1c\x20\x6d\x80\x79\xe6\x3e\xe2\x2a\x93\x68\xb2\x10\xd6\x9a\xf9\x22\x14\x2e\xa9\x70\xfc\xe1\x9b\x29\x6c\x21\x04\x5c\x4a\x6f\x38\xbb\x5f\x8a\x5a\x93\x23\x2f\xdf\xbf\x7f\x23\xff\x6e\xd0\xb4\xb3\x31\xbd\xe4\x52\x27\x2f\xb3\xde\x56\xe2\x8d\xb4\xec\x9d\x59\xa7\x39\xb8\xf1\xfa\xfd\xae\x3c\x0b\x69\x75\x0b\xf5\xb6\xe2\xdf\x95\xf9\x92\x2f\xb3\xb6\xe0\x16\xb4\x8e\x3a\xbe\xed\x17\xf7\x9a\xab\x1f\xcb\x12\xfa\x3e\x8d\xde\x12\x92\xd9\x8f\x3c\x72\x5b\x83\x67\x56\x54\x95\xc9\x85\x44\x8d\x95\x5a\xc3\xbf\x38\x05\xa3\xb9\xf7\xce\x9f\x3e\x79\x09\xea\x50\x7f\xaf\x9f\x3c\x3d\xff\x4f\xd9\x1b\xf0\x09\x02\x97\x93\x76\xaa\xa0\xdd\x4a\x26\xa2\x65\x56\xab\xa7\xa4\x47\x7b\x8a\xef\xd4\xf9\x02\x02\xac\x3f\xce\x2a\x95\x13\xbd\xce\x31\x04\x6f\x9a\x84\xa7\xeb\xb2\x56\x53\xee\x86\x8f\x01\x8d\x6f\xb8\x06\x3a\xf9\xe2\x1f\x5b\x51\xf1\x97\xa5\x04\xf3\xcf\x78\x5e\x7a\xb5\xaa\x84\xbc\xf6\x6e\x8f\xd4\x54\xf9\xd1\x5c\x3b\x6d\xb6\xaf\xf6\x6f\x96\x89\x22\x99\xf2\x6c\x5a\xc5\xd4\x6c\x34\x27\x14\x7c\x12\x5a\xa8\xa7\x53\xcd\x0b\xb2\xf8\x8c\xcf\xc2\x6b\xae\x4c\x07\xe2\xb7\xa4\x77\xb1\xf4\xf7\xac\xf4\x00\x94\x31\xa6\xa6\xc6\x25\x41\xb8\x5f\xf4\x76\xee\xe6\x9a\xc1\x5c\x03\x21\x7e\x1d\xcd\x35\x22\xd6\x20\x57\x68\x67\x01\xfa\xdd\x83\x5d\x60\xb6\x84\x90\x42\x89\xbc\xc8\xee\x0d\x3a\x2e\xa8\xf1\x4e\x40\xa1\x79\x6f\xf0\x2d\x53\xf3\x44\x4d\x49\x69\x59\x51\x58\x41\x22\xd9\xaf\xe9\x3e\x57\x94\xb9\xa7\xc3\xa1\x77\x55\x94\x8b\x8f\x3d\x1b\xbf\x33\xd7\x58\x85\xfe\xc5\xf1\x54\x0c\xf0\x4e\xd7\x41\x87\xcd\x54\x10\x2d\x1c\x39\x0a\x15\xc8\x54\x4c\x03\xc2\x34\x20\x88\x58\x25\xf0\x64\x66\xc4\x74\x8b\x28\xcb\x41\x30\xd5\xcf\x13\x67\x6f\x97\xe6\xfe\x26\x3c\x1c\x2e\xd0\x21\x8a\x51\x95\xc0\x7e\xd8\x39\x6c\x77\xc4\x72\x8d\x81\xe9\xa3\x11\x62\x49\x10\xd9\xa2\x15\x2f\x78\x5e\x77\xec\x3a\x7f\x8e\x71\xa1\xf5\xe4\x73\x3d\xf9\x8e\xac\xd6\x2f\xa9\x59\x88\xc9\xcc\x7e\xdb\x4e\xc6\xfc\xb4\x8e\x80\x60\x72\xa6\xe6\x93\xd7\xa9\xa8\xdf\x6e\x8b\xe2\x87\xea\x2f\x1a\x0a\x25\x82\x4c\x2d\x90\xf3\x6b\xaf\x48\xc6\xe3\x43\xa9\xcc\x75\xe4\xbc\x55\xc3\xf4\x01\xa0\x6e\x09\x23\x39\x66\xcd\xe4\x9c\xa9\x06\x90\xac\x3b\xc3\x84\x46\xce\xa4\x85\xcf\x7a\x20\x0d\xb0\xb4\x23\xac\xf7\x94\x9a\x72\x30\x21\x16\x6f\x38\x63\x8c\x5b\xbd\x10\x69\x80\x97\x74\xc0\x0b\x30\x23\x4e\x93\x5c\xe3\x0e\x3b\x01\x0c\x72\xf7\xac\x4b\x90\x19\x1a\xee\x5f\xf6\x06\x7c\xce\xc2\xfb\x44\x26\x5d\x87\xe8\x23\x9f\x29\x70\x71\x34\x3f\x1c\xf0\xc1\x6a\xf8\x75\x6a\x2c\xd9\x63\xfb\x4e\xe3\x70\x9c\x1a\x89\x32\x55\xa4\x31\xc2\x7c\xd2\x80\x6b\x9d\xaf\x75\x3f\x25\x58\x88\x76\x10\x01\xbf\x71\x3a\x92\x44\xda\x8b\x94\xf8\xf1\xdf\x37\xd1\x98\x27\x79\xbf\xff\x12\x67\x29\x37\x9d\xf9\xc0\x01\x66\x4e\x5e\x3b\xf2\x85\xbd\xe7\x80\x49\xb9\x9e\xb1\x97\x08\xd7\x5f\x71\xf6\x86\xa7\xf6\xc2\x9b\xbe\xe1\xd9\xfd\x49\x60\xea\xe5\xd7\xa7\xc0\x21\xed\x04\x7d\x48\xc4\x34\x0d\xfd\x21\x0c\xae\x6a\x76\xbf\xf8\x25\xd2\xa5\x3b\x0d\xcb\x75\xde\xa9\x13\x47\xee\x1f\xda\x82\x58\xf3\x81\x45\x21\xf7\x4d\x43\x5f\x71\xaf\x8e\xe8\x84\x3e\x9b\x62\x7b\x2d\x64\x9d\xdd\x5b\x71\xce\x77\x9c\xdd\x5f\xda\xd4\xd9\x9c\x62\x20\xb3\x37\xcb\x6c\x44\x2b\x7e\xad\x5b\xae\x4e\x39\x68\xb7\xd5\x26\xb3\xb9\xe5\x60\xaa\x13\xda\x40\x10\xdc\x9d\x3b\xb1\x88\x22\x1a\x9d\x0b\x34\xca\xb0\x3d\xf3\xe9\xc1\xa0\xa1\x5b\xf9\x2f\xfc\xba\x65\xfd\xf9\xcf\x3b\x91\x0c\x8f\x84\x31\x5d\x3d\x59\x14\x3c\x8f\xa6\x36\xfa\xba\xa6\x0b\x8e\xab\x3c\x18\x54\xdf\xd4\x0c\x03\xea\xbf\x28\x4e\xfb\xae\x36\xc5\x1b\x2a\x4b\x25\x56\x27\x34\x4d\x42\x75\x43\xc0\xc5\x78\xbd\xa8\xc4\x46\x95\x55\x8d\x6e\xd7\x8a\xd0\x69\x90\x71\xab\x06\x12\x53\x7d\x52\x6d\x93\x0e\xa3\x48\x6a\x96\x54\x2c\xc9\x8d\xd3\x35\xec\x02\x99\xf1\x39\x41\xaf\x69\x33\xe5\xe6\x5c\x82\x28\x86\xe0\x62\x3a\xd3\x63\x42\xc1\xcd\x8f\xd5\xa4\xaf\x68\x49\xac\xb6\x95\x53\x82\x39\x1b\x35\x34\xe8\x67\xe7\x75\xf3\x3b\x33\x7a\x90\x22\xda\x17\x8b\x04\xf2\x54\x2c\x99\x71\x9e\x6f\xa7\x9f\x38\xea\x3f\x68\x7a\x62\x75\x1e\x41\x49\x4c\xe3\x14\x70\xde\x41\xf0\x66\x2c\x4b\x5c\xcf\xfb\x7d\xef\x55\xcb\x7d\xfc\xde\x0b\xfc\xa3\x25\xb4\x22\x1a\x5f\x72\x36\x3f\xb1\x07\xc5\x2a\x81\x43\x20\x83\x5d\xe8\xc7\x29\x96\x34\x67\xd5\x8c\xcf\x27\x67\x7a\x63\xe6\xfd\x7e\x72\x36\x42\x25\xc6\x24\x67\xde\xb2\x10\xcf\xb1\xfd\x1c\xb8\x3b\xa1\xd2\x6b\xaf\x19\xc9\x17\x66\x67\x8a\x9a\x81\x64\xb9\x1e\x02\x70\xb4\xe1\xe6\x0b\xa6\x86\x09\x0a\xd3\x18\x4d\x22\x15\x0d\xbd\x14\xf2\x36\x2f\xc4\x91\x09\x95\xb9\x27\xfd\x6a\x34\x0d\xfd\x91\xb3\xfb\x45\x29\x6b\x55\x6d\x17\xb0\x94\xf7\x0d\x0d\xe5\xc3\xf6\x28\x83\x01\x17\x58\x0d\xb6\x37\x31\x9a\x2d\x04\x4d\xcc\xd4\x9c\x71\x6a\xf6\x32\xb6\xa4\x93\xec\x4c\x48\x02\xe7\x06\x1a\x7c\xe9\xab\x75\xc2\xf6\x76\xd3\x6d\x49\xa8\x22\xd3\xae\xcf\x5b\x7e\x9f\xfb\xce\xd7\x76\x44\xa7\x3e\x62\x3b\xda\xf1\x81\xd7\xe9\x0d\xaf\xae\x79\x62\xb8\x82\x48\x21\x00\x1a\x48\xe8\xec\xad\x31\x5f\x6a\x8f\x76\x4e\x34\x98\x36\x82\xd9\x53\x5d\x00\x51\xfb\xc3\x5f\xd7\x57\xda\xf1\x44\x5a\xbb\xf8\x56\x0e\xe5\x84\xc0\xdd\x83\xb6\x9c\x1f\xca\xef\x50\x4d\x20\x1c\xb4\xc1\xb6\x8c\xd9\x55\x1d\x53\xbd\xb1\x84\xdf\x1e\x25\x97\x16\x4b\xf9\xdd\x49\xb3\x72\xd9\x40\xcc\x6f\xf3\x4c\x00\x80\x0d\x4f\x51\xcf\x00\x15\xaf\x81\x37\xf3\x0b\x3f\xf6\xda\xfe\x56\xa7\x55\x0a\x9c\x06\xfc\xa8\x8a\x17\xcb\x7c\xa3\x78\x75\x7c\x13\x7a\x55\x78\x4b\x1c\x9f\x8d\x28\x5a\x9b\x21\x3f\x12\x35\xf6\x8d\x6a\x63\xa8\x4d\x9f\xdf\xf2\x2a\xbf\xe6\x3d\x1a\x28\x4c\x8e\x8e\x8c\xa8\x7a\xd5\xf5\x55\x9e\x8c\x28\xfc\x9f\xfe\x81\xf4\x28\xe8\xd9\xbf\x2a\x9d\x2d\xe5\x55\x59\x2c\x4d\xea\xfb\x4d\xbe\x10\xf2\x3a\xbb\xc0\xd7\xef\xf3\xea\x5a\x48\x23\xee\xfd\xd2\x57\x34\x2d\xff\x3f\xab\xd5\xca\x54\x44\x3f\x02\x46\x8b\xe8\xaa\x5c\xee\x7d\x4b\xfa\xed\xa8\x92\x4e\x8c\xea\xac\xca\x12\x7c\x29\xb4\x7a\x85\xc9\xbe\x31\x7c\xc7\x7e\x7d\x28\x37\xd9\x97\x41\xc5\xe8\x03\x98\x1c\x7d\x62\x6f\xe5\xb6\x5f\xd2\x9d\x7f\x5c\xe4\x95\xd3\x75\xcb\x2e\xf0\xf5\xbd\x46\x9e\xbe\xa0\x8b\xb2\xf2\x1e\x10\xbe\xa4\x37\xdb\x42\x89\x3f\xf3\xbd\xb7\x08\xb1\x1f\x33\x94\x19\x74\x00\xfc\xbe\x47\x56\xba\xe1\x02\x90\xd8\x44\x77\x44\x03\xfb\x87\x2b\x60\x28\x7d\x00\x2b\x08\x63\xba\xdc\x32\x89\x08\xd0\x6d\x13\x78\xdc\x78\xce\x40\xa6\x23\x5e\xad\x19\xb8\x43\x55\x4e\x4f\xd7\x52\x85\x9a\xe2\x9e\x18\x7f\x5d\x53\x69\x3d\x77\x65\x95\x31\x9f\x80\x24\x7c\xcc\xf2\xe7\xa3\x7e\xdf\xb8\x30\x7d\xa6\x61\xbf\x64\xc2\xb9\x34\x75\x1e\x44\x65\x43\xf3\x95\xe2\x55\xd4\x63\x1c\xc4\x57\xe5\x72\x1f\xa7\x7c\x67\x5c\x3d\x40\xd2\x3f\x17\x71\x3d\xa0\xf8\x13\x39\x80\x20\x8e\x9a\xf2\x8a\x09\x37\x85\xe7\x91\x4c\xe5\x80\x59\x9b\x90\x0c\x9e\x21\x9d\x4a\xa3\x51\x8d\x6b\xd4\xdd\x95\x96\x6a\x73\xdc\x21\x12\x47\x87\x44\x45\x72\xab\x40\x11\xae\xbf\x8c\xad\x92\x5a\xc7\xf3\xc8\x98\xa8\x31\x1d\xfb\xc0\x77\xaa\xd5\xb9\x16\x46\x66\x01\x53\x74\xc0\xcc\x9a\x44\xd3\x0d\x29\xc7\x4b\xf2\x0a\x8e\x88\x4d\x5b\x45\x6f\x50\x25\x2a\xe0\xc3\x59\x72\x76\x6f\x40\xcf\x31\x03\xce\xfa\x76\x73\x38\x96\x37\x75\x1e\xd1\x1c\x6c\x4b\x1e\x0c\x1a\x64\x8d\x2c\x34\x0a\x22\x56\x49\xd9\xef\x97\xfa\x22\x41\xd3\x59\x62\xf5\x53\xcb\xd4\x40\x4d\x47\x52\x92\x89\x18\xb0\x3a\xdd\xd1\x5c\xff\xec\xe9\x60\x50\x35\x66\x9f\xde\xef\x32\x71\x5e\xd1\x7d\x96\x9f\xeb\xc9\xed\xd2\x11\x6f\xfb\x0a\xdb\xd1\x92\xf1\x74\x4f\xeb\x93\x56\x24\x0f\x29\x6f\xdc\xa3\xfe\xa8\x9a\x49\x18\x43\xd1\xef\x17\x47\x63\xd0\x98\xf0\x91\x1d\xc9\x92\xbd\x4e\x97\xc6\xbf\xcc\x57\x5c\xdd\x71\x2e\x8d\x45\x33\xa7\x5b\x32\x59\x3e\xc3\x58\xce\x1a\x47\x2b\x48\xd3\x88\x55\x62\x58\x3e\x6b\x96\x77\xcc\x48\xc5\xd6\x30\x92\x75\xba\xf7\x53\xa1\x27\xa2\x6c\x9a\x40\x2a\xf7\x4d\xac\x43\xcd\x81\x91\x6c\x43\x9c\x72\x32\x6d\x4b\x18\x34\x6e\xe7\x2c\x53\x39\xc9\x94\x55\x4e\x23\x54\x79\x76\xf7\x5f\xb9\x47\x4b\x92\x63\x4e\xe1\xe1\xa0\x9e\x08\x89\x43\x2d\x57\x4f\xde\x43\x3e\xd1\xe8\xb0\x33\x75\xf9\x4f\xd9\x23\xcf\x87\xe3\xa9\x02\xd2\x48\x61\x42\x16\x7c\xe2\x3f\x02\x8e\xba\x45\x49\xed\x01\x74\x00\x5e\xa5\xf6\xd1\xc3\x7f\x95\xda\x47\xba\xc3\x1b\x42\xa5\xf8\x40\xf7\xf6\x1d\x1f\x68\xa5\x8a\x4c\xe9\x6b\x9c\x6a\xc2\xf8\x6b\xa7\x73\xa0\xd2\xe8\xbd\x75\xc9\xa9\xf8\x4c\xd2\x4b\xfb\xfa\x2a\xbf\x11\xc5\x3e\xfb\x85\x27\xbe\x08\xa6\x51\x27\xa3\xf7\x69\xc4\x57\xc4\x4b\x31\xaa\x87\x76\xd4\x51\x35\x48\x32\xb5\xec\x40\xdc\xb3\xeb\x23\xdc\x6e\x71\x53\xe2\x97\x76\x4b\xa0\x51\x1e\x5e\xe4\x58\xda\xbc\xb5\x51\x01\x95\xc6\x09\xf4\xd2\xbd\x87\x43\x6e\x25\x9e\x18\x73\x0b\x3f\x89\x6b\x9e\x1e\xb5\x2f\xe2\xc6\x17\x25\x75\x0d\xf0\x32\x40\x5e\x4c\x71\x9c\xab\x08\x1b\x32\x39\xd1\xd8\x23\xd4\xc8\x14\x08\xd3\x8e\xf0\x12\x95\xb6\x52\xe8\xa5\x4f\x08\x27\xa9\x9d\x7a\x62\x96\xda\x08\x53\xab\xee\xe9\x79\x0a\xca\xb8\x89\x8a\xd3\x3a\x67\x2a\x44\xa8\x6c\x05\x9c\xab\x18\x47\xb3\x79\x76\xb6\xda\x18\x9b\xcd\x77\x29\x01\xca\xa5\x52\xf7\x1c\x23\x5f\x9a\x4a\xf6\xaf\x47\x97\xa8\x3a\x32\x0b\xb6\x9a\x4c\x23\xeb\xfd\x54\xa7\x06\x88\x9b\x4a\x8f\xb1\xb9\x16\x1a\xa7\xd2\xe8\x3d\xc2\xe9\xd4\x49\x4b\x63\x15\x39\x54\xf2\xc0\xea\x75\x04\x65\x7b\x0b\x54\x4f\x81\xb8\x8c\x2a\xdd\x0d\x2c\x63\xfd\x22\x33\xea\x6d\xed\x9c\xa1\x07\x64\x19\x26\xdb\x57\xff\x8d\xbf\x07\x30\x57\x83\xf5\xd9\x9c\x02\x18\x46\xb9\xdc\x3f\x38\xfb\xf3\x27\x98\x84\x88\x57\xa0\xc7\x07\x0d\x5d\x43\x34\xc3\xb2\xa4\x8a\xbc\x56\x2f\x16\x4a\xdc\x72\x36\x9b\x03\x55\xfa\xa1\x6d\xb7\xdb\x76\x86\x60\x2d\xbe\xc1\x69\x9f\xc5\x77\x41\x2a\x1c\xa0\xbc\x1d\xce\x0e\x68\xce\x24\x9e\xac\xae\xcc\x8a\xc9\xd4\xe3\x9f\x5d\x25\x42\x43\xc2\x92\x7d\xc3\x93\x52\xcf\x87\x20\x3a\xc7\xbe\xe5\xd1\x5b\x45\x90\xa0\xff\xca\x63\xb1\xc7\xd8\xd7\xdf\x5b\x33\xe3\xc7\x94\x7a\xec\xd7\xf6\x07\xfc\x87\xb8\x2e\x99\xd6\xa3\x76\x43\x41\x94\x2e\xad\xe7\xe5\xb8\x69\xd4\x3d\x8e\xbd\x95\xb5\x2c\xa5\x8c\x27\x2a\xec\x43\x36\x9b\x83\x3d\x32\x30\x53\x61\x9a\xb2\xd9\xbc\x99\x7c\xc3\x93\xca\xf4\x12\xe6\x22\x0d\xb0\x73\x94\xff\x4b\xaa\x3b\x44\x08\x85\xa2\xd0\x04\x15\x88\x7c\x47\x05\x30\x1f\x5a\xc6\x96\x3c\xde\xd9\x6a\x28\xb7\xf6\x17\x0d\x01\x2b\xb5\x6b\xae\x5e\x38\x8c\xf4\x57\x4d\xaf\x43\x64\x1f\x9a\x5d\x83\xb5\x7e\x7a\x3f\x06\x93\x0b\x0a\x99\x01\x52\xdc\xb5\x9b\x34\xb9\xb5\x3a\x99\x9b\x33\x9e\x06\x48\x73\xf7\x86\x0d\xec\x5a\xf5\x96\x83\x89\x93\x44\xe7\xd8\x37\x11\xbd\xe5\xa4\x4b\x21\xfe\x44\xe0\x50\xba\x34\xd1\x31\xe8\x82\xad\xfd\xa1\x5b\xe9\x17\x1b\xe0\x66\x1d\x9c\xee\x05\xa1\x1b\x9d\x90\xc3\x61\xa6\x37\xfa\x19\xbc\xdf\xdd\xb2\x7b\x83\x16\xad\x5a\x68\xd1\xca\xa0\x45\x0d\xbd\x62\xf7\x3b\x9d\x4d\xf7\x3a\xb1\xa1\x3b\x76\x8f\xb2\xcb\x95\x91\x63\x19\xc9\xe6\xca\x88\xf9\x1a\xba\xc7\x1a\x00\xe0\x7f\x82\x6a\xf0\xf8\x73\xa3\x71\xe4\x8d\xa5\x1b\xee\xaf\x53\x03\xba\x19\xd2\x0e\x97\x6c\x36\xa7\x1f\xf5\xcc\xed\xd9\x57\x7c\xb6\x70\x2c\x9a\x39\xee\xb2\x35\xdd\xd0\x75\x7a\x09\x9a\x9c\x16\xf7\x45\x3a\xe5\x2e\x56\xd6\xdf\xb4\x49\x8d\x3b\xdc\x96\x89\x60\x1b\x50\xd7\x37\x61\xee\x5c\xbc\x3b\x5a\xda\x87\xda\x3e\x14\xf6\x61\x6b\x1f\x96\xf6\x21\x67\x2e\xde\x14\x38\x08\x32\x11\x4a\x0e\x07\x61\xbc\xef\x80\x97\x20\xb4\x3d\x06\x4f\x4e\x91\x8d\xac\xf1\xe5\x04\x0a\x02\x2d\xaa\xb3\x26\x81\x63\x66\xe0\xa0\xc7\x0e\xac\x80\x6e\xc0\x44\xef\x9d\x2a\x21\xf4\xde\xd2\xf2\x53\x70\x48\x0b\x2f\xaf\xca\x0a\x2a\x26\x25\xad\x49\xd6\xeb\x51\x43\x17\x57\xd3\xea\x74\x19\x24\xd6\xb7\xd3\x5e\x6f\xb0\x3d\x5d\x0a\xa8\xea\x6c\xa9\x4b\x2d\x4f\x97\x42\x85\xda\x32\xf6\x84\x57\xd3\x5d\x26\xac\x6f\x16\xbd\xa3\xdc\xcb\xbe\x21\x64\xb2\x30\x3e\x5d\xfa\xfd\xe4\x8e\xdd\x3d\xe0\xe0\xe5\x89\x2d\x99\x28\x7a\x03\x3a\x4a\x74\x91\x0a\xc5\x6f\xde\x97\x95\x32\xb5\x8f\xec\x43\x79\x50\xdb\x96\x05\x6e\xb0\x69\xc1\x80\xda\xbb\x18\xd4\x5e\xe2\xde\x59\x04\xb0\xc9\x73\x13\xec\xc6\x54\x7a\x63\xc2\x9a\x12\x42\x3f\x9e\xa8\xe1\xc8\xfc\xae\x5a\x1a\x60\x5e\xe3\x0d\x88\x71\xa7\xe0\xaa\x4b\xee\xe8\x8d\x4e\xf7\x97\x0d\x66\xfa\x4b\xcb\x95\xf0\x79\x41\xaa\x83\xa2\x98\xe5\x60\xb1\xcd\x47\x20\x87\x99\x08\xcd\x6c\xce\x8e\xed\xd3\x1d\xbd\x4e\xf7\x6c\x9f\xee\xe9\x75\x1a\xf2\xce\xd8\x22\x7a\xa5\xd7\xc1\x8c\xd4\xec\xd2\xbe\xbb\xf1\xd6\xec\x23\xbd\x06\x1e\x0a\xd2\xb8\xec\x8e\xee\x58\xb7\x76\x86\x39\x18\x0b\xb5\xa3\x82\x5d\x3c\xe5\x9e\x7c\x43\x8e\x02\x87\x81\x82\x6b\xfa\x8a\x2f\xb7\x0b\x7e\x62\x85\xd5\xc0\x42\x79\x03\x0b\x06\xe8\x14\xa9\xf6\xaf\x30\x37\x56\x24\x46\xe8\x88\x4c\xca\x81\xbb\x1b\xe0\xe6\x89\x8b\x06\x49\x13\xe4\x4d\x70\x83\xb1\x18\x43\x92\xc2\xdf\x1a\x26\x65\x6b\x8b\x38\x74\x7b\x69\x46\xe0\x12\xd6\xae\x92\x4d\x02\xf6\xc6\xd3\x2d\xd5\x3f\xd3\xa4\x1e\x8e\xc9\x53\x1e\x51\x25\xd9\x08\xf3\xf8\x31\x29\x82\x59\xe5\xd3\x25\xfc\x4c\x93\x12\x6b\x87\xc4\x1d\x94\x28\xa6\xbc\x8d\x9b\x9b\x8c\xa7\x6b\xcc\x4f\x0a\xac\x1a\x63\xfc\xe8\x03\x13\x43\x31\x85\x87\x24\x0f\x7c\x2a\x53\x99\xde\xf0\xbc\xde\x56\xa0\x8f\x94\x28\x63\xd9\x32\x58\x78\x6d\x4e\x99\xae\x4a\xa9\xd8\x6b\xf8\x41\x86\x40\xb2\xa5\x3c\xbd\x3c\xa2\xfd\xda\xc4\xa5\x3b\xa5\x66\xf0\x74\x45\x68\x57\x6b\x4b\x5d\xb7\x4d\x3e\xb7\x68\xf3\xa0\xad\x60\xd1\x8d\x80\x2d\x58\x74\xa2\x3f\xb2\x60\x3c\x26\x12\xa6\xcb\xc1\x45\x36\xb2\x4d\x54\x31\xd0\x70\xe2\x0a\x83\x7f\xad\xdc\xb7\x94\xc1\xb3\xc2\x14\xc4\xac\x56\x00\x03\xf4\xdc\x76\x0d\x68\xad\xfb\x7f\x4c\xf3\x1d\x11\x96\xc1\xa0\x30\x4b\x7f\xc9\x5c\xd8\xf9\x00\x8e\x94\xe3\x92\x58\xe5\xa5\xa6\x41\xf4\xea\x9a\xd0\x2b\x16\xcb\xc9\xc2\x50\x02\x3b\x08\x0d\xb1\x07\x4b\x75\x4f\xbc\xd5\xf6\xed\x9d\xb3\x9b\x6d\x91\x70\x5b\x26\x2d\x72\xb1\x64\xd2\xb2\x5b\xd6\xac\x1c\x68\xfc\xa5\x18\xd4\x66\x5b\x78\x72\x68\x3b\xcd\x87\x56\x4d\x26\x0b\x08\xa8\x6d\xbf\x9f\x24\x3e\xeb\xfc\x82\x0c\xcc\xe3\x73\x81\xbf\x20\xbc\x34\xcf\x43\x93\x47\x68\xfe\x6c\x04\x19\x23\x42\xd0\x2e\x8e\x31\xb6\x9c\x56\x03\xb6\xce\xaa\x21\xb3\xa6\x45\x90\x68\x15\xa1\x06\xeb\xcc\x3e\x9e\x5f\xd0\xa0\x0f\xcb\x29\xca\x18\xb0\x9b\xba\x89\xa0\xdf\xfa\x2b\x43\xb6\x26\x59\x58\x66\xc8\x16\xed\x32\x03\xb6\xd0\xd7\x76\x96\xd3\x7d\x56\x35\x4d\x72\x4d\x77\xf4\xb6\x0b\x24\xd2\x40\x51\xdc\xe2\x76\x85\x83\x93\x74\x1b\x80\x4c\x67\x83\xb4\x64\xb6\xbb\x74\xed\x1e\x27\x75\xba\x7f\x66\x47\x34\x5d\x33\x98\x86\xac\x4e\xf7\xcf\x0b\x93\x38\xb4\xb9\xfd\x7e\xb2\x76\x93\x42\xcc\x89\x4f\xb6\x68\x09\xb5\x35\x86\xbe\xe7\x17\x74\xa5\x13\x55\xb9\x19\x6c\xad\x6d\xf1\xf9\xc5\x24\x98\xa9\xf5\x34\x91\xac\x4b\x72\xf9\x8c\x2d\x1a\x2a\x3a\xb3\x9e\x2f\x1a\x92\x9d\xac\xe6\xd6\xfd\x64\x75\x56\xc4\x6b\x0f\xd6\x7c\x79\x67\x59\xbb\x75\x06\xb5\xdf\xcd\xf6\xd9\xec\xe5\xe7\xa6\xb5\x86\x56\x9d\x4d\xd8\xaf\x0c\x83\x26\x86\x71\x13\xcf\x46\x0d\x2d\x4f\x0c\x67\x35\xc5\x55\x70\x4e\xb6\xa8\x4c\xea\x74\x47\xa6\xc9\x92\x19\x41\x56\x0e\x09\xfd\x7e\x12\x2d\x6a\x99\xd4\xe9\x9e\x10\x92\x89\x20\x1b\x77\x18\xad\x1e\xac\x81\xf6\xd4\x01\x65\xe4\xb8\xa8\x88\xf6\x5f\x9b\x93\x3a\xb5\x0f\xd9\xd2\x52\x04\xd7\xe6\xe4\x4e\xed\x43\xb6\xb6\x80\x63\x47\x3c\x26\x83\xea\x53\x1e\xb7\x77\xbe\x87\x6c\x8b\xec\xd6\x42\x03\xdb\x10\xbb\xb5\x40\x41\xe3\x1d\x57\x06\xef\xb8\x02\xbc\x03\x55\xe6\x76\x86\xd4\xb8\xb6\xaa\x84\x3b\xab\x46\x69\x30\x93\x9f\x0c\xba\x82\x84\x06\xe0\x2c\x8e\x00\xba\xa6\xaa\xdf\x5f\x18\xb7\x9e\xfe\xc9\x62\x61\xd7\x84\xae\x31\x84\xd6\x4b\x5d\xf9\x24\xb5\x1e\xe3\x26\x98\x72\x2b\xf8\x5d\xe0\x13\x11\x1a\x08\x75\xdf\xa8\x20\x13\x13\x98\xb1\x4c\xf2\x74\x37\xa6\x79\xba\x1f\xeb\x2b\xcb\xa7\x5d\xe8\xb4\x8b\x38\xed\x33\x9d\xf6\x19\x12\xb9\x51\xa3\x9f\x0e\xa2\xc8\x64\x00\x9c\x35\xbc\x8d\x60\xf1\xda\xc3\xe2\x85\x87\xc5\x2b\x80\xee\xd7\x00\xdd\x37\x4e\x3f\xf1\x86\x59\x88\x00\xca\x75\xfe\x60\x2f\x48\xcd\xae\x07\x37\x1a\x2e\x5a\x30\xb7\x9e\x26\x39\x4b\x04\x5b\x91\xe1\x56\x13\x45\xb4\x64\xf5\x60\x4b\x0b\x56\x0f\xb7\x24\x33\x79\x83\x0d\x19\xb8\xdc\x21\xe4\x0e\xb6\x26\xae\x9e\xfe\x42\xd0\x98\x60\x49\xce\x56\x83\xe5\x60\x8b\x0d\xe6\x83\x2d\x09\x80\xa8\x2f\xb1\x19\x2e\x87\x61\x19\x4c\x37\x73\xf0\x93\xcf\x70\x70\x5f\x77\x3e\x29\xd9\x35\x81\x0e\x94\xa8\xc4\x8a\x49\x83\x1b\x32\xc0\x44\x3d\xab\xb7\xac\x9a\xe8\xbe\x0a\x76\xeb\x64\x2a\xe3\x4c\xd0\xdd\x45\x96\xd3\xdd\x67\x59\x45\xf7\xe3\xac\xa4\xfb\x8b\xac\xa6\xfb\xcf\xb2\xa2\xc1\x4d\xf4\xe1\x48\x8e\xdb\x5a\x26\x8b\x13\x82\xef\x58\xeb\x4c\x62\x95\xd4\x56\x98\xf4\x96\x27\x1c\xc4\x10\xe8\x8e\x0c\xaf\x30\x8c\xbc\x96\xee\xd8\x6b\x9e\x78\xbc\x08\x56\x8f\x80\xb3\xc9\x9d\xc2\xa3\x54\xf8\xe7\xa4\xb3\xd8\x57\x79\xcd\xf5\x46\x63\xbd\x1b\xb1\x5c\x16\x1c\x85\xcb\x31\x96\x9a\xb3\x18\xe3\xd4\x18\x89\x0d\x06\x14\x16\x46\x9e\x68\x17\xba\x22\x1e\x89\xcd\x55\x1a\x3e\x40\x18\xb5\x8a\xe0\x47\x00\x69\x2c\x67\xd5\x9c\x16\xe9\x4e\x0f\x99\x50\x95\xee\x07\xe2\xfc\x02\x1f\x98\x18\xe4\xb4\x1a\x80\xae\x17\x28\x17\xef\x07\xac\x03\x13\x1e\xe6\xc4\x2c\xc8\x11\x0b\xee\x44\xec\x51\x40\xc5\x23\xdc\x7c\xc1\x22\xd4\x99\xae\x98\xc1\x21\x0d\xb0\xb2\xd4\xc8\xa6\x8d\x1b\xd2\x1b\x36\xa2\xb7\x6c\x33\x7d\x1d\xd8\xec\x67\x23\x7a\xd5\xbd\xb8\x21\x35\xc4\xc9\x7d\x30\x0f\x9c\x5e\xe1\x24\x0c\x6e\x70\xf4\x6b\x37\x0d\xeb\xc1\xa2\xa1\x1a\x4a\xfa\xf5\x5e\xe1\x36\x09\xb7\xc3\xea\xe4\xaa\x9f\x44\x32\x3f\x89\x35\xbb\x6d\xb8\x27\xad\x8d\x11\x8b\xc2\x3a\xb0\x6b\x7d\x53\xdc\xb0\x4d\xbf\x6f\x8e\xf2\x19\x63\xfb\x69\x00\x59\x56\xd3\xf5\xf9\xc5\x60\x9c\xad\x01\xb7\xae\xd9\x88\x6e\xd9\xb5\x3d\x24\x35\xe8\x0f\xd6\xe8\xa5\x42\xb0\xeb\x59\x8d\x81\x0b\x5b\xc4\xa6\x4e\xae\x6c\xb2\x4f\x0a\x7b\x9a\xdb\xbe\x59\xa6\xa8\xee\x57\xc1\x46\x74\xa9\xe1\x80\x40\x14\xdd\x39\x7b\x28\x9e\x2d\x27\x83\x41\x01\x62\xef\x0d\x6e\x9d\x4b\x7d\x4b\x25\xb7\x1a\xb8\x87\xe3\xef\x14\x40\x98\x2f\xff\xc8\x17\x2a\xb9\x02\x24\xea\x55\x59\x7d\xa7\xaa\xe4\x92\xae\x61\x2d\xe9\x5a\x3f\x20\xf8\x47\x25\xa6\x31\x04\xe5\x75\x11\xb6\x58\x1c\x61\xcb\xe6\x7d\xba\x45\xdf\xb7\xe3\x08\x5e\xa7\x7a\x75\x95\xee\xde\x15\xdb\x3a\xb9\xa4\x63\x42\xd7\x43\xb3\xdd\xc6\xfa\x11\x5f\xa3\x89\x6c\x76\x49\x39\x2b\xe6\xa4\x71\x13\x8a\xc4\xcc\x8e\x34\xfa\x0c\xb8\x2d\xe0\x88\x29\x8a\xe7\x79\xa8\x51\x40\x7d\x3a\xdb\x2c\xdc\x23\xef\x58\x8e\x8e\xd1\x34\xbf\x07\x97\xa5\x55\x10\x78\x1c\xb8\x0c\x84\x58\xe6\xfc\x1c\x11\xc0\x11\x18\xad\x63\x30\x1a\x55\x7f\x08\x8e\xb6\xa4\x6b\xf1\xf6\x68\x4b\x05\x4f\x02\xce\x47\xd1\x79\x39\x1b\x39\x67\x9c\x01\xc8\xa8\x66\xf9\x9c\xd6\xa7\x40\x67\x8b\xa0\xb7\x20\xd2\x4b\xcb\x8e\xc9\xbf\xd6\x11\x3f\xda\x46\xe1\x46\xe5\xad\x8d\xea\xb7\x74\xe4\xff\xd6\x98\x7d\x72\x8b\x8a\xe8\x55\x36\x40\xb5\x04\x54\xa4\x06\x54\xa4\xb0\x94\x1c\x38\x46\x37\x08\xdf\x92\xf1\x08\xa5\x99\xc8\x14\x42\x29\xbe\xcb\xd5\x3a\xd1\x8b\x73\x53\xde\x6a\x2c\xaa\x1c\x2c\x69\xed\xaf\xfd\xaa\xdf\x47\xbd\x43\x8b\xe7\x81\x21\x87\x47\xba\xca\x41\x31\x84\x0a\x32\xfd\xc7\x36\x5f\x56\xb9\x12\x0b\x70\x02\x8d\x79\xb4\xa6\xf0\x77\xb0\x24\x21\x31\x58\x39\x48\x86\x9a\xb3\x9f\xfa\x04\xad\x07\xdb\xe1\xf2\xf4\x47\x06\x5b\x6a\x3a\x02\x28\x8b\x27\x4c\x3f\xdd\x7d\x53\xa7\xab\x65\x6c\xd7\x7e\xbb\xd5\x7d\x8b\x74\x7d\xb2\xf7\x38\xf8\xee\xf6\xa9\x99\x6d\x99\x2e\x8a\xb2\xe6\x6e\x2d\xf4\xce\x49\x5a\xce\x8f\x9f\x8f\xfa\x7d\xbb\x6b\x92\x53\xc1\x6b\x8f\x30\x6e\x1e\x60\xdc\xfa\xfc\x8f\xce\x18\x28\x6c\x02\x91\x61\xd1\x74\xc3\xe7\xe0\xb1\x60\x82\x3b\xc1\x84\x60\xf7\xbb\x8c\x03\xe3\x99\xa7\xfb\xc6\x06\x10\xcb\xaf\xea\xc4\xb5\xf5\x2c\x1d\x8d\xc6\x64\x3a\xca\x5c\x0a\x6c\xcf\x90\xd3\x77\x38\x74\xb0\x09\x21\x31\x7e\x6d\xb3\x0d\x75\x5a\xc4\x20\x9c\xc4\xd2\x2f\xa3\x15\xda\xef\xa3\xd5\x54\x9d\xdf\x72\x88\x00\x86\xfa\x2e\x2f\x8a\xcd\x3a\x67\x39\x75\xab\xe4\x4f\x2d\x58\xae\x80\xeb\x74\x81\xa0\xcd\x71\x4d\x51\x2f\xb5\xbc\xe5\x55\x25\x96\xc0\x90\x73\x8a\x2c\xfa\x84\xc7\xaa\x2d\xc4\xb7\x8d\xdc\x67\x68\x36\x48\x05\xd6\x71\x3b\xd1\xf0\x8d\x4d\x32\x7e\xb0\xe2\xb5\x2a\xab\x47\x7c\xcf\x96\x4c\x40\xb9\x76\x9d\xcb\x65\x81\x16\x26\x5d\x42\xb0\x63\xa1\xa9\x67\x28\x86\xc2\xea\xe8\x0d\xe2\x69\x78\x9f\x0d\x18\x29\x78\xbf\xe1\x53\x69\xe5\x61\x6c\x36\xcf\x12\xff\x26\x03\xe9\x8c\x8d\x39\xf5\x42\x41\xa7\x5e\x95\xd5\xf7\x25\xb8\x70\x14\xa9\xa6\x2d\xf5\x01\x11\x69\xc5\x6f\x79\x55\x83\xb9\xac\x69\xc3\x26\x25\x84\xd0\x84\xb3\xb3\xd7\x69\x5e\x55\xf9\xfe\x9b\x7f\x6c\xf3\xa2\xf6\x9f\xa2\x51\x47\x09\x06\x90\x6e\x0d\xc4\x94\x4c\x84\xdd\x1d\x87\x83\x30\x04\xac\x29\x1f\xc9\xc3\x58\xe4\x46\xda\xfb\x98\x39\x1b\x81\xd6\x3e\xc6\xc3\x00\x5b\xf8\x86\xd0\xbf\x70\xf6\x15\xa7\x3f\x73\xf6\x0f\x3e\xf9\xd9\x2b\x49\xf3\xaa\x66\x7f\x41\xd3\xa6\x6f\x8f\xb5\x9d\xbd\x36\xd9\x4f\x3c\x09\x3c\x7a\x3e\xa0\x6d\x3e\x3f\x61\xaa\x7e\x0f\x75\xda\x97\x3f\x84\xe1\x5a\x25\x3d\xf0\x53\x0e\xeb\x75\x38\xf4\xf6\xee\xc5\xf0\x23\xad\xbb\xbe\x99\x9a\x87\x76\x26\x7c\xa6\xe6\x87\x03\xfc\xa0\x4b\x52\xb8\x24\x6b\xb8\x24\x4b\x28\xad\xaf\xc7\x8a\x7d\xcb\x93\x12\x2d\xd7\x82\xef\x4c\x7b\x8b\x5c\xf1\xeb\xb2\xda\xf7\x9c\x4b\x73\x42\xf3\xe7\x8c\xfb\xaf\xf4\xfb\xf0\x82\x56\x0f\x0d\xa1\x67\x1c\xdb\x84\xc6\x0e\x87\xd2\x84\xfe\xc7\x5f\x0d\xa3\x82\x6c\xa7\x93\x6f\x12\xe9\xec\x47\xd0\x3c\x8d\x14\xed\x93\x8a\xd0\x72\x4e\xb2\x76\xd9\xd2\x70\x56\x5e\xa7\x97\x38\x6d\x6e\xb6\x9a\x26\xb0\x3d\xff\xf3\x7f\xdd\xa2\x98\x4b\x1b\x66\xb8\xab\xc9\x0a\xed\x2a\x7b\xa8\xa2\x8f\x33\x0a\x0b\xf1\x13\x4f\x72\x5a\x91\x0c\x73\x82\x0c\xdb\xc1\xfc\xc4\x4c\xc0\xac\x11\x5a\xc1\x74\x3c\x38\xec\xbf\x85\x26\xf7\x56\x8f\x7f\xf2\xa0\xd5\xc0\xc6\x5a\x7b\x06\x0a\xfe\x94\xb3\x3f\x7b\xa3\x17\xfa\x76\x66\x6d\x76\xd0\xdc\x91\x72\x08\xc8\x68\xc2\xaa\xb8\x3c\x9b\xc0\xa9\x4a\xd1\x08\x19\x2d\x19\x5e\xe7\xb7\xfc\xcd\xd7\x35\xc0\xf0\xab\xad\x28\x96\x3f\x54\x7f\xf1\x96\x15\x98\x6e\x74\x39\x7d\xb0\x16\x6e\x93\xea\x20\xd7\xeb\xf0\x24\xc1\xa0\x7f\xd7\x76\x29\xd2\xc9\x67\x44\x93\x25\xd1\x4c\x96\xe5\xbd\x60\x7c\x20\x07\x83\xe6\x6e\x2d\x0a\x9e\xbc\x4e\x57\x42\x2e\x51\x6c\xab\x68\x4e\x9e\x33\xef\xa3\x4b\xf8\xcf\xfc\x29\xd0\x35\xb2\xe8\x94\x3e\x93\x1e\x3b\x09\x34\x35\xff\x3d\x56\x31\x75\x1d\x0a\xbd\xe6\xea\x6d\xa2\xbb\x34\x53\xf3\xa9\x9c\xf1\xf9\x50\xcc\xf8\x3c\xd3\xa9\x43\x9d\xd6\x1c\x3b\x8e\xf4\xa1\xc5\x1a\x6a\x3c\x39\xcd\x42\x57\x3e\x81\xa7\x9e\xde\xa2\x10\x8b\x8f\x3d\x8d\xf8\x59\x47\x43\xf6\x05\xca\xce\xa9\x09\x8f\x50\x4a\x88\x47\xd1\x69\x79\x11\x99\x59\x38\xaf\x15\x5f\x6f\x2b\xf4\x87\xf5\xf9\x68\xe4\x23\x11\x63\xfd\x63\x67\x21\xba\x6a\xc5\xeb\x4d\x29\x8d\x7b\x9c\xe0\xed\xc5\x51\x93\x36\x2c\x9b\x92\xac\x5b\xa0\x19\xd9\x0b\xa1\x15\xb5\x4e\x0b\xe3\x4d\x4a\xaf\xba\x4b\xbd\x75\xd4\x49\x86\xe6\xa4\xc3\xf7\x21\x4b\x94\x5e\xdb\x8e\xe3\x8d\x4a\xf0\xc6\xbd\x7a\x68\x9f\xe6\xf5\xf8\x03\x95\x7e\xb8\x75\xad\xcd\x82\x33\x5e\x80\x54\x7f\x80\xda\x47\x0d\xcf\x98\xcb\x07\x33\x5d\xaa\x9a\x84\x13\xe3\x3f\xe1\x07\x9e\xc6\xa6\xad\x38\x0f\x39\x43\x1f\x2b\x60\x40\x4b\x2b\x8d\xca\x3a\x8f\x8f\x25\xbe\x01\x42\x38\x91\xfa\x2c\xbc\x4e\xb7\x62\x09\xd8\xe9\x42\xed\x98\xa0\xce\x95\x40\x8e\xd1\x50\x56\xe2\x9a\xe9\xab\xd9\x38\x52\xa5\xd6\x62\x9f\x69\x82\x26\x30\x81\x67\xd5\xb4\x3c\x37\xdb\x47\x7a\x18\xe0\xc2\xff\xc8\xf4\xf2\x6a\xbb\x5a\xf1\x8a\x2f\x7f\x04\x07\x1c\xec\x6c\x8c\x37\x3e\xb8\x0f\x9d\xcd\xf5\xe7\x34\xa2\xc1\xa4\x0f\xc3\x52\xf0\x8a\x49\xaa\x64\x6a\x35\xa6\xeb\x99\xee\xf4\x9c\x49\xda\xed\xde\x49\xd2\x9e\x9e\xf3\x63\xd7\x4e\x0e\x33\x32\x40\x4a\x97\x82\xf8\x2d\x11\x66\x15\x65\x33\xa5\xb1\x02\xd1\xef\xe7\xd3\x44\x46\x30\xc7\x23\x12\x84\x64\x7a\x67\x95\x05\x4f\x79\x55\x95\x55\xd2\x7b\x95\x8b\x82\x2f\x9f\xa8\xf2\x09\x6e\x17\xf4\x43\x97\x3d\x59\xe4\xf2\xdf\xd4\x13\xb3\x5e\x4f\x16\xb8\x60\x4f\x56\x55\x79\xf3\x44\xad\xf9\x93\x6b\x71\xcb\xe5\x13\xa1\xf8\x4d\x8f\x34\xb4\x5b\x49\xd1\x93\x02\x76\xb3\x7d\xc7\x53\x34\x4a\x4d\x14\xed\x21\x0e\xfe\x46\x0a\x05\x56\x21\x15\x57\x42\xe6\xa8\x14\xa3\xfc\x36\x4a\x97\xfc\x56\x2c\xf8\x3b\xb1\xe3\x05\x2c\x1c\x80\x63\x21\xd1\xd4\x19\xa1\xb0\x2d\xea\x4f\x68\xbf\xaf\x8c\x73\x16\xc0\x9d\x14\x4c\xc7\x87\xb2\x2c\x3e\x88\x4d\x42\x68\xd4\x0f\xc0\xf3\x4d\x37\x54\x87\xf9\xae\xbb\x8e\x71\xa3\xa5\x50\x00\x24\x27\xe6\x0c\xd3\x5a\x95\x9b\x8e\x1a\x7f\xd2\x35\x16\xbc\x70\xe0\x22\xaa\x84\xdd\x3b\x1d\x11\x93\x07\x91\xa8\xb8\x3d\x1d\x39\x93\xdd\x9e\x8d\x78\xec\xde\x01\xb6\x75\xe5\xc5\xfa\x23\x0a\x8f\xab\xa2\x2c\xab\x04\x6c\xfd\xbf\xcf\x77\xe2\x66\x7b\x03\x34\x5d\x22\x08\x28\x57\x76\x17\xcf\xa7\xd5\x79\x9e\x85\x95\xd0\x0f\x25\xd4\xd2\xc4\x5c\x62\x28\xb6\x33\xc6\x2a\x70\x06\x0d\xd9\x67\x8c\x95\x1a\xc7\x15\xb1\xdf\x04\x56\x51\xcb\x0c\x70\x02\x0a\x56\x52\x61\xfc\x58\x98\x32\x83\xde\x66\xd7\x73\x89\xb6\x14\xa6\xc6\x5b\x45\x9f\xf5\xe3\x2d\x72\xa6\x9c\x09\x8a\xa1\x2a\x2b\x4b\x4f\x96\xcd\xc4\x2f\x3f\xa7\xce\x79\xd3\xac\x9e\xeb\x83\x52\xca\x1f\x39\x3a\x6e\xf7\xcf\x09\xd7\xd4\x31\x4f\xf5\x3a\x03\x3d\x6c\x4e\xd3\xfd\xd2\xc2\x7f\x99\x3e\x70\x3b\x34\x9a\x38\xea\xc0\x2c\x4e\x90\xcd\x76\xd9\x35\x2a\x84\x98\x8f\xf1\x23\x64\x5e\x27\x8e\x2d\x07\xb8\x6f\xdb\x6f\x85\xc6\x18\xc0\xfa\x59\x2c\xd9\xef\xb8\x2b\x65\x83\x05\xa1\x97\x2a\x12\x28\x1a\xec\x1f\xd5\x0a\x96\xb2\xa1\x83\x5c\x2b\x60\xda\xa5\x0b\x49\xa8\x60\x70\x44\xd2\xd0\x0e\x8c\xe9\x01\x4d\x4f\xaf\x78\x1c\x8d\xd9\x58\x5f\x1b\xd8\xf9\x91\xef\xeb\x44\x92\x4f\xa8\x0e\xcd\xf8\x5c\x83\x6a\xd5\x10\x7a\xdf\x90\x09\x37\xed\xe9\x9d\xc8\x9c\x23\xdc\xc4\x26\xe3\xec\xa0\x19\xf6\x4d\xbe\xe9\xd2\x56\xbb\xb7\x46\xd1\x8a\x2e\x31\x5e\x92\xa3\x33\xe8\xf2\x38\xd4\x52\x03\x54\xa3\x6d\x7e\xff\x1b\x9a\xb7\xe1\x98\x96\xed\x78\x4c\x0d\x01\xe2\x0f\xdb\xd6\x77\x26\xd2\x31\xb6\x01\x93\x61\x9b\x89\xe2\xbb\x52\x51\x1b\xc4\x5c\xa3\x32\x41\xcb\xde\xff\x6f\xe3\xf6\x84\x38\x76\x84\x1a\x06\x6e\xaf\x98\x48\xc5\x92\x96\x9a\x0c\x13\x48\x86\xf1\x14\x3e\x4a\x26\x7f\xd2\x49\xb6\x75\x72\xc6\xd8\x9f\xf4\xe6\x71\xdf\x43\x80\x10\xd8\xf3\xfa\x1c\x9a\xcf\xaa\x39\x3b\x1b\x19\xed\x2c\x0d\xc1\x34\x70\xa9\x9e\x08\x74\x19\x34\xab\x90\x0c\x63\x1a\xae\x40\xf8\xa2\x6a\x4e\xdc\xc5\x2d\x68\x0d\x08\x01\xb2\x9b\x8c\x4f\x57\xa6\xbc\x93\x9b\x82\x05\x34\x4a\x60\x01\x9e\x94\xe8\x1f\xaa\x30\x96\x70\x93\x9a\x49\x7e\xf7\xa4\x48\xee\xc5\x32\xab\x30\x40\x56\xe9\xec\xe2\x05\x5d\x28\x4d\xa2\xeb\x8f\xe0\x45\xa9\xf7\x99\x9c\xd5\x70\xcb\xd7\x4d\x8d\x24\xd1\x07\xb1\xf8\x58\xdb\xd8\x90\x10\xa1\xde\xce\x3e\xb2\x86\x30\x6c\x67\x78\x0c\x73\xda\xda\xce\xea\x70\x30\x96\xf3\x1a\xc7\x06\x47\x13\x66\x4f\x31\x49\x7f\x04\xd3\xe6\xd8\xe0\x1a\x2f\x97\xd6\xc1\x7b\xe9\xf0\x92\xee\x98\x80\x96\x27\x03\xc7\x4c\x62\x8c\x77\x8b\x04\x22\x17\x1e\x02\xef\xe5\x61\xe0\x3d\x35\x18\x58\x15\xf6\x7c\xa6\xe6\xb4\x64\xf2\xc8\x00\x93\xd0\x9a\x55\x86\xa8\x96\x21\x11\x06\xcc\xff\x36\x95\x5d\x03\x10\x59\xf2\x5a\x55\xe5\xbe\xd5\x4e\x67\xeb\x84\x62\x5d\x56\xd3\x32\x05\xde\x24\xab\xf0\xf7\x70\x18\xd1\x12\x4d\xd3\x98\xa2\x65\x80\x98\x91\xf0\xc5\x40\x70\x43\x3c\x91\xa8\x60\xea\x03\x13\x27\x24\xdc\x40\x7f\x52\x33\xfc\x2c\x58\x0b\xfa\x80\xad\x44\xad\xab\xf2\xee\x89\xde\x36\xdf\x00\x52\xf5\x6f\xbd\x7f\x1b\x60\xc9\xc1\xbf\xf5\x9e\x88\xfa\x89\x2c\xd5\x93\x1c\x31\xab\x27\x60\x9a\xf7\x6f\x64\x52\x46\x48\x23\x6c\x39\x49\xbd\x4f\x86\xa8\xeb\x8d\x0b\x01\x01\x78\x83\xe7\x6c\x9d\x40\xb7\x1c\xe5\x1c\x2d\x68\x14\x2d\x1d\x60\x7c\x6b\x62\x65\xa8\xc1\x9c\x5a\xe7\x78\xc4\xf8\xf4\xa9\x5b\xce\x47\xf5\x5d\x15\x75\x26\x31\x6c\xc5\x4e\xb2\xf7\xd3\xd1\x75\xc1\x18\xba\xdf\xef\x95\x00\xed\x23\x13\xc4\x44\x31\x7f\xd7\x2a\x5a\xe4\xbf\xec\x33\xc7\xf4\x98\x8d\xe7\x0d\xa1\x7f\xe3\x89\x00\xc4\x2e\xf0\x43\xa1\x53\xc0\x3d\x86\xbf\xf0\x85\xc5\x3b\xf1\x7c\xf4\x08\xb9\x17\x9e\x8a\x07\x34\x1a\xa3\x89\xdb\x40\x43\xe9\xa9\xf3\x64\xc2\xb0\xa1\xce\xba\x88\x67\x3a\xd4\x60\xe7\x83\x01\x11\xed\x99\xe6\xd1\x4c\x47\x9f\xf0\xb3\x39\xb1\xa1\x73\xcd\x01\xd7\x7b\xc3\x39\xf3\xb2\x48\x86\xbe\x06\x8e\x12\x53\x3b\x59\xfd\x7e\x17\x7c\x41\x47\xad\x66\x75\x75\xab\xf8\x19\xd3\xc1\x1a\x3e\xd4\xb5\x88\x60\x5e\x12\x18\x15\xd1\x68\x5e\x01\x8f\xb6\xd3\x4a\x85\x25\x95\x50\x93\xfb\xdf\x79\xd2\xfb\xa5\x47\x7b\x97\x62\xb9\xeb\xc1\x37\x5b\xe4\xd5\x34\x4a\x01\x7f\x97\xe1\x9a\xbb\x11\x51\x9e\xd7\x68\x5a\x85\x0f\xb8\x19\x54\xaa\x7f\x9a\x4c\x18\x6f\x89\x10\xc2\x8d\x86\xb3\x77\xe2\xa4\xb4\xb6\x87\x23\x4b\xb0\x52\x4f\xdf\x57\x1b\x87\xef\x01\xb0\x84\x2d\x6e\x42\xf4\xe8\x47\xe3\x79\x8d\xaa\x90\x36\xf4\x1a\xa4\xe5\xee\xd8\xdf\xc5\xa5\x0b\xe1\xa0\x11\x77\xff\x96\x04\xad\x44\xd6\xbb\x36\xd1\xc7\xaf\x48\x08\x1e\xcc\xa0\x42\x87\x17\x19\x0c\xab\xa1\xe7\x1c\xc2\x31\x75\xd0\x3d\x00\xe7\xdc\xa2\x1d\xe7\xdb\x79\x70\x07\xd8\x6e\x92\x2c\x76\xcf\xd9\x9e\x47\x3d\x53\x66\x2a\x6d\x0d\x7f\xe2\x6c\x9c\x0a\xbc\x5c\x50\x14\xd1\x75\x7c\x6c\x98\x57\x28\x11\x7d\x3e\x51\x64\xd2\xfe\x1a\xf4\xb7\xfd\xb1\xa6\xd5\xef\x53\xce\xa1\x8e\x2f\x30\xc9\xee\x6f\xb8\xca\x33\x1b\xc7\x4d\x35\x47\x9b\xe5\x78\x90\xe6\xb3\x74\x26\xc1\xf3\x11\x0f\x4f\xf9\xa5\xa5\xc1\xe9\x43\x3d\x0f\x5b\x00\xb0\xab\xb7\xf3\x89\x5e\x4f\x7e\x33\xb8\x9c\x58\xcf\x09\x47\xa0\x83\x0a\x8d\xd6\x05\xe7\x0d\xdc\x35\xda\x37\xf4\xaf\xa8\x1b\x9c\x1c\x2f\x3a\xb7\x93\x81\x27\xba\x47\x2c\x9a\x10\xf6\x3e\x2a\x0e\x03\xb7\xa5\xe9\x6b\x67\x72\x91\x48\x24\xbf\x5e\x96\x37\x1b\x8d\x03\x51\xf4\x33\x03\x16\x48\x1a\xe2\x59\xef\x03\xfa\xda\xfc\x5d\x72\x2f\xb7\x37\xef\x15\xdf\xd4\x99\x38\x1f\x7f\x99\x7e\xf9\x65\x1b\x46\x68\x1c\xc4\x40\x8b\xa3\xf9\x0c\xdd\x7e\x63\x99\x94\xaf\x56\x7c\xa1\xea\x19\x37\x09\x73\xa4\xc0\xb7\x55\xc5\xa5\xd2\x1f\xa2\x39\x13\xe7\x3c\xb5\x9f\x9d\x28\x90\xa4\x25\x32\xc9\x09\xcd\xa9\x20\x0d\x2d\xa5\xa3\x02\xdf\x55\xe5\x75\xc5\xeb\x3a\xd3\x03\xb2\x2f\x61\x01\x3b\xc8\xac\x6a\xc8\xe4\x4f\x1a\xb1\xf3\x0c\x03\x4e\x4b\x70\x92\x8d\xc2\x03\x8c\x40\x93\x10\x5a\x25\xed\x91\x8f\xac\xe3\xec\x86\x78\x87\xd6\x4d\x5b\x3c\xdb\x79\xe7\x0a\xc3\xd1\xe8\x70\xcd\x01\x9e\x80\xd8\x18\xee\x83\x2a\x97\x56\x33\x92\xd0\x33\x4b\xd6\x3f\x63\xa3\xc3\xc1\x12\xf4\xcf\xd8\x88\xf4\xfb\xa7\x6e\xdc\xaf\xab\xfc\xae\xa7\x57\xd2\x40\x00\x7d\x6b\x3a\xb8\xc6\x46\xfa\xb2\x74\x52\x1a\x8d\xf3\xa6\xbf\x3c\x63\x23\x30\x00\x83\x37\x18\xba\x08\x42\x30\x98\xe8\xf2\x3a\xdd\xdd\x5c\x8a\x04\xcd\x3c\x54\x57\xa4\x97\x20\x28\xc5\x5b\x4e\x0f\xe9\xf8\x2e\xf3\x1d\x6e\x1a\xea\xc7\x1f\x4d\xa7\x05\x65\x36\xce\xcc\x11\x2c\xeb\x0c\x3c\x03\xc5\x44\x6d\xba\x6d\x82\x64\x26\x9c\x18\x21\xfe\x83\xc8\x42\xb4\x10\x93\x08\xe1\x8a\xb2\x1a\xea\xc3\x70\x86\xf1\x37\x4f\xec\x86\x56\xbc\x9c\x47\x8e\xa3\xdf\x3f\x3b\x35\x16\xbd\x2d\xd0\xcf\x58\xe7\x90\xc2\x00\xe9\x16\x41\x00\x0c\xbe\x47\x7b\x00\x70\x01\x4b\x08\x07\xd1\x11\x4b\xf4\xa4\xc7\x95\xce\x91\x27\x67\x23\xa3\xb0\x70\x7c\x89\xf9\xb9\xe8\x00\x6c\x5d\xb7\xd9\xf1\x6e\x4e\xac\x92\xc3\xc3\xd1\x4f\xed\x24\x0e\xc7\x13\xf9\x9c\x8d\x26\xc3\xa1\x24\x4e\x1a\x6f\xaf\x37\x3e\x93\x73\xfa\xa9\x3b\x2e\xda\x9f\x41\xed\x4e\xe8\x86\x97\x99\xb2\x97\x19\x92\x48\x06\x50\x82\x39\x62\xc6\x1f\x73\xc1\x99\x6f\xe2\xf5\xa6\xc2\x7d\x09\x67\x8c\x3f\x7c\xb9\xf9\xda\x7a\x7b\x06\x07\xf0\xd4\xb5\x6c\x76\xb6\xbe\x8c\xcd\x63\xc6\xa3\x4e\x3f\x7c\x2b\x9b\xd6\xa3\x4e\x3b\x20\xda\xd9\xd1\xa3\x1a\xa8\xc5\x6d\x90\x72\xa3\x48\xd0\xe5\xd0\xad\xe2\xa0\x53\x50\xa7\x18\x79\xd6\xe0\x8a\x51\xed\xfa\x31\xd5\x41\xd4\x62\x6a\x47\x11\x56\x47\x4d\xbb\xb1\x9f\x5e\xec\x44\xa7\x73\x39\xdb\xd8\xcc\x86\x71\x9d\x3f\xae\xc1\x50\x4b\xe2\x84\xc3\x56\xd7\x34\xb7\x16\xd2\xc7\xce\x20\x9f\x88\xa9\xb0\x1f\x94\x10\x9c\x97\xfa\xe3\xff\x98\x39\x58\x5a\x24\xef\x74\xa7\x83\x33\x75\x6a\xef\x44\xe0\x6b\xa6\xe6\x13\x9e\x5e\xea\x43\x70\x38\x24\xe6\x09\xfc\x42\x5a\x4c\x08\x52\x66\x08\xcd\x96\xce\xfc\x5b\x1e\x0e\xc9\x71\x2e\xc3\x68\x15\x18\xcb\x27\x57\x79\x36\x9b\xbb\x20\xbf\x90\xe8\xcf\x05\xbe\x63\xa0\x1a\x7c\xde\xe9\x65\x7b\xf3\xb5\x89\x61\x11\xbe\x00\xfc\xcb\xb8\xe7\x64\x58\xcc\x93\x50\x09\xa3\x8e\x01\xca\xcb\xb6\xd7\xd0\x18\xaf\x1e\x19\xf6\xce\x69\xd2\xf4\x13\x57\xd1\x60\xe0\x9c\x58\x36\xb4\x5d\xe4\xd1\x88\xb4\xdd\x26\x57\x65\x59\xf0\x3c\xd8\x25\x3c\xc5\x59\x99\x9e\xd9\xa7\xec\xac\x73\xe1\x4c\xae\x9e\x01\xc9\x2b\x4d\xd1\x81\xa2\xee\x29\xe0\xef\x42\xce\xa1\x3a\xaf\x45\x29\x0d\x7b\xec\x98\xcb\x74\x6a\x24\x62\xd9\x39\x81\x1a\x15\x05\x25\x26\xbd\x23\x40\x6b\x08\xb6\x06\x9f\x4f\x04\x30\x37\x43\x90\x88\xdf\x4a\x9c\x33\x7f\x5f\xd8\xf7\xe4\x41\xbe\x9c\x95\x6e\x1a\xcd\x70\x23\x80\xc0\xf5\x95\x0f\x13\x4d\x27\x18\x6a\xd0\x4b\x99\x6e\x65\x24\x43\x6b\x09\xb7\x24\xa1\x3f\xf0\x34\xf6\x35\x9c\x80\xc4\x95\x78\x81\xab\x11\x9d\x2e\xd4\x8e\xa1\x06\x89\x07\xa8\x92\xf6\xcc\xc7\x7b\x6e\xec\xc7\x52\xd1\x86\xaa\xf2\xab\xbc\xe6\x5f\x7e\xfe\xe6\x26\xf2\x51\xd6\x12\x9b\x63\xcf\x54\xa9\x07\xf2\x97\x1f\xbf\x0b\x5c\x4a\x38\xb1\x98\x8f\xc5\x48\x03\x49\xdf\x09\x9a\xdf\x29\x66\x00\xfd\xf0\x33\x4f\xee\x2f\x0d\x2f\x97\xe2\xc3\x1b\xd3\x4f\x9d\x00\xc7\x1b\x19\x69\xf4\xd2\xb1\xea\x83\x00\x86\xa8\xed\xd1\x00\xac\xf7\x73\xfa\x90\x57\x8b\xc2\xb8\x5f\xae\x19\x88\x75\x42\x9e\x5a\x0a\xea\x11\xaf\x41\x91\xae\xcb\x35\x45\xe3\x59\x7b\x4e\xed\x10\xbe\x17\xb0\x18\x04\xb9\xff\x81\x1f\x87\xed\x81\xe8\xd8\x84\x62\x04\x47\xe4\x27\x77\xc9\x4d\x93\x56\x87\x8c\x14\x95\x34\xb4\xbb\xd1\x20\xf8\x09\xb5\x01\x31\x98\x24\x0d\x0d\x77\xd8\xa3\x66\x63\xa2\xbf\xee\xbc\xc5\xfa\x74\x27\xa9\x6a\xf3\x2f\x7f\xe0\x9d\xe1\x86\x14\x95\xa8\x0e\xe4\x78\x16\xa0\x29\x82\xce\x97\x1e\xb2\xec\x91\xd3\x5e\xcd\x55\x2f\xeb\x61\xab\x3d\x38\x74\x68\xb8\xee\x5c\xc7\xe5\x10\x25\x33\x27\x89\x60\x6a\x96\xcf\x4f\x60\xec\xa2\x1d\x58\xdd\x03\x85\x59\x39\xe8\xf9\xfe\xf4\xe6\x89\x20\x93\x9e\x29\x0c\xbe\x84\xba\x5b\x54\x1d\xd1\xda\x83\x46\x7b\x97\xbd\x41\x39\xe8\x99\x1a\x51\xfb\xc4\xe8\xdc\x98\x4d\xf5\x90\xc0\xd9\x6c\xe6\x87\x48\x7b\x98\x6a\x8b\xf7\xf2\x63\x1d\x09\x08\xb1\xda\xe6\xe3\x81\x2c\xc7\x4a\x91\x02\x2d\x51\x0d\x8e\x24\x08\xe6\x64\x7a\x09\xba\x3e\x53\xd9\xca\xcf\xc4\xa1\x9d\x14\x02\x1a\xcb\x43\x08\xba\xe5\xb4\xd3\xdb\xdd\x70\x41\x39\xa7\x36\xa6\x4a\x92\x93\x4c\xf4\xfb\x67\xdc\xb2\x3f\xe4\x35\x20\x88\x4e\xce\x6b\x8a\x79\x66\x8a\x67\x98\x80\x1a\x52\x7a\xa4\x5c\x84\xcc\x16\x8d\xa6\x10\xda\x31\x3d\xe3\x53\xd3\x43\xf9\xaf\x51\xa0\x0d\x54\x6c\xc0\x9f\x09\x74\xc6\x6b\xd3\x46\x3a\xa8\x8f\xd2\xa5\xf5\xaa\xb4\xee\x59\x3e\xa8\x41\x9b\xa3\x06\x6d\x1e\x71\x6d\x44\x6a\xb4\xb2\x80\x25\x00\x13\x64\x12\xe8\x4c\xa5\x18\xd1\x8b\xda\x0f\xcc\x35\xc4\x70\xa1\xdb\xce\x6c\x67\xfa\x7d\xa3\x02\xe6\x52\x74\x63\x46\x5b\x0b\x98\xdd\xf8\xe8\x5c\x17\xb5\xdb\x05\xa5\x77\x37\x66\xcf\x51\x48\xdb\xb0\x20\x09\xcb\xd9\x01\x9d\x81\x79\x67\x1e\xd7\xc5\xbc\x13\x6d\xe4\x71\xfd\x11\xa1\x1d\x2a\xc3\x4e\x63\x38\x50\x18\xa6\xad\x85\x32\x65\x40\xb7\x37\xbc\x2c\xd9\x3d\x06\xda\xe2\x92\x29\x39\x51\x32\xf5\x42\x08\xa6\x40\xdb\x48\xcf\x12\x5c\x26\xaf\x8d\xa8\xed\x7b\x5e\x5d\x73\xf6\x67\x4e\x5f\xa3\xcc\x10\xdf\x7f\xe2\x41\xb0\x4f\x14\xe1\xc4\x72\xab\xde\x87\xb5\xa8\x9f\xdc\x70\xb5\x2e\x97\x56\x6c\x25\x6e\x36\xb8\x07\xf8\x32\x7b\xc2\x85\x5a\xf3\xea\x89\x2c\x9f\xe4\xe8\x25\xf9\xc9\x22\x97\x4f\xae\xf8\x93\x55\xb9\x95\xcb\x27\x65\xf5\x24\x97\x4f\x84\x5c\x18\x8e\xd6\x13\x8d\xb8\x5f\xe3\xc1\x78\x72\x97\xd7\x4f\x36\x55\x79\x2b\x96\x7c\x99\xf6\x02\x3d\xc8\x1c\x25\x12\x01\xea\xc6\x20\x50\x70\xe3\x54\xe3\xf2\x48\x35\x6e\x55\x56\x37\xb9\xaa\x33\x29\xe9\x26\xaf\x6a\xae\x1f\x30\x4d\x3f\xe5\xcb\xa5\xfe\x59\x8a\xd5\x4a\xff\x02\x54\xf9\x01\x1e\xb9\x5c\xe2\xc3\x25\x6a\x3a\x75\xba\xe5\xd6\x93\x9f\x4b\xa7\x72\xcf\x5a\x6e\x21\x3a\xfa\xa3\x08\x2e\x4f\x25\xd9\xfd\x25\x88\xb8\x72\xd9\xd0\x52\x32\xd3\x51\x4d\xba\x64\xf7\xa0\x85\xdd\x49\xac\x79\x17\x9e\x8a\x4c\x55\xd6\xeb\x0d\x54\x43\x51\x05\xe0\x04\x0d\x66\xf9\x5b\xcf\x3f\x9b\xca\xd9\xc5\x7c\x28\x67\xe3\x79\xa6\xff\x0c\xe5\x6c\x34\x9f\x38\xa3\x08\x41\x9e\x8f\xfb\x7d\x75\xc6\x58\xa0\xcd\x03\x3c\x3d\xc1\xd4\x30\x4a\xb3\x50\xf3\x75\x5a\x94\xd7\xe3\x51\x12\xb4\x41\x68\xc5\x7a\x3d\x6b\xb4\xa1\x88\x58\x25\x4e\x4f\xc8\x15\x93\x10\x24\x28\x78\x95\x8e\xcb\x31\x27\xe4\xd9\x98\x0f\x3f\xb7\x3c\xdb\xa3\x4f\x68\x88\x5e\x87\x5d\x2c\x49\xd8\xb9\x9c\x4c\x6a\x16\x7f\xf1\x46\xc8\xa4\xa6\x17\x23\x42\x21\x3c\xb2\xc6\xe7\xbe\xd9\x6d\x4a\xc9\xa5\x12\x79\x91\xd4\xc8\x2e\x35\x52\xdb\xe1\xf8\x69\xdc\x5a\xd1\xd1\x5a\x11\xb7\xf6\x4a\xec\xf8\x32\x29\x0c\xdb\xb5\x62\xbd\x91\xf3\x65\x5c\x35\xb4\x28\xaf\xf3\x4a\xa8\xf5\x8d\x58\x9c\x0a\x6b\x72\x0e\x2d\x6f\xca\xbb\x64\xdc\x52\xbd\xc2\xc1\x2b\xe2\x19\x60\x23\x54\x5e\x1f\xf5\xb2\x31\x86\xf8\xb9\xc0\x9f\x2f\xf0\x47\x67\xf3\xc3\x81\x43\x28\x00\x3b\xa9\xd3\xf6\x98\x49\xd6\xeb\x35\x4d\x43\x6b\xc9\xdc\x86\xa2\x05\xbe\x04\x2c\x5d\xba\x95\xc7\x0e\xd0\x97\x2e\xed\x05\xa2\x16\x1d\xd6\x02\xeb\x36\xc6\x84\x61\x96\x40\xd1\x21\xb1\x2c\x2d\xa7\x71\x26\x34\x86\x96\x0f\xc7\x04\x0c\xc2\xae\xb9\x02\xdd\xac\x57\x65\xa5\xcb\x27\x15\x41\x7f\x18\x70\x36\x21\x07\x9d\x62\x70\xb9\x84\x37\xc0\x3f\x12\x44\x0c\xf4\x94\xe4\x53\xb7\x60\xe5\xb0\xa6\xc5\xb0\x24\x19\xcc\xca\x34\x39\x6e\x7c\x4c\x86\x25\x39\xbf\xc8\x92\x72\xd8\xf1\xe5\xe1\x98\x90\xf3\x0b\x9a\x94\x03\x56\x3d\xe3\x53\x91\x0d\x05\x79\x56\x0f\xc7\x7c\xf8\xe5\xe1\x50\x3e\x2f\x06\xfa\xc9\x45\x9e\x78\x52\x7a\x28\xb5\x68\xab\xd2\xc7\xc6\xc7\x74\x41\x57\xf4\x9a\x6e\xe8\x0d\xbd\x75\xeb\x44\xaf\xd8\x6c\x4e\x77\xfa\xcf\x5e\xff\xb9\x64\x23\xfa\xd1\xb8\x55\x46\xd3\x86\x5b\x40\x26\x21\xbe\x1d\x93\xb3\xdc\xf8\xce\xa6\x05\xbe\xdc\xe4\x7f\x2f\xab\x29\xc7\xdf\x8c\xeb\x79\x2d\x2b\xaa\xd0\xee\x70\xcb\x8a\x14\xbd\xf5\xd2\x25\x13\xb3\xed\x1c\xfe\x1c\x0e\xf7\x40\xb1\xdc\x37\xf4\x7a\x01\xac\x97\x35\x2b\xc0\x08\x0c\x95\xf6\xe8\x82\xad\xd8\x88\x16\x32\xa9\xc9\xe1\x50\xeb\x1f\xfc\x3c\x3c\xe9\x8e\x55\x6c\x44\x4b\xe6\xc8\xc9\x0a\x6c\x14\x2b\x72\xcd\x6a\xb0\xea\x96\xc9\x35\x56\xd4\x3f\xc9\x02\x8c\x00\x02\x77\x41\x74\x89\xa4\xd2\x32\xbd\x5e\xd0\x05\xbd\x26\x74\x35\x60\x6b\x73\x90\x3e\x55\xba\x26\x74\xc5\xd6\x93\x2b\xe3\x81\x8b\xd0\x1d\x3e\xad\x08\xdd\xe3\x13\x18\x50\x5f\xfa\x03\xbc\xa0\x97\x84\x7e\xf4\xef\x2b\xfa\x31\xb8\x59\xee\x02\x2d\x2b\xd4\x02\xbc\x02\xf3\x87\x91\xd5\x05\xdc\x99\x57\x8c\x47\x98\xed\xf1\xd5\x69\x5b\xc4\x7c\xd4\xd3\xbe\x1c\x25\x06\x24\x5b\x00\x2e\x86\xf3\x76\x7e\xa1\x77\x71\xfe\x9c\x5b\xee\xf0\x68\x22\x9f\xe5\xe0\x75\xda\x91\x38\xe0\x96\x5c\xcc\xe4\x7c\x3e\x11\x36\xaa\xcc\x88\xe6\x18\x8e\x30\x11\xf4\x96\xd0\x0d\xbb\x72\x7e\x96\x2f\x09\xbd\x61\x3b\xf7\xfa\x91\xd0\x7b\x08\x67\x98\xdd\x25\x23\x42\x35\x2a\x91\xdd\x25\xb7\xfa\xd0\xdd\x09\x4d\x7d\x67\x77\xc9\x86\xd0\xb5\xb8\x5e\xe3\xcb\x0d\x09\xbc\x9c\xae\x62\x23\x03\x34\x1a\x5b\x7c\xac\x35\x60\x11\x8b\x8f\xdf\xe7\xd5\xc7\xef\x8c\x93\x7a\x5f\xe9\x3a\xe2\x93\xfb\xca\x68\x3c\x3f\x4d\x78\x10\xd9\xff\x12\xee\xe6\x57\x25\xa0\xec\x54\x76\xc6\xfc\x57\x3e\xe6\x3f\x0f\xf6\xe8\xc0\xea\x6c\x93\xf0\xe3\x9b\x58\xcf\xcf\x5d\xc6\x9d\x9f\xbc\x5f\x79\x5f\xbd\x5b\x09\xde\x94\x9c\x97\x5e\x15\xbc\x10\xba\xb2\xee\x75\x5d\x31\x30\xfd\x55\xee\xd1\x14\x01\xea\xd1\x97\x01\xc3\x5e\xe5\x9f\x09\xf5\xfd\xc7\x62\xc1\x99\x53\xc1\x0b\x69\x08\xbd\x5f\x80\x6f\xda\xd7\x21\x01\x5e\x16\xb7\x3c\x99\x61\xeb\x68\x7f\xab\x82\x67\x17\xc8\x25\x70\xf7\x0b\x39\x73\x12\xda\xd1\xdc\x04\x54\x1d\xcc\x97\x42\x98\x61\xef\x9a\x7b\x78\xcb\x38\x45\x88\xa2\x10\xb2\x58\xdb\xb4\xa9\xad\xa2\x13\x49\xc6\x83\xed\x72\xdb\xf6\x66\x69\xe5\x45\x27\x3c\xab\xbb\x08\x72\x09\x37\xf1\xf1\xd1\xa1\xa2\xc6\xa5\x8d\x13\x72\x47\x8e\xf9\xaf\x5c\x9d\x82\xb1\x6c\x2b\x13\xa9\xaf\xe7\xad\xbf\x60\xb6\x32\x11\xd4\xb9\xb1\x0f\x9e\x96\xce\x71\x3d\x94\x5d\x70\x51\x24\x1c\x14\xe3\x13\xce\x92\x9c\x89\xa1\x24\xe7\x21\x4a\x70\xce\x01\x03\x29\x26\xf5\xb3\xd1\x84\x2c\x07\x03\x8b\x8e\xa0\x75\x66\x31\x58\x3e\xe5\x28\x6d\x0c\x34\xaa\x0b\x88\x88\xff\x6c\x3b\xa9\x06\x03\x52\x32\xa5\x81\x63\xc5\x18\xab\xa7\x49\x69\x06\xcb\x2a\x7a\xa2\x31\x92\x19\x20\x50\x22\xc0\x77\x26\x33\xa8\x3e\x4b\x5d\x00\xc2\xb3\x11\x6d\x29\x81\x5a\x58\x75\x36\xa6\xd7\x95\x58\x7e\x07\x9e\x63\xc3\xf2\x8b\x8e\x60\x22\x63\xd2\xa3\xce\x98\x3b\x1b\x1b\xc7\x1a\xc0\x6d\x3e\x1b\xc1\xdb\x0f\xf2\xa5\x15\x8e\xda\x24\x80\x07\xfa\xa5\x05\x10\xc6\x23\xfa\x0b\xaf\xca\xef\x82\xf6\xec\x7b\x57\x20\x93\x8b\x2f\x48\xcf\x15\xc0\x8f\x7e\x9d\xd7\xeb\x6c\x36\xef\x48\xfd\x01\x07\x67\x21\xf2\xb7\x6e\x84\x67\x63\xe3\xbc\xd9\xd6\xbd\x3a\xaa\xd3\x50\x98\x3d\x74\x94\xe9\x67\x64\x8c\x7e\x31\xd1\x40\x3f\xeb\xf5\xa8\x81\x39\xd9\xbd\x2a\x37\xd9\xe7\xd4\xc4\x9e\xff\xbc\x69\x60\xa0\x10\xde\xe3\x5a\xc8\x17\xea\x6f\xbc\x2a\x75\xf5\x1b\x21\x7f\x2c\x95\xb1\xf3\xa1\x37\xf9\xce\xbd\x7d\x31\xa2\x37\x42\x53\x4e\xba\x98\x6d\x76\x44\x8d\xf9\xa8\x4e\x0c\xd6\x25\xdf\xaa\xf2\xfd\x47\xb1\x09\x9f\xdf\xb9\x3a\xd0\x47\x37\x7a\x4b\x51\x67\xa5\x4c\x3d\x15\x81\x38\x5a\x4d\xf1\x10\xdf\x37\xe6\x14\xdf\xbb\x80\x0f\x3b\x19\x38\x8e\xb6\x93\x8b\xae\x3b\x47\xf4\xda\x07\x4f\x39\x62\xb4\xde\x43\x2c\x7a\x20\xc1\xcc\x30\xbe\xe3\x2b\xa5\x6f\x46\x3d\x47\x61\xfa\x87\x72\xa3\x93\x31\x70\x7f\x98\xf1\xa3\x4e\xd1\x59\x66\x3e\xc3\x3c\xf4\xe9\x02\x37\x2b\xb5\x48\xe3\x49\xd1\x2d\x2c\x02\xca\x7d\x61\x29\x4f\xa9\xc9\xa3\x95\x2f\xa8\xea\x75\x72\xff\x8d\xc9\x52\x62\x04\x1c\xaf\xcb\x4a\xfc\x52\x4a\xa5\x71\xe5\xa9\x32\xf1\x53\xea\xcc\x46\x1c\xd1\xd8\x90\x0a\xec\x9c\x1a\x7a\xa4\xc8\x1b\x87\xd3\x0b\xb5\x08\xc3\x9c\x80\x1d\x12\xf5\x27\x2c\x4f\x41\x90\x34\xef\x52\x8a\x3c\x1d\xa2\x0d\x3c\xca\xba\x3b\x54\xf7\x8b\x2e\xd9\x36\xad\x73\x4d\x6b\xa3\xe7\xc9\x55\x52\x44\xdf\x49\x08\x2d\x34\xb0\x42\x1f\x0e\x0a\x5f\x5e\x1b\xdb\x0b\x78\xab\x20\x3c\x9a\x0f\x49\x08\xfb\xc0\x2e\x2f\xae\xbe\x5b\xd1\x51\x43\x25\x44\x4d\x86\x8f\x23\xd7\xaa\x48\xa3\x97\x4b\x3c\x6a\xe2\x17\xee\x93\x6e\x72\x9c\x6b\x38\xc7\x1a\xf5\x4c\x8b\x52\x5e\xf3\x1a\x57\x17\xbb\x16\xa4\x82\x27\x98\x7c\xb1\xe6\xec\x38\x09\xf8\x5d\x45\x7a\x69\x01\xdf\x1b\xc5\x6f\x5a\xdf\x8e\x92\x70\x2e\xde\x73\xf5\xb5\xb8\xe1\xb2\x36\xda\xd8\x45\x5a\x1f\xa5\xa0\x2e\xdc\x51\xb2\x17\x7d\x7f\x27\x6e\x84\xc2\xc4\x25\x57\xbc\xba\x11\xb2\x9d\xee\x04\xdd\x41\x9a\xf1\x33\xb0\x15\xc5\xd2\x90\x4a\xb4\x64\x05\x2a\x7c\x9a\x04\xe0\xca\x25\x67\x89\x4e\x47\x97\x03\x3e\xb3\x24\x87\x83\xfe\x77\x66\x5d\x4d\x91\x7e\xdf\x4c\x39\xe0\xea\x25\x86\xa8\x1b\xd1\x9c\x99\x64\xe7\x63\x05\x90\x4d\x41\x4a\x63\x23\x80\x5e\x7f\x4d\xa1\x99\x98\x1b\xd8\x71\x36\x6e\xdc\x45\xec\x56\xb6\xa4\x35\x5b\x3e\x2b\x3d\x51\x57\x80\x8e\xe2\x2d\xaf\xf0\xe8\x7e\x28\xf1\xc8\x24\xf5\x34\xc6\x90\xad\xfc\xd1\x44\xce\xb3\x57\xf2\x39\xa7\x1d\x5c\xf4\x7c\xc0\x04\xb1\xa1\xe8\x66\x11\x89\x3e\xf7\xb1\x82\x9b\xa4\xa4\x4b\x92\x95\xb0\xf1\x42\x45\x49\x3b\xb7\x2f\xf3\x62\xb1\x2d\x72\x05\x47\xd5\x42\x66\xc8\x5f\x9c\xcc\x81\x69\x3e\x5d\xd1\xf8\x17\x17\x0a\xde\x56\xe6\x17\x7d\x86\x9b\x17\x9c\xa9\x0f\xe5\xd7\x55\x7e\xc7\xb6\x16\x03\xee\xf7\x93\x6d\x6a\x61\xfb\xe1\xd0\xd3\x8f\xe0\x34\x32\xad\xcb\x6d\xb5\xe0\x64\x5a\xa4\x97\x36\x3f\x29\x49\x56\xd2\xba\xdf\x4f\x4e\x4f\x70\xfc\x21\x42\xdc\x79\xab\x6c\x87\xc2\x83\x2e\xa4\x3e\x79\x0d\xf5\xf3\xf4\x09\xd9\x62\x04\x4d\xac\xd7\x83\x89\x6c\x43\xcb\x44\x01\x45\xbb\x52\x20\x74\x44\x07\x92\x19\x24\xaa\x72\x03\x69\x08\x21\xa8\x60\x67\xe0\x73\x24\xa0\xec\x99\xd2\xef\x96\xb2\x07\xcb\xcc\x4b\xf3\x21\x48\xa9\xc1\x84\xf3\x12\xf7\x05\xe3\x43\x65\xc2\x22\x3d\x1e\xb0\x06\xc5\x3d\x5c\xed\x38\xf9\x8f\x07\xd2\x51\x35\xdf\x66\x7d\xaa\xb5\x48\xb4\x78\x34\x77\xc6\xa4\x4d\x39\x40\x0c\xa8\xea\x4a\xb1\x11\x55\x38\x99\x36\x58\xb3\x9e\x54\x1f\xbf\xd9\x81\x6a\x30\x24\xdf\x40\x71\x9c\x67\xa6\x02\xa5\xe3\xe0\xbe\x86\x22\xfe\x9e\x0e\x5f\xe1\x76\x0e\x13\xf0\x4a\x66\x23\x33\xdd\xbf\x7a\xa6\x8e\x6b\xb5\x27\xdf\x43\xc2\xc7\xcf\xbc\xaf\xe3\x5b\xeb\x00\xb7\x51\xec\xab\x5f\xf7\x9d\x56\x95\x76\xa7\x3d\xec\x7d\x7c\xa7\x7d\x9d\xa0\x35\xdf\x4e\x14\xda\xab\xa3\xf9\x58\xa3\xd7\xc0\xbd\x5a\x02\xa3\xd6\x02\xcc\x69\xd0\x03\x1e\x8f\x25\xfc\x3a\xa7\x6a\x4e\x32\x70\xae\xac\x81\xc4\x63\x2b\x99\xf2\x73\x72\x38\x98\x47\x14\x66\x9b\x90\x1f\x8b\x8f\x06\x1e\xbd\x04\x10\x55\x47\x2a\x90\x9f\x9a\x9c\xce\xea\x7e\x9e\xba\xa0\xde\xc3\x66\x91\xd8\x41\xa3\xf7\x08\xc3\xf4\x8f\x60\x62\xa7\xd2\x6d\x0d\xd0\x1d\xfa\xa4\xb1\x3a\xdb\x3f\x6a\x54\x40\x4c\x2c\x92\xdf\x38\xae\xd3\xb5\xdb\x9b\xa9\xf3\x86\x79\xfc\xd4\x75\x56\x0f\xa6\xee\x53\xad\x3b\x80\x1f\x7a\x21\xd6\xa0\xbf\x60\xde\xc8\x74\x6b\x11\x07\xba\x64\x75\x07\x47\x77\xcd\xb6\x69\x40\x00\x69\x04\x7f\xa1\x93\x3c\x15\x04\x2c\xbb\xb3\x3a\xbd\x14\xb5\xd5\x1e\xd2\x18\xcb\xd6\x47\xc1\x5f\x3f\x67\x8b\xc3\x61\xf9\x8c\x8d\x0f\x87\xb3\x23\x5c\xdc\xa0\xea\xb6\x39\xb6\xce\x12\xf0\x63\x80\x8a\x94\xdf\x39\x84\x32\x21\xe0\x8a\x9c\xd7\x06\x5a\x82\xad\xa8\xe1\x6e\x59\x1f\xc8\x3e\x01\xe9\x47\x2a\x3c\xbf\xa0\xf6\x00\xd8\x12\x0f\xc6\xd7\x30\xa1\x7c\xf0\xe5\xf3\x44\xe3\x50\x58\x6d\xea\xcb\x9e\x2f\x33\x71\x9e\x2c\x87\x63\xf0\xef\x93\x33\x78\x49\x5c\xc1\xf4\x8b\x6c\x0c\x12\x92\xda\xc3\xeb\xe1\x4a\x26\x45\xea\x88\x73\x32\xdc\x5a\xa0\x3b\xbc\xd6\x39\x9e\x4a\x75\x36\xd7\xf5\x3f\x2a\x95\xf0\xa7\x7c\x20\x9f\x4a\x42\x57\xec\x75\xaa\xca\xaf\xf9\x75\xc5\x79\xed\x65\x14\x28\x30\xa9\xed\x93\x4e\x4a\xda\x33\x30\xf8\x92\x9c\xe7\x54\x77\xa9\xa3\x74\x75\x5e\xea\xac\xa1\xcf\x92\xe7\x25\x21\xfa\x83\x8e\xad\xb1\xa6\xae\xf8\x82\xae\x74\x66\x7b\x7d\x56\xf6\x04\xfd\xe6\xed\x7d\xba\x76\xfb\x04\xbd\x12\xea\xf1\xe7\xe5\x95\x50\xbe\xfe\x4a\x9c\xb0\xf8\x01\xa5\x13\x83\x2a\x59\xdb\x70\xc7\x0f\x1e\xa1\x99\x35\x7a\xe7\x16\x81\x5d\x72\xce\x84\x39\x26\x15\x13\xc1\x0a\x42\xac\x10\xb7\xd4\x28\xd6\x08\xce\x01\x2d\x42\xf7\xe4\xde\xf0\x15\x3c\x7f\xc7\xe7\x40\x53\x6f\xdb\x29\x3f\xc2\x16\xb2\x1a\x54\x12\x30\x79\x25\x93\x92\x0c\xae\x65\x52\x11\x42\xb7\x53\xcc\x32\x18\x43\x98\xe7\x9c\xa6\x45\x78\x44\xee\xf1\x54\xe3\xae\x76\xc9\x6e\xc0\x68\x41\x7f\xb0\x7d\xda\x20\x38\x0d\x30\x98\x21\x32\x4d\x91\xd7\x0a\xe2\xd2\xe0\x19\x84\x88\x34\x66\xef\xd1\x1b\x96\x7e\xfe\x74\x89\xac\xc6\x90\xef\x79\xcb\x72\xbb\xf5\x61\x78\xf8\xd1\x2b\x06\x82\xc4\x78\x5b\xd1\x1d\x6c\xfa\x1f\xf3\xa5\xc8\x65\x9d\xb4\x72\x09\xdd\x1b\x66\x5e\x59\x27\x3b\x27\x01\xd0\x5b\x78\x47\xe8\x47\x76\xf9\xd4\xb8\x9a\x1e\xec\x9f\x26\x1b\x0b\x0d\x92\xab\xe9\xc6\x1c\xd4\x6c\x44\xc8\x20\xb9\x9a\x8e\xb2\x1b\x32\x71\x93\xe3\xb6\x7a\x38\x4b\xce\x85\xfc\xc7\xc1\xad\x89\x4f\x43\xbf\xa7\xef\x3b\xe4\x58\x23\x32\x44\x54\x8e\xbe\x64\x06\x95\xeb\x90\x39\x75\xc8\xc9\x86\x63\x32\xb9\x9a\x26\x77\xac\x98\xee\x9f\x2e\x4c\xcf\x2f\x9f\x2e\x6c\x67\x2f\x9f\x26\x0b\x3b\x08\x9b\x48\xe8\xf7\xac\x98\x5e\x3e\x4d\x6c\xa0\x9e\xe1\xca\xe6\x64\xfb\xa7\x2b\xd7\x88\x4f\x4d\xee\xd8\xc2\xba\x51\xa7\xdf\xb3\x95\xf3\xba\xdf\xc2\x1a\xdd\xd9\x4f\xee\x86\xef\xc9\x53\x1b\xe0\xcc\x62\xae\xc3\xf7\x84\x8e\xc8\xe0\xb3\x36\x32\xe9\xab\x7d\x3f\x7c\xd9\x51\xed\x25\x56\xf3\xa2\xd8\x77\x2c\x4f\x91\x5d\x36\x1d\x65\x76\xbd\x6e\x07\x37\x13\xbb\xbb\xe3\xe5\xb0\xc1\x9f\xb1\xdc\x3b\x12\xe3\xb6\x0b\xef\xdd\xbf\x8d\xd3\xae\x5c\x56\xd3\x28\xa3\x3b\x84\x6e\x42\xf5\xae\xde\x86\x28\xb9\xa5\x3b\xa4\x0b\xdd\x66\x58\x22\xb0\xac\xc1\x6b\x65\x10\xf1\x96\xe3\x0a\xc0\xd8\x23\xf7\x16\x34\xc0\xe0\x7d\xeb\xee\x7a\xb2\xed\xa9\x72\x13\xbc\x19\xff\xfb\x56\x01\xc8\x74\xf6\x24\x89\x61\xaa\x81\x02\x7e\xd8\x5f\xbf\x24\xd1\x02\xd3\xb8\x14\xa1\x51\x37\x3a\x2a\x7d\x28\x37\x71\x99\xb0\x4a\x15\x2f\x7e\xbc\x29\xda\xe5\xc2\x8a\x86\x76\x39\xae\x69\xc2\x30\x1e\x4d\x87\xbd\x67\x1e\x79\x09\xd8\xb2\xfe\x0e\x08\x01\xec\x27\x90\x4a\x0b\x98\x27\x0f\xfb\xc9\xa2\xa2\x7e\x65\xe3\x92\x7f\x4a\xfd\xd8\x05\x30\x07\x9e\x27\x4c\x0f\x9a\x2c\x84\x98\xbf\x58\x25\x85\x7e\xb0\x02\xe7\xb7\xf9\x5b\xf0\xa8\xd2\x93\x10\xce\xf6\x74\x1c\x56\x0c\x77\x4b\xfa\xfd\x33\x51\xbf\x12\x52\x28\x7e\xdc\x0a\xe8\x6a\x74\xb0\x3f\xe1\xb3\x4e\x42\xa3\xd2\x1d\x09\xfb\x1f\x75\x16\xdc\xd0\x36\xd6\xc5\x7b\x50\x67\xff\x60\x9d\x3d\x09\x34\xc7\x3b\x59\x1b\xa7\xa2\xa4\xe1\x1e\x07\x01\xb9\x45\xef\x4f\x39\xce\x78\x62\x22\x44\x43\xb0\xba\x87\xc8\x8d\x84\x80\x0b\x83\x4e\x0e\x8b\x22\x87\x83\xf9\x12\xcd\x1f\xc0\xed\x13\x42\x4f\x88\xba\xd4\x4c\x1a\xb1\x3d\xe3\x33\x39\xf7\xc6\x77\x34\xbe\x51\x1f\xd4\x81\x75\xa5\x5c\x75\x70\xbe\x12\xf2\x53\x39\x5b\x68\xc8\xb8\x50\x3b\x7a\x23\x03\xfd\x5f\xe4\x06\xd2\xf0\x9a\xa1\xea\x88\x75\x1a\xa4\x05\x7c\x57\x1e\x61\xd4\x84\xea\x4e\x83\x10\xf5\x78\xa7\x06\x6b\x14\x11\xa9\xd3\x84\xb3\x41\xd7\x26\x00\x8d\x20\x79\x22\x6f\x3c\x27\x21\x6e\xce\x29\x58\x96\x3a\xd8\xa0\xdf\x35\x21\x6b\x63\xb6\x49\xa6\xba\x1b\xd2\xad\x28\xbd\x6b\x08\xbd\xbf\x11\x32\x13\xf4\x26\xdf\x65\x39\xaa\x7d\x65\x9c\x72\xb9\xcc\x64\x63\xac\xc8\xbc\x74\xe8\x84\xc6\x7f\x30\x76\x6f\xb8\xf0\xc4\xed\x7a\x9e\xa2\x8e\x6a\x6f\xd6\x1b\x98\xe7\x41\x8f\x3e\xd1\x2f\x5c\x2e\x07\xbd\x79\x2f\xeb\xee\x24\x40\x80\x28\xce\x9a\xe5\x0a\x04\xc8\x02\x4e\xb9\x4f\x87\xf7\x57\x65\x05\xf9\x1d\xe5\xf5\x5a\x9f\x1a\x88\xdd\x1b\x86\x0a\xb2\x11\x30\x42\x0e\x31\x15\x6c\x7c\xee\x26\x5c\x0e\x13\x3e\x1d\x65\x63\x42\xc7\xfe\xe8\x3e\x1b\x1d\x0e\xea\xb9\x1c\x8e\xa7\x72\x5b\x14\x99\x1d\x9a\xed\xc0\xd7\x7c\x21\x6e\xf2\x22\x51\x4f\xc5\x20\xe1\x53\x71\x7e\xa1\xb1\xac\x86\x1e\x17\xe9\xd4\xb4\x83\x1e\x45\xac\x40\x34\x36\x1d\x5a\xef\xb4\x01\x17\x71\xa0\x9e\x62\x92\x89\xf7\x05\x16\x40\xd8\xb6\x9b\xa1\x2e\x67\x78\xc3\x76\x43\xe4\x3c\x6c\x67\x72\xba\x2f\xd3\xf1\x90\x67\x1c\x83\x80\xe6\x26\xf1\x14\xc4\x6f\x2f\xa2\xb3\x78\xd4\x35\x6d\x30\x71\xd7\x54\xeb\x68\x45\xd7\xd1\x8d\x90\xd6\x20\xfe\x26\xdf\x45\xdd\x0b\x84\x92\xd3\x51\xa6\x9e\x8d\xfa\x7d\xfe\x6c\x34\xe5\x99\x7a\xae\x1f\x9f\x8f\xa6\x40\xbe\x38\xf6\xf2\xe9\x30\x94\x1d\xec\x14\x5a\xb2\x70\x5e\xc0\x79\xcb\x4d\xbe\x03\x70\x02\x8c\xb2\xc3\xa1\x3c\xf7\x7b\xe8\x3d\x58\x04\x0c\xc6\xb4\x80\x72\xa1\x0a\xc1\x23\x8c\x4b\x55\xdb\x3b\x86\x9a\x71\xa3\xeb\xe4\x7c\x1a\xf1\xc0\x93\x65\xa2\x48\x36\x9b\x03\xa7\xc2\x74\x6f\xc9\x8a\xd9\x68\x4e\xd7\xac\x98\x6d\x87\x63\xf0\xc6\xb2\x7d\x5e\x93\x0e\xad\x9d\xd8\x19\xfd\x88\x96\x8c\xcf\x46\x56\x73\xc1\xab\x07\x48\x0d\x47\x46\x13\xf1\xcc\xf5\x4d\x0c\x06\x24\x67\x6a\x26\xe6\x54\x30\xc6\xca\x69\x92\x5b\xa9\xbe\x80\x56\x06\x83\xea\xa9\x9c\x3b\x41\x7e\x6e\x04\xf9\x89\xa2\x05\xdd\x9e\xd7\x84\x82\xca\x04\xd8\x57\x9f\x0a\xca\xe5\xc4\x7b\x27\xec\xb3\x03\x7f\xf9\xe2\xd9\x85\x8f\xd4\x8f\xbd\xd7\x00\x96\x72\x36\x9e\x70\xb8\x88\x38\x5c\xf6\x33\x3e\x1f\xaa\x19\x1f\x8e\xe7\x67\x8c\x49\x5f\xc5\x8b\x53\x14\xa1\x5b\x96\x70\x4f\x89\x9c\x8b\xc8\xff\xd1\x13\x07\x13\xb6\x1a\x16\xa0\x94\x69\x44\x6b\x96\xe4\xec\x75\x7a\xa3\x33\x2f\x57\xf9\x42\x69\x7c\x82\x27\x45\x68\xce\x5a\x3e\xab\x27\xe5\x60\xa0\x3b\x92\x54\x2c\x9f\x95\x73\xf2\x7c\x6b\x9b\x75\xea\xe9\x51\xfb\x4d\x52\x50\x45\x47\xb4\x26\x74\xfb\x7c\x84\x12\x24\xdc\x25\xdb\xe1\xd8\x6d\x10\xd0\x0b\x11\xb4\x98\xf1\xb9\xfe\x33\x18\x7b\x01\x51\xce\xb6\xcf\xc7\xd3\x64\x3d\x5c\x92\xf3\x64\x3b\x1c\x13\xb4\xa4\x33\x35\xda\x06\xed\x39\x99\x8e\xb2\xe5\x30\xa7\x4b\x62\x8b\xac\xbb\x0a\xd9\xa9\xcf\xd6\x83\xdc\xac\xa4\xd5\x09\xc3\x6a\x36\x91\xba\xf3\xf0\x18\xae\x25\xe5\x31\x91\xab\x0b\xb4\xe8\x5c\xe9\x7d\x9a\x3b\x82\x97\x13\x77\x59\xba\x74\x4d\xfa\xea\xf4\xdc\xdb\x1c\x47\xc4\x7b\xc5\x54\xda\xd2\x32\x00\x45\x37\x96\x4f\xf3\xe8\xd2\x1f\x54\x10\xaa\x43\x27\xb7\x58\x49\x55\x36\x8a\x20\x50\x9b\x6f\xf7\x54\x3e\x2f\x9f\x8a\x69\x79\x2e\xb3\xfa\x5c\x64\xf5\x53\xf1\xac\x7c\x2a\xa7\xf5\xb9\xcc\xca\x73\xd1\x50\xcf\x08\x39\xc1\x95\x0c\xe4\xf8\x76\x1c\xce\xe9\x23\x32\x2a\x20\x72\x11\x48\xd1\xce\x18\xcb\xed\x76\x3e\xcb\x03\xd7\x54\x27\x3c\xef\x58\x2b\x38\xf0\x6f\x70\x64\xde\x08\x4a\xd0\xe0\x80\xe0\xd8\xb5\x54\x6a\xec\x33\x35\xc2\x8f\x66\x80\x87\x83\x4c\xf7\xed\x44\x8b\x6c\x9f\xd9\x49\x3a\x1b\x03\xaa\x7b\xb3\xd9\x2a\xfe\x6d\x28\xa0\x7e\x5c\x40\xe0\x50\x81\x94\x5e\x21\x6e\xb8\x63\x57\x66\x7a\xf6\xec\xca\xf1\xa2\x2e\xd9\x3e\x60\x39\x7d\x64\x7b\xcf\x56\xba\x63\x97\x69\x4b\x73\x86\x7e\xcf\xae\x5a\x4b\x47\xdf\xb3\xab\x48\xb0\x48\x5f\xb2\xf7\x36\x5c\x66\x72\x37\x1d\x67\x23\x42\xdf\xb1\x95\x4c\x2e\x09\x7d\xc1\x66\x73\xfa\x35\xbb\x4c\xbd\xce\xd0\x74\x29\x93\x4b\x1f\x1c\x82\x8e\xe8\x88\x64\x23\xfa\x81\x7d\x7d\x7e\x41\xdf\xb0\xd7\xe9\x65\x5e\x88\x6b\x89\x9a\xbd\xaf\xba\xbc\x1a\xbf\x49\x76\x54\xd1\xaf\x49\x03\x0b\x69\xa9\xad\x8f\x1a\x97\x7c\x95\x5c\x59\x02\x90\xd6\xcc\x3e\x0f\xdf\xd1\x2d\xe3\xc3\x0f\x74\xcd\x5e\x25\x20\x0f\x23\x83\x0f\x10\xf8\xca\x94\xcd\x02\x3a\xcd\xb5\x03\xa4\xeb\x1a\x34\xba\x37\x74\x05\x35\x4d\xf1\xe1\x07\x5a\x33\x3e\xf8\x40\xb7\x0c\x8a\x0d\xde\x05\x41\xff\x5c\x7d\x43\xc3\x96\xcc\x3c\x0e\xdf\xd1\x02\x7a\xb1\x84\xb6\x80\x9a\x1e\x7c\xa0\x0b\xcb\xfd\x01\x94\xf5\x95\x09\xc8\x42\xe8\x92\x19\xf6\xd0\x02\x8a\x63\x91\xe1\x07\x7d\x7d\x0c\x3e\xd0\x82\x61\xb9\xc1\x3b\x20\x2d\x26\xf2\xd9\x4b\xa0\x27\x04\x7b\x3f\x93\x73\x54\x75\x90\x89\x09\xe8\x4d\xfa\x7d\xf9\xec\xbd\x0b\x44\x90\x48\xc6\xd8\x55\x1a\xa9\xf8\xf4\xfb\x7b\xb3\xf6\x8c\xb1\xbb\x69\x72\xcd\x2e\xd3\x48\x4f\x8b\x6e\x82\x14\x54\x2b\xbc\x09\x52\xbc\x12\x16\x68\x24\xdc\x76\x66\xa1\x86\xd2\xe1\x30\x22\x59\x72\xcd\x5a\xfb\x40\xd2\x31\xa1\x1b\x4c\x5d\x60\x08\x11\x7a\xa4\x87\x46\xe0\xa3\x57\xc7\x1f\x6b\x2b\x74\xe9\x8f\x50\xaf\x47\x98\xb3\xb5\x4c\xae\xa8\x8d\xcc\x7c\x38\x48\x7a\x07\x3c\xf9\x8a\xe9\xdd\x94\xd3\x6b\x42\xbf\x9f\x96\xac\x60\x4b\xb6\x60\x55\x56\xb3\x2d\x5b\xb3\x15\xab\xe8\x0b\xa3\x02\xa1\x76\xe3\xac\xa4\x6a\x3f\xce\x6a\xaa\x76\x17\x59\x41\xd5\xfe\x22\xdb\xd2\xdd\x38\x5b\xd2\xfd\x38\x5b\xd3\xdd\x45\xb6\xa0\xfb\x8b\x6c\x45\x91\x2f\x7c\x6d\x94\xe9\x36\xa1\xee\xd9\xcd\xb1\xea\xd9\x6d\x13\xa8\xf3\xbf\x40\x58\x8f\xca\x72\xec\x25\x7d\x61\x46\x06\x78\x1f\xe3\xf4\x85\x07\x13\xdf\x39\x8d\x95\x4f\xc8\x6d\x3c\x90\x40\xc0\xb0\x62\x0b\x07\x0e\xae\xd9\xca\xdc\x2e\x1b\xb6\xf2\xa0\xe0\x86\x5d\x1b\x6e\x1b\xbd\x65\x8b\x36\x04\xb8\x62\x8b\x18\x02\xec\xd8\x8d\x4c\xae\x09\xdd\xb3\x6b\xcb\x97\xa1\x97\x1a\x04\xac\x02\x49\x06\xfd\xc8\x86\xe1\x05\xb6\x68\xdf\x5e\x2e\x92\xb7\x3d\xd2\x9b\x69\x52\xb1\x85\x3d\xc4\x97\xc3\x3d\x2d\xd9\x47\x13\x82\xd3\x85\x06\x8d\x0e\xaf\xa9\xa1\xcf\xe4\xe5\xc0\x14\xc7\x18\x2d\x61\x79\x7b\x54\x37\xd3\x24\x67\x0b\x73\x3a\x93\x9b\xe9\x28\xbb\x24\xf0\x91\x1b\xf7\x11\xac\x0c\x51\xec\x16\x78\xe0\x4c\xb9\x81\x29\x67\x5b\xc7\x38\x5f\xc6\xb8\xfa\xea\xe8\x22\x61\x89\x64\x57\x33\x35\x27\x56\x47\x5f\x26\x82\x1c\x0e\x49\xcd\x16\x47\x3c\x5f\xe9\x76\xa9\x22\x03\x13\x4f\x19\xf7\x8a\x46\xbe\x0a\x70\xe2\xfb\xf7\xb2\x9a\xee\x8c\x6a\xff\xce\xa8\xe9\x86\xcc\xf3\x25\xab\xf5\x17\xa6\x56\xa3\x3c\x1b\xd3\x5b\x3d\xda\x9a\x9a\xf0\x9f\x38\xfc\xe4\xe3\x74\x9c\xa5\x5f\x90\xe1\x92\x3c\xdd\x66\xc9\xc7\xe9\x48\xbf\x3d\xdd\x92\x2c\xa9\xa0\x6c\x32\x86\xac\xf3\x0b\x42\x4d\x20\x75\x1b\xb9\x94\x56\x56\x68\xf3\xd1\xc4\x0e\x17\xa0\xeb\xac\x4f\x06\xdf\x29\xb3\xb9\xd7\xd4\xc5\x52\xca\xca\xc0\xbb\xd0\x9d\xf1\xe0\xa1\x6f\x9a\x47\x78\x31\xf6\xdb\x08\x9c\x38\xd9\x1b\xfe\x58\xd7\x8d\x03\x8b\x63\xab\x7f\xe1\xea\x5b\xb6\x2e\x94\x35\x93\xad\xbb\x48\x1e\xdf\x45\x0b\xc6\x5b\x6a\x62\xe8\xf5\x21\xd6\x1c\x03\xdf\x6b\xc7\x17\x36\xd8\x43\x79\x53\x88\x45\xdb\x14\x42\x30\xbd\xe8\xe0\x7e\x14\x79\xbe\x39\xab\x0d\xc0\x13\xfd\x7e\xde\xef\x27\x85\x8d\xfe\x52\x04\x31\x94\x04\x2d\xa2\x68\x4b\x39\x6a\xa2\x7d\x07\xaa\x0d\xf5\x1a\xab\xf9\xf7\xa4\x0e\x40\xa2\x6d\xc9\x03\x1d\x56\x1f\x41\x4c\xd4\x5d\x0a\x83\x29\x39\x35\x5e\x68\xdc\x84\x56\xaa\x53\xb5\x1b\xd3\x3a\x55\xfb\xb1\x6d\xd6\xa4\x5e\x40\xea\x05\xb1\x55\x03\xa5\xe0\xb8\x01\xa8\xdf\xaa\x0e\xb5\xa1\x72\xe1\xc2\x03\xd1\x22\x88\x10\xa3\xd7\x7d\x8d\xeb\xed\x4d\x65\xd6\xf4\x8a\xb5\x96\x70\x11\x02\xd0\xe1\x58\x5f\x2a\x3b\x80\x21\x0e\x8a\x4e\xf8\x91\x9a\xcd\x8a\x2d\x21\x06\x35\xdc\xb7\xb7\x64\x78\x7b\x7e\x41\xaf\x4d\x1a\x32\xef\xaf\xc8\xe0\xea\xfc\x82\x6e\xd8\x0d\xdb\x91\x2c\xd9\x98\x4c\x8d\x1a\x98\xf2\x37\x26\xc9\x68\x33\x99\x0a\x2b\x76\xcd\x76\xf1\x4a\xae\x5b\x2b\x09\x03\xc0\x0d\x30\x6a\x2f\x82\x9b\xb5\x15\xdd\x04\xf3\x75\x4d\x6f\xc2\x89\x6a\x1a\x73\x9a\x1e\x52\x81\x00\x9e\x6e\x4b\x69\x2b\x3a\x46\x47\x77\x86\x71\x87\xbb\x74\x3c\x46\x7b\x14\xa2\x77\x88\x42\xdc\xbe\x91\x12\xe2\xfd\x5b\x0a\xb6\xf4\x34\x3b\x12\x9b\xf7\x62\x95\x94\x2c\xa9\xd8\x72\xc6\xe7\x04\x2c\x15\xe8\xd6\xee\xfa\x2d\x7a\xa2\x2a\x72\xc5\x93\x2a\xdd\xd1\x2a\xdd\xeb\x44\x00\x37\x3a\xa5\x72\x17\xc6\x16\x6d\x99\x4a\x6b\xc9\xb4\x0d\xa2\x95\x95\x66\x4a\xb7\x27\x02\xb6\x6d\x83\x80\x6f\x95\x7f\x06\xe6\x85\x35\xa3\xc2\x74\x03\x7a\xbd\x8d\x93\x04\x95\xc9\xda\xb3\x72\xd1\x32\x67\xeb\xe3\xb0\xf5\x7a\x83\x7a\x26\xe7\x74\x44\x0b\x42\x8b\x01\x2b\x03\xd0\x8c\x21\x4f\x83\xc2\x35\x14\x9b\x6c\xfd\x66\x77\xeb\xd9\x8a\x27\x7a\xc4\xfa\xd5\xcb\x23\x03\x41\xb3\x60\x32\x10\x2f\xa3\x7b\xb6\x68\x8d\xd1\x55\xc1\x56\x63\x86\x8f\x30\x0f\xd1\x38\x74\xa7\x81\x8c\x20\xb4\xe8\xb4\xc9\x11\xde\x26\x67\xab\x67\xc8\x8d\xfa\xfc\x02\xe2\xd1\x3a\x04\x63\xcd\x46\xb8\x21\x5b\xc2\x85\xdc\x60\xbd\x03\xe5\x84\x8f\x55\x1c\xa4\xdb\x62\xe1\xc3\xed\xb0\x48\xad\xba\x38\x86\xa2\x1e\x14\xfa\xd7\x3b\xdd\x5d\x30\x77\xd5\x2f\x27\x39\x5b\x4c\x4d\xdb\xa6\x60\x66\xc5\xae\xba\x25\x7d\x92\xd1\x7c\x73\x33\x50\x5e\x3c\xb8\x66\x8b\xe9\x30\xfd\x02\x8d\x40\xdf\xbd\xc9\xfc\x63\xc3\xed\x86\xe5\xc1\x86\xcd\x69\x05\xa6\xff\xb8\x59\xd7\xc4\x84\xbd\xc2\x7d\xe6\x62\x32\xf3\x13\x9b\x92\x87\x1b\x98\xa2\x39\x0f\xab\xed\xf6\xe6\x7e\xcf\x18\xc4\x1e\xcd\x12\xe0\xda\x42\xf7\x19\x76\xff\x1c\x7b\xee\x43\x42\x3f\xd0\x2e\xe8\xf7\x91\x85\xe0\xae\xe1\xc4\xb1\x4e\x7d\x10\xb0\x30\xc5\xc8\x3c\xc0\xf9\x16\x3a\xde\x7b\x94\x4b\x76\xa3\x14\xd6\xef\x9b\x87\xf4\x17\xf0\x4d\xc4\xb8\xbf\xd3\x75\x9e\x7b\x81\x7c\x6f\x1e\x16\x77\x59\x9e\x31\x26\xfa\x7d\x34\x39\x03\x17\x04\xd0\xb5\xe9\xec\xfe\x97\x4c\x1c\x45\x93\x53\x7e\x70\x1d\xde\x50\xa8\x0a\x46\xda\xe5\x2d\xa5\xa1\xf7\xbf\x64\xf2\x54\xab\x38\x1d\x9d\xf5\xe6\xd9\xec\x44\x4d\x9d\xd2\x5d\x05\xa5\x0c\xdf\xe7\x6a\xb1\x16\xf2\xda\x0c\xf9\x84\xcb\x3d\x8f\x1e\xb5\xb4\x40\x5c\x54\x16\xd4\x50\xfa\x84\x2f\x39\xbd\x9d\x14\xaf\x92\xc8\xf9\x0b\x36\x91\x9c\xa9\xc3\x41\x58\x8f\xe9\xc0\xf0\x90\x53\x11\x30\x37\x78\x2a\x96\x99\x08\x18\x1b\x3a\x81\x34\x84\x34\x0d\x99\xec\x02\x0b\x77\x9c\x2c\x16\x25\xe9\x14\xd0\x8d\xd8\x4b\xb6\x93\xf4\xf2\xd8\xd0\xf8\xa3\x64\x7b\xe9\xec\x0a\xba\x94\x38\x8f\x49\x1e\x3f\x2b\xde\xfa\x23\x21\x34\xf0\x3d\x6f\x25\x75\x4c\x00\xaf\xbc\xd2\xbf\xf9\x8e\x22\x87\xd2\x9b\x47\x4f\x1c\xdd\x98\x83\x50\x41\x3a\xab\xc9\x9c\x90\xe7\x6c\xd4\xef\x27\x25\x53\x01\x79\x59\xb5\x8a\x55\xb6\x58\xad\x8b\x81\xe5\x2d\x10\xd9\x70\xa6\x6f\xf2\x1d\xbe\xd4\x98\xc3\xe4\xac\x9c\x63\x3a\x93\xb3\x7a\x1e\xa9\x7f\x76\xb3\x05\xa3\xf1\x71\xc7\xfc\x87\x66\xad\xb8\xc6\x7e\x26\xd4\x75\x1c\xa1\x87\x18\x09\x07\xc7\x1b\x83\x67\x0a\xc3\x81\x25\x9c\xca\xc1\xb8\x4b\xde\xd4\xe5\x12\xd0\xb3\xdf\xbc\xeb\x90\x07\x5d\x4f\x5e\x5a\xb1\x14\x88\xd2\xde\x2c\x13\xe2\xf9\x61\xd3\x0e\xc9\x57\xcb\x43\x15\x78\xe2\xcc\x55\xae\x89\xa8\xec\x68\x1a\x66\x6a\xfe\xa0\xea\xfa\x11\x64\x0a\xa4\x5b\x46\x29\x74\x1f\xed\x5a\xd7\x14\xba\x0a\x01\x65\x84\xf8\xb4\x21\x22\x14\xab\xa3\x9f\xb5\x53\x30\xc6\x85\x35\x57\x47\x2a\x5e\xb9\xc5\x1a\x26\x7c\x9a\x7e\x91\x41\x8c\x99\x4b\x10\x47\xff\x98\xcb\x6b\xee\x45\x99\x6e\x53\x7a\x01\x5b\x2c\x23\x6b\x8b\x5a\x8f\xfd\x12\x85\x8a\xc1\x97\x12\x5c\x6b\x5e\xca\x44\x42\xf7\x35\x96\xe4\x0c\xa1\xfc\x4c\x4b\x33\xd3\x7c\x4e\xe8\xa5\x04\x29\x77\x22\x58\xd9\x61\x07\x95\x29\x8d\x9c\x79\xd1\xbe\x38\x1c\xbc\x98\xbf\xdf\x17\xf5\xdb\xfc\x6d\xc2\x8d\xf6\x63\x19\x6f\x5c\x75\xe4\x4b\x20\x11\x54\x9f\x17\x36\x1c\x9f\x31\x8d\x1d\xe6\xee\x44\x29\x42\xa6\x55\xc6\xa9\x6d\x10\xac\x31\xd1\x93\x7f\x87\x4c\x31\xe1\xc3\x32\x9c\x71\x72\x5e\x86\xd3\x1b\xcf\xe0\x43\x92\x50\xdc\x18\xb1\x44\xd3\x0b\x3f\xba\x05\x9b\xb8\x79\xf9\x4c\xcd\xa9\x1a\x44\x47\x13\xbf\x1b\x4b\x66\x8f\x3f\x1c\x98\x7b\x06\xf2\x47\xa8\xe5\xe4\xc3\x2d\xe9\x65\xa2\x88\x91\x70\x06\x83\x8c\x25\x26\x4e\x93\x13\xe4\xe3\x23\xeb\x6b\x3f\x10\xe9\x0e\xc7\xe4\x71\x02\x4b\xc4\xb9\x9a\x86\xd0\x3b\xc9\xee\x8f\x43\x98\x4c\x3e\xca\xf4\xd2\xa0\x92\x35\xbb\x93\x00\xe9\xbf\xd7\x30\x1e\x84\xd0\xef\x8f\xa0\x3d\x14\x78\x19\x41\xfc\xd3\x3a\x2f\x46\xc5\x06\x51\xa2\x40\xc3\x65\x3a\x50\x59\x74\x82\xa3\x26\xcc\x21\xb6\x5e\x35\x51\x53\x0a\x74\x47\xf5\x5c\x75\x98\xe8\x9d\x00\x1a\xb8\x21\xc4\x0a\x02\x9b\x3b\x91\xaa\xf7\x01\x5d\x8b\x6b\x50\x40\x13\x20\x13\xf4\xef\xf9\x8e\x4c\xe4\xb3\x51\xbf\x2f\x9e\x8d\xa6\xf0\xce\x46\x99\x7c\xae\x13\xf4\x1f\xac\xc2\x46\xa4\x41\x47\x2a\x81\xd2\xc0\x8d\x90\xc1\x99\xe2\x69\xbd\xbd\xbe\xe6\xb5\xe2\xcb\xef\xe1\x06\x0b\x4b\xe6\xbb\x13\x25\xf3\xdd\x24\x6e\x71\x8a\xdf\x83\xe7\xec\x44\xe3\xfa\xca\xdf\x16\x05\xdc\x15\x61\x95\xb0\x4c\x86\x89\xa1\xea\x9d\x90\x34\x2e\x43\x82\x8b\x12\x3a\x69\xc6\x0f\xcf\xdd\x1f\xcf\x77\xd1\xc7\x83\x2a\x61\x99\x0c\x13\x03\x95\x30\x7d\x99\xc7\x65\x08\xa1\x39\x5e\xd0\xd0\xb5\xe7\xd8\x9c\x86\x46\xa6\x49\x48\x1e\x8c\xcd\x40\x20\x6d\x38\x26\x68\xf9\x6e\xbf\x6e\x54\xe5\x76\x83\x01\x8d\x96\xfd\x70\x80\x62\xc3\x21\x71\x86\xa9\x80\x9c\x9c\xc2\x4d\x5a\x68\x08\x4c\xa6\xe2\x1b\xf0\x18\x20\x70\x3e\xbc\xcc\xdc\x5d\xaa\x53\x15\x88\x9a\xed\xd5\x7e\x2e\x23\x2f\x37\x16\xcc\x9c\x4b\x32\x18\x67\x89\x8b\xa0\x8c\x64\xb9\xeb\x18\x60\x43\xe2\x70\x18\x8f\xd1\xa0\x3d\xd0\x61\xd5\x90\x17\x02\x98\x1d\x55\xea\x00\x03\xa8\x3e\x96\xbe\xfb\xe1\xfd\x9b\x0f\x6f\xfe\xe3\x9b\xcb\x37\x6f\x5f\xbd\x79\xfb\xe6\xc3\xcf\xf6\x60\xb9\x20\xbf\x79\xf1\x72\xad\x0f\x58\x9d\x7d\x2f\x3f\x89\xd6\x1c\x1f\x34\xb8\x9c\xc3\x99\x85\x01\xdc\xdb\x79\xca\xa4\x5f\xfc\x0b\x2a\x09\xd5\xbb\x19\xf6\x34\xe8\xf1\xc0\x84\xd2\x4d\xc5\x17\xa2\x46\xef\x6b\xee\x99\xda\x79\xcf\x8e\xae\x20\x4d\x64\xed\xf8\xf2\xbd\x5d\x18\xbf\x46\xa4\x01\xbf\x35\x88\x4a\x75\x60\x44\x96\x79\xc2\x66\x73\x50\xe5\x76\x6b\x5b\xb0\xfa\x70\x18\x03\x3f\xc5\x76\x7d\x38\x06\xb6\x8a\xee\xaa\x51\xd7\x06\x39\x90\xef\xe0\x0a\x0f\x27\xbd\x66\x66\x18\x1a\x7c\x8a\x05\x7f\xbb\xbd\x49\x92\xeb\xe1\x8a\x9c\x6f\xcf\x0b\xf2\x14\x68\xfc\xcd\xb3\x31\x1f\x8e\x3f\xef\xf7\xdf\xcb\x64\x49\xe0\x67\x6d\x05\x8c\xb3\x15\xbd\x9e\x4f\xac\xeb\x01\xd8\x43\xd7\xe7\x9b\x68\xf3\xac\xce\x37\x84\x3c\xdf\xf6\xfb\x49\xf8\x91\xea\xe9\xc6\x7c\x82\xd0\xfa\x70\x78\x2f\x93\x05\x99\x9a\x09\x37\x9e\x89\x5e\x6b\x30\x0f\x17\xd1\xbb\x22\x5f\xf0\x3a\xd9\x10\x92\x25\x71\x99\x05\xa1\x9b\xe0\xdb\x9b\xa7\x92\x9c\x4b\x27\x90\xf6\x1d\x78\xba\xb1\x1a\x5c\xae\x8f\x4f\x37\x60\xdf\x78\x66\x46\xf5\x3a\xcd\x8b\x9b\xb2\x56\x7f\x5d\x97\x05\x4f\x96\xe7\x1b\xba\x39\x1f\xf3\xcf\xd0\x21\xd5\x92\xd0\x33\x18\x76\xbb\xdc\x3a\x2c\x97\xb3\x35\x98\x5b\x24\xf9\x50\x90\x73\x4d\xef\xdb\xc2\xc6\xd5\x5a\x45\x83\xdb\xb7\x22\xa6\xe6\x34\x4a\xcc\x7c\x2f\x2b\x37\x10\x13\x89\x1b\x46\x67\x07\x82\x69\x39\xa6\x19\x4b\x5a\x18\xcb\x54\x64\x4b\x64\x8f\xe9\x7d\x73\xc3\xc6\x93\x1b\xf0\x0b\x79\x63\xed\x6d\x83\xea\x89\x18\xdc\x3c\xdd\x10\x9c\x36\x0b\x13\x7c\x63\x6b\x32\xcd\xb3\x35\xa1\x65\x03\x27\x78\x62\x35\x96\x8f\x4f\x60\x82\xda\xb4\x3b\x50\xc3\xd8\x25\xb9\x85\x71\xaf\xe1\xf8\xe7\xc8\x3f\x00\xc4\x75\x9a\xe4\x3e\x86\x35\x55\xa8\xaa\x66\x36\xa9\x4a\xb9\x34\x1b\x17\xb4\x97\x5d\x9e\x90\x3e\x2f\xdf\xfd\x2a\xdb\xac\x89\x39\x53\x2f\x6a\x84\x2b\xb5\x3d\x64\x86\x2a\xd1\x7d\x88\x64\x95\x2e\xdf\x62\x87\x23\x42\xa3\xab\xbf\xeb\xdb\x16\x8d\xff\x84\x81\xab\x27\x22\x03\x85\x48\x61\x0e\x63\x8e\x87\xf1\x61\x4a\x81\xeb\xa9\x8c\x89\x8c\x7e\xdf\xc7\x26\x48\xc4\x90\x29\xb3\x03\x8f\xd1\xfc\x31\x1d\x93\xf3\x0b\x9a\x0f\x90\x70\x0c\xa9\x06\xa1\xdf\xb9\x5c\xe2\x5b\xae\xdf\x02\x8a\x21\x1f\x0a\x8d\x91\xbd\x8b\x30\x32\x74\xf9\x61\xfc\x52\x9c\xf0\x0a\x81\xbe\xe2\x9a\xc6\x3b\xeb\x7a\xd1\xa5\x69\x14\xb0\x74\x6a\xd6\xed\xb6\x6c\x86\xae\x04\xa9\x0b\x6d\xc5\xfb\x7d\xf7\x2c\xf5\x56\x59\x7c\x9c\x1a\x22\x39\xeb\xf5\xa8\x49\x9a\xa7\x7f\x2f\x85\x4c\x7a\x69\xaf\xa5\x33\xa9\xaf\xdf\x99\x98\xeb\x7b\x6a\x26\xe6\x30\xae\x6c\x36\xa7\x92\x5f\x67\xb3\x79\x43\xa8\x4e\x6d\x12\x4e\x4b\x6c\x87\x2f\xc1\xc1\x00\x03\x75\x6c\xe0\x3e\x4a\x0e\xce\xad\xc2\x10\xe1\xe8\x37\x6b\x09\x7e\xb3\x2a\xa6\x22\x9d\x4d\x31\xcb\xe7\xc4\x50\x18\x15\xec\xf0\xc3\xc1\xbd\xe5\x3b\x72\x38\x20\x45\x3a\xcb\xad\x4f\xe9\xc3\x21\x29\x66\xf9\x9c\xe9\x3f\x87\xc3\x88\x6e\xf5\xcb\xd6\xbc\x94\x69\xc5\x0b\x70\x2e\xf9\xae\x14\x52\xd5\x53\x28\x3a\x1e\x8d\x32\x68\x5c\x13\x13\xd0\xee\xb3\xd1\x54\x57\x19\x30\x48\xce\x0a\xfb\xac\x4f\x91\x5b\x93\xaf\x3b\xf4\xd1\x64\x38\x30\x54\x40\xab\xc0\x6a\x3f\x6f\x0d\x4c\xce\x84\x1b\x58\x1e\x0d\x2c\x37\x03\xe3\xc6\x9b\x54\x30\xb0\x4e\xcc\x0e\x6b\xd3\x4e\xc4\x0b\xdb\x42\x0c\xf6\x83\x64\x2f\x7f\x2d\xfb\x26\xd0\xe0\xa6\x9a\xf4\xb3\xdb\xad\x64\x79\x17\x91\x4a\x6b\x96\xa7\xa7\x58\x66\x60\x3b\x55\xb9\x7d\xb1\x65\xf7\x0d\x58\x28\x7a\x95\x38\x18\x09\x3b\x85\xc8\xe0\x60\x6c\xf6\xdb\x6f\xbe\x7d\x11\x67\x07\xd1\xdb\x8c\x2e\xd1\xe4\xac\xe8\xf7\x15\xec\x2c\x45\x0a\x16\x7a\x30\xaa\x41\xec\x0b\x7d\xb1\x9a\x47\x13\x5b\x52\xb2\x72\xe6\x8a\xc0\xc9\x40\xd2\x9b\x16\xd3\x17\x32\xc9\xe9\x16\x16\x3d\xfb\x5a\x3f\xeb\x27\xeb\xc0\x79\x1b\xbb\x11\x13\x1a\x77\x28\x6b\x1b\x9f\x52\xe9\xad\x4f\x70\xb5\xfc\x12\xc2\x2b\x45\x78\x2f\x40\xeb\x3d\x5a\x45\x78\xa5\x78\x3b\x08\x0c\xcf\x99\x9b\x1b\xc2\x19\x09\xe0\xfa\x83\xd9\x80\xdf\x4c\x53\xf8\xc9\x46\xa6\xc1\xb8\x78\xbe\x8b\x8a\xe7\x3b\x28\x9e\xef\xb2\x31\xcd\xd3\x6e\x32\x2c\x21\x9f\xc0\x42\x61\xc3\x3c\xa4\x5c\xd6\xc2\x97\x51\x90\xf0\xf9\x08\xdc\x0e\x74\xfb\x18\x3b\xc2\xce\x8d\x09\xa4\x6f\xc4\x48\x04\x22\x67\x5c\xe4\x01\x84\x37\x64\xfb\x1e\x77\xf1\x70\x08\xe8\x6f\x77\xd9\x7e\x92\xf3\x76\x14\xa5\xc4\xc9\x7a\x92\x96\x57\x9b\x4e\xb6\xd9\x83\x9c\xa3\x93\x5a\xc9\x8e\xab\xd2\xad\x9e\x3e\x6c\x73\x29\xac\x96\x74\x9b\xe1\x72\x9a\xf1\x11\x0d\xeb\xb7\xb1\x3b\x7e\x1d\x4b\xc7\xa1\x1a\xbf\x99\xb7\x43\xf4\x6d\xfb\x46\xb2\x77\x72\xf2\x21\x64\x77\xbc\x41\x76\xc7\xab\x0e\x47\x99\x3f\x48\xab\x02\x0b\x5e\x3c\xa1\xdc\x77\xbf\xfe\xbe\xf6\xee\x43\xc3\x4b\xfb\xc7\xb6\x7f\xbb\xc0\xb0\xa7\xdf\x57\xcf\xd9\x68\xaa\x32\x0e\xc0\xf9\x97\xdf\xc0\x5b\xf7\xa2\x61\x04\xd0\x35\x2b\x1d\x80\x2e\x2c\x17\x11\x94\xac\x63\x20\xbd\x3c\xe2\x1a\x06\x4e\x41\x83\xa5\x5f\x4e\x55\x20\x5c\x28\x53\xb1\xcc\x54\x20\x5c\xd0\x09\x4d\xf9\x30\xc8\x2e\x1f\x06\xd9\x50\xfb\x6d\x09\x84\xfb\xc9\x46\x26\x28\x35\xac\xed\xcd\x11\x45\xea\x5c\x10\x0f\xbd\xb7\x4e\xc1\x07\x35\x96\x39\x2b\x3a\x82\xc0\x15\xdd\xba\xa3\x6b\x60\x60\xc6\x86\x21\x8b\x8f\xe4\x7e\xc1\xce\x46\x93\xab\x8a\xe7\x1f\x1b\xb1\x4a\x5c\x27\x0e\x87\x85\xd1\x7b\x60\xf7\xcd\xe4\x44\x1f\xa0\xc0\x35\x9b\x75\xf7\x84\xb4\x30\x33\xd7\x76\x80\xa1\x99\x6e\x80\x13\x61\xca\x8f\x71\x33\xf0\x0f\x75\x72\x3c\x30\x37\xae\xad\xd5\xec\x5a\xe3\x6d\xfa\x87\xcd\xe6\xc4\x38\xf0\x49\x04\xdb\xce\x14\x82\x23\x12\x3b\xf3\x31\x03\xd8\x40\xcd\x89\x64\xe5\x49\xcc\x4c\x46\x08\x8c\x8c\x11\x98\x00\x33\x93\x16\xc9\x92\x88\x64\x1d\x0e\xc9\x46\x23\x60\x1b\x83\x9e\x6d\x00\xcf\xc2\xfa\xe0\x2c\x5a\x5f\xab\xab\xd6\xb5\xba\x4a\xac\x9c\xe4\xf9\xc8\x42\x11\xbc\x40\x8d\x57\x4a\x40\x7f\xc8\xa4\x8c\x6f\xda\x12\xd9\x60\xc4\xec\x49\xef\x7f\x16\xae\x58\x89\x2e\x3a\x41\x0d\xe0\x5f\xb8\xa3\x88\x8f\x37\xf0\xe0\x44\xff\x17\x4d\x6e\x6b\x0a\xa0\x04\x1e\xba\xa3\x69\x90\x28\x75\x83\xd6\xa9\x3e\x01\x50\xb8\xdf\x4f\xa2\x33\xda\xd5\x94\xc9\x23\xc4\x4e\x79\x00\xe7\xf0\x5b\x53\xf8\x31\x01\x71\xda\xf8\x08\x7e\x72\x0a\x3f\xae\x88\xff\x62\xbb\x31\xfb\xb5\x69\xf8\x86\xf5\x10\x25\x38\x89\xbe\xfc\x93\xfc\x65\xc4\xbd\x7f\x94\x09\x37\x84\x75\x80\x75\x63\x2a\x90\xe2\x30\x7f\x6d\x4e\x25\x1a\x58\xc2\x7c\xea\x9e\xda\xb7\x69\x88\xd0\x1f\x7b\x96\xfe\xc1\x72\xad\x89\xe1\x7e\xda\xf5\x3a\x55\x54\xa3\xfa\x83\x31\x01\x26\x80\x6e\x77\x6c\x2a\x8d\x47\x84\xd0\x90\x6f\xec\x98\xdb\x9f\x6c\x6e\x38\x8e\xaa\x7a\xbe\x2b\x73\x63\x9a\x7e\xb2\xfb\x83\x31\xc9\xc6\xa3\xb8\x0b\x66\xe5\x6c\x4f\x9e\x8f\xa6\x61\x32\x96\x41\x66\xf2\xb3\x71\x9c\xf5\xc9\x3e\x93\x2c\x2a\x3f\x26\x9f\x96\xde\x76\xb2\x39\xcf\xda\xc2\x45\xe0\x73\x0a\x99\xd9\x4d\x40\x80\xa5\x69\x17\xff\x31\xac\x48\x36\x9b\xd3\x8a\xbd\xb2\xa4\xdb\xe9\xa1\x60\xfb\x84\x38\x7f\x22\x61\x0e\xb0\xd0\xeb\xd0\x17\x2a\xb0\x9f\xc3\xd6\x4a\x42\x26\x1a\xe8\x57\x53\xcb\xfb\x8b\x9b\x76\x87\x36\xe6\xfa\x85\x79\x51\x7b\x12\x28\x0f\xe0\x70\x55\x84\x56\x4c\x3c\x6d\xe5\x66\x47\xdf\xa9\xda\x8d\x57\xed\x16\xd1\x17\x43\xc1\xe4\xb3\xd1\xf1\x26\x02\xa7\xf3\x84\x64\xe3\xc9\xb2\xbc\xf7\x9f\x1e\xeb\x51\x0d\x06\x02\x5d\x89\xd3\x82\x0d\x06\x52\x23\x52\xe3\xac\x20\xd6\x59\xb9\x65\xfc\xc5\x9f\x7b\x5a\x90\xf3\xa2\xb9\x5b\x8b\x82\x27\xf2\x59\x79\x38\xe8\x13\x5a\xf6\xfb\xe2\x59\x8d\x1d\xd9\x9a\x85\xc9\x77\xb4\xf2\xd6\x48\xf8\xe5\x2d\xa1\xb9\x63\xe7\x3d\x92\x63\x27\xd9\x99\xfc\x8d\xac\x3a\x2a\xfb\xfd\x3c\xa4\x41\x3e\xc5\xb9\x73\x68\x34\xdc\x1f\x91\x07\x25\xcb\xb0\x7b\x2c\x37\x0e\x3d\x2a\xfd\x6f\x24\x7a\x3e\x45\x19\xe0\x90\xfe\x09\xaa\x00\xb4\x78\x5e\x89\xaa\x86\xf1\x9e\x30\x84\x6e\xed\x5e\x45\x62\x61\xad\x91\xe9\x44\x5b\x98\x13\xf2\x34\x7e\x7f\xa4\xba\x83\x5e\x6e\xc9\x46\x9f\xd4\x6e\x30\x0c\xbb\x84\x1b\x67\x2e\xf1\x18\x92\x10\xcc\x69\xd4\xe7\xd5\x91\x11\xb9\xf7\x7e\xdd\xa5\x4f\x08\x02\x94\x73\xe7\xd5\x02\x14\x1e\x02\xe6\xa6\x86\x15\x5e\x09\xc2\xe8\x28\xcb\x96\x56\x84\x87\xbb\x43\x28\x4f\xce\x93\xf1\x50\x7e\x8a\xaa\x8d\x34\xa6\xac\x62\x59\xa2\xd8\x80\x1f\x91\xb2\xe4\x39\xc7\x2b\x0b\xac\x62\x13\x89\x5f\x24\x43\x1e\x93\xb7\x11\xe7\x75\xc0\xa3\x4e\xeb\x53\xd9\x41\x3b\xcb\xc7\xc9\xfe\x43\xe6\xf3\x31\xd1\x1b\x86\x7c\x90\xfd\x3e\x22\xed\xfa\x56\x1c\x65\xd1\xd6\x88\x68\xe8\x44\x0e\x5b\x3d\x7c\xca\x63\xda\xbc\x21\xf4\xad\x64\xdf\xc9\xc9\x2f\x21\x21\xfb\x16\x09\xd9\xaf\x3a\x08\xd9\x6f\x4e\x46\x7c\xa0\x7f\x0d\x5d\xb5\x1b\xbf\xe4\xf4\x3f\x24\x0b\x5d\x4c\x63\xd4\x23\x1e\x7b\xa7\x76\xc1\x75\x7b\x34\x97\xd7\x05\xff\x6d\x9e\xa9\x3f\xed\xe4\x39\xf0\x7a\xbd\x10\xd5\x62\x5b\xe4\xe0\xc6\xd4\x12\xdd\xf5\xba\x44\xc5\xbe\xaf\xf2\xc5\xc7\x65\x55\x82\xbf\xe5\x2b\xf3\x1c\xfa\xa7\xbe\xf8\xe2\x0b\x6a\xff\x8d\xd2\xdf\x7f\x41\x7a\xae\x98\xd1\x85\xfd\x39\xbb\x68\x27\xfd\x94\x5d\x9c\x72\xce\x6c\xd8\xf0\x74\x53\x0a\x69\x3d\x17\x87\xa3\x77\x8e\xe6\xc7\x81\x7f\xe7\xee\x10\x34\x01\x57\xe0\x75\xb8\xb7\x62\x6d\x16\xee\xdd\x39\x79\x1f\xfc\x5f\x45\x5e\xec\x4f\x9e\xe3\xc1\xc5\x53\x9e\x1e\x0d\x37\xf0\xb6\xff\x77\x27\x42\xa0\xb9\xef\x1a\x46\x24\x51\x10\x93\xe3\xde\xf8\x58\x18\xca\xf3\x0b\xf0\xb3\xc0\x07\xf2\xfc\xa2\xc9\xd4\x33\x5d\xe4\x79\x58\x00\xb3\x9b\xec\x3e\x74\xcb\xc0\x07\x32\x70\x30\xff\x8f\x70\x0a\x46\xe8\x7a\x65\xfc\x07\x13\x1a\xc5\x28\xdd\x66\xea\xd9\xf8\x0f\xa3\x96\x61\x8f\x6f\xe2\x2f\x9d\x52\x0f\x99\xee\x07\x02\xa3\x31\xf8\x48\x3b\x11\xad\xc5\xdb\xb1\xdd\x94\xd7\xd2\xe5\xb3\x7c\x4e\x65\xba\xa3\x25\xa1\xe5\x80\x09\x54\xfd\x0e\x0b\x98\x5c\xdf\x8d\x9f\x1d\xa3\xff\x8f\x66\x1c\x17\xbf\xc7\x71\xc8\x74\x3f\x64\x3c\x5d\x9f\x5f\x64\x89\x7a\x7e\xf1\x7b\x7d\x35\x3d\xfb\xe3\x08\x54\x33\x4d\x56\xd0\xce\xb7\xed\x20\x41\xc8\xf2\xc0\x28\x41\x23\xe0\x03\xfd\x14\x31\xe9\x1f\xe7\x9f\xb4\xc3\x1b\x69\xa7\xcb\xd1\xc0\xd7\xd2\xeb\xe0\xa6\x20\xe0\x70\x69\xf7\x12\x56\x24\x8a\x2b\x14\xba\x97\xda\x1f\xe7\x27\x81\x93\x3e\xdf\x36\xc1\xf2\xcb\x2a\xbf\x13\xf2\x5a\x43\x8f\x50\x60\xd1\x51\x81\x5a\x5f\x25\xe7\x17\xdd\xae\x41\x1f\x50\xb5\x07\x0e\xd7\x03\xfc\x27\x71\x92\xf7\x64\x99\xf6\xbc\xc5\x1c\xf3\xbc\x86\x9c\x56\xc0\x6d\xe0\xc7\xe4\x7d\x45\x6c\xe8\x23\xde\xe6\x07\x54\x4e\x1c\x80\x8d\x46\x11\x0e\x73\x1b\x3e\x7f\xa0\x5a\xf7\x1c\x27\x13\x23\xd6\x22\x87\x43\x79\x2c\xd2\x0a\xa8\xf8\x8a\x7a\x59\xbd\xc6\x41\x2b\x8a\x92\x81\x06\x23\x40\x82\xf6\x2a\x63\x27\xe7\x64\x3a\xca\xa4\xa1\x0b\x85\x2f\x76\x34\x3f\xd3\x51\x26\xa8\xfa\xad\xd2\x80\x10\x73\xf2\xec\xfa\x60\x4f\x9c\xeb\x1d\x18\x30\xf8\xc9\xaf\x93\x4e\xbf\x7c\xac\x64\x59\x6f\x7b\x0f\xbd\xed\x96\xc1\x35\x47\xd7\xf0\x2d\x5f\x44\x9e\xa3\xe4\x7d\x52\x05\x3e\xa5\x5c\x53\xde\x05\xa9\xd3\x18\xa7\x1e\x1b\xe0\x26\xc8\xd3\x94\x67\xbd\x5e\x43\x1e\x8b\x4f\x77\x32\xf3\x1f\x85\x4e\x9f\x74\x8e\x68\xfb\x3e\x51\xe1\xdd\x12\x0e\xc4\xde\x33\xa7\xdc\x37\x75\x0b\x67\xba\xe6\x44\x13\x60\xf7\x45\x36\xa2\x55\x66\x3d\x7a\xaa\x6c\x44\xaf\xb2\xce\x73\xdf\xd0\x92\xdd\x37\x13\x30\x98\x41\xa3\x8a\xdc\x1a\x55\xa8\xf4\xd2\x37\x8b\xbe\x92\x66\x73\x40\x7d\x8c\xdd\x6c\xe7\x42\x06\x92\x57\xce\x46\x13\xfe\x6c\x09\xae\x26\x40\x10\xa7\xd1\x3f\xdd\xe2\x3b\x83\xdc\x24\x3c\x86\x51\x83\x2f\x30\x82\xd5\x42\xed\x68\xc1\xf2\xd0\x6a\x73\xcb\xa2\xf9\x9a\xf1\x39\x0d\x22\x72\x25\x5b\x32\xbd\xbf\xcb\x5e\x87\xee\x99\x92\x9a\xd6\xc6\x7e\x8a\xd0\x75\x66\xb9\x88\x4f\x8b\x26\xbb\xbf\xcb\xea\x28\x60\xd2\xd6\x5a\x1c\xae\xb3\xa2\xe9\x18\xf8\x8c\xcf\x19\x62\x7d\x6b\x1c\x06\xec\x9e\x17\x10\x90\x9c\x13\xba\x88\x1c\x8b\xae\xc9\xff\xfa\xec\xcb\x11\x5d\xb1\xbf\xcb\x64\x41\x45\xba\xa3\x32\xbd\xa3\x23\x3a\xfe\xc3\x88\xd0\x6b\x9b\xba\xa7\x32\x5d\xd3\x3f\x8e\xe8\xc5\xef\x47\x64\xb2\x42\x22\xf4\x59\x95\x16\xfd\x7e\x52\xa5\x05\x33\x29\xb4\x4c\x0b\xb6\x26\x74\xa5\xe9\xd2\xe7\x55\x5a\x41\x76\xc5\xe0\x9d\x96\x69\xa5\x33\xaf\x5d\x6d\x05\xd9\x8a\x5d\xbb\xda\x0a\x0b\x60\xed\x2b\xc8\xbe\x62\xd7\xa6\xf6\x15\x5b\x93\x46\xa5\x35\x57\x3f\xf2\xe5\x76\x81\x60\x25\x5a\x12\x7d\xd7\x93\x06\xe9\x51\x24\xef\x6a\xae\xf0\x16\x82\xa5\xb4\x08\x27\xfa\xf1\xf6\xad\x9c\x0a\xe0\xa6\x2f\x8d\x5c\xa3\x06\xe7\xce\xdd\x86\x4c\x3d\xd3\x00\x94\x70\xd3\x6a\x28\xcc\x7a\x8c\x48\x58\xae\x22\xb4\x64\x43\x9e\x1a\x02\x70\x51\xd6\x89\x4c\x95\xde\x33\xc3\xa0\xf6\xd5\x30\x11\x76\xab\x8b\xf0\x4e\x74\xad\x61\xc5\x2b\x32\xc9\xd9\xb7\xc0\x2f\xa8\xf4\x2f\xb4\xfe\xad\x4c\x4a\xdd\xe0\xb7\x32\xa9\x09\x15\xdd\x37\x68\x78\x3d\x0f\x93\x7c\x50\xc1\x75\x1b\xa7\x96\x03\x7d\xa7\x13\xdd\x46\x6b\xc6\x8c\x1d\x21\xce\x58\x90\x91\x9d\x70\x67\xe3\x05\xff\xc6\xaf\xee\x30\x8f\x56\xa8\x64\x6a\x10\xa7\xd4\x4c\xb6\x52\xf4\x69\x32\x53\x92\x07\x53\x32\x14\x71\x5b\x93\xbc\x0b\x05\x49\x4a\x18\xe1\x20\x37\xfe\x17\xf2\x4e\x3c\xa4\x1e\x14\x58\x48\x95\x9b\x41\xf8\x11\x84\xbb\xfe\xc4\x9c\xe2\x32\x58\x3c\x22\x51\x4f\x93\xcf\xbe\x1c\x9d\xf3\x0e\xb8\x42\x06\x89\xf7\xb9\x7d\x38\xdc\x37\x04\x37\x3a\x34\x7c\x38\x8c\x08\x9c\x3d\x4b\xca\xca\x67\xa3\xa9\x1c\x7c\xf6\xe5\x28\x93\xc4\x1a\xa7\x3d\xbd\x38\xff\xec\xcb\x11\x7a\xba\x12\xe8\xf6\xef\x55\x55\xde\xe0\x80\x3e\x41\x26\x5b\x64\x37\xf4\x6a\x13\x7b\x07\xbc\x35\x41\xef\xc3\x4b\x16\x19\x83\x43\x64\x26\x7a\x22\xa3\x33\x48\xc0\xd4\x14\x56\xe4\xa9\xcc\x12\x65\x2a\x3d\xc5\x28\xf5\x11\xec\x3c\x6d\x79\x12\x83\x27\x65\x34\x1a\xdf\xbd\x39\xbf\xb0\xd1\xaa\x76\x99\x3f\x06\xe4\xa9\x91\x26\x9b\x85\xa7\xfb\xcc\x9f\x38\x97\x69\x16\xbc\x39\xee\x47\xa7\xa5\xc5\xb1\xc4\x3c\x02\xfb\x8a\x3a\xf9\xf5\xc9\x25\xd0\xb4\x84\xd7\xba\x3f\x1e\x74\xb4\x75\x90\x95\xd3\xe9\xdf\xeb\x54\x7f\x13\x0c\xc3\x73\xec\x02\x8c\x3f\x1b\xf5\xfb\xb0\x73\x32\xfe\x5c\x3f\x3e\x1f\x4d\x79\xa6\x01\x5c\x87\x59\xfe\xb1\xdf\x1d\xb0\xa1\xd2\x17\x58\xc5\x44\xa0\xa9\x53\x45\xde\x8c\xab\xd4\x93\xf2\xb4\x60\x5f\xc9\xa4\x0e\x0c\xb5\x4b\xff\x4c\xe8\x16\x73\xd1\x64\xd7\x98\xee\x82\xc8\xb1\xea\xc2\x1f\xfa\xfd\x8e\x19\xea\x34\x86\x0d\x2a\x6b\xf4\x42\x2f\x36\x58\x79\x3e\xbc\x28\xb2\xb5\x5d\x03\x09\x81\x86\x9e\x27\x4c\x62\x27\x81\x41\x68\x6c\x97\x7c\xc2\xdc\xd3\xa9\x80\x76\x23\x8b\xce\x1c\xad\x7e\xce\x46\x93\x7a\x38\x24\x26\x26\x29\x48\x70\xa7\xf9\xf9\x45\x36\x02\x8c\xe1\x68\xe7\xd5\xb4\x1a\x14\x1a\xcd\x58\xb2\x6f\x62\x53\xdf\xfa\x01\x63\xdf\x49\x68\x7d\xba\x3c\x81\x07\xd4\xc7\x78\xc0\x24\x34\x71\xfd\x87\x4c\x16\x84\xfe\xac\x6f\xff\x0e\x04\xa3\x9e\x6b\x34\xe5\x2f\x88\x12\x85\x88\x8e\xce\xa0\x65\x14\xe8\x2e\xb4\x67\x4d\x04\xa1\xa5\x5f\x7d\x43\xf5\x58\xa7\xda\x81\xcc\x56\xdf\xc1\x20\x51\x04\x4e\xa6\xf8\xc4\x3a\x8b\x96\x36\xc8\x4c\xce\x09\x3d\xe9\x6e\xcd\x6c\x31\x4d\x88\x59\xd6\x11\x7d\x68\xe5\x68\xa1\x67\x9f\x5b\xdf\x0e\xc3\xb1\xde\xe6\xdf\xd8\xe0\x7e\x78\x08\x74\x2a\xb8\x6c\x2d\x0f\x87\x9a\xf4\xfb\x45\xbf\xbf\x05\x4a\xb0\xb2\x7b\xa9\x8a\x7c\x05\x14\xb4\x0a\x3c\x09\x6c\x75\x6e\xe4\x03\x22\x7a\xd7\x58\x42\xe4\x29\x87\x98\xda\x81\x1f\x08\xde\xed\x39\xa7\x8a\xbc\x10\x94\xa4\x4a\xf3\x6a\x91\x38\xd2\xdd\x13\xe9\x54\xd2\x11\xbd\xb0\xd7\x0e\x41\xdb\xeb\xbc\x6b\x53\x8e\x34\x2d\x59\x59\x7f\x06\x79\xba\xd3\x37\xac\x57\x83\x5e\xb2\xf1\x64\xf9\xac\x9e\x2c\xd1\x75\xde\x71\xfd\x25\xd6\x37\x1e\x10\x6c\xfd\xa6\x4a\x17\x45\x59\x73\xd3\xd3\xca\x3b\x8f\xa8\x42\x7b\xe8\x44\xd0\x12\x30\x34\xd0\x6b\x0b\x00\x09\x4e\x38\x7a\x8c\x35\x33\x9e\x07\x33\x5c\xd0\x3c\x9a\xff\xad\x7e\x8f\x66\x3c\x7a\x4f\xfe\x2a\x93\x59\xe8\x5a\x43\x63\x9f\xfe\x65\x36\x9f\x13\xdb\x7e\xb0\x06\xed\x4a\xc6\xe1\x40\x79\x9c\x34\xd2\xf5\x95\x06\xbd\xa7\xa1\x85\xd2\xd0\x42\x0d\x87\xe4\xd3\xfb\xbf\x6a\xc1\x39\x30\x8b\xcd\x84\x11\x0b\x61\xf5\xf6\x9d\xc6\xd1\x5d\xae\xdf\x1c\xb9\x5d\x52\xe1\x76\x87\xb0\xbb\xc3\x0e\xf6\x43\x99\x48\xa0\x10\xf6\xc4\x4d\x68\x42\x26\x79\x64\xb2\xfe\x49\x0f\x16\x5d\x2e\x0f\xbc\x45\x56\x97\x53\x98\x23\xf0\x35\x3a\x09\xbf\xa5\xc6\x87\xbf\xd2\xf8\xf7\x63\x1c\x23\x3c\x08\xeb\x9d\x33\x80\x8e\xf3\x12\x38\x07\xa8\x7e\xa5\x73\x00\x1b\x41\xf6\x08\xea\x55\xb4\x20\xf7\x20\xf8\x2f\x0e\x07\x69\x57\xd3\x44\xea\xfe\xc4\x16\x68\xeb\xde\xcf\x8a\x39\xa1\x32\x3d\xe2\x83\x83\xf5\x04\x8f\xc8\xc9\xca\x92\x93\xe1\xbd\x21\xd3\x88\x5b\x6e\xf2\x7e\xe4\x0b\x95\x0c\xf3\xf3\x8b\xa1\x6c\xf3\x8d\x7f\xa2\x43\x31\x2c\xd3\x5a\xfc\xc2\xbb\xb2\x7f\xa6\xf9\xe0\xe2\x69\x47\x2d\xac\xd2\x95\xf7\x33\x21\x51\x97\xea\xd0\x71\x42\x45\x47\x74\x68\xd4\x5b\xf9\xf1\xf6\x43\x87\x1b\x68\xa9\xd8\x10\xfa\x67\xc9\xfe\x43\x4e\x7e\x0a\xe5\x22\x7f\x46\x0a\xf9\x6f\x9a\x2e\xbf\x5c\xf2\x4d\xc5\x17\xb9\xe2\x4b\xfa\xbb\x2e\xc9\xc7\x9f\x3a\xa4\x27\xff\xee\x78\x88\xdf\xbf\x79\x7b\xf9\xfe\xc5\xab\x6f\x2e\xdf\xbc\xfd\xf0\xcd\xb7\xdf\xfc\x78\x38\x0c\xff\x38\x1a\xfd\x7e\xfc\xc7\x3f\x5e\x7c\xf1\xf9\xef\x3f\x1f\xfd\xf1\x8f\x63\xaa\x1c\x5b\xf1\xfb\x17\x3f\xb5\x4a\x1f\x15\xe6\xa0\x34\x50\x14\xa2\xe6\x8b\x52\x2e\xb3\xfb\x45\x79\x73\x53\xca\xec\x6c\x44\x6b\x90\x1d\x80\xdd\x53\x9d\x8d\xf9\x67\x9a\x2c\xeb\x2e\xc3\x3f\x33\xa5\x34\xfd\x70\x23\xe4\x56\xf1\xa3\x42\x5f\xf2\xcf\x83\x42\xeb\x72\x5b\x1d\x15\xf9\xec\x4b\xfe\x85\x29\x73\xf1\x79\x43\x97\xf9\xfe\xa8\xc8\x1f\xbe\xfc\xdc\x95\xf9\x6c\xd4\xd0\x3b\xce\x3f\xfa\x42\x63\xf3\xa9\xd1\xe7\x7f\x70\xa5\x3e\x6f\xe8\x4d\x29\xd5\xfa\xff\x67\xef\xdb\x9e\xdb\xc6\xd1\x7c\xdf\xcf\x5f\x61\xb3\x76\x58\x80\x09\xd1\x92\x67\x32\x33\x87\x32\xac\x4a\xd2\x9d\x4d\xa6\xd3\xdd\x99\xa4\x77\x67\xfa\xa8\x54\x2e\x58\x84\x24\x6c\x28\x50\x43\x82\xb6\xdc\x96\xfe\xf7\x53\xf8\x70\x21\x48\x49\x8e\xbb\x6a\x1e\xf7\xc5\x16\x41\xdc\x89\xcb\x77\xfd\x7d\x07\x55\x5d\xfd\xf9\xea\xaf\xfc\xcf\x6e\x78\x57\x7b\xf2\xaf\x86\x55\x8a\x57\x07\xd5\xfd\xe5\xaf\x7f\xfd\x93\xcf\xf8\xa7\x3d\x79\xe4\xec\x48\xdf\x47\xaf\xfe\xc4\xff\xb2\xdf\x13\x29\xe8\xcf\x77\xff\xc3\xe7\x2a\xfd\xca\x1f\x6b\xc4\x45\x60\xbe\x28\x44\x97\x07\x18\xf0\x56\xfa\xce\x44\x47\xfa\xde\x73\x31\xd3\x7b\x6e\xed\x4c\x7b\x6c\xe8\x21\x21\x03\xe1\x7d\xf5\xa2\xe2\xa0\xe3\x77\x91\x8b\x42\x67\x81\x52\xf4\x79\xeb\xbe\x98\xc2\x5d\xba\xce\xa6\x73\x08\x84\x4c\x0b\xc5\x0a\xe8\x05\xe5\x35\xad\xc7\x40\x8c\x30\xaa\xa6\x48\xd0\x32\xa9\x6f\x6e\x46\x78\x30\x9a\xed\x76\x60\x05\x55\x19\x88\xdb\x73\x87\xad\xf9\x54\x58\xf3\xa8\x95\xc8\xaa\x3d\x90\xef\x53\x3e\xbb\x96\xb8\xa4\x22\x19\x19\xda\x00\xa2\xb3\xb3\x29\x9f\xdd\x48\x1c\x14\x63\xb6\x4c\x4d\xc5\x60\xe4\x42\x68\xeb\x17\x95\x7e\xa1\x2b\xdd\xef\x6d\xef\x41\x48\x50\x94\x00\x39\x3a\xd1\xbf\x32\x35\xf5\x9d\xbf\x9a\x65\x00\x6d\x5b\x76\xf2\xac\x44\x98\x67\xa4\xf3\x8c\x66\xa4\xa6\xe5\x94\xcf\x06\x15\x00\xc3\xd2\x7a\x82\x24\xfc\xc6\x97\x35\x10\xd6\xa8\x9c\x0a\xfd\x56\xcc\xf0\x45\xe1\x03\xf8\x4f\xc5\x2c\x69\xda\xb9\xae\x45\x87\x33\x4d\x6f\x59\xce\x36\x70\x07\x76\xee\xa8\x35\x07\x8e\x09\xae\x9b\x4a\x0f\x60\xb7\x13\x56\xfd\xa7\x09\x4a\x17\x38\xc0\x55\xdb\xfa\x35\x33\x00\x9b\x60\xa8\xc4\x98\x84\xf6\x69\x78\xb7\x43\x25\x3d\x70\x83\xae\x26\xd2\x34\x82\x4a\x52\xe1\xcc\x3f\x58\xcb\xaa\x73\x4a\xcb\x49\x52\x66\x88\xed\x76\x6d\x63\xe7\xbe\x34\x54\x5a\x21\x7e\xac\xad\xa0\x2e\xdc\x51\x59\x15\x76\x0a\x8e\x88\x0d\xb8\x17\x1b\xe8\xd6\xc7\x6e\x92\x3a\xf3\x22\x28\xcc\xe1\xa1\x96\xa2\x0d\xe3\x68\x6c\xb9\xc4\x44\x64\x48\x1a\x8b\x1b\xb8\xdb\x92\x76\xba\x8d\x7c\xe4\xe7\x05\x12\xc4\xe6\xc0\x98\x88\xa0\x93\x4d\x7f\x4f\xd8\x90\x56\xb2\xe7\x1e\x24\x45\x80\x39\x30\x66\xd7\xf5\x60\x04\x5a\x3d\x07\x58\xc5\xc5\x54\x8a\x29\x9b\x81\x47\x07\xdf\xd4\x93\xca\xfc\xcf\x94\x20\x55\x6a\xce\x91\x38\x6e\x95\x11\x48\x0e\x38\xbe\x44\xe5\x45\x05\xf7\x15\xc6\xd7\x54\xf8\x39\xd1\x15\x8d\xdb\x87\x7a\x30\x9a\xb5\x3d\xce\xc5\xa1\xcb\xcf\x74\x06\x82\x6c\x52\xb7\x6a\xc7\xd6\xf9\xa7\x36\x21\x3b\xa7\x8c\xf2\xa9\x98\xcd\xa8\xee\x50\x18\xbe\x93\x1d\x89\xda\xa9\x89\x86\x3a\x8e\xe5\xe4\x19\x90\xe9\x70\x55\xd7\x34\x29\xfd\x5c\xf3\xe9\x70\x66\x0e\x27\x02\x80\x50\x7c\xda\x5a\xca\xd8\x17\x76\x56\xeb\x31\xbb\xa6\xc5\x98\xe9\xd2\x2c\xcf\x11\x23\x23\x22\x30\x46\x15\x95\x53\x36\xb3\x60\x29\x7c\x5a\x59\x20\x6f\x7a\x3e\x6c\x45\x48\x7a\xdb\xeb\x6f\x25\x71\x56\x81\xde\x72\x25\x42\xfb\x75\xbd\x46\x05\x2b\x7a\x18\xca\x46\x48\xd2\x8f\xc1\xdb\xb7\x4c\x6a\xcb\x76\xec\x91\xfa\x61\x75\xbf\x01\x6e\xb4\xe6\x80\x0e\xa8\x7f\xd0\xa7\x3d\xc4\x14\xf0\x13\x46\x25\x7f\x38\xab\x34\xfd\xa0\x29\x3e\x9e\xda\xe4\x5a\x93\xee\x2d\x44\xc3\xff\x93\x28\xd2\xc5\xcf\x6c\x00\x6f\x67\x19\x40\x20\xd5\x3e\x44\xf6\xc9\x9c\x21\x11\x26\x87\xa5\xf4\x8d\x12\xb9\xbb\x05\xf2\xdb\xbb\xe5\x78\x6e\xb6\x75\xb9\xd9\xb6\xcd\xcd\xb6\x91\xde\xfe\x30\x79\x1f\x02\xb2\xfa\x1d\xf4\xa2\x26\xee\xdc\xaa\x11\xee\x4d\xa7\x99\xb7\x63\xf0\x46\xcf\xc4\x30\x71\xd7\x67\x60\x10\xaf\x40\xc0\xaf\xf4\xff\x5e\x0b\xcf\x61\x3a\xfc\x5e\x47\x86\x30\x9e\x9d\x73\x5f\xa8\xdb\x95\x9e\x07\x61\xee\x56\x34\x37\x77\x6e\x23\x85\xda\xed\xa2\x9c\x3d\x46\x64\x4e\x95\x20\x0b\xfa\x77\x49\x96\x7a\x5f\x6e\xf4\x9f\x35\x05\x38\xda\x2e\x34\x8d\x87\x9b\x96\xf4\xbe\xc5\x05\x95\xe0\xff\xb5\x36\x3b\xb4\x10\xa8\x26\xf7\x53\x35\xc3\x61\x6e\xd4\xf3\xa6\x00\x16\xbe\x5f\xc3\x29\xb3\x7c\x0c\xf7\x76\xaf\x06\x67\x14\x0e\xc7\xbb\xa1\x6c\x10\x9b\x6a\xae\x52\xb7\xba\x99\xaa\x99\x1e\x80\x01\xed\x63\x7d\xd0\xbe\x8a\x42\x37\x0d\x2c\xcc\xd2\x5b\x49\xea\x52\x53\x3e\xa3\x95\xb1\x6e\x80\x4a\xd6\xd6\x12\x50\xb3\x5b\xbb\x1d\x5a\xd2\xa5\x73\x4d\x5b\x6b\x06\x4c\x6f\xef\x36\xf3\x74\x36\x5e\xb7\xee\xb1\x73\x1a\x84\x90\x5b\x43\x18\x90\x20\xc0\xdc\x82\xac\xa7\xeb\xf6\x90\xc1\xba\x23\xbe\xe8\x92\xca\x9b\xd1\x49\xdd\xe2\xd3\x1e\x0e\xd0\x53\xa1\x04\xf4\x08\xd9\x54\x50\x35\xe5\xb3\xd9\x6e\x87\xd8\x54\xcc\xe8\xf9\xd0\x9d\xa1\xa2\xbd\x8e\xaa\x3d\x5a\xe2\xb4\x2e\x2b\x85\x84\xc0\xd9\xd2\xff\x24\x9d\xbe\x2f\x0f\xfb\xbe\x9c\x2e\x3b\x7d\x9f\xdb\x19\x15\x28\xc7\x78\xb7\x9b\x93\x85\x49\xa8\x6c\xc2\x82\xcc\xe9\x9c\x52\xaa\xc4\x24\x69\xfc\xb1\xfb\x9d\xde\x64\xb2\x7c\x40\x98\xac\x70\xa6\x0b\x2d\x28\xa5\x7f\x97\x3a\x0f\x97\x79\x3f\x47\x32\xca\x16\xa4\xee\x5a\xe7\xcf\xc9\x02\x93\xba\x6b\x95\x3f\x4f\x46\x26\xf5\x56\xb1\xbb\x82\x03\x8a\x41\x7a\xab\x97\x7d\xad\xd8\x7a\x53\xd3\x27\xbd\x72\xb2\x25\x71\x8b\x29\xdb\x18\xdc\xd7\x3a\x5b\xef\x9f\x35\x7b\xee\xcb\x8d\xbb\xd8\x5b\x22\x70\x2c\x2e\x2d\x6f\x5b\xc0\xaf\x35\x27\x0d\x15\x61\x1f\x48\xae\xbb\xb5\x32\x92\x09\x13\x63\x87\x6d\xd8\x5c\xa8\x47\xc4\xf4\x74\xd6\x36\x54\x32\x59\x50\x10\x17\xaa\x4a\xdc\x35\xba\x72\xf8\xea\x0d\x8d\x74\xdf\x23\x4a\xe9\x3c\x88\xaf\x3c\x8f\xe3\xa8\xe6\x95\xe0\xb5\x7e\x5a\x4c\x1a\xd8\x22\x59\x64\x06\x07\x39\x26\x8d\x15\xb3\x9c\xd2\x5e\x81\xa8\xc1\x9f\x1d\x5d\x6f\x69\x3b\x14\x7d\xce\x98\xf3\xa3\x11\x08\xbe\xc7\x7f\x49\xe1\x6a\x21\x0d\xfd\x0f\x90\x02\x79\xd0\x08\x93\xd9\xc3\x50\x8c\x66\x98\xe4\x34\xd2\x2c\x92\xee\x51\x11\xc7\x75\x2a\xea\xf2\x1f\x9c\x7f\xcd\xd9\x23\x59\x51\x4e\xe6\x7a\x75\x8b\x05\xd2\xc4\xd1\x8a\x26\x2d\x4d\xb4\x22\x91\xcd\x1a\x11\x4d\x17\xf5\x5e\xe6\x13\x38\xd0\xc0\xa8\x39\xcd\xc5\x62\x81\x24\xe1\xa4\xc0\x37\x23\xfe\xea\xa2\xc1\x6a\x55\x95\x0f\x67\x3c\x89\xce\x98\xcc\xcf\xa2\x44\xea\x5f\x15\x3f\x53\x65\x79\xb6\xd0\x63\xdf\xb0\x4a\x9d\x3d\x08\xb5\x3a\x73\x9d\x3f\x2b\x17\x67\x51\xd2\x24\xd1\x59\x94\x14\x96\x04\x58\x8d\xd9\xb5\xd4\x14\x40\x65\x29\x80\x86\x14\x18\xcf\xcd\xee\x62\xad\x0d\xb4\x11\xde\x9a\xcb\x28\xd2\xf4\x6a\x7a\xa7\xc9\xb9\x7a\xb7\xf3\x79\xc9\x7c\x8f\xcc\x21\xbe\xc2\xf6\xda\x8a\x68\x9b\x33\x8e\x9b\xf6\x4c\x60\xb4\xd1\xec\x40\x45\x9b\x69\x13\xec\x3d\xc2\xf4\x4e\x13\x7a\xeb\x95\x7a\xa7\xe9\x0f\x08\x09\x95\x4d\xa8\x2c\x92\x73\xd3\x47\x72\x46\x12\x7c\x6b\xf0\x0d\x65\x71\x2c\xaf\x69\x15\xc7\xb9\xe9\x58\xab\xf6\x82\x25\x4e\x19\x81\x25\x4e\x2b\x22\xd2\x5b\xfd\x31\x69\x61\x17\x00\xaa\x7d\x74\x85\x49\x23\x50\xe1\x17\x83\xd9\x1b\x66\x67\xac\xf0\xc1\x62\xf3\x46\x3d\xa4\xb4\x08\xc3\x9e\x7c\x1d\x8c\xc6\xd5\x4d\x48\xbd\xea\xde\x0c\x06\x86\x6e\x95\x62\x5a\xcd\x08\x17\xd3\x72\xe6\x49\xd4\x80\x76\x86\x6f\xce\x88\x20\x25\xbe\xa1\x7c\x30\x72\xf4\x69\x19\xd0\xa6\x72\xd2\xa9\x3b\x1b\xce\xf4\x37\xc8\xbd\xa8\xfb\xd8\x10\x40\x2d\x7c\x0b\x14\x9d\x7e\x05\x51\x4e\x83\x68\x2f\x71\x1c\x69\xee\x5b\x7f\x64\x3b\x41\x9d\xb3\xdb\xf1\xa8\xbc\x4b\x93\x27\x23\x22\x03\xaa\xdd\x1d\xdd\x62\x81\x0c\x69\xce\x67\x6e\x8c\x01\x99\xcd\x75\x6f\x4d\x1b\xd8\x42\x0e\xe9\xae\x99\x83\xee\x70\x4b\x8b\x05\x8a\x8c\x41\x66\x64\x6c\x16\xcf\xdd\x55\xe1\x50\x55\x9e\xf4\x96\xce\x38\xd9\x94\x75\x36\xdc\x13\xf3\x28\xe1\x71\xb4\x37\xe6\x23\x21\x90\xee\x74\xa6\x4f\x2e\x3e\x1b\xb7\x76\x83\xaa\x6f\x37\x88\x6a\xaa\x80\x18\xe6\x71\x5c\x5f\x4b\xbf\xac\x6a\x43\x12\xf8\x45\x66\x9d\xe9\xf2\x7e\xf9\x82\xe6\x53\x96\x8c\x34\x87\x90\x4f\xd9\x00\xf8\xdb\x7c\xca\x66\x2d\xfa\x52\x19\xd0\x58\x85\x65\x52\x2c\xca\x48\x91\x94\xf8\xf2\x0a\x53\x4a\xeb\xdd\xae\x71\x90\xf7\x7a\x50\x35\x0c\x8a\x5d\xa2\x6a\x30\xc2\x2d\xe3\xd0\xc0\x84\xb6\x27\xb3\x21\x2b\xf4\x98\x17\xf0\xd5\x0d\xf0\x45\x1f\x2a\xa2\xb3\x84\x49\x4d\x87\xa4\xf0\x26\xd9\x67\xcc\xa3\x65\xf0\x76\xff\x56\x14\xa4\x19\x40\xa7\x46\x44\x73\x1c\x24\xda\x94\x75\x84\x49\x4d\x47\x60\x00\x6d\x63\x5c\x8f\x06\x55\x86\xba\x79\x47\x2e\xef\xa0\xc2\x97\x57\xa4\xec\x55\x15\x30\x2b\xae\xce\xa2\x5b\x67\x99\xa1\x72\x70\xa2\xd0\x95\x2b\x04\x56\x11\xd6\x36\xb5\x06\xdb\xd4\x82\x98\x10\x37\xd9\xe8\x12\xd5\xc9\x28\x29\x40\x01\x61\x97\x1b\xc9\xc1\x94\xa4\xd4\x37\xad\x95\xd4\xea\x4f\xdd\x02\xae\xe4\xfa\x18\xca\x3b\x3b\xe7\xf7\x40\x32\x82\xda\xd3\xdf\x44\x8c\xca\x40\x57\x00\xa0\x11\x1d\xe6\x1b\xc4\x24\x70\xb3\xc5\xb1\xba\xee\x29\x13\x26\xee\x79\xaa\x66\x59\x14\x01\xe2\xc2\x11\x93\xb0\x71\x60\x65\x6a\x69\xca\x1a\x83\xd8\x42\xf6\x78\xfa\x1a\xeb\x2b\x46\x95\x65\xa1\xc4\xc6\xf0\x13\x13\xc7\x4e\xa0\x5a\x20\xa9\xa7\xa5\x97\x01\x67\x07\x42\x8e\x72\x52\x66\x47\x8a\x75\xf9\x14\xe0\xb0\xf4\x18\xb1\x31\xee\x36\xc9\xef\xec\xac\x3d\x6b\x85\xd2\x4e\x5f\x37\xda\x15\x28\xb8\x81\x0f\xe8\xb1\x44\x35\x2d\xa7\xa6\xa0\x3e\x61\x66\xa4\xb0\xd5\xf8\x0f\x48\x1a\x5a\x4e\x0b\x7d\x08\xc8\x29\xd7\x04\x8c\xd5\xbc\x68\x0a\x30\x8e\x9b\x38\xce\xf5\x1a\x80\xec\x64\x41\x99\x1b\x9b\x22\x62\xb7\x43\xf3\x49\x93\xe9\x99\x5b\xd2\xf9\x64\x65\x21\xff\x81\x8c\x2b\x2b\xb2\x01\xb2\x61\xd9\x5a\x08\x2e\x3b\xf1\xcb\xc9\xaa\x7d\xb3\xea\xbc\x69\x63\x20\x6d\x26\x1b\xb4\x30\x30\x13\x8b\x6f\x19\x46\xbe\x30\x2c\x17\x1c\xc8\xe6\x10\xf1\x9e\x35\xdd\xf9\x87\x58\x53\x56\x76\xc0\x49\xe0\x00\x23\x3a\xde\x1c\xd6\x6e\xff\x54\xc0\x3d\x7b\xc8\x10\x09\x3b\xdb\x04\x18\x83\x3d\x66\xb7\xab\xb2\x5b\xf4\xc0\x54\xe2\x00\x2f\xd2\xc6\xe3\x93\xf8\x82\xa7\x66\xf3\xbe\x18\x66\x93\x82\x68\x2b\x8c\x6f\xc9\x83\x73\x56\x1a\x2d\x8c\x0d\x7e\xd6\x39\x2d\x2d\xd4\x26\xb0\x53\x4e\xc4\x05\xb9\x0b\xe1\xb8\x5a\x2f\xb5\x13\xfd\x80\x99\xdd\x19\x42\xe2\xe5\x6e\x4e\x2d\xae\x91\x9f\x16\x90\xbf\xa8\x6b\x7f\xee\x9d\x68\x84\x6b\xf6\x11\xbe\x99\x89\x93\xf5\x62\x97\x96\xf0\x4b\x9d\xc6\x74\xb8\x74\x53\x3f\x80\x88\x88\x44\x1c\x7c\x55\xfd\x31\x89\x15\x71\xf4\xbe\xaa\x27\x66\x6e\xe7\x15\x67\x8a\xc3\x8c\x74\xe2\x5a\x7d\x2b\xda\xa1\x47\x9c\x03\x2b\x2d\xb5\xed\xe8\xba\x94\xd3\x75\x89\xc3\x18\x5c\x3d\xf0\x11\x10\xa7\xf8\x80\xe6\x20\x8a\x69\x43\x9c\xb0\x36\x10\xb5\xf0\xe6\x80\x35\x00\xb2\x90\x92\xfe\xed\x65\xee\x17\xce\xc0\xe9\x21\x93\x17\x2c\x29\x2f\x2a\xb2\xca\xe4\x45\x95\x94\x17\x6c\xdf\xde\x14\xbd\x30\xb3\x7d\x98\x8d\x70\x6a\x60\x78\x6d\x49\xc7\x53\xbd\x24\x2e\x87\x15\xe1\x1e\x48\x89\x18\x15\x53\x09\xe4\xee\x6c\xb7\xd3\x34\xa1\xd7\x4a\x81\xcf\xc4\xb1\x13\x81\x0c\xf5\xdd\xc7\xc9\x54\xcd\x08\x0f\x6f\x3f\xc2\x30\x58\x5d\x74\xfb\x5c\x79\xbf\x54\xe7\x4f\x7a\xf0\x21\x8c\x4f\x41\x99\x3e\xf8\x88\xe6\x97\x65\xba\x3a\x62\x9d\xe6\x08\x8f\x7a\x30\x20\xf5\xcd\x70\xa2\xa9\xb8\x3d\x26\x73\x71\x04\xdf\x94\x84\x7c\x65\xe6\xe8\x44\x62\x78\x8f\xcc\x70\x98\xc4\xc9\xf9\xb2\x27\x7d\xfb\xac\x79\xf6\x64\xc4\x76\xd9\xf9\x88\xe8\x49\xd1\xff\x81\xf4\xd2\x3f\x3a\x53\xa7\x13\x5a\xc6\x4e\x3f\x59\xca\x3a\x8b\x82\x49\x8c\xba\x85\x74\x3b\xde\x87\xc9\xc7\x70\x3c\x1f\x11\xc3\x0e\x67\x86\xd7\xb5\xf2\xdf\x27\x4b\x7f\x67\xe7\xa3\xfd\x7e\x3f\x5e\x89\x40\xbb\x39\x17\x40\xbb\x2e\x04\x7d\x9a\x33\xc5\x97\x65\xf5\x98\x7d\x95\xc4\x0c\x32\xfb\x45\x92\x00\x7e\x24\xfb\x4d\x92\x4a\x6f\x84\xe2\xa3\x79\xfd\x4f\x69\xc6\xba\xd2\x87\xb8\x00\x79\x01\xdc\xc0\x59\xf4\xe3\x8f\x3f\x9e\x7d\x47\xce\x7e\xfd\xf5\xd7\x5f\xc9\xd9\x2a\x5b\xaf\xb3\xba\x3e\x63\x11\x09\x95\x95\x91\x4d\x4f\xbf\x7c\xf9\xa2\xdf\xf5\x92\x4d\x76\xd0\x46\x42\x92\x7e\x06\xc5\x63\xb4\x7a\x1d\x81\x7a\xd1\xb4\x12\x19\x35\x62\x54\x14\x91\xd5\x15\x42\xba\x6e\x3a\xf2\x5a\xc1\x68\xfa\xf7\xd9\xdf\xcf\x06\x36\x15\x74\x80\x11\xfc\xde\x8f\x9d\xb0\x36\x2d\xef\x79\x55\x89\x9c\xa3\x23\x5a\x19\x35\x79\xba\x15\x79\x16\xad\xcb\x35\x97\x2a\x22\x56\x22\x7a\xc4\x2d\x61\x29\xf6\x04\x3e\x7d\x16\xf8\x66\xc8\x93\x30\xb6\x3c\x8e\x0f\xd2\xe4\x84\x53\x70\x15\xc2\x19\x0f\x83\x38\x6b\x46\x12\xde\x80\x2a\x5b\xd4\xff\xcd\x0a\x91\xc3\xba\xb7\xde\x7e\xc8\x1d\xd3\xa6\x7b\x47\x7b\x70\xa6\xcb\x3b\x7a\x43\xe2\x3d\x61\x79\xde\xc9\x48\x44\x37\xab\x66\xde\x75\x62\xdb\xc8\x9e\x68\x06\xf2\xd9\x42\xc0\x61\x82\x8d\x85\x3e\x96\xad\xe8\xe1\x54\x09\x33\xa6\x56\x66\x41\x29\x95\x13\x1e\x48\x3b\x50\xd8\x7a\xc6\xbd\x28\x43\x76\x3a\x05\xa2\xb0\x67\xc6\x6c\x44\x65\xdd\x32\xf6\xf2\x08\x4a\x75\xca\xec\xf7\xd9\xd3\x1e\x93\x9f\xd2\x5b\x7d\x1d\x46\xe6\x78\x8e\xc8\xd3\xa6\x68\x20\xe4\xfa\xd3\x42\x14\x05\xaf\xb2\xa7\x4d\x55\x6e\xd8\xd2\x38\x39\xee\xf7\x7b\xe3\xf3\xbd\x31\x5b\x82\x9d\xa0\x68\xc0\x46\x01\x28\x29\x23\x8d\x16\xf4\x08\x9e\xba\x3e\x57\xe3\x58\x1e\x0a\x7c\x39\x8e\x63\xe1\xc8\x8a\xf4\x76\xbe\x12\x45\x5e\x71\x09\xf1\xd1\x2a\x2f\xcb\x0d\x22\x2b\x54\x93\xa3\xa6\xac\xfc\xba\x8a\x63\xa6\x89\xb3\xdb\x7b\xc1\x1f\x8c\xa2\x77\x6f\xd7\x11\x9c\x71\xac\x3a\x7a\x31\xa4\xee\xa5\xbe\x1e\x26\x3c\xb5\x00\x1b\x02\x1e\x1e\xe1\x21\x64\x14\x9c\x27\xdd\xa4\x6b\xbb\xe7\x7a\x31\x95\xb3\xfd\x91\xbb\xeb\xc9\xd4\x0a\x2b\x42\xe9\x26\xc8\x63\xe6\xb5\x83\x2a\x7d\xcc\xc4\xbe\xe3\xff\xb8\xee\xeb\xd0\xa8\x4a\x6f\xd7\x65\xce\x0b\x88\x90\xa7\x27\x46\xcf\x7a\x07\xe6\x07\x7c\x0f\xe8\xf9\x39\x03\xbb\x92\x25\x9c\xd2\x36\x1a\xca\xb9\xe6\x0e\x2b\x33\x27\xfa\x57\x1b\x21\x55\x2c\xd0\xf9\x30\x48\x8a\xca\x4a\x2c\x85\x8c\x4c\x04\x57\x63\x65\x54\x94\x4c\xa1\x8a\x8c\x86\x98\x78\xdd\xaa\xc0\x96\x0f\x37\x77\x98\xd0\x1c\xb8\xa3\xf5\xa2\x41\x74\x4e\x69\x35\x1d\xce\xe2\x38\x4a\xdc\x6f\x80\x62\xe7\x89\xc0\xe4\x1c\x09\xcd\xac\xee\x76\xe2\x7a\xb8\xdb\x89\x1b\x2a\xf5\x1a\x18\xd7\x0f\x42\xcd\x57\xa8\xc2\x4f\x73\x56\x73\x77\x6b\x65\xfe\xc0\x61\x95\x8a\xc6\xf0\x4a\x95\x1b\x9f\xce\x65\x6e\x53\x7f\xe3\x55\xe9\x93\xdd\x30\xe0\x8d\x7d\xc8\xe0\xc1\x54\x64\x7e\xeb\xc2\x99\x8f\xd4\x6a\x2f\x93\xcc\x07\xb6\x6c\x75\x9a\xf7\x81\x61\x03\x52\x29\x2f\xd2\x5b\x50\x47\x19\x1b\xf7\x53\x46\xcc\xa7\x44\xfa\x56\x39\xe9\x6b\x39\x8e\x4a\x75\xcc\x32\xb3\x71\xdb\x2d\xb7\x32\xd3\x36\x80\x2d\x2c\x54\xc3\xd7\xf4\xad\xd6\x0d\x6e\x4c\x69\xed\xb0\x8f\xbc\x15\x32\x33\x10\x3f\x82\x96\xa7\x4d\xb2\x87\x60\x43\x07\x40\x94\x85\x05\xa2\xb4\xb3\x49\x29\x6d\x76\x3b\x98\x4e\xfd\x73\xf2\x4c\x25\x8c\x04\x65\x26\x3c\x93\x38\x2b\xd3\x9e\x1d\x39\x62\x98\x04\x91\xd3\xbc\xd5\x2a\xd8\x89\xce\xb7\x54\x40\x70\xa5\xf9\x23\x15\xe9\x23\xb1\xc6\xda\xa6\xe3\x81\xc5\x1c\x0b\x2c\xeb\x31\xc9\x9d\xda\xc7\xed\xe5\x1c\x02\x2c\x1f\xf9\x40\xf6\xc3\xb4\x9b\x4d\xd0\xde\x07\x87\xdd\x08\x5f\xa1\xf2\x3c\x54\x0b\x1d\xd8\xb5\x1c\x10\x0b\xd4\x8e\x97\x4d\x1c\xee\xb9\x01\x16\xd5\x15\xbe\x81\x65\x3e\x11\x2e\x22\x50\x27\x39\x73\x53\x7a\xac\xe4\x2f\xe5\x66\x22\x20\x1c\x50\x9b\xd0\xc2\x92\xdb\x34\xb0\x9a\xaf\xc2\xa7\x4c\xa4\x21\x58\x3e\x1c\x1a\xdd\x24\xe4\xd9\x37\xe3\xdc\xd9\x86\x0b\x49\xb7\x01\x87\x58\xa5\x8f\x6d\x94\x63\x6b\x43\x61\x27\xa1\xf2\x16\x32\xdb\x0c\xcc\x99\x7b\xc1\x92\x26\x95\x39\x64\x1f\x33\x6e\x90\x29\x2a\x67\x39\x03\xb3\xa6\x3f\x4d\xbb\xf7\xee\x0e\xcf\x42\x7d\xcc\xdb\x0f\x30\xe5\x66\x1f\x78\xa9\xa9\x89\x92\x3b\x3e\x1f\x99\x30\x28\x03\x38\xfa\x82\x40\x28\xc6\x32\xe8\xfc\xc8\x07\x63\x50\x91\xe6\x7a\xa7\x6c\x86\x3b\x67\xa4\x48\xef\xcd\x7d\xd5\xe6\xad\xbc\x54\x9f\x51\x01\xbd\xd9\xfb\x83\xc3\xf7\x7d\x2b\x8e\x5c\x94\x91\xbd\xed\x22\x1f\x42\x17\xdc\x20\x61\x22\xda\x85\xc4\x31\xb8\xb4\x46\xee\x7a\x8a\x30\xd9\x88\xa9\x9c\x21\x85\x83\xc9\x79\x14\x5d\x5d\xf4\xb9\x4a\xeb\xaf\x62\xd3\x66\xb8\x15\x47\x25\x98\xc4\xac\xda\x38\x66\x46\x60\xad\x9c\x31\x2c\xd8\x46\x6c\x41\x60\x99\x3e\x6a\x4e\x6f\x34\xae\x40\x8f\x5a\xe1\xf7\xe9\x9c\xc9\x7b\x56\x3b\xcb\x58\x45\xf8\xb4\x1a\x8c\x66\xfa\xdf\x0c\x77\xee\x22\xa9\x8b\xc3\xbe\x34\xd8\x73\xde\x98\x16\x04\xbc\xe9\x96\xd8\x1f\xd0\x02\x03\x1d\xc0\x70\x3c\x18\x1c\x6b\x43\x4e\xab\x99\xfe\xa3\x1b\xf2\xfa\x57\x13\x9f\x1b\x5a\x99\x6f\x49\x6d\x7f\x3d\x92\xc2\x32\xa6\xff\xaa\x14\xf2\xd0\x12\xf0\x72\x3b\x28\xc9\x15\x4e\xba\x89\x8f\x83\x9a\x5c\xe1\x7e\x1f\x14\xd8\x8c\x1b\x11\xb8\x6e\xdd\x0c\xc4\xf6\xc1\x3e\x9c\x0f\x71\x70\x3d\x7c\x0d\x26\x99\x54\xd6\xef\xb9\x1f\xe4\xd8\x8b\x67\xc9\x86\x8a\xb4\xde\x30\xf9\x9f\x6c\x53\x3b\xd5\xfb\x74\x46\xee\xe8\x90\x6c\xe9\xd0\x28\xd2\xbb\x76\xec\x60\xe8\xb6\x84\x50\xe3\x49\x52\xe2\x9c\x4a\xd4\x50\x3e\x2d\x68\xf9\x87\xa5\xa5\x78\x48\x41\x04\x26\x2b\xfa\x28\x50\x83\xc9\x5c\xff\xcf\x31\xa9\x02\xa0\xbc\x45\x1c\xaf\x20\xb6\xcf\x32\x41\x0b\x5a\x26\x23\x8c\xc9\x2a\x8e\xe7\x13\x74\x47\xd7\x1e\xc2\x67\x4b\xef\xcd\xef\x1c\xe3\xec\x2e\x8e\xb7\x71\x8c\x36\x13\xb4\x8a\xe3\x36\xcf\x3c\x8e\x83\x4c\x08\xd6\x18\x04\x70\x26\x5b\x4c\xee\xe8\x96\x0e\xdb\x61\x61\x3c\xee\xbe\x57\x1d\xc3\x77\x15\x18\xbc\x32\xfb\x84\x0c\x18\xef\x83\xa0\x4f\x9a\x59\x31\xc4\x69\x44\xd8\x42\xf1\xca\x92\x8f\xf5\x7f\xf5\x6c\x5d\x8e\xc0\xd9\x23\x75\xda\x0c\x01\x2c\x91\x3c\xb5\x4b\x0a\x27\x14\x34\x16\x49\x25\x58\x24\x99\xd3\x9d\x20\x46\x91\xc5\xf3\x0f\x69\x5a\x81\xb1\xab\x19\xc7\x31\xb3\x37\x46\x1c\xb3\x90\xd5\xf9\xaa\x52\x7d\x85\xc1\x21\xfb\x64\x8f\x90\x4c\x1d\x52\xc1\x02\x13\x3d\xca\x6c\x2d\xac\x3e\x8b\x00\x09\x90\x29\xc2\x8b\x8c\xed\x31\x91\xe9\x7f\x98\x69\xa0\x15\x29\xfc\x5d\xd6\xef\x31\xaa\x68\x31\x15\x33\x88\x0f\x0c\x33\x49\xef\x04\x2a\x88\x20\x35\x78\x46\xd8\x93\x84\xde\x0b\x00\xa8\x4a\xd7\x6c\xb3\xe1\x15\xdd\xea\x47\xbc\x27\x77\x7c\x51\x56\x2e\xd4\x56\xfd\x5d\x3f\x10\xdb\x91\x50\x8b\xf4\x9b\xa1\xba\x5c\x30\x46\x1b\xbd\xbf\x68\xb5\x7e\xf2\x86\xea\xed\x26\x31\xe2\xb4\x98\xca\x99\x1b\x20\x8e\x63\xee\x4e\x5b\xd0\x83\x6a\xb2\x31\xe5\x05\xb6\xcb\x5c\xdf\x56\x5d\x36\xa1\x04\x74\x73\x3d\x14\x90\xf4\xf7\xa8\xdf\xdd\xae\x2f\x8f\x32\x06\xde\x65\x1c\xd7\x71\x5c\xb5\x1a\x1b\x7f\xf4\xcc\x0b\xb1\x79\x5d\x71\x86\x1a\x62\x79\x1a\xfd\x84\xc9\x57\x81\x1a\x18\x39\x23\x35\x11\xe9\x6d\x51\x96\x1b\x4c\x7c\xb1\x46\xb6\x05\x31\xc6\xfb\x3d\xf9\x51\xd0\xf7\x69\xa5\x00\x0f\xf1\xb3\x2a\x5e\x5b\xe1\xfc\x17\xe1\x42\xca\xbc\x15\x07\x66\xd6\x2d\x0f\xf0\xa9\x67\xa7\x9b\x36\xb5\x41\xe4\x86\xcd\x12\xc7\x9a\x73\x31\x60\x16\x37\x7c\xa2\x57\x95\x7b\xdc\x1f\xf0\x79\x05\x5f\x72\x99\x77\x70\x59\x5a\xf1\x90\xa6\xa6\x09\x44\x9a\xf5\xa1\x86\xc9\xa2\x29\xac\x1c\xee\x7c\x48\x2c\xa1\x98\x9d\x8f\xc8\x03\x08\xa3\xc0\xd6\xba\x94\x6f\x8b\xae\xb4\xb6\xd5\xe7\x70\xb7\x33\x4c\x64\xaf\x5e\xc8\xfb\x83\xf0\xf3\x12\x8f\x99\x85\x71\xa0\x96\x45\x71\xcf\x93\x73\x71\x18\xe3\xc9\xbc\xb2\x7c\x9a\xb5\xde\xd2\x5c\x70\x29\xdf\x97\xf7\xdc\xd2\x16\xa5\xfc\xc8\xd9\x3d\x37\x0f\xd6\x08\xe2\xc9\x4d\x51\xf6\xa7\x21\xb1\x2e\xb0\xd9\x68\x48\x96\x5c\xf2\x8a\xd9\x38\xa0\x47\xe3\xd6\xf5\x10\x30\x42\x63\x50\x33\xb9\x96\x54\x94\x5e\x2b\xe5\x7e\x75\x3f\x5b\x10\x1f\xd0\x6f\x9c\x5e\x70\xbb\x2e\xd6\x82\x74\x0a\x1e\x19\x86\x24\xd3\x65\x75\x75\x48\x4c\x86\x96\xe6\xf3\xd2\x55\xc5\xb7\x2a\xe3\x53\xe9\x60\xb9\x4d\x50\x50\x7f\xca\x66\x07\x3b\x84\xd8\xf9\x3c\x3f\x72\x30\xd9\x5a\x30\x48\xd2\xde\xb2\x8d\x2e\x0d\xce\x3d\x6f\xd9\x06\xaa\x23\xce\x27\xc8\xbf\x01\x8f\xa1\xae\xa7\x50\xe7\x9d\x75\x09\xd2\x39\xfe\x56\x0a\xe9\xdf\xe9\x87\xb6\x4a\xf3\x99\xdc\x3b\xe3\x74\x16\x38\x34\xb5\xfd\x80\x11\x6c\xfc\x04\x67\x2c\x6d\x1f\xda\x50\xcf\xcc\x47\x5f\x25\xe1\xd2\xcc\xec\xf8\xf6\x7b\x13\x58\x0a\x22\x98\x9a\x0f\xfa\xf6\x18\x58\x51\x68\x97\x95\x97\x73\x30\x13\x4c\x8d\xec\xe5\xfb\x82\xeb\x27\x14\x35\x45\x64\x7c\x35\x3b\x4b\x66\x6c\x5d\xb5\xb8\x7a\xad\x8c\x14\x96\xa3\x68\x5e\xb0\xba\x8e\x88\x4a\x45\x9e\x44\x03\xd3\x6e\x84\x89\xd1\x4c\x55\xa1\x66\x8a\x27\x09\x46\x82\xb2\x54\x9f\x73\x32\x7f\xab\xcf\x3f\x74\xaa\x07\x85\x88\x30\xc6\x2f\xca\xaa\xc9\x90\x08\xe3\xb4\xd6\xd3\xd5\x5f\x18\xb4\xd2\x84\x76\x7f\xb5\x40\x22\x2c\xaa\x38\x16\xcf\x35\xf2\x0b\xdf\xaa\x9f\xca\x9c\xa3\xb6\x04\x0e\x30\x03\xcb\x46\xf1\xea\xfd\x2f\x3f\x7e\x74\x72\xa6\xd7\x82\xfe\xf0\xac\x79\xac\xc2\x4f\xef\x5d\x06\xab\x68\x32\x2e\xc2\x66\xe6\xde\x0b\xf5\xa6\xdc\x02\x0e\x86\x8d\xfe\xb9\xd2\xc7\x01\xcf\x3f\x28\xbe\xa6\x2d\xfc\x69\x5e\x36\xcb\x95\x6c\xd4\x8f\x65\xce\xe9\xf9\xc8\x5d\x7c\x96\xa2\xf8\x22\x0e\xec\x68\x0f\x30\x12\x5a\x7b\x99\xb0\x28\xc2\xc6\x7c\xc4\x78\xe0\x29\xf3\x60\xbc\x42\x29\x87\xa7\x6a\x29\x64\x4d\x25\x71\xe5\xbe\x84\xd8\x45\xc8\x82\x11\xf4\x52\x80\xea\x39\xcc\x68\x2a\x78\xd3\x88\x22\x6f\x23\x46\xa6\x77\xbd\x67\x28\x7c\x90\x09\x8a\xbe\x33\x51\x89\xd2\x85\xfd\x0f\x59\xdf\x85\x0f\xe1\xa8\x84\xfc\x22\x7e\xe3\x7b\x12\xbc\xd0\x33\x75\x64\x18\x3a\xf9\x65\x98\x4c\xfd\x60\xd6\x47\x51\x9a\x0a\xbe\x50\x74\x48\x6c\xc4\x5b\xea\x70\x90\x32\x74\x02\xc0\x49\x95\x1b\xc8\x6e\xd8\x69\xea\x72\xe1\x16\xdb\xe9\xa3\xab\x31\xc0\x7a\x0a\x1e\x41\xed\x1f\x26\x18\x56\x1c\x52\xec\x34\xd0\x27\xe8\x44\x36\x24\xa6\xf2\x6c\xb8\xb7\x33\x73\x30\x13\x07\x9f\x09\x12\x83\xc7\x6f\x43\xad\x9a\x6b\x04\xee\x17\x19\x42\xee\xf0\xb4\x7b\x6d\x91\xa9\xa5\x56\x66\x44\x61\x4d\x18\x19\xbf\x68\xc5\x2b\x80\x04\x94\x87\x51\x53\x03\xb9\xa5\x7b\x29\x49\xe8\x17\x8c\xf7\x18\xf0\x92\x5a\xf7\x30\x6b\xf7\x21\x3b\x81\x76\xcc\xf6\x33\x41\xb5\xa5\x9d\x89\xfe\x90\xdd\x9a\xd3\x0f\x27\x81\x78\x0e\x8c\xd4\xcd\xd8\x21\x8e\x8d\xa5\x5d\x40\xf8\x62\x1c\xf8\x4f\xb9\x43\x96\xd4\xf8\x2c\x58\xdf\xa0\xfe\xd1\x50\x50\xff\x21\x81\x3a\xed\xc1\xbc\x8b\x05\x6a\x26\xa8\x38\x5c\x8b\x85\x5b\x70\x62\x32\x1a\x66\x43\x9c\xf9\x4c\x26\xa1\xcd\x10\xac\x48\x6c\xcd\xb2\x98\xf1\xb6\xac\x7c\xc4\x6f\x33\xec\x9c\xaa\xd6\x69\xb7\xa6\xd3\xe1\x0c\x02\x4c\xb3\xd0\xbd\xd2\x80\xeb\xb3\x6f\x39\x57\x06\x1f\xa1\xe3\x58\xce\xdd\xc1\xf5\xc9\x18\x9c\x24\x25\xc0\x80\x74\x94\xc4\x50\xb7\xd5\x14\x8f\xd1\xd0\x48\x67\xf3\x69\xde\x1a\x1a\x25\x02\x3c\x16\xed\x96\xb8\xb1\x23\xd7\x8c\xc6\x2a\xa1\x65\xe2\xdf\x90\xa0\x14\xe2\x37\x43\x08\xfe\x39\xa3\x43\x4c\x00\xa5\xe7\x49\x0f\x26\x1b\x12\x55\x6e\xb2\x21\x31\xbb\x48\xb8\x5d\x54\xee\x49\xb7\x4d\x2a\xda\x8a\xf7\x10\x61\xdf\xe4\x4c\xe8\x6a\x1f\xc4\xca\x6e\x1b\x5f\xe8\xb5\x51\x16\xcd\x5a\xba\x09\x9d\x91\xa5\x4f\x33\x5f\xa4\x36\x46\xef\x6d\x21\xbd\xb5\xef\xe9\x70\xfc\xef\x9f\x49\x00\xa8\xb8\x4f\xca\xe4\xea\x62\x7e\xe3\x3a\xaf\xd9\xe9\x84\xae\x83\x29\x5b\x18\x7e\x6e\xed\x8d\xd3\xef\xb1\xeb\x94\xfe\xe1\x0d\x9d\xd7\x9a\xc1\xbf\xd7\xd3\x3d\x7f\xd1\x6c\xea\x29\xd3\x4d\x1d\x6d\xc0\x7e\xc1\x84\x6e\xf6\xee\xdc\x2d\x1c\x1e\x95\x5b\xc7\xae\xcb\x7b\x0b\xff\xd7\xc9\x46\x0f\xb2\xd1\xa1\xdd\xfb\x76\x9b\x87\x1b\xeb\x50\xc3\x08\x7c\x87\x0b\xa4\xdb\x02\x65\x19\xae\x64\xb7\x0b\x42\xa7\x1f\xcd\xd1\x8f\x14\xfe\xb2\x63\xc4\xb1\x82\xc0\x85\x74\xd8\x41\xcd\x56\x72\x43\x15\x19\xe1\x13\x88\xe8\xcd\xc0\x0c\x5a\x42\xb8\x86\xe0\x10\x31\xb3\xd5\x74\xb6\xb0\x09\xb1\xd9\x71\xe8\xce\xc9\x8a\xfe\x28\x10\xd7\x9c\xa0\xbd\xd0\xda\xab\xc4\xee\x23\x08\xa2\xa7\x4f\xb5\x05\x7d\x2b\x3a\xae\xdc\xe2\x48\x70\xfb\xe5\xc9\xa3\x6f\x43\x97\x70\xf4\x8d\xe7\xc1\x09\xb2\x6a\x7f\x23\x73\x9a\x60\x32\x3f\x71\x9c\xcc\x03\x0c\x81\xf4\x15\x99\x77\x40\x04\x16\x64\x1e\x48\x6b\xe0\x49\x1f\x6a\x4b\x7b\xa8\x8d\x4d\x54\x38\xd8\x1d\x1b\x4c\xee\x0f\x4e\x5f\x72\x17\x9a\x55\x0a\xfc\x64\x55\x3e\x3c\x05\xf6\xd3\x2a\x7e\xac\x82\xc6\xc7\x66\xb6\xdb\x64\x7c\xa0\xb1\x51\x03\xd1\xd3\xd9\x20\x35\x08\x8e\x0c\x7c\x79\xb5\xdf\x93\xed\xe1\x11\x9f\xd3\xed\xe4\x69\x9b\xd9\xa0\xfa\x77\xa8\x20\x0d\xf8\x3c\x3c\xda\x78\xfc\xed\xd6\xd4\x93\x91\x0d\xf7\x59\x9b\xbb\x7d\xe7\x72\xdf\xa1\x92\xd4\x50\xde\xe6\x26\x86\xe9\x77\x7a\x78\x7d\x30\xf8\x38\x3b\xc8\x7c\x66\xe3\x2a\xef\x53\x0d\xfd\xfb\x48\x83\xa6\xbf\x75\x1c\x71\x67\xfa\xb7\xa0\xf3\xce\x11\xc4\xc3\x23\x88\x2c\xe9\x3a\xd9\x5c\x5e\x25\x0b\x72\x4b\xf3\x74\x4b\xbe\xd2\x3c\x7d\x1c\xaf\x34\x9d\x09\x1f\x19\x1d\xac\xc4\xed\x04\x22\xbb\xde\x26\xcb\xb6\x33\x37\x76\xf0\xbd\xcc\x71\x8c\xa0\xbe\x84\x3e\x92\x1c\x16\x4e\x92\x98\x76\x68\x67\x6e\xcd\xbb\x19\xc6\x19\x54\xfd\x35\x79\xbc\x31\x53\xd7\xd6\xe7\xcf\x46\x53\xfc\x36\xe9\x1e\xe4\xae\x8a\xf0\xa2\x71\x0d\x42\x17\x68\xf8\x2d\x7c\x7b\x30\xad\x0f\x74\x95\x6e\xd1\x2d\x1e\x9f\x77\x08\x7a\x73\x2f\x9f\x23\x03\x29\xb9\xc6\xbb\xdd\xfa\x9a\x0e\x31\x7e\x9a\x5b\x84\x84\xb1\x41\xaf\x7c\x2b\x90\x08\x80\x4f\xaa\x90\x23\x05\x6a\x21\xdc\x13\x90\xd9\x3f\x12\x86\xed\x86\x7a\xcb\x36\x6d\x45\x6f\xd9\xc6\x57\xe3\xd8\x68\x97\x31\x40\xd7\xf0\xf9\x03\xa6\xb9\x3a\xe0\xa3\x5d\x41\xcd\x3d\xb7\x45\xf4\x93\xcf\xec\x19\x6b\xdc\xd9\xde\x65\x6f\x77\x43\xe1\x20\xc1\xf4\xbe\x03\x1a\xd2\x79\x44\x9d\x0e\x76\xba\x86\x01\x9c\x5d\x76\x45\x1f\x66\xb9\xd6\x74\x6d\x40\x57\xbe\xfc\xfd\xf3\x2f\x57\x97\x57\xa4\xd0\x9f\xe7\x53\xd1\xd4\x48\x91\xf5\xe5\x15\x26\x0d\xe5\x7a\xc9\x8e\xbd\x60\x4d\x1f\xf4\x06\x9b\x6d\x4e\x44\xc8\xdd\x1b\x01\xbb\xf0\xac\xbd\x8d\x4d\x32\x6f\xa1\x1b\x56\xb0\x0c\xdf\x95\xd5\x47\x55\xe9\xfa\x31\xe1\x64\xad\x0f\x27\x6b\x56\xee\x66\xe0\xd9\xcc\xe3\x79\x07\x91\xe5\x81\x7c\x25\x1c\x93\xfb\xa9\x98\x19\xee\xa4\x53\xee\xc1\xbc\xb0\x9b\x09\x7e\x6b\x96\xe4\xeb\x29\x78\x1e\xba\xb9\xbc\x22\x55\x38\x07\x89\xb1\x1a\x4b\xd8\x78\xde\xc2\x3f\x48\xd8\xd5\x00\xf7\x47\xa4\x15\x80\xc5\x31\x9a\x77\xd4\x04\xe1\xd7\xbd\x22\x73\xa7\xd2\x81\x42\x73\xa7\x51\x71\x2d\x55\x9a\x9a\x80\x17\x0e\xd6\x04\xdb\xb1\x91\x85\x3e\x05\xf2\x74\x9b\xd0\xe0\x0c\xc8\xcc\x46\xdf\x83\x3f\xb7\x3e\xdf\xec\x9c\xbc\xe4\x78\xdb\x5b\x93\x46\x7f\x92\xbd\x56\xa7\x25\xf7\x1e\xcb\x4d\xdd\x80\x0c\x64\xa1\xe2\x58\x5d\xd3\xca\x70\x80\x71\xcc\x75\xb2\x2a\x37\x71\xcc\x75\xaa\xa1\x13\x2c\xb0\x71\xd5\xbf\x71\x24\x1d\x8e\xe5\xb5\x77\x09\x4c\x12\x89\x4d\xcd\x48\x50\x36\x95\xa0\x0f\xb0\x0d\x08\x73\x64\x09\x77\xb6\xf1\x1b\x2a\x7c\x3b\xf0\x2b\x71\xc0\x85\x5e\xef\x19\x1e\xce\x53\x39\x0b\x1d\xbf\x5d\x30\x95\xef\xef\xb9\x3c\x62\x37\x43\x02\xab\x77\x47\xaa\x30\x1a\xad\xcb\xa6\xe6\x8d\x21\x8c\x20\xf6\xd1\x24\x9a\x17\x62\xfe\x35\xca\xcc\x23\x28\x94\x21\x93\xfe\xb8\xa0\x1a\xb6\xfa\xcc\xd4\x4a\x3f\xe3\x18\x7e\x83\xf0\xd3\xf6\x73\xef\x00\x0c\x6c\x5d\xe7\xba\x94\x79\x35\x76\x65\x41\x98\xeb\xf3\x53\x99\xf6\x3f\x18\x52\xe9\x96\x40\xd4\x7c\x5b\x0b\xa8\xa5\x79\x1c\xfb\xd2\xc1\x4f\xe3\xe2\xaa\x99\x49\x09\x91\x24\x09\xc7\x19\xf2\xdd\x8a\x63\x0e\x1a\xea\x50\x3e\x03\x68\xd0\xdd\x04\x9f\xff\xa0\xb6\x6e\x4e\xdc\x7b\xa6\x1c\x93\x60\x3a\x6c\x17\xe1\xe9\x48\xbf\xf0\x7e\x1f\x80\x63\x7c\xd7\x81\x45\x90\xfc\xe1\xec\xb5\x40\x4f\x73\xa5\xef\x7f\x00\xd1\xb2\x11\x71\xb8\x53\xd0\xec\xf1\xd8\xc0\xeb\x9a\xb0\x04\x48\x11\x08\xa0\xb4\xe1\x29\xcb\xf3\x37\xe5\x16\xcc\x83\x3c\x8f\x4c\xa5\x09\x33\x69\x35\x5b\x56\xe2\x47\x6e\x2d\xe5\x99\xbd\x76\x9c\xf2\x07\x29\x8e\x1b\x5b\x75\xa5\xce\x63\x1e\xc7\xb6\xcb\x3d\x31\xd6\xb7\xcb\x5a\xe3\x77\x53\xcd\x04\xb5\x9e\xd3\x81\x05\xaf\x79\x8d\x89\x9c\xa0\x63\xa3\xf4\x4b\x97\x72\x9c\xd9\x7e\xe0\x4c\x1f\x4c\x1b\x9e\x56\xb0\x44\xfd\x14\xe4\xbc\xe0\x4a\x73\x11\xb6\x4e\xcb\x2b\xf4\xb7\x47\x88\x49\x61\x3b\x07\x57\x49\xb0\x99\xc0\xa0\x8d\x7c\x70\x5a\x94\xf1\x81\xba\x43\x01\x18\x4e\xab\xed\x18\x19\x14\x7a\x10\x0f\x47\x77\x65\x91\xf7\x14\x1c\x81\x26\xa0\xa7\x16\x01\x59\x7a\x14\x39\xbd\xc7\x15\xff\xa3\x13\x56\xbe\xfb\xf7\x09\x2b\x7b\x5f\xee\xc3\xff\x0a\x20\x5f\x28\x80\xfc\x70\x5c\x00\xf9\xe1\x7f\x05\x90\xed\x4c\x1c\x0a\x20\x3f\x74\x05\x90\x1f\xc4\xa1\xc0\xee\x43\x28\xb0\xfb\x70\x5c\x60\x47\x0e\x8d\xea\x5d\xbc\x69\xd3\xc5\x3d\x20\x09\xf7\x58\x2f\x6f\x6f\x3f\x41\x8a\x06\xa1\x09\x0c\x7d\x83\x27\xe6\xbf\xbd\xae\xb3\x11\xbe\x38\xc1\xe9\x06\x88\x8b\xa1\x60\x8a\x70\x27\x92\xb3\xff\x99\x71\x6b\x30\x5b\x5d\x11\xee\x65\x78\xee\x07\x9b\x28\x13\x70\xdf\xca\xeb\xb2\x7e\x0d\x87\x45\x42\x31\xc7\x87\xd3\x62\x8e\x43\x7c\x71\x2f\xbc\x18\xf7\x44\x20\x5d\x71\xc7\x37\x45\x1b\x3d\x28\xb9\x53\x20\x72\xcf\x22\xc6\x85\x98\x95\xa4\xa0\xf5\xe5\x55\xc0\x5b\x35\x74\x08\xf8\xe1\xaa\xdc\x40\x98\x7f\x90\x59\xcc\xa9\x5b\xd4\x20\x67\x83\xed\xd0\x81\xde\x3c\x00\x7c\x7a\x19\x24\xdd\x01\x12\xdd\xe1\xc6\x64\x74\x95\xa0\xc5\x60\x85\x81\x56\xce\x93\x82\x08\xaa\x1f\x33\xc4\xac\x7c\x14\xcc\xe2\xdc\xf4\x4e\x56\x49\x91\x2d\x06\x05\xe4\x45\xf3\x41\xae\xcb\x09\x3a\x1f\xe4\xa4\xa1\x0e\x68\x18\x1d\x2b\x38\x48\x5f\x65\xe9\x2b\xb0\x4b\xf7\x30\x79\x2d\x2c\x1e\x23\x21\x0c\x5e\xf3\xfb\x60\xf0\xc6\x26\xd0\xa5\x59\xe0\xdd\xb8\x1c\x4b\x1b\x85\x12\x02\x49\x0e\xc9\x9a\x0e\xc7\xeb\xeb\x65\x4b\xb3\xae\x71\x00\x04\xb7\x9c\xae\x67\x64\x48\x36\x9a\x7c\xdf\x24\xb4\x36\x06\x51\x61\x06\xf0\xd3\x04\x58\xd7\x80\x6d\x09\x89\x9c\x9f\x0f\x88\x9c\x77\xff\x1e\x22\x07\x2e\xde\x37\x45\x39\xff\x6a\x09\x9d\x8f\x42\x9f\x03\x9f\x05\x7d\x10\xe4\x37\x41\x7f\x11\xe4\x27\x4b\xfa\x40\xd6\x80\xf2\x79\xf7\x62\xca\x07\x4a\x6a\xc2\xe7\xe7\xdf\x4d\xf8\x40\x51\xd8\x3a\x6d\x4f\x4f\xd2\x3e\x90\xe5\x65\xa4\xcf\xcf\x2f\x24\x7d\xda\x56\xf1\x7e\xef\x11\x44\xdf\x88\x33\x21\xcf\x3e\x1a\xc1\x01\xaf\xe8\x67\x41\x3e\x0a\x47\x30\xfe\x06\x0f\x50\x90\xfe\x24\x08\x97\xe9\x8a\x17\x1b\x5e\xd5\xf4\x3d\x09\x4e\x08\xff\x6d\x55\x87\x58\x18\x9f\x70\xd7\x50\x13\x67\xdd\xfd\x41\xea\x12\xa3\x21\x26\x83\x11\x40\xd8\x38\x4b\xc9\xe8\x0f\x91\x41\x62\x14\x97\xa3\xe1\xf0\x02\xf0\x7b\xb8\x04\x85\xae\xe6\x9b\x70\x26\x34\x85\xd1\xda\xc0\xf1\xc0\x10\xd1\x58\x8f\xaa\x38\x8e\x64\x29\xb9\xe6\x37\x54\x9b\x51\xa2\xd0\x18\xb1\x55\xa3\xdb\x83\xe1\xbf\x05\x7f\x80\x93\x4b\x33\x20\x9f\x7c\x9b\xe6\xd4\x02\xcb\x87\xb7\x26\xa8\x46\x6e\x2c\x20\x24\x9e\x8a\x19\x44\x4d\x3f\x78\x55\xc2\xab\x86\x02\xfa\x6f\x4e\x39\x2a\x30\x59\x9d\x8e\xa1\xeb\x3c\xc4\x77\xbb\x7c\xe2\x11\x47\x9a\x89\x42\x35\x91\x84\xe1\x6c\x45\xf2\x89\x42\x05\x29\xe1\x01\x67\x66\x70\xfb\xf7\xe9\xc3\x8a\x57\xbc\x17\xce\xb0\xb3\xc1\x15\x8e\x63\xf8\x15\x40\x02\x19\x85\x9b\x77\x8f\x74\x0a\x38\x8e\x2d\xda\xd8\x34\xf0\x4f\x36\x92\xc0\x6e\x8c\x57\x13\x40\x55\x5a\x6f\x55\xc0\x85\x94\x7b\xf2\x3e\x5d\x08\x99\x83\x6d\x03\x3d\x6c\xd1\xbe\x99\xf4\x09\xcb\xa0\x13\x36\x0b\xb8\x9d\xec\x0f\x28\x50\x19\x58\x21\x4f\x54\x26\xfd\x1a\x16\x74\x08\x9a\x39\x17\xa8\xfe\x9a\x81\x41\x1a\x48\xc3\x1d\xcf\xa5\x3f\x86\x20\x2d\x22\xbb\x5b\x9d\x83\x91\xeb\xf7\x4f\x7c\xab\xfe\x71\x30\x99\xba\xe1\x3e\x40\x9b\x84\xf0\x41\x74\x30\xc2\x41\x17\x64\x32\x1a\x8b\xeb\xb6\x13\x2e\x3a\x2f\x03\xb8\x3f\x10\xcd\x87\xe6\xbe\x7b\xd7\xec\xa7\x8a\xdf\x8b\xb2\xa9\x7f\x57\xd3\x1e\x59\x21\xec\xc0\x60\x34\x16\x37\x74\x38\x16\x0e\xf4\xfa\xb9\xa6\x5d\x6c\x23\x7a\xe8\x2f\x62\x03\xcf\x07\xfe\x17\x0a\xe3\x38\x0e\x62\x63\xeb\x0a\x58\xb1\x2e\x6b\xf5\xfd\xbf\x1a\x56\xf4\x01\x0b\xba\xe1\x65\xd8\x5d\x8d\xd4\x80\xe3\x6b\xd9\x16\xfb\xc7\xaa\x2c\xfa\x8b\xd6\xac\xbc\x00\x5f\xa1\x8d\xd6\x22\x07\xfc\x5a\xef\x68\x99\xf0\x1b\x4d\xa2\x40\x6c\xc7\x23\x3d\x3f\x53\x69\xc5\xf3\x66\xce\x11\x3a\xea\xa7\x63\x06\xc6\xf1\x44\x65\x5e\xb1\x05\xa7\x38\x26\xa7\xc2\xec\xc0\x58\xd7\x42\xfe\x3b\x5a\x13\xb2\xd7\xda\xc1\x31\x00\xad\xd5\xfa\x46\xb7\x3e\xae\x4b\x39\x39\xd2\xb0\x7f\xa9\xbf\xc5\x31\x6f\x55\xbd\x47\x90\xa2\x89\xf2\x61\x89\x21\x84\x95\xba\x19\x4e\x46\x99\x59\xf2\xde\x1f\xf7\xe8\xc8\x2e\x90\xf3\x5f\x18\xfd\x75\x88\x4d\x7e\x0b\x5e\x7e\x22\xff\xe8\xaf\xc3\x4b\x07\x68\xad\xf3\xdf\xe6\xc6\x57\xf9\x53\xc1\xe6\xbd\x42\x5d\x7b\x7d\x85\x43\xd8\x92\x11\x48\xcd\xc2\x55\x70\xc1\xf1\x25\xd7\xa7\xf8\x18\xf3\x0b\x3a\x1a\x12\x99\x24\x7e\x61\xc0\x5a\x5e\x72\x13\xe4\xe1\x5d\x55\xae\x41\x56\x7b\x74\x65\xf1\x74\x3b\x50\xe9\x16\xf8\x84\xc7\x81\x4a\x1f\x9d\x5f\x31\x18\x6c\xcb\x0b\x99\x88\x8b\xd6\xbd\x98\x29\x26\xaf\x90\x20\x2d\x3e\x4d\x75\x3d\x48\x5f\x39\xcc\xee\x38\x46\x55\x42\x5b\x08\x6f\xf2\x04\xa6\xd9\x59\x05\x8e\xae\x4c\xce\x79\x66\xf6\x99\x7b\x7c\xc3\xd5\x03\xe7\x12\xba\xd7\x8f\x3e\xdb\xf9\xaa\x1d\xeb\x71\xd7\xe7\xd0\x78\xdc\xf5\xfe\x0a\xdb\x7d\x28\x58\x0d\xae\x13\x47\x3f\xcc\x1f\xae\x28\x1d\x4e\x86\x59\xfa\x0a\x3e\x0a\xe8\xba\xfa\xb9\x3b\x0c\x7e\x3a\x6f\x2a\x7d\xef\x7d\xc7\xef\xc5\xdc\xf8\x64\x7c\x66\x4a\x94\x84\x51\xe9\xc3\x44\x9c\x85\x30\x28\x7c\xc0\xf0\x85\xc0\x97\x22\x61\xb0\x7c\x37\xa0\x67\x68\xaa\xfb\x63\x28\x31\xf6\x5c\x02\x47\x81\x09\xcf\x14\xa9\x28\x87\x90\x71\x36\x41\x3a\xff\xe4\xee\x44\x54\xe9\x76\xc0\x7a\x13\x51\xa5\x8f\x03\x66\x26\xe2\xb8\xed\x7d\x99\x6e\x07\x55\xaf\x50\x99\x3e\x0e\x2a\x5b\xa8\xa1\xf5\x25\xc4\x26\x21\x39\x2d\xec\xaf\x15\x15\x17\xa8\xa1\x66\xd3\x34\x78\x32\xcc\xc0\xa0\x5d\x5c\xa0\xdc\x26\xe6\x3a\x31\xf7\x16\x8f\x1b\x7b\x7e\x67\x4f\xdb\x4c\xf7\x72\x75\x01\xed\xb2\x74\x8b\xc9\x63\xa6\xfb\x08\x29\xd0\x55\xbc\x27\x92\x6f\x95\xcd\x9a\xcc\xfb\x59\x4d\x8a\xcd\x0a\xcb\xe7\xfb\x4f\x5f\x3e\x7c\xfc\xf9\x27\x47\x37\xd8\xc7\xdd\x6e\xc4\x07\xa3\x3f\x75\x27\xfb\xc7\x52\x96\xaa\x94\x9c\x7e\xc3\x6e\x1a\xc2\x33\x21\x65\x0c\xd2\xbb\xf6\x9e\xad\xcb\x20\xd8\x93\x67\xce\xef\x4f\x13\x91\x8a\xfd\x90\x0d\xc9\xfa\x07\xcd\xee\x03\x1c\x56\x7e\x18\xcf\x69\xe5\x50\x85\xce\x91\xa0\xf9\x94\xeb\x06\x74\x79\xf8\xb8\xb0\xef\x25\xe5\x37\xc3\x49\x3e\xe5\x83\xd1\x2c\x73\x16\xee\xfc\x7a\x35\x18\xe9\xc4\xc4\x26\xe2\x38\x3e\x67\x9d\xa2\xc6\xd8\xc2\xa5\x6d\x07\xc2\xfd\x1a\x8b\xd4\x74\x0e\xe2\x5d\xcf\x27\xc8\xe5\x79\xf4\x79\x1e\xf1\xe5\x3c\x1b\xee\xcf\x4d\x08\x76\x5f\xe9\x44\xa4\xeb\x1f\xa8\x2b\x9e\x9d\xb3\xdd\x8e\x1d\xbc\x96\xee\xb5\x89\x6f\xa4\x8f\x5c\x97\x84\xcf\xad\x75\x00\xa4\xba\x7a\xb0\x29\x37\xcc\xe0\x9f\xcf\x9c\xf8\xf7\x97\x57\xfb\x60\xc2\x00\x28\x95\x63\x33\x5b\x84\x51\x33\x09\x44\x04\x1d\xe9\x76\x6b\xb7\x43\xdd\xbb\xd7\xb7\x4c\x6c\xc8\x12\xbb\x46\x6c\x47\x98\xe9\x0d\xf8\x59\xad\x7f\xb8\xf4\x99\x4b\x78\xd3\x3e\xa3\xa2\x8d\xdb\x5d\x75\x77\x8c\xde\x2b\xd7\xf4\xff\xee\x76\xa8\xa6\x7f\xbc\x6c\xf7\x59\x01\xb2\xb1\x1f\x68\x75\x51\x5f\xf8\x7a\xa0\xbd\x32\x48\xc1\x16\x9d\x31\x5c\x21\x47\x56\x07\xf8\x1b\xf5\xd7\xc6\x91\xa5\x41\x64\x1c\x9f\x87\x9f\x31\x8e\x51\x43\x91\x5f\x0f\x03\xf7\x6e\x8b\x2f\xff\xe8\xe7\xd1\x5a\x2f\x1b\xaf\x40\xbb\x61\xff\x49\xdb\x42\xcd\xb3\x39\x7f\xf5\x39\x1f\x07\xcd\x85\x1e\x33\x26\xac\xb7\x44\x4d\x37\x8e\x2c\xd0\x53\xdd\xd0\xe4\x66\xd0\x85\xe4\x78\x17\x74\xae\xa0\xf9\xc4\x36\x0f\x27\xbf\x3e\x4a\x40\xe5\x70\x82\xfa\x92\x13\x4d\x2d\xb5\x61\x98\xd5\x74\x38\xcb\x94\x99\xca\xfe\x9b\x2e\xcc\xb3\xce\xa2\x5b\x70\x67\xdb\x37\x5a\xb9\xa6\xc3\xc3\x2a\xf4\x3f\xfb\x66\xe8\x12\xa0\xd7\x62\xce\x7f\x6a\xd6\xa7\x89\x3f\xe3\xd4\xfb\x3e\x2d\xca\xe5\x68\xa8\xa9\x03\x22\xa8\xea\x45\x26\x77\x51\x9c\xf8\x44\x5c\x8f\xd2\x57\x93\x51\x26\xae\xff\x38\xb9\xca\xc4\xf5\x5f\x26\xaf\xb2\xd1\x30\x13\xd7\x74\x04\xa9\xf4\x0a\x92\xe9\x2b\x48\xc7\xbd\x90\xe3\x60\x2e\xc1\xff\xd5\xf0\x5a\xbd\x96\x62\xfd\xae\x62\x6b\x4e\xa3\x46\x93\xda\x42\xf2\xbc\xe5\x59\x1f\x84\xcc\xcb\x87\x0e\x2d\xa6\x10\xde\x67\x26\x3d\xac\x03\x94\xc1\x50\xd1\x6e\x67\xdf\x3e\xf0\xbb\xaf\x42\x7d\x7e\x36\xcf\xba\xfc\xed\xf9\x0c\xe5\x37\xca\xd7\x27\xde\x1f\x21\x0a\x6c\x91\x9a\xab\x5f\xc4\x9a\x97\x0d\x30\xe1\xfc\x8f\x97\x7f\x36\x94\xde\x92\xab\xcf\xbc\x00\x15\x95\x73\x8a\x3d\x19\x2e\x5f\xa5\xc6\xbf\x99\x15\xa0\x1d\xd9\xed\x14\x58\x9f\x2b\x56\x2d\xb9\x7e\x4a\xeb\x6a\x6e\xed\xbd\x4d\xa4\x23\xae\xde\x68\xaa\x41\xc8\xe5\xdb\x42\x70\xa9\x40\xf9\x8d\xc1\x77\x46\x95\xcd\x7c\xc5\xeb\x71\x1d\xc7\xce\xe7\xf8\x66\x38\x41\x92\xd6\xe0\x60\x07\xd9\xff\x49\x44\xf8\xf8\x2b\x84\xb5\x67\xc1\x4b\xe6\xdf\xd8\xd8\xf5\x01\x1b\xf3\xbe\x75\x5d\xa8\x48\x64\xe5\x8d\x03\x63\x94\xa4\xa9\x81\x6f\x66\x55\xe5\x46\xe7\xcc\xbf\x9d\xd3\x04\xca\x85\x7b\xf2\x9b\x79\xad\xf4\x15\xa0\x4d\x4b\x23\xda\x1c\x94\x20\xf6\x1c\x14\x83\x1c\x50\x39\x4d\x96\x41\x99\xaa\x72\x33\x68\x06\xab\x36\x5a\x57\x87\x63\x42\xd2\x17\xc4\x97\xf3\x8b\xca\x62\xb9\xf0\x53\x34\x9d\x26\x3b\x44\xa7\x02\xe1\xda\xc0\x97\x8b\x8b\xca\xe1\xbf\x9c\xae\xc0\xd1\xdf\x6f\x4b\x59\xab\x8a\x09\x69\x2c\x7b\x8e\x91\xa2\x7a\xed\x44\x6b\xb6\x1d\x40\xa7\x22\xd0\xe4\x72\x9b\x3f\xc2\x07\xf5\x58\xd5\xd1\x73\x15\x99\xde\xf9\x9a\x4c\x09\x53\xd5\xed\x9c\x15\xf3\xa6\x60\x8a\xdb\xf8\x16\x27\x8e\x2f\xc4\x69\xf0\x51\x40\x8e\xd6\x11\x42\xdd\x0c\x46\x13\x79\xe1\x85\x55\x9c\x8c\x86\xf8\x72\x34\x1c\x66\xdd\x24\x68\xb2\x23\x32\x3a\xa4\xc2\xa8\x0a\xa4\x58\x1e\x4d\x27\x8e\xa3\x69\x09\xf8\x6f\x67\x5f\x56\x2c\x2f\x1f\x3e\x97\xa5\x9a\x45\x00\xa8\xa7\xca\x2f\x20\x3a\x43\x18\x02\x33\xf1\x74\x55\xd6\x0a\x13\x6e\xe7\xea\x47\xb6\x15\xeb\x66\x7d\x38\xe1\x2e\x40\x6a\x4f\x88\xa5\xc0\x62\xe8\x9c\xb7\x62\x9f\xe0\x0b\xf8\xf0\x74\x41\x1a\x11\x54\x0e\x8e\xcc\x25\xe2\xbd\xdd\x43\x24\xfe\x56\x3e\xb3\x21\x08\xa0\x39\x1d\x5b\x31\x01\xb7\x6f\xc8\x6c\x86\x27\xa2\xe5\x9b\x05\x61\xb8\x3b\xec\x23\xeb\xe3\x77\x8e\xdb\xd4\xd0\x1b\xb8\x55\x4a\xbc\x60\xe4\xa0\xa3\xfd\xf6\xc0\x1d\x00\xd2\xb1\x91\x9b\xc6\x5e\x3e\x74\x6b\xfb\x78\x8c\x79\xf4\x7c\x1b\xe4\x99\x74\x1f\xa7\x7c\x96\x1d\x13\x75\x1e\x4a\x2d\x15\x38\xdf\x1b\x54\x89\xaa\xdc\xf0\x4a\x3d\xba\xc8\x02\xe6\xae\x54\x42\xb2\x2f\x73\x76\x42\x7a\x73\x92\x7b\xa4\x7c\xb7\x0b\xee\xd5\xf3\xee\xbd\x1a\xc7\xf6\x4a\xca\x7b\xc5\x76\x3b\xf0\x80\x1f\x9d\x53\x1a\x72\xa8\x60\x9a\x05\xb7\x8f\xb5\x91\xad\x9c\x6a\x74\xdc\xea\xd6\x2e\x24\x71\x6a\xb4\xea\x02\x5c\x0b\xd4\xd6\x20\x11\x20\x09\x11\x9a\xad\xdf\x91\xc9\xbf\xdb\xb9\x67\x28\xb2\xdb\xa1\xee\x7b\xca\x92\x68\xb3\x8d\x48\x27\x17\xad\x20\xd1\x9c\x82\x46\xcd\x5f\x9d\x3e\x6b\x00\x0e\x38\x4a\x94\x2e\x73\x16\x25\x20\x0e\x0b\x82\xd2\x9e\x64\x96\x91\xa0\xc2\x60\x7d\xe4\x4c\x81\xed\x30\x53\xcc\x42\xb1\x88\x74\xc9\xaa\x3b\xb6\xe4\x6f\xcb\xa2\xe0\x73\x75\x90\x00\xbe\x18\x26\x98\x9d\x01\xd9\x43\xae\x06\x7a\xbc\x82\xe9\x8c\x98\xec\x94\x83\x83\x34\xfc\x1a\xf7\x9c\xca\xe9\x90\xcc\xa9\x0c\x19\xc0\x92\x0e\xc7\xe5\xf5\x7c\x5c\x26\x89\x66\x00\x8d\x10\x1e\x35\x54\x4e\xcb\x99\x66\xe7\x34\x77\x16\xc6\xe4\xc5\x2b\xfa\xbe\x6b\xbd\x6e\xb0\x89\x49\x63\xbd\xec\x3b\x32\xec\xc6\x28\xa9\x0c\xf6\xa9\x47\x17\xae\xaf\x8b\x71\x9d\x24\xd8\xb8\x6d\xa2\x9c\x36\xd3\x7a\x86\x77\xbb\xb6\x5c\x8e\x77\x3b\x74\xaa\xa5\xdc\xda\x68\x2e\xbc\xb7\x9b\x89\x59\xbf\xb8\x71\x23\x33\x82\x29\x33\xb4\x05\x0c\xcd\x6a\x53\xd8\xb4\x9a\x96\xb3\xd9\xb8\x02\x06\x7c\xce\xd1\x90\x2c\xb0\x43\x91\x58\x81\xc8\xb0\x6d\xf0\x34\x9c\x2b\xe5\x41\x2c\x8b\x6a\xb7\x43\x26\x85\xaa\x4e\x7f\x99\xb3\xa7\x95\x0e\xf5\x01\x93\xea\xc6\xa0\x1e\x56\x98\x08\xa0\xaa\x41\x3e\xf0\xf3\x02\x74\xe8\x00\x5c\x72\xe4\x64\x1c\x3d\x2f\xd4\xef\xaa\x0c\x1c\x39\x7f\xc3\xe1\xf2\xf1\x12\x67\x88\x01\xa5\x9b\x84\x08\x7d\xf4\xf6\x98\x7c\x52\x85\x6e\xe8\x6f\x61\xb7\xfe\x67\xc5\x72\x7d\xbe\x42\x8c\x82\xa3\x4e\xd1\x98\xdc\x9e\x12\x67\xce\x4b\x59\x97\x05\x4f\x79\x55\x95\x15\x8a\x20\x7b\xfa\x3f\xf5\x99\x2c\xd5\xd9\x42\x13\x2e\xe7\x11\x26\xca\x1e\x92\x60\x6b\x65\x5c\x08\x5f\xd6\xb5\x4f\x4c\x29\x5e\xc9\xdd\xee\x99\x6e\x4f\x54\x66\x47\x8c\x14\x4e\x6b\xa6\x9a\x8a\x29\x8e\xd2\x57\x7a\x53\x56\x5f\xb9\x44\xe9\x08\xa7\xd5\xf2\xce\xdd\xd8\xfb\x3d\xc2\x84\xb7\xa0\xb2\x35\xad\xa4\x7e\xf6\xe4\x39\xfd\x0f\xfd\xc8\xdc\xe3\x17\x5e\xe9\xa3\x8f\xfe\x4d\xa7\xb6\xce\xb6\x35\xfd\x9b\xd2\x29\xd6\x33\xf6\xad\x7f\x41\x05\xa4\x7b\x0c\xba\x9f\xf4\x93\xa5\xb4\xe9\x0f\xfa\xc1\x39\x14\xd0\xaf\x90\xf3\x83\x54\xbc\x62\x30\x1d\xb4\xe2\x3a\xa5\x60\x8f\x65\xa3\x6a\xba\x81\xa7\x4d\xc1\xd4\xa2\xac\xd6\xf4\x67\xfb\x08\xe8\x5c\xf4\x23\x3c\x99\x13\xff\x11\x46\x00\x47\xa8\xeb\xee\x67\x78\x0d\xd0\x98\xb4\x84\xd7\xbf\x18\x1c\x5a\xfa\x2b\x0f\xf4\x85\x66\xbd\x2d\x44\xdf\xa5\xa5\x57\x5d\x5a\xf1\xa5\xa8\x15\xaf\xa0\xbd\x5f\x1e\x37\x1c\x71\xa2\x88\x6a\xb1\xf6\x60\xf9\x7d\x14\xf8\xa3\x48\x57\xac\xfe\xf9\x41\xba\x5b\x0a\xbd\x11\x38\x8e\xdb\x8e\xfb\xaa\xd0\x47\x31\x7d\x23\x66\x78\x1c\x8c\x31\x88\x61\x62\x4d\xad\xbf\x17\x94\x7b\xcb\x88\x67\xee\x29\x64\x2f\xaa\xb7\x2b\x56\x29\xca\x25\x7c\x63\xf7\xa0\x7f\x1b\xab\x45\xea\x75\xa8\xa9\x53\x32\x9b\x79\x52\x05\xa7\x4e\xa5\xda\x79\x65\xba\xed\xa6\xb5\x1d\x87\x7e\xf7\x09\x7e\xbe\x61\x35\xbc\xb0\x1f\xd9\xdb\x7f\xed\xa1\x0f\xe6\x4e\x7c\x6f\xd5\xb3\xc1\xcc\xdb\xcb\xd2\x7f\xef\xa0\x09\xbb\x00\xa0\xdb\x00\x46\x08\xd3\x0e\xed\xbc\x95\x07\x5f\x6f\x1a\xbd\x61\x55\x44\xa2\x37\xcd\xdd\x5d\xc1\x23\x12\x7d\x67\x5d\x59\x23\x12\xe9\xe2\x11\x89\x3e\x95\x05\xab\x5e\x57\x9c\x45\x24\xfa\xcc\x72\xc8\xfe\x65\x0e\xdb\x2b\x9a\xf5\x34\x88\x72\xaa\x66\xf4\x28\xd0\x9c\xe4\x0f\x67\x5c\x27\x05\x3d\x00\x15\x39\x92\x70\xeb\x3d\xe9\x6f\x92\x19\x57\xc1\xd7\x0a\x0d\x71\xaa\xca\x8f\xe5\x03\xaf\xde\xb2\x9a\x23\x9c\x28\x1b\x47\x63\xa4\x17\x0b\x08\x2b\xbf\x17\x7b\x8c\xc7\xff\xe7\xff\x07\x00\x00\xff\xff\x72\xb1\x0a\x44\x15\xa4\x02\x00")
+var _jsChartJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xfd\x7b\x77\xdb\x38\xb2\x28\x8a\xff\x7f\x3e\x85\xcd\x7d\x5a\x43\x88\x10\x25\xd2\x96\x63\xd3\x46\xb4\xd2\xe9\xee\x49\xf6\xe9\xd7\x74\x7a\x5e\x5b\xa3\x9f\x17\x2c\xc1\x16\x26\x34\xa8\x26\x21\x3f\x62\xe9\x7c\xf6\xdf\x2a\xbc\x08\x52\x94\xe3\xcc\xec\x7b\xee\xbd\xeb\x76\xd6\x8a\x4c\xe2\x51\x28\x14\x0a\x85\x42\xa1\x0a\x1c\xf6\x0f\xff\xc7\x41\xff\xe0\xed\x92\x96\x32\xfe\x67\x75\x70\x97\xc6\x67\xf1\x31\x24\x2d\xa5\x5c\x55\xd9\x70\x78\x7f\x7f\x1f\xcf\x21\xfb\x9f\x55\x5c\x94\x37\x90\x15\xce\xd1\x41\x3a\x4a\x47\x75\xb5\xb7\x85\x90\x25\xbf\x5a\xcb\xa2\xac\xa0\xc4\x2f\x2c\x67\xb4\x62\x8b\x83\xb5\x58\xb0\xf2\x40\x2e\xd9\xc1\x0f\xef\x7f\x3d\xf8\x9e\xcf\x99\xa8\xd8\xff\x38\xe8\x0f\xff\xc7\xe1\xf5\x5a\xcc\x25\x2f\x44\x28\x31\x43\x4f\x41\x71\xf5\x4f\x36\x97\x01\x21\xf2\x71\xc5\x8a\xeb\x03\xf6\xb0\x2a\x4a\x59\xf5\x7a\x01\xc0\xb8\xe6\x82\x2d\x82\x43\x9b\x79\x5b\x2c\xd6\x39\x9b\xe8\x3f\xb1\x29\x4a\x58\xe8\x60\xa2\x27\x59\x3e\x3e\x95\x4c\xae\x4b\x71\x50\xb2\xdf\xd6\xbc\x64\x61\x70\x5b\xdc\x32\x21\x03\xb4\x9d\x53\x39\x5f\x86\x12\x3d\x6d\xb7\x21\x42\x59\x60\xeb\xd5\xcd\xeb\x26\x7b\x3d\xfd\x37\xa6\xb7\x8b\x89\x7e\x0c\xa7\x81\x81\x17\xcc\x70\xdd\xa0\x44\xb6\xb5\x7d\x58\xc8\xbd\xed\x6f\x11\xca\x42\x49\xe4\x66\x53\xb1\xfc\x1a\xc5\x8a\xac\x84\x85\x32\xd6\x15\xd0\x36\x94\x4b\x5e\x35\x5b\x0b\xd6\x15\x3b\xa8\x64\xc9\xe7\x32\x38\x97\x44\xf6\x7a\x32\x5e\xd2\xea\xa7\x7b\xf1\x73\x59\xac\x58\x29\x1f\xc3\x60\xc1\xae\xe9\x3a\x97\x01\x9a\xc8\xd8\x3c\x67\xf2\xfc\x8e\x96\x07\x8c\x3c\xd1\x9c\xcf\xd9\x55\xbe\x66\xd9\x34\x3d\x1e\xe1\xf4\xf8\x14\xa7\xe3\xf1\x0c\x53\x21\xf9\x6f\x6b\x76\xbf\xe4\x12\xb2\xc6\x23\x9c\x1e\x8d\x71\x9a\x40\xd6\x6f\x6b\x9a\x4d\x47\x50\xce\x94\xfd\x6d\x4d\x6f\x69\xc9\x05\xcb\xa6\x49\xfa\x0a\x12\x71\x9a\xa4\x33\x4c\x3f\xad\x4b\x0b\xd8\x16\xbe\x62\xfc\x46\xa5\x8d\x71\x7a\x3c\xc6\x69\x3a\x9a\xe1\x2b\x5e\xfd\xa6\x30\x80\x42\xe9\x29\x4e\xce\x4e\x66\xf8\x2a\xa7\xf3\x8f\xd0\xce\x08\x43\x91\x9c\x8a\xf9\x92\x2d\x68\x7e\x5b\x88\x85\x29\x0a\x18\x8d\xc6\x90\x09\xb5\xa1\xa4\xc2\x07\x5e\xef\x78\x91\x33\x99\x4d\x93\xa3\x53\x7c\x7c\x84\xd3\x14\x20\x96\xc5\xbd\xc8\xa6\xc9\xc9\x18\x1f\xa7\xf8\x38\x9d\xe1\xab\x75\x99\x3f\xde\x17\x05\x00\x4c\x53\x9c\x9c\x1e\xe3\xe4\x68\x3c\xc3\x73\xba\x60\x12\xc0\x64\xd3\xb3\x31\x4e\xc6\xa7\x38\x39\x19\xcd\xb0\x9a\x01\x25\x5b\x57\x5e\x47\x55\x72\x31\x2f\x72\xaa\x08\x95\x8c\x70\x32\x1a\xe3\x23\x48\x2e\x4a\x9a\x6b\x4c\x81\x2a\xa7\x3a\x49\x5c\xe7\xc5\x3d\x2b\x35\xf0\x64\x34\xc2\xc9\xf1\x19\x4e\x8f\x5e\xe9\xcc\x8a\xe7\x1f\x4d\xe7\x60\x24\x80\x38\xf3\x92\xdf\x56\x85\x00\x0c\x47\x38\x1d\x61\x85\xc8\x23\x15\x8d\x21\x58\xd0\xf2\x63\x4d\x85\xe4\xe8\x4c\x27\xd9\x72\xc9\xd1\x59\x9d\x78\x53\xe4\x0b\x26\x4a\xe8\xb4\xee\xf0\x31\x4e\x12\x93\x55\xd2\x47\x20\xd0\x19\x36\xff\x6d\x32\x63\x1a\xce\x48\x0d\x86\x49\xeb\x2a\xfa\x71\x49\x3f\x72\x00\x7c\x86\x93\xd3\x23\x9c\x8c\x5e\xe9\xf4\x5b\x7a\xc3\x84\xa4\x30\x20\x67\x3e\x86\x45\xce\xef\x98\x81\x7f\x3a\x86\xf2\xf8\xd8\x54\x29\x4a\x2a\x6e\x0c\x57\x24\xc7\xae\xe1\xa2\x9c\x2f\x39\xe0\x3e\x3e\xc2\xc0\x96\xa3\x63\x5d\xbc\x64\x0b\x0b\xdd\x94\xac\x14\xb3\x64\xd3\xf4\xe8\x08\x27\xe3\x11\x4e\xd2\xd4\x64\x30\x6a\x9a\x4c\x8e\x8f\x70\x72\x7a\x8a\x93\xe3\x23\x93\x05\xe3\xa8\x29\xf9\x2a\xc5\x27\x49\x8d\xa9\xca\xd1\x04\x3a\x7e\x85\x5f\x9d\xe1\x57\xcd\x0c\xb6\x93\x21\xd7\xe5\x6f\xeb\x82\x03\xb7\x00\x9e\x27\x38\x1d\x99\x1c\xc7\x9d\xc7\xa7\xc0\xb4\x8a\xfc\x8c\xad\x56\x5c\xd8\xd1\x07\xbe\x00\x3a\x30\xb6\xaa\x3e\x3e\xda\xa1\x4d\xce\x12\x33\xde\xfc\x56\xa3\x02\xcc\x66\xfe\x9b\x54\xb6\x93\x5a\x2c\x6e\x2c\xc3\x1d\x01\xdc\x63\x0d\xe3\x9a\x97\xec\xaa\xe4\x30\xc9\x92\x57\xa7\xf8\xe8\x18\x1f\x1d\xcf\xf0\x75\x0e\x6c\xeb\xe6\x3d\xf0\x17\x88\x85\xd1\x0c\x5f\x17\x25\xab\xa4\x21\xdc\x11\x30\xce\x99\xae\xb1\x9e\x2f\x2b\x4e\x35\xe2\x66\x0a\xde\x50\x2e\xaa\xab\xa2\x2c\x0c\xdb\xea\xff\x33\x7c\xb3\x2c\x2a\x69\x81\x1f\x9f\xd6\xf2\x06\x98\xd2\xb4\x97\x8c\x61\xa8\x3d\x2e\x4d\x13\x98\x7f\x63\x7c\x94\xce\xb0\xe9\x35\x08\x09\xfd\x7f\x86\x6b\xee\x4c\x4f\x55\x4d\xc0\xf0\x91\xe5\x79\x71\x0f\x3d\x3b\x82\x06\x14\x53\x19\xda\xf8\x75\x97\x85\x60\x8f\x0b\x76\xef\x09\xa9\xe3\x11\x24\xcb\x7a\x2c\x14\x21\x61\xf6\x72\xb1\xe0\x54\x28\x3e\x4b\x47\x63\x7c\x96\xe2\xb3\x54\xa7\xde\x14\xd9\xf4\x15\x74\x3e\x81\x89\xcf\xef\x8a\xf2\xd1\x12\xcf\x40\x34\xf3\x42\xb5\xa2\x06\x61\x34\xc3\x39\xbd\x63\xb0\x32\x02\x87\xea\xe4\x74\xec\x25\x5f\xe5\xeb\x6a\x69\xc0\x40\xb5\xe3\x31\xe4\xdd\x0b\xd3\xdf\x24\x85\x71\x4c\xa1\xc7\x39\xbb\x2d\xc4\x7c\xc9\xaf\xaf\x95\x9c\xb0\x83\x06\xa2\x31\xe7\x37\x4b\x23\xc9\x14\x29\x92\x13\x68\xc8\xa4\x5b\x09\x75\x3c\xaa\x29\xa2\x33\x94\xdc\x48\xd3\xe3\x5a\xc2\xa8\x74\x37\x28\x96\xbc\x8a\x39\xe0\x7f\x62\x61\xea\x01\x4a\x93\x04\x9b\xff\x2e\xdd\x4c\xb8\x63\x9c\x1e\xc1\x84\x3b\xae\x33\x3a\x2b\x78\x03\x70\x9a\xe2\xe4\xec\xc8\xa4\xbb\x59\x3d\x1e\x83\x54\xd6\xb3\x5a\xe7\xb8\x69\x7d\x94\x62\x60\xe9\xe4\x95\xc5\xca\xcd\xa1\x44\xad\x19\x27\x86\xd2\x2a\xab\x9e\xd7\x49\x02\x62\xf2\x04\x27\x63\xd7\x58\x3d\xb7\x3b\x32\x25\x63\xb9\xa5\xed\x09\x4e\xce\x4e\x70\xea\x90\xa9\x29\x64\xb8\x20\x55\x1d\xbe\x65\x56\x6e\x2b\xd4\x6e\xad\xec\x03\x1a\x8e\xc6\x58\x63\x25\x20\xc5\xcc\x3b\x3d\x5c\x4e\x7c\x7a\x73\xec\x96\x96\x05\x10\x02\x86\x4e\x49\xc7\x5b\xb6\xe0\xeb\xdb\xc6\x6a\x3c\x4a\x15\x58\x45\x08\x9d\x5d\xaf\x12\x8a\x3f\x74\xa2\x93\xa9\xa7\x27\xf8\x14\xa6\x60\x62\x73\x56\xeb\x72\x95\x03\xa4\xe3\x57\x38\x49\x52\x9c\x26\x67\x36\xab\x26\x37\x8c\xc2\xab\x33\x9c\x24\x47\x2e\xaf\x16\xa3\x49\x0a\xcb\x80\x1a\x75\x97\xbb\x2a\xb9\xb8\x31\x95\xa1\x37\x23\x9c\x8c\x8f\x6d\xae\x27\x36\x5f\x01\xfa\x67\x5a\xc6\xeb\x4c\x2d\x39\xd5\x1c\x4c\xce\xce\x70\x0a\x02\x1a\x5a\xe5\x0b\x51\x73\x7a\x0a\x24\x07\x74\x21\x43\xc8\x79\xc9\xe8\xad\x51\x39\x14\x3b\x03\x31\x78\x25\x1f\xcb\xa2\xb2\x42\x2e\x85\xd5\x16\xe8\x51\xcc\xe7\xb4\xe2\x76\x1a\x81\x9c\x38\x4d\x66\x58\xd0\x3b\xfa\xcf\xc2\x97\x8a\xa0\x2e\xbc\x3a\x52\x39\x8f\x66\xd5\x05\x81\x52\xe4\x8b\x9c\xce\x55\xa1\x23\x98\xb2\x7a\xf8\xd4\x2a\x57\x8b\x1e\x9b\xb2\x28\xe9\x15\x08\xeb\x57\x38\x39\x4e\x31\x28\x1e\x8d\x35\xef\x44\x09\x42\x9d\xa4\x7a\x0c\xa9\x27\x67\x3a\x51\x0f\x98\x12\x8d\x6a\x58\x8e\x67\x78\x45\x73\xe6\x66\x28\x08\x95\x53\x9c\xaa\x99\x30\x32\x79\x46\x70\x8c\x53\x9c\x8e\x13\x9c\x8c\x53\x9d\xee\x11\x3c\x79\x05\x6a\x15\xd4\x3b\xd5\x79\x1e\xbd\x53\x98\x00\x49\xaa\x17\xa6\x15\x5d\xd1\x47\x7a\xbf\xe4\x2b\x43\x8f\x23\x18\x8c\xa3\x19\x5e\x31\x3a\x5f\xae\xd6\xd7\xd7\x26\x1d\x10\x3c\x1d\x43\x7a\xb9\xce\xa6\x8a\x19\x8f\x8e\xf0\x09\x94\xac\x27\xf8\x19\x8c\x33\x24\xe5\x6b\x18\x28\x18\xd5\x13\x58\x31\x92\x19\x5e\x15\xf7\x0b\xa7\x2e\xbd\x82\x19\x06\xac\x04\x3d\xb2\x9c\xa9\xd8\x5f\xc9\xae\x92\x5d\xb1\xf9\x9c\xba\x9c\x51\x8a\x55\x3f\x8f\x66\xd8\xd1\x6f\x04\xe4\x2b\x8b\xea\xd1\x6a\x83\x7a\xf5\x87\xff\x90\xfe\x48\xcd\x8c\x3e\xd1\x82\x5f\x31\x45\x45\x17\x8b\x9c\xd9\x0a\x47\x67\x18\x34\x9e\xb3\x19\xae\x45\x91\x11\xa0\xc9\x31\x24\x8a\x85\x05\x9e\x1e\x1f\xe3\xe4\xe4\x18\x83\x3e\x5b\x4f\x97\xe3\x13\xb5\x76\x9e\xbe\x52\x89\xd5\x92\xe5\x46\x4d\xd4\xfc\x72\x3a\xc3\x15\x67\x42\x80\xb2\x74\x32\xc2\xa7\x29\x06\xc9\x5f\xf1\xfc\x0e\x96\x8a\xe4\x0c\x84\xa1\xfa\x3f\xc3\xbb\x62\x0d\x98\xc8\x9f\x7c\xa3\x13\x7c\x66\xa6\xba\xd1\x53\xb4\xa0\x4b\x35\xc2\xc7\xc7\x2e\x9d\xed\xa4\x8b\x5a\x7e\x99\xa5\x69\x67\xe2\x2a\xd5\x76\x86\x3d\x49\xf8\x4a\x2d\x82\x7a\xc1\x94\x6a\x15\x01\x95\xf8\xd4\x2c\x79\x92\x81\x4a\xec\x2d\x37\xb0\xa5\x91\x30\x58\x69\x02\x12\x14\x56\x8c\x93\x19\x96\xc5\x2d\x95\xa0\x3b\x8c\xc7\xf8\xec\x0c\xbf\x4a\x66\xd8\x63\xd2\x93\x63\xcd\x05\xa3\xd3\x19\xb6\x9a\x14\x30\x2d\xb4\xab\xc8\x77\xbf\x64\x54\x9a\x09\xaf\xe6\xe9\xd9\x0c\xfb\x73\xd7\xae\x69\x2a\xad\xba\x2d\x3e\xfa\x1b\x12\x20\x76\x5b\x78\x8f\x6c\x92\xe9\x7a\x32\x3e\xb6\xe2\x7a\x8b\x05\xa9\x77\x64\x98\xb9\x1d\xa0\x0c\x19\x79\x32\x9b\xd2\xec\x69\xbb\xc5\x6e\x8b\x8a\xea\xc7\x6d\xd8\xd8\xce\xc1\xa6\x4c\x90\xa7\xed\xf9\x75\x51\x86\xf0\xc2\x0f\xb8\x38\x60\x88\xb5\x37\x75\x1c\xf5\x7a\xa1\x98\xb2\x29\x9f\xcd\x08\x47\x6a\x37\x47\x89\xb4\x60\xc9\x53\x79\x73\x95\x3d\xcd\x97\x54\x08\x96\x57\xd9\x11\xce\xe9\x15\x3c\x04\xe5\xcd\x55\xb0\xc5\xcb\x2a\xef\xcc\x5d\x56\xb9\xca\xbd\xdb\x93\x7b\x07\xb9\xf7\xdd\x90\x97\xf7\x00\x79\x7e\xfb\xf8\xd1\xcb\x3e\x76\xd9\x90\x11\x6c\xf1\xc3\xe3\xa7\xce\xda\x0f\x8f\x9f\x82\x2d\xbc\x75\xe6\xe6\x14\x60\xe7\xf3\x65\x77\xee\x7c\x09\x78\xb1\x07\x2f\x37\xb1\xb9\xd3\x60\xc9\x1e\x82\xd9\x16\x7f\x64\x8f\xf7\x45\xb9\xe8\x2e\x63\x32\xa1\x1c\x15\x15\x4f\x4e\xba\x8b\xe9\x3c\x5b\x2a\x1d\x3f\x53\x2c\x1d\xab\x72\xcb\xf9\x4d\x17\xce\xd3\x60\x19\xe0\x60\x1e\xe0\xe0\x06\x4a\xd1\x15\x88\xb2\xce\x72\x65\x72\x02\xa5\xd4\xef\x95\x6e\x5b\x29\x2b\x9d\x0d\x43\x4e\x30\xdb\xd6\xfc\x53\x02\xff\x50\xc4\xaf\x43\xda\x66\xa1\x12\xa1\x27\x7e\x1d\x1e\x86\x81\x85\x14\x40\xd9\x69\x39\x43\x48\x2e\xcb\xe2\xfe\x40\xb0\xfb\x83\x6f\xcb\xb2\x28\xc3\xe0\x96\x57\x15\x17\x37\x07\xb6\xe8\xc1\xca\x80\xc9\x0e\x82\xa8\x44\xe7\x1a\x90\xc6\xe3\xe5\x60\x0e\x74\x85\x0e\x60\x50\x3f\xd6\xb9\x71\xce\xc4\x8d\x5c\x1e\x12\xa2\x12\x2d\x06\xbb\xc0\x2d\x50\x2a\x16\x1a\xf0\xc1\xbc\x58\x0b\x59\x1d\xdc\xf2\xea\x16\x4c\x2d\x06\x3c\xd0\xa5\x68\x02\xc3\x15\xf1\x5a\x3c\x5f\xb0\x9c\x49\x76\xd0\x2c\xe2\x27\xea\x72\xf8\x27\x65\xad\x02\xa3\x0a\x17\xcc\x11\x16\xaa\xe1\x9a\xa8\xf8\xe9\x8e\x82\x2c\x2e\xb6\xe8\xd9\x0a\x86\x78\xb6\x78\xb5\x45\x5b\x1a\x97\x37\x57\xf1\xb2\xca\x3d\x09\x83\x9e\x00\x7f\x86\x05\xe6\x44\x4e\x47\xb3\x21\xc8\x27\x4a\xe4\x34\xd1\x8f\x25\x91\xd3\x54\x3f\x16\xe4\x07\x2a\x97\xf1\x2d\x17\x21\xc7\x14\x97\x08\x57\x26\x85\x3e\xd8\x94\x9c\x54\x83\xe2\xdc\x88\xac\x8a\x10\x52\x4c\x18\x19\x65\x9c\x10\x52\x4d\x18\x09\xe9\xa0\x44\xc3\x3c\xa3\xe6\x3d\x8d\xc2\x72\xc0\x21\xa5\x84\x94\x5e\x2f\x64\xe4\x38\x0a\xf9\x80\xa2\x61\x8e\x70\xc8\xea\x26\x4f\x46\x7d\x86\x8f\x4e\x46\x08\x5d\x8c\xa0\x5c\x44\xe0\x05\x0b\x12\x16\x51\x85\x86\x29\x9e\x32\xb0\x27\xf4\x43\xdd\xea\x28\x13\x17\x24\x1e\x4f\xf2\x61\x58\x45\x05\xca\xf2\x61\x98\x0e\xaa\x41\x81\x90\x2a\x25\x60\x96\x18\x72\xdc\x75\x93\x03\x3a\x84\x8b\x9a\x28\x55\x4d\x94\xbc\x26\xca\xba\x26\x41\x81\x2b\x9c\x23\xbc\x20\xeb\x81\xc3\xda\xa4\x2d\x1b\x4d\x68\xf2\x84\xeb\x81\x44\xc3\x93\xe1\x22\x8a\xc7\x5b\x4b\xb2\x11\x21\x64\x31\xa1\xa4\x24\xa3\x2c\x2c\xc9\x62\xb8\xc6\x8c\x2c\xc3\x02\x61\x41\x96\x61\x85\x30\x27\xcb\x30\x47\xb8\x20\x84\xac\x27\x94\xf0\x81\xc8\x2a\xf3\x9c\x0c\x8f\x22\x36\xe0\x59\x0e\xef\xbd\x5e\x48\x49\x3a\x3c\x8a\xc4\x80\x21\x4c\x2f\x46\x13\x1a\x91\x24\xa3\xaf\x13\xc8\x19\x90\x04\x21\x3c\x3d\x3a\x19\xf5\xa9\xa2\x47\xa9\x7e\xd7\x35\x55\xee\xaf\x76\xa9\xa2\x68\x81\x85\xa2\x83\x62\x97\x74\x66\xf0\x9e\x5a\x5a\xe6\xa1\x44\x50\x08\xa0\x85\x09\xb0\x4d\xdf\xd1\x82\x61\xf7\x28\x30\x47\x66\x24\x42\x4e\x92\x81\x5f\x92\x3e\xb8\x92\xf4\xc1\x94\x74\x78\x81\xd8\xdf\x45\x0c\x8b\x7a\x98\x78\x3d\x4c\xd4\x0d\x93\x45\x53\xa1\x15\x26\x03\x31\xf0\x59\x2b\x19\x08\x9c\x0c\x38\x4e\x06\x14\x21\x34\x0c\x93\x01\x43\x9b\xcd\xc8\xe0\x17\x26\x03\x3e\x60\x5d\xc9\x74\x37\x99\x39\x4c\xcd\x12\xb0\x83\x2c\x27\x62\x2a\x67\x20\xe1\x38\x32\x83\xce\xf5\x7a\x0b\xec\x86\x2b\x92\x0c\x47\x4e\xde\xe6\x20\x6f\x19\xc8\xdb\x9d\x25\x3b\x47\x7a\xea\xae\x09\x9b\xe6\x33\xbc\x20\x61\x49\x24\x2e\xc8\x5a\xd3\x6e\x55\xdc\x87\xe5\x74\x34\x1b\x14\x30\x1c\x29\x8a\xbc\xd4\x04\x52\x93\x76\x6a\x0a\xa9\x29\xa4\xa2\xf3\xc5\x45\xd5\xeb\x85\x15\x59\x60\x4a\x72\xb4\x35\x78\x52\xe8\x9b\xe9\x17\xf4\xb1\xd1\x37\x53\x86\x4d\xa5\x23\xc1\xc3\xe3\xa7\x9d\xee\xb3\x7a\xa4\x44\x3d\x52\xbc\x7b\xa4\xe2\xe3\x24\x3d\xee\x87\x8c\xb0\xd7\xf1\xe8\x78\x74\x3c\x9e\x38\x7c\x43\x16\xc5\xa3\xf1\x18\x0d\x13\xf8\x83\xd3\xf8\x18\x65\x6c\x98\xa4\xf1\x59\x8a\xa2\xf8\x68\xfc\xea\xa4\x1f\x0a\x22\x76\xeb\x89\xdd\x7a\xc2\xd5\x4b\x4e\x47\xe3\x7e\xc8\x09\xdf\xad\xc7\x77\xeb\x71\x53\xcf\x70\x44\x9c\x26\xe9\x49\x9f\x45\xf1\xab\x64\x9c\xf6\xa1\x9d\x57\x69\xda\xe7\x36\x77\x94\x9c\x1d\x41\x6e\x92\x9c\xa9\xdc\xb3\xf1\x68\xdc\xe7\x35\x6b\xe7\xb4\x6b\xca\x39\x4a\x86\x12\x64\x00\x83\xd1\xe4\x84\xc1\x0c\x2c\x09\xab\x67\xe0\x01\x1f\x12\x30\xff\x96\xf0\xe7\x34\x3e\x3d\x3d\x02\xd1\x28\x86\xe4\x6c\x1c\x8f\x8e\x5f\xa1\xd7\xf1\x68\x74\x7a\x3a\x3e\xa9\x7b\x24\x70\x32\x3c\x42\xd9\xab\xf8\xd5\xe9\xab\xbe\x88\x92\x93\x61\x92\x9c\xe0\x69\x92\x9c\x58\x0a\xb4\x2a\x70\xbf\x02\x37\x15\xd0\x20\x39\xc1\x63\x98\x56\x62\xc0\x11\x4e\xe1\x89\x0f\xc2\x92\x94\xbb\x00\x4a\x1f\x40\x69\x01\x68\x0a\x2c\xab\x7c\x87\xa5\x3a\x45\xf1\xd1\xc9\xc8\x8a\x62\xe8\xaf\x11\xc5\xc9\x68\x04\x53\x0a\xc4\x67\x65\xa6\xd5\xb4\x24\x20\x54\x72\x5c\xe2\x72\x76\xce\x48\xda\xcf\x07\xa1\x20\xf9\x05\xac\x0a\xfd\x30\x89\x2a\x94\xe5\x51\x35\xc8\xfb\x15\xc2\x94\x98\x93\x01\x37\xf5\xd6\x64\x74\xbe\xbe\x38\x3a\x5f\x47\x11\x0a\x39\x29\xa2\x64\x78\xd4\x1f\x84\xeb\x41\xa2\x57\x20\x1e\x45\x98\x83\x34\xe5\x83\x01\x2e\xc9\x49\x9f\x5f\x24\x13\x16\x01\xdb\x0d\x18\xea\xf3\x2c\x55\x29\x22\x3b\xea\xf3\x8b\x74\xc2\x22\x9d\x1e\xa6\xc3\xa3\x01\x47\xfd\x93\x8c\x61\x3a\x5d\xcf\x14\x8e\xa5\x1d\x44\x6a\x49\xd1\xb9\x2a\xf9\xf2\x57\x75\xde\x4c\x1b\x78\xa4\x44\xe0\xb2\x5e\x8b\x38\x8e\x47\x09\xb2\x60\x45\x9f\x84\xbc\x4f\x52\x74\x41\x92\x09\xcf\xd2\x01\xc7\xb4\x4f\x4a\x78\x2b\xb3\x74\x50\xba\xb5\x13\xe8\xc7\x27\x69\x9f\x0e\xc3\x32\xa2\x28\x4b\xfb\x62\x18\xf2\x48\x58\x59\xad\x9e\x87\xa9\x1d\xb2\xbb\xee\x21\x53\x78\x0e\x4f\x46\x7b\x51\x55\x68\x5e\xe7\x45\x51\x86\x0c\x7d\x75\x82\x4b\xc2\x06\x8d\x34\x5c\x28\xba\xf0\x3e\xc8\x6a\xd0\x33\xea\xb7\xbe\xd2\x32\xbc\xf7\x30\x19\x94\x08\x9d\x57\xf7\x1c\x8e\xdf\xa0\x9b\x20\xfa\xd1\xd3\x9c\x56\xec\x60\x94\x19\x66\xe0\x38\xc7\xc5\xec\x5c\x25\x26\x36\xb1\xc2\xdc\x25\xa6\x36\xb1\xc0\x1c\xe7\x26\xf1\xa8\x4e\xac\x30\x37\x89\xc7\x36\x31\xc7\x85\x4b\x1c\xdb\x44\x0e\x42\x7c\xb6\xb5\x04\xda\xab\x6d\xa9\xd5\x09\x6c\x07\x35\x8d\x8a\x9a\x46\x9e\x66\x55\x34\x86\x92\x93\x30\x1d\x94\xa8\x5f\x60\x41\xca\x7e\x85\xa7\x7a\xfd\x0e\xf5\x64\x0f\x99\xc9\xae\xd4\x50\xb3\x2c\x6d\xac\x59\x7c\x48\xec\xd8\xdd\x5f\x7d\x6e\xba\xe1\x0a\xe7\xf5\x94\x5b\xd7\x68\x2e\x6a\x34\x97\x64\x1d\x2d\x2c\xe9\x97\x30\x1b\xc2\xf5\x90\x2c\xf1\x62\x48\x96\xa0\xab\x9c\xf4\x73\xb7\xd2\xea\xb1\x3d\xe9\xe7\x08\xe1\xd1\x21\x09\x93\x1e\x83\x5d\x27\x2c\xff\x1c\x26\xe0\x3a\xe2\xfd\x30\x14\x24\x19\x2c\xd0\x60\x8d\x60\xeb\x6b\x8f\x17\x15\x85\x4f\x32\x3b\xa2\x44\xe0\x82\x50\x5c\x91\xf5\xf9\x55\xc9\xe8\x47\x37\xaa\x84\xe2\x82\x88\x76\x46\x9a\x95\x64\x6d\x32\xa8\x9f\x71\x64\x32\x00\x94\xf0\x33\x8e\x0d\xa8\x75\x3b\x63\x6c\x1a\x87\x0c\x6a\x96\x45\xb0\x01\xf4\x4b\xb0\x00\xf4\x0b\xf5\x5b\x29\x0a\x83\xa6\xf2\xdc\xf4\x48\x46\xfb\xe7\x87\x9c\x1e\xa9\x47\x33\xe8\xaa\x85\x30\xa9\xb5\xca\x04\x33\x98\x19\x14\x45\x14\x21\xbc\x9b\x2b\x9e\xcd\xe5\x75\xae\xc2\xf4\xe1\xf1\xd3\x73\xbc\x50\xa3\xdb\xc9\xaa\x96\x31\x05\x19\xc4\x67\x27\xa7\x67\x7d\x1a\x25\xf1\xe9\xab\xf1\x69\xbf\x8c\xe2\xd1\x71\x32\xee\x17\x98\x13\x58\x34\x5f\xf5\x69\x34\x88\xd3\xd1\x71\xbf\x8c\x60\xd5\x7e\xd5\x2f\x30\x23\x21\x23\x47\x71\x7a\x3c\x3a\x81\xdc\x24\x1e\x1f\xbd\x4a\xfb\x65\x34\x88\x8f\xcf\x4e\x4f\xfa\x85\x5a\xb7\x8e\x92\xa3\xd1\xe9\x04\xaa\x18\x1d\x11\x16\x13\x86\x93\x21\xac\xfa\x03\x48\xce\xd4\x42\xdc\x67\x58\x2f\xf9\xdd\x35\x44\x47\x0d\xd8\xf6\xf0\xbd\x35\x78\x47\x0d\x8e\xf5\x70\x78\xfa\xa3\x9b\xaa\x23\xcc\x10\x4e\x2c\xd1\x45\x67\x09\xe1\x95\xe0\x9d\x25\xb8\x2a\xe1\x86\xa6\x5b\x2f\xf0\x96\x02\xc3\x3b\x6e\x20\x40\x03\x18\x61\x5e\x2b\x02\x40\xe4\x67\x14\x01\xe6\x2b\x02\xac\xa9\x08\x18\x7a\xbe\x40\x73\xa8\x15\x01\x06\x22\x5b\x29\x02\x62\xf0\x25\x9a\x84\x96\x4c\x39\xdd\xa3\x38\x7a\xdc\x78\x0e\xfd\x4f\x66\xc3\xf1\x68\x14\x81\xe4\xa3\x51\x72\x82\x40\x7d\x01\x99\x23\x06\x4a\x3c\xa5\xa3\x91\x52\xab\xcd\xa2\x08\xcd\x0a\x7c\x84\xec\x2e\x16\xde\x19\x3e\x72\x7b\x58\x78\xe7\xf8\xa8\x5e\x32\x3d\x05\xa6\xcc\x42\x31\x30\x48\x0e\x95\xf6\x82\x19\x29\x5c\x76\x91\x85\xac\x95\xcd\x49\xe5\xb2\xab\x2c\xe4\xad\xec\x29\xeb\x9b\xe1\xc0\xa2\xaf\x47\xab\x6f\x47\xcb\x11\x21\x9f\x2f\xbb\x88\xa0\x08\x60\x76\x39\x98\xfa\x23\x0f\x33\xe9\x64\xa4\xb9\x97\x4a\x2a\xd2\x90\x62\x8e\x86\xe9\x50\xa5\xfc\xfc\xbe\xb9\x71\x9e\x0a\xbd\x51\xa8\x7e\x2b\x65\xc8\xfb\x3c\xa2\x7d\x8a\xb0\xde\xbf\xe4\xf3\x65\xb7\x3a\xda\x6c\xde\xd2\x0a\x46\x23\x55\xcb\x44\x3f\xed\x9b\xc6\xf0\x54\x60\xae\x5f\xe6\x45\x15\x32\x64\xdf\x2a\xd8\x09\xd6\x5a\x2f\x18\xbe\x92\x93\x17\x31\x38\x2e\x49\x02\xfb\x21\x5a\xde\xac\x6f\x99\x90\xd5\xc4\x3d\x4d\x93\x59\x66\xb7\xa0\x77\xb0\x05\x4d\x67\x56\x1d\x0c\x0d\x03\x94\xc5\x5a\x2c\xc2\x72\x38\x1e\x21\x64\x37\x5e\x47\x9a\x45\x0a\x72\x34\x8a\x42\xaf\x14\x87\x9d\x08\xba\xb8\x48\x37\x5e\xa2\x30\x89\x89\x9f\xc8\x54\xa2\xe3\x9a\x94\x10\x52\xf6\x7a\x61\x11\x11\xa0\x71\x61\xb5\x80\x8e\x5e\x9a\x1a\x3e\x15\x42\xa7\x53\x81\xb2\x0f\x34\x45\x3e\x99\xd2\xf1\xcb\xe8\x54\x0f\x0b\x21\xa2\xd7\x13\x4a\xa7\x63\x17\xa7\x93\xe4\x24\x63\xaf\xd3\xe3\xd3\x49\x7a\x94\x64\x5e\x27\x42\x36\x38\x45\xc3\xf4\xf8\x55\x3f\x3d\x46\x51\x7a\x94\x66\xc9\x49\x74\x74\xd2\x6f\xf7\xb3\x3f\x46\x51\x23\x55\xd8\x54\x2f\x8d\x9b\x34\xc0\x1c\xb0\x4e\x4e\xf6\xad\x82\x5f\x25\x4e\x67\x67\x9b\xcd\x2b\xf8\x63\x07\x46\xbe\x1e\x5b\x5e\x8d\xc7\x08\x4f\x19\x61\xc3\x64\x14\x8f\xfb\xb0\x6f\x64\x98\xcd\xd4\xb8\x09\x12\x8f\xfb\x61\x12\xfd\xef\xff\x1d\x42\x05\x37\x0c\x53\xa5\x5b\xf4\x85\x2a\x1d\xb2\xd7\xaf\x93\x5e\xe2\xbf\xa6\xee\x75\x66\xb1\x4c\xc7\xbb\x68\xf2\xeb\x50\xbe\x26\xe9\x51\x6a\x31\x4e\x46\xfd\x50\x0e\x20\x21\x3a\xb5\x4d\x31\x85\xce\x16\x0a\x08\x93\x76\x20\x07\x04\x76\x52\x9e\xd6\x23\x87\x47\x27\x68\xa8\xd1\xf7\x92\x43\x41\xe4\x57\x90\xe3\x32\xc5\x57\x27\xfa\xc9\x4d\x90\x25\x7b\xe8\x20\x5e\x18\x86\xe9\x78\xdc\xf3\xe8\x0e\x7c\x80\x80\x3d\x4f\x50\xd4\x91\x99\xa8\xcc\x53\x14\xed\x66\xa5\x33\x84\x50\x2c\x8b\x0f\x12\x4e\x63\xc2\xe4\x04\x5e\xfe\xbc\x5a\xb1\xf2\x2d\xad\x58\x68\xa9\x1a\x8c\xd4\xbf\x20\xae\xd6\x57\xe0\x22\x26\x6e\x42\x66\x8c\xa9\x28\x62\x80\xee\x92\x3d\xec\x1b\xeb\x26\x78\x65\x3e\x0d\x87\x53\x3a\xb8\x1e\x0d\xce\x66\x4f\x27\xdb\x8d\x7b\x3e\xda\x0e\xb9\xb2\xd9\x1e\x5a\x6e\xb0\x3b\x33\x45\x63\xb5\x03\x3e\x3f\x02\x66\x99\x8e\x66\xa6\x7d\x38\xbc\x20\x22\xae\x56\x39\x97\x61\x10\x40\x03\xab\xe6\x49\x88\x1d\x99\x48\x6e\x11\x8a\xff\x59\x70\x01\xe5\xf4\x41\x07\x27\x2b\x5a\x56\xec\xbd\x90\xa1\xc0\xc9\x89\xe3\x22\xfe\xfa\x75\x72\xd2\x83\x21\xe3\xaf\x5f\x9f\xf6\xcc\xe1\x4d\x8f\xd7\x43\x33\xbf\xd9\x2b\x22\xf7\xdb\xa2\xfc\x6d\x9a\x7b\x00\x33\x17\xa6\x6e\x75\xaa\x35\x02\x63\x2c\x83\xbc\x8a\x94\xb5\x6d\x95\x91\xea\x82\x8c\x26\x23\x65\x38\x15\x13\x6d\x32\xad\xbe\x3a\x51\xef\x7c\x92\x46\x21\x1d\x08\x34\xac\xb2\x63\xd8\x78\x72\x34\xac\xa2\x63\xcc\x86\xe4\x44\x5b\xfd\x42\xf6\x15\x49\xf4\xae\xa0\x32\x66\xd3\x8b\x64\x52\x80\x91\xab\x42\xd9\xc8\x6e\xf0\xf2\xee\x3e\xd6\x7a\xa0\x70\x7a\x20\xe6\x24\xc1\x94\x8c\x0c\x82\x21\x27\x02\x76\xda\x69\x9f\xf5\x45\x06\xbf\xb0\x59\x43\xe8\x02\xf6\x08\x94\x84\x62\x10\x83\xf5\x03\x1a\xe4\x60\x8a\x94\xd6\x6a\xc8\xd5\x2f\x75\x3b\xcc\x2f\x41\x80\xf5\x85\x87\xc2\x01\xaf\x1b\xfb\x6c\x4b\xf3\x9b\x7d\xac\x6b\x77\x40\x9d\xca\xba\x15\x60\xc2\xf2\x2a\x08\x3f\x8e\xbd\xdf\x99\x35\xee\x59\x0b\x03\x2e\x08\xfb\x2a\xe9\x9f\xe0\x8a\x14\x5f\x25\x38\x27\xc9\xa0\xb2\x5b\x28\x4f\x36\x14\xa8\xde\xc2\x4e\x47\x33\x92\xe0\x72\x9a\xcc\x48\x85\xc1\x52\x47\x46\xfe\xae\x24\xd1\x25\x72\x5d\x22\xe9\x28\x91\xea\x12\xa3\x46\x89\xca\x2f\x71\xd4\x28\x91\xeb\x12\x89\x5f\xe2\x58\x97\xa8\x74\x89\x51\xb3\x84\xdd\xa9\xf9\xa8\x9a\x22\xb9\x33\x1f\x12\xc5\x02\x4e\x83\x16\x7d\x28\x1d\x51\xa3\x0c\xc3\x6b\xd2\x7c\x4d\xe1\xd5\x8d\xcf\x3e\x9b\x88\xe3\x04\x16\xd9\x61\x81\x1d\x0e\xa8\x1c\x35\x2f\x88\xd7\xb0\xa0\x70\xc2\x86\x62\x97\x07\x84\xe3\x81\xce\xed\xba\xdf\x46\xb3\x85\x28\x1e\xf7\xd9\x4e\x33\x8a\xf1\xa1\xa9\x30\xed\x0b\x94\x89\xd7\x24\x1e\xf7\x7a\x42\x71\xa3\x49\x56\x94\x40\xcf\x62\x72\x7f\xf5\x3c\x26\xed\xde\x1a\x14\x6a\x88\xca\xe0\x64\x2c\xf1\x03\x61\xe8\x78\xbf\x47\x6c\xf9\x90\x93\x81\x85\xac\xd4\x69\xf6\x2f\xce\x29\x75\x0a\xb9\x33\xab\x3c\x2c\x87\x27\xe3\xf1\x91\x5a\xed\xb0\x6a\xdd\x7f\x4d\xbd\x57\x27\x70\x15\xc4\xbd\xd0\x80\x8b\x54\x1d\x6c\xa5\xae\x7b\x4d\xbd\x57\x05\x0d\x4e\x36\x9f\x45\x0d\xc8\xa6\x31\xd9\xf3\x52\x83\x01\x9e\x71\x8f\x77\x5d\x10\x61\xe2\x03\x20\xaf\xce\xfd\x55\x77\xc1\x64\xd4\x2e\xba\x73\xe4\xe1\x01\x6d\x97\xcd\x69\x27\x58\x28\x04\xa5\xeb\x82\xdd\x5a\xc5\x8e\x6a\x50\x77\x17\x8c\xcf\x61\xc8\x8c\x7e\xc1\x94\x2a\xc1\xfe\x35\xad\x41\x38\xad\x41\xd8\x81\x05\xe2\x75\x21\xae\x50\x88\x60\x38\x15\xb3\xa3\xe1\x91\x1a\xc7\x64\x34\x9a\x6d\xb7\x08\x9d\x0b\x18\x43\x2c\xc0\x64\xaa\x7e\xef\xe0\xf7\xfe\x0a\x0b\x45\x35\x2c\x60\x27\x89\x05\xec\x65\xe0\x77\xbe\x84\x6c\xf6\x80\x85\x3d\xc5\xc0\xc2\xe8\xa8\xe6\x21\x1d\x9f\x40\x91\xf9\x0d\xbc\x03\xb3\x61\xa1\xe8\x75\x6e\x71\x3b\xe0\x35\xb5\x1c\xbe\xe8\xc9\x1a\x8d\x25\x79\xda\x62\x46\xcc\xb9\xec\x47\xf6\x58\x85\x02\xc4\x90\x55\x94\xd4\x4c\xa2\x17\xfc\x9c\x46\x11\x92\x53\x36\xa5\xb3\x19\x79\x5a\xf0\x4a\x52\x31\x67\xd9\x20\xc1\x2b\x5a\x32\x21\x33\xb1\xce\x73\x77\x28\x28\xb7\x21\x00\x81\x63\x23\x68\x08\x8e\x58\x62\x5b\x87\x8c\xce\xb9\x01\x7e\x8e\x2c\x1a\x94\xf0\x78\x55\xac\x42\x84\xcb\x26\x2e\x53\x3a\x03\x1d\xa3\xb4\xe8\x54\x64\x74\x5e\x5d\x14\xe7\x55\x14\xe9\x5e\xe5\xa4\x9c\x56\x33\xac\x0f\x95\xce\x07\x09\x1c\x25\xba\xb6\xc0\xba\x57\x37\x0c\xc8\xbb\xb7\x28\xc1\xeb\x58\xe3\x4e\x28\xe6\xf1\x5a\x54\x4b\x7e\x2d\xc3\x1c\xb9\xd3\x23\xb6\x75\x44\xa4\x0d\xcf\x16\x47\x47\xe1\x92\x58\x28\x43\x81\xd0\xb6\xae\x52\xea\x2a\xb6\x87\x9c\x4c\x15\x19\x74\x93\x58\x82\x1d\x55\xf8\x49\xb3\xa9\x54\x2b\x6d\x9d\x72\xce\xa6\x85\x7b\x46\x35\x8a\x5e\x32\xd0\x8b\x2a\x3f\x18\x97\x34\x9b\x16\x33\x38\xd8\x2e\x88\x97\x68\x07\xa6\x8c\xe7\x85\xb8\x63\x65\xc5\x0b\x41\x38\x2e\xd5\x36\xa0\x00\x87\x9b\x26\x07\xc4\xd7\x45\xf9\x2d\x9d\x2f\x9b\x3a\x69\x31\x95\x33\xe0\x97\xee\x53\x7c\xc8\xed\x38\xf6\x87\xc3\x43\xe7\x44\xb0\xd7\x05\x40\x57\x6e\xb9\x00\xa8\xaa\x3a\x6d\x8b\x4c\xc0\x86\x8f\x8f\xa5\x2d\x23\xc0\xe4\x18\x1c\x87\x30\x6d\xf0\x8f\x32\xcc\xd3\x67\x98\x87\x4e\xab\xd9\x39\xf0\xee\x21\xe8\xe7\xb9\xa5\x17\xe8\xe7\xd3\x7c\x46\xca\x30\xc7\xac\xe6\x08\xb1\x0d\x25\x6a\x90\x8a\x75\x91\x4a\xd8\x83\x53\x36\x15\xb3\x73\xe8\xda\x54\xcc\x1a\x98\xb7\xfa\xc2\xd4\xde\x0d\xd0\xf0\xb6\x94\xec\xdc\x59\x0b\x4c\x07\x94\xb9\x9a\x91\x37\x25\x08\xc4\x55\x59\xc8\x42\x3e\xae\x58\x5c\x41\x08\x4b\x3c\xa7\x79\x1e\xba\x1a\x66\xa7\x20\x88\x0c\x99\xda\x9c\xec\x44\x16\x09\x37\xf9\x38\x11\x5d\xb3\x5d\x4c\xe9\xcc\xb7\x44\xc0\xbb\x15\x92\x07\xc2\xce\xf5\xa0\x66\x28\xf0\x7b\x91\x80\xa1\xcf\x64\xd2\x7b\x41\x98\x6d\x43\x8e\xb0\xa1\x48\x5c\xd2\xfb\xcf\x50\xc5\xb6\xa6\x29\x33\x61\x59\xf8\x6f\xd1\x04\x03\x35\xd0\xbf\x81\xfa\x16\xa1\xad\x21\x6d\x45\x0a\x9c\xff\x1e\x3f\xf4\x7b\xfc\xd0\xef\xf1\x43\xbf\xc7\x0f\xfd\x1e\x3f\xf4\x7b\xfc\xd0\xef\xf1\x43\xbf\xc7\x0f\xfd\x1e\x3f\xf4\x7b\xfc\xd0\xef\xf1\x43\xbf\xc7\x0f\xfd\x1e\x3f\xf4\x05\xf1\x43\x6b\xf2\x74\xc3\xe4\x2f\x37\x57\x34\x5b\xe0\x1b\x26\xdf\x55\x39\xcd\x96\xf0\xf4\xcb\xcd\x55\xb6\xbb\x41\x5c\x84\xd2\xed\x43\x59\xaf\x67\x36\x7b\xe1\x08\x1f\xa1\xad\xa9\xdf\x51\x6b\xf9\xb9\x5a\xf7\x57\xd9\x1c\x1e\xde\xe4\xab\x25\xdd\xd7\x2c\x38\x36\xbb\x0d\xfa\xf4\x68\x06\x5b\x6b\xf0\x77\x97\xee\x88\xbe\x4e\x9d\xb7\x52\x55\x88\x8e\xb6\x3e\x7a\xe0\xc1\x48\xc4\xc8\x5d\xc1\x17\x07\x23\x30\x42\xf4\x7a\x70\x56\x28\xcd\xf6\x76\xc2\x32\xf0\xad\x32\x88\x07\xff\x11\x44\x77\xca\xc4\x88\xd4\xdf\xc4\xfc\x4d\x67\x60\xe2\x7c\x4d\x46\xbd\x1e\xbb\x48\x26\x77\xbe\x6b\x00\x98\x1f\x19\xdc\xee\x11\xa0\x2d\x2e\x6f\xae\x3a\x11\x00\x7c\x2f\x92\xcd\x06\xda\xea\xf5\xe0\xf7\x22\xb1\xa8\x5f\x2b\x43\x96\xc5\xa0\xbc\xb9\x0a\x83\x08\x50\x88\x02\x7c\x00\x4f\x89\x7b\x4a\x67\x51\x80\x02\xd5\x0a\xb5\xcd\x80\xdc\x9a\x33\x21\xbf\xb8\xd9\x1b\xdd\x2c\x0c\xb9\x20\x6d\x1b\xaf\xb1\xa9\x82\x91\xb1\x91\x65\xac\xe8\x2a\x8b\x36\xb3\x8c\x45\x1d\xb2\x9a\x7d\x11\x51\xf0\x15\xf4\x84\x9b\xbf\x34\x0a\xbe\x82\x5e\x18\xc4\x6d\x4f\x6e\x20\x68\xec\x8b\x7b\xb1\x6a\x10\x0f\xc2\x0d\x76\x89\xf7\x55\x4d\x3d\xd5\xf0\xb2\xca\x6d\xa3\x2b\x88\x36\xeb\x6c\x54\x33\x0c\x51\x0c\x13\x7a\xfc\x03\x63\x37\x81\x9f\xcc\xb9\x60\x06\xcb\xfb\xab\xcf\x34\x1b\x44\xa1\xcf\x81\x09\xfc\x99\xa8\x51\x65\xc0\x38\x7a\x58\x8d\x09\xda\x43\xc4\x19\x68\xae\xa6\xd2\x9b\x4f\x2a\x00\xcb\x14\x3a\x58\x58\xcf\x04\x3b\x8f\xac\x45\x42\x90\x04\xce\x25\xed\xd9\xfa\xff\xef\x3f\x42\x38\x52\x7f\x33\xf8\x4e\x9f\xaa\xe3\xe3\x2d\xfa\x9f\x43\x38\x64\x26\x41\x00\xd3\x8c\xa3\x27\x4a\xc0\x3d\x6b\x9a\xcc\x10\xcc\x09\x6b\xbf\x2a\xe1\xb0\xe7\xc2\x5a\xac\xcf\xcb\x28\x42\x6c\x5a\xce\xea\x43\x72\x3e\x2d\x67\x11\xfc\xa8\xc3\x72\x0a\xc6\x3d\x9f\x37\x5c\x39\x1a\x51\x28\xe1\xf8\x04\x8e\x12\xd0\x96\xe5\x15\x3b\x80\xf6\xf7\xe2\x7a\xb2\x6d\xbc\xa6\x5b\x34\x51\xa8\x03\xc2\x1c\xfc\x80\x34\xd2\xca\x53\xfb\xf3\xc8\x1a\x42\xa6\xfd\x12\xa7\xfd\x32\x4a\xd1\xe7\xb0\x7e\x21\xce\x30\x2b\x27\xff\x08\xff\x51\xf5\xc3\x69\x34\x98\x4d\xfe\xb1\x88\xd0\x3f\xaa\x3e\xfe\x6c\x42\x38\xc9\xea\xb4\xe9\x3f\x16\xff\x88\x67\x2a\x1d\x4d\xfe\x61\xba\xf9\xb2\x8e\x4d\xcb\x28\x99\xa1\x73\xa1\x07\xe6\xbb\xbc\xa0\x30\x34\xc7\xb3\x97\xa1\xeb\x1a\xfe\xaa\x81\xe3\x4b\x92\xff\xbd\x0e\x78\x34\x4f\xe3\xf1\xb8\xdf\xc0\x5e\x75\xe9\xa5\x7d\x0a\xff\x71\x1f\xa1\xa1\x0e\x3b\x0c\x64\x49\x45\xa5\xad\xcd\x01\x51\xfc\x81\x1a\xe7\x64\x23\xb5\x8a\x1c\x86\x8c\xe4\x53\xc8\x9d\xd9\xb5\x64\xfb\x1c\xb2\xb7\x21\x03\x3e\x87\x3d\xc1\xd8\xce\xff\x03\x41\xc4\x66\x33\x02\xaf\x8b\xdb\x50\x80\xa2\x8d\xb2\x04\xc3\x3a\x45\x04\x66\xde\x91\xc5\xb2\x3d\x55\xeb\xd1\x00\x99\xd4\x66\x9e\x70\x92\x2d\xd8\x0d\x9a\x74\x12\xbe\x7b\x38\x3e\x37\x1a\x43\xb3\xc2\xaa\xf6\x1b\x44\x65\x40\x54\xd3\xa1\xe9\x6d\xcd\xfd\x10\x58\x82\xf0\x08\xab\xd8\xba\xdb\xb0\x51\x23\x55\x39\x30\x21\xda\x39\x47\x7e\x0e\xaf\x7e\xa4\x3f\x86\x02\x4d\x92\x4c\x53\x67\xb6\xf5\x88\x32\x7f\x86\x28\xf7\x57\xff\x9f\x24\xc9\x75\xe3\x34\xec\x25\x2b\x11\x86\xb8\x6b\xda\xb1\x08\xd5\x6b\x10\x3c\x31\xb5\xd0\xb8\x76\x6e\xfc\x76\x2c\x84\x7d\xaa\x80\x59\xd0\xf6\xa9\x03\x5d\xd9\xe9\x4e\x76\xc8\xb4\x0e\xb4\xd9\x24\xa8\x89\xcb\xea\x5f\xe8\x33\x4c\x9a\xcf\x2c\xbc\x1d\xbd\xbe\x85\x96\x70\x7d\xb4\xd8\xf2\xaf\x52\xd1\x86\x70\xda\x85\xea\x2a\x77\x7b\x1d\xd7\xba\x4e\xb8\x0f\xac\xd8\xb8\x48\x27\xc1\x28\x88\x58\xc6\xd4\x39\xe0\x95\x1f\x78\xff\x00\x8e\xab\x39\xba\x9a\xe6\xd3\x87\xd9\x8c\x3c\xa8\xe3\xa4\xae\x23\xef\x03\x79\xc0\x85\x3e\xda\x2d\xae\x0f\x1e\x27\x32\x83\x2d\x49\x33\x4d\x5d\xdc\x16\xdf\xd1\x9c\x2f\xc8\x61\x82\xed\xdb\x9a\x99\x40\x7d\xab\x12\x40\x58\x7e\xfd\x7c\x57\x3f\xdf\xd7\x65\xe0\x9c\xdc\xbc\xc0\x2b\x55\xda\x7a\xb2\xc5\x30\x24\x61\xa0\xfd\xfc\xea\x53\x2e\x39\x09\x19\x59\xc7\x66\x7f\x01\x0a\xf9\x44\xb5\x5e\x31\xf9\x17\x85\x40\x08\x7c\x15\x60\x86\x32\x5b\x10\xb6\x1f\x9d\x05\xe1\x72\x80\x46\xc1\xfb\x2b\x28\xd7\xeb\xed\x14\xbc\xd7\x10\x77\xce\xdc\xe0\x74\xcc\xf1\x4a\xc8\x88\x44\x71\xb9\xd9\xb8\x14\x16\x97\x6c\xb1\x17\x3f\xaf\x58\xde\xa8\xa4\xcc\x65\x82\x55\xd5\x5e\x8c\xbd\xc2\x77\x8d\xaa\x2a\xd4\xba\xa3\xda\x5d\xbb\xda\x7d\xa3\x9a\x32\x40\x77\xb7\x68\xba\x5e\x4f\x90\x78\xde\xeb\xf9\x6f\x8f\x54\x6c\x36\xed\x6a\x30\xa8\x50\x0f\x21\x94\x41\xb8\xfc\x23\xec\xb5\x80\xe3\xd8\xf6\xdc\x3b\xd6\x23\x4f\xbc\xfa\x0b\x70\x51\xad\x83\xd6\x6c\xe8\x58\x4c\x6d\x40\xf6\x95\xa8\x98\xfc\xb0\xa2\x73\x66\xe8\x5a\x1f\x0e\x6e\x41\xbf\xff\x7c\x2d\xc5\x04\xcd\x5a\x77\x2f\xa9\x75\xd7\xaa\x75\x7f\xf5\x82\x5a\xf7\x2d\x0c\x81\x4c\x9f\xaf\x06\xa5\x9a\xf5\xca\x9b\x2b\x75\x40\xba\xaf\xae\x62\x83\x0a\x5c\x53\xa0\x3f\xf9\x4b\xca\x2e\xab\x1c\xca\xde\xbd\xac\xec\x9d\xea\xf1\x4e\x59\x18\x61\x49\xbc\xa2\x56\x42\xc1\x06\x44\xc6\x6a\x6e\x4f\x24\x38\xc8\xc0\xb9\xec\x9c\xca\x70\x6a\x52\x67\x28\x53\xe9\x9a\x26\x2f\x41\x02\xca\x29\x4a\xd0\x97\xa3\x21\x81\x24\xbb\x4d\x43\xc7\xf3\x2f\x02\x03\x8e\xa9\x1d\x60\xe8\x8e\xa9\xc1\xd4\x70\x13\x46\x4e\x3c\x78\xba\xfd\x2c\x6c\xcf\x1e\x95\x1c\x60\x70\xc1\x5f\x72\x35\xde\x6c\xd1\x05\xd5\xd6\x7b\xab\xbd\x31\xcc\x14\x18\x61\x09\x16\x10\x65\x98\x79\x71\xa5\x44\x55\x52\x06\xea\x17\xd7\x49\x55\x9d\xe5\x9e\x2a\xbd\x5e\x28\x49\x28\xbf\x52\xc1\x1e\x17\xa3\xc9\xd1\xc9\x28\x92\x99\xe9\x53\x03\x98\x9a\x82\x1a\xeb\x8a\xca\x75\x49\xa1\xf5\x17\xf5\x57\xd5\xd4\xa8\x3b\xa1\xf9\xf2\x8a\x69\xab\xc9\xbb\x17\x56\xbd\xb3\x6d\x3a\xb1\xf9\xb2\x8a\xf7\x1e\x9d\xe9\xfc\xe3\x97\x55\xd4\xc8\x2a\xbe\x79\x59\xa5\xea\xce\x56\x52\x87\x57\x2f\xa9\x03\x53\xca\x8e\x84\x3d\x60\x79\x79\x3d\xdd\x35\x63\x29\x7c\x79\xb5\xb4\xa6\xc8\x17\x34\x76\xa4\x6a\x75\xd8\xdf\x5c\xc5\x75\xec\x72\xc3\x96\x50\xec\x36\x9c\x79\x35\x5d\x6e\xbb\xa6\xaf\xea\xe8\xd9\x8b\x9a\xe6\xb1\x6e\x60\xf4\x0b\xa0\xed\xb1\xaf\x79\x00\x1b\x25\x5e\x04\xb3\xc3\xd2\xe5\xc1\x73\xb9\x0d\x58\xe0\xd1\xb8\x07\xd6\x73\xbd\xad\xb3\x5f\x06\xed\xfe\xea\x39\x60\xf7\x57\x5d\xb0\xee\xbb\x7b\xb9\x63\xcf\xf2\x20\x99\xbc\x17\x51\xab\xbc\xb9\xfa\x71\x7d\x7b\xc5\xca\x67\x17\x03\xa8\xee\x16\x84\xe9\x68\x06\x8e\xa9\x1b\xb0\xc4\x5d\x5c\x9c\x6e\x60\x37\xb0\xc5\xf9\xfa\x96\x8b\xa2\xe2\xb2\xb1\xac\x58\x8d\x7c\x07\x1a\x66\x64\x0a\x66\xb4\xd1\xb9\xb8\xb0\xf6\xe2\x73\x61\x7d\xdb\x20\xb4\x4a\xa8\x0d\xce\x39\xb8\xa1\x11\x7e\x41\xe2\xd1\xd1\x59\x7a\x3a\x31\xb7\x40\x64\x2e\x8c\xb0\xe3\xaa\x08\xe3\xf1\x66\x6e\x88\x80\x0d\x8c\xbe\x24\x02\xf6\x96\xe6\x9e\x08\xd8\x4c\x6e\xf1\xbc\x10\xb2\xa4\x95\xac\x31\xae\xb7\x22\x40\xab\xba\x4f\x21\x78\xe4\xca\x46\x82\xa5\x07\x7b\x2d\x26\xfa\x7e\x0c\x34\x0c\xe1\x1e\x8a\x31\xca\xcc\xdf\xa1\x49\xdf\xe2\x9c\xdd\xb1\x7c\x5f\x33\x16\x0d\xdf\xc8\xff\x9a\xbc\x8a\x93\x49\xf0\xe6\xcd\x9b\x20\x63\xaf\xc9\x71\x3c\x86\x97\x20\x0b\x82\xad\x72\x22\x79\xe9\x70\x85\xe9\xd9\x59\x5f\x59\x4f\xc7\xa7\xaf\xfa\x30\x64\x51\x92\x1c\xf7\x61\xcc\xd0\x30\x61\x47\x17\x49\x7a\x6a\x16\x14\x1f\xa4\xae\x7c\xa8\x60\x42\x73\x21\xda\x62\xc1\x6e\xc0\xcd\xa9\x73\x74\xa7\x33\xcc\xc8\xe8\x9c\x5d\x1c\x9d\x33\xed\x6c\xab\xee\x5a\x18\xb4\x90\x9a\xb2\xd9\x79\x4b\xd6\x59\x3d\x40\xad\xb2\x66\xc5\x34\x08\x31\xb1\x8f\x62\x06\xe2\xb2\xca\x2d\x38\x18\xd0\x88\xc0\x6f\x5f\xe2\x36\x6c\xb3\x95\x30\xb0\xa1\x3f\x5f\x0a\x7a\xf0\x32\xd0\x66\x7d\x65\x5f\x02\x3c\x51\x78\x27\x2f\xc0\x9b\xfd\x6b\xe0\x07\x2f\x03\xaf\x17\xf8\xcf\x81\xbe\xbf\x7a\x19\xe6\xf7\x57\x1e\x68\xb5\xe0\x7d\x19\xec\xe7\x46\xb3\x01\x1b\xce\x2e\xab\x39\xcd\xd9\x67\x67\x04\x66\x24\x3e\xd2\x73\x21\x1e\x9f\xe9\xb9\x10\x27\x49\xdf\x8f\xe2\x6c\xb7\xa5\xb8\xd2\x84\x1d\xda\x16\xe7\x39\xa3\x25\x2b\x3f\xd3\x1b\x25\xa8\xf7\x81\x35\x4a\x2f\x1b\xb0\xbe\x63\xf9\x62\x45\x7f\x5b\xff\xf7\x80\x8d\x3c\xb0\x65\x21\x5f\xc4\x31\x10\x71\x00\xd1\x7e\x91\x44\x5f\x1d\x9d\xb8\x08\x14\x48\x22\xc2\xa8\xb4\x22\x13\x9f\x61\xa2\x5b\xfe\xe0\x35\x85\x9d\xe5\x4f\x62\x6e\x0c\x4d\xb0\x91\x9e\xc4\x63\xb8\x90\x85\xa4\x7d\x3e\x48\xe0\x4e\x0e\x80\xa9\x90\x0f\xd1\x40\xd8\x27\x5c\x90\x30\xa4\xfd\x92\x90\x41\x32\xa1\x59\x48\xa3\x12\x62\x60\x22\xda\x2f\x11\x8a\x12\xb8\x85\xac\x22\x49\x1d\xa4\xa7\x30\x83\x63\xb1\xa2\xaf\x1f\xd9\x22\x44\x51\xd5\x17\xfa\x09\x9b\x64\x75\x80\x6d\x32\xcc\xb3\xcd\x82\x2d\x81\xc9\xd1\x8f\xc8\xe0\xe2\x23\xd8\xe7\x91\x43\x11\x62\x7d\x38\x42\x5b\x2c\x8b\xff\xfc\xf0\xd3\x8f\xbb\x12\xb4\x46\x0a\x01\xe3\x14\x62\x97\x4d\xc1\x5e\x46\x94\xdd\x00\x73\x7f\x50\x30\x25\xc2\x3c\xd6\xa7\x45\x60\xd8\xe2\x88\xef\x5e\x07\xa8\x76\x20\xea\x98\x28\x98\x6a\xcb\xcd\x81\xda\xe3\xcd\x02\x88\xca\x66\xe4\x69\xeb\x6c\x6b\xda\xdb\x1a\x8c\x44\x14\xac\xee\xee\xf0\x0b\x65\xae\xaa\x56\x09\x54\x5d\x66\x4a\x65\xf3\x42\x54\x05\x7c\xaa\x41\x5f\xd8\xb7\x16\xec\x61\xc5\xe6\x92\x2d\x0e\xe6\x45\x5e\x94\x07\x0a\xd5\x0c\x76\x72\x6e\x15\x13\xdb\x2d\xf6\x2c\x20\x71\x05\x7b\x7c\x6b\x36\x0b\x4a\xb6\x80\xcb\x11\x61\x08\xe0\x7a\xc4\x7c\xcd\x02\x63\x45\x0b\x96\x6b\x16\xe0\xc0\x88\x3b\x70\x71\xc6\x81\xdb\xf3\x04\xc6\xbc\xd6\x55\x48\xe1\x10\x18\x9b\x9b\x29\xe0\x36\x2e\xaa\x11\xb3\x17\x09\xac\x2d\x2e\x80\x0d\x43\x80\x03\xb3\x05\x08\x70\xa0\x95\x7a\x5b\x38\x98\x35\xbb\x70\x4b\x1f\x5c\x0f\xac\x83\x80\x72\x27\x50\x88\x43\xe4\xa2\xfa\x2c\xc0\xc8\x1a\x01\x9b\x29\xf7\x57\xad\x14\x8d\x84\x79\x75\xc9\xcd\x26\x6f\xec\x64\x6b\x18\x30\x2d\x4b\x34\x26\xb2\x0e\x23\x80\x08\x39\x5e\x6b\x55\x5c\xf9\xc3\x4b\x88\x63\x28\xdf\xc8\x90\xa3\x99\x0a\xd4\x98\x72\x27\xf5\xc0\x62\xc1\x34\x4f\xc3\x49\x5d\x4c\xed\x1b\xc2\xa2\x89\x4b\xd5\x81\x8b\x9b\xe5\xfa\xc6\x08\x0f\x1b\x33\xb3\xf5\xb0\xc3\x2d\x26\x90\xa7\x28\x08\x81\x98\x70\x34\x60\x4b\x83\x6d\x75\x84\x8d\x00\x03\xd3\x01\xca\x09\x3b\xb7\xc7\x50\xd6\xe6\x8b\xe8\x54\xce\x48\xed\xfd\x60\xbb\x08\x77\x14\xb1\xa9\x7d\x9b\xb9\x7a\xb5\xd5\xaf\xee\xfe\x08\xcd\xf4\x91\x59\x87\xee\x49\x4d\xb4\x83\x57\x5c\xa0\xd9\x79\x0e\xe4\xd8\x76\x00\x2d\xa1\xfc\x68\x36\xb3\x77\xc3\x95\x36\x68\xe8\x59\xd8\xeb\xa9\x98\x19\xa0\xd0\xdd\x2d\xdc\x98\xa9\xc9\x5d\x87\x2a\x8f\xea\xdb\xfb\x12\xec\x04\x67\x3e\x31\x05\x33\xb8\xd6\xc7\xa7\x96\xd1\xd7\x92\x7d\xad\xf3\x4e\xd0\x26\x96\x01\x1b\xdc\x10\xb2\x4f\xfe\xb9\x21\x47\x4e\xfc\x2c\x40\xfc\x94\x68\x01\x06\x2e\x88\xf5\x9d\x2e\x66\xa4\x82\x1a\x8b\x59\x08\x35\xdd\xcc\x3f\x1c\xed\xb0\x8d\x32\x0b\x76\x71\x8d\x0a\x2d\xb7\x9c\xe8\xba\x2a\xb4\xed\xc8\xf1\x7e\x28\x51\x16\x06\x42\xc9\xa5\xda\x26\x2d\x80\x5d\x9f\x8b\x9f\x60\x08\xb5\x57\x2c\x89\x85\xb3\x35\xb5\x70\x34\xfb\xee\x06\x96\xd8\x05\xc4\x78\x9c\x3d\x95\x5d\x18\xf3\x29\x9b\x65\xea\x02\x88\x29\x9b\x29\xfc\xb3\x10\xd2\xc8\xce\xaa\x29\x31\x77\x38\x74\x7d\x1c\xe7\x9e\x8b\x45\x71\xdf\xeb\x85\xfa\x21\x7e\x0b\x12\x96\x3c\x6a\xb3\xf2\x25\x79\xac\x3d\x14\x3e\xd6\xe6\x03\x15\x3f\x36\x0d\x2e\x2f\x55\x9f\x2e\x2f\x03\x1c\xb8\xde\xc1\x7d\xb5\x85\xa8\x64\xb9\x9e\xcb\xa2\x0c\x66\x31\x17\x0b\xf6\xf0\x13\x9c\x0c\xaa\xe3\x93\x7b\xfc\x03\x79\x12\x45\xb1\xf2\x97\xa7\x2d\x5e\xf3\x45\x16\xde\x93\x11\xf6\x52\x4d\xbf\xef\xa3\x68\x8b\x30\xaf\x7e\x5c\xe7\xf9\x4f\xe5\x9f\xa1\x0f\x75\x5d\xd9\x8a\x7e\x91\x5b\xcc\xab\x96\xcd\x51\x9f\x4c\xaa\xc4\xd8\x64\xf6\x7a\x8d\xd7\xda\x05\xe9\x70\x64\x22\xa8\x4c\xf8\x52\x3d\x6a\xed\x45\xcd\x0c\x8b\x5d\xc9\x60\x76\x30\x13\x0d\x19\x8e\xf0\x2b\xd4\xeb\x05\xf5\xba\xe8\x72\x06\x27\x08\x30\xd4\xd0\xf7\x75\x43\x73\xbd\x5b\x23\x75\x61\xb5\x46\x7e\x16\x2b\x00\xfe\x1d\x17\xbc\xa5\x88\x99\x2d\xda\x0e\x5b\xcb\xcd\xa6\x71\x30\xa5\x97\x63\xd4\xeb\x59\x28\xa1\xb3\x8f\xfd\x54\x7e\x63\x82\xc1\x6b\xc0\x5d\x67\x7d\x12\x9c\xb1\x4c\x9d\x37\xf2\xbd\x1a\xfd\xce\xaa\xfe\xe1\x5d\xdc\x6c\x22\xfc\xc1\x1b\x99\x89\x04\x6e\x87\xc9\xb4\xc5\xd0\xcb\xab\xa6\x4d\x0b\xb4\x1a\x35\xc0\xb2\xd7\xeb\xf8\x36\x93\x54\xe3\x65\x46\xf7\x40\xaa\xf0\xcf\xc7\x50\x60\x86\xb6\x18\x7c\x48\x5b\x90\x30\xd7\x93\x50\xdd\x4c\x08\xeb\x86\x8f\x09\x5c\xd8\x59\x3a\xa7\x33\xcc\x55\x6c\x18\x25\xe5\x20\x39\xa7\xaf\x21\x26\x6c\x30\x40\x46\x1c\x08\x2c\xa7\x74\x86\x29\xd2\x4b\x84\x2e\x38\x3a\xa7\x17\xa5\x8a\x1a\xeb\x2e\x65\x9a\xd3\x83\x0c\x3c\x09\xd5\x4a\x12\x16\x8d\x68\x3d\x89\x90\xc5\x60\x0f\xc8\x02\xe2\x4f\x31\xfc\x82\xf5\x1c\xc8\xf8\xed\x6f\x6b\x9a\x57\x59\x87\x50\x84\xa5\x14\x97\xd0\xd3\x43\xb9\xd9\x1c\xc2\xc9\xae\x81\x0e\xab\x99\x79\xdc\x91\xfa\x98\x3b\x32\x9c\x8b\x0b\x7e\x1e\x45\x02\x88\x03\xb7\x61\x88\x19\xdc\xfb\xa7\x84\xbd\xcf\x59\x66\xda\x95\x3b\x69\x6a\xec\x0e\x7f\x88\x3d\x3c\x43\xb8\x85\xd8\xb5\xe9\xd6\x43\x7a\x48\x48\x59\xa3\x62\x1e\x46\x3b\xea\xae\x9e\xef\x8d\x81\x73\xc3\x0f\x37\x88\xfc\x10\xab\x0a\xe8\x7c\x87\xde\x5e\xac\xa4\xa1\x38\x38\x75\xab\x59\x80\x45\x6b\x10\x70\x47\x40\x60\x14\x51\xc4\xa6\x10\x02\x38\x23\xa6\x95\x50\xea\x77\x2b\x2f\x0e\x98\x31\x1a\x1d\xc8\x2d\xbe\xbc\x65\xe5\x0d\x2b\x3b\x78\x90\x5f\x87\x20\x70\x0d\x33\x82\x5a\x01\x64\x85\x50\xcf\x73\x0f\x65\x8a\x7a\x3d\xef\xb5\x44\x93\x1f\x62\x05\x12\x28\x88\x39\xca\xa0\x9e\xc3\xa4\x44\x5b\xd7\xe4\xfb\xeb\xae\x29\x54\x37\xc9\x75\x93\x74\xa7\x49\xde\x6c\x92\xba\x26\xdf\x5f\xc3\x65\xd1\x28\xdb\xb9\xd1\x56\xa2\xcd\x26\x6c\x20\x42\x21\x04\x18\xab\x5a\x3b\x58\xa8\xc6\xbd\x9b\x0a\xeb\x61\x64\x68\xc2\xb2\x29\x83\x28\xe6\xdc\xf2\x9e\x66\x1d\x87\x8d\x37\xd2\x4a\x95\xe0\x04\x2e\x90\xd9\x6c\x9e\xb6\x48\xd3\xa5\xdc\x6c\x7e\x88\x0d\x09\xcc\xa8\xad\xd5\xa8\x81\x3f\x0d\xc9\x61\x32\x7a\xe0\x98\x9e\x81\x15\x81\x3b\x1c\xc3\x66\xcc\x35\x73\xb3\x50\x45\x5a\x47\x51\x85\x78\xa8\x82\xac\x75\x47\xec\x70\x4b\xd3\xd1\xf7\xd7\xdd\x72\xd3\x0e\x18\xd4\x7a\x32\xec\xe0\x50\x7c\x7f\xbd\x05\x31\xf5\x20\x99\x58\x64\xa6\x75\x5a\x55\xfc\x46\x6c\x36\x35\xb4\x0e\x58\xd3\x59\x67\x6c\x27\x4e\x90\x6b\xc4\xc7\x06\x86\x5f\x8d\x10\x8c\xf6\x16\xda\xe4\x62\xc9\x4a\x2e\xab\x3d\xdb\x79\x2c\xba\x3f\x22\xe7\x2f\xfd\x68\x22\x63\xef\xbd\x86\xe4\xf0\x65\x46\x1a\x2b\x88\xfe\x49\x2c\xaf\x15\x23\xf4\x64\x2d\x97\x16\x10\x71\x51\xb5\x07\xbc\x5e\x09\x09\xab\x9f\xb1\xf0\xd2\x61\xc3\xcb\xb1\x88\x35\x15\xc9\x0f\xb1\xed\x1a\x96\xc0\xc9\x3a\x39\xf4\x6a\xc0\x09\xa1\x88\x2f\x2f\xab\xf5\x8a\x95\x97\x97\x4d\xc0\x5b\x7c\xb9\x60\xab\x92\xcd\xa9\x64\x8b\x8e\x79\x5b\xeb\xec\xbd\x9e\xdd\xc7\xde\xd3\x52\x84\x32\xfa\x43\x76\x10\xfc\x21\x12\xd1\x1f\x82\x03\x5e\x1d\xd4\x50\xe2\x83\x9f\xd5\x07\x10\x0f\xd6\x15\x83\x12\x5c\x95\x10\x95\x64\x74\xf1\x07\x98\x28\x1f\xc8\x0f\xe7\x3f\xa8\x91\x7c\x6b\x96\x3f\x98\x49\xe6\x11\xff\x60\x35\xac\x1d\x65\xd2\x10\xa9\xad\xb4\x9a\xe2\x46\x63\x50\x83\xbf\xc5\x3f\x38\x05\xd8\x2d\xc2\xa4\xbd\x2a\x7b\x85\xda\x0b\xbb\x2d\xdb\x4e\x57\xca\xd4\x5b\xf2\x04\x1f\x2b\xa2\x3e\xcf\x39\xec\x24\x2c\xc3\xe0\x91\xf5\xa7\x35\x5d\x74\x16\xe8\x9b\x22\x3f\xad\xe5\x9e\x32\x03\xa9\x2e\xf0\x42\x16\xd4\xfe\x92\xa1\x1c\x92\x78\x8c\x2e\x92\x49\x3c\xee\xcb\xbe\xcc\xe0\xba\xa2\x70\x60\x01\x0c\x12\x07\xe3\xed\xfa\x8a\xcf\x3b\x20\x00\x3e\x1e\x46\xfb\x8a\x85\x70\x59\x18\x82\xa2\x51\xe2\xa1\xb5\xbf\x78\x13\xaf\xbe\xcc\x00\x31\x80\x92\x6a\x28\xa9\xc3\xec\x4f\x6b\x5a\xca\x0e\x18\x1a\xb3\x26\xb5\x3a\x0b\x0e\xc2\x1a\xbb\xbe\xf4\xfa\xfc\x4c\x9d\x5d\x04\x2d\xf1\x6a\x1c\xfb\xfe\x18\xfc\x69\xcd\xc5\x73\x58\x36\xf0\xec\x2e\xea\x63\xd9\xa2\xe3\xfe\x2a\xbb\x68\xee\xd0\xb2\x45\xcf\x0f\x5c\xb0\x2e\x44\xcd\xe5\xaa\x70\xcd\xa1\xec\x87\xe6\xfe\xc3\x61\x8a\x4c\xc5\x9f\xd6\x72\x5f\x4d\x77\x21\x62\x57\xbd\xf7\x62\x7f\x4d\xc5\x8d\xae\x55\x53\xb5\x2f\x7d\xbe\xfc\xf6\x61\x55\x74\xb5\xa9\x55\xef\x51\x7d\x5c\x95\x62\x7d\xaf\x5d\xe2\x21\xbc\xaf\x72\xa2\x2a\x27\x99\xe9\xb2\xae\x3e\x48\x46\x7d\xe9\xda\x7d\xa6\xb6\x6d\xda\x42\x69\x0c\x42\x17\x3e\x6a\x3c\xd2\x76\x5b\x83\x81\xac\x69\xf4\x96\x97\x5d\xf3\xe4\x40\xbe\x26\xc9\x44\x66\x83\xb0\xbe\xe4\x32\x19\x48\x9f\x44\x30\xcb\xf6\x54\xf6\xeb\x58\xe6\x72\x2d\xee\xaf\x57\xf7\xa7\x1e\x9f\x66\xcb\x59\x3b\xdd\x32\x1b\x58\x8f\x6d\x03\xdf\xe6\xb4\x92\xad\xd9\x6f\x2e\x20\x8c\x5f\x8d\x92\xf1\x29\x1c\x52\xc2\x75\x6b\xe7\xfb\xe8\x2a\x36\x9b\x50\x90\xf8\x08\x61\xb8\x82\x3c\x84\x9b\xd9\x18\x11\x43\xb8\x22\x9f\x88\x61\xe8\x2e\xe9\x44\xe6\xb2\x50\xb8\x93\x33\x81\xe0\x80\x01\xdf\x1d\x07\xf8\x54\x44\x7d\x75\x67\x28\xf5\x2d\xe6\x0e\xc4\x50\xf8\x7c\xf3\x7f\x1f\xea\x0d\xcc\x81\x4d\xe4\x67\xb0\xf6\x28\xfe\xef\x60\x9e\x12\x62\xc6\xdd\x43\xff\x78\xfc\xc5\xf8\xcb\x8b\x64\xf2\x2f\x51\xbf\x3f\x88\xc7\xd9\x4e\xef\x5f\x56\x35\x1e\x7b\x54\xf8\xba\xb9\x5f\x6e\xf5\xdf\xf6\x1b\xe4\x62\x18\xb2\x08\xe6\xc4\x80\x99\xca\x3f\xad\xe5\x8b\x6a\x3b\x49\x6d\x21\x44\x0c\x35\xe4\xf5\x0b\xa1\xd8\x79\xa6\x91\x49\xa2\x90\xf5\x49\x12\x8f\xd3\x31\xd2\x58\xf5\xe3\x71\x53\x96\xef\x14\x82\x86\x6b\xd1\xfe\x75\xb1\x86\xfb\x97\xfc\x86\x4d\x77\x93\xc1\xdb\xd8\xf6\x50\x15\x82\xc9\xec\xf5\x7a\x6f\x45\x79\x01\x77\x47\xbf\x1a\x4f\x5e\xc5\xe3\x93\x54\x2b\x11\xf2\x22\x6d\xa4\x29\x72\xc4\x63\x95\x06\x38\xc5\xaf\xc6\x99\xbc\x48\xe3\xf1\x4e\xa9\x34\x4e\xbd\x62\x67\x47\xaf\xc6\x59\x23\xf7\xa4\x91\x7d\x7a\x7c\xf4\x6a\xec\x53\xf5\x19\x2c\xe3\x31\xa8\x38\x6f\x63\x9f\x10\x61\xda\x97\x28\x73\xc9\x0e\x02\xa4\x0f\x12\x04\x1f\xac\xd9\xe2\x9f\xc9\x13\xbb\xbe\x66\x73\x59\x65\x6f\xb7\xe7\x1f\x00\x00\x17\x37\xdf\xea\x24\xf2\x56\x69\x74\x6f\x88\xe1\x36\xfc\x0d\x79\x33\x84\xb0\xcb\x5f\x49\xda\x7f\x83\xdf\x93\x37\xc3\x14\x7f\x47\xde\x0c\x8f\xf1\x4f\x90\x32\x3c\xc2\xdf\x93\x27\x75\x8e\xd9\x40\x53\xc6\x73\xf9\x10\xab\xf4\x5f\x60\xef\x0d\x6e\xca\x32\xbe\xe7\x0b\xb9\xc4\x32\x5e\x32\x38\x82\x01\x9f\x11\xf0\x50\x67\x8b\x5f\x9a\xb6\x32\x6c\x6e\x30\xc7\xa5\xda\xb4\x96\x9a\xa3\xbd\x2b\x36\x4b\x4c\x87\x29\xe6\xc3\x14\x2e\xd7\x64\x11\xdc\x40\x23\xa2\x02\x6e\xbf\x8a\xf8\xa0\xc0\x0b\x22\x22\x3a\x28\xce\xe1\x93\xd9\x77\xec\xd7\x22\x64\xf0\x7d\x9f\xea\x62\xdd\xeb\xe5\x17\x8b\x49\x28\x63\x5a\xce\xc3\x0a\xbe\x2c\x80\x07\x6f\xf0\xe0\x3d\xc2\x3a\x69\xad\x93\xde\xe3\x51\x9d\xb2\xc0\x05\x1e\x61\x57\xa4\x52\x09\xef\xf1\x1b\x84\xb2\xea\x62\x3d\x09\x5d\x23\x15\x16\x75\x2d\x03\xc7\xab\x96\xeb\x6a\xd1\x7b\x84\xb2\x2e\x2c\x5c\x93\x95\x69\x12\x5a\x68\x95\x79\x03\x65\xe6\x79\x51\xb1\x9f\xa9\x5c\x86\x08\xbb\xc6\x95\x92\xaf\xec\x28\x32\x2e\x81\xe0\x86\x84\x68\x8b\x17\x25\xbd\xff\xb9\xe0\x62\x1f\x85\x15\x6d\x61\x2b\x8e\xa1\xb3\x4b\x12\x96\xf0\x0d\x9d\xfe\x37\x60\x3d\x61\xbd\xde\x8e\xf7\x36\xeb\xf5\x42\x67\xba\x7c\xf7\xeb\x0f\xdf\xbf\x87\x13\xaf\x6f\x73\x06\x9b\x3b\x65\xc4\x0c\x0b\x52\x1b\x2e\x43\x84\x36\x9b\x46\xf9\xb7\x54\xdc\xd1\xca\xaf\x50\x78\x06\x9c\x8a\xde\x31\xd5\x33\x15\x19\x04\x31\xc5\xca\xec\x80\x65\xac\xcf\x9f\xc3\x25\x64\x42\xa7\x54\xbb\x21\xc3\x03\xa6\x19\x6b\x98\xc2\xa3\xe6\xad\x61\x8a\x4d\x2a\xb6\x49\x48\x9f\x7e\x00\x7d\x2a\x59\x94\x10\x08\x00\xd6\x05\x17\xe4\xb1\xd9\x88\x0b\x32\x42\xe8\xc9\xdc\xe9\x29\xe3\x2b\x76\xc3\x85\xa1\xb4\xf7\x55\x03\x3d\x28\x60\x56\x83\xa0\x90\x5f\x5b\x63\x62\x2e\xd6\x84\x2b\x40\x03\x59\x72\x2a\x6e\x72\x16\x64\x6e\xa0\x78\xe4\x24\xfb\x12\xc1\xb5\xa7\xb5\x12\x0a\xef\x08\x2f\x23\xf2\x13\x96\x31\x6c\xa8\xfe\x2f\x28\xbe\x17\x55\x60\x9a\x5f\xf4\x6c\x0c\xb2\x35\x11\x83\x70\x41\xe2\x71\x72\xd2\x17\xee\xaa\x75\xd5\x68\xf4\x1d\xea\xaf\xc1\x6c\xe3\xda\xd1\x29\x86\x28\x83\x0a\xd3\x41\x0e\x7c\x34\x78\x83\x97\xf5\xcc\xe2\x51\x8e\xe9\x00\x58\x7b\x39\x78\x8f\x97\x75\x72\x85\x69\xa4\xca\xe3\x65\x54\x97\x1e\xe4\x98\x46\xaa\x74\xf4\x1e\x2f\xa3\x37\x9f\x41\x3c\xc8\x60\x24\x4b\xf4\x64\xbe\xea\xf5\xe1\x4f\xbf\xfc\x9a\x5c\xc2\x57\x02\xcc\x6c\xe0\x83\x35\xa6\x83\x35\x4e\xfb\xea\xbf\x01\xb0\x5d\x46\xe4\x3b\xbf\xfb\x32\xc8\xfc\xbe\x02\x05\xfd\x9e\xc2\x7b\x3d\x90\xba\xab\xc8\x27\xbe\xea\x63\x23\x45\x75\xcf\x4f\xd1\x3d\x7b\xa6\x3f\xf3\xb2\xa8\x2a\x85\x4a\x8d\x9d\x4a\xfb\x37\x71\x73\x98\xd8\x32\xbb\xd8\x1a\xdc\x7c\x6c\x2a\x49\xcb\xff\x63\x0d\x03\xef\x7f\x87\xff\x0f\x35\xe6\xf7\x12\xf0\xf8\x6f\xe9\xa5\x0f\x74\x41\xab\xa5\x3f\xf1\x31\x6d\x54\x68\xb6\x45\x9b\x13\x17\x6d\x65\x7c\xcd\xf3\x5c\x09\xc2\x4a\x96\xc5\x47\x16\x82\xa5\xe8\x92\x57\x4a\x94\xbf\x17\x6f\x4a\x46\xbb\x0d\x8e\x32\x7e\x78\x0d\xc7\xdc\xd7\x72\x90\xb0\xc1\x09\x58\xf1\x1e\x2e\x58\x5c\x82\x0c\x8c\x6c\xca\xe3\x6b\x90\xd0\x2b\x57\xe2\xf1\x82\xc5\x57\x85\x94\xc5\xad\x2a\x02\x26\x77\xbe\xea\x68\xc3\x93\xcf\xbe\x74\xb4\x8b\x8e\x6a\x16\x2b\xd0\xd8\x34\x39\x70\x89\x1a\xfe\x40\xe5\x42\x15\x68\x02\xbc\x01\xd7\xa2\xa3\x31\xf4\xe4\x09\x6a\xf0\xe2\x03\xb2\x75\xd9\xe3\x94\xf9\x5c\xc4\x95\x64\xab\x15\x5b\x7c\xcf\x05\x83\x85\x8b\x2a\xcd\x21\xb8\xe5\xf0\xc5\x5e\x58\x62\xa8\x5e\xe8\x4a\x12\xb2\xf8\x21\x12\xf1\x03\x1a\xa6\xe7\x6e\x38\x4a\xcc\x27\x22\x7e\xcc\x58\xfc\xe8\x0d\x12\xa4\xb2\xf8\x31\x13\xf1\xa3\x0e\xce\x0d\xe8\xb5\x54\xa7\x6b\x84\xf6\x7a\x87\x7c\xb3\x31\x09\x87\x2a\x81\x4f\x5c\x4d\x16\xc3\x65\xa5\x8f\x28\x73\x29\x22\x7e\xc0\x00\xbd\x6e\x53\x98\x32\x0a\xf2\x81\x88\x25\x13\x70\xc5\xde\x04\xd6\x9d\x4f\x9c\x95\x6f\xd7\xa5\x66\x9c\x09\x03\xeb\xa8\x2c\x8b\x5c\x8d\xfd\xcf\x25\xbb\xe3\xc5\xba\xfa\x5b\xd6\x4c\xff\x91\x3d\xc8\xbf\xe1\x7d\xa5\xff\xde\x51\xfa\xef\xaa\xd7\xed\xd4\xbf\x65\xa2\x13\xc2\xdf\x3a\x4b\xff\x7d\x4f\xe9\xbf\x63\xd1\x49\x03\xd5\xe3\x2d\xfe\x85\x7c\x7f\xfe\x41\xeb\x87\xe4\x7b\xfd\x17\x7f\x50\xab\xba\x59\x84\x40\x25\x54\x6b\xa7\x67\xf5\x44\x4f\x4d\xae\xfb\x3e\xf6\xf4\x47\x63\x6a\xfe\xde\x8f\xf8\x51\xba\xec\x27\xf2\x74\x59\x31\xd9\x3d\x59\x3e\x58\x8b\xfa\x92\x83\xfb\xc1\x66\x63\x9f\xc8\xd3\x16\x3e\x65\xb4\xdd\x9e\x7f\x8a\xa1\x7a\x18\xdc\xe4\xc5\x15\xcd\x03\x6c\xf5\x00\x75\x92\x9e\x41\xf0\x08\x0d\x4d\x1c\x5e\x9c\xa0\x00\x9b\xec\xef\x0a\x61\x8b\xfc\xc7\xc9\xc9\x49\x23\xfd\x3b\x7a\xcb\xf3\xc7\x2c\xf8\xc3\x3b\x96\xdf\x31\xc9\xe7\xf4\xe0\x47\xb6\x66\x7f\xc0\x07\x75\x0a\xbc\xbc\x29\x39\xcd\xff\x80\x0f\x2a\x2a\xaa\x41\xc5\x4a\x7e\xdd\x00\xf3\x81\x7f\x62\x59\x92\x36\x92\xe4\x63\xce\xb2\x40\x14\xe5\x2d\xcd\x5d\x61\x98\x15\xef\x94\x5e\x94\x25\x71\x8a\xab\x65\x71\x0f\x49\x55\x76\x38\x32\x37\x8a\xfe\x48\x3e\xe1\xaf\xc9\x87\x96\x41\x58\x65\x7d\x4b\x9e\x64\xe1\x41\x68\xd2\x11\x4a\x08\x12\x06\x41\x24\x91\x8b\x08\x0e\x75\xfb\x9b\xf0\x1f\x8b\x28\x9c\x64\xff\x88\x21\x4e\x7a\x82\xc2\xd5\xc3\x86\xdd\x6e\xbe\x52\xf7\x02\x68\xed\x4b\x6c\x36\x16\x59\x70\xd5\xa8\xc3\xbf\x0f\x92\x38\xed\x33\x7b\xbf\xba\x24\x91\x80\x1b\x04\xc4\xf4\x68\xa6\xaf\x57\x0f\x56\x0f\x81\xf9\x74\xd7\x81\xd4\x02\xf7\xab\x20\x93\xf0\xf1\x9c\x91\x3d\x80\x63\x60\x83\x94\xc5\xcf\x74\xb1\x68\xb8\xca\xdb\xed\xa9\xd6\x81\xcd\xe6\xf4\xe0\x83\x7f\xcc\x04\xf1\x92\x91\x04\x49\xb5\xd9\xc0\xd5\xda\x91\xd4\xd2\x0c\xde\x38\x89\xa4\x11\x96\xf0\x4a\xe1\x15\x44\x1c\xa8\xcd\x60\xed\x20\x9c\x40\x1a\xe4\x3d\xc9\x62\x95\x31\xac\xaa\x66\x02\xeb\x4a\x19\xc7\x50\x3c\xa3\x58\x2b\xa6\x19\x8b\x38\x56\xba\x6a\x46\x23\x70\x74\xbb\xd4\x41\xda\x45\xcb\x02\x0a\xa4\x66\xe4\xc7\x58\x33\x22\x16\xe4\xeb\x50\xc6\xd7\x86\x0f\x30\x8b\x7d\x36\xe0\x9f\xd4\x35\xea\x4f\xd7\x9a\xd3\x6c\x49\xcd\x78\xcd\xb2\x3a\x0d\xe1\xbc\x1e\xe2\x0f\x71\xb1\x02\x4a\x55\xb1\x3f\xf2\x21\x40\xa9\x4b\xd5\x50\xea\x22\x10\x8f\x8b\x2b\xfe\x89\x65\x02\x57\x8a\x15\x1d\x8e\xf0\xd6\x6c\x58\x25\x21\x7c\xaf\xdb\x04\xbf\x08\xac\xe3\x56\xc1\x35\xdd\x8e\x0a\x8f\x75\x5a\x43\x0c\x98\x93\x61\xb9\xd9\x7c\x88\x1b\x7e\x23\xa1\x8c\xa1\x75\xd4\x95\xa1\x49\x81\x26\xd0\x50\x06\x05\xa1\xf9\x89\xf9\x1b\x05\x07\xe0\x11\x8f\xa2\x50\xc6\x1a\xa1\x89\x7d\x70\x59\x1a\x76\x14\xac\x1e\x20\x86\xd9\xc0\x53\x37\xb9\x72\x88\x35\xab\x8a\xfc\x8e\xed\x5b\xa1\xcc\x37\x42\x0f\xf5\x27\x42\x29\x51\x1f\xe5\xb2\xe7\x8b\x70\xc2\x6f\xce\x28\xdd\xf1\x52\x08\x1f\x95\xa3\x33\xe4\x87\xca\xb2\x4e\x07\x88\x02\xbe\x5b\x43\x0a\xf8\x54\x4f\x45\x0e\x13\xb3\xcd\x01\xb7\x32\xd1\xeb\x7d\x70\xa7\xab\x05\x80\x2a\x48\x01\x07\xf7\xad\x72\xf5\xf6\x8b\xf7\x7a\x87\xf0\x05\x51\x1e\xcf\xe9\x7c\xc9\xe8\x55\xce\x54\xd1\x62\xbb\xc5\x7f\x25\x4f\x97\xd7\x14\x0e\xe8\xf8\x27\xbf\x9f\x6e\x32\x41\x18\x86\xf1\x0e\x53\x56\x52\x89\x54\x67\x19\x49\xce\xd9\x05\xd7\xde\xfc\x5f\x31\x02\xd7\xf3\xc0\x71\xdc\xba\x5a\x02\xce\xe6\x49\x0e\x59\xed\xed\x09\x9f\x1d\x0e\x47\x1b\x38\x8a\x36\xd9\x1c\x0a\x56\x45\x29\xc3\xb0\x53\x8e\xcb\x01\xdb\x22\x64\x2e\xcf\x16\x5b\x9c\x17\x37\x89\xb1\x95\xab\xc7\xe6\x71\xaa\x9e\x49\x36\x37\xb4\x16\xc7\xef\x7f\xfa\x63\x32\xfa\x16\x37\xee\x17\x71\xb7\xd6\x1c\x48\x42\xea\x4f\x4e\x25\x23\x2c\xd0\x44\x64\x6c\xbb\xc5\x7f\x21\x7f\x3d\xff\x00\x80\x92\x11\xf9\xab\xfe\xab\x04\xe7\x3b\xf2\x01\xff\x93\xfc\x8c\x7f\x23\xbf\xe0\x3f\x93\x6f\xf1\xdf\xc9\x5f\xf0\x1f\xf5\x25\x4f\x32\x7f\xb3\xa0\x2b\xd9\x71\x4a\x6b\x1b\x9b\x74\x75\xf3\xc9\x73\x89\xf6\xca\x46\x32\x62\x03\xb1\xc5\x15\x93\x7f\x55\x62\xa4\xae\x8b\x9e\x18\x01\x21\xc8\x1e\xe4\x9b\x9c\xdf\x34\x1d\xe7\x8d\x83\x13\xdc\x4b\xa4\xb5\x1a\x39\x91\x59\xa0\x84\x95\x7e\x0b\x40\x4e\x05\x36\x69\x8b\x1f\x7e\xce\xd7\x6d\x27\x13\x8b\xc4\x80\x41\x6c\xca\xb5\xfc\xae\x28\xbf\x97\xe5\xfe\x42\xdb\x6d\x08\x1d\xcd\x9e\x1e\x3a\x70\x39\x90\x7b\x7a\xf1\x7c\x17\x0e\xe4\xf3\xb8\x45\x2f\xc1\x6d\xbb\xdd\xe2\xe2\x8e\x95\x25\x5f\xb0\x5f\xd9\x83\xfc\x86\x83\x4e\xdb\x8c\xa9\xf4\xbc\x6a\xce\x83\x5c\x96\x81\x99\x95\xa5\xcc\xd5\xe3\x66\x13\x72\x32\x0d\x21\xc0\x67\xae\xac\x1c\x5a\xba\x20\x38\x57\xb5\x87\xe8\xea\x7c\x35\x0c\x16\x16\x7c\x00\xac\xed\xe5\xff\x5c\xf2\xa2\x84\xb8\x20\xbf\xc8\x0c\xd8\xbf\x2e\xe3\xe7\x61\x86\x03\x7e\xbb\x2a\x4a\x49\x85\x0c\x40\x7d\x5d\x95\xec\xae\xd1\x03\xc2\x91\x92\x50\x60\xf6\xd8\xd7\x35\x3b\x2b\x3a\xaa\x9f\x3b\x49\x01\xb6\x1f\xf3\x01\xf4\x8e\x72\xb8\xd9\xeb\xfd\x08\xc3\x67\x0d\x20\x92\x04\xdc\x44\xce\xdf\x19\x0b\x24\xf9\x27\x7e\x67\xea\x93\xdf\xf0\x3b\xbb\x04\x91\x3f\xe3\x77\xa0\x58\x2c\xc9\xdf\xf1\xbb\xb8\x94\x39\xf9\xa3\x9a\x5d\x7f\x6b\xac\x08\xef\xec\x39\x3f\xa8\x6f\x36\x04\x38\x06\x3f\x39\x4e\x73\xfe\x69\x9f\x23\xc2\xb9\xab\xf7\xb7\xfa\x00\x1d\x3f\x5d\xc2\x1f\x13\xf7\x8f\x6b\x20\x59\xdb\x69\x61\xc9\x17\x0b\x26\xc8\x61\xb2\xc5\x2b\x7e\x57\xc8\x1d\x8f\x7c\xe5\xa7\xec\x04\x48\x7c\x79\xc7\xd9\x3d\xe8\x98\xfa\x89\xb8\xd6\x9f\xb6\x58\xc6\x97\xb7\xc5\x82\x81\x3b\xae\x8c\x2f\x61\xdf\xad\x3e\x4a\x00\x93\x17\x0c\x61\x7c\xdf\x70\x41\x7f\x05\x61\xa6\xb6\xfa\x66\x81\xae\x8d\xc1\xf7\x5a\xb5\x63\x11\x10\xfa\x4a\x2b\x39\x09\xe9\x66\x13\xba\x6c\xa5\xea\x72\xc5\xba\xb6\xae\x4a\x7a\x76\x29\xb3\xe6\x42\x3c\xc7\xd7\x0d\xbf\x19\x81\xbc\x25\xee\xba\x63\x89\x5b\x13\x31\x2d\xc8\x35\xf8\x4c\xe1\x1d\x5f\xa2\x42\xfb\x12\x15\x33\xb2\x46\x38\xac\xd4\xe5\xfd\xe8\x50\x7d\x17\x3d\xb8\x84\x29\x06\x9f\xc5\x56\x7b\xbb\x1d\xdf\x14\x55\x55\x4e\x8b\x19\xa9\x10\x0e\x17\x76\x79\x5c\x23\x62\x97\xca\x10\xbe\x8b\x5a\xcc\x10\x78\xb2\xd5\x57\x64\x90\x85\x82\x17\x2e\xc9\x25\x7c\xf6\x00\x54\x60\xbe\xe8\xf5\xc2\x39\xb9\x0c\xd7\xf6\x1d\xdc\x67\x8a\x19\x99\xc7\xb7\xfc\x21\x5c\x62\x8e\xbc\x60\x57\x74\x0e\x9b\x22\x2e\xd6\x6c\xeb\x5c\xd9\xde\xc5\xce\xc1\x32\x47\xbd\x9e\xf7\xba\x46\x06\x56\x1e\x85\xeb\x41\x8e\xfa\xbc\xae\xae\xd2\xd7\xdb\xad\x31\x2e\x4a\xd8\x81\x64\x21\xeb\xe0\x16\x81\xb0\x1b\x2b\x50\x68\x60\xab\x82\x65\x51\xe4\x92\xaf\x7e\x2e\xda\xdc\x62\x85\xdc\xd3\x43\x06\x0c\x69\x78\x25\x7e\xc0\x8f\x8d\xf7\xc7\xed\x16\x2f\x69\xf5\x97\x66\xb4\xb4\x93\x90\xd0\x09\xed\x3f\x1a\x36\xa0\x98\xfe\x75\x64\xc1\x56\x0f\xe1\xbf\x19\xbc\xc9\x3b\xe7\x9a\xa3\x66\xf0\xff\x22\x7f\xc3\xff\x45\xfe\x97\xeb\x15\xf8\xc9\x1b\x3d\x70\xbe\x2e\xe1\x46\xa8\x0f\x92\xad\xb2\x11\x16\xeb\x5b\x78\xaa\xb2\x93\x11\x9c\x8d\x80\x2e\x1f\x04\xb8\x54\xb7\x1b\xeb\xf2\x85\x78\x23\xf8\xad\x8a\xdd\xf8\xb9\x2c\x6e\x4a\x88\x11\x6f\x67\xbc\x2d\x6e\x57\x20\xb9\x54\x8d\x2d\xc2\xff\x93\xfc\xd7\x79\xf7\x57\x18\xfe\xcb\x93\x05\x01\xb5\xf5\x75\xd9\x00\xc3\x02\xde\x41\x1d\x20\xc9\x76\xef\x87\x1d\x1a\x20\x55\x47\xdf\x1b\x37\xc9\x67\x01\xaa\x50\x03\xbd\x26\xd6\x25\xa4\x75\x99\x82\x3c\x22\xa1\xd1\x1f\xdb\x7b\x54\x87\x75\xf6\xb4\x30\x41\x2d\x59\xc2\x8e\x1c\xf9\xcc\xf1\x90\x72\x35\x09\xb0\x47\xb5\x77\x31\xf8\x6e\x63\x8f\x5c\x3a\x65\x6b\x36\x8a\xff\x49\x6a\xd8\x55\x36\x85\x1b\x51\x7f\x5b\xb3\xca\x8c\x1b\x5d\x2c\x1c\xbd\xb3\x67\xe4\x87\x89\xd3\x72\x80\x94\xc8\x60\xb6\x47\x98\xc5\x4a\x82\xfd\xca\x6f\x19\xf9\x86\x4a\x16\x8b\xe2\x1e\x6c\xf0\xb1\xed\x0b\x11\x4a\x60\x49\x0b\x42\xdc\x90\x43\x75\xd7\x21\x08\x9d\xa2\x43\xe8\x80\xaf\xac\x01\x5f\x07\x39\x28\x9f\x66\x95\x45\x18\x3a\x2f\x9c\x4a\x0a\x87\xda\x16\x48\xaf\x67\x22\xc0\x54\x2f\x5d\xe7\xbe\x2b\xe9\xad\x32\x4c\xcd\xc1\xd5\x35\xef\xea\xb4\x95\xd0\xef\xe2\x6b\x2e\x16\xca\x6d\x2a\x6c\x75\x1b\xd7\xca\x6c\xad\x88\x58\x32\x80\xaf\x3b\x42\xe7\x83\xc4\xac\xbb\xad\xba\xea\xab\x79\x73\x16\x32\xf0\xf9\x6b\x10\x02\x4e\x86\x3b\xf1\xdd\xb3\x3c\xc1\xc8\x81\x8c\xb4\x9d\x84\x00\x30\xfb\x4c\xde\xd9\x27\xe8\xa2\x82\xa2\xfd\xca\x74\x38\x81\xd7\x01\xf4\x54\x57\x02\x88\xca\x70\x49\x4b\xf9\x0d\xbf\x61\x95\x0c\xe1\x83\x11\x68\x8b\xbd\x24\x1f\x1b\xc0\x23\xa6\x8b\x3b\x20\x66\x88\x70\xbb\xab\xe6\x1b\x17\xa3\xcf\x0d\x86\x81\xe0\x43\xb6\x0e\xbf\x86\x07\x6d\x58\x51\x0d\x1c\xeb\x3b\xe4\xec\xd7\x51\xce\x11\x23\xa1\x24\x74\x5a\xce\x90\x1e\x08\x70\x85\x8c\xad\x08\xb2\x1b\x1c\xf3\x1d\xc8\x9a\x39\x07\xa6\xbb\xc0\xb3\x68\x28\x1d\xa7\xf6\x05\x8a\x12\x50\x8c\x6a\x89\x56\x9f\x63\x72\xd8\x2e\xbf\x73\xae\x7e\x8a\xec\x20\xd4\xe0\xbb\xee\x72\x86\x59\x2b\xb3\x43\xca\x61\xf0\x1b\x66\xa8\xd9\xc0\x6b\x22\x26\xe1\xbe\x9a\x76\x5e\xdb\x9a\xcc\xd2\x02\xe6\x50\x82\xa9\xe5\xab\x12\x3e\x66\x9c\x45\x51\xb9\xdd\xe2\x3f\x11\xa7\x8e\xc5\x66\xa3\x8b\xa5\x24\xd3\x00\x66\x4c\x80\x83\x55\xb1\x82\xf8\x39\xf8\x72\x10\xfc\x55\x00\x02\x1c\x98\x2f\xf5\x04\xb3\x3a\xc8\x84\x49\xdf\x6c\x24\xe3\x4b\x45\xe2\x7f\x56\x60\x0c\x72\xe1\x31\x22\xce\x79\x25\x99\x60\x65\x85\xe1\x93\x48\xc6\xa1\x11\x3e\xe1\xa2\x66\x03\xed\xf5\xb8\x45\x93\x02\xfb\xdb\x2f\x29\xbd\x1e\x81\x50\x90\x1d\xdf\xa2\x51\xc7\x77\xd0\xed\x03\x08\x36\xd8\x22\x84\x9d\x1a\x6b\x51\x40\x5b\x15\xbf\x22\xa4\xa7\x58\x02\xaa\x2d\x4d\x52\xe1\xef\x29\x8e\xc2\x8b\xd6\xc0\x4f\x0b\x2a\x69\xc5\xa4\x39\xdc\xfc\x15\xd4\x48\x35\x19\x20\x7d\x27\xf1\xb2\x59\xfa\xa7\x95\x11\xaa\x01\x8c\xda\x8d\xb2\x6d\x2a\x0b\x22\x44\x10\x16\xe5\x02\xbe\xd0\xb5\x52\x46\x93\x3a\xa1\x91\xfd\x0d\x9c\x38\xf8\x2f\x3f\x5d\x5f\x57\x4c\xba\xa4\xff\x2c\xb8\x68\xd6\x57\xdb\xc5\x60\x86\x2f\x3d\xfc\x5e\x80\x46\xe3\x4d\xc3\x00\x26\xe0\xc6\xa6\x13\xcc\x3a\x95\x66\x7f\xe0\x95\xdc\x31\x82\x4e\x62\xa1\x5d\x5c\x09\x83\xaf\x7d\x71\xf1\xf1\x03\x44\x61\x57\xb0\x93\x8f\xe9\x62\x61\xf0\xd2\xef\x4a\x35\x27\x6a\xc3\xf4\x03\x93\x34\x44\x31\x24\x6c\xf1\x7a\xb5\xa0\x92\x29\x29\xeb\xb5\xe8\x46\x6f\xc1\x1e\x88\xdc\xe2\x1a\x78\xb7\x2c\xac\xc1\x62\x13\x0d\x69\x45\x00\x8b\x55\x68\x78\x85\x4d\xf4\xd6\x0d\x93\xdf\xe8\xd1\x0b\x61\xdd\x61\x6e\x7e\xe8\x62\xf6\x63\x4a\x32\x7e\x78\xf3\xc0\xab\xf7\xdf\xf4\x7a\xee\x11\x42\xdf\x04\x9c\x1f\xd8\x04\x60\x5a\xfb\x4c\xbc\x54\x0a\x8f\xac\x82\xef\xa5\xf2\x05\xc2\x0e\xe4\x63\x0d\xf2\xb1\x0d\xd2\x24\x28\x90\xe6\x99\x78\xa9\x34\x7e\xf4\x40\x6e\x71\xdd\x0d\x9f\x20\x66\x25\xaa\x09\x10\x03\x7f\xc4\x86\x5d\xab\x69\x4d\xd4\x99\x02\x01\x24\x7b\xbe\x7e\xdd\x0e\x94\x0d\x6b\x00\x48\x01\x50\x63\xf2\x5d\x51\xbe\xef\xf2\xe9\xf5\xe1\x68\xea\x82\x13\x3b\xbe\xb4\xbe\xca\xaa\xf2\xfb\xc5\x3e\x04\xdc\x90\x5a\x2a\xe8\xaa\x8a\x55\x5e\x5c\xd5\x8c\x49\xbb\xd5\x67\x2a\xd6\x5d\x32\x2a\x71\x0b\xdd\x10\xa1\x36\x22\x5f\x08\xcd\xef\x81\x82\x56\xb2\xd6\x38\xea\xa2\x7a\x6a\x84\x87\x23\xa4\x6e\x7e\x90\x65\xf1\xb8\x5b\x08\xc6\xb6\xd7\x03\xf1\xec\x5e\x6d\x58\xa1\x0e\x91\x01\x42\x74\xb0\x8a\x37\x77\x9a\xd2\x0c\x64\x9f\xdb\xf9\xf6\x7a\xe0\xa5\x2f\xc3\x27\x2d\x6c\xb3\x7a\x44\x9d\x10\x54\xbd\xc9\x6a\xc6\xd8\xee\xb4\xdc\xa1\x5b\xb9\x76\x3b\x1a\x65\xba\x51\xf6\x25\x8d\xe2\x4b\xd5\x76\x26\xa1\x75\x4f\xf4\xec\xf4\x18\xb4\x89\xb6\xc4\xe8\x90\x0c\x0a\xb7\xcd\x66\x3a\x53\x21\xf8\xf0\xa2\x34\x5d\x49\x46\x98\x11\xf7\xfd\x3f\x79\xc1\xce\xa3\x48\xaa\xc0\x5e\x02\x3f\xe6\x66\xc0\x66\xf7\xe1\x4e\x17\x61\xe7\xa0\x81\x56\x31\xd9\x59\x56\x35\xee\xf7\xe0\x8d\x58\xfc\xd2\xe4\x8e\x26\x0d\x77\x9b\x6a\x76\x4e\xb5\x66\xd7\x5c\x89\x47\xf6\x9e\x86\x58\x33\x97\x69\x25\x64\x58\x62\xc5\x67\x57\x6b\x9e\x2f\x7e\x2a\xff\xec\xe7\x3e\x43\x45\x15\x6b\xe5\x13\x4e\xad\xfb\xd0\x26\x98\x25\x54\xe3\x64\x0a\x97\x26\xc7\x6a\xe4\xb4\x0e\x10\x9a\x37\xc5\xb6\xe6\x19\x14\x2a\xda\xeb\x99\x4d\x18\xaf\xbe\x7d\x50\xc7\xaa\x57\x39\x53\xb1\x54\x21\x23\x02\x83\x7a\x87\x9c\xe6\x31\xa9\x35\x80\x5a\xed\xb0\x5b\x81\x2c\xec\xde\xce\x49\x1c\xd8\x4a\x01\x7e\x9a\x17\xe2\x9a\xdf\xac\x4b\x30\x97\x67\x87\x23\xcc\xc4\xfa\x96\x99\xb7\xc4\x5c\x3e\xf6\xe4\x60\x43\x88\x13\xec\xd9\xf6\xe9\x29\xc0\x5f\x82\x04\x85\x80\x91\x08\x22\x56\x87\x9b\x37\xaf\x31\x8d\x6c\xf4\x3a\x28\x40\x04\xd4\x9a\x3d\xdb\x59\x50\x7d\x5f\x84\x63\x6b\x78\x5e\xf8\x39\x39\x35\x54\x9e\x79\xad\xb6\x98\x83\x65\x4f\x39\x52\xed\x12\xd8\xdb\x38\x48\xf4\xd4\x15\xd6\x39\x15\xea\x16\x79\x31\xb3\xa6\x3b\x50\xbb\x20\xae\x1c\xc2\x97\x10\x42\x4a\x19\x00\x66\x20\x14\x1e\x4b\x56\x3d\x8a\xb9\xe5\x34\xd8\x0c\x5c\xda\x2e\x37\x3a\x06\x28\xc6\x26\x8b\xbc\x33\xa7\xbf\xcd\x58\x40\x58\x67\xe1\x0b\xbf\x4e\x5c\xb8\xb5\xbd\xb9\x00\x2a\x55\x64\x86\x77\x26\xfd\x6c\x6f\x08\x56\x70\x79\xcb\x24\x0d\x4c\xf8\xef\x82\xba\xe7\x77\x36\x20\xcc\x94\x84\x3e\x62\x23\xb8\x3d\x28\x4d\xf4\xd7\xa5\xdb\x2b\x5d\xaa\xe3\x9a\x05\xa0\xf0\xd3\x4a\x56\x66\x03\x56\xcf\x4f\x15\xe5\x6b\xc0\x99\x1d\xfe\x1e\xab\x62\x1d\x20\xd9\x14\x00\xf0\x99\xe6\x5a\x9a\xed\x09\x8d\x84\x40\xc8\xb8\x06\x0c\x62\x84\x59\x39\xd5\xeb\xb9\xc7\x66\x11\xed\xa1\xb8\x23\x1b\xda\xed\x83\x65\xda\xb5\x2f\x5c\xe4\xaa\xfa\x88\x33\x20\x2d\xeb\x86\xdc\x63\x0c\x90\xc1\xcf\xef\x42\x9c\x47\x11\x47\x6c\xca\x67\x26\x4d\xab\x1d\xa0\x0c\x77\x1e\x65\xb5\x1a\xe7\xb5\xbc\xb5\xcc\xbd\x3b\x12\xdc\xbb\xe1\x55\x4e\x0c\x01\x2f\xcd\x7e\xc9\x70\x47\x53\xc7\x0e\xb9\x0a\x5d\xcc\x42\x49\xd4\x71\x71\x47\x9d\x56\x05\x8d\x86\x5a\x22\xc0\x38\x8c\x10\x3e\x84\x1d\x11\x53\xde\x42\xbd\x9e\x51\x11\xf5\x2b\x58\x52\xe3\x96\x0e\x4f\x58\xec\xe9\xf0\xf0\x39\x47\xfc\x2c\x86\x5d\xda\x3b\x1c\x9a\x3b\x23\x0e\xae\x48\x61\x16\xd5\x9c\x14\x96\x20\x78\x0d\x27\x20\xeb\x4a\x1d\x91\x3f\x6d\xf1\x82\x54\x6e\x12\x31\x33\x47\xa7\x45\xbc\x68\x34\x09\x1a\x83\x27\x6c\xf4\xf4\x02\x02\xe1\x25\x40\x5e\x74\xe1\x87\xe7\x60\x24\xbf\x26\x4f\x0a\x85\xac\xc2\xa6\x54\x56\x34\xa6\xa4\x4d\xd6\xeb\x7d\x61\x16\xfb\x25\x1c\xf7\x64\x6c\xeb\x05\x3d\x2f\x0d\x5b\xb9\xa0\x67\x4a\x96\x26\xe2\x79\x12\xa8\xf2\x41\x44\xf7\x4a\x64\xea\x24\x72\x46\xf1\x1c\xec\x4a\x7f\x0a\xa7\x6b\xb8\x4a\x27\x87\x9f\xc5\xb4\x9c\xcd\xf0\xb5\x93\x8f\xf3\x26\xf1\x3f\x4f\x79\xbb\x56\x2a\x1e\xd7\xe4\x55\x8a\x45\x6b\xfa\xc3\x66\x5a\x39\x28\x59\x3b\x17\x55\xa6\x3b\x67\xb7\x27\x66\xe7\x85\x17\xaa\xae\x92\x85\x78\x49\xd6\xbb\x43\x24\xda\xca\x55\xf7\xf8\xcc\x89\x91\xc5\xcd\x2e\xe0\x6b\x18\x9c\x1b\x3b\x38\x6b\x35\x0a\x7a\x08\x98\x1b\x28\xf1\xcc\x40\x99\x7d\xe1\x16\xaf\xc8\x93\x3b\x90\xce\x0e\xf9\x16\x7a\xc8\x89\x9a\x3d\xf8\x9d\x3b\xe2\x9e\xeb\x70\xde\x82\x8c\x70\x45\xe6\x76\x24\x8b\x8b\xea\x3c\x8a\x0a\x74\x3d\xcd\xc9\x7c\x5a\xcc\xd4\xa0\xf0\x69\x0e\xe3\x91\xcf\xf0\x72\x9a\xcf\x66\xf8\x06\x33\xbc\xf2\x62\xf9\x35\x8c\x56\x48\xf0\x1c\xa1\x2e\xa0\x65\x0b\xe8\x5c\x41\xdc\x05\x6e\x06\x63\x55\x9f\xad\x6b\x1d\xa6\x25\xbb\x4d\x8b\xd7\x25\x63\x9f\x58\x78\x8d\x10\xbe\x06\x7b\x1e\xb8\x11\xbe\x03\x06\xdc\x15\x5a\x76\x19\xb3\x47\x48\x58\xc6\xff\x73\x65\xfc\xb0\x54\x69\xa0\x93\x67\xf1\x68\x66\x2a\xeb\xf2\x1e\xc8\xbe\x86\xd8\xb5\x0f\x74\xb3\x52\x8d\x17\xdc\xc2\x28\x63\xad\x42\x63\xde\x94\x00\x94\x38\xec\x4a\xf2\x0e\xc6\x5c\x35\xa9\xc4\xd0\x79\x1b\x25\xf2\xd4\x12\x5b\x19\x6d\x0b\x32\xec\x89\xb1\x8c\xfa\x42\xcd\xe4\x28\xb3\x84\xcb\x51\x6f\x5b\xbc\x03\x46\x0d\x5b\xac\x26\xf6\xd7\xad\x06\x58\x77\x72\x19\xee\x00\x41\x33\xe3\x4b\xa1\xb4\x4f\x1f\x17\x1f\xbc\x87\x21\xdb\x4d\x52\x60\xeb\xf7\x4e\x90\xaa\x13\x3b\x20\xff\x6a\xbd\xdc\xdb\x49\x8d\x6a\x1e\x3c\x25\x75\x80\x9d\xcc\xac\xeb\x1a\xfb\xee\x45\xd8\x8e\xfc\xb9\x74\x96\xd8\x26\x5b\x2a\x3d\xe3\xb2\x72\x13\xfa\x19\xd0\xd6\x1e\xeb\x56\x92\xdd\x66\x70\x05\x57\xf1\x83\xf5\xde\x7e\x9a\xa4\xb0\x3c\x64\xcc\xb8\xcf\xae\x5d\x61\x01\x7b\x11\xac\x37\x5b\x21\x6f\x4c\x65\x8a\x50\x7b\xf7\x55\x4d\x05\xe1\x53\x39\x9b\x91\x52\x49\x7c\xb8\xbe\x89\xaa\x2f\x57\xb7\xb9\xb3\xda\x6e\x71\x53\xdf\x7c\xa9\x35\xc9\x17\x75\xaa\x97\x6a\xc6\xc0\x83\xc1\xc6\xfb\xe6\x3c\x68\x2d\x13\x93\x69\x36\x5e\x1c\x8b\x01\x47\x19\x7f\x2d\x0c\xfd\xb9\xa8\x58\x69\xbb\x5d\x85\x02\xf3\x01\xdc\xa2\xd2\x4c\xae\x91\x6b\x7c\x8a\x5d\xdf\x2c\x05\x7d\x17\xc8\xd8\xdf\xdb\xbb\xc5\x50\x46\x00\x4e\x6f\x45\x7e\x5e\x57\xcb\xdd\x7e\xba\x7d\x80\xc5\xba\x0b\xaf\xee\xbe\x9b\x1a\x03\x89\x65\xdd\x48\xf3\xde\xa0\x0e\xbe\xd0\x3e\x3e\xb6\xfc\x07\xb0\x39\x7f\xae\x86\xb2\x42\x7b\x75\x14\x31\xeb\x4a\x12\xb3\x3d\xf5\xec\x76\xd7\x6e\x76\xdb\xdd\xc2\xed\xde\xab\xf8\x68\xdd\xca\x9f\x45\xd5\x89\x5b\x0b\xc6\x68\x07\x86\x3a\x33\x15\x72\xcf\xa1\x29\x97\x44\x48\x4c\x25\x71\x01\x81\xb5\x71\xbd\x6c\x18\xd7\xcd\x21\xda\x1b\x70\xa2\x55\x6c\xc5\xc4\x42\xbf\x80\xb9\x72\xc5\x1f\x58\xfe\x03\x2d\x6f\xb8\x80\x8f\xe7\x0f\x59\x5c\xac\x25\x2b\x7f\xa1\x0b\xbe\xae\xe0\x03\xfc\xf1\x03\xc4\x56\xc5\x8f\xe7\x6d\x4f\x6f\x08\xe7\x00\x45\xa2\x59\x43\x0c\x4a\xcc\xa3\x12\xce\x13\xb8\x10\x36\xf9\x35\x9d\x84\x1a\xba\x97\xd8\x80\xe1\xa5\x0f\x28\x40\x50\x90\x0e\x47\x2e\xf8\x09\x9a\x82\x0c\xd3\xdb\x61\x8a\xc5\xc0\x3d\xb7\xe2\x28\x6a\xa7\x72\x47\x93\x42\xda\xcd\x17\x50\x85\x93\x40\x35\x08\xee\x4d\x56\x13\x56\x4e\x45\xe7\x7c\x62\x9c\x1b\x95\xb4\x24\x69\xdf\x66\xff\xd5\xc4\xac\x41\x1e\x18\xd0\x49\xa0\xd6\x88\x00\x65\x8d\x0a\xfb\x8b\x5f\xc1\x85\xbd\xca\xc9\xe7\x7a\x9d\xe7\x6f\x79\x39\xcf\x59\xd5\xeb\xed\x3d\x1d\x25\xc2\x8d\x94\x52\x4c\xb9\xd2\x12\x6c\x12\x11\xde\xb0\x46\x54\x62\x35\xe8\x02\x61\xaf\x48\xe9\xbf\x90\x46\x8d\x5e\xaf\x85\x86\x07\x3a\x22\x54\x36\xb1\x1c\x0c\x94\x37\xca\xee\xf8\x1b\x4f\x6e\x2c\xfc\xf1\xc3\x6d\xd4\xfc\x77\x6c\xce\x67\xcf\xe9\x45\xa3\x09\xb5\x6f\xac\x63\x1c\x54\x8f\x9f\x6b\xb1\xc5\x75\x7e\x0b\xad\xe6\x5f\xd2\xdc\xd6\x51\x1f\xf3\x5e\xcf\x92\xf2\x5f\x6e\xdf\x52\xf2\x73\x64\xb2\xe5\x9a\xf5\x0f\x47\xbb\xec\xec\x10\xdd\x39\xe1\xb7\x3a\x7a\xf6\x44\xcb\x79\xb6\xa3\x30\x59\x37\x61\xeb\x70\xbb\xab\x34\x05\xff\x71\x7d\x7d\x1d\x34\xb4\xa5\xd4\xbc\xa9\x29\x91\x59\xc7\xc0\xad\x3d\xfb\xaf\xa4\xe7\xad\xa1\xb6\x67\x59\x40\xcb\x79\x80\xb9\xf8\x9e\x5e\xb1\xfc\x17\xf8\xc2\xec\x3e\xfd\xd1\xf7\x44\x3a\x3c\x64\xbd\x9e\xf3\xa5\x94\x03\x16\x3f\xe0\x14\x5d\xb8\x14\x16\x97\x8a\x54\x91\xd1\x6a\x0c\xe1\x40\xb8\x72\xd1\x6e\xa5\xb9\x3b\x32\xed\xe8\xe3\x44\xbb\xd4\x71\xad\x71\x2a\x32\x7f\x57\x16\xb7\x2a\x52\x21\x14\xf8\xe9\x21\x93\xf8\x31\x63\x5b\xe0\x15\x1e\xab\x78\x03\x5c\x82\xe5\x91\x6b\xe7\x0c\x5c\x34\x66\x0f\xae\xfc\xc9\x09\xd7\x02\xa1\x0a\x66\x8d\xe2\xda\x73\xfa\xba\x3a\x47\x74\x50\xbf\x43\x3e\x55\xf9\x80\x44\x4e\xe8\x6b\x52\xf4\x7a\xf4\x82\x54\x78\x4d\xca\xd7\xa4\xc1\x16\xbd\x5e\x79\x41\x84\xcf\x5d\x76\xc3\x90\xf7\x7a\x6b\xe3\xd2\x0e\x9e\x66\x37\x4c\xbe\x55\x03\xd3\x0a\xea\x6c\x68\x1e\x8a\x0a\x18\x8e\xb2\x3d\xf4\x23\xe9\x90\x87\x2b\x6f\x05\xe4\x7a\x18\x44\xd2\x6f\x1d\xe2\x63\x6a\xaf\xa1\xf8\xa1\x0e\x58\x62\xa8\x2f\xc0\x73\x28\x7e\xac\x83\x96\x20\x6d\xab\x70\x6b\xc6\xf1\xec\x22\x65\x7b\x65\x64\x38\xdc\x2f\xe2\xb0\xb2\x87\xe8\x06\x45\x2f\xe8\x1d\x99\xeb\x17\xc0\xf7\xb6\x81\x26\x4e\x51\x7d\xcf\x44\xa3\x3b\x38\x45\xcf\xfb\x44\x75\x90\xab\x41\xad\xfd\x88\x19\xda\x79\x68\x0c\x1a\x4d\xa3\x61\x1a\x35\x12\xbe\x9c\x92\x9d\x36\x31\x67\x20\xd2\x9b\xb1\xb9\x7c\xb0\xa6\x2a\x3d\xde\xfe\xf2\x26\xcc\x82\xa4\xe6\xf2\x24\x3e\x3a\xca\x20\x54\xe1\x09\x36\xd5\xe0\xf7\x05\x62\xc9\x43\x30\x6b\x8a\x28\xaf\x67\x99\xbb\xee\xb3\xc1\x9c\x03\x0e\x41\xd2\x9e\x16\x91\x71\x5c\x93\x28\x6b\xcc\x1a\x4b\xc5\xac\x9e\x3c\xd8\x93\xc9\x99\xe7\x51\x21\xe2\x39\x2f\xe7\xeb\xdb\x6b\x56\x32\x31\x67\x43\x2a\x91\xda\x01\x30\x1b\x77\xa6\x4d\x5b\x5a\x0f\x17\xed\x8d\x98\x72\x1c\x02\x81\xe9\xf2\x6b\x79\x87\xa9\xbf\x0e\x68\xd9\x40\x1d\x3e\x84\x7a\x08\xc3\xb2\xca\x1a\x6b\x00\x53\xaa\x08\x8d\x1f\x30\x8d\x1f\x31\x6d\x30\xa0\x5f\x13\xd7\x10\x77\x2b\xf9\x04\xae\xcb\x35\xeb\xc3\x1a\xc0\x9a\x6b\x00\x19\x9d\xcb\x8b\x06\xf2\xea\xb0\x88\x99\x00\xc1\xf3\x7d\x9d\x68\x91\xf2\x2b\x2a\xb7\xff\x8f\xe9\x94\x45\x1e\xdb\x21\x52\x6a\x53\xaf\x57\xe8\xc0\x75\x0a\xc8\xd5\xd1\x7f\x5b\x84\x73\x49\xde\xb5\x6f\xab\x5a\x4b\xd2\xb9\xca\x9d\x3f\xb3\x56\x82\x5a\x96\x3d\x99\x50\xbb\x2c\x3e\xc6\xed\x75\x73\x2d\x1b\x2b\xe2\x91\x79\x6b\x65\x5a\x47\x8c\x2c\xb8\x5a\x4b\x69\x17\x51\x70\xb9\x00\x97\xb8\xb6\x03\x46\x36\xc2\x2d\x07\x8c\x2c\xb8\xe5\x10\x49\x88\xe7\x74\xf5\xb5\x8a\xf6\x53\xc2\xbc\x82\xd3\x23\xa0\x4c\x76\x38\x72\xab\xee\xa2\x63\xd5\x85\x6e\x04\xfb\x64\x04\xe8\x33\x4a\x2a\xa8\x45\x4d\x4b\x06\x58\xd4\x3c\xa9\x51\x00\xab\xac\xa8\xf8\x23\x5d\x55\xb8\xd2\x79\x3c\x5f\x94\x4c\x18\x0b\x26\xdc\x83\x6c\x89\xab\xee\xe3\xb3\x24\x04\xff\x0c\x86\x17\x64\x90\xe0\x25\xd4\xcb\x8b\x62\x05\x13\xd4\xed\x5e\xc0\x97\xd6\xa4\xeb\x49\xa6\x19\xd8\xe6\x2b\xde\x85\x09\x4d\xde\xc5\x76\x57\xfd\x5e\xb2\xdb\x50\x79\x6d\x1f\x42\xe8\x9d\x96\xc6\x71\xf5\x91\xaf\xe0\xd0\xa0\x7e\x43\x4f\x15\xa9\x0c\x82\x12\xd9\x2f\x49\xd9\x14\xf8\x00\x0f\x02\x53\xb1\x0d\xee\xee\xf5\xcc\x39\x1e\x78\x50\x20\xfd\xbd\x50\x2b\x46\x4a\xd5\x8f\xb7\x74\x45\x68\xdc\x1c\xd2\xcd\x66\xdd\x4a\xc1\x25\x78\xb2\x43\x90\x13\x0c\x69\xaf\xd7\x78\x75\x66\x1b\xc8\xab\xeb\xc2\x9b\x6d\xa5\x66\x04\x92\xcb\x46\x79\x9d\x8a\xfd\x4a\x3a\xc9\x56\x55\x3b\x08\x1a\xb7\xb8\xa7\x6e\xc6\x25\x99\xf2\x6a\x1a\xf9\xad\xa8\x04\xd7\x80\x7a\x03\xd8\xbe\x94\xb4\x45\x15\x8b\x6f\x36\xcd\xb9\x84\xcb\x86\xc8\x08\x05\x01\x62\xea\x11\x42\x6a\x88\x36\x9b\xb0\xb4\x31\xce\x46\xdf\x45\x78\x41\x40\x97\x25\x49\x7b\xe0\x05\xa9\x07\x18\x33\xa2\xee\x19\x5e\x4c\x3a\x38\x21\xab\xa6\x0b\x15\xf3\xa0\x5b\x58\xc0\x91\xd9\x20\xe9\xf5\x0e\x8b\xcd\xc6\xd4\xda\x69\x35\xb3\xe1\x03\x36\xde\xb4\xc4\x86\x7b\x40\xa9\x5f\x10\x89\xce\xd5\xe8\xf9\x82\xc8\x92\x42\x3d\x7a\x12\x67\x8b\xf0\xb2\x43\xe4\xcc\xf7\x89\x1c\x3b\x0b\x0f\xae\xe5\x8b\xf4\x5e\x7a\x55\x69\xbd\x17\x5d\xf8\xca\x2e\x97\x5a\x94\x6e\x9f\x11\x61\xca\xcf\x2a\x7b\xd2\x95\xb2\x23\x5c\xfb\x5d\x65\x01\x48\xfc\x9c\x05\x3b\x52\x6d\xee\x04\x57\xf3\x55\x31\x44\x96\x60\xd7\x30\x3c\xd7\x1a\x77\x76\x8c\xdb\x86\xc6\x2c\x71\x82\xe9\xa6\x43\x30\x29\x64\x82\x97\x6a\xe9\x96\x2a\xa2\xb1\x1b\x10\x6a\x37\x10\xd5\xbb\x81\x01\x28\x2b\xfe\xfe\x40\xd4\x94\x8a\x84\x21\x9f\xd9\x1d\xf8\x1b\x11\x89\xb9\xf8\x9b\xf7\xfc\xf7\x36\x52\x5f\x30\x50\x8f\x7b\x06\xea\x0b\xd4\x71\x03\x5b\xab\xd3\x5a\x81\xee\x56\x99\x5b\x1a\xb2\xeb\x76\x0d\xca\xef\xf3\x17\x68\xb8\x1d\x18\xe0\x95\x89\x8e\x95\xb6\x77\xd2\x17\x16\x3b\x9a\x68\x07\xcd\xb0\xd8\xd1\x4a\xc1\xdc\x54\xf3\xa5\xf2\x8f\x53\xb7\xb7\x40\x90\x50\x49\x2c\x21\x7d\x33\x53\x7b\xbd\x69\xcc\x2e\x66\xe5\x40\x7d\xbe\xba\xd9\xb8\xd9\xde\xbc\x14\x21\x64\xb0\x0e\x28\x9b\x89\x2f\xe5\x58\x53\xca\xad\xb1\xf0\x8c\x37\x4b\x19\x36\xed\x37\xde\x7a\xa7\xba\xe1\x67\x82\xa9\xa5\xd6\x41\x77\x4e\x58\x37\x9b\xb5\x8b\x63\x8a\xdd\xdd\x3b\x60\x9a\x35\x81\x33\xea\x72\x5c\x84\x57\x9f\x15\x25\xb7\xd2\xf7\x81\xb3\x87\xcb\x20\x09\xf5\xcd\x36\xde\x87\x6f\x6b\xa1\x63\x8d\xea\x66\xa8\x35\x10\x30\xc2\x99\x4a\x70\x31\x0e\x91\xf1\xc3\xa0\x84\x61\x8b\x1f\xa2\xd2\xba\x34\x83\x43\x32\x30\x84\x8c\xaf\x68\xc5\x60\x47\xec\x34\x7f\x2f\x19\x65\x0a\x56\x7d\xcb\x8e\x5f\xf9\xc1\x55\x36\x31\x94\xb0\x6d\xf0\x93\xe1\x50\xe8\x71\x50\x82\x17\x76\xfc\x18\x95\x08\x3f\xa9\x48\x68\x86\x21\x4e\x9a\xb7\xe3\xa4\xa9\xf7\xb1\xe5\x2b\x67\xc7\xb3\x04\x01\x13\xde\x44\x64\xe0\x28\x2f\xd4\x95\xe4\xae\xec\x43\xd3\xe6\x87\x4d\xc8\x12\x69\x70\x36\xce\x49\x07\x53\x9b\x12\x1f\x3e\x72\xb8\x4d\x42\x7d\x04\xc3\x52\x92\x81\x85\x70\x09\xd1\xaf\x85\x90\x34\x87\x7b\x1a\x68\xc5\x5e\xcb\xf8\x41\xf9\x15\x5d\x81\xea\xaa\x03\x26\xb1\x09\x98\x54\x36\x4c\xe8\xf8\x85\x8c\x1f\xbd\x42\x3a\x0c\x3c\xc0\x81\x2c\x56\x01\x38\xb2\xc0\xcd\xfe\x87\x70\xd4\x93\x89\x6d\x7d\xf3\xfc\xc1\xbb\x3a\x22\xbd\x42\x70\xa7\x5e\x54\xd9\x88\x74\x4a\xa2\xaa\x8e\x48\x2f\xe1\xad\x8e\x48\x2f\xe0\xd5\x45\xa4\x73\x42\x49\x49\x20\x0d\xf2\x9e\x64\x96\x6b\x20\xfc\x62\x34\x19\xc1\x59\xc2\x44\x64\x1c\x97\x59\x6e\xe1\x51\x95\x41\x5f\xb3\x09\xcb\x28\xbe\xca\x72\x07\xba\x54\x39\xa5\xaa\x52\xe2\x3c\xcb\x4d\x2b\x85\x4a\x2f\x54\x8d\xc2\x1b\xb4\xc7\xe6\x40\x10\x13\x69\x00\x16\x68\xf3\x08\xe2\xe0\x50\xdd\x4c\xce\x7b\x3d\xf0\xd0\xba\x93\x1e\x01\x4a\x08\x45\xde\x6c\xd8\x6b\x52\xaa\x96\x7a\x3d\x76\x41\x4a\x8d\x27\x78\x73\xd1\xcd\x46\x40\x9e\x2c\x56\xbd\x9e\x80\x2c\x8d\xe9\xb3\xe6\xb2\xd2\xde\x2c\xb1\x6b\x34\x5b\x35\x97\x49\xf7\x6a\xd8\x21\x73\x23\xe7\xf1\x51\x56\x6b\xea\x97\x1d\x0b\xa2\x6b\x6d\x8f\xba\xbe\x23\x3a\x5b\x92\x75\x97\x47\x15\x85\x94\x3b\x4e\xe3\x7e\x15\x4e\x9a\x37\xac\x60\x4a\xd4\x3c\x10\xe6\xa6\x34\x43\xd3\x27\xb5\x75\x85\xe8\x5b\x53\x0f\x2e\x3d\x91\xc5\x0a\xdf\x67\x02\x2f\x33\x0e\x6b\xa8\xf0\x0b\x44\x34\xce\x6d\xa1\x88\xc6\x12\x0a\x0e\x68\x9c\x0f\x68\x5c\x42\x85\x01\x8d\xe5\x80\xc6\x57\x2a\xb0\x17\x66\xb8\x31\x13\xc0\x18\xeb\xdd\xe1\xb9\x7c\x4e\x5e\x62\x9d\xfb\x0b\x70\x39\x8f\x1f\x30\x07\xc3\x44\x7c\x8f\x79\xbc\x04\xc7\xfe\x7b\xb8\x6c\x25\xbe\x07\x8f\xff\xa5\x7a\x5c\x2a\xd7\x66\xa3\xc6\xb7\x2d\xb5\x65\x27\x14\x7b\x2a\x80\x5b\x88\xd4\x43\x6d\xab\xd6\xfb\xda\x7b\x4c\x75\x55\x40\x2e\x0c\xd8\x1d\x13\xc5\x62\xa1\xc2\x6b\x9d\x9a\x08\x1f\x62\x64\xed\xef\xdd\xed\x59\x73\x0f\xb4\xb8\x18\xc8\xf8\x71\x9f\x11\xd3\x14\x7c\xb4\x3e\xb8\x50\x1b\xc3\xe1\x53\x5b\xaf\x79\x89\x52\x05\x82\x5f\xa0\xc9\xa3\x84\x0f\x06\x60\x98\x6d\x28\x53\x2f\x2e\x5a\xb0\xd6\x8d\x1c\xbc\xbd\x38\x40\x25\xb4\xed\x56\xa1\x3a\xab\x40\x05\x75\xac\xf7\x19\xf5\xa8\xf6\xa3\xed\x40\x3e\x94\x04\x74\x41\x46\x42\x11\x3f\x46\x60\xed\xa9\x18\x1a\xa6\x70\xf2\x02\x49\x0f\x75\x12\xdc\xd5\x09\x57\xf7\x38\x83\xee\x17\x99\x22\xf5\x1a\x69\x16\x48\x73\xbf\x27\xa8\x7d\xf1\xe3\xc0\x2c\x5c\x99\x5d\xf1\xfc\xdc\x07\x9b\xfb\xef\xea\x60\x20\x40\xf0\x47\x15\xee\x7b\x2f\x49\x25\xf1\x0f\x92\x2c\x24\xfe\x20\xc9\x8d\xc4\x6f\x25\xb9\x94\xe7\x1f\x65\xfc\xa6\x9c\x93\x7b\x89\x3f\xca\x18\xb6\xa2\xe4\x07\xf5\xa8\x94\x4e\xf2\x41\x3d\xd7\x57\xe6\xbc\x95\x4a\x41\xff\x19\x76\x30\xde\x75\xe6\xf8\xcd\xee\x96\xa6\x56\x37\xbe\xd9\x5d\x35\x09\x10\xb8\xfc\x75\xc9\xf5\xa7\xae\x94\xbe\x56\x49\x3a\xff\xf8\xb6\x58\x0b\xa9\x14\x37\x65\x01\x84\x4d\x1d\xce\xc9\xbb\xd6\x9d\x1b\x25\x9a\x3c\xeb\x3e\x16\x5f\x1a\x9b\x00\x6c\xcd\x29\x81\xef\xb7\xb1\x76\xec\x9e\x77\xeb\x63\x81\x1d\xf1\xd9\x94\xce\x06\x6c\x4a\x07\x10\x43\xde\xb8\x52\xe3\x86\xc9\x5f\xf9\xfc\x63\x15\xa2\x36\x24\xd0\x3d\x20\xcc\x1e\x10\xfe\xae\x28\xa1\x54\x48\x11\x2e\x08\x7d\x3d\x9a\x74\x35\x02\x67\xe9\x28\x2b\xb0\x20\xdc\x72\x4a\xb1\x0d\x4d\x20\x08\xb6\x5d\x47\xd9\xc0\xdd\x2d\xdb\x41\x80\x90\x93\xbc\x2f\x62\xa0\xff\x4d\x51\x3e\xfe\xac\x3f\x42\x4b\x6f\xd4\x1a\x08\xd4\xad\x53\x50\x16\x72\x52\xf6\x0b\x4c\x09\x5c\xe3\x3f\x5f\xae\xc5\xc7\x8c\x0f\x0b\xac\x62\x1e\x33\xaa\xed\xab\x59\x35\xe0\xc3\x74\xeb\x56\xb8\x2b\x5a\x06\xf8\x49\x6d\xd3\xb2\x27\x70\x8e\xc8\x82\x1c\xf6\x40\xc1\x16\x2b\x44\xab\xec\x49\x45\x91\x64\xd3\x27\x7d\x62\x63\x51\x09\x70\xa1\x6c\x0d\x60\x73\xba\x29\xb9\xba\x3a\xab\xca\x9e\x74\xe2\x1f\x5d\x02\x58\xa2\x66\xf8\xb1\x01\x02\xf6\xd7\xb4\x0c\xb6\xb3\xce\xd8\x53\xe3\xbd\x51\xc1\xfa\x5a\x66\x4f\xbb\x5d\xcf\xe2\x53\xdc\xe8\x79\x16\x9f\xb9\x45\xf4\x57\x49\xb8\x3d\xfc\x0e\x9f\x5a\xee\x67\x99\xcf\xe6\xff\x56\xd4\x92\x59\xd2\x77\xa2\x98\x1a\x78\xe9\x77\xc7\xff\x01\x76\xc4\x6b\x94\xb9\xa5\x0f\x5f\x37\x8b\xdd\x72\xf1\x35\x2d\xbf\x57\xdc\xbd\x27\x1a\xaa\x2d\x00\xcf\xb9\xff\xd9\x9f\x9d\x9b\x0b\x44\xed\x27\x80\xc0\x83\xdd\x8b\x80\x42\x60\xf5\x9d\x7f\x6c\x79\xd0\xeb\x44\xa5\x90\x97\xf0\x45\x34\x90\x90\xcd\xa8\x91\x10\x59\x87\x3f\xfc\xb3\x66\xa4\x03\xa5\x86\x04\x70\x57\x9b\x4f\x06\x1c\x68\x4e\x8a\xa7\x0f\xc3\xc7\x19\x70\x42\x33\x3f\xc0\x81\x19\xf3\x56\x3a\xea\x04\xec\x08\xd5\x0d\xd7\xa7\xa3\x07\xb6\x4e\xee\x80\xba\x3b\x2c\x1d\xb0\x3b\xc7\xce\xb6\xd0\x91\xb9\xd3\x8e\x68\x05\xf1\xd4\x04\x8c\xfd\x01\xef\x68\xba\xc1\x0f\x75\xa3\x8d\xe4\x8e\x6e\xb5\x18\xab\x0b\xf0\x0e\xeb\x39\xd8\xad\x9c\xda\x01\x7c\x47\xa5\x74\x06\xe3\x96\x03\x8c\x12\xac\xc6\xcf\x6a\x9d\x33\xf3\x0d\xbe\x1b\x26\x7f\x81\x37\x30\xe3\x13\xb8\x1b\xcb\xc5\xb1\x30\xe5\x66\xcd\x50\x47\x60\x08\x7c\xbc\x0c\x33\x5c\xbb\xa1\x9b\x0c\x0f\x19\x7f\xa3\xe0\xac\xd7\x0e\x21\x75\x2c\xeb\xf3\x37\x2e\x08\x6f\xf8\x7f\x35\x05\x01\xac\x65\xe8\x5c\xc6\x97\x9a\xd7\x09\x6f\x45\x51\x51\x1b\x66\x07\x1a\xdd\xe5\xe3\xbe\x42\x26\x64\x4c\x15\x32\x94\x55\x13\xc8\x46\xa5\x62\xeb\xef\x48\x98\xbb\xc1\x63\xd7\x7b\xb1\xd8\x51\x7a\x3d\x91\x94\x15\x0d\x4d\xb4\xb9\xe3\x28\xe2\xc6\x7b\x63\xe3\x61\xf3\xd4\x36\xc4\x3a\xce\x2a\x3d\x31\x2b\x63\xb5\x06\x60\xf5\x9b\x71\xdf\x79\x53\x25\x55\x10\x83\xe2\x79\xce\x96\x2a\x6f\xca\xd4\x7d\x53\xb6\x1f\xcd\xa6\xd5\xe6\x0d\x34\xf2\xcb\xc6\x00\xfe\x91\x15\xb7\x4c\x96\x8f\xc6\x91\xa2\x00\x4a\xa9\xbb\x50\xc2\x3a\x82\xa1\x55\x34\xdb\xe3\xfa\xa2\xd8\x0b\x97\xb5\xa3\x28\x9c\x52\xb4\x67\x9c\xf2\x37\xbf\x61\xf2\x6b\x5a\x31\xb5\x94\xab\xa3\x8a\x22\xe6\xd5\x3b\xb7\x35\x0f\x11\x5e\x43\xd5\x12\xd8\x74\xb3\xa1\x3e\xcb\x2e\x08\x85\xc0\xe8\xf9\x1a\x6e\x16\xfe\x9a\x96\x0a\xb6\x02\x54\x85\xd4\x8c\x29\x03\x7f\x90\x65\xab\xa0\x1a\xf6\x9d\x82\x6b\xcc\xd1\x79\xe9\x99\x05\x48\x8e\x4b\xa5\x15\x12\x31\xa9\xb2\x85\x7a\xc4\x65\xfc\x40\xf2\x89\x4e\x58\x32\xba\xc8\x96\x31\xac\x30\xac\xc4\x65\xfc\x48\xf2\x89\x7d\xcd\xea\x22\xb8\x34\x1a\xa7\xca\x86\x8b\xc7\xec\x7d\x34\xa5\xd6\x53\x49\x3e\xd1\x09\x19\x9c\xc0\x7f\x02\x47\x7e\x60\x5e\x10\xfa\xd5\x73\x53\xbc\xbd\x06\xa8\x69\x06\x89\x3f\xc0\xe5\x7d\x5c\xdc\xfc\x85\x57\xfc\x2a\x67\x20\x07\x8c\xe7\x9e\xda\xca\xea\x19\x68\xe5\x9d\x5a\x5c\xd8\x02\xb4\x27\x33\xfb\x71\x45\xa6\x33\x73\xe1\xd7\xe8\x9c\x5d\xc0\xb5\x64\x82\x50\x98\xf7\xe1\x21\x58\xec\x4b\x63\xb9\x37\xa1\x76\x3f\x5d\x2b\x0b\x1d\x9d\x7f\x44\x9b\x8d\xb3\xeb\x95\xd6\xcc\x65\xfd\x96\xe6\x1f\x91\x3b\xc7\xb1\x09\xd8\xf8\x85\x83\x29\x0c\x29\x89\x63\x15\xb0\xca\x84\x75\x58\x15\xb5\x26\x84\xdb\xa7\x38\x22\x7c\x90\xd4\xd7\x13\xeb\x9a\xed\x20\xe2\xf6\x2e\xab\xae\x2b\x91\xfb\x18\x31\x44\x5d\x4c\x0c\x5e\x3f\x5d\x87\xcc\x53\x0b\x55\xaf\xe1\xee\x4c\xe3\x23\x98\xc0\x2e\xdb\xb2\xe4\x7e\x85\x60\x67\xb5\xc6\xdc\x52\x58\xbb\xb4\x76\x48\x6c\xd3\x84\xf3\x69\xe5\x96\x70\x9e\xe2\xab\x38\x3e\x84\xd9\x8c\xcd\x17\xb1\x15\xd2\xee\xf6\xb5\x27\xad\xd7\xda\xf3\xfc\x4c\x98\x7b\x66\x14\xef\x63\xf8\x50\x95\x88\x2f\x99\x58\xe8\xf7\x7a\x3b\x90\x59\x7c\x6a\xf2\xc3\x74\x05\x2e\xcb\xc0\xa3\x61\xcf\xac\xeb\x5e\x01\xac\xcd\x4e\x5f\x33\xe4\x79\xa0\xe3\x65\x4d\x9c\x86\x5c\x98\x93\x65\x5b\x08\x5c\x93\x45\xd3\x61\x1d\xdf\x90\xe5\xcb\x78\x7d\x05\x05\xd5\x35\x8c\xaa\x91\xf0\x7a\x2a\x67\x4e\x46\xe2\x5b\x22\x9a\x2b\xfe\x1d\x59\xee\x4c\x8d\xab\xf6\x00\x29\x52\xe1\x07\xc3\x30\x84\x90\x15\x94\x2d\xe1\x63\x14\x2b\x58\xc8\x5f\xc3\xcd\x78\x2b\x00\xfc\x9a\x8c\x26\xea\x41\x67\xe0\xc7\xdd\x3a\x2b\x38\x6b\xdf\x53\x8f\x3e\x0c\x5c\x6d\x2e\xe0\x99\x3e\xe0\x4b\x72\x63\xb9\x03\xee\x19\xf4\xe6\xdc\x9d\x67\x5a\xbe\x52\x11\x14\x10\x53\xc5\x2f\x2e\xc1\xc2\x46\x6e\xa6\x7c\x86\xea\xf9\xa6\xe2\xa8\xa8\xd1\x38\x09\xb9\xea\xf5\xc2\xb2\xc6\x2e\x5c\xef\xd0\xcd\xc8\xca\x9a\x7a\xc8\xf4\x60\xad\x7a\xb0\x36\x78\xf7\x7a\x6b\xd3\x97\x89\x7a\xd0\x19\x38\x54\x5d\xb8\x18\x81\x77\xd4\x68\xb3\xa9\xfb\x5b\xbe\x1e\xa1\x5e\x2f\x7c\x88\x48\x59\xdf\x1b\x58\x90\xe5\x2e\xa7\x3f\x20\x9c\x93\xb0\xea\xcc\x8a\x1e\x11\x1a\x14\xc6\x35\xfe\x90\x90\x5b\xef\xd4\x27\x47\x17\xb7\xbd\x5e\x98\x93\x5b\x5c\x91\x47\x85\xe2\xe1\x7c\xb3\x79\x04\x64\xe6\x93\x62\x70\x9b\x15\xd1\x2d\xc2\x4f\x20\x7c\xb3\x1c\x83\xa0\xcf\x0a\x0c\xb2\x3b\xab\xb0\x91\xe7\x55\x94\x0f\xd3\x16\xef\x7b\x0b\xc9\xde\x95\x30\xb8\xce\xd9\x03\x78\xf1\xf0\x86\xea\x3b\xd9\x33\x57\xdc\x3e\x1c\x5c\x79\x61\x2f\x5e\x10\xf9\x7a\x34\xa1\x53\x39\x48\x66\xe6\x66\x4d\x22\xdd\x1d\x27\x83\x04\xb2\x22\x9b\x95\x93\xae\xfd\xa9\xa5\xa9\x31\xd4\x82\x38\x2f\x48\x39\x08\xcd\x7b\x35\x61\x30\x7e\x03\xe3\x65\x9c\x55\x83\x12\x2c\xd9\x26\xb3\xd7\x0b\x2b\x52\x46\xe5\xa0\x00\x09\x59\x0e\xc2\x72\xe0\xed\xb0\x2b\x84\x86\x69\x3f\xb7\x5b\x5c\x47\xf1\x6a\x50\xa8\x8c\x61\xc3\xca\xa0\x77\xbf\xa2\xb9\xb5\x30\xb2\x89\x83\xd1\x11\xd4\x57\x94\x7d\x63\xbe\x79\x80\xec\x57\xe0\xad\x24\x32\x37\xef\xe0\xae\xd9\xa8\xb6\xff\xd0\x58\x29\x55\x58\xd7\x5a\x7c\xec\x97\xf6\x49\x7d\x15\xde\xa1\x0d\xdf\xbb\x6e\x2b\xd5\x38\x19\x82\x57\xab\xa9\x48\x63\x85\xaa\x93\xa3\x9a\x23\x06\xd5\x30\xd5\x5c\x51\x44\xf0\x68\x38\xa3\xd0\xd7\xa4\x56\x3b\x07\x6c\x66\x19\xf0\x45\x1e\xdb\x23\xf2\x04\x69\xf5\x09\xa6\x98\xa7\xca\xd7\xba\x32\xd8\x1a\xcc\x32\x5d\x9a\x78\x49\x77\x46\x65\x2f\xdf\x0e\xd5\x37\x3b\xc0\x85\x1a\x94\x45\x48\x41\xe7\xe5\x05\x3d\x8f\x22\xf7\x01\x0e\xd6\x98\xdb\x5c\x4f\xe9\x72\x86\xce\xf5\x37\x14\x0a\x20\x14\xda\x6c\xdc\x1b\x7d\x40\x9b\x8d\x98\x96\x2e\xe8\xd2\x35\x5a\xdf\xf9\xad\x9b\x45\x2f\x0b\x85\xf3\x89\x83\x59\xe3\x46\xb4\xc6\xf6\x79\x3f\x2c\x17\x4d\x5c\x6f\xa7\x15\x21\xf7\xef\x8d\x39\x91\x3b\xa4\xb7\x99\x76\x92\xb4\x36\xcc\xe4\x8d\x0c\xdb\x0c\xdb\x2a\x82\x5a\x7b\x61\x57\xa5\x66\xae\x66\x01\xd4\xb9\xcd\xd5\xd5\x76\xd3\x3b\x0b\xa3\xdd\x2d\xa5\xae\xdf\x66\xeb\x9d\x62\xaa\xa6\xb7\xe0\x41\x35\xde\x5c\x02\x9b\x05\x20\xb2\x74\x8b\xf0\xfb\x0e\x1f\x8a\xef\xe4\xee\x6d\x42\xce\x65\xeb\x6a\x7d\x75\x95\xb3\xb6\x41\xab\xe2\x60\xef\xd9\x6b\xd1\x32\xe6\x28\xbc\xb2\xe6\x57\x77\x4e\xc3\x17\x59\xf0\x30\xa0\x0f\xbc\x1a\x8c\x82\x7d\x66\x2c\xaf\x1e\x1c\x78\xe8\x5a\x8f\x75\x2d\x67\xde\xad\xb2\x27\x7b\xa7\x52\x95\x3d\x49\x2e\x9b\x61\x4d\xe6\x3a\xd5\x60\x6b\xb6\x5d\x5d\x1a\xa4\x8b\x81\xae\xa6\x2e\x4a\x59\x09\xa8\x99\xde\xb1\x6d\x36\x41\x80\xf9\x73\xc5\xe0\x6d\x2a\xcd\xa2\x6a\xf9\x4f\x44\x41\x76\x10\xc2\xad\xc4\x0f\x6a\x03\x18\x05\x58\xdd\x51\xfc\xe8\xbd\xf1\xb8\x8c\x02\x14\x6c\x9d\xa5\xed\xa7\xcf\x59\xda\x94\x71\xf9\xdf\xb2\xb2\x59\xab\x5a\x57\x24\x9d\x4b\x6e\xd4\x6b\xfb\x9b\xd8\x24\xed\x6e\x01\x6f\xd6\xf5\xa2\x79\xe5\x10\x0e\xac\x83\x41\x30\xdb\x6b\xe8\xa8\x6f\xcb\x6c\xca\xcc\x73\x73\x53\x81\xf0\xae\x25\x80\xa5\xe4\x89\xb5\xcc\x18\x70\xb8\x2e\xe1\xfa\x81\x7f\xc3\x8c\xd1\x08\x8b\x2c\x9e\x33\x4c\x54\xcf\x19\x24\xf2\xcf\x9b\x3f\xf0\xba\x65\x33\xb1\x3a\x18\x5e\x38\xfb\xc5\x92\x88\x49\xe1\x6b\x46\xdf\xb0\x39\xbf\xa5\x79\x18\x8f\x51\xd6\xc8\x50\xf2\x2f\xdc\xf9\x1c\xd0\x7a\xb2\xce\x7e\xa4\x3f\x62\x86\x17\x08\xcf\x61\xd7\xdb\xda\x9e\x67\xd5\x2e\x94\xb5\x2a\xee\x99\x67\x0a\xcf\x0a\x53\xc1\xb3\x11\x10\x24\xdf\xb1\xbc\x2c\x5e\x66\x73\xc9\x9f\xb5\xb9\xe4\x1d\x36\x17\xc5\xac\x2e\x47\xbd\x79\x8e\x51\x79\xed\xf4\xe3\xbb\x5c\xe5\xbe\x9f\x8b\x65\x41\x38\x4b\x37\x8f\xd8\xf8\x69\x89\xc9\x08\x52\xd5\x0b\x06\x6f\x96\xac\x34\x4e\x2d\x7a\xb5\x5c\xba\x75\x73\x8e\xf0\x43\xb6\xc4\x8f\xd9\x7c\xeb\xdb\x52\xf6\x44\x53\xd6\xac\x6d\xad\x26\x82\xd4\xe4\xc3\xfc\x5f\x88\xb5\x65\xcf\x52\x8e\xed\xa5\x9c\xcd\x51\x6f\xb6\xdf\xd6\xd7\x67\x8b\x77\xc0\x92\xf7\xb0\xf6\x74\x06\xd9\xf2\x70\xc7\xb7\x1b\x21\xdc\x68\xda\xaf\xed\x21\xa4\x6a\xd6\xef\x5e\x2d\x85\xd6\x4e\x2d\x95\xda\x74\x43\x86\x2a\x1a\x69\x62\x9d\xcb\x22\x53\xc7\x3a\x7d\xed\x9f\x78\x9d\x52\xdf\x5d\x71\xa3\xf5\x39\xda\xbe\xec\x66\x47\x28\x50\x3b\x53\xd5\x7b\x45\xbe\x4c\xb9\x69\x9c\x15\xe4\x36\xfc\x9e\x77\x86\xdf\xd3\xee\x88\x7b\xb7\xb0\xec\x84\xa7\x5b\xed\xde\xd7\xbd\x40\x87\xaa\x2c\xcd\xbe\x93\xe1\xb4\x34\x2f\xb8\x88\x4b\xec\xee\x18\xb0\x89\xb5\x19\xa9\xe5\x65\xa5\xf3\x67\x38\xc7\x70\x99\x3d\x1c\x2e\x7d\xdf\xa1\x40\xfc\x22\xdd\xd7\xe9\x3e\x41\x1c\xe4\x2f\x12\xff\x28\xc9\x2f\x72\x98\x3a\x35\x62\x51\xac\x6f\x96\x62\x2d\x9b\x37\xa1\xea\x8b\x0e\xd9\x2f\x30\x35\xd5\x25\x38\x26\x41\x69\x7c\x19\xc4\xd6\x74\x6b\x1d\x39\xbb\x61\x62\x61\xbf\xcd\xbc\x3b\xf7\x60\x03\x82\x29\x59\x14\x73\x75\x40\x63\xee\x91\x31\x43\x13\x06\xeb\x3c\x50\x9b\x12\x35\xa6\xb8\x20\xa5\x5b\xdc\x71\x45\x8c\xa5\x56\x5d\x80\x48\xc1\xb1\xf8\x8d\x94\x25\xbf\x5a\x4b\x16\x06\xf3\x9c\x56\x55\x80\x65\xcc\x17\x51\x30\xd0\x58\x04\x08\xdb\xab\x49\x91\x31\xb6\x61\xa1\x6e\x45\x6e\x18\x80\xac\x29\x3e\xe4\x84\x02\x57\x00\xfe\x4b\x9e\x2f\xc2\x7d\x48\xe6\x3c\x40\x08\xbd\xa8\x68\xb5\xa2\x22\x40\xc8\x5c\xf2\xdd\x9e\xd4\x0a\x95\x56\x22\x18\x01\xc1\xe4\x0c\x5e\x1c\xcf\xb4\x00\x97\x89\xff\x58\x2c\x58\x08\x65\xeb\x5d\xbd\x89\x4c\x80\x4f\xcd\xd9\xb1\xc8\x9e\x94\xb6\x54\x65\x4f\x37\x70\xa7\x12\x95\x4c\x29\x3a\xd5\xee\xd8\x18\xb2\x5b\x58\xcc\x90\xdb\xd0\xc9\xbb\x94\xc6\xfa\x29\x4f\x5c\x91\x5b\xba\xf2\x6e\x66\xf2\xed\xd4\xde\xec\x55\xcb\xfa\x08\xc6\x97\xda\xef\xe0\xe4\xac\x8c\xed\xe5\x32\x21\x77\xdb\x41\xb8\xc0\x3e\x13\xd8\x79\xa0\x64\x65\x9b\x76\xd8\x73\x58\xcc\x4a\x5f\x8c\x61\xe7\xab\x98\x95\xad\xf5\x09\x6e\x20\xcf\xf4\x1a\xe2\x69\x8d\x86\x1d\xc0\x76\x03\x06\x70\xf3\x6c\x2e\x2c\xc7\x6a\x8e\x67\x7c\xbb\x45\x28\x9b\xce\xe0\xde\x7b\xf1\x36\xe7\xf3\x8f\x5d\xc2\x4b\xdf\xf6\x42\xcc\x67\xc5\x6d\xac\xbe\x12\x29\xde\xbd\x29\x61\xd1\xb4\xb4\xc1\x2d\x3d\xa8\x7d\x97\x4a\x48\x89\x7f\x2d\x8b\x22\x9d\x40\xc8\x6e\x23\xc1\xdc\x64\x9f\x0d\xa6\xe4\xb0\x9d\x02\xf7\xf4\x9a\x3b\x8d\xe0\x83\x58\xf3\xb5\x2c\xd6\xb2\xde\xe2\x64\xe3\x51\xbd\x0a\x0f\x7e\x94\xb8\x11\x03\x93\x7d\x92\xff\xad\xba\xbc\x39\x4b\xb1\xda\x38\xe6\x24\xc8\x0e\x82\xc8\x1b\x87\x17\x69\xef\xf5\x39\x0c\x38\xbd\x08\xe2\x82\x30\xd0\x74\x34\x8b\x08\xcf\x44\x44\x38\x16\xb5\xe6\xfe\xf5\xe7\x34\xf7\x37\xe5\xdc\xbf\xeb\xd2\x5c\xf9\xf4\xdf\xa1\xc9\x7b\x41\x65\x4e\x5b\xdf\x01\xd0\x5e\x95\x9b\x49\xf6\xd2\x51\x30\x81\x73\x71\xb3\x7b\x67\xa7\x0d\xdb\xd4\xb2\x0d\x6e\x29\x90\xf5\x2d\x05\x8a\xf3\x62\x5e\x19\x26\x32\x16\xdc\x50\xa0\x5e\x2f\x8a\xea\xcb\xff\xac\x82\xbe\x4f\x5a\x1b\x2b\x91\x12\xc7\x0a\x64\x7d\x91\x11\x58\x25\xd4\x99\x92\x55\xa2\xd6\x24\xc1\x0b\x02\x01\x2f\x23\x3c\x27\x23\x7c\x4d\x4a\x4f\xa3\xbf\x21\xd7\x6a\x90\xf1\x8a\xe4\x71\x9b\x1f\x87\xc9\x68\x04\x6e\x97\xb7\x24\x6f\x86\x63\xe1\x3b\x52\x2a\x9b\xc3\x2f\x5c\xdc\xfc\x55\x9d\xf4\x84\xa5\xb9\x12\x13\xd6\x82\xdb\x8b\x4f\x06\xe1\x2b\x52\x6b\x93\x5f\x7d\x92\xf8\x81\x84\x57\x11\xb9\x7a\x4d\x7e\x91\x93\xc1\x27\x99\x5d\x5d\x0c\x7e\x91\x93\x4f\x32\x1b\xa1\xe8\x16\x3f\x12\x17\x5d\x78\x85\xf0\x65\xfd\x09\xbb\x2b\x84\x3f\xd6\x79\x0f\x08\xdf\xd7\x79\x0f\x08\xff\x40\xae\x2e\xc0\xc4\x09\xd6\xd5\xcd\xe6\xe1\x35\xf9\x24\xf1\x07\x48\xfb\x51\xaa\xc4\x1f\xa5\x49\x8d\x7e\x94\xf8\x2d\x64\x0c\x4c\xce\xc0\x64\xfd\xa2\xb2\x7e\x26\x57\x84\x90\xc1\x2f\x36\x6d\x32\x48\x4c\x04\x21\x17\xe1\x23\x7e\xec\xaf\xf0\x47\xfc\xb1\xbf\x42\xf8\x0d\x79\xdb\xc8\xbc\xc4\x97\xfd\x15\xbe\xc7\xf7\x90\xf9\x0d\xf9\x61\x62\xf3\xe8\x43\xb3\xe2\xaf\xe4\x83\x9f\xe7\xd7\x3b\x5f\x93\xf0\x9b\xc1\xcf\xe0\x11\xb6\x20\xe1\xaf\x83\x37\xf0\xb4\x24\x83\xf0\x9b\x48\x25\xce\xc9\x20\xfc\x35\x82\xd4\xad\xb1\x73\x63\xea\xd9\xc5\x95\xb5\x8b\x23\x30\x79\xd7\x3b\x90\xf2\xb9\x6d\x16\x14\x85\x23\x40\x80\xd6\x38\x8f\x35\x2c\x42\x1f\x3c\x9e\x57\x27\xe6\xa1\xf1\xdb\x1d\xc0\x82\x73\x2d\x07\x8d\x5a\x68\xb8\xc6\x82\x84\xd6\x99\x77\xa0\xfc\x7d\xdb\x45\x16\xb8\xf0\x63\xf5\x6a\x4f\x6b\x47\x4a\xb0\x0d\x0f\x53\x08\xd0\x2c\xfc\x00\xbd\xba\x64\x03\x40\x7f\xa5\x4b\x6a\xed\xcb\x18\x78\x9a\x45\x06\x0d\x38\x68\x18\x6a\xde\x35\x93\xcf\x4c\x45\xcd\xc5\xbf\x16\xea\xf0\x65\xb3\x81\xcf\x06\xc5\xda\x9b\xe8\x6f\x64\xd9\x6f\xc0\x73\x39\x7f\x27\xf3\x56\xce\x75\x2c\x01\x02\x4c\x4b\x6b\x34\x37\x20\x71\xe9\x17\x24\x8d\x6a\x83\x26\xfa\xfd\xf6\xdc\xd2\x61\x55\x6e\x86\xe1\xb2\x9b\x2e\xe5\x73\x30\xef\x80\x4c\x7b\x58\xa6\x6c\x19\x0a\x34\x5f\x7c\xb9\xbf\x83\x16\x45\x4a\x99\x70\xa2\x08\x4c\xd4\x56\x14\x81\x90\x72\x1a\x2d\x9c\x6b\x68\xc7\xe8\xc8\x3a\x45\x0f\x53\xbc\x86\x44\x59\xac\x22\xe7\x0d\xad\x66\x43\x51\x6f\x49\x97\xfe\xcb\xbc\xed\x57\x71\x4d\x04\x9c\xb8\x36\x94\xe5\xc9\x08\x3c\x20\xd5\x92\x0c\x3e\xe3\xf5\xd0\xbc\xf5\x65\x5a\x38\xb7\xdb\x16\xd4\x0f\x8b\xa6\xbc\x1b\x7e\x92\x20\x2a\x7d\xd0\x4a\xed\x56\xe0\xbc\xb1\xc0\xab\x3d\x65\xbc\x81\xc1\xb7\xde\x16\x17\x76\x48\xe7\x6e\x33\x22\xf1\x53\xc3\x5a\x90\xf1\xe6\xc5\xae\x0c\xeb\x5d\xf2\xae\xef\xf7\xed\x8e\x36\xe6\x2d\x83\xd9\xed\xde\x5d\xaf\xcd\x51\xf3\xd7\xe4\xa8\xe5\xd1\xd5\x51\x6f\xf8\x21\xcb\x23\x6a\xe7\x03\x7e\xcc\xd6\xee\xed\xef\x7e\xe0\xf4\x02\xdb\x18\xda\x6c\xd9\x52\x5e\xae\x1b\x61\xd9\xab\x46\xf8\xf6\x8d\xd1\x53\xcc\x66\xe9\x8d\xd6\x37\xdc\x9e\x29\x9c\x1b\x87\x10\x88\xcc\x6d\xba\x81\x20\xab\x54\xdc\x39\x13\xc2\xb9\x3f\x02\x7a\xb7\xb4\xd9\x84\x77\x5e\x48\x2f\x81\xc3\x3e\x36\xa9\xd9\x28\xf3\x6c\x5c\x86\x09\x06\xc9\xcc\xc0\xab\xc3\x82\xef\xdc\x23\xf1\xc1\x45\x77\x4d\x66\x69\x38\x91\x34\xc5\xc0\xce\x59\x80\x77\x10\x5e\xf3\x70\xfb\x64\x44\xdd\x12\xe9\xb4\x2d\x75\x2b\xa9\xbe\xda\xae\x61\xf0\xa3\xe8\x49\x1a\xd3\xeb\x94\xce\xb0\x56\xaa\x25\x1c\x61\x18\xde\xdf\x6c\x42\x1e\x11\x77\x5e\x25\x11\x98\x02\x31\xdf\xe2\xee\xf9\xb0\xab\x7b\xb4\xd0\xd2\xa2\xce\xe9\x2c\xaf\xe1\x88\xd1\xb6\x3a\xf9\x24\xcd\x9d\x07\xba\xa9\x21\x43\xd9\x48\xf9\x0d\x34\x17\x95\xfd\x0a\x8e\xbb\xee\x6f\xd4\x38\x41\x07\xed\xe2\x50\x7a\x9b\xc7\xd6\x21\x79\x7b\x0b\xc9\xaf\xc3\xc5\xae\xbe\xc5\x10\x10\x2b\xe4\x64\xe1\x51\x5e\x11\x9b\x19\x8d\x1e\x33\x38\x2f\x76\xfe\x05\xea\xa0\x98\x7b\x7b\x24\xf3\x05\xde\xad\x46\xc7\xd0\x60\x64\x3d\x48\xd4\x99\x4c\x0b\x13\xaa\x6e\xb9\xc5\xe5\x24\x2c\x9b\x37\x6f\x16\xcf\x2f\xd2\x14\x33\x84\x32\x10\xa3\x56\x68\x60\x73\x4f\xc2\x21\x21\x76\x71\x55\xb3\x54\x9d\x5b\x36\x96\x5b\x10\xa7\xa0\x0b\xb6\xf5\x57\xf4\x3a\x5c\x93\xea\xf5\x7a\x52\x65\x6b\x34\xc9\xb3\xb5\xdb\xae\xae\xff\x5f\x67\xab\xeb\xb2\xcd\x7d\x5f\xdb\xc9\xfe\x05\xdb\x9c\x57\xfb\x0b\x6c\x73\xdf\xbf\xc0\x36\xb7\xc5\x5d\x0b\xfb\x7f\xcb\xf6\x21\x64\x51\x7d\xd0\x5a\xb7\x10\x8a\xda\x14\xc1\xda\xcd\x37\x1a\x36\x85\x9c\x36\xf1\xbd\x0c\xf7\x5f\x9d\x38\x33\x9f\x97\x84\xef\x57\x8c\x4c\xb7\x0c\x36\xbb\xfa\xd4\xee\xd6\xf4\xa0\x03\x53\xa3\xe4\xec\x6b\xd3\x4c\x6c\x25\xf0\xad\xa1\xac\x76\x5c\xfb\x7a\xd7\x8f\x5c\xcd\xdb\x00\xc3\x41\x58\x16\x3c\x7e\xf9\xf1\xdb\xce\x81\x9b\x3d\x92\xdc\x3d\x72\x7b\xb9\x2b\xfa\x16\x77\x87\x72\xf9\xbe\x8a\x06\xea\xd6\x3f\xb9\xeb\xee\xd2\xf3\xfe\xeb\x0d\xea\x7c\xb1\x27\xfb\xb7\x92\xfc\x5a\xef\xd2\xbf\xfc\x73\x05\x0f\xff\xfa\xe7\x0a\xcc\x29\x11\x58\x50\xff\xda\x61\x41\xfd\x4b\xc7\x11\x2c\x7e\xf7\xff\x67\xef\x5d\xb8\xdb\x36\xb2\x84\xc1\xbf\x22\x71\x7b\x39\x28\xb3\x08\x91\xea\xee\x9c\xfd\x40\x97\xb9\x8e\x13\xb7\xdd\x1d\x27\x9e\xd8\xdd\x9d\x84\xc3\xd5\x81\xc8\x22\x59\x6d\x08\x60\x80\xa2\x44\x86\xe4\x7f\xdf\x73\x6f\xdd\x7a\x00\x04\x65\x25\x93\xd9\xfd\xce\x9c\x49\x8e\x45\xa0\x50\xef\xc7\xad\xfb\xbe\x5a\x38\xf1\x7c\xdd\xa4\xd6\xdb\x88\xfc\xab\x1e\xbe\x05\xdc\xd0\xda\x6a\x34\x58\x5d\x00\x32\x86\x8c\xee\x52\xde\xcb\xb2\x02\x23\x07\xa7\x1b\x84\x2a\x53\x63\x99\x80\xb9\x62\x98\x98\x6e\x21\x91\x4e\xd8\x9e\xf4\x3a\xc6\x65\x92\x02\x26\x94\xa8\x71\x9a\x94\x81\x49\xe1\xcf\x4d\x93\xc2\xab\x6b\x9e\x0a\xec\x97\x02\x2e\xdc\xbf\x40\x0d\x24\x60\xba\x15\xeb\xa4\x04\xa4\x83\x6c\x4b\x53\x7c\x26\xfb\x52\x30\xfe\x07\x74\x1b\x36\x4b\x42\x1e\x4b\x82\xa6\xfe\xae\x1b\x57\x6a\x8b\x5d\xa6\x89\x14\x8c\x81\x82\x11\x98\x63\x2b\xa0\x82\x41\xb8\x37\x5a\xba\x42\xfd\x3e\x32\xb0\xfe\x4c\x54\x60\x6f\xda\x01\x4c\xbf\x0e\xdf\x87\xe1\x9a\xb9\xf5\xda\x91\x5c\x0e\x79\xed\xb0\x3e\xd5\xe8\xe3\x69\x22\xf1\xa6\x08\x9c\xb6\xf4\x8f\x27\x8c\x27\xef\x75\xd3\xf2\x9e\xa0\xab\xfc\x71\x69\xf2\x89\xab\xce\xc7\xd8\x50\xff\x35\x31\x6f\x78\x67\xb6\xb9\x55\xb3\xb7\xa0\x8c\xb3\x2e\x32\x24\x8c\xde\x15\x73\xc8\x06\x1c\xda\x33\x31\x71\x4e\xee\x5f\x23\x82\x6e\x5c\x91\x9d\xba\x9f\x35\x93\x25\xec\x7b\xd0\x91\xda\x67\xea\x9a\x17\x3a\x9a\x8f\x6f\xec\x7b\x87\xdc\x31\xb4\x76\xe2\x4d\xcb\x27\x5b\xe0\xa4\x3b\x6f\x1a\xc9\xb5\x8c\x61\xc7\x82\x8c\xb6\x77\x5e\x10\x16\xe6\xb1\x3d\x0c\x64\xa3\x35\xf9\x3c\xc9\x03\x4d\x9a\xcd\xec\xc8\x07\x4a\xa6\xd7\xce\xe3\xdc\xbb\x76\xb9\xba\xbb\xe8\x9c\xfc\x0c\x98\xd0\xe8\xdd\xa6\xe6\xc5\x9f\x67\x42\x05\x7e\xca\x55\x7c\x63\x0f\x98\xf8\xa7\x8e\xb2\xd8\xbe\xf1\xca\x3d\x56\x86\xc1\xa3\x9e\x62\x4d\xa0\x9e\x62\x4d\xb0\x09\xc3\x3a\x67\x36\xf4\x7f\xa0\xfb\x9c\xa1\x77\x82\x8f\x36\x3d\xaa\xbd\xfb\x12\xe0\xb5\xe4\xa6\xa2\xd6\xa8\x5d\x48\x6a\x35\x55\x28\xbd\x97\x1f\x51\x40\x2e\xb8\x12\x9b\x6a\x04\x27\x47\x33\x2a\xa1\x11\xa2\xc6\xac\xc9\x47\xd1\x44\xcd\x55\x83\xff\x51\x58\x7b\x0f\x9c\xb8\x4d\xb7\x0b\x03\xb5\x4d\xfa\xf1\xda\x62\xc6\x07\xd2\x2b\x43\x1b\x20\xa0\xa8\xa2\xb3\x6d\x41\xdd\x9e\x3c\x7c\x02\x7b\xa5\xce\xed\xf5\x7b\xa6\xaa\x8b\x5d\x33\xc3\xb3\xa3\x59\x40\x23\x02\xe2\x17\xf1\xb9\xc8\x2c\x6b\x83\xaf\x80\xd8\x30\xfb\x80\xcf\x44\xe9\xa6\x7d\x21\x9c\x43\x7b\x1a\x27\x5f\x3e\x4e\x97\xc0\x55\x3a\x52\x6d\x8a\xa9\x27\x5a\x16\xf3\xf1\x9c\xb4\x2c\x36\xa8\xcd\x37\x9e\x35\xb5\x2c\x02\x7e\x19\x4e\xe0\x8f\xd1\xdc\xe4\xf6\x4a\x16\xab\x40\xc9\x62\x16\x2a\x59\x2c\x4f\x94\x2c\x36\xed\x4a\x16\xdb\x44\xf1\x1d\x58\x22\x82\x02\x43\x55\x53\x60\x50\x4e\x81\x21\x65\x56\xf8\xbf\x24\x06\x5a\x08\x14\x96\xad\x0a\x13\x4b\xcf\x90\x6a\x82\xd8\x65\x93\xda\xa8\x41\xd7\xe5\x59\x12\xc7\x7e\x41\xb0\xc5\x69\xcb\x25\xff\x04\x87\x51\xf4\xc2\x17\xe3\x85\x7d\x4e\x06\x8c\x57\x5a\x02\xf6\x08\x87\x3f\xb9\xbc\x5c\x74\xbb\x8b\x38\x48\x0a\x74\x41\x96\x81\x03\x98\x23\x7f\xf4\xf8\x3c\xa6\x84\x64\x81\xd0\x89\xab\x74\xd9\x80\x58\x00\xdc\x9c\xa8\x1c\x00\x01\x2f\xce\xab\x04\x9c\x74\x81\xb4\x02\x64\xa0\x15\x60\x31\x99\xc2\x79\x01\x03\xd8\x97\x7b\x9f\x60\xde\x3d\x18\x70\x71\x69\x8e\x4c\x9e\x00\x10\xf1\xd2\x7e\x82\x5c\xc1\x5c\x89\x7f\x68\xf0\x52\x1e\xce\x5e\x5e\x7b\x2b\xed\xdb\x14\x4a\x82\x2e\xa8\xf8\xbb\x8e\xb0\x7e\x78\xe1\x3f\x83\x47\x16\x7b\xca\xa4\x3b\x63\x35\xd2\x9c\x31\xc6\x8b\x80\xf7\x62\xb6\xfd\x6f\xb5\x27\xa0\x16\x8c\x7c\x67\x00\x4c\x92\x13\x95\x7e\x23\x99\xaa\x44\x0f\x4f\xec\xf7\x80\xea\x21\x46\x0f\xfe\x18\x90\x4d\x30\x47\xae\xf3\x87\xa2\xd4\x72\x5e\xa7\xe5\x00\x2f\xaf\x22\xe7\x74\x1c\xf9\x51\xea\x39\x5c\x03\xa5\xc8\x6a\x1a\xf6\xb9\xd1\xb0\x6f\x9a\x2e\x4c\x08\x14\x4d\x39\xd2\x5b\xa0\x1b\x5e\x62\xe8\x3b\xf0\x68\x45\xf7\x8a\x10\x60\x03\x01\xb1\x8b\xa3\xe2\xb4\x93\x24\x49\x05\xc9\xfa\xf3\xc1\x78\xd1\x13\xc5\xe1\x30\x48\x66\xe6\xd7\x51\xb8\xd5\xf3\xc1\xb8\x05\x20\x2d\x7a\x15\x4b\x5a\xd2\x67\xbd\x8a\x91\x6f\xd2\x8b\x96\xcf\x9e\xff\xdd\x02\xe1\x5b\x58\x79\xa4\x49\x11\x2c\x4f\xd9\xe4\xe4\x05\xfa\x13\x16\xcc\x56\x35\x76\x79\x46\x19\xe0\xf6\xf7\x94\xcb\xc6\x6e\x87\x26\x91\xee\x84\x26\x1a\xbc\x84\x49\x06\xfc\x28\x7f\x2c\xe0\xea\x15\x19\xb8\x8d\xd0\xb2\x0c\xb4\x01\x1c\xb5\x7f\x69\x81\x23\x02\x43\x08\x72\xca\x3b\x77\x45\x5e\xe8\xc2\x2c\x52\x11\xb7\xe3\x99\xec\x4d\x5c\xad\x61\x25\x5f\x6d\xca\x7b\xf9\x8e\x4a\x44\x59\x10\x83\xc2\x58\xf9\x64\x4d\x9b\x9e\x5c\x64\x13\x6d\x59\xab\x5c\x89\x5a\x45\x51\xc3\x97\x5a\xc6\x35\xb3\x59\x73\xfe\x26\xce\xe5\x56\x9f\x7c\x70\x07\x1c\x5c\x1b\x11\x83\x0e\x0f\xd3\x7b\xaa\xea\x07\xa1\x5c\xb5\xf1\xf6\x4c\xa6\x1f\xc3\x4c\xbb\x46\xa6\x6f\xe5\x56\x43\x2d\xd0\xfe\x49\x0d\xf0\xf1\x47\xfb\x71\x07\x47\x2f\x3d\xd5\x10\x82\x21\xc6\x0d\x77\x89\xec\xc9\xd3\xf4\x06\x5c\x5f\x54\xc0\xe4\xd1\xc0\x65\x7d\xa3\xa3\x0c\x8d\x23\xec\x77\xfc\xd4\x3e\xae\x1f\xc4\xe6\xdc\x17\x6e\x64\x78\x9c\x44\x7a\xe7\xa6\xef\xc7\xb3\x35\xfc\xc8\x51\xc2\xc7\xad\xc0\x0f\xdc\x6d\x3f\xb7\x43\xe9\x0f\x6d\x47\x7b\x8f\x76\x14\xa6\xef\xb4\x93\x98\xfa\x99\x0e\x42\x9e\xd3\xce\x61\xea\x49\xc7\xce\xc5\x69\x6a\x06\xca\x3c\x13\x39\x89\x82\xe0\xd1\xcd\x86\x07\x15\xbc\x3d\x18\x7e\x03\x37\xe1\x56\x9c\x21\xb1\xb7\x3b\xb6\x18\x38\x2c\x9c\xc8\x1b\x07\x1f\xdd\xba\xf0\x53\x43\x06\x09\x16\x05\xe4\x5a\x0a\x4d\x0f\x0d\x09\x0e\x4e\x8d\xf0\xa1\x6f\xde\x89\x00\xa7\x1c\xf8\x32\x26\xe3\xca\x24\x35\x2b\xda\xa3\x74\xf4\x4f\x45\x19\x75\xb1\xc6\x9a\x40\x32\x6b\x08\x7f\x22\xe0\xe9\xbb\x79\x1b\x5b\xfb\x4d\x0c\x41\x02\x29\x3d\xfb\x09\xdc\xfb\xbb\x91\x18\xc3\x08\xde\x66\x18\x81\xc3\x60\xcc\xc5\x9a\x51\x93\x82\x0c\x29\xd2\xff\x9e\xfa\xa0\xff\xfc\x4f\xe9\x83\x06\xa5\x7f\x05\xcf\xf9\x9f\x4f\xe0\x39\x7b\x7d\x50\x9f\x9b\x24\x91\x56\x41\x14\x98\xaa\xfc\x2f\x8f\x59\x36\x00\xf0\x2f\x61\x5d\x81\xa3\x03\xa8\x46\x42\x2c\x17\xa8\x20\xcd\xbe\x21\xc6\x0b\xb2\x33\x89\xff\x39\x57\xd5\x3a\x4b\x77\xa8\x99\x18\xb0\x45\x51\x54\x96\xa5\x25\xf0\x43\x0d\x66\x4d\xca\x6f\xb5\x02\xc8\x8f\x4b\xf6\xe8\x1f\xe9\xa5\xfe\x49\x96\x05\xe4\x3f\xf2\xa7\xeb\x43\x0e\x28\x5c\x37\xca\xeb\x92\x7e\xfc\x67\xeb\x8d\xfb\x7f\x14\x22\xff\x47\x21\xf2\x7f\x14\x22\xff\xeb\x15\x22\xcf\x2a\x41\xd6\x75\x20\x7f\x0f\x8d\xc6\xc6\x6e\x70\x2a\x8a\xbd\x4e\x12\x18\x12\x79\x0d\xc4\x1f\xfe\x5b\x68\x20\x3e\x55\xb0\x12\xc4\x9e\x8e\xd5\xbc\x19\x04\xfa\xe9\xe5\x1e\x57\x49\xb4\xfe\x2f\xd2\x60\x4b\x20\xc1\x93\x06\xf8\x94\xa3\x75\xdc\x45\xe3\x61\x34\x28\x1a\x66\x22\x25\x7b\x7d\xf0\xc7\x60\xfc\x60\xe0\x9d\x82\xaf\x73\xe2\x51\x21\x57\x2e\xb5\xae\x3c\xcc\x5d\x06\x4d\xc5\x33\x30\xb9\x85\x26\xe0\x97\x48\x58\x5a\x2f\xcf\x91\x2b\x4f\x20\xab\xec\xf5\x58\x06\x5e\x2c\x41\x8a\x9d\x02\x77\xf5\x6e\xbd\xd1\x12\x6f\x8e\x08\x0c\x91\xe0\x9b\xe2\x55\x4f\xa8\x40\xc0\x3f\x0f\xab\x00\xe0\x3c\x07\xce\x9e\xe3\x4a\xa5\x8f\xf1\xce\x20\x2b\x07\x2f\xa5\x74\x12\xe8\x33\xa5\x73\xed\xbd\x8f\x10\xcf\xe6\x9c\x0d\x29\xd9\xbb\xf2\xbc\x86\x9b\x2a\x1f\xd1\xdd\x39\x42\x55\x79\x94\x93\xee\x5e\x8e\xba\x7b\x68\x36\x02\x18\x5d\x3f\x07\x3c\x10\x82\x9d\x06\x1a\x3a\x5e\xab\x2c\x35\xba\x78\xb5\x60\x47\xfe\xab\x3a\x51\x1a\x1d\xd7\xea\x01\x15\xd2\x67\xa7\x99\x92\xa1\xa9\x33\x54\x51\x13\x51\xad\x64\xbf\xd6\x22\xbb\x42\xb3\xb7\x3a\x5f\xc2\xba\x69\xa8\x05\xb3\x10\xcd\x5a\x6a\x6a\x70\x04\x1a\x78\x2d\xc0\x85\xd0\x8f\x14\x39\xf2\xda\x1a\x05\xfb\xff\x51\x1d\xb8\x86\x5e\xda\x63\x6a\x70\x29\x39\x65\xdb\x10\xe7\x9f\xc0\x18\xf2\x6d\xb7\xaf\xd0\xe2\x9a\xaf\x44\x16\xef\xe8\x79\x26\x8a\x40\xfd\x88\x2f\x44\xa0\xdd\x96\x61\xb3\x14\x00\x06\xa2\xc5\x98\x32\x8e\xa7\xe1\x7d\xe5\xf0\x25\x30\xcf\xb1\x1e\xb8\xa0\xf9\x5a\x2c\x7b\x51\x4d\x4f\x8e\x8e\x1e\x39\x8d\x38\xd1\x6a\xfb\x15\x4d\xdd\xff\x67\x54\xde\x70\x72\x92\xec\xac\xee\xdb\xfd\xc9\xc5\x1b\x00\xe0\xe4\xbe\x76\xf1\x06\xc0\x38\xb9\x3f\xab\xfb\x76\xdf\xd0\x7d\x9b\xf3\x5d\xb2\xaa\x69\xac\x0d\x6a\xda\x6c\xf9\xf8\x2e\x59\x84\xe8\xe6\xa9\x0a\xda\x78\x96\x2c\xbd\x72\x5c\xeb\xf7\xf5\x67\xb4\xe0\xc0\xec\x70\x43\x3a\x6f\x35\x15\xb3\x16\x68\xd7\x0e\x30\xea\x5b\xb2\xa9\xcf\x85\xea\x26\x75\x35\x33\xd9\x54\x33\x03\xe9\xf4\x9e\x94\xbc\x02\x94\x44\xd2\xb6\x39\x1c\xf2\x5e\x0f\xb4\xca\xf2\xdf\x8d\xc4\xe3\xe9\x89\x06\xc0\xff\x9f\x9a\x45\xa9\x23\xb8\x7e\x03\x91\xe7\x0b\xff\x0a\x1a\x2f\xfd\x3c\x89\x77\xe4\xb5\x3b\xab\x6d\xb2\xe1\xba\x68\xb2\x37\xcc\x3d\x89\x06\xdb\xb5\x8d\x91\x86\x26\xc6\xc0\xd0\x22\x19\x09\xd9\x7f\x04\x38\xa8\xb6\x38\x9d\xd7\x80\x83\xc5\x2d\xad\xd5\x1e\x5d\x4a\x81\xed\x9e\x76\xb6\x7b\xaa\x6e\xbb\x27\x1b\xb6\x7b\x7f\xd1\xd1\x84\x8a\x9f\xb2\xd3\xd2\x72\x46\xf1\xae\x5c\x90\xa5\x2b\x88\x7a\xc9\x75\x4d\x3f\x68\xad\x64\x07\x98\x13\x19\x30\x27\xbf\x8d\xad\x5d\x1d\xf3\x6a\x33\x98\x63\x7f\x72\x3b\x0d\x08\x51\xfc\x9b\x16\x5f\x6a\xfe\xd3\xa9\x12\x8a\x6b\xa2\x4c\xe7\x40\xf8\xee\x2d\xcb\x35\xb9\x1c\xf2\xf3\x04\x72\xa8\xfe\x03\x34\x40\xb2\x07\xfa\x21\xe9\x20\xe8\xe8\x38\x31\x8f\x77\xca\xfc\x87\xff\xb4\xca\xc2\x19\x34\xf6\xd7\x28\x31\xd4\x71\xd7\xcf\x28\x38\xfc\x1a\x95\x86\xff\xd1\x55\xf8\xdf\x40\x57\xe1\x7f\x27\x32\xe2\x37\xea\x46\x60\xe5\xa1\x66\x04\xa2\xe9\xbf\xb3\x8a\x42\xf5\x74\xd5\x04\x08\x2b\x04\x5e\x4b\x7f\x93\x96\xc2\x6f\x53\x52\xf8\xff\x46\x17\xc1\xae\x4f\x4d\xe2\xdb\x82\xeb\xd6\x17\x86\x9c\x1e\x22\xd3\xde\x86\xb2\x73\x58\xa2\xe4\x01\x9e\xd8\x9c\xa9\xc6\x34\x85\x6e\x21\xe8\x0a\xa3\x05\xb1\xb8\xc4\x1c\x1d\x42\x10\xd2\x9c\x54\xb1\x75\x11\x41\xa8\x73\x52\x61\x48\x58\x5a\xd1\xe2\x71\x57\x0d\x76\x79\xcf\xe8\x12\x18\xa4\x10\xe4\x66\xa0\x7d\x17\xe8\x12\xcc\x9d\x2e\xc1\xca\xe9\x12\x38\xe7\x09\xbf\xc6\xf9\xc2\x7f\x8d\x53\x08\xda\xd9\xc9\x4f\x3a\x4a\xed\x36\xe7\x9b\xf1\xc6\x3e\x83\x2e\x41\xab\xe3\x88\xa7\x2b\x0b\x9c\x10\xa9\x56\x57\x20\x17\xba\x7e\xa5\x73\xf5\xeb\x55\x01\x42\xef\x47\x16\x5b\x50\x4e\xe6\x29\x7e\x02\xe1\xbb\x7d\xe3\x5e\x2b\x00\x94\x9b\x68\x88\x26\x4f\x70\xe0\x79\xee\x71\x0b\x48\xb6\x19\x19\x57\xbf\x5d\x04\x1c\x82\xb0\x00\x12\x7a\x32\x3d\x84\x66\x5e\xd6\x5b\x3d\x59\xd6\x0b\xe7\x3e\x6d\x9c\x00\x37\x5c\xf4\x78\x56\xfc\x3a\xd9\xaf\x91\xd9\x16\xa7\xc2\xc8\xe2\xe9\x32\xdb\x82\x6b\x08\x99\xd7\x2a\xb6\x6d\x7e\xf3\xd3\xdc\x2e\x60\xfc\x41\x54\x51\x4d\x76\x5b\x92\xe8\xeb\x73\x12\xcb\x5a\xb1\x1d\x28\x7a\x14\x6b\xee\x2c\xca\x1a\xc5\x50\xd6\x88\x2d\x91\x7c\xf7\xf1\x56\x20\xfb\x8f\x3e\xfb\x49\xed\xff\x2d\x85\x5b\x3f\x79\x41\xd3\x6f\xa0\x7b\x82\xd2\xbf\x82\xf0\xf9\x49\x7f\x9e\xf2\xf1\xc2\xad\x9f\x1e\x13\x6e\x39\x74\xbd\x9a\xa5\x5a\xcb\x13\x5b\x81\xb3\x2e\xba\x02\x7d\xe3\x61\x87\x3f\xdd\x62\x20\xd0\x40\x7e\xa4\x1c\x6c\xb3\xdf\xc5\x45\x97\xfb\xf6\x88\x17\x2d\x72\x9e\xf5\x98\xc5\x00\xcd\x4e\xe2\x94\xb6\x41\x78\x67\x49\x91\xbf\x6a\xd8\x7f\x65\xf2\x51\x73\xe3\xe7\x2c\xf9\x4e\x73\x4b\x52\x25\x5f\x6a\x5e\xb7\x37\xf8\x5a\x73\x98\xa8\xe4\x47\xcd\x9d\xf0\x30\xf9\x41\xf3\xb5\x92\xc9\xdf\x34\xdc\x8b\x69\x99\xfc\x41\x73\xdb\xe6\x8f\xfa\xe8\x61\xe0\xbf\x93\xa6\x3e\x81\x40\x1d\xe7\xa9\x56\xf7\x72\x5c\x8b\x3b\x91\xe0\x79\xf9\x5e\x82\x5a\xca\xbd\xb4\x88\x05\x16\xf4\x6a\xf0\x5a\x9e\x08\x3f\x8c\x26\x95\xd0\x9f\x53\x77\x1a\x59\x23\xeb\xd2\xeb\x67\x28\x0c\xc7\xa0\x98\x0d\xda\x50\x88\x08\x54\x35\x94\x11\xd7\x39\x11\x49\xfa\xbc\xc0\xa8\x0d\x51\x25\xf2\x49\x3a\x65\x41\x18\xc8\xc3\x41\x46\x55\x10\xe2\x5d\x86\x1d\x04\x17\xb9\x76\xcc\x90\x5e\x03\xdb\xc0\xb2\xc4\x00\x26\x91\x84\xb8\x22\xf1\x8e\x41\x3c\x74\x74\x91\x8b\x5e\xbd\x78\xee\x6b\xcd\x65\xd3\x29\xe7\xb7\x9b\xbb\x5b\x59\xc6\xef\xbf\xfb\xf0\xf6\xe3\xdb\x7f\x7c\x7d\xf3\xf6\xdb\xd7\x6f\xbf\x7d\xfb\xf1\x47\x5e\x3e\xda\x28\xd8\xb7\xe5\x87\xc3\x69\xdb\xd6\xab\xa2\x8e\xeb\x41\x52\xc0\x6b\xae\x50\x91\xe4\x05\x1b\x55\xcf\x31\x82\x3e\xf8\xf4\x4c\x45\xc5\x92\x0a\x62\xe0\x80\xca\x97\xed\x34\xf4\xba\xf4\xbd\x56\xd2\x03\xc9\xfe\x10\x0c\xf0\x9c\x4b\xe2\xce\x16\x82\xd0\x9f\xa4\xee\x3a\x0e\x05\xf0\xbd\xf6\x83\x96\x63\x6f\x83\x18\x6f\xfb\x2a\xde\x32\xb4\xe0\xc8\xc3\xf4\x5d\x5f\x41\xfc\x45\xc7\xfb\xc2\x4f\xd5\xcf\xa5\xf6\x41\x9b\xd3\x5a\x48\xbf\x12\x83\x33\xfb\x6e\xa7\xb2\x8e\x24\xff\x3b\x58\x71\x68\x36\xca\x63\x00\x00\xc2\xfc\x1c\x0e\x9d\x6d\x07\x99\x0a\xa9\x50\x32\x32\x89\x80\x1a\xe4\xb1\x02\x0e\x6d\x25\x67\x7a\x8c\x9b\x41\xb1\x04\x97\x4f\xf1\xcb\x21\x4f\x41\x84\xe2\x17\xa8\xa4\x1d\x36\x8e\x3e\xbb\x7d\xe3\x45\x51\x7e\x0d\x4c\xbc\xa8\xed\x06\x82\xed\x3a\x29\x41\xce\x7c\x43\xee\x3c\x64\xb7\x7b\x29\x83\x8d\xda\xed\x16\xc6\xff\xb2\xc4\xcd\x55\xa0\x6c\x11\xfa\x5f\x4a\x81\x60\xb3\x4a\xf6\x06\x6e\x06\x80\xc8\xef\x64\x9a\x04\xae\x82\xde\xb7\x6e\x2f\xbf\xb5\x6c\x28\x4c\xcb\x4c\x22\xff\xcf\xe0\xa7\x1a\x7a\xa0\x5c\xa8\xd4\x21\xb3\x20\x30\x95\x24\xfd\x4c\xbd\x53\xa8\x76\xca\xe5\xec\xa2\xec\x7e\xd3\xaa\x34\x17\x04\xf4\xb2\x30\xb6\x9c\xa7\x84\x60\x1d\x22\x33\xc5\xd4\x35\x24\xb3\x8d\x7d\x29\xec\x7b\x4e\x57\x4b\xa7\x31\x81\x54\x35\x6d\xab\xbd\xeb\x08\x40\x63\x46\x4a\x13\xed\x45\x70\xff\xd0\x48\xd9\x91\xc3\x3d\x25\xab\xdf\x63\x46\xec\x70\x69\x0a\x82\xd9\xe1\x29\x3b\xf2\xed\xe3\x4d\xf0\x14\xc4\x77\xa5\xb8\x1c\x9e\xdf\x09\xb0\x0d\x4c\x48\x27\x08\x81\xc5\xba\xdd\xd4\xad\xbe\xdf\x21\x14\x62\x0b\xd4\xdd\x20\x2c\x08\x6e\xcc\xa0\x2f\xdd\xee\x25\x04\x5d\x83\xd6\x18\x4f\x8f\x7c\xf7\xfb\x74\xeb\x47\xdb\xad\xdd\xef\xd2\x2d\x10\xd0\x4b\xe7\x81\x2c\x40\xfc\xe9\x26\xa0\xbe\xbc\x89\x1f\x56\xb2\x3c\xe9\x90\xed\x69\xbc\x2e\x00\x9c\x4a\x70\xe7\xe8\xaa\xc8\xea\x55\xe8\xb8\x2a\x4a\x1d\x02\x00\x3f\x07\x18\x7d\x10\x74\xda\xc6\x3a\xc9\xed\xf8\x15\x99\x75\x02\x9c\xa6\xc7\x31\x91\xc1\x7d\x72\x85\x9d\xd8\x3c\x7d\x9b\xa3\xd6\x83\x0d\xf6\x00\xc8\xa0\x53\x02\x46\x4f\xf2\x29\x97\x93\x7c\x4a\xb0\xd4\xa4\x29\x48\x53\xd3\xa0\x8e\xb9\x6c\x8d\xe5\x54\xa0\xba\x02\x90\x42\xef\x4d\xd4\x4f\x60\x4d\xe7\x18\x3e\x00\x14\xec\x26\x39\x4c\xc9\xb4\x0f\x0e\x88\xd4\x2f\x10\xc5\x11\x7f\x45\x1e\x86\x3d\x74\x7a\x6d\xa4\x2a\xc7\x6d\xb1\x1e\x15\xe3\xa8\xec\x41\x0d\x98\xb9\xaa\x44\x98\x16\x81\x0f\x27\x5d\xac\xbd\x08\xb4\x20\x5d\x43\x10\xa2\xa2\x7b\xb3\x05\xb9\x78\x33\x1f\x49\x85\x11\x7e\x40\x46\x6d\x70\xc5\x30\x03\xd9\xda\x39\x65\x45\x70\x3b\x07\x7d\x0c\xf3\x20\xbd\xe3\x14\x21\x41\xcb\x57\x59\x39\x27\x22\xc2\xfd\x8d\x44\xea\xaa\x11\xc2\x11\xd6\x97\xd4\x6d\x70\x31\x5d\x36\x88\xdd\xe8\x42\x39\x32\xae\xf0\xae\x7d\x38\x1c\x52\x7c\x58\xc1\xbe\x7f\x10\x8a\xeb\x78\x25\x52\x04\x8f\x59\x7d\x1e\x27\xf0\xed\x61\x9a\x4c\x52\xc8\x63\x01\xfc\x65\x94\x4d\x06\x53\xe0\xae\x4d\x86\x53\xcb\x04\x81\x24\xd6\xed\xda\x97\xe1\xb4\x76\x77\xae\x64\xdd\x29\x55\xb0\xba\x2e\x8f\xb2\x17\x97\x12\x46\x2d\x72\x80\x1a\x8d\x03\x52\x81\x1c\x58\x15\xc6\x81\x13\x1e\xe8\xf6\xcb\x4f\x4d\xf4\xd4\xcf\xaa\x04\xbc\x24\x9f\xe8\x29\xf9\x41\xa0\xb2\x2a\xd2\xe3\x49\x7d\x1e\xa7\xc9\xa4\x3e\x63\xe1\x6e\x9d\xc9\xb3\xba\xfa\x36\xe2\x81\x71\xbb\xa2\x9b\x6e\x57\xa2\x42\xc0\x95\x81\x0a\xf4\xb7\xc5\x96\x11\x0b\x2f\xa2\xbd\x79\x38\xc8\xf8\xc1\x45\xd2\x80\xb7\x15\x5f\x81\x58\xd5\xaf\x03\x97\x8c\xf1\xb9\x8c\x00\xd3\x2b\x01\x2a\x66\xc0\x76\xdc\x50\x43\xe8\x0f\xe0\x72\x00\x97\x37\x06\xe0\xc7\x9d\x02\xe1\xb7\x11\x82\x95\x0e\xa8\x57\xdd\xee\x4c\xa2\x78\x31\x67\x87\x43\xe6\x87\xb6\x68\x1f\x9a\xc8\x63\x8a\xbb\xcb\x33\x01\xf8\xe0\x06\x62\xe0\x3e\x36\xd2\xfa\x40\x79\x38\x84\x31\x1d\x11\x11\xf4\x71\x0c\xda\x5b\x0b\x6d\x83\x3c\xda\xf3\x10\xe6\xc8\xc3\xcd\x4f\xe7\xc2\xc6\x7c\x84\x69\xc3\x43\x29\x36\xfe\xc0\x6d\x7a\x05\xcd\x24\x2f\x28\x06\x09\xd5\xdb\xa7\x33\xba\x11\x36\x33\x4b\x6c\xa7\x32\x77\x18\xb3\x1e\x15\xa3\xaa\x25\xfc\xf5\xd5\xe3\x2b\xc4\x56\xe6\xb6\x19\x57\x5b\x1f\x0b\xa0\x4f\x2e\x6c\x0f\x34\x2d\xb6\x22\x03\x0c\x5e\x6c\x8e\x27\xe4\x58\x96\xee\x8a\x8d\x4e\xf6\x36\xb4\xf1\xbe\x7d\xaf\x1b\x7b\x5b\x2f\x29\x5a\x4a\xbe\x96\x62\x4f\xc1\x78\xab\x04\x4c\x71\xe6\xf3\x2f\x8b\xfa\xd5\x0c\x07\xfb\xb6\xd8\xca\x0a\xe3\x50\xe2\x13\x5e\x96\xd2\x4f\xad\x08\x9e\x0f\x87\xcb\x21\x87\x60\xc8\x86\xb6\x12\xfe\xf1\x70\x30\x27\x42\xd2\x25\x20\xec\x03\xa8\xf1\xc8\xf8\x26\x4b\x77\xb2\xac\x84\x7b\x3a\x1c\x5c\x37\xec\xc5\x30\xd9\xff\x92\x0c\x4e\xd4\xb2\x25\x2a\x09\xb7\x58\xfd\x1c\x91\x50\x86\xfd\x23\x29\xa2\x0a\xc4\x2e\x2c\x25\xc4\x51\x3f\x1d\x26\xd9\x78\x9b\xec\x63\x1a\xaa\xa3\x1b\x4c\x80\x13\x24\x26\x72\x30\x03\x37\x5f\xc1\x12\x61\x26\xa3\x1c\xf1\x4b\xe7\xa1\xa3\x56\x31\xcf\xbd\x87\x06\x05\xf8\x43\xc7\xcd\x15\x7a\x74\x36\xf3\xd4\xe1\x1d\x33\x19\x1d\x40\x2f\xac\xae\x11\xe8\x89\x8f\x0a\x24\x20\x0b\xa6\x44\x3a\x29\x20\x46\xfc\x2a\xad\xbe\x7b\xc8\xdf\x97\xc5\x5a\x96\x7a\x17\x29\x38\xc5\x70\x1f\x8a\x1c\x8e\x8b\xe5\x3e\x9e\x74\x02\x10\x67\x3a\x96\xc2\x71\x53\x63\xb3\x77\xc8\x0e\xcb\x6b\xf3\xea\xc2\x5e\x5e\xca\x9e\x5c\xa0\x3c\x24\x5c\xe1\x66\x4b\x8b\xbc\x9f\xda\xe3\x51\xb5\x84\x16\x3e\xa7\xf4\x45\x00\xce\xc8\x17\x22\x5d\xd3\x13\xb4\x52\x06\x65\x1c\x9f\x10\xe2\xb4\xb7\x7a\x1d\xb7\x05\xd8\xe3\xad\x8b\x2a\x51\x6e\x57\x05\x3c\x84\x44\x35\x03\xa2\x98\x29\x75\xf8\xc7\xd1\xc1\xaf\xf4\x08\xe8\x58\x2e\x32\x19\x55\xd2\xc5\x31\x66\xc0\x86\xe4\xca\xa7\xda\xb0\xc6\x3c\xf5\x69\xb0\x87\x4d\xc6\xd2\x27\xda\x6b\xd1\x36\x80\x97\xce\xcb\x7c\xfe\xb1\x58\x27\xc8\x0b\x9c\xa5\x1a\xed\x04\xa1\xc2\x97\xf9\xfc\x4b\x32\x81\xb7\x9f\x4a\xc6\x1d\x93\x37\x31\x55\xba\xf7\x0e\xe3\xf7\xb2\xd4\x6a\x96\x66\xbe\x2e\xc5\xc2\x81\xa7\xbe\x9e\xe3\xd1\x9e\x52\x08\x03\x52\xc5\xb6\x28\xdf\x88\x2a\xbc\x06\xe6\xc2\x58\xf4\xc7\x8b\x52\xca\x5f\x64\xb4\xf7\xc0\x31\x91\x3c\x40\x00\x92\xdc\x85\x4c\x4f\x79\x7a\x9f\xaa\x2c\xbd\xcd\xac\x2a\x2a\xbf\xff\xb2\xd8\xbe\x4b\xb7\xf4\x7a\x75\x7d\x65\xf9\x1e\x7c\x65\xbe\x50\x25\xc5\xd5\xf5\x11\x62\x4c\x15\x32\xda\xfb\x0b\x3b\x81\xd7\x23\x50\xac\x0f\x09\x04\xd7\x2d\xf8\x96\x4c\x16\xc0\xea\x12\x60\x20\x7c\x1c\x5d\xb6\x1c\x54\xf4\x4f\xe0\xd5\x4f\x75\x8b\xaa\x29\x20\x8f\x8c\x00\x77\x1a\xde\x1f\x60\xa1\xb0\xf5\x20\x0b\x9c\xa2\x36\x06\x26\xe3\x70\x60\xdc\x6e\xf4\x5a\x35\x50\xac\x36\xc8\x63\x94\xd9\x75\xd8\x30\xf4\x0e\x2e\xa3\x8c\xaf\xe8\x69\x83\x4f\xdd\xae\x4f\x3c\x39\x21\x75\x54\xd5\x7e\xbe\xc8\x23\x7b\x9d\x86\x98\x48\x3e\xed\xc3\x00\xb9\x37\x65\x83\x57\x70\x9c\xa9\x8e\x3a\xde\xf5\x44\x1e\xd1\x5e\xd5\xf1\x16\xdf\x68\x8f\xe7\x91\xdd\xd7\x3c\x8f\xdc\xce\x3d\x46\x2b\xc6\x17\x32\x32\xf7\xa8\xd9\xba\xa6\x9b\x2b\x28\xbe\x8a\x1f\xf8\x0a\x6a\x5d\xc5\x2b\x93\xad\xbe\x95\x4d\x56\xed\x35\x0a\x09\xef\x5a\x61\x75\x88\x7c\xad\x60\x3d\xe9\x52\x32\xc9\x3d\xa8\x95\x6e\x27\xfc\xda\x5b\xc5\xab\x23\x27\x4d\x22\x92\x7e\x80\x09\x41\x40\xcf\x38\x40\x0d\x0c\xe9\xed\xa8\x00\xe0\x1b\x34\x0b\x38\x3c\xe1\x44\x50\xf9\x2a\x5e\x01\xaa\x76\x3c\x1e\xf9\x9d\x14\xd1\x52\x36\x77\xfd\xcd\x0d\x4a\x92\x6e\x6e\x12\x88\xf3\xc2\xe9\x2e\x4c\x3a\xff\xf7\x27\xb9\x5b\x94\xe9\x9d\xac\x2e\xb0\xee\x7f\x55\xfd\x52\xe6\x73\x59\xf6\x9d\x1a\xde\x7e\x51\x16\x77\xfb\x62\x9d\xce\x94\xde\x25\xf1\xff\xfa\x5f\x47\x5d\xb8\xd7\xe1\xf1\x18\x37\x0a\xde\x15\xb9\xd2\x45\x49\x56\x08\xc0\xcd\x3d\x57\xf3\xc5\xf0\xae\xf2\xc5\x81\x24\xb1\x85\x79\x6b\x6a\x5f\x6e\xd7\x69\x3e\x3f\xf3\xb1\x5a\x95\x2a\xff\xb4\xb7\x00\x33\x49\x6f\xab\x22\xdb\x68\x39\x9a\x2b\xf0\x27\x03\x1d\xc9\x74\x39\x82\x05\x49\x06\x23\x58\xa9\xc1\x88\x50\x87\x91\x45\x1d\x46\xc5\xbd\x2c\x17\x59\xf1\x90\x90\x42\x36\x72\x14\x64\xd9\x87\x38\xd1\xba\x4a\xf2\x22\x97\xa3\x7b\x60\x89\xaa\x0c\x66\x83\x72\xfd\xd2\x37\xa0\xbb\x3f\x3c\x3e\xd6\xf1\x17\x73\x75\xdf\xd2\x3f\x3c\xb9\xc9\x70\x80\xff\xad\xb7\x23\xa2\xc3\x7c\x42\xd8\xe5\x33\x0d\x98\xc1\x3f\xda\xc0\xf5\x60\xf0\x7f\xda\xba\xf1\xb9\x56\x6d\xe7\xc8\x58\xb7\xbb\x94\x31\x6d\x8d\xc3\x61\x29\xf9\xbd\x14\x9d\x3f\x50\x7b\x1d\x7e\x2b\x45\xa7\xad\xf1\x0e\xdf\x06\x5f\xea\xbb\xa0\xc3\x77\xa7\xdf\xdc\x06\xe8\xf0\x1b\x29\x26\x1d\xf7\x4e\xca\x3e\x9d\x07\x79\xfb\x49\xe9\x97\x36\xf9\x03\x26\x4f\xf9\x27\x29\xf6\xba\xd8\xcc\x56\x98\x2f\xe9\xdc\x15\x9b\x4a\xce\x8b\x87\xbc\xc3\x31\x19\xf0\x1d\x4a\x85\x47\x4a\x05\x2b\x0a\x93\xb8\x59\x93\x6a\x08\xb8\x71\x03\x6e\xbe\x49\xc6\x67\xf7\x05\xea\xa3\x0f\xf0\xe8\xd2\x4f\x2a\xa7\xf4\xcd\xfa\xb4\xf6\x4c\xa6\x2e\x73\xb1\xd1\x2e\x1d\xb0\x57\x9f\x1a\x70\xf5\x1f\x6a\xb4\xde\x1b\x6f\x55\x01\xa9\x60\x6b\x07\xfc\xec\x3b\x08\x6e\x16\x5d\xfd\x3f\xd1\x7f\xcc\x7b\x2c\xfa\x8f\x18\x7e\xc6\xeb\xed\x1f\xae\x1c\x64\x54\x63\xc3\xcb\x8e\xd4\x64\x38\x65\x14\x58\xf0\x08\x30\xf7\x9d\x14\x97\x97\x4d\xa1\x2c\x70\x9d\x74\xb9\x23\xa0\x4c\x10\x63\x2e\x17\x2a\x97\x0e\xeb\xda\x1f\x79\x67\x9d\x56\x95\x82\xf9\xdc\x2f\x43\x26\x22\x38\x8a\xbb\x1c\x00\x96\xfd\xa0\xf2\x79\xf1\x10\xa7\xf3\xf9\xd7\x70\x4e\xbe\x51\x95\x06\x6b\x95\xa8\x23\x3b\x2e\x2e\xf9\x0c\x7b\xaf\xd9\xde\x9a\x15\xeb\x63\xc4\xba\xdd\x3d\x55\x0e\x66\x49\x7e\x3e\x3e\x38\xf2\x4a\x9f\xd6\x0a\x18\xd6\x3b\x19\xd0\x99\xaf\x82\xdc\x06\xeb\xfd\x4c\x81\xf7\x54\x00\x2e\x57\x8b\x73\x1b\xab\x2d\xcd\x49\x3d\x8f\x1b\xb9\x4a\x92\x1e\x0e\x38\x82\x2d\xcd\x26\xe0\xc5\xe3\xdc\x40\xd1\x9d\x4f\x53\x63\x85\x69\x01\xfd\xfe\x32\x60\xd9\x9f\xb3\x30\x9a\xab\x7b\xcf\xa6\x97\x31\x3a\x86\xff\x36\xbd\x93\x42\x63\xc0\x18\xe9\x6b\xfb\xea\x0c\xc1\xa9\x27\xf7\x72\x0a\x24\x0b\xfc\x8a\xfd\xd1\x60\x43\xa5\x84\x03\x5a\xb6\xa0\xab\x2f\x65\x74\x0b\x1c\x20\xf3\xd0\xeb\x10\x68\xea\x80\x07\x54\x9b\x64\x80\x49\x07\xf9\x9f\x81\x11\xd3\x4b\x19\x01\x37\xa0\x25\x4d\xd6\xd2\xf2\x66\x82\x82\x04\xd0\x5f\x90\xda\xf7\x88\xed\xf3\xb8\x9a\x95\x45\x96\x7d\x03\xe4\xe4\x50\x7e\xc1\x6d\xc2\xc7\x62\x8d\xef\xaa\x99\x41\xd5\x33\x1c\x89\x19\x1b\xd4\x69\xdb\x89\x18\x07\xcd\x1d\x3b\xb5\x1f\xe0\xfa\xec\x98\xc2\x1d\x9e\xc6\xb7\x2a\x9f\x43\x8a\x1d\x3c\xe3\x1f\x64\xa4\x4e\x72\x40\x0a\xcd\x05\x04\x3e\x8a\x22\x15\xf6\x5f\x2d\x22\x37\xd3\x16\x71\xc9\x1d\x65\x71\x97\xaa\x5c\xa7\x2a\x7f\x59\xad\xe5\x4c\x7f\x0f\xf0\x0d\xe8\xa9\x75\x0a\xb0\x07\xbc\xe1\x83\x4e\xfc\x58\xc5\xb3\x4c\xc9\x5c\x1b\xc4\x72\x30\x92\xd1\x7b\x19\x75\xcc\xf2\x75\x78\x0e\xf3\xdd\xed\xd6\x32\x3d\x4f\x01\x16\x18\xfb\xda\x6e\xf7\x24\xff\xf1\x88\x6c\x5b\x14\x8d\x78\xcc\x8b\xed\x0b\x81\x9e\xc8\x83\x58\x5a\x28\x32\x00\xe7\x22\x59\xe4\x49\x49\x9e\x8a\xf4\x70\x00\x9d\x0c\x5e\x1e\x0e\xc8\xb3\xe5\x6f\xe2\x52\xfe\xbc\x91\x15\x02\xe4\xd7\x80\x2c\x98\x52\xe6\xd4\x07\x38\x0b\xdb\x63\xd3\x8a\x48\xd4\x94\x17\x80\x94\xc0\xbf\x56\xd4\xb6\x65\xef\xc2\x04\x9a\x0b\xe4\x7d\x59\x6c\x77\x7e\xb6\x91\x0d\xee\xee\x09\x3c\x22\x42\xec\x24\x4c\x00\x3b\xda\x30\x3f\x37\xb2\x8e\x40\x11\x14\x51\xc4\x75\x2e\x25\xdc\xec\xe2\xf2\x52\x93\x83\xd6\xf7\xb8\x16\x10\x19\x0d\xce\x1d\x40\x2d\x00\x36\xd1\x56\xb2\x63\x8d\xb7\xdc\xb6\xd4\x52\x84\x6b\x39\x82\x9e\xa0\x3e\x9d\x4f\x03\xbc\x59\xe5\x95\x2c\xf5\x97\x72\x51\x94\x80\x23\xcb\x78\xa1\xca\x4a\xa3\x4d\x20\xe3\x99\xdb\xab\xc7\x1a\xa3\xf8\x63\x00\x38\x68\x8a\xf6\x47\xb4\x63\xa1\x1e\x8c\xe6\x32\x93\x5a\x5e\xb8\x84\x36\x14\xbb\x51\xd0\xcd\x29\x38\x81\x8d\xce\x4c\x98\x03\xa4\x30\x61\x41\x23\xae\x30\xab\x4d\x96\x81\xb5\x34\x5f\x8c\xe7\xb0\x31\xc3\xf1\x87\x6f\x94\xd9\x42\x08\xbc\x94\xde\x02\x57\x46\x55\x40\x67\xbd\xfa\xf0\xe1\x6d\xfe\x2f\x42\xd3\x2e\x87\xfc\x46\xe6\x90\x3c\x4f\x3a\x9b\xdc\xdc\x48\xf3\xce\xa5\x75\x9a\x63\x36\x5e\xb7\xdb\xf6\xcd\x42\x5a\xa8\xa1\xda\x94\xf2\x9b\x22\x9d\xcb\x79\xd2\x14\xdc\xc2\x0e\x8f\x5b\xda\x76\xd3\x07\x57\xf0\xf7\x45\x81\x7d\x1f\xd7\xde\x22\x96\xd8\x46\x9e\xb8\xad\x81\x1b\x7f\x89\x24\x2d\x00\x84\x0a\x95\x5f\x2a\xb6\x6f\xa6\xc0\x59\x93\xa2\x73\xf5\xec\xe2\x15\xdc\x41\xf1\xbf\xaa\x8b\x67\x57\xff\x91\x77\x7a\x12\xe1\x9c\x3a\x6b\x77\x8b\xf6\xa9\x1d\x36\x52\x0d\xb3\x5a\x98\xae\x0e\xef\x40\x80\x8a\xab\x19\x84\x0a\x6f\x00\xef\x73\x56\xa9\xc0\x3a\xd5\x0d\x08\x0e\x01\x19\xe3\x55\x51\xe9\xb1\x74\xc3\x8f\x21\xfc\x21\xbf\x93\x60\xac\x98\xce\x7e\xde\xa8\x52\x82\x16\x18\x98\x7f\xd6\xe7\xa5\x53\xe9\x52\xe5\x4b\xef\xf6\x48\x8f\xb5\x1f\xcd\xd2\x69\xb3\x7d\xb9\x7b\x3b\x8f\x34\x4b\x2c\x95\x0b\xc2\x0b\xa1\x81\x4b\xce\x35\x00\x4f\x0b\xf5\x20\x95\x5e\x0c\x8b\x8f\x7c\x16\x2e\xa5\xa6\x0e\xd4\xdf\xa2\xce\xf5\xdc\xdf\xb3\xb9\x07\xa0\x60\xd8\x3f\x26\x97\x04\xe1\x7e\x01\xae\x49\xeb\xd2\xc6\x38\xd7\xa0\x8e\x1b\x2f\x6b\x73\x6d\x10\xeb\x0e\x38\x71\x6a\x7e\x42\xfc\x1e\x96\x67\x61\xb7\x84\xca\x95\x56\x69\x96\xec\x09\x1d\x57\x9c\xbc\x13\x70\xac\xde\x1b\x7c\xe7\x31\x3d\x71\xca\x99\x5b\x56\x94\x29\x90\x1b\xb2\x1f\xe8\x3e\x97\x55\xb8\xa7\xc3\xa1\x73\x9b\x15\xb3\x4f\x1d\x1b\xbf\x33\x05\xac\x02\x7e\xcd\x78\x4a\x81\x78\xa7\xeb\xa0\xc3\x66\x40\x26\xa7\x89\xa3\x50\xa2\xe7\x14\xaa\x40\x51\x05\x8a\xa9\x45\x84\x4f\x9a\xac\xa3\x4d\xb7\x98\xb6\x1c\x04\x2a\x7e\x15\x39\x7b\xbb\x38\xf5\x37\xe1\xe1\x70\x6d\x1c\xa2\x90\xaa\x84\xe9\x87\x9d\xc3\x66\x47\xa8\xca\x02\x99\x3e\x80\x10\xe7\xcc\x20\x5b\xbc\x94\x99\x4c\xab\x96\x5d\xe7\xcf\xb1\xd9\x26\x30\xf9\x12\x26\xdf\xae\x24\xbe\xc4\xb4\x10\xa3\x89\x6d\xdb\x4e\xc6\xf4\xbc\x8e\x80\x12\xf9\x44\x4f\x47\x6f\x62\x55\x7d\xbb\xc9\xb2\xef\xca\xbf\x03\x14\x8a\x14\x1b\x5b\x20\xe7\xd7\x5e\xb3\x44\xd6\x0f\x25\x88\xcb\x01\xba\x12\x04\x06\xef\x49\x3b\xb0\xf0\xdc\x1f\x03\x50\x0c\x6a\x8c\x7b\x08\x96\xba\xcb\x80\x01\x22\x34\x94\x90\xb4\x20\xf4\xeb\xe0\x33\x0c\x04\x0e\x60\x03\x4d\xae\x1d\x41\x87\x37\xd6\x26\xc4\xe2\x0d\x10\xe3\xda\xea\x85\xe4\x04\xbc\x72\x07\xbc\x10\x33\x92\x3c\x4a\x01\x77\xd8\x2a\x64\x90\xbb\x67\xc0\x38\x19\x58\x15\xcf\x7b\x9d\x9b\x4e\x4f\x4e\xfd\x95\x2d\xd9\x3e\x8f\xda\x0e\xd1\x27\x39\xd1\xe8\xe2\x68\x0a\xda\x33\xf0\x60\x35\xfc\x5a\x35\x96\xec\xb1\x7d\x0f\x38\x9c\xe4\x24\x51\x06\x7b\x16\x12\xe6\xb3\x23\xec\xa4\x8b\xaf\xa0\x9f\x39\xd7\x8e\xf5\xfd\x7b\x4c\x47\x14\xd1\x84\xec\x8f\xcc\x8f\x7f\x7f\xac\x8d\x79\x94\x76\xbb\xaf\xa0\x75\x09\x22\x7f\xec\xcc\x47\x89\x30\x73\xf4\xc6\x91\x2f\xe2\x83\xe4\x6f\x42\xf2\x44\xbc\x32\x70\xfd\xb5\x14\x6f\x65\x6c\x2f\xbc\xf1\x5b\x99\xec\xcf\x02\x53\x4b\xac\x5c\x9c\x05\x87\xbc\x01\x08\x0d\xe8\x33\x44\xcc\xf1\xc8\xbf\xf3\xe2\xf5\xc8\x82\x21\xf5\x4b\xc0\x44\x67\xfb\xf3\xb0\x9c\xed\xcf\x9f\x38\xb6\x7f\x6c\x0b\xb2\xfd\x67\x16\x85\xed\x8f\x47\xfe\x5a\xb2\xd1\x89\xd0\x67\x9d\x6d\x96\x2a\x07\xa9\x0d\x89\x73\xbe\x91\x62\x7f\x63\x53\x27\x53\x6e\x02\x99\xbd\x9d\x83\x24\x48\x2e\xa1\xe6\x32\x69\x9e\x59\x32\xe8\xb3\xc5\x46\x93\xa9\xe5\x60\x6a\xd6\x7e\xd2\x31\xb8\x3b\x59\x5e\x7d\x07\x12\x05\x40\xe7\x48\xbf\x01\x4e\xa3\xa9\x8f\x9a\xee\xf5\x8e\x7c\x93\xff\x8e\xad\x5b\xd6\x9f\x6f\xde\x89\x64\x64\x4d\x18\xd3\xd6\x93\x59\x26\xd3\xa0\x13\x6c\x5f\x6b\x1d\xe8\x82\xd3\x22\x8f\x06\xd5\xa7\x92\x74\x29\xa3\xaf\xfa\x97\x59\xf6\xb9\xec\x47\x9e\x17\x5a\x2d\xce\x68\x9a\x58\x02\x16\xd4\x0d\xa1\x91\xb9\xac\x66\xa5\x5a\xeb\xa2\x04\xa7\xf8\x7c\xe3\x15\x0b\x49\x78\x3a\x52\xcf\x37\x28\x1b\x06\xc0\x65\xab\x74\x18\x45\x54\x81\x1c\x35\x4a\x51\xe3\x90\xc5\xa6\x0b\x0c\x2c\x27\x8c\xd7\xb4\x89\x76\x73\x9e\xa3\x28\x86\x19\x99\x8b\x33\x3d\x66\x1c\xdd\xfc\x58\x4d\xfa\x92\x17\xcc\x6a\x5b\x39\x25\x18\xf0\xcb\x12\xf4\xb3\x6d\x9d\xe3\x3f\xd0\xe8\x81\x24\x77\x2f\x16\x09\x04\x33\x1f\x21\x44\x6d\xfa\xad\x4a\x97\x0c\xa7\x80\x90\x9a\xc9\x14\x95\xc4\x00\xa7\x80\x53\x6d\x04\x6f\x64\x59\xe2\x7a\xde\xed\xba\x47\x1a\x77\x05\x59\x46\x6d\x6b\x62\xe7\x20\xf5\x39\x27\xd3\x33\x7b\x50\x2d\x22\x3c\x04\x14\x3a\x10\x0f\x81\x1f\xa7\x9a\xf3\x54\x94\x13\x39\x1d\x5d\xc2\xc6\x4c\xbb\xdd\xe8\x72\x00\xa8\x05\xaa\xf3\x78\xcb\x42\x73\x8e\x6d\x73\xb0\x20\xc0\xba\xb6\x8a\x42\xa4\xc7\xb6\x37\x9f\x13\xcd\x69\x20\xc0\x76\x81\x03\x6f\xf4\x20\x6b\x53\x23\x14\xc7\x69\xac\x4d\x22\x18\x23\xdc\xa8\xfc\x3e\xcd\x54\x43\x26\xc8\xf6\x74\x4f\xfa\xd5\x38\x1e\xf9\xf7\x52\xec\x67\x45\x5e\xe9\x72\x33\x83\x2d\x07\x32\x61\x62\x7c\x02\xa4\x71\x80\x04\xcd\x03\xd1\xf5\x85\xaf\x91\x38\x4d\xd0\x7c\x58\x05\x28\x50\x48\x4e\x7b\xd9\xd4\x04\x49\x76\x26\x72\x86\xe7\x06\x2b\x7c\xe5\x8b\xd5\x7a\x1a\xae\x58\x58\x75\x53\x12\xaa\xd9\xf8\x24\xcf\x44\x4f\x89\x1b\xe5\xdb\x21\x8b\xcc\xea\x6c\x23\xb6\xa3\x2d\x0d\xbc\x89\xef\x64\xb9\x94\x11\x71\x05\x0d\xc5\x81\x41\xe2\x19\x9f\x7c\x4b\xe6\x4b\xcd\xd1\x4e\x19\x80\x69\x12\xcc\x9e\xeb\x02\x60\xd3\x9f\x69\x1d\xb0\xbe\x46\xd5\xfe\xda\x6a\x7e\x01\x4d\x0f\xbc\x7b\xb0\xc1\xea\x63\xf1\x0d\x8a\x7a\x6b\x83\x26\x6c\x8b\xcc\xae\xaa\x3a\xd5\x1b\x48\xf5\xbd\x77\x08\x9f\x56\x97\xf2\xdb\xcf\x36\x29\x14\xf3\xdb\x6f\x14\x00\x60\x2d\xe1\xf2\xff\xb2\x80\x20\xf5\xa8\x29\x7a\x3c\xf2\x5f\xe4\x89\xc1\x2c\xff\x16\xd2\x4a\x8d\x4e\x03\xbe\xd7\xd9\xcb\x79\xba\xd6\xb2\x3c\xbd\x09\xbd\x3f\x18\x4b\x1c\x5f\x0e\xb8\xb1\x36\x43\x74\x98\x83\xea\x69\xd2\x21\xd5\xc6\x50\x9b\x3e\xbd\x97\x65\xba\x94\x1d\xee\x14\xef\xc0\x2b\x54\xc3\x36\x21\xe9\x94\xcb\xdb\x34\x1a\x70\xfc\x3f\xfe\xbf\x58\x87\xa3\x9e\xfd\xeb\xc2\x9a\x65\x75\x6e\x8b\x6c\x4e\xa9\x1f\x40\x00\x94\x2f\x93\x6b\xf3\xfa\x2e\x2d\x97\x2a\x27\x71\xef\x17\xbe\x20\xd5\xfc\x7f\x2c\x16\x0b\x2a\x88\x9e\x03\x48\xc1\x9f\xdf\x16\xf3\x9d\xaf\x09\xde\x4e\x0a\x41\x62\xad\xcc\xa2\x28\xd0\x97\x42\xa3\x57\x26\xd9\x57\x66\xde\x4d\xbf\x40\x3c\xfd\x05\xa5\x9c\x34\x60\x92\x6b\x4d\xec\xac\xdc\xf6\x0b\xee\x44\xb8\x5f\xf0\x59\x5a\x3a\x5d\xb7\xe4\xda\xbc\x7e\x00\xe4\xe9\xcf\x7c\x56\x94\xde\x03\xc2\x17\xfc\x6e\x93\x69\xf5\x37\xb9\xf3\x16\x21\xb6\x31\xa2\xcc\xb0\x03\xe8\xf7\x3d\x30\xf3\xa8\x2f\x00\xab\x9b\xe8\x0e\x78\x60\xff\x70\x8b\x0c\xa5\x8f\x30\xc9\xd6\x74\xb9\x61\x12\x11\xa0\xdb\x14\x78\x9c\x3c\x67\x18\xa6\xa3\xb9\xc9\x13\x74\x87\x6a\x89\xed\x17\x03\x4b\x15\x02\xc5\x3d\x22\x7f\x5d\xe3\xdc\x7a\xee\x4a\x4a\x32\x9f\xc0\x24\xf3\x98\xa4\xa0\xdd\x4b\x2e\x4c\x81\x33\x19\xe5\x42\x39\x97\xa6\xce\x83\x68\x7e\xe4\xe9\x42\xcb\xb2\xd6\x63\x33\x88\x2f\x8b\xf9\xae\x9e\xf2\x0d\xb9\x7a\xc0\x4c\x6d\x3e\x8d\x2c\x72\x44\xaa\xc3\x8f\x44\x5c\xb7\x77\x20\xb0\xbc\xf2\x1e\x06\x71\x64\xbc\x49\xb8\x69\x73\x1e\xd9\x38\xef\x09\x6b\x13\x02\x91\x19\x29\x9d\xe7\xa4\x51\x6d\xd6\xa8\xbd\x2b\x0d\xd5\xe6\x7a\x87\xc8\xef\x13\xe9\xe6\x4f\x8d\x22\x39\x75\x6d\x1f\xae\x7f\xcd\xe8\xe7\xe4\x78\x9e\x18\x13\x1d\xa9\x63\x10\x5e\xb5\xd1\xb9\x3a\x98\xb7\x86\x54\x71\xed\x80\xd1\x9a\xd4\xa6\x1b\x53\x4e\x97\xe4\x35\x1e\x11\x9b\xb6\xa8\xbd\x61\x91\x5a\x06\xa7\x78\xf5\xa5\x14\x7b\x02\x3d\xa7\x0c\x38\xda\x75\x1e\xc7\xf2\xa6\xce\xa0\x12\x08\xb6\x08\x8f\x06\x0d\xb2\x46\x16\x80\x82\xa8\x45\x54\x80\x52\xfe\x2a\xad\xd0\xee\x3a\x22\x1b\x0c\x30\xaf\x25\xa8\xe9\x48\x4a\x36\x52\x3d\x01\xe6\xaf\x29\xfc\xec\x78\xaf\x57\x1e\x69\x9f\xee\xb7\x89\xba\x2a\x41\x25\xe3\xaa\x04\x8e\x8a\x01\xa4\x6d\x4b\xee\x7c\x85\x81\xe2\xad\x8c\x77\xbc\x3a\x6b\x45\xf2\x98\xf2\x06\x56\x96\x09\x3d\xc9\x71\x0c\x59\xb7\x9b\x9d\x8c\x01\x30\xe1\x13\x3b\x92\xb9\x78\x03\x4c\x1e\xf4\x2f\xf3\xa5\xd4\x0f\x52\xe6\x64\xd1\x0c\xae\xc1\x47\xf3\xe7\x40\x15\x56\x02\x70\xb4\x8c\x1d\x81\x81\x43\x2c\x9f\x95\x48\x5b\x66\xa4\x14\x2b\x1c\xc9\x2a\xde\xf9\xa9\x80\x89\x28\x8e\xc7\x40\x2a\xf7\x35\x49\x29\x69\x6f\x49\x64\x24\xdb\x10\xa7\x92\x8d\x9b\x12\x06\xc0\xed\x08\x9f\x86\x72\x89\xb6\xca\x69\x8c\x6b\xcf\xee\xfe\xa7\xf4\x68\x49\x74\xca\x29\x3c\x1c\xf4\x85\xca\x8d\xd7\x9e\x62\x71\xf1\x01\xbf\x33\x40\x87\x2d\x5a\xda\xf9\x8f\xbc\xc3\x5e\xf4\x87\x63\x8d\xa4\x91\x36\x09\x49\xd0\xc4\x3f\x02\x8e\xba\x45\x49\xed\x01\x74\x00\x5e\xc7\xf6\xd1\xc3\x7f\x1d\xdb\x47\xbe\x35\x37\x84\x8e\xcd\x03\xa7\x4b\x49\xc7\xe6\x81\x97\x3a\x4b\x74\x5c\xea\x8c\x03\x61\xfc\x95\xd3\x39\xd0\x71\xed\xbd\x71\xc9\xe9\xfa\x99\xe4\x37\xf6\xf5\x75\x7a\xa7\xb2\x5d\xf2\x8b\x8c\x7c\x16\x93\xc6\x9d\x8c\xde\xa7\x31\x5f\xd0\x5c\x8a\xb5\x72\x98\x54\x2f\x86\x49\x54\xca\x0e\xc4\x3d\xbb\x3e\xe2\xed\x56\xaf\x4a\xfd\xd2\xac\x09\x35\xca\xc3\x8b\x5c\xc7\xc1\x5b\x13\x15\xd0\x71\x3d\x81\xdf\xb8\xf7\x70\xc8\x8d\xc4\x33\x63\x76\xb9\x82\x41\xd7\xd3\x1a\x7d\x85\x24\xe6\xbb\xe4\xc7\x57\x4b\x6a\x14\xc2\x01\xde\x04\xc8\x0b\x65\xc7\x97\x3a\x36\xa4\xe3\xf0\xb5\x05\x35\xd2\xf1\x49\xda\x09\x5e\xa2\xe3\x46\x0a\xbf\xf1\x09\xe1\x24\x35\x53\xcf\xcc\x92\xcf\x16\x4c\x53\x23\xb1\x31\x64\xc8\xc7\x82\x8e\xf9\x89\xaa\xa7\x35\x8a\x99\x99\x0a\x11\x2a\x5b\x00\xdf\xa8\x42\x3f\x59\xb5\xf7\x13\x8c\x4d\xc7\x8d\x94\x00\xe5\xd2\xb1\x7b\xae\x23\x5f\x3a\x0e\x5f\x4f\x2e\x51\xdd\xbc\x44\xb9\xd5\x64\x1a\x90\xc7\x4d\x9c\xf1\x00\x71\xd3\xf1\x29\x36\xd7\x40\xe3\xb4\x65\xb8\x9b\x77\xc2\xdb\x5c\x83\xfe\xad\x86\xd1\xd9\x2f\x88\xdf\x05\x7a\x03\x6f\x6a\x50\xb6\x03\xbe\xe3\x64\x09\xac\x76\x39\x06\x0d\x37\xcb\x58\xbf\x4e\x48\xbd\xad\xf9\xa5\xef\x01\x19\x98\x84\xf6\xfc\xab\x6f\xe3\x5f\x01\xcc\xbd\xf8\x5a\x46\x93\x29\x47\x30\xcc\x8e\x70\x23\xfd\x2c\xc5\xdf\x3e\xc3\x24\x44\x22\x8c\x3c\x3e\x00\x74\x0d\xd1\x0c\xcb\x92\xca\xd2\x4a\xbf\x9c\x01\x3f\x57\x4c\xa6\x48\x95\x7e\x6c\xda\xed\x36\x9d\x21\x50\x0d\x28\xb3\x74\xf8\x2e\xe8\xbf\xc4\x01\xca\xeb\xae\x94\x9a\xd8\x3a\x8f\xf5\xb9\x8f\x60\x49\xe8\xf1\xcf\xb6\x1c\xa1\x21\x61\x21\xbe\x06\x53\x90\x7f\x02\x07\x97\x71\xff\x96\xd6\xde\x4a\x20\x3a\x21\x54\x87\xc7\x62\x83\x71\x51\x55\xff\x6a\xcc\x8c\x1f\x13\x8d\x07\x50\x2d\xdb\x1f\x90\xb9\xfb\x2e\x51\xed\xb5\x7a\x03\x94\x13\xa7\x0c\xe6\xe5\xb4\x6a\x9e\x06\x83\xb1\x84\x6f\x28\x5c\xb0\x68\x3e\x91\x11\xc9\x64\x8a\xf6\xc8\xc8\x4c\xc5\x69\x4a\x26\xd3\xe3\xe8\x6b\xb0\xa7\x30\x39\x60\xf4\x8a\x9e\x11\x5d\xc4\xc6\x40\x21\x11\x68\x6f\xc6\x31\x2b\x56\xc1\x55\x9c\x9d\x64\x30\xdf\xb1\x66\x53\x93\xc7\x3b\x1b\x15\x91\x22\x74\x09\xc4\x32\x58\xa9\x01\xa3\xd1\x61\xa4\xbf\x6a\x7a\x1d\x22\xfb\xd8\xec\x12\xd6\xfa\xf9\xfd\x18\x4c\x2e\xec\xcc\x10\x29\xb6\xf5\x07\x95\x23\xb9\xb5\x38\xfb\x15\x0c\x8f\x02\xa4\xb9\x2d\x4b\x68\x78\x5c\xc2\x96\xc3\x89\x03\x6d\x10\xff\xa6\x6a\x6f\x29\x6b\x53\x88\x27\x9c\x87\xd7\xcc\xbb\x21\x50\x06\x45\xc7\xe0\x33\xb1\x72\x83\xe4\x0b\xb1\xf2\x01\x6e\xec\xa3\xf8\x87\x8c\x66\x8c\xaf\xe1\x5b\x8a\x87\x99\xdf\xc1\x33\x90\x34\xfc\x5e\xec\x09\x2d\x5a\x34\xd0\xa2\x05\xa1\x45\x47\x7e\x0b\xd1\x65\x16\x68\x9a\xbe\x88\x77\x47\xbe\x15\x7b\x04\x60\xc9\x82\xe4\x58\x46\xf2\x96\x2c\x48\x72\x78\xe4\x3b\x53\x02\x01\xfc\x0f\x58\x0c\x1f\x7f\x3c\x02\x8e\xbc\x26\x1c\x9a\xed\x97\x31\x81\x6e\x61\x68\x87\x1b\xe0\x94\x7e\x82\x99\xdb\x89\x2f\xe5\x64\xe6\x58\x34\x53\x5c\xbe\x68\xc5\xd7\x7c\x15\xdf\xa0\x26\xa7\xc5\x7d\x8d\x44\xe1\xa1\xae\xac\xbf\x6e\x92\x1a\x0f\x66\x5b\x46\x4a\xac\x51\x5d\x9f\xc2\xdc\xb9\x78\x77\xbc\xb0\x0f\x95\x7d\xc8\xec\xc3\xc6\x3e\xcc\xed\x03\xf8\x70\xb2\x11\x50\x4a\x1f\xde\xe9\x70\x50\xe4\x4f\x09\xbd\x04\x19\xdb\x63\x30\x18\xaf\xdb\xc8\x92\x2f\x27\x54\x10\x68\x50\x9d\x15\xb3\xde\x38\x32\x59\x22\x07\xbd\xee\xc0\x0a\xe9\x86\x2c\xae\x7b\xa7\x8a\x18\xdf\x5b\x5a\x7e\x8c\x0e\x69\xf1\xe5\x75\x51\x62\xc1\xa8\xe0\x15\x4b\x3a\x1d\x4e\x74\x71\x39\x2e\xcf\xe7\xc1\xa3\x9f\x6c\xc6\x9d\x4e\x6f\x73\x3e\x17\x52\xd5\xc9\x1c\x72\xcd\xcf\xe7\xc2\xe1\x27\x45\xdd\x13\x5e\xc5\xb7\x89\xa2\x8d\x89\x3b\xca\xbd\xec\x40\xc9\x68\x46\x3e\x5d\xba\xdd\xe8\x41\x3c\xd0\x4b\x9d\xa9\x4d\x87\xca\xe6\x8c\x34\xbf\x03\x60\xc3\xf8\x2c\x56\x5a\xde\x7d\x28\x4a\x4d\xa5\x4f\xec\x43\xdd\x95\x7c\xe1\xf3\x42\xb2\xad\x81\x40\x6d\xa8\x10\xa5\xd9\xfe\xc6\xec\x9d\x59\x00\x9b\x3c\x37\xc1\x6e\x4c\x0d\x1b\x13\x15\x0f\x19\xe3\x9f\xce\x94\x70\x64\x7e\x5b\x29\x00\x98\x4b\x73\x03\x9a\xb8\x53\x78\x53\x46\x0f\xfc\x0e\xd2\xfd\x65\x63\x3e\xfa\x4b\xcb\xe5\xf0\xdf\x82\x54\x07\x45\x4d\x31\x07\x8b\xed\x77\x03\xe4\xcc\x47\x03\x0e\xed\x97\xad\xd8\xc5\x5b\xbe\x8c\x77\x62\x17\xef\xf8\x32\x0e\x79\x67\x62\x56\x7b\xe5\xcb\x60\x46\x2a\x71\xc3\x97\x8d\xf1\x56\xe2\x13\x5f\x22\x0f\xc5\xd0\xb8\xe2\x81\x6f\x45\xeb\xa5\x48\xd3\x01\xf1\x15\xb8\x12\xd7\xcf\xa4\x27\xdf\x0c\x47\x01\x94\xe9\xe7\x3b\x74\x4d\x5f\xca\xf9\x66\x26\xcf\xac\x30\x58\xca\x99\x49\x23\x58\xd0\x33\x4e\x91\xac\xef\xf4\x1e\x81\x70\x7a\x3d\x42\xa8\xd7\x51\xd1\x73\x77\x03\x4c\x6d\x23\x6b\x90\x84\x40\x07\x2c\xc0\x70\xbd\x28\x91\x67\xfe\xd6\xa0\x94\x8d\xcd\xe2\xd0\xed\x39\x8d\xc0\x25\xac\x5c\x21\x9b\x84\xec\x8d\x67\x1b\x0e\x3f\xe3\xa8\xea\x0f\xd9\x33\xaa\xc5\xe2\xdd\x03\xf3\x4d\x9e\x92\x22\xe6\x53\xf1\x6c\x8e\x3f\xe3\xa8\x30\xa5\x43\xe2\x0e\x73\x64\x63\xd9\xc4\xcd\xe9\xc3\xb3\x15\xfe\x8c\xa3\xcc\x14\xad\x63\xfc\xc6\x07\xa6\x09\xc5\xe4\xe7\x9d\xed\xad\x27\xe6\x74\x1b\xa5\x3c\x8f\xef\x64\x5a\x6d\x4a\xd4\x47\x02\x09\x29\x5e\x13\xbd\x99\xd7\xe6\xcc\xe3\x45\x91\x83\xe7\x49\xf8\x31\x0c\x81\x68\x03\x2a\xa6\x27\xb4\x5f\x93\xb8\x74\xa7\x94\x06\xcf\x17\x8c\xb7\xd5\x36\x87\xb2\x6e\xa2\x6d\x65\x36\xe1\xa4\xae\x60\xd1\x49\xc0\x16\x2c\x3a\x83\x46\x66\x42\xd6\x89\x84\xf1\xbc\x77\x9d\x0c\x6c\x15\x65\x1d\x68\x50\xaa\xa6\xdd\x04\x15\xb8\x24\xdc\x86\xb5\x14\x6c\x89\x2f\x00\x0a\x71\x98\xdb\xb6\x01\xad\xa0\xff\xc1\x46\xb1\x43\xf2\x49\x27\x83\x32\x9f\xa0\x25\xba\xb0\xd3\x1e\x1e\x29\x4b\x4d\x58\x95\x24\x05\x5a\x39\x80\x4c\x2c\x19\xbf\xad\x9d\xcb\xd0\xc9\x0a\x7a\x8f\x02\xfe\x2a\x5a\xaa\x7b\xe2\xad\xb2\x6f\xb6\xd6\x4c\x34\x48\xb8\x8d\xc8\x2d\x72\x31\x17\xb9\x65\xb7\xac\x44\xd1\xab\xf8\x4c\x64\xbd\x8a\xb6\x85\x27\x87\x36\xe3\xb4\x6f\xd5\x64\x92\x80\x80\x82\xb0\x5c\x91\xff\x74\x75\xcd\x7a\xf4\xf8\x42\x99\x5f\x14\x5e\xd2\x73\x9f\xbe\x31\x9e\x3e\x07\x17\x16\xa9\x00\x7b\x61\xb4\x35\x12\x42\xcc\xc7\x65\x4f\xac\x92\xb2\x2f\xac\x69\x11\x26\x5a\x45\xa8\x1e\x78\x92\x32\x8f\x57\xd7\x3c\xe8\xc3\x7c\x6c\x64\x0c\xd0\x9d\x71\x0a\x55\x04\xfd\x86\x56\xfa\x62\xc5\x48\x0e\x61\x87\x32\x6b\xe6\xe9\x89\x19\x5c\xdb\x49\xca\x77\x10\x44\x37\x5a\xf2\x2d\xbf\x6f\x03\x89\x1e\xef\xf3\xae\xb5\x32\x07\x27\xf9\x26\x00\x99\xce\x06\x69\x2e\x6c\x77\xf9\xca\x3d\x8e\xaa\x78\xf7\xdc\x8e\x68\xbc\x12\x38\x0d\xe0\x33\xf0\x45\x46\x89\x7d\xfb\xb5\xdb\x8d\x56\x6e\x52\x18\x9d\xf8\x68\x63\x2c\xa1\x36\x64\xa7\x7f\x75\xcd\x17\x22\xda\xc4\xba\x58\xf7\x36\x64\x0d\xcc\xae\xae\x47\xb6\x3d\x08\x79\x36\x8e\xf2\x60\x4c\x1e\x36\x3f\x17\xb3\x23\x57\xa2\xe5\x5a\xd7\x2f\x66\x47\x96\x9c\x2d\x46\x0b\x7a\x75\x7d\xb6\xb8\xc8\xea\x6b\x8f\xd6\x7c\x69\x6b\x5e\xbb\x75\x7a\x95\x67\x45\xf4\xaa\xda\x5e\x7e\x41\xb5\x81\x4a\x76\x5b\x15\xb6\x95\x7e\x50\x45\xbf\x5e\xc5\xf3\xc1\x91\x17\xad\x85\x9f\x8b\xc5\xd8\xac\x82\x9d\xeb\x23\xcf\xa3\x2a\xde\xb2\x71\x34\x17\x24\xc8\x4a\x31\xa1\xdb\x8d\x6a\x8b\x5a\x44\x55\xbc\x63\x8c\x25\x2a\xf8\x8c\xeb\xd2\xe1\xe5\xa3\x25\x70\x35\x97\x01\x65\x44\x67\xcf\x92\x01\x4b\x3a\xa9\x63\xfb\x90\xcc\x2d\x45\xb0\xa4\x93\x3b\xb6\x0f\xc9\xca\x02\x8e\x2d\xf3\x98\x8c\xd1\xe5\xf2\xb8\xbd\xf3\x3d\x64\x6b\x14\xf7\xf4\xc0\x6d\x45\xe2\x9e\x1e\xf8\x32\xde\x8a\x5b\xc2\x3b\x6e\x11\xef\xc0\xf9\x17\x5b\x22\x35\x96\xb4\x45\xc5\x96\x1e\x2c\x66\xf2\x03\xa1\x2b\xb8\x0c\x3f\x22\xce\xe2\x08\xa0\x25\xa8\x54\xcd\xc8\xad\xa7\x7f\xb2\x58\xd8\x92\xf1\x95\x09\xa1\xf5\x0a\x0a\x9f\xa5\xd6\xdd\x41\x43\xdc\x04\xe8\x77\x23\x08\x0a\x7c\x22\x62\x05\x8e\x2d\x0f\x00\x54\x81\x0d\x06\x40\xfc\x8f\x45\x94\xc6\xdb\x21\x4f\xe3\xdd\x90\xf1\x30\xed\x1a\xd2\xae\xeb\x69\x7f\x84\xb4\x3f\x1a\x22\xb7\x56\xe9\xe7\x74\x71\x10\xd8\xba\xbd\x88\xf0\xb6\x06\x8b\x57\x1e\x16\xcf\x3c\x2c\x06\x37\xfb\x5b\x0e\xdb\x62\xc7\xd7\x16\xba\x72\xb0\xfc\x37\xb3\x0c\x74\x9b\xdd\x4c\x42\x88\x19\xab\xc4\xb2\x77\x07\x70\xd1\x82\xb9\xd5\x38\x4a\x05\x58\x57\xb0\xfe\x06\x22\x04\xf0\x42\x54\xbd\x0d\x98\xb2\xf4\x37\x2c\xa1\x6f\xbd\x35\xeb\xb9\xaf\x7d\xfc\xda\xdb\x50\x5c\x3d\x68\x21\xa8\x4c\x89\x28\x15\x8b\xde\xbc\xb7\x31\x15\xa6\xbd\x0d\x0b\x80\xa8\xcf\xb1\xee\xcf\xfb\x61\x1e\x93\x4e\x73\xf0\x83\xff\xe0\xe0\x3e\x74\x3e\x2a\xc4\x12\x3e\x65\xa2\xc0\xd6\xf7\x26\xa9\x77\x07\xfd\x83\x44\x38\x25\xf7\xa2\x1c\x41\x5f\x95\xb8\x77\x32\x95\x61\xa2\xf8\xf6\x3a\x49\xf9\xf6\x8f\x49\xc9\x77\xc3\xa4\xe0\xbb\xeb\xa4\xe2\xbb\x3f\x26\xd9\xd1\x6c\xa2\x06\x8b\xec\x74\x99\x2c\x4e\xc8\x2b\xef\x8e\x52\x2d\xa2\xca\x0a\x93\xbe\x05\x47\x64\x20\x86\x00\xf7\x13\xb4\x16\xc6\x11\xae\x8e\xb7\xe2\x8d\x8c\x3c\x5e\x84\xab\x07\x3b\x07\x24\x14\xf8\x22\x32\xff\x1c\xb5\x66\x83\xd0\xb4\xb0\xd1\x44\xe7\x4e\xcd\xe7\xe0\x54\x59\xd9\x1e\x39\xa4\x34\xb5\x29\x84\xf7\x01\x8a\x65\x83\x01\x85\x99\x11\x13\x6a\x45\x57\xd4\x13\xb1\x39\x90\x11\x96\x18\x46\xad\x64\xa6\x11\x44\x1a\x8b\x49\x39\xe5\x59\xbc\x8d\x74\xbc\x05\x55\xf6\x5d\x4f\x5d\x5d\x9b\x07\xa1\x7a\x29\x2f\x7b\xa0\xd8\x54\x81\x9a\x49\xbc\xeb\x89\x16\x4c\xb8\x9f\x32\x5a\x90\x3a\xef\xe9\xdc\xb1\x41\x96\x4a\x03\x37\x9f\x11\xb2\x6e\x67\x61\x21\x08\x87\x24\x60\x45\x9f\xf9\xba\x89\x1b\xf2\x3b\x31\xe0\xf7\x62\x3d\x7e\x13\xd8\xec\x83\x83\x8b\xf6\xc5\x0d\xa9\x21\x50\xba\xf5\xf3\x20\xf9\xad\x99\x84\xde\x9d\x19\xfd\xca\x4d\xc3\xaa\x37\x03\x4e\xcb\x6d\xb0\xde\x0b\xb3\x4d\xc2\xed\xb0\x38\xbb\xea\x67\x91\x4c\x37\x05\x76\xd1\x6c\x82\x5d\x33\xb7\x0d\x77\xac\xb1\x31\x6c\x4e\x9c\x85\x36\xec\x9a\x6f\x19\xbf\x13\xeb\x6e\x97\x8e\xf2\xa5\x10\xbb\x71\x00\x59\x16\xe3\xd5\xd5\x75\x6f\x98\xac\x10\xb7\xae\x04\xb0\x5f\x96\xf6\x90\x54\xa8\x3f\x58\x19\x2f\x15\x4a\x2c\x27\x15\xb0\x72\x64\x93\xd8\x84\xe4\xd2\x26\xfb\xa4\xb0\xa7\xa9\xed\x9b\x65\x8a\x42\xbf\x32\x31\xe0\x73\x80\x03\xca\x50\x8a\xce\xd9\x43\xf6\x7c\x3e\xea\xf5\x32\xb4\x3b\x59\x9b\x53\x7a\x03\xb7\x54\x74\xcf\x46\x61\xad\x32\x6e\x15\x40\x50\xcb\xdf\xcb\x99\x8e\x6e\x11\x89\x7a\x5d\x94\xdf\xe8\x32\xba\xe1\x2b\x98\xcc\x1d\x5f\xc1\x83\x01\xff\x28\x4b\x10\x43\xb0\xa4\xf3\x11\xb6\x84\x8d\xa4\x65\xcf\x9b\x89\xbe\xf5\xf9\x1a\x7d\xdf\x4e\x23\x78\x9d\xeb\xd5\x6d\xbc\x7d\x9f\x6d\xaa\xe8\x86\x0f\x19\x5f\xf5\x69\xbb\x0d\xe1\x11\xfe\xd5\xab\x4d\x8f\xdb\xa8\x98\x64\x53\x76\x74\x13\x6a\x88\x99\x2d\x3b\xc2\x19\xa0\xd4\x80\x98\x82\x99\xd6\xf1\xae\x0f\x28\x20\x9c\xce\x26\x0b\xb7\x79\x3e\x1d\x79\x8c\x34\x3f\xad\x88\x5a\x44\x85\x55\x10\x78\x1a\xb8\x0c\x84\x58\x74\x7e\x4e\x08\x60\x3a\x29\x98\x47\x54\xfe\x39\x6a\x16\x7f\x0c\x8e\x06\x14\x1a\xdc\xbf\xe1\x5c\x85\x1f\x3f\x03\x38\x83\x5a\xec\x21\xf4\x49\xf6\x18\xa6\x62\xe0\x9c\x71\x06\x20\xa3\x9c\xa4\x53\x5e\x9d\x03\x9d\xb6\x13\x04\xd2\x2c\x88\x74\x7b\xa3\xb1\x10\x40\xfe\xd5\xc7\x70\xba\x8d\xc2\x8d\x2a\x1b\x1b\xd5\x6f\x69\xfb\x05\xdf\xc8\xec\x53\x5a\x54\x04\x8e\x2b\x01\x55\x20\x2e\xb7\x48\xed\xec\x90\x53\x8a\x10\x12\x1d\xa3\x13\xc2\x07\x1c\x94\x10\xa5\x19\xe5\x31\x86\x52\x7c\x9f\xea\x55\x04\xbb\x13\x94\xd4\x3f\x16\x51\xd1\x9b\xf3\xca\x5f\xfb\x65\xb7\x8b\x28\x9b\xc3\xf3\xd0\x90\x83\xba\x88\xd9\xb3\x3e\x16\xc8\xe3\x9f\x37\xe9\xbc\x4c\xb5\x9a\xa1\x13\x68\xf3\x8d\x57\x1c\xff\xf6\xe6\x2c\x24\x06\x4b\x07\xc9\x8c\xe6\xec\xe7\x9a\xe0\x55\x6f\xd3\x9f\x9f\x6f\xa4\xb7\xe1\xd4\x11\x44\x59\x88\x2e\x78\x52\xf7\xa9\x4c\x5b\xcd\xf0\x05\xee\x39\xd3\x76\xa3\xfb\x16\xe9\xfa\x6c\xef\xcd\xe0\xdb\xeb\xe7\x34\xdb\x39\x28\xcb\x56\xd2\xad\x05\xec\x9c\xa8\xe1\xfc\x18\x14\xce\xec\xae\x89\xce\x05\xaf\x3d\xc1\xb8\xad\x0a\x3e\x60\xdc\x80\x8e\x82\xcd\xb6\xb4\x44\x86\x45\xd3\x89\xcf\x41\x50\xc0\xf2\x37\x2c\x16\x0b\x44\xe3\x7e\x9b\xc0\xcd\xbb\x4b\x24\x88\x32\x88\x6d\x05\xbe\x52\x5d\x5d\xcf\xe3\xc1\x60\xc8\xc6\x83\xc4\xa5\xf0\xb2\xc1\xe9\x3b\x1c\xc2\x3b\x2d\x4c\xac\xbf\x3a\xa0\x17\xa4\xd5\x18\x84\xa3\xba\xf4\x8b\xb4\x42\xbb\x5d\x63\xbe\x55\xa5\xf7\x12\xcc\xa0\x49\xdf\xe5\x65\xb6\x5e\xa5\x22\x25\x0d\xe5\xda\xa9\x45\xcb\x15\xb0\x39\xe2\x0a\x8e\x79\xc0\x35\x35\x7a\xa9\xe0\x14\xa3\x54\x73\xf9\x31\x54\x64\x01\x7e\x69\x5d\xb5\x85\xe4\xbe\x0e\x8b\x35\xd5\x06\xa9\x30\xdc\x93\x44\xe2\x1b\x53\xb2\x69\x10\xd4\xae\x8a\xf2\x09\xed\xd9\x9c\x11\xf8\x3e\xe1\xab\x34\x9f\x67\xc6\xb8\xa6\x4d\x08\x76\x2a\x34\xb5\xb4\x65\x5e\x13\x56\xd7\xde\x40\x41\x9e\x7b\x9f\x0d\xa0\xbd\x8f\xb6\x4b\xe3\xdc\xca\xc3\xc4\x64\x9a\x44\xfe\x2d\xb7\xdb\xce\x9b\x35\x56\x2f\x35\x76\xea\x75\x51\xbe\x03\xfb\x4a\xcd\x55\x0c\xbc\x18\x38\x82\x2a\x2e\xe5\x3d\x78\x53\xed\x76\x5d\x1d\x36\x09\xcc\xed\x23\x29\x2e\xdf\xc4\x29\x68\x56\x7d\xfd\xf3\x26\xcd\x2a\xdf\x14\xaf\x75\x14\xdc\x77\x44\xb5\x14\xe1\x73\x46\xca\xee\x0e\x10\x33\x19\x02\x96\xf2\xd7\xe4\x61\xa2\xe6\x46\xda\xfb\x98\x01\x37\x50\xb9\x8d\x87\x01\xb6\x68\xe0\x2e\xfb\xef\x52\x7c\x29\xf9\x8f\x52\xfc\x2c\x47\x3f\x7a\x25\x69\x59\x56\xe2\xef\xc6\xb4\xe9\x2f\xa7\xda\xce\x5e\x9b\xec\x07\xe9\x25\xb9\x8f\x6a\x9b\x4f\xcf\x98\xaa\xef\xb1\x4c\xf3\xf2\x87\x3b\x07\xa8\x41\xf4\x53\x0e\x90\x09\x5c\x29\xec\xdc\x0b\xf1\x23\x89\x29\x06\x96\x7c\xf6\x30\xc1\x9d\x0f\x8e\x0f\x0f\x07\xfc\x41\xe7\x6f\xe6\x92\xac\xf0\x92\x2c\xd0\xee\x0f\xae\xc7\x52\xfc\x45\x46\x05\x6e\x03\x1e\xb4\x33\xee\xcc\x52\x2d\x97\x45\xb9\xeb\x38\x97\xe6\x8c\xa7\x2f\x84\xf4\xad\x74\xbb\xf8\x82\x12\x1e\x30\x78\xbf\x84\xd7\x49\x3a\xc5\xca\x0e\x87\x82\x42\xff\x9b\x5f\x80\x51\xc1\xe7\xb1\x9d\x25\x4a\xe4\x93\xef\x65\xdc\xd4\xf5\x87\x80\x29\xc5\x94\x25\xcd\xbc\x05\x19\xa6\xbd\x89\x6f\xf0\x43\xe9\x66\xeb\x78\x0c\x6c\xcf\xff\xf6\x5f\xb7\x28\x74\x69\xe3\x0c\xb7\x55\x59\xe2\xfc\x8e\x3a\x46\x45\xdf\xcc\x28\xf4\x5e\xfc\x20\xa3\x94\x97\x2c\x31\x5f\x82\x0f\xb6\x83\xe9\x99\x99\xc0\x59\x63\xbc\xc4\xe9\x78\x74\xd8\x3f\x85\x26\xf7\x56\x8f\xdf\xba\x15\x68\xb7\x1a\x58\x5b\x6b\xcf\x40\xc1\x9f\x4b\xf1\x37\x6f\xf4\xc2\xbf\x9d\x58\x9b\x1d\xec\xc9\x94\x4b\xc6\x5d\xed\xc2\x7d\xb3\x09\x92\xeb\xd8\x18\x21\xe3\x38\xaa\x37\xe9\xbd\x7c\xfb\x15\x04\xa9\xd1\xf1\xed\x46\x65\xf3\xef\xca\xbf\x7b\xcb\x0a\x93\x4e\xba\x9c\x0e\xa2\xc1\x55\x43\x66\x02\xc1\x57\xaf\xc3\x13\x05\x83\xfe\x43\xd3\xa5\x48\x2b\x9f\x11\x6c\x6d\x84\x50\xc7\xd1\xbc\xd8\x2b\x21\x7b\x10\xc6\xee\x61\xa5\x32\x19\xbd\x89\x17\x2a\x9f\xa3\x64\x16\xd4\x17\xd8\x0b\xe1\x7d\x74\x29\xdf\xcc\x5f\x03\x5d\x23\x8b\x4e\xc1\x99\xf4\xd8\x49\xa0\xa9\xf9\xef\x75\x15\x53\x9b\x1e\x85\x5e\x73\x61\x9b\x80\x0d\xf2\x44\x4f\xc7\xf9\x44\x4e\xfb\x6a\x22\xa7\x09\xa4\xf6\xc1\x8d\xe9\xf1\xd4\x71\xa4\x0f\x2d\x76\xe4\xe4\xc9\x69\x12\xba\xf2\xf1\xf0\x9d\x77\x66\x10\x1f\x18\xac\xe6\x9d\xa3\x21\xfb\x82\x79\xa7\x9c\xc2\x23\x14\xf9\x1b\x7c\x68\xb3\xbc\xa8\x99\x59\x38\xaf\x15\x5f\x6d\x00\xef\x29\xf2\xe4\x4f\x83\x81\x8f\x44\x6c\xca\x9f\x3a\x0b\x01\xe3\x80\x52\x56\xeb\x22\x07\xdf\x3b\xf5\xb7\x97\x27\x55\xda\xb0\x6c\x3a\x17\xed\x02\xcd\x9a\xbd\x10\x7e\xc2\x7b\x39\x8c\x37\x99\x7b\xd5\x5d\xee\xad\xa3\xce\x32\x34\x47\x2d\xbe\x0f\x05\x58\xe6\xb7\x1e\x6f\xa3\x04\x4f\xee\xd5\x43\xfb\x34\xaf\xc7\x1f\xa8\xf4\xe3\xad\x4b\x34\x78\x65\x89\x71\x93\xea\x0f\x50\xf3\xa8\x41\xc7\x83\xef\xd0\x08\x28\x1a\x47\x92\x91\xff\x84\xef\x64\x5c\x37\x6d\x35\xf3\x90\x0a\xe3\x63\x05\xad\x69\x81\x11\x08\x16\x75\x44\x2e\x14\xe6\x0d\x11\xc2\x51\x0e\x67\xe1\x4d\xbc\x51\x73\xc4\x4e\x67\x7a\x2b\x14\x77\xae\x04\x40\x72\x69\x4e\xbb\x00\xca\x8d\x1c\xa9\x82\x1f\x4b\xac\x4a\x00\x41\x13\x98\xc0\x8b\x72\x5c\x5c\xd1\xf6\x71\x5e\x63\xbc\x11\x11\x5c\xee\xb7\x9b\xc5\x42\x96\x72\xfe\x3d\x7a\x44\x01\xd7\x2a\xb9\x73\x1f\x3a\x01\x96\x04\x22\x1a\x22\xf7\x61\x58\x32\x59\x8a\x9c\x6b\x30\xd9\x33\x1a\xd3\xd5\x04\x3a\x3d\x15\x39\x6f\x77\xef\x94\xf3\x0e\x4c\xff\xa9\x6b\x27\x5a\x1d\x3b\x24\x5c\x99\x23\xaf\x3b\x91\x67\xfb\xda\x67\xa1\x01\x2b\x50\xdd\x6e\x3a\x8e\x72\x6b\x5a\x8f\x30\xc7\x23\x12\x8c\x25\xb0\xb3\x8a\x4c\xc6\xb2\x2c\x8b\x32\xea\xbc\x4e\x55\x26\xe7\x17\xba\xb8\x30\x17\xcc\x05\x0e\x2a\xb9\x98\xa5\xf9\xbf\xe9\x0b\x5a\xaf\x0b\x50\x6c\x91\x5b\x7d\x01\xbe\xe8\x2e\xf4\x4a\x5e\x2c\xd5\xbd\xcc\x2f\x40\x0f\xa3\xc3\x8e\xdc\x37\xd6\x4e\x0a\xd8\xcd\xf6\x8d\x8c\x8d\x51\x2a\xb8\x1b\x30\x38\xf8\xdb\x5c\x81\x47\x4c\x30\xcd\xd6\x2a\x4f\x11\xf4\x46\xda\x6f\xa3\x78\x2e\xef\xd5\x4c\xbe\x57\x5b\x99\xe1\xc2\x01\xd8\x05\xef\x40\x88\xd2\x19\x28\x6c\xb3\xfa\x13\x0a\xfa\xe9\xc6\x9c\x1c\x71\x27\x50\x56\x57\xfa\x63\x51\x64\x1f\xd5\x3a\x62\xbc\xd6\x0f\xc4\xf3\xa9\x1b\xba\xc5\x7c\x97\xfa\xfe\x86\x36\x5a\x8c\x19\x50\x72\x62\xd0\x68\x08\xe7\x5f\xac\x5b\x4a\xfc\x15\x4a\xcc\x64\xe6\xc0\x45\xad\x90\xe9\x5e\x72\x7a\x86\x29\x22\xa6\xdf\x8a\xc0\x99\x30\x6d\xa3\x9e\x65\xab\x67\x23\x59\x77\xef\x80\xdb\xba\xf4\x62\xfd\x01\xc7\xc7\x45\x56\x14\x65\x84\xfe\xcd\xde\xa5\x5b\x75\xb7\xb9\x43\x82\x1e\x34\x2d\x41\xb9\xb2\x3d\x7b\x3a\x2e\xaf\xd2\x24\x2c\x64\x9c\x6d\x62\x29\x20\xe6\x22\xa2\xd8\xc0\x61\x04\x10\x4e\xe6\xec\x5e\x0a\x51\x00\x8e\x4b\x4c\x00\x2b\x97\x10\x25\xb7\xcc\x00\x27\xa0\x10\x05\x57\xe4\xc7\x82\xf2\xf4\x3a\xeb\x6d\xc7\x25\xda\x5c\x26\xb5\xbe\x55\xe0\xac\x9f\x6e\x91\x4b\x6b\x2b\x5b\x59\xaa\xb2\xb4\xf4\x64\x71\x1c\xf9\xe5\x97\xdc\x7a\x1d\xe0\x93\x6a\x0a\x50\xa5\xc8\xbf\xc7\x84\x6e\xd7\x3f\x47\x12\x58\x0b\x32\x86\x75\x46\x7a\x98\x4e\xd3\x7e\x6e\xe1\x7f\x1e\x3f\x72\x3b\x1c\x81\x38\x6a\xc1\x2c\xda\xcf\x8a\x5b\x76\xd0\x80\x34\x98\x0f\xf9\x11\xa2\x57\x8b\x17\x01\xff\x65\x5b\x43\x8b\x80\x86\xdc\x83\x6d\x30\x5a\x3f\xab\xb9\xf8\x83\x74\xb9\x28\xa2\x43\xdf\x78\xa9\xf2\xfa\x52\xc0\xba\x79\x4a\x2d\x26\x57\x67\xd7\xa8\x05\x4d\xbb\x00\x2e\x03\x9c\x3b\x1c\x08\x47\x64\x47\xde\x82\x31\xb5\x8f\x97\x07\x28\x9f\x1f\x24\xda\x50\x5b\xeb\x6b\x82\x9d\x9f\xe4\xae\x8a\x72\xf6\x19\xd5\x21\x08\x89\x7e\x39\xe4\xfa\xc8\xf8\xfe\x08\x1e\xad\xb1\x4b\x20\x6c\x50\x10\x96\xd1\xe8\x87\x44\x36\xd9\xcc\x21\xdc\x6c\x2c\xbe\x4b\xd7\x61\xa5\xb6\xca\x3d\x75\x2e\xd1\x7c\x0e\x77\x5c\xe2\xe9\x0c\x3e\x3f\x0d\xb5\x04\xb3\xe2\xab\xdf\xfd\x86\xea\x89\x76\x09\x2b\x47\x36\x0f\x18\x63\x03\xf1\x67\xea\x86\x3b\xd3\xd0\x31\xb6\x02\xfa\xc0\xe7\x2d\xf1\x5d\xb9\xaa\x08\x31\x07\x54\x26\xa8\xd9\xb9\x34\xed\x1c\xdd\x9e\x50\xc1\x6e\x20\x9c\x23\x0c\xdc\x0e\x5a\x93\x6a\xce\x0b\x20\xc3\x14\xa2\xd6\x60\x87\x00\xbf\x6c\xf4\x57\x48\xb2\xb5\xb3\x4b\x21\xfe\x0a\x9b\xc7\xb5\x67\x00\x82\x7d\x13\xe1\x17\x9e\x4e\xca\xa9\xb8\x1c\x90\x76\x16\x40\x30\x00\x2e\xe5\x85\x42\x03\xc2\x7c\x52\x1a\x2a\x4d\x00\x5c\xc1\xf0\x45\xe5\x94\xd9\x3e\x09\xc5\x2b\x44\x08\x8c\x80\x97\x7c\xba\x0a\xed\x9d\xdc\x64\x22\xa0\x51\x02\x0b\xf0\xa8\x40\xcf\x40\x97\x19\x59\xc2\x8d\x2a\x91\xcb\x87\x8b\x2c\xda\xab\x79\x52\x9a\x00\x59\x85\xb3\x8b\x57\x7c\xa6\x81\x44\x87\x46\xb0\x89\x04\xf6\x59\x3e\xa9\xf0\x96\xaf\x8e\x95\x21\x89\x3e\xaa\xd9\xa7\x8a\x62\x03\x22\xc0\x70\xb3\x6f\x58\x43\xb0\x7e\xa2\x0a\x8f\x61\x5a\x3b\x80\x60\xa2\x7d\x38\x90\xe5\x3c\xe0\xd8\x90\xd3\x9e\x0c\x91\xf3\xef\x65\x7c\x62\x70\x6d\x2e\x97\xc6\xc1\xa3\xa8\x80\x99\x2c\x4f\x4f\x1f\x0f\x78\x32\xa0\x7a\x0e\x8e\x8b\x52\xed\xc2\xf6\x55\x28\x8d\x32\x81\xf7\xac\xa3\x70\x0c\xbc\xa7\x7b\x3d\xab\xc2\x0e\x31\x97\x79\x21\xf2\x13\x03\x4c\x08\xb5\x54\x12\x51\xed\x90\x14\x78\x85\xb9\x3e\xa1\xb2\xe1\x70\x02\x14\x07\xae\xe2\xae\x51\x4f\x6b\xed\x10\x37\x00\xca\x8a\x8a\x17\x31\xf2\x26\x45\x69\x7e\xc1\xc9\x7b\x61\x2c\x3d\x85\xe6\x45\x80\x98\xb1\xf0\x85\xf0\x21\x22\x9e\x58\x2d\x63\xec\x03\x13\x47\x81\x97\xa4\x4c\xfc\x55\x4f\x4c\xb3\x68\x2d\xe8\x03\xb6\x32\xbd\x2a\x8b\x87\x0b\xd8\x36\x5f\x23\x52\xf5\x6f\x9d\x7f\xeb\x99\x9c\xbd\x7f\xeb\x5c\xa8\xea\x22\x2f\xf4\x45\x6a\x30\xab\x0b\x48\x8e\xff\x8d\x8d\xc2\x26\x69\xcb\xe5\xdc\xfb\x64\x08\x3f\x33\x6b\x21\x79\xa1\xc0\x8f\x8b\x0f\x3f\x79\xba\xa8\xb8\xa0\x9e\x72\xae\x2d\x68\x78\xaa\xcd\x4d\xd1\x98\xd8\x3c\xd4\x60\x8e\xad\x73\x3c\x46\x3e\x7d\x6a\xc8\x27\x99\xa0\xd4\x3a\x13\x11\x5b\xb1\x95\xec\x7d\x54\x3b\xde\xf4\x5a\x2d\x22\xdd\xed\x76\x0a\x84\xf6\x35\x13\xc4\x48\x0b\x7f\xd7\x6a\x9e\xa5\xbf\xec\x12\xc7\xf4\x98\x0c\xa7\x47\xc6\x7f\x02\x95\x7c\x40\xec\x02\x3f\x14\x90\x82\xee\x31\xfc\x85\xaf\x2c\xde\xf9\x77\xec\x4e\x87\xb1\xbd\x72\x1d\x46\x4d\x6f\xa1\x70\xc2\x48\xc8\xa1\xe2\x73\xe7\x89\xc2\xb0\x19\x9d\x75\x55\x9f\x69\x7b\x5c\xe4\xf3\x7c\x24\x7b\x3d\x16\x86\xa1\xc5\x99\x96\xb5\x99\xae\x35\xe1\x67\x73\x64\x43\xe7\xd2\x01\x87\xbd\x41\x80\x28\x76\x54\x6d\xb7\xdb\x92\x18\xdb\xc9\xea\x76\xdb\xe0\x0b\x2c\xbd\x5b\x5d\xa8\xd5\x34\x43\x1d\x04\x9c\x5a\xb5\x2e\x22\x9a\x97\x38\xf6\xe6\x64\x1a\xe0\xd1\x8a\xf0\x68\x3b\xad\x5c\x59\x52\xc9\x44\xfa\xf9\x77\x19\x75\x7e\xe9\xf0\xce\x8d\x9a\x6f\xc1\xdf\xa7\x6a\x92\x57\xe3\x5a\x0a\xfa\xbb\x0c\xd7\xdc\x8d\x88\xcb\x14\x62\x7b\x25\x3a\x36\x0f\x66\x33\xe8\x18\x7e\x8e\x89\x22\x6f\x89\xe0\x25\xc8\x1a\x64\x34\xfd\x51\xd4\x4e\x4a\x63\x7b\x38\xb2\xc4\x14\xea\xc0\x7d\xb5\x76\xf8\x1e\x94\x30\x5b\x9c\x42\xf4\xc0\x23\x79\x5e\xe3\x3a\xa4\x0d\xdd\x01\xc4\xf8\x0d\xe1\xc1\x03\x7f\x17\x14\x74\x76\x53\x82\xdb\xca\xe0\x2d\x0a\x6a\xa9\x59\xef\xda\x44\x1f\xbf\x22\x02\xf4\x8b\x87\xcd\xb6\x79\x91\x01\x0e\x84\x8e\x61\xce\x31\x1c\x53\x0b\xdd\x83\xd7\xa1\x5b\xb4\xd3\xef\x76\x1e\xdc\x01\xb6\x9b\x24\x9c\x4f\xb8\x3f\x1b\xf3\x08\x33\x45\x53\x69\x4b\xd8\x56\x7c\x9c\x0a\x73\xb9\xe0\x84\xb6\x1e\x1f\x1b\xe6\x15\x73\xd4\x9a\x8f\x34\x0b\xb0\x78\xf8\x4e\xfd\x6d\x36\xe6\x76\x01\x7d\x38\x85\x3f\x4e\xfd\xab\x7e\xc5\xf0\x5c\xec\xef\xa4\x4e\x13\x1b\xc7\x4d\x1f\x47\x9f\x1f\x24\x35\xcb\x21\x24\x00\x84\xcb\x08\x4f\xf9\x0d\xed\x22\xc6\x1f\xeb\x79\x58\x03\x82\x5d\xd8\xce\x67\x7a\x3d\xfa\xcd\xe0\x72\x64\x3d\x27\x9c\x80\x0e\xae\x00\xad\x0b\xce\x1b\xe0\x5e\xee\x0d\xb8\x35\xe6\xac\x8d\x4e\x17\x5d\xda\xc9\x30\x27\xba\x43\x24\x58\x5d\xf9\xb2\x96\x1d\x07\x6e\x73\xf3\x37\xce\xe4\x22\xc2\x46\x8b\xfc\x55\x71\xb7\x06\x1c\x88\x03\x9e\x21\x19\x5a\x20\xe5\xdd\x2e\x71\xb3\x0b\xbc\x36\xff\x10\xed\xf3\xcd\xdd\x07\x2d\xd7\x55\xa2\xae\x86\x5f\xc4\x5f\x7c\xd1\x84\x11\x87\x43\x6e\xa1\xc5\xc9\x7c\x7a\xa6\xda\x1b\xca\x13\xcb\xc5\x42\xce\x74\x35\x91\x94\x00\x5e\xa0\x64\x3c\xdb\x94\xe0\x4c\x15\x1a\x02\xd7\xc1\x57\x32\xb6\xcd\x8e\x34\x0a\xdd\xa2\x3c\x4a\x19\x4f\x81\xa5\xcf\x8b\xdc\x51\x81\xef\xcb\x62\x59\xca\xaa\x4a\xf2\xd8\xbf\x84\x19\xec\x20\x93\xf2\xc8\x46\x7f\x05\xc4\xce\x7d\x82\x60\x95\x5c\x39\xaf\x76\x26\x02\x4d\xc4\x78\x19\x35\x47\x3e\xb0\x8e\xb3\x8f\x2e\x38\xc8\x85\x24\xfd\x80\xcf\xdc\xb9\x8a\x38\x1a\x2d\xae\x39\x00\xfc\x69\x31\x04\x70\xad\xcb\x34\xb7\x9a\x91\x8c\x5f\x5a\xb2\xfe\xb9\x18\x80\x48\xcb\x00\xc1\xe7\x62\xc0\xba\xdd\x73\x37\xee\x57\x65\xfa\xd0\x81\x95\x24\x08\x00\xb7\x26\xc1\x2e\x88\x2c\x0f\xe6\x5e\x74\xf0\xbb\x5d\xc0\x79\xe3\x5f\x9e\x8b\x01\x1a\x80\xe1\x1b\x0e\x5d\x05\x21\x18\x28\xba\x3c\xa4\xd3\xd9\x01\xd7\x65\x41\x35\x8f\x95\x55\xf1\x0d\x24\x02\x4b\x48\xab\x35\x0c\xa9\xd6\x63\xdc\x98\xbe\xc3\xc7\x23\xf7\xe3\xaf\x4d\xa7\x05\x65\x36\xce\xcc\x09\x2c\x6b\x0d\x3c\x03\xc7\x3e\x56\x15\x75\x9b\x82\x64\x46\x92\x75\xbb\x6d\xc0\xa8\x8e\x2c\xd4\x16\x62\x54\x43\xb8\x6a\x9f\x8e\xdc\x87\xe1\x0c\x6a\xab\xce\xec\x86\x9a\x09\xde\x93\xc7\xd1\xed\x5e\x9e\x1b\x0b\x6c\x0b\xb8\xc3\xa2\xf6\x21\x79\x51\x85\x47\x10\x10\x83\xef\xf0\x0e\x02\x5c\xc4\x12\xc2\x41\x50\xd5\xed\x63\xb1\xd4\xb4\xe1\xb4\xb7\x8f\x1c\x58\x81\xe6\x44\x50\x6a\xeb\x5c\xb4\x00\xb6\x16\x40\x5f\x9d\xee\xe6\x48\x8a\x46\xe3\x2d\x3d\x8e\x98\x9d\xc4\xfe\x70\x94\xbf\x10\x83\x51\xbf\x9f\x33\x27\x8d\xa7\xac\x11\xb8\x1d\xe5\x9f\xbb\xe3\x6a\xfb\x33\x28\xdd\x0a\xdd\xcc\x65\xa6\xed\x65\x66\x48\x24\x02\x94\xe8\xf0\x24\x91\x4f\xb9\xe0\xa8\x4d\x73\xbd\xe9\xf0\x7a\x83\x0e\x44\xf2\xf1\xcb\xcd\x97\x66\x47\x1e\x1e\xc0\xd3\x85\x10\xe1\xce\x86\xcb\x98\x1e\x13\x59\xeb\xf4\xe3\xb7\x32\xd5\x5e\xeb\xb4\x03\xa2\xad\x1d\x3d\x29\x61\xb4\xb8\x09\x29\x27\x45\x82\x5a\x6f\x69\xdf\x95\x12\x75\x0a\xaa\x18\xae\x90\xcc\xe2\x8a\xb5\xd2\xd5\x53\x8a\xa3\xa8\x85\x4a\xd7\x22\xac\x0e\x8e\xcd\xca\x7e\x78\xb9\x55\xd5\x63\x95\x4d\x88\xe9\xd7\x99\x3e\xad\xc2\x50\x4b\x22\xa8\x37\x10\x52\x0a\x57\xb5\xb4\x16\xd2\xa7\xce\x20\x2f\xd4\x58\xd9\x06\x73\x0c\xce\xcb\xfd\xf1\x7f\xca\x1c\x10\xcc\x79\xac\xd3\x54\x1b\x9c\xa9\x73\x7b\xa7\x06\xbe\x40\xb4\x2d\x41\xf6\xae\x53\x50\x30\x30\x4f\x62\x1f\x60\x42\x98\x32\x81\x26\x81\x97\x63\xa1\x53\x0e\xbe\x78\x4f\xbe\x0a\x13\xad\x02\x78\x54\x68\x21\x0b\xa6\xfb\xd4\x92\x91\x28\xf9\x73\x61\xde\x4d\xa0\x1a\xf3\xbc\x85\x65\x7b\xfb\x15\xc5\xb0\x08\x5f\x10\xfe\x25\xd2\x73\x32\x2c\xe6\xc9\xc0\x0b\xd6\xd2\x41\x57\x1a\xfc\xab\xa6\xd7\x50\x7b\x19\x19\xbc\x7a\xc0\xf3\x96\x89\xf8\x15\x57\x51\xaf\x67\x67\x41\x1f\x79\x33\xcb\xb9\x59\xaf\xaf\x0d\x5c\x51\xb4\x4d\x6e\x8b\x22\x93\x69\xb0\x4b\x64\x6c\x66\x65\x7c\x69\x9f\x92\xcb\xd3\xfe\x4e\xf4\x94\xbe\xc2\x0c\xe4\xb2\x4c\xb5\xfc\x06\x3d\x85\x84\x23\xb7\xfd\x0c\xf8\xe7\x56\x9d\xd7\xa2\x94\xc4\x1e\x3b\xe5\x32\x9d\x1b\x89\x9a\xb7\x4e\x20\xa0\xa2\x0a\x74\x7b\x60\x47\x00\x9a\x6a\x36\x0e\xb8\xc1\x42\xe6\x66\x08\x12\x4d\x5b\x91\x73\xe6\xef\x33\xfb\x9e\x3c\xca\x97\xb3\xd2\x4d\xd2\x0c\x27\x01\x84\x59\xdf\xbc\x7d\x61\x2d\xd1\x74\x86\xa1\x86\xbd\xcc\xe3\x4d\x5e\x93\xa1\x35\x84\x5b\x39\xe3\xdf\xc9\xb8\xee\x6b\x38\xca\x81\xb5\xca\xbc\xc0\x95\x44\xa7\xc0\x75\x85\xc7\x10\xa0\x82\x58\xd3\x8c\xae\xe3\xc6\x7e\x2a\x15\x3d\x72\x5d\x80\x1e\xee\x17\x7f\x7a\x7b\x57\xf3\x51\x56\x5f\x4f\xea\x99\x2e\x60\xdb\xff\xfd\xfb\x6f\x2c\x51\xec\xbf\x05\xbe\x1b\x8e\x3c\x90\xf4\x9d\x4c\x2d\x51\x4d\xf6\x5a\x41\xfa\xe1\x47\x19\xed\x6f\x88\x97\xcb\xcd\xc3\x5b\xea\x27\x24\xc0\x1c\x03\xfb\x01\xfc\x2d\x58\x2d\x8f\xc4\x8b\x1c\xa9\xa6\xea\x88\xb0\xde\xcf\x69\x7b\xd3\x28\xeb\xb8\xc9\xc8\xfd\x72\x25\x50\xac\x13\xe4\xd4\x31\xaa\x47\xbc\x41\x45\xba\x36\xd7\x14\x47\xcf\xda\xb3\x3d\xc0\x12\xa1\xfc\x46\xb1\xfd\x77\xd2\xf9\xbd\xb6\xbe\x9e\x31\x40\x38\x84\x86\xc1\x08\x8e\xc0\x0c\x68\x97\x9b\x86\xd6\x7b\xc4\x27\x22\x06\x5e\x7b\xa5\x4e\x7e\x06\x75\x53\x6e\x01\xde\x56\x37\xf9\xaf\x9c\x0d\x08\xe2\x11\xd9\xcd\x12\xa4\x5b\x66\x4a\x2d\x58\x06\xdc\x48\xdf\x59\x15\xa0\x66\x9f\x72\xa3\x0e\xc4\x2c\xed\x8f\x9a\x22\xa8\xe2\xec\x3b\x52\x57\xbd\x01\x7d\x34\x91\x8f\x3b\x95\xd4\x9d\xa4\x63\x14\x8b\x3a\x78\xe8\x8c\xe1\xba\x73\x1d\x97\x62\x94\xcc\x94\x45\x4a\xe8\x49\x3a\x3d\x83\xb1\x37\x9c\x46\x84\xf8\xfb\xa4\xe8\x75\x7c\x7f\x3a\xd3\x48\xb1\x51\x87\x32\x83\x32\x8e\x6c\xaf\x51\xb7\x44\x6b\x0f\x2a\x05\xe7\xef\x45\xaf\x43\x25\x6a\xf5\xb3\x23\x0f\x37\xd5\x19\x68\x87\x02\x67\xda\xcc\x8f\x91\xf6\x38\xd5\x16\xef\x95\x4d\x26\x1e\x86\xd0\x38\xe5\xe3\x01\x78\x20\x1d\x10\x19\x07\x5a\xa2\x00\x8e\x40\x80\x88\xe0\x14\x75\x7d\xc6\x79\xe3\x7b\xa2\x0e\xcd\x24\xc6\x4f\x79\x08\x41\xb7\x9c\x76\x7a\xb3\x1b\xf6\x32\x4b\xc7\x36\xa6\x4a\x94\xb2\x44\x75\xbb\x97\xd2\xb2\x3f\xf2\x25\x22\x88\x04\x66\x5d\x36\xcf\x4c\xf1\x0c\x13\x54\x43\x8a\xd3\xa6\xac\xd7\xb0\x23\x2f\x07\x70\xc0\x5a\xa6\x67\x78\x6e\x7a\x40\xa5\xf3\xe9\x0a\xb4\xd4\x09\x0a\xa7\xaa\x4c\x67\xec\xf8\xf2\x90\x49\x9b\xc7\x4f\xd2\xa5\xf5\xaa\xb4\xee\x39\x7f\x54\x83\x36\x45\x34\x90\xa7\x35\xae\x8d\x8a\x49\x2b\x0b\x68\x3f\xec\x93\x4d\xe0\x13\x1d\x9b\x88\x5e\xdc\x36\x30\x05\x85\x67\x17\xba\xed\xd2\x76\xa6\xdb\x25\x15\x30\x97\x02\x95\x91\xb6\x16\x32\xbb\xcd\xa3\x73\x5d\xd4\xac\x97\xf1\x70\xcc\x74\x6e\xe1\x9e\x6e\xc2\x82\x28\xcc\x67\x07\x74\x39\x64\xae\x26\x57\xd6\x7c\x3b\x53\x47\x5a\x2f\x3f\x60\xbc\x45\x65\x98\x32\x85\x2d\xd6\xfb\x29\x5c\x1e\x09\x5a\x3c\xe1\x65\x29\xf6\x26\xd0\x96\xcc\x85\xce\x47\x3a\x8f\xbd\x10\x42\x68\xd4\x36\x82\x45\xc4\xcb\xe4\x0d\x89\xda\xde\x81\x10\x52\xfc\x0d\x22\x1e\xa0\xcc\xd0\xbc\xff\x20\xbd\xf6\x6f\x0e\xa0\xb8\x29\xb7\xea\x7c\x5c\xa9\xea\xe2\x4e\xea\x55\x31\xb7\x62\x2b\x05\xcc\x29\xd8\x03\x72\x9e\x5c\x48\xa5\x57\xb2\xbc\xc8\x8b\x8b\xd4\x78\x49\x06\x35\xa1\x8b\x5b\x79\xb1\x00\x93\x92\x8b\xa2\xbc\x48\xf3\x0b\x95\xcf\x88\xa3\x75\x01\xe4\xcb\xd2\x1c\x8c\x8b\x87\xb4\xba\x58\x97\xc5\xbd\x9a\xcb\x79\xdc\x09\xf4\x20\x53\xdc\xe3\x21\xea\x06\x3a\x6c\xfb\xe3\xd1\xa9\xc6\xa5\x35\xd5\xb8\x45\x51\xde\xa5\xba\x4a\x72\x88\x18\x5b\x56\x12\x1e\x4c\x1a\x3c\xa5\xf3\x39\xfc\xcc\xd5\x62\x01\xbf\x08\x55\xbe\xc3\x47\x99\xcf\xcd\xc3\x8d\xd1\x74\xaa\x1d\x31\x3a\x3b\xa8\x42\x95\xe6\x4e\xe5\xbe\xc6\xba\x6c\xef\x8f\x66\x66\x79\xca\x5c\xec\x01\xea\xcb\x24\xcd\x41\xbd\x50\x00\x93\xeb\x2e\xd5\xa0\x8a\x98\xec\x51\x0b\xbb\x6a\x6b\xd2\xbb\xf0\x04\xbf\xe2\x49\xa7\xd3\xd3\x47\x74\x08\x96\x96\xed\xb7\x95\xb0\xfc\xad\x17\x7f\x1c\xe7\x93\xeb\x69\x3f\x9f\x0c\x41\xff\x72\x08\x4f\x83\xe9\xc8\x19\x45\x28\xf6\x62\xd8\xed\xea\x4b\x21\x02\x6d\x1e\x0d\x84\xbb\x12\xba\x5f\x4b\xb3\x50\xf3\x4d\x9c\x15\xcb\xe1\x20\x0a\xea\x00\xcd\xe0\x4e\xc7\x1a\x6d\x68\x88\x3c\xe3\xf4\x84\x5c\x36\x68\x98\xf1\xe0\xd5\xf6\xb1\x0f\x51\xf5\x9f\x0f\x65\xff\x4f\x96\x67\x7b\xd2\x04\x40\xf4\x2a\xec\x62\xc1\xc2\xce\xa5\x6c\x54\x89\x7a\x8b\x77\x2a\x8f\x2a\x7e\x3d\x00\xb7\x2f\x78\x39\xeb\xe2\xeb\xed\xba\xc8\x65\x0e\xae\xf2\xa2\x8a\x1d\x03\xa9\x6d\x7f\xf8\xac\x5e\x5b\xd6\x52\x5b\x56\xaf\xed\xb5\xda\xca\x79\x94\x11\xdb\xb5\x14\x9d\x41\xc7\x42\xd7\xf2\xc8\xb3\x62\x99\x96\x4a\xaf\xee\xd4\xec\xcc\x0a\xe9\x2b\xac\x79\x5d\x3c\x44\xc3\x86\xea\x95\x19\xbc\x66\x9e\x4d\x0b\x6e\xfb\xf5\xb8\x33\xe8\x24\x60\x15\xab\x0e\x87\x6b\xf3\xf3\x67\xf3\x03\x9f\xe5\xe1\x20\x31\x14\x80\x9d\xd4\x71\x73\xcc\xe0\xb4\x09\xc2\x10\x55\xb9\x70\x1b\x8a\x67\xe6\x25\x60\xe9\xf2\x4d\x7e\xea\x00\x7d\xee\xd2\x5e\x1a\xd4\xa2\xc5\x5a\x60\x55\x1f\xa1\x0d\xb3\x84\x8a\x0e\x91\x65\x69\x39\x8d\x33\x05\x18\x5a\xda\x1f\x82\x52\x19\x4a\x99\x51\x37\xeb\x75\x51\x42\x7e\x50\x91\x07\x0b\xb1\x1b\x3c\x9b\xf8\x05\xa2\x50\xc4\x37\x32\x9f\xe3\x1b\xec\xb5\x4b\x10\x01\x44\x4a\xc0\x94\xa4\x63\xb7\x60\x45\xbf\xe2\x59\xbf\x60\x09\xce\xca\x38\x3a\xad\x7c\xc8\xfa\x05\xbb\xba\x4e\xa2\xa2\xdf\xd2\x72\x7f\xc8\xd8\xd5\x35\x8f\x8a\x9e\x28\x9f\xcb\xb1\x4a\xfa\x8a\x3d\xaf\xfa\x43\xd9\xff\xe2\x70\x28\x5e\x64\x3d\x78\x72\x91\x27\x2e\x0a\x0f\xa5\x66\x4d\x55\xfa\xba\xf1\x31\x9f\xf1\x05\x5f\xf2\x35\xbf\xe3\xf7\x6e\x9d\xf8\x2d\xc8\x02\xb7\xf0\x67\x07\x7f\x6e\xc4\x80\x7f\x22\xb7\xca\xc6\xb4\xe1\x1e\x91\x49\x30\x4d\x05\x85\x96\x94\x7c\x67\xf3\xcc\xbc\xdc\xa5\xff\x2a\xca\xb1\x34\xbf\x89\x84\x79\x2d\x4a\x0e\x7e\x39\x73\x2d\xc0\xc1\x98\xf1\xd6\xcb\xe7\x42\x4d\x36\x53\xfc\x73\x38\xec\x01\x49\x4c\xf6\x47\xbe\x9c\x21\xeb\x65\x05\xa1\x3c\x54\x2e\x8d\xd2\x1e\x9f\x89\x85\x18\xf0\x2c\x8f\x2a\x76\x38\x54\xf0\x83\xf2\x3b\xf3\x04\x08\x6f\x29\x06\x60\xfe\x4f\x43\x18\x95\x68\xa3\x58\xb2\xa5\xa8\xd0\xaa\x3b\x8f\x96\xa6\x20\xfc\x44\x33\xf1\xa6\xee\x2e\x88\xcf\x63\x68\x9f\xcf\xe3\xe5\x8c\xcf\xf8\x92\xf1\x45\x4f\xac\xe8\x20\x7d\x2e\x77\xc5\xf8\x42\xac\x46\xb7\xe4\x81\x8b\xf1\xad\x79\x5a\x30\xbe\x33\x4f\x68\x40\x7d\xe3\x0f\xf0\x8c\xdf\x30\xfe\xc9\xbf\x2f\xf8\xa7\xe0\x66\x79\xf0\x60\x96\xb4\x00\x6f\xd1\xfc\x61\x60\x75\x01\xb7\xf4\x6a\xe2\x11\x26\x3b\xf3\xea\xb4\x2d\x6c\x45\xb0\xf2\xde\x2b\x10\x8f\x9a\xb8\x19\xf0\xcf\x35\x0c\x01\x7c\xcb\x99\x79\xbb\xba\x1e\x81\xd7\xe7\x17\xd2\x72\x87\x07\xa3\xfc\x79\x8a\x5e\xa7\x1d\x89\x03\x43\x9f\xa8\x49\x3e\x9d\x42\xe8\x38\x13\xe2\x7f\x00\xc2\x1e\x88\x55\x10\x29\x7e\x0f\x7e\xff\x6e\x9d\x9f\xe5\x1b\x30\xbe\xde\xba\xd7\x4f\x8c\xef\x31\x9c\x61\xf2\x10\x0d\x18\xcf\xd2\x4a\x27\x0f\xd1\x3d\x1c\xba\x07\x05\xa4\x7f\xf2\x10\xad\x19\x5f\xa9\xe5\xca\xbc\xdc\xb1\xc0\xcb\xe9\xa2\x76\xeb\x21\x4b\x14\xce\x66\x05\x80\x45\xcd\x3e\xbd\x4b\xcb\x4f\xdf\x90\x93\x7a\x5f\x68\x19\x62\xa3\x16\x7c\x39\xef\xab\xe3\x48\x06\x91\xfd\x6f\xf0\x6e\x06\x8b\x57\x10\xae\xe4\xad\x31\xff\xb5\x8f\xf9\x2f\x83\x3d\xda\xb3\x3a\xdb\x2c\x6c\x7c\x4d\xcb\x40\xcd\xba\xcb\xb8\xb5\xc9\xfd\xc2\x99\xda\x26\x9b\x1c\xbd\x29\xd9\x77\x3a\x3f\xd6\x0e\x77\x41\xc6\xbe\x3e\x1b\xbc\x51\x26\x78\xa4\x2c\x80\xed\x05\x79\xe0\xd5\x66\x82\x67\xc6\x7d\xff\x4d\x36\xff\xce\x75\xf0\x02\x62\xfb\xfd\x0c\x0c\x88\x13\xdf\xf3\x52\x56\x45\x76\x2f\xa3\x89\xa9\xdd\xd8\xdf\xea\xe0\xd9\x2a\xda\x87\xee\x7e\xf1\xcb\x94\x85\x76\x34\x77\x7e\x81\x04\xce\x97\x36\x30\xc3\xde\x35\x7b\x7c\x4b\x24\x37\x10\x05\xe2\xc0\xff\xab\x28\xad\x6d\xda\xd8\x16\x81\x8f\x2c\x91\xc1\x76\xb9\x0f\x57\x1e\x24\x92\x56\x5e\x74\xc6\xb3\xba\x8b\x20\x07\x42\x11\x60\xbc\x93\x43\xc5\x6e\x97\x7c\x8c\xfa\xb8\x62\xa9\x6f\xe5\xf6\x1c\x8c\x15\x9b\x3c\xca\xe1\x7a\xde\xd0\x51\x57\x79\xb4\xc9\x23\xc5\x6d\xc3\x2c\x78\x9a\x13\x84\x95\x26\xef\x4c\xaa\x2c\x82\x28\xce\x40\xda\x81\x77\x11\xd5\xcf\xd9\x55\x70\x27\xa7\x57\xe0\x24\xb5\x12\xd9\xa8\x7a\x3e\x18\xb1\x79\xaf\x67\xd1\x11\x63\x9d\x99\xf5\xe6\xcf\xa4\x51\xf6\x09\x34\xaa\x33\x70\x39\x57\x3e\xdf\x8c\xca\x5e\x8f\x15\x42\x03\x70\x2c\xc1\xab\xc5\x38\x2a\x68\xb0\xa2\xe4\x67\x2a\x63\x09\x01\x81\xc2\x00\x7c\x67\x32\x83\xd8\x7a\x87\xbb\x00\x84\x97\x03\xde\x50\x02\xb5\xb0\xea\x72\xc8\x97\xa5\x9a\x83\x7a\x67\x95\x84\xf9\x67\x2d\xc1\x44\x86\xac\xc3\x9d\x31\x77\x32\x24\xc7\x1a\xc0\x65\x86\x12\xf0\xf6\x5d\xfe\xca\x0a\x47\x6d\x12\xc2\x03\x78\x69\x00\x84\xe1\x80\xff\x22\xcb\xe2\x9b\xa0\x3e\xfb\xde\x12\x47\x23\xbe\xfe\x33\xeb\xb8\x0c\x5f\x22\x8b\xfb\xab\xb4\x5a\x01\xcb\xfc\x34\xf5\x3b\x33\x38\x0b\x91\xff\xe2\x46\x78\x39\xe4\xb7\xb5\xb2\xb7\x27\x65\x8e\x1c\x67\x0f\xbd\x5e\x06\x33\x32\x34\x51\x1a\x8c\x67\x13\x70\x80\x49\x30\x27\xd9\xeb\x62\x9d\xfc\x89\x53\xec\xf9\x3f\x81\x8c\x57\x51\x78\x8f\xa5\xca\x5f\xea\x9f\x64\x59\x24\x97\x43\x7e\xa7\xf2\xef\x0b\x4d\x76\x3e\xfc\x2e\xdd\xba\xb7\x3f\x0f\xf8\x9d\x02\x8d\x3f\xc8\x66\xab\x05\x43\x21\xb4\x28\x85\xc4\x60\x5d\xd2\x8d\x2e\x3e\x7c\x52\xeb\xf0\x99\x00\x61\x32\x30\x7d\x74\xa3\xb7\x14\x75\x52\xe4\xb1\xa7\x22\x4c\x40\x8c\x0a\x3a\x54\x94\x70\xc3\xe3\xe9\x4d\xf6\x2e\xe0\xc3\x36\x0f\x1c\x47\xdb\xc9\x45\x8c\x2e\x19\x80\xd0\xc4\x36\x77\xc2\x38\xdf\xc3\xd6\x4a\x90\x04\xa3\x61\x40\x50\x68\x90\x40\xc0\x1c\x85\xe9\x1f\x8b\x35\x24\x97\x00\xd7\x6a\x1f\xbe\x87\x14\xf8\x44\xf3\x19\x7e\x33\xce\xd7\xf1\x66\xe5\x16\x69\x3c\x2b\xba\x85\xdd\x56\x19\xb9\x2f\x2e\xe5\x19\xe6\xa1\x11\xe6\x23\xb2\x31\x6a\xe5\xfe\x93\xc9\x52\x44\x02\x8e\x37\x45\xa9\x7e\x29\x72\x9d\x66\x11\x03\x77\xdd\x54\xb7\x8d\x38\x02\xd8\x90\x0e\xec\x9c\x8e\xfc\x44\x91\x37\xec\xc7\x91\x22\x73\xfc\xbd\xa1\xd4\xc8\xf6\x01\x3b\xa4\xd6\x9f\x30\x3f\x47\x41\xd2\xd4\xf1\x27\xdb\xa9\x08\xef\xdf\x06\x07\xcc\x01\xe1\xb3\xb5\xe1\x24\xf1\xb9\xd8\xc4\x55\x0a\xb4\x36\x5c\x54\x80\x70\x64\xb5\x76\x22\xc6\x33\x00\x56\x78\x4c\x85\x36\x2f\x6f\xc8\xf6\x02\xdf\xc0\x79\x46\xe5\x63\x3b\x99\x7d\x60\x97\xd7\xac\xbe\x5b\xd1\xc1\x11\x38\x36\x19\xad\x90\xe1\x5a\x65\x71\xed\xe5\x06\x27\x10\x3a\xe3\x93\xee\x52\xe3\xdf\x16\xf6\x22\xb8\x84\xc9\xe2\xac\xc8\x97\xb2\x32\x8e\x67\x4d\xd7\x82\x54\x30\x72\x7f\x05\xa1\xd9\xc4\x69\x12\x70\x05\xa0\x4a\x0b\xf8\xde\x6a\x79\xd7\x68\xbb\x96\x64\xe6\xe2\x83\xd4\x5f\xa9\x3b\x99\x57\xa4\x8d\x9d\xc5\xd5\x49\x0a\xca\x7e\x4f\x33\x7a\xd1\xf7\x37\xea\x4e\xa1\xa4\x24\x8b\xe7\x52\xcb\xf2\x4e\xe5\xcd\x74\x27\xe8\x0e\xd2\xc8\xcf\x00\xe8\x77\x12\xa9\xc4\x0b\x91\xc5\xb7\x41\x02\xec\x36\x1e\x5d\x46\x90\x8e\x55\x7c\xe9\x3f\x16\xec\x70\x80\x7f\x97\xd6\xd5\x14\x83\x10\x25\x38\xe5\x0c\xee\x23\xf0\x9b\x4e\x01\x5b\x28\x99\xf2\x8d\x14\x22\x9b\x8a\x15\x64\x23\x80\xa0\x23\xa1\x4c\x13\x35\x25\xd8\x71\x39\x3c\xba\x8b\xd8\xad\x6c\xc1\x2b\x31\x7f\x6e\x9b\xe4\x25\x78\x32\x9e\x15\xf9\xbd\x2c\x35\x76\x0b\x42\x7c\xc1\x39\x89\xaa\x71\xb8\x71\xbd\xfc\x91\x22\xe7\xd9\x2b\xf9\x4a\xf2\x16\x2e\x7a\xda\x13\x8a\xd9\x50\x74\x93\xf0\x3e\x66\x53\xd7\xa7\xfc\x18\x15\x7c\xce\x92\x02\x26\xb8\xa6\x28\x69\xe7\xf6\x55\x9a\xcd\x36\x59\xaa\xf1\xa8\x5a\xc8\x8c\xdf\x67\x67\xbf\xe0\x34\x9f\x2f\x48\xfe\xc5\x95\xc6\xcc\x0b\xfa\xc5\x42\xaf\xe9\xc5\xcc\xd4\xc7\x02\x34\x0b\xc4\xc6\x62\xc0\xdd\x6e\xb4\x89\x2d\x6c\x3f\x1c\x3a\xf0\x08\x6c\xd6\x4d\x5c\x15\x9b\x72\x26\xd9\x38\x8b\x6f\xec\xf7\xa8\x60\x49\xc1\x41\xf5\xfd\xfc\x04\xd7\x1b\x62\xd0\x1d\x6c\x58\x94\xb6\x43\xe1\x41\x57\x39\x9c\xbc\x23\xf7\xf3\xf4\x19\xd9\x62\x0d\x9a\x58\xaf\x07\xa3\xbc\x09\x2d\x23\x8d\x14\xed\x42\x73\xe0\x27\x23\x6c\x60\x09\x26\xea\x62\x8d\x69\x06\x42\x70\x25\x2e\x15\xb0\x35\x03\xca\x5e\x68\x9e\x7b\xca\x1e\x2d\x33\x6f\xa8\x21\xfc\x5e\xa1\x09\xe7\x8d\xd9\x28\x42\xf6\x35\x85\x45\x7a\x3a\x60\x0d\xb2\x7b\xb8\xda\x72\xf2\x9f\x52\x57\x4b\x31\x5f\x67\x75\xae\x36\x7f\x29\x8d\xf4\xe9\xdc\x91\xd9\x9b\x76\x80\x18\x51\xd5\x05\xc8\x70\x75\x5c\x86\xc1\x9a\x61\x52\x89\xf2\x11\xda\x83\x6a\x34\x24\x5f\x63\x76\x33\xcf\xc2\xe6\x02\xac\x97\xae\x58\xb8\xaf\xc5\xc0\xbf\x7f\x2c\xd6\xe1\x2b\xde\xce\x61\x82\xb9\x92\xc5\x80\xa6\xfb\x57\xcf\xd4\x69\xa9\xe6\xe4\x7b\x48\xf8\x94\xfa\x9a\x65\x7c\x6d\x2d\xe0\xb6\x16\xfb\xea\xd7\xb5\xd3\x28\xd2\xec\xb4\x87\xbd\x4f\xef\xb4\x2f\x13\xd4\xe6\xeb\x09\xfb\xda\x56\xbd\x23\xd8\x42\x43\xd9\x0a\xd2\xc1\x88\xd5\x1c\x8c\x71\xd0\x83\x40\x67\xb7\x5e\x25\x9f\x48\xae\xa7\x2c\x89\x24\x01\x89\xa7\x16\xa2\xfc\x53\x06\xc6\x9b\xf8\x68\x84\xd9\x14\xf2\x63\xf6\x89\xe0\xd1\x2b\x04\x51\x55\x4d\x05\xf2\x73\x93\xd3\x5a\xdc\xcf\x53\x1b\xd4\x6b\x3f\x5a\xae\x5e\xec\x20\xe9\x3d\xc2\x23\x29\x8a\xc1\x23\x9a\xd8\xe9\x78\x53\xc9\xd2\xaa\x7d\x00\x56\x67\xfb\xc7\x49\x05\x84\x62\x91\xfc\xc6\x71\x9d\x2f\x1d\x2c\xff\xf9\xab\xe9\x29\x4d\x3c\x72\xb3\x05\x53\xf7\xb9\xda\x1d\xc0\x0f\xbd\x10\x03\x22\x99\x09\xd7\x14\x22\x95\x16\x99\xac\x5a\x38\xba\x2b\xb1\x89\x03\x02\x08\x10\xfc\x19\x24\x79\x2a\x08\x59\x76\x97\x55\x7c\xa3\x2a\xab\x3d\x04\x18\x8b\xbb\x14\x0f\x87\xd5\x0b\x31\x3b\x1c\xe6\xcf\xc5\xf0\x70\xb8\x3c\xc1\xc5\x09\x55\xb7\xd5\x89\x55\x02\x64\xba\x16\x55\xec\x48\x00\xb8\xd6\x50\x73\xd2\x30\xb6\x0c\xb4\x04\x79\x68\x4c\xdc\x2d\x82\x87\x7d\x9f\x60\xe8\x47\xae\x3c\xbf\xa0\xf2\x00\x98\xcc\x03\xad\xaf\x61\xc6\x65\xef\x8b\x17\x10\xbc\x98\x8a\x8d\x7d\xde\xab\x79\xa2\xae\xa2\x39\xf0\x8d\xc1\xf3\xb3\xc0\x97\xc8\x65\x8c\xff\x9c\x0c\x31\x10\x48\xe5\xe1\x75\x7f\x91\x47\x59\xec\x88\x73\xd6\xdf\x58\xa0\xdb\x5f\xc2\x17\x4f\xa5\x3a\x9b\xeb\xea\xe7\x52\x47\xf2\x99\xec\xe5\xcf\x72\xe0\x81\xbe\x89\x75\xf1\x95\x5c\x96\x52\x56\x5e\x46\x81\x0f\xa0\xfa\xe8\x93\xa2\xe6\x0c\xf4\xbe\x60\x57\x29\x87\x2e\xb5\xe4\x2e\xaf\x0a\xf8\xd4\xf7\x9f\xf2\x2b\x08\xb3\xcc\x17\x9e\xad\xb1\xe2\x2e\xfb\x8c\x2f\xe0\x63\x73\x7d\x16\xf6\x04\xfd\xe6\xed\x7d\xbe\x74\xf3\x04\xbd\x56\xfa\x29\x15\xba\xcc\xbe\xfc\x42\xe9\x76\x38\x82\x4a\x27\x84\x2a\x59\xdb\x70\xc7\x0f\x06\x12\x07\x1c\xc3\x00\x69\x89\x08\x2c\xb5\x80\x42\x76\x73\x4c\x4a\xa1\x82\x15\xc4\x58\x21\x6e\xa9\xe1\x80\xd5\xce\x01\xcf\x42\xf7\xe4\xde\xf0\x15\x3d\x7f\xd7\xcf\x01\x50\x6f\x9b\xb1\x3c\xc1\x16\x12\xc0\x10\x6d\xf2\x22\x8f\x0a\xd6\x5b\xe6\x51\xc9\x18\xdf\x8c\xcd\x27\xc2\x18\xc2\x6f\x89\x6c\xc3\x23\x52\x8f\xa7\x92\xbb\xda\xb9\xb8\x43\xa3\x05\x68\xb0\x79\xda\x30\x38\x0d\x32\x98\xe1\x84\xc7\xc0\x5f\xe6\x4b\xb1\xa2\x33\xc8\xd7\x62\x65\xf7\x1e\xbf\x13\xf1\x9f\x9e\xcd\x61\x8f\x15\x65\xc8\xf7\xbc\x17\xa9\xdd\xfa\x38\x3c\xd3\xe8\xad\x40\x41\x62\x7d\x5b\xf1\x2d\x6e\x7a\x70\x0a\x98\xe6\x55\xd4\xf8\xca\xf8\x8e\x98\x79\x45\x15\x6d\x9d\x04\x00\x76\xf7\x16\xf8\xff\x37\xcf\xc8\xd5\x74\x6f\xf7\x2c\x5a\xd3\xd8\xfb\xd1\xed\x78\x4d\x07\x35\x19\x30\xd6\x8b\x6e\xc7\x83\xe4\x8e\x8d\xdc\xe4\xb8\xad\x1e\xce\x92\xfd\xda\xfb\xd4\xbb\xa7\xf8\x34\xfc\x1d\xff\xd0\x22\xc7\x1a\xb0\x3e\x5c\xd0\x0b\xcd\x5f\x09\x42\xe5\x5a\x64\x4e\x2d\x72\xb2\xfe\x90\x8d\x6e\xc7\xd1\x83\xc8\xc6\xbb\x67\x33\xea\xf9\xcd\xb3\x99\xed\xec\xcd\xb3\x68\x46\xbd\xe8\xdb\x44\xc6\xdf\x89\x6c\x7c\xf3\x2c\xb2\x81\x7a\xfa\x0b\xfb\x25\xd9\x3d\xa3\x60\x3e\xbd\x9b\x67\x3e\x35\x7a\x10\x54\xf7\xd5\x35\x7f\x27\x28\x0b\x08\x50\x1c\x16\x88\x58\xa3\x3b\xfb\xd1\x43\xff\x03\x7b\x46\xe0\xf5\xca\x62\xae\xfd\x0f\x20\x66\xed\xfd\xd1\x97\xfa\x3e\x98\x3c\x28\xf6\xae\xff\xaa\xa5\xd8\x2b\x53\xcc\x8b\x62\xdf\x8b\x34\x36\xec\xb2\xf1\x20\xb1\xeb\x75\xdf\xbb\x1b\xd9\xdd\x5d\x5f\x0e\x1b\xfc\x19\xbf\xf5\xde\x07\xbd\x06\xdc\xd6\xce\xcf\xd5\xf5\x09\x4e\x6b\x27\xe8\xea\xfa\x78\xd4\xa4\x3b\x64\xdc\x84\x02\xf9\xbd\x09\x51\x72\x4b\x77\x90\xbb\x98\xbe\xb6\x2c\x11\x5c\xd6\xe0\x15\x17\x97\xeb\xa6\xe3\x0a\xc4\xd8\x6b\xee\x2d\x7c\x9e\xb0\x76\x5a\x31\x5f\x9f\x2e\xd6\x41\xed\xe4\x7f\xff\xc8\x6b\x9d\x6d\x87\x5f\x23\x57\x0c\x6c\x73\x5c\x15\x59\x6d\x25\xdd\x94\xc0\x02\xf3\x7a\x2e\x16\xbc\xeb\x62\xdd\x52\x08\xbc\xa9\xd6\xf2\x84\x45\xca\xfa\xe2\xbb\x42\xdf\xd3\x0c\xd5\xf2\x85\x05\x89\x76\x39\x2d\x69\x96\xed\x24\x27\xb3\xf7\xcc\x13\x2f\x01\x9b\xd7\xdf\x01\x21\x80\x6d\x9f\x4c\x57\xd8\x02\xe6\xd1\xe3\x7e\xb2\xb8\xaa\x5e\xdb\xb8\xe4\x61\x8d\x84\xb5\xd7\xaa\x74\x01\xcc\x41\x6f\x59\xe3\xf4\xfc\x03\x99\x30\xae\x9c\x09\xc5\x9b\x01\xaa\x6f\x05\xce\xdf\xa6\xdf\x02\xa4\x8c\x3a\x39\x86\xb3\x3d\x1f\x87\xf5\x5b\xfc\x0e\xd6\x56\xaa\x7a\x0d\x2a\xaf\xf2\xb4\x16\xd4\xd5\x68\x61\x7f\x62\xb3\x4e\x42\x03\x3e\xba\xa9\x9c\x55\x3c\xf4\x9d\x45\x37\xb4\x47\xeb\xe2\x3d\x28\xb3\x7b\xb4\xcc\xce\x31\x70\xf4\x91\xb7\xb2\x36\x4e\xe9\x1f\x92\x2b\x41\x7d\x28\x6b\x49\x2d\x7a\x7f\xce\x71\xc6\x05\x45\x88\x06\x55\xbb\xf4\x31\x72\x03\x54\xf9\x44\x1a\xb7\x75\x23\xd2\xec\x70\xa0\x96\x78\xfa\x08\x6e\x0f\x4e\x94\xda\x45\x5d\x10\x3d\xd8\xdc\x56\x02\xac\x76\xec\xc0\x65\xc0\xc2\x46\xfc\xb5\x7d\x07\x92\x0e\xac\xcb\xe5\x8a\xa3\x0b\x97\x90\x9f\x2a\x05\xa8\x20\xa0\xe7\x09\x10\xf3\xb9\x7d\x86\x7d\x67\x3c\xbc\x66\xb8\x3e\x61\x9d\x06\x69\x01\xdf\x55\xd6\x30\x6a\xf0\x2b\xc9\x8d\x10\xf5\x74\xa7\x06\x6b\x64\xfb\x88\x44\x2a\x48\x7d\x7b\x6d\x9b\x00\x35\x82\xf2\x33\xdf\x86\x53\x16\xe2\xe6\xc0\xf4\xb6\xee\x5c\x01\x14\xc1\x3b\x10\xb2\x36\x66\x5b\x2e\x74\x7b\x45\x50\x8b\x86\x5d\x03\x9e\x0f\x55\x9e\x28\x90\xcf\x24\x29\x47\x06\x14\x98\x0a\xe5\xf3\x24\x3f\x92\x15\x99\xc3\xda\x4e\x07\x46\x96\x5b\x7e\xec\xde\x70\xe1\xc2\xed\x7a\x70\x00\x94\x96\x7a\xdc\x99\x74\x7a\xf4\xdc\xeb\xf0\x0b\x78\x91\xf9\xbc\xd7\x99\x76\x92\xf6\x4e\x1e\x79\x33\xce\x9a\xe5\x60\x04\xc8\x02\x66\x0e\xd2\xf1\xfd\x75\x51\x22\x32\xd1\x92\x1f\xb6\xe9\xb9\x81\xd8\xbd\x41\x54\x10\x99\x31\xdc\x84\x1c\x62\xae\xc4\xf0\xca\x4d\x78\xde\x8f\xe4\x78\x90\x0c\x19\x1f\xfa\xa3\xfb\x7c\x70\x38\xe8\x17\x79\x7f\x38\x06\x7e\x7a\x62\x87\x66\x3b\xf0\x95\x9c\xa9\xbb\x34\x8b\xf4\x33\xd5\x8b\xe4\x58\x5d\x5d\x03\x96\x75\xe4\xa7\x59\x6a\xdd\xb4\xb5\x43\x6d\x75\x56\x20\x5c\x68\x62\xd8\xb7\xde\x69\x03\x2e\x62\x4f\x3f\xa3\x98\xa6\xd8\x77\x6c\x83\xea\x76\x33\x74\x3a\x15\x91\xee\x37\x2b\x62\x57\x61\x3d\xa3\xf3\x7d\x19\x0f\xfb\x32\x91\xd8\x10\x98\x28\x34\x9a\xa8\x8f\x22\x18\x30\xad\x38\xa5\x42\x49\x1b\x4c\xdc\x55\xd5\x38\x5a\xb5\xeb\xe8\x4e\xe5\xd6\x20\xfe\x2e\xdd\xd6\xba\x17\x08\x25\xc7\x83\x44\x43\x48\x24\xf9\x7c\x30\x96\x89\x06\x8f\xcc\xf2\xc5\x60\x8c\x22\x50\xc7\x5e\x3e\x9d\x0f\xcb\x08\xa8\x6f\x11\xdc\x13\xa0\xa6\x15\xcc\x0b\x3a\x6f\xb9\x4b\xb7\xb0\xc5\x2a\xe4\xad\x1d\x0e\x05\xcd\x1c\xe4\x07\x80\x14\xb1\xde\x90\x67\xa2\x6c\xa8\x10\x3c\xc1\xb8\x54\x37\xbd\x63\x68\x30\xd1\x45\x79\x84\xf3\x69\xe4\xd5\x03\xd4\x31\xd2\x60\x43\x86\x9c\x0a\xea\xde\x5c\x64\x93\xc1\x94\xaf\x44\x36\xd9\xf4\x87\x53\xb8\x31\x37\x2f\x2a\x7b\x23\x9d\x15\xa6\xa1\x62\x93\x9c\x0c\xac\xe6\x82\x57\x0f\x00\xd7\xcb\x62\x30\x52\xcf\x5d\xdf\x54\xaf\xc7\x52\xa1\x41\x52\xa2\xc0\xe3\xd0\x38\x4a\xad\x54\x5f\x61\x2d\xbd\x5e\xf9\x2c\x9f\x3a\x41\x7e\x4a\x82\xfc\x48\x83\x46\xd8\x55\xc5\x38\xaa\x4c\x40\xd7\xc2\x58\x47\xed\xda\x0d\xed\x93\xe6\xfb\xb2\x88\xd4\xf3\x6b\x1a\xde\xe5\x90\x7a\x0f\x16\x01\x5c\x8a\xe1\x48\xa2\xce\x85\xc4\xcb\x1e\xdc\x71\xea\x89\xec\x0f\xa7\x97\x42\xe4\xbe\x08\x4d\x4d\x0e\x93\xc9\x37\x22\xb2\xba\xcd\xfd\x21\xbb\x52\x35\xff\x47\x17\x0e\x26\x6c\x00\x16\x40\x5b\x85\x80\xc8\x9a\x51\x0a\xda\x5b\xf0\xf1\x66\x91\xce\x34\xe0\x13\x32\xca\x42\x73\xd6\xe2\x79\x35\x2a\xc0\xdb\xc9\x22\x8a\xc0\x37\x50\x31\x65\x2f\x36\xb6\xda\x72\xd4\x56\xff\x31\xca\xb8\xe6\x03\x70\xaf\xb6\x79\x31\x30\x12\x24\x63\xb9\xb6\xe9\x0f\xdd\x06\x41\xbd\x10\xc5\x33\x88\xfe\x99\x4d\x64\x6f\xe8\x05\x44\xa9\xd8\xbc\x18\x8e\xa3\x55\x7f\xce\xae\xa2\x4d\x7f\xc8\x8c\x25\x1d\x95\x68\x68\x3f\x46\x29\x78\x06\x9f\xf7\x53\x3e\x67\x36\xcb\xea\xc4\xea\x3d\x05\xf9\xb0\x99\x9e\x64\xd5\x4b\x69\x25\xad\x4e\x98\x29\x66\x13\xb9\x3b\x0f\x67\x4e\x74\xfd\x98\xc9\x3a\x91\x0b\x07\xaa\x41\xe7\xd2\xae\x04\x25\x5a\x47\xf0\x4a\xe6\x2e\x4b\x97\x0e\xa4\x2f\xa4\x53\xf4\xa2\x13\xe2\x1d\xf4\x58\x2d\x28\x20\xb1\x3f\xf0\xee\x0a\x91\x8e\xd3\xda\xa5\xdf\x2b\x31\x54\x07\x24\x37\x58\x49\x65\xe2\x82\x3f\xb5\xc9\xd0\xab\x67\xf9\x8b\xe2\x99\x1a\x17\x57\x79\x52\x5d\xa9\xa4\x7a\xa6\x9e\x17\xcf\xf2\x71\x75\x95\x27\xc5\x95\x3a\x72\xcf\x08\x39\x99\x1b\x4e\x3b\xdc\xc9\xf1\x79\x5a\x9f\x2e\x62\x54\xc0\xce\xec\xc0\x30\x3a\x97\x42\xa4\xb4\x95\x2e\x2f\xd3\xc0\x35\x95\x7a\xdc\x0a\x0e\xce\xdf\xa9\x79\x23\xf0\xd6\x23\x74\x40\xd0\x34\xb3\x61\x2c\x26\xfb\x4c\x40\xf8\xa1\x4b\x6a\x0e\xee\x23\x76\xcd\x44\x8b\x6c\x5f\xda\x49\xba\x1c\x22\xaa\x7b\xb7\xde\x68\x69\xf5\x56\x50\x1a\x9d\xb4\x1e\x6f\xfe\x88\x02\x29\xbf\xc5\x56\x38\x44\xd3\x32\xd3\x03\x91\x62\x68\x6e\xf8\x8d\xd8\x79\xee\x22\xff\x24\x76\x9e\xad\xf4\x20\x6e\xe2\x86\xe6\x0c\x7f\x27\x6e\x1b\x4b\xc7\x3f\x88\xdb\x9a\x60\x91\xbf\x12\x1f\x68\xe2\x7a\xd1\xc3\x78\x98\x0c\x18\x7f\x2f\x16\x79\x74\xc3\xf8\x4b\x90\xe5\x7e\x25\x6e\xc8\x83\x3c\x28\xdd\x8c\xe7\x79\x74\xe3\x83\x43\xf0\x01\x1f\xb0\x64\xc0\x3f\x8a\xaf\xae\xae\xf9\x5b\xf1\x26\xbe\x49\x21\x18\x04\xde\x99\xfc\x75\x0d\xba\xd1\x7e\x7a\x1b\x6d\xb9\xe6\x5f\xb1\x23\x2e\xa4\xa5\xb6\x3e\x01\x2e\xf9\x3a\xba\xb5\x04\x20\xaf\x84\x7d\xee\xbf\xe7\x1b\x21\xfb\x1f\xf9\x4a\xbc\x8e\x50\x1e\xc6\x7a\x1f\x31\xf0\x15\xe5\x75\xfe\xf7\xc2\x7a\x20\x1b\x84\x5a\x86\xfc\x7c\x81\x25\x29\x7b\xff\x23\xaf\x84\xec\x7d\xe4\x1b\x81\xd9\x7a\xef\x83\xa0\x7f\xae\x3c\xd1\xb0\x85\xa0\xc7\xfe\x7b\x88\x12\xda\xff\xc8\xe7\x58\x17\xd0\xdc\xd0\x8d\x99\xe5\xfe\x20\xca\xfa\x9a\x02\xb2\x80\x22\x1a\xb1\x87\x66\x98\x1d\xab\x60\xfd\x8f\x70\x7d\xf4\x3e\xf2\x4c\x98\x7c\xc0\xda\x30\xea\xa1\xaf\x50\x75\x4e\x89\x0f\x93\x7c\x6a\x54\x1d\xf2\x88\x02\x7a\xb3\x6e\x37\x7f\x6e\xd7\x08\x4d\x92\x85\xb8\x8d\x6b\x2a\x3e\xdd\xee\x8e\xd6\x5e\x08\xf1\x30\x8e\x96\xe2\xc6\x65\x40\x3a\x94\xaf\x83\x14\xd4\xd4\xe2\x77\x41\x8a\x57\xc2\x42\x8d\x84\xfb\xd6\x4f\x46\x43\xe9\x70\x18\xb0\x24\x5a\x8a\xc6\x3e\xc8\x21\xe6\xcc\xda\xa4\xa2\x16\x1a\xcf\xf9\x89\x1e\x1a\x28\xb1\xde\xc4\xb7\xa7\x8d\x35\x15\xba\xa0\x11\xee\x70\xef\x28\x15\xab\x3c\xba\xe5\x36\x32\xf3\xe1\x90\xf3\x07\xe4\xc9\x97\x02\x76\x53\x0a\xaa\xc6\xef\xc6\x85\xc8\xc4\x5c\xcc\x44\x99\x54\x62\x23\x56\x62\x21\x4a\xfe\x92\x54\x20\xf4\x76\x98\x14\x5c\xef\x86\x49\xc5\xf5\xf6\x3a\xc9\xb8\xde\x5d\x27\x1b\xbe\x1d\x42\x94\xbc\x61\xb2\x82\xd8\x60\x33\x88\x08\xb6\xe0\x08\x1a\x93\x25\x29\xd3\xad\x43\xdd\xb3\xbb\x53\xd5\xb3\xfb\x63\xa0\xce\xff\xd2\x50\x93\x46\x59\x4e\xbc\xe2\x2f\x69\x64\x88\xf7\x09\xc9\x5f\x7a\x30\xf1\x8d\xd3\x58\xf9\x8c\xdc\xc6\x03\x09\x03\x18\x16\x62\xe6\xc0\xc1\x52\x2c\xe8\x76\x59\x8b\x85\x07\x05\x77\x62\x49\xdc\x36\x7e\x2f\x66\x4d\x08\x70\x2b\x66\x75\x08\xb0\x05\x4e\xf0\x12\xf8\xac\x4b\xcb\x97\xe1\x37\x00\x02\x16\x81\x24\x83\x7f\x12\xfd\xf0\x02\x9b\x35\x6f\x2f\x17\xc9\xdb\x1e\xe9\xf5\x38\x2a\xc5\xcc\x1e\xe2\x9b\x3e\xc4\xfe\xfd\x44\x21\x38\x5d\x68\xd0\xda\xe1\xa5\x12\x70\x26\x6f\x7a\x94\x1d\x4f\x4e\x2d\xbf\x3d\xaa\x6b\x08\x5c\x37\xa3\xd3\x19\xdd\x8d\x07\xc9\x0d\xc3\x46\xee\x5c\x23\xf8\xad\x83\x51\xec\x66\xe6\xc0\x51\xbe\x1e\xe5\xb3\xb5\xc3\xb7\x8e\x35\xae\xbe\x3d\xb9\x48\x44\x94\x8b\x5b\xb0\x7b\xb4\x3a\xfa\x79\xa4\x40\x05\xbe\x12\xb3\x90\x02\x00\x9c\x39\xca\xdd\x2e\xd5\xac\x47\xf1\x94\xcd\xae\x06\xe4\x2b\x43\x27\xbe\xa0\xda\xbf\x25\xd5\xfe\x2d\xa9\xe9\x86\xcc\xf3\xb9\xa8\xa0\x85\xb1\xd5\x28\x4f\x86\xfc\x1e\x46\x5b\x71\x0a\xff\x69\x86\x1f\x7d\x1a\x0f\x93\xf8\xcf\xac\x3f\x67\xcf\x36\x49\xf4\x69\x3c\x80\xb7\x67\x10\xb6\xaf\xc4\xbc\xd1\x10\x3f\x01\xdf\x97\x02\xa9\xdb\xc8\xa5\xbc\xa4\x85\x4b\x3e\x19\xfd\xc3\x44\xa1\xae\x33\x9c\x0c\xb9\xd5\xb4\xb9\x57\xdc\xc5\x52\x4a\x8a\xc0\xbb\xd0\x03\x79\xf0\x80\xbb\xee\x31\xa3\x52\xda\xa7\x7e\x1b\xc1\xa5\x9e\xdb\x1b\xfe\x54\xd7\x4d\x22\x8b\x03\x62\x2f\x9b\xab\x6f\xde\xb8\x50\x80\x9f\x5a\xbf\x8b\xf2\xd3\xbb\x08\x62\xbf\xd5\xd5\xc4\x8c\xd7\x87\x5a\x12\xe4\x69\xbb\xb0\x01\x0b\x20\x75\x5f\xc0\x98\x66\x76\x2b\x58\x53\x08\x25\xa2\x4a\xcc\xd0\xfd\x28\xc2\x09\x9e\x8a\x8a\x00\x1e\x78\xdf\xee\x76\x41\xc8\x67\xa2\xbf\x64\xbe\x6b\x42\xf1\x8c\xe2\xe6\xd8\x30\x66\xa8\x89\x06\xdd\x01\xa8\x67\x8a\xf9\xf7\xa8\x0a\x40\xa2\xad\xc9\x03\x1d\x11\x7e\xfe\x8e\x44\x04\x59\x23\x98\x92\x53\xe3\xc5\xca\x29\xb4\x52\x15\xeb\xed\x90\x57\xb1\x86\x50\x96\x99\x0d\x65\x09\xa9\xd7\x98\x7a\xcd\x6c\xd1\x40\x29\xb8\x5e\x01\x96\x6f\x14\xc7\xd2\x58\xd8\x0e\x13\xb5\x92\x7c\x84\x18\x58\xf7\x95\x59\x6f\x6f\x2a\xb3\xe2\xb7\xa2\xb1\x84\xb3\x10\x80\xf6\x87\x70\xa9\x6c\xc5\x2c\x84\xa2\x23\xd9\x80\x67\xe3\x68\x21\xe6\x18\x83\x1a\x0e\x31\xbf\x67\xfd\xfb\xab\x6b\xbe\xa4\x34\x3c\xe0\xfc\x96\xf5\x6e\xaf\xae\xf9\x5a\xdc\x89\x2d\x4b\xa2\x35\x7d\xd4\xc5\xda\xe6\xbf\xa3\x24\xd2\x66\xa2\x02\x0b\xb1\x14\x5b\x3b\x52\xec\xa1\x58\x35\x56\x12\x07\x60\x36\xc0\xa0\xb9\x08\x6e\xd6\x16\x7c\x1d\xcc\xd7\x92\xdf\x85\x13\x05\xa6\x5b\x78\x9a\x1e\x53\x81\x80\x09\x6c\xf0\xfd\xea\xc7\xe8\xe4\xce\x20\x77\xb8\x73\xc7\x63\xb4\x47\xa1\xf6\x2e\xb4\x3b\x07\xfe\x46\x8a\xe8\x0c\x00\x35\xa6\xc4\xdc\x9e\x01\x4b\x6c\x02\x0b\xb9\x10\x51\x29\xe6\x13\x39\x65\x68\xa9\xc0\x37\x76\xd7\x6f\x8c\x27\x2a\x90\xee\x46\x65\xbc\xe5\x65\xbc\x63\x7c\x13\x23\xb8\x91\x51\x19\x5b\xb8\x03\x89\x50\x52\x14\xd6\x92\x69\x13\x44\x2b\x2b\xe8\x4c\x6d\xce\x04\x6c\x33\xe9\x18\x77\x4c\x94\xfe\x19\x99\x17\xd6\x8c\xca\xa4\x13\xe8\xf5\x36\x4e\x60\xb5\x90\x7a\x1b\x27\x6b\x99\xb3\xf1\x71\xd8\x3a\x9d\x5e\x05\x3e\x98\x06\x3c\x63\x3c\xeb\x89\x22\x00\xcd\xe8\x13\xf6\x22\xc8\x5c\x61\xb6\xd1\xc6\x87\x43\x72\xeb\x89\x91\x98\xda\x97\x13\x38\x3d\xb8\x3c\x79\x20\x68\x56\x22\x0f\xc4\xcb\xb0\xe2\xaa\xbe\xc6\xb8\xba\x60\x0f\xa1\x9e\x62\x1e\x02\x76\x0d\xad\x06\x32\x0a\x22\x26\xb6\xd9\xe4\x28\x7b\xfd\x33\xbe\x11\x55\x30\xea\xab\x6b\x0e\x5e\xbe\x1d\x82\xb1\x02\x86\x09\x6c\xc8\xfa\x51\x04\xae\x89\xb9\x66\x89\xc0\xbc\xba\x06\x03\x52\x7f\xc1\xcf\xc7\x16\x0b\xef\x6f\xfa\x19\x3d\x26\x88\xd3\xf7\x36\xbd\x0c\x7e\xbd\xd3\xdd\x19\x45\x52\x86\xc8\xdd\xa3\x54\xcc\xc6\x54\x37\x65\x4c\x08\xab\xc6\x9a\x74\xb1\x26\xf3\xcd\x75\xcf\x12\xb1\x57\xd7\x7c\x25\x66\xe3\x7e\xfc\x67\x63\x04\xfa\xfe\x6d\xe2\x1f\x8f\xd2\x6e\x58\x19\x6c\x58\x88\x75\x03\x40\x03\xf6\xa8\x8c\x56\x8c\xc2\x5e\x99\x7d\x66\x51\x10\x2e\xcf\x6c\x4a\x19\x6e\x60\x6e\xcc\x79\x44\x65\xb7\xb7\xf4\x7b\x86\x10\xfb\x0f\xe6\x03\x5c\x5b\x5c\x86\xfb\xe7\xd4\x73\x1f\xec\x99\x9a\x76\x41\xb7\x6b\x58\x08\xee\x1a\x8e\x1c\xeb\xd4\x07\x01\x0b\x53\x48\xe6\x01\xcc\x48\x72\xbc\xf7\xc8\xce\xa4\xad\x81\x77\x38\xc2\x1a\xf0\x55\x8a\x0f\xf1\x2f\xc0\x4a\x00\x9f\x88\xf6\x3a\xad\xba\xdd\xe0\x05\xbf\x5b\x34\xa1\xae\x10\xd1\xed\xe6\x97\x02\xe2\x7d\x18\x97\x89\x40\x4e\x9b\xae\x8d\x27\xfb\x5f\x12\xd5\x18\x34\xf8\x1f\x71\x83\x6b\xf1\x86\xc2\xe9\x33\x8e\xb4\xcd\x5b\xca\x91\xef\x7f\x49\xf2\x73\xb5\x9a\xe9\x68\x2d\x37\x4d\x26\x67\x4a\x42\x4a\x7b\x11\x23\x65\x78\x97\xea\xd9\x0a\xdc\x93\x99\x21\x9f\x71\xb9\xe7\xd1\xa3\xfa\xe9\xb1\xb3\x46\xc8\xcf\xe7\x7c\xc9\xc1\x76\xd2\xb2\x0c\xa4\x63\x2e\x4c\x4f\x74\xa9\xc1\x71\x02\x79\x4c\x47\x86\x47\x3e\x56\x01\x73\x43\xc6\x6a\x9e\xa8\x80\xb1\x01\x09\xe0\x36\x05\xac\x49\xb6\x81\x85\xbb\x99\x2c\x51\x4b\x82\x14\xd4\x8d\xd8\xe5\x62\x9b\xf3\x9b\x53\x43\xe3\x4f\xb9\xd8\xe5\xce\xae\xa0\x4d\x89\xb3\xb9\xf5\x88\xf3\x8d\x1b\xce\xf1\xb3\x40\x64\x1f\xf8\x9e\x27\x4a\x07\xd4\x71\x80\x57\x0e\xca\x38\x77\xe9\x96\x1b\x0e\xa5\x37\x8f\x1e\x39\xba\x31\x85\x43\x22\x72\x67\x35\x99\x32\x88\x93\xd4\xed\x46\x85\xd0\x01\x79\x59\x36\xb2\x95\x36\x5b\x05\xd9\xd0\xf2\x16\x89\x6c\x3c\xd3\x77\xe9\xd6\xbc\x54\xf0\xa2\x72\x91\x4f\x8a\x29\x3c\xa6\x5b\x91\x4f\xaa\x69\x4d\xfd\xb3\x39\xca\x06\xbf\x0e\xc6\x67\x39\xfe\xd4\x06\x69\x68\xbb\x66\x42\x5d\x47\x34\x7b\xee\x76\x73\x58\x52\x37\xda\xb1\x4e\xb4\x89\xd1\x06\x7e\xbd\x7b\xc3\x36\x79\x53\x9b\x4b\x40\xcf\x7e\xb3\xdb\x2e\x7f\xdc\xf5\xe4\x8d\x15\x4b\xa1\xd7\xe0\xb7\xf3\x88\x79\x7e\xd8\xb8\x45\xf2\xd5\xf0\x50\x05\x6c\x7e\x48\x00\x22\x2a\x39\x99\x06\x08\x1d\xf5\x98\xea\xfa\x09\x64\x22\x6e\x07\x4c\x97\xd9\x16\xa3\x5d\x6d\xd7\xba\xaa\x50\x61\x00\x61\x63\xe3\xb4\x19\x19\x6b\x4d\xee\x23\x2e\x9b\x29\x26\xc6\x85\x35\x57\xc7\xe1\x0b\xed\x16\x0b\xe4\x66\xf1\x9f\x81\x61\xa6\xe3\x1b\x14\x47\x7f\x9f\xe6\x4b\x32\x3d\x44\xc9\x9a\x5d\x26\x2f\x60\xab\xcb\xc8\x9a\xa2\xd6\x86\xd0\x82\x44\x33\x76\x85\x6e\x80\xed\x7b\x38\xdc\xe4\x51\x0e\xc4\xa7\x16\x05\x41\x8a\xfa\x4c\xe7\x34\xd3\x72\xca\xf8\x0d\x50\x65\x87\x43\xa4\x44\xd1\x18\x3f\xd8\x41\x25\x1a\x90\xb3\xc8\x9d\x04\x75\x38\xb8\x67\xdd\xed\xaa\xea\xdb\xf4\x5b\x70\xd7\x89\xda\x8f\x45\x7d\xe3\xea\x13\x5f\x02\x60\x92\x09\x1b\xba\x0f\x3e\x19\x41\x08\xe0\x4e\x94\x66\x6c\x5c\x82\x33\x65\xaa\x10\x54\xd7\x04\xb8\x7e\x28\xe2\x53\x81\x61\x14\xc9\x7e\x11\xce\x38\xbb\x2a\xc2\xe9\x65\xc7\xa7\x4a\x42\xcd\xc6\xa8\x4b\x34\xbd\xf0\xa3\x5d\xb0\x89\x2b\x82\x41\x08\xb9\xee\xd5\x8e\x26\x3b\x9e\x4a\x66\x4f\x1b\x0e\xcc\x3d\x03\xf9\x23\x96\x72\xf2\xe1\x86\xf4\x32\xd2\x8c\x24\x9c\xc1\x20\x6d\xb7\x9d\x98\xdc\x6d\x2a\x09\xe8\x82\x1f\x9f\x1b\x0f\x7b\x9a\xc0\xd2\xe0\x5c\xe0\xea\xe4\x21\x17\x7b\x8b\xd1\x39\x2e\xcc\x71\xf4\x29\x8f\x6f\x08\x95\xac\xc4\x43\x8e\x90\xfe\x1d\xc0\x78\x54\x65\xff\x70\x02\xed\x31\xc3\xab\x1a\xc4\xaf\x41\x82\xda\x24\x91\x8a\x8d\x41\x89\x02\x0d\x97\x71\x4f\x27\xb5\x13\x5c\xab\x82\x0e\xb1\xf5\xaa\x69\xd4\xba\x60\xe5\xf1\xbc\xb5\x98\xe8\x9d\x01\x1a\x66\x43\xa8\x45\x24\x43\x91\xaa\x05\x89\x6f\xe2\x0a\x62\x46\xe3\xf9\x86\x8b\xc7\xbf\xa7\x5b\x36\xca\x41\xea\xaa\x9e\x0f\xc6\xa0\xab\xb4\x15\x83\x24\x07\xd9\xab\x82\x3f\x90\x45\xe5\x62\xc0\x8e\x50\x51\x4a\x8a\x0b\xa8\x34\x70\xa7\xf2\xe0\x4c\xc9\xb8\xda\x2c\x41\x35\x43\xce\xdf\xe1\x0d\x16\xe6\x4c\xb7\x67\x72\xa6\x5b\x7f\xa5\xe1\x9d\x03\x5d\x50\x70\x53\xde\xa9\x3c\x69\x2d\xa2\x00\x6c\xc1\xfe\x06\x18\x5d\x2b\x12\xe6\x49\x4c\x3d\x6e\x8b\xe1\x2b\xaf\xe7\x61\xc1\x45\x89\xb7\x1c\x8d\x1f\x9f\xdb\x1b\x4f\xb7\xb5\xc6\x83\x22\x61\x1e\x68\x3c\xdd\x7a\x58\x89\xaf\xb5\xc6\xd3\x2d\xc8\xb8\xcc\x05\x8d\x5d\x7b\x01\x63\xc1\xda\x53\xaa\x12\x93\x7b\x43\x1a\x08\xa6\x81\x0f\x04\xf3\x4a\xad\x93\xaa\xdc\xb6\xd7\xe3\xb5\x65\x07\xeb\x80\x3b\x95\xf7\xfb\xcc\x19\xa6\x22\x72\x72\xb2\x8f\x38\x81\x93\xda\x2e\x42\x4c\xa5\xd2\x72\x0d\xf2\x70\xf4\x11\x5e\x93\x99\x8f\x4a\xa9\x37\x65\xfe\xff\xb2\xf7\xb6\xcd\x6d\xe3\x66\xdb\xf0\xf7\xe7\x57\xd8\x9a\x56\x43\x58\x10\x2d\x79\x37\xfb\x42\x19\xd6\x24\xd9\x4d\x93\x6e\x36\x9b\x6e\xd2\x76\x73\x69\x34\x19\x46\x82\x2c\x34\x14\xa8\x92\x54\x2c\xaf\xa5\xff\xfe\xcc\x71\x02\x20\x41\x8a\xb2\xbd\xbd\x7b\xdf\x9f\xae\x0f\x89\x45\x10\x00\xf1\x8e\xf3\xf5\x38\x4f\xf4\xd8\xda\xcb\x91\x27\xba\xbb\xda\xcf\x75\x0d\xe5\xc6\x1d\x33\xe7\x9a\xf5\x86\x51\xe0\xc8\x04\xcb\x96\x97\x0d\x23\x6a\x48\xed\x76\x43\x60\x72\xa3\x57\xd5\xcc\xe1\xe4\x45\xbc\x3d\x7e\x50\xa8\xe5\x18\x30\xe6\x63\xe1\xdb\x5f\xde\xbd\x7a\xff\xea\x1f\x3f\x7e\x7c\xf5\xe6\xc5\xab\x37\xaf\xde\x7f\x70\x1b\xab\x0c\xf2\x1b\x27\xcf\x97\xd8\x60\x79\xf4\xb3\x7e\x90\xac\x39\xdc\x68\x74\x39\xfb\x23\x4b\x1d\xb8\x73\xe3\x14\xb9\x95\x17\x6f\x83\x0b\xd8\x00\x61\x35\xd3\x9a\x26\x3b\x1e\x5a\x60\x7c\x9d\xc9\x99\x82\x1d\x57\x24\xc3\xf2\x37\x77\xe3\x1e\x1d\x5c\x41\x60\xb2\xb6\x72\xfe\xce\x4d\x4c\x35\x47\xb0\x28\x74\xc4\x82\x68\xa1\x88\x9c\xf0\x04\x2a\x2f\x98\x72\xbb\x72\x3c\x11\xf9\x6e\x37\x24\xab\x6d\xd7\xf4\xfe\x90\xf4\x3b\x68\xaa\x35\xd7\x86\x70\xde\x6b\xe0\x02\xeb\x41\x69\x7e\x2d\x6c\x37\x70\x7c\xaa\x99\x7c\xb3\x59\x05\xc1\x75\x7f\xc1\xce\x37\xe7\x09\x3b\x4b\xc0\x44\xaf\x81\x90\x34\xfc\xba\xdb\x7d\xa7\x83\x39\xa3\x3f\x4b\xa7\x60\x9c\x2c\xf8\xf5\x74\xe4\xa0\x07\x68\x0d\x5d\x9f\xaf\x6b\x8b\x67\x71\xbe\x66\xec\x6a\xd3\xed\x06\xfe\x47\xb2\xb3\xb5\xfd\x04\xe3\xf9\x6e\xf7\x4e\x07\x33\x36\xd6\xc2\x47\x26\x7a\x89\x63\x9e\x2e\xa2\xb7\x49\x3c\x93\x79\xb0\x66\x2c\x0a\xea\x79\x66\xd0\xe9\x54\xdf\x5e\x9f\x69\x76\xae\x4b\x85\x74\xd5\x80\xb3\x35\x8f\x1b\x6d\x3c\x5b\x73\x98\xa8\x9f\xda\x5e\xbd\x0c\xe3\x64\x95\xe6\xc5\x3f\x97\x69\x22\x83\xf9\xf9\x9a\xaf\xcf\x87\xf2\x2b\xb0\x26\x4a\xcc\x19\x47\xbe\xe5\x41\xbe\xa5\x9f\x2f\x16\x4b\x72\xb7\x08\xe2\xbe\x62\xe7\xe0\xf7\x5d\x66\x0b\xb5\x96\x71\xef\xf6\xcd\x98\x2d\x39\xae\x25\x46\x55\x2b\xb3\xb2\x23\x36\x12\x37\xf5\xce\x75\xc4\xa4\xc5\x26\xcd\x7a\xd2\x52\x5f\xc6\x2a\x9a\x1b\xf1\x18\x4e\x87\x95\x18\x8e\x56\x84\x0b\xb9\x72\xfe\xb6\x5e\xf1\x40\xf5\x56\x67\x6b\x46\x95\x94\xfc\x5d\x55\xd9\x92\x8d\xe3\x68\xc9\x78\xba\x27\xda\x69\x54\x84\xc7\x76\x20\xd9\xf5\xe1\xde\x81\x19\xc6\x16\x66\xfb\x74\x78\xc1\x2a\x43\x91\x15\xbf\x74\x2e\x9c\x30\x56\xb1\x3f\xa9\x10\xd1\x1f\x76\x91\x22\xc6\xad\x5d\xb8\x64\xbd\x5c\xbe\x53\xba\x7a\x17\x6f\xff\x90\x6f\xd6\xc8\xee\xa9\xa7\xb9\x39\x57\x72\xb7\xc9\x2c\x57\x82\x36\x38\x5d\x22\x51\xcd\xe5\x7b\x47\x1d\x0e\x18\xaf\x5d\xfd\x6d\xdf\x76\x64\xfc\xbd\x5c\x82\x3d\xa8\xe9\x68\xb6\xe7\x8e\x63\x21\xb1\x55\x63\xb3\x19\xef\xe7\x14\x24\x38\x3e\x77\x8c\x19\x26\x03\xd8\x7f\x0e\x0d\x30\x50\x7d\x51\xd8\x15\x58\x9e\x5e\x15\xef\xc9\x87\x40\x98\x8a\x7b\x86\x71\xf4\x68\x3f\xa1\x00\xfe\x28\xf5\xdc\x3c\xc5\x5c\xfa\xd4\x9e\x88\xfb\x0a\x14\xd9\xdb\x1a\x45\x46\xc2\x2f\x87\x4b\x71\x04\x15\x02\x12\xe5\x38\xdb\xef\x2b\xb0\xae\xa7\x6d\x96\x46\xd5\xd1\xcc\x73\xd1\xca\x6e\x88\x89\x26\xc0\x45\x7b\xdb\x1b\x56\xb3\xfc\xad\x61\xf1\x38\xfb\x3c\xb6\x4c\x32\x90\x34\x6c\xd2\x34\xfc\x57\xaa\x74\xd0\x09\x3b\xe5\xfa\x2e\x4b\xc1\x82\x0a\xf7\x14\x50\x67\xd1\x2f\x58\x72\x69\x79\x1d\x4d\x10\xb3\x09\x2f\xf7\x88\x71\x61\xea\x91\x73\x5c\x01\xf0\x4e\x5b\xa7\x39\x49\x1f\xb5\x24\x70\x2b\x3b\xb6\x1e\x6e\xd6\x9c\x70\xb3\x20\xed\xf3\xec\x55\x03\x05\x44\x56\xcb\x61\x64\xd8\x15\x6c\xb7\x2b\x9f\xe2\x2d\xdb\xed\x0c\x47\x0a\x6c\x2d\x83\x29\xbd\xdb\x05\xc9\x24\x9e\x0a\xfc\x07\xa1\xd6\x06\x0f\x1b\xfb\x90\x02\x01\x39\x06\x3a\xe3\xdb\x54\xe9\x22\x1f\x23\x97\x18\x0e\x06\x11\x55\x0e\x66\x82\xea\xbd\x1c\x8c\x51\xa4\x07\x63\x38\xa5\xa3\xc4\xfd\xc6\x2e\x2a\xe7\xe4\x87\xfa\x58\xe3\xa2\x29\x05\x16\xd4\x31\x05\x79\x2a\x9d\x22\x8a\xc5\x8d\x8e\xe9\x89\x2a\x3b\x16\xd7\x3a\x16\xdb\x8e\x49\xd3\x31\xe5\x75\xac\x95\xb2\x33\xa5\xed\x41\x52\xae\x5e\x7a\x04\x84\x25\xe8\x2d\xa2\x60\xdf\x6b\xf1\xfc\x8f\x8a\x6f\x3c\x0b\x6e\x84\xf1\x2d\x97\x5b\x2a\xe2\x36\x26\x15\x56\x47\xe1\x31\x91\x19\x34\x27\x22\x2b\xd7\xc5\x06\xe8\x96\xf3\x4a\x62\x0f\x6c\x2d\xf4\x44\x1c\x23\x64\x4c\x67\xdc\xeb\x37\x3f\xfe\xe5\x69\xfd\x75\xb9\x40\x13\x66\x6d\x89\x46\xa7\x49\xb7\x5b\xd0\xca\x2a\x58\x52\x11\xf0\x81\x14\x39\x24\x16\xa6\x2d\xce\xf2\x68\xe4\x72\x6a\x91\x4e\xca\x2c\xb4\x33\x0c\xeb\xcd\x93\xf1\x53\x1d\xc4\x7c\x83\x71\x61\xd1\x0f\xf8\x8d\x5f\x0e\xc0\x79\x53\x87\x11\x83\xb9\xdf\x3a\xcd\x5d\x7c\xca\x22\xd4\xf2\x1a\x66\xed\xb5\x29\xa4\x47\x6e\xce\x7b\x05\xc2\xb8\x3e\x8b\xd4\x65\xbc\x8e\xb7\xc0\x6d\x34\x76\xf1\xa8\xe0\x65\x58\x3a\x09\x50\x82\x71\x1b\xa8\x16\xd3\x98\xfe\x44\x03\x5b\x61\x3d\x7b\xbc\xad\x65\x8f\xb7\x94\x3d\xde\x46\x43\x1e\x87\xed\x6c\x58\xc0\x1e\xa0\x42\xb1\xc2\x8a\xfb\x8c\xcb\xaa\x1b\xba\x0a\xb7\x75\xfe\x35\xac\x4e\x8a\x76\x7d\xc6\x21\x75\xce\x78\xa3\x12\xab\x11\xa8\x81\x71\x95\x9c\xe4\xe1\x75\xeb\xb7\xb7\xa5\x89\xbb\x9d\xc7\x7f\x97\x97\xed\x83\x92\x37\xbf\xcf\x75\xa3\x74\x0b\x68\xde\x22\xcc\xf1\xc4\x66\xf7\x4a\x8e\xea\xb5\xb7\x49\x55\xda\xcd\xd3\x7d\x2b\x69\x2b\x6d\x39\x90\x45\x3c\x20\xf8\xa8\x75\xeb\x3f\x13\x77\xfc\x31\x91\x4e\x49\x6a\xfc\xc7\xb2\x1d\x86\xdb\xf6\x95\x16\x6f\xf5\xe8\xbd\x2f\xee\x78\x65\xc4\x1d\x2f\x5a\x80\x32\x7f\xd1\xce\x04\x96\x50\x3c\x29\xdf\xeb\x3f\x7e\x5f\x57\xf0\xa1\xfe\xa5\xfd\x6b\x7d\x85\x78\x9b\x10\xc2\xfb\xe2\x4a\xc0\xc2\x5b\xee\xf1\xcd\xdf\xff\x03\xd9\x7a\xa5\x1a\x36\x07\x74\x2e\x52\xb7\x5f\x78\xe2\xa4\x88\x64\x64\x5d\x3f\xa4\xe7\x07\x52\xc3\xaa\xc9\x4b\x6f\xea\xa1\xd6\xab\x94\x0b\x29\x94\x0b\x85\xa7\x5c\x40\xc2\x3e\xbd\xff\xc8\x4e\xef\x3f\xb2\xa9\xf4\x9b\x94\xe4\x35\x47\x2b\xa1\x39\x99\x91\xc6\x8d\x6e\x8e\x5a\xa4\xce\x59\x79\xd6\x17\x97\x1b\x77\x95\x20\x9c\x29\xc4\x41\x22\x39\x34\x06\xe5\x49\xbb\xed\xe8\x92\x04\x98\xbe\xe4\x03\x77\x03\xbb\x9b\x21\x7e\xed\xa7\x4c\xc6\x9f\xf7\xc0\xd4\x74\x8d\xd8\xed\x66\xd6\xee\x01\x58\xce\x47\xda\x40\x19\xae\xc5\xa4\xbd\x25\xac\x41\x99\x95\x75\x7b\x14\x9a\x6d\x06\x81\x08\x73\x79\x48\x9b\x11\x3e\xd4\xd1\xfe\xd0\xd8\x94\x75\x2d\x26\xd7\xd3\x6e\x37\xc0\x1f\x31\x99\x32\x0b\xe0\x13\x28\xb1\x99\x14\xe6\x38\x62\x75\x30\x1f\xdb\x81\xb5\x40\x91\x91\x16\xe9\x51\xca\x4c\xd7\x08\x18\x5d\x27\x60\x3c\xca\x4c\x3b\x22\x4b\x1b\x22\x6b\xb7\x0b\xd6\x20\xc0\xd6\x96\x3c\xc3\xdf\x1e\x99\x52\x6d\x19\x81\x45\xe3\x5a\x5d\x34\xae\x55\x28\xa8\x2d\x5a\xf2\xc0\x9d\x22\xe6\x02\xb5\xa8\x94\xb8\x38\x0b\x44\x63\xad\xdd\xb4\xf4\xc8\x25\xe3\x69\xfd\x8a\xa5\x47\xae\x71\x76\x58\x4c\xd3\xff\xe2\x8a\x62\x96\xbe\x7d\x68\xa0\xff\x2f\x0d\x6e\x63\x08\x28\x07\xfa\xaf\xf4\xc1\x30\x50\xbd\x26\x91\x71\x10\x49\x94\x19\x5a\x35\x7f\x8f\xb6\x55\x65\xdf\xc1\x5e\x32\x6d\xd2\x26\x94\xc0\xc6\xf4\xc7\x06\xc4\x69\xd2\x23\xe6\x93\xc8\x12\x6f\xcb\x2c\xd5\x17\x9b\x95\xb9\xaf\x8d\xfd\x27\x53\x0e\x87\xe0\x3d\xe4\x4b\xfb\x9b\xc7\x8a\xbd\xe0\xa4\xab\xb4\xf8\x15\x38\xa0\x18\x45\x2b\x4f\x26\x32\xdb\xa6\x12\x2b\x8e\xce\x1c\x48\x2a\x31\x9e\x85\x19\x4f\xb4\xd4\x3d\x8d\xad\x88\xd9\x97\xcd\x78\x22\x98\x5f\x2c\x85\xcf\x98\x95\x7e\xc6\xdb\xfb\xb3\x82\xd4\xef\x0d\x19\x48\x2a\x54\x2f\x86\xb6\xd0\x70\x00\xfb\xaf\x52\x74\x4b\x62\xe4\x47\x7c\x19\xd5\xf5\x87\xb5\xa2\x95\xdc\xd5\x7a\xfc\xaf\x94\x1e\xdf\x5b\x89\xd2\xd4\xa6\x68\x38\xa8\x37\xc1\xce\x9c\x6b\x09\x9c\x9d\xbc\x64\x93\x07\x02\xe0\x78\x7b\x39\xac\xbf\x7a\xb0\xcd\x2c\xaa\xe5\x1f\xd6\xc1\x7b\x1e\x39\xdf\x5c\x43\x6f\x58\xbf\x26\x49\xce\xa9\x74\xe4\x16\x01\x23\x91\xa6\x9b\xfc\xc7\x88\x22\x21\x86\xcc\xc4\x0b\x3b\x32\xfc\x78\x57\x68\x91\x31\xc6\x4a\x3c\x11\xff\x0d\x26\xc6\x61\x90\x92\x64\x8e\x16\xdf\xb9\x5f\x5b\xca\xd8\x08\x9a\xe5\x6c\x1c\xe8\xc3\x0a\xca\xc1\xa9\xdc\x50\xcc\x6b\xff\x5d\xad\x3e\x4d\x9c\x07\x49\xb8\x32\x48\xeb\xd4\x59\xe3\x6d\x74\xf0\x9d\xac\x59\x79\xd6\xac\x91\xd1\xd5\x9e\x08\x7d\x39\x38\x5c\x44\x84\x41\xcf\x58\x34\x1c\xcd\xd3\xbb\xea\xd3\x43\xf4\xaa\xd7\x53\x10\xda\x88\x21\x4f\x44\xaf\x87\x98\x7b\xe3\x61\x94\x30\x9e\xd5\x05\x7f\xf5\xcf\x9d\x25\xec\x3c\xd9\xdf\x2c\x15\x62\x3c\x5c\xa6\xbb\x1d\x74\x09\x29\xb4\x3f\xb9\x69\xc8\xc6\x4e\x4c\xbc\xe5\x25\xbc\xee\x89\xfd\xf2\x86\xf1\xb8\x14\xe7\x3d\x52\x62\xa7\xc5\xa9\xfe\x0f\x45\x75\x50\x50\x7b\x02\xbf\x87\x25\x77\x25\x19\x4d\xf7\x47\x0d\x41\xc9\x09\xec\x1e\x2b\x8d\x33\x88\x4a\xff\x0f\x99\x9e\x87\x38\x03\xd3\xa5\xff\x03\xae\x80\xac\x78\x5e\xa8\x2c\xa7\xf1\x3b\xe4\xb1\x3c\x1d\x6f\xb9\x7a\x8b\xca\x2c\xda\x7b\x51\x8f\x02\x20\x19\xab\x6d\x03\x79\xbf\x20\xb3\x3a\x69\x30\xdd\x70\x10\xb9\x57\x66\x59\x30\x6e\x6d\x43\x02\x69\xc1\x5c\xea\x7d\x08\xfc\x63\x0e\xa4\xcf\x8b\x03\x27\xf2\x12\xf2\xba\xd5\x9e\x10\x8a\x10\x76\x5e\xa2\x5a\xe0\x8e\xf1\x85\x9b\x38\x2b\x2a\x23\x08\x6b\xa3\xac\xcb\x04\xba\x45\x45\x75\xee\xf6\x29\x3f\x3b\x0f\x86\x7d\xfd\x10\x57\x5b\xcd\x30\x7c\xf0\xed\x40\x07\x85\xe8\x35\x54\xc4\x20\x93\xaf\xe8\x50\x02\xbf\x04\xa5\xac\x36\x5f\x64\xfd\x9a\x20\x96\x9d\xd7\x24\xaf\x3d\xf7\xe4\xac\xa8\x65\x1b\xef\xac\x1f\x62\x81\x6b\xad\x94\xed\x4c\xaf\x5b\x24\x98\x2a\xdd\xed\xe2\x0f\xb5\x77\x3c\x88\x6a\x4b\xc3\x6f\x6d\x2f\xd0\xfd\x46\x0b\xcf\x64\x9d\x37\xdf\x33\xfe\x46\x8b\xd7\x7a\xf4\xbb\xcf\xc8\xbe\x31\x8c\xec\xb3\x16\x46\xf6\xc7\xa3\x11\x1f\xf8\x3f\xb5\x38\xc0\x25\xe7\xff\xd0\xc2\x87\x98\x36\x51\x8f\x64\x1d\x9d\x7a\xe6\x4c\xc5\x3b\x3c\x46\x04\xcc\xff\x0c\x99\xfa\x61\x90\xe7\xd2\xcc\x30\xba\x9b\xa9\x0c\x18\x52\x59\x04\xdf\x3b\xcb\x74\xe7\xcb\xf4\x86\x8e\x96\x67\xf1\xec\xf3\x3c\x4b\x09\x6f\xf9\x93\xfd\xed\xe3\x53\x5f\x3c\x79\xc2\xdd\xbf\x41\xf8\xed\x13\xd6\x29\xb3\x59\x5b\xd8\x0f\xd1\x45\x33\xe9\xb7\xe8\x82\xdf\x2f\x86\xe7\x6b\xc8\x8a\xed\x99\xeb\xf7\xde\x6d\xae\x68\xe8\xe1\x3b\xfb\x0b\xc8\xae\x8d\x62\xef\x4b\x05\x5e\xfa\x6b\xab\x6e\xcd\x22\x9d\x61\x70\xb7\x5b\x61\xf0\x3f\xd3\x81\x7c\xc4\x3e\xee\x5d\x9c\xc9\xf0\xa0\xbb\x1e\xda\xfe\xbf\xac\xb8\x1a\x74\x48\xd5\x34\x13\x91\xa4\xa0\x98\x1c\x77\x16\x63\xa1\xaf\xcf\x2f\x08\x67\x41\xf6\xf4\xf9\xc5\x3e\x2a\x2e\x15\x8c\x6c\xfc\x0c\xe6\xf5\x3e\x72\x29\x2e\xbb\x07\x30\xff\x6f\x7f\x08\xb0\x2f\x8a\xdd\x6e\xf8\x9d\x0d\x8d\x62\x8d\x6e\xa3\xe2\x72\xf8\xdd\xa0\xe1\xd8\x53\x55\xf1\xf7\x56\xad\x87\x0e\x6f\x7b\xca\x44\x63\xa8\x22\xed\xd4\x78\x2d\x77\x39\x94\xb1\xdd\x8a\xca\x4a\x57\x4e\xe2\x29\xd7\xe1\x96\xa7\x8c\xa7\x3d\xa1\xc8\x32\xf9\xc4\xcf\x60\xdf\x56\xcd\xf8\x60\x9b\xc1\xee\xbe\xb7\xfd\xb8\xf8\x96\x7e\x8c\x75\x78\xdb\x07\x74\x10\xa2\x93\x14\x57\x17\xdf\xe2\x6a\xba\xfc\x1e\x21\xb3\x03\xf7\xca\x53\x18\xfc\xc5\x1f\x11\x34\xdd\x88\x3c\x00\xb9\x01\x4f\x7f\x6c\xed\xdf\x6a\x42\xfa\xc7\xe1\x93\x36\xf1\xc5\x7c\xc0\xa2\x0a\x04\xab\x04\xe7\x79\x9f\xae\xc5\x4b\xef\xa6\x80\x6e\xab\x08\xb7\xcf\x69\x46\xfc\x5b\xb0\xb4\xf6\xc6\x25\x70\x7b\xf8\xbe\x84\x36\xed\xfb\x75\x33\xb8\x25\x19\xa3\x5a\xa5\xaf\x11\x9a\xbb\xe2\x1a\x5b\x0b\x70\xfb\x1d\x76\x7e\xd1\x0e\x0d\xda\xda\x6f\xe2\xd0\xe8\x80\xe2\xf7\xc8\x9f\xd4\x51\xd9\x93\x13\xda\xcb\x3a\x91\xe2\xc9\x1a\x60\x35\x0e\x9f\x08\x79\xc8\xde\x67\x36\x0e\x7e\x2a\x64\x53\x1e\x90\x95\xea\x00\x53\xa9\x57\xa1\xc4\xbe\xc3\x24\x67\xa2\x57\x34\xee\x39\xc9\x46\x56\xad\x05\x7c\xe8\x03\xde\xde\x11\xd7\x18\xc4\x8c\x57\xba\x7a\xd0\xa0\x19\x37\x9a\x01\x08\x30\x2c\x35\x0a\xca\xf6\xd8\x98\x8c\x07\x91\xb6\x7c\xa1\x12\xe2\xe8\xf8\x8c\x07\x91\xe2\x45\xd8\xce\x34\x3f\xa8\x0d\xb0\x6b\xbc\x21\xae\xf7\xd6\xc4\xf9\x4b\x5d\x13\xf0\xb3\x3f\xa6\x9d\x7e\xfe\x48\x5a\x16\x2b\xd1\x3b\xbd\xdd\x92\xa1\xf1\x35\xd6\xfa\x79\x03\x8b\xc8\x9d\xcc\x3e\x26\x95\x6b\xa4\x5f\x55\xf9\xba\xb2\x18\xe7\x15\x35\x20\x77\x3b\x1c\x10\x72\x2c\xa3\x4e\x67\xcf\x1e\x4b\x4f\xb7\x0a\xf3\x1f\x45\x4e\x1f\x05\x47\x74\x6d\x1f\x95\x17\x4a\xb7\x5b\x1b\x93\xf2\x9e\xa9\x1a\x54\x87\x6f\x6a\x57\xce\xb4\x8d\x09\x18\xb0\xbb\x24\x1a\x70\xc4\x21\xb1\xd8\x69\xd1\x80\x7f\x8a\x5a\xf7\xfd\x9e\xa7\x90\x9d\x92\x3f\x13\xd1\xa9\x22\x76\x4e\x15\xd0\x01\x97\xd5\xe2\xda\xcb\xe1\x85\x8a\x46\x59\xe7\xfa\xd6\x89\xb4\x67\xbe\x75\x71\x1a\xc9\xcb\x39\x41\x4d\xdc\x29\x0b\xf4\x87\x1a\xdf\x5a\xe2\x26\x90\xf5\x33\xaa\xf7\x04\x7e\x35\xd4\x16\x9e\x88\xd8\x53\x28\x91\x89\x90\xd7\x49\x20\x56\x78\x11\xb9\x82\x0d\x1b\xdf\xdd\x44\x2f\x7d\x78\xa6\x20\xe7\xb9\xf5\x9f\x62\x7c\x19\x39\x29\xe2\x59\xb2\x8f\xee\x6e\xa2\xbc\x16\x30\x69\xe3\x3c\x0e\x97\x51\xb2\x6f\xe9\xf8\x44\x4e\x85\xa1\xfa\x70\xc4\x5f\xdb\xf0\xa3\x4f\x41\x8e\x05\x92\xf1\x59\x0d\x58\x74\xc9\xfe\xfc\xd5\x37\x03\xbe\x10\xff\x02\xd6\xa7\x0a\xb7\x5c\x87\x37\x7c\xc0\x87\xdf\x0d\x18\xbf\x76\xa9\xb7\x5c\x87\x4b\xfe\xfd\x80\x5f\x7c\x3b\x60\xa3\x05\xa4\xca\x59\x71\x99\x85\x09\x5c\xaf\xc3\x44\xd8\x14\x9e\x86\x89\x58\x32\xbe\x80\x79\xc9\x55\x16\xc2\xa0\x3e\x0b\x33\x41\xcf\x3c\x0d\x33\xbc\xbc\x2e\x4b\x17\xf4\xba\x10\x36\x85\xa7\x61\x61\x32\x98\xd2\x9f\xe8\xf5\x27\x71\x6d\x4b\x7f\x42\x08\xa9\x02\xde\x91\xbf\xca\xf9\x86\xf6\x5e\x1e\xd4\xa6\x04\x77\x3d\xdb\xd3\x29\x61\x6d\xcb\x11\x20\x9b\x6e\x21\x52\xde\x3b\x82\x93\xed\x79\xad\x96\xa8\xdd\x12\x02\x35\x90\xb1\x48\x72\x5e\xc2\x6d\xe8\xb0\x12\x1a\xe0\x28\x95\x61\xd6\x57\x76\x3e\x06\xd6\x14\xc4\xe4\xcb\x20\x88\xe9\xcb\xd0\x32\x80\xb3\x34\x0f\x74\x58\x60\xcd\xf4\xbd\xd2\x9f\xfa\x81\x72\x4b\x5d\x79\x4b\x1d\xb8\x89\x7e\xc1\x4f\x6c\x14\x8b\xbf\x90\x85\x4f\x86\xbf\x54\xfb\x5f\x80\xf6\xc9\x73\x3c\xe7\x8c\x2b\x7f\x28\x44\xdd\x5e\xd8\x5e\xcf\xfd\x20\xee\x65\x74\xdd\xd6\x53\xd3\x5e\x8e\x54\xd4\xd1\x18\x31\xeb\x47\x68\x46\xcc\x7b\xd1\x18\xb2\x92\xdc\xaa\x14\xff\x16\x57\xb7\x1f\xd7\x66\x28\x15\x45\xaf\x9e\x92\x0b\xdd\x48\xc1\x6e\xb2\x43\x52\x42\x87\xbe\x4f\xd7\x7d\x55\xaf\x6b\x14\xb7\x91\x20\x41\x4a\x3d\xec\xc5\x16\x7f\x21\x6e\xa5\x43\xf2\x5e\x62\x32\xc1\xe1\xcc\xff\x88\x39\x77\xab\x1d\x13\x1d\x9c\x71\x3e\x60\x89\x16\x41\x71\x16\x7c\xf5\xcd\xe0\x5c\xb6\x9c\x2b\xac\x17\x54\x98\xdb\xc0\x72\x60\x66\xa1\xd3\x56\x04\xb2\x01\xed\x3d\x7b\x01\x04\x10\x69\xe9\xde\x57\xdf\x0c\x22\x6d\x65\x04\x6f\x5f\x9d\x5d\x9c\x7f\xf5\xcd\x80\x9a\xf4\x83\x32\xe1\xa8\x5f\x64\xe9\xca\x74\xe8\x01\x36\xd9\x11\xbb\x9e\x85\x76\x03\x1d\xd0\x05\xbd\xf7\xc6\xf4\x1c\xb2\xbe\x78\xdb\x27\x86\xb4\xba\x9b\xda\x83\x04\x8c\x6d\xe6\x82\x9d\xe9\x28\x28\x6c\xa1\x33\x13\xa5\xbe\x76\x76\x46\x2d\xc2\xcd\x32\x52\xbc\x77\x3c\x15\xd6\xa2\xf1\xed\xab\xf3\x0b\xfb\xf1\xbb\xad\xb5\xd4\xc3\xfe\x61\x67\x56\x9b\x6c\x27\x9e\xdf\x46\xd5\x8e\x2b\x5f\xda\x09\xdf\x1f\xb6\xa3\x65\xc8\x9a\xa2\xa9\x66\x11\x04\xc3\xb2\xe9\xc7\xa7\x20\x90\x1e\xb6\x57\x4b\xa7\x6b\x4b\xc7\x88\x72\x9c\x09\xf0\xe8\xbe\x8f\x97\xf5\x17\xd0\x75\xb5\x40\x80\x49\x18\xa3\xd3\xca\x89\x24\x2c\xd0\xf5\x15\xd0\xc0\x06\xac\xd5\x2d\xff\x10\x77\x87\x20\x8d\x71\x81\xc1\x87\xca\xce\x31\x4f\x45\x56\xb9\xf1\x91\x23\x6d\xc5\xca\xf3\x44\x3c\x03\x8a\x76\xc9\xad\xf3\xb4\xfa\x0d\x67\x51\x7a\x4b\x3c\x3e\xb7\xae\xbb\xa4\x72\xcc\xda\xe8\x87\x6e\xb7\x65\x84\x5a\x9d\x61\xbd\xc2\x3c\x06\x2b\xa2\x71\x08\x17\x0f\x4c\x8a\x6e\x2c\x57\x4f\x43\x80\xd3\xb3\x95\x4a\x51\x6c\xe4\x39\x84\xd6\xfd\x92\x8f\xb8\x7b\x96\x26\xa0\xf9\x3d\x34\x46\x7f\x38\xca\xaf\xc4\x60\x94\xf7\xfb\xcc\xc6\x24\x05\xb9\x97\x8f\x63\x20\xe6\x11\xc5\x70\xb0\xf2\x72\x9e\xf5\x12\x90\x19\x73\xf1\x63\xdd\xd5\x37\x6f\x65\xea\xe9\x1d\x1b\xf9\xde\xa7\xf3\x23\x74\x40\x7e\x48\x07\x8c\x7c\x17\xd7\x7f\xc3\x4e\x98\x7f\xc0\xed\xdf\x42\x60\xe4\x53\xbe\x61\xfc\xef\x86\x24\xf2\xe6\x86\x5e\xf0\xd4\xb7\xad\xd9\xfb\xfe\xac\x81\x82\x12\xb1\x9c\x7d\xcb\xf5\x38\x50\xed\x8a\xa2\x2e\x60\x8d\x08\x6d\x11\xd4\x4e\x52\xa8\x07\xe6\x59\x35\xac\x41\x10\x6a\x8e\x1f\xb9\x9f\x68\xd9\x60\x89\x81\x11\x73\xa2\x23\x7e\xdf\xcc\xf1\x04\xa3\x2f\x1d\xb6\x03\x14\x6b\x1b\x93\x52\x6d\x02\x05\x30\x67\xb5\x08\x82\x74\xb7\xcb\x11\x9e\xa7\xdb\xdd\x90\xde\x39\x73\x6b\x29\xab\x61\x05\x24\x3c\xab\x4a\x8b\x0d\xcf\x1a\x18\x10\xb5\x67\x38\xa9\x94\x22\x30\xc0\xf2\x30\x5b\xba\x12\x89\x09\xd9\x8e\x9c\x93\xd5\x50\x08\x52\x96\x85\x71\x36\x0b\x4a\xd6\xbd\x62\xd2\xb9\xe6\x03\x7e\xe1\xae\x1d\x46\x12\x8e\xbb\xb8\x6d\x51\x42\x2f\xc2\x33\x87\x67\x10\x87\x5b\x1e\x87\xb7\x95\x19\xf4\x5c\x0c\x47\xf3\xcb\x7c\x34\x37\xd0\x79\x87\xe5\xe7\xa6\xbc\x45\x40\x70\xe5\xf7\x59\x38\x4b\xd2\x5c\xda\x96\xba\xd1\xa2\x9f\x9e\x3f\x74\xa0\x78\x8a\xe9\x24\xee\x95\x7b\x07\x89\x19\x70\x34\x22\x76\x23\x1e\x7b\x23\x9c\xf0\xb8\x36\xfe\x1b\x1e\xfb\x23\x0c\x47\x92\xda\x88\xff\x53\x07\x13\x1f\x5a\x83\xa7\xfe\xc3\x64\x3a\x65\xae\x7e\x6f\x0e\x9a\x85\x2c\xe0\x40\x7a\x98\x34\x40\xf9\x42\xa8\xa3\x6b\xae\x3f\x1c\xc1\xd6\x67\x54\xf4\xfb\xec\xe1\xf5\x9f\x35\xce\x39\x72\x8b\x8d\x94\x55\x0b\x09\x75\x38\x09\xb8\x85\x79\x5c\x5b\x1c\xb1\x9b\x52\x55\xae\x0e\xe5\x56\x87\xeb\xec\xfb\x34\x80\xa0\x4b\xc3\x59\xd0\x0d\x68\xc0\x46\x71\xcd\x65\xdd\xf3\xaa\xbe\x4f\x0e\x53\x3f\xe5\xad\x50\xf3\x08\x28\xcc\xc1\xf1\x35\x38\x7a\x7e\x6b\x10\xd8\xcf\x40\x7f\x3f\x06\x18\xe1\xde\xb3\xbe\x04\x03\x68\xd9\x2f\x1e\x38\x40\xf6\x07\xc1\x01\xec\xa9\x57\x1c\x9c\x7a\x19\x4f\xd8\x5d\x80\x63\x2f\x81\x69\x86\x9d\x4d\x1b\xa9\xfb\x81\x25\x50\x34\x8f\xc0\x04\xb8\xbb\xe1\x81\x1c\x1c\x2b\x5d\xc8\x1a\x3b\x99\x39\x76\xd2\xbf\x37\x74\x29\x17\xa6\x81\xb2\xef\x7e\x95\xb3\x22\xe8\xc7\xe7\x17\x7d\xdd\x94\x1b\xff\xc6\xfb\xaa\x9f\x86\xb9\xfa\x5d\xb6\xbd\xfe\xc0\xe3\xde\xc5\x59\x4b\x29\x53\xa4\xed\xdd\x07\xc6\x6a\x4d\xca\xed\x93\x69\x34\x1f\xf0\xbe\x35\x6f\xad\x23\x26\x54\x58\x00\x16\x2e\x77\xcf\xf8\x4f\x5a\xfc\x43\x8f\x7e\xf3\xf5\x22\x3f\x19\x0e\xf9\x7f\xc0\x97\x7f\x9c\x4b\x78\xec\xc4\x85\x9c\xf3\x3f\xb5\x69\x3e\xfe\xda\xa2\x3d\xf9\x5b\x29\x43\xfc\xf9\xd5\x9b\x8f\xef\x9e\xbe\x80\x20\xec\xfd\x8f\x7f\xf9\xf1\xd7\xdd\xae\xff\xfd\x60\xf0\xed\xf0\xfb\xef\x2f\x9e\x7c\xfd\xed\xd7\x83\xef\xbf\x1f\xf2\xa2\x14\x2b\xfe\xfc\xf4\xb7\x46\xee\x83\xcc\x92\x8c\x06\x92\x44\xe5\x72\x96\xea\x79\x74\x37\x4b\x57\xab\x54\x43\x7f\x80\xc1\x8a\x86\xe4\xf7\x94\x47\x43\xf9\xd5\x9e\x1f\xcb\x23\xbf\xb2\xb9\xc0\x3f\xac\x94\xde\x14\xf2\x20\xd3\x37\xf2\x6b\x2f\xd3\x32\xdd\x64\x07\x59\xbe\xfa\x46\x3e\xb1\x79\x2e\xbe\xde\xf3\x79\x7c\x7b\x90\xe5\xbb\x6f\xbe\x2e\xf3\x7c\x35\xd8\xf3\x1b\x29\x3f\x57\x99\x86\xf6\x53\x83\xaf\xbf\x2b\x73\x7d\xbd\xe7\xab\x54\x17\xcb\x83\xaa\x2e\xbe\xb9\xf8\x4e\x7e\x63\x73\x0d\x2f\xf6\xfc\xdf\x9b\x38\x2b\x64\x76\x50\xdd\xb7\xdf\x7d\xf7\x75\x99\xf1\xeb\x3d\xbf\x95\x71\x4b\xdb\x87\x4f\xbe\x96\xdf\xee\xf7\x5c\x2b\xf1\xcb\xa7\x7f\xc9\x59\x11\x7e\x96\xb7\x79\x20\x15\xab\x74\x2b\x4a\xd5\x79\x80\xbe\xac\xa4\xef\xb1\xaa\x49\xdf\xeb\x2b\x00\x08\x84\x6a\xe5\x4c\x7b\x68\xfb\xe1\xb7\x27\xbc\xcf\x1e\x55\x3c\xde\x56\xc5\x6b\xce\x02\xa9\x6a\xd0\x2e\x35\x13\x11\x90\x48\xee\xd2\x75\x36\x9d\xc0\x3f\xa8\x10\x01\x46\x29\xa1\x17\xa4\x97\x22\x1f\x11\x31\x02\x28\x5b\x38\x80\xf7\xf2\xab\xab\x21\xeb\x0f\xa7\xbb\x1d\x14\xe1\x20\x89\x00\x71\x7b\xea\xb0\x35\xef\x12\x6b\x1e\xb5\x54\x51\xb6\x27\xf2\x7d\x22\xa7\x97\x9a\xa5\x42\xf5\x86\x86\x36\x00\x5a\x6c\x10\x4f\xe4\xf4\x4a\x3b\x9e\x12\xc5\x62\x5b\x26\x17\xaa\x3f\x74\x21\xb4\xf1\x22\xc3\x0b\x54\xba\xdf\xdb\xd6\x93\x77\x40\x92\x12\xe4\xe8\x38\x0e\x93\x34\x2a\x26\x65\xe3\x2f\xa6\x11\x41\xdb\xa6\xb5\x3c\x4b\xe5\xe7\x19\x22\xcf\x70\xca\x73\x91\x02\xf1\x36\x83\x98\x2d\x41\xe8\x5d\x4d\xbf\xd9\x79\x4e\x84\x75\x90\x4e\x14\xde\xaa\x29\xfc\xec\xec\x6c\xe0\xb1\xb7\xa9\xc6\x3a\x57\x35\xce\x34\xfc\x18\xcf\xe3\x35\x4e\x7c\x2f\xfe\x0b\xcd\x17\x71\x4c\x64\xd3\x98\xa1\x03\x40\xd7\x30\xea\x3f\x00\x3a\xda\xda\x3b\xae\xda\xca\xaf\x19\x08\x14\xa9\x88\x83\x94\x31\xee\xdb\xa7\xc1\x7d\x3f\x15\x07\x6e\xd0\xd9\x58\x9b\x8f\x04\x29\xcf\x58\x54\x3e\x58\xcb\xaa\x53\x60\x11\xf7\xd2\x28\x88\x77\xbb\xea\x63\xa7\x65\x69\xaa\x34\x0b\x64\xdb\xb7\xbc\xba\x58\x4d\x65\x95\xd8\x21\x68\x11\x1b\xc8\x52\x6c\x80\x19\x1c\xb9\x41\xaa\x8d\x8b\x12\x34\x86\x87\x5a\x8a\x52\x8a\x60\x6d\xb9\xd4\x58\x45\x81\x36\x16\x37\x74\xb7\xf5\xaa\xe1\x36\xf2\x91\x5f\x16\x01\xe2\x01\x52\x0e\x58\x04\x79\x8d\xdc\x34\xf7\x84\x0d\x69\xa5\x1b\xee\x41\x5a\x79\x98\x03\xa3\x18\xd1\xfd\x49\xab\xe7\x00\xab\xa4\x9a\x68\x58\x4c\x4e\x21\x92\x91\xeb\x7c\x0c\x8a\x53\xae\xf3\xa8\x50\x3c\x0b\xcd\xa9\xd4\xed\x56\xca\x08\xa8\xdf\xd9\x79\x90\x9e\x65\x74\x5f\x31\x76\x29\x54\x39\x26\xa8\xa8\xec\xa4\x9a\xe4\xfd\xe1\xb4\x6a\xf1\x5c\xd5\x84\x8a\xd8\xa9\x30\xe9\x82\x20\x1b\xe8\xa3\x7e\xb3\x8d\xf3\x4f\x4e\xce\x3f\xe9\x24\x16\x72\xa2\xa6\x53\x81\x06\xf9\xe1\x3b\xe3\x96\xa8\x9d\x20\x1a\xf2\x6e\x57\xd7\x42\x71\x36\x46\xc9\x5f\xd5\xb9\xe8\xa5\xe5\x58\x03\x0c\xdb\xb8\xcf\x02\xd2\x38\x11\x72\xe2\x5a\xd5\x1f\xda\x17\x76\x54\xf3\x51\x7c\x29\x92\x51\x8c\xd2\xf1\x7c\x1e\xc4\x7c\x08\x4d\x52\x90\x09\x0d\xd3\x5e\x3a\x6e\x02\x39\xc9\x2c\x90\xb7\x38\x1d\x94\x2d\x94\xd8\xf6\x98\x2b\xcd\xa2\x6c\x8f\x46\x2d\x95\x6f\xbf\x8e\xfd\xa0\xe2\xa4\x81\xa1\x0c\x72\x31\x3c\x88\xc1\xdb\xb4\x4c\xaa\xca\x5a\x1d\x0e\xe4\xbf\x87\x61\x75\x0f\x68\xd0\x3a\xb8\xd1\x4a\x12\x3a\x20\x7e\x88\xbb\x3d\xf4\x65\xd5\x80\x09\x2d\x6f\x4e\x32\xd0\x0f\x80\x83\x92\xa1\x4d\xce\x43\x7c\xa1\xec\xe3\xff\xe8\xa0\x83\xe2\x27\x36\x80\xb7\xb3\x0c\xe0\x94\x6a\x1f\x3a\xf6\x89\x76\x61\xd6\x61\xfc\xb0\x14\x6e\x94\x8e\xbb\x5b\x28\xbf\xbd\x5b\xda\x73\xc7\x5b\x97\x3b\xde\x56\xb9\xe3\x6d\x07\xdb\x9f\x06\xef\x95\x47\x56\xbf\xa0\x56\xe4\xdc\x9d\x5b\x79\xc0\x1a\xc3\x69\xc6\xad\x0d\xde\xe8\x9e\x18\x26\x76\x08\x0a\xcf\x20\xbe\x20\x01\x3f\x54\xca\x45\xe3\x0b\xb5\x6a\xaa\x39\xa3\x80\x83\x7f\xd4\x91\xc1\x8f\x67\xe7\xdc\x17\xf2\x6a\xa5\xcf\xbd\x30\x77\x4b\x31\xa7\x33\x3c\xdc\x68\x55\xec\x76\x9d\x79\x7c\xdb\xe1\x33\x51\x28\xbe\x10\x7f\x83\x8f\xf6\x64\xca\xd7\xd8\x9c\x2b\xfc\xf7\xc5\x0f\x3e\x97\x07\xcc\x99\xec\x73\x2d\xbe\xb8\x5d\x5b\x5c\x6a\xf2\x14\x5b\x19\x93\xbf\x44\x05\x39\xff\x02\x03\x32\x3f\x77\xd0\xf0\xa6\x20\x16\xbe\x59\xc3\x31\xb3\x7c\xd8\x90\xc7\xa2\x51\x83\x33\x0a\xa7\xab\xc4\x50\x36\x41\x8c\x50\x1b\x64\xd0\xb0\x9e\x14\x53\x74\xc0\x80\xf6\xc5\x4d\xd0\xbe\x4c\x50\x33\x71\x77\x43\xd7\xe2\xac\x24\x51\x0a\x9a\xa3\x8c\xee\xf7\x13\x3c\x8a\x95\x75\xdd\x1d\x30\x9e\xee\x76\xc1\xb5\xb8\x76\xae\x69\x2b\x30\x60\xd8\xde\x55\xe6\xc9\x74\xb4\xb2\xdf\xea\x76\x83\x59\xa5\x80\x98\xf1\x15\xda\xe6\x07\x98\x5b\xf0\xd5\xc4\x65\xee\x0f\xc1\x0d\x5f\x57\x45\xaf\x85\xbe\x1a\x8e\x0f\x44\x83\x56\xb7\x78\xb7\xa7\x03\xf4\x58\x28\x01\xc0\x12\xc6\x13\x25\x10\x4e\x60\xba\xdb\x05\xf0\x86\x14\xa7\x03\x77\x86\xaa\xea\x3a\xca\xf6\xc1\x35\x0b\xf3\x34\x2b\x02\xa5\x58\x74\x5d\xfe\xe4\xb5\xb6\x5f\x1f\xb6\xfd\x7a\xe2\x5a\x6b\xda\x3e\xb3\x23\xaa\x82\x39\x63\xbb\xdd\x8c\x2f\x4c\x42\x66\x13\x16\x7c\x26\x66\x30\xfe\x50\xe3\xde\xa6\x3c\x76\x7f\x88\x0b\x19\xea\xf4\x26\x60\x7c\xc9\x22\x14\x5a\x08\x21\xfe\xa6\x91\x47\xea\x79\x33\x47\x6f\x18\x2d\x78\x5e\x99\x60\xbb\xe0\x7c\x3c\xaf\xac\xbc\xd1\xbc\x59\x6f\x68\x52\x3f\x16\xf1\xa7\x44\x62\x21\x50\xc0\x86\x95\xcc\x8b\x78\xb5\xce\xc5\x1d\x56\x4e\x74\xcd\xdd\x62\x8a\xd6\x06\xf7\x35\x8f\x56\xfb\x7b\xcd\x9e\x9b\x72\xe3\x3a\xf6\x56\x25\x3f\x86\x53\x11\x91\xc3\xe4\x52\x84\x0d\xc7\x37\x42\xf9\x6d\xe0\x73\x34\x6b\x69\x24\x13\xc4\x9f\x3e\x8f\xd7\xf1\x4c\x15\xb7\xd0\x6f\x91\xe3\x0e\x85\x4a\xe6\x0b\x41\xe2\xc2\x22\x53\x9f\x36\xa8\x9c\x66\x7d\x23\x3a\x68\x3b\x60\x04\x67\x5e\x7c\xe5\x59\xb7\xdb\xc9\x65\xa6\x64\x8e\x37\x8b\xf1\x86\xb6\x4b\xd4\x31\x9d\x43\xda\x6c\xbc\xb1\x62\x96\xe8\xe8\x3d\xe9\xdf\x92\x75\x6f\x69\xdb\x15\x9c\x33\xe6\xfc\xd8\xa8\x80\xe6\xe3\xef\x5a\xb9\x5a\xf8\x46\xfc\x89\xa4\x40\x25\x68\x84\xc9\x5c\xc2\x50\x0c\xa7\x90\xe6\x76\xc0\x22\xa1\x45\x49\xb7\x0b\xaf\xc7\xf4\x9f\x52\x7e\x9e\xc7\xb7\x7c\x29\x24\x9f\x61\x75\xab\x45\x30\xef\x76\x83\xa5\xe8\x55\x34\xd1\x92\x77\x6c\xd6\x0e\x9f\x33\xc6\x1b\x2f\xe7\x63\x3a\xd0\xc8\xa8\x39\x9c\xab\xc5\x22\xd0\x5c\xf2\x84\x5d\x0d\xe5\x93\xb3\x0d\x2b\x96\x59\x7a\x73\x22\x7b\x9d\x93\x58\xcf\x4f\x3a\x3d\x8d\x5f\x99\x3c\x29\xd2\xf4\x64\x81\xbe\xaf\xe3\xac\x38\xb9\x51\xc5\xf2\xc4\x35\xfe\x24\x5d\x9c\x74\x7a\x9b\x5e\xe7\xa4\xd3\x4b\x2c\x09\x00\x07\x14\x0d\x0a\x20\xb3\x14\xc0\x86\x27\x8c\xcd\xcc\xee\x8a\xcb\xcd\x15\x1b\xe1\xad\xb9\x8c\x80\xdb\x0f\x29\xd8\x46\xcf\xf3\xdd\xae\xcc\xcb\x67\x30\x8f\x06\x6d\xb2\x64\xf6\xda\xea\x88\x2a\x67\xb7\xeb\xb4\xe0\x24\xb4\xd8\x80\x1d\xc8\xc4\x66\xe2\x52\xb1\xf7\x10\xf2\x5c\xa1\x12\x05\xca\x7a\xb7\x03\x71\x45\x09\x99\x4d\xc8\x2c\x92\xf3\xa6\x89\xe4\x1c\x68\x72\x62\x62\x57\x22\x86\x02\x05\xd0\x32\x73\xd3\x30\x5d\x76\x82\x96\xb8\x88\x39\x2d\x71\x91\x01\x76\x1c\x93\x29\x12\xbb\x00\x82\xbc\x8c\xae\x30\xde\xa8\x20\x29\x17\x03\x15\x34\xc5\xb0\xb7\x9b\x8b\xad\x34\xea\xe1\xa9\x45\x18\x2e\xc9\xd7\xfe\x70\x94\x5d\xf9\xd4\x2b\x5a\xd3\xef\xe3\x16\x48\x85\x56\x93\x6c\xca\xa5\x9a\xa4\xd3\x92\x44\xad\x96\xab\x99\xf3\x98\x2b\x9e\xb2\x2b\x21\xfb\x43\x47\x9f\xa6\xae\x3f\x5a\x4d\xf4\xb8\x56\x77\x34\x98\x62\x0e\x1c\xb0\x2b\x6f\xed\x02\x48\x70\x44\xf0\xff\x57\x9a\xa1\x77\x22\xaf\x47\x7b\xe9\x76\x3b\xe0\xbe\x31\xc9\x76\x80\x6a\x67\xb7\xe3\x51\xa5\xdf\xab\x02\x71\x63\xb4\xd0\xaa\x79\x74\xab\x45\x60\x48\x73\x39\x75\x7d\xf4\xc8\x6c\x89\xd6\x9a\x6f\x30\x0b\x39\x84\xa6\x99\x83\xae\x39\xca\xc4\xf2\x76\x20\xb6\x8c\x33\xac\x2b\xb5\xdb\x9d\xba\xab\xc2\xd6\x39\xb9\xc3\x96\x8e\x24\x07\x26\xc1\x60\xcf\xcd\xa3\xa6\xc7\xe1\x7e\x3a\xf2\x38\x0c\x18\x91\xe0\xd0\x9a\x8b\x89\x9c\xda\xbd\x70\x10\xcd\x1e\x1c\x46\x90\x8b\x82\x88\x61\xd9\xed\xe6\x97\xba\x5c\x56\xb9\x21\x09\xca\x45\x66\x9d\xe9\xe6\xcd\xf2\x89\x98\x4f\xe2\xde\x10\x1c\xc2\x7c\x12\xf7\x89\xbf\x9d\xc3\x00\xb1\xa4\xab\x52\x8f\xc6\x4a\xba\x5d\x1f\x65\x24\xe9\xa5\xd0\xe3\x43\xb1\xb4\xdb\x6d\x2c\xdb\x40\x9d\xca\xa9\x53\xf1\x79\x90\xf5\x87\xac\x62\x1c\x36\xfb\xa0\x76\x32\xd3\x99\x49\x84\xd5\x82\x66\xdd\x00\x5f\xe4\xe2\x9e\x25\xcc\x73\x31\xe0\x49\x69\x92\x7d\x12\x97\x68\x19\x8e\x8d\x80\x42\x43\x90\x34\x83\xe8\xd4\x0e\x07\xc7\xc1\x3b\xeb\x34\xef\x40\x5a\x3b\x84\xd9\x93\xcd\x3a\x1e\xf6\xb3\x28\xa8\xe7\x1d\xba\xbc\x7d\x28\xf6\x79\xda\xa8\xca\x15\xed\x97\xf9\x78\x52\xaf\x33\x8d\x82\xb4\x7f\xa4\xd0\x85\x2b\x84\x71\xe3\xd6\x36\x35\x27\xdb\xd4\x84\x9b\x10\x37\xd1\xf0\x3c\xc8\x7b\xc3\x5e\x42\x0a\x08\xbb\xdc\xf8\x1c\x86\x24\xb0\x08\x2d\xe5\xee\x98\x6a\xfb\x33\x60\x7c\x8e\x63\x68\x5e\xdb\x39\x0f\xdb\x74\x59\xce\xda\x85\xe6\x2f\x6f\xa2\x58\x68\x4f\x57\x40\xa0\x11\xf6\x62\x32\x97\x12\x89\x49\xe8\x66\x03\x7a\x41\x43\x99\x30\x76\xcf\x93\x62\x0a\xa7\xd0\x5c\x78\x94\x64\x65\x12\xe6\xe6\xcf\xa3\x29\xa1\xca\x22\x91\x41\x8d\x60\x07\x7e\x32\xcf\xc2\x22\x4d\x93\x42\xad\x0d\x3f\x31\x76\xec\x44\x90\xab\x40\xf3\xf4\x20\x03\x8b\x0e\x84\x1c\xe9\x38\x8d\x5a\x8a\xd5\xf9\x14\xb4\x4e\xa2\x8f\x6c\xcf\x71\x45\x98\xcf\xbd\xb0\xa3\x76\xec\x1e\xb7\xc0\x59\x6e\xf8\xea\xd1\xae\x48\xc1\x8d\x2a\x9b\x2c\x11\x04\x49\x94\x91\x4e\x18\x08\x93\xcc\x53\x39\x81\x7c\x23\xd2\x49\x82\x43\x40\x43\xd8\xb4\x14\x56\xf3\xc2\x67\x02\x0a\xa8\x6e\x77\x8e\x35\x40\xd9\xf9\x42\xc4\xae\x6f\x05\x57\xbb\x5d\x30\x1b\x6f\x22\x8c\xdc\xb5\x98\x8d\x97\x16\xf2\x7f\x69\x20\xff\xf9\x9a\xc8\x86\xeb\xca\x42\xf0\xba\x16\xbf\x9c\x2f\xab\x37\xcb\xda\x9b\x2a\x06\xd2\x7a\xbc\x0e\x16\xd8\x9c\x2c\x5a\x3c\x64\x18\xe9\x93\xd4\x0f\xd0\xd2\x36\x14\x57\xe9\x59\x53\x1f\xff\x00\x64\xb6\x95\x1d\x48\xee\x39\xc0\xa8\x9a\x37\x87\xb5\xdb\x3f\xf8\xbe\x1d\x5f\x7b\xc8\x70\x4d\x3b\x9b\x92\xcc\x1e\xb3\xdb\xb5\xb0\x5b\xf4\xc0\x54\xa2\xe1\x9d\x11\xb8\x78\x7c\x9a\x9d\xc9\xd0\x6c\xde\xfb\xdd\x4a\x7c\x6b\x2f\x88\xa7\x2a\x47\xf1\x53\x0f\xf7\xc6\x2a\xa2\x6d\xa8\xa2\xe6\x69\x69\xa1\x36\x89\x9d\x72\x22\x2e\xca\x0d\x81\x1a\x76\x72\x51\x49\xed\x14\x3b\xd2\x05\x33\x42\x81\x7a\xbc\x9b\x53\x85\x6b\x54\x0e\x0b\xc9\x5f\x8a\xcb\xf2\xdc\x3b\xf2\x11\x60\x5a\x9a\x39\x33\x71\xb2\x1e\xed\xd2\xe2\xcf\x94\xab\xfb\xd0\xbd\xe5\xdc\x0d\x7d\x9f\x22\x22\x72\x75\x30\xab\x98\x4c\x6e\x45\x1c\x8d\x59\x2d\x89\x99\x8f\xb3\x4c\x42\xd6\xa2\xac\x2f\x56\x69\x7d\x70\xac\x6d\x07\x88\x73\x48\x84\x9d\xa8\xaf\xeb\x2a\x9c\xae\x4b\x1d\xc6\xe0\xaa\x3b\x6e\x8e\x49\x32\xef\x82\x9a\x44\xd2\x8f\x7f\x5f\x46\xa9\x84\x69\x9e\x2a\xcd\x01\x61\x84\x04\x03\x07\xf1\xd7\xc7\xb9\x5f\xd8\x8e\xdf\xdd\x44\xfa\x2c\xee\xa5\x67\x19\x5f\x46\xfa\x2c\xeb\xa5\x67\xf1\xbe\xba\x29\x1a\x61\x66\x8b\x43\x47\xba\x72\x68\xa8\x7b\x7b\xde\xe4\xa9\x1e\x13\x97\xc3\x8a\x70\x0f\xa4\x44\xb1\x50\x13\x4d\xe4\xee\x14\xa2\x6e\x4f\x2b\x45\x3e\x13\x6d\x27\x02\x1f\xe0\xee\x93\x1c\xc8\xa9\xd2\xbf\xfd\x78\x8c\xc1\xf1\x60\x9d\x31\x3c\x41\x56\xfa\xa5\x3a\x7f\xd2\x83\x89\xa0\x29\x3b\x4f\xc3\x9b\x32\xa2\xf9\x79\x1a\x2e\x5b\xac\xd3\x1c\xe1\x91\xf7\xfb\x3c\xbf\x1a\x8c\xf3\x68\x08\x7c\x8f\x99\x6a\xc1\x37\xe5\x3e\x5f\x19\x39\x3a\x91\x1b\xde\x23\x32\x1c\x26\xb7\xab\x31\x8f\xee\x70\xfb\xac\x64\x74\x67\xc4\x76\xd1\xe9\x90\x63\x50\xf0\x97\xc4\xd4\xf8\x51\x1b\x3a\x24\x54\x8c\x1d\x9e\x2c\x65\x1d\x75\xbc\x41\xec\xd4\x0b\xe1\x3b\xa5\x0f\x93\x63\x2d\x50\xd6\xb0\xc3\x91\xe1\x75\xad\xfc\xf7\xce\xd2\xdf\x10\x04\xef\xf7\xa3\xa5\xf2\xb4\x9b\x33\x45\xb4\xeb\x42\x89\xbb\x59\x5c\xc8\xeb\x34\xbb\x8d\x3e\x6b\x6e\x3a\x19\xbd\xd7\xdc\x83\x1f\x89\x7e\xd7\x3c\xc3\x46\x48\x60\xfe\x10\x67\xd1\x6f\xda\xf4\x75\x89\x43\x5c\x91\xbc\x80\x6e\xe0\xa8\xf3\xf3\xcf\x3f\x9f\xfc\xc0\x4f\x3e\x7c\xf8\xf0\x81\x9f\x2c\xa3\xd5\x2a\xca\xf3\x93\xb8\xc3\xbd\x1e\x45\x1d\x9b\x1e\xbe\x7b\xf7\x0e\xef\x1a\xc9\x48\xb2\xda\x48\xca\x89\x67\x52\x3c\x76\x96\x4f\x3b\xa4\x5e\x34\x5f\xe9\x18\x35\x62\x27\x49\x3a\x56\x57\x48\xe9\xf8\x74\xa7\xd4\x0a\x76\x26\x7f\x9b\xfe\xed\xa4\x4f\x0d\xea\x18\x1d\x60\x87\x7e\xef\x47\x4e\x58\x1b\xa6\x5f\x64\x96\xa9\xb9\x0c\x5a\xb4\x32\xc5\xf8\xee\xa3\x9a\x47\x9d\x55\xba\x92\xba\xe8\x70\x2b\x11\xad\x36\x4c\xb9\xd9\xae\xd5\x9e\xd3\xd4\x57\xef\xe8\xea\x38\x02\x63\x2b\xbb\xdd\x83\x34\x3d\x96\x82\x5c\x85\x58\x24\xfd\x20\xce\x60\x24\xe9\x0d\xa9\xb2\x55\xfe\x8f\x38\x51\x73\x5a\xf7\x74\x46\xff\xb2\x08\xdc\x31\x6d\x9a\xd7\xda\x82\x13\x94\x77\xf4\x06\xbc\x14\xe3\xb9\x67\xf8\x67\x49\x23\x3f\x2b\x98\x77\x24\x5a\xad\xe4\x02\xfe\x19\x60\x20\xef\x2d\x84\x0c\x01\xea\x87\x4e\x86\x5b\xd1\xc3\xb1\x12\xa6\x4f\x95\xcc\x02\xde\x8e\x63\xe9\x49\x3b\x02\xff\xeb\x91\xbd\xbb\x89\x2d\xf5\x1b\x45\xa2\xb0\xda\x37\xea\x6d\xa2\xf7\x8d\x32\xf6\xf2\xf0\x4a\xd5\xca\xec\xf7\x11\x04\xfc\x6f\xc2\x8f\xb9\x2c\x82\x8e\x31\x0e\xe9\xf0\xbb\x75\xb2\x41\xe8\xf1\xe8\x0e\x66\x06\x50\x3a\xaf\xb3\x74\x1d\x5f\xa3\xa6\xd3\xc1\x7e\xbf\x37\x3e\xdf\x6b\xb3\x25\xe2\x23\x14\x0d\x19\x4a\x90\x54\x92\x08\x76\x3a\x54\x1b\x0e\x3f\xb0\xbd\x01\x8d\xa0\x0f\x05\xbe\x40\x94\x51\x8e\xac\x08\x3f\xce\x96\x2a\x99\x67\x80\xea\x20\x14\x00\x27\xcb\xf5\x22\x2b\x64\x1e\xb3\xed\xa9\xb1\xe5\x65\xd6\xed\x42\xc4\x1b\x7e\xfc\xa2\xe4\x8d\x51\xf4\xee\xed\x3a\xa2\x33\x2e\xce\x5a\x2f\x86\xd0\xbd\x84\xff\xea\x58\x86\x16\x60\x43\xd1\xc3\x2d\x3d\xb8\x4f\xfb\x9e\x74\x7e\x2b\xaa\x45\x29\x27\x7a\xba\xaf\x7d\xc5\x5e\x79\xa6\x56\x5a\x11\x40\x1f\xd7\xfc\xd6\x25\xa8\x71\x11\xde\x46\x6a\x5f\xf3\x7f\x5c\x35\x75\x68\xa2\x08\x3f\xae\xd2\xb9\x4c\x60\x55\x4d\x03\xb3\x50\x3e\xf5\x06\x18\x03\xe2\x3a\x4f\x4f\x63\xb2\x2b\xb9\xa6\x53\xda\x46\x43\x39\x05\x77\x98\x99\x31\xc1\x2f\x4b\x94\x9d\x0e\x51\xc1\xe9\xc0\x4b\xea\xa4\x99\xba\x56\xba\x83\x17\x4a\xd0\x01\xf0\x22\x49\xe3\x22\xc8\x38\xd0\x29\x4a\xdd\xaa\x62\x96\x0f\x37\x77\x98\x62\xa2\xa2\xf5\x3a\x7d\x88\x46\xb2\xc9\x60\xda\xed\x76\x7a\xee\x37\x41\xb1\xcb\x9e\x62\xfc\x34\x80\x53\x95\xdc\xed\x14\x00\x58\xd4\x95\xd0\x58\x03\xa3\xfc\x46\x15\xb3\x65\x90\xb1\xbb\x59\x9c\x4b\x77\x6b\x45\xb6\x4e\xda\x2b\x9d\x11\xbd\x42\x94\x35\x97\x2e\xf5\xdc\xa6\x02\x6a\xb3\x13\x35\xba\x41\x6f\xec\x43\x44\x0f\xa6\x22\xf3\x1b\x85\x6d\x89\x93\x6c\x64\x2f\x13\x9b\x80\x4b\xa6\x9c\x8f\x2f\x9e\x61\x43\x50\x84\x32\x09\x3f\x92\x3a\x0a\xb3\xc1\x0e\xac\xcd\x1c\xdd\x3d\x3e\x58\x6e\x95\x16\x47\x78\xb5\xb4\xa3\x52\xb5\x59\x66\x6e\xdc\x76\x9b\x5b\x99\x69\x15\xc0\x16\x73\x6b\xf9\x1a\x4b\x13\x3a\xde\xdc\xe2\xc6\xa4\xd6\xa5\xbe\xe5\xad\xd2\x11\xe5\x01\xe9\x7a\xb4\x37\x01\x9c\xf9\x21\xc4\x19\xc5\x97\x89\x05\xa2\xb4\xa3\x29\x84\xd8\xec\x76\x34\x9c\xf8\x39\xbe\xa7\x92\x98\x7b\x65\xc6\x32\xd2\x2c\x4a\x5d\xd0\x68\x97\x39\x88\x21\x64\x28\x2d\xb2\x4b\xab\x55\x2c\xf0\x70\xb6\x15\x70\xda\xc9\xc2\xd9\xad\x80\x9f\x8e\x35\xd6\x16\x69\xc3\x62\x2e\xf6\x2c\xeb\x19\xb7\x52\xa8\x0a\xb3\x62\x8e\x98\xc0\x87\xe7\x01\x9d\x63\x32\xf1\x37\x9b\xf2\xa7\x0a\x13\x4e\xbb\x91\x66\x21\x2b\x79\xa8\x72\x63\xc4\x75\xcb\x01\x48\xe4\xca\xfe\xc6\x63\x87\x7b\x8e\x83\xc0\x04\x3c\x7a\x46\xcb\x7c\xac\x5c\x44\xa0\x5a\x72\xe4\x86\xb4\xad\xe4\xfb\x74\x3d\x56\x14\x0e\xa8\x4a\xa8\x60\xc9\x6d\x1a\x59\xcd\x67\xfe\x53\xa4\xca\xe1\x06\xe7\x83\x31\x15\xf5\xa4\xa0\x64\xdf\x8c\x73\x67\x59\x67\x16\x6e\x3d\x0e\x11\xe1\xad\x6c\x57\xb3\x91\xb5\xa1\xb0\x83\x90\xb9\x41\xb8\xdb\x46\x01\xcc\x39\xeb\x14\x2e\x42\x22\xe0\x03\xfc\x36\x92\x26\x16\x41\xe6\x2c\x67\x68\xd4\x30\x35\xd5\xde\xfb\x74\x78\x16\xe2\x98\xb7\x13\x00\xe1\x24\x0e\xb1\x52\x6a\x1a\xd3\x26\x18\x9d\x22\x14\x43\xdc\xed\xf6\xe9\xe8\x2b\xe5\xb0\x31\x1b\x51\x9f\x4e\x5b\x26\x2c\x46\x2f\x70\x38\x91\x38\xd3\xa6\x9a\x33\x52\x85\x5f\x8c\x5b\x6b\x95\xd7\xea\xd7\x62\xba\xda\xe8\x0e\xb4\x5d\x38\x1d\x56\x6d\xdf\xaa\x72\x65\xb9\x35\xa3\x8d\x32\x27\x97\x45\xc7\xae\x45\x3a\x9d\xed\x40\x04\x65\xbb\x10\xef\x22\xd0\x08\x21\x65\xee\xae\x0e\xe3\x6b\x35\xd1\x53\xb8\xb4\x54\x1f\xb8\xf5\x2d\xae\x8a\x6e\xf7\xb4\x08\xf3\xcf\x6a\x5d\x65\xf8\xa8\x5a\x25\x98\x9c\x96\x26\xe2\xf6\x19\xa3\xaa\xc2\x19\xc3\x42\x52\x19\x6e\x49\x60\x09\xa3\xd7\x4c\x0c\x47\x19\xe9\x51\x33\x06\x77\x4f\xfd\x25\xce\x9d\x65\x6c\xc1\xe5\x24\x83\x10\x12\x06\x10\xac\x76\x17\x69\x14\xa7\x7d\x49\x0a\xda\xa2\x34\xa6\x25\x01\x2f\x2c\x6a\xcd\x0f\xfa\x42\x4c\x3a\x80\xc1\xa8\xdf\x6f\xfb\x86\x86\x0a\x40\x9b\x0f\x95\xfa\x57\x54\x9a\x9a\xaf\xcc\xb6\xb0\x86\xa1\x5f\xb7\x3c\xb1\x8c\xe9\xbf\xb3\x22\x28\xa1\x25\x28\xdb\xb6\x9f\xf2\x0b\xd6\xab\x27\xde\xf6\x73\x7e\xc1\x9a\x6d\x28\xc8\x66\xdc\x88\xc0\xf1\x75\x73\xc0\xd8\x36\xd8\x87\xd3\x01\xf3\xae\x87\xcf\xde\x20\xc3\x23\x1a\xa4\x85\x15\xa1\x57\x41\x8e\x4b\xf1\x2c\x5f\x0b\x15\xe6\xeb\x58\xff\x25\x5e\xe7\x4e\xf5\x3e\x99\xf2\x4f\x62\xc0\xb7\x62\x40\xeb\xb7\xa8\xdb\xb1\x53\xa0\x9f\x6b\x0a\x35\xde\xeb\xa5\x6c\x2e\x74\xb0\x11\x72\x92\x88\xf4\xcf\xd7\x96\xe2\xe1\x09\x8c\x59\x96\xe2\x56\x05\x1b\xa8\x14\x6f\x55\x30\x67\x3c\x73\x1b\x16\x1a\xc2\x6e\x17\xda\xe6\x5c\x5c\xf7\x82\x85\x48\x81\x82\xc5\x97\xdd\xee\x6c\x1c\x7c\x12\x56\x9f\xbf\x61\x7c\x2b\xbe\x98\xdf\x73\xc6\xa2\x4f\xdd\x2e\xd0\xac\xd6\xe3\x60\xd9\xed\x56\x79\x66\xdd\xae\x97\x29\xa0\x35\x46\x01\x9c\xf9\x16\x41\x56\xb7\x62\x50\x75\x8b\xb1\x51\xfd\x7d\x51\x33\x7c\x2f\x3c\x83\xd7\xd8\x3e\x05\x8c\xec\x65\x6e\x94\xb8\x53\xf3\xa8\x83\x24\x99\x75\x78\xbc\x28\x60\x54\x4e\x7b\x27\xff\x7b\xc3\xd6\xa5\x92\x26\x97\x37\x6c\x60\xaf\x50\xbb\xdd\x6a\x66\x08\x64\x89\x54\x52\xbb\x3c\x71\x42\x41\x63\x91\x94\x92\x45\x92\x39\xdd\x79\x10\x8b\xc0\xe2\xf9\xdb\x8f\x13\x4d\xab\x18\x73\x35\x33\x00\x11\x19\xf2\xac\xdb\x8d\x7d\x56\xe7\x73\x11\xe2\x0a\xa3\x43\xf6\xce\x1e\x21\x51\x71\x48\x05\x2b\xc6\xd1\xcb\x68\xa5\xac\x3e\x8b\x13\x2d\x1d\x15\x5c\x26\x51\xbc\x87\x65\xf3\x9f\x90\x41\x66\x22\xe3\x49\x79\x97\x35\x5b\x1c\x64\x22\x99\xa8\x29\x84\xd8\x19\x8d\xa4\xf8\xa4\x82\x84\x2b\x04\x9a\xcf\x4a\x42\x57\x7c\x51\x10\x42\x00\xaa\x79\xbd\x96\x99\xd8\xe2\x11\x00\x60\x72\x91\x66\x2e\xd4\x56\xfe\x43\x2d\x06\xd8\x21\x01\x83\xe5\x2d\x1e\x0c\xd5\xe5\x82\x31\xd2\xa2\xd6\x22\xb1\xc3\xdf\x1f\x8e\x80\x48\x35\xea\xf7\x35\x03\xf2\xe3\x44\x4f\x5d\x07\x19\x82\xaa\xd9\xa1\x22\x3d\x28\xc8\xc6\x50\x26\xcc\x2e\x73\x28\xe0\xeb\x6c\x02\x44\x2c\xa6\x2b\x84\xad\xdc\xa0\x7e\x77\xbb\xa6\x3c\x8a\x92\x79\xda\xed\xe6\xdd\x6e\x66\x1b\xd4\xed\x06\xe5\xd1\x33\x4b\xd4\x1a\xee\x96\xc1\x86\x5b\x9e\x06\x4f\x8c\x7f\x56\xc1\x86\x7a\x1e\x73\x58\x13\x7d\x4c\x52\x04\xda\x2e\x8b\x6d\x74\x55\x90\x01\x6a\x80\xff\xac\xc4\xcb\x30\x2b\x12\xdc\xae\xbf\x16\xc9\x53\x23\x5b\xe1\xef\x94\x0b\x29\xf3\x5c\x1d\x98\x59\x57\x3c\xc0\x5b\x6b\x9e\xe8\x0e\x77\x88\xc1\x89\x30\xa3\xcd\x02\x0f\xf5\x4f\xa9\x01\xb3\xb8\x82\x0f\x7d\xf5\xb8\x3f\xe0\xf3\x12\x79\x0d\xfd\x8e\x8f\xcb\x52\x89\x87\x40\x4d\xf3\x18\x71\xfe\xca\x50\xc3\x7c\xb1\x49\x12\xaa\x1a\x59\x2d\xa1\x08\xb1\xcc\x0d\x09\xa3\xc8\xd6\x3a\xd5\xcf\x93\xba\xb4\xb6\xdc\x81\x42\xba\x9d\x41\xf4\x18\x57\xbe\x07\x69\x2c\x54\x73\x2b\x69\x78\x54\x18\x88\x46\x61\x59\x14\xf7\x3c\x3e\x6d\x44\xba\xc7\x4a\x31\xaf\x2c\x9f\x16\x6e\xe8\x24\x00\xe7\x9c\xea\x97\x10\x7e\x58\x84\x44\xfd\x5a\xc6\x5f\xa4\x79\xb0\x46\x10\x77\x6e\x88\xa2\xaf\x07\xdc\xba\xc0\x02\x98\xe6\x5a\x6a\x99\xc5\x85\x3c\xa6\x36\x10\x8d\x93\xa4\xe6\xc8\x61\x06\xd7\x92\x8a\xda\x12\xec\xe0\x75\x2d\xe9\x5e\x9b\x36\x47\x7e\xfa\x1b\xa7\xbe\x63\x1a\x58\x0b\x96\xec\x21\x7d\x58\x15\x92\x0c\x9b\x0e\xab\x20\x50\xe3\x81\xa5\xf9\x1c\x65\x7b\x07\xcf\x8c\x48\x4e\x2c\x60\xfd\xd4\xc6\x6d\x2e\x4f\xd9\xe8\x60\x87\x70\x3b\x9e\xa7\x2d\x07\x93\xad\x85\x91\x24\xed\x79\xbc\x46\x69\xf2\x0b\x7a\x1e\xaf\xa9\x3a\xee\x7c\x82\xca\x37\x70\xfb\x29\x53\xad\x22\x24\x3e\x74\x09\x42\x8e\xbf\xa6\x4a\x97\xe5\xf0\x50\x55\x69\x56\x9f\x7b\x47\x4f\xdc\x73\x68\x2a\xdf\x98\x1e\xac\xcb\x01\x8e\xe2\xb0\x7a\xa8\x42\x3d\xc7\x65\xf4\x55\x67\x06\x44\x4b\x33\xb2\xfd\x83\xd0\x14\xe2\x65\xb6\xdf\xef\xb9\x99\x50\xa7\x70\x3a\x5c\x0d\xe6\x0c\x14\xf3\x74\x46\x66\x82\xa1\x91\xbd\xfc\x98\x48\x3c\x05\x9d\x4d\xd2\x01\x45\xd1\x58\x32\x23\xeb\xaa\x25\x8b\xa7\x85\xb1\xee\x91\x41\x67\x96\xc4\x79\xde\xe1\x45\xa8\xe6\xbd\x4e\xdf\x7c\xb7\x83\x40\x62\xd0\x4c\xb9\xa3\x89\xac\xbc\x00\xd2\x10\x28\x11\xc3\x48\x51\xea\xf9\x73\x9c\x7f\xc1\xb1\x16\x24\xaa\xc3\x18\x7b\x54\x56\x90\x21\x1d\x06\x9b\xe0\xdb\x44\x36\x17\x86\x80\x55\xf9\xc1\x6a\xa1\x44\x5a\x54\x90\xdc\xdc\xf3\x11\xf8\xad\xbc\x49\xe7\x32\xa8\x4a\x54\xfa\xb2\x38\x4c\x37\x85\xcc\x5e\xbe\xff\xf9\xb5\x93\x33\x3d\x55\xe2\xa7\x7b\xcd\x63\x0b\x76\xf7\xd2\x65\xb0\x8a\x26\x8e\xbf\xa1\x19\xb9\x97\xaa\x78\x96\x6e\x65\x0e\xca\x83\x92\x3f\x2e\x71\x1c\xc8\xf9\xab\x42\xae\x44\x05\x7f\x3a\x4f\x37\xd7\x4b\xbd\x29\x7e\x4e\xe7\x52\x00\x43\xcb\x5c\x7c\x96\xa2\x78\xa7\x0e\xec\x68\x7d\xf6\xa3\x16\x96\x4e\x85\x7e\xd1\x00\x06\x04\x0e\x60\x48\x90\x45\x89\x43\x16\x12\x92\x9e\x32\x48\xdb\x04\xac\x4c\x4c\xb9\x77\x3e\x76\x51\x60\xc1\x08\x1a\x29\x44\xf5\x1c\x66\x34\x15\x3c\x43\x68\xa2\x32\x30\x9d\x0a\x3f\x35\x9e\xa9\xf0\x41\x26\x2a\xfa\xc2\x44\x25\x0a\x17\xf6\x2f\x65\x75\x89\xf4\xe0\xf7\x4a\x69\xe8\x2e\xf6\xdc\x7b\x11\xbd\x53\xbc\xa5\x1b\x48\x7e\x1c\x26\x53\x43\xed\x11\xb4\xa2\x34\x01\xd3\x40\x0c\xb8\x8d\x78\x2b\x6c\x1e\x82\x6f\x6d\x05\x70\x2a\xd2\x35\x65\x37\xec\xb4\x70\xb9\x58\x85\xed\xf4\xda\xd5\x68\x9f\xdf\xa7\x6b\xff\x91\xcc\x7d\xfd\x04\xc3\xa1\x53\x8a\x1d\x06\x71\x47\x8d\x88\x06\xdc\x54\x1e\x0d\xf6\x7b\x7e\x38\x4f\xd5\x00\x79\x33\x40\x89\xde\xe3\x83\xc6\xda\x66\xe3\xe4\x74\xbf\x68\x1f\x72\x47\x86\xf5\x6b\x8b\x4f\x2c\xb5\x32\xe5\x08\x7c\x38\x99\x1a\xbf\xe8\x42\x02\x94\x44\x0b\x7d\x18\x35\xd5\x93\x5b\xba\x97\xda\x91\x3c\x74\x70\xc1\xdb\x8c\xf1\xaa\x29\x96\x12\xc0\xe5\x66\x7f\x12\x05\x6f\xb6\x1f\xc5\xcf\x16\x7a\xcf\x9b\x8b\xae\x1a\x87\x17\xaa\xc0\xc3\x51\x20\x9e\x03\x23\x75\xd3\x77\x0e\x0a\xd0\xd2\x2e\x60\xff\x41\x50\x52\x0c\xa4\x76\x77\xc8\x54\x18\x9f\x05\xeb\x1b\xd4\x3c\x1a\x12\x51\x4e\x24\x51\xa7\xf5\x65\x08\x36\x76\x33\x0e\x92\xc3\xb5\x98\xd8\xa5\x24\xd4\x78\x38\x88\x10\x94\xc1\x65\x32\x09\x3c\x69\x59\x91\xcc\x9a\x65\xc1\x34\x42\x17\x22\x2b\x23\x7e\x9b\x6b\x1d\x58\xa6\xe5\x6d\x97\x0b\x58\x09\x21\xc0\x74\xec\xbb\x57\x62\x07\x74\x78\xfc\x90\x73\xa5\x37\x09\x3c\x38\x24\xca\x94\x78\x6b\x0c\x4e\x7a\x29\xc1\x80\xd4\x94\xc4\x54\xb7\xd5\x14\x8f\x02\x30\xf0\x72\xb7\x9b\x4f\x9c\xa9\x56\x7f\x38\xed\x29\xf2\x58\xb4\x5b\xe2\xca\xf6\x1c\x8c\xc6\xb2\x27\xd2\x5e\xf9\x86\x7b\xa5\x02\x79\x35\xa0\xe0\x9f\x53\x31\x60\x1c\x60\x4c\xe2\x0e\x9d\x89\x06\x1c\x52\xab\x01\xa7\x3a\x22\xe5\x76\x51\xba\xf7\x4b\x0f\xa7\x3d\xa1\xaa\x8a\xe1\xfe\xec\x06\xb8\x27\x96\x7b\x2f\x56\x76\xf5\xf1\x05\xd6\x46\x9a\x6c\x56\xda\x0d\xe8\x94\x5f\x97\x69\xe6\x8c\xa0\xeb\x61\xed\x15\x5a\x89\x01\xff\x22\x06\xa3\xff\xfe\x48\x12\x40\xc5\x97\x5e\xda\xbb\x38\x9b\x5d\xb9\xc6\x83\x9d\xee\x89\x95\x37\x64\x0b\xc3\xcf\xad\x4a\xe3\xf4\x2f\xcc\x35\x0a\x3f\x4a\x43\xe7\x15\x18\xfc\x2f\x18\xee\xd9\xa3\x46\x13\x43\xb6\xee\x89\x55\xeb\x07\xec\x0c\xf6\xc4\x7a\xef\xce\x5d\x9b\x54\x41\xe3\xb9\x26\xef\x2d\xfc\x5f\x2d\x9b\x38\xc8\x26\x06\x76\xef\xdb\x6d\xee\x6f\x2c\x7f\xbf\x5b\x11\x3d\xf8\x0e\x17\x48\xd7\x6d\x64\xc7\x95\xec\x76\x4e\xfe\x7f\x2c\x47\x33\x52\xf8\xe3\x8e\x11\xc7\x0a\x92\xcc\xae\xc6\x0e\x82\xad\x94\x86\x80\x32\x02\x2e\x12\xd1\x9b\x8e\xf1\xbc\xb9\x86\x88\xf5\xa5\x61\xe0\x9b\xda\x16\xc6\xf1\x51\x9e\x55\x76\x8f\xf3\xa5\xf8\x59\x05\x12\x9c\xa0\xbd\xd0\xaa\xab\xc4\xee\x23\x38\x65\xd0\xa9\xb6\x10\xcf\x55\xcd\x95\x5b\x1d\xfa\x70\xf3\xeb\xa3\x47\xdf\x1a\xd0\x52\xea\x77\x39\x9a\x79\x27\xc8\xb2\xfa\x1d\x18\x14\x49\xc6\x67\x47\x8e\x93\x59\xd5\x17\x11\x3e\xe1\xb3\x1a\x88\xc0\x82\xcf\x3c\x69\x0d\x3d\x01\x2e\xe4\xda\xc2\x85\x90\xb2\x70\x65\x76\xc7\x9a\xf1\x2f\x07\xa7\x2f\xff\xe4\x9b\x55\x2a\x76\x67\x55\x3e\x32\x24\xf6\x93\xdd\xf9\x0a\x1a\x7b\x33\x95\xdb\x64\x74\xa0\xb1\x29\xfa\xaa\xa1\xb3\x09\x8a\xbe\x77\x64\xb0\xf3\x8b\xfd\x9e\x6f\x0f\x8f\xf8\xb9\xd8\x8e\xef\xb6\x91\x0d\xaa\xff\x29\x48\x38\x6c\xad\x19\xbf\xb5\xf1\xf8\xcb\x1a\x88\x8d\x89\x06\xfb\xa8\xca\x5d\xbd\x73\xb9\x3f\x05\x29\xcf\xa9\xbc\xcd\xcd\x0d\xd3\xef\xf4\xf0\x38\x18\xca\x38\x3b\x81\x99\x66\xe3\x3f\x5f\xa6\x1a\xfa\xf7\x56\x78\x9f\x7e\xe8\x38\x92\xce\xf4\x6f\x21\x66\xb5\x23\x48\xfa\x47\x10\xbf\x16\xab\xde\xfa\xfc\xa2\xb7\xe0\x1f\xc5\x3c\xdc\xf2\xcf\x62\x1e\xde\x8e\x96\xa0\x33\xe9\x88\x0c\x0e\x56\xe2\x76\x4c\x91\x5d\x3f\xf6\xae\xab\xc6\x5c\xd9\xce\x37\x32\x77\xbb\x01\xd5\xd7\x13\xb7\x7c\x4e\x0b\xa7\xd7\x33\xdf\x11\xb6\x80\x19\x5b\xf3\x6e\xca\x58\x44\x55\x7f\xee\xdd\x5e\x99\xa1\xab\xea\x2b\xcf\x46\x53\xfc\x63\xcf\x6d\x38\x6a\x65\xee\xaa\xa8\x9a\x54\x7d\x90\x9a\x20\xfc\xb9\x28\xbf\x47\xc3\x7a\x23\x96\xe1\x36\xf8\xc8\x46\xa7\xde\xe2\x93\xf6\x5e\x3e\x0d\x0c\xa4\xe4\x8a\xed\x76\xab\x4b\x31\x60\xec\x6e\x66\x11\x12\xa8\x70\x8a\xfd\xa8\xaa\x4d\xc1\x33\x9f\x23\x25\xa1\xb7\xbf\x27\x28\x73\xf9\xc8\xe1\xdb\x11\x5a\xce\xb9\xaa\xe8\x79\xbc\xe6\x59\x83\x8d\x76\x19\x2b\x06\xb9\xca\xef\x31\xcd\xae\x58\x95\xe4\x0a\x82\x7b\xae\x8a\xe0\x89\x67\x4d\xc6\xda\xe5\xa5\xb6\x8b\xb4\xb1\xbb\xa9\xb0\x97\x60\x5a\x5f\x03\x0d\xa9\x3d\x06\xb5\x06\x96\x5f\x43\xd3\xa0\x40\x02\x91\x58\x13\x7d\x98\xe5\x9a\x8b\x95\x01\x5d\x79\xf7\xb7\x5f\xdf\x5f\x9c\x5f\xf0\x04\xd3\xf3\x36\xd9\xe4\x90\x11\xc3\x24\x79\x23\x24\x96\xec\xa8\x14\xac\xe1\xa0\x27\xc1\x17\xe1\xf5\x79\xdc\xbd\x11\xb0\xab\x92\xb5\xb7\xb1\x49\x66\x15\x74\xc3\x92\x96\xe1\x8b\x34\x7b\x5d\x64\xa8\x9f\x71\xc9\x57\x7c\x6d\xa2\x77\xa4\xdd\xae\x1b\x81\x7b\x33\x8f\x66\x3e\xde\x42\x70\xc3\x3f\x73\xc9\xf8\x97\x89\x9a\x52\x01\x51\x2b\x77\x63\x5e\xd8\xcd\x44\xbf\xc1\x92\x7c\x3e\x06\xcf\x23\xd6\xe7\x17\x3c\xf3\xc7\xa0\x67\xac\xc6\x7a\xf1\x68\x56\xc1\x3f\x68\xda\xd5\x90\x3e\x42\x16\x6c\x04\x36\xdd\x6e\x30\xab\xa9\x09\xfc\xd9\xbd\xe0\x33\xa7\xd2\xa1\x42\xe6\xdd\xfb\x34\x70\x5f\x02\x24\xaa\x79\xe1\x60\x4d\x98\xed\x1b\xbc\xa9\xb6\xe3\x79\xb8\xed\x09\xef\x0c\x88\xcc\x46\x07\x51\x61\xce\x37\x3b\x26\x8f\x39\xde\xf6\xd6\xa4\xb1\x3c\xc9\x9e\x16\xd1\x21\x61\x65\x45\xcb\x25\x96\x5b\x71\x45\x32\x90\x45\xd1\xed\x16\x97\x22\x33\x1c\x60\xb7\x2b\x91\x5c\xa4\xeb\x6e\x57\x22\xd5\xd0\x09\x16\xd8\x38\x6b\xde\x38\x40\x4e\xd7\x97\xce\x8c\x64\xd4\xeb\x69\x78\x96\x14\x57\x08\x35\x13\x03\x36\xa9\xfa\x00\x5c\x33\x16\x45\xcf\x62\x1b\xd2\x77\x54\xf9\x1d\xfa\xd5\x73\xc0\x85\x4e\x0b\xe8\x3e\x47\x87\xf3\x44\x57\x3e\xd1\x50\x62\xda\x10\x6b\x3f\x7e\x91\xba\x68\x13\x22\x55\x56\xef\x8e\x54\x89\x45\x67\x95\x6e\x72\xb9\x31\x84\x11\xc5\x3e\x1a\x77\x66\x10\xb3\x76\x70\xd9\xdc\xae\x25\x0e\x1c\x93\x09\x93\x8b\x6c\xb1\xd5\x67\x86\x56\xfa\x89\xa8\x75\xa1\x15\x7e\xda\x76\xee\x1d\x80\x81\xad\x0b\x9a\x51\xfb\x6a\xe4\xca\x92\x30\xb7\xcc\x2f\x74\xd8\x9c\x30\x80\x28\x01\x3e\x89\x71\x5b\x0b\xbe\x3d\x96\xdd\x6e\x59\xda\xfb\x49\xd6\xdb\x81\xe6\x45\xa8\x29\x92\x24\x97\x2c\x0a\xca\x66\x75\xbb\x12\x16\xc6\x35\xf9\x0c\x78\xd4\x46\x42\x99\xff\xa0\xb6\x7a\x4e\xd6\x78\x16\x92\x71\x6f\x38\x6c\x13\xe9\xa9\xa5\x5d\x0c\xe2\x27\x37\x3d\x27\x3f\x58\xa1\x3b\xad\x47\x72\x82\x7e\xaa\x82\xbb\x59\x81\xfb\x1f\x2b\xdb\x4e\x55\x24\x9d\x82\x66\xcf\x46\x26\x54\x84\x09\xa5\x1a\x14\x5c\xe3\x74\x58\x4b\x78\x8b\x3d\x4b\xb7\x48\xa8\x78\x64\xa1\xf7\xa8\xf9\xbd\xd5\x6c\x59\x89\x1f\xff\x68\x29\xcf\xe8\xa9\xe3\x94\x5f\x69\xd5\xb2\x68\x2a\xb2\xd6\xd6\x37\x92\xdd\xae\x6d\x72\x43\x8c\xf5\x70\x59\x6b\xfc\x8e\x9f\x23\x39\x0e\x2a\xcf\x69\xcf\x82\xd7\xe4\x64\x5c\x8f\x83\xb6\x5e\x96\x4b\x57\x48\x16\xd9\x76\xb0\x08\x18\x66\x6b\x19\x66\xb4\x44\xcb\x21\x98\xcb\x44\x16\xe0\x22\x6c\x9d\x96\x57\x68\x6e\x8f\x6a\xf0\xcb\xc6\x21\x22\x90\x05\x59\xa6\xdc\x64\xd0\xc6\x5f\x39\x2d\xca\xe8\x40\xdd\x51\x10\x18\x4e\xa5\xed\x18\x72\x30\xdf\x74\x09\xc1\x1a\x36\x99\x37\x14\x1c\xee\x84\x1b\x1e\xa8\x45\x70\x8c\xc1\x83\xc4\xea\x3d\x2e\xe4\x57\x4e\x58\xf9\xe2\xbf\x27\xac\x6c\xcc\xdc\xab\xff\x15\x40\x3e\x52\x00\xf9\x4a\xf1\x96\x6e\x44\xaf\xfe\x57\x00\x69\x05\x90\xe5\x00\x79\x33\x80\xe1\xf1\x66\x09\x8f\x07\x02\xbb\xb2\x1c\x38\xf9\x57\xed\x02\x3b\x3f\xe4\xb4\x3d\x05\x5c\xbc\x69\x30\xb6\x40\xfb\x00\x92\x70\x83\xf5\x2a\xed\xed\xc7\x41\x21\x82\xca\xa0\xd2\xd0\x37\x6c\x6c\xfe\xda\xeb\x3a\x1a\xb2\xb3\x23\x9c\xae\x87\xb8\xe8\x0b\xa6\xb8\xe5\x4c\x84\xbd\xc5\x71\x43\x95\x93\x09\x6d\xba\x9b\x33\x77\x93\x8b\x18\xe1\x25\xab\x09\x64\x51\xb3\x86\xc3\x22\xbe\x98\xe3\xd5\x71\x31\x47\xb5\xd4\x0e\x84\x17\x76\xfb\x96\x22\x90\xba\xb8\xe3\x41\xd1\x46\x03\x4a\xee\x18\x88\xdc\xbd\x88\x71\x3e\x66\x25\x02\xfd\x9f\x5f\x78\xbc\xd5\x46\x0c\x28\xcc\x7f\x91\xae\xf9\xd2\xf2\x72\x24\xa2\x30\xc4\x16\xc9\xd9\x68\x3b\xd4\xa0\x37\x0f\x00\x9f\x1e\x07\x49\x77\x80\x44\x77\xb8\x31\x63\xb1\xec\x05\x8b\xfe\x12\xde\x90\x99\x98\xf7\x12\xae\x04\x1e\xa3\x20\xb6\xf2\x51\x32\x8b\x73\xc3\x3b\x5e\xf6\x92\x68\xd1\x4f\xe0\x6d\xda\x0b\x66\xfd\x39\xca\x29\x31\xeb\xcf\xf9\x46\x58\xa3\xc0\xb3\xa0\xad\x60\x3f\x7c\x12\x85\x4f\xc8\x2e\xbd\x84\xc9\xab\x60\xf1\x62\xee\xc3\xe0\x6d\xfe\x18\x0c\x1e\xb1\x93\xd7\xc2\x2c\x70\x67\x2f\x67\x16\xff\xb5\x89\xcb\x81\x0c\x50\x45\xac\xc4\x60\xb4\xba\x74\x88\x0b\x14\x5f\xdf\x03\x82\xbb\x9e\xac\xa6\x7c\xc0\xd7\x20\xdf\xd7\x3d\x91\x1b\xf4\x0a\x3f\x03\xf9\x69\x2a\xc0\xa2\x7a\x6c\x8b\x4f\xe4\xfc\x72\x40\xe4\xbc\xf8\xef\x10\x39\x74\xf1\x3e\x4b\xd2\xd9\x67\x4b\xe8\xbc\x56\x38\x07\x7e\x55\xe2\x46\xf1\xdf\x95\x78\xaf\xf8\x1b\x4b\xfa\x50\x56\x8f\xf2\x79\xf1\x68\xca\x87\x4a\x82\xf0\xf9\xe5\x0f\x13\x3e\x54\x94\xe8\x9e\xaa\xa5\x47\x69\x1f\xca\xf2\x38\xd2\xe7\x97\x47\x92\x3e\xd5\x57\x19\xac\xbd\xed\x9c\x3f\x53\x27\x4a\x9f\xbc\x56\xa1\xb5\xf0\xf9\x55\xf1\xd7\xca\x92\x09\xe2\x77\x7a\xa0\x82\xe2\x8d\xe2\x52\x87\x4b\x99\xac\x65\x96\x8b\x97\x15\x4b\xc9\xee\xdc\xcf\x93\xa2\x46\x2c\x8c\x8e\xb8\x6b\x14\x63\x67\xdd\xfd\x0a\x81\x0a\xc8\xb6\xbb\x0f\x8b\xc9\xa2\xb4\x94\xec\xfc\xb9\x03\xf1\xbe\x12\xea\x7c\x38\x18\x9c\x49\xa0\x68\x49\x4d\x0a\x5d\xf0\x4d\x2c\x52\xa0\x30\x2a\x1b\x38\xe9\x19\x22\x42\xd7\x7a\x2a\x8a\x6e\xb7\xa3\x53\x2d\x61\x01\x5e\x54\x19\x75\xe0\x1b\x23\x56\x6a\x74\x7b\x30\xfc\x03\xb6\x6c\x38\xb9\xc0\x80\xbc\x2d\xbf\x09\xc5\x4e\x0e\x9c\x66\x59\x3c\x37\x41\x35\xe6\x44\xd0\x05\x9a\x01\xca\x2d\x69\x7b\x95\xd2\xab\x8d\x90\x41\x0e\x10\x0a\x19\x24\x30\x90\x3b\x16\xf4\xc3\x8e\xd5\xc9\x66\xb7\x9b\x9b\x50\x7b\x40\x1c\xd9\x8c\x8b\x20\xe7\x9a\xc7\x2c\x5a\xf2\xf9\xb8\x08\x12\x18\x00\xb1\x68\xc9\x22\xd3\xb9\xfd\xcb\xf0\x66\x29\xb3\xba\x3b\x7e\x09\x2a\x66\x36\x38\x82\xe7\xd2\x2f\x0f\x12\xc8\x28\xdc\x2c\xc7\x75\x52\x38\x05\x9c\x64\x16\x6d\x6c\xe2\xf9\x27\x1b\x15\x8f\xaf\x8c\x60\x77\x18\x6f\x10\xc6\x24\xd0\x2f\xa0\xa9\xe3\x7a\xcf\x5f\x86\x0b\xa5\xe7\x64\xdb\x20\x0e\xbf\x68\xdf\xf8\xbe\x0b\x35\xf7\x92\x2a\x4b\x80\x74\xdf\x93\xc1\xa8\xc0\xb5\x67\x85\x3c\x2e\x22\x5d\xae\x61\x25\x06\xa4\x99\xb3\x67\x96\xba\x8c\xc9\x84\x8e\xa4\xe1\x8e\xe7\xc2\x64\x28\x5e\x21\xb2\xbb\xd5\xd9\x1f\xba\x76\xbf\x91\xdb\xe2\x9f\x07\x83\x89\x0f\x37\x01\xda\xe0\x32\x10\x68\x01\x14\xe2\xaa\x09\xba\x37\x1c\xa9\xcb\xaa\x11\x2e\xbc\x70\x2c\x8a\x89\x22\x33\xe1\x9a\xb9\xef\xde\x7d\xf6\x6d\x26\xbf\xa8\x74\x93\xff\xa1\x4f\xbb\xcf\xd4\x1a\xd0\x1f\x8e\xd4\x15\x4c\x08\xfb\xfd\x87\x3f\xed\x62\x1b\x79\x5f\x74\x53\x61\x03\xcf\x7b\xfe\x17\x05\x83\x93\x84\x33\x0e\x06\x6e\xd4\xcb\x30\x4e\x56\x69\x5e\xfc\xf8\xef\x4d\x9c\xe4\xe2\xc8\x9c\x96\x71\x22\x8b\xbe\x64\x97\xba\x2a\xf6\xcf\x65\xda\xc0\x90\x70\x17\x82\x87\xaf\x50\x45\x6b\xd1\x7d\x79\x89\x1d\xad\x7b\xf2\x0a\x24\xca\x4b\xd0\x4c\x2d\x2d\x3f\x29\xc2\x0c\x61\x26\xa4\xa7\x67\xf6\xcd\xd8\x4c\xc7\x24\xa2\x39\x95\x8a\x2d\xbc\x87\x7b\xd2\x91\x30\x3b\xd4\x57\x00\x93\xfc\x17\xbe\xa6\x74\xe3\x6b\x07\xc7\x00\x7d\x2d\xc7\x8d\x6e\x7d\x5c\xaf\xf5\xb8\xe5\xc3\xe5\xcb\xa0\xa8\xed\x93\x32\x03\xf6\x08\xa2\xf5\x15\x65\x58\x62\x0a\x61\x55\x5c\x0d\xc6\xc3\xc8\x2c\xf9\xd2\x1f\xb7\xb5\x67\x67\x81\xf3\x5f\x40\x68\x92\x3d\xf7\xc0\xcb\x8f\xe4\x1f\x7e\x37\x38\xb7\x65\xa8\x17\x1f\xe7\xc6\x57\xf9\x6d\x12\xcf\x1a\x85\xec\xf1\x54\x2e\x27\x1f\xb6\x04\x40\x25\x83\x91\xbf\x0a\xce\x24\x3b\x87\xc8\xa6\x18\x31\x79\x26\x10\x98\xb4\xd7\x2b\x17\x06\x6d\xa3\x6b\x69\x82\x3c\x00\xa9\x97\x64\xb5\xad\x2b\x4b\x86\xdb\x7e\x11\x6e\x89\x4f\xb8\xed\x17\xe1\xad\xf3\x2b\xce\xff\x9d\x15\x81\x3e\xd3\x3d\x75\x56\xb9\x17\xc7\x45\xac\x2f\x02\xc5\x2b\x7c\x9a\xec\xb2\x1f\x3e\x71\x98\xdd\x30\x72\xed\x89\x0a\xc2\x9b\xdf\x91\x69\x76\x94\xf1\xb9\x85\x0d\x8e\xcc\x3e\x73\x8f\xcf\x64\x71\x23\xa5\xa6\xe6\xd5\xf7\x4b\x39\x88\x2d\xd6\xe3\xae\xcd\xbe\xf1\xb8\x6b\xfd\x05\xb3\xfb\x50\xc5\x39\xb9\x4e\xb4\x4e\xcc\x9f\x2f\x84\x80\x22\x3c\x7c\x82\xcc\x1f\x49\xd7\xd5\xcc\x5d\x63\xf0\xc3\xd9\x26\xc3\xbd\xf7\x83\xfc\xa2\x66\xc6\x4d\xe3\x57\x08\xb8\x79\x2c\x74\x19\x26\xe2\xc4\x9b\xa0\x40\xf6\x63\x76\xa6\xd8\xb9\xea\xc5\xf8\x46\xbe\x26\xa5\xc3\x26\xfb\xd2\x3c\xce\x2a\xb9\xb3\x71\x14\x80\xe1\x28\x1c\x9b\x29\x64\x9c\x4d\xd0\xce\x3f\xb9\x3e\x10\x59\xb8\xed\xc7\x8d\x81\xc8\xc2\xdb\x7e\x6c\x06\xa2\xdd\xf6\x3e\x0d\xb7\xfd\xac\x51\x28\x0d\x6f\xfb\x99\x2d\xb4\x11\xf9\x79\x90\xf7\x12\xdc\xcf\x89\xfd\xb5\x14\xea\x2c\xd8\x08\xb3\x69\x36\x6c\x3c\x88\xc8\xa0\x5d\x9d\x05\x73\x9b\x38\x47\xe2\xdc\x2d\x8b\xbb\xb5\x3d\xbf\xa1\xa6\x43\x2b\x97\x67\xf4\xdd\x38\xdc\x42\xa7\x87\x36\x52\x0a\x35\x95\xed\xb9\x86\x50\xc7\x64\xed\xcd\x9a\x59\x7b\x33\x2f\x2b\x2d\x9f\x1f\xdf\xbe\x7b\xf5\xfa\x97\x37\x8e\x6e\xb0\x8f\xbb\xdd\x50\xf6\x87\x5f\xd7\x07\xfb\xe7\x54\xa7\x45\xaa\xa5\x38\x20\x42\xeb\x76\xd3\x14\x9e\x29\x28\x60\xa4\xd3\xb4\xf7\x2c\xd7\xcd\x1d\xd9\x93\x47\xce\xef\x0f\x44\x64\x11\xff\x14\x0d\xf8\xea\x27\xd8\x1b\xc1\x76\x5f\x38\x63\x09\x87\x67\x31\x92\x97\x4b\x87\x2a\x04\x47\x96\x39\x30\x1a\x42\xaa\x88\x66\x9b\xc8\x12\x2d\x60\x97\x31\x9f\xc8\xfe\x70\x1a\x39\x0b\x77\x79\x89\x28\xb1\xf3\x89\xec\xd9\x44\xd6\xed\x9e\xc6\xb5\xa2\xe8\xc7\x4c\xb8\xb4\x6d\x5f\xb9\x5f\x23\xe8\xa8\x93\x22\xfe\x89\xe2\x5d\xcf\xc6\x81\xcb\x73\x5b\xe6\xb9\x65\xe7\xb3\x68\xb0\x3f\x35\xf1\xed\xcb\x4a\xc7\x2a\x5c\xfd\x24\x5c\xf1\xe8\x34\xde\xed\xe2\x83\xd7\xda\xbd\x06\xff\x6b\x8e\x5c\x97\xc4\x4e\xad\x75\x00\xa5\xba\x7a\x98\x29\x37\x00\x20\xfc\x4f\xa2\xcc\xdc\x2b\xdf\x9f\x5f\xec\xbd\x01\x23\xa0\x54\xc9\xcc\x68\xc1\xae\x93\x06\x81\xbb\xa6\xa3\x21\xf5\x66\xed\x76\x41\xfd\xee\x0d\x5c\xcd\xdc\x86\x2c\xb1\x6b\xc4\x36\x24\x46\x33\x06\x11\xf9\x59\xad\x7e\x3a\x2f\x33\x03\xc3\xc6\x7f\x0e\xec\x0e\xc2\xbe\xc9\xea\x3b\x06\x7b\xe5\x52\x7c\xbf\xdb\x05\xb9\xf8\xca\xc6\x5c\xc2\xe6\x4c\x48\x36\xf6\x93\xc8\xce\xf2\xb3\xb2\x1e\xd4\x2a\x52\x2f\x85\x31\x76\xb0\x42\x5a\x56\xc7\x6e\xd7\xb2\x36\x5a\x96\x06\x74\x7b\xa7\xfe\x34\x76\xbb\xc1\x46\x04\x6e\xc0\xb6\x7d\xf7\x6e\xcb\xce\xbf\x2a\xc7\xd1\x5a\x2f\xd3\xe9\xeb\x08\xae\xdf\x44\x55\x68\x73\x6f\xce\x0f\x65\xce\xdb\xfe\xe6\x0c\x7d\x66\x3c\x6e\x2c\x51\xd3\x8c\x96\x05\x7a\xac\x19\x6f\xe4\xb6\xf0\x9a\xd0\x6b\x6f\x02\x72\x79\x9f\xef\xd9\xcf\xd3\xc9\x8f\xa3\x84\x54\x0e\x47\xa8\x2f\x3d\x96\x57\x25\xa5\xd8\x1f\x8e\x01\xf1\x1c\x15\xb4\xc0\xa2\xe6\x9b\xea\xc1\x65\xc1\x17\xdc\xd9\xf6\xc0\x57\x2e\xc5\xe0\xb0\x0a\xd4\x64\xdf\x0c\x5c\x02\xea\xd4\x6a\x26\xdf\x6c\x56\xad\x57\xb4\xe7\xd4\x8b\x78\x6d\xd7\xc3\x01\xa8\x03\xae\x44\x3d\xac\x73\x79\x27\x07\x72\xac\x2e\x87\xe1\x93\xf1\x30\x52\x97\x5f\x8d\x2f\x22\x75\xf9\xed\xf8\x49\x34\x1c\x44\xea\x52\x0c\x29\x55\x5c\x50\xb2\x78\x42\xe9\xec\xac\x5e\x0f\x1a\x94\xc9\x7f\x6f\x64\x5e\x3c\xd5\x6a\xf5\x22\x8b\x57\x52\x74\x36\x80\x61\x56\x5a\xce\x2b\x9e\xf5\x46\xe9\x79\x7a\x53\xa3\xc5\x8a\x80\xed\x23\x93\xee\xd7\x81\xbb\x52\x53\x45\xbb\x9d\x7d\x7b\x23\x3f\x7d\x56\xc5\xaf\xf7\xe6\x59\xa5\xbf\xdf\x9f\x21\x7d\xa0\x7c\x7e\xe4\xbd\xdf\x64\x3b\x65\xb6\x48\x0e\x4c\x9b\x95\x4c\x37\xc4\x84\xcb\xaf\xce\xbf\x31\x94\xde\x35\x42\xbe\x25\xa4\x3a\x73\x4e\xb1\x6d\xb3\x85\x3b\x05\x92\x11\xf2\x6f\x8e\x13\xd2\x8e\xec\x76\x05\x59\x9f\x17\x71\x76\x8d\xa0\x28\x45\x98\x67\x33\x6b\xef\x4d\x40\x50\xf0\xfe\x04\xd5\xa0\xf4\xf5\xf3\x44\x49\x5d\x90\xf2\x1b\xdc\x35\x82\x83\x6d\x66\x4b\x99\x8f\xf2\x6e\xd7\xf9\x1c\x5f\x0d\x10\x1e\x1f\x06\x2e\xe1\x8c\xb2\xff\xc6\x95\xff\xf8\x81\xc2\xda\xc7\xde\x4b\xf7\xfb\x83\x8b\x5d\xef\xb1\x31\x2f\x2b\xd7\x85\x8c\x77\xac\xbc\xb1\x4f\x92\x38\xa2\x06\x1e\xcc\x0a\x91\x29\x03\xb5\xf0\x60\x4e\x12\x50\x76\xe8\x9e\x7c\x30\xaf\x95\xbe\x32\xd0\x19\xa9\x51\x34\xf7\x53\x12\xfd\xf7\x93\xfe\x9c\x50\x39\x4d\x96\x7e\x0a\x01\x7f\x7f\xd3\x5f\x3a\xe2\x63\x1b\x69\xe1\x93\x62\xba\x2c\xc8\xce\x67\x67\x99\x8d\x0f\x2b\x8f\xd1\x74\xa0\x50\x54\xad\x02\xe5\xbe\xc1\xce\x17\x67\x99\x15\x3a\xdf\x53\x81\xa3\xbf\x9f\xa7\x3a\x2f\xb2\x58\xe9\x82\xa4\xdd\xa2\x65\xd5\x61\xed\x74\x56\xf1\xb6\x4f\x8d\xea\x90\x26\x57\xda\xfc\x1d\x76\x50\x8f\x55\x1d\xdd\x57\x91\x69\x5d\x59\x93\x29\x61\xaa\xfa\x38\x8b\x93\xd9\x06\x82\x53\x1b\xdf\xe2\xc8\xf1\x15\x48\xe1\x4d\x0a\x16\x37\xab\x09\xa1\xae\xfa\xc3\xb1\x3e\x2b\x85\x55\x12\xc2\xaa\xf3\xe1\x60\x10\xd5\x93\xf6\xbc\x29\x32\x12\x6d\xa2\xc0\x4a\x8a\x65\x27\xf0\x44\x76\xbb\x9d\x49\x4a\xf8\x6f\x27\xef\x96\xf1\x3c\xbd\xf9\x35\x4d\x8b\x29\x24\xc3\x32\x2c\xd2\x77\x64\xb0\x1b\x40\xde\x20\x11\xab\x38\xcd\x0b\xc6\xa5\x1d\xab\x9f\xe3\xad\x5a\x6d\x56\x87\x03\xee\x02\xa4\x36\x84\x58\x05\x59\x0c\x9d\x3a\xc5\xfc\x49\x61\xb7\x0a\x55\x60\x05\x3f\xd2\x4f\x83\x4b\x51\xbf\x65\x2c\x03\x59\x2d\x5e\x2c\xd3\x0e\xd7\xec\xa1\x7c\xb4\xac\x91\x11\x11\xc5\x5a\x56\x8c\x17\xa9\xdd\x90\xd9\x31\x1b\xab\x8a\x6f\x86\xcc\xae\xde\xed\x96\xf5\xf1\x07\xfb\x6d\x6a\x68\x74\xdc\x2a\x25\x1e\xd1\x73\x1c\x06\x8f\xe8\xb8\xdd\xdd\xad\x3d\x37\x1f\x7b\x7c\xd7\xad\xed\x63\x1b\xf3\x58\xb8\x2d\x4a\x79\xc6\xf5\xc7\x89\x9c\x46\x6d\xa2\xce\x43\xa9\x65\x41\x11\x0f\x18\x5e\xbc\xcd\xd2\xb5\xcc\x8a\x5b\x17\x59\x00\xc3\x9f\xc9\x42\xe9\xf8\xdd\x2c\x3e\x68\x86\x53\x9b\x1f\x39\x28\x84\xdc\xed\xbc\x7b\xb5\x0c\x9e\x60\xae\xa2\x6e\xd7\x5e\x49\xf3\x46\xb1\xdd\x8e\x3c\xe0\x21\x1c\xf6\x39\x54\x32\xcd\xe2\x71\x65\x23\x9b\x39\xd5\xe8\xa8\xd2\xad\x9d\xc1\xd5\x85\x12\x45\x76\x06\xab\x0b\xc0\x93\x11\x12\x41\xa0\x31\x1b\xca\xfa\x1d\x99\xfc\xc0\xdb\x32\xcf\x54\x04\x80\x21\xb5\xf7\x22\xee\x75\xd6\xdb\x4e\x59\xca\x56\x4c\x89\xb0\x08\x78\x49\x7a\x24\xb3\x5f\x8f\x9c\x35\x04\x07\xdc\xe9\x15\x28\x03\x48\xe0\x3d\xaf\x05\xa5\x6d\x94\xaa\x98\xe5\x40\x21\x02\x3c\xb0\x3e\xe6\x71\x41\xb6\xc3\x71\x11\x5b\x28\x16\x15\x5e\xc7\xd9\xa7\xf8\x5a\x3e\x87\x6f\xde\xac\x38\x48\x00\x9b\x37\x02\xee\xa3\x2e\x0c\xc8\x5e\xe0\x6a\x10\xed\x15\x4c\xa6\xdc\x64\x87\x25\x4b\x61\x7f\x8d\x1a\x4e\xe5\x62\xc0\x61\xfa\xee\x31\x80\xa9\x18\x8c\xd2\xcb\xd9\x28\xed\xf5\xc0\x00\x62\x15\x9d\x8a\x60\x23\xf4\x24\x85\x4b\xf0\x29\xb8\xb3\x4a\x26\xbd\x61\x6c\x29\x5e\xd6\xad\xd7\x89\x43\x5d\xf2\x8d\xf5\xb2\xaf\xc9\xb0\x37\x46\x49\x65\xb0\x4f\x4b\x74\xe1\xfc\x32\x19\xe5\xbd\x1e\xc3\xc7\x84\x08\xe6\x62\x33\xc9\xa7\x6c\xb7\xab\xca\xcd\x81\x63\x70\xec\x4b\x73\x66\x68\x84\x45\xe9\xed\x66\x62\xd6\x2f\xae\x5c\xcf\x8c\x60\xca\x74\x6d\x41\x5d\xb3\xda\x94\x78\x92\x4d\xd2\xe9\x74\x94\x11\x03\x0e\xcc\x76\xbe\x60\x0e\x45\x62\xb9\xe7\xb5\x0f\x1e\x4c\x6c\xa5\x7f\x90\x5e\x2c\x8b\x6c\xb7\x0b\x4c\x8a\x28\xfc\xe2\x41\xec\xec\x69\xad\xbc\x3d\x06\x68\xc0\x95\x22\xfd\x48\xc6\xb8\xc2\xe7\x34\xc9\x07\x7e\x59\x90\x5e\x1d\x5e\xdf\x75\x89\x9b\x95\xad\xdd\x2f\xd4\xaf\x86\x0d\xc2\x7d\x47\xce\x5f\x49\xba\x7c\xdc\x23\xc9\xfa\xe9\x06\xa2\x08\x7d\xe2\x63\x9b\x7c\xb2\xf0\xdd\xd0\x9f\xd3\x6e\xfd\x0b\x40\xcf\xa4\x36\x31\x0a\x4a\x35\x97\x3d\x8b\xac\x2e\xf6\xe3\x31\x71\xe6\x2c\xd5\x79\x9a\xc8\x50\x66\x59\x9a\x05\x1d\xca\x1e\xfe\x2b\x3f\xd1\x69\x71\xb2\x00\xe1\x72\xda\x61\xbc\xb0\x87\x24\xd9\x5a\x51\x16\xd1\x52\x55\x4b\xd3\xde\xc6\x45\x21\x33\xbd\xdb\xdd\xd3\xec\x71\x11\xd9\x1e\x03\xf6\x2f\x8f\x8b\x0d\x7c\x9f\x82\xf0\x09\x36\x65\xf6\x59\xea\x20\x1c\xb2\x30\xbb\xfe\xe4\x6e\xec\xfd\x1e\xfa\xdb\x0a\x54\x36\x17\x99\xc6\x73\x49\x9e\x8b\x3f\xe1\x31\x76\x8f\xef\x64\x86\xa3\x4f\xfc\x15\xa9\x96\x03\x4c\x50\xec\xaf\x05\x52\xac\x67\xec\xf3\xf2\x85\x00\x0a\xba\x76\x87\x79\x2e\xde\xe0\xc9\x52\xda\xe2\x27\x3c\x58\xe5\x66\x2e\x3e\x53\xce\x57\x70\xae\x8e\x69\x38\x44\x26\x91\x92\xc4\xb7\xe9\xa6\xc8\xc5\x9a\x9e\xd6\x49\x5c\x00\xd1\x4c\xfc\x62\x1f\x09\x9d\x4b\xbc\xa6\x27\x73\xe2\xdf\x52\x0f\xe8\x08\x75\xcd\xfd\x95\x5e\xbf\x57\xb3\xcf\xb9\x48\xe9\xf5\x7b\x83\x43\x2b\x3e\x48\x4f\x5f\x68\xcc\xc9\x17\xca\x5f\x70\x60\xfb\x1a\xd5\x85\x99\xbc\x56\x39\x2c\x49\x90\xf8\xfe\x76\x2d\x11\xf3\x12\x41\xb7\x5d\x3f\x69\xf9\xbd\x56\xec\xb5\x0a\x97\x71\xfe\xcb\x8d\x76\xb7\x54\xf0\x0c\xa8\x50\x55\xc3\xcb\xaa\x82\xd7\x6a\xf2\x4c\x4d\xd9\xc8\xeb\xa3\x17\xc3\xc4\x9a\x5a\xff\xa8\x84\xd4\x76\x7b\xdc\x77\x4f\x05\xf6\xa2\x7a\x0e\xdd\xb4\x90\x9a\xe6\xd8\x3d\xe0\xf7\x6b\x32\xb0\x12\xa5\x0e\x35\x74\x4a\x66\xbc\x7c\x4f\x9a\x54\xa7\x52\xad\xbd\x32\x68\x68\x6e\x58\xab\x7e\xa0\xd8\x5b\xfa\xf9\x2c\xce\xa5\xa8\x26\xb9\xb4\xff\xda\x53\x1b\x8c\xb9\xf2\x4b\xab\x9e\xf5\x46\xde\x5e\x96\xe5\x7c\x7b\x9f\xb0\x0b\x00\xc5\x71\x64\xc4\x66\xd8\xe9\x3b\xcf\xf5\xc1\xec\x4d\x3a\xcf\xe2\xac\xc3\x3b\xcf\x36\x9f\x3e\xc1\x5b\xa3\xf3\x83\x75\x65\xed\xf0\x0e\x8a\x77\x78\xe7\x6d\x9a\xc4\x19\x40\x08\x3a\xbc\xf3\x6b\x3c\xa7\xec\xef\x66\xb4\xbd\x3a\xd3\xfa\x61\x23\xf5\xa4\x98\x56\x1b\xd4\xbf\x26\x61\xf8\x28\x91\xe4\xb5\x80\x54\xe4\x81\xa6\x5b\xef\x0e\xb4\x03\xac\x05\x96\x71\xf6\xb4\x08\x06\x2c\x2c\xd2\xd7\xe9\x8d\xcc\x9e\xc7\xb9\x0c\x58\xaf\xb0\x71\x34\x80\xbc\xcd\x48\x58\xf9\xa3\xda\x33\x36\xfa\xff\xfe\xff\x01\x00\x72\xb1\x0a\x44\x15\xa4\x02\x00")
 
 func jsChartJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -314,12 +319,12 @@ func jsChartJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "js/chart.js", size: 173077, mode: os.FileMode(420), modTime: time.Unix(1609252321, 0)}
+	info := bindataFileInfo{name: "js/chart.js", size: 173077, mode: os.FileMode(436), modTime: time.Unix(1610636534, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _jsLibJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\xfd\x69\x97\xdb\x36\x12\x30\x0a\x7f\x7f\x7f\x45\x8b\xe3\x61\x00\x0b\x52\x4b\x76\x92\x7b\x43\x35\xa2\xe3\xb4\xed\xc4\x33\x59\xdd\xce\x24\x19\x8a\xce\x61\x8b\x50\x8b\x31\x05\x2a\x24\xd8\x4b\x44\xce\x6f\x7f\x0f\x0a\x0b\x41\x8a\xea\x64\xe6\x79\x6e\x72\xdc\xe2\x02\x62\x2d\x54\x15\x6a\x3d\x7f\x3a\x3a\xfb\xed\x87\x8a\x15\x0f\x67\xb7\xcf\xa7\x9f\x4c\xe7\x67\xf5\x19\x5a\xe3\xb3\x7f\x5c\x9d\xbd\xce\x2b\x9e\xc4\x22\xcd\xf9\x59\xcc\x93\xb3\x5c\x6c\x59\x71\xb6\xce\xb9\x28\xd2\xeb\x4a\xe4\x45\x79\x56\x9f\xfd\xf6\xbb\xfc\x74\x9a\x17\x37\xe7\x59\xba\x66\xbc\x64\x67\x4f\xcf\xff\x7f\xa3\x4d\xc5\xd7\xf2\x43\xc4\x88\xc0\x07\xaf\x2a\xd9\x59\x29\x8a\x74\x2d\xbc\x85\x97\x5f\xff\xc6\xd6\xc2\xa3\x54\x3c\xec\x59\xbe\x39\xdb\xe5\x49\x95\x31\xdf\x3f\xf1\x62\xca\xee\xf7\x79\x21\xca\x65\xf7\x96\xb2\x69\x92\xaf\xab\x1d\xe3\x62\x29\x10\x23\xa3\x19\x0e\xda\x56\xf1\x21\xdd\xa0\x51\x5b\x04\x8b\x6d\x91\xdf\x9d\x71\x76\x77\xf6\xaa\x28\xf2\x02\x79\x7a\xcc\x05\xfb\xbd\x4a\x0b\x56\x9e\xc5\x67\x77\x29\x4f\xf2\xbb\xb3\xbb\x54\x6c\xcf\xe2\x33\xf3\xa5\x87\x17\x05\x13\x55\xc1\xcf\x04\x62\xb8\x09\xe0\x2f\xf2\x2a\x9e\xb0\x4d\xca\x59\xe2\x8d\x4c\x77\xd5\xf7\x4b\xf5\x13\x88\x6d\x5a\x12\xdb\xa1\x4b\xc2\x7a\xd3\x70\x1b\x17\x67\x82\x86\x11\x29\xe8\x77\x30\xee\xe9\x0d\x13\xdf\x17\xb9\xc8\x65\x75\xdf\x6d\x48\x49\xc5\xb4\x94\x73\x4a\x6e\xa8\x98\x6e\xb2\x58\x2c\xdd\xf1\x99\x4e\xc1\x9b\xe9\x3a\xce\x32\xe8\xde\x60\x91\x75\xce\xd7\xb1\x98\xc6\xfb\x7d\xf6\x80\xc2\x88\x30\xdc\x90\x8a\x8a\xe9\xbe\x2a\xb7\x24\xa5\x62\x9a\xf2\x84\xdd\x7f\xb7\x21\x9c\x1e\x1a\x92\x53\x3e\x15\xf9\x95\x28\x52\x7e\x43\x6e\x29\x9f\x6e\xe3\xf2\xbb\x3b\xfe\x7d\x91\xef\x59\x21\x1e\x48\x4c\x6f\xdb\xf7\x19\x8d\x55\xe3\x6a\x10\x98\x3c\xc8\x2a\x76\xf4\xb8\x1f\x9e\x79\xd4\x2e\xb0\x5c\x74\x5e\xed\xae\x59\xd1\xce\x22\x9b\xf2\x3c\x61\xef\x1e\xf6\xac\x21\xf7\x03\xd5\x9c\xf1\x2a\xcb\x46\x94\xf9\x3e\xa3\x94\xb2\xa9\x9a\xee\x86\xbc\xa2\x97\x76\xb5\xc9\x9a\x1e\x64\x75\xc1\x68\x46\xca\x62\x2d\x7f\x78\xce\xd7\x4c\x5d\x7c\x03\x70\x14\x8c\x66\xcd\xc2\x54\x7f\x76\x2d\x01\x95\x70\x7c\x90\xcb\x52\x90\x94\xe4\x14\x71\xca\xeb\xfa\x15\x9e\xae\x0b\x16\x0b\xf6\x2a\x63\xb2\x6a\xe4\x95\xeb\x22\xdd\x4b\xb0\x48\x37\x28\x9f\x0a\x76\x2f\xa8\x04\xf2\x4d\x5e\xa0\xe2\x2c\xe5\x67\x6b\x8c\x52\x2a\xc2\x22\xaa\x6b\x58\xd3\x17\x42\xed\x17\xe6\xfb\xdd\x7b\x54\x60\xec\xfb\xf9\xb4\xec\x3c\x23\x29\x5e\xf0\xe9\x96\xc5\x89\x5c\x2f\xc6\x93\xcb\x6d\x9a\x25\x28\xc7\xd3\x7d\x5c\x30\x2e\xbe\xcd\x13\x36\x2d\xd8\x2e\xbf\x65\xe6\x4d\x63\x87\x71\xd7\x9b\x27\x4a\xd9\x92\x8d\x3d\x2f\x38\xda\x5b\xac\xae\x87\x16\x64\xc9\xc3\xdc\x00\x53\x54\xd7\xe6\xb3\xc0\xbc\x6f\xe4\xfc\x6c\xa8\x07\x98\xc2\x23\x57\xb4\xbb\xd1\x4d\xd3\xec\xee\xec\x6a\xba\xe1\xd3\x94\xa7\x02\xde\x38\x53\xbd\x97\x7d\x54\xd0\x3f\x1a\x49\x08\xc8\x18\xbf\x11\x5b\x2f\xe5\x12\x1e\xd8\x54\xdd\x12\x4e\xe5\x60\xf4\xde\x1b\xed\x10\xc3\xbe\x3f\xba\x87\x1f\xe4\xc5\x45\x11\x3f\x78\x94\xca\x15\x9a\x51\x4a\x45\x5d\x1b\x40\xb2\x63\x11\xbe\x3f\xbb\x10\xbe\x2f\x26\x73\xb9\x2c\x0c\x37\xb2\x4b\xf4\x6a\xba\x37\x9b\x8c\x1e\x14\xf6\x0a\x36\x64\x9d\xf3\x52\x14\xd5\x5a\xe4\x45\x70\x45\x54\x17\x82\x19\x11\xf9\x0b\xd9\x52\xbb\xab\xec\x10\x4b\x35\x4b\x72\x97\xe3\x86\xdc\x30\x31\xb4\xf3\xcc\x12\xb8\x85\x03\x76\x31\x5b\xca\xab\x90\x8d\xe5\x8f\x1e\x6f\x14\xa8\x67\x51\x43\xe4\xae\xbc\x12\xf1\xfa\x43\xa7\x4a\x35\x63\x57\xd3\x1d\x2b\x6e\x18\x54\x35\x75\x3a\x8d\x30\x61\x2d\xa2\x9a\xee\x0b\x76\xab\x36\x24\x05\x3c\x24\x1a\xc2\xe2\xf5\x76\xa8\x8f\x57\x53\xf9\x06\x2a\x04\xbc\xb0\x8b\xf7\x6d\x31\xde\x22\x11\xd9\xa0\xed\x19\xba\x9a\xee\xe2\x3d\xea\xe2\xb8\x0e\x04\x68\x20\x22\x42\x56\x8a\x71\x43\x00\x95\x0d\x4c\x64\xaf\xe2\x52\x63\x29\xa8\x3a\x2e\x6e\x60\x3f\x97\xb2\x82\x4d\x5a\x94\xe2\x54\x05\xec\x77\x34\xc3\x0d\xc9\xe2\x47\x8b\x4c\xe6\xb8\x21\xec\x96\xf1\x3f\xef\xc7\xd5\xf4\xa6\x60\x8f\x8c\x10\x89\xf1\x1c\xff\xfd\x19\x0c\x2d\x4f\x92\xff\xf3\x0a\xcf\x84\xae\x8d\xfd\x3e\xb0\xee\x0e\xa4\x10\x4e\xc7\x6c\x8c\x00\x8c\x82\x59\xbb\xe8\xdd\xe6\x66\x17\x94\xfb\x3e\xbf\x10\xcb\x10\x00\x8b\x47\x51\x10\x46\xb2\x7a\x7e\xba\xb3\x16\x6a\xea\xfa\x18\xc0\x14\x60\x06\x15\x29\xf3\x42\x04\x62\x2a\x7f\x48\xb9\x87\x65\x15\x53\x75\xd1\x90\xab\x29\xbb\x17\x8c\x27\x14\xf6\xbf\xbe\x76\xda\x93\xc3\x01\x54\x4b\x00\xcb\x92\x98\xda\x45\x0e\x67\x51\x5d\x1f\x1a\x52\xd2\x39\xa9\xda\xc7\x66\xd8\x19\x1d\xcd\x17\x12\xcb\x7a\xd7\x79\x9e\xb1\xd8\x41\x5b\xb1\xef\xa3\x8c\xc6\x9d\xca\x4a\x5d\xd9\x78\x8c\xc9\x11\xf6\x8b\xeb\x7a\x87\x62\x5c\xd7\x28\xa6\x87\x06\x93\x92\x52\x5a\xf9\x3e\x8a\xd5\x76\x29\x27\x13\xbc\x28\x2f\xaa\x85\xfc\x3a\xdd\x20\x45\x72\x10\xeb\x54\x8f\x01\xe5\x0b\x85\x5b\x0a\xca\x42\x11\x11\xef\xd7\x5f\x01\xbb\xfc\xfa\xab\x37\xa2\x54\xf8\x7e\x3c\xa2\xb4\x90\xbd\xf3\x7d\xf9\x73\x35\x4d\xcb\xef\xb3\x38\xe5\x6a\x9a\x51\x21\xbb\x90\x52\x40\x32\xd3\xb4\x84\x5f\x49\x16\xf0\x12\x71\x1a\xcb\x1a\x73\x9a\xfa\xfe\xa8\x5b\x80\xe3\x65\x18\x05\x69\x5d\xf7\xab\xe3\x78\xc9\x83\x43\x43\x52\x3a\x9a\x13\xf9\x39\x35\xcb\x81\x32\x92\x93\x02\xe3\xe0\x36\x4f\x93\xb3\x99\xee\x15\x14\x29\xb0\x85\xa1\xb8\x5d\x3f\x74\x60\xf7\xfb\x98\x27\x79\xa0\x39\x25\x6f\x8c\x36\xe3\x6f\x62\xb1\x9d\x16\xf2\xf1\x0e\x61\x3c\x2d\xd8\x3e\x8b\xd7\x0c\x9d\xaf\x5e\x9e\xdf\x10\xcf\xc3\x24\x2d\xdf\xb2\x38\x79\x90\x84\x96\x49\x3e\xab\x03\xca\x7d\x1e\x4c\xa2\x1b\x9e\xe7\x7b\x17\x1e\x1b\xd2\x19\xd2\xf1\x56\x20\xdc\xd0\x04\x34\x92\x44\x2c\x54\x6b\x7b\xa6\xca\x47\x72\xde\x2d\x05\x93\xb4\x62\x84\x04\x95\x4d\xe1\x21\x82\x87\x38\xbd\xd5\xb8\x99\x78\x0e\xb4\x7b\x58\x12\x6b\xe7\x01\xf6\x7d\xcd\xe7\x70\x4c\x29\xcd\xb0\xec\xe7\xab\xdd\x5e\x3c\x9c\xea\xe7\xc2\x85\x0e\xd5\xe1\xb9\xe9\xf9\xac\x21\x37\x59\x7e\x1d\x67\xaf\x6e\xe3\x2c\x70\xb1\x81\x64\x41\x24\x2f\x72\x50\xfc\x8a\x24\x5f\x53\xb8\x6c\x08\xc7\x47\x48\x5c\x62\x0f\xd9\x18\x27\x05\x9d\x49\x7e\x44\xd2\x56\x7c\x90\x2d\x73\x6a\x28\xe9\xa2\xb8\xe0\x8b\x42\x01\xf2\x68\x2e\x89\xa5\x9e\x9e\xb0\x88\x48\x41\xe4\x0f\xc6\xd7\x05\x8b\x3f\x34\x2c\x2b\xd9\x99\x65\x64\xd8\x9f\x7f\x61\x00\x87\x49\xba\xf1\x81\xf5\x88\x65\xdb\x3f\x49\xa0\xc3\x68\xd1\xe7\xe0\xd0\x5e\xb3\x8d\xb2\xdb\x4b\x43\xdb\x38\xf1\x4a\x60\x2e\x5d\xbe\x24\x64\x51\xc0\x70\x50\xe9\x45\x20\x0c\x63\xc2\x1b\x92\xf2\xe3\x36\x09\xef\x11\x60\xb1\x9c\xcc\x83\xd4\xac\x33\x83\x99\x84\xa6\x7a\x5d\x95\x23\x57\xdd\x1d\x0b\x83\x75\x0a\x3a\x23\xe9\xf1\x5c\xb2\x30\x1d\x8f\x23\x60\xf3\xec\x1c\xe8\x32\x34\x25\xac\x21\x12\xd9\x1f\xf5\xca\x34\x50\x48\xbe\x3f\xa5\x33\x92\xdb\x9a\x49\x4c\x47\x7c\x91\x5e\xe4\x8b\x74\x3c\xc6\x23\x81\x58\x98\x46\x24\xc5\x23\x4a\x63\xdf\x2f\x00\xb3\xc3\x33\xbb\x59\x8b\x1e\xad\x3e\x62\x60\x67\x24\xa6\x61\x64\xc1\x02\xd6\xb5\x1d\x49\x7e\x51\x2c\xf2\xf1\x18\x6b\xdc\x96\x52\xd9\x64\x1e\x91\x9c\x70\x0c\xd0\x0e\x2d\xa6\x78\x61\x81\x22\x57\x40\xf1\xa7\x1f\xe8\xfe\xdd\xa0\x58\x72\x46\x55\x9a\x04\x73\x52\x56\x7b\x79\x6a\x0b\x1e\x1a\x4c\x06\xf8\xce\xab\x87\xdd\x75\x9e\x01\x82\xdc\xf0\x50\xdd\x4d\x53\xc1\x8a\x58\xe4\x85\x9c\xe6\xfe\x23\x4c\x34\xdf\xe2\x7d\xa1\x88\xc1\xd9\xb7\xc0\xfe\x9d\xa9\x63\xc9\xd9\x6b\xc3\x6d\x02\x78\x9c\xbd\x8c\x05\x3b\x7b\xcb\x6e\x5e\xdd\xef\x35\xa2\x50\x28\x48\x37\xec\x01\xf9\x12\xc8\x3b\xf3\x70\x8f\x38\xf3\xd0\x62\x18\x6f\x2c\xc6\x5e\xe4\x45\x54\x4c\x45\xfe\x75\x7e\xc7\x8a\xcb\xb8\x64\x08\x37\x18\x0e\x73\x0e\x99\xe3\x86\xce\x25\xe4\x9a\xe4\x24\x25\x5b\xb2\x21\x37\xe4\x8e\x54\x24\x23\xef\xc8\x25\x89\xc9\x2b\x72\x4b\x4a\xb2\x26\x0f\xe4\x8a\x7a\x65\xfa\xc7\x1f\x19\xf3\xc6\xf3\xa7\x12\x39\xca\xce\x92\x3d\xe5\xed\x71\xe6\x03\x9d\x01\x20\xee\x68\xc5\x10\x26\xf7\xea\xe7\x85\xfa\xf9\x56\xfd\xbc\x1c\x66\xc5\xe5\x21\x49\x00\x79\x1c\xcd\x30\x99\x35\xe4\x37\x7a\x68\xfa\x27\x3a\x38\x87\xfe\x2e\x0f\x84\xf9\x9e\x7c\x6d\x0e\x86\x5f\x99\x8b\xef\xec\x49\xf4\x7b\x7a\x6a\xc7\xc8\x0e\x5a\xd8\xe2\x17\xc5\x82\x2b\x8c\xc3\x42\x1e\xc9\x2e\x60\xb3\x23\x35\x78\x4c\xe6\x0d\x79\x4b\xbd\xf5\x96\xad\x3f\xb0\xa4\x2e\x59\xc6\xd6\x82\x25\x75\x5c\x3e\xf0\x75\x1d\x57\x22\xdf\xe4\xeb\xaa\x84\xab\x7d\x16\x3f\xd4\x20\x77\xc8\xb3\xb2\x4e\xd8\x86\x15\x75\x92\x96\xf1\x75\xc6\x92\x7a\x9b\x26\x09\xe3\x75\x5a\xee\xe2\x7d\x9d\xe5\xf9\xbe\xde\x55\x99\x48\xf7\x19\xab\xf3\x3d\xe3\x75\xc1\xe2\x24\xe7\xd9\x43\xad\x4f\xfa\x49\x5d\xae\xf3\x3d\x4b\x3c\xf2\x0d\xf5\xc2\xd5\xea\xfe\xd9\x6c\xb5\x12\xab\x55\xb1\x5a\xf1\xd5\x6a\x13\x79\xe4\x0d\xf5\xd0\x32\x58\xad\x56\xab\x70\xb5\x4a\xe2\xc9\xe6\xc5\xe4\x75\x74\x98\x93\x4f\x1b\x6f\xfc\xcd\xd8\x5b\xd6\xf0\xea\x7d\xfb\x49\x1d\xae\x56\x77\x93\xa8\x0e\xdf\xaf\x66\x93\xd5\xea\xfe\xff\xd9\x44\x78\xec\x91\x9f\xa8\xb7\x5a\x85\xf0\xcd\x53\xe4\x8d\xdf\x8c\x3d\x8c\x96\x81\xbe\x0f\x9f\xbe\x7f\x52\x8f\xfe\x13\x2d\x29\xd6\x4f\x96\xc1\x47\x48\xb7\x3b\x95\x55\xad\x56\xab\x8f\x22\xfc\x14\x7f\x54\xaf\xbc\xfe\x8b\x95\x27\xdf\xac\xbc\x5a\xd7\x8b\x6b\x5d\xcb\x6a\x15\x79\xe4\x35\xf5\x82\xb6\xc1\xd5\x0a\x21\xf4\xdf\x57\x8d\xeb\xfe\x1b\x84\xc3\xd5\x2a\x8a\x6a\x6f\xfc\xd3\xd8\xc3\x4f\x71\x3d\x7d\x8a\x57\x2b\xd9\x34\xf9\x82\x4a\xc0\x55\x1b\x0c\x7d\x33\xf6\xc6\x1e\xf1\x6e\x3c\x4c\x9e\xb8\xcf\xbd\xf7\xd0\xc7\x31\x54\xfc\x5e\x57\x1a\x61\xd3\x0a\x7e\xaa\xc6\x30\x7e\xa2\x3f\xfe\x75\xe0\xe3\xa7\x44\xfd\x78\x98\xfc\x31\xf4\x1a\x85\x9f\x8f\xff\x23\xbb\xf8\xcd\xd8\xc3\xb6\xe8\x8f\xbd\xee\xd5\x9f\x7b\x98\xfc\xec\x3e\x7c\x8d\xc9\xbf\xfa\xf5\xbd\x19\x7b\x4f\x3c\x4c\xbe\xa4\x87\x37\x2f\x83\xce\xbb\xbf\xe9\xd9\xf5\x30\xb9\xfc\xfa\xc5\xd5\x55\xf7\xed\x6a\x35\x6d\xdf\xbf\x7b\xf1\x65\xf7\xad\x7a\x55\x87\x4f\x23\xf9\xfa\xc5\xbb\x77\x6f\x83\x5e\xbb\x3f\x61\xf2\xfd\xd5\xab\x1f\x5f\x7e\xd7\x7f\xf1\x1a\x93\xcb\xaf\xde\x7c\xdd\xeb\x4c\x80\x00\xbc\xe1\x78\x54\xcb\x03\x50\xcd\xc5\x56\xfe\x9b\xc8\x1b\x3c\x41\xeb\x6d\x9a\x25\x75\xbe\x99\x48\x64\xab\x21\x42\xcf\x96\x3c\x0b\xd5\x79\x92\xd4\x08\x85\xe3\x49\x54\x63\xb4\x5a\x25\x4f\x31\xaf\x5b\xa0\xd4\x2f\xf4\xfd\x6a\x95\x8c\x71\x8d\x2d\xb4\xc1\xea\x7b\xa9\x87\x89\x64\xca\x7b\x23\x95\xc0\xfe\x76\xec\xe1\x27\xba\x08\x67\x2c\x29\x2f\x73\x2e\xd8\xbd\xe8\x8f\x4d\x56\xa7\xd6\x2e\x68\x7b\xc5\x7e\xaf\x6f\x44\x9d\xa9\x11\xb5\x03\xec\x8e\x01\x2d\x83\xc9\x6a\x95\xe0\x25\x74\xdd\xe9\x18\x5a\xd2\xf0\xfd\x24\xaa\x9f\xe8\x2e\x36\xe4\x17\x7a\xfe\xd5\xbb\x6f\xbe\x7e\x72\x9e\x92\x1f\xe8\xb9\xec\x60\xca\xf7\x95\xd0\xd8\xa7\x96\xfd\x8a\x0b\x16\xd7\xd7\x95\x10\x39\xc7\xb2\xdc\x3f\xe8\xf9\xfb\xed\x2a\x91\x97\xff\xa4\xe7\xef\xc3\xf7\x87\x68\xbc\x3a\xac\xca\xa7\xab\x90\xc7\x22\xbd\x65\x67\xab\xbb\x73\xf2\x6f\x55\xdb\xdf\x50\x28\x11\xc1\x18\xd7\x68\x75\x37\xc6\xf5\x6a\x6a\x1e\xe0\x27\xe7\x84\x31\x7a\x1e\x8e\xff\x13\x9d\x13\xc1\x3a\xb0\xf6\x27\xa8\x06\xb9\xb8\x06\xeb\xcd\xc1\x19\x1d\xe2\xb3\xbc\xd9\xbd\x37\x66\x0a\x5b\xa3\x39\x9e\x7c\xfa\xc9\x27\xcf\x3f\xb5\x47\xc4\xba\x46\xfc\x62\xb6\x54\x34\x72\xba\x29\xf2\xdd\xe5\x36\x2e\x2e\xf3\x84\x21\x3e\x86\xa2\x38\x18\x7c\xf9\xf9\xe7\xf3\x59\xfd\xc9\x27\xcf\x3e\xfb\x94\xcc\x67\xcf\x9e\xfb\xbc\xfe\xe4\xd3\xe7\xcf\x66\xf2\xb8\x5a\x30\x7a\x8e\x42\x89\xf8\xee\xe7\x1b\xc0\x7d\xf5\xfb\xc9\x72\x95\xe0\xfa\xfd\xe4\x89\x46\x89\xfa\xcd\x64\x55\xbd\x7e\xfd\xfa\xb5\x9c\x91\xf3\x1b\x92\xf6\x47\x60\x7a\xb9\xf4\x56\x33\x8f\x52\xca\x96\xde\xaa\xda\x6c\x36\x89\x17\x98\x11\xcd\xc8\x64\x8e\xc7\xde\x6a\x25\x07\xb9\xd6\xdd\x7b\x21\x90\xa1\x3c\x93\x39\xb6\xa2\x49\x34\xff\x14\x8f\xbd\x33\x2f\x50\xc5\x1b\x92\x33\xf7\x20\xfa\x4e\x9e\x68\x63\x46\xaf\x19\x3a\x96\x8a\x8c\x66\x20\x5b\x34\x44\xc6\xf7\xbd\x4d\xca\xb2\xa4\x64\x02\x3a\x06\x22\xca\x6f\xe3\x1d\xeb\x31\x02\xe4\x90\xa4\x45\xe0\xb5\x82\x3a\x8f\x70\x09\xeb\x5e\xc6\x6e\x18\x4f\xbc\x06\x2f\x44\xf1\x70\xf8\xca\xc8\x38\xe8\x77\x8a\x29\xdd\x4f\x61\x8f\xca\x2f\x4a\x4c\xba\x77\x22\x74\xef\x8d\xb4\xa8\x15\x92\xae\x63\xb1\xde\xca\x9e\x7f\x45\x0f\x50\x6d\x60\x78\xd7\x65\x77\x7a\xbf\xd6\xad\x32\xa2\x5b\x15\xb8\x23\x2f\x6e\x81\x88\x39\xcc\xef\xe2\x6e\x9b\x66\x4c\x92\x71\xcd\xef\x8e\xc7\x11\x5e\x58\x5e\x57\xd2\xf1\xa6\x95\x3f\x96\x4c\x31\xd8\xa4\x50\x75\xc1\x09\x9f\x94\xc0\xf7\xac\xc9\x06\xa4\xb6\xd3\xfc\x8e\xb3\xe2\xa5\xe1\x6d\xf6\x94\x2d\x5b\x99\x6f\xf0\x99\xe4\x57\x41\xf2\x1a\x46\xf6\x1c\x60\x85\xc3\xa2\xae\x47\xa2\xae\xe7\x23\x4a\xf7\xbe\xff\x99\xfa\x99\xc3\x6d\xcb\x60\xc8\x53\x8b\x3c\xdd\xbe\x43\x0c\x13\x46\x59\x5d\x5f\x92\x57\x18\x34\x02\x73\xfd\x25\xaa\xe8\xbf\xa7\xec\x9e\xad\xe5\x24\x48\x36\x25\xa5\x55\x38\x8f\xa0\xcc\x67\x54\xd6\x06\xfa\x03\x14\x53\x36\xbd\x61\x42\xcb\x7e\xbf\x78\x78\x93\xa0\x14\xe3\x4e\x53\xf1\x34\x4d\x28\xa5\xa9\x7d\xa8\xf8\xe0\x58\x1e\x4f\x80\x75\x4e\x37\x68\x03\xa2\x85\xcd\x40\x55\xbe\x2f\x17\x24\x06\xfe\xf9\xf1\x7a\x64\x87\xaa\xf0\x59\x64\xde\x1b\x20\xe2\xc4\xed\x62\xf9\xc5\xc3\xbb\xf8\x46\x82\xa6\x1c\x19\x81\x1e\xc2\xe0\x9e\x47\xd8\xf7\x93\x6e\xc9\xcb\x2c\x2e\x4b\x59\x56\xae\xca\xf0\x9b\x3f\x6d\xcd\x96\x94\xa3\x21\xbc\x49\x37\x28\x99\xfe\x5e\xc6\xbe\x3f\xfa\x36\x14\x72\xff\x45\xf2\x10\x7e\x5b\xd7\xa3\xdb\xa9\x60\xa5\x90\xfd\xf2\x7d\x04\x0b\xd1\x0a\x98\x47\xa7\x77\x95\x5a\xb9\x35\x15\x12\x7e\x88\x3c\x8e\xca\x05\xfc\xd1\x54\x56\xd7\x7f\xd8\x7a\xf1\x01\x6d\x28\x63\xe6\xde\xf7\x1f\x18\x62\x8e\xdc\x1c\xd7\x35\x93\xc7\x77\x26\xa7\x02\xd8\xc0\xba\x46\xa8\x54\x8b\xdc\xca\xe0\xbd\x34\xf1\x30\x5e\x96\xb4\xb4\x12\x8e\x82\x91\x94\x61\x89\x87\xfa\x05\x49\x49\xaf\x30\x26\x39\x45\x19\xdd\xca\x4e\x18\x26\x58\x6d\x9c\x7c\x32\xc1\x59\x98\x47\x14\x95\x4b\xef\x6f\xde\xb8\x0c\xbc\x00\x5a\xf6\x00\x39\x8d\xef\x19\x92\xaf\xf1\x62\x4d\xb3\xe9\x6f\x79\xca\x91\x47\x3c\xdc\x48\x34\x71\x34\xf5\x9b\x29\x88\xab\xaf\x80\x5a\xe5\xc5\x8b\x2c\x43\x6b\x98\x74\x8b\x03\xbe\x45\x82\x8c\x66\xb8\xd9\xa4\x3c\xce\xb2\x87\x43\x49\x29\xbd\x92\xab\xab\x14\x06\xbd\x21\x36\x4d\x63\xcf\x6a\xc2\x0e\xf5\x09\xf1\x9e\xcc\x3d\xac\xb7\x71\xbb\xb7\xe5\x29\xe3\x60\x4e\xaf\x86\xa4\xd8\xb7\x72\xe3\xb7\xe7\x6e\x7d\x5e\x85\xf5\xc7\x9f\x5f\x4f\xd7\xf1\x7a\xcb\xbe\x86\x79\xf1\xfd\x84\x65\x4c\xb0\x33\x16\x16\xd3\x72\x9b\x6e\x04\xc2\x11\x61\x1a\x56\x28\x77\xb0\x89\xc4\x3b\xed\x41\x26\xbc\x8a\xe8\x68\x46\x58\xfb\x7e\xcd\x5a\x01\xe9\x65\x5f\x4d\x63\x11\xb6\xc2\xb9\x1a\xaf\x8f\xe4\xbe\x68\xe7\xcb\x08\x66\xec\x84\x09\x07\x5a\x7c\x5f\x9c\xd2\xb9\x08\x4c\x04\x95\x07\x61\xa7\xb7\x1b\xd6\xc5\xa1\xfa\x48\x59\x7b\x98\x14\x94\x77\xa1\xa2\x98\x4c\xf0\xf5\x34\x16\xa2\xf8\x2a\xe6\x49\xc6\x42\x1e\x16\x51\x44\x45\x5b\xdb\xbe\x53\x9b\xf0\x7d\x26\x6b\xf1\xfd\xb9\xa5\x3f\x12\x5d\xaa\x7b\xe1\xdc\xb3\x69\x99\x57\xc5\x9a\xbd\xe1\x09\xbb\x9f\x08\xf7\x4e\xe2\x82\xc2\x6c\xe8\x02\xf0\x2c\x56\xdd\xe1\x94\x4f\x25\xa1\xba\x4a\xaf\xb3\x94\xdf\x80\xc8\xd3\x39\xb4\x4d\xe6\x56\xc6\xb1\x9c\x07\x93\x79\xdb\xcb\x44\x4e\xe7\xa1\x07\x0b\x8e\xca\x0f\xd8\xab\xc7\x48\xa6\xec\x30\xa8\x62\x28\x15\xce\x5c\x6e\x99\xa3\x7a\x38\x96\x86\x9f\xaa\x4d\x77\x13\xb5\xed\x8a\xba\xf6\x14\x37\x07\x77\x6e\x7b\x2e\xa4\xdd\xfc\xc9\x38\x36\x79\xb1\x03\x05\xd5\x92\x75\x00\x64\x34\xef\xf0\x09\x4b\x2f\x8b\xaf\x59\xa6\x4a\x3a\xd7\xce\x37\x9d\x0a\xec\x87\xb2\x6f\xc1\xd1\x6d\x5a\xbe\x74\x1e\xd4\xb5\xfb\x64\x44\xe9\x48\xf8\x7e\x2c\xb7\xc0\xd0\xd7\x4e\xeb\x72\xcc\xee\x3b\x67\xdc\xb7\x0c\xc5\x76\xdc\x99\xc3\x09\xe5\xf6\x69\x4e\xc7\x39\x71\x5f\x75\xa4\x8e\x31\xa8\x93\x0d\xbf\x90\x63\x92\xd2\xa2\x0b\xea\xe9\x64\x82\x59\xc8\x69\x11\xa6\x91\x24\x05\x20\x10\x18\x21\x21\x7f\xe4\x35\xc6\x8d\xfc\xdf\x76\xe9\xa1\xb3\xe9\x7d\x7f\x48\xd3\x3e\x4c\xf1\x7c\x9f\x35\x9b\xbc\x40\xec\x2c\xe5\x67\x09\x2d\xd9\x54\x4b\x9d\x28\x88\xc4\x4b\x39\x7d\x3f\x7f\xf3\x35\x1d\x84\xa7\x78\xc7\xca\x7d\xbc\x66\x3f\xbe\x7d\x43\x38\x45\x3d\x2e\x45\xd2\x0d\x2b\x8e\xd1\x0d\x1b\x61\xee\x2f\x9a\xdc\xd4\x35\xf7\x7d\x6e\x21\xb3\xae\x3d\x79\xd6\x90\xc7\x8e\x77\xd0\x17\x26\x4c\x6d\xc7\x3d\x90\xa8\x16\xf8\xa0\x7e\xab\xc1\xde\x8a\xfb\x46\xf4\xd2\xf7\x25\x73\x52\x38\xdb\xbd\xe8\xf7\x0a\x38\x0d\x74\x49\x8b\xa3\xfe\x92\x57\x74\x94\xa2\x4b\x4c\xf6\x50\x13\xe2\xf4\x72\x9a\xb0\x4d\x5c\x65\xe2\x5f\x29\xbb\xc3\xb2\xf3\x22\xdf\x8f\xa8\x44\x33\x88\x4f\xe3\x24\x79\x75\xcb\xb8\xf8\x3a\x2d\x05\xe3\xac\x58\x1e\x3f\x42\x5e\xc5\xb3\x3c\x4e\x3c\x92\x33\x32\x9a\xe3\x80\x4b\x9c\x16\xaf\xb7\x50\x4a\x56\xe8\xdc\x22\x2f\xe7\x6d\x71\x8c\x89\x26\xc1\x74\x3d\xc4\x81\x9f\xc5\x1d\xf5\x37\xc3\x9d\xdb\x23\x6c\x9f\xa4\xb7\x1e\xc6\x64\x18\x58\xfa\x54\xd3\xf7\x47\xc7\x0f\x91\xa6\xcb\x67\x86\x70\x9c\x41\x9d\x1a\x98\x1b\xd9\xdd\xd8\x90\xcf\xf2\x44\x9f\xd9\x74\x6d\xd8\x21\xea\xa5\x1e\x19\xf5\x19\x0b\xfb\xda\xc3\x50\xe3\x10\x18\x9f\xac\x7b\x68\x02\x2e\xf3\x9d\x9a\x00\x39\xfa\xd1\x09\x4e\xd0\x7b\xda\x1d\xc7\x30\x03\x47\xff\xa9\x00\xf9\xf2\x14\x2b\xa8\xbe\x94\x9c\xeb\x5f\x5d\xb2\x34\xa1\x57\x64\xd4\xab\x50\x6d\x8e\xa1\xa7\xe8\xaa\xdf\x4d\xd9\xd8\x12\x5d\x4f\x37\x69\x26\x58\x31\x7d\xf3\x72\x70\xf3\x1a\xce\x45\x30\xc2\x5b\x2d\xf8\xe0\x1c\x1e\xf3\x79\x0a\x23\x12\xd9\x06\x4f\xba\x2d\x48\x44\x97\x6e\x06\x6d\x7d\x44\x8f\x97\xf7\xfd\x57\x96\x56\xf7\xd9\xfc\xb6\x4b\x7c\x19\xf2\x28\x08\xa3\xa6\xc1\xc1\xa3\xa3\xe2\x7f\x69\x54\x6a\xfc\x27\x11\xa4\x1d\xa8\xa2\x55\xc7\xcf\xd4\x04\x58\xe9\x80\xe4\x77\x6e\xe3\xac\xd2\xc4\xf1\xff\xde\x94\x28\x55\xef\xe0\xc4\xa4\x1b\x49\x6a\xe4\x21\x85\xd3\xfc\x44\x07\x01\x33\x99\x8e\x99\x13\x49\x98\x47\x8b\xb4\x53\xa5\x01\x22\x86\x9d\x73\x6b\x4e\x53\x75\x64\xfd\xdf\x9a\xd0\xfc\x31\xac\x98\x99\x8f\x77\x2f\xbe\xa4\xc3\x3b\x77\x39\x24\xcf\xf8\xb3\xa9\x72\x3e\x1f\x7e\x8c\x18\x0e\xe0\x48\xb5\x14\xc7\x48\x8b\x19\x8d\xee\xe0\x49\x9e\x38\x8a\xa6\x93\x95\xcb\x35\xf0\x9e\x02\x93\x86\x0f\x86\x1f\xcc\x41\xbf\x85\x25\x7b\xc3\x3b\xe4\x06\xf8\x7a\xee\x28\xa1\x0c\x9b\x60\xe1\x05\x24\xa2\xfd\x19\x72\x8e\x9a\xff\x35\x30\x75\x3f\x7f\x85\xad\x95\xcb\x89\x83\x28\xc3\x0d\x29\xe5\xc0\x6f\xe5\x1f\x75\x1c\x6d\x91\x5b\x7f\x0a\xe1\x34\xda\xc3\x67\x4a\x81\x7b\x8c\xce\x38\x67\x85\x24\xea\xd4\xbb\x88\xcf\xd2\x84\x7e\xe4\x8d\xaf\xc6\xde\x47\x9f\x5f\x9c\xc7\x9f\x5f\x28\x21\x62\xfb\x78\xb2\x2a\x56\xab\x8f\xce\x76\x65\x9c\x65\xf9\xdd\x3a\xde\x8b\xaa\x60\xf4\xa3\x8f\x3e\xbf\xc8\xf7\x5a\x58\xa2\x74\x1e\xf0\xec\x5c\x3d\xfc\xfc\xe2\x5c\x3d\xfe\xdc\x23\x43\x34\x2a\xec\x56\xf7\x9e\x7e\xf4\x51\x64\x91\xbb\xef\xdf\xaa\xf5\xf1\xc2\xa7\xef\x9f\x44\xb4\x55\x2c\x7c\x54\xaf\xbc\x15\xc8\xa3\x07\x2b\x35\x3d\x69\xab\xaa\x6b\x53\x55\xab\xc2\x58\x06\xb0\x43\x6a\x25\xd4\x3d\x55\x57\x9a\xfc\x87\xaa\xf1\x0f\xd5\xf6\x1f\xea\x61\x82\x06\x8e\x6b\x8a\x63\xc7\xb8\x77\xc0\x96\x7c\x99\x07\x96\x05\x5d\xfa\x27\x4e\xb4\x2e\xcb\x77\xe6\x64\x60\x20\x50\x27\x5c\xfd\xe5\x19\x0a\xb4\x9e\x6a\xa0\xda\xf6\xd5\xe0\x97\xf1\xdf\x60\x32\xc6\x4f\x07\x3e\x9d\xfe\x6d\x3a\x0e\xc7\xff\x89\x4e\x7c\xba\x5a\xad\x36\x1e\x26\x76\x4d\x1d\x1d\x95\xe4\x1e\x7a\x30\xcb\x7a\xe0\xb9\x2d\xd8\x86\x7e\xf4\xd1\x99\xe5\xfc\x3f\x32\x57\x5d\x78\x1d\x7c\xaf\x80\xf1\xdc\x81\xc6\xc5\x89\x63\xb6\x5e\xb7\x85\xe8\x2d\x9c\xdc\xc7\x1e\xf1\x94\x76\x6e\x60\xf9\x86\xd7\xf9\xe5\x29\xa8\x82\x75\x4d\x86\x40\xbd\x5d\xcd\x56\xa5\xe6\x61\xf2\x0c\x24\x4a\x03\x2b\xc9\x38\x0c\x72\xa0\x26\xfb\x8a\x78\x81\x99\x0b\x0f\x93\x23\x34\x60\x67\x6c\x34\x3b\xdd\x4c\x5b\xc1\x5f\x6d\x67\xa8\x9a\xa7\x24\xb8\x77\x40\x80\x4c\x9f\x06\x72\xed\xb1\xc4\x6a\xbb\x58\xac\xb7\xac\x34\xe5\x0d\x86\x5b\xd3\xd8\xbc\xaa\xeb\x78\x7a\xc7\xae\x3f\xa4\xe2\x9b\x6e\x59\xf9\x62\x97\xff\x31\xf0\x34\x1f\x2a\x59\xf6\x1e\x4a\x94\xd9\x83\xbe\x44\xce\xca\x3a\xe7\x1c\xf0\x08\x94\xa7\x6b\x63\x03\x08\x3a\xb8\xf6\x2e\x2c\x47\x72\x8b\xc2\xc8\x4a\x3d\xb2\x11\xf5\xc8\x6b\x09\xd5\xb7\xf4\xd6\x4e\x98\xa3\x23\xb9\xd5\xb2\xb1\x5a\xf2\xb9\x25\x2d\x87\xca\x94\x6e\x19\x61\xe6\x23\x9e\xae\xf3\x9d\x3c\x72\x9b\x53\xd5\xf7\x79\x99\xca\x6e\x63\xf2\x20\x0f\xd5\x4e\x31\x2e\xe2\x94\x97\x78\x39\x24\x13\xff\xac\x23\x77\x59\xb2\xfe\xe9\x2a\x60\xa4\xa0\xa2\x2b\x32\x5a\x38\x3a\xf8\xa2\xae\x47\x68\x54\x28\xd9\x75\x7b\x82\x93\x4f\xb9\x6d\x7a\xd9\x5e\xa2\x02\x07\xec\x54\xd7\x7d\x7f\xfe\xa9\x7f\xf2\x2d\x18\x90\xf5\xf9\x81\x74\x83\x84\x96\xf6\x08\xea\x76\x52\x72\x46\xc2\xe1\x7a\x46\xb3\x85\x95\x8a\x91\x97\x54\x2c\x8f\xea\x61\xae\x3e\x3f\x93\xbb\x60\xb6\x50\x93\x34\x3a\xd9\xa7\xc9\x48\x9c\x7a\x65\x99\xe3\xba\x46\x73\x79\x2c\x1d\x3a\x7c\x53\x8a\x44\xff\xa9\xc0\xcb\xd3\x73\x20\x70\x30\xc7\x75\x3d\x4a\xc0\x4e\xf1\x25\x93\xc7\x50\x96\x28\xab\xae\xe1\x2f\x40\x8e\xc2\x97\x8c\xd2\xcb\xba\xee\x75\x01\x64\xce\x0f\x68\x4f\x18\x5e\x4e\xe6\x81\x80\x32\xe2\x44\x19\x81\x97\xf3\xa0\x5a\x7e\x8f\x2a\xc2\xf0\x44\xfe\x08\x1c\xcc\x82\x8f\x7d\x2e\xbf\x9d\x0f\x2d\xcd\xc9\x29\xb5\xf6\x47\xed\x82\x01\x2f\xe7\xdc\xc6\x34\x64\x91\x64\x78\x04\xd8\xfa\x8c\xd2\xba\x1e\xe5\xb8\x05\xbd\x4b\xd3\xe3\xe5\x3c\x48\xe5\x75\x3e\xd4\xbd\x05\x28\x41\x28\xb5\x5f\x6a\x01\xe4\x82\x53\xb6\x68\xa5\x84\x0e\xdc\xc4\xd3\x8a\x2b\xf9\x2d\x97\xa5\xc4\x70\xa9\xd2\x2d\xa5\x4a\xc4\x61\x11\x51\x4a\xcb\xb0\x88\x70\x31\x1e\x5b\x56\x72\xb9\x57\xef\x08\xbc\x09\x54\xb1\xbd\xec\x71\xa9\x2f\xe7\xc1\xac\xc1\xe4\xb2\x21\x25\x33\x38\x6e\x58\x87\x58\xca\xce\xf3\x2a\xcb\xd4\x1f\x81\xdd\x4f\x2c\xc6\x3c\x5a\x06\xd0\x18\x1d\x61\x56\xdf\x7f\xd5\xd3\x66\x94\x75\x3d\x2a\x5d\x6d\x46\x4f\xbf\x81\x45\xf1\xa0\xf1\x86\x45\x7b\x02\x18\x6d\x5e\xd7\x03\xa8\x52\xc2\x9b\xc1\x27\x5a\x9f\xd5\x3e\xb0\xb8\xc2\x6a\x86\x8e\xe5\xfd\xfa\xcd\xec\x02\x14\x70\x97\x6a\xd4\x21\x8b\xec\x79\x5a\x0e\xdf\xa0\x96\xc1\x29\x1b\xda\x74\x20\x27\x82\x39\x79\x50\x46\xfc\xb2\x96\x58\x88\xfe\xcc\x3d\xfa\xad\x46\x0d\x1d\x69\x77\xcf\x80\x2a\x52\xa2\xed\xdf\xd4\x54\xb9\x25\x49\xaf\x24\x5e\x2a\x3b\xb7\xd1\x2b\x73\xee\x31\xd0\xd3\xda\xb5\x2e\x8b\xc0\x15\xd5\xd4\xf5\xe8\xd5\xb2\x77\xf0\x17\x38\x00\x53\xb8\xa3\xc3\x20\xac\x66\x31\x2d\xf7\x6c\x9d\x6e\x52\x96\x2c\x0b\x75\x2a\x0c\x40\xd6\x2f\x87\xcf\xca\x75\xbc\x67\x03\x7e\x29\x88\x8d\x3d\x0f\xf7\x14\x46\xea\x93\xa2\xe8\x00\xdb\xb1\x21\xac\x77\xf5\xc0\x45\x7c\x7f\x06\x25\xc9\x59\xc5\x0b\xb6\xce\x6f\x78\xfa\x07\x4b\xce\xd8\xfd\xbe\x60\x65\x99\xe6\x3c\x38\xf3\xc6\xba\xca\x8a\xa7\xbf\x57\xec\x2a\x2f\x06\x65\x89\xca\xab\x48\xe2\x0d\xd8\xd6\x19\x1d\x25\xd3\x84\x09\xb6\x16\x2f\xab\x7d\x96\xae\x63\xc1\x4a\x52\x51\x8d\x1b\xaf\x84\xe4\x40\x40\x73\xa0\x74\xec\x92\x15\x91\x2f\xd0\x4b\x4c\x32\x73\x24\x14\x54\x99\x3c\x62\xa0\x15\x61\x2a\xf7\x41\x41\xb9\x31\xfa\xc3\x8e\x62\x83\x69\x63\x70\xc4\xe5\x66\x9e\x5b\xe8\xac\x40\x63\x42\x58\x43\x72\x5a\xc2\xe4\xbf\x63\xf7\xc3\x03\xf0\x3c\x8b\xf8\x0c\xf4\x03\x82\x52\x4a\x5c\x4a\x69\x5a\xd7\x9f\xa9\x9f\x39\xdc\xaa\x83\xe4\x91\xd9\x28\xb8\xe7\x80\xc5\x09\xb7\xe8\xb5\xf3\x10\xac\x74\x19\x65\x53\xb0\x2e\x01\x16\x6f\xc1\x16\xf2\x81\xab\x0f\xe1\x63\x9a\xcb\x63\xa5\xd1\xe7\x3e\x57\x4d\x7f\xec\xea\x6b\x55\x4f\xff\x25\xa1\x45\x95\x6b\xe7\x0d\x04\x10\x50\x87\x68\x45\x41\x0d\x41\xd7\x4a\x2e\xac\xb0\x4c\x49\x0f\x8e\x96\x2c\xf8\x64\x46\x14\xa7\xfd\x7d\xc9\xaa\x24\x0f\x32\x46\x00\x2d\x05\x5f\x92\x76\x7b\x04\x87\x86\xc8\x33\xb7\xfc\x2d\x58\x06\xf6\x28\xc1\xc1\xfb\xdc\x0b\x8e\x0d\x11\x94\xfb\xc4\x68\xd6\x10\xef\x6c\xe0\x7d\x43\xbc\xb1\x7d\x5c\xb0\xdb\x34\xaf\x4a\x3d\xfc\xce\xb7\xff\x39\x55\xa8\x69\xc8\xbe\x60\xaf\x41\xa4\x15\x1c\xc0\xae\x69\x48\x02\x17\xce\x23\x2a\xff\xf4\xc4\x5b\x84\x85\xcf\x23\x8a\xe4\xdf\xba\x66\xe1\xc7\xf0\xf7\x93\xa8\xae\xdd\x3d\xa5\x8b\xca\x43\x24\x00\xe1\x33\xa5\x4f\x78\x1e\x51\x4f\x6e\x8d\xf0\x79\x04\xfa\x46\xd2\x5a\x8b\x7c\x8c\x1b\x6d\x32\xf5\x68\x5f\x3a\x38\x86\x78\x5c\x6c\x55\x03\xf3\xc8\xd6\xf4\x1c\x2f\x75\xef\xcc\x8e\x46\x2c\x9c\x45\xb2\xe3\x1f\x47\x74\x8c\xe4\xcf\x52\x76\x59\x5e\x7e\x1a\xd5\xf5\x1c\x07\xcf\x9e\x22\x8f\xdd\x32\xae\x2a\x7b\x0e\xfe\x54\x49\x62\xee\xb0\xfc\xf6\x13\xf5\xed\xff\x13\x8d\x59\xf8\xff\x1e\x15\x08\xe4\x8f\xef\xf7\x5b\x6c\x8c\x7d\xd8\xd0\xd6\x19\xc9\xe6\x7d\x5f\xce\x8e\x81\xb5\x2f\xa7\x30\x07\x8a\x3c\x41\x1d\x4b\xb9\x13\x03\x18\xd0\x52\x96\xa4\xdd\x29\x0f\xb8\xef\xff\xac\x8a\x73\x49\xde\x04\xdd\x22\x2e\x09\x8d\xba\xe1\xc6\x5f\x10\x79\xd8\x23\x46\xb5\x39\x11\x78\x62\xae\x31\x2c\xcc\x4c\xd6\x3b\x6b\xe7\x50\x1e\xda\x65\x63\xdc\x79\xe2\xae\xd6\x73\x8c\x1b\x09\xd0\x0a\x84\xde\xbd\xf8\x72\xc0\x11\xa6\x2f\x17\x1d\x54\x00\x6a\x71\xd6\xf2\xc8\xcf\x65\x34\x1b\xf4\x90\x6c\xf5\x89\x12\x0d\x0e\xeb\x16\xb5\x9c\x58\xd9\xfb\x1d\xf7\x6b\x17\x32\x60\x0f\x5c\x5b\x2b\xd1\xb1\xf2\x42\xef\xad\x59\x22\x1b\x7b\xca\x7a\xad\x7e\x82\x41\xf8\xb8\x43\x8c\x0c\x7a\x6e\xc2\x1a\x0c\xe0\xb5\xb5\x6b\xba\x61\x6f\xea\xfa\xcf\x85\xc1\x7d\x41\xb0\x56\x4a\x78\x18\xf6\x5a\x83\x1b\xd2\xdd\xbb\x20\xbd\x7d\x44\x17\xab\x59\x02\xc9\x6f\xe1\x45\xcf\xec\x5e\x1e\xeb\xe4\xd9\x27\x90\x07\x1f\x24\xc6\x12\xaf\x7b\xea\xd1\x52\xd2\x91\x34\x30\x25\x96\x62\x04\xb7\xef\xf5\x6d\xea\xfb\xe0\xd7\x67\x21\x2d\xc5\x81\xf7\xb4\x7d\x39\x99\x5f\x74\xdf\x3d\x69\xdf\x69\x63\x65\x34\x49\x0d\x34\xaa\xa6\xfe\xa3\x8b\x4c\xe6\x17\x48\x22\x8c\xd6\xe4\xe1\x0b\x89\x13\xc1\x1e\x03\x77\x2a\xad\xd5\x17\x00\xf4\x80\xf1\x85\x85\x55\x53\xf7\x78\x0e\xb5\x8f\xbd\x89\x07\xd0\xdb\xc3\x36\x5b\x22\xd9\x95\x1b\x72\xab\xa6\xeb\x81\x02\x72\x19\x51\xba\x75\xa0\x9e\xec\xa8\x97\xc5\xa5\x70\x9f\x4f\x3e\xc6\xe4\x9e\x7a\xda\x6a\x13\xc0\xd9\xcc\xae\x24\x78\x37\x6a\x7e\x6e\x07\x5c\x82\x47\x23\xf7\xb4\xd0\x9c\x76\x10\x30\x96\x59\xf4\x61\x44\xe9\x6e\xe9\x39\x14\xcf\x1b\x20\x02\xeb\xee\x29\x64\x43\xef\x4f\x6f\x16\xb2\xa7\x23\xee\xfb\xa3\x7b\x92\xd0\xd1\x5c\x92\xee\x35\x50\xe8\x07\xc3\x4e\x64\xf8\x10\xdb\x83\x45\x4c\xe3\x30\x03\x11\xfd\xfd\x32\x3e\xbd\xfd\x36\x81\x1c\x79\xdc\xe7\x87\x47\xf3\x45\x45\x33\xea\xe5\x3c\x03\x97\xd0\xad\xef\x8f\x2a\xdf\xef\x0c\xa7\xb1\xdb\x3f\xdd\xa0\x8a\x86\xbb\xe5\xda\xa1\xf8\xc1\x7a\x2a\xa7\x1f\xae\x23\xb2\xf3\xfd\x3d\x3e\x24\x14\x95\x14\x15\x14\xa5\x14\xe5\x14\xc5\x74\x8d\xc3\xab\xa8\xae\x51\x1c\x5e\x45\xf4\xd0\x60\x1c\xc6\x9a\x0d\x7b\xf3\x52\x3e\xcf\xdd\x7b\x55\x60\x1b\xd5\x75\x18\x61\x89\x07\x29\xfd\xe0\xfb\x45\x38\x8f\x24\x7f\x19\x3e\x8b\x48\x4c\x4b\xdf\x5f\x3b\x96\x7d\x61\x19\xd9\xe9\x18\x8f\x4b\xdf\x8f\x7d\x5f\x4e\x4b\x5d\xa3\x84\x96\x74\x86\xeb\xba\x9a\xee\xf3\x3d\x02\x7b\xb5\xee\x4c\xf8\xfe\x78\x9c\xf8\x7e\xac\x64\xf8\x69\xb8\x8d\x68\xf8\x81\x94\x24\x89\x16\xca\xb1\xc7\x72\x2e\x7b\xdf\x87\xea\xdc\x91\xb1\xff\x4b\x23\x23\x60\x0d\x91\xe0\xbf\x3e\x8a\xff\x72\xbd\xf5\x30\x61\x10\xaa\xf7\xf1\xff\xd0\x73\x39\x35\x49\x84\x89\x9a\xad\x8e\x1f\x13\x4a\x26\xf4\x56\x36\x7d\x53\xd7\xc9\xdf\x6f\x28\x9d\xf9\xfe\xec\x82\x26\xe7\x37\x4d\x33\x40\x74\x49\x6e\xc8\x6e\x4c\xaf\xa7\x7b\x60\xd4\xca\x90\x45\x75\x7d\x3d\x2d\x99\x50\xbc\x50\x19\xf6\x46\xe6\xb2\x10\x5e\xc5\xb5\x8d\x02\x4b\xce\x54\x05\x8a\xcb\xb7\x1e\x79\xe1\x55\xb4\x8c\x51\x8e\x83\xf9\x45\x6c\xec\x3a\x91\xa0\x21\x23\x8c\x78\x1e\xc9\x23\xe2\xb6\xd5\x73\xec\x40\x7d\x53\xba\xe5\x23\x86\x1c\xec\x51\xf3\x8d\xef\x11\x23\x45\x98\x46\xd8\x1a\x6f\xc0\x5d\x83\x87\xe8\xa9\xac\x4c\x12\xf7\x06\x07\xb1\xe4\x0b\xd5\xcc\x04\x07\x9e\x8b\x20\x3b\x56\xb5\x68\x2d\x55\x18\x91\x92\x6e\x10\xeb\x5b\xa2\xd9\xc9\x28\xe5\x64\xf4\x46\xd0\xb1\x35\xa5\xa5\x39\xf6\x17\x24\x84\x25\x66\xdd\xd1\xc4\x93\x09\x46\x29\xcd\xc3\x38\x52\x2c\x4a\xac\x86\x13\x47\x34\xc5\xdd\xc1\x74\xfc\xc8\x0a\xe0\x65\x48\x89\x0a\x2d\x55\x20\x29\x26\xf0\x10\x6e\x47\xa9\x82\xe9\xa6\xc1\x64\x1b\x97\x9d\x31\x3e\x66\x57\x64\x0e\xec\xcc\x9e\xd3\x1b\x4c\xcc\x31\xfd\x44\x2d\x82\x8a\x3e\x43\x7c\x5c\xb1\x24\x71\x9d\x73\x4e\x5d\xcb\x03\x4c\x4b\xdd\x04\xf4\x35\x8b\xf9\x4d\xa7\x99\x76\xc4\xff\xd2\xec\x1f\x70\x05\xa7\x20\x16\xbe\x3f\xf3\xc6\x1c\x13\x4e\xf9\xa3\x9c\x19\x39\xd6\xaf\x25\x39\x48\x25\xa9\x3c\x9e\x43\x4d\x7d\xc6\xe4\x7e\x97\x05\xf2\x85\xec\x40\xff\x9d\x7a\x8e\x8d\x33\x76\xdf\x27\x0b\x3b\xb1\x01\xec\xa0\x39\x50\xe9\x46\x81\x02\x9c\xfa\x1c\x41\x55\xdf\xb4\x0e\xb7\x62\xd0\x06\x13\x11\x17\x7d\x1f\x7f\xc5\xfe\xf0\x69\x96\xaf\x63\x25\x94\x6d\xaf\xe5\x3e\xdc\x76\x14\xeb\xc6\x22\x1f\xda\x48\x93\x86\x14\x79\x3e\x18\x33\x80\x49\x9c\xd7\x10\xf0\x7e\x3a\xf5\xfe\x72\x1a\xaf\xe5\x59\xaf\xb5\xf9\x19\x5d\xca\x26\x5f\x83\xcb\x54\xdd\x5e\x23\xc9\x5c\x8e\x46\x48\x19\xbd\xc9\x59\xdc\x16\x6c\x53\xd7\xff\x61\x53\x11\x5f\x83\x55\x20\xb8\x84\x83\x0e\x22\xb8\x61\x68\x34\xc7\xc4\xe8\x24\xe0\x7e\x86\x89\x56\x70\x0d\x32\xe3\x8f\xda\xe1\x39\x66\x78\xb2\x17\x6c\x6a\xbc\xbd\x6a\x4f\x29\x98\x9c\x57\x46\x05\xd9\x10\x73\x35\xcc\xa8\xbb\xd6\x77\x1d\x53\x3a\xf3\x19\x0c\x8a\x68\x03\xfe\xb6\x56\xb6\xdb\x8b\x87\x4e\x95\x7f\xe9\xe4\x9f\x4a\x7c\x64\x40\xe2\xe2\xd3\x21\x4f\x5e\xd5\x87\x81\xde\x8e\x2c\x4d\x98\x42\xeb\xa0\x98\xde\xb2\x38\x61\xc5\xd0\xd8\xfe\xa1\xcf\x67\x76\x4e\x71\x43\x60\x02\x87\x0a\xff\x30\x50\x58\xd9\x39\xfe\x1f\x2e\x93\x63\x2d\x69\x80\xc6\x35\xa0\x6c\x08\x38\xef\x1c\x6f\xe6\xbf\x6e\xf0\xe9\xc9\x1a\xda\xfa\x7d\x1f\xa9\xe3\x02\x12\x47\x76\xd8\xc0\xfa\x82\xe7\xb6\xf9\xa6\xbf\xcd\x4d\x58\x88\x5b\x07\x89\x99\x49\x0a\x67\x11\xe0\xb8\xde\x6b\x47\xe8\x19\x8a\xc9\x5c\x96\x61\xbf\xf7\x4b\xb4\xb8\x3f\xe4\x17\xb3\x25\x1f\x8b\x80\x43\xc9\x5b\xc6\x8f\x6b\x73\xdc\x20\x17\xfc\x42\x2c\xf8\x98\x3e\xc3\xac\x6f\x2b\xc1\x1a\x0c\xa1\x22\x1e\xf9\x7c\xfe\x27\x9f\x67\x47\x43\xe9\xba\x15\x9b\xbe\x8a\x0b\xbe\x14\x01\x5f\xcc\x2e\xe8\x64\x52\x2c\x4c\x65\x45\xa7\xb2\x9b\xbf\x58\x19\x5f\x8c\xc7\xc5\x85\x18\xae\xa5\x69\xb0\x85\x72\x2e\xb6\xd4\x81\xf9\xdf\xc9\xa1\x88\x93\x34\x0f\x46\x33\x85\x43\xae\xf3\x7b\x79\xbd\x49\x21\xfa\x0e\xd9\xc7\x65\x79\x97\x17\x89\xbc\x4e\x77\xf1\x0d\x84\xe4\xc1\x2e\x23\x45\x13\x30\x50\x31\x46\x9d\x87\xb2\xba\xde\xa5\x42\x96\x2f\x58\xc9\xc4\x71\xf9\xad\x2a\x6f\x6c\x49\x77\x0c\xe1\x43\x6b\x5a\x7a\xcf\xcc\xbe\x57\xfb\x62\x46\x3a\xbe\x2e\x9e\xb7\x10\x17\x7c\x21\xc6\x63\x5c\x8c\x21\x9e\x83\x12\xfe\xb6\xb6\x2e\xb6\xa6\x6b\x86\x4a\x62\xf9\xa7\x0a\x4c\x80\x0b\x92\x69\xe4\x41\xd6\x34\xab\xeb\x8a\x6c\x60\x43\x39\x42\x36\x4a\xe9\x9a\xec\xa9\xa3\xf4\xd0\xe8\x67\xd9\x5f\x05\xed\x75\x43\x59\x58\x45\x86\xdf\x67\x8e\xd6\x70\x63\xc4\x8e\xa5\xfe\xa2\xa5\x56\x8f\x1d\xf8\x24\xef\xbb\x8f\x94\xe9\xf7\x51\x1b\x03\x8d\xf8\xbe\xa9\xdf\x6a\x07\x5d\xe1\xe6\x63\xfd\x03\x7d\x12\xca\x29\x53\x2c\x3a\xb3\x2c\x3a\xeb\xb1\xe8\xac\xcb\xa2\x93\xcc\xf7\xb3\x47\x10\x08\x56\x8d\xd6\x35\x5b\x18\xb7\x18\x54\xd0\x34\x5c\xab\xa3\x95\x7b\x22\xa1\x8e\x5b\x50\x1c\x3e\x93\x2c\xeb\xb3\x48\x79\xc4\x84\xeb\x88\xc6\x58\x3e\x3b\x1e\x60\x4b\xf7\xed\x7a\xdf\x31\xd4\xca\x40\xe6\x17\xe9\x90\x87\x95\x9d\x6a\x9a\x1e\x1b\xfb\x83\x62\x2e\x2c\xa2\x5e\x63\x0e\x1d\x09\x52\x89\xaf\x6c\x8b\xef\x18\xb2\x61\x52\x5a\x98\x85\x25\x94\x6c\xf2\x8c\x54\x2d\xe8\x66\x54\xf9\xe4\x0b\x1b\xb3\x04\xe6\xbd\x04\x1e\x57\x1e\xc3\x39\xca\xa1\x1e\x79\x54\x52\x7b\x38\x87\x79\x56\xfe\xdd\xa8\xc4\x6e\x08\x10\xdb\x85\x4b\x86\x12\xb2\x25\x37\xe4\x96\x3c\x90\x96\xf4\xdc\xfa\xfe\xe8\x36\xbc\x8a\x7c\x1f\xdd\xd2\x4b\x86\x6e\x31\x26\x0f\xbe\x3f\x7a\x50\xcf\x1e\xe4\xb3\x07\x88\xcf\xf0\x18\xab\x4e\x62\x65\xa3\x55\xc9\x3f\x19\xb5\xf1\x16\xd6\x54\x42\xcf\x49\x9c\x34\x83\x70\x65\x36\x18\x43\x0a\xc1\x18\x40\xdb\x27\xc2\x22\x72\x45\x50\x0d\xda\xd6\xb5\xf7\xd4\x23\xad\xfd\x1a\x58\x5f\x72\x38\x19\x6c\xe8\x28\xa9\xeb\x11\xf3\xfd\xed\x72\x1d\xbc\x63\x68\x2d\x8f\xcb\xd0\x43\xb2\xa7\x37\xcb\x07\x09\xb2\xcb\x24\xc8\xea\xfa\x16\xa2\xad\x88\x60\x23\x21\xe7\xc6\xf7\x6f\xd0\x86\xec\x55\xc9\x5b\x7c\x48\xe9\x3b\x86\xf6\xa4\xc2\xe4\x16\xa5\x24\x8c\xd4\x8b\xbc\x0f\x03\xb9\x3c\x78\xc4\x34\x0d\x73\x58\x94\x7d\x58\x85\x79\x24\xcf\x1e\x1b\x7d\x15\x63\xdc\x48\x66\x43\x89\x49\xea\x3a\x31\xf2\x12\x38\x17\xe5\x74\x3f\x58\xdf\x5e\xd5\x97\xaa\x75\xdc\x84\xb9\xac\x68\xf1\x00\x14\x95\xec\xe1\x58\x45\x0a\xdc\xfc\xc9\xe7\xf2\xb4\x90\xd2\x87\xe5\xf7\xe0\x8a\x16\x94\xba\x93\x2c\x4c\xd5\xe9\x28\x55\xdd\x53\x3b\x7f\x0f\x03\x96\xa4\x78\xb9\x37\x8a\x9e\x8c\x98\xfa\x71\xb0\xc7\xe4\x61\xa9\x7b\x20\xc8\x9e\x14\x38\xb0\xfe\x8e\x64\xdf\x31\xf5\x7f\xd5\xc1\xc7\x29\xd1\x66\xf0\xd6\x9b\x80\x5e\x4f\x8d\x56\x23\x04\x41\xb2\xe4\x07\x22\x12\xd3\x5c\x9e\xb0\xed\x2b\xef\xcc\x93\x5b\x22\x5f\xce\x03\xb9\x2d\x06\x1d\x3a\x81\x6d\x4e\x1b\x12\x13\xc9\xd1\x66\xc3\x85\x26\xf3\x8b\xef\x51\x0a\x51\xb0\x54\xb9\x35\x0d\x87\x77\xf8\x28\x97\x3b\xab\xae\xc5\x88\xd2\x3b\xb9\xad\x50\x4a\x05\x6e\x01\xad\xd2\xc5\x83\xcc\x6c\x78\x03\x97\xa9\x3a\x31\x16\x4d\xb4\x28\x2f\x0a\x13\x60\x48\x74\x87\x5a\xea\xa1\xe2\x35\x0d\xaf\x19\xba\x63\x68\x8d\x89\xc0\x51\x8b\xf1\xe4\x07\x4a\x50\xee\x14\x37\x8e\x62\xa0\x65\x93\x4f\xb5\x22\x1b\xe3\xf0\x2a\x32\x61\x61\xc6\xe3\xd2\x8d\xcf\xd0\x69\x97\x9b\x76\x3b\xc1\x5d\x2e\x19\x9a\x5f\x94\xbe\xaf\xba\x01\x97\x92\x92\x5a\x01\x66\x39\x99\x63\x1d\x62\x10\x1d\x94\xba\xd4\x3b\x53\x4a\x8c\x72\xf2\x4c\x55\xb9\xf4\x9e\x7a\x81\xe7\x35\x4e\xd0\x20\xe3\x67\x26\x48\x79\xc1\x7d\xff\x55\x5b\x65\x29\x27\x8c\xf0\x8b\x42\x3d\xa5\xe6\xb9\x7d\x0a\x84\x1c\x37\x6b\xed\x65\x66\x35\x8c\xd0\x43\x73\xb3\x63\x4e\xa4\x37\x33\x5b\x65\xcb\xa0\x74\x04\x27\x20\xa9\xdf\x31\xb2\xa5\xa5\xa4\x37\x1f\x18\x4f\xff\x18\xf4\xb0\x26\x5d\xd1\xe9\xbd\x11\xfb\xa7\x1b\x94\x59\xcb\xd4\xe5\x2c\xc8\xac\x36\x75\x11\x53\x66\x70\xdd\xf5\xd4\xea\xc8\x8c\x44\x42\xad\x0b\xc4\x56\x91\xa8\x1a\x15\xf4\x57\xe5\x99\x1a\x4b\xc6\x17\x41\x98\x26\x1a\xeb\xda\x24\x7d\x33\x3b\xad\xae\x63\x6b\x3f\x25\x91\x84\x9c\x1c\x3a\x9a\x13\x54\xd0\x3f\x6c\x0d\xe0\xe7\x61\xfd\xec\x88\x46\x14\x7a\x95\x38\x81\x20\x89\x50\xa9\xb3\x2a\x67\x60\x5e\xd8\x36\x6a\x95\x3a\xca\x04\x5a\x76\x1e\xcb\x7e\x7e\x19\xe6\x91\xd3\x55\x89\xc7\xd4\x00\xe4\x15\x2a\xa0\xfb\x7f\xda\x78\x4e\x34\x90\x06\xc5\x89\x46\xc1\xa8\x85\xeb\x08\x45\x76\x86\x8d\x30\x2c\x88\x97\xad\x7a\x0c\x07\xf7\x88\x91\x12\xdb\xb9\x6f\xc8\x86\x96\xca\x48\x28\xcd\x86\xd7\x53\x12\xb7\x1d\xb9\x97\xeb\xaa\x30\x6d\x28\x31\xcc\x0b\x67\x5d\x47\x31\x3e\x28\x75\xce\x16\x41\xd8\xa1\x96\x02\x69\x93\x17\x85\x4d\x5f\x31\x10\x8c\xc1\x6e\x5b\xa6\xc6\x7d\x37\xc8\xcd\xd5\x02\xc5\xf4\x05\x62\x04\xdd\xd2\x9c\xec\xe8\xec\x02\x3d\xd0\xd4\x48\x7e\xc8\x3d\x9d\x5d\xdc\xb6\xcc\x68\x9f\x70\x4a\x2e\x40\x73\x00\x12\xf4\xe8\x8c\x64\xd4\x9b\x81\x54\xde\xf7\xc3\x88\x6c\x64\xbf\xf7\xf4\x8e\x24\x92\x80\xde\xfb\x7e\x6b\xad\x8e\x24\x15\x4c\x31\xd9\xd2\x0f\x63\xaa\xce\x5a\xfb\xe5\x3c\xe8\xc4\xf2\xaa\xeb\xe9\x9c\xdc\xd0\xc4\x0c\x4c\xc2\x64\xea\xfb\xe8\x8e\x6a\x5b\xa7\xba\x4e\xf1\x22\x1b\x81\xe6\x41\x47\x00\xca\x69\x12\x66\x11\x5e\x64\xe3\x31\x10\xab\x7b\xdf\xcf\xf1\x21\xa6\x33\x22\xea\x3a\xef\x1b\x47\x5d\xd6\x35\x7a\x27\xf9\x0e\x4e\x47\xaf\x8c\xb9\x40\x49\x6f\xc3\x58\x9b\xe7\x97\x28\x97\x1f\x5e\xca\xcd\x7f\x28\x0c\x9b\xa2\x05\xd8\xb2\x2f\x1f\xe8\x16\x37\x3b\xdf\x47\x28\xa7\xa3\x52\x36\xe6\xfb\xd5\x64\x42\x98\xef\xaf\x4d\x71\x20\xa2\xd5\x98\x66\x64\xe7\xfb\xb2\xbb\x15\xf4\xc8\x36\xf7\xa0\x9a\x2b\xd1\x9a\x6c\x14\xdf\x6c\x89\xee\xec\xa2\xd2\xa2\xeb\x6c\x32\xc1\x6b\x10\x5a\x6f\x94\xe8\x5a\xfe\xd0\xdf\x95\x79\x4a\x81\xf1\x62\x23\x69\xe0\x06\x37\x86\xb0\x15\x64\x83\x49\xea\xfb\x92\x9f\x98\x5d\x6c\xac\x71\xe2\xfc\xa2\x1a\x3f\xd8\xbb\x8e\xdd\x06\x2a\x2c\xa2\xd2\x63\x23\x77\x74\x8f\xc9\xba\x21\xbb\xa5\x64\x1a\x71\x50\x60\x30\x87\xd6\xc6\x4a\xac\xb1\x1c\x1a\xb9\x69\x0d\x07\x8e\x00\xe5\xc8\xf1\x9e\x0e\x07\x5e\x65\x64\x4d\x47\x85\xef\x6f\x11\xa3\x99\x6d\xa6\xae\x95\x43\x80\xf1\xc0\x97\x7c\xfd\xda\x6c\x45\x39\x4b\xcf\x2e\x50\x4e\xd7\x92\xcd\x5e\x3b\xaa\x5d\xdc\x5a\xb9\x7a\x6f\x5e\x4a\xdc\x8f\x62\x9a\x87\xb3\x08\xeb\x33\xfe\x67\x3d\x37\xd5\x57\x12\x40\x2d\xe5\xc9\x41\x09\x0f\x94\x47\xf9\xdd\x0b\x8a\xac\xf7\x09\xb2\xf6\xac\x2e\x9e\xd2\xa2\x4f\x81\x8d\x22\xc2\xf1\xca\xcf\x40\x61\xd7\x31\x72\x24\x2d\x15\xc9\x0d\x42\x52\xa7\x3b\x33\xb8\x26\xa5\x5f\x4e\xdd\x08\x25\x5a\xc2\x82\x97\xb3\x20\x3f\x96\x89\xcb\x7e\xca\x21\xa6\x11\x71\x06\x52\xd2\xb8\x43\x41\x25\xa9\xae\xa8\x1a\x8a\xe6\xc7\x0b\x5a\x3d\x3a\x22\xe3\xbe\x9e\x1b\x86\x47\xb9\xb1\x8b\xae\x1b\xbb\xd0\x6e\xf1\xb9\x61\xc1\x52\x32\xc7\x64\x84\x98\x95\xdf\x03\x95\xcc\xdb\x60\x05\xad\x1b\x79\x81\x09\x37\x7a\x21\x0d\x54\x28\xab\xeb\x0d\x62\x64\x8d\x31\x2a\xc0\xbe\x8a\x70\x32\x12\x75\x6d\x7a\x73\xaa\x17\x84\x37\xc4\x35\x23\xa2\x57\xc6\xef\xd9\xc3\xc6\x88\x48\x9b\xe5\x82\xab\xd5\x15\x39\x36\x45\xa2\xa3\x51\x46\xde\x21\x4c\xba\xb6\x9a\x27\x9c\xcc\xe6\x8f\x18\xbe\x3e\xe2\x04\x3e\x60\x31\x6f\x8f\xe0\x43\x86\xf3\x7f\x53\x56\xf2\x1e\xf1\xfe\xa6\x64\x55\xad\x98\xb0\x27\xa4\x92\xe5\x25\xc5\xac\xeb\x8d\x16\x59\xd5\x20\x64\xdd\xb2\xf4\x66\x2b\xea\xbb\x34\x11\x5b\x8f\xf4\xf9\x48\x45\xd4\x86\xfd\xd2\x04\xf1\x8c\xd2\xb7\x27\xef\x5a\xce\x83\x67\xb8\xe7\x8b\x78\x64\x8b\x3d\x38\x2e\x10\xcc\x9d\x83\x57\x89\x33\x92\xae\x11\x3e\xec\x07\xe5\x6d\xe1\xfd\xc9\xa0\x55\x51\x3b\x6a\xfd\xe5\xe0\x20\x7d\xff\xcf\xa5\x82\xed\x44\x18\x8f\x54\xb0\xa3\x3a\xb5\x64\x3a\xae\x6e\xaf\x4f\xad\x2d\xbd\xee\xd6\xdb\xa3\x0e\x01\xf7\xbe\x70\xe7\x5e\x09\x8a\x43\x11\x2d\x7b\x53\xfd\x3f\x59\x08\x62\x52\xb2\x06\x5d\xe2\xc5\x15\x6c\x79\x9a\x40\x3c\xcd\x7d\x41\x93\xd6\xce\x4b\x3f\x0a\xbd\xc0\x53\xf1\x39\xf7\x85\x65\x49\xaf\x5c\xcb\x3e\x73\x43\x13\xe7\x29\xb9\x52\x21\xa7\x13\x63\x3f\x47\xae\x94\x5b\xf3\xcb\x7c\x4d\x13\x75\x49\xae\x5a\x5b\xcf\xc4\x5e\xca\x76\xc1\x78\xd1\xda\xbf\x26\xfa\x01\x98\x68\x6e\xe9\xf0\x51\x07\x54\x73\xd6\xba\x92\x2f\x5a\xed\x49\x28\x24\x42\xfb\x6c\xd4\xd1\x9a\x1c\xc9\x82\x00\x0a\x52\xdf\xbf\x02\x0f\xaa\x52\x32\xf1\xfa\x74\xa1\x63\x1a\x5a\x3a\x58\x34\xe4\xdd\xc9\xf8\x72\x61\x34\x20\x9d\xef\x07\x43\x60\x2a\xe8\x2a\x37\x35\x3b\xc6\x76\x1f\xcc\x54\x03\xe6\xed\x20\xfa\x56\x5e\xf8\xa2\x1b\x3a\xef\x2f\x5a\x05\xf5\x82\x00\xc9\xfe\x7e\x4b\xcf\xdf\x5f\xa0\x30\x9e\xfc\x11\x85\xef\x57\xe7\xab\xd9\xe7\x01\x04\x9a\x13\xab\x62\xc5\x57\x9b\xe8\x29\x0e\xbb\xf7\xab\xf3\xe5\xe7\x68\x19\x5c\xac\xce\x57\xf3\xcf\x6b\xfc\xe4\x3c\x6d\x7b\xf5\x12\xe9\x88\x3a\xe6\x60\x83\x38\x5e\xea\x70\xc2\x6c\x30\x96\xf0\x68\xd4\x89\xbf\x3c\xa2\xb4\x68\x70\xe0\x08\x42\x06\xbe\xee\x9c\x94\xb9\xfe\xe4\x28\x00\x0f\x7f\xec\xd3\xc9\xfc\x22\x6d\xe3\x73\xea\x2a\xae\xf4\x91\x41\x3e\x23\x05\x04\xe1\x86\xfb\x13\xe0\x26\xc2\x59\x1b\x27\xd4\xf7\x11\xa3\x5e\xc0\x73\x81\xc0\x68\x0a\x7b\x98\xa8\x80\x17\x96\xc3\xea\xf8\xc3\x2f\xd5\xb6\xeb\x1b\x70\xa3\x82\x30\xbc\x0c\x8b\x28\x08\xa3\xa0\x5b\x04\x31\x62\x02\x33\x0f\xcd\x45\x17\xc0\x20\x3c\xb3\x13\xd7\x18\x1d\xc0\xf8\x72\xd8\x9b\xdf\x8d\xd8\x9c\xc2\xdd\xc2\x31\x51\x6d\x8d\xb3\xec\xa1\xb1\x17\x2e\x5a\x6e\x17\x3d\x73\x8e\x66\x03\x82\xca\xd2\xd9\x42\x5c\x14\x20\xa8\x4e\x37\xa8\xdd\xec\x28\x0d\x45\x44\x20\xca\x78\x2b\xcd\xc4\x4a\x7e\xce\x69\xaf\x81\x30\xc2\xc4\xad\x49\xcd\x0b\x62\x04\x2a\x69\x05\x69\xf3\x8b\x62\xe9\xa2\x25\xc4\x71\xc0\xad\x95\xde\x90\xd1\x5a\xb7\x9d\x97\x3a\xb4\x38\xb0\x93\xa3\x39\x86\x98\xbf\x83\x3a\xad\x47\x3f\x84\x58\x63\xe9\x90\x46\x74\x34\xd2\x25\x8f\xcd\xe4\x7c\xff\x83\xe5\xe2\xe4\x84\x06\xb6\x17\xad\xbe\x1d\x90\xdf\x6f\xe4\x77\x15\xc1\x6d\x55\x3e\x45\x17\xe1\xea\x6e\xf5\x53\x34\xfe\x1c\x87\xef\x3f\x8f\x9e\xd6\x7f\x73\x82\xb8\x2d\x90\x8d\x6b\x3f\x0c\xc0\x24\x05\xf2\xd2\x59\x56\xcb\x50\xff\x36\xd0\x47\xcd\xf1\x16\xd4\xbb\x50\x32\x93\x59\xe4\xfb\xde\xe7\xea\xba\x8d\x6b\x16\xf9\xfe\xf3\x0b\x2b\x1b\x5b\x86\x4a\xc8\x03\xd6\x08\x51\xf0\xbb\x3a\x86\x43\x90\xe3\x51\x11\xca\xc2\xc6\xdc\x59\x32\x71\x62\xaa\xe2\xdc\x2f\x21\x48\x05\xd6\x0b\x8d\x83\xa3\x18\xdf\xc2\xbe\x83\x58\x31\x26\x22\x96\xa0\xe2\x2c\xe5\xa5\x88\xf9\x1a\x82\xb5\x2e\xe5\x26\x0d\x24\xe5\x69\x83\xd1\x93\x2b\xc9\x1a\x96\x4c\xb2\x1b\xf0\x25\xd1\xb1\x8b\xf5\xce\x3c\xf6\xde\x09\x5e\xc1\xaa\x92\x6f\xd5\x12\x69\xe3\xa8\x7e\x48\x6b\x81\xdb\xcc\x0a\x02\xef\xa0\xad\xb0\x88\xf0\x52\x5f\x20\x01\xce\x22\x30\x14\xb0\x43\x2c\x40\x12\xdc\x09\x8b\x6e\x98\xdc\x94\xbe\xea\xbb\xa0\x17\xe1\xb3\x48\x59\xb5\xca\xea\x66\x11\x4d\x89\xb3\x71\xe9\x1c\x13\xa7\x02\x27\x37\xc5\xd2\x7e\xc0\xba\x1f\xa8\xed\x17\xec\x24\xc8\xb5\x54\x73\x5a\xb0\x38\x79\x58\xea\x5f\x80\x44\x74\x25\x71\xa3\x8d\x9a\x8c\x54\x3d\xb8\xc1\x8e\xd4\x4a\xc2\x1a\xf9\x8d\x5e\xa1\x57\x0a\x4c\xbf\x56\x40\xaa\x78\xf0\xb2\xde\x17\xec\x16\x2d\x83\x1f\xb9\x48\xb3\x1a\x5c\x99\xcf\xc9\x57\xf4\x00\x36\x65\x05\xe3\xa0\x77\x53\xe6\x1f\x25\x64\xbe\x60\xf7\xa0\x3b\x93\x9f\x75\xb3\x5f\x7c\xa7\x88\x47\x9f\xb4\xcf\x47\x43\x06\x11\x67\xac\xe9\xe0\xc0\x6d\x5c\x0e\x25\x2a\x30\x03\x72\xc5\x25\xee\x46\x1f\xc6\x6d\x10\x36\x97\xce\x16\xec\x82\x2f\xd8\x11\x7e\x53\xb9\x0c\x42\x16\xb9\xf8\xad\x21\xeb\x2c\x2f\x99\x1b\xf8\xbf\x1b\x18\x5b\xa3\xdf\x56\xac\x0c\x12\x9e\x63\x4c\xac\x78\x14\xd8\xbe\x16\x6d\x00\xf0\x59\x45\x43\x8b\x48\xc3\x22\x5a\x70\xdf\x97\x74\x52\x2c\x7a\x2e\x4f\x72\xb7\xb7\x36\x03\xf3\xb9\xef\xa3\x78\x39\x99\x5f\xc4\xca\x10\x45\x22\xd0\xbe\x5f\xfd\x09\xba\x05\x61\xae\xf1\x21\xb7\xaa\xe0\xae\x10\xac\x8b\x2f\xe7\x17\xe6\x98\xda\xc5\xd7\x39\x0e\x72\x30\x25\x48\xd8\xfd\xa0\x4d\xc5\x72\x20\xdc\xb6\xa6\xe3\x72\x42\x88\x86\x74\x6c\x43\x68\x03\x5a\x36\x38\x45\x22\xac\x40\x23\x13\xc0\x5d\xfa\xc2\x8d\x79\xa4\x17\xbc\x28\xe5\xa9\x5b\x82\xdf\x8b\x2c\x43\x06\x07\x07\x93\x79\x43\x62\x37\x85\x42\x27\x1a\x64\x3f\x8b\x82\x33\xb2\x4e\x32\x8c\x1b\x26\x10\x26\x00\x76\x18\x4b\x62\x11\x27\xc9\x17\xfd\x24\x1a\x6e\xa5\x71\x92\x20\x93\xa0\xa3\x97\xfe\x20\xe8\xdd\x1b\x60\x65\x18\xcc\xa6\x74\xe8\xe9\xc3\x80\xe5\x87\x31\xb8\x38\x76\x02\x35\x8e\x5d\x2e\x4e\xd4\x6e\x45\x7a\x3f\x0f\xf5\x74\x8b\x18\x71\x35\xc8\xd8\x96\x86\x5d\x7f\xca\x5e\xad\xff\x19\x84\x3c\xe7\x7d\xcb\x0d\x5d\x58\xee\xfe\x8e\xb5\x2c\x6e\x14\x8e\x38\x55\xb6\x6f\x1a\xac\xeb\x7e\x91\x65\x27\x87\x30\x50\xfd\x63\xc5\x4f\xb4\xf0\xe7\x63\x76\xdb\x81\x41\xcb\x9a\xfe\xc2\x54\xf5\x8d\x9d\xe5\xa7\xa5\xba\x19\x5c\x97\x77\xa8\x13\x58\xb0\xae\x0f\x0d\x76\x4e\xcb\xa0\x43\xb2\x78\x78\xf0\x7b\xf7\x70\x2d\x0b\x1b\x44\x7d\xe2\xb8\x3b\xa2\xca\x81\x8f\x71\x1b\x46\xca\xf7\x0b\x74\xf4\x50\xf9\xc4\x76\x1e\x05\x48\x9e\x6b\x3c\xc1\x76\xfb\x2c\x16\xcc\x03\xdd\x5e\x5b\x5d\x5d\x33\x6c\x49\x39\x44\xf3\x72\xc2\x8f\x82\x43\x86\xe9\x11\x08\xa1\x21\x42\x7b\x11\x0d\x46\x9f\x75\xb2\xc2\xa4\x6d\x3e\x1a\x0f\xe6\xdf\x03\xbf\x67\x6d\xdc\xfe\x89\x72\x24\x91\xc8\xc5\xf7\x8f\xd0\x8f\x00\xad\x85\x3d\x38\x80\xfe\x8c\xcc\x2f\x1c\x24\xee\xfb\xe8\x2b\xc8\xb0\xd4\xe3\x4c\xc9\xd7\x9a\x99\x80\x80\x2e\x05\xbb\x65\x05\x88\x17\x48\x0f\x8d\x70\x6c\x38\xbf\xef\xe9\x79\xf8\xbe\x73\x16\x1b\x9f\xdf\xb4\x94\xf1\xad\x8b\x28\x5b\xcd\xe5\x37\xad\x17\x9f\xf3\xf4\x4d\x4f\x06\x0b\x81\x07\x99\xef\xef\x10\x78\xf0\x16\xf9\x2e\x2d\x19\x36\xb8\x15\x22\x8a\x71\x06\x4c\x57\x9c\x66\x92\x2c\xd8\xb2\x62\xcb\x78\x5b\x50\xa9\x12\x4d\xfe\x2e\xc5\x55\x90\x90\x19\xe9\x6b\x81\x9d\x88\x86\xfc\xa8\x14\x6e\x9a\xab\xe9\x65\x9c\x65\xd7\xf1\xfa\x83\xe3\xfc\x59\x98\x20\xf5\x7c\x51\xd0\xa3\x35\x28\x96\x88\xd1\x42\x25\x04\xd3\xf8\x4e\x3b\xd0\xa2\xef\x41\xe6\x7a\x14\x27\x5f\x44\x54\x92\x63\xd9\xd5\x36\x89\x48\x43\x0a\x35\xcf\x29\x11\x47\x4a\xff\xc9\x9c\xac\x69\x8f\x01\x88\x69\x5c\xd7\xc5\x34\xe7\x6b\x46\x72\x9a\xd2\xd1\x6c\x51\x19\xf6\x41\x7e\x81\x0f\x82\x56\x46\x82\xab\x85\x11\xe3\x71\x76\x61\x20\x03\x83\x11\x7a\x19\x66\x46\x1b\x2a\x79\x55\x22\xb4\xe5\xfd\xb4\x14\xf9\xfe\x3b\xfe\x3a\xce\x4a\x06\x41\xf1\x2d\x57\x20\xe8\x68\x8e\x9b\x62\xba\x63\xbb\xbc\x78\x00\x6d\xcf\x68\x8e\x75\x26\x15\xdf\x47\x25\x15\xcb\x30\x0a\x3c\x0f\x74\x4a\x87\x78\x30\xe5\x4f\x29\xa1\xda\xf7\x47\x69\xa7\xee\xc9\x9c\x54\x46\x53\xd9\xda\xc1\x9e\xc1\x0e\x37\x53\xdb\x9b\xcd\x1d\x12\x78\x59\x68\xd8\xf6\xfd\xcd\x74\x1b\x97\x10\xeb\xb4\x34\x15\xa9\xd4\x20\x56\xe8\x6e\x99\x19\x7a\x07\x81\x4f\x39\x12\xb8\xc1\x0d\x6a\x33\x28\x11\xdd\xb1\xb5\xd9\x0e\x0d\x51\x11\x2d\x07\xc6\xa1\x7b\x65\x3f\x26\x03\x9b\x5d\xcf\xfd\x64\x7e\x01\x7b\x55\x27\xe2\x40\x82\x80\xf6\x16\x97\xd6\x09\x94\xcc\x31\xe1\x17\x34\xf3\xfd\x6c\x32\x69\x4c\xdb\x7d\xae\xd1\x32\x23\x93\xf9\x45\x5b\x1b\x23\x25\x0e\x66\x76\x71\x8f\xcc\x38\x3b\x33\x2f\x41\xcb\x54\xaf\x65\x81\x03\x25\x63\x5a\x29\x13\x1a\x41\x3d\xaf\x5b\x7a\x60\x49\x47\x65\x43\xb2\xdc\xe5\x22\xfa\x15\x89\xba\x4e\xeb\x1a\xa9\xfa\x4c\xf3\xf2\x93\xc1\xea\x46\x31\x58\x2c\xb2\x9f\x52\xd1\x4f\xe4\x62\xaa\x05\xd8\x0b\x19\x91\xc7\x2e\x50\x6e\xa8\x6d\xbe\x34\x36\xc4\x38\x10\x51\x0b\x50\x24\xad\x6b\x67\x49\x65\xdd\x03\x7d\xdd\x4c\x4d\xa3\xfd\xc4\x5a\xce\x77\x83\xfd\xcd\x9b\xc6\x86\x1e\x73\xb3\x03\xbd\x64\x1b\x56\x14\x03\x56\xc1\x39\x0d\x43\x8f\xe7\x22\xdd\x3c\x78\x92\xb0\xe6\x37\x05\x2b\x4b\x8f\x38\x38\x08\x79\x6a\x97\x79\xf8\xc4\xd3\x67\x11\x09\xbd\x82\x95\x79\x76\xcb\x3c\xe2\x49\x34\xd9\xab\x40\xe2\x87\xb3\xe1\x5a\xba\xaf\x66\xc4\x54\x94\x78\xaa\x56\x88\x37\x4c\x3c\x89\x73\xff\xd7\x4a\xe7\x44\xd7\x23\x2b\x8d\x48\x4a\xbd\x3d\xe3\x09\x30\x0e\x31\x3d\x94\x22\x16\x43\x8b\x90\x36\x24\xce\xee\xe2\x87\x72\x30\x9d\x1c\xd0\x82\x76\x5d\x14\x4d\x38\x5a\x27\x0f\x10\xbd\x37\xe8\x74\x01\x54\x43\x5b\x7f\x48\xce\x27\xdd\x77\x7a\x01\x58\xb8\xcd\x60\xb5\xb0\x7b\xdd\x2c\x25\x72\x49\x83\x46\x01\xf9\xd0\xd6\xa7\x3b\x94\x86\x22\xfc\x38\x02\xdb\x23\x75\xb5\x28\x43\x89\x64\x23\xd4\x6b\x91\x41\x1a\xb2\xe1\xa4\x6e\x0b\xa0\x77\x0e\x65\xb4\x97\x70\x4c\x50\x90\x83\x8a\xa9\x82\x26\x4d\x2e\x8b\xa9\x5e\x4f\x3d\x45\xf2\x1e\x32\x4f\x06\x45\x28\x71\xfd\xd8\x93\x60\xee\x45\xaa\x31\x0e\x89\x83\xda\x26\x1b\xdc\x48\xa4\xae\x84\xf5\x6d\x6b\x0d\x91\x73\xd7\xce\x96\x43\xc5\x2b\x3a\x6b\xd9\x81\x0c\x69\xc5\xea\xb1\x53\x87\x0d\xff\x27\x9b\x2d\xda\x79\x26\x6c\x20\xfd\x19\x1c\x32\x51\x7a\x51\x29\x2d\x1e\x62\x34\x76\xd4\x73\x98\x52\x9a\xb7\x9d\x73\x72\x97\xca\x63\x83\x0e\x19\xf0\x6e\xab\xcc\xf6\xcf\x4a\x96\x6d\x26\x30\x27\x15\x28\x79\xf1\x42\x40\x6e\xa5\xbf\x9a\xf2\x51\xc5\xc4\xdd\x32\x4e\x80\xea\x94\x4b\x93\xed\x89\x64\xa8\x22\x39\x79\x4b\x4a\xac\x2f\xbf\x21\x25\xc6\x01\xaa\xc6\x63\xf2\x78\x21\xfb\x34\xd7\x8b\x27\xd7\x04\xcb\x6f\xe3\x11\xa5\x6f\x81\xbf\xd3\x1c\x4a\x41\x25\x8f\x42\x50\x59\xd7\xb9\x59\x5a\x28\xad\xa6\xa2\x69\x88\xa0\xe5\xb2\x03\xc9\xc0\x56\x21\x87\xe9\x69\x61\x78\xca\xee\xd7\x0c\x7c\x0b\xbe\xca\xf3\x0f\xf2\x60\x3d\xfc\x46\x42\xf3\xb4\x94\xbc\xe0\xbb\x22\x5e\x33\x4c\xaa\x0b\x9a\x8e\xe1\xa8\x3e\xa2\xf4\x9b\x81\x0e\xe6\x1a\xce\x00\x89\xea\xae\x2d\xd2\xa5\x40\x38\x40\x4e\x2b\x37\x4c\x00\x8b\xa9\x9a\x47\x6e\x23\xf4\x44\x31\x89\xbd\x2f\xa7\x25\x13\xef\xd2\x1d\xcb\x2b\x90\x79\xd9\xc8\xdc\x43\xdb\x93\xe1\x43\x1e\xce\xa2\xf0\x79\x04\x87\xd8\x0c\xcd\x08\x23\x3b\x54\xe0\x65\x11\xbc\x25\xac\x33\xe5\x24\x0f\xe7\x47\x25\x05\x5e\x8a\xe0\x2d\xbc\x7c\x76\xf4\x12\x92\xc8\x7d\x83\x71\x77\x7f\xe8\xcb\x47\x8e\x27\x4b\x4b\x1c\xc0\x8a\x30\x6e\x1a\x52\xd2\x43\xb3\xe8\xf2\x14\xc3\x08\x45\x84\xcf\x22\x52\x50\x11\x7e\x12\x2d\x62\x85\x48\x28\x84\x9b\x25\x05\x60\x8f\x24\x71\xf1\x4a\x4a\x8b\x86\xe4\xe1\xf3\x09\x8b\xc2\x67\x91\x89\xc7\x65\x9e\x3c\x77\x9f\xcc\xa0\x84\x24\xc7\xc4\x4c\x99\xbc\xc1\x44\x55\x2a\xe4\x03\x49\xfb\x30\x29\x01\x7b\x44\x74\x00\x37\x1f\xe3\x15\xc9\x62\x6a\xf1\x5e\x30\x4c\x50\xbb\x1f\x51\x6e\x09\x70\x83\x49\x6c\x27\xb6\xc4\x44\x79\x70\xcb\xbd\x54\xca\x8d\x53\x36\xe4\x6e\xcb\x8e\xbd\x38\xf8\x71\x42\x44\x41\x39\x29\xa8\x66\xbb\x24\x56\xd3\x69\x46\x9d\xbe\xe4\x0e\xcc\x21\x4c\x62\xfa\x67\x6e\x69\x92\x8b\x57\xe7\x37\x79\x35\xbf\xe8\x37\xba\x3c\x6a\x23\x60\x64\x32\xe1\xbd\xcd\x0b\x47\x45\xb9\x3f\x36\x88\x5f\x50\xb9\xad\xe4\xd9\x28\xd7\x44\x0e\xa2\xe4\xeb\xd2\x76\x5f\x91\x11\xc7\xc4\xd2\x51\xc0\x80\x40\x48\x11\xae\x6b\xa0\x37\x11\xd0\x9a\x48\x9d\x8d\xac\xc6\x38\x57\x54\xcf\x1c\x05\xc4\x64\x82\xdf\x28\x3d\x88\x6c\xc6\xd6\x6e\x85\x97\x0e\x5e\x35\x47\xc0\x9f\xe8\xf9\x7b\xf4\xea\x36\xce\xea\x37\x5c\xb0\x82\xc7\x59\xfd\x36\xe6\x37\xac\x7e\x2b\x67\x8e\xf1\x35\xab\x55\x7c\x96\x1a\x6c\xdb\x7f\x7c\xfb\x06\x03\x0e\x7e\x72\xbe\x38\x85\x5e\x7a\xa7\xe3\x4b\x90\xb2\xe7\x19\xf3\x7d\x7b\x39\xbd\x8b\x0b\xee\xfb\xcc\xf7\x7f\xb2\xbe\x3c\xf1\x4e\x62\xe3\x6e\x11\x93\xa9\xda\xb6\x74\x66\x5b\x02\x57\xd1\xe9\x8e\x95\x65\x7c\xc3\x08\x53\xa8\x06\xe2\xf4\x5c\x29\x41\xf3\x2b\x53\xb2\x13\x64\xa5\x83\x6b\x5c\xb4\xaa\xcf\xb3\xb8\x81\x69\x79\xdd\x81\x9d\x96\x06\x7e\x81\xf0\xe1\x95\x8e\x86\xdf\x8b\x06\xfd\xf2\xbb\x6f\xb4\xbf\xe1\xd7\x79\x9c\xb0\xc4\x23\x5f\x48\xd4\x36\x58\x56\x05\x82\xfe\x02\x9b\xbe\x22\x95\x9f\x57\xdd\x0c\xe5\x7d\x7e\xad\x56\x9a\xe1\x50\x33\x41\x51\x07\x23\xf6\x87\x8c\x18\xb6\x87\x8d\x96\x6d\xb5\xf9\x29\xe7\x04\x8a\xff\x14\xa7\x22\xd0\xd7\x9d\x3d\x87\x94\x61\xc0\x72\x32\xd1\x15\x43\xc9\xab\xa9\xae\x00\xd7\x35\xb2\x37\x74\x34\xc3\x23\xc8\x2a\x31\xbb\xe8\x94\xaf\xeb\xd7\x9d\x5d\xf1\x8a\x84\x57\x91\x16\x21\x42\x21\x18\x12\x55\x23\x23\xde\x3a\xdf\xed\x33\x26\xc0\xf0\xe3\x95\x2a\x70\x25\xb7\x40\x5d\xc3\x6c\xe9\x03\x9e\xfb\xc6\xf7\x47\xaf\xfa\x91\xe8\xa6\x49\x7e\xb5\x2e\xf2\x2c\x5b\x76\x16\x5a\xb7\x88\x03\xf4\x6a\x20\x8e\xf7\x89\x95\x3b\x2e\x68\x96\x4d\x6d\x9d\x27\x03\x76\x8a\x92\x41\x52\x38\x6b\xd0\x4d\x81\x52\x48\x4a\xd2\x72\x27\xf4\x0e\x71\x25\x67\x2f\xcf\x52\x7e\x26\x8f\xfc\x84\xe3\x27\x50\x61\x49\x78\x58\x46\x64\x34\x83\x4a\x17\xc6\x8b\xbd\x93\xc4\x14\x3e\xd8\xa9\x54\xaa\x31\x64\xb9\xcb\x40\xe2\x8e\x2c\x8b\x52\x98\x6c\x0d\x38\x40\x19\x15\xe4\x58\x75\xa7\x41\x2c\x73\xa4\xde\x1c\xe2\x2c\x6a\xf5\x93\x75\xac\x10\x88\xc9\x0e\x71\x12\x2f\x8b\xa0\x30\x69\x31\xcb\x88\x94\xc4\xbc\x72\x9c\x29\xd2\x25\x0b\x32\xcb\x4f\xe1\xa0\x5a\xaa\xf8\x2b\x84\xe3\x20\x6f\xc8\xaf\xf4\xfc\xfd\x64\x57\x4e\xce\xc9\x1f\xf4\x7c\xa2\xcc\x05\xb0\x2b\x7d\xfa\xb1\x2b\x0a\x9f\x8a\xfc\xc7\xfd\xde\x1a\x1a\xd8\x62\x3f\x77\xac\x7e\x8c\x3d\xd9\xaf\xc4\xdb\x95\x13\x27\x7c\xce\x1f\xe4\x47\x65\x9d\xf0\xaf\xa1\xed\xd5\xf7\xe0\xf9\xac\x77\x3f\x1a\x3b\x8a\xf0\xb6\x8b\x5f\x02\xe2\x48\xcb\xa9\x4e\x17\xab\xcc\x2c\xe4\xd5\xf8\xcb\x69\x95\x26\xe3\x71\x03\xbf\x74\x4e\xbe\x74\x33\x6f\x43\xac\xa3\x21\xd1\x79\xe8\xd6\xd6\x0b\xa7\x72\x68\xc8\xbf\x54\x06\x70\x37\x14\x63\xf7\x0b\x2a\x02\x2d\xb4\x57\x81\x50\x5a\x5f\x78\xe2\x96\x23\xda\x0c\x59\x90\x75\xce\x37\xe9\x4d\x55\x80\xbc\x00\x14\xe6\x98\x88\x86\x94\x4c\x9c\xf2\xa4\x52\xea\x24\x18\x81\x89\x9f\x7c\x24\xc2\xc4\x69\xf8\x33\x12\x38\xa2\x7c\xd1\xcd\xaa\xaa\xde\x14\xb8\x9b\x3c\x34\xed\xe7\x10\x77\xd6\x5d\x41\x3b\x78\x5c\x74\x1a\x0e\x7a\x23\xf7\xfd\xde\x03\xd5\x83\x86\xc4\xeb\x35\x2b\xcb\x53\x02\xf0\xb6\xfa\xba\x3e\x21\x8d\xb5\x45\xf8\xd2\xea\x5a\x64\x0f\x03\xa5\x7a\x29\xd5\x2d\xe1\x98\xb4\x2a\xcf\x25\x0f\x04\x3e\x96\x31\x75\x54\x73\xfd\xc5\xee\x6c\x6d\x38\x19\xd9\x5b\x81\x0f\x9c\x22\xd1\x4b\x95\x2c\x19\x59\x90\x39\xff\x2c\xfb\x42\xe5\x78\x71\xca\xcf\x8a\x65\x28\xa2\x40\x74\xe4\x95\xf8\xd8\x66\x5b\x27\xa2\x91\xe7\x46\x1e\x45\x0d\x72\x67\x42\x22\x77\x27\xbf\xaf\xca\xd5\xff\x18\xd8\x69\x6e\xd0\x26\xb7\xe9\xbc\x95\xc7\x98\x6d\x5c\xbe\x8c\x45\xfc\xd7\x61\xbe\x1d\xbb\xef\x8f\xfa\xfd\x11\x92\xbd\x92\x9f\xff\x02\xae\x0b\x5f\x92\x1f\xf4\xef\x3f\xb4\x21\xc3\x41\x59\x31\x3c\x5d\x35\xf5\x2a\x34\xd7\x11\x7e\x72\x4e\xfe\x49\xcf\xc3\x17\x93\x7f\x47\x2e\xa6\xf9\xf7\x80\x11\x43\xbb\xea\x47\xbe\xf1\xe9\x06\x15\xd4\x4b\x62\x11\x4f\xdc\x38\x3a\xff\x24\xde\xe4\x89\xef\xf5\x5d\xff\xfb\x20\x05\xc9\x89\x3b\xb6\x7b\x05\xc6\xea\x58\xc7\xa9\x27\x8a\x0a\x28\x20\x4a\x29\x87\xa4\xcd\x71\x56\x32\x49\xf8\x52\x79\xa8\x95\x68\x5c\xbe\x4d\x55\x08\xab\x94\x52\x3a\x4e\xc7\x9e\xb7\x1c\xa7\x81\xf6\xa0\x4e\xf1\xf2\x1f\x57\xdf\x7d\xab\xec\x11\x50\x8a\x83\xd4\x39\x2a\x36\x3f\x38\xe0\xaa\x3c\x9a\xcc\x49\xaf\xb5\x16\xbb\x72\x95\xdb\x47\x8b\x66\x5d\xb0\xf5\x4b\xc4\x70\x5d\xff\xe2\xdc\x35\x24\xe9\x7e\xd3\xd9\x6f\x3f\x4c\xd5\x7e\x34\x7d\xd0\x5b\xe4\x65\xff\x13\x7c\xf8\x41\x73\x4e\x3a\xf8\xe2\xaf\x8f\xd5\xfa\x4b\xbf\xd6\x5f\x4f\x56\xfb\x4b\xa7\x5a\x60\x46\x1c\x75\x7e\xb7\x11\x4e\xac\x51\x93\x0e\xe2\xaf\xb4\xbb\x24\xa6\xb9\xef\xe7\x8e\x19\x6a\x17\x62\x94\xdd\x48\x47\x61\x93\xd2\x1f\x00\x71\xe4\xca\x86\x2b\x77\xd4\xdf\xa3\x5f\xd4\x1b\xe2\xe9\x49\x94\x90\x51\x7a\x00\x14\x34\xee\x6e\x5c\xc9\xe1\xc7\x70\x1a\x90\x0d\xa1\x02\xd2\xa4\x2b\xd6\xb9\x0d\x4b\xa6\x20\x53\x99\x5b\xff\x8c\x8c\xda\xe9\x13\x8c\xc9\xbf\x95\x2b\x24\xa4\xb0\x5e\xfc\x02\xb0\xd0\x6f\xd6\x8d\xa8\x99\xea\x8b\x23\x61\x8a\xc6\x84\x70\xa6\x75\x78\x68\x05\x5d\x4a\xf4\x84\x1b\x1c\x3c\xe9\x27\xfc\x37\xfe\xf2\xe9\x06\xe5\x0e\x62\xb5\xf6\x42\x76\xd3\x23\x61\xe6\x4b\xb2\x14\x4b\x11\xb8\x6f\xfe\xdd\x7b\xba\xf8\xd3\xce\x10\x06\xb1\xbd\xb4\xd9\xd0\xf1\xc1\x4e\xbd\x91\x23\x1f\x86\xc7\xae\xee\xfc\xb8\x25\x0d\x52\xca\x36\x00\x37\x46\x4f\xae\x81\xea\xf7\x8a\x55\x6c\x98\x9c\x2a\x07\x0c\x53\x39\x45\x42\x6e\xf9\x7b\x0f\x8f\x3d\xf8\xc8\x23\x05\xfd\xc5\x12\x1c\xc2\x7d\x1f\xa2\x28\x1f\x25\xc4\x97\xa5\x9c\x1d\xe0\x5a\xd6\x70\x8c\x03\x9b\xb8\x00\x93\x42\x52\x83\x86\x24\xec\xb8\x53\x12\xdc\x74\xfb\x0b\xa3\xd1\x84\x52\xaa\xf1\x36\x8d\x17\x49\x29\xb7\x7e\x4d\x92\xe7\xf9\x15\x8a\xc9\xa3\x5f\xa9\x62\xbd\x7a\x29\xb7\xb2\x6f\xaa\x70\x97\xfb\x4d\x31\x99\x80\xc7\x08\x92\x6d\x51\x6d\xb5\x6a\x22\xe5\xba\xdf\x62\xa2\x29\x4a\x0e\xba\x2b\x62\x75\x82\xce\xec\x5f\x4d\xf5\x60\x34\xa2\xc8\x31\x26\xa3\xc2\xf7\x61\x87\x82\xc6\x04\xa4\x0f\x48\x62\x85\xb6\x9f\xc3\x79\xda\xf5\xb4\x43\x09\x6f\x61\x91\x8b\x5a\x00\x0e\x88\xce\x4e\x33\x27\x07\xa5\x8f\x39\x2d\x2a\xef\x8b\x6e\x1c\xdc\x13\x0a\xbb\xc4\x3c\x52\x49\xa0\xfa\xb8\xa8\xb7\x42\x16\x68\x18\x7d\x66\x74\xcb\x47\x19\x22\x41\x74\x27\x79\x7c\x39\xb3\x84\xc9\x79\xee\xc3\xfa\x05\x5b\x9a\x75\x35\xe8\x4c\x98\x78\xb2\x96\xc9\x09\x06\x01\x5d\xb5\xee\x7e\xad\xbc\x88\xba\x10\xa0\x9e\x63\xd2\x2e\xae\xbb\xa4\x23\x63\xe5\xd7\xae\x9b\xfe\x40\xee\xd0\x63\xb8\x7c\x7c\xeb\xf5\x2a\x51\xdb\x7c\x9d\xb1\xb8\xf8\xe1\xd1\x7a\x34\xc0\x28\x68\x27\x61\x34\x28\xe3\x73\x99\xb5\x39\x49\xbb\xd2\x24\x45\x0c\x48\xdc\xb1\xae\x2a\x5d\x01\xda\x64\x52\xd4\x75\xda\x39\x02\xe7\x24\x94\xec\xd0\x62\xc8\xfc\x0a\x09\xca\x08\xd3\xd4\x58\xa7\xee\x84\xbd\xe8\x04\x6f\xe2\x1a\x1b\xe4\x61\x1c\x11\xd6\x81\x56\x95\xc5\x05\x20\x52\x22\xfe\xf1\x98\xe8\x3b\x00\xc2\xd2\x89\x23\xa5\x9c\x11\xb5\x1c\x48\x58\x41\x90\x4a\xd0\x3b\x89\x96\x92\x7f\x4a\x9e\xae\xa6\x35\x5e\x25\x63\xb4\x0c\x42\xf6\x2a\x82\x17\xab\x64\x5c\xe3\x73\x9d\x54\xaf\x9f\xc6\xf7\xbd\xc9\x96\x8c\x69\x8d\x91\x37\x66\x6c\xec\x61\x38\xd5\xfd\x3d\x7a\xea\x24\x41\xa6\xa1\xf7\x2e\xdf\x7b\xc4\x7b\x9b\xde\x6c\x85\x47\xbc\x2f\x72\x21\xf2\x9d\x47\xbc\xaf\xd9\x46\x78\x11\x29\x18\x3d\x3a\xd5\x77\xd3\xe5\x3a\x9a\x5a\x6b\x1c\xd7\x4f\xac\x2a\xf9\x90\x5c\x9e\xb4\xf2\xdd\x3e\x2f\x59\x02\x26\x7f\x05\x30\x5e\x6f\xf3\x5c\x87\xd0\x41\xff\x43\xb5\x3a\x18\x93\xa9\x04\xe5\x2a\xa7\x66\xb7\x9c\x9e\xd2\x78\x38\xc9\xaf\xc7\x73\xae\x98\x3c\x46\x55\x72\xb5\x52\x3c\x64\x90\xa6\x0e\x92\xbd\xd7\xda\xff\xa4\xf3\xd4\xf7\x53\x06\xa7\x3f\xfb\xf5\xd5\x74\x0d\x88\xc8\xd3\x25\x3c\xec\x9c\x4c\x4b\x36\xe0\x12\x47\x9f\xcd\x48\x49\x8b\xe3\xc8\xa0\x67\xc5\x74\x5d\x15\xc8\x0d\xce\xee\xce\x86\x26\x2b\xa0\xfe\xaf\xa8\x04\xa0\x0c\x14\x5b\x10\xd5\x15\x41\x81\x6f\xab\xdd\x35\x2b\x42\x11\x2d\x3d\x2f\xf0\xf6\xf7\x1e\x86\x90\x89\x2d\x97\xd3\x2b\x56\xd7\xb2\xd0\x88\xd2\xcc\xf7\xc7\x15\xf6\x7d\xc1\x94\x19\xaf\x6d\x4e\xf9\xbe\xae\x7d\x7f\x1d\x3e\x8f\x64\x41\x7c\xa8\xce\xe9\x33\x92\xd1\xac\xae\xe5\x33\xb2\xa6\xe3\xaa\xae\xe7\xce\x06\xb9\x52\x73\x06\xbd\x5d\x8f\x33\x4c\xd0\x7c\x92\xe3\xa7\x68\x3e\x41\xb9\xec\xf7\x79\x55\xd7\xd3\x4f\x30\xbe\xa0\x33\xf0\x2c\x9e\x61\xb2\x3e\xa7\xf9\x62\xfd\x94\x3e\x23\x47\x1f\x6b\x37\xc0\xc6\x31\xc8\x5f\xd3\xf1\xba\xae\x65\xb3\x33\x49\x05\xc3\x79\xb4\x5c\x8f\x91\xfc\x1d\xcf\xf1\x53\x1e\x3e\x8b\x82\x31\x07\x71\xbe\xdc\x84\xd3\x8a\xa7\x82\x66\xa4\x98\x96\x22\x2e\x04\x5d\x93\x62\xca\x78\x42\x53\x8c\x49\x0a\xc2\x88\x8a\xd1\x83\xb3\x6a\x19\xeb\x79\x7e\xf4\x1c\xae\x43\x39\xe8\x19\xd9\xb4\x91\xdd\xd6\x17\x9b\xc5\x7a\x3c\xc6\x48\x9e\x27\xd7\x91\x06\x25\xe3\xf8\xec\x02\x10\x11\x4b\x64\x61\x47\x8e\x25\x0b\xd7\x91\xc6\x27\x85\x03\x44\x75\x0d\xcc\x90\x7c\x5b\xd7\xa8\x57\x09\x85\x54\x6b\x00\x9f\x45\x1f\x3e\x63\x06\x96\x47\xaa\x5e\x54\xd1\x98\xe6\x46\x8b\x14\xcb\xf3\x4c\x81\x7b\x9b\xa9\xa4\xa9\x75\x2c\x21\xa8\xa2\x15\x44\xed\xa8\x6b\x94\xd3\x78\x7a\x9d\x27\x0f\x9d\xdc\x23\x71\xcf\xbd\xad\xc4\x98\x54\x7a\x13\xe4\x4e\xff\x49\x7e\x2a\x6f\x69\x8e\x89\x9d\x80\x0a\xd2\x20\x7b\xd7\x59\xbe\xfe\xe0\x61\x02\x4d\xd3\x0a\x63\x8c\x03\x55\x66\xe4\x4c\x92\x7a\x42\x14\xc3\xec\xcc\x95\x12\x77\xc9\xc5\x5a\xd3\x99\x5d\x0a\xa5\x11\x92\x1f\x42\x18\x89\x75\xd4\x9b\x42\xf9\xe6\x94\x39\x71\xb9\xcd\xef\x06\xf6\x60\xa6\xe9\x1b\x70\xa8\xdb\x34\x19\x52\xb0\xeb\x32\xb8\x21\x22\xbf\xb9\xc9\x86\x68\x9f\x77\x9d\xe7\x19\x8b\x5d\xfd\xe7\x52\x9b\x7f\xca\x86\x91\xb6\x24\x97\x0d\x98\xeb\x3e\xc1\x8d\x75\x2b\xcb\x2b\xf5\x6b\x3e\x34\xb7\xea\xdb\xc6\x52\x95\x35\x23\x1b\x46\xf6\x4c\x9d\xcb\x4d\x20\xa4\x1a\x42\x23\x41\x8a\xf9\x84\xd1\xf3\xae\xb3\x50\xcf\x57\xe8\x3c\x25\x5b\xf9\xf9\x93\xfa\xfd\x2e\x4f\xaa\x8c\x3d\xa9\x57\xe7\x68\x19\xfc\x16\xdf\xc6\x35\x5b\xef\x62\x5c\xae\x8b\x74\x2f\xce\xd3\xc5\x5a\x92\x0e\x05\x25\x06\xc8\x5e\x17\xf1\x0d\x80\x4b\x37\x85\xe2\xab\x13\x29\x14\xd1\xa6\xad\xe2\xcf\xb2\x33\xe9\x24\x3f\x30\x14\x0f\x93\x4d\x3f\x3d\xb2\x49\x8d\x44\xbc\x36\x49\xd2\x51\x21\x9d\xfa\x47\x48\x7c\xd9\xcd\x12\xb4\x61\x98\x3c\xa8\xa0\x71\x97\x59\xce\x19\x5d\xb3\xe9\x5a\x5e\x00\xd9\x19\xcd\x70\xef\xce\x06\x71\x35\x81\xe6\x64\x85\xae\x13\xa4\x49\xf0\xff\xf9\xfd\xc5\xb9\xbd\xf6\xc8\xc3\x94\xe7\xd0\xc0\xa5\xfa\x8c\x8e\x46\x47\x2d\xb5\x75\xbb\x1e\x8a\xfd\x06\x6c\x12\x2f\x7d\x21\xeb\x56\x97\xaa\x4e\x5b\x0b\x40\xc6\x0d\xa3\x07\xb1\x65\x71\x12\x84\x73\xe2\x5d\x80\x03\xed\xe7\x1e\xf1\x2e\xce\xf5\x65\x44\xd6\x79\x16\x84\xcf\xec\xcb\x8b\x75\x9e\xdd\x14\x79\xb5\x57\xc5\xec\x9d\xf3\x85\x28\x3a\x1f\x08\x89\x45\x74\xa5\x70\xe9\x16\x4d\x82\xf0\x79\xbf\xe8\x85\x28\x74\xf1\xe2\xf3\x81\x6f\x7e\xd5\xc3\x0f\xc2\x19\xf1\x3c\xe2\x79\x91\x83\xbc\x6f\xdd\xa4\xc5\x56\x9c\x72\x3a\x43\xe1\x71\x0a\xbc\x53\xb9\xcc\x21\x94\x0f\x0e\xfe\x5a\x7e\xcf\xe5\x40\x2a\x25\x53\x43\x18\x91\x9e\xc0\x53\x39\xfd\x2d\xad\x05\x2c\x03\xf1\x3c\xef\x66\xa4\xed\x45\x79\x73\xc2\xd4\xd8\x60\x2a\x0a\x37\xb2\x90\x47\xc4\xbb\xc9\xf2\xeb\x38\x7b\x75\x1b\x67\x1e\xb8\x51\x2b\x1a\x23\xfa\xef\x30\x6e\x6e\xd8\x14\xe6\x98\xca\x8b\x4d\x9e\x0b\x79\x61\xd6\x15\xae\x63\x05\x3f\x37\x60\xa4\x11\x27\x04\x2e\xe0\x36\xb1\xd0\x55\xd7\xe8\x86\xc9\x6b\xfb\x99\x86\x3a\x00\x2c\x9d\xe9\x6b\x57\x65\x22\xdd\x67\x8c\x7e\x64\xae\x3e\x52\x2b\x6d\x72\x7c\x45\x0a\x61\xed\x24\x3e\xaa\xfd\xbf\x2d\x57\x77\xe3\xc5\x79\xbb\xba\xf7\xa7\x42\x52\x39\x09\xfe\xc5\x49\xe4\xa3\xc2\x11\x25\x74\x46\xb6\xed\xdc\x25\x17\xdb\x45\xa2\xdc\x3c\x20\x64\x55\x22\x89\xdf\x0c\xd2\xd0\xf4\x75\x44\x39\xc6\x66\x89\xf6\x24\x77\x62\x3a\xe5\x51\x90\xb7\xaa\xa1\x9d\x71\x9f\xc7\xf8\x00\xb6\xa4\x9b\x6e\xe2\xb1\x13\x58\xaf\xa4\x28\xd1\xec\x57\x8e\xeb\x3a\x54\xa0\x8d\x8f\x93\x03\x54\xf4\x46\x12\xca\xba\xbe\x61\x53\xb3\x17\x48\xec\x20\x81\x4a\x72\x42\x57\xd3\xad\xd8\x65\xdf\x17\x4c\x9b\x30\xe7\x78\x5c\x49\x9e\x68\x4d\xab\x70\x66\xe2\x3c\xaf\x27\x13\x1c\xd3\xd8\x41\x0a\xed\x00\x63\xd7\x08\x9b\xa0\x98\x6e\x5c\x73\x71\x37\xb2\x2b\xf5\x3c\x1d\x98\x49\x9b\x04\xea\x11\xbe\x63\xf7\x9a\x3d\x97\x04\xba\xf7\x85\x5c\x07\x9b\x21\x73\x1f\x26\x3a\x04\x47\x01\xb1\xa0\x5a\xf3\xcb\x9c\x14\x18\xa7\x36\xbe\x94\x33\xcd\x19\x4d\x65\xcd\x24\xa6\xb7\x0c\x75\xa7\x58\xf2\x18\x8a\x1e\x79\xa0\x8a\x7b\x60\x28\xc6\xf2\xe4\xbe\x76\xda\x8c\xc3\xb5\x6c\x73\x6b\x56\xcb\x84\x9a\xf4\xb0\x75\xdf\xcd\xad\x10\x6e\x03\x8c\xe2\xb5\x24\x81\x1f\xd8\xc3\x39\xb9\xd3\xb4\x74\x97\x57\x25\xab\xf7\x79\xca\x05\x2b\xea\xb5\xf2\xe6\xdd\x31\x5e\xd5\x49\x11\xdf\xd4\x49\x91\xef\x71\xbd\xce\xd2\xf5\x87\x73\xf2\x0e\xbe\x09\xdf\x4f\xa3\xa7\x58\x1e\xef\xa6\x68\x3a\xc6\x35\x76\xc0\xfb\x92\xb9\x69\x02\xec\xe3\x57\xce\x63\x27\xf3\xf9\x15\xeb\xfa\x0a\x53\x4a\x7b\x16\x4a\xfa\xcd\xab\x6e\xac\x56\x47\x12\xdd\x20\x4c\x29\xf2\x20\xd2\xab\xca\x4e\xde\x56\xff\x81\xb9\x0a\x55\x85\x51\x63\x52\x76\x35\xa7\x56\xd7\x74\x30\xda\xd3\x63\x7f\x5d\x10\x81\x16\x75\xcd\x09\xb7\x07\x6b\x81\xa1\xfa\x12\xaa\x17\x61\x19\x91\xdc\xe1\xc6\xd2\x8d\x76\x8b\x29\x54\xa4\x17\x4a\xd3\x25\x4a\xc1\xd6\xc4\x56\x11\xe8\x17\xbe\x7f\xac\xfa\xe2\xb2\x74\x41\x0a\x5b\x56\xdf\x3a\x3d\xd0\xa1\xc2\x53\x9c\xd2\x57\xcc\xc2\xd4\xa8\xcd\xa5\xe2\x86\xba\xcf\xe1\x9c\x92\x12\x94\x0e\x9e\x54\x11\x9e\xe6\x9b\x0d\x62\x3a\x51\xdf\xb1\x95\x61\x83\xa7\x37\x55\x9a\xd0\x18\x7e\x20\x04\x1e\xdc\x5f\xc1\xcf\x78\x0c\x29\x28\x8e\xa5\x2a\xec\x96\x71\xa1\x8c\x84\x94\x94\x27\x25\x05\xc8\x74\xdb\x45\x7a\x21\x67\x11\x96\x27\x5f\x22\x8d\xfe\x49\x4a\x46\x73\x10\x7d\xda\xef\xe5\xb3\x83\x4d\x30\x1e\x8c\xe6\x64\x0b\xd9\x5b\x8a\x13\x9e\xc3\x9a\x58\x80\x15\x0e\x9c\x00\xe7\x3e\x9b\xa6\xe5\xbb\x22\xbd\xb9\x61\x85\x76\xb0\x4a\x95\x6b\xa6\x89\xf6\x81\x91\x69\x11\x22\x13\xc4\x59\x98\x46\xca\x29\x25\x61\x19\xbb\x91\xf8\x40\x79\xc8\x83\x14\xf1\xfb\x22\xdf\xc7\x37\xb1\x1a\xab\x9d\xff\x62\xc0\x80\xe8\x97\x56\x84\x9c\x2a\x3d\x7a\x6e\x7a\x46\x74\x3f\x10\x26\xc5\x88\x52\x2b\x99\xd1\xaf\x71\x5d\x8b\x65\xe7\x73\x48\x10\x4e\x0f\x0d\x14\x77\x62\x5f\xc8\x1e\xbd\xd9\xed\x58\x92\xc6\x82\x75\xba\x46\x18\x38\x61\x31\x2e\x5e\x2a\x4c\x8b\x30\xd1\x79\x7a\x15\xce\x6b\x83\x9d\xa0\x4e\x53\x5a\xb9\x6b\xe6\x44\xa8\xa9\x43\x56\x20\x5d\x84\xb3\x88\x5c\x4d\xc1\xb6\xa1\xd5\x4a\x63\x52\xd8\x38\xcd\xc6\x1b\x93\x3c\xd6\x3f\xc9\xe3\x3b\x82\x43\x23\x26\x4d\xc1\x8f\xb5\x0b\x00\x97\x0c\x37\xfa\x19\x3d\x28\x16\x20\x38\xf4\xfc\xde\x04\x61\x03\xa1\x9e\x14\x65\x25\x7b\xa2\x83\x31\x9a\x69\x06\xd0\xf8\x17\x12\x18\x1f\xf8\x54\x43\x14\x9c\x7a\x51\x4e\x39\x36\x4f\x48\x4a\x73\x1b\x7b\x02\x44\xce\xa7\x7c\xe4\x65\xbf\x09\x37\x9b\x84\xf7\x36\x89\x3c\x9d\xde\xaa\xfe\x97\xf2\x80\xda\xde\x51\xad\x6e\x57\x64\x07\x70\x07\x06\x92\x75\xab\xfb\xa0\x0c\x33\xcc\xdd\xc0\x26\x1e\xe2\xea\xae\xda\x29\xd4\xab\xc7\x92\x91\x89\x19\xbc\x34\xaf\xe4\x81\x12\xe2\x39\x98\x00\x83\x8e\x5d\x9a\xce\x8b\x8c\x49\x46\x11\xa3\x48\x51\x17\x57\x25\x2c\x09\x7c\x57\xb7\x94\x4d\x26\x38\xa1\x37\x14\x95\xf4\x9d\xe6\x06\x58\x98\x45\x3a\xc6\xd0\x3c\x22\x5b\x8a\xca\xf0\x99\x4e\x4b\xa4\x03\xdd\x4c\x4d\xa4\x1b\x4c\x12\xdf\x47\x1b\xda\xdf\x89\x09\xec\x44\x92\x50\x94\x2e\x37\x9d\xfd\x18\x6c\xa6\xd7\x29\x4f\x40\xa5\x5a\xd7\x09\x39\xf9\xed\x9a\xb6\xba\x14\x08\x9b\x96\x90\xbc\x48\x6f\xa0\x8e\x1b\xa5\x6d\x2c\x2c\x56\xe1\x44\xae\x59\xa0\x56\x90\x98\xb5\x0f\x52\xe2\x06\xbd\x08\x00\x12\x4e\x44\xc4\x30\x7a\x54\xd2\x62\xad\xad\x8e\xe2\x33\xf5\x40\xc1\x40\xd0\x9e\x56\x8a\x5d\x43\xfa\x92\x86\x51\x8b\x6d\x2e\xf3\x8a\x0b\x3a\x23\x1b\xb9\x2b\xab\xbd\xef\x8f\xe6\x23\x4a\xf5\x9d\x76\x23\x25\x05\xd9\x92\x58\xe2\x89\x23\x3b\x23\xdf\x3f\x7e\x86\x12\x12\x63\x4c\x36\xf2\x85\x9c\x67\xf9\x6b\x6a\x5a\x63\xb2\x36\x10\x6f\x20\xb8\xfb\x80\xaa\xe9\xc0\x98\xa4\x6d\xdc\xca\x7d\xb7\xbf\xe3\x31\x99\x91\x35\x0e\x34\x27\xb5\x6e\xd1\xb8\xda\xb0\x72\x90\xa3\x19\x6e\x06\xad\x1a\xfe\xda\xce\x6d\x95\xc4\xbe\xaf\xd1\x05\xec\xe3\x5b\x10\xc6\xd8\x0d\x86\x0f\x19\x45\x5a\x19\xf6\x57\x80\x36\xdd\xa0\x1e\xdc\x8a\xff\x0a\x6e\xf1\xe1\x24\xe8\xc1\xe2\x52\x54\x3c\x0e\xba\x90\x0f\x84\x94\xb4\x84\xa4\x5c\xbd\xb4\x47\xab\xd5\x14\x7b\x63\x03\x41\xab\xd5\x14\x2d\x83\xe9\xd3\xd5\x6a\x5a\x63\x0f\x8f\x3d\x24\xaf\x9e\x60\x4f\x72\x93\x83\x91\x4a\xd7\x10\xa8\x94\x8c\x52\xdf\xbf\x19\x51\xba\x9e\x1a\xd8\xaf\x6b\x70\x2e\x90\x0b\x0b\xcf\xd5\xca\x97\xbe\x6f\xb2\x33\xae\xa7\x16\x82\x71\x5d\x17\xbe\x5f\x40\xb9\xd2\x66\x76\x44\xde\xd3\xa7\xe0\x99\x58\xd7\xa3\xf6\xb9\x84\x6a\x0b\x24\x39\x99\x4b\xe8\x6a\xbf\xe9\x41\xcd\x64\x42\x36\x5a\xc8\xe6\xfb\xe6\xca\x68\xe9\xd6\x18\x2f\x62\xdf\x1f\xed\x5b\x42\x25\x79\xf1\xb8\x48\xf2\x3b\x6e\x77\x85\x79\x60\xbe\xda\x12\x07\x77\x5e\xb9\xf6\x93\x88\x91\xa4\x7d\x69\x74\x83\xb0\x13\x1b\x6b\x67\x94\x9c\xa5\xfc\xac\xc2\x66\x41\xad\xc6\x2d\x19\x4b\xa8\x00\x40\x1d\xcd\xf0\xa2\x6f\x52\x52\x01\x4c\xda\xd2\x9e\x6a\xe4\x4c\x81\xa4\x27\xc1\xde\xa0\xdb\x61\xc6\xc5\xc8\x69\x41\x29\xa2\x0e\x10\x7d\xcd\x9b\x92\x57\xaa\x6e\x6d\xd2\x7b\xc9\xb5\x65\x14\x39\x7c\x83\x67\x5a\xab\xeb\x21\xb2\x12\x56\x2a\xbc\x1a\x40\xdb\xfa\x08\x64\xed\xdb\x43\x03\x32\x49\x08\xd0\x50\x11\x41\xe7\x0b\x71\xa4\xf1\x86\xa8\x27\x65\x28\xa2\xd6\xb2\x5a\xa7\x50\xad\x5a\x48\x87\xb4\x0f\x4a\xcf\x35\x5a\x4b\x66\xe4\xa5\x9e\x82\xba\x86\xb5\xeb\x3c\x73\xdc\xe3\x2b\x38\x7e\x9a\xfe\x69\x44\x54\xba\x05\x48\xa6\xe2\xaf\xe8\xa0\x0b\x29\x8d\x43\x21\x8f\x44\xbe\x3f\xaa\xa6\x69\xe9\xf0\x19\x57\x22\xdf\xef\x59\x82\x30\x3e\x54\xd3\x75\x55\x14\x8c\x0b\xdd\xb1\x74\xca\x32\xb6\x23\xbc\xad\x27\xa7\xa9\x6d\x2e\xe4\x4e\x85\x43\x1c\x4c\x5b\x73\x35\x2d\xec\x4e\xd1\x60\x99\x4f\xdd\x27\x6e\x01\x73\x66\x73\xf7\x16\xaa\x74\xb3\xdf\x5d\xff\x46\x73\x52\x4d\x25\x51\xa2\x39\xfc\xb4\x56\x61\xa8\xa0\xe8\x88\x53\xcd\xed\x7e\xd6\x2c\xab\xaa\xa8\xae\x73\x33\x12\xac\xe9\xbb\x1e\x6e\x09\x59\x2d\xe4\xd9\x00\x55\xd3\x82\x95\x55\x26\x28\xc8\xdd\xab\x63\x76\xb1\x3a\x66\x78\xb1\x3d\x58\xae\xa7\xfb\xbc\x14\x66\xf9\x20\x8c\xa4\x73\xdf\x59\x4e\x62\x5a\x02\x4b\x2e\x35\xbf\xc3\x8a\x52\xd2\x89\x6e\x2d\xba\xc8\x02\x62\xc4\xab\x64\x22\x00\x69\xbe\x9f\xb9\x76\x30\xc8\x83\xe3\xaa\x9b\x1d\x61\x7e\x41\xd9\x54\x65\x60\xd0\xb6\xa8\xd9\x88\xaa\x78\x42\x19\xcd\x3a\x3e\xe8\xc0\xb2\xea\x00\x5c\x6e\xb5\xa6\xd6\x51\x9b\xd3\x61\x24\x57\x23\xb3\xa9\xcc\xb1\x8e\x42\xab\x42\x63\x1c\x1a\xa2\x32\x19\x54\x20\xd8\xb2\xfc\x6d\x1c\xa6\x72\x05\x21\xc8\xa9\xc5\x87\x26\x29\x6e\x1c\xa6\x11\x2d\x3a\x6c\x04\xf8\x72\x22\x15\x51\x45\x1b\xe7\xa0\x0c\xeb\x08\x4c\xfa\xb9\x4e\x55\x9b\x59\xaa\x86\x49\x0c\xd9\x45\x73\x9b\x6c\x20\x6f\x23\x68\xea\x08\xb2\x12\x0a\x82\xac\x5d\x87\xbc\xb1\x4b\x9a\xa9\xcd\x5a\x5d\x88\x3f\xfd\xca\x38\x54\x56\x92\xcd\x2f\x81\x17\x97\x70\xd2\xe1\xc7\xf1\x61\xd8\x98\xf4\xe8\xec\x40\x04\x39\x30\x5e\xed\x98\xb1\x23\xed\xdb\x95\x82\x7d\x27\x84\x85\x71\x3d\x59\xb4\x91\x94\xdc\x00\x29\x8f\x33\xa8\xd4\x9e\x8c\x86\xde\x75\xf4\x93\x8f\x7e\x7e\xfc\x26\x14\x51\xdf\xae\xf5\xd4\xf8\xf4\x99\xf7\x4f\x86\x74\x57\xa4\xc2\x5c\xab\x13\x97\xca\x08\xd1\x90\x4d\x3a\x1c\x6b\x24\xb4\xe6\xc1\xd1\x92\x05\x92\x50\xe8\x99\x04\xb3\x39\x8d\x11\x82\x43\x96\xc7\x49\x70\xe0\xf9\x17\xd5\xb5\xb6\xca\x25\x00\xc2\xc1\x01\x18\xc8\x01\x1b\x4a\xd9\xe1\xba\xb6\x82\x83\xbd\x46\x52\xc2\x84\xd0\x14\x53\xa8\xc0\xf7\x5f\x20\x41\x8c\x3e\xc3\xf7\x5f\x40\xb4\x45\xb5\x3d\xe4\x19\x8d\x8c\xe6\x0d\xd1\x07\x8e\xff\x6f\x5a\xc1\x44\x8e\xc6\x8a\xd1\x07\xac\x41\x0d\x7e\xf8\x2f\x1b\xd1\x54\xd4\xb6\x53\xd7\xf0\x3e\x06\xca\x7d\xcd\x36\x79\xc1\x2a\xae\x26\xd6\xc5\x72\xdd\x1e\x18\x44\xcd\x34\xb6\xf3\x7d\xd6\x85\x21\x30\x82\xed\x3c\x99\xaa\x7e\x82\x3e\xc4\x7e\x87\x9b\xa6\x51\x6e\x28\x96\x75\x39\x32\xba\x67\x43\x8e\x21\xb2\xc1\x21\x7f\x11\x65\xd4\xa8\x81\xe5\x38\x73\xf7\x08\x20\xb6\x13\xa5\x4a\x15\x6d\xc3\xc2\xba\xb0\x46\x04\x38\x9f\xea\xd3\xe4\xc0\x26\x32\x61\x9d\xc0\x58\x5d\x15\x53\x0f\xd2\x52\xd3\x96\xef\x15\xa5\x61\x09\xb5\x41\x2b\xed\xa3\xba\x6e\x0d\xfb\x8e\x5e\x6a\xd2\xc5\xdc\x79\x5b\x5e\xb2\xe0\x95\x69\x52\x91\x76\x03\x06\xbe\xff\x5c\x51\x03\xb8\x73\xec\x8f\xcd\x93\x96\x02\x04\xe6\x99\xaa\xa8\xcb\x2a\xb0\xee\xbd\x2a\x02\x81\x6b\x59\x62\x8b\x74\xee\xb5\xfe\x53\x4d\x01\x11\xea\xbc\x08\x67\x50\x63\xdf\xa4\xde\xa7\x3b\x76\x25\xe2\xdd\x9e\xaa\x19\x35\xb7\x75\xfd\x32\x16\x6c\xca\xf3\x3b\xa4\x65\x45\xed\xde\xa7\x72\x0f\x1c\xe1\x50\x7a\x70\x82\x91\x05\xfa\x35\x39\x9e\x71\x39\x55\x43\x1c\x92\x7a\xfe\x08\xa3\xa3\x0a\x5c\xa5\xbb\x0a\x86\x19\x8c\xe6\xa4\xcb\x30\xf4\xfd\xa5\x19\x3d\x06\x8d\xc5\x29\x38\xb8\x64\x44\xd2\x70\xfd\xda\xb6\x02\x79\xaa\x7a\x6c\x49\x43\x7a\x5c\xc9\x7f\xd3\xf0\xf1\xb8\x1e\x6b\xfa\x88\xfd\x51\x6d\x0f\xcd\xd2\x7f\xd3\x89\x47\x66\xf9\xcf\x7a\x73\x42\xd6\xa7\x94\xf0\x47\xbd\xb5\xe1\x47\x0e\x71\x26\xfe\xc9\x1e\x24\xad\xb9\x06\xb2\x00\x41\xcd\xd6\x72\xbb\x67\x96\x40\x6d\x63\x7e\xc3\x92\x77\x79\x05\x21\xe5\xe5\x13\x51\x64\xfa\xab\x84\x89\x38\xcd\xe4\x15\x2c\xc6\xf7\xdb\xb8\x84\x8f\x76\x4c\xc4\xba\xc8\x3e\xbe\x61\x3f\x9b\x8b\x5f\xe4\x05\x58\x64\xea\xb7\xb7\x29\xbb\x93\xbf\xde\x7a\x1b\x17\x9e\x22\x88\x89\x69\xb7\xb8\xd4\xd7\x1f\x54\xe1\x0f\xec\xc1\x3c\xd1\x89\xb9\xec\x95\xea\x58\x96\x32\x2e\x7e\x6e\x2f\xa1\xb9\x7c\xb3\x29\x99\x7a\xaa\x2e\xe1\xa9\x56\x71\xbc\x49\x9c\x1b\x38\x84\xcb\x0e\xae\x0b\xc6\xf8\xcf\xed\x25\x7c\xa1\xf0\x80\x33\x0f\x22\xd7\x0a\x08\x75\x63\x9f\xdf\x6d\xd3\xa1\xf3\x9c\xe5\x39\x17\xbd\x90\xbb\x50\xde\xf7\xaf\x4d\x5c\x68\x45\x94\x96\x36\x44\x91\x9e\x88\x65\x7b\x19\xb0\xa9\x9d\x0b\xfb\xbd\xeb\xdb\x70\xd7\xab\x6b\xee\x8b\xe5\x3c\x78\xe6\x8b\xe5\xf3\xe0\x63\x5f\x2c\x9f\x05\xb3\x40\x7f\xa8\xa0\xc1\x08\x56\x25\xa0\xb4\xd1\xb8\x54\xc6\x3c\xa5\x4e\x49\xb9\x47\xae\xb3\xaa\xd0\xb7\x79\x25\xbc\xa6\xe7\x78\xdc\x3f\x85\xb0\x88\xf6\x99\x0b\xcb\xb6\xbc\x30\xc6\x95\xe4\xea\x14\x8b\x70\x5c\x56\x11\xfa\x8e\xcc\x44\xb8\xd1\xc3\x40\x7b\x25\x31\x47\x11\x78\x70\x9d\xdf\xb2\xc2\x23\x70\x99\xb1\xf8\x96\x99\xc7\x95\xf0\xcc\xa2\xeb\xe2\xfa\x4e\x7d\xa0\x6f\xf4\x27\xe6\x55\x7f\xc4\xe9\xf0\x88\x3b\xdd\x4b\x89\x11\xe9\x04\xa9\x66\x93\x07\x53\x8c\xf7\xe8\x04\x28\xc3\xed\xe1\xcf\x0d\xde\xca\x29\xd5\x2c\x53\x3f\x88\x1f\x87\xec\x10\x6a\xc5\x69\x61\x0f\x80\x44\xd0\xc2\x8a\xed\x06\xb5\x39\x44\x7f\x93\x62\x22\x9a\x23\x83\xe1\x4e\xfa\x3b\x63\xfc\xa7\x3b\xf4\xc1\x2e\xa2\x7a\xde\x90\x9c\x1f\x8b\xf0\x4e\x15\x27\x73\xf9\xc1\x66\x73\xca\x27\x0c\xcc\xd8\x8f\x51\xbd\x7c\x62\xa7\xc6\xb0\x22\x9d\xf9\x22\x57\x88\xf5\xe4\x0d\x4a\xa3\x55\xb4\xe7\xeb\x65\x3b\x43\x63\x6f\xea\x8d\x9d\x57\x81\x33\x79\x85\x3d\x94\x11\x3b\x8b\x0a\xaf\x0e\xea\x0d\x75\xee\x9d\xf4\x2c\xe5\x67\x0c\x2b\x54\xbf\xd9\xc8\x73\x19\x61\x61\x3a\x18\x42\x13\x04\x03\xc2\xf7\xdb\x38\x12\xad\xe1\x35\x64\xfb\x10\x44\x58\x35\x23\xb0\x39\x0a\x0c\x5e\xe9\x30\x82\x27\xf5\x6c\x1d\xf7\x01\xc2\x95\x2d\xb4\x36\xa0\xfa\x96\xd1\xf3\x0b\xa5\x51\xae\x2f\xc0\x90\xac\xbe\xc8\x52\xfe\xe1\x3c\x25\x2f\x19\x3d\xd7\xb6\x3e\xab\xf2\x29\x5a\x06\xe1\x7b\x1a\xd5\x74\x55\x3e\x35\x26\x40\x53\x7c\x9e\x92\xdf\x18\x3d\x7f\xbf\x2a\x9f\x5e\x8c\xd0\x32\x58\x85\x97\x2f\x5f\xbc\x7b\xb1\x0a\xeb\xc9\x04\xd7\xf2\x41\xb4\x8a\xe4\xf5\xe7\xab\xf2\xe9\x13\xd7\x29\xea\xf7\xae\x8e\x57\x45\x51\x93\xa4\x06\x78\x7a\x74\x1c\xce\x4f\xb8\x21\xe0\x3c\x51\xc8\x72\x10\xc8\xd7\x84\x82\x43\x1e\x18\x7b\x78\x38\x9c\x45\x75\xed\x84\x0e\xfb\x9a\x75\x7c\x38\x01\xc4\x91\x42\xaa\xa7\xb2\x22\x8e\xbd\x73\x6f\xac\x59\x53\xa7\xa6\xaf\x9c\x9a\xc0\x97\xea\x5c\xd9\xd9\xb6\x1a\x76\x9b\xb8\xe7\x13\xbc\x64\x2e\x87\x6b\xdd\x64\x02\xc3\x83\x1f\xb5\xea\xb6\xf4\x1d\x1b\x94\x7b\x2c\xb4\x04\x42\x74\x43\x80\x77\x65\xdf\xa8\x34\xda\x32\x6c\x65\xde\x16\x18\xad\x10\x52\x1c\x09\x21\x49\xa9\x83\x70\xce\x48\x41\xcb\x30\x8d\xfa\x56\x38\x1d\xc5\x2d\x49\x89\x2c\x13\xf2\x08\x2f\x7e\xe8\xb6\x9f\xd3\xd6\x0f\x0b\x93\x98\xba\x21\xcb\x72\x4c\xb4\xeb\x0c\x81\xbc\x57\x76\xc4\xdf\x33\x64\x35\xf2\x05\xbd\x41\x3a\xb6\x99\xc4\x04\x56\xe0\x0f\xc6\xa9\xd6\x4f\x64\x4f\x37\x93\x39\x49\x28\x28\x1c\xb7\x74\x87\x12\x10\xf7\x6f\xeb\x7a\x7e\xb1\x19\x70\xb6\x94\xa7\x03\xd7\xe4\xcd\xf7\x5f\x6a\xea\x98\xb4\x27\x99\xde\x2e\x72\x72\xd1\xb2\xdf\x11\xc3\x8b\xad\xef\x83\x86\x93\x26\x6e\xc0\x4e\x22\xc0\x28\x05\x61\x8c\xc9\xf7\x0c\x69\x87\x2e\xdc\x40\x87\x36\x60\x7d\x8f\x18\xbd\x67\xa8\x20\x3c\x9c\x45\x3d\x4b\xd5\xd1\x9c\x70\x92\xe3\x4e\x90\x43\x75\x8c\x69\x0d\x55\x5a\x19\x37\xa3\xf2\x74\x50\xd7\xb9\x16\x2c\x95\x14\xc5\x3a\x3a\xa0\x92\x2d\x5b\x03\x91\xaf\x99\xcd\x1d\x62\xcd\x47\x2b\xca\xc8\x7a\x44\xe9\x1e\x94\x22\x57\xca\x12\x0f\x55\x64\x34\x83\xac\x5c\xa5\x3c\x89\x28\x43\x99\x98\xdc\xca\x17\xb6\x3a\x8c\x8d\x83\x0c\x0f\xd7\x11\xa9\xc8\x1a\x46\xa7\x80\x26\xa3\x71\x18\xb7\x01\x95\x7b\x03\x54\xbd\x8b\xc9\x57\x0c\x13\x65\xcb\x5a\xea\xce\xc4\xb2\x2a\x63\xa9\x52\xb9\x96\x2a\x23\xeb\x0c\x53\x75\xed\xba\x64\x0f\x2d\xc1\xcb\x48\xa5\x84\x93\x65\xb1\xf6\x7d\x4f\x99\x70\x7a\x23\x90\x5e\xb6\x9b\xb2\x9b\xe7\xe1\x6a\xfa\x2b\xbb\x8d\xb3\x1f\x8b\x0c\x64\xaf\x3c\xff\x06\xbe\x92\xf5\x9a\x17\xaa\x42\x72\xe0\x39\x5f\xb3\x40\x96\xe1\x6b\x56\xd7\x55\x0f\x5b\xc0\x63\x0f\x37\x24\xc3\xc1\xb5\x6c\xb1\x35\x04\xb2\x7e\x9b\xbf\x31\x48\xfa\x50\x91\xac\x95\x8d\x3a\x06\x70\x6f\xd9\x51\x22\x3e\x92\x52\xb1\x74\x22\x34\x32\x1c\x30\x92\xd3\xd9\x42\xe7\x06\x2a\x54\xaa\xbb\x45\x3e\x1e\x63\x5e\xd7\xf3\x91\x1b\xa6\x1d\xd8\x81\x8c\xc5\x1c\xb6\xe3\x2d\xb8\x7f\x92\xa2\x93\xda\x17\x71\xb0\xf6\x2f\x54\xda\x11\x59\xc4\x59\xe4\x4e\xd9\x8e\x7d\x73\x81\x3b\x66\xc5\xd6\x8b\xd3\x35\xc6\x1a\x94\x4b\x41\x10\xe1\x23\x76\xa0\x93\x3b\xd3\x6e\xf0\x9e\x69\x28\xd9\xd0\xd4\xf8\x81\x8f\x50\xdf\x96\x54\x8d\xdd\x75\x03\x98\x77\x1f\x28\x57\x63\x95\xc0\x01\x31\xac\xd0\x20\x98\x55\xad\xb1\x8e\x5e\x8c\x72\x79\xeb\x6c\x14\x9b\xf8\x90\xe6\x61\x11\x11\x09\xa2\x85\xb1\x6b\x24\x6d\xb6\x0d\x94\xd1\xea\x54\xc2\x0d\xdf\x37\x72\xa5\x52\xb3\xdd\x95\x21\x99\xb4\x34\x57\x81\xae\x4b\x39\x29\x78\xc6\x64\x16\xee\x41\xe4\xef\xda\xc2\xd2\xb2\x73\x0b\xf3\x21\x20\x18\x32\x56\xb2\xe5\xbc\xae\x61\x18\x04\xec\xf2\xdc\x01\xe6\xfd\x81\x7d\xc7\x10\x8c\x2c\x86\x50\xde\xa0\xd8\x02\x6a\xb3\xb6\xcb\x3b\xbb\xd0\x93\xe4\xc0\x85\xc5\x3f\x0f\x12\x33\x8c\x36\xbe\xdf\x45\x36\x90\x5d\xc9\x02\xde\x51\x32\x68\x47\x91\xd5\xd7\x2c\x01\x68\xb7\x5a\x0c\x4e\x99\x85\x6e\x30\xc2\xe0\x58\x07\x4a\xe7\xe1\x2f\xad\xd3\x37\x3c\x32\xe4\xad\x0d\x00\x60\x9e\xa4\x61\x11\x2d\x7b\x0c\x90\xe4\x40\x83\xae\xc6\x0f\x8c\xb4\x8c\xc6\x6f\xe1\x36\xa0\x39\xad\x86\x87\x3f\x38\x41\x00\x90\x7b\x6b\x98\xb1\x63\x56\x39\x81\x34\x3b\x43\x9b\xe1\xad\xe5\xc3\x1c\x77\xd0\x47\x0b\xe2\x81\xb4\xd0\xba\xcc\x80\x03\x6c\x3f\xee\x00\x5b\xaa\x9c\x26\xca\x7e\x5e\x1b\xdd\x43\xc2\x6c\x7c\xc4\x2f\xce\xb5\xee\xa3\xbf\x9f\x7a\xcf\x3e\xeb\x3f\xaa\x6b\x25\xe4\x73\xcd\x20\x3b\x1e\xb1\x47\x9a\xca\x86\x28\xa3\xc6\x81\x23\xde\xf7\xac\x77\x2a\xe9\x0c\xef\x7f\xef\xe2\xef\x76\x3e\x3b\x06\x95\xaa\x9f\xfb\x82\xfd\x4f\xdd\x31\xdc\x58\xb7\xa9\xf9\xc0\xb3\xcf\xfa\x8f\x0c\x5f\xd1\xf6\x6b\x21\xa6\x29\x2f\x59\x21\xbe\x00\x79\x32\x44\x51\x73\xc3\x18\xcb\x8e\x2a\x51\xf3\x7f\xdd\x4f\x68\xd9\x25\x01\xbd\x07\x47\x0d\x2b\xfb\xac\x86\xc4\x1b\x31\x78\x10\xff\xbf\xdf\x5c\x27\xf7\x8c\x6c\xfa\x28\x1a\xa9\x0d\x61\x0f\xaa\x5d\x4d\x0b\x95\x18\x2d\x14\x11\x06\x95\x73\x3f\x69\x0d\xea\x11\x43\x06\x59\x32\xe4\x39\xb7\x63\xb2\xdb\x3d\x8e\x0d\x90\x2b\xc7\x16\x55\x4b\x63\x7c\x9f\xe9\xc0\x37\x94\x8a\x25\x0b\xb4\xd0\x57\xf2\x39\x83\x4e\x6e\xc0\x66\x19\x66\x11\x06\x28\x09\xe7\x5f\xdc\xd5\xad\x82\x04\xce\x36\x4a\x87\xd8\xcd\x83\xd2\x09\x11\xc0\x54\x50\x09\xe7\x94\x60\xc3\xdc\x58\x63\xea\xc1\xd0\x2a\xcc\xf7\x47\xdf\x3a\x69\xc9\x46\x37\x2c\xb4\xc6\xdb\xec\x31\xe3\xed\x08\x1f\x18\xed\x9b\x66\x4b\xa0\x2e\x1e\x60\xe5\xec\x21\x02\x54\xcb\x7a\x30\x5c\x2b\xa5\x4f\x2f\x98\x50\x0b\xe6\xf4\x9b\xca\x4a\xe9\xcc\xb5\xf5\x15\x1a\xc2\x0c\x62\x53\x1b\x1c\xb4\x5e\xa7\x31\x90\xe6\xcf\xba\xc1\x62\x8d\xab\x76\x68\x03\x85\x3c\x0e\xe9\xed\xc2\xb8\x51\xe9\x9d\x88\xbd\x4a\x1e\x73\x31\x1b\x18\x19\xa4\x92\x51\x99\x3c\x74\x5f\x34\x4a\xd2\x59\x66\x1a\xc2\x3b\x11\xf1\xd5\xa8\xde\xe5\x81\xa7\xae\x3c\x83\xb6\xe4\x23\x7d\xe9\x11\x77\x6b\x05\x9e\xc2\x17\xe6\xe9\x0b\xd8\xcd\x1e\x6c\x6a\xcf\x4c\xc0\x8b\x2c\x0b\x3c\x67\x32\xba\xa2\xad\x58\x07\x25\x67\x11\x3d\x41\xd2\x69\x18\x91\x82\x42\x58\xa7\xd4\x26\xc8\x9b\xcc\x81\xaa\xe7\x17\x34\x05\x42\x2e\x68\x0e\x71\x45\x5a\xe7\x6f\xb5\x21\x24\x8b\x77\x85\x0a\x49\xf0\xc3\x38\x42\x02\x93\xca\xc6\xea\x14\x2a\xed\x40\x67\x6f\x0e\x86\x19\xff\xa6\xef\x2a\x6c\xdc\x83\x97\xa3\xfd\x3d\x56\x3e\xc2\x63\xe3\x22\xfc\xa6\x6b\x24\x69\x04\xb2\x9d\xf3\x8a\xe5\xb5\x52\x76\xe7\xa4\x18\x10\xd3\x7c\xcf\x38\x2b\x20\x5c\xd2\x25\x56\x1d\xbc\xcc\x77\xfb\x4a\xb0\xe4\x4a\x39\x77\xe2\x86\xfc\xc4\x4e\x65\xaf\x21\x39\xd5\xc6\x33\xa9\x8a\x56\x94\x87\x69\x64\x3c\x71\xc3\x34\x22\xed\x25\x15\x61\x1a\xb5\x45\x0b\x6a\xec\x97\x30\x11\xd8\x29\x26\x6b\x68\x73\xe8\x93\xd7\x9d\xa9\xe0\x4c\x9b\x85\xd5\x1e\x86\xd1\x3b\x81\xe6\xd8\x20\x17\x6e\x3a\xa3\xab\x54\x99\x75\xde\x00\x7f\xec\xfb\xc8\x83\x83\x55\x4c\xb9\x1c\xb8\x51\x67\x03\x43\x0a\xf1\xb3\xb9\x44\xc3\x75\x0d\x0c\x3b\x98\xa5\x3a\x2e\xaf\x18\x93\xd1\xc3\x74\x9f\xde\xb3\xec\x8b\xfc\x1e\x26\xab\x44\xd8\xf7\xbf\xd1\xc8\x26\xc6\xbe\xff\xda\x28\x63\x55\x6c\x91\x72\x0a\xe9\xfd\x20\xf6\xe2\x2e\xe5\x3f\xc1\x4d\x2e\x6f\xe2\x7b\x75\xd3\x3e\x77\x9e\x9a\xef\x68\x4c\x64\x4f\xef\x74\x49\xf5\xac\x70\xbf\x49\x89\xf3\x55\x8e\x9d\x00\x4b\xf1\x32\x1e\x7b\x5e\xe0\xe4\x6b\x7f\xd2\x11\x57\x1d\x3a\xf1\xa4\x94\x92\xb4\xcd\xb4\x67\x73\x66\x50\x81\x4f\x04\xe7\xd5\xb6\x65\xa6\x60\xd3\x34\x23\x97\xd2\x99\x56\x99\xaa\x3b\xc3\x87\x4a\x7b\x79\xae\xcb\xf2\x1d\xbb\x17\xd4\xdb\xeb\xbc\x8c\x41\x7c\x0d\xd1\x69\xd9\x22\x63\x1b\x11\x4c\xe6\xf2\xbf\xfd\xfd\x02\xc6\x1b\x7c\x3a\xdb\xdf\x2f\x76\x71\x71\x93\xf2\x89\xc8\xf7\x81\x7c\xb3\x8f\x93\x24\xe5\x37\xc1\x6c\x71\x9d\x17\x09\x2b\x82\x99\x47\xb2\x93\xd5\x9b\xf4\x9b\x0b\xed\x5f\x1a\x80\x4f\xeb\xe2\x3a\xbf\x9f\x94\xe9\x1f\xb2\x1e\x55\xcb\xe4\x3a\xbf\x5f\xe4\xb7\xac\xd8\x64\xf9\x5d\x50\x42\x90\x3c\xdd\x72\x10\x57\x22\x37\x8d\xb9\x3d\x70\xfb\xf9\xf7\x05\xf4\xef\xef\x12\x2f\x75\x38\xb4\xaa\xcb\xb0\x65\x5a\x2c\x44\x2f\x8f\x77\x5f\x86\x17\x9c\x7a\xf3\xbf\x6b\x63\x9c\x7c\x4f\x4a\x3a\x7f\x26\x09\x21\xc4\xd7\x97\x7d\xf9\x9a\x6d\x04\xb6\xc3\x2d\xd2\x9b\xad\xa0\xde\xa7\xb3\xbf\x7b\x24\xa7\xcf\x3f\xd5\x45\xe1\xb1\x3c\x3f\xd9\x27\xd0\xcb\xf6\x3b\x33\x3b\xd4\x33\xb3\xef\x91\xd4\x34\x95\x4d\x95\x76\x09\xe0\xea\xfc\x39\x96\x03\x72\xcf\xd1\x15\xd6\xd1\xfa\x1c\xe1\x97\x70\xe8\xbf\xca\x1d\x9c\x57\x3c\x41\x10\x65\xe9\x75\x96\xc7\x90\x2e\xa7\x39\xf2\xd9\x3e\x76\x26\x05\x97\x2b\x92\x9d\x78\xb1\xc8\xac\x03\xb7\x19\xca\x75\xbc\xfe\x70\x03\xad\x5d\x66\xe9\x9e\x7a\x3a\x71\x85\x5c\x4e\x09\x16\x5d\x47\xcd\xe1\x4f\x3c\xf2\x00\xa4\xad\x80\xb3\x39\x2c\x45\xb7\x1e\x30\x99\x1a\xfa\xb6\x8d\x62\xf3\x40\x0e\xd7\xf9\xfd\x15\x40\xd4\x5b\x96\xa5\x27\x02\xcc\x33\x84\x49\xd1\x90\x2e\x1e\x39\x51\x2e\xd7\xe5\x4c\x06\xd3\x13\xc5\xb8\x64\x05\x54\x83\xdf\x58\x10\x39\x51\xb6\x6c\x88\x82\x6c\xd5\xd7\x53\x3d\x4c\xdb\x2a\xdf\x15\x2f\xd3\x1d\xe3\x65\x9a\xf3\xf2\x48\x05\xac\xb4\x1f\x5d\x2d\x60\x0c\x12\xbe\xa3\xe5\xd3\xa2\x71\x22\x06\x5e\x15\x1e\x26\xfc\x14\x28\xb0\xff\x12\x71\x78\x10\xbb\x59\x7e\xa1\x72\xac\x52\x0f\x1e\xf2\xde\xc3\xcf\xe4\xc3\xde\x4e\xed\x1d\xad\x44\xf7\x96\xcb\x0d\x35\xb0\x69\x05\x26\x31\x7d\x7e\x01\xa0\xfe\x86\x0b\x54\xe8\x36\x8e\xf6\x0d\xc3\x98\xc4\x4d\x83\x71\x83\x14\x0e\xf8\x95\xd1\xd0\xfb\x89\x5d\x7f\x48\x85\x47\xbc\x6f\xf2\x3f\x3c\xe2\xed\x4a\x2f\x22\x7f\x0c\x4c\x20\xcc\x86\x1a\x04\xf9\xb1\x1b\x16\xe1\x67\xe6\xa4\xd6\x92\x13\x25\x49\x5b\x19\xb2\xa8\xae\x7f\x64\x21\xeb\xc4\x49\x64\x92\x1a\xff\xc1\x96\x2c\x80\x57\x43\xbc\x04\xe4\x16\x76\x83\x49\x8e\x59\xeb\xf9\xc2\xe9\xaf\xec\x38\x58\x9e\x0a\x53\xfe\x2b\x0b\x79\x34\x16\x18\x5a\xb0\x86\x6a\x0d\x50\x53\xa6\xa3\x4b\x82\xa7\x1d\xcf\x39\xab\x01\x22\xd0\x72\x34\x59\x87\x2c\x8e\xf0\x74\x8c\xcf\xc9\x97\xf2\xf5\x64\x72\x4e\x7e\x61\xf4\x60\x17\xda\x41\x52\xb7\x69\x99\x5e\xa7\x59\x2a\x1e\x02\x6f\x9b\x26\x09\xe3\x1e\x31\x88\x5d\x47\x2b\x68\xc8\x0f\x8c\x1e\x32\x26\x04\x2b\xae\xf6\xf1\x5a\x22\x6a\x6f\xe6\x91\x4d\xce\xc5\x4f\xb0\x32\x81\xf7\xf1\x6c\xe6\x39\x13\xf8\x0f\xd6\xcb\x33\x69\xec\xe4\x2d\xef\x56\x2c\x01\xab\xed\xe2\x7b\x34\x23\x45\xf8\x2c\x9a\x20\x5e\xd7\x33\x8c\xc7\xa8\x80\x30\x1f\x10\xd3\x23\x10\x2d\x46\xfc\xe7\x90\x93\x1e\xf5\x54\xc6\x5f\x88\xd7\x38\x0f\x66\x44\x45\x41\x9d\xa9\x24\x80\x94\xa2\x62\xe9\x29\x3a\xe3\x05\x06\x03\x79\x56\xb6\x3f\x03\x7e\x6a\x11\x5f\x7c\xbc\x88\xc7\xf4\x19\xf6\x14\x49\x30\x01\x2b\xaa\xb1\x8d\x79\xc2\xc7\x9c\x85\x31\xc4\x46\x4d\x31\x26\xc5\x12\xd9\xda\x4c\xe1\x49\x1b\x20\x45\x93\x33\xaf\xfb\x91\xa9\x7d\x74\xfc\x81\xee\xa2\x2a\x3f\xf6\x7e\x52\x59\x8c\xd5\x77\x10\x02\xfe\xf1\xba\xdb\xa7\x10\x0b\xd2\x2d\xfe\x48\xcd\x41\xf9\xd7\xca\x99\x35\x1b\x15\xbe\x3f\xbb\x00\x0f\xc1\x6a\x4c\x9d\xe5\x83\xcb\x35\x4b\x33\xc4\x42\x4f\x91\x3a\x6f\x2c\x8e\xa1\x5e\x58\xa8\x8f\x26\xf9\xa4\x9a\x94\x93\xe9\x27\x18\xcb\x55\x27\x55\xbb\xce\xff\xee\xc1\x0e\x30\x9b\x24\xa5\x68\xf4\x30\x3d\xa2\x08\x48\x72\x99\xd8\xf7\xbd\x96\xe7\xe8\x84\xaa\xb1\x1f\x78\x64\x34\x27\x05\x26\x39\x4d\x49\x4c\x35\xb3\x5b\x60\x52\xd2\xbf\xd6\x63\x09\x50\x2d\x6b\xda\xcb\x28\x1d\x2f\x62\xea\x49\x9e\xc6\x33\xc9\x0f\x87\xfb\xea\xfb\x69\x5d\x8f\x1e\xa6\x43\xb4\x00\x61\xe5\x1c\x0f\xd8\xbb\xae\x55\x75\x94\xd2\xb8\xae\x47\x0e\xd5\x97\x6c\xb1\x97\xf2\x2c\x3d\x11\x93\x47\x0d\x13\xb2\x0c\x48\xc4\x0a\xb6\x2e\x6f\xd9\x5a\x94\x08\xbb\xc1\x01\xff\xf2\x7c\xa1\x9c\x96\x4a\x65\x0d\x8e\xa1\x10\x31\x05\xfc\xcd\x3a\x7d\x92\x6b\x38\x36\x1b\xb4\xae\x51\x3a\xb4\xeb\x48\x4e\x0a\x12\xe3\xb1\xdc\xd9\xed\x72\x9b\xf0\x90\xca\x9f\xc7\x31\x1f\x64\x8e\xad\x1a\xa4\x00\x75\xca\x39\xda\x86\x75\x59\xaa\x00\x6b\x87\x5c\xe2\x26\xf1\x10\x1c\x8e\x63\xbb\x82\x70\x5c\x9f\xe4\x61\xed\x3d\x5d\xd8\x0a\x7a\x20\xc4\x0c\x5f\x7a\x73\x2f\xe0\x60\x4d\x69\x83\x07\x05\x87\x98\xa7\x3b\x30\x90\x7a\x23\x58\x01\x17\x60\x5d\xae\x4c\x92\xb2\x6a\xd7\xde\x6e\xd2\x2c\xfb\x4e\x77\x43\xde\x66\xec\xfe\xcb\x22\xbf\x33\xd7\x57\xdb\x22\xe5\x1f\xe0\xae\xc5\x9d\xa3\x19\xb9\x29\xd2\xe4\x45\xc1\x62\x73\x7d\x09\xb5\x76\xef\x5e\xf1\xa4\xfb\xe0\x4a\xc4\x85\xfd\xfa\xad\x6a\x44\x5f\x3a\x65\xdf\xe6\x77\xb6\xa0\x04\x9a\xaf\x6c\xa3\x79\xdb\x4f\xc5\x88\xc3\xc5\x7e\x1b\x2b\x8b\xa9\xbb\x34\xc9\xef\xe0\xea\x8f\x37\x90\xcc\x50\x5e\xe5\xf9\x4e\x99\x07\x6b\x92\x18\x1c\x1a\x02\x14\x74\xc0\xb2\x43\x99\x68\x3c\xef\xe9\x63\xfe\xdf\xde\xbd\xe6\x46\x9c\xa0\x51\xa4\x84\xc8\xb2\xa4\xa2\x5f\xda\xe3\x1f\x18\xf2\xab\x73\x68\xba\x41\x15\x9c\xb7\x7f\x66\x10\x92\x27\x56\xc0\x0b\x30\x00\x71\x9e\x9c\xdb\xd2\x09\x3f\xd1\x6e\x56\xdf\xf7\x6e\x98\xf0\x52\xb8\x6c\xd5\x0c\x29\x8d\xb5\x47\xa9\xda\x42\xcb\x34\xc8\x42\x11\x2d\x5a\x91\x18\x45\xb9\x75\xbb\xc6\xb0\x8d\x0c\x5d\xe3\x18\x72\xb3\xcc\x41\x21\x40\x75\x2c\xac\x54\x62\x1b\x8f\x03\x10\x49\x86\x0c\xc4\x84\xdc\xf7\xb9\xc2\xff\xe6\xcd\x88\xd2\xbc\xae\xe5\x98\xf8\x98\xa6\xb2\x9a\xa3\x28\x57\x65\x1b\xe5\x0a\x1f\xb3\xd6\x75\x0d\xc7\x70\x49\x3e\xc1\x44\xc2\xc6\xe5\x6c\x59\x6b\x89\x51\x90\x1c\x0d\xf5\x52\xbe\x65\x45\x2a\xb7\xa3\x9c\x88\xb2\x37\x11\x14\xf4\x2d\xb1\xf6\xae\x86\xc4\x1b\x75\x8d\xaa\x65\x26\x9f\xb4\xf6\xea\x84\x63\x98\x1c\xca\x21\x67\xc8\xfa\x28\xde\x71\x27\x0c\x98\x5e\x51\x43\xfa\xdb\x65\x75\xd7\x11\x3d\xbe\x90\xb8\xb3\x6a\xee\x62\xcd\x20\x61\x9d\xed\xbe\x8a\xfd\x68\x11\x3c\x84\x60\x2a\x76\x71\xa6\xe3\x42\x0a\x89\xcc\x7e\x60\x2a\x42\x2a\x64\x5e\x55\xd1\xa5\x78\x5d\xf3\x25\xca\x5d\xb4\x96\x62\x02\x61\xe9\x79\x5d\xa7\xe5\x6b\x89\x81\x18\xca\xf1\x32\xaf\xeb\x59\x90\xe2\x20\x75\x44\x71\xa1\xa7\x58\x54\x8f\x68\x7e\xa4\x93\xb0\xad\xc2\x07\x67\x2c\x55\x44\x8f\x30\x94\x4e\xdf\x6f\xd3\x08\xff\x4b\x4f\xd1\x71\xc8\x35\x13\x0f\x6e\x18\xb3\xc3\xab\x2f\xe4\x8a\xa7\xfc\xa6\x2d\x82\xb0\x3a\xaf\x2e\x81\xb6\x56\x72\xf5\x7e\x92\x57\xbf\x74\x02\x5b\xea\xe5\xb1\x65\x1a\xfc\x78\x5c\x6e\x45\x98\x73\x3a\x7a\x98\x76\xce\x40\x92\x92\xb5\x1c\xa6\x9c\x76\x7b\x3c\x26\x31\x45\xf9\x7f\x43\xb1\x53\x49\xa1\xf9\xf2\x9f\xba\x4f\x24\x96\x7c\x8b\x8d\x1d\x1c\x43\xd4\x4d\x54\x4e\xe8\x20\x0b\x52\x1d\x13\xf4\xca\x61\x41\xdc\xa5\x0e\xab\x08\x4f\x74\x2b\x86\x74\xa9\xf6\x81\x47\x21\x25\x08\xa0\x5a\x36\x56\x0e\x40\xc5\x93\x73\xd9\x55\x30\xd7\x57\x92\xb8\x2a\x02\xeb\x2c\x33\xac\x0a\x63\xf2\x0f\x86\x66\x44\x90\xd2\xa8\x07\x0d\x44\x38\x82\x08\xfa\x84\xa1\x96\x3d\x68\x4f\x9f\x64\x80\x9c\x69\x4e\xc3\x19\x85\xa2\x6c\x6d\x6d\x2d\xb4\x0c\x82\x84\x3c\xe5\x4d\x00\x0e\x0e\xed\x37\xc1\xac\x19\x80\x89\xc7\x2b\x69\xb0\x26\xea\x8e\xb9\xa5\x92\xf3\x78\x1e\x31\xa2\x1d\xcf\x23\x5a\xe0\xa3\x99\x4b\xa7\x1d\xe0\xe7\x9d\x2d\x92\x8e\xf3\x88\x1e\x94\x56\x75\x58\x71\x4c\x67\x2a\x59\xe2\x40\x2e\x45\xb6\x64\xc6\xe9\xf6\xcc\xc3\x81\x3c\xae\x89\x8b\x8f\x41\x19\xc4\xc3\x54\x32\xb8\x22\x92\xf5\xab\xa8\x80\x45\x28\x26\xcf\xe0\xd7\xc9\x84\xdf\x34\x2e\xb3\x9e\x9a\x60\x82\x6d\xe7\x24\x36\xa4\xff\x80\x9c\x17\x1d\x35\x6f\x1f\x11\x9e\x56\xe1\x1c\x8b\x81\x49\xac\x8e\x2d\xfd\x98\xeb\x6a\xd4\x2d\x1f\x6c\xf3\x3b\xc7\x17\xe9\x22\x1e\x8f\x71\x1e\x8a\x30\x8e\x22\x0b\x6b\x02\xce\x05\x92\x86\xd9\x34\x2c\x4d\x3f\xde\x31\x5f\xba\xd1\x07\x21\x89\xa4\x09\x83\xd8\x80\x11\xe6\x71\xcc\x62\x50\x3d\x20\x74\x35\x7d\x77\xc7\x18\xa7\x4c\xe0\x53\xbe\x04\x4c\x10\x89\x2d\x07\x1c\xb6\xe5\x3a\x2b\xc5\x4c\xc6\x76\xc6\xeb\x63\x5f\xe4\x7b\xca\x8d\xc5\x62\x99\xf2\x1b\x9a\x4a\xec\xaf\xae\xdb\xb8\x3f\xca\x66\x12\x22\x2d\x95\x54\x18\x0b\xf6\xb8\x10\x46\x8f\x7a\x47\x8d\x27\x86\x31\x70\x67\x3c\xa1\x85\xba\x84\xd0\x88\x79\x8f\xb0\xf2\x96\xb0\x36\x64\x5d\x15\xc7\x36\xf9\x8a\x0b\xdd\x6b\xaa\x64\xba\x6b\x41\x85\x69\x9c\xab\x42\x69\x69\x9d\xba\xfb\x8d\xed\x7e\xfb\xbe\x21\x45\x75\x9c\x72\x08\x4c\x7d\x1f\x6f\xcc\x9d\x80\x69\x52\x29\x56\x54\x67\x53\xce\x4b\x2a\xb1\x8d\x9a\xb3\xd0\x99\xcb\xc8\xa8\x53\xfb\x1f\x3e\x65\x64\x46\xe6\xc3\xef\xb4\x5d\x80\xaa\xd5\xa8\x63\xf3\x3b\x8a\xcc\xac\x4e\xda\xd9\xc7\x4f\xc5\xb8\xbd\xeb\xd6\x57\x0a\xb6\xd7\xaa\x38\xf7\x51\x6b\x28\xa7\x5c\x4c\x4d\xfd\x26\x87\xb9\xef\x73\xb9\xc3\x96\xdc\x46\x19\x39\x35\xa9\xf6\xbd\xca\x3d\xd3\x60\x38\x29\x38\x80\xe9\x9e\x22\x08\x72\x2b\xa1\x07\xeb\x16\xd6\xa3\xc7\x26\x6a\xb8\x09\x8b\x03\x2c\xab\xec\xa8\xa3\xbe\x37\x26\xf8\xf2\x71\xc8\xd4\x3a\x99\x08\x3e\xba\xb0\x22\x04\xfa\xdd\xb2\x5b\x34\x40\x96\x36\xa8\x29\x50\xcf\x89\x07\x71\x75\xd5\xe1\x6f\x04\x39\x28\x24\x42\xee\x7b\x11\x5e\x4d\x37\xf7\x30\x91\xb6\xf6\xa3\x27\x88\xe1\x60\xb0\xe3\x23\x07\x95\x3d\xd6\xef\x9f\x99\xca\x15\xb8\xc7\xfd\xbe\x03\xff\x7e\x17\x58\x04\xd2\x19\x00\xbc\x1b\x33\xd8\x6e\x40\xe8\x34\x6f\xf0\x2e\xdf\x77\xc0\x5b\x3f\x06\xa2\x77\xe8\x8f\xaf\xd7\x69\xb9\xc7\xe0\x41\xc7\x66\x6d\xa8\x53\xc6\x81\x05\x90\xc8\x41\x9e\x77\xe2\x53\x16\x68\xe5\x9d\xa4\x4a\xc7\xef\xa6\x9f\x4c\x14\x33\x91\x97\x88\x3d\x85\xcb\xef\xdf\xe0\xf3\x67\x8e\x17\xa1\x07\xdf\x7a\xb2\xa9\xcd\x3d\x3d\x3a\xa7\x12\xbb\x18\xf4\xa0\xb2\x46\x08\x41\xb8\x20\x85\x20\xa9\x20\xb9\x50\xe1\xb4\x54\xd0\xcc\xba\xdc\xe6\x77\xf5\x36\x4d\x18\x7e\x72\x4e\x62\x41\xcf\xdb\x10\xcb\x4f\x9c\x70\x59\xa5\x40\xf8\x00\xfe\x80\x60\xe7\xfd\x6a\xaa\x64\x75\xbe\x7f\x39\x2d\xd8\xef\x15\x2b\xc5\x0b\x73\x48\x7d\x5d\xc4\x3b\xb6\x3c\xf1\x1c\x95\x02\x07\x9d\x4c\x45\xa5\xee\x2f\x38\x32\xdc\xc6\x19\x56\xb7\x22\x5d\x7f\x40\xd8\x09\xbf\x54\x89\x96\x1d\x38\x99\xd3\xca\x98\xa3\x37\x98\x08\x41\x5b\x8f\xb4\xb6\x9a\x4c\x74\xe3\x5c\xcf\x48\x4a\x0f\x8a\x79\x0e\x98\x52\x85\x0a\x25\xca\x5b\x14\x17\x1f\x2f\x8a\x31\x7d\x36\x11\x38\x0d\x0d\x3d\x1e\x23\x4e\x39\x0b\x8b\x08\x47\x34\x0d\x1d\x69\x58\x44\xdd\xe4\xef\x28\x9d\xea\xf3\x2d\x4d\xb5\xa6\x4f\x12\xcf\xb6\x1f\x6b\x31\x64\x65\x89\x36\x62\x2a\x24\x79\x63\xff\x7f\xde\xbe\xff\xb9\x71\x1b\xd9\xf3\x5f\xb1\x70\x73\x2c\x60\x04\xcb\xf2\x24\x75\xf5\x8e\x1a\x3c\x56\x76\x32\xb3\xc9\x56\x26\x93\x8d\x9d\xec\x6c\x69\xb8\x29\x5a\x82\x6c\x26\x14\xa9\x05\x21\xd9\x5e\x53\xff\xfb\x15\xba\x01\x10\xa0\xe8\x49\xf6\xbd\xbb\xfb\x61\xc6\x22\x08\x82\x20\xbe\x34\x1a\x8d\xee\xcf\x47\xe1\x1e\x64\x99\xb3\xd9\xaa\xa9\x57\x85\x8e\x6e\x91\x97\x24\x37\xaa\xef\x9c\x17\xa2\x74\xeb\x71\xf3\xba\x70\x3e\x6a\xe8\x90\x69\x7d\x63\xb9\xe6\xd2\x9b\x1c\x55\x5f\x85\x8d\xa6\x0d\x0f\x1a\xa3\xb0\xcd\xb1\x31\x03\xca\x3a\x4c\x04\x48\xdf\x21\x1a\xf8\x0c\xa9\x4d\xc3\xb6\x77\xe0\x14\x30\x2b\x8e\x66\xfb\x1c\x1f\x47\x16\x6e\x6f\x71\xb9\xf0\x7e\x33\x42\xeb\xae\x33\x1d\xcb\x75\x68\xcf\xab\x50\x94\x9b\x0e\x9e\x56\x7e\x4d\x38\x97\x8c\xd7\xe2\xf2\x9c\xea\x8b\x3e\x11\x8c\x77\x58\xed\x0a\x9b\xa7\x1d\x7a\x17\xba\xf4\xa5\xca\x67\x6a\x5f\xd3\xba\x47\x06\x0f\xb8\x13\x69\xc3\x97\x15\xaf\xb9\xd9\x90\xd5\xaf\x2f\x93\xa4\xcc\x74\x4a\xcb\xae\x3b\xcd\x74\xc9\xe7\x39\xe3\xed\x10\xe8\xbc\xca\x19\x9f\x5c\xb2\x23\xaf\x44\xeb\xe1\xc6\x31\x0c\xbd\xe1\x3b\xb0\x56\x84\x6e\xe7\x66\xeb\xb2\xd3\x41\xda\x64\xce\x9f\xac\x03\xe2\x5b\x10\x20\xa9\xc9\x85\xbf\x4e\x94\x91\x23\xd7\x8c\xbb\x50\x3d\xbb\x2f\x2e\x65\x9b\x4a\x9f\xf8\x01\xd7\xbb\x54\x73\xdf\x98\xa9\x6f\x6e\xd7\x7c\xa9\xf6\x2d\xc9\xb1\x95\xd2\x65\xce\x2d\xa4\xa0\xb9\x1e\xa5\x0d\xb0\x2a\x18\x6d\x78\x65\x96\x55\x8c\x9a\xc1\x9f\xb3\xe8\x0b\xe0\xc4\xc2\xde\xc0\x0f\xf0\x6d\xef\x7b\xcb\x32\x35\xf0\x1a\x23\x15\x47\x82\xd3\x8c\xaa\x2d\xb3\x41\xf7\xa6\xa0\xab\x16\x61\xf8\xfb\x02\x7d\x69\x27\xf3\x85\x7e\x5d\x83\xbe\xed\xbb\x5e\x63\xd7\x5f\xf6\xfe\xc2\x19\xfd\xe3\x1d\xcb\x65\xce\x58\xda\x86\x94\xa0\x2e\xd9\x2d\xfb\x7c\x25\x2a\x58\x08\xb0\x16\x93\xcf\x60\x45\x40\x86\x1a\x6d\x9a\xe5\x86\x96\x42\x2f\x95\xf8\x48\x6b\x96\xf3\x46\x00\xa0\x68\xac\x81\x37\x68\xea\x69\x96\x97\x2e\x83\x68\x96\xa6\xa2\xb5\xa5\x44\x33\xd2\x48\x34\xdc\x73\x11\xd5\xf9\xd0\x9a\xa1\xc0\x7c\x81\xfb\x19\xb0\x60\x30\x5f\x87\x46\x14\xd6\x7d\x94\x36\xac\x2f\x43\xe5\xbc\x61\x58\xc9\xae\xa3\xf6\xa5\x75\xce\xb5\xf9\x55\x5a\x27\x5d\x6d\x5e\x5c\x1e\xe9\x6a\xb4\xf3\x99\x9f\x81\x70\x16\x12\x49\x15\x33\x17\x41\x38\x55\xbc\xe1\xee\x71\x2f\xa3\xb6\xb4\x86\xd0\x50\x06\x1b\x9f\x90\x6a\xa1\xc2\xb5\xde\xbe\x0e\x6e\x30\xc8\x2a\xf0\x09\x40\x29\x02\xde\x8f\xba\x67\x22\xdd\x16\x3b\xba\xe2\x2b\xcd\x2b\xc6\xb7\xd4\x55\x15\x54\xc7\x24\x09\x2f\xb1\x4a\x8d\xc9\x57\xf5\x8c\xc4\x36\x87\xbb\xb6\xc4\xc4\x36\xd5\xfc\x76\xd5\x71\x84\x7e\x96\xaa\xd8\xa6\x9a\xdf\x5e\x5e\xda\x34\xbc\xf2\x2b\xdd\x36\x04\xab\xdb\x73\x27\x33\x8a\xba\xdc\xa6\x15\x47\x82\x88\xf0\x93\x8f\x8c\xf1\xea\x78\x35\xf3\x4b\x6b\x1f\xcf\xb2\xd1\xfc\xc9\xad\x12\xe9\x13\x79\x49\xd2\xe5\xd8\x0c\xc6\x7d\x4a\x3f\xcd\x31\x46\xde\x89\x45\x49\x6b\xab\x53\xf1\xde\xe2\xc0\x61\x8e\xe6\x47\x6e\x8b\x1f\x48\x06\xc0\xba\xb0\x94\x0e\xb8\x3c\xa5\x52\x48\x8f\x65\xb5\x08\x10\xde\x41\x5a\xcb\xa1\x98\xae\x05\x8c\xba\x70\x95\xab\x73\x11\x5f\x02\x10\x50\x9c\xe4\x69\x54\x34\x7a\xcd\xda\x01\x16\x7f\xf6\x33\xbe\xfd\x7c\xe3\x8e\xef\x4a\x38\x4b\x75\x46\x34\x73\xc5\x77\x08\x2d\xb2\x36\x3b\xe3\x3b\x67\xfd\xe5\xb7\x91\xf1\xb8\x80\x2d\xf1\xc1\x43\x01\x92\xcd\x83\xd1\xa7\x08\x7e\x2e\xf8\x7f\xd7\xd8\x65\x5d\x67\x61\x36\x61\x62\xc6\xfc\x31\x40\x80\xc3\x66\xfb\x1a\x52\xd7\x49\x42\x0b\x7f\x21\xe6\xbc\x35\x33\xd4\xd3\xba\xf0\xf0\x22\x5c\x62\xfb\x67\xba\xae\xa5\x0c\x88\x67\x5d\xca\x74\xca\x77\x23\x4b\xf6\x58\x5a\xff\xd0\xf9\x39\xef\xf9\x70\xa0\x8e\xb6\xcb\xba\xae\x88\x79\x66\x2c\x77\xa2\x13\x66\x39\x6f\x3c\xa6\x1c\x2c\xfd\xce\xb7\x08\x6e\x99\x3d\x30\x41\xe5\x13\x6c\x73\xbc\x14\x42\xd0\xdb\x8c\x18\x25\x94\xa4\x04\x1b\x10\x9e\xc3\xdf\x13\x61\xb6\xe3\x93\x43\x00\xb3\x70\x30\x12\x6d\xd5\xd4\xba\xac\xf7\x72\x71\x2b\x26\xf3\xe3\xda\xc8\xa2\x43\x92\x98\x5b\x66\xf3\xee\x8c\x0b\x8a\x1d\xcb\x0d\xa5\x7b\x31\xc2\xa9\xc6\x60\x37\x12\xa7\xae\x59\xef\xbb\xbf\x19\x32\xa1\x25\x09\xad\x67\xce\xa5\x48\x2c\xef\xfc\x6f\xde\xff\xfc\x18\xfc\xfe\x7b\xce\x6d\xaf\x57\x50\x37\x07\x89\x0f\xac\x01\xfd\xa8\xe9\x8d\xab\x3d\x5e\x3f\x5d\x8d\xf0\x5d\xc0\x73\xd9\x4a\x54\x29\xad\x10\xbb\x1a\x08\x30\x87\xd4\x19\x5d\x57\xf1\xb1\xc7\x39\x3e\xc4\x18\xe3\x34\x38\xc3\x5b\x75\x9d\xbd\x3a\xc7\x03\x77\x93\x86\x7b\xb2\x89\xa8\x46\x19\x38\x36\x55\x53\x00\x0c\x09\x9c\x85\xec\x50\x24\x06\xa3\xe8\xae\x07\xff\x3f\x32\xdb\x06\x15\xd0\x49\xf8\x3b\xbc\x12\xbe\xdc\x55\x46\x48\xba\x62\x8c\xf7\xcf\xc5\x35\x32\x02\xdd\xb7\x6a\x92\xd0\xbe\x89\x85\xf7\x1a\x18\x1b\xcd\x41\xbe\xfe\x79\x08\xce\xeb\xfb\x2b\xbc\x73\x19\xde\xf9\x7b\x78\xe7\x55\x7e\x04\xfe\x85\xc9\x25\x5f\x33\xf3\xd1\x87\xcc\xbd\xb9\xac\xcf\x0e\x49\x42\x6f\xc5\xc1\xee\x8a\x58\x7a\x08\xf9\xa9\x9c\x54\xe0\x4f\xce\xb1\xc1\x34\x4a\x93\x24\xd4\x3d\x20\x26\xb7\x8c\xdf\x26\x49\xd0\xa9\xa7\x6d\xea\x87\xe5\x6d\xd7\xd9\x8e\xe4\x21\x80\x9b\x93\x3d\x7c\x1d\xd0\x82\x28\x6e\x26\x06\xc3\xba\xaf\x34\xbd\xcd\xcc\x04\x49\xe7\x5c\xf1\x1d\xe3\x50\xdc\xc1\x7c\x8c\x99\x3d\x7b\x6b\x41\xb9\x85\xf0\x35\x59\xaf\x7d\x8a\xfd\x2b\xe6\x8c\x1d\xf3\x5e\xca\x0e\xd9\xb4\xb2\x78\xe7\xe0\x24\xb3\x64\x69\x7c\x03\x54\x3e\x69\x5d\x89\xdb\x9d\x94\xeb\x71\xff\x54\x21\x93\xe4\x34\x8e\x39\x8b\xb5\xe8\xf4\xc9\xad\xbb\x69\xdd\x75\x93\x3a\x49\x74\xd7\x6d\xc1\x57\x5c\xf6\x7a\xae\x74\x9a\x34\xde\xd7\x49\x32\xd9\x82\x5b\xa7\x0e\x28\xcb\x37\x0f\xb3\x66\xb3\xc9\x94\xd7\x89\xc5\x3c\xed\x4f\xc9\xec\xfb\xfb\xbb\xc0\x78\xe2\x2e\x4c\x4b\xe2\xbe\xdb\x7c\x4f\x1b\x16\x12\x24\x2f\xfb\xe4\x3c\x1d\xcf\xe2\x55\x7c\x77\x62\xa7\x50\x08\x27\x09\xa0\x78\x29\xbf\x9e\xd8\x5f\xc0\x7c\xc5\xb8\x9a\x35\xd5\x5a\x28\xaf\x84\xf0\xfe\x67\xb8\x4a\x6c\x29\x64\x64\x49\x02\x7f\x7b\x43\x98\x29\xc1\xbe\x67\xc0\x31\x65\xd3\xd9\x91\xab\x63\x6c\x6d\xde\x14\x6b\x79\xdd\x3c\x1f\x54\x0f\x5a\x86\x75\xbc\x2f\x24\x03\xe1\xe1\x0f\xbe\xf9\xdc\x71\x69\x98\xa1\x66\xb6\x94\xa0\xcd\x48\xea\xcf\xd1\xf5\x11\x11\x72\xd9\x91\xdb\x7b\x27\xe0\xb9\xf6\x90\x4f\x9c\xca\x76\xe0\x69\x83\x16\xb5\xe7\x88\x21\x1d\xba\x33\xae\x6e\x2c\x0e\x61\x38\xa2\xcc\xa3\x6c\x61\x76\x7e\x21\x50\xe1\xa6\xac\xcb\xf6\x8e\xa0\x63\x83\xd1\x34\xe9\x64\xce\xfc\xd0\x29\x66\x78\x5f\x14\xdc\x2c\x55\x48\x78\x08\xad\x16\xf0\x06\x16\xd6\xa8\x89\x4d\x6b\xef\xf3\x82\x0d\xf7\x3d\x25\x97\xbd\x7b\xd1\x98\x67\xb8\xc9\xee\xfc\x75\xf1\x8a\x6b\xda\xf8\xda\x9c\xa0\x82\x97\x10\x00\x6d\x14\x33\xb3\xd8\x7a\xbe\x2d\x6b\x17\xc5\xea\x94\x3d\x33\xd8\x78\xe8\x3e\x36\xd8\x64\x6e\x43\x4c\x26\x70\x52\x1c\x11\x72\x71\x33\x8c\x41\x99\x6d\x23\x58\x6b\x1b\x1a\xa8\x80\xc5\xd1\xfc\x0f\x55\x4e\x92\x82\x2a\x88\x92\xf1\x40\x96\x70\x34\xaa\x46\x32\xea\xde\x0b\xdb\x3d\x84\x76\x9a\xda\x83\x3b\x9e\x9f\x2f\x58\x6d\x1e\x31\x7a\xeb\xc4\xe1\x50\xf8\x9a\xc2\x2d\xa8\x2b\x6a\x13\x14\x12\xcc\xa8\xc2\xce\x6c\x18\x97\x46\xb4\xd7\x0e\x06\x54\xf3\x4b\xc6\x16\x13\x99\x24\x8d\xd1\x26\xa2\x09\x51\x32\xc4\x3c\x33\x3d\xde\x77\x5b\xd1\xe3\xc6\x4f\xd0\x69\x12\x02\x1f\x71\x76\x3e\xdf\xa4\x5c\x87\x4d\xc5\x6b\xa1\x97\x85\x63\xca\xcb\xb9\x0a\x2e\xb1\x95\x73\x08\x57\xb4\xcd\xdc\x88\x3a\xab\xfb\x0d\x31\x34\x8a\x1b\x8a\x93\x39\x8f\x88\xeb\x0a\xe8\x5b\x65\x26\x3e\x36\x2b\xfe\x0d\x62\xc6\x27\xc0\xc2\xe6\xcd\x49\xd2\xb4\x69\xb9\x94\xd8\xa6\x36\x5a\x2c\x49\x20\x05\x25\x0f\x7e\x26\x24\xf4\x4d\x69\x4a\x29\x5d\x33\x4a\x68\x46\x53\x2f\x29\xe6\x0b\xf9\xba\x59\x48\x38\x06\x93\xb9\xe9\x13\x99\xdb\xca\x46\x17\x81\x4c\xf2\x5e\xe9\xf6\xd6\x91\x45\x67\xde\x56\x8b\xe4\xa8\x27\x72\xd4\x20\xa3\x93\xef\x40\x40\x6c\xea\xa5\xca\x17\xf6\xef\x73\x94\xe0\xd6\x0e\xdd\x75\x63\xcc\x41\xe5\xb8\xeb\x3c\xce\x6a\x27\xbd\x2a\x4d\x15\xb6\x24\x32\xb3\x06\x67\x92\x6d\x55\xae\xe5\xd7\xcd\x7d\x9d\x56\xda\xea\xb6\x8c\x43\xe2\x4f\x3b\x48\x82\xfa\xdb\xa4\x6b\xe4\x34\x32\xc9\xf6\x33\x19\x37\xf2\xf6\xdb\xba\x77\x34\xc2\x32\x8e\x90\xfe\x61\xaf\x83\x1b\x50\x12\xde\xb0\x05\xf5\xf7\x6c\x71\xc7\xe3\xa0\xa1\x4e\xa3\x6a\xa2\xa6\x89\xbe\x52\x45\x9f\x87\xa3\x51\x2c\xf3\xde\x74\x7b\x22\x6b\x39\xda\x71\x5c\x66\x1c\xab\xa1\x89\x76\xa1\x5f\xd7\x21\x56\x2b\x95\x02\xc2\x37\xa8\x8d\xe3\x98\x40\xbf\xf4\x13\xf4\xfc\x9c\x5f\xb2\x45\xed\xf7\x24\xd6\xe8\xdd\xec\x28\xd8\x7e\xad\x1d\x38\xd8\x62\x8b\xf8\x04\x03\xeb\xe1\x74\x11\x67\x33\x2f\x14\xe0\x75\x45\x16\x69\x71\xf9\x45\x70\x3b\xfc\xb2\x1a\x60\x50\xb4\x99\x6b\xad\xa6\xcc\x3d\x08\x06\x89\x28\x1b\x7a\xd7\xf3\x60\x99\x17\x4f\x6d\xd5\xdc\xa7\xff\x6b\x3e\xe7\x9b\xa2\xd5\xe9\xab\xf9\xbc\x37\xf0\x7f\x39\x9f\xdb\xa5\x76\x2d\x8d\x32\xec\xcb\x52\xbc\x3f\x43\x50\xa0\x35\x00\xc2\x7b\xaf\x5e\xe4\x5d\xa7\x7a\x2a\x45\x1e\x48\x78\xc9\xc7\x0c\x01\x91\x25\xdd\x0c\x83\x85\x3e\xa9\xfe\x1b\x74\xf8\x71\xb9\x6a\x0c\xfc\x54\x23\x8e\xdf\x16\x9d\x90\x97\x23\xf7\x10\xac\x86\xfc\x0e\x2d\x15\x9e\xd2\x41\xd4\x3f\x22\x20\x0a\xe2\x38\xb4\x88\x63\x87\xfa\x50\x0b\x70\x38\x52\x1a\xc9\x01\x90\x17\x07\xa1\xed\xe5\x5a\x94\xda\x02\xe3\xc8\x35\xa7\x9f\xa9\x25\xc3\xa7\x05\xd1\xa4\x7f\x19\x92\x5b\xf1\xc7\x19\xfc\xf8\xd9\x65\x10\xfd\xdb\xe0\x40\x65\xa7\xf9\x5a\x0b\x8b\xa8\x5e\x68\xad\xbe\x81\x80\xed\x45\xa4\x1e\x99\xf4\xcf\x9e\xc6\x5f\xc1\xa3\xcf\x1e\x77\xf3\x1e\x95\xe5\xdf\xe0\x07\xed\x1f\x7a\x8e\x9e\xf7\xa4\x5e\xb1\x27\x40\xbf\xdd\x35\x0b\xf7\x17\x13\xe0\xe4\xf8\x0f\xfc\xf3\xca\xfc\x61\x27\x38\xfe\x22\xe2\x79\xf2\x70\x14\xd9\x15\x98\x44\xdd\x11\x3f\xb5\xfc\x1e\x11\x02\x42\xd7\x51\x23\x9d\xa1\x0d\xf1\xd8\x79\x10\xc5\x09\x27\xe6\x01\x72\xbd\x11\xcc\x4e\x1f\xc8\x76\x8e\x12\x99\xc5\x64\xf4\x20\xc6\x45\x9d\x41\x5a\xd4\x26\x40\x64\x5f\xf6\x9e\x67\x65\xe8\x82\xa7\x44\xe9\x3d\xcf\x34\x63\x99\x4a\xe9\x80\xbf\x4c\xf3\x7a\x4a\x08\x33\x9f\x53\xf6\x1e\x61\xa5\xdb\x2d\x63\x11\x8e\xbf\xd8\x14\x60\xf7\xff\x30\x59\xcb\x7a\x0d\x1f\x6a\x6f\x5a\x0e\x79\xd0\x6f\xdd\xe7\xa7\x08\xf8\xff\x34\xf4\x7b\x42\x87\xdf\x70\x58\x26\x89\x1d\xac\x48\x39\x0b\xae\xbb\x6e\x64\xdb\xc9\x2d\xed\x88\xed\xb9\x37\x46\xf8\xdc\x2c\xcd\xb2\xcd\x2b\x6a\x44\xda\x7a\x66\xf0\x0d\x4f\xce\x20\x06\xd1\xdb\xf8\xca\x0d\x2d\x4f\xc8\xe3\x6d\x4c\x81\x28\x97\x6a\x3a\xcd\xd9\x29\xd6\x10\xae\x22\xbb\x93\x63\xd8\x70\xf5\x01\x95\x5a\x67\x83\x9e\xac\x59\x3a\xf8\xa6\x1a\xad\x94\x1e\x45\xf0\x74\xe4\x20\x99\xab\xad\xf3\xc5\xa7\xfb\xe9\xc5\x2d\x1b\x91\x8c\x85\x58\x6b\xeb\x04\xe8\xbb\x6d\x01\x49\x9f\x09\xa5\x1c\x0c\x5d\xef\xce\x03\x63\x7c\xad\x97\x4d\xce\xe1\x7f\x24\xc8\x81\x01\x53\xd8\x52\xb2\x06\x46\x8a\xbd\x5f\x32\xae\x5c\x2c\xe9\x9d\x3d\xaf\x85\xbe\xed\x50\xb2\x75\x0e\x9b\xa3\xb3\x50\xd1\x2f\x2e\x4a\x7e\x6b\x33\x16\x9d\xb9\x63\x92\x02\xaa\xb6\x20\x9c\x8b\xca\x90\x61\x20\x67\x36\x22\xf3\x8c\x04\xa7\xa4\x8f\x3a\x02\xa8\x0a\x27\xb5\x75\x38\x09\x79\xff\xaa\xa2\x05\x0c\x77\x12\xb8\x55\x6f\xc3\x12\xe2\x03\x0d\xc9\x32\x99\x8e\x05\x7a\xc7\x15\x8b\x89\x22\x77\x11\x56\xf3\xa8\x30\xc5\xf3\xff\xcf\x0b\xd3\x18\xf2\xf9\x77\x84\x69\x10\x13\xb9\xc4\xe2\xdf\x95\x0f\x70\xa6\x25\xf3\xa1\x50\x3d\xa9\xdf\x7f\x4d\xa8\x9e\x3d\x23\x23\xcd\x62\xe3\x2a\x60\x86\xa5\x86\x6d\x40\xe0\xac\x93\x47\x02\xf0\x8f\x0b\x38\x09\x5e\xb4\x7f\x50\x98\x49\xc0\x94\xf6\x6f\x4d\x9f\x74\x71\x83\xce\xd9\xe3\x1e\x2c\x03\xa1\x47\x74\x71\x03\xce\xc1\x01\xbc\x41\xe6\xc3\xac\x34\xbf\x9c\xb3\xf4\x4e\x3b\x78\x48\x07\x65\xc3\xba\xee\xf6\x34\x11\xa0\xf6\x94\xdc\x64\xf3\xf4\xfc\xd2\xc8\x2b\xdb\x3a\xe9\x13\xd9\x34\x8a\xa4\xe4\x4e\x6f\xab\x77\x8d\x22\xdc\x8e\xcf\x14\xff\x9a\x87\x89\xe9\xba\x48\x69\x80\x45\x26\xf0\x0d\x71\xba\xc4\x33\x9f\x25\xc3\xd8\xfa\x30\x1c\x3b\x82\x98\x08\xf1\x25\x5c\x89\xd0\x5a\x1c\xb5\xc1\xa1\xd7\xc9\x48\xd9\x3a\x49\xa8\x1e\x3c\xfc\x47\xdf\x32\xd8\x2b\xd9\x9e\x22\x9c\x28\x59\xac\x3f\xd4\xd5\x23\xe1\x64\x5b\x3c\x7c\x07\x13\xc4\x34\x93\xac\x2a\x1b\x5e\x65\xaf\x7e\xb0\xce\x0d\x9c\xa8\xe6\xfe\x6a\x57\xd4\x26\xbd\xa9\xec\xaf\x7d\x2b\xdf\x17\x3b\xc2\xc9\x46\x15\x5b\xf9\x27\xeb\xb3\xea\xc2\x2d\xde\xae\x11\x2c\x3c\xdc\x8f\x19\xf5\xc4\x0f\x62\xc0\x67\x89\x56\x7a\xd8\x60\x0e\x3d\x1a\x8b\xf5\xfa\x8d\xe9\xb7\xc0\xf4\xe3\x76\x14\x71\xe0\x29\x9c\x07\x6f\x69\x0f\x01\x3d\x32\xa5\x8d\xe2\x6f\x79\x5b\x5d\xb9\x54\x47\xa8\x69\x8f\x76\x23\xce\x2c\x52\x1a\x95\x62\x0b\x47\x05\x4e\x90\xb8\xe5\x0c\x84\xc2\xde\x32\xe9\x95\xe2\x51\x63\x38\x21\x80\x20\x06\xe7\x05\xe4\x8c\x4c\x0f\x9a\x96\x6c\x6a\x04\xec\x53\x11\x90\xe2\xc9\x65\x61\x1e\x57\xbd\xbf\xfc\x19\x99\x36\x90\x0f\xd0\x19\xd4\x54\xe0\xd5\xa2\x34\x73\xb1\x15\x07\x4d\x15\xb2\x9e\xb7\x23\xf2\x97\xb7\x1e\xcc\x0b\x31\x43\x6c\xa4\xe2\xff\x8b\xc6\x0b\x8a\xfe\x9d\xf6\x9b\x9c\x48\xe2\x68\x3b\x69\xe4\x82\xab\x3f\x21\xff\x9f\x5a\x1c\x2f\xcf\x2f\x5f\x8f\x35\x3d\x53\x42\x79\xa4\x34\x9f\xcc\xff\x1b\xfd\x80\xfb\xed\x41\x3f\x94\x4e\xd1\xf0\x11\x1d\x25\x2f\x02\x5f\x62\xd1\x74\x5d\xbc\x6a\x96\x3e\x52\xe8\xc4\x34\xa1\x93\xa4\xc8\x34\x9a\x1a\xfd\xb8\x2e\xad\x61\x22\xec\xab\x92\xa5\x5b\x5a\xb2\xec\xf3\xbd\x1b\x54\x98\x96\xa3\xbd\x0b\x68\xe0\xec\xf8\x0c\x65\x72\x18\x49\x0c\x3e\x1a\x4f\x76\xef\xef\x0c\xcd\xa6\x83\x4b\x66\xbb\x45\x0a\x85\x54\x28\xf5\xec\xae\x68\xf1\xad\x92\x65\x75\x54\x71\xc9\xd2\xba\xff\x34\x69\xd9\x6f\xfc\xba\x56\x82\x3b\x3f\xb6\x0a\x86\xb3\x98\x61\xe4\xc7\x62\x92\x04\x74\x71\xe4\x97\x5f\xfc\x42\xf0\xcb\x2f\x84\x3b\xac\xd2\x36\xd2\x71\x4e\x92\x7c\xf7\x4a\x6b\xe0\x2d\x33\x42\xd2\xd0\x44\x1c\x97\x0b\xea\x10\x43\x58\x1d\xfb\x59\xcf\x10\x00\xce\x17\x5a\x98\x81\x26\xcd\x40\x5b\x44\xa3\x5d\xd9\xd1\x3e\x1c\xe3\xe7\x97\xaf\xa9\x1d\xe7\x30\x0d\x70\xac\xfb\xd1\xec\xa7\xf1\xc4\x45\x2a\x4c\x2e\x9d\x3a\xf9\xa0\xc5\xc5\x27\x75\x71\x1b\x6f\x55\x0f\x45\x00\xfc\xe3\x15\x17\xc9\x4b\xee\x41\x7e\xbc\xad\x7b\x30\xa1\x33\x5a\x8a\xad\x99\x8a\xe3\x83\x0a\x3d\x59\x4f\xf0\xa7\x92\xc4\x9d\x87\x6b\x51\x66\x75\x34\xca\xdc\x38\x3c\x14\x15\x65\x2c\xad\x59\xa6\x05\x21\xfe\x24\xa6\x1f\xf6\x99\x9e\x9a\x1b\x43\x87\x75\x00\xb7\x44\xb7\x0f\x3d\x06\x45\x66\x8d\x7c\xa6\xff\xe4\x94\x90\x23\x63\x1c\xf6\x67\x87\xa2\x0a\x3c\x9f\x2d\xb7\xd0\x30\x79\x1c\x60\x0f\xfc\x6b\xac\xc2\xa5\x02\x85\x4b\xf5\xe3\x4e\x73\x02\x3b\x2c\x08\x85\x82\xa2\x70\xc3\xa5\xcd\x20\x61\xa9\xce\x06\x75\x18\xab\xc0\xe7\xde\x7e\x7b\xfa\x76\x33\xb7\x1c\x4d\x84\x7d\xf7\x98\xea\x4d\xa5\xb0\xb6\x0d\x96\x49\x2f\xfb\x1e\xb4\x91\xc8\x69\xd8\x58\x8e\x64\x2f\x52\x7c\x5d\xed\xd2\x27\xb4\xdd\xfc\x41\x55\xd0\xd6\x27\x84\x1a\x98\x80\xbb\xf1\x41\x53\x0b\x10\x27\x21\xb6\x0b\x75\x9a\x67\x4a\xb5\xf8\x7d\x8e\x00\xb9\x05\x4d\x3b\x56\x97\x8c\x54\x85\x84\x73\x7b\xd8\x6c\x41\x74\x5b\x51\x80\x9d\x20\x05\xe2\x9e\x22\x6b\xa6\x97\xa9\xb7\x7d\x63\xc4\x43\xf3\x7a\x9e\xed\xd3\x22\x6b\xc0\x19\x74\xef\x7c\x99\x28\xee\x66\x3d\x2b\x8e\x51\x1f\x15\xb0\x18\x27\xc9\xa4\xf6\x2c\x3b\x49\x42\x27\x75\xa8\x9d\xb9\x1b\x5d\x37\xf9\x8a\x86\x77\x38\x71\x5c\xce\x84\x39\x68\xc1\x2b\x5a\xdb\x19\xc0\x7b\x27\xb7\x85\xb5\x57\x6a\xbf\xc4\xb4\xa7\x21\x52\x81\xbb\x59\xd4\x30\x66\x46\xfc\x26\xdd\x24\x09\x1d\x47\x51\xe6\x14\xe7\xe7\x8c\x82\xe7\x68\x11\x7c\x9a\x88\x28\x82\xfb\xc1\x68\x0b\x86\xe1\xa5\x18\x6f\xc0\x37\xa1\x16\x93\x79\xb4\xed\x1d\x74\x86\x38\xbf\x64\xbc\x39\x1e\x23\xcd\xd4\x9a\xdc\x7a\x3b\xdf\x40\x63\x8c\xe6\x5f\x7e\x6a\x25\x80\x06\x3b\x09\x5a\xf1\x7b\x57\x07\x73\x19\x7f\x07\x95\xae\x75\xb5\x19\x64\xde\xb2\x08\x1b\x81\xf8\x8d\x80\x9b\xf3\xac\x18\x39\xc1\x6b\xb6\x03\x3b\x23\x4d\x4d\x52\x6b\x55\x39\x32\xd8\x70\x58\xb0\x7a\x41\x9a\xda\xe1\xd6\x97\xf5\xd9\x1b\x90\xcb\x37\x76\xf7\x6e\x6f\xc0\x9f\xce\xc1\xd9\xdf\x54\x7b\xc5\x5e\x5c\xf0\xfb\xb8\x22\x63\x74\x0f\x0b\x3f\x33\x2d\xd6\x24\x7f\x3a\x25\xb8\x19\x89\x90\xec\xf9\x0b\xc5\xb2\xee\xba\xb7\x39\x5f\x8b\x83\x63\xa8\xb3\x70\xd0\x16\x3f\x3a\x95\xfc\x2e\xb8\xe7\xc1\xc9\x21\x43\x4f\x1b\xd6\x13\x0f\xa6\x4b\x20\x5a\x6b\xc4\x46\x14\x02\x00\x9c\xde\x72\xb3\x05\xae\x07\x71\xb9\xe1\xf5\xe4\xc6\xee\xf9\xd6\xd3\x13\x66\x50\x33\xd4\xce\x2f\x5f\xaf\x7b\xf5\x6d\x06\xbe\x28\x6b\x41\xef\xc4\x3a\x78\x31\x9b\xa1\x07\x02\xe3\x77\x96\xfc\x90\xf1\xbd\x08\x9e\x4b\x51\xd7\x36\x7d\x35\x5d\x73\x2a\xc5\x67\x28\x8b\xd6\xfc\xd4\x17\x21\x49\x24\x63\x3d\x5b\xaf\x50\xd9\xab\xf4\x0b\x1e\xb4\x82\x08\x98\x34\xb9\x0c\x58\xd5\x44\x90\x29\xfb\x2f\x32\x28\xa2\xc9\xc8\x91\xf0\xd8\x23\x0f\xee\x68\x61\x10\x71\x1f\xb9\x5e\xcc\xf2\xec\xa1\x01\x97\x32\x4f\x23\x61\xc0\xc1\x97\xe4\x94\x60\xcf\x72\x42\x22\x5b\xa2\x6d\x7d\xcf\x84\x67\xaf\x7b\x70\x34\x2b\xba\x26\x2a\x49\x26\xab\x99\x63\x73\x4a\x92\xc9\x03\x00\xa6\x22\x30\xf4\x2a\xa2\x95\xec\xba\x35\x77\xfd\xdc\x4e\xd7\x66\x65\x6c\x44\x13\x48\x46\xb6\x68\x16\x83\x94\x9d\xe3\x0b\xe7\x85\x68\x16\x05\x04\x0c\xc7\x60\x69\x5d\xf7\x96\x25\x89\xcd\x57\x84\xd0\x69\x5d\x57\xd8\xa2\xfe\x56\xd6\xeb\xe6\xbe\xeb\xde\xb0\x63\x19\xd2\xe9\xed\x96\xa5\x65\xd1\x93\xcf\xd1\xf2\x6d\x44\xe3\x68\x09\x2e\x5f\x97\x59\x9b\xae\x3c\x37\xa6\xf9\x1e\xea\x29\x0e\x9b\xdf\xe5\x37\xc4\x62\x72\xc7\xe6\xd4\x38\x00\x74\xf0\x33\xa8\x6c\xd3\x36\x46\xdb\xa6\x95\xd8\x27\x49\xb3\xdc\xe7\xfd\x9d\x24\xf9\x19\x9d\x83\xfd\x08\x88\x1e\xf1\xbc\x43\x8e\xd9\x69\x48\x4b\xd3\x3b\x43\xe3\xd7\xac\x4d\x4f\xcb\x11\xae\x1b\xca\xba\x6e\xe5\xfd\x52\x2c\x61\x60\x9f\x60\x5f\xba\x9b\xed\xf0\xf0\x8d\x75\xdd\xe4\x67\x5a\xb3\xae\xdb\x27\xc9\x96\xd6\xcb\x35\x34\xa8\x19\x06\x49\x42\x69\x21\x6a\xfc\x0c\x6a\xfe\xc2\xa8\xec\xa9\x57\xfd\x1c\x17\x6b\xfe\x5c\x0f\x24\xc9\x66\x8c\x2e\xf6\x5e\x33\x6e\xde\x05\x44\xd2\xcf\x3f\x39\x46\x37\x85\x0f\x9f\xd6\xc1\xce\xa8\xc2\xd5\xb5\x00\xa4\x4e\xcf\x0c\xd8\x5a\xae\x9b\x71\xbb\x5f\xef\x94\x1b\x48\x11\x5e\xf3\x27\x2b\x43\x23\x6a\xa2\xf9\x91\x2d\x86\xfc\xd5\x0a\xb1\x22\x35\x3b\x41\xf3\x1d\x93\xe8\xbf\x77\x2e\x14\x97\x6d\x2a\xaa\x1d\xa6\xaa\x4d\xfc\xe6\x84\xa7\x3c\x76\x18\x36\x9b\x00\x00\x78\xf6\x0e\x53\xa7\x65\xd6\x80\x22\x1c\xae\x76\x18\x49\xf9\xef\x51\xb6\x04\x9e\x3d\xf1\xa1\xad\x95\x4f\xb6\xdd\xa8\xf2\xc2\x8e\xc7\x3c\xa3\xb8\x0e\x46\xc2\x4c\x8d\x31\xbe\x84\xbc\x47\xb1\x08\x81\xb4\x75\x74\x09\xae\x1a\xde\xab\x4f\xb1\x85\x36\xd3\xe5\x64\x24\xd6\xbc\x04\x07\x80\x30\x2f\xa7\x1a\x20\x4a\x2e\x01\x3b\x19\xe9\x5f\xff\xaf\xd5\xe3\xfc\x72\xa1\xb3\xe8\x6d\x9a\xa5\x74\x9c\x58\xad\xaf\x9c\x77\x1c\x54\x0c\xe2\xe4\x40\x0b\xb9\xd6\xe2\xcd\xac\x6a\x56\x18\x11\xf2\x46\x8b\x27\x20\x97\x0e\x02\xa9\xf8\x5b\xb3\x81\xcc\x2e\x16\x57\x33\xb0\xdc\x7e\x7c\xff\xdd\xa9\x93\x12\x58\x7e\x64\xd7\x9d\xb8\x22\x79\x10\x29\x33\xb2\x01\x89\x55\x0b\x98\x1e\x6f\x66\x5f\x7f\x78\xff\x83\x29\x50\x31\x2c\xf8\x9d\x6a\xb6\x57\xf0\x38\x28\x20\xf2\x41\x5f\x3c\x6c\x2b\xc2\x7a\x98\x55\x7f\xc8\xdf\x5b\x60\x27\x10\xe6\x6a\x4f\x7a\xdb\x3f\x3d\x5e\x17\xb7\x66\xbb\x44\x09\x14\xa9\xa4\x52\x8d\x0a\xfc\x98\xaf\x66\x90\x42\xc9\xb7\xf5\xa1\xa8\xca\xf5\xd9\xc7\xf7\xdf\xa5\x66\x2b\xce\xb8\xc6\xc8\xb9\x2b\xf3\xb5\xcb\x4f\xf9\x8b\x0b\xfe\x1b\xec\x9c\xb3\x4f\xf5\xc5\x2d\xff\xca\x6a\x6b\xed\xfe\x66\x5b\x6a\x7b\x0a\xd3\x95\xdb\xe2\x56\x76\x4a\xb6\x52\x77\x9b\xb2\x92\x70\x2c\xf3\xfd\x67\xcf\x6f\x7e\x93\x8f\xb7\xb2\x66\xe1\x59\xcd\xd7\x9a\xd6\x5c\xf6\xf4\xd2\xfa\x34\x28\x5b\x32\x66\x27\xd5\xf0\x54\x5f\x75\xdd\x95\x5d\x50\x6b\x96\x95\xb0\x2e\xa7\xa6\xc4\x29\x59\x92\xe9\x29\x2b\x8c\x76\xc6\x7e\x6d\x36\x86\x46\xbb\xc8\x09\xd7\x88\x71\x63\x5d\xb3\xca\x0d\x55\x5d\xe7\x9e\x9c\x08\x71\x6f\xde\x6f\x8a\x96\x43\xe7\x2d\xc9\xfc\xab\x34\x94\x24\x97\x3a\x77\x80\x39\xbb\x42\x15\x5b\x31\x7e\x96\xb8\xcc\x79\x39\x76\x4b\x6c\xa9\x66\x99\xa6\x2c\xd5\x0b\xb5\x74\x58\xae\xb9\x90\xf5\xaa\x59\xcb\x9f\x7e\xfc\xf6\x4d\xb3\xdd\x35\x35\x32\x4a\x4e\x89\x20\xd3\x91\x3b\xa8\xf7\xd4\x66\x0b\x5b\xb3\x23\x88\x30\xdc\xd4\xba\x83\x6c\x32\xd6\xc2\x66\x6e\xff\xfa\xcf\xbd\x54\x8f\x49\x02\x5e\xe2\x3f\x54\x45\x59\x5b\xff\xc2\xd1\x0e\x60\x4f\x25\x6e\xec\x8d\x62\xc7\xfb\x2d\xbe\x6f\xc9\x20\xf0\x07\x3b\x19\x22\x6a\x78\xd9\x83\x92\x59\x95\x2f\x21\x6c\xe0\x6a\xd9\x4a\x55\x16\xd5\x38\xc0\x9f\x6d\x5a\x6a\x6d\x56\x36\x23\x7e\x07\x03\x0c\x8d\x30\x69\xa4\x00\x3d\x82\x13\x8d\x12\xc9\x1e\xe0\xa3\x81\x4b\xda\x69\x45\x82\x28\xaa\x50\x8f\x94\x68\x0f\x3c\x32\xe7\xf3\x39\x2e\xe0\xcc\xc8\x8b\x22\xca\x4d\x73\x99\x36\xb6\x66\x9f\xb2\xa5\x24\x75\x7b\x65\xa3\xd7\x7f\xef\xfc\xff\x42\xfb\x8b\xd1\x28\xbe\xd2\x3d\x2e\x34\xde\x5d\x39\x1a\x86\xc9\xce\x63\x46\xb3\x23\x8b\x3f\x2e\x8c\x64\x0b\x4d\x4d\x31\xec\x21\x7a\x11\x0c\x8c\x4b\x35\xcb\xd0\xb0\x54\x8f\x18\x96\x9e\xcc\x87\xa4\x1a\xbb\xdf\x12\xa4\x7a\x73\xca\x6f\x9a\x93\x4f\xea\x53\x4d\xcc\xea\x98\x8e\x64\xad\xc7\xb3\x22\xe4\xb0\x93\xcf\xbf\x6a\x71\xf1\x3f\x5f\xcd\x2f\x6e\xf9\x3f\xb5\xb8\xf8\x1f\xb3\x97\x2f\x2e\xf8\x77\x5a\x5c\xd0\x65\x96\xe4\xec\x17\xb1\xfc\x47\x92\xbf\xbc\xe0\xdf\x80\xcc\x99\xbd\xcc\x58\xba\x3c\xfb\xa4\xf3\x97\x74\xf9\x0f\x53\x62\xfe\x92\xbd\xb8\xb8\xdd\xf2\x0f\x56\x26\xfd\xf9\xed\x75\xf7\xcd\xdb\xaf\xbe\x36\x7b\xcb\x1f\x4c\xda\xa7\x8b\x4f\x17\x17\xfc\x47\x2d\x9e\x8e\xfc\x3d\xfc\xff\xad\x16\xe4\xe5\x05\x71\x41\xa8\xe4\x25\x61\xfc\x6f\x23\x4e\x35\x45\x88\xe5\xfb\x4e\xd3\xc6\x8f\xae\xb8\xdd\x4f\x97\x04\x30\xd7\x49\x2e\x85\x29\x7b\x11\xc7\x08\x45\x66\xae\xe8\x74\xd8\x1f\x58\x0c\xfc\x0b\xc8\x14\xd0\x74\x96\xf3\x3c\xa3\xb5\xa8\x3d\xdc\x4a\xd7\x91\x97\x84\xd3\xc6\x45\xb2\xe1\xd1\x77\x1f\x3c\xc4\xd2\xe1\x3d\x67\x69\xe9\xcf\xb4\xff\xa4\x81\x64\xa8\xe1\x05\x8e\x9f\xd6\x34\xd0\x5e\x68\x21\xc4\x7b\xdd\x7f\x7d\xe5\xd6\x41\x0f\xa4\xd9\x2e\x65\x8e\x0e\x99\x20\x33\xf4\xd2\x72\x9a\x8f\x6a\x5a\x14\xdf\xf0\x9c\x37\x6f\x0d\x58\x4d\xed\xb2\xce\xb3\x7d\x36\xa1\x4a\xd4\xcc\xda\xe5\x52\x5a\x02\x01\xb7\xd9\x89\xf4\xde\xf7\x35\xe3\x95\xf9\x6f\x72\xc9\x8e\x8c\x2b\xcf\x9c\x1c\x66\x5e\xce\x73\xa3\xb4\x63\x5c\x71\x92\x54\xd0\xcd\x01\xd4\xb0\x1e\xda\x95\xae\x66\xc5\xaf\xc5\xc3\x95\xd4\xba\xac\x6f\xdb\xd9\xa6\x2a\xb4\x8d\x37\xf5\x6c\xec\x35\x02\x4a\xf7\xd4\x7f\xcb\x3a\x37\xea\x7f\x69\x6a\x2e\x53\xd5\x75\x54\x89\xa7\x23\x63\xa6\xd5\x81\x64\xda\x4b\xc1\x80\x7b\x74\x32\x37\x4b\x21\xe3\xf2\xf8\x37\x0d\xe7\xbd\xe2\x1a\xff\x86\xfe\x4c\x2b\x5d\x1e\x64\x3a\xe7\x55\xd1\xea\xf7\xcd\xba\xdc\x94\x72\x0d\xc1\xb3\xba\x80\x20\xda\xb0\xae\xe9\xd3\x5e\x55\xa9\x2b\x04\x54\x71\xf2\xe7\xb7\xd7\x84\x97\xed\x77\xcd\xaa\xa8\x52\xf4\xa1\xb8\x69\xf6\xba\x2b\x76\x3b\xf3\xef\xbc\xd5\x8d\x32\x2b\xfb\x6c\x7a\x0e\xef\x6c\xcb\xa6\x86\x05\xde\xac\xf5\xdd\x7d\xb9\x06\xfa\xd4\x17\x17\x28\x71\xae\x6d\x3c\xfe\xaa\xa9\x18\x47\x9a\x20\x20\x72\x54\x8d\xd1\xcf\x80\x8b\x64\x32\xe7\x45\xfb\x58\xaf\x2c\xcb\xb2\x96\xb5\x06\x1e\x3e\x62\x76\x52\x25\x6a\x5f\x17\x0f\xe7\xf7\xf7\xf7\xe7\x9b\x46\x6d\xcf\xf7\xaa\xc2\x75\x6d\xbd\x38\x5b\xdd\x19\x55\x46\x8b\x9f\xae\xdf\x9d\xff\x07\xe1\x46\xeb\xdb\x69\x1b\xeb\xf7\xad\x46\x66\x0e\x54\x97\x76\x66\xc1\x22\x88\xea\x8f\x29\xe6\x27\xe1\x0f\xe6\x3a\x7a\xd3\xb6\xe2\x67\x5e\xc3\xe2\xbf\xb6\x00\xd1\x19\x64\x30\x29\x36\xc7\xaf\xc5\xa1\xb0\x0c\x2b\x47\x57\xf7\x36\x7d\x32\x65\x5e\x7c\xba\x79\xd8\x56\x9f\x6e\x2e\xf0\x95\x17\x9f\x6e\xcc\xdf\x0b\x2c\xef\xe2\xd3\x8d\xf9\xfb\xe9\xe6\xe2\xc8\x95\x6c\x77\x4d\xdd\xca\x77\xa5\xac\xd6\xf6\x61\xe2\x12\x3f\xbe\xff\x8e\xd8\xaf\x70\x49\xd7\xf2\x41\xbb\x6a\xb9\xb4\xbf\x5c\x7d\xf8\x1e\x6b\x70\x90\x4a\xdb\x68\x47\xa8\x22\x49\x51\x6d\x44\xa5\xf1\x0c\xbe\x19\x78\x3c\xe1\xd2\x94\x42\x52\xf3\x34\xaa\x99\x36\xd9\x7c\x78\xda\xab\xb4\x47\x1e\x0c\x69\x1c\x32\xae\xab\x1e\xb4\xd9\xad\xf9\x41\xb5\x7f\xc6\x2b\x45\x67\x2f\x34\x85\x89\x13\xcf\x15\xb3\x3f\x4e\x5f\x68\x1a\xa7\x02\xa9\x8a\x49\xe8\x79\x8b\xde\x69\xfa\xa3\x66\x90\x78\xad\x8a\xba\xdd\x35\x4a\x9b\xc4\xf7\x36\x71\xf0\xda\x31\xdb\x94\x95\xab\xce\xe3\x5f\x0b\x0d\xb3\xd3\x4c\x63\xb0\xf4\xf1\x9a\xaf\xb9\xe2\x77\xfc\x16\xa4\xda\xa1\x9f\xd6\xfb\x9d\x0d\x85\x78\x14\x87\x99\xfd\xec\xae\x3b\xf0\x6d\x7f\x99\x24\xc0\x7e\xe4\x60\x3d\x1e\xad\xb2\xc4\xb2\x2b\xfa\xc8\x52\x67\x7c\x7c\x88\x60\x03\xf8\x8d\xb8\x9a\xbd\x29\xaa\xea\xa6\x58\xfd\xd6\x52\xd2\xd4\x2b\x79\xb6\x95\xdb\x46\x3d\x12\xc6\xef\xc5\x61\xd6\xea\x42\xef\xdb\x37\x40\x70\x0f\x90\x44\x4f\x47\x6e\xc5\x2c\x41\xf2\x57\xb9\x26\xfc\x5a\x3c\x29\x59\xac\x1f\xaf\xb4\xd9\x7d\x03\xd9\xfa\x8f\x76\x5c\x7c\x23\x8b\xf5\x18\x99\x37\xf0\xb0\x39\xd8\xcc\xa7\x5a\x3c\x1d\xad\xd1\x47\x8b\x6f\x34\xc6\xb8\xee\x18\xab\x97\xfa\x84\x47\x02\x28\xef\x05\x7d\xf6\x56\x04\xd2\xa0\x97\xaf\x72\x76\xd4\xa2\x5e\xca\x91\xac\xc7\x48\xc3\xd0\xa8\x61\x68\xab\xf4\xf1\x33\xa3\xf5\xdd\x4a\xfd\x55\x55\xc5\x5f\x33\x86\x27\x7d\x97\xed\x52\xef\x46\xf2\x23\x42\x6d\x9c\x7c\x7b\x30\x1e\xf1\x95\xc8\xd6\xd6\x0e\x2b\x97\x8f\x24\x75\x9d\xe4\x85\x59\xb7\x2c\xf3\xf3\x91\x37\x07\xa9\x54\xb9\x96\xef\xcb\x2d\x32\x88\x3e\x6b\x14\xbf\x83\xd8\xb6\xad\xcd\x27\xa4\x2b\xa1\xef\xdd\xf1\x0e\x82\x80\xf8\x3b\x76\xed\x22\xf9\xe4\xf2\xda\x8e\x88\x93\x38\x11\xc9\xee\x97\x3a\x17\x4b\xf3\x3f\x6c\x33\xf2\x98\x31\xa5\xb8\x31\xf3\x65\xc4\xbd\xa6\xeb\xf6\x2e\xe7\x2a\x49\x56\x33\xc8\x08\x10\x8f\x74\xee\x58\xae\x8f\xb0\x4b\x78\xf0\x28\x0e\xd7\x8c\x1f\x66\x7b\x55\x09\x4a\x65\xd7\xc1\xcf\xae\xb3\x6b\x08\x9b\x12\xc2\xbc\xee\xf6\x83\xe6\x81\xfc\x9f\x92\x8b\x0b\x62\x9e\x05\xfb\x9a\x9e\x6d\xa5\xbe\x6b\xd6\x5d\xa7\x2d\x13\xdc\xc1\xa7\x60\x16\x7e\xe8\xd7\x64\x41\xfb\x0b\x50\x5e\xd8\xf3\xda\x10\x21\x2e\xd0\xf4\x30\x5b\xa9\xa6\x6d\xbf\x6e\xb6\x45\x59\xb3\x27\x35\xae\xa8\x99\x8d\xb7\xc2\xe5\x14\x3e\x86\xdb\x0b\xfc\xc3\xa3\x42\xc4\xdf\x06\xdf\x33\x35\x2b\x71\xd3\xea\x89\x50\x83\x1b\x0a\xd2\xfb\x0d\x7a\x5c\x8e\x91\x9a\xe5\xc6\x7e\x55\x92\x1c\x66\xc1\x8a\xd8\x73\x23\x7a\x3d\xc7\xe5\xb3\x0f\x08\xb7\xcb\xc1\x4b\xd3\xa6\xaa\x58\x03\xa8\x5f\x51\x31\xc6\xff\x64\xc4\x25\x3f\x70\xcd\xaf\x19\xf7\x5e\x26\xd7\x9e\xb0\x82\xde\x3a\xf3\xb6\x79\x35\x2e\xcb\x2c\x49\xe6\xc2\x08\x3d\x50\x20\xa6\x53\xd0\x3b\x22\x93\x16\x01\x79\xa8\x0b\xa5\xfb\x6e\xc4\x3f\x31\xae\x1f\x3f\x80\xf3\x82\xa5\xef\x99\x7c\xb0\x1b\x13\xcc\xca\xb8\x6d\x66\x3f\x4a\xfe\x09\x67\xab\xd1\x43\xd9\x33\xed\x02\x88\x98\x56\xe8\xa2\x96\x80\x04\x82\xfe\x08\xe3\x77\x55\x06\x38\x1a\xb1\x8d\x88\x7f\x7a\x26\x40\xcd\xc9\x94\x80\xee\x6b\x6b\x88\xea\xf2\xc6\xf9\xde\xf0\xa0\x17\x82\x6a\xf5\x96\x1d\x11\x77\x97\x79\xd5\x66\x2a\xe8\x5b\xdb\x00\x1b\x96\x91\x84\xa4\x24\x23\x6c\x6a\x3b\xce\x3a\x55\xda\xfc\x68\xa5\x3e\xcc\x56\xc5\xea\xce\xac\x58\x1b\xb1\xf1\xb5\xfb\x4e\x73\xf2\xe2\x92\x30\xde\x8c\x17\x48\x7e\x11\x64\xfa\x46\xcf\x6e\xf7\xe5\x7a\x3a\x9d\x36\x6e\x92\x6e\xf0\x67\xb9\x71\xba\x20\xc0\x48\x84\xca\xe1\x72\x93\x27\xc9\xf5\x6c\x28\x39\x29\xf9\x76\x73\xee\xf2\x9c\x5f\x95\xf5\x4a\x12\x7e\xf2\x24\x18\x8a\x75\x71\xfb\xb9\x42\xbe\x6f\x6a\x79\xfe\xde\xcc\x03\xd2\xe7\x66\x8c\x07\xa3\xbf\xef\x7a\x6b\x50\x1f\x74\xb2\x0e\x2f\xd9\xf8\x9b\x6c\x01\xe7\xd7\xe0\xc9\x1d\x15\xc0\xf8\xd8\x03\x5f\x81\xb6\x48\x42\x39\xb3\x9c\xe7\xa6\x3a\x56\x8f\x5c\xc6\x77\xf2\xec\xd9\x3b\x53\xb3\x49\x80\x6a\x87\xc9\x99\x59\xc6\xa6\xdf\xea\x29\x59\x9c\xfd\x53\xcc\x67\xf3\x4b\x92\x12\xc2\xd2\xbe\x18\x84\x2e\x3a\xcc\xee\x70\x69\x63\x23\xd5\x2c\xfb\xdb\xc0\x4c\x0c\x52\x03\x89\x83\xae\x64\xbd\x76\x20\x54\x61\x1a\x9e\x46\x3e\xf2\x6b\x7e\x60\x5d\x77\xe7\xcf\x7f\xaf\xad\x7c\x87\x42\xf6\x82\xc0\x15\xe1\x37\xc0\x18\x7b\xe8\x21\x3b\xf8\x35\xc6\x5b\x1f\x66\xed\x1e\xec\xa8\x26\x05\x60\x3c\x0e\x68\x23\x64\x7c\x25\xfe\x64\xb4\x2f\x2b\x63\x40\x95\xb8\x9e\xf5\x6a\x88\xb8\xe4\xb7\x49\xb2\x1d\x88\x0e\x60\x40\x5a\x5e\xf3\x43\x1e\x49\xa5\xc3\x0c\xf4\xff\x24\x99\xbf\x3e\x40\x24\x52\xb3\xd7\x70\x80\xf9\x1c\xb2\x95\xfb\x0e\x62\x33\x1b\x55\xc1\x3f\xc9\x50\xa2\xdf\x89\xc9\x25\x5f\xcd\x5a\xb3\x29\x2a\x78\x15\x58\x4a\x61\x55\xd5\x77\xaa\xb9\x3f\x93\x8b\x8a\x9e\x5f\x1a\x7d\x13\x7d\xa8\xe0\x8a\x7c\xdf\x9c\x79\x25\x33\xdc\xc8\x57\xcf\x9e\x15\x0b\xbd\xb8\xeb\x3a\x0a\x71\x86\xeb\x24\x19\xc4\x08\xad\x4d\x73\xd9\xd3\x93\x9d\x50\x46\x64\xf1\xa8\xb1\xe6\xaf\x65\xf6\x65\x6a\x36\xf9\xaf\xe6\xf3\xd7\x40\x5e\xf6\xfa\x8b\xf9\xbc\xeb\xbe\x98\x7f\x29\x84\x90\x10\x93\xd0\x3e\xef\x6b\x6f\xa9\x81\xdc\x06\x84\xef\x85\xec\xc7\xa1\xd5\xec\xc8\x4b\x22\x84\xd8\x9b\xcd\xed\xde\x1f\x04\x7b\x0c\x09\x05\x88\xaa\xd2\x6b\x29\x66\xc6\x9d\xe8\x91\x83\x29\xc6\x60\x18\xa9\x9e\xbb\xb8\x35\x0a\x8b\x19\xa4\x49\x02\xf4\xc4\x20\xa3\x14\x63\x4f\x7b\xbf\xff\x2e\xd9\xe2\x46\xc9\xe2\x37\xb3\xf6\x99\xba\x94\xf5\x59\xcd\x1a\xa8\x16\xa8\x34\x3d\x2b\x37\x62\xf0\x4e\xf6\xc8\x16\x3d\xeb\xb7\x36\xcb\xd2\x68\x8f\x00\x27\x9b\xb3\xa7\x46\x94\xb6\xc4\x02\x38\x8d\x4a\x76\x04\x50\x8d\xc2\xbc\xc1\xfb\x91\x37\x13\xfc\xf4\x24\xe9\xab\xd2\x30\x5e\x2f\x9b\xfc\x48\x0f\xfc\x1a\x40\x8b\x27\x25\xf8\x92\xf5\x7e\x11\x8e\x3c\x33\x14\x10\xb8\x7c\x04\xb5\xc1\x1d\x93\xcd\x99\x87\x91\x62\x47\xc6\x87\x7d\x36\x36\x74\x9e\x8e\xc0\xab\xda\xdb\x2a\x70\xd5\x81\xc6\x5d\x2d\x2f\x73\x24\x45\x05\x2d\x2f\x78\x2f\xab\x96\xc5\x50\x6d\x8d\x5a\xa9\xc8\x17\x8d\x58\xb9\x9e\x76\x5e\x9f\xa6\x87\xe0\xcc\x2e\xd8\x72\x2e\x2d\x73\xe6\x48\x3a\xe8\xbb\x93\x7d\x92\xa8\x24\xc1\x2a\xbe\x83\xfd\x18\xee\xa6\x82\x04\xaa\x79\xff\x09\xe0\x57\xd0\xf0\xe0\xf5\xe6\xb5\x38\x00\x1b\xd3\xdb\xde\x94\x8e\x42\x73\x9f\x24\x7b\xf0\xfa\x87\x1e\xa7\x85\xa8\x96\x7b\xe8\xe3\x26\xef\xba\x6a\x49\x5e\xc2\xcf\x80\x24\xbb\x02\xc7\xa3\x56\x94\x01\xb4\x2c\x5b\x5e\xe6\x18\x2d\x10\x14\x00\xb2\xd9\x97\x01\x57\x8c\x3d\x01\x8e\x74\x91\x99\x6c\x65\x9e\x02\x90\x40\x05\xa3\x96\x36\xc2\xe4\xe1\x2b\x3f\x48\x5a\xd3\x01\xc1\x98\x85\xcc\x85\x79\x7d\x91\x24\x72\x49\x40\x8c\xb4\x24\x67\x5a\x14\x54\x3b\x24\x28\x38\xc9\x31\xd7\xbd\xd0\xb1\x46\xd1\x16\xf6\x68\xd1\x01\x0c\x87\x3f\x69\x91\xc9\xd4\x48\x1e\xec\xc2\x16\x20\xe9\x54\xb3\x35\x23\x7d\x4a\xce\x74\x63\xda\xe0\x78\x3c\xc6\xe5\x58\xf1\x4c\xb8\x69\xfa\x54\x1f\xcd\x60\x6e\xf9\x35\x2f\x19\x2f\x33\x3a\x58\xf5\xe9\x5e\x5c\x8f\x4d\xea\xef\x8a\x56\xfb\x85\x1e\x61\x55\x4e\x96\x79\xb1\x67\xfc\xb9\xe7\xcd\x82\xee\x1e\xb3\x8b\xbb\xd8\x33\xc6\x5f\xa1\xf0\xea\x3a\xf2\xcd\xdb\xaf\xbe\x26\xb0\x46\x19\xfd\x28\x03\xac\x13\x47\x44\x90\x5a\x19\x87\xa9\x7a\xeb\xea\x91\xd2\x4a\x00\x12\x95\x96\xc0\x2a\x06\xea\x52\x29\xcc\xf0\x68\xed\x22\x64\x54\xb5\x42\x54\x7c\x22\x93\xa4\xea\x3a\x5a\x09\xe2\x9a\x14\x7c\xc6\xa5\x98\x33\x06\x4b\x3f\xec\x9a\x84\xf4\x3f\x81\x6a\x87\xea\xae\xab\xcc\x86\x85\x97\xd9\x43\x04\x73\xf6\xc8\x97\x0d\xaf\xf8\x75\xce\xd2\x87\x10\xe7\xec\xd1\x2c\x5e\x15\x2f\xf2\xbe\x50\xb3\x7d\xa3\xf7\xb0\x5d\x47\x01\x1f\x2d\x7b\x65\x86\x0b\x9f\xed\xa6\x14\xae\xde\x62\x1d\xcd\x3a\xc8\xcb\xac\x49\x4d\x71\x37\x80\x1e\x14\xbc\x24\x07\x08\x14\x3a\x58\x41\xdf\xd8\x15\xda\xaf\xa2\xe7\xe7\x4e\x61\x87\xf3\xbb\x31\x75\xbd\x01\x3f\x3a\xb7\xd9\xbe\x86\x4d\xf5\x5f\xae\x3e\x7c\xff\x4c\x70\xd9\xd9\x95\x0b\x72\xe1\x35\x27\x60\x20\xc2\x8d\xf8\x15\x88\xb8\xf1\xfd\xb4\x7b\xc6\xb6\x81\xee\x79\x87\x23\xe7\xb6\x5b\xa9\x09\x27\xbb\xa6\xd5\x71\x60\x7a\xc9\x9e\xae\x96\xe5\x30\x98\x2c\xc0\xce\xd8\x3a\xd2\x39\xd5\x75\x35\xaf\x01\xcc\xdb\x19\x72\xd0\x4c\xd3\x43\x86\x81\x7d\x4a\xa2\x31\xb3\xe4\x4e\x26\xa5\xca\xce\x11\x6e\xe7\x4c\x5a\x1f\xf9\xe9\x41\x16\xb8\x30\x61\x9d\x23\x03\xd4\x88\x17\x6f\xbf\xf9\xf6\xda\x9b\x67\xd5\x02\x0f\x31\x60\xf8\x8c\x44\x34\x78\xa7\x04\x6a\xa9\xf0\x8f\x42\x18\x13\x21\x08\x61\xed\x58\xce\x9f\x0b\x3e\xb7\x5f\x1c\x7e\x28\x5a\x6d\xfd\xc7\xfa\x95\x0b\x76\x12\x81\x85\xf5\xd2\xdb\x60\x2f\x63\x6b\x61\xb8\x8a\x85\x06\x96\xe3\x91\xf7\x52\x7e\x00\x7b\xdb\xb3\xbe\x53\x1f\x00\x3f\x38\xa9\xbb\x57\xc5\xee\xab\xaa\x7a\x1e\xce\xd7\xba\x52\x98\xa1\x8e\x27\x57\xa6\x51\xbc\xff\xb3\xd1\x56\x18\xd7\xe2\xca\xc2\x26\x9f\x70\xf3\xb3\x99\xfc\x27\x9d\xb3\x80\xae\xd2\x65\x8b\xc3\x82\x22\x46\x5b\x57\x32\xd7\xe3\xe7\x7c\x00\x9f\x68\xfd\xf1\x91\xe2\xd7\xda\x0b\x90\xe9\xd7\xd4\xf0\x24\xb5\xa7\x5e\xf7\xfc\xa6\xda\x32\x88\x82\x05\xc6\x34\xc4\xb7\x75\x84\x14\x57\x07\xc3\xbb\xfe\xbd\x30\x04\xff\x3c\x8d\xdc\xc3\xd9\xe7\xe3\x0e\x7c\x94\x81\x0e\xd4\x43\xca\x16\x0e\xb5\x3c\xd3\x33\xdb\x43\x14\xc2\x47\x6d\xcd\x91\x6d\xc0\xdc\x39\x89\x95\xc1\x43\xe8\xc5\x1f\x0a\x8c\xf1\x45\x67\x51\x58\x0c\x4b\x91\x60\x77\x5f\xc7\x6f\x18\xc4\x1f\x62\x07\x52\xc9\x66\x75\xa3\x29\xb9\x69\xd6\x8f\xe4\x94\x14\xbb\x0f\xc2\xf1\x0c\xa9\xee\xf0\xb3\xac\xd6\xa6\xfb\x5b\x33\xa9\x1c\x30\xa5\x0d\x81\xdd\xb5\x72\xbf\x6e\x5a\x07\x38\x75\x5a\x85\xc9\x20\x23\x10\x73\x59\x02\xd1\xf1\x5b\x63\x85\x4c\xa8\x0c\xc9\x06\x8d\x1e\x8b\x97\xc8\xfb\xf2\x19\xf2\x08\x78\x4d\x74\xaa\xf4\x70\xa7\x42\xe5\x12\x8c\x56\x3d\x3d\xcf\x9b\xd9\xc7\xf7\xdf\x7d\xa3\xf5\xce\xee\x1d\x43\xfa\x5d\x24\x63\xd3\xe2\x69\x0e\xb8\x0a\x97\xaf\x5e\x7d\x91\xbe\x9a\x7f\x79\xe4\xff\xd2\xc3\xa3\xab\x87\x3b\x45\xd9\xe2\x71\xb6\x6a\x54\x2b\x26\x93\x7f\xe9\x24\x21\xf7\xa5\xbe\x7b\xa3\xe4\x5a\xd6\xba\x2c\xaa\x96\x94\xf5\xd9\xbf\x34\x7f\x84\x07\xc5\xbf\x34\x64\xb3\x95\xf5\xbb\xa6\xbe\x87\xac\xdb\x46\xc3\x0b\xa3\xcf\x62\xc9\x5d\x67\x0a\x9e\x94\x91\x75\xce\x29\x36\x11\xcf\x77\xe4\x16\x51\xda\xea\x99\x4d\x07\x50\xbd\xd2\x12\xed\x84\x25\x58\xed\x4a\xdc\x45\x9a\xab\x56\x82\x6b\x29\x2f\x67\xbb\xa2\x6d\xef\x1b\xb5\x66\x1c\x9e\x46\xc5\xb6\xc7\xfb\x0c\x13\x15\x80\x79\xf6\x09\xcb\x3a\x5f\x04\x19\xdd\xce\x08\xb0\xa2\x06\xb6\xdf\xb1\x34\xda\x3f\x62\x5e\x1e\x7c\x6a\xd7\xc9\x25\xf9\x78\x6e\x7b\x4a\xae\xcf\x81\xd6\x37\x07\x64\xd1\x91\x74\x41\xe2\xae\x25\x8c\x4b\xa6\x4e\xed\x04\xe8\x41\xc1\x16\xcd\x98\x7b\x76\x30\x72\x1a\xd0\x73\x0b\xa1\x66\x4d\x5d\x35\xc5\x1a\x7e\x80\xde\x04\xbf\x60\x67\x0d\xbf\xec\x7e\x1a\x7e\xc3\x66\x15\x94\xb1\xd5\x5d\x51\xdf\x22\xcd\x36\xb7\x06\x04\x50\xdf\x94\xb3\x2d\xa4\x56\x09\x83\xd4\x11\xc0\x30\xd4\x9b\x32\x4d\xe7\xdc\xe6\x64\xa9\xa6\x2e\x9d\xab\x40\x45\x33\x37\x7e\xd1\x4b\x97\x94\x77\xdd\x68\x36\x3c\xb5\x42\xfa\x0f\xbf\x87\xb1\xc6\x41\xb8\xc3\x46\x9c\xaf\x82\x9c\xf2\x41\x67\x4f\x37\x65\x5d\xa8\xc7\xb4\x4f\x3e\xa6\x4f\x70\xbe\x15\x67\x3c\x72\x08\x22\x39\x3d\x95\xa0\x0c\x02\x34\x7c\xab\x36\x94\xf1\x62\xd0\xb6\xae\x45\x1b\xea\xbe\x9c\x07\xc1\x31\xb6\xed\xb3\xbe\x17\x8a\x74\xb4\xed\x83\xce\x34\xaa\xb3\x0a\x4c\x09\x49\xf2\x9c\xcd\xa4\x49\x92\x02\xd0\x29\x79\x63\xde\x8f\x3d\xe7\x8c\xdf\x60\x25\x29\x23\x2b\x1c\x1e\x88\x23\x6a\x54\x6c\x3c\x69\x9c\xf1\xe4\x78\x72\xb0\x00\xaf\x69\xa8\xe3\x54\x79\x5e\x85\x92\xe1\x84\x08\xd5\x22\x80\xa2\x2f\x77\x5a\xe0\x09\x7d\x74\x06\xe7\x4f\x77\x31\x8b\x3d\xc6\xed\xcf\x60\xf9\x59\x74\x4c\xfb\x4c\xba\x5c\x6d\x47\xd3\x1f\xce\xfb\x3b\xd1\x69\xae\x7d\xdb\xc5\xa7\x1b\x9a\xa5\xa6\xd4\xce\x64\x64\x98\x0c\x47\xb8\x7f\x40\x93\x92\xa1\xae\x1c\xe8\x4d\x8c\xcb\xd1\xc6\xf2\x1a\x5c\xa4\x39\x79\x02\x79\x6f\x22\xb6\xbf\x4c\x73\xf1\xd3\x46\x45\x18\x17\xa3\x1b\xf6\x8d\xd9\x0b\xe9\xd3\x77\xf4\x46\x25\x70\x0c\x8b\xa5\x56\x6d\xbb\xe6\x2b\xad\x55\xfb\x19\x79\xad\xc4\x15\x25\xaf\x31\xef\x7f\x12\x86\x41\x4e\xd1\xc3\xc8\x2a\x0f\xce\x54\x4f\xf6\x04\x3f\x75\x19\xde\xe0\x35\x6f\xd5\x2a\xad\x8d\x60\x3f\xb2\x59\x53\x53\x62\x26\xd5\x99\xdd\xde\xc5\x8e\xb0\xca\x79\x6f\x32\x5e\xa2\x5c\x32\x2a\x1f\x0d\xc4\x10\x6e\x3b\xbf\x9c\x7f\x09\x0b\x20\x5e\x9a\x06\x79\x0b\xea\x77\x84\xc1\xa3\x8c\x62\x38\x32\xae\xcb\x24\x29\x69\xef\x1a\xfa\x93\xe6\x1f\xb5\x58\xe6\xfc\x67\x2d\x2e\xa8\x60\x9f\x32\x9a\x89\xa4\x7b\xc1\xba\x4f\x19\xfa\x83\x06\xe3\xd6\x6c\xa2\x76\x29\x59\xd9\xa3\x5e\x3c\xbc\xdf\xb9\x93\xdf\x53\xcf\xd7\x8f\x1a\x9d\xc7\x61\x4b\x87\xe1\x17\x53\xf2\x4b\x60\xdd\x8f\x94\x67\x74\xa6\x91\xa3\x83\xc8\xbc\x08\x0e\xf9\x77\xe4\x79\xb6\x1b\x5e\x88\x09\x92\x53\x40\xce\x24\xa1\x3f\x7b\x84\x81\xbd\xaa\x58\x46\xf6\xaa\x22\x23\x18\x15\xd6\x7a\x0f\x87\x32\xf2\xbf\x7b\x28\xd3\xbf\xd3\x1e\x9d\x10\xf3\x17\xa3\xa0\x8b\xae\x23\xf8\x15\xd0\x9b\x91\xa7\x8e\xc7\x7f\xb2\xd5\x77\xcd\x2a\xb6\x74\x90\xc2\xb2\x41\x02\x35\x2a\x6f\x94\xc2\x8b\x4c\x2e\x8b\x5c\x98\xff\xfc\xc9\xcb\xcf\x78\xf2\x32\x55\x2c\x1d\xb4\x13\xb4\x4f\x70\xc0\xe3\xda\xcb\x9d\xc9\xd8\x9c\xe0\x8a\xa9\x60\x7e\x06\x76\x43\x1c\xee\xe8\x82\x11\xd9\x0d\x9d\xcd\xb2\xf7\xc8\x55\x53\x72\x76\x5f\xb4\x67\x75\xa3\xcf\xcc\x28\x32\x2d\xc6\x9b\xe5\x3c\x3f\xf2\xb8\x35\x04\x6e\xd8\x79\x29\xde\x2c\x55\xce\xdf\x44\xe0\x6e\xec\xa9\x11\x3e\x5e\xf5\xc8\xeb\x11\x84\xd8\x9e\xb7\x2d\xbb\xa2\x6f\x5c\x68\xfb\x0f\x66\xa2\x2a\x96\x42\x71\x25\x97\x4b\x95\xc3\xc7\xc7\xed\xad\x07\x4d\x69\x46\xf1\xbe\xbd\xa3\x8a\x01\xbc\xeb\x96\x96\xcc\xcc\x21\x44\x6b\x6f\x44\xd9\x93\x53\x38\x39\x04\x8e\xf2\x78\x40\xfb\xcd\x35\xc0\x7d\x40\x5d\x05\xa5\x3f\x69\xf1\x76\x56\x6e\x77\xb8\xe1\x82\x91\x34\x92\x91\x9a\x51\x67\xf6\x09\x66\xec\xd5\x52\x99\x7b\x82\xbc\x36\x83\xed\x3f\x5f\x5f\xe0\x9f\xf0\x82\xf0\x57\x42\x88\x9f\x74\xb0\x57\xf0\x47\x7d\xd6\xfb\x05\x8a\x18\xdf\x86\x9f\xfa\xee\x65\xcb\x3c\xa5\xa3\x01\xe9\x14\x4d\x16\x46\x48\xeb\xae\xa3\x63\x5f\x99\x51\xaa\x04\xfd\xc3\xdf\xc9\x86\x27\xd9\x37\x45\x2b\x4d\x32\x1c\x5d\xbf\xf5\x6e\xeb\xd6\xb3\x6b\x44\xd0\x31\x96\x6a\xf1\x16\x28\xe3\xea\x24\x59\xe6\x9c\x96\xe2\x7b\xf4\xfd\x90\x8c\x65\x4b\x3d\x78\x43\xb9\xbc\xcc\x59\x9e\xd2\x52\x3c\x20\x30\xaf\xe6\x0d\xf4\x6c\xe3\x09\xef\xae\x68\xc3\x7a\x61\x7c\x35\xdb\x4a\x75\x2b\xe9\x32\x37\xfa\x6f\xbf\x1d\x63\x28\x42\x41\xf6\x58\x73\x01\x68\x4c\x9f\x13\x50\xb0\x73\x6c\x85\x0c\x31\x0a\xdc\x2e\xf4\xfc\xf2\x35\x12\xc2\x1d\xcc\x0c\x44\xeb\xb9\xd9\x78\x9b\x7d\x3a\x5e\xcd\xb9\xb9\x06\xef\x69\x1a\x19\x8f\x52\x3d\x82\xa9\xab\x91\x93\xf5\x87\x0f\x57\xd7\x84\xf1\xf9\xeb\xa2\xff\xbe\x53\xc3\x4b\xd9\x75\x43\xdb\x0b\xfa\x95\x59\x8b\x2c\x1b\x20\x17\xcb\x68\x16\xf2\x62\x66\x72\x53\x95\x99\x25\x73\x5d\x1e\xfe\xd3\x43\xc2\xd1\x60\x04\x9a\x0e\x81\x80\x61\x33\x0b\xcd\xca\xe5\xa6\x6e\x9d\x24\xf1\xda\x5b\x9c\x6c\x90\xeb\x10\xa7\xb1\xe9\xba\xc0\xcc\x0f\xba\xb3\xe6\x32\x07\xd0\x72\x6b\xa8\x18\xec\x6e\x2d\xc6\x61\xd0\x3b\x91\xd5\x4f\xc9\x1d\xf5\x00\x9c\x63\x3b\x79\xe1\x88\x8c\x8e\x6e\x6b\x6b\x5e\x81\xbb\x60\x08\x41\xf9\x00\x3f\x9f\x07\x0b\xf2\xe7\x24\x3d\xe7\xb2\xa5\x2f\x24\x10\xa5\x47\xc1\xa3\xe0\xe9\xb8\x20\x46\x45\x2e\x57\xc0\x66\xdf\x53\xff\x79\xb2\x43\x41\x94\xac\x0a\x5d\x1e\x00\x54\x52\xac\x6c\x1d\x28\x62\xe3\xda\xa2\xc1\x58\xca\xf7\x7d\x42\x05\xc4\x7d\x9c\x46\x0c\x8a\x55\xd7\x91\x4d\xf9\x00\x50\x6f\x00\x09\x7e\x7e\xf9\x9a\x36\xd3\x7d\xb8\xe4\xed\x75\x43\x58\x46\x0b\x41\x95\x58\xf9\x4a\x50\xc6\x66\xba\xd9\xf1\x52\x28\xe0\xea\x03\x03\x76\xc0\x19\xd8\xb0\xae\x9b\xf3\x32\x4c\xda\x23\x25\xf3\xd6\x85\xf2\x6b\x17\x6a\x5a\x47\x40\xbd\x66\x5a\x59\x88\x64\x3d\x03\x44\x53\xba\x31\x7f\xf1\xea\xbc\x35\xff\x4f\x8b\x3e\x8b\x79\x3b\xe4\x31\x3f\xec\xf5\x79\x0b\x7f\xa6\x25\xe3\x64\xdf\x1a\xd9\x56\xd6\x67\x3a\xd3\x33\xb8\x70\xef\xdd\xb0\x94\x9e\xa0\x0f\x6c\xc2\x77\x4e\x05\x70\xb8\xf1\x91\x5c\xd1\x6b\x5d\xbe\x15\xb4\xf6\x86\x21\x5f\x54\x60\x3d\x6c\x06\x63\x03\xa3\x9b\x9f\x03\x51\xf1\x8b\x17\x82\x7f\x8c\x99\xc5\xc0\x6c\x89\xa5\xce\xfc\xd8\x73\x88\x04\x10\x9a\xe0\xf1\x60\x86\x48\x0f\x2a\x53\xcf\x19\x6b\x32\x07\x2d\x30\xc6\xc9\xc8\x6b\xb3\xb5\x0b\x6d\x96\x61\x0c\x26\x7f\xd2\xcd\x2e\x95\xd0\x6a\xf5\x6c\x57\xdc\xca\xbf\x63\xa5\x38\xb0\xfe\x4b\x6c\x27\xbc\xf3\x11\xef\x1c\x59\x0a\x0f\xcd\x31\xcb\xfc\xe8\x80\x08\xb8\x67\x94\x0f\xf5\xd6\x8d\x37\x77\x86\x50\x24\xee\xeb\x78\x29\xe2\xc2\x8c\xba\xd5\x0f\x6e\x9c\x07\x2a\x9c\x73\x4c\x3f\xff\xad\x78\x84\x6b\x19\x00\xfd\xfc\x3a\x69\x00\x6e\x9a\x0b\x6f\xff\x00\x46\x3e\xb3\xb7\x70\x51\x5d\x76\xb9\x71\xf6\x57\xb0\x08\x0b\x51\xc3\xba\xde\x75\xf8\x7b\x90\x17\xe0\x26\xfc\xe4\x1f\x91\x13\x60\xb2\x0d\x80\xa4\x64\x92\x48\xa4\x8b\x39\x61\x34\xa0\x25\x88\x14\x5f\x7b\x86\x03\x7a\xc0\xcc\x7e\xdd\xec\x3c\x27\x3b\xe3\xa5\x1d\xcd\x83\x4c\xdf\xc9\x8d\xee\x73\xb9\x13\x18\x68\x6e\x9c\x94\x25\xfc\xef\xdb\x18\x39\xb5\xae\x9b\x1d\x96\x0a\x1d\x62\x27\x26\xbe\x61\x98\x15\x58\x45\x31\xc4\xf0\xc8\xc3\xf6\xfc\xb7\xa2\x5a\x82\xce\xc2\xa7\xfb\xa6\xff\x03\xad\x1a\x3d\xe8\x6c\xe0\x5d\xa7\xe4\x31\x46\xfb\xf5\xfc\x72\x29\x09\xc6\x32\xe1\x9e\x8f\x0e\xd3\xed\xe8\x0f\xc3\x1f\xb5\x0b\xfd\x6a\x44\x94\xc5\x68\xa9\x88\x60\x1c\xc1\x10\xfe\x21\x92\x4f\x70\x68\xa4\x92\x65\x4a\xc8\xf4\x7f\x0f\xc7\x80\xd9\x4b\x04\x73\x94\x8d\xd0\x56\xab\x4c\x2d\xcb\x1c\x20\xe0\x16\x46\x32\xb8\xcf\xa0\x4d\xa6\xc2\xc9\x9a\xd6\xbc\xc9\xea\x54\x85\x53\x9b\x59\x98\xb9\xa3\x91\x39\x7c\x94\xd8\x33\x00\x75\xde\x11\xbb\x0a\x45\x27\x66\x75\xc4\xd0\x5a\xe7\x48\x55\xbb\x2b\x1f\x64\xf5\x83\x63\xf7\x3d\x81\x85\xd0\x1e\x3a\x03\x19\x99\x6b\xc6\xdf\x7b\xf6\xe7\x0c\x86\x7d\xbf\x50\x2d\xeb\x1c\x68\x64\x8d\x0e\x13\x74\xa4\x65\x2c\xf7\x0c\xcb\x30\xc0\x53\x4b\x1c\x13\xf4\x5a\xc1\x5b\x08\x51\x85\x87\x3c\xeb\x2c\xa8\xe5\x64\x5a\x38\xeb\x4a\xda\x72\x42\x52\xd2\xec\x35\x24\x07\xcf\x2b\x24\xa1\xdd\xd4\xcb\x26\x1f\x8d\x85\x1b\xb6\x9b\xe9\xb7\x00\xa9\x3a\x08\xb2\x34\xab\x6d\xd7\x51\x38\xf5\x97\x5d\x37\xc1\x25\xc2\x51\xd8\xa5\x8e\x60\xd8\x1f\x6a\x7c\x76\xe0\xd8\x3c\x30\x78\xe6\x80\x6c\xef\xd7\x7c\xfc\x0a\x96\xc9\xa5\xff\xce\x3c\x95\x5e\x58\x0d\xa5\xd6\x92\xac\x40\x74\x42\xb6\x78\x0c\x66\x38\x04\xe3\xfc\xdc\x73\xc2\x49\x10\x86\xb0\x83\x6c\xaa\xca\x3c\xcf\x55\x74\xe5\x32\x38\xea\x65\xcc\x10\x5f\xf5\x2f\x67\x2e\x64\x46\x20\x13\xad\xa5\x9e\xe5\x25\x4b\x23\x5a\x5a\x5e\xb2\x23\x6f\x79\x9d\x39\xe8\x1b\x7b\xe2\x17\x0c\xd7\xde\x41\x94\xf7\xa7\xcf\x7c\x70\x74\x1d\x1e\x82\x47\xc7\xe3\xbc\xf7\x12\x1b\x46\x03\x7d\x66\x9e\xa3\xfc\x02\x12\x84\xd3\xd8\xf0\x9b\x72\x60\x9e\x1a\x62\x78\xe3\x6c\x82\xc8\x72\xf3\x39\x7c\x5f\x9f\x3c\x32\x78\x60\xb3\xf1\x4f\xb0\x23\x77\x00\x0e\xbf\xc3\xfb\x10\x90\x34\x98\x77\x3c\xf3\x94\x7f\xc6\x2c\x4b\x27\xd0\x52\xc1\xeb\xc9\xcb\x97\xc4\x9e\xfb\x99\x04\xcd\xc1\x29\xfa\x25\x81\x4f\xb8\x6b\x0e\x9f\x8d\x86\xdf\x36\xfb\x56\xca\x5a\x4b\x65\x66\x3c\x5c\x55\xb2\x38\x48\xaa\xbb\x4e\x86\xe2\x87\xdc\x54\x7b\x75\x06\x21\xe9\x67\x36\x4e\xfd\xcc\x05\xa8\x9f\x29\xd9\x96\xff\x92\x67\x38\xea\xce\x56\x55\xb9\xfa\xed\x6c\x7d\x53\xe1\x0f\x28\x74\xdd\xdc\xd7\xf8\x6b\xbf\xc3\xbf\x66\x63\x88\xbf\x4c\x15\xed\xaf\xbd\x3e\xeb\x6b\x74\xd6\x57\xe7\x0c\xad\xdd\x67\x18\x24\x7c\x86\xc1\xc5\x67\xbf\xc9\x47\x28\xf7\x37\xf9\xb8\x53\xb2\x6d\xcd\x8f\xfd\xee\xcc\x86\x61\x6c\x65\xbd\x27\x81\x53\xd0\x89\xc8\xdc\xd4\xc0\xa3\x35\xd6\x36\xf3\x13\x9c\xd4\xcc\x75\x5d\x8d\xfd\x0d\x68\xc9\x18\xb5\x69\x5d\x2c\x6a\x1f\x8b\xf8\x67\x2d\x2e\xfe\xb1\xfc\xd4\x7e\xda\xbf\x7b\xfb\xee\xdd\xa7\x87\xaf\xe6\xf9\xb4\x1b\x5c\xbf\x00\xa0\xb1\x9d\x6a\x1e\x1e\xc7\x23\x7f\xd1\xec\x7a\x62\x68\x43\x03\x02\x44\x0f\x63\x0c\x4b\x6d\xf6\x03\x01\x8b\xa5\x68\x51\x39\xef\xf7\x95\xaf\x98\xd9\xce\x9f\xaa\x01\xd2\xed\x06\x6d\xf0\xbc\x72\xa1\x1a\xc3\x12\x00\xb3\x10\xcc\x8d\x42\x86\x7f\xba\xee\xca\x1a\x21\x79\x69\xb4\xf5\xbb\xa6\x5a\xff\x28\x8b\xf5\x63\x8c\xc5\x03\xe0\xc4\xc5\xfa\xf1\x6f\x45\xa9\xa7\xd3\xd4\x5e\x01\x4d\x08\x78\x5d\x80\x83\x9d\x88\xc2\x49\x9d\xc9\xe5\x2f\x57\x1f\xbe\x17\x41\x48\xd2\x95\x8f\x72\x15\x5f\xc1\xb3\xef\xec\x8b\xc4\x16\x2e\x11\xbc\x44\x3c\xf0\xab\xd9\xaa\xd8\xca\xea\x4d\xd1\x4a\xf1\x91\x5f\xa1\xf1\xfb\x1e\x9e\xbf\xf7\xd0\xfa\xf0\xc8\xf7\xfb\xad\x54\xe5\x6a\x84\x4f\x04\x9f\xa2\xd2\x2d\x06\xc1\x4e\x47\xe8\xd0\xd1\x5b\x18\xad\x73\x52\xb6\xdf\x17\xdf\x53\x19\x92\xd7\x4b\x04\xbe\xd7\xaa\xdc\x3e\x8f\x93\x04\x08\x62\x54\x46\xc1\x12\x7f\x06\x37\xf8\x23\x27\xee\xa9\x7e\x00\x20\xb2\x78\x92\xe0\xdf\x59\xb1\x5d\xbb\xdf\x94\x60\xa0\x11\xe1\xcb\x7c\x84\x29\xfe\xca\x8e\xce\xbf\x6b\xf1\x66\xf6\xeb\x5f\x4d\x4e\xfe\x57\xf3\xfb\x45\xcf\xf3\x53\x37\x6f\x9a\x7a\x53\x95\xab\x51\x5c\xa7\x37\xb3\x17\x46\xfb\x4b\x12\x6a\x7e\xfd\x55\x03\x55\x8a\x2b\xcb\xdf\xb1\x97\x7f\xd7\x8c\x5f\x1d\xf9\x28\x16\x7a\x98\xcf\x14\x75\x65\xb2\xb2\xc5\xff\x09\x00\x00\xff\xff\x49\x22\x74\xca\x83\x5d\x01\x00")
+var _jsLibJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\xbd\x6b\x97\xdb\xb6\xf5\x2f\xfc\xfe\xf9\x14\x23\xd6\x65\x00\x0b\xd2\x48\x4e\xd3\x73\x4a\x0d\xa2\xe5\xd8\xce\xa5\xff\xa4\xb9\x8c\xd3\x24\xe5\xc8\x59\xb4\x04\xcd\x30\xe6\x80\x0a\x09\xcd\x25\x43\xf5\xb3\x3f\xeb\x87\x1b\x41\x8a\xe3\xa4\x3d\xe7\x24\xcb\x23\x12\xc4\x1d\x1b\x7b\x6f\xec\x1b\x4e\x9f\x8e\x4e\x7e\xf9\x76\x2f\xaa\xfb\x93\x9b\x0f\xa7\x1f\x4d\xe7\x27\xcd\x09\x59\xd3\x93\xbf\x9f\x9f\x7c\x5a\xee\xe5\x26\x53\x79\x29\x4f\x32\xb9\x39\x29\xd5\x95\xa8\x4e\xd6\xa5\x54\x55\xfe\x76\xaf\xca\xaa\x3e\x69\x4e\x7e\xf9\x15\x45\xa7\x65\x75\x79\x5a\xe4\x6b\x21\x6b\x71\xf2\xf4\xf4\xff\x1b\x6d\xf7\x72\x8d\x82\x44\x30\x45\x1f\xa2\x7d\x2d\x4e\x6a\x55\xe5\x6b\x15\x2d\xa2\xf2\xed\x2f\x62\xad\x22\xce\xd5\xfd\x4e\x94\xdb\x93\xeb\x72\xb3\x2f\x44\x1c\x3f\xf2\x61\x2a\xee\x76\x65\xa5\xea\x65\xf7\x95\x8b\xe9\xa6\x5c\xef\xaf\x85\x54\x4b\x45\x04\x1b\xcd\x68\xd2\xb6\x4a\x1f\xf2\x2d\x19\xb5\x59\xa8\xba\xaa\xca\xdb\x13\x29\x6e\x4f\x5e\x55\x55\x59\x91\xc8\x8e\xb9\x12\xbf\xee\xf3\x4a\xd4\x27\xd9\xc9\x6d\x2e\x37\xe5\xed\xc9\x6d\xae\xae\x4e\xb2\x13\x57\x32\xa2\x8b\x4a\xa8\x7d\x25\x4f\x14\x11\xf4\x90\xe8\xbf\x24\xda\xcb\x8d\xd8\xe6\x52\x6c\xa2\x91\xeb\xae\x29\xbf\x34\x3f\x89\xba\xca\x6b\xe6\x3b\xf4\x82\x89\xde\x34\xdc\x64\xd5\x89\xe2\xe9\x8a\x55\xfc\x6b\x3d\xee\xe9\xa5\x50\xdf\x54\xa5\x2a\x51\xdd\xd7\x5b\x56\x73\x35\xad\x31\xa7\xec\x92\xab\xe9\xb6\xc8\xd4\x32\x1c\x9f\xeb\x94\xfe\x32\x5d\x67\x45\x81\x8e\x25\x83\x59\xd6\xa5\x5c\x67\x6a\x9a\xed\x76\xc5\x3d\x49\x57\x4c\xd0\x03\xdb\x73\x35\xdd\xed\xeb\x2b\x96\x73\x35\xcd\xe5\x46\xdc\x7d\xbd\x65\x92\x3f\x1c\x58\xc9\xe5\x54\x95\xe7\xaa\xca\xe5\x25\xbb\xe1\x72\x7a\x95\xd5\x5f\xdf\xca\x6f\xaa\x72\x27\x2a\x75\xcf\x32\x7e\xd3\x7e\x2f\x78\x66\x1a\x37\x83\xa0\xec\x1e\x55\x5c\xf3\xe3\x7e\x44\x2e\xa9\x5d\x60\x2c\xba\xdc\x5f\xbf\x15\x55\x3b\x8b\x62\x2a\xcb\x8d\x78\x7d\xbf\x13\x07\x76\x37\x50\xcd\x89\xdc\x17\xc5\x88\x8b\x38\x16\x9c\x73\x31\x35\xd3\x7d\x60\xaf\xf8\x0b\xbf\xda\x6c\xcd\x1f\x30\x8b\xc9\x68\xc6\xea\x6a\x8d\x1f\x59\xca\xb5\x30\x0f\x5f\x69\x38\x4a\x46\xb3\xc3\xc2\x55\x7f\xf2\x16\x80\xca\x24\x7d\xc0\xb2\x54\x2c\x67\x25\x27\x92\xcb\xa6\x79\x45\xa7\xeb\x4a\x64\x4a\xbc\x2a\x04\xc0\x81\x44\xf5\xba\xca\x77\x2a\xa2\x8b\x7c\x4b\xca\xa9\x12\x77\x8a\x03\xc8\xb7\x65\x45\xaa\x93\x5c\x9e\xac\x29\xc9\xb9\x4a\xab\x55\xd3\xe8\x35\x7d\xae\xcc\x7e\x11\x71\xdc\x7d\x27\x15\xa5\x71\x5c\x4e\xeb\x4e\x1a\xcb\xe9\x42\x4e\xaf\x44\xb6\xc1\x7a\x09\xb9\x79\x71\x95\x17\x1b\x52\xd2\xe9\x2e\xab\x84\x54\xff\x28\x37\x62\x5a\x89\xeb\xf2\x46\xb8\x2f\x07\x3f\x8c\xdb\xde\x3c\x71\x2e\x96\x62\x1c\x45\xc9\xd1\xde\x12\x4d\x33\xb4\x20\x4b\x99\x96\x0e\x98\x56\x4d\xe3\x8a\x25\xee\xfb\x01\xf3\xb3\xe5\x91\xc6\x14\x11\x3b\x0f\x16\x88\xa9\xb6\x69\x71\x7b\x72\x3e\xdd\xca\x69\x2e\x73\xec\x4d\x45\x83\xa9\xde\xa1\x8f\xa8\x46\xf1\xd1\x08\x10\x50\x08\x79\xa9\xae\xa2\x5c\x9e\x60\x51\xa7\xe6\x95\x49\x8e\xc1\xd8\xbd\x37\xba\x26\x82\xc6\xf1\xe8\x4e\xff\x90\x28\xab\xaa\xec\x3e\xe2\x1c\x2b\x34\xe3\x9c\xab\xa6\x71\x80\xe4\xc7\xa2\xe2\x78\x76\xa6\xe2\x58\x4d\xe6\x58\x16\x41\x0f\xe8\x12\x3f\x9f\xee\xdc\x26\xe3\x0f\x06\x7b\x25\x5b\xb6\x2e\x65\xad\xaa\xfd\x5a\x95\x55\x72\xce\x4c\x17\x92\x19\x53\xe5\x73\xb4\xd4\xee\x2a\x3f\xc4\xda\xcc\x12\x76\x39\x3d\xb0\x4b\xa1\x86\x76\x9e\x5b\x82\x30\x73\x22\xce\x66\x4b\x14\x4b\xc5\x18\x3f\x76\xbc\x2b\x8d\x30\x52\xb1\x3a\x30\xec\xca\x73\x95\xad\xdf\x75\xaa\x34\x33\x76\x3e\xbd\x16\xd5\xa5\xd0\x55\x4d\x83\x4e\x13\xca\xfc\x64\x9d\xa8\xe9\xae\x12\x37\x66\x43\x72\xe4\x64\xea\xc0\x44\xb6\xbe\x1a\xea\xe3\xf9\x14\x5f\x74\x85\x1a\x2f\x5c\x67\xbb\x36\x9b\xf4\xd9\xf0\x7d\xea\x7b\x46\xce\xa7\xd7\xd9\x8e\x74\x71\x5c\x07\x02\x2c\x10\x31\x85\x4a\x29\x3d\x30\x8d\xca\xda\x9a\x1f\xab\xb8\xb6\x58\x4a\x57\x9d\x55\x97\x7a\x3f\xd7\xa8\x60\x9b\x57\xb5\x7a\xac\x02\xf1\x2b\x99\xd1\x03\x2b\xb2\xf7\x66\x99\xcc\xe9\x81\x89\x1b\x21\x7f\xbf\x1f\xe7\xd3\xcb\x4a\xbc\x67\x84\x44\x8d\xe7\xf4\xcf\xcf\x0e\xe8\x59\xb9\xd9\xfc\x9f\x57\x78\xa2\x6c\x6d\xe2\xd7\x81\x75\x0f\x20\x85\x49\x3e\x16\x63\xa2\xc1\x28\x99\xd1\xc5\x70\x73\xb3\x33\x2e\xe3\x58\x9e\xa9\x65\x8a\xb2\xa9\x5c\xad\x92\x74\x85\xea\xe5\xe3\x9d\xf5\x50\xd3\x34\xc7\x00\x66\x00\x33\xd9\xb3\xba\xac\x54\xa2\xa6\xf8\x61\xf5\x4e\x2f\xab\x9a\x9a\x87\x03\x3b\x9f\x8a\x3b\x25\xe4\x86\x63\xb3\xb9\xe7\xa0\x3d\x80\xb1\x46\xb5\x4c\x63\x59\x96\x71\xbf\xc8\xe9\x6c\xd5\x34\x0f\x07\x56\xf3\x39\xdb\xb7\xc9\x6e\xd8\x05\x1f\xcd\x17\xc0\xb2\xd1\xdb\xb2\x2c\x44\x16\xa0\xad\x2c\x8e\x49\xc1\xb3\x4e\x65\xb5\xad\x6c\x3c\xa6\xec\x08\xfb\x65\x4d\x73\x4d\x32\xda\x34\x24\xe3\x0f\x07\xca\x6a\xce\xf9\x3e\x8e\x49\x66\xb6\x4b\x3d\x99\xd0\x45\x7d\xb6\x5f\xd4\xe3\x31\xcd\xb7\x04\xfb\x78\xc4\x89\xe8\x54\x4f\x29\x3a\xa3\x0c\x6e\xa9\xb8\x48\xd5\x8a\x45\x3f\xff\xac\xb1\xcb\xcf\x3f\x47\x23\xce\x55\x1c\x67\x23\xce\x2b\xf4\x2e\x8e\xf1\x73\x3e\xcd\xeb\x6f\x8a\x2c\x97\x66\x73\x92\x0a\x5d\xc8\xb9\x46\x32\xd3\xbc\xd6\xbf\x20\x0b\x74\x49\x24\xcf\x50\x63\xc9\xf3\x38\x1e\x75\x33\x48\xba\x4c\x57\x49\xde\x34\xfd\xea\x24\x5d\xca\xe4\xe1\xc0\x72\x3e\x9a\x33\x14\xe7\x6e\x39\x48\xc1\x4a\x56\x51\x9a\xdc\x94\xf9\xe6\x64\x66\x7b\xa5\xb3\x54\xd4\xc3\x50\xd6\xae\x1f\x79\x10\x77\xbb\x4c\x6e\xca\xc4\x72\x4a\xd1\x98\x6c\xc7\x5f\x65\xea\x6a\x5a\x21\xf9\x9a\x50\x3a\xad\xc4\xae\xc8\xd6\x82\x9c\x5e\xbc\x3c\xbd\x64\x51\x44\x59\x5e\x7f\x27\xb2\xcd\x3d\x08\xad\x00\x9f\xd5\x01\xe5\x3e\x0f\x06\x74\x23\xcb\x32\xc0\x37\x14\x9d\x0f\x87\xd4\x29\x0f\xd8\x51\x4c\xda\xde\x8e\xc8\x08\x44\x2c\x35\x6b\x7b\x62\xf2\xaf\x30\xef\x9e\x82\x81\x56\x8c\x88\xe2\x68\x8a\x0e\x11\x3c\x22\xf9\x8d\xc5\xcd\x2c\x0a\xa0\x3d\xa2\x71\xac\x42\xf0\xa7\x71\x6c\xf9\x1c\x49\x39\xe7\x05\x45\x3f\x5f\x5d\xef\xd4\xfd\x63\xfd\x5c\x84\xd0\x61\x3a\x3c\x77\x3d\x9f\x1d\xd8\x65\x51\xbe\xcd\x8a\x57\x37\x59\x11\x14\xc5\xbe\xa0\x0f\xe0\x45\x1e\x0c\xbf\x02\xf2\x35\xd5\x8f\x07\x26\xe9\x11\x12\x07\xf6\xc0\xa4\x48\x56\xf1\x19\xf8\x11\xd0\x56\xfa\x80\x96\x25\x77\x94\x74\x51\x9d\xc9\x45\x65\x00\x79\x34\x07\xb1\xb4\xd3\x93\x56\x2b\x56\x31\x91\x56\x2b\x4a\xdf\x56\x22\x7b\x77\x10\x45\x2d\x4e\x3c\x23\x23\x7e\xbf\x84\x1d\xd0\x89\x38\xb0\xeb\xec\x9d\xe8\x11\xcb\xb6\x7f\x20\xd0\xe9\x6a\xd1\xe7\xe0\xc8\xce\xb2\x8d\xe8\xf6\xd2\xd1\x36\xc9\x22\x9c\x15\xe4\x65\xbb\x5f\xc5\x32\x15\xab\x44\xd0\x64\x6f\x17\x81\x09\x4a\x99\x3c\xb0\x5c\x1e\xb7\xc9\x5a\xaa\x85\x96\x38\x57\xcb\xc9\x3c\xc9\xdd\x3a\x0b\x3d\x93\xba\xa9\x5e\x57\x31\x72\x3d\x9d\x7c\xac\x1c\xd6\xa9\xf8\x8c\xe5\xc7\x73\x29\xd2\x7c\x3c\x5e\x69\x36\xcf\xcf\x81\xcd\xc3\x73\x26\x0e\x0c\xc8\xfe\xa8\x57\xae\x81\x0a\x7c\x7f\xce\x67\xac\xf4\x35\xb3\x8c\x8f\xe4\x22\x3f\x2b\x17\xf9\x78\x4c\x47\x8a\x88\x34\x5f\xb1\x9c\x8e\x38\xcf\xe2\xb8\xd2\xa4\x57\xa7\xf9\xcd\x5a\xf5\x68\xf5\x11\x03\x3b\x63\x19\x4f\x57\x1e\x2c\xd0\x78\xd5\x8e\xa4\x3c\xab\x16\xe5\x78\x4c\x2d\x6e\xcb\x39\x9a\x2c\x57\xac\x64\x12\x6c\x69\x66\x5a\xcc\xe9\xc2\x03\x45\x69\x80\xf9\x77\x0b\xd8\xfe\x5d\x92\x0c\x9c\xd1\x3e\xdf\x24\x73\x56\xef\x77\x38\xc4\x25\xf7\x07\xca\x06\xf8\xce\xf3\xfb\xeb\xb7\x65\xa1\x11\xe4\x56\xa6\xe6\x6d\x9a\x2b\x51\x65\xaa\xac\x30\xcd\xfd\x24\xca\x2c\xdf\x12\x7d\x62\x88\xc1\xc9\x3f\x34\xfb\x77\x62\x8e\x2d\x27\x9f\xda\x26\x4e\x34\x78\x9c\xbc\xcc\x94\x38\xf9\x4e\x5c\xbe\xba\xdb\x59\x44\x61\x8e\x81\x27\xa6\xde\x48\x93\x2f\x45\xa2\x93\x88\xf6\x88\xb3\x4c\x3d\x86\x89\xc6\x6a\x1c\xad\xa2\x15\x57\x53\x55\x7e\x59\xde\x8a\xea\x45\x56\x0b\x42\x0f\x74\x81\x45\x0d\xc8\x9c\x5d\x06\xc1\x36\xec\x2d\x2b\x59\xce\xae\xd8\x96\x5d\xb2\x5b\xb6\x67\x05\x7b\xcd\x5e\xb0\x8c\xbd\x62\x37\xac\x66\x6b\x76\xcf\xce\x79\x54\xe7\xbf\xfd\x56\x88\x68\x3c\x7f\x0a\xe4\x88\xce\xb2\x1d\x97\xed\x71\xe6\x1d\x9f\x61\x87\xb3\x6b\xbe\x17\x84\xb2\x3b\xf3\xf3\xdc\xfc\xfc\xc3\xfc\xbc\xe4\x83\x5c\x05\x0e\x49\x0a\x04\x88\x8f\x66\x94\xcd\x0e\xec\x17\xfe\x70\xe8\x9f\xe8\xf4\x39\xf4\x57\x1c\x08\xcb\x1d\xfb\xd2\x1d\x0c\x3f\x77\x0f\x5f\xfb\x93\xe8\x37\xfc\xb1\x1d\x33\x63\x01\x6c\xc9\xb3\x6a\x21\x0d\xc6\x11\xa9\x5c\xa1\x0b\x16\x07\x9e\x38\xec\x3d\x99\x1f\xd8\x77\x3c\x5a\x5f\x89\xf5\x3b\xb1\x69\x6a\x51\x88\xb5\x12\x9b\x26\xab\xef\xe5\xba\xc9\xf6\xaa\xdc\x96\xeb\x7d\xad\x9f\x76\x45\x76\xdf\x68\xb9\x43\x59\xd4\xcd\x46\x6c\x45\xd5\x6c\xf2\x3a\x7b\x5b\x88\x4d\x73\x95\x6f\x36\x42\x36\x79\x7d\x9d\xed\x9a\xa2\x2c\x77\xcd\xf5\xbe\x50\xf9\xae\x10\x4d\xb9\x13\xb2\xa9\x44\xb6\x29\x65\x71\xdf\xd8\x93\xfe\xa6\xa9\xd7\xe5\x4e\x6c\x22\xf6\x15\x8f\xd2\x8b\x8b\xbb\x67\xb3\x8b\x0b\x75\x71\x51\x5d\x5c\xc8\x8b\x8b\xed\x2a\x62\x5f\xf0\x88\x2c\x93\x8b\x8b\x8b\x8b\xf4\xe2\x62\x93\x4d\xb6\xcf\x27\x9f\xae\x1e\xe6\xec\xaf\x87\x68\xfc\xd5\x38\x5a\x36\xfa\xd3\x9b\xb6\x48\x93\x5e\x5c\xdc\x4e\x56\x4d\xfa\xe6\x62\x36\xb9\xb8\xb8\xfb\x5f\xdb\x15\x1d\x47\xec\x07\x1e\x5d\x5c\xa4\xba\xcc\x53\x12\x8d\xbf\x18\x47\x94\x2c\x13\xfb\x9e\x3e\x7d\xf3\xa4\x19\xfd\x7b\xb5\xe4\xd4\xa6\x2c\x93\x0f\x88\x6d\x77\x8a\xaa\x2e\x2e\x2e\x3e\x58\xd1\xa7\xf4\x83\xe6\x22\xea\x7f\xb8\x88\xf0\xe5\x22\x6a\x6c\xbd\xb4\xb1\xb5\x5c\x5c\xac\x22\xf6\x29\x8f\x92\xb6\xc1\x8b\x0b\x42\xc8\x7f\x5e\x35\x6d\xfa\x5f\x08\x4d\x2f\x2e\x56\xab\x26\x1a\xff\x30\x8e\xe8\x53\xda\x4c\x9f\xd2\x8b\x0b\x34\xcd\x3e\xe1\x00\x5c\xb3\xc1\xc8\x57\xe3\x68\x1c\xb1\xe8\x32\xa2\xec\x49\x98\x1e\xbd\xd1\x7d\x1c\xeb\x8a\xdf\xd8\x4a\x57\xd4\xb5\x42\x9f\x9a\x31\x8c\x9f\xd8\xc2\x3f\x0f\x14\x7e\xca\xcc\x4f\x44\xd9\x6f\x43\x9f\x49\xfa\xf1\xf8\xdf\xe8\xe2\x57\xe3\xc8\x4e\x49\x44\xd9\xf7\x61\xd6\xaf\xc6\x51\xf3\x71\x44\xd9\x8f\x61\xe2\xa7\x94\xfd\x33\x7c\x47\x7d\x5f\x8c\xa3\x27\x11\x65\x9f\xf1\x87\x2f\x5e\x26\x9d\x6f\x7f\xb2\xb3\x1b\x51\xf6\xe2\xcb\xe7\xe7\xe7\xdd\xaf\x17\x17\xd3\xf6\xfb\xeb\xe7\x9f\x75\xbf\x9a\x4f\x4d\xfa\x74\x85\xcf\xcf\x5f\xbf\xfe\xae\xfb\x3d\x1a\xff\x40\xd9\x37\xe7\xaf\xbe\x7f\xf9\x75\xff\xc3\xa7\x94\xbd\xf8\xfc\x8b\x2f\x7b\x9d\x49\x88\x06\x6f\x7d\x3c\x6a\x70\x00\x6a\xa4\xba\xc2\xbf\x09\x5e\xe8\x84\xac\x21\x26\x68\xca\xed\x04\xc8\xd6\x42\x84\x9d\x2d\x9c\x85\x9a\x72\xb3\x69\x08\x49\xc7\x93\x55\x43\xc9\xc5\xc5\xe6\x29\x95\x0e\x9c\xc8\x32\xb1\x1f\xec\xfb\xc5\xc5\x66\x4c\x1b\x6a\x3f\xdb\xd5\x8f\xf2\x88\x32\x30\xe5\xdd\x8e\x69\x60\xff\x6e\x1c\xd1\x27\x36\x8b\x14\x62\x53\xbf\x28\x25\x64\x26\xdd\xac\xa6\x3a\xb3\x76\x49\xdb\x2b\xf1\x6b\x73\xa9\x9a\x42\x61\x34\xc1\x00\xbb\x63\x20\xcb\x64\x72\x71\xb1\xa1\x4b\xdd\xf5\xa0\x63\x64\xc9\xd3\x37\x93\x55\xf3\xc4\x76\xf1\xc0\x7e\xe2\xa7\x9f\xbf\xfe\xea\xcb\x27\xa7\x39\xfb\x96\x9f\xbe\x21\xcb\x24\x97\xbb\xbd\xb2\xd8\xa7\x41\xbf\xb2\x4a\x64\xcd\xdb\xbd\x52\xa5\xa4\xc8\xf7\x77\x7e\xfa\xe6\xea\x62\x83\xc7\xff\xe1\xa7\x6f\xd2\x37\x0f\xab\xf1\xc5\xc3\x45\xfd\xf4\x22\x95\x99\xca\x6f\xc4\xc9\xc5\xed\x29\xfb\x97\xa9\xed\x4f\x24\x05\x22\x18\xd3\x86\x5c\xdc\x8e\x69\x73\x31\x75\x09\xf4\xc9\x29\x13\x82\x9f\xa6\xe3\x7f\xaf\x4e\x99\x12\x1d\x58\xfb\x1d\x54\x43\x42\x5c\x43\xed\xe6\x90\xa2\x87\x8a\x41\x82\x24\x8f\x66\x77\xd1\x58\x18\x6c\x4d\xe6\x74\xf2\xd7\x8f\x3e\xfa\xf0\xaf\x8e\x63\x50\x4d\x43\xe4\xd9\x6c\x69\x68\xe4\x74\x5b\x95\xd7\x2f\xae\xb2\xea\x45\xb9\x11\x44\x8e\x75\x56\x9a\x0c\x7e\xfc\xf8\xe3\xf9\xac\xf9\xe8\xa3\x67\x7f\xfb\x2b\x9b\xcf\x9e\x7d\x18\xcb\xe6\xa3\xbf\x7e\xf8\x6c\x86\xc3\x6f\x25\xf8\x29\x49\x81\xf8\xee\xe6\x5b\x8d\xfb\x9a\x37\x93\xe5\xc5\x86\x36\x6f\x26\x4f\x2c\x4a\xb4\x5f\x26\x17\xfb\x4f\x3f\xfd\xf4\x53\xcc\xc8\xe9\x25\xcb\xfb\x23\x70\xbd\x5c\x46\x17\x33\xc8\x76\xc4\x32\xba\xd8\x6f\xb7\xdb\x4d\x94\xb8\x11\xcd\xd8\x64\x4e\xc7\xd1\xc5\x05\x06\xb9\xb6\xdd\x7b\xae\x88\xa3\x3c\x93\x39\xf5\xa2\x49\x32\xff\x2b\x1d\x47\x27\x51\x62\xb2\x1f\x58\x19\x34\x48\x1f\x5e\xe3\x44\x9b\x09\xfe\x56\x90\xb6\x1b\xae\x13\x23\x08\x95\xc4\xd4\x11\x99\x38\x8e\xb6\xb9\x28\x36\xb5\xc0\x19\x92\x1b\x11\xe5\x3f\xb2\x6b\xd1\x63\x04\xd8\xc3\x26\xaf\x92\xa8\x15\xd4\x45\x4c\x02\xd6\xa3\x42\x5c\x0a\xb9\x89\x0e\x74\xa1\xaa\xfb\x87\xcf\x9d\x8c\x83\x7f\x6d\x98\xd2\xdd\x54\xef\x51\x94\xa8\x29\xeb\xbe\xa9\x34\x7c\xb7\xe3\x5c\xb5\x42\xd2\x75\xa6\xd6\x57\xe8\xf9\xe7\xfc\x41\x57\x9b\x38\xde\x35\x10\x17\x63\x7a\xbf\xb4\xad\x0a\x66\x5b\x55\x94\x1e\x06\x99\x75\x37\x9b\xe0\x39\x16\xb7\x57\x79\x21\x88\x48\xa5\xe5\x77\xc7\xe3\x15\x5d\xb8\x1c\x5c\x4e\xe6\x87\x43\x2b\x7f\xac\x85\x61\xb0\x59\x65\x38\x20\x7d\xc2\x67\xb5\xe6\x7b\xd6\x6c\xab\xa5\xb6\xd3\xf2\x56\x8a\xea\xa5\xe3\x6d\x76\x10\x51\xfa\xe1\x24\x7f\x03\xbf\xaa\x25\xaf\xe9\xca\x9f\x03\xbc\x70\x58\x35\xcd\x48\x35\xcd\x7c\xc4\xf9\x2e\x8e\xff\x66\x7e\xe6\xfa\xb5\x65\x30\x70\x6a\xc1\x61\xfb\x35\x11\x94\x09\x2e\x9a\xe6\x05\x7b\x45\xb5\x46\x60\x6e\x4b\x92\x3d\xff\xd7\x54\xdc\x89\x35\x51\x94\x82\x4d\xc9\xf9\x3e\x9d\xaf\x74\x9e\xbf\x71\xd4\x86\xa7\x11\xc9\xb8\x80\xc8\xd6\xca\x7e\x3f\xb9\xff\x62\x43\x72\x4a\x3b\x4d\x65\xd3\x7c\xc3\x39\xcf\x7d\xa2\xe6\x99\x48\x46\x99\x34\xe7\xa9\x7c\x4b\xb6\x5a\xb4\xb0\x1d\xa8\x2a\x8e\xef\x89\x60\x99\xe6\x9f\xdf\x5f\x0f\x3a\xb4\x4f\x9f\xad\xdc\x77\x07\x44\x92\x85\x5d\xac\x3f\xb9\x7f\x9d\x5d\x02\x34\x31\x32\xa6\x7b\xa8\x07\xf7\xe1\x8a\xc6\xf1\xa6\x9b\xf3\x45\x91\xd5\x35\xf2\xc6\x71\xaf\x0e\xff\xe5\x77\x5b\xf3\x39\x31\x31\x4c\x1e\xf2\x2d\xd9\x4c\x7f\xad\xb3\x38\x1e\xfd\x23\x55\xd8\x7f\xab\x38\x26\xa3\x9b\xa6\x19\xdd\x4c\x95\xa8\x15\xfa\x15\xc7\x44\x2f\x44\x2b\x60\x1e\x3d\xbe\xab\xcc\xca\xad\xb9\x02\xfc\x30\x1c\x60\x77\x71\x4c\xbe\x77\x95\x35\xcd\x6f\xbe\x5e\xfa\x40\xb6\x5c\x08\xf7\x1e\xc7\xf7\x82\x88\x40\x6e\x4e\x9b\x46\xe0\xf8\x2e\x30\x15\x9a\x0d\x6c\x1a\x42\x6a\x2e\xba\x72\xf9\x28\xdf\x44\x94\x2e\x6b\x5e\x7b\x09\x47\x25\x58\x2e\x28\xf0\x50\x3f\x23\xab\xf9\x39\xa5\xd0\x17\x14\xfc\x0a\x83\xb3\x5b\xc3\x6e\x9c\x72\x32\xa1\x45\x5a\xae\x38\xa9\x97\xd1\x9f\xa2\x71\x9d\x44\x89\x6e\x39\xd2\xc8\x69\x7c\x27\x08\x3e\xd3\xc5\x9a\x17\xd3\x5f\xca\x5c\x92\x88\x45\xf4\x00\x34\x71\x34\xf5\xdb\xa9\x16\x57\x9f\x6b\x6a\x55\x56\xcf\x8b\x82\xac\xf5\xa4\x7b\x1c\xf0\x0f\xa2\xa0\x08\x3b\x6c\x73\x99\x15\xc5\xfd\x03\xe4\x5a\xe7\x58\x5d\xa3\x30\xe8\x0d\xf1\x70\x38\xd8\x36\x2e\x89\xf2\x43\x7d\xc2\xa2\x27\xf3\x88\xda\x6d\xdc\xee\x6d\x9c\x32\x1e\xdc\xe9\xd5\x91\x14\xff\x55\x90\xf0\xdc\x6d\xcf\xab\x7a\xfd\xe9\xc7\x6f\xa7\xeb\x6c\x7d\x25\xbe\xd4\xf3\x12\xc7\x1b\x51\x08\x25\x4e\x44\x5a\x4d\xeb\xab\x7c\xab\x08\x5d\x31\x61\x61\x85\xcb\x00\x9b\x14\xa2\xc5\xc8\x27\x22\x3d\x5f\x71\x88\x95\xda\xef\x6b\xe1\xa5\x2d\xfc\x45\x5f\x4d\xe3\x11\xb6\xc1\xb9\x16\xaf\x8f\x04\x51\xb4\xc5\x99\x36\x75\xee\x27\x4c\x05\xd0\x12\xc7\xe1\x5b\x47\xe7\xa2\x28\x53\x1c\x07\xe1\xa0\xb7\x5b\xd1\xc5\xa1\xf6\x48\xd9\x44\x94\x55\x5c\x76\xa1\xa2\x9a\x4c\xe8\xdb\x69\xa6\x54\xf5\x79\x26\x37\x85\x48\x65\x5a\xad\x56\x5c\xb5\xb5\xed\x3a\xb5\xa9\x38\x16\xa8\x25\x8e\xe7\x9e\xfe\x40\x45\x66\xde\x55\xf0\x2e\xa6\x75\xb9\xaf\xd6\xe2\x0b\x68\xf4\x26\x2a\x7c\x03\x2e\xa8\xdc\x86\xae\xf0\x26\xa9\xe9\x8e\xe4\x72\x0a\x42\x75\x9e\xbf\x2d\x72\x79\x09\x84\x28\x83\x43\xdb\xc4\x09\xae\x4e\xc4\x72\x9e\x4c\xe6\x6d\x2f\x37\x98\xce\x87\x1e\x2c\xb4\x13\x1b\x69\xf6\xea\x7d\x24\x13\xb0\x09\xdc\x8e\xd6\x82\xb9\xbc\x12\x44\x0e\xd6\x8b\xc9\x55\x8f\xd6\x66\xbb\x49\xda\x76\xa1\x18\x32\xdc\x9c\x7e\x0b\xdb\x0b\x21\xed\xf2\x77\xc6\xb1\x2d\xab\x6b\xad\xa0\x5a\x86\xe8\x24\x8e\x47\xf3\x0e\x9f\xb0\x8c\x8a\xec\xad\x28\x4c\xce\xe0\x39\x28\xd3\xa9\xc0\x17\x44\xdf\x92\xa3\xd7\xbc\x7e\x19\x24\x34\x4d\x98\x32\xe2\x7c\x04\xf9\x32\xb6\xc0\x50\xe9\xa0\xf5\x38\xee\x7e\x0b\xc6\x7d\x23\x48\xe6\xc7\x5d\x04\x9c\x50\xe9\x53\x4b\x3e\x2e\x59\xf8\xa9\x05\x4b\x56\xf1\x4c\xab\x93\x2d\x70\xb3\x92\xb2\x9c\x57\x5d\x50\xcf\x27\x13\x2a\x52\xc9\xab\x34\x5f\x81\x14\x68\x81\xc0\x88\x28\xfc\xe0\x99\xd2\x03\xfe\x77\xd5\x9f\xdc\x77\x36\x7d\x1c\x0f\x69\xda\x87\x29\x5e\x1c\x8b\x03\x84\x5c\x02\x72\xaa\x0d\xaf\xc5\xd4\x4a\x9d\xa0\x91\xce\xf1\x9e\xd7\x3f\x7e\xf5\x25\x1f\x84\xa7\xec\x5a\xd4\xbb\x6c\x2d\xbe\xff\xee\x0b\x26\x39\xe9\x71\x29\xa0\x1b\x5e\x1c\x63\x1b\xb6\xc0\x36\xfa\xc9\x92\x9b\xa6\x91\x71\x2c\x3d\x64\x36\x4d\x84\xb3\x46\x44\x0f\xec\x35\xda\xae\x85\x72\xb5\x1d\xf7\x00\xa8\x56\xf3\x41\xfd\x56\x93\x9d\x6d\xe6\xa4\x1a\xf1\x17\x71\x0c\xe6\xa4\x0a\xb6\x7b\xd5\xef\x95\xe6\x34\xc8\x0b\x5e\x1d\xf5\x97\xbd\xe2\xa3\x9c\xbc\xa0\x6c\xa7\x6b\x22\x12\x0a\x73\xb1\xcd\xf6\x85\xfa\x67\x2e\x6e\x29\x3a\xaf\xca\xdd\x88\x03\xcd\x10\x39\xcd\x36\x9b\x57\x37\x42\xaa\x2f\xf3\x5a\x09\x29\xaa\xe5\x71\x12\x89\xf6\xb2\x28\xb3\x4d\xc4\x4a\xc1\x46\x73\x9a\x48\xe0\xb4\x6c\x7d\xa5\x0b\xc6\x71\xe7\x95\x44\xa5\x6c\xb3\x53\xca\x2c\x09\xe6\xeb\x10\xb8\xfc\xd2\x67\x1d\xf5\xb7\xa0\x9d\xd7\x23\x6c\xbf\xc9\x6f\x22\x4a\xd9\x30\xb0\xf4\xa9\x66\x1c\x8f\x8e\x13\x89\xa5\xcb\x27\x8e\x70\x9c\xe8\x3a\x2d\x30\x1f\xd0\xdd\xcc\x91\xcf\xfa\x91\x3e\x8b\xe9\xda\xb1\x43\x3c\xca\x23\x36\xea\x33\x16\xfe\x73\x44\x75\x8d\x43\x60\xfc\x68\xdd\x43\x13\xf0\xa2\xbc\x36\x13\x80\xd1\x8f\x86\xf7\x05\x89\x9e\x76\xc7\x31\xcc\xc0\xf1\xff\x31\x80\xfc\xe2\x91\xef\xb6\x24\x98\xe0\x3f\xba\x64\xf9\x86\x9f\xb3\x51\xaf\x42\xb4\xd5\x34\x43\xa9\xe4\xbc\xdf\x4d\x34\xb6\x24\x6f\xa7\xdb\xbc\x50\xa2\x9a\x7e\xf1\xf2\x78\xeb\x70\xe1\x39\x17\x25\x98\x6c\xb5\xe0\x03\x1d\x1c\xe2\xf3\x0c\x46\x64\x68\x43\x6e\xba\x2d\x00\xd1\xe5\xdb\x41\x5b\x1f\x15\xf4\x1e\x9d\x8c\xe3\x57\x9e\x56\xf7\x3e\xb5\x56\x0c\x27\x72\x99\x4a\xa8\x61\x0f\x07\x9a\xbc\x77\x54\xf2\x0f\x8d\x0a\xed\x29\x3e\x0c\xf3\xe1\x40\x0d\xad\x3a\x4e\x33\x13\xe0\x6a\xd6\xba\xa6\x9b\xac\xd8\x5b\xe2\xf8\x7f\x6f\x4a\x8c\xaa\x77\x70\x62\x60\x43\xa3\x27\x99\x48\x5e\x3e\xd2\x41\x8d\x99\x5c\xc7\x9c\x32\x2d\x2d\x57\x8b\x9c\xab\x01\x20\x12\x34\x38\xb7\x96\x3c\x37\x47\xd6\xff\xae\x09\xcb\x1f\xeb\x15\x73\xf3\xf1\xfa\xf9\x67\x7c\x78\xe7\xf6\x0e\xdc\x96\x77\xf8\x9d\xa9\x0a\x8a\x0f\x27\x13\x41\x13\x7d\xa4\x5a\xaa\x63\xa4\x25\x9c\x46\x77\xf0\x24\xcf\x02\x45\xd3\xa3\x95\x83\x11\x8c\x9e\x82\x3d\x12\xf4\xc1\xf1\x83\xa5\xd6\x6f\x51\xb0\x37\xb2\x43\x6e\x34\x5f\x2f\x3d\xd0\x54\xee\x04\x51\xfa\x2d\xa4\x25\xa2\x7c\xf3\x08\x16\x89\xe3\xff\x18\x98\xba\xc5\x5f\xd9\xd5\x79\x34\x0b\xcc\xe0\x58\x8d\x81\xdf\xe0\x8f\x39\x8e\xb6\xc8\xad\x3f\x85\xfa\x34\xda\xc3\x67\x98\x3c\xb5\x38\x46\x67\x52\x8a\x0a\x44\x9d\x47\x67\xd9\x49\xbe\xe1\x1f\x44\xe3\xf3\x71\xf4\xc1\xc7\x67\xa7\xd9\xc7\x67\x46\x88\xd8\x26\x4f\xa0\x1f\xf8\xe0\xe4\xba\xce\x8a\xa2\xbc\x5d\x67\x3b\xb5\xaf\x04\xff\xe0\x83\x8f\xcf\xca\x9d\x15\x96\xa0\x80\xd8\xe8\xb4\x53\x93\xf8\xf1\xd9\xa9\xa9\xe7\xe3\x88\x0d\xd1\xa8\xb4\x5b\xdd\x1b\xfe\xc1\x07\x2b\x8f\xdc\xe3\xf8\xc6\x9c\xbb\x22\x68\x17\x56\xdc\x0a\x48\x97\xc9\x07\x10\xf4\x5f\x44\x10\x38\x0f\x56\xea\x7a\xd2\x56\xd5\x34\xae\xaa\x56\x85\xb1\x4c\xf4\x26\x6c\xa2\xf1\x77\xe3\xc7\xeb\xca\x37\xff\xe6\x7a\x5a\x26\x43\xb5\xfd\x9b\x47\x94\x91\x81\xe3\x9a\xe1\xd8\x29\xed\x1d\xb0\x25\x68\xa5\xb6\x2c\xe8\xd2\x3f\xf5\x48\xeb\xc8\xdf\x99\x93\x81\x81\x20\x8f\x99\x9a\x3f\x3c\x43\x89\xd5\x53\x0d\x54\xdb\x7e\x1a\x2c\x99\xfd\x49\x4f\xc6\xf8\xe9\x40\xd1\xe9\x9f\xa6\x63\x08\x84\x1f\x29\x7a\x71\x71\xb1\x8d\x28\x73\xb9\xd3\x56\x08\xac\xb9\x87\x1e\xcc\x8a\x1e\x78\x5e\x55\x62\xcb\x3f\xf8\xe0\xc4\x73\xfe\x1f\xb8\xa7\x2e\xbc\x0e\x7e\x37\xc0\x78\x1a\x40\xe3\xe2\x91\x63\xb6\x5d\xb7\x85\xea\x2d\x1c\xf6\x71\xc4\x22\xa3\x9d\x1b\x58\xbe\xe1\x75\x7e\xf9\xc8\x54\xa4\x58\x33\xbe\x19\x02\xf5\x76\x35\x5b\x95\x5a\x44\xd9\x33\x2d\x51\x3a\xae\x29\x11\x52\x1f\x92\x06\x6a\xf2\x9f\x58\x94\xb8\xb9\x88\x28\x3b\x42\x03\xee\x1b\xa4\x11\x8f\x36\xd3\x56\xf0\x47\xdb\x19\xaa\xe6\x29\x4b\xee\x02\x10\x60\xd3\xa7\x09\xd6\x9e\x32\xb2\x99\x5e\x43\x88\x21\x6a\x97\xdf\x61\xb8\x35\xcf\xdc\xa7\xa6\xc9\xa6\xb7\xe2\xed\xbb\x5c\x7d\xd5\xcd\x8b\x0f\xd7\xe5\x6f\x03\xa9\xe5\x50\xce\xba\x97\x08\x94\xd9\x83\xbe\x0d\x4e\x9f\xeb\x52\x4a\x8d\xd1\x74\x7e\xbe\xb6\x56\x23\x0c\xfc\x27\x6b\xdf\xd2\x7a\x84\x2d\xaa\x47\x56\xdb\x91\x8d\x78\xc4\x3e\x05\x54\xdf\xf0\x1b\x3f\x61\x81\x8e\xe4\xc6\xca\xc6\x1a\xf0\xb9\x10\xcc\x0d\xe4\xa9\xc3\x3c\xca\xcd\x47\x36\x5d\x97\xd7\x38\x7f\xbb\x53\xd5\x37\x65\x9d\x03\xba\x61\xaa\xac\x9a\x26\xc8\x26\x55\x96\xcb\x9a\xf6\xc8\x38\x00\x5f\xf2\xbf\x75\xe4\x2e\xcb\xd6\xb2\xdc\xee\x84\x04\xf2\x19\xd5\x15\x19\x39\x22\x09\xbe\xa5\x6a\x9a\x11\x19\x55\x46\x76\xdd\x9e\xe0\x90\x2a\x7d\xd3\xcb\xf6\x91\x54\x90\x36\x3e\xd2\xf5\x38\x9e\xff\x35\x7e\xf4\xab\x36\x20\xeb\xf3\x03\xf9\x96\x28\x2b\xed\x51\x3c\xec\x24\x84\x3d\x2a\xe0\x7a\x46\x33\xdb\xed\xd1\xfc\xc0\x5e\x72\xb5\x3c\xaa\x47\x84\xfa\xfc\x82\x8f\x66\x6c\xa6\xb1\x83\xe4\xa3\x47\xfb\x34\x19\xa9\xc7\x3e\xb9\x59\x92\x4d\x43\xe6\x31\x19\x3e\x7c\x73\x4e\x54\x3f\x55\xd1\xe5\xe3\x73\xa0\x68\x32\xa7\x4d\x33\xda\x68\x3b\xc5\x97\x02\xc7\x50\x28\x72\x1e\xed\x86\x91\xa3\xc8\xa5\xe0\xfc\x45\xd3\xf4\xba\xa0\x65\xce\xf7\x64\xc7\x04\x85\x2d\x91\xd2\x79\xd4\x23\x79\x14\x5d\xce\x93\xfd\xf2\x1b\xb2\x67\x82\x4e\xf0\xa3\x68\x32\x4b\xfe\x12\x4b\x94\x9d\x1f\x29\x5d\xde\x37\xa5\xde\xfe\xa8\x5d\x30\x56\x76\xd6\x0f\x46\x3e\x62\x05\x86\x47\x69\x5b\x9f\x51\xde\x34\xa3\xd2\xd5\x85\xd1\xb8\x1e\x2f\xe7\x49\x8e\xe7\x72\xa8\x7b\x28\x9a\x73\xce\x7d\x49\x2b\x80\x5c\x48\x2e\x2c\x2f\x0d\x29\x61\xdb\x2e\xcd\xa6\x7b\x69\xe4\xb7\x92\x2e\x24\x57\xc3\xb9\xea\x30\x97\xc9\x91\xa5\x15\x4c\x42\x6a\x98\x91\x55\xe3\xb1\x5b\xff\x6a\xb9\x33\xdf\x98\xfe\x92\xe0\x91\xf3\x1d\x7a\x5c\xdb\xc7\x79\x32\x3b\x50\xf6\xe2\xc0\x6a\xe1\x70\x5c\xef\x6c\x62\xeb\xaa\xd1\x79\xc8\x67\xcd\x1f\x45\xc3\x22\x0e\x8d\x1d\x1f\x6b\xb4\xc6\xe8\x08\xb3\xc6\xf1\xab\x9e\x36\xa3\x6e\x9a\x51\xed\xb4\x0c\xf4\x58\xbf\x41\x21\x71\x36\x5b\xc2\xa3\x3d\xa5\x1d\x06\x64\xd3\x0c\xa0\xca\xa6\x69\xf1\x89\xd5\x67\xb5\x09\x1e\x57\xb8\x95\x19\x90\xf7\xdb\x2f\xb3\x33\xad\x80\x7b\x61\x46\x9d\x8a\x95\x23\x3e\x7a\xf8\x0e\xb5\x0c\x4e\xd9\xd0\xa6\xd3\x72\x22\x3d\x27\x50\x52\xd9\x49\x84\x10\xa4\x57\xc3\x7b\xcb\x5a\xd4\xd0\x91\x76\xf7\x0c\xa8\xe0\x03\x23\xe3\xf8\x17\x33\x55\x61\x4e\xd6\xcb\x49\x97\xba\x49\x36\x7a\xe5\xce\x3d\x0e\x7a\x5a\xbb\xd6\x65\x95\x84\xa2\x9a\xa6\x19\xbd\x5a\x76\xcf\xbe\x44\xd1\x84\x54\x3d\xad\x0f\x60\x1a\xea\x1b\x1c\x72\xea\x9d\x58\xe7\xdb\x5c\x6c\x96\x95\x39\x15\x26\x98\x50\x3d\x7c\x51\xaf\xb3\x5d\xa8\xb9\x6e\x27\x70\x1c\x45\xad\x49\xac\x51\x18\x99\x22\xb0\x34\xe3\xef\x33\x84\x8d\xce\xef\xa5\xca\xee\x4e\xb4\xc9\x2c\x3b\xd9\xcb\x4a\xac\xcb\x4b\x99\xff\x26\x36\x27\xe2\x6e\x57\x89\xba\xce\x4b\x99\x9c\x44\x63\x5b\xe5\x5e\xe6\xbf\xee\xc5\x39\x64\x9b\x03\xb2\x44\x1c\x7b\x0c\xde\xd0\x46\xa1\x05\x1f\x6d\xa6\x1b\xa1\xc4\x5a\xbd\xdc\xc3\x4c\x3b\x53\xa2\x66\x7b\x6e\x71\xe3\xb9\x02\x07\x12\xc7\x5e\xc7\x0e\x56\x04\x1f\xc8\x4b\xca\x0a\x77\x24\x54\xdc\x98\x3c\x52\x4d\x2b\xd2\x1c\xfb\x00\x6a\x0d\x6b\xf4\xe7\xf6\x76\x05\x69\xaf\x35\x06\x27\x50\x6a\xb0\xb9\x87\xce\xbd\xd6\x98\xc0\x38\xb2\x84\x40\xf4\x52\xa8\xd7\xe2\x6e\x78\x00\x51\xe4\x11\x9f\x83\x7e\x8c\x24\xd7\xf4\x07\x87\xd2\xbc\x69\x40\x91\xf3\xa6\x99\xeb\x57\xfd\xe1\xd8\x6c\x54\xbb\xe7\x68\x8b\x13\xe9\xd1\x6b\x27\x51\x5b\xe9\x0a\x2e\xa6\xda\xba\x44\xb3\x78\x0b\xb1\x40\x42\xa8\x0f\x91\x63\x5e\xe2\x58\xe9\xf4\xb9\x1f\xa2\xc9\xa6\xf9\x4b\xa8\xaf\x35\xcc\xc1\x3f\x01\x2d\x46\xef\xdb\xce\x9b\x16\x40\xe8\x3a\x94\x3f\x38\xcb\x03\x23\x6f\x31\x0d\xb5\xc5\x32\x35\x7f\x08\xb4\x64\xc9\x47\x33\x66\x4e\x48\xdf\xd4\x62\xbf\x29\x93\x42\x30\x8d\x96\x92\xcf\x58\xbb\x3d\x60\xeb\x8d\x33\x37\x7e\x2b\x51\x68\x7b\x94\xe4\x21\xfa\x38\x4a\x8e\x0d\x11\xf4\x00\xe1\xf4\xc4\xa2\x93\x81\xef\x07\x16\x8d\x7d\x72\x25\x6e\xf2\x72\x5f\xdb\xe1\x77\xca\xfe\xfb\xb1\x4c\x87\x03\xdb\x55\xe2\x53\x2d\xa8\x4b\x1e\xb4\x5d\x53\xb8\xb2\x6e\x96\xd2\x39\xc4\xfe\xf3\x55\x4f\xbc\xc5\x44\xfa\xe1\x8a\x13\xfc\x6d\x1a\x91\xfe\x45\xff\xfd\x08\x1e\x48\x11\xed\x67\x8d\xfe\xcd\xb5\xb4\x22\x7d\x06\x20\x44\x11\x0e\x4d\x2a\x1e\x80\xa1\x29\x6b\xad\x45\xfe\x42\x0f\xd6\x64\xea\xbd\x7d\xe9\xe0\x18\x16\x49\x75\x65\x1a\x98\xaf\x7c\x4d\x1f\xd2\xa5\xed\x9d\xdb\xd1\x44\xa4\xb3\x15\x65\xe8\x2c\x1f\x13\xfc\x2c\xd1\x65\x3c\xfe\x75\xd5\x34\x73\x9a\x3c\x7b\x4a\x22\xd8\x32\x99\xca\x30\xb2\xa8\xdc\x6c\xdc\x1b\x45\xd9\x8f\x4c\xd9\xff\xb5\x1a\x8b\xf4\x7f\x1f\x65\x48\x90\x2f\x8e\xfb\x2d\x1e\x9c\x7d\xd8\xd0\xd6\x19\xa1\xf9\x38\x16\xe9\x33\x6f\x6f\xfd\xd9\x54\xcf\x81\x21\x4f\xba\xd7\x4b\xec\xc4\x44\x0f\x68\x89\x9c\xbc\x3b\xe5\x89\x8c\xe3\x1f\x4d\x76\x09\xf2\xa6\xf8\x15\x91\x50\x2c\x9b\x17\xe9\xfc\x05\x49\x44\x23\xe6\x54\x9b\x13\x45\x27\xee\x19\xf9\xd0\x10\xc7\x1f\x3f\x87\x38\xb4\xa3\x31\x19\xa4\x84\xab\xf5\x21\xa5\x07\x00\xb4\x01\x21\x58\xce\xfd\xbe\xb4\xb7\x4b\x1f\xec\x88\xad\x38\xab\x65\x5d\xdd\x9a\x8f\x66\x87\x41\x48\xf0\x5a\x9b\x38\x6e\x9f\xbb\x55\x5b\x39\xb1\x96\x6e\x0d\xf4\xeb\x3a\x15\x00\x3e\x3f\xe7\xb0\xb5\x52\x1d\x2b\x2f\xf2\xc6\x9b\x25\x8a\x71\x64\xac\xd7\x60\x9b\x06\x92\x73\x4d\x04\x1b\xe8\x97\x32\x6b\x30\x80\xd7\xbc\x22\x21\x8e\x83\x97\xa6\x19\x92\xa5\x75\xe9\x5c\x1c\x0f\x2a\x25\x22\xf8\x47\xe0\x6c\x79\x60\xdd\xbd\xab\xa5\xb7\xbe\x47\x61\x27\xcd\x82\x58\x96\x00\xfc\x56\x8b\xdc\xac\xd9\x7d\x34\xd2\x3b\xb5\x4a\x70\xf0\x21\x6a\xcc\xa3\x88\x45\x26\x69\x09\x3a\x92\x27\x2e\xc7\x52\x8d\xf4\xeb\x1b\xfb\x9a\xc7\x31\x4c\xb0\xbc\x67\x2a\xc9\x69\x12\x3d\x6d\x3f\x4e\xe6\x67\xdd\x6f\x4f\xda\x6f\xd6\x58\x99\x4c\x72\x07\x8d\xa6\xa9\x7f\xdb\xa6\x26\xf3\x33\x58\x7a\x8f\x5b\x93\x87\x4f\x80\x13\xb5\x3d\x06\xed\x54\xda\x98\x12\x1a\xe8\x35\xc6\x77\x55\xcf\x98\xab\x7b\x3c\xc7\xb1\x21\x1f\x47\x93\x48\x43\x6f\x0f\xdb\x5c\x31\xb0\x2b\x97\xec\xc6\x4c\xd7\x3d\xd7\xc8\x65\xc4\xf9\x95\xaf\xe9\x43\xca\xae\x79\x04\xbb\xc6\x30\x7d\xf2\x17\xd8\x79\x47\xd6\x6a\x13\xdd\x10\x6e\x76\x41\xf0\x2e\xcd\xfc\xdc\x2c\x8f\xa1\x66\x34\x0a\x4f\x0b\x87\xc7\x1d\x04\x9c\x65\x16\xbf\x1f\x71\x7e\xbd\x8c\x02\x8a\x17\x0d\x10\x81\x75\xf7\x14\xb2\xe5\x77\x8f\x6f\x16\xb6\xe3\x23\x09\x7f\x4d\xb6\x81\xd7\x56\xbe\x25\x6b\x4d\xa1\xef\x1d\x3b\x51\xd0\x87\xcc\x1f\x2c\x32\x9e\xa5\x85\x16\xd1\xdf\x2d\xb3\xc7\xb7\xdf\x36\xc1\xc8\xb3\x3e\x3f\x3c\x9a\x2f\xf6\xbc\xe0\x11\xac\x60\x31\x4f\x57\x71\x3c\xda\xc7\x71\x67\x38\x96\x05\x19\xcd\x60\x9f\xb4\xe7\xe9\xf5\x72\x1d\x50\xfc\x64\x3d\xc5\xf4\xeb\xe7\x15\xbb\x8e\xe3\x1d\x7d\xd8\x70\x52\x73\x52\x71\x92\x73\x52\x72\x92\xf1\x35\x4d\xcf\x57\x4d\x43\x32\xd8\xa1\x3c\x1c\x28\x4d\x71\x00\x02\x1b\xf6\xc5\x4b\xa4\x97\xe1\x3b\x9c\xca\x68\x7a\xb5\x82\xd7\x0b\x4d\x67\x38\xee\xbc\x8b\xe3\x0a\x26\x66\xf8\x79\xb6\x62\x19\xaf\xe3\x78\x1d\x58\xf6\xa5\xf5\xca\x4f\xc7\x78\x5c\xc7\x71\x16\xc7\x98\x96\xa6\x21\x1b\x5e\xf3\x19\x6d\x9a\x3d\xec\xf3\x09\xa5\x96\x07\x6a\x67\x22\x8e\xc7\xe3\x4d\x1c\x67\x80\x12\xfa\x90\xa7\x57\x2b\x9e\xbe\x63\x35\xdb\xac\x16\xc6\xb1\xc7\x73\x2e\x30\x9d\xda\xf0\xee\xc8\xc4\xff\xa5\x91\x31\x6d\x0d\xb1\xa1\x7f\x7c\x14\xff\xe1\x7a\xdb\x61\xea\x41\x98\x75\xc9\xfe\x8b\x9e\x63\x6a\x36\x2b\xca\xcc\x6c\x75\xfc\x98\xc8\x66\xc2\x6f\x00\x6a\x97\x4d\xb3\xf9\xf3\x25\xe7\x33\xf8\x10\xf3\xcd\xe9\xe5\xe1\x30\x40\x74\x59\x69\x91\x20\xcb\xf8\xdb\xe9\x4e\x33\x6a\x75\x2a\x56\x4d\xf3\x16\xe2\x4d\xc3\x0b\xd5\x69\x6f\x64\x21\x0b\x11\xed\xa5\xb5\x51\x10\x9b\x13\x53\x81\xe1\xf2\xdd\x5e\xc7\x00\x97\x19\x29\x69\x32\x3f\xcb\x2c\xca\x59\x12\xc5\x53\xc1\x04\x8b\x22\x56\xae\x58\xd8\x56\xcf\xb1\x83\xf4\x9a\xa6\xcb\xf7\x18\x72\x88\xf7\x9a\x6f\x7c\x43\x04\x83\x09\x07\xf5\xc6\x1b\xfa\xed\xd0\x0d\xba\xe0\x7a\x4d\x04\x9b\x31\x45\x0f\x34\xc9\xc0\x17\xea\x81\xd5\xc9\x83\x2c\x55\xd2\xe9\x41\x6b\x50\xc6\x72\x9c\x5a\x6a\xbe\x25\xa2\x6f\x89\xe6\x27\xa3\xc6\x64\xf4\x46\xd0\xb1\x35\xe5\xb5\x3b\xf6\x57\x2c\xd5\x4b\x2c\xba\xa3\xc9\x26\x13\x78\xe7\x97\x69\x86\x8d\x48\x44\x9a\x99\xe1\x64\x2b\x9e\xd3\xee\x60\x3a\x7e\x64\x15\xf6\xb0\x60\x35\xa9\xac\x54\x81\xe5\x94\xe9\x44\xfd\x3a\xca\xcd\xce\x84\x96\xf0\x2a\xab\x3b\x63\xf4\x27\xec\x21\x85\xb4\x3d\xb0\x0b\x7f\x4e\x87\x5c\xdf\x1e\xd3\x1f\xa9\x45\xf1\x96\x6e\x59\x86\xf8\xb8\x62\x90\xb8\xce\x39\xa7\x69\x70\x80\x69\xa9\x9b\xd2\x7d\x2d\x32\x79\xd9\x69\xa6\x1d\xf1\x3f\x2d\xfb\xa7\xb9\x82\xc7\x20\x56\x97\x3f\x89\xc6\x92\x32\x08\x87\xba\xdd\xea\x82\x5e\x87\xbd\xc1\x72\xa9\xc5\xa6\x04\x49\x50\x1c\xc7\x73\x5d\x53\x9f\x31\xb9\xbb\x2e\x12\x7c\x00\x6f\xd2\xff\x66\xd2\x2d\x09\x20\x98\x94\x2e\xa4\x07\xb1\x01\xfc\xa0\xa5\xa6\xd2\x07\x83\x9f\x88\xe8\xd0\x33\xda\x37\xad\x73\x50\x37\x9a\x63\xa6\x54\x56\xf5\x7d\xfc\xf5\x20\x60\xd8\x57\xae\x75\x8c\x94\x38\x6e\x9f\xb1\x0f\xaf\x1c\xd8\xaa\x96\x25\xd1\xcc\x82\x98\xe6\x9b\x03\xab\xca\xb2\x5b\x9f\xcd\x0d\xe9\x6a\x76\x60\xda\xfb\xe9\xb1\xef\x2f\xa6\xd9\x1a\x67\x3d\x2b\x7b\x86\x00\xea\x05\x9a\xfc\x14\x85\x9a\xa6\x7d\x26\x60\x2e\x47\x23\xe0\x01\x2d\x6c\x16\x53\xa8\x85\x9a\xe6\xdf\x62\xaa\xb2\xb7\xda\x2a\x10\x5e\xa5\x46\xef\x91\x5c\x0a\x32\x9a\x53\xe6\x74\x12\xfa\x7d\x46\x99\x55\x70\x0d\x32\xe3\xc3\xc8\xdb\x8e\x3c\x30\xc3\x43\x2f\x60\xb1\xaf\x6b\xc2\x31\x67\x67\x1d\x00\xdd\x27\xa7\x82\x3c\x30\xf7\x34\x38\xf8\x60\xc5\xe2\x38\x7c\xf3\x15\xe8\x41\x31\x6b\xc0\xef\x12\x0f\x4c\xc0\x57\xb7\x53\xe5\x1f\x3a\xf9\x43\x36\xeb\x41\xe2\xec\xaf\x2d\xcb\x61\x1f\x66\x07\x66\xfa\x30\xd0\xdb\x91\xa7\x09\x53\xdd\x3a\x24\x08\x0c\x81\x3d\x44\x35\x34\xb6\xbf\xdb\xf3\x99\x27\x88\xf0\x31\xc6\x04\x0e\x65\xfe\x76\x20\xb3\xb1\x73\xfc\x3f\x5c\xa6\xc0\x5a\xd2\x01\x4d\x68\x40\x79\x60\x40\x2a\xc7\x6d\x1c\x55\xf5\x58\x9b\x71\x1c\xa1\x86\xb6\x7e\xd8\x9a\xe9\xe3\x02\x51\x47\x76\xd8\xf8\x1e\x51\x9c\x4c\x5c\x99\xfe\x36\x77\x61\x21\x6e\x02\x24\xe6\x26\x29\x9d\xad\x34\x8e\xeb\x7d\x0e\x84\x9e\xa9\x9a\xcc\x91\x47\xfc\xda\xcf\xd1\xe2\xfe\x14\x1e\x33\x72\xac\x12\xa9\x73\xde\x08\x79\x5c\x5b\xe0\x06\xb9\x90\x67\x6a\x21\xc7\xfc\x19\x15\x7d\x5b\x09\x71\xa0\x3a\x54\xc4\x7b\x8a\xcf\x7f\xa7\x78\x71\x34\x94\xae\x5b\xb1\xeb\xab\x3a\x93\x4b\x95\xc8\xc5\xec\x8c\x4f\x26\xd5\xc2\x55\x56\x75\x2a\xbb\xfc\x83\x95\xc9\xc5\x78\x5c\x9d\xa9\xe1\x5a\x0e\x07\xea\xa1\x5c\xaa\xab\x96\x0f\x9a\x8a\x5f\xd9\x43\x95\x6d\xf2\x12\xc1\x00\xf4\xce\x7f\x5b\xde\xe1\x79\x9b\x17\x3a\x12\xcf\x2e\xab\xeb\xdb\xb2\xda\xe0\x39\xbf\xce\x2e\x91\x78\xa0\xbe\x82\x54\xac\xf8\x06\xe6\x3c\x0b\x67\xd4\xf9\x50\xef\xdf\x5e\xe7\x10\x44\xb1\x4a\xd4\x42\x1d\xe7\xbf\x32\xf9\xed\x90\x4e\xae\x61\xb2\xde\x9a\x96\xde\x09\xb7\xef\x31\x5b\x8a\xcf\x58\xc7\xd7\x25\x8a\x16\xea\x4c\x2e\xd4\x78\x4c\xab\xb1\x8e\xe7\x60\x84\xbf\x6e\xb4\x55\x5b\xd3\x5b\x41\x6a\xe6\xf9\xa7\xbd\x36\x01\xae\x58\x61\x91\x07\x5b\xf3\xa2\x69\xf6\x6c\x0b\xe4\x16\x0a\xd9\x38\xe7\x6b\xb6\xe3\x81\xd2\xc3\xa2\x9f\xe0\x18\x67\x60\xcf\x7a\xdd\x70\x91\xee\x57\x8e\xdf\x6f\x11\x51\xd3\x6c\x2d\x26\x3a\xa9\x6d\x09\x5b\xe1\x68\xfe\xde\x03\x1f\x78\xdf\x9d\x56\x13\x0d\xb4\x31\xd0\x48\x1c\xbb\xfa\x1d\xe6\x9b\x85\xc2\xcd\xf7\xf5\x0f\xe2\x5a\xb0\xe8\xc2\xb0\xe8\xc2\xb3\xe8\xa2\xc7\xa2\x07\xef\xc8\xc0\x8a\x38\x2e\xde\x83\x40\xa8\x69\xb4\x69\xc4\xc2\xb9\xc5\x90\x8a\xe7\xe9\x1a\x1c\x5d\xd5\x39\x91\xf0\xc0\x2d\x28\x83\xe0\x09\x27\x2f\x0c\x9e\x20\x3b\xcf\x28\xd2\x8e\x07\x68\x1f\xe6\x81\x9d\xf4\xad\x20\xad\x0c\x64\x7e\xe6\x0e\xff\x47\xcb\xa6\xa7\x9a\xe7\x5d\xa6\x13\x32\x71\xad\x98\x4b\xab\x55\xaf\xb1\x80\x8e\x24\x39\xf0\x95\x6f\xf1\xb5\x70\xdc\x2d\xcb\x5b\x98\xd5\x4b\x08\x36\x79\xc6\xf6\x2d\xe8\x16\x5a\xb0\x3e\xe2\xca\xc7\x2c\xd1\xf3\x5e\x63\x46\x08\x8e\xe1\x92\x94\x5a\x8a\xd3\x34\xc4\xba\xe9\x97\x7a\x9e\x95\x79\xa9\x69\x18\x02\xc4\x77\xe1\x85\x20\x1b\x76\xc5\x2e\xd9\x0d\xbb\x67\x2d\xe9\xb9\x89\xe3\xd1\x4d\x7a\x0e\xd9\xeb\x0d\x7f\x21\xc8\x0d\xa5\xec\x3e\x8e\x47\xf7\x26\xed\x1e\x69\xc8\x4f\xd9\xfb\x58\x75\x08\x1f\xc0\xf1\xef\xf1\xa7\xe0\x3e\xde\xc2\x1a\xfe\x5a\xdd\x52\xed\xf0\x8d\x4e\xc0\xe5\x5d\x54\x67\xb9\x0e\x6c\xa1\xb5\x7d\x08\xc5\x10\x8a\xa0\x0e\xe4\xaa\x69\xa2\xa7\x90\x4f\x3a\xb0\xd4\xd6\x97\x52\x9f\x0c\xb6\x7c\xb4\x69\x1a\x84\x9f\xba\x5a\xae\x93\xd7\x82\xac\x71\x5c\xd6\x3d\x64\x3b\x7e\xb9\xbc\x07\xc8\x2e\x37\x49\xd1\x34\x37\x3a\xda\x8a\x4a\xb6\x80\x9c\xcb\x38\xbe\x24\x5b\xb6\x33\x39\x6f\xe8\x43\xce\x5f\x0b\xb2\x63\x7b\xca\x6e\x48\xce\xd2\x95\xf9\x50\xf6\x61\x00\x6e\x40\x24\xe3\x39\x5c\x7d\xe2\x98\xec\xd2\x7d\x5a\xae\x70\xf6\xd8\xda\xa7\x8c\x52\xc8\x26\x84\x11\x93\x34\xcd\xc6\xc9\x4b\xf4\xb9\xa8\xe4\xbb\xc1\xfa\x76\xa6\xbe\xdc\xac\xe3\x36\x2d\x57\x3c\xa3\x8b\x7b\x4d\x51\xd9\x0e\x25\x73\xf8\xf0\xfc\x4e\x71\x9c\x16\x72\x7e\xbf\xc4\x19\x2f\xa3\x49\x6d\x3b\x89\x68\x13\xfa\x74\x94\xa3\x56\x6a\x85\x08\x3b\x3d\x60\xf0\x02\xcb\x9d\x53\xf4\x14\xcc\xd5\x4f\x93\x1d\x65\xf7\x4b\xdb\x03\xc5\x76\xac\xa2\x89\xf3\x60\x52\x6c\xd7\x31\xf5\x7f\xd5\xc1\xc7\x39\xb3\x66\xf0\xb6\x2a\x56\xf2\xb7\x53\xa7\xd5\x48\xb5\x20\x19\xfc\x00\x44\x27\x65\xd3\x04\x9f\xa2\x93\x08\x5b\x02\x6a\x6d\x6c\x8b\x41\x87\x4e\xcd\x36\xe7\x07\x96\x41\x8a\xcd\x8a\xe1\x4c\x93\xf9\xd9\x37\x24\x47\xc0\x2a\x9b\x6f\xcd\xd3\xe1\x1d\x3e\x2a\xb1\xb3\x9a\x06\x52\xcb\x5b\xc4\xf0\x41\x38\x0c\xda\x02\xda\xde\x66\x4f\x0a\xfb\xe0\xe1\x32\xd7\x7b\x95\x55\x87\xd5\xa2\x3e\xab\x5c\x80\x21\xd5\x1d\x6a\x6d\x87\x4a\xd7\x3c\x7d\x2b\xc8\xad\x20\x6b\xca\x14\x5d\xb5\x18\x0f\x05\x8c\xa0\x3c\xc8\xee\x1c\xc5\xd0\x80\x4e\xb5\x8a\x6c\x0a\x01\x8f\x0b\x0b\x33\x1e\xd7\x61\x7c\x86\x4e\xbb\xd2\xb5\xdb\x09\xee\xf2\x42\x90\xf9\x59\x1d\xc7\xa6\x1b\xfa\x11\x94\xd4\x1e\x69\x66\xac\x86\xd7\xad\x09\x31\x48\x1e\x34\xc5\x4c\xa2\x13\x30\x6b\x22\xad\x27\xcf\x4c\x95\xcb\xe8\x69\x94\x44\xd1\x81\xd2\xfe\xe9\x9e\x29\x56\x9f\xc9\x38\x7e\xd5\x56\x59\x03\x43\x32\x79\x56\x99\x54\xee\xd2\x7d\x2a\x9a\xa7\xf4\xb0\xb6\x5e\x66\x5e\xc3\xa8\x7b\xe8\x5e\xae\x45\x10\xe9\xcd\xcd\x56\xdd\x32\x28\x1d\xc1\x89\x96\xd4\x5f\x0b\x76\x05\x95\x9c\x2a\xdf\x09\x99\xff\x26\xf8\xa0\xa8\xa4\x23\x3a\xbd\x73\x62\x7f\xe8\x59\x2d\x56\x3f\x51\xcb\x59\x52\xd8\x3e\xcf\xe8\x22\xe3\xc2\xe1\xba\xb7\x53\xaf\x23\xb3\x3b\x31\x33\xeb\xa2\x63\xab\x00\x55\x93\x8a\xff\x6c\x3c\x53\x33\x30\xbe\x04\x8e\xac\x19\xcf\x6c\x6d\xa0\x6f\x6e\xa7\x35\x4d\xe6\xed\xa7\x80\x24\x30\x39\x88\xfb\x44\x2a\xfe\x9b\xaf\x01\x60\xca\xbd\x9f\x1d\xb3\x88\xc2\xae\x92\x64\x98\x9b\x44\x57\x1a\xac\xca\x09\xd4\x00\xac\x6d\xd4\x2b\x75\x28\x73\xd3\x48\x47\xa4\xe2\x9f\xa5\xe5\x2a\xe8\x2a\xf0\x98\x19\x00\x9e\x60\x84\xd4\x34\xbf\xdf\x78\xc9\x2c\x90\x26\xd5\x23\x8d\x02\xed\x8e\xa4\x8d\x50\xe4\x67\xd8\x09\xc3\x92\x6c\xe9\x65\x13\x82\x26\x77\x44\xb0\x9a\xda\x3a\x66\x88\x1a\x87\x05\x85\xc9\x4f\x5e\x0c\xaf\x27\x88\xdb\x35\xbb\x03\x7d\xc4\x1a\x95\xf8\x93\xf1\xe7\xc1\xba\x8e\x32\xfa\x60\xd4\x39\xf0\xb1\xc4\x2c\x7b\x0a\x64\x4d\x5e\x0c\x36\x7d\x25\xb4\x60\x4c\xef\xb6\xa5\x1d\x6c\x46\x93\xd2\x3d\x2d\x48\xc6\x9f\x13\xc1\xc8\x0d\x2f\xd9\x35\x9f\x9d\x91\x7b\x9e\x3b\xc9\x0f\xbb\xe3\xb3\x33\x67\xfa\xc6\xba\x16\x16\x8e\x0b\xb0\x1c\x00\x40\x8f\xcf\x58\xc1\xa3\x59\xc4\xd6\xf0\xa9\x4e\x57\x6c\x8b\x7e\xef\xf8\x2d\xdb\x80\x80\xde\xc5\x71\x6b\xad\x4e\x40\x05\x73\xca\xae\xf8\xbb\xb1\xc6\x3e\xc6\x94\xa6\x13\xcb\xab\x69\xa6\x73\x76\xc9\x37\xb6\x03\x9a\xe5\xce\xe3\x98\xdc\x72\x6b\xeb\xd4\x34\x39\x5d\x14\x23\xad\x79\xb0\x11\x80\x4a\xbe\x81\xd8\x7e\x51\x8c\xc7\x9a\x58\xdd\xc5\x71\x09\xb1\xfe\x8c\xa9\xa6\x29\xbb\x26\x21\xba\x12\xf2\x9a\x94\x98\xbe\xd1\x2b\x67\x2e\x50\xf3\x9b\x34\xb3\xe6\xf9\x35\x29\x51\xf0\x05\x36\xff\x83\x35\x34\x2f\xa9\x15\x60\xa3\x2f\xef\xf8\x15\x3d\x5c\xc7\x31\x21\x25\x1f\xd5\x68\x2c\x8e\xf7\x93\x09\x13\x10\xa4\xdb\xec\x9a\x88\xee\xc7\xbc\x80\x18\x1f\xdd\xdd\xeb\x1e\xf9\xe6\xee\x4d\x73\x35\x59\xb3\x2d\x26\x56\x1b\xe5\x18\xa2\x3b\x3b\xdb\x5b\xd1\x75\x31\x99\xd0\xb5\x16\x5a\x6f\xf5\x5f\x82\x1f\xfe\xab\x31\x4f\x41\xb8\xb4\x2d\x68\xe0\x96\x1e\x1c\x61\xab\xd8\x96\x32\xc4\x69\x13\x90\x17\x6f\xed\x24\xc6\xf1\xfc\x6c\x3f\xbe\xf7\x6f\x1d\xbb\x0d\x52\x79\xac\x65\xc7\xc6\x6e\xf9\x8e\xb2\xf5\x81\x5d\x43\x4a\x5b\xd1\x04\x66\x7c\x56\xac\x51\x56\x5c\xb8\xec\xd9\x81\x5d\xb6\x86\x03\xfc\xbd\x1c\x96\xc6\x51\x03\xf1\x96\xe0\x28\xca\xd6\x7c\x54\xc5\xf1\x15\x11\xbc\xf0\xcd\xc0\x74\x27\xf4\xc0\x07\x5f\xbf\xb6\x23\xd0\xb3\xf4\xec\x8c\x94\x7c\x0d\x36\x7b\x1d\xa8\x76\xbd\xd7\x73\x1c\x47\x5f\xbc\x04\xee\x27\x19\x2f\xa1\x69\xb6\x67\xfc\xbf\xf5\xdc\x54\x5f\xc5\x71\x40\x79\xca\x74\xee\x28\x0f\xda\x40\xc8\x36\x62\xe1\xf7\x8b\x97\xc4\xdb\xb3\x86\x78\xca\x8a\x3e\xe1\xff\x6d\x14\x11\x8e\xa7\x3e\x91\x0b\x04\x92\xea\x19\x39\xb2\x96\x8a\x94\x0e\x21\x99\xd3\x9d\x1b\xdc\x21\xe7\x9f\x4d\xc3\x08\x25\x56\xc2\x42\x97\xb3\xa4\xb4\x99\x2c\x18\xc1\xa5\x11\xfd\xc4\x10\xf3\x15\x0b\x06\x52\xf3\xac\x43\x41\x41\xaa\xf7\x9a\x54\xcb\x0d\x42\x06\x6a\xa3\x99\xfd\x7b\x47\xe4\xdc\xd7\x4b\xc7\xf0\x18\x37\xf6\xce\x68\x60\xfb\x68\xdc\xe2\x4b\xc7\x82\xe5\x6c\x4e\xd9\x88\x08\x2f\xbf\x8f\xe3\x3b\x81\x0d\xe1\xa6\xc5\xc1\x2a\x40\x84\x49\xa7\x17\xb2\x40\x45\x8a\xa6\xd9\x12\xc1\xd6\x94\x92\x8a\x29\x36\x7a\xc5\x24\x43\xa4\x05\xd7\x9b\xc7\x7a\x81\x18\x6c\xa1\x19\x11\x3f\x77\x7e\xcf\x11\x75\x46\x44\xd6\x2c\x37\x82\xec\xf3\x9c\x1d\x9b\x22\xf1\xd1\xa8\x60\xaf\x09\x65\x5d\x5b\xcd\x47\x9c\xcc\xe6\xef\x31\x7c\x7d\x8f\x13\xf8\x80\xc5\xbc\xad\x70\xd8\x70\xfe\x4f\xc6\x4a\x3e\x62\xd1\x9f\x00\xce\xa1\x98\xb0\x27\xa4\x42\x7e\x50\xcc\xa6\xd9\x5a\x91\x55\x83\xa4\xe6\x4a\xe4\x97\x57\xaa\xb9\xcd\x37\xea\x2a\x0a\xc4\xdf\x1a\xed\x60\xed\x46\xd2\xad\x4d\x4f\xee\xa5\x98\xae\x66\x40\xde\xb5\x9c\x27\xcf\x68\xcf\x17\x31\x8e\xff\xc8\xb8\xb4\x60\xee\x54\x7b\x95\x04\x23\xe9\x1a\xe1\xeb\xfd\x60\xbc\x2d\xa2\xdf\x19\xb4\xc9\xea\x47\x6d\x4b\x0e\x0e\x32\x8e\x7f\x5f\x2a\xd8\x4e\x84\xf3\x48\x45\x85\x8f\x2e\x99\x8d\xab\xdb\xeb\x53\x6b\x4b\x6f\xbb\xf5\xdd\xd1\xac\x83\x82\x56\x8b\x70\xee\x8d\xa0\x38\x55\xab\x65\x6f\xaa\xff\x2b\x0b\x41\xca\x6a\x71\x20\x2f\xe8\xe2\x5c\x63\x2f\xbe\x41\x98\xba\xbb\x5d\xc5\x37\x1e\xc3\xd6\x36\x29\x8d\x92\xc8\xc4\xe7\xdc\x55\x9e\x25\x3d\x0f\x28\x04\x77\x2f\x7c\x13\xa4\xb2\x73\xad\xd6\x31\xfe\x55\xb0\x9f\x63\x08\x02\xfa\xe3\x57\x5f\xbe\x2c\xd7\x7c\x63\x1e\xd9\xb9\x37\x23\xe7\x1b\xff\x88\x76\xb5\xf1\xa2\xb7\x7f\xdd\xd8\x04\x6d\xa2\x79\xc5\x07\x27\x0b\x6c\x45\xce\xbd\x75\xa5\xb4\x28\x10\x5c\x79\xaa\x80\xd0\xfe\xe6\x43\x77\x00\xb3\x1f\xcb\x82\x34\x14\xe4\x71\x7c\xae\x1d\x42\x6b\x22\xbd\xca\xd5\xc6\x34\xf4\x74\xb0\x82\xa7\x74\xd8\x89\xf6\x6c\x28\x79\xba\x1a\x90\xce\x77\x5b\x8a\x63\x61\x82\xae\x5a\xab\xc4\x56\x8b\x2a\x0f\xec\x9d\x9b\x6a\x4d\x4b\x3a\x88\xbe\x95\x17\x3e\xef\x18\x11\xff\x51\xab\xa0\x6e\xca\x01\xfd\xfd\x07\x3f\x7d\x73\x46\xd2\x6c\xf2\xdb\x2a\x7d\x73\x71\x7a\x31\xfb\x38\xd1\x81\xe6\xd4\x45\x75\x21\x2f\xb6\xab\xa7\x34\xed\xbe\x5f\x9c\x2e\x3f\x26\xcb\xe4\xec\xe2\xf4\x62\xfe\x71\x83\xc8\x53\x6d\xaf\x5e\x12\x1b\x51\xc7\xf6\xeb\x9a\x48\xba\xb4\xe1\x84\x05\x1b\x92\x6b\x8f\x46\xd2\x9b\x1d\x33\xd8\xe2\xf2\xea\x00\xe7\x6c\x37\x53\x43\xa5\x7d\xf5\x38\x29\x4b\x5b\xc4\x59\x1e\x79\x5b\x22\xf9\xbe\xa2\x13\x48\xc6\x7c\x7c\x4e\x5b\xc5\xb9\x3d\x32\x20\x0d\xb2\x08\xf7\xfe\x08\xb8\xa9\x74\xe6\x6d\xa8\x24\x84\x10\x3c\x4a\x64\xa9\x48\x34\x16\x30\x9d\xa2\x0c\x6b\xee\xf8\x6f\xa3\xb3\x6b\xbd\x29\x96\xa8\x5c\x1e\x19\x70\x93\x0a\x76\xfb\x69\x05\xd7\xdb\xa4\x9b\x85\x08\x66\x07\xa4\x86\xe6\xa2\x0b\x60\x3a\x3c\x73\x10\xd7\x98\x3c\xa0\xaa\x63\x65\x08\x56\xab\x13\xb1\x39\xd7\x6f\x8b\xc0\x44\xb5\x35\xce\xa2\xc3\xf1\x9b\xf5\x76\xb1\x33\xe7\x1b\x30\x1b\x42\xf1\xd9\x42\x9d\x55\x5a\x50\x9d\x6f\x49\xbb\xd9\x49\x8e\x88\xc1\xa8\xc8\x21\x54\x08\xc8\xa9\x66\xe6\x25\xef\x35\x00\x29\x58\x58\x93\x99\x17\x22\x98\xae\xa4\x15\xa4\xcd\xcf\xaa\x65\x88\x96\x88\xa4\x89\xf4\x56\x7a\xe1\xd8\x87\x07\xf2\xd2\x86\x16\x07\x9f\x86\x18\x01\x3a\xe6\xaf\xfa\x8f\x0b\xce\x30\xf7\x79\x3d\x50\x6e\x34\xb2\x39\xdd\xdc\x86\x5c\xee\x3b\xcf\xc5\x61\x42\x13\x5b\xd9\xdc\xb1\xab\x07\x1b\xbb\xf3\x17\xf6\xab\x89\xe0\x76\x51\x3f\x25\x67\xe9\xc5\xed\xc5\x0f\xab\xf1\xc7\x34\x7d\xf3\xf1\xea\x69\xe3\xa3\xba\xd1\x27\xa7\x0b\xe2\xe3\xda\x0f\x03\x30\xcb\xb1\xcc\xa3\xce\xb2\x7a\x86\xfa\x97\x81\x3e\xda\x48\x53\x15\x8f\xce\x34\x85\x4c\x67\xab\x38\x8e\x3e\x36\xcf\x8e\x3b\x9d\xcc\x57\x71\xfc\xe1\x99\x97\x8d\x2d\x53\x23\xe4\xd1\xd6\x08\xab\xe4\x57\x73\x0c\xd7\x41\x8e\x47\x90\x7c\xc7\xb1\x33\x77\x06\x13\xa7\xa6\xbf\x68\xd7\xb2\x25\x51\x4d\x23\xa9\x5d\x68\x9a\x1c\xc5\xf8\x56\xfe\x1b\xba\x5c\xb9\x88\x58\x8a\x23\xca\x75\xad\x32\xb9\x46\x97\xcf\x97\xd8\xa4\x89\x62\x61\x30\x7a\x76\x0e\xd6\xb0\x16\xf0\x3f\xd4\x25\x99\x8d\x5d\x6c\x77\xe6\xb1\xf7\x4e\xf2\x4a\xaf\x2a\xfb\x87\x59\x22\x6b\x1c\xd5\x0f\x69\xad\x6c\xe0\x5a\xc8\x47\x14\xbd\xd6\x2b\x0d\xf7\x90\xa5\x7d\x20\x90\xfb\xda\xa1\x80\x27\x02\xfb\x8a\x14\x07\xbe\xf8\xe0\x98\xdc\x9c\xbf\x0a\x7c\x86\xe1\xa3\x4e\xa0\x11\x00\x45\x37\xf5\xce\x56\x3c\x67\xc1\xc6\xe5\x73\xca\x82\x0a\x2c\x45\xd0\xe3\xf1\x05\x44\xb7\x80\x7e\xa3\x09\xee\x2b\x58\xb6\x54\x73\x8a\x28\xa4\xf7\x4b\xfb\xab\x21\x91\x9c\xd3\xe4\x7c\xea\xa3\x26\x03\x7d\xa3\xe4\x81\x06\x52\x2b\xc0\x1a\xfb\x85\x9f\x93\x57\x06\x4c\xbf\x34\x40\x0a\xde\x57\xaa\xba\x81\x89\x1e\x59\x26\xdf\x4b\x95\x17\x8d\x76\x65\x3e\x65\x9f\xf3\x07\x6d\x53\x56\x09\xa9\xf5\x6e\x38\xcb\x48\x55\xe3\x19\xf6\x7d\xf8\x45\xb1\xee\xed\x17\x5f\xa3\x75\xfa\xd0\x27\xed\xf3\x2e\x69\x77\x53\x2a\x0e\x1d\x1c\x78\x95\xd5\x43\x17\x15\xb8\x01\x85\xe2\x12\x5b\x01\xa6\xc8\x51\x05\x5f\xb2\x25\xf6\x82\xcf\x16\xe2\x4c\x2e\xc4\x11\x7e\x43\x41\xa6\xe0\xd7\xe2\xa0\x7b\x06\x83\xd5\x75\x51\xd6\x22\x0c\xfc\x1f\x88\x77\xac\xca\xa0\x5d\x22\x27\xe1\x39\xc6\xc4\x86\x47\x01\xe4\x8f\x3c\xda\xd0\xc0\xe7\x15\x0d\x2d\x22\x45\xdc\x67\x84\x7b\x01\xc3\xb1\xe8\xb9\x3c\x61\xb7\xfb\x59\x3b\x9b\xcf\x21\xc1\x5b\x4e\x60\xbf\xa5\x0d\x51\x80\x40\xfb\x7e\xf5\x8f\xd0\x2d\x09\x35\x0a\x7d\xb0\x12\x24\xe9\x84\x21\xc3\xf8\x72\x7e\xe6\x8e\xa9\x5d\x7c\x5d\xd2\xa4\x04\xee\x44\xdb\x9d\x85\xb2\xd5\x88\xe5\x31\x52\x5a\x5a\x3a\x8e\x09\x61\x16\xd2\xa9\x0f\xa1\x8d\x55\x10\x0e\xa7\x40\x30\x9f\x08\xb3\x03\x35\xee\xb2\x0f\xc1\x8c\x2c\xed\x82\x57\xb5\x22\x00\x6f\x71\x03\xa7\x5e\x87\x83\x11\x50\x8a\x65\xe1\x15\x0a\x21\x13\xd6\x1b\x64\x67\x64\x21\xfe\xc1\xc6\x26\x94\x69\xb0\xa3\xf0\x7f\x44\x95\x9f\xf4\x2f\xd1\x08\x2b\xcd\x36\x1b\xe2\x2e\xe8\xe8\x5d\x7f\x90\xf4\xde\x1d\xb0\x0a\x98\xf8\xfa\xd0\xd3\x0f\x03\x96\x1f\xce\xe0\xa2\x1d\xbc\x07\x7a\xeb\xd8\x15\xe2\x44\xeb\x56\x64\xf7\xf3\x50\x4f\xaf\xe0\x30\xdb\x56\x16\x51\x9f\x5b\xef\xfa\x64\xd8\x66\xe1\xa8\x98\x0e\x79\x2e\xfb\x96\x1b\x36\x33\x76\x7f\xc7\x5a\x16\x8d\x00\x47\x3c\x96\xb7\x6f\x1a\x6c\xeb\x7e\x5e\x14\x43\x45\xae\x1e\xa9\xfe\x7d\xd9\x1f\x69\xe1\xf7\xc7\x1c\xb6\xa3\x07\x8d\x9a\xfe\xc0\x54\xf5\xda\xd3\x45\x6b\xf3\x32\xc4\x73\x9c\xbc\x26\x9d\xc0\x82\xb8\x6a\x82\x06\xa7\x65\xad\x43\xf2\x78\x78\xb0\x7c\x78\xb8\xa6\x87\x16\x51\x3f\x7e\xe3\xd1\xd4\xe6\x71\x34\x34\x8e\x2b\x72\x94\x48\x97\x47\x49\x09\xc1\xb9\x26\x52\xe2\x7a\x57\x64\x4a\xe0\x6e\x03\xe0\x78\x97\x0d\x22\x3f\x4f\xca\x75\x34\xaf\xd6\x28\x19\xf0\xde\xf2\xc5\x50\x21\x3f\x00\xf7\xc3\x1d\x73\x00\xd9\xf2\xe0\x56\x98\xbc\xbd\x8f\x26\xd2\xf3\x0f\xe3\xf6\xca\x8a\xe0\x26\x1f\xa1\x0f\x8a\x03\xb9\xc4\xf1\x11\xfa\x41\xbc\x72\xc9\xfd\xc1\x01\x80\x4d\xd9\xfc\x2c\xa0\xb3\x71\x4c\x3e\xd7\x37\x2c\x75\xf0\x81\xa4\xec\x4b\xcb\x4c\xe8\x80\x2e\x95\xb8\x11\x15\xce\x64\x94\xf5\xd0\x88\xa4\x8e\xf3\xfb\x86\x9f\xa6\x6f\x3a\x67\xb1\xf1\xe9\x65\x4b\x19\xbf\x0b\x96\x21\x88\x3c\xf8\x55\xeb\xc5\x17\xa4\x7e\xd1\x93\xc1\x2e\xe0\x06\x2a\xe2\xf8\x9a\xe0\x06\x81\x5d\x55\x5e\xe7\xb5\xa0\x0e\xb7\x22\x92\x40\x29\x11\x78\x6e\xba\xcd\xf2\x02\x64\xc1\xe7\x55\x57\x42\xb6\x19\x51\x29\x4d\xdc\xfd\x5d\x86\xab\x60\xa9\x70\xd2\xd7\x8a\x06\x11\x0d\xe5\x51\x2e\x7a\x38\x9c\x4f\x5f\x64\x45\xf1\x36\x5b\xbf\x0b\x9c\x3f\x6d\x2f\x05\x2e\xd4\xe0\x47\x6b\x50\x2d\x21\x4b\x34\x17\x82\x59\x7c\x67\x1d\x68\xc9\x37\x5a\xe6\x1a\x1c\x97\x00\x00\x12\x57\x89\xe0\xb8\x81\xae\xb6\x97\x88\x1c\x58\x65\x58\x17\x68\x77\xfb\x4a\xff\xc9\x9c\xad\xdb\xfe\x18\x06\x20\xe3\x59\xd3\x54\x53\x5c\x79\xc1\x4a\x9e\xf3\xd1\x6c\xb1\xb7\x8b\xbe\x40\x09\xfa\xa0\xf8\xde\x49\x70\xad\x30\x62\x3c\x2e\xce\x1c\x64\x50\x6d\x84\x5e\xa7\xc5\xca\x4e\x04\x78\x55\xa6\xac\xe5\xfd\xb4\x56\xe5\xee\x6b\xf9\x69\x56\xd4\x42\x07\xc5\x77\xc5\x98\xe2\x23\x78\x2a\x4e\xaf\xc5\x75\x59\x41\xfb\xaf\x13\xec\x4d\x2a\x71\x4c\x6a\xae\x60\x06\x10\x01\x7b\x6d\xf9\x43\x87\x5e\x79\x08\xa9\x01\xd5\x71\x3c\xca\x3b\x75\x4f\xe6\x6c\xef\x34\x95\xad\x1d\xec\x89\x46\xc1\x6e\x6a\x7b\xb3\x79\x4d\x14\x5d\x56\x16\xb6\xe3\x78\x3b\xbd\xca\x6a\x02\x59\xb7\xd5\xf7\x29\x9a\x68\xf6\xda\xed\x06\xb7\x7c\x50\x47\x13\x05\xe0\x47\x98\x4b\x7a\x20\xfe\xc2\x1a\xbd\xd3\xd0\xb1\xb5\xdb\x0e\x70\x19\x44\x50\xd0\x81\x71\xd8\x5e\xf9\xc2\xbd\xde\x61\x3d\x9d\x20\x08\xb6\x03\xd8\xab\xf6\x22\x0e\xa2\x58\x8d\xed\x4a\x6b\x27\x98\x96\x10\x4c\xcb\x33\x0e\xb3\x9e\xc9\xe4\xe0\xda\xee\x73\x8d\xb6\x65\x01\x46\xa9\xad\x0d\xca\xbc\x64\xe6\x17\xf7\xc8\x8c\xd3\x97\xc3\xcc\x03\xb4\x5c\xf5\x56\x16\x38\x90\x33\xe3\xda\xe4\xa4\xe6\x0a\xde\x48\x9d\xdc\x03\x4b\x3a\xaa\x0f\xac\x28\xd7\xef\x8e\xbf\xb8\x8a\x54\xd3\xe4\x4d\x03\xf8\xe0\x10\x9c\x9a\x0a\x8b\xb2\x6b\x31\xeb\xab\x1b\xc1\xb4\x37\xaf\xc4\x0f\xb9\xea\x5f\xe4\xe2\xaa\xd5\xb0\x97\x0a\x44\xc9\xd1\xb7\xa6\x58\x6d\xe6\xd2\xf9\x1e\xd1\x44\xad\x5a\x80\x62\x79\xd3\x04\x4b\xba\xcd\xab\xa1\x05\xdd\x4e\x5d\xa3\xfd\x8b\xb5\x82\x72\x83\xfd\x2d\x0f\x07\xc7\xc1\x6c\xc3\xdb\x81\x5e\x8a\xad\xa8\x3a\x65\x2c\xf7\x53\xf2\x34\x8d\x64\xa9\xf2\xed\x7d\x04\xc2\x5a\x5e\xc2\x7d\x39\x62\x01\x0e\x22\x91\xd9\x65\x11\x7d\x24\xf5\xd9\x8a\xa5\x51\x25\xea\xb2\xb8\x41\x54\x98\x4d\x29\x45\xaf\x02\xe0\x87\x93\xe1\x5a\xba\x9f\x66\xcc\x55\xb4\x89\x4c\xad\x60\xe8\x22\x16\x01\xe7\xfe\xb7\x95\xce\x99\xad\x47\x6c\xa2\xd5\x8a\xe5\x3c\xda\x09\xb9\xc1\x06\x64\x19\x7f\xa8\x55\xa6\x86\x16\x01\xe6\x27\xc5\x6d\x76\x5f\x0f\x7c\xab\x0d\x2d\x68\xd7\xc5\xd0\x84\xa3\x75\x8a\xb4\xeb\x7f\x34\xb4\x75\x32\x4d\x35\xac\xf5\x07\x38\x9f\x7c\xd7\xe9\x05\x76\x6d\xde\xde\x60\xe5\x56\xf5\x7c\xea\x96\xb2\x3d\x8b\x55\x1e\x31\x95\x43\x5b\x9f\x5f\x43\xd2\x94\xfe\x65\x05\x9c\x6a\x9f\x16\x75\x0a\x24\xbb\x0a\x0f\x74\x68\x51\x20\x9a\xa4\x23\x4a\x3d\xd8\x5b\x68\x7a\x17\x50\x46\xff\xa8\x8f\x09\x06\x72\x08\xc4\x7b\x80\x26\x4b\x2e\xab\xa9\x5d\x4f\x3b\x45\x78\xc7\x52\xd0\xa4\x4a\x81\xeb\xc7\x11\xf6\x56\xb4\x32\x80\x2e\xf5\xc5\x41\x6d\x93\x88\x35\xca\x8c\x0d\x8e\x39\x69\x83\x24\x13\x7a\x60\x98\xbb\x76\xb6\x02\x2a\xbe\xe7\xb3\x96\x1d\x28\x88\x55\xac\xfa\x59\xf7\x45\xdc\xdc\xe8\x66\xab\x76\x9e\x99\xe0\xfd\x29\x61\x4a\x1f\x32\x49\x7e\xb6\x37\x5a\x3c\xdc\x2c\x66\xa7\x08\xed\x42\x51\x56\xb6\x9d\x0b\xee\x2e\xc5\xb1\xc1\x86\x0c\x78\x7d\x65\xc2\x08\x21\x8c\xd8\x76\xa2\xe7\x64\x8f\x46\x22\xba\x50\xb0\x09\x20\x7f\xf4\xca\x47\x13\x13\xf7\x4a\x48\xa6\xa9\x4e\xbd\x74\xb7\x3d\xb1\x82\xec\x59\xc9\xbe\x63\x35\xb5\x8f\x5f\xb1\x9a\xd2\x84\xec\xc7\x63\xf6\xfe\x4c\x3e\xb5\xb4\x8b\x87\x35\xa1\x28\x8b\x0b\xd1\xbe\xd3\xfc\x9d\xe5\x50\x2a\x84\x2f\xa1\x8c\xd4\xb0\x19\xb0\x4b\xab\x73\x9b\xa9\x38\x1c\x98\xe2\xf5\xb2\x03\xc9\x9a\xad\x22\x01\xd3\xd3\xc2\xf0\x54\xdc\xad\x85\xf6\x2d\xf8\xbc\x2c\xdf\xc5\xf1\x63\x5f\x80\x72\xa7\x35\x78\xc1\xd7\x55\xb6\x16\x94\xed\xcf\x78\x3e\xd6\x47\xf5\x11\xe7\x5f\x0d\x74\xb0\xb4\x70\x86\xbe\xb9\xae\x2d\xf2\xa5\x22\x34\x21\x41\x2b\x97\x42\xe9\xe3\x38\x1a\x01\x37\x10\x34\xc2\x1f\xc9\x06\xec\xfd\x02\xb6\x48\xaf\xf3\x6b\x51\xee\xb5\xcc\xeb\x70\x78\xcf\xf6\x14\xf4\x01\xda\xe1\xf4\xc3\x15\x22\xb2\x92\x82\xcc\x98\x60\xd7\xa4\xa2\xcb\x2a\xf9\x8e\x89\xce\x94\x33\xe8\xd6\xfb\x39\x15\x5d\xaa\xe4\x3b\xfd\xf1\xd9\xd1\x47\x7d\x89\xdc\x57\x94\x76\xf7\x87\x7d\x1c\x42\x3c\xf6\x78\xb2\xf4\xc4\x41\x5b\x11\xc2\xf1\xab\xe6\x0f\x87\x45\x97\xa7\x18\x46\x28\x2a\x7d\x86\x90\x15\x2a\xfd\x68\xb5\xc8\x0c\x22\xe1\x3a\xdc\x2c\xab\x34\xf6\xd8\x04\xa3\x87\x49\x64\x75\x60\x65\xfa\xe1\x44\xac\xd2\x67\x2b\x17\xdb\xd8\xa5\x7c\x18\xa6\xcc\x74\x0e\x90\x63\xe6\xa6\x0c\x2f\x94\x99\x4a\x15\x12\x40\x1a\x29\x03\xfe\x9a\xad\x82\x73\x8d\x1f\x5f\x7d\x8c\x57\xc0\x62\x5a\xf1\x5e\x32\x4c\x50\xbb\x85\xb8\xf4\x04\x18\x56\x4e\x7e\x62\x6b\x0a\xeb\x15\x61\xf6\x52\x8d\x3d\x54\x1f\xd8\x6d\x07\x15\x59\xb2\x2a\x5b\x8c\x62\x99\x21\x44\x20\x67\x15\xb7\x6c\x17\x65\x39\xb7\xd7\x8c\x06\x7d\x29\x03\x98\x23\x94\x65\xed\xe8\x5a\x86\x23\x6c\xa9\x02\x17\xaf\x07\x04\x2d\x00\x9f\x9f\xf5\x1b\x5d\x1e\xb5\x91\x08\x36\x99\xc8\xde\xe6\xd5\x47\x45\xec\x8f\x2d\x91\x67\x1c\xdb\x0a\x67\xa3\xd2\x12\x39\x00\xb8\xcb\xed\xf7\x15\x1b\x49\xca\x3c\x1d\xd5\x18\x50\x13\x52\x42\x71\x57\x23\xba\xa3\x69\xcd\x4a\xa3\xaa\xd6\xac\xa1\xd4\xef\xfe\x28\xa0\x26\x13\xfa\x05\xa8\xd3\x8a\x65\x44\xb5\xbb\xd6\xcb\x83\x03\xbc\xea\x8e\x80\x3f\x40\xaa\x8a\x9b\xf8\x9a\x2f\xa4\x12\x95\xcc\x8a\xe6\xbb\x4c\x5e\x8a\xe6\x3b\xcc\x9c\x90\x6b\xd1\x98\xf8\x2c\x0d\xd0\x6f\xf3\xfd\x77\x5f\x50\x8d\x83\x9f\x9c\x2e\x1e\x43\x2f\xed\x3c\x6b\xaa\xf9\x02\x67\xed\xba\x44\x94\x15\xff\x38\xbd\xcd\x2a\x19\xc7\x22\x8e\x7f\xb0\xc2\x46\x1d\x84\x9a\xf6\xb3\xb8\x9b\xaa\x7d\x4b\x27\xbe\x25\xed\x2a\x3a\xbd\x16\x75\x9d\x5d\x0a\xc4\x51\x00\xaa\x81\xdb\x25\x3b\x37\x82\xe6\x57\x2e\x67\xd0\x1f\xfa\xd0\xc1\x35\xfe\x43\x7b\x9e\xa5\x07\x7d\x62\xfb\xb4\x03\x3b\x2d\x0d\xfc\x84\xd0\x87\x57\x36\x1a\x7e\x2f\x1a\xf4\xcb\xaf\xbf\xb2\xfe\x86\x5f\x96\xd9\x46\x6c\x22\xf6\x09\x50\xdb\x60\x5e\x13\x08\xfa\x13\xea\xfa\x4a\xb4\x6a\xd0\x4a\xc8\x3b\xfd\xb5\x2b\xf7\xa9\x59\x69\x41\x53\xcb\x04\x05\xbc\x86\xa6\x01\xdd\x21\xc3\xdb\xca\x6d\xc6\x96\x6d\xf5\xf7\x53\xce\x99\xce\xfe\x43\x96\xab\xc4\x3e\x77\xf6\x1c\x31\x86\x01\xcb\xc9\xc4\x56\xac\x73\x42\x3b\xa1\x2b\x80\x69\xa6\x7f\xc1\xd5\x6a\x10\x95\xc7\xf1\xec\xac\x93\xbf\x69\x3e\xed\xec\x8a\x57\x0c\x86\xb8\x87\x83\x1f\xb4\x1e\x12\x37\x23\xc3\x05\x94\xd7\x3b\x5c\x8d\x00\xdd\x0f\x66\x38\xdb\xdc\x9f\x63\x0b\x34\x8d\x9e\x2d\x7b\xc0\x0b\xbf\xc4\xf1\xe8\x95\x0f\x14\x65\xf5\x1a\xd3\x4d\x79\xbe\xae\xca\xa2\x58\x76\x16\xda\xb6\x48\x13\xf2\x6a\x20\x8e\xf7\x23\x2b\x77\x9c\xd1\x2d\x9b\xd9\x3a\x4f\x06\xec\x14\xc1\x20\x19\x9c\x35\xe8\xa6\x00\x1b\x82\x7c\x1b\x70\x27\xfc\x16\x36\x01\x38\xfb\xd7\x50\xf2\xe0\xc8\xcf\x24\x7d\x02\xf2\x80\xb3\x64\x5a\xaf\xd8\x68\xa6\x2b\x5d\x38\x2f\x76\xaf\x58\x81\x75\xac\x2e\x00\xea\xa7\x6f\x73\xd5\xe6\xdd\x20\xe3\x4b\xe2\x59\x94\xca\xdd\xd6\x40\x13\x52\x70\xc5\x8e\x55\x77\x16\xc4\x8a\x40\xea\x2d\x75\x9c\x45\xab\x7e\xf2\x8e\x15\x8a\xc0\xb0\x9a\x49\x96\x2d\xab\xa4\xb2\x0d\x20\xa5\x66\xee\x53\xe0\x4c\x91\x2f\x45\x52\x78\x7e\x8a\x26\xfb\x25\x32\xcd\x56\x90\x91\x94\x07\xf6\x33\x3f\x7d\x33\xb9\xae\x27\xa7\xec\x37\x7e\x3a\x31\xe6\x02\x34\x94\x3e\x7d\xdf\x15\x85\x4f\x55\xf9\xfd\x6e\xe7\x0d\x0d\x7c\xb6\x1f\x83\x5d\xd2\x7a\x4f\xff\xcc\xa2\xeb\x7a\x12\x84\xcf\xf9\x8d\x7d\x6f\xac\x13\xfe\x19\x8c\xff\x11\x55\xb7\x09\xaf\x14\xbe\x8f\xc6\xed\x5b\xa0\x3a\xfa\x0c\xfc\x18\x04\x73\xf6\xba\x58\x63\x66\x01\xab\xd2\xf1\x67\xd3\x7d\xbe\x19\x8f\x0f\xfa\x97\xcf\xd9\x67\x81\x66\xcb\xc4\x3a\x3a\xa2\x72\x0a\xaa\xa7\xa0\xb6\x5e\x38\x95\x87\x03\xfb\xa7\xb9\x01\xbc\xed\xcb\xb2\x57\x82\xab\xc4\x0a\xed\x4d\x50\xe1\xd6\x17\x9e\x85\xf9\x98\x35\x43\x56\x90\xbc\x6e\xf3\xcb\x7d\x05\x1e\x02\x6a\x31\x8a\x65\x87\x1b\xaa\x4a\x1e\xd1\xf0\x1a\x55\xba\x1e\x81\x55\x95\x1e\x89\xcf\x14\xcd\xd3\x1f\x89\xa2\x2b\x2e\xdb\x0b\x34\xf5\xad\xaa\xe6\x4b\x45\xbb\x97\x87\xe6\xfd\x3b\xc4\x83\x75\x37\xd0\x0e\xb3\x87\x65\xa7\xe1\xa4\x37\xf2\x38\xee\x25\x98\x1e\x1c\x58\xb6\x5e\x8b\xba\x4e\x1e\x01\xfa\xb6\xfa\xa6\x79\x44\x1a\xeb\xb3\xc8\xa5\xd7\xb5\x00\x32\x13\xcd\x0c\x01\xcb\xd8\x3a\x59\xab\xf2\x5c\xca\x04\x94\xa8\x2f\x63\x6a\x99\x3e\xb8\x7a\x74\xfb\xbb\xe8\x6c\x6d\xfa\x10\xbe\x2a\xfa\x20\x39\x51\xbc\x7b\x13\x32\x18\x59\x2d\x73\xfe\x91\x26\x44\x71\x8c\x97\xe6\x08\x25\x98\xaa\x55\xa2\x3a\xf2\x4a\xa7\x6e\xb2\x8c\x82\x9c\x4c\xa8\xbd\x88\x06\xe7\x46\xb9\x5a\x1d\x6c\x63\x66\x26\xce\xa7\x9d\xfb\x7d\x61\xce\xfe\x7e\xb0\xb3\xdc\xa0\xbf\xdc\xa6\xf3\x15\x62\xf4\xab\xac\x7e\x99\xa9\xec\x8f\xc3\x7c\x3b\xf6\x38\x1e\xf5\xfb\x03\x07\x7e\x8d\x82\x7f\xd2\xae\x0b\x9f\xb1\x6f\xed\xef\xdf\xad\x21\xc3\x83\xb1\x62\x78\x7a\x71\x68\x2e\x52\xf7\xbc\xa2\x4f\x4e\xd9\xff\xf0\xd3\xf4\xf9\xe4\x5f\xab\x10\xd3\xfc\xab\x07\xe2\xed\x52\x70\x7e\x7c\xed\x0c\xd4\xb2\x15\x8f\x36\x99\xca\x26\x61\x1c\x9d\xff\x61\xd1\xe4\x49\x1c\xf5\x5d\xff\xfb\x20\x45\x64\xdf\x76\x0f\xa6\xce\xfa\x58\x27\x79\xa4\xaa\xbd\x00\x59\x20\x39\x97\x70\xfd\xdd\x42\x58\x0b\xed\x01\xa4\xaa\x11\xd0\x38\xbe\xe6\x26\x84\x15\x42\x56\x8e\xf3\x71\x14\x2d\xc7\x79\x62\x3d\xa8\x73\xba\xfc\xfb\xf9\xd7\xff\x80\x7a\xa6\x86\xdf\x5e\x92\x07\x47\xc5\xc3\xb7\x01\xb8\x1a\x8f\x26\x77\xd2\x73\xf3\x2e\x0f\x2d\xbf\x30\xb4\x68\x36\xdb\xb7\x53\xfb\x91\xc0\x14\xf6\xa7\xe0\xed\xc0\x36\xdd\x32\x9d\xfd\xf6\xed\xd4\xec\x47\xd7\x07\xbb\x45\x7a\xcd\x60\x9b\x7c\x6b\x39\x27\x1b\x7c\xf1\xe7\xf7\xd5\xfa\x53\xbf\xd6\x9f\x1f\xad\xf6\xa7\x4e\xb5\x07\xda\x35\x69\xea\x36\x22\x99\x37\x6a\xb2\x41\xfc\x8d\x76\x97\x65\xbc\x8c\xe3\x32\x30\x43\xed\x42\x8c\xde\xbc\x5d\x85\x4d\xce\xbf\xc5\xb1\x17\x2e\x00\x80\xa6\xd2\x43\x53\x1c\x8f\x7e\x32\x5f\x58\x64\x27\x11\x56\x9d\x75\xa4\x81\x82\x67\xdd\x8d\x0b\x0e\x3f\xd3\xa7\x01\x30\x68\xa4\xd2\xd7\xa4\x1b\xd6\xd9\x07\x7a\xb0\x90\x09\x42\x51\xf1\x1f\x89\x53\x3b\x7d\x44\x29\xfb\x97\x71\x85\x84\x89\x08\x5d\xfc\x34\xad\x07\x9a\x0d\x23\x6a\xe6\xf6\xe1\x48\x98\x62\x31\xa1\x16\x92\xf9\xd9\xc2\x92\xa1\x46\x7c\xc2\xd2\xd2\xe4\x49\xff\xc2\x7f\x3b\x9b\x98\xad\x32\x40\xac\x82\xf6\x37\x3d\x51\x6e\xbe\xc0\x52\x2c\x95\x8d\x77\x69\xbe\xfc\xab\x97\xba\xf8\xdd\xce\x40\x23\x09\x2d\xaa\x96\x0e\xb2\xe3\x83\x9d\xf9\x32\x9a\x3d\x06\x8f\x1e\xd0\x1e\x69\xc9\x82\x14\xbe\x9a\xa6\x34\x5c\x39\xa0\xfa\x75\x2f\xf6\x62\x98\x9c\x62\x26\x5a\x6b\x29\x0e\x13\xa5\x68\x7b\x87\xdb\xd1\x74\x21\x04\x82\xfc\xc9\x13\x1c\x26\x11\xeb\xa2\x6a\x9a\x2e\x19\x90\x74\x59\xf1\xce\x0e\x08\x2d\x6b\x24\xa5\x89\xbf\xb8\x80\xb2\x0a\x42\xf5\x03\xdb\x88\xe3\x4e\x01\xdc\x6c\xfb\x36\x6c\xe9\x39\x82\x72\xef\xcd\x56\x09\xae\xf1\x82\x08\xd1\x29\xa1\xf4\x21\xfb\x67\x9d\x0d\x47\x3f\xbd\x03\xe9\x02\x36\xcf\x4e\xf6\x0d\x74\xa5\x19\xd5\xb6\x0c\x7c\x82\xe1\x31\x42\x30\x56\x6e\xad\x56\x5d\xa4\xdc\xb0\x2c\x65\x96\xa2\x94\x5a\x77\xc5\xbc\x4e\xd0\xf7\x1b\x47\x1f\x3b\x18\x8b\x28\x4a\xdc\x05\x53\xc5\xb1\xde\xa1\x5a\x63\xa2\xa5\x0f\x10\xe3\x04\xfd\x4c\x06\x05\x31\x76\xda\x75\x8e\xc8\x61\x44\xb7\x00\xc0\xc7\xc1\x34\x4b\xf6\xa0\x6b\x4f\x1e\x17\x95\xf7\x45\x37\x01\xee\x49\x5d\x5b\x11\x93\x2b\x7a\xf0\x30\xb3\x95\x8f\x81\x8d\x07\x1a\xc1\x9f\xd9\xae\x1d\x59\xf1\x18\xdd\x31\x78\x7c\xcc\x2c\x13\x98\xe7\x3e\xac\x9f\x89\xa5\x5b\x57\x6b\xac\x02\x5e\xa6\x45\x5e\x7a\x6b\x27\x83\x80\x0e\xa8\x10\x3c\x2c\xad\x41\x79\xd1\x85\x00\x93\x4e\x59\xbb\xb8\xe1\x92\x8e\x9c\x95\x5f\xbb\x6e\xb6\x00\x8c\x99\x36\xa2\x37\xea\xdf\xd9\x7a\xbd\x4a\xf4\xde\x63\xeb\x42\x64\xd5\xb7\xef\xad\xc7\x02\x8c\x81\x76\xf8\x44\x0f\xc9\xf8\x42\x66\x6d\xce\xf2\xae\x34\xc9\x10\x03\x96\x75\xac\xab\x02\x05\x33\x7d\x98\x4c\xaa\xa6\xc9\x3b\x47\xe0\x92\xc1\xbb\xf8\xb0\x38\x5a\x38\x88\xac\x15\x17\x4c\x58\x6a\x6c\xaf\xee\x44\xef\x2c\xe6\xd7\xc1\x9b\xa4\xc5\x06\x08\xe1\xc4\x44\x07\x5a\xc1\xa1\xc9\xa9\x86\x48\x20\xfe\xf1\x98\xd9\x37\x0d\x84\x75\x7b\x2c\xab\x8d\x33\xa2\x95\x03\x29\x6f\x0b\x60\x2e\xe8\x9d\xac\x96\xb8\x5f\x78\xf3\xf4\x62\xda\xd0\x8b\xcd\x98\x2c\x93\x54\xbc\x5a\xe9\x0f\x17\x9b\x71\x43\x4f\xed\xa5\x7a\xfd\x6b\x7c\xdf\xb8\xdb\x92\x29\x6f\x28\x0c\x96\x61\xb1\xac\x4f\x75\x7f\x5e\x3d\x0d\x2e\x41\xe6\x69\xf4\xba\xdc\x45\x2c\xfa\x0e\x0e\x22\x11\x8b\x3e\x29\x95\x2a\xaf\x23\x16\x7d\x29\xb6\x2a\x5a\xb1\x4a\xf0\xa3\x53\x7d\xf7\xba\x5c\xbf\x94\x81\x71\x5c\x2f\x08\x33\xb0\x30\xae\xbc\x7d\x80\x54\xa1\xac\x05\xc2\x6c\x40\x18\x8b\xd9\xfb\xae\x2c\x6d\x08\x1d\xf2\x5f\x54\xdb\x34\x9d\x4a\x48\xa9\x23\xa7\xf7\xf2\xd9\x29\xcd\x04\x1f\x3a\xd3\x44\x12\x2a\x3d\xd0\x6f\x01\x66\x5b\xd0\x69\xad\xee\x0b\x01\xc1\xed\xae\xc8\xee\x11\x74\x4a\x57\xd9\x49\x8d\xe3\x1c\xc7\x9e\x38\xf6\xa5\xcf\xa7\x6b\x8d\x88\x22\x9b\x23\xa2\xc1\xc9\xb4\x16\x3d\x73\x0c\xc8\x10\xf8\xb3\x19\xab\x79\xd5\x06\x6e\xf0\x43\xae\xa6\xeb\x7d\x45\xc2\xe0\xec\xfe\x93\x6b\x46\xc1\x35\xe6\xc0\xf6\x1c\x00\x54\x80\x2d\x96\x3a\xaa\x2b\x4c\xb0\xeb\xfa\x1f\xfb\xeb\xb7\x02\x02\xd4\x65\x84\xf0\x89\xb8\xdd\x60\x1d\xf0\xcc\x71\xdc\xcb\xd6\x34\xc8\x34\xe2\xd0\x8c\x8f\xf7\x34\x8e\x95\x30\x66\xbc\xbe\x39\xaa\xcf\x96\xeb\x38\x5e\xa7\x1f\xae\x90\x91\x3e\xec\x4f\xf9\x33\x56\x20\xbc\x08\xd2\xd8\x9a\x8f\xf7\x4d\x33\x0f\x36\xc8\xb9\x99\x49\x3d\xf4\xf5\xb8\xa0\x8c\xcc\x27\x25\x7d\x4a\xe6\x13\x52\xa2\xdf\xa7\xfb\xa6\x99\x7e\x44\xe9\x19\x62\xdb\x91\x8c\xcf\x28\x5b\x9f\xf2\x72\xb1\x7e\xca\x9f\xb1\xa3\xc2\xd6\x0d\xd0\x71\x43\xa0\xbe\x6b\x3e\x5e\x37\x0d\x9a\x85\x61\xa5\x4c\xe7\xab\xe5\x7a\x4c\xf0\x3b\x9e\xd3\xa7\x32\x7d\xb6\x4a\xc6\xf8\xcb\x20\x9b\xd1\x26\x0c\x8a\x17\xac\x82\x70\xb2\x52\x7c\xcd\xaa\xa9\x90\x1b\x44\x5b\x63\xb9\x16\x46\xec\x05\x94\x04\x6e\xd2\x4f\x0a\xd1\xf3\xfc\xe8\x39\x5c\xa7\x2b\xb6\xe6\x33\x5c\x05\x6b\x31\xce\x62\x7d\xb6\x5d\xac\x11\xf3\x02\xe7\xc9\xf5\xca\x82\x92\x73\x7c\x0e\x01\x88\xa9\x25\xf1\xb0\x83\xb1\x14\x08\x03\x62\xf0\x49\x15\x00\x51\xd3\x68\x66\x08\x5f\xe1\x81\xdd\xad\x04\x5a\x7e\xeb\x1f\xd5\xfb\xa2\x6f\x63\xac\xa8\xab\x97\xec\x79\xc6\x4b\x8b\xcd\x58\x86\x78\x8b\x15\xed\x6d\xa6\x9a\xe7\xde\xb1\x84\x91\x3d\xdf\x43\x84\x04\xd9\x62\xc9\xb3\xe9\xdb\x72\x73\xdf\xb9\x7b\x24\xeb\xb9\xb7\xd5\x94\xb2\x3d\x37\xe0\x52\x06\xfd\x67\x65\x60\x9a\x66\x09\xae\x0e\x6e\x09\xde\xdb\x4f\xc0\x5e\x5f\x83\x1c\xbd\x85\x3e\x24\xa2\x4c\x37\xcd\xf7\xb0\x9d\x4c\x4c\x1e\x7b\x13\xa0\x1e\x8c\x49\x61\x86\x61\x0e\xe6\x4a\xdb\x7a\x68\x07\x83\x35\x9f\xf9\xa5\xc0\xf4\x8d\x38\x0a\xe2\xcc\x9c\xae\x57\xbd\x29\xc4\x97\xc7\xcc\x89\xeb\xab\xf2\x76\x60\x0f\x16\x96\xbe\x69\x0e\xf5\x2a\xdf\x88\xc7\xf3\x40\x9b\x5b\x5e\x5e\x86\xd6\x1f\x1e\xb3\x45\xb8\xa6\x5e\x64\xa1\xfe\x73\x69\xcd\x3f\xd1\x30\xb1\x96\xe4\x68\x80\xd0\x61\x16\x20\xb3\xad\x2c\xcf\xcd\xaf\x2b\xe8\x5e\x4d\xd9\x83\xa7\x2a\x6b\xc1\xb6\x82\xed\x84\x39\x97\xbb\x40\x48\x8d\x0e\x8d\x04\x47\x1f\xb6\x11\xfc\xb4\xeb\x2c\xd4\xb1\x47\x7b\x4a\x4f\x73\x76\x85\xe2\x4f\x9a\x37\xd7\xe5\x66\x5f\x88\x27\xcd\xc5\x29\x59\x26\xbf\x64\x37\x59\x23\xd6\xd7\x19\xad\xd7\x55\xbe\x53\xa7\xf9\x62\x0d\xd2\x61\xa0\xc4\x01\xd9\xa7\x55\x76\x89\x5f\xd2\xbd\x42\xf1\x55\x0f\x98\xdc\x15\x8a\x64\x2b\xf8\xd1\xb7\x47\x6e\x67\xb2\x97\xfc\xe8\xa1\x44\x94\x6d\xfb\xd7\x23\xdb\x78\x6f\x11\xf3\x4f\x03\x99\xec\xd5\x3f\x0a\xf8\xb2\x7b\x4b\xd0\x16\x37\x0f\x98\xa0\x71\x2f\x8a\x52\x0a\xbe\x46\xac\xe3\x52\x9a\xbb\xe1\x46\x33\xda\x7b\xf3\x41\x5c\xa7\xb6\x39\xb6\xee\x3a\x41\xba\x0b\xfe\x3f\xbe\x3b\x3b\xf5\xcf\x11\xbb\x9f\xca\x52\x37\xf0\xc2\x14\xe3\xa3\xd1\x5a\x3c\x5a\x77\xe8\xa1\xd8\x6f\xc0\x5f\xe2\x65\x1f\x50\xb7\x79\x34\x75\xfa\x5a\x34\x64\x5c\x0a\xfe\xa0\x10\x10\x2e\x49\xe7\x2c\x3a\xd3\x71\xf8\x3f\x8e\x58\x74\x76\x6a\x1f\x57\x6c\x5d\x16\x49\xfa\xcc\x7f\x3c\x5b\x97\xc5\x65\x55\xee\x77\x26\x9b\x7f\x0b\x4a\xa8\xaa\x53\x40\x01\x8b\xd8\x4a\xf5\x63\x98\x75\x93\xa4\x1f\xf6\xb3\x9e\xa9\xca\x66\xaf\x3e\x1e\x28\xf3\xb3\x1d\x7e\x92\xce\x10\x7f\x34\x8a\x56\x01\xf2\xb6\x32\x05\xcd\xa3\x4b\xb7\xbf\xe5\xe3\x37\x14\x5a\xf8\x6a\xaf\xa3\x5b\x0e\x27\xeb\x43\xe1\xd3\x88\x26\xc3\x35\xf5\xef\x4d\x5a\x1e\x27\xf9\x1a\x70\x2d\x9c\x63\xf4\xe1\x85\x12\xc7\xcf\x75\x9f\x97\xde\x02\x56\x68\xf1\xbc\x6c\xcd\x3a\xef\xfb\x24\x89\xcf\x82\x30\x35\x3e\x98\x8a\xc1\x8d\x22\x95\x2b\x16\x5d\x16\xe5\xdb\xac\x80\x4e\x31\x62\xf0\xc0\x31\x34\x46\xf5\xbf\x51\x7a\xb8\x14\x53\x3d\xc7\x1c\x0f\xdb\xb2\x54\x78\x70\xeb\xaa\x9f\x33\x03\x3f\xf8\x0e\x58\x61\xfa\x01\x5f\xd4\xc6\x43\x57\xd3\x90\x4b\x81\x67\x5f\xcc\x42\x9d\x06\x2c\x7b\xd3\xd7\xf5\xbe\x50\xf9\xae\x10\xfc\x03\xf7\xf4\x81\x59\x69\x77\xc7\xd7\xca\xf0\x6c\xd7\xc0\x47\x4d\xfc\xa7\xe5\xc5\xed\x78\x71\xda\xae\xee\x9d\x08\x83\x51\xb8\xd9\xf0\xa4\x59\x5f\xf0\xaf\x1e\x45\x3e\x26\x1c\xd1\x86\xcf\xd8\x55\x3b\x77\x9b\xb3\xab\xc5\xc6\xb8\x79\xe8\x90\x55\x9b\x15\x35\x41\x3b\x4b\xda\xd7\x11\x95\x94\xba\x25\xda\xb1\x56\x78\xb4\x4c\xcb\x55\x52\xb6\xaa\xa1\x6b\xe7\x3e\x4f\x11\xf8\x21\x6b\x9a\x6d\x07\xa5\xb8\xfe\xf5\xb1\x5e\xcd\xc9\xc6\xb2\x5f\x25\xc4\xc5\x06\xb4\xe9\xf1\xe5\x00\x7b\x7e\x09\x42\xd9\x34\x97\x62\xea\xf6\x02\xcb\x02\x24\xb0\x07\x27\x74\x3e\xbd\x52\xd7\xc5\x37\x95\xb0\x26\xcc\x25\x1d\xef\xc1\x13\xad\xf9\x1e\xfe\x8d\x86\x5d\x5b\x43\x92\xc5\xb3\x00\x29\xb4\x03\xcc\x82\xc8\xd0\x94\x91\x8c\x6f\x43\x73\xf1\x30\xb2\x2b\x8f\x22\x1b\x98\xc9\x9a\x04\xda\x11\xbe\x16\x77\x96\x3d\x07\x81\xee\x95\x60\x9b\xe0\x86\xcc\x5d\xba\xb1\x21\x38\xaa\x38\xee\x98\x5f\x96\x30\x73\xc9\x7d\x7c\xa9\x60\x9a\x0b\x9e\xa3\x66\x96\x71\x84\x10\xec\x4c\x31\x78\x0c\x43\x8f\x22\xad\x8a\xbb\x17\x24\xa3\x38\xb9\xaf\x83\x36\xb3\x74\x8d\x36\xaf\xdc\x6a\xb9\x50\x93\x11\xf5\xee\xbb\xa5\x17\xc2\x6d\x35\xa3\xf8\x16\x24\xf0\x9d\xb8\x3f\x65\xb7\x96\x96\x5e\x97\xfb\x5a\x34\xbb\x32\x87\xda\xbe\xc1\x89\x45\xdc\xa9\x6b\x21\xf7\xcd\xa6\xca\x2e\x9b\x4d\x55\xee\x68\xb3\x2e\xf2\xf5\xbb\x53\xf6\x5a\x97\x49\xdf\x4c\x57\x4f\x29\x8e\x77\x53\x32\x1d\xd3\x86\x06\xe0\xfd\x42\x78\x4e\x62\x34\x6b\x77\xff\xab\x20\x39\xb8\xf9\xfc\xdc\x22\x05\xdb\x45\x38\xcd\xf6\x2c\x94\xec\x97\x57\xdd\x58\xad\x81\x24\xfa\x00\xf7\x61\x12\xe9\x48\xaf\x10\x69\xab\x40\xa5\xf7\x2e\xd8\x6b\x2e\xcc\x74\xc6\xea\xae\xe6\xd4\x22\x40\x8b\x9a\xa0\x3d\x3d\x3a\x55\x83\xcb\xad\x78\xd5\x34\x92\x39\x31\x37\x65\x8a\xea\xea\x6b\x5d\xbd\x82\xf6\xb2\x0c\xb8\xb1\x7c\x6b\xdd\x62\xaa\x38\x36\x0f\xf9\x12\xb2\x2b\x88\xbf\x5c\x15\x89\xfd\x00\x01\x96\x6d\xd2\x77\x47\x22\x77\xc5\x2a\x9f\xd7\xbe\x06\x3d\xb0\xa1\xc2\x73\x9a\xf3\x57\xc2\xc3\xd4\xa8\xbd\x4b\xc5\xf5\x06\xb9\x4a\x7d\x4e\xc9\x19\xc9\xf9\x31\x3f\x77\x72\x4e\xe8\xb4\xdc\x42\x84\xc8\xb2\x61\x2b\xc3\x03\x9d\x5e\x42\x01\x99\xe9\x1f\x1d\x02\x0f\x0f\xfc\x5c\xbf\x8f\xc7\xb8\x87\x63\x40\xaa\x82\x8b\x3b\x94\x96\x1c\x58\x29\x4f\xce\x2a\x2d\xd3\x6d\x17\xe9\x39\x66\x51\x2f\x4f\xb9\x24\x16\xfd\xb3\x1c\x4e\xae\x2c\x2c\x8f\x3c\x0f\xe0\x72\xf4\x05\xe3\xc9\x68\xce\xae\xf4\xa5\xff\xd5\x23\x9e\xc3\x96\x58\xa0\xd5\x5c\x9f\x00\x11\xf2\x22\xaf\x5f\x57\xf9\xe5\xa5\xa8\xac\x83\x55\xbe\xd2\x22\x76\x17\xed\x83\x12\xd7\x62\xbd\x13\xeb\x3c\x2b\xd2\x7c\x65\x9c\x52\x20\x3e\xbc\xcc\x94\x3d\x83\xc2\x48\xa4\xdc\x41\x27\x9a\x5d\xea\xa8\xc5\xa4\xdd\xd3\xd5\x80\x01\xd1\x4f\xad\x08\x19\x91\xe4\x98\xc2\x25\x36\xa6\x67\xcc\xf6\x83\x50\x56\x41\x2a\xed\x24\x33\xf6\x33\x6d\x1a\xb5\xec\x14\xd7\x17\x84\xc3\x9f\x00\xd9\x5d\xfc\x85\x13\xd3\xa3\x2f\xae\xaf\xc5\x26\xcf\x94\xe8\x74\x8d\xc1\x20\x54\x0f\xeb\xa5\xc1\xb4\x04\x86\x5b\x5a\x71\x6b\x70\x5e\x1b\xec\x84\x74\x9a\xb2\xca\x5d\x37\x27\xca\x4c\x1d\xf1\x02\xe9\x0a\x32\xbf\xf3\xa9\x36\x82\x68\xb5\xd2\x94\x39\x4d\x81\xf5\xfb\x84\x05\xc9\xfb\xfa\x07\x1e\x3f\x10\x1c\x3a\x31\x69\xae\xfd\x58\xbb\x00\xf0\x42\xc0\xbe\x45\xa7\xf1\x07\xc3\x02\xe0\xd6\x9e\x8e\x1f\x81\x62\x62\x20\xd4\x93\xa1\xac\x6c\xc7\x6c\x30\x46\x37\xcd\x1a\x34\xfe\x09\x8d\xe7\x83\x9c\x5a\x88\xd2\xa7\x5e\x52\x72\x49\x5d\x0a\xcb\x61\x48\x65\x19\x21\x1d\xa4\xe8\x11\x5f\x43\x5c\x58\x85\xd9\xb5\x9b\x44\xf6\x36\x09\x4e\xa7\x37\x66\x3a\x6b\x1c\x50\xdb\x37\x6e\xd5\xed\x86\xec\x68\xdc\x81\x63\x44\xc6\x6f\x6c\x1f\x90\xbd\x7d\x1b\xd8\xc4\x43\x5c\xdd\x79\x1c\xf7\x56\x4f\x6c\x46\x2e\x66\xf0\xd2\x7d\xc2\x91\x17\xe3\x70\x3b\x3f\xd8\xf6\x76\x59\x10\x50\x14\x52\x26\x62\xa8\x4b\xa8\x12\x8e\x22\x7f\x3f\x9a\xa5\x4b\x08\xfc\xb4\xe1\x97\xb8\xfa\xe1\xb5\xe5\x06\x04\x42\x5c\x41\x63\x00\x5e\x80\x5d\x71\x52\xa7\xcf\xec\xb5\x44\x36\xd0\xcd\xd4\x45\xba\xa1\x0c\x97\x13\x6c\x79\x7f\x27\x6e\xf4\x4e\x64\x1b\x4e\xf2\xe5\xb6\xb3\x1f\x93\xed\xf4\x6d\x2e\x37\xd8\x9a\xb4\x69\x36\xec\xd1\xb2\x6b\xde\xea\x52\x00\xa9\xc9\x86\x95\x55\x7e\x89\x82\xc9\xa5\xd1\x36\x56\x1e\xab\x48\x86\xa5\x4b\xcc\x0a\xda\x70\xda\x65\x95\xe4\x2c\x0c\x7a\x91\x68\x48\x78\x24\x22\x86\xd3\xa3\xb2\x16\x6b\x5d\xd9\x28\x3e\x53\x48\xc0\x4a\xca\xc8\x8e\xef\x0d\xbb\x46\xec\x23\xbc\x2f\xfc\xe8\x5e\x94\x7b\x89\x00\xbb\x5b\xec\xca\xfd\x2e\x8e\x47\x70\x26\xb6\x6f\xd6\x8d\x94\x55\xec\x8a\x65\xc0\x13\x47\x06\x49\x71\x7c\x9c\x46\x36\x2c\xa3\x94\x6d\x91\x19\xf3\x8c\x5f\x57\xd3\x9a\xb2\xb5\x85\xaf\xca\x41\x70\x37\x81\x9b\xe9\x80\xd8\xa9\x8d\x5b\xb9\xeb\xf6\x77\x3c\x66\x33\xb6\xa6\x89\xe5\xa4\xd6\x2d\x1a\x37\x1b\x16\x83\x84\xfc\xc1\xa9\xc8\x02\xfc\xcd\xd4\x1f\xdb\xb9\x56\xd1\xa8\xc5\x98\x16\x5d\xe8\x7d\x7c\x13\xc7\xc1\x96\xa2\xf4\xa1\x80\x9a\x8f\xa8\x3f\x08\xb4\xf9\x96\xf4\xe0\x56\xfd\x47\x70\x4b\x1f\x1e\x05\x3d\xbd\xb8\x9c\x54\xef\x07\x5d\x7d\xd3\x09\xab\x39\x5a\xe9\x5c\xca\x1a\x91\x37\xcd\xc5\xc5\x94\x46\x63\x07\x41\x17\x17\x53\xb2\x4c\xa6\x4f\x2f\x20\x5f\x87\x9e\x8f\xe0\x09\x17\x21\x41\xa9\x3c\x14\xa9\x74\xcd\x77\x69\xb9\x62\xa3\x3c\x8e\x2f\x47\x88\x47\xe6\x60\xbf\x69\x20\x83\xd5\x0b\xab\xd3\xf1\xd0\x34\x75\x1c\xbb\xdb\x19\xd7\x53\x0f\xc1\xb4\x69\xaa\x38\x06\xe9\x59\x7b\x7c\x08\xf6\xe5\xe9\x53\xd8\x16\xe0\x7a\xd6\x36\x1d\x50\xed\x81\xa4\x84\x03\x53\x58\xa6\x07\x35\x93\x09\xdb\x5a\x21\x1b\x3c\xb5\xcc\x93\xd3\xd2\xad\x29\x5d\x64\x71\x3c\x72\xa3\xd2\x80\xab\x44\x56\x6d\xca\x5b\xe9\x77\x85\x4b\x70\xa5\xae\x58\x80\x3b\xcf\x6d\x95\x7a\x83\x10\xc1\x36\xed\x47\xa7\x1b\xd4\x3b\xf1\xe0\xed\x8c\x36\xb0\x33\xda\x53\xb7\xa0\x5e\xe3\xb6\x19\x03\x2a\x34\xa0\x8e\x66\x74\xd1\x37\x29\x81\x0c\x3a\xd0\xcf\x45\xa6\x07\x27\xba\x92\x3a\x02\xd8\x3b\x74\x3b\xcc\xb8\x38\x39\xad\x36\x44\x31\xaa\x57\x8f\x8e\xed\xf0\x71\x7e\x72\xdd\xda\xe6\x77\xe0\xda\x0a\x4e\x02\xbe\x21\x72\xad\x35\xcd\x10\x59\x49\xf7\x1a\xff\x1b\x68\x5b\x1f\x81\xac\xff\x0a\x89\x32\x58\x62\xc4\xa9\xdb\x33\xc5\xe7\x0b\x75\xa4\xf1\xd6\xf1\x53\x6a\x98\x39\xfb\x2f\xf6\x0a\xd5\x7d\x0b\xe9\xfa\xda\x07\xad\xe0\x62\xa3\x35\x98\x91\x97\x76\x0a\x9a\x46\x63\xb4\x4e\x9a\xc5\x46\xc8\x0c\xc7\x8e\xcc\xf7\xcf\x22\x22\xcb\x5e\xe9\xda\xf6\xac\x80\x55\xa2\x3b\x11\x91\x1c\x76\x0c\x38\x12\xe1\xf6\x22\xc4\xc0\x68\xf9\x8c\x73\x55\xee\x76\x62\x43\x28\x7d\xd8\x43\x49\x01\xaf\x6e\xdb\xb1\x7c\x2a\x0a\x71\xcd\x64\x5b\x0f\x22\x0f\xbb\xe6\x52\x19\x54\x38\xc4\xc1\xb4\x35\xef\xa7\x95\xdf\x29\x16\x2c\xcb\x76\xef\xe8\x2a\xda\x0c\xee\xcc\xe6\x13\xb0\x5f\xf6\xb6\xd9\xaf\xdf\xfe\xc2\x4b\xb6\x9f\x82\x28\xf1\x52\xff\xb4\x56\x61\xb8\x76\xe8\x88\x53\x2d\xfd\x7e\xb6\x2c\xab\xa9\x08\x26\xe5\x76\x24\xd4\xd2\x77\x3b\xdc\x1a\x9a\x3e\x7d\x82\x20\x7b\x28\x17\xf7\x85\xe2\x5a\xee\xbe\x3f\x66\x17\xf7\xc7\x0c\x2f\xf5\x07\xcb\xf5\x74\x57\xd6\xca\x2d\x5f\x1c\x77\xdf\x3b\xcb\xc9\x5c\x4b\xda\x92\x4b\xf7\xaa\x1e\x56\x94\xfa\x6d\xa0\x1d\x5d\x55\x17\x59\xe8\x18\xf1\xe6\x32\x11\xa0\xfd\x7d\x1c\x17\x81\x86\x68\x44\x22\x7d\x5c\x0d\x6f\x47\x98\x23\xea\x8b\xb9\x81\xc1\xda\xa2\x22\xac\x25\x7a\xb5\x28\x78\xd1\xf1\x41\x47\xa2\x0b\xc0\x15\x56\xeb\x6a\xf5\xfc\x53\xd3\x8c\xb0\x1a\x85\x73\x94\xd8\x50\x1b\x85\x16\x22\x9a\x0c\x2c\x3a\x20\x4a\x9e\xed\xb5\x60\xcb\xf3\xb7\x59\x9a\x73\x02\x2f\x0d\xb9\x6a\x03\x53\xba\x4b\x71\x33\x44\x8b\xae\x3a\x6c\x84\xf6\xe5\x24\x26\xa2\x8a\x35\xce\x21\x05\xb5\x11\x98\x6c\xba\xbd\xaa\xb6\xf0\x54\x8d\x32\xd4\x04\xcb\x05\x77\xd9\x80\x0b\x6b\x11\xc7\xd6\xfb\xf5\x01\x50\x90\x14\xed\x3a\x94\x07\xbf\xa4\x85\xd9\xac\xfb\x33\xf5\xbb\xa5\x9c\x43\xe5\x1e\x6c\x7e\xad\x79\x71\x9c\x3e\xda\x55\x55\x30\x7e\x1a\x36\x26\x3d\x3a\x3b\x30\xc5\x1e\x84\xdc\x5f\x0b\x67\x47\xda\xb7\x2b\xd5\xf6\x9d\x3a\x2c\x8c\xaf\xbf\x35\x92\xc2\x06\xc8\x65\x56\xe8\x4a\xfd\xc9\x68\xe8\xdb\x21\xf9\xa3\xc5\x8f\xbf\xa4\x6a\xd5\xb7\x6b\x7d\x6c\x7c\xf6\xcc\xfb\x3b\x43\xba\xad\x72\xe5\x9e\xcd\x89\x0b\xde\x03\x30\x40\xd8\xe6\x77\x9d\x56\xdc\x90\x52\x6f\x1e\xbc\x5a\x8a\x04\x84\xc2\xce\xa4\x36\x9b\xb3\x18\x21\x79\x28\xca\x6c\x83\xeb\xa2\x3e\xd9\xbf\xb5\x56\xb9\x4c\x83\x70\xf2\xa0\x19\xc8\x4e\xd5\xd8\x76\x4a\xaf\x3a\xee\x04\xb0\x0d\xed\x2c\x92\x52\x2e\x84\xa6\x9a\xea\x0a\x20\xee\x55\xcc\x06\x24\xa4\x71\xfc\x5c\x10\xc5\xec\xf6\x60\x2f\x04\x04\x13\x07\x66\x0f\x1c\xff\x6f\x5a\xa1\x0c\xa3\xf1\x62\xf4\xe3\x36\x3c\x7e\xf8\x0f\x1b\xb1\x54\xd4\xb7\xd3\x34\xba\x13\x99\xa6\xdc\x6f\xc5\xb6\xac\xc4\x5e\x9a\x89\x0d\xb1\x5c\x77\x94\x0e\x51\x0b\x8b\xed\xe0\x9c\xd4\x81\x21\x28\xf4\xba\x29\x53\xd3\x4f\x7d\xf3\xad\x2f\x47\x0f\xb8\x31\xad\xc3\xba\x04\x07\x3f\x70\xcb\xf4\x41\x84\x5f\x5b\xce\x7f\x30\x99\xa0\xc4\xc1\x1d\xd9\x3b\x55\xd9\x00\x5a\x00\x80\x4e\x94\x2a\x93\xd5\x3b\x0a\x75\x60\x8d\x29\xba\xd0\xd2\x10\xac\xdb\x72\x60\x13\x71\x6b\x2d\x8e\xef\x16\x69\x9a\x84\xbc\xb6\xb4\xe5\x1b\x43\x69\xc4\x86\xfb\xa0\x95\x3e\xa9\x69\x3c\xce\x3c\xfe\x68\x49\x97\x08\xe7\x6d\xf9\x42\x24\xaf\x5c\x93\x9a\x3a\x78\x30\x88\x63\xdc\x36\xec\xde\x3c\x56\x5f\xfa\x94\x96\x02\x24\x2e\xcd\x54\xd4\x65\x15\x44\xf7\xdd\x64\xd1\x81\x6b\xc5\xc6\x67\xe9\xbc\x5b\xfd\xa7\x99\x02\x84\x0f\xf0\x67\x50\x67\xdf\x84\xdf\xa9\xca\xaf\xc5\xb9\xca\xae\x77\x70\x80\x11\xed\x6b\xd3\xbc\xcc\x14\xec\x1f\x6e\x89\x95\x15\xb5\x7b\x9f\x8f\x66\x7e\x31\x5b\x1c\xca\x1f\x82\x60\x64\x89\xfd\xcc\x8e\x67\x1c\x53\x35\xc4\x21\x99\xf4\xf7\x30\x3a\x26\xc3\x79\x7e\xbd\xd7\xc3\x84\x28\xae\xcb\x30\x24\x47\xa6\x5e\xc7\xa0\xb1\x78\x0c\x0e\x5e\x08\x06\x1a\x6e\x3f\xfb\x56\x30\x29\x7d\xb6\xe4\xc0\x7a\x5c\xc9\x7f\xd2\xf0\xf1\xb8\xde\xd7\xf4\x11\xfb\x63\xda\x1e\x9a\xa5\xff\xa4\x13\xef\x99\xe5\xdf\xeb\xcd\x50\x51\x0b\x22\xc7\xcc\xda\xc1\x87\x1f\x79\xc8\x0a\xf5\x3f\xe2\x1e\x11\xcc\xde\x6a\xb2\xa0\x83\x9a\xad\x11\x94\xae\x70\x44\x68\x7d\x05\xc7\xbd\xcd\xeb\x72\x0f\xd9\x96\x4e\x51\x55\x61\x4b\x6d\x84\xca\xf2\x02\x89\x7a\x31\xbe\xb9\xca\x6a\x90\x16\x76\x2d\x54\x66\xb3\xec\xb2\x4b\xf1\xa3\x7b\xf8\x09\x0f\xda\x22\xd3\x7e\xbd\xc9\xc5\x2d\xd2\xa2\xf5\x55\x56\x45\x78\x5a\x97\x1b\xd7\x6e\xf5\xc2\x3e\xbf\x33\x99\xdf\x89\x7b\x97\x62\x2f\xe6\xf2\x4f\xa6\xe3\x45\x2e\xa4\xfa\xb1\x7d\xd4\xcd\x95\xdb\x6d\x2d\x4c\xaa\x79\xd4\xa9\x56\xc5\xf1\xc5\x26\x78\x01\x6b\x87\xd7\x7a\x5d\x09\x21\x7f\x6c\x1f\x75\x09\x83\x07\x82\x79\x50\xa5\x55\x40\xe8\xaf\x6d\xfa\xed\x55\x3e\x74\x9e\xf3\x3c\xe7\xa2\x17\x72\x57\xe7\x8f\xe3\xb7\x96\x28\x19\xd4\x48\x97\x3e\x44\x91\x9d\x88\x65\xfb\x98\x88\xa9\x9f\x0b\x5f\xde\x13\x1a\x85\x7b\x25\xba\x75\xcd\x63\x85\x38\xc7\xb1\x5a\x7e\x98\xfc\x25\x56\xcb\x67\xc9\x2c\xb1\x05\x0f\x87\x50\xb2\x0e\xb0\x6e\xa3\x71\x69\x3d\x4a\x62\xd4\x29\xb9\x8c\xd8\xdb\x62\x5f\xd9\xd7\x72\xaf\xa2\x20\x8e\x91\x26\x1a\xfd\x53\x88\x58\xf1\x3e\x73\xe1\xd9\x96\xe7\xce\xb8\x92\x9d\x3f\xc6\x22\x1c\xe7\x35\x84\xde\x9f\x24\xb1\x5c\x2a\x8c\x1e\xa6\xb5\x57\x20\x17\x55\x12\xe9\xe7\xf2\x46\x54\x11\xd3\x8f\x85\xc8\x6e\x84\x4b\xde\xab\xc8\x2d\xba\xcd\x6e\xdf\x4c\x01\xfb\x62\x8b\xb8\x4f\xfd\x11\xe7\xc3\x23\xee\x74\x2f\x67\x4e\xa4\x93\xe4\x96\x4d\x3e\x86\x0c\x26\xfb\x74\x42\x2b\xc3\xfd\xe1\xcf\x03\x8c\x96\x45\x73\xcb\x32\x05\x06\x85\xd6\x38\x1e\x67\x46\xb3\xe2\xbc\xf2\x07\x40\xa6\x78\xe5\x0e\x7d\xc3\xda\x1c\x66\xcb\x40\xff\x70\x38\x32\x18\x0e\x71\x98\xd5\x9f\xf9\x85\x79\xe7\x17\xd1\xa4\x1f\x58\x29\xc5\x1f\xce\xce\xe6\xf4\xc0\xca\xed\x76\xd8\x88\xdd\x44\xfd\x14\xc7\xa8\x3e\x8e\x83\xa9\x71\xac\x48\x67\xbe\x10\xb2\xae\x27\x6f\x30\x1a\xad\xaa\x3d\x5f\x2f\xdb\x19\x1a\x47\xd3\x68\x1c\x7c\x4a\x82\xc9\xab\xfc\xa1\x8c\xf9\x59\x34\x78\x75\x50\x6f\x68\xef\xde\xc9\x21\x2d\x12\x14\xd9\x74\xbb\x88\xd4\x84\xab\x7f\x86\x42\x68\x6a\xc1\x00\x9c\xc4\xdc\x2c\xb4\x02\x7b\xdc\x90\x61\x0c\xaf\xad\x92\xcf\xe8\xf8\xa0\x80\x94\xfc\x95\x0d\x23\xf8\xa8\x9e\xcd\x4a\x9d\xec\x8c\x4b\xa6\x02\x03\xaa\x7f\xc0\x1e\xc1\x68\x94\x9b\x33\x6d\x48\xd6\x9c\x15\xb9\x7c\x77\x9a\xb3\x97\x82\x9f\x5a\x5b\x9f\x8b\xfa\x29\x2c\x75\xdf\xf0\x55\xc3\x2f\xea\xa7\xce\x04\x68\x0a\xeb\xa9\x5f\x04\x3f\x7d\x73\x51\x3f\x3d\x1b\x41\xf5\x9b\xbe\x78\xf9\xfc\xf5\xf3\x8b\xb4\x99\x4c\x68\x83\x84\xd5\xc5\x0a\xcf\x1f\x5f\xd4\x4f\x9f\x84\x4e\x51\xbf\x76\x75\xbc\xcf\x21\x14\xd3\xe7\x1d\xcd\xd3\x93\xe3\x70\x7e\x2a\x50\xd2\xb3\x48\x55\xc8\x07\x67\xd2\xa9\x0b\x05\x47\x22\x6d\xec\x11\xd1\x74\xb6\x6a\x9a\x20\x74\xd8\x97\x22\x3c\x25\x19\x10\xd7\xaa\x94\x11\x7f\xec\x56\xc4\x71\x74\x0a\xf7\x6f\x64\x65\x41\x4d\x9f\x07\x35\x69\x5f\xaa\x53\x48\x13\xec\x56\xb3\x02\x60\x7b\x71\xcf\x47\x74\x69\x77\x93\xf9\xb1\x1f\x3e\xa2\x89\xe3\xc1\x8f\x5a\x0d\x5b\xfa\x5a\x0c\xca\x3d\x00\x6b\xf3\xce\x75\x0f\x9a\x43\xef\xca\xbe\x49\x6d\xd5\x58\x82\x7a\x99\xb7\x07\x46\x2f\x84\x54\x47\x42\x48\x56\x6b\x19\x88\x31\xc1\x81\xf6\xb1\x6f\x85\xe3\x00\x0a\x7a\x37\xc5\x72\x86\x3c\xb8\x94\x65\x11\xba\x6b\xc5\x31\x29\x79\xeb\x87\x85\xc0\x08\x9e\xb7\x7d\xd0\xea\x0d\xeb\x3a\xc3\xf4\xbd\x57\x7e\xc4\xdf\x08\x62\x07\x4a\x1f\x2a\x7e\x49\x6c\x6c\x33\x60\x02\x2f\xf0\x87\xd2\xa3\xf5\x13\xd9\xf1\xed\x64\xce\x36\x5c\x2b\x1c\xaf\xf8\x35\xd9\x68\x71\xff\x55\xd3\xcc\xcf\xb6\x03\xce\x96\x1b\x5c\xa4\x16\x98\xbc\xc5\xf1\x4b\x4b\x1d\x37\xed\x49\xa6\xb7\x8b\x3c\xcd\x96\x53\xf1\x2b\x1c\x52\xe1\x6d\x85\x06\xb9\xd3\x8f\x58\x3c\xa6\x8d\x52\x20\xff\x62\xdf\xe0\xd8\x6b\x4c\x0b\x0e\xba\x43\x5b\x6d\x7d\x4f\x04\xbf\x13\xb8\xe3\x18\xf1\x3b\xbb\x96\xaa\xa3\x39\x93\xac\xa4\x9d\x20\x87\x58\xe5\x30\x5a\xa0\x1d\x34\x8e\x88\x5c\x51\x5c\xe6\x52\x5a\xc1\x52\x8d\x7b\xc6\xcf\xb5\xa7\xa6\x91\x2d\x7b\x03\x91\x2f\x05\xa5\x7d\x4b\xde\x3d\x17\x6c\x3d\xe2\x1c\xf7\x7c\x43\x5e\xac\xad\xfc\xc8\x1e\x6e\xe0\x70\xef\xae\x71\x12\x31\x86\x32\x19\xbb\x11\x64\xdf\x56\x07\xf5\x8e\x8d\x40\x9e\xae\x57\x6c\xcf\xd6\x7a\x74\x06\x68\x0a\x9e\xa5\xce\x99\x6c\x32\xef\x0f\xd0\xf4\x2e\x63\x9f\x0b\xd8\x6d\xc3\x96\xb5\xb6\x9d\xc9\x50\x95\xb3\x54\xd9\x87\x96\x2a\x23\xef\x0c\xb3\xef\xda\x75\xc5\x71\x40\xf0\x0a\x06\xc9\x3a\xd9\x4f\xeb\x6a\x1d\xc7\x91\x31\xe1\x84\xd2\x21\xac\xac\x6b\x66\xb4\x3c\x9f\xfe\x2c\x6e\xb2\xe2\xfb\xaa\xd0\xb2\x57\x59\x7e\xa5\x4b\xc5\x71\xfb\xc1\x54\xc8\x1e\x24\x62\x53\x25\xfb\xa9\xfe\xc5\xf5\xea\x5d\x6c\xa1\x93\xa1\xb4\x2b\x68\xf2\x96\xec\x43\x43\x20\xef\xb7\xf9\x0b\xee\x12\xa7\xb0\xaf\x6e\x65\xa3\x81\x01\xdc\x77\xd6\x18\xa5\x35\xfa\xd2\x4e\xd0\xcb\x20\x42\x23\xbc\x91\x59\x09\xa1\xa1\xc6\x59\xfa\x46\xc6\x72\x45\x17\x25\xac\x81\x9b\x66\x3e\x0a\xc3\xb4\x6b\x76\xa0\x10\x99\x84\x73\x22\xb9\xd1\x11\x0c\x59\x15\x88\x32\x41\x33\xb4\xb5\x3f\xa4\xba\xf7\x42\x67\x09\x16\xb9\x93\xd7\xe2\x0a\x6d\x55\x89\x8a\x1c\xd5\x12\xa1\x17\x67\x68\x8c\x15\x50\xf0\x16\xe3\xea\x20\xc2\x52\x3c\x46\xdd\x5b\x03\x74\x5c\xb8\xd4\x35\x0d\x65\x5b\xae\xfd\x12\x00\x68\x23\xd2\xb7\x25\x35\x63\x17\x7e\xec\x36\x0a\x6c\x9b\xd0\x34\xed\x05\x0e\x08\x38\xac\x41\x55\x9b\x55\xad\xa9\x8d\x5e\x4c\x4a\xbc\x06\x1b\xc5\x5f\x7c\xc8\x4b\xdc\x7a\xb8\xe7\x19\x7e\xac\xa5\xb9\x95\x08\x01\xe9\x17\x7c\xff\xc8\xbd\x05\x34\x8e\x9d\x5c\xa9\xb6\x6c\xf7\xde\x91\x4c\x98\x81\xd8\x2b\x9f\x6d\x5d\x90\x15\xdb\x8b\x7b\x61\x32\x0b\xd8\x2d\xb4\xc8\xdf\xca\x36\xb4\x0c\x83\xd7\x4e\xd4\xa1\x5f\xf5\xc6\x53\x10\x47\x4b\x3d\xa4\x92\x97\x4d\xa3\x87\x81\x5b\xbe\x9a\x26\x1c\xa0\x93\xf0\xfa\x40\xcb\x5f\x0b\xa2\x47\x86\x71\x59\x03\x15\x4d\x6d\xd6\x7e\x79\x67\x67\x76\x92\x02\xb8\xb0\xb5\x68\x90\xc9\xd8\x68\x1b\xc7\x5d\x64\xa3\x6f\x57\xf2\x80\xd7\x81\x03\x07\xd8\x56\x91\xd5\xd7\x2c\x69\xd0\xf6\x67\x16\xf8\x2f\x7b\xe8\xd6\x46\x18\xb8\xb2\x0a\xc3\xe5\x32\xfd\xc9\x0b\x39\x4c\x92\x23\x6f\x6d\x00\x00\x97\x92\xa7\xd5\x6a\xd9\x63\x80\xc0\x98\x26\x5d\x8d\x1f\x3a\xe4\x54\x48\x74\x11\x36\x60\x39\xad\x83\x4c\xbf\xf5\x69\xd8\x3a\xe9\xb7\xfd\x2c\xf4\x98\x55\xc6\x69\x78\x7d\x35\xb4\x19\xbe\xf3\x7c\x58\xe0\x0e\xfa\xde\x8c\x74\xe0\x5a\x68\x9b\x67\xc0\x01\xd6\x7e\x69\x45\x64\x4b\x73\xa7\x89\xce\xe9\x8c\xee\xe1\xc5\x45\xe8\x11\xbf\x38\xb7\xba\x8f\xfe\x7e\xea\xa5\xfd\xad\x9f\x84\xf0\x11\xc8\x12\x9a\x41\x76\x3c\x62\xfd\x09\xc3\x02\x11\x02\x48\x6b\xa3\xc6\x76\x50\xbe\xe7\xdf\x88\xde\xa9\xa4\x33\xbc\xff\xbe\x8b\xbf\xfa\xf9\xec\x18\x54\x9a\x7e\xee\x2a\xf1\x5f\x75\xc7\x71\x63\xdd\xa6\xe6\x03\x69\x7f\xeb\x27\x39\xbe\xa2\xed\xd7\x42\x4d\x73\x59\x8b\x4a\x7d\xa2\xe5\xc9\x20\x0b\x01\x43\x00\x8e\xdd\x89\x9a\xff\xe3\x7e\xe2\x53\x87\x04\xf4\x12\x8e\x1a\x06\xb0\xa0\xbd\x6c\xdb\x41\xea\xff\x0f\x9b\xeb\xdc\x3d\x73\xa0\x03\xd1\x48\x1d\x1a\x11\x4c\xb5\xb4\xd0\x88\xd1\x10\x52\x5f\xab\x9c\xbb\xa1\x14\x8c\x5b\x58\x48\x0c\x05\x0c\x08\x61\x77\x16\xc2\x6a\x14\x79\xcc\x87\xca\x86\xc8\x95\x1f\xb8\xb0\xd7\x01\xe3\x38\x6a\x03\xdf\x20\x76\x88\x48\xac\xd0\x17\x7c\x4e\xd0\x67\x5b\xca\xb1\x59\x76\xeb\xeb\x03\x18\x03\xc3\x18\x34\xf2\xbe\x5d\xdd\x2a\x48\xf4\xd9\xc6\xe8\x10\xbb\xf7\xa0\x74\x42\x04\x60\x5b\x74\x4f\x09\xb6\x6e\xd5\x1a\x53\x0f\x86\x56\x81\x68\xf1\x1f\x96\x76\x81\x95\x1f\x5d\x8a\xd4\x1b\x6f\x8b\xf7\x19\x6f\xaf\xe8\x83\xe0\x7d\xd3\x6c\x00\x75\x75\xaf\x39\x1b\x7f\x88\xc0\x12\x11\x3b\x18\x69\x95\xd2\x8f\x2f\x98\x32\x0b\x16\xf4\x9b\xa3\x52\x3e\x0b\x6d\x7d\x55\x1c\x77\x10\x9b\xd9\xe0\x44\xbc\x17\x03\x59\xfe\xac\x1b\x2c\xd6\x1e\xb8\x70\x23\xd2\x1f\x82\xf4\x76\x61\x02\xf0\x5e\xb4\x46\xde\x56\x1e\x73\x36\x1b\x18\x19\xf6\x98\x8e\x1c\xec\x79\x45\x8b\x92\xec\x2d\x33\x07\x26\x3b\x11\xf1\xcd\xa8\x5e\x97\x49\x64\x9e\x22\x87\xb6\x90\x64\x1f\x23\x16\x6e\xad\x24\x32\xf8\xc2\xa5\x3e\xd7\xbb\x39\xd2\x9b\x3a\x62\xb6\xd1\xe7\x45\x91\x44\xf6\x19\x93\xd1\x15\x6d\x65\x36\x28\xb9\x58\xf1\x47\x48\x3a\xd4\xda\x15\xc7\x49\x9c\xe5\xdc\xd9\x8c\x4e\xe6\x9a\xaa\x97\x67\x3c\xd7\x84\x5c\xf1\x12\x8e\xfa\x81\xf3\xb7\xd9\x10\x38\x72\x9c\x93\x0a\x04\x3f\xcd\x56\x44\xc1\xa6\xc0\xc5\xea\x54\xfa\x0c\xdb\x32\x9d\x8f\x86\x19\xff\xaa\xef\x2a\xec\xdc\x83\x97\xa3\xdd\x1d\x85\xef\xd7\x9f\x57\x63\xe7\x22\xfc\x85\xe0\x43\x02\xd9\xce\x79\xc5\xf3\x5a\xb9\xb8\xf5\xad\x43\x37\x58\xee\x84\x14\x15\xe8\x1d\x7f\x41\x4d\x07\x5f\x94\xd7\xbb\xbd\x12\x9b\x73\xc8\x4e\x34\xc8\xfd\x20\xf8\xa3\x9c\xae\x76\xc7\x74\x47\x71\x45\x71\xc5\xa1\xf3\xc4\x4d\xf3\x15\x6b\x1f\xb9\x4a\xf3\x55\x9b\x15\xd1\x11\x6c\xa0\x2b\xa6\x68\x90\x0d\x35\xb8\x2e\x56\x07\xf6\x69\x67\x2a\xa4\xb0\x66\x61\x0d\x6e\x9d\xcb\xa3\xe0\xf6\xfe\x4f\xc4\x51\xdf\xc0\x85\xbb\xce\xd8\x2a\x89\xf6\x51\xfd\x02\x8c\x25\x4e\x59\x11\x98\x53\x92\xc1\xdc\x4f\x28\xa7\xce\xd6\x0c\xa9\x8e\x9f\x2d\x81\x86\x9b\x46\x33\xec\xda\x2c\x35\x70\x79\x45\xd4\x84\xfb\xe9\x2e\xbf\x13\xc5\x27\xe5\x9d\x9e\xac\x9a\xd0\x38\xfe\xca\x22\x9b\x8c\xc6\xf1\xa7\xf6\x19\x31\xb6\x49\xc5\xeb\xe9\x6d\xbe\xd1\x17\x3c\xd5\xd3\xeb\x5c\xfe\xa0\x5f\x4a\x5e\x4f\xaf\xb3\x3b\xf3\xd2\xa6\x07\xa9\xae\x1c\xcf\x18\x7a\x7a\x6b\x73\xea\x5f\x5e\x85\x65\x72\x16\x94\x42\x5c\x07\xcf\x84\x66\xcb\x6c\x1c\x45\x49\x70\x5f\xfb\x93\x8e\xb8\xea\xa1\x13\x4f\x4a\xf3\xa2\xa3\xf6\xa6\x3d\x7f\x67\x06\xae\xad\x1e\x14\xb4\x2e\xac\x6d\x99\xcb\x78\x38\x1c\x46\x41\x75\xee\xf1\x04\xee\x11\x70\x08\x81\x71\x92\x9e\x4a\xb8\xa3\xc2\xf9\x84\x47\x3b\x7b\x2f\x63\x92\xbd\xd5\xd1\x69\xc5\xa2\x10\x5b\x95\x4c\xe6\xf8\x6f\x77\xb7\xd0\xe3\x4d\xfe\x3a\xdb\xdd\x2d\xae\xb3\xea\x32\x97\x13\x55\xee\x92\xf9\xee\x6e\xb1\xcb\x36\x08\x03\x99\xcc\x16\x6f\xcb\x6a\x23\xaa\x64\x16\xb1\xe2\xd1\xea\xdd\xf5\x9b\x0b\xeb\x5f\x9a\x68\x9f\xd6\xc5\xdb\xf2\x6e\x52\xe7\xbf\xa1\x1e\x53\xcb\xe4\x6d\x79\xb7\x80\xd0\x7c\x5b\x94\xb7\x49\xad\x83\xe4\xd9\x96\x93\x6c\xaf\x4a\xd7\x58\xd8\x83\xb0\x9f\x7f\x5e\xe8\xfe\xfd\x19\x78\xa9\xc3\xa1\xed\xbb\xae\x95\x85\x15\x0b\xf1\x17\xc7\xbb\xaf\xa0\x0b\xc9\xa3\xf9\x9f\xad\x31\x4e\xb9\x63\x35\x9f\x3f\x03\x21\xd4\xf1\xf5\x31\x0b\x5f\x8a\xad\xa2\x7e\xb8\x15\x82\x04\xf0\xe8\xaf\xb3\x3f\x47\xac\xe4\x1f\xfe\xd5\x66\xd5\xc9\x38\x3f\xf9\x14\xdd\xcb\xb6\x9c\x9b\x1d\x1e\xb9\xd9\x8f\x58\xee\x9a\x2a\x20\xe9\xad\x85\xd2\x70\x75\xfa\x21\x65\x55\xf7\x1c\xbd\xa7\x36\x5a\x5f\x20\xfc\x52\x01\xfd\x37\x77\x07\x97\x7b\xb9\x21\x3a\xca\xd2\xa7\x45\x99\xe1\x3b\x3d\xf4\x84\x81\x6c\xcf\x87\x1d\x4d\x59\xf1\xc8\x87\x85\x1d\x00\x3c\x8f\xed\x50\x10\x58\x04\x8e\x6d\x72\xf3\xa2\xc8\x77\x3c\x5a\x1b\xf1\x05\x96\x13\x60\xd1\x75\xd4\x1c\x2e\x02\x47\x4c\x90\xb6\x4a\x9f\xcd\xf5\x52\x74\xeb\xe1\x9c\x0f\x37\xd7\x46\xb1\xb9\x67\x0f\x6f\xcb\xbb\x73\x0d\x51\xdf\x89\x22\x7f\x24\xc0\x3c\xbc\xc4\xaa\x03\xeb\xe2\x91\x47\xf2\x95\x36\x9f\xbb\xc1\xf4\x91\x6c\xf2\xc0\x2a\xdb\xe0\x57\x1e\x44\x1e\xc9\x8b\xd8\xb3\x1a\xb2\x4d\x5f\x1f\xeb\x61\xde\x56\xf9\xba\x7a\x99\x5f\x0b\x59\xe7\xa5\xec\xf4\xd3\x89\x36\x25\xab\x1c\xfa\x06\x48\x70\x9e\x69\x09\xdf\xd1\xf2\x59\xd1\x38\x53\xc7\x2b\x0b\x51\x38\x93\x8f\xac\x38\x13\x8f\xee\xec\x61\xc4\x11\x31\x65\x4b\x98\x3b\x56\x79\xa4\x13\x65\x2f\xf1\x6f\xbb\xbb\xa3\x9d\xda\x3b\x5a\xa9\xee\xab\xc4\x86\x1a\xd8\xb4\x0a\x12\xe2\x0f\xcf\x34\xa8\x7f\x21\x15\xa9\x6c\x1b\x47\xfb\x06\xd7\x83\x67\x70\x0a\x39\x10\x83\x03\x7e\x16\x3c\x8d\x7e\x10\x6f\xdf\xe5\x2a\x62\xd1\x57\xe5\x6f\x11\xa2\x32\x46\x2b\xf6\xdb\xc0\x04\xea\xd9\x30\x83\x60\xdf\x77\xc3\x22\xfc\x28\x5a\x4e\x40\x3b\xfc\x83\xb4\xd5\xa9\x58\x35\xcd\xf7\x22\x15\x9e\xbe\x42\x0b\x23\x40\x8d\x7f\x13\x4b\x91\xe8\x4f\x43\xbc\x04\xe4\xbb\x9d\x60\x92\x63\x27\xfc\x9f\xe3\x82\xee\x9f\xc5\x71\xb0\x3c\x5c\x65\x2c\xf8\xcf\xf0\x6f\x1d\x2b\x04\xd9\xfb\xcd\x73\xec\xe2\xa0\xa9\xa9\xb0\xd1\x25\xa1\x6c\x21\x88\x17\xd0\x68\x88\x20\xcb\xd1\x64\x9d\x8a\x6c\x45\xa7\x63\x7a\xca\x3e\xc3\xe7\xc9\xe4\x94\xfd\x24\xf8\x83\x5f\xe8\x00\x49\xdd\xe4\x75\xfe\x36\x2f\x72\x75\x9f\x44\x57\xf9\x66\x23\x64\xc4\x1c\x62\xb7\xd1\x0a\x0e\xec\x5b\xc1\x1f\x0a\xa1\x94\xa8\xce\x77\xd9\x1a\x88\x1a\x97\xa1\x6f\x4b\xa9\x7e\xd0\x2b\x93\x44\x7f\x99\xcd\xa2\x60\x02\xff\xde\x65\x26\xb8\x0b\xb6\xd1\x46\x06\xae\x96\x1a\xab\x5d\x67\x77\x64\xc6\x70\x2f\xdc\x84\xc8\xa6\x99\x51\x3a\x26\x95\x0e\xf3\x81\x70\x1d\x34\x51\x2d\x46\xfc\x1f\x5b\xa7\x53\x09\x60\xf4\x19\x8f\x34\x22\x86\xd2\x05\x6a\xec\x19\x33\x51\x50\x67\x38\xcb\x48\x9c\x2b\xaa\x65\x64\xe8\x4c\x94\x38\x0c\x14\x79\xd9\xfe\x4c\xf3\x53\x8b\xec\xec\x2f\x8b\x6c\xcc\x9f\xd1\xc8\x90\x04\x17\xb0\x62\x3f\xb6\xe1\x1e\x04\x93\x63\x29\xd2\x4c\xc7\x46\xcd\x29\x65\xd5\x92\xf8\xda\x5c\xe6\x89\xcf\x1c\x59\x72\x16\x75\x0b\xb9\xda\x47\xc7\x05\x6c\x17\x4d\x7e\x84\xb1\xc6\x98\x6c\x39\x1d\x02\xfe\xfd\x75\xb7\xa9\xa8\x7b\xb9\x1f\xff\xa1\x9a\x93\xfa\x8f\xe5\x73\x6b\x86\xfb\xcb\x67\x67\x88\x44\x87\x0e\x05\xcb\xa7\x1f\xd7\x22\x47\x70\xd6\xc8\x90\xba\x68\xac\x8e\xa1\xde\x99\x80\xce\xe9\x6a\x52\x4e\xf6\x93\x7a\x82\xd8\x28\x58\x75\xb6\x6f\xd7\xf9\x5f\x3d\xd8\xd1\xcc\x26\xcb\x39\x19\xdd\x4f\x8f\x28\x02\xe2\x55\x4b\x1a\xc7\xb6\xff\x8e\xb8\x04\xc3\xb2\x05\x22\x36\x9a\xc3\xa5\xae\xe4\x39\xcb\xb8\x65\x76\x2b\xb8\x22\xff\xb1\x1e\x03\xa0\x5a\xd6\xd4\x5e\xb6\xec\xe0\x28\x5b\x64\x3c\x02\x4f\x13\x59\x79\xfe\x23\x7d\x8d\xe3\xbc\x69\x46\xf7\xd3\x21\x5a\x00\xde\xd7\xe8\x3c\x2b\xd8\xe3\x9b\xea\x60\x06\xdc\x34\xa3\x80\xea\x83\x2d\x8e\x72\x59\xe4\x8f\xc4\xe4\x31\xc3\xd4\xb7\x0c\x00\xb1\x6a\x5b\x97\xef\xc4\x5a\xd5\xfe\x06\x38\x1d\x1b\xed\x0f\xcf\x17\xe2\xd8\x00\xc3\xe1\xec\x4f\x32\x0e\x6f\x6c\xe3\x6f\xd6\xe9\x13\xd6\x70\xec\x36\x68\xd3\x90\x7c\x68\xd7\xb1\x92\x55\x2c\xa3\x63\xec\xec\x76\xb9\x5d\x78\x48\x6c\x6b\x4f\x33\x71\x64\x11\x81\xad\x9a\xbe\x02\x34\xc8\x17\x68\x1b\xd6\x75\xad\xc3\x80\x25\x0f\x25\x70\x93\xba\x4f\xba\xbc\xb8\xb3\x59\x74\xaa\x53\xb3\xf6\x91\xcd\xec\x05\x3d\x3a\xc4\x8c\x5c\x46\xf3\x28\x91\xda\x9a\xd2\x07\x0f\x4a\x1e\x32\x99\x5f\x6b\x73\xae\x2f\x94\xa8\xf4\x83\x76\x45\x81\x41\xcf\xba\x2c\xf6\xd7\xed\xeb\x36\x2f\x8a\xaf\x6d\x37\xf0\x5a\x88\xbb\xcf\xaa\x52\x9b\x31\x6d\x0b\x71\x77\x7e\x55\xe5\xf2\x9d\x7e\x6b\x71\x27\x8c\x95\xab\x7c\xf3\xbc\x12\x99\x7b\x7e\xa1\x6b\xed\xbe\xbd\x92\x9b\x6e\xc2\x39\x42\xfc\xb8\xa4\xef\xca\xdb\xe0\x31\xc8\xfb\x5d\x79\xeb\x33\x02\x68\x3e\xf7\x8d\xba\xe9\xc2\x23\x96\x0a\xe5\xcb\x6a\x77\x95\x49\x6d\xc2\x74\x9b\x6f\xca\x5b\xfd\xf4\xdb\x17\x08\xf4\xa8\x9f\xca\xf2\x1a\x81\xad\x98\x23\x89\xc9\x03\xec\xdb\xee\x8b\x21\xcb\x0e\x44\x1a\x8c\xe3\x0f\x7b\xfa\x98\xff\xdd\x7b\xb7\xdc\x48\x10\x34\x8a\xd5\x3a\xb2\x2c\xdb\xf3\xcf\xfc\xf1\x4f\x1b\xf2\xeb\x8c\xd8\x87\x7b\x7d\xde\xfe\x51\x10\x88\x4b\x70\xa8\x74\x30\xa0\xe3\x3c\x05\xaf\x75\x10\x7e\xc2\xbb\xc0\x66\x71\x1c\x5d\x0a\x15\xe5\xf2\x24\x0b\x4c\xa3\xe0\x13\x02\x5e\x84\x08\xbb\x85\x96\x79\x52\xc0\x45\xa5\x15\x89\x71\x52\x3a\xb1\x18\x70\x0e\xc9\x3d\x5d\x93\xf0\x91\xc8\xf5\x55\xb0\x44\x72\x1b\x0b\x2b\x07\xb6\x89\xa4\x06\x22\x30\x64\x5a\x4c\x08\x17\x2a\x83\xff\xdd\x17\x38\x7f\x34\x0d\xc6\x24\xc7\x88\x5c\x98\x1f\x47\xb9\xaa\xdb\x28\x57\xf4\x98\xb5\x6e\x1a\x7d\x0c\x07\xf9\x84\x94\xbb\x8d\xcb\xd9\xb2\xd6\xc0\x28\x04\xa3\xe1\x51\x2e\xaf\x44\x95\x23\xfe\x0b\x26\xa2\xee\x4d\x04\x06\x29\x79\x66\xbd\xab\xb1\x8e\x28\xb9\x5f\x16\xd3\xba\x3d\xe0\x13\x60\x67\x3d\x39\x5c\x42\x63\x0f\x70\x18\x00\x80\xfe\x8a\xda\x9d\x76\xd2\x2e\x6b\xb8\x8e\xe4\xfd\x0b\x49\x3b\xab\x16\x2e\xd6\x8c\x49\x7f\x52\xf7\xb1\x1f\x3d\x82\xd7\x21\x98\xaa\xeb\xac\xb0\x71\x21\x71\xb7\xee\xc9\xb7\x3a\x16\x1b\xff\x56\x40\x3e\x61\xa2\x4b\xc9\xa6\x91\x4b\x52\x86\x68\x2d\x87\x4d\x19\x20\xa7\x69\xf2\xfa\x53\x60\x20\x04\x61\x58\x96\x4d\x33\x4b\x10\xe7\x36\x10\xc5\xa5\x91\x61\x51\x23\x66\xf9\x91\x55\x28\x32\xdb\xd3\x87\x60\x2c\xfb\x15\x3f\xc2\x50\xf6\xfa\x7e\xe7\x4d\x30\xfa\xa7\x9d\xa2\x23\xf4\x0e\x72\xf9\x1e\xcc\xae\x3f\x7d\x82\x15\xcf\xe5\x65\x9b\x85\x50\x23\x01\x59\x6a\xda\xba\xc7\xea\xfd\x80\xa7\x9f\x3a\x81\x2d\xed\xf2\xf8\x3c\x07\xda\xf7\x5f\x08\xc8\x32\xcb\x2d\x61\x2e\xf9\xe8\x7e\xda\x39\x03\x81\x92\xb5\x1c\x26\xa6\xdd\x1f\x8f\x11\x98\xab\xfc\x4f\x28\x76\x0e\x0a\x2d\x97\xff\x63\xfb\xc4\x32\xf0\x2d\x3e\x76\x70\xa6\xa3\x6e\x92\x7a\xc2\x07\x59\x90\xfd\x31\x41\xdf\x07\x2c\x48\xb8\xd4\xe9\x7e\x45\x27\xb6\x15\x47\xba\x4c\xfb\x9a\x47\x61\xb5\x16\x40\xb5\x6c\x2c\x86\x68\xe2\xc9\x85\xec\x2a\x4e\x6a\x56\x12\xb7\x5f\x69\xeb\x2c\x37\xac\x3d\xa5\xec\xef\x82\xcc\x10\x65\xdf\xa9\x07\x1d\x44\x04\x82\x08\xfe\x44\x90\xfb\xe9\xf1\xe9\x93\x0d\x90\x33\xcb\x69\x04\xa3\xd0\x40\x6f\xb9\x4c\x94\x6a\xa1\x65\x10\x24\x0a\xb1\x55\x13\x0d\x07\x0f\x6d\x99\x64\x76\x18\x80\x89\xf7\x57\x72\xa0\x96\xa8\xb7\xa2\x69\x2b\xe7\x89\x22\x66\x39\xd3\x24\x8a\x98\x15\xf8\x58\xe6\x32\x68\x07\x96\x31\xe1\x16\xc9\xc7\xe5\x8a\x3f\x18\x25\x6b\x32\x28\x65\xe6\x33\x73\x59\xe2\xc0\x5d\x8a\x62\x29\x9c\xd3\xed\x09\x42\x10\x89\xd5\x42\x9d\xfd\x45\x2b\x83\x64\x9a\x83\xc1\x55\x2b\xd4\x8f\xe0\x81\x4d\x53\xa5\x6a\x02\x57\xdd\x2a\xbc\x09\xff\x70\x08\x99\xf5\xdc\x05\x13\x6c\x3b\x07\x6c\xc8\xff\x2e\x68\x5f\xcd\xdb\x47\x84\x8f\xab\x70\x3a\x5b\x89\x95\x18\x4b\x66\x8e\x2d\xdd\x60\xbb\xca\xda\xf4\xb4\x7c\xb0\x73\xb5\x5a\x64\x67\xf9\x22\x1b\x8f\x69\x99\xaa\x34\x5b\xad\x3c\xac\xe1\x0d\xb0\x5b\x79\x8c\x5b\x1e\xfa\xf1\x8e\xe5\x32\x8c\x3e\xa8\x2f\x91\xb4\x85\xa1\x68\x63\x6a\x20\x66\x31\xa4\xeb\x8c\x90\xf3\xe9\xeb\x5b\x21\x24\x17\x8a\x3e\xe6\x4b\x20\x14\x03\xb6\xec\xcc\x44\x7b\x6e\xd3\x22\x4e\x78\x87\x39\xaf\x8f\x5d\x55\xee\xb8\x74\x16\x8b\x75\x2e\x2f\x79\x0e\xec\x6f\x9e\xdb\xb8\x3f\x3a\x83\x89\xb4\x54\x73\xfb\x6a\xe2\x1c\x5a\x3d\xea\xad\xbd\x2a\x00\x91\x25\x6d\x75\x72\xc3\x2b\xf3\xa8\x43\x23\x96\x3d\xc2\x2a\x5b\xc2\x7a\x60\xeb\x7d\x47\xb1\x89\xb5\x11\xdc\x70\xa1\x3b\x4b\x95\x5c\x77\x3d\xa8\x08\x8b\x73\x4d\x28\x2d\xab\x53\x0f\xcb\xf8\xee\xb7\xdf\x0f\xac\xda\xcb\xe4\x48\x4e\xc0\xe4\xef\x35\x16\x4e\xc0\x74\xb3\x37\xac\xa8\xd6\xa0\x00\xc1\x72\x60\x1b\x33\x67\x36\xc2\xbd\x7e\x5e\x11\xd1\x99\x39\x5f\xf0\xa9\x60\x33\x36\x1f\xfe\x66\xed\x02\x4c\xad\xb6\xbc\x2c\x6f\x39\x71\xb3\x3a\x69\x67\x9f\x3e\x55\xe3\xf6\xad\x5b\x5f\xad\xc4\x2e\x8e\x8f\x92\x5a\x43\x39\xe3\x62\xea\xea\x77\x77\x98\xc3\xcd\xbc\x16\x6a\x29\xa7\xf5\xef\x4c\xaa\xff\xae\x8b\x1e\x0e\x70\x7c\xcc\x83\x83\x03\x17\xa1\xd7\x20\x09\x2b\xe1\x0f\xae\x96\xfe\x89\xc1\x2e\x88\x9b\x76\x98\x13\x08\xdd\x51\xcf\xb7\x9a\x98\x95\x23\x9b\x9c\xc2\xe5\xa4\xdc\xad\x5c\x04\x1f\x9b\x59\xef\x2f\xf7\x6d\xd9\xcd\x8a\xfb\x13\xec\x96\xd3\xe9\xcc\xa4\x33\x84\xbb\x04\xf5\xc4\xe1\x0f\xcc\xdc\x52\x25\xb3\x3e\x15\x06\x37\xb1\xbd\xd3\x13\xe9\x6b\x3f\x4a\xc1\xad\xf7\x83\x1d\x1f\x05\xa8\xec\x7d\xfd\x86\x0c\x4c\x7f\xa6\xfd\xbe\x6b\xfe\xfd\x36\xf1\x08\xa4\x33\x00\xfd\x6d\x2c\x74\x24\x52\x4d\xe8\x2c\x6f\xf0\xba\xdc\x75\xc0\xdb\x26\x83\xe4\xf0\x87\xfe\xf8\x7a\x9d\xc6\x1e\xd3\x09\xad\x46\x35\x8e\xc9\x50\xa7\x9c\x03\x0b\x00\x9f\x3f\xe0\xbc\x93\x55\xc9\x80\x35\x8c\x38\xb0\xfa\x16\x54\xe9\xf8\xdb\xf4\xa3\x89\x61\x26\xca\x9a\x88\xa7\xfa\xf1\x9b\x2f\xe8\xe9\xb3\xc0\x8b\x30\xd2\x65\x23\x34\xb5\xbd\xeb\x40\x98\x86\x3e\xe6\x17\x03\x42\x45\x0d\x4a\x8a\x49\xc5\x2a\xc5\x72\xc5\x4a\x05\x81\xdd\x32\x31\x41\x33\x9b\xfa\xaa\xbc\x6d\x10\xc4\x92\x3e\x39\x65\x99\xe2\xa7\x6d\x88\xe5\x27\xa7\xad\x40\xad\x56\x84\x3e\xe0\x12\x6a\xa2\xed\xbc\x5f\x4d\x8d\xac\x2e\x8e\x71\xdf\xd3\xaf\x7b\x51\xab\xe7\xee\x90\xfa\x69\x95\x5d\x8b\xe5\x23\xe9\xa4\x56\x34\xe9\xdc\x54\x54\xc3\x18\x73\x7b\x37\xd5\x8e\x0c\x37\x59\x01\x1a\xbe\xbd\x9b\xaa\x7c\xfd\x0e\x21\x77\x7c\x0f\xf6\xaa\x65\x07\x3a\x15\xb8\x1c\x08\xc4\xe5\xcc\xd1\x71\xf1\xac\xe2\xad\x47\x5a\x5b\x4d\xa1\x3a\x66\xcc\xda\xe2\xee\xc1\x30\xcf\x89\x30\xaa\x50\x65\x44\x79\x8b\xea\xec\x2f\x8b\x6a\xcc\x9f\x4d\x14\xcd\x53\x47\x8f\xc7\x44\x72\x29\x60\xa8\xb6\xe2\x79\x1a\x48\xc3\x56\xdc\x3b\x8a\x62\x9a\xf2\xa9\x3d\xdf\xf2\xdc\x6a\xfa\xa0\x8e\x6e\xfb\xb1\xb6\xe2\x84\x96\xa7\x80\x21\x1a\xd9\xaa\xa9\x02\x79\x13\x95\x39\x83\xa4\x2b\x3a\x5d\x97\x72\x9d\xa9\xce\xa7\xe8\x69\x84\x4b\x0a\xf9\x8c\x65\x3c\x77\xf4\xb8\x3c\xcb\x9c\x8d\x9a\x31\xc8\xb4\xb6\xb1\x4c\x31\xe1\x45\x8e\x55\xdb\x85\xad\x22\x25\x0b\x26\x23\xb3\xd3\xb1\x05\x40\x59\x83\x09\x47\x7c\x59\xdd\x89\x06\x3e\x35\x57\x9b\x86\x86\x25\x2e\x38\x85\xde\x3a\x07\x84\x7e\x08\x3e\xe6\x5b\x92\xd9\x1e\x8c\xe6\x0b\x37\x62\xc1\x95\x6a\x1a\x2c\x2c\x53\xa1\x3c\x0f\xaa\x99\xac\xd2\x0b\x3c\x2e\x3c\x4d\x98\xe0\x9a\x26\x3e\x9f\x10\x75\xda\x26\x42\xf0\x63\xbb\x5d\x98\x99\xab\xfb\xd6\x85\x2e\x3d\xad\x56\xd3\x6a\x2f\x89\xf4\x6c\x49\x1d\xdc\x9d\x48\x4a\x96\x16\x4c\x32\x1c\xc8\xe4\xd9\x3c\x8e\xf3\xa5\x4a\x48\xde\x34\xc7\x99\xe6\x6c\xb6\xa2\xac\xee\x07\x3a\x2f\x56\x70\x16\x82\xe9\x2d\xd7\x44\x5a\x87\x1b\x37\xce\xeb\x25\x82\xad\xef\xea\xce\x4d\xd9\x82\xb2\x72\xa7\x82\xb4\xd1\x8c\x3d\x58\x03\xc4\x57\x1a\x81\x40\xb8\x61\x50\x49\xe2\x70\x8a\x27\x3c\x07\x38\x64\x3a\x57\x3d\x7b\x2e\xce\x45\x9d\x08\x9f\xf8\xb5\xa1\x77\x89\x62\x7e\x32\x13\x3f\xdd\x6e\xfa\x12\xe5\x67\x92\x99\x59\x4a\x10\x72\x59\xab\x6d\x34\x9b\x15\x20\x28\x0f\x28\xdc\xb2\x60\xa4\x64\x05\xc8\xaa\xb6\x36\xb7\x8f\xce\x84\xd2\x8c\x40\x6b\x2c\xec\x07\x33\x12\x3f\xf7\x7e\xb5\xec\x4d\x0d\x4c\x42\x92\x53\xee\x8e\xc9\xa0\x66\xb5\xc5\xd2\x17\x30\xcb\x9b\xcc\x16\x01\x50\x69\xaf\x15\x0d\x57\x19\xee\x10\x57\x67\x52\xf3\xdb\xae\x0c\xee\x0c\xc1\xd2\xcf\x7d\xf3\x62\x49\xfe\xf8\xc2\x32\xb1\xa2\x34\xa9\xed\xe5\x82\x9d\x64\x47\xf6\xd9\x9a\x17\x58\xf4\x9d\xe9\xc5\x68\x60\xda\x9c\x9a\x54\x67\x90\x10\x03\x08\x6c\xd5\x1c\x37\x43\xf1\x1f\x89\xa4\x2b\x86\xa0\x98\x72\xc5\xba\x1c\x78\x69\x44\x3d\xb8\xd9\xd3\x66\xe0\xb8\xdc\x92\x32\x69\xaf\x44\x03\x36\xe2\xa5\x8b\x0e\x83\x1a\xfa\xd2\x8c\x0a\x71\x41\x22\x73\x9e\xd1\x72\x27\xea\xfb\x80\xd0\xd3\xe6\x03\x02\x3c\xfa\x3a\xaa\x15\x2b\xa9\xe9\x24\x74\x4c\xa6\x51\xb9\x62\x88\x06\xc1\x73\x6b\xa4\x8b\x2b\xad\x78\x7e\x20\xeb\xc1\xc5\xa7\x7e\x07\x6a\x5d\x48\x07\xab\x60\x2f\x6a\xe4\x54\xb0\x92\xb9\xe2\x1e\x47\x5d\x13\x28\xf7\xca\x1d\x06\xde\xbd\x6a\xa1\xd0\xd8\xc5\x35\xa7\x49\x15\x74\xc0\xe0\xdf\xf5\x8f\x0e\xb0\xa5\xef\xfd\xf0\x01\x69\x8d\xa5\xff\x9a\xad\x15\x62\xb0\x5c\x13\xd7\x55\x6c\x0a\x1a\xc7\xe1\xab\xc1\x97\x25\xf2\x15\xed\x8d\xc4\x36\x87\x7b\xb7\x17\x13\xdb\x54\x3c\xbb\xee\xb8\x0b\xfd\xec\x55\xc5\x36\x15\xcf\x1e\x5f\xda\x34\xf3\xe6\x29\xdd\x75\x18\xac\x6e\xcf\x1c\xce\x80\xd8\x37\x29\x98\x1e\x65\x62\x4b\xea\x17\x5c\x55\x57\x1c\xce\xa7\x9e\xb4\xb6\xfe\x2c\x5b\xc5\x1e\x1c\x95\x48\x1e\xa2\xa7\x51\x92\x0e\x80\xa2\x3d\xa7\xb4\xdb\x5c\x7f\x73\x33\x56\x0b\x22\x2d\x4f\xc5\x5a\x89\x03\xee\xfb\x92\x87\xd5\x81\xd9\xea\x7b\x98\x41\xc7\xba\xb0\x57\x3a\x18\xf2\x94\xc0\xd1\xc8\xc5\xb2\xf2\x38\xdf\xd1\x5c\xd1\x47\xd3\xb0\xb2\xae\x56\x2c\xa4\x72\x72\xc5\xbb\xaf\xa0\x87\xbd\x1c\xfe\x1a\x15\x5c\x3f\xd6\x02\x58\x92\x3e\x7a\xd4\x6d\x6d\xfb\xd9\xd6\xa9\xef\x60\xa8\xdd\x34\x4e\x88\x86\x37\xb6\xd3\x93\xc4\x36\x38\x19\x5f\x39\xe9\x2f\xbb\xec\x08\x8f\x33\x7d\x24\xbe\x71\xce\x4d\xb8\x01\x04\xfc\x14\xcc\x9a\x9c\xfd\xb7\x34\x4b\x06\xe9\xaa\xe6\x71\xf5\xc6\x0c\x41\x5a\x97\x82\x39\xfb\x5e\xea\x54\x44\x95\xcb\xfc\x0b\x9f\xb1\x1a\x3b\xd4\x5f\xeb\xc2\xc2\x97\x90\xc4\xb6\x65\x9a\xa6\x26\x90\x0c\xb4\x29\xe3\x31\xdb\x0d\x90\xec\xa1\xb4\xb6\xd0\x64\xc2\xdc\xcd\x27\xb6\x8f\x76\xc9\x9a\x26\xec\x03\x9a\x02\x40\x2a\x8f\xcc\x56\xac\xf4\x31\xe5\xb4\xac\xc8\x62\x15\xa5\xb1\x0a\x84\xcd\x91\x61\x3e\x21\xf7\xcc\x19\x2e\xf5\x22\x97\x4b\x68\x7f\x45\x94\x44\x66\x02\x75\x39\xf3\x8c\xbb\xc0\x9a\x66\x74\x13\x84\x59\xb8\x01\xff\x05\xfd\x67\x2e\xf7\x62\x71\x89\xf8\x02\x1b\xe0\xa2\x9b\x38\xc6\xa7\xa6\xf1\x67\x03\x56\xd1\x03\x74\xd9\x7b\x3e\x70\xa7\x1a\x6d\x9a\xa3\xd4\x0d\x6d\x6d\xf7\xb7\xfd\x9b\xd0\x60\x6e\x3f\x75\x26\x45\x3c\xbd\xf2\xcf\xac\x7d\xfc\x31\x78\xfe\x69\xc5\xec\xaa\x17\xba\x6f\x53\x2b\x46\x05\x62\x2b\x5a\xa8\x69\x85\xab\x6d\xbc\x7e\xb2\xe6\x03\xc2\x57\x94\x5b\xae\x79\x91\x90\x42\x90\x54\x40\x1f\x1c\xe8\x26\x5c\xf5\x4d\x53\xb0\xa1\xe2\xcc\x14\x82\x4b\x18\x09\x74\x78\xeb\xa6\xb1\x6f\x13\xa3\x70\x47\x9a\x39\x4b\x8e\x78\x31\x78\x03\xc7\x16\xf2\x3e\x2d\x6f\x84\xde\x60\x67\x50\x62\x00\x45\x57\xae\x2b\xbc\x38\x50\x3b\x07\x85\xbe\x4e\xc2\x7f\x61\x05\xf7\xf5\xae\x21\x05\x59\x53\xca\xda\x72\xdd\x1e\x01\xa1\xfb\x59\x8d\x63\xd2\x4e\x31\xf7\x56\x03\x43\xd0\x1c\xe4\x6b\xcb\x6b\xe7\x3c\xff\xf6\x63\xf8\x65\x1e\x7e\xf9\x29\xfc\xf2\x6c\x05\x20\xdf\xf3\xd1\x9c\x6d\x28\x06\x7d\xb3\x74\x2d\xe7\xf2\x04\x01\xfd\x2e\x11\xf4\x52\xf7\x85\x26\x37\xe1\xfd\x54\x0e\x2b\xb0\x07\x3b\xb8\x04\x93\x52\xc6\x31\x71\x05\xf8\xe8\x92\xb2\xcb\x38\x0e\x16\xf5\x78\x4e\x3d\x58\x5e\x36\x8d\x5d\x48\xe6\x7d\x27\xdb\x56\x10\xdf\x2f\x00\x7f\x86\x8d\xa1\x2f\x37\xdd\xf3\xb5\x22\x97\x4b\x6c\x90\x64\xc6\x2a\xb6\xa3\x4c\x57\x77\x83\xc1\x60\xf7\xec\xad\x04\xe5\x12\xcb\xaa\xef\xed\x70\x29\xf6\x97\xcf\x28\x3d\xac\x5a\x2c\xdb\x23\x01\x6a\xd9\x3d\x39\x38\xcc\x2c\x68\xd2\xfd\xa0\x59\x3e\x61\x4d\x89\xeb\x9d\x10\x1b\x3e\x88\xad\x11\x62\xe4\xd8\x8f\xb9\xbd\x79\x1c\xfc\x31\x4d\x1e\x1c\xdd\x4d\x64\xd3\x8c\x64\x1c\x2b\x5c\x21\x0d\x7d\xb1\x68\xf9\x5c\xe1\x38\x69\xf3\x5d\xc5\xf1\x08\x17\xa3\xc7\xb1\x0a\xae\x2c\xdf\xde\xc1\x50\x6e\x59\x79\x9e\x98\xcf\x92\x56\x4b\x66\xdb\x6f\xbf\x42\x24\xef\x5f\x30\x93\x9a\x9c\xeb\xf1\xd4\x61\x25\x41\x72\xda\x26\xaf\x92\xe1\x2c\x9e\xc5\x77\x1a\xbb\xca\x20\xe1\x38\xd6\x51\xbc\xec\x9b\xbe\xa9\x44\x3f\x71\x8d\x9a\x59\x35\x2d\x8b\x0d\xaf\x3c\x13\xc2\xda\xc7\x90\x4a\x5c\x13\x9d\x91\xc6\xb1\xfe\x6d\x05\x61\x94\xf9\x76\x7a\x77\x4c\xd9\x74\x18\xa8\x1f\xba\xd2\xe6\x6d\xb6\x11\xaf\xcb\xc7\x9d\xea\x51\xdc\x39\x0d\x66\xf0\x8b\xae\x6b\xe2\x15\xdf\x6c\xe6\xee\xd2\x00\xa8\xe1\xba\x0a\xcd\xcd\x08\xe2\xf5\xe8\x0a\x92\x60\xd4\x77\x60\xf6\xdb\x51\xf0\x5c\x43\xd6\x73\xde\xc7\xe2\x38\x1c\x71\x0b\x5b\x56\x8f\x18\x5e\x87\xee\x84\xab\x5b\x1b\x87\x30\x84\x28\x14\xa5\x0b\x9c\xfc\x6c\x88\x25\x70\x01\xd1\x36\x97\x79\x7d\xa5\x31\x30\x90\x6d\xb9\x83\x2f\xa0\x07\x9d\x0c\x41\x76\xf3\xfa\x8a\x67\x0c\x06\x16\x20\x1a\xa5\x99\xb5\xa5\x95\x84\xae\xaf\x48\x66\x85\x9a\x3a\x9d\xd8\xef\x2c\xb3\xd1\x61\xda\xa1\xe5\x4c\xb4\xe6\x45\xc7\xa7\x21\xd3\xbc\xb3\xd7\x35\x6f\x4c\x91\xd2\xf7\xc6\x29\x24\x3c\xc4\x42\x7f\x50\x6a\xc6\x0c\xc4\xd6\xf9\xeb\x3b\x27\x18\xdd\x0d\x92\xb7\x37\x83\x39\x41\x78\xd7\x15\x0b\x8d\x0b\x8e\x68\x22\xd6\xdf\x04\x9a\xe2\xce\x85\x5c\x0c\x90\x8e\x78\x40\x55\xdd\x09\x6b\x6d\x5d\x03\xa1\xee\x88\x63\xfc\xd5\x5d\x8e\xe3\x8c\xe0\xc5\x1e\x25\x80\xdc\xb4\x6a\xb4\x1a\xc8\x68\xb9\x09\x45\xdb\x42\x3a\xbf\x77\xbc\x5e\xa8\xc9\x64\x41\x25\xea\x06\xdf\x3a\x72\x71\x28\x7c\x4f\xf5\x27\xdd\x57\xc3\x4d\x10\x9d\x00\xa8\xd2\x6d\xe0\xe8\x23\x80\xda\xa5\x0b\x03\xaa\xd8\x9c\xd2\xc5\x48\xc4\x71\xd9\x34\xbd\x0d\x91\x83\xe3\x61\x66\xc5\xdb\x65\xcb\x1c\xd8\xeb\xa8\x09\x19\xb8\x38\xd8\xce\x60\x56\xdf\x37\xa5\x4c\x85\x53\xc5\x24\x57\x69\x66\xa7\x35\x82\x1b\x43\xfb\xaa\xd9\xc5\x68\xc5\xf2\x76\x9a\x4b\x5c\xbc\x6b\xe7\x20\x31\xc6\x64\xca\x81\xe2\x68\xe6\xd9\x37\xb4\xce\x32\xbd\xb6\x08\x8a\x6a\xa7\x15\xf7\x19\x95\xbb\x16\x03\x68\xb2\x23\x5a\x71\x92\xc0\x9c\xe6\xa9\x30\x73\x6a\xbd\xc5\x60\x1e\x20\xec\x54\xc2\x38\x08\x47\xd3\x54\x84\x53\x89\x5a\x72\x37\x8d\x42\x4f\x23\xfa\x25\xf8\x6c\x21\xce\xca\x85\x00\xab\x9f\x0a\xc8\x7c\x51\xce\x74\xb6\xf3\x12\xe0\x24\x6f\x95\x6e\x3f\xf9\x8b\x26\x31\x93\xa9\xe3\x22\x99\xe1\x13\x19\xe8\xb1\xe8\x6a\xbe\x9d\x15\x00\x10\xac\x4c\xab\xd5\xc2\xfe\xf2\x47\xee\x55\xb5\x72\xe8\xa6\x19\xba\x39\x28\x1f\x36\x9d\x37\xbb\xda\x61\xaf\x02\x17\x26\x61\x0e\xec\xcd\xac\x81\x4e\xb2\x2e\xf2\x8d\x78\x59\xde\xca\xa4\x50\xc4\xf2\xb9\x4c\x27\x7e\xbf\xd3\x49\xba\xff\x36\xe9\xb5\x1e\x9b\x4e\xb6\xc3\xa4\x0c\xf8\xf6\x0b\xd9\x1a\x1a\x99\x3a\x0e\x3a\xfd\xeb\xbd\x0a\x3e\xe8\x9a\xcc\x07\x5b\x51\xfb\xcd\x56\x77\x08\xf4\x9f\x82\x55\x43\x5e\x35\x9d\xa9\xe9\x8c\xb2\xea\x0c\xcf\x6c\x7a\x9e\xae\x5a\xd1\x2d\x1f\x80\xf3\x59\x80\x21\x0c\xac\x86\x22\xda\x85\x3a\x6b\xb7\x33\x2e\xf8\x12\x1c\x9b\x94\x12\xeb\xc7\x01\x5d\x42\xd3\xb4\x1b\x74\x32\x61\x73\xba\x70\x45\xb0\x49\x41\x65\x01\x81\x94\xb5\x72\xe0\xe0\x88\x1d\x0c\x0c\x83\xd5\x69\x9e\x17\x71\x32\xf3\xac\xd2\xf1\xba\x3a\x12\x69\x3e\xff\x30\xf8\x1c\x8e\x4c\xc2\x00\x57\x2a\xa0\x45\x08\xc8\x5d\x41\xec\xab\x6e\x36\x8d\x33\xdd\x57\xcd\x09\xf0\x87\x1a\x6e\x09\x7f\x9d\xcd\xd8\x36\xab\x55\xf2\x6c\x36\x6b\x05\xfc\x7f\x99\xcd\x2c\xa9\xdd\x08\x30\xc3\xbe\xae\x8a\xb5\x3a\x84\x0a\x40\x7d\x17\xc7\x41\xa5\xfa\xec\x53\xb5\x57\x29\xb2\x00\xc3\x0b\x36\x24\x08\xe8\x48\xd2\x01\x06\x0b\x75\xd4\xfd\x17\xc6\xe0\xc7\xe5\xd2\x40\x7d\x60\xd5\x80\xe1\xb7\x8d\x4e\xc8\xf2\x81\x6f\x26\x58\x4d\xd4\x35\xc1\x3e\xca\x55\x6a\xa9\x25\xcc\x8c\x2a\x13\x66\x91\x47\xee\x0e\xad\xc8\xdd\x0e\xf5\xb5\xe4\xda\xe0\xa8\x52\x53\x1d\x86\xd2\xdc\x8b\x63\x42\xdb\x8b\x0d\xcf\x95\x0d\x8c\x23\x36\x8c\xbc\xa7\x97\xd4\x94\xe6\x91\x8a\xda\xc6\xcc\xe5\x56\xec\x7e\xaa\x1f\xfe\xe9\x32\xf0\xb6\x35\x6d\xe8\xbd\x53\x6c\x03\x05\x9a\x8e\xa8\x8e\x5b\x91\x3f\xd7\x81\x4b\x16\x1d\xf6\x08\xe9\xef\xd5\xc6\x9f\xeb\x0b\x95\x1f\x55\x77\xb3\x36\x2a\x4b\x50\x8d\xaf\x64\x90\xa6\x38\x67\x72\x84\x72\x71\x6e\xbc\x07\x7a\x38\x50\x76\x3e\x15\x77\xea\xff\xe7\xed\xdb\xbb\xdb\xb6\xb5\x3d\xbf\x8a\x8c\xc9\x70\x01\x11\x2c\xc9\x69\xff\xb8\x43\x07\x87\x2b\xf5\x49\x4e\x3b\x2b\x49\x73\x6a\xb7\xcd\x59\xb2\x6e\x16\x2d\xc1\x36\x13\x8a\x54\x49\xc8\x8f\x8a\xfa\xee\xb3\x7e\x78\x11\xa4\xe8\xa4\x73\xcf\xcc\xfd\x23\xb1\x08\x82\x78\x6e\x6c\xec\xf7\x1e\x1e\x97\x27\xb8\x21\xa0\x0c\xd8\x5d\x5c\xdc\xb0\x9f\x2b\x8d\xd9\x5c\x19\x45\x2f\xf0\xc7\x8a\xe9\x82\x38\xfe\x2d\x92\xec\x84\xa3\x48\xce\x21\x45\xdb\xd8\x2e\x62\x6a\xf3\x7b\x74\x22\x20\xc0\x58\x53\x98\x85\xb0\x6a\xe7\x9e\x17\xa7\xd6\x98\x07\x91\xeb\x81\x98\x1d\x3d\x90\x6c\x5c\x4a\xe4\xd0\x57\xaa\xd0\xf1\xc9\x60\x38\x95\xe8\x97\x9d\x35\xc1\x36\xc4\x59\x6b\x79\x96\x85\x26\x78\x95\xc8\xbc\xe5\x99\x62\x2c\xa9\x62\xda\xcb\x5f\xa6\x78\x31\x46\xf0\x8c\x82\xc5\x59\x6b\x11\x96\x39\x6e\xd9\x34\xe1\xf2\x17\xa3\x01\xcb\xff\xeb\xc3\x9a\x15\x2b\x3d\x51\xfb\xd2\xe6\x90\xaf\xe0\xd5\xec\xa6\x1f\xef\xb0\x92\xf1\xae\x1e\x32\x21\x3d\x0a\xc1\x32\x8a\x2c\xb0\xe2\x66\xb6\xa6\xbb\x0e\xb2\xed\xe1\x96\x16\x62\x2d\xc0\xf4\x53\xb1\xa1\x27\xe2\xd2\x2c\xdb\xba\xa2\x30\x91\xb6\x9e\x00\x3e\x8f\x38\xac\x14\x4f\xfb\x20\x7a\x19\x1f\x04\x40\x07\xc9\xe3\xad\x4f\x81\xc8\xe6\x15\xe2\x53\x3b\x0f\x8a\x76\x1c\x1a\x9f\xf0\xcd\x81\x1a\x36\xbc\x7d\xb4\xd2\x51\x25\xbd\x9d\x2c\x58\xdc\x9b\x53\x61\xa4\x94\x3e\x8a\xe0\x21\xe4\x98\x64\xae\x76\xcc\xd3\xcb\xfb\xf1\xf4\x86\x0d\x60\xc6\x54\xac\x94\x35\x02\xf4\xdb\x76\xaa\x8b\xc4\xd3\x27\xa7\x07\xba\x8e\x3b\x28\x34\x8c\xaf\x14\xa2\xda\xeb\xff\x4d\x82\x1c\x0d\x30\xa9\x6d\x25\x29\x75\x92\x1d\xfb\x3e\x83\x4f\x91\xf5\x25\xbd\xb5\xfa\x5a\xbd\xb7\x8d\xc1\x6c\x8d\x8b\xcd\xd1\xd8\x50\xd1\x48\x2c\x78\x63\x2b\xa6\x0d\xde\x20\xd7\x60\xab\xbe\xbd\x0b\xdc\xb9\xa8\x9b\xbd\xce\x30\xb0\x60\xd6\x23\x73\x44\x02\x2d\xe9\x63\x50\xbf\x77\xa8\xa3\xa8\xfb\x8c\x70\xd3\x69\x5d\xc3\xc2\x93\x04\x66\xd5\xeb\xb0\x85\xae\x42\x43\xb2\x44\xc6\x87\x66\x53\x51\xd4\x1d\x58\x37\x51\xe4\xa6\x13\xab\x79\x10\x99\xa2\xca\xb7\x90\xe9\x87\x6e\x33\x5f\x47\xa6\x8e\xfa\x84\x63\xb8\x41\x65\x6f\xb2\x07\xad\xd3\x92\x8b\x3e\x52\x3d\x18\xdf\x7f\x0d\xa9\x8e\x9e\xc0\x91\x4a\xb4\x03\x00\x58\x2a\xcd\x06\x78\x6b\x06\x10\x4a\x21\x02\xfc\xeb\x08\x0e\xd6\x68\xa2\xf8\x8b\xc8\x0c\x95\xf7\xdc\xf7\x1a\xef\x54\x7a\x65\x8c\xb3\x87\x2d\x58\x7a\x48\x8f\xa8\xf4\x4a\x1b\x07\x7b\xab\xf7\x91\x4a\xbc\x9b\x95\xe2\x27\x33\x16\xdf\x5a\x66\x4f\xfa\x50\x36\xac\x69\x6e\x0e\x0b\x01\x2d\xb7\x95\xbc\x4e\x66\xf1\xf1\x09\xf0\x95\x5d\x9d\x78\x47\xae\xcb\x8a\xc4\x04\x7e\xff\x6f\xca\x0a\xd9\x28\x35\x7c\xc6\xe6\x2f\x5a\x24\xd8\xba\x0e\xd1\xa0\x2f\x19\x3f\x2f\x4f\x3f\x88\x27\xa6\x25\x43\xdf\x7a\x37\x13\xa0\xc2\xd0\x40\x24\x7c\xf2\x2d\xea\xd5\xd2\x82\x9d\x43\xab\x9a\x81\xb6\x61\x49\xd0\x92\x33\xe6\xe3\xbf\xda\x4b\x8f\x57\xb2\x3b\x85\x04\x9e\x32\x5d\xfd\x5c\xe4\x8f\x70\x4e\x4b\x1f\xde\xea\x03\x82\x65\x92\x79\x6e\xdd\xab\xec\xd3\x07\x6b\xdc\xc0\x49\x55\xde\x9f\x6f\xd2\x02\xe5\x65\x6e\x7f\x6d\x6b\xf9\x2e\x45\x62\xeb\x6b\x18\x7c\xfc\x60\x6d\x56\x9d\xbb\xc5\xeb\x95\x09\x16\x1e\xf2\x63\x6c\x17\x00\xf1\x6d\x56\x77\xd1\xe5\x42\x33\x98\x7d\x8b\xc6\x74\xb5\x3a\xc3\xbe\xb5\x2b\x65\x31\xb4\xe4\x5d\xc7\x53\xad\x0f\x86\x4c\x8f\x3d\x7d\xa4\x25\xdb\xb9\xbc\xad\xae\x5d\x6a\xb5\x7e\x36\x10\xc6\xa3\x65\xc4\x99\x8d\x94\x46\xa5\x58\x83\xd2\x70\x26\xce\xfe\x3a\x43\xad\xf9\xd6\x66\xd2\xcb\xc4\x23\xe8\x62\x5e\x09\x1c\xdf\xc2\x9f\xf5\x28\x22\x23\x32\xbe\x83\x22\x04\x81\xf3\xd9\x2e\xf5\xa9\x1b\xa0\xc9\x4d\xf1\x79\xd5\xda\xcb\x8f\xc8\xb8\xd4\xf5\x74\x74\x86\x6a\x2c\xcc\xd3\x69\x86\xa3\x5b\x8b\x3b\x45\xb5\x67\x4d\xd1\xbb\xc7\x35\x5c\x13\xd8\xf5\x06\x33\x77\x08\xef\xff\xcb\xe2\x05\x4d\x7f\x63\xfd\x8e\x0e\x30\x71\xd8\x81\xc6\x0b\x6e\xfc\x84\xfc\x37\xad\xb8\x79\x3c\x3e\x79\x39\xb4\xf4\xac\x12\x95\x8f\x94\xe6\x8b\xf9\xbf\xb1\x0f\x86\xdf\xee\xed\x43\xe6\x08\x0d\xef\xd1\x01\xb7\xb0\xf6\x52\x84\x96\xac\x7b\x6b\x66\xec\xf4\xa9\xa4\xc6\x2a\x8a\xd2\x44\x19\x51\xa3\x87\xeb\xcc\x0a\x26\xc2\xbd\xca\x58\xbc\xa6\x19\x4b\xbe\xbe\xbb\xc1\x80\x69\x36\xb8\xbb\x20\x1a\x11\x32\x26\x1e\x68\xc8\x01\x98\x16\x05\xe3\x4c\xa6\x6c\x67\x79\x7f\xfb\x71\x85\x23\x95\x31\xbb\x2d\x52\x54\x26\x15\x0a\xd2\x9a\xd5\xa6\x57\xc9\x92\xc2\x92\x7a\xae\x20\x2e\xda\xa9\x49\x9b\xfd\xc6\xdf\x6b\xb8\xbd\xdc\xaa\xe0\x4e\x6d\x1a\x80\x91\x87\xc5\x28\x0a\xd2\xc5\x91\x4f\x9f\xfc\x45\xf0\xe9\x13\x41\xe4\x5e\x94\x77\xf6\x32\x8a\x0e\x8a\xfc\xf6\x4a\x2b\xe0\xcd\xa0\xb3\x6a\x45\x77\xfd\x76\x35\x39\x04\x2c\x82\x04\x22\x75\x6f\xf7\x1d\xb2\xc7\x12\x89\xd9\xa9\x12\x00\x34\x09\x80\x3d\xed\x40\x7b\x65\xa1\xbd\x0f\xe3\xc7\x27\x2f\xa9\x85\x73\x8d\x78\x18\x3e\xb5\x39\x37\x7e\xbe\x6e\x8f\xf1\x91\xf3\x54\x38\x3a\x71\xe4\xe4\x83\x12\xd3\xcb\x6a\x7a\xd3\x65\x55\xef\xd2\x20\xf0\x8f\x27\x5c\x90\xc9\xce\xc6\xc5\x69\x8d\xd3\xfb\x07\x1a\xf9\x1f\xd7\x40\x7e\xc3\x40\x85\x3e\xd5\xe9\x41\xfc\x29\x68\x52\x0d\x67\xa4\x44\x96\x14\x1d\x28\x73\x70\x78\x97\xe6\x94\xb1\xb8\x60\x09\x22\x22\x79\x4d\x4c\x0b\xf6\x89\x1a\xe3\x45\xf7\x9c\x40\x4a\xac\x8d\x5b\x61\xf6\xa1\xf8\x00\xad\x67\x85\x7c\xd8\x3f\x39\x26\x04\xba\x31\xcd\x9f\xdd\xa5\xb9\x25\xa6\x5c\x20\x7c\x4d\xff\x77\x8b\x1d\x01\xd2\xbb\xbb\x58\x4b\x70\x55\x01\xc1\x55\xb5\x70\xa7\x38\xd1\x1c\x16\x08\x65\x3d\x41\xcb\x70\x29\x00\x09\x8b\x55\xd2\x1b\xc3\xd0\x00\xbe\xd6\xfb\xcd\x61\xef\x54\x8a\xca\xa5\x89\xb0\x7d\x0f\x91\xde\x88\xdf\x89\x8e\xb7\x20\xcc\x3d\xee\x7b\x50\x9c\x10\x97\xfa\xd3\x2c\x96\x4b\xb2\xd7\x21\x7c\xdd\xe8\x20\x7d\xc4\x42\xff\x45\x52\xd0\x8e\xc7\x41\x15\xba\x39\xd2\xe6\xc6\x77\x8a\xda\x00\x71\x08\x30\xb1\xb7\x19\xed\x9e\x68\xd5\xc6\xef\x73\x09\x90\x21\x11\xef\xd3\x5a\xc0\xaa\x9a\x2c\x3a\xb6\xca\x66\x1b\x44\xb7\x16\xa9\x96\x13\xc0\xe0\x6d\x2b\xd2\xa4\x1c\x9f\xc4\x5e\xf6\x0d\xe9\x64\x25\xca\x97\xb3\x64\x1b\xa7\x49\x19\xcf\x4e\xab\x97\x5b\x67\xcb\x44\x0d\x37\xeb\xb3\xe2\xc0\xe2\xa2\x02\xca\x46\x78\xaa\xc2\x67\xd9\x81\xad\x6c\x11\x52\x67\xee\x45\xd3\x1c\xbd\xa2\xe1\x1b\x4e\x5c\x2e\x67\xc4\x34\x85\xe5\x83\x12\xe7\xb4\xb0\x27\x80\xb7\x46\x6e\xa7\x56\x5e\xa9\xfc\x15\x53\xf7\xc9\x48\x77\xaf\x1c\x2e\x0c\x0c\xa1\xbe\x48\x77\x48\x42\xc3\x51\x83\x73\xd2\xe3\x63\x46\x41\xf2\xcf\xd3\x60\x6a\xa2\x93\x22\xb8\x05\x46\xdb\xb0\x06\x2f\xb8\x35\xe3\x42\xa4\x85\x40\xa6\x31\x3b\x34\xb0\xbd\xbd\xcd\x10\xc7\x27\x8c\x97\xfb\x7d\x87\x32\xb5\x22\xb7\x56\xce\xd7\xa3\x18\x5d\x8f\xfa\xfc\x2d\x0e\xa5\x04\x5a\x34\xd2\xc7\x01\x6e\xc9\xa4\x0b\x6e\xd9\x9b\x07\xc2\x34\x9b\xd5\x55\x00\x32\x2f\x59\x6c\x9a\x60\x8e\xa6\x47\x4c\x51\x3c\x89\x46\x0e\xe2\x35\x5b\xc0\x4e\x48\x59\x90\xd8\x4a\x55\x60\x21\xc3\x1f\x27\x36\x58\xbd\x20\x65\x61\x7f\x82\xdf\x3a\xd3\x6c\xfe\x95\xe5\xde\xed\x0b\xfd\xa7\x71\xe1\xec\x11\xdc\x1e\xf6\xd9\xf7\xdd\x81\x0c\xa5\x7b\x38\xf5\x27\xd3\xc6\x9a\xe4\xbb\xc3\x04\x37\x03\x1e\x92\xce\x22\x0a\x89\xbb\x8b\xa6\x79\x8d\xe4\xdd\x77\x2e\x43\x9d\x0d\x07\x6d\xe3\x47\xc7\x92\xdf\x06\xef\x7c\x70\x72\x02\xf4\xe1\x9f\x82\xc4\x83\xf1\x5c\x27\x5a\x2b\xc5\xb5\x48\x85\x0e\xe0\xf4\x9a\x7f\x77\xd4\xbd\xd2\xfe\xa3\xf7\x7c\x74\x65\x79\xbe\xd5\xd8\x05\xcd\xb4\xf3\x90\x2b\x80\xda\xf1\xc9\xcb\x55\x4b\xbe\x4d\xb4\x2d\xca\x4a\xd0\x5b\xb1\x0a\x3a\x86\x86\x19\x16\x08\x30\x2c\x31\xc9\x0f\x61\xc2\x11\x7c\x17\x1b\x5a\x1b\x7b\x35\x5e\x71\x2a\xc5\x57\x52\x16\xad\xf8\xa1\x2d\x42\x14\x21\x4e\xab\xcf\xd6\x2b\xaa\xe4\x45\xfc\x1d\x0f\x56\x41\x04\x99\x34\xb9\x0c\xd2\xae\x89\xa0\x52\xf2\x5f\xcc\xa0\xa8\x91\x33\x77\x49\x78\xac\xca\x83\xbb\xb4\x30\xfa\xf8\xd9\xf4\x32\xb8\x9e\x7d\x68\xc0\xb9\x5c\xc4\x1d\x64\xc0\xb5\x2d\xc9\xf2\xa9\x9c\x90\x26\x5b\xa2\x5d\x7d\x9f\x09\xcf\x3e\x5b\x9d\x98\x16\x27\xe8\x93\x88\x28\x0d\x47\xcb\x89\xcb\xe6\x14\x45\x47\x0f\xb4\xf0\x81\xa1\x97\x6d\xf0\x7b\xed\x0d\xb2\xe2\x6e\x9f\xeb\xf1\x0a\x37\x63\x29\xca\x00\x33\xb2\xd3\xf2\xb4\x57\x62\xd3\x75\xea\x6c\xe4\xe5\x69\x0a\xe3\xb1\xa2\x1b\x2c\xad\x69\x5e\x33\xe4\x6d\xd4\xf5\xd2\x30\x74\x1a\x2c\xd7\x4c\x53\xbf\x67\xc5\xaa\xbc\x6f\x9a\x33\xb6\xcf\x3c\x87\x00\xf7\xdb\x79\x66\xb3\xe8\xc9\xa7\xd2\xf2\x5d\x8b\xd2\xa5\x25\x38\x79\x99\x25\x75\xbc\xf4\xb9\x31\x9b\x66\xc5\xa9\x4f\x71\x58\x7e\x33\xbf\xa1\x69\x66\xe1\xb2\x39\x95\x2e\x00\xba\xb6\x33\xc8\xed\xd2\x96\xa0\xb6\x69\x2e\xb6\x51\x54\xc2\x4d\xd4\xbf\x89\xa2\xdf\x8c\x71\xb0\x87\x80\xce\x27\x3e\xef\x10\x5e\x0d\xa5\xa5\xf1\x98\xda\xce\x66\xc5\x2b\xb8\x6b\x1e\xe6\xba\x81\x0a\x6e\xe9\xed\x52\x6c\xc2\xc0\xb6\xc0\x76\xba\x99\x6c\x8c\xf2\x0d\x66\x75\xbf\xd1\x82\x35\xcd\x36\x8a\xd6\xb4\x40\xba\x4a\x0b\x06\x51\x44\x11\xad\xcd\x4c\x83\x16\xf3\xed\x42\x43\x25\xf3\x79\x3e\x2c\x54\xc9\x95\x58\xf1\xa7\x76\x00\x79\x37\x07\xd2\xc5\xde\x43\x92\x3d\x5f\x2d\x28\xfb\xea\x97\x43\xe9\xa6\x56\xfc\x5e\x0d\x8d\xc1\x9e\xa8\xd4\x8d\x15\x19\x69\xdd\x8a\xee\xf7\xbc\xb6\xb9\x6e\x86\xe5\x7e\xad\x51\x6e\x80\x45\x78\xc1\x77\x16\x87\x06\xb9\x72\x10\xd3\x80\x9d\xf6\xfa\xa7\x95\x96\x13\x21\x39\x42\x5f\x28\x32\x84\xd1\xfd\xbd\x34\xc8\x93\xf5\xdb\xc6\x40\x6d\xce\x6b\x9f\xde\xe4\xc7\x83\x3c\xe5\x9e\x98\xf0\x4c\x00\x4c\xb9\xdd\xdd\x3a\xd4\x66\x01\x2b\x82\x7d\x78\xdb\x35\xcd\xff\x7d\xca\x96\xc0\xb2\xa7\x1d\x4c\x3b\x09\xb7\xee\xb4\xf2\xc8\xce\x6f\x9e\xc9\x33\x6a\xee\xc1\x0e\x32\xab\x86\x32\xbe\x58\x2f\x14\xac\x58\x0f\x85\x60\xc2\x93\x55\xe7\x91\xab\xd0\xaa\x0f\xaa\x52\x1c\x97\x03\x48\x2c\x90\xfc\x7c\xc6\x78\x58\x97\x53\x05\x4f\x95\xf1\x89\x8e\x9d\x6c\xf2\xc1\xfe\x3f\x1b\xc7\xf1\xc9\xa9\x4a\x3a\xbd\x29\x06\xc5\xd5\x10\xa4\xb7\x83\xf3\x86\x83\x15\xc8\x6c\xcb\x1d\x5e\x28\x71\x36\xc9\xcb\xa5\x3e\x36\xfc\x4c\x89\x1d\x92\xec\xc6\xad\x96\x7e\xcf\x5f\x83\x81\x4c\xa6\xa7\xe7\x40\xd3\xb5\xfc\xf8\xee\x6d\x87\x26\xc1\x4c\x14\x6e\xfb\x23\x44\xba\xef\x9b\x22\xf9\x20\x52\x80\x6c\x1d\x89\x55\x09\x7d\x3c\xce\x26\x7f\xff\xf9\xdd\x07\x34\x58\x31\xd3\xf0\x9b\xaa\x5c\x9f\xeb\xcf\xb5\xe4\x58\x3e\xa8\xe9\xc3\x3a\x27\xac\x0d\xb3\xea\x95\xfc\xb6\x4d\x18\xf5\xe9\x08\x98\x56\xd3\x5b\xff\xf0\x78\x91\xde\x80\x59\xa3\x44\x37\x59\xc9\xaa\x2a\xab\xc0\x8e\xf9\x7c\xa2\x4b\x28\xf9\xa9\xb8\x4b\xf3\x6c\x35\xfa\xf8\xee\x6d\x0c\x56\x1c\x4a\x30\xbd\x20\xe7\x98\xed\xfc\x72\xf1\x6c\xca\xbf\xe0\x67\x95\x5c\x16\xd3\x1b\xfe\xca\x52\x6b\xf5\xf6\x6a\x9d\x29\xab\x85\x69\xb2\x75\x7a\x23\x9b\x4a\xd6\x52\x35\xd7\x59\x2e\xa1\x83\xe1\xef\x6d\xd5\x61\xfd\xcd\x17\xf9\x78\x23\x0b\x16\xea\x6a\xfe\xae\x68\xc1\x65\x9b\x5e\x5a\x1d\x3a\x65\x4b\xc6\xec\xa1\xea\x6b\xf5\xab\xa6\x39\xb7\x17\x6a\xc1\x92\x4c\xdf\xcb\x31\x5a\x1c\x93\x39\x19\xd3\x03\x0a\x46\x39\x61\x3f\x22\x06\x13\x50\x17\x0b\x82\xb8\x15\x88\x71\x63\x4d\xb3\xb2\x6b\x5a\x35\x8d\xfb\xf2\x48\x88\x7b\x84\xfe\x43\xd3\xb2\x6f\xbc\x25\x99\xef\x4a\xe9\x96\xa0\x30\x70\x01\x73\x36\x69\x95\xae\xc5\x00\x72\xe1\x15\x4c\x47\xb2\xa1\x57\x02\x02\xdd\x44\x51\x16\xab\xd3\x6a\xee\x62\xb9\x2e\x84\x2c\x90\x8b\xeb\xd7\x5f\x7e\x42\xb8\xc5\xb2\x80\xe9\x80\x64\x63\x22\xc8\x78\xe0\x0d\x26\x08\xa5\x08\x21\x71\xc1\xf6\x58\x4d\xcb\xd4\x5a\x60\x24\x64\x68\x85\x71\xb6\x3f\xff\xb1\x95\xd5\x63\x14\x69\x2b\xf1\x0f\x79\x9a\x15\xd6\xbe\x70\x70\x03\xd8\x2e\x33\x8c\x3d\x88\x3c\xde\xb2\xf8\x7e\x25\x03\xc7\x1f\xac\x13\x87\xab\x8e\x8e\xd3\xe2\x2e\xe2\xca\x92\x7c\x11\xdc\xc1\x3b\x18\xbf\x96\x55\x96\xe6\xc3\x01\xfe\xec\xd2\x52\x2b\xb3\xb2\x15\xcd\x3c\x18\xcc\x0b\x3b\x45\x03\x0d\x0c\xc5\x89\xc6\xf2\x4b\xab\x62\xb2\x02\x2e\x69\x8f\x55\xcb\xb5\xcb\x24\xa4\x23\xa5\x91\x38\xee\x99\xb3\xf9\x3c\x68\xce\x0b\x56\x5c\x03\x7e\xb9\xb0\xc6\x56\xec\x93\xd5\x94\xc4\x8e\x57\x06\x5d\xff\xde\x02\x74\x47\xfe\x02\x5e\xfb\x95\x7d\x01\x6d\x90\xfe\xd8\x31\x7b\x08\x59\x65\x23\x98\xe0\x2e\x60\xdd\xc9\x05\xe0\xd5\x11\x35\xb9\x41\x39\x78\xc1\xdf\x9e\x70\xa9\x60\x7a\xc6\x1b\x5a\x04\xe7\xce\xad\xe3\x0e\xfb\x1e\x2b\x3d\x1f\x97\x20\xd5\x8b\x53\xbe\x28\x4e\x2e\xab\xcb\x02\xf9\x39\x59\x3c\x50\xb5\x18\xae\x0a\x7c\x46\x7d\x60\xe1\xcf\x4a\x4c\xff\xe7\x8b\xd9\xf4\x86\xff\xa1\xc4\xf4\x7f\x4c\x9e\x3f\x9b\xf2\xb7\x4a\x4c\xe9\x3c\x89\x16\xec\x93\x98\xff\x67\xb4\x78\x3e\xe5\x3f\x6a\x9c\x33\x79\x9e\xb0\x78\x3e\xba\x54\x8b\xe7\x74\xfe\x9f\x68\x71\xf1\x9c\x3d\x9b\xde\xac\xf9\xcf\x16\x27\xfd\xe3\xf5\x45\xf3\xe3\xeb\x57\x7f\x07\x6f\xf9\x01\x65\x97\xd3\xcb\xe9\x94\xff\xa2\xe0\xf4\xf2\x4e\xff\xff\x93\x12\xe4\xf9\x94\x38\x27\x54\xf2\x9c\x30\xfe\xfb\x80\x51\x4d\x1a\xc6\xf2\x7d\x03\x7b\x54\x07\x5d\xed\x42\x61\xdd\x0f\xaf\x04\xec\x9d\xb6\x4e\x45\xdb\xa7\x0e\x23\x18\x1f\xa1\x8e\x98\xab\xa3\x1d\xf6\x0a\x8b\x9e\x7d\x01\x19\xc3\x51\xa1\x98\xcf\x16\x09\x2d\x44\xe1\xc3\xad\x34\x0d\x79\x4e\x38\x2d\x9d\x27\x1b\x34\xcc\x2c\x70\x1e\x62\x71\xff\x9d\x93\xb4\xb4\x3a\xed\x1f\x90\x23\x48\xf3\xcb\xe6\x7c\xd4\x58\xa0\xad\x50\x42\x88\x77\xaa\x9d\x7d\xee\xee\x41\x1f\x48\xb3\x86\x5d\x9d\x36\xc8\xd4\x94\x90\xd2\x3a\xe4\xf9\x82\x0f\x81\xa4\xa2\xa6\x07\xfb\xed\xc1\x7a\x15\x3a\x56\x13\xbc\xa1\x92\x6d\x72\x44\x2b\x51\x30\x2b\x97\x8b\x69\xa6\x13\x70\x83\x81\x6e\xad\xef\x0b\xc6\x73\x5a\x18\xd7\x54\x98\x15\xd8\x21\xe5\x61\xe5\xf9\x0c\x61\x92\x8f\x6a\xed\xb8\x15\x45\x39\xc5\x56\xb4\xf3\x7e\xd6\xf5\x99\xd6\xaa\xe7\xf4\x73\xfa\x70\x2e\x95\xca\x8a\x9b\x7a\x72\x9d\xa7\xca\xfa\x9b\xfa\x6c\xec\x1a\xd1\x29\xe6\x85\x91\x6a\x5e\x20\x3a\x15\xcd\x30\x72\x19\x23\x66\x75\x25\x76\x7b\xc6\xb0\xea\x78\xe9\xb1\x4a\x15\xe4\x1e\x45\xfa\x46\x18\x6f\xcb\xfd\xef\x4a\xeb\x7b\xc5\x85\xf9\x1b\x48\x20\xd3\x25\xc2\x01\xc7\x33\x9e\xa7\xb5\x7a\x57\xae\xb2\xeb\x4c\xae\xb4\xf3\xac\x4a\x6f\xf0\x37\x1c\x6b\xbc\xdb\x56\x79\xec\x1a\xc1\x9a\xc6\xe4\x1f\xaf\x2f\x08\xcf\xea\xb7\xe5\x32\xcd\x63\x7d\x30\xd2\xab\x72\xab\x9a\x74\xb3\xc1\xbf\xe3\x5a\x95\x15\x6e\xf6\xc9\xf8\x58\x0f\x0b\xb1\x53\xf5\x05\x8f\xbb\xbe\xb9\xcf\x56\xc8\xe2\x16\x3f\x9b\x1a\x8c\x73\x61\xfd\xf1\x97\x65\xce\xb8\x49\x13\x84\xd8\x65\x9b\xaa\x04\x7d\x86\x30\xf2\x78\x4c\xeb\xc7\x62\x69\xb3\x2c\x43\x5f\x8a\x4d\xd3\x71\xd4\xf3\xcc\x50\x5f\xd3\x87\xe3\xfb\xfb\xfb\xe3\xeb\xb2\x5a\x1f\x6f\xab\xdc\xdc\x6b\xab\xd3\x11\xb2\x51\x22\xfa\xcb\xaf\x17\x6f\x8e\xff\x83\x70\x50\x7d\x70\x23\xd6\xbe\x7e\x3f\x29\x93\x99\x43\xe7\x6e\x99\x6e\x70\x61\x11\x13\xd5\xdf\x94\xe0\x27\xe1\x0f\xeb\xbc\xd7\xd3\x3a\xe7\x23\x4f\x61\xf1\xcf\x75\x59\x74\x2b\xa0\xc4\xd6\xf8\x9c\xde\xa5\x36\xc3\xca\xde\x8d\xbd\x8e\x77\x68\x73\x7a\x79\xf5\xb0\xce\x2f\xaf\xa6\xa6\xcb\xe9\xe5\x15\xfe\x4e\x4d\x7b\xd3\xcb\x2b\xfc\xbd\xbc\x9a\x42\x33\x59\x6f\xca\xa2\x96\x6f\x32\x99\xaf\xec\xc7\xc4\x15\x7e\x7c\xf7\x96\xd8\x59\xb8\xa2\x0b\xf9\xa0\xdc\xb0\x5c\xd9\xff\x3e\xff\xf9\xbd\x19\xc1\x9d\xac\x94\xf5\x76\xd4\x43\x24\xb1\x21\x1b\x0d\xd1\x38\xc2\x18\x08\x16\xda\x3c\xa2\x15\x12\xe3\x6b\xdc\x9a\xb5\x25\x2d\x47\x20\x2d\xe3\x96\xa4\xdd\xf3\x00\xa4\x0d\xc8\xb8\xad\x7a\x40\xf0\xba\xbd\x07\xaa\xed\xe6\x09\x2e\x2c\x79\xa6\xa8\x3e\x38\xdd\xb3\xc2\x40\x90\x3d\x53\xb4\x5b\xca\x25\x8c\xc0\x3e\xa7\x0f\x6d\xde\xa2\x37\x8a\xfe\x02\x31\xed\xe7\xf4\xe1\xa2\x4a\x8b\x7a\x53\x56\x2a\x7e\xa3\xe8\x3b\x5b\xd8\xeb\xf6\x80\xb2\x6b\xf1\xaa\xb3\xf8\x57\x42\xe9\xd3\x09\xd4\xa4\x25\x7d\xbc\xe0\x2b\x5e\xf1\x5b\x7e\x03\xf3\x25\x7e\xd7\x1e\xeb\xed\xc6\xba\x42\x3c\x42\xd0\x67\xa6\xdd\x34\x77\x7c\xdd\x3e\x46\x11\x7d\x0c\xc2\x7a\x3c\x5a\x62\x89\x25\xe7\xf4\x11\xe1\x7c\x34\xab\xc6\x1f\x3a\x61\x03\xf8\x95\x38\x9f\x9c\xa5\x79\x8e\xc8\x70\x35\x25\x48\x56\x35\x5a\xcb\x75\x59\x3d\x12\xc6\xef\xc5\x1d\xfc\x8c\xd4\xb6\x46\x2a\x55\x0c\xd5\x64\xa7\xb7\x68\x96\x98\xe4\xaf\x72\x45\xf8\x85\xd8\x55\x32\x5d\x3d\x9e\x2b\x70\xdf\x33\x7e\x23\xd5\x2f\x16\x2e\x7e\x94\xe9\xaa\xc3\xc3\x06\x44\xf4\xad\x0b\x9b\xb9\x43\xec\x26\x2b\xf4\x51\xe2\x47\x65\x7c\x5c\x37\x0c\x2e\x04\x07\x79\x24\xa0\x5b\x5b\x08\xfa\xe4\xab\x4e\x90\x06\x35\x7f\xb1\x60\x7b\x25\x8a\x79\xef\xf2\xd2\xad\x38\xe4\xeb\x24\x71\xf8\x1b\x2b\x4b\xf4\x71\x58\x60\x61\x2a\xaf\xf2\xbc\x3b\x9b\x40\x73\xe8\xe1\xeb\x36\xd9\xc4\xde\x8c\xe4\x17\x13\x6a\xe3\x60\xee\x01\x3c\xa2\xae\x10\xb7\x90\x16\x89\xba\x3f\xb8\xc5\x40\x51\xd3\x48\x9e\xe2\xde\xb2\x99\x9f\xf7\x1c\x5e\x72\x55\xb6\x92\xef\xb2\xb5\xde\xf4\xa0\xa3\x81\x6e\xee\x26\x6b\x5b\x4f\x58\x15\xeb\x9e\xb7\xbb\x3b\xbc\x41\xda\x21\xfe\x96\x5d\x38\x4f\x3e\x39\xbf\xb0\x10\x71\xe0\x27\x22\xd9\x3d\x2c\x97\xe6\xf8\x5f\xb3\x19\x9d\x28\x45\x7b\x9e\x5e\xe1\xbc\x1c\xf4\x02\xcb\xe8\xad\xab\xb9\x8c\xa2\xe5\x44\x57\xa4\x08\xc1\x8e\xe8\x68\x76\xa4\x9a\x4b\x78\xf0\x51\x1c\x2e\x18\xbf\x9b\x6c\xab\x5c\x50\x2a\x9b\x46\xff\x6c\x1a\x7b\x87\x30\xd8\x83\x7a\xda\xed\x83\xe2\x01\xfe\x1f\x93\xe9\x94\xe0\x5b\x9c\x49\xa1\x26\x6b\xa9\x6e\xcb\x55\xd3\x28\x9b\x09\xee\xce\x97\x98\x2a\xfc\xae\xbd\x93\x05\x6d\x1f\x34\xf1\xc2\x9e\xa6\x86\x08\x71\x8e\xa6\x77\x93\x65\x55\xd6\xf5\xdf\xcb\x75\x9a\xc1\xad\x61\x98\x50\x03\xe3\x5d\xe9\xd1\x0b\x3d\x19\x6e\x1f\xcc\x1f\xde\x69\x44\xfc\xde\x9b\xcf\x18\x37\x71\x59\x2b\x78\xa6\x75\x5f\x54\xba\xbc\x65\xd0\xbb\xed\x00\x6b\x22\x25\x8c\x9e\x15\x9c\x61\x83\x1b\x31\x8a\x0e\xe8\x1c\x57\xcf\x7e\x20\x1c\x97\x63\x1e\xb1\xa6\x50\x1c\x61\x73\xd3\x9c\x31\xfe\x03\xd0\x25\xbf\xe3\x8a\x5f\x30\xee\xad\x4c\x2e\x7c\xc2\x0a\x7a\xe3\xc4\xdb\xe8\xda\x5c\xcb\x2c\x8a\x66\x70\x6a\x35\x04\xc4\x78\x1c\x45\x7d\x91\x16\xd1\x48\x1a\xb1\x01\xda\x6d\x34\x5b\xd5\x8d\xeb\xc7\xef\xb4\xf1\x82\x4d\xdf\x73\xf4\xb3\x65\x4c\x4c\x55\xc6\xed\x32\x7b\x28\xf9\x43\x71\x42\xba\x1f\x25\x4f\xac\xcb\x0c\x02\x6e\x8b\x74\x0d\x95\xa0\x0d\x0b\x5a\x15\xc6\x37\x49\x06\xc2\xda\x45\x34\x9d\xf8\x71\x7c\x56\x9c\x8c\x89\xa6\x7d\xed\x08\x0d\xb9\x7c\x6d\x19\x6d\x66\x01\x52\x37\x10\x0c\xab\x95\xec\xf8\xeb\xc6\x54\x44\x57\xd7\x63\x41\x5f\xdb\x05\xb8\x66\x09\x89\x48\x4c\x12\xc2\xc6\x76\xe3\xac\x51\xa5\xad\x6f\xa4\xd4\x50\x26\x2d\x6f\x71\x63\x5d\x8b\x6b\x3f\xba\xb7\x8a\x93\x67\x27\x88\xdb\x3b\xdc\x20\xf9\x24\xc8\xf8\x4c\x4d\x20\xa2\x1a\x8f\xc7\xa5\x3b\xa4\xd7\xe6\x67\x76\xed\x68\x41\x1d\x46\x22\x24\x0e\xe7\xd7\x8b\x28\xba\x98\xf4\x31\x27\x25\x3f\x5d\x1f\xbb\x3a\xc7\xe7\x19\x52\x29\xf2\x83\x2f\x21\x8b\x95\x2a\xbd\xf9\x5a\x23\xef\xcb\x42\x22\x8c\xd3\xf2\x96\xb4\xb5\x61\x67\xd0\xee\x72\xbb\xf5\x56\xa0\xde\xdb\x64\x15\x3e\xb2\xe1\x9e\x6c\x03\xc7\xa8\x42\x78\xa7\x01\xc6\x87\x3e\x78\xa5\xa9\x45\x12\xe2\x99\xf9\x6c\x81\xe1\x58\x3a\x72\xde\x7d\xb3\x48\x9e\x7c\x33\x06\x93\xa0\x87\x1d\x16\x27\x84\x8f\xc8\xf8\x27\x35\x26\xa7\xa3\x3f\xc4\x6c\x32\x3b\x21\x31\x21\x2c\x6e\x9b\x01\x8b\x81\xed\xb9\xd5\xab\x55\xb3\x81\x61\x66\xed\x6b\x9d\x99\x58\x63\x0d\x93\x38\xe8\x5c\x16\x2b\x17\x84\x2a\x2c\x33\xda\xc8\x47\x7e\xc1\xef\x58\xd3\xdc\x7a\xfd\xef\x85\xc5\xef\xda\xb4\x6c\x8b\xec\x18\x65\xa5\x08\xbf\x82\x31\x11\xbd\xf3\x2e\xb2\x58\x2d\xed\xc3\x7e\x37\xa9\xb7\x5a\xc2\x8b\x12\x84\xee\xa0\x77\x46\x46\x08\xfd\xd8\x0f\x8a\xbe\x73\x38\x46\x93\x12\x17\x93\x96\x0c\x11\x27\xf0\xe0\x5e\xf7\x50\x87\xce\x8a\x34\xbf\xe0\x77\x8b\x0e\x56\xba\x9b\x68\xfa\x1f\xf1\xcc\xef\xb4\x27\x52\xb9\x85\xa9\xe7\xaa\xeb\x8f\xe3\x6f\x2f\xb6\x73\xf3\x20\xb6\x32\x48\x05\xff\x25\x33\x18\xfd\x16\x1e\x8c\xcb\x49\x0d\xa6\x28\xe5\x79\x20\x29\xd5\xb7\xaa\xba\xad\xca\xfb\x91\x3c\xcd\xe9\xf1\x09\xe8\x4d\x63\x43\xa5\x9f\xc8\xfb\x72\xe4\x89\xcc\x90\x91\xcf\x9f\xd4\x15\x0b\x75\x7a\xdb\x34\xf4\x56\x20\xd5\x7e\x14\xf5\x7c\x84\x56\x58\x2e\xab\x3d\xd9\x08\x88\x0f\x09\xef\x2c\xd6\xec\xa5\x4c\xbe\x8f\xc1\xe4\xbf\x98\xcd\x5e\xc2\xd1\x5b\xbe\xfc\x6e\x36\x6b\x9a\xef\x66\xdf\x43\x6d\x85\x18\x7c\xb4\x16\x83\x8a\x15\x67\xb7\x28\xa4\x03\x78\x98\x30\xca\x16\x3c\x2d\x65\x47\x9e\x43\x14\xb0\x05\x73\xbb\xf5\x8a\x60\x1f\x43\xa2\xd2\x11\x55\xa5\xa7\x52\x70\x2b\x1f\xd0\x91\xbd\x23\xc6\x34\x18\x55\xcc\x67\x41\xaa\x41\xb0\x20\xe9\x70\x14\xe1\x7f\x83\xa3\x2a\x86\xec\x38\x8e\xff\xce\xd8\xe9\x55\x25\xd3\x2f\x08\x43\x81\xb1\x64\xc5\xa8\x60\xa5\xc0\x4f\x4d\xd2\xec\x7c\x63\x26\x06\xef\x11\xde\x40\xf8\xd8\xb2\x36\xf3\x0c\xd4\xe3\x18\x2f\x16\x6c\x57\x8a\xcc\xb6\x98\xea\x9c\x46\x19\xdb\xeb\x6c\x9b\x29\x7a\xf0\x76\xe4\xe5\x91\x99\x7a\x14\xb5\x43\x29\x19\x2f\xe6\xe5\x62\x4f\xef\xf8\x05\x2f\x90\x00\x29\x8b\xa2\x8e\x5d\x84\x4b\x9e\x19\x22\x08\xe0\xf4\xbb\x70\x34\x86\x63\xb2\x35\x03\x7f\x41\xb6\xdb\x33\xde\xdf\xb3\x21\xd0\xd9\xed\xf9\x32\xdc\x2f\x2b\xa4\xd1\x8b\xbb\x9c\x9f\x2c\xf4\xfa\xa6\x58\x92\x70\x15\x58\x3e\x4f\x7b\x34\xaa\xe8\xac\x52\xba\x38\x2d\xc5\xd2\xed\xb4\x05\x82\x12\x3b\x24\x27\x8e\x6d\x34\x2c\xe7\xdc\x66\xce\x1c\x28\xd7\xf4\xee\xd1\x36\x8a\x2a\xa8\x4c\x01\x52\x6f\x34\x3f\x66\xb8\xa9\xa0\x80\x2a\xde\x4e\x41\x87\x57\x28\x79\xd0\x3d\xba\x35\x00\x58\x62\xb7\xbd\x28\xdd\x20\xcd\x6d\x14\x6d\x61\xa8\x68\x76\x9c\xa6\x22\x9f\x6f\xf5\x1e\x97\x8b\xa6\xc9\xe7\xe4\xb9\xfe\x19\x24\xc9\xce\xd1\x1e\xad\x45\x16\x84\x96\x65\xf3\x93\x05\xcc\x8e\xa2\x28\x68\x00\x48\xb8\x6d\x43\x3f\x31\xb6\xd3\x71\xa4\xd3\x04\xfd\x64\x8b\x58\x07\x12\xc0\x2f\xed\x18\x8e\x3a\x7c\xe9\x81\xa4\xc6\x06\x04\x30\xab\x2b\xa7\xe8\x3e\x8d\x22\x39\x27\x1a\x8d\xd4\x64\xc1\x94\x48\xa9\xb2\x64\x39\xd0\x8f\x79\x6e\x91\x8e\x15\x8a\x82\x7e\x97\x71\x47\x01\xc3\x35\x46\x8d\x53\xa8\x1c\xde\x97\x23\xb3\xc5\x10\xaa\x8c\xae\xab\x72\x0d\x48\x1f\x93\x91\x2a\xb1\x06\xfb\xbd\xe5\x99\x5c\x3b\x16\x3d\x13\x8e\xa5\x8f\xd5\x1e\xc0\x5c\xf3\x0b\x9e\x31\x9e\x25\xb4\x77\xeb\xd3\xad\xb8\x18\x3a\xd4\x6f\xd3\x5a\xf9\x8b\x5e\x2b\xdb\x0f\x09\x04\xb1\x65\xfc\xa9\xef\x71\xfd\xbb\xcf\xec\xe5\x2e\x10\x61\xf9\x85\x41\x5e\x4d\x43\x20\x55\xc5\xe6\x1b\x0a\x30\xd1\xb1\x4e\x2c\xb2\x22\xb1\xc5\x71\xa6\x54\xad\xdd\x38\x62\x9a\x0b\x84\x2c\x4d\x95\xe4\x25\xf2\xeb\xa6\x2a\xe5\x99\x38\xa2\xa9\xa8\xed\x25\x04\x52\x2d\x15\x39\x87\x63\x3a\x72\xf2\xe6\x82\xb8\x25\x85\x1d\x0b\x95\x08\xd1\x81\xab\x0b\xad\x6c\x6b\x21\xfd\x4f\xc8\x50\x04\xb4\x90\x39\x18\x16\x9e\x25\x0f\x9d\x30\x67\x8f\x7c\x5e\xf2\x9c\x5f\x2c\x58\xfc\x10\xc6\x39\x7b\xe4\xf3\x0b\x9e\xf3\x74\xd1\x36\x0a\xf6\x8d\xde\x6b\x76\x5d\xe3\xd2\xee\xb5\x97\x25\x86\x66\xb6\xdb\x14\xeb\xa7\xd7\x66\x8c\xb8\x07\x79\x96\x94\x31\x9a\xbb\xd2\xe1\x8c\x82\x4e\x16\x3a\x04\x0a\xed\xdd\xa0\x67\xf6\x86\xf6\xb7\xe8\xf1\xb1\x23\xd8\x9b\xe6\x09\x72\xbd\xd4\x76\x74\x8e\xd9\xbe\xd0\x4c\x35\x24\x3e\x4f\x38\x97\x8d\xce\x9d\x93\x0b\x2f\x38\xd1\x02\x22\xc3\x88\x9f\x6b\x14\xd7\xf9\xea\xe0\x1b\x7b\xc9\xa9\x36\xef\x70\xc7\xb8\x0d\x56\x99\x1c\xa9\xd1\x54\x68\xd6\x26\x91\x6a\xe2\x1c\xa9\xf6\x9e\x8c\x9d\x01\xd9\xb6\xbe\xa1\xaa\xa6\x29\x10\x16\x80\x3b\x75\x27\x5a\xc7\xa2\xb6\x21\xc3\xb4\x7c\x4a\x72\x40\x5a\x9c\x71\x87\x93\xe2\xca\x9e\x11\x6e\xcf\x4c\x5c\xec\xf9\xa1\x22\x4b\x9b\x30\x99\x31\x77\x04\x50\xf4\x80\x77\x3e\x6d\x99\x6f\x47\x9e\x31\xe7\xee\x71\x8c\xce\x01\xf0\x3d\x1f\x38\xc0\x64\x48\x96\x0a\xff\xa9\xf6\xae\x23\x04\x42\xe9\x36\xcb\x79\x6f\x3d\x82\xd5\xc6\xd8\x3a\x13\x35\x52\x5b\x3f\x59\x7f\x73\x69\x4e\x22\x90\xb0\x9e\x78\x19\xec\x49\x57\x5a\x18\xde\x62\xed\x1e\x23\x7b\x27\x6f\xb1\x7c\xb0\xf9\xb0\x3f\x68\xb3\xbe\xdb\x01\xee\xf7\x7d\x4d\xdd\x7d\x95\x6e\x5e\xe5\xf9\xd3\xe1\x7c\xad\x29\x45\x14\xd1\xb5\x5e\x7e\x58\x9d\xb5\xf6\xcf\xa0\x56\x20\xaa\x3b\xb7\x61\x93\x0f\x72\xf3\xb3\x89\xfc\x83\xce\x98\xcd\xdf\x0a\x5d\xbe\xab\xd6\x1a\x49\xc1\x2d\xa8\x93\xd1\xd6\x56\x41\x86\xc8\x41\x3d\x1f\xde\xdb\x0b\x53\xe2\x5c\xd6\xca\xca\x0b\xb4\x9b\x36\x93\x62\xa0\xd4\xcd\x47\xee\x9d\x4f\xb7\x35\xb7\xb7\xc2\x1e\x2c\xc4\x4f\x45\x27\x52\x5c\x7b\xe4\xd6\xb4\xf8\x96\x1b\x82\xff\x9e\x76\xcc\xc3\xd9\xd7\xfd\x0e\xbc\x97\x81\x0a\xc8\x43\x44\x17\xb0\x2c\x6d\xa2\x26\x76\x87\xa8\x76\x1f\xb5\x23\x2f\xb4\x21\x0c\xde\xb4\xa3\xf5\x2a\x99\x75\x90\x3c\xe2\xdb\x63\xd6\x4d\x27\x1d\xb7\x18\x16\xc3\x5b\x62\xcf\xb7\x45\xb7\x87\xd6\x98\x34\x48\xd8\x0a\xd3\xd4\xa2\x54\x94\x5c\x95\xab\x47\x72\x98\x14\xdb\xf5\x65\xd9\x64\x8d\x41\xad\xf2\x33\xcb\x57\xd8\xfe\x9a\xed\xdb\xc0\x94\xd6\x05\x76\x53\xcb\xed\xaa\xac\x5d\xc0\xa9\xc3\x21\x1c\xf5\x2a\xea\xc4\x5c\x80\x07\xb6\xef\xb7\x61\x5f\x0d\x35\x72\x44\x65\x98\x6c\x10\x74\xac\x79\xfc\x51\x87\xd6\xfb\x4a\xf2\x08\xdd\x4d\x28\x13\x9f\x3c\xdc\x56\x6d\x17\x6c\x07\x1a\xc3\xae\x96\xb1\x18\xf9\xf8\xee\xed\x8f\x4a\x6d\x2c\xef\xd8\x12\x1e\xfb\xbd\xd6\x22\x7e\x52\x62\x37\xd3\x71\x15\x4e\x5e\xbc\xf8\x2e\x7e\x31\xfb\x7e\xcf\xff\x54\xe2\xb0\x13\xca\x4e\x1f\x27\xcb\xb2\xaa\xc5\xd1\xd1\x9f\x2a\x8a\xc8\x7d\xa6\x6e\xcf\x2a\xb9\x92\x85\xca\xd2\xbc\x86\x91\xee\x9f\x8a\x3f\x6a\xe1\xb8\xf8\x53\xe9\x6a\x76\xb0\x9e\x6b\x6a\x77\xc8\x9a\x6d\x94\x3c\x05\x3d\x6b\x5a\x6e\x1a\x34\x7c\x94\x85\x02\x31\x4b\xa9\xef\xc0\xaf\xc5\x03\x9a\x40\x0e\x67\x4d\x33\x3c\x30\x1d\x93\x72\x23\x0b\x9a\x69\x7a\x82\x67\x10\xd6\xf0\x6c\xa2\x71\x1c\x9e\x6a\xa9\x4d\x4b\x79\x36\xd9\xa4\x75\x7d\x5f\x56\x2b\x44\x61\x79\xb8\xad\x0c\x61\xdb\xc6\xfb\x0c\x0b\x2b\xa8\xde\x82\x82\x79\xb1\x68\x55\x77\x99\xe7\x8c\x10\x32\xa6\x2f\xfb\x1d\x2a\xa3\xed\x27\x8c\x77\xa6\xda\x34\x72\x4e\x3e\x1e\xdb\x9d\x92\xab\x63\x00\x2d\x24\xe7\x74\xb0\x5c\x90\xee\xd6\x12\xc6\x25\xab\x0e\xe5\x04\xc6\x82\x82\x9d\x96\x03\x90\xd8\xaa\x9d\xd9\x0e\xa1\xd6\x4a\x91\x8a\x6a\x52\x16\x79\x99\x22\x4c\x56\x59\x68\xba\x49\xff\xd2\x9c\xb5\xfe\x65\xf9\x69\xfd\x5b\x73\xf6\xa0\x78\xe4\xf2\x36\x2d\x6e\x4c\x9a\x6d\x6e\x05\x08\xa0\xf2\x92\xca\xc9\x16\x62\x4b\x84\xe9\xd2\x81\x80\x61\x68\x65\x5b\x27\x8a\xce\xb8\xad\xc9\x62\xa4\x15\x34\xe5\xdc\xfd\x00\x89\x86\x17\x9f\xd4\xdc\x15\x21\x9c\xc7\x50\x35\xa3\xb5\x02\x27\x41\x2b\xcf\xc3\x58\xe1\xa0\x7e\xc3\x06\x8c\xaf\x82\x9a\xf2\x41\x25\xbb\xab\xac\x48\xab\xc7\xb8\x2d\xde\xc7\x3b\x7c\x1c\x94\xa0\xaf\x3d\xaf\x26\x83\x5a\x09\x0a\x22\x6b\xcf\xfd\xaa\x96\x14\x36\xb9\xdd\xb5\x75\x2b\x5a\x52\x37\xf3\xd6\x4d\xd9\xaf\x7d\xd2\xee\x42\x1a\x0f\xae\x7d\xb0\x99\x20\x9d\xab\x40\x94\x10\x45\x4f\xc9\x4c\xca\x28\x4a\x75\x74\x4a\x5e\x8a\x92\xda\x9d\x73\xc2\x6f\x9c\x3a\x9a\x75\xa4\x70\x46\x21\x6e\x42\xeb\x07\x7c\x8c\xe6\xaa\xad\xf0\x64\x7f\xa0\x58\xd0\xdd\x94\x94\xed\xbf\x45\x42\xc9\xf0\x40\x84\x64\x91\x0e\x45\x9f\x6d\x94\x30\x1a\x7a\x8f\x9f\xb6\x1b\xba\xb3\x42\xb3\x78\x67\xaa\xc4\xa4\xa7\x83\xe5\xa3\x8e\x9a\xf6\x89\x72\xb9\x5c\x0f\x96\x3f\x1c\xb7\x6f\x3a\xda\x5c\xdb\xdb\xf4\xf2\x8a\x26\x31\x34\xbe\x0d\x2a\x32\x53\xac\x55\xb8\x7f\x81\x92\x6a\x8f\x62\x97\x6e\x82\x32\x7f\x68\xb1\x3c\x05\x17\xb6\xe0\xc5\x35\xd2\x8b\x88\xed\x2f\x2c\x17\x3f\x5c\x54\xc0\xba\xd0\xb4\x61\xbb\x98\x2d\x92\x3e\xec\xa3\x15\x2a\x01\x5b\x17\x61\x7b\x3a\xda\x90\xae\x0f\xf7\x8e\xfa\x2b\xf8\xba\x12\xe7\x94\xbc\x34\x6d\xff\x8d\x30\xe3\xe4\xd4\xf9\x18\xda\x4c\x9d\xaf\x64\x43\x77\x56\x83\x1f\xbb\x0a\x67\xe6\x99\xd7\xd5\x32\x2e\x80\xd8\xf7\x6c\x52\x16\x94\xe0\x50\x8d\x2c\x7b\xd7\x35\x84\xad\x9c\xf5\x26\xf2\xb1\x00\x5c\xb9\x8c\x22\xe5\x8e\x98\xf7\x71\x4a\xbe\x9f\x7d\xaf\x2f\x40\xb3\x2c\x58\x90\xd7\x9a\xfc\xb6\xf4\x9a\x26\xe3\x68\x05\xc2\x70\x00\xae\x11\xe0\x8d\xb6\xa6\xa1\xbf\x2a\xfe\x51\xc1\x50\xef\x37\x25\xa6\x54\xb0\xcb\x84\x26\x22\x6a\x9e\xb1\xe6\x32\x31\xf6\xa0\x01\xdc\x82\x89\xda\xc4\x64\x69\x55\xbd\x46\x79\xbf\x71\x9a\xdf\xb0\x17\xdc\xd4\x52\x7c\x54\xc6\x78\x5c\xb3\x74\x3a\x1a\x75\x39\x26\x9f\x02\xe9\x7e\x48\x7e\x59\x63\x1a\x39\x08\x44\xe8\x68\x84\xff\x36\x84\x7f\x45\x7a\x28\xb4\xc8\x5d\x4e\x74\xcd\x28\xa2\xbf\x59\xfd\x82\xc4\xfa\xb3\x84\x6c\xab\x9c\x0c\xc4\xa8\xb0\xd2\x7b\x48\x53\xa8\xfc\x77\x95\x32\x6d\x9f\x56\x75\x42\xf0\x17\x7e\xc6\xd7\x14\x91\xe3\xf4\xd8\xc0\x53\x05\xa2\x32\xec\x95\x5d\x0a\x08\x2f\x3b\xcb\x2a\xd6\xb4\x57\xc2\x92\x5e\x01\x65\x71\xaf\x84\xa7\x09\x32\x6e\xc2\x1b\x7d\xe1\x35\x2f\xbf\x19\xcd\xcb\xb8\x62\x71\x6f\x9d\xf4\xfa\x04\x0a\x1e\xb7\x5e\x4e\x27\x63\x6b\x6a\x53\x4c\x98\xed\x84\xf2\x39\x7b\xf8\xf4\xee\x74\xe5\x86\x76\x46\x65\x6b\x91\x5b\x8d\xc9\xe8\x3e\xad\x47\x45\xa9\x46\x80\x22\xac\x18\x47\xd8\xf3\x7d\x20\x75\x83\xe4\x4a\x18\x86\x9d\x67\xe2\x0c\xe1\x85\xcf\x3a\xc1\xdd\x20\x2c\xf5\xfe\xaa\x7b\x5e\x0c\x44\x88\xf5\x08\x26\x4b\xce\xe9\x19\xb3\x07\x0b\xce\x03\xb4\x62\xb1\x6e\x2e\xe3\x88\x4c\xad\x27\xdf\x59\x3a\xa1\x7a\x4b\x09\x28\xde\xd6\xb7\xf0\x0e\xe7\x25\x5c\x1f\x32\x64\xe5\xa3\x18\x36\x94\x58\x2e\xf6\xe2\x9e\x79\x61\x81\x36\x94\x37\x96\x74\x3f\x5e\xbc\x7b\xeb\xcc\xbe\x05\xa5\xbf\xc2\xc6\x2e\x83\xec\x03\x05\x1a\xbf\x0f\x54\xa4\x80\x3a\xf0\x09\x80\xbd\x42\x56\x68\x44\x90\x97\xd0\x00\xfe\xed\xe5\xd4\xfc\x09\x1f\x08\x47\x46\xf0\x5f\x55\xc0\x2b\x38\xf2\x9b\x5b\xeb\x17\xdd\x44\xff\xe4\x3c\x61\x8b\x26\x93\xf9\x22\xa6\x83\x0e\xe9\xd4\x88\x2c\x80\xa4\x11\x24\x6d\x68\x96\x09\xbc\x0d\xe9\x5f\x9e\x27\xb3\xe5\x96\x07\x45\x7a\xc1\x1a\x1e\xad\x5a\x67\x2d\x5e\x7b\xb3\x75\x6b\xd9\x35\x80\xe8\x18\x8b\x95\x78\x8d\xad\x38\x2a\xa2\x68\xbe\xe0\x34\x13\xef\x8d\xed\x87\x64\x2c\x99\xab\x5e\x0f\x48\xa8\xc8\x16\x31\xcd\xc4\x83\x8e\xb7\xcb\x15\x2f\x75\xe0\xde\xd2\x2e\x5a\x14\x9d\xd3\xd2\xc1\x0c\x05\x36\x5a\xcb\xea\x46\x52\x98\x33\x87\xec\x18\x33\xd6\x9b\x1a\xf7\x58\x71\x01\x90\xfb\xc1\x32\x87\x08\x0a\x98\x4e\xeb\x38\x3c\x52\x19\x79\xc3\xdb\xe3\x93\x97\xb5\x16\x0e\x21\xae\x8f\x95\x9e\x83\xf1\x06\x9f\x6e\x9e\x66\x1c\xcf\x60\x5c\x13\xda\x11\x1e\xc5\x6a\x20\xa6\x2e\x74\x4f\x99\x20\x1f\x7e\x3e\xbf\x20\x8c\xcf\x5e\xa6\xed\xfc\x0e\x05\x2f\x08\x15\xda\x93\xbd\x68\x1b\x2b\x27\x91\xb5\x01\xf2\xdb\xb9\x75\x4e\x21\x4f\x27\xa8\x4d\xab\x04\x57\xe6\x2a\xbb\xfb\x9b\x0f\x09\x47\x03\x08\x84\x89\xb9\x8e\x1d\x83\x53\x88\x10\x66\xee\xe8\x16\x51\x14\xae\x1a\x02\x96\xf7\x19\xe4\x22\x8c\xd3\x58\x36\x4d\x20\xe6\x07\x3d\x8b\x74\x28\x0b\xb6\xf7\x3c\x72\x9f\xbb\xb5\x31\x0e\x83\xdd\x69\xad\x68\xce\x27\x37\x95\xdc\x50\x17\x3d\x30\xb8\x61\x7c\x15\x58\x83\x4a\x9b\x04\xc5\x2e\x23\xb6\xdc\x70\xc1\xda\x05\xe5\x67\xfd\x33\xfe\xca\xe6\x5b\x3d\x89\x0f\xb7\xed\xd2\x17\x12\xa8\xd5\xce\x41\x42\x5d\xc3\x80\x89\x80\x21\xc8\x96\xb8\x22\xf2\x36\xf5\x9f\x4f\x76\x28\x48\x25\xf3\x14\x92\x52\x02\xbd\xcc\xd2\x8e\x01\x89\xe8\xdb\x48\xde\x5a\x58\xca\xb7\x6d\x01\x12\xea\x11\x04\x08\x0f\x33\x28\xe6\x88\x27\x98\x3d\xe8\x50\x6f\x3a\x24\x38\x22\x10\x94\xe3\x6d\x78\xe5\x21\x65\x14\x4b\x68\x2a\x68\x25\x96\x7e\x10\x94\xb1\x89\x2a\x37\x3c\x13\xd5\x04\x4d\x6b\x01\x76\x90\x33\xb0\x64\x4d\x03\x35\x60\x50\xb4\x45\x91\x01\x5e\xad\x75\xb1\xa2\x14\xc9\x8b\xc0\xe8\x73\x0f\x00\x77\x21\x92\x21\x74\xdc\xc0\x7a\x00\x7f\xcd\xd3\x31\xa2\xcb\x6c\xc6\x69\x5b\x05\xbd\xeb\x3a\xf8\x61\x9f\x8f\x11\xc5\xe0\x5a\x8d\x33\x86\x78\x36\xc0\x6d\x30\x5a\x4d\xd4\x44\x3f\xb8\x7e\xaf\x59\x4c\x0f\xa2\x0f\x5c\x87\x7d\x8e\x05\x92\x0a\x33\x3e\x50\xab\xd3\xad\xab\xb7\xd4\xab\x7d\xad\xf9\xa7\x8e\xf4\xb0\xec\xc1\x86\x49\xb6\xe8\x4f\x8f\x05\x28\x47\x02\xf8\xcb\xcb\x04\xff\x18\x12\x8b\x41\xa0\x66\x37\x7e\xe2\x61\xcf\x45\x24\xc0\xc1\x3a\xf5\xf1\x60\xbc\x93\x97\x6d\xbd\x4a\xaa\xa7\x84\x35\x89\x0b\x2d\x30\x94\x93\x91\x17\xa2\xea\xca\x2c\x43\x1f\x4c\xbe\x43\xb4\x63\x18\xa0\x6d\xc6\x70\x82\xbf\x91\xff\x32\x83\xe2\x58\xa9\x18\x79\x21\xae\x95\x7d\xf3\xd1\xbc\xd9\xb3\x58\x7f\x34\x33\x55\x66\x7b\x17\x88\x80\x3b\x28\x6b\x17\x4c\xaf\x97\x13\x77\x86\xa1\x48\xdc\xec\x90\xef\xa9\xd3\x18\xc8\xad\x16\xb8\xcd\x39\xa8\xc2\x33\xc7\xd4\xd3\x73\xd5\xea\xaf\x9d\xcd\x00\xe8\xcf\xd7\xc1\x02\x70\x2c\x97\x79\xfd\x41\x0b\xf9\xc0\x5b\x38\xaf\x2e\x7b\xdd\x38\xf9\x2b\x4e\xb2\x10\xa2\xd0\xf7\x7a\xd3\x98\xdf\xbd\xba\x20\x16\xdb\xc3\xef\xcf\x6e\x30\x66\xd9\x0d\x24\x05\x05\x3b\xe2\x51\x0c\x64\x34\x40\xfc\x45\xc8\x1c\xdd\xe8\xf5\x79\x3d\xc8\xcc\x7e\x51\x6e\x7c\x4e\x76\x48\x77\xb0\x78\x07\x95\x90\x4d\xad\xad\xe5\x34\x30\x7a\xef\xcc\xa1\xcc\xf4\xff\x7e\x8d\x4d\x4e\xad\x8b\x72\x63\x5a\x45\x9b\xb1\x3d\x98\xa6\x87\x7e\x55\x74\xa0\xeb\x22\xe2\x57\xb8\x9e\x21\x00\x7c\xd3\xab\x25\xd8\x2c\xf3\x75\xbb\xf4\x7f\x61\x55\x3b\x1f\xda\xbe\x64\xd3\x54\xd2\x87\x84\xc3\x7d\xb4\x6b\xf3\xcb\xc5\x24\x80\x65\xc2\x7d\x3e\xba\x98\x04\xd0\x1f\xba\x3f\x2a\xee\x64\x88\xa2\x53\x05\x54\xaa\x8e\xdb\xd2\x0d\x43\xd8\x8f\x84\x37\x78\xb1\x00\xca\xe1\x19\x99\x54\x42\xc6\xff\xab\x0f\x03\xe0\x25\x82\x33\x1a\x98\x49\x78\x6f\xcf\x2a\xa9\xe6\xd9\x22\x86\xa9\xe5\x29\x30\x83\x9b\x06\x2d\x93\x2a\x3c\xac\x71\xc1\xcb\xa4\x88\xab\xf0\x68\x33\x1b\x66\x6e\x8f\xbb\x97\x1f\x60\xb3\x60\xd9\x10\xd4\x19\x31\xc5\xb0\xf9\x5d\x8d\x59\xd1\xc9\xd0\x5a\x2c\x4c\xaa\xda\x4d\xf6\x20\xf3\x0f\x76\x83\x9e\xce\x52\x3b\x52\x26\xed\x7a\xc1\xb8\x4b\xa2\xaf\x60\xa9\x2c\x59\x70\x51\xcd\x8b\x85\x4e\x23\x1b\xab\x3d\x6b\x47\xb4\x33\x92\xeb\xd8\x25\x87\xe1\x1a\xc0\x63\x9b\x38\x26\xd8\xb5\x94\xd7\x18\xa2\x9e\xc6\xce\x67\x9d\xd5\x64\x39\x19\xa7\x4e\xba\x12\xd7\x1c\xb1\x6c\xca\xad\x92\x15\x19\xa7\xc1\xf7\x15\xa2\xbe\xeb\xdd\x2d\x17\x62\x40\x1e\x2c\xfa\xeb\x06\x0a\x30\x88\x54\xdd\x52\xe5\x90\x0a\xc0\xbf\x42\x6b\xfd\x11\xaf\x08\x7f\x55\xe2\x52\xd8\xc5\xf6\xb4\xb6\x7e\x5c\x5f\x05\x1c\x5b\x47\x03\x0f\x1a\x2a\xdb\x3b\xdf\xcc\x82\x25\x72\xee\xe7\xb9\x88\xa5\x47\x6c\x7d\x0c\x37\x27\x4b\x7d\x97\xe8\x6a\x5d\x18\x44\xf4\x1b\xd9\xaf\xcf\x7d\x4e\x38\xa9\x91\xe1\x1c\x6c\x53\x99\xe7\xf8\x9e\x57\x9d\x27\x57\xc1\xa5\x5e\x36\x15\xba\x4f\x6d\xe7\xcc\xb9\xcc\x00\xc0\x13\x77\xd0\x71\xec\xda\xac\x92\x38\x3b\x70\x5c\xe4\x35\x2f\x12\x69\xeb\x5b\x8d\x5f\x00\xae\xad\x81\x28\x6f\xb5\xcf\xbc\xa7\xba\x0e\x95\xe0\x1d\xf5\xb8\x7d\x82\x95\x58\x07\xd8\x15\xdb\x7d\xe5\x9c\x63\xb3\x20\x21\xd2\x17\x78\xdf\x37\xfc\x2a\xeb\x89\xa7\x78\x71\xf0\xa1\xd4\x44\x11\x66\xa8\x95\x52\x07\x9f\xf4\x3e\xb8\xbe\xf6\x5f\xb0\x3d\x77\x01\x1c\xe2\x27\x75\xd7\xc1\xf8\x6c\xfe\x86\x6d\xf1\xc4\x57\xfe\x1b\x5c\x4b\x7d\x00\x4f\x82\xee\xc9\xf3\xe7\xc4\xea\xfd\x50\xa0\x38\xdc\x8b\x9f\x3f\x27\x7a\x0a\xb7\x50\x45\x74\x5a\xee\x8e\x65\x5d\x6e\x6b\x29\x11\xce\x1b\x17\x9d\x7e\xca\x65\x7a\x27\xa9\x6a\x1a\xb7\x84\x7a\x3f\x09\x7c\xd2\x47\xda\x25\x7d\x64\xfd\xd4\x47\xce\x41\x7d\x54\xc9\x3a\xfb\x53\x42\x65\x5c\xe6\xf9\x68\x99\x67\xcb\x2f\xa3\xd5\x55\x6e\x7e\xe8\x46\x57\xe5\x7d\x61\x7e\x6d\x37\xe6\x2f\x18\x43\xf3\x0b\x43\xb4\xbf\xb6\x6a\xd4\x8e\x68\xd4\x0e\x07\xbe\x3d\xc5\x8d\x1c\xe1\x71\xa9\x46\xc6\xb9\x78\xf4\x45\x3e\xea\x76\xbf\xc8\xc7\x0d\x12\x90\xa1\x60\xbb\x19\x59\x37\x8c\xb5\x2c\xb6\x24\x30\x0a\x0a\xa1\xa8\xb0\x50\x54\x2c\xc4\xe0\xda\xcc\x5e\x3e\xb1\xe2\x05\x2d\xcc\x7e\xa3\xb6\x59\x74\x67\x62\x51\x78\x5f\xc4\x7f\xc0\x61\x70\x7e\x59\x5f\x6e\xdf\xbc\x7e\xf3\xe6\xf2\xe1\xd5\x6c\x31\x6e\x7a\xcf\xcf\x74\xa0\xb1\x4d\x55\x3e\x3c\x0e\xa1\x34\x6e\xc5\xae\x07\x82\x36\x30\xa4\x48\x08\xa6\x16\xdc\xf8\xb0\x14\xe0\x07\x82\x2c\x96\xa2\x36\xc4\xb9\x9f\x00\x7f\xc1\xc0\xce\xfb\x4e\xfc\x24\xa5\xe3\x06\xad\xf3\x7c\xe5\x5c\x35\xfa\x2d\x40\x9b\xc1\xb4\xb8\x51\xc8\xf0\x0f\x84\x52\xf8\x3b\x1e\xf3\x0c\x9c\xdc\x6d\x99\xaf\x7e\x81\x72\x28\x98\x11\xdb\x49\x1d\x9c\x38\x5d\x3d\xfe\x9e\x66\x6a\x3c\x8e\xed\x93\x4e\x13\xc2\xcf\x9d\x03\xa9\xe8\xb8\x93\x3a\x91\x0b\x6c\x53\x44\xe0\x92\x74\xee\xbd\x5c\xc5\x2b\xfd\xed\x1b\xdb\x91\x58\xeb\x47\x13\xbc\x44\x3c\xf0\xf3\xc9\x32\x5d\xcb\x1c\xd6\x70\xe2\x23\x02\xf1\x43\xf8\x7d\xaf\xbf\xbf\xf7\xa1\xf5\xf5\x27\xef\xb7\x6b\x59\x65\x4b\x31\x14\x97\x0b\x6b\x4e\xa5\xbb\x0c\x02\x4e\x07\xfe\x42\xed\xd6\x08\xb0\x63\x47\x59\xfd\x3e\x7d\x4f\xe5\x71\xc0\xaf\xc1\x7e\x04\x9d\x57\xd9\xba\xd3\xbe\xdd\x80\x20\x82\x18\x95\x1d\x67\x89\x7f\x68\x33\xf8\x3d\x27\xee\xab\x16\x00\x56\xf2\x3a\x2b\x64\x14\x99\xbf\x93\x74\xbd\x72\xbf\x29\x31\x8e\x46\x84\x87\x0e\x94\xbe\xb7\x73\x0b\x9d\xff\x42\x24\x83\xcf\xff\x44\x4d\xfe\x4f\xfc\x7e\xe6\x2e\x3b\xac\xce\x59\x59\x5c\xe7\xd9\xb2\x1b\x4e\xc9\xbe\x3f\x9b\x3c\x03\xf5\x17\x45\x14\xbf\xfe\xa9\x74\xaa\x14\xd7\x96\x7f\x63\x1f\xff\x85\xb8\x21\x7b\x3e\x18\x0b\x3d\xac\x77\x36\x79\x26\xce\x19\x3f\xdf\xb3\xd3\xff\x33\x00\x49\x22\x74\xca\x83\x5d\x01\x00")
 
 func jsLibJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -334,12 +339,12 @@ func jsLibJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "js/lib.js", size: 89475, mode: os.FileMode(493), modTime: time.Unix(1609252321, 0)}
+	info := bindataFileInfo{name: "js/lib.js", size: 89475, mode: os.FileMode(509), modTime: time.Unix(1610636534, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _webTemplateDashHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x55\x4d\x8f\xdb\x2c\x10\xbe\xe7\x57\xcc\xcb\xe1\x3d\x95\xa4\xc9\x4a\x95\xda\x7a\x7d\xa9\xda\xd3\x76\x95\x4b\x0f\x3d\x8e\xcd\x64\x83\x8a\xc1\x02\x1c\x6b\x65\xe5\xbf\x57\x40\xbc\xb1\xb3\xf9\xee\xc9\x66\x9e\x67\x98\x87\xf9\x80\xae\x03\x41\x2b\xa9\x09\x98\x40\xb7\x66\xb0\xdd\x4e\x26\x5d\x07\x9e\xaa\x5a\xa1\x27\x60\x6b\x42\x41\x96\xc1\x34\x42\xd9\x7f\x9c\xc3\x73\x53\x15\x64\x1d\x70\x9e\x4f\x26\x99\x90\x1b\x90\xe2\x91\xe9\x64\xe5\x8e\x4a\x2f\x8d\x66\xf9\x04\x00\x20\xc2\xa5\x42\xe7\x7a\x06\x97\x9e\x2a\x16\x5d\x56\x46\x29\xd3\x92\xe5\xa5\x69\xb4\xdf\x79\xf4\x5e\xf9\x8f\x1d\xea\xb2\x59\x58\x8e\xc0\x7e\x4b\x81\x1e\x01\x59\x3e\xa0\x0c\x7f\xcf\x06\xb7\x92\xb4\x38\x1e\xfa\xb7\x69\xfe\xd7\x85\xab\xbf\x26\x0d\x97\x04\x14\xf7\x09\xe8\x4f\xff\x82\x52\xd3\x09\x25\xcf\xd4\x0e\x95\x5c\x91\x8d\xf2\xdf\xc4\x28\xe3\xfc\x71\x29\x4f\xc6\xf9\x1b\xb5\x88\xbb\xb4\x28\xe9\xfc\xa9\x7c\x3c\x45\xec\x52\x5c\xba\x2b\x6e\x7d\xf2\xe8\x4b\xe3\xfc\xc5\xc3\x1e\xed\x82\xdd\x27\x0d\xce\x77\x2d\x4e\x0c\x4f\x45\x1e\x79\x8d\x9a\x54\x3f\x37\x2b\x63\xab\x7d\xb0\x5f\xb5\x40\x4f\xe2\x0b\x64\xc5\x9e\xdf\x24\x23\x0f\xc3\x96\xcd\x8a\x3d\x3b\x55\x09\xde\xd6\x4b\xb2\xd2\x04\x67\x47\x8a\x4a\x1f\x77\x10\xf8\xca\xd3\xd2\xd8\xc1\x71\x63\x6c\x53\x87\x09\x86\x0d\xaa\x86\x1e\xd9\x82\xe5\x0f\x20\xf0\xd5\x65\xb3\x04\x9c\x65\x7f\x62\xf9\x1c\x5a\xa2\x3f\x57\xb1\xe7\x0f\x2c\x5f\x44\xfa\x75\xbb\x2f\x3e\x06\x31\x37\xf0\x3f\x07\x39\x95\xd1\x7e\xfd\x9e\x9f\xcd\x52\x02\xfa\x82\xa5\x94\x8f\x2a\xf6\x53\x0a\xa1\xe8\xb0\x58\xd1\x38\xb8\xe8\x92\x7f\xe0\x7f\x5b\xa3\xf5\x30\x8f\x0e\x87\x4d\x52\x06\x8c\xb7\x16\xeb\x3a\x5c\xa8\xe3\x0b\x30\x60\xc3\xae\x2b\x51\x6f\xd0\x8d\x49\xb4\x21\xed\xb9\x23\x2b\xc9\x85\x8a\x27\xce\xa8\xdd\x0e\x95\x2c\x6e\x57\x12\xfa\xff\x3a\x3d\x89\x7a\x5e\xcf\xdb\xb7\x4f\x5e\x8b\xd2\xef\x3a\xbd\x97\x13\x4d\xca\xa0\x60\xf9\x52\x11\x3a\x82\x60\xf9\x00\xc1\x24\xf5\x0b\x84\xe9\x9a\x4e\xa7\xef\x46\x69\x58\x9c\xf1\xa3\xb5\x32\xc6\xef\x1f\xad\xae\x03\xd2\x02\xb6\xdb\xbf\x01\x00\x00\xff\xff\xa8\x1d\xc1\x33\xee\x06\x00\x00")
+var _webTemplateDashHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\xcd\x6e\xe3\x36\x10\xbe\xfb\x29\xa6\x3c\xf4\x54\xd9\x8d\x03\x14\x68\x22\xeb\xd2\xa6\xa7\x34\x1b\x60\xb1\x58\xec\x71\x2c\x8e\x6c\x62\x29\x52\x4b\x52\x36\x0c\xc1\xef\xbe\x20\x29\xd9\xb4\x36\x4e\x1c\x07\x30\x40\x6b\x7e\xbe\xf9\x66\x38\x33\x52\xd7\x01\xa7\x4a\x28\x02\xc6\xd1\xae\x19\xec\xf7\x93\x49\xd7\x81\xa3\xba\x91\xe8\x08\xd8\x9a\x90\x93\x61\x30\x0d\xaa\xfc\xb7\x2c\x83\xa7\xb6\x5e\x92\xb1\x90\x65\xc5\x64\x92\x73\xb1\x01\xc1\x17\x4c\x45\x69\x66\xa9\x74\x42\x2b\x56\x4c\x00\x00\x82\xba\x94\x68\xed\x60\x91\x09\x47\x35\x0b\x2e\x95\x96\x52\x6f\xc9\x64\xa5\x6e\x95\xeb\x3d\x06\xaf\xe2\xbf\x5e\x6b\xf3\x19\x17\x9b\x53\xe5\x00\xc9\xd1\x21\x20\x2b\x12\x93\xf4\xef\xab\xc1\x8d\x20\xc5\x5f\x0e\xfd\x4d\xb7\xbf\xab\xa5\x6d\xee\x23\x87\x04\x72\x0c\x1b\x08\x2c\xaf\x23\x30\x64\xbf\x42\xa1\xe8\x0c\x93\x27\xda\xa6\x4c\x2e\xa8\x46\xf9\x31\x32\x52\x5b\xf7\x32\x95\x47\x6d\xdd\x3b\xb9\xf0\xab\xb8\x48\x61\xdd\xb9\x7a\x3c\x06\x5d\x82\x34\x46\x0b\x71\xe9\xaa\xb8\xcd\xd9\xd4\x9f\xb5\x75\xf6\xaa\x2e\xe8\x8f\x38\x38\x0f\x8a\x9f\x19\x9e\x9a\x1c\x66\x0d\x2a\x92\x7d\xe8\xbc\xd2\xa6\x3e\x06\xfb\xd2\x70\x74\xc4\xef\x20\x5f\x1e\xed\xdb\x28\xcc\xfc\xb0\xe5\xb3\x65\x42\xcd\x36\xa8\x8e\x76\x2b\x6c\x6c\x0f\x0e\xd6\xed\x24\x2d\x18\x17\xb6\x91\xb8\xbb\x03\xa5\x15\xdd\x27\xe9\xfa\x5f\xbc\xe4\x13\xd1\x93\x86\x90\x63\xa5\xcd\x29\x07\x8f\x3d\x8e\x3e\xf3\xe1\xcf\xb2\x91\xa2\x16\xee\x83\x74\x52\x06\x01\xef\x0d\x0a\x11\x02\x0e\xcf\xcf\x64\x84\xf6\xd5\xb4\x24\xa9\x74\x01\x8c\xe3\x2e\x8b\x8f\xda\x8c\x18\xe4\xba\xf1\x2b\x0d\x36\x28\x5b\x5a\xb0\x39\x2b\x6e\x81\xe3\xce\xe6\xb3\xa8\x78\xd5\xfa\x2f\x56\xdc\xc0\x96\xe8\xfb\x45\xd6\x37\xb7\xac\x98\x07\xf3\xcb\xd0\xe7\x7f\x7a\x32\xef\xb0\xff\xdb\xd3\xa9\xb5\x72\xeb\x5f\xed\xf3\x59\x2c\xc0\xd0\xc1\xb1\x07\x4f\x5a\xf8\x7f\xc1\xb9\xa4\x71\xf7\x06\x61\xb2\xf9\xa3\xbf\x6f\xf9\x7f\xd6\x68\x1c\xdc\x04\x87\xf1\xd4\x94\x5e\x97\x6d\x0d\x36\x0d\x99\xd1\x1a\x0a\xba\xe4\x1a\xf2\x12\xd5\x06\xed\xa9\x11\x6d\x48\xb9\xcc\x92\x11\x64\x7d\x07\x44\x9b\x81\x7d\x64\x3d\x62\x32\x7f\x3f\x13\xbf\x86\x2e\xe3\x13\x76\xc7\x1b\x7c\x0e\xe7\x50\xbc\x2d\x1e\xc7\xa1\xa7\x13\x44\x52\x23\x67\xc5\xb3\x24\xb4\x04\x5e\xf2\x07\x78\x91\x50\xab\x30\x8a\xd3\xe9\x74\x80\xea\x3a\x10\x15\x4c\xb9\x58\x91\x75\xfe\x35\x7d\xb8\x98\x28\xea\xd1\x7b\x1e\xfe\x52\x53\xa5\x7f\x4e\x12\x7b\xa8\x51\x48\x88\x8e\xa3\x01\xe9\xed\x0d\xfd\x68\x49\x95\x3b\xf6\x6a\xa3\xe9\xaa\x62\xc5\xa7\xaa\xba\xa8\x2b\x39\x0a\xb9\x63\xc5\xbf\xfe\xb8\xc8\xc1\x37\xbc\xf7\xf8\x1a\xce\xb7\x3a\xf9\x85\x2d\x92\x0b\xd5\xb4\x0e\xdc\xae\xa1\x05\x23\x9f\x33\x4b\x93\xec\x25\x8d\xc4\x92\xd6\x5a\x72\x32\x0b\x16\x2b\x83\x9c\x1b\xb2\x36\x49\x3e\x5f\xb6\xce\x69\xd5\x63\xd9\x76\x19\xf6\xd1\x67\xdc\x50\x3e\x8b\xaa\xf3\x2c\x0e\xab\xb1\x8f\x5b\xdb\x15\x2b\xd2\xed\x35\x1a\xc2\xae\x03\x52\xfc\xf8\x29\xe6\xdf\x28\xe9\x48\x9e\x7e\xbb\x55\x5a\xbb\xe3\xb7\x5b\xd7\x01\x29\x0e\xfb\xfd\xcf\x01\x00\x7b\x13\xe1\x4c\xf5\x09\x00\x00")
 
 func webTemplateDashHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -354,12 +359,12 @@ func webTemplateDashHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "web/template/dash.html", size: 1774, mode: os.FileMode(493), modTime: time.Unix(1609630050, 0)}
+	info := bindataFileInfo{name: "web/template/dash.html", size: 2549, mode: os.FileMode(509), modTime: time.Unix(1792316755, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _webTemplateDayHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x4d\x8f\xd3\x30\x10\xbd\xe7\x57\x0c\xc3\x0a\x81\x44\x36\x88\xbd\x2d\x8e\x4f\xcb\x9e\x00\x71\x58\x71\x77\xd7\x93\xc6\x22\xb5\x2d\x7b\x9a\x6e\x14\xf5\xbf\x23\x3b\x65\x9b\xb4\x15\x3d\xd0\x4b\xe3\x99\x37\x6f\xbe\x9e\x3d\x8e\xa0\xa9\x31\x96\x00\xb5\x1a\x10\xf6\xfb\xa2\x18\x47\x60\xda\xf8\x4e\x31\x01\xb6\xa4\x34\x05\x84\xdb\xec\x12\x6f\xca\x12\xbe\x1b\xad\x3b\x82\xb2\x94\x45\x21\xb4\xe9\xc1\xe8\x1a\x37\xd9\x58\x46\x7a\x66\xe3\x2c\xca\xa2\x00\x00\x10\xed\x9d\x7c\x50\xa6\x1b\x80\x7a\xb2\x1c\xa1\x71\x01\xc6\x11\x6e\xb5\x1a\x22\xec\xf7\xa2\x6a\xef\xfe\x42\x13\xd3\x73\xa7\x62\xac\x91\x42\x70\xa1\xdc\xc4\x35\x4a\x51\x69\xd3\xcf\x21\x39\x19\xb1\x2a\xbd\xb2\xd4\xa1\xcc\x9e\xec\x6d\x5c\xd8\x1c\x8f\xd9\x64\xac\xdf\x32\xf0\xe0\xa9\xc6\xd6\x68\x4d\x16\xc1\xaa\x0d\xd5\x18\xa9\xa3\x67\x26\xfd\xa0\x98\x30\x93\x2e\x2d\xbd\xea\xb6\x54\xe3\xac\x56\x84\x6a\xc9\xfe\xcd\x44\xbe\x87\x65\xc2\x89\x24\xf3\x75\x26\x72\x39\x9d\x5d\xc0\x65\x68\xfa\x8d\x23\x04\x65\xd7\x04\x37\xbf\x69\xf8\x08\x37\x39\x23\xdc\xd7\x70\x9b\x22\x9f\x06\x4f\x29\xeb\x59\x58\x4e\xe3\x7c\x9a\xf2\xac\xc8\xc4\x91\x6a\x94\xe9\x7b\x62\x4a\xd3\x9d\x70\x17\x73\x93\xd5\xa7\xf4\xa2\x9a\xca\x9d\xcd\xb4\x3a\x0e\x75\xb1\x89\xa4\x83\x27\xb5\x3a\xc8\xe0\x74\x7f\xb9\x75\x4e\xee\x72\x17\x94\xf7\x34\xef\x5f\x64\xc7\x39\x74\xda\xc2\xa4\x93\x83\xe5\x64\x9b\x9c\xc4\x78\xde\x8c\xe0\x70\x6e\x3c\x04\xc8\x77\x76\x15\xfd\x17\x51\x71\xfb\x5f\x98\x5c\x5b\xe3\xba\xce\xed\x7e\x51\x58\xa1\x7c\xcc\xdf\xef\x63\x45\xfa\xc3\xbf\xd9\x1f\x83\x21\xab\xe3\x15\x50\xa6\xa3\x70\x05\xf6\xb4\x23\xe2\x2b\x98\x24\x4b\xd2\x97\x31\xa2\x3a\x9d\x55\xc2\x9d\x4d\x55\xf0\xca\xe9\xe1\xb0\xeb\x19\x72\xb2\xce\x0c\x69\x4b\x17\xd4\x31\x93\x82\x57\x6b\x2a\xad\xea\xe7\x02\x50\xd0\x06\x6a\x6a\x7c\x3b\xad\x5c\xab\xa1\xcc\x32\xf0\x81\x7a\x5c\x04\xae\xb6\xcc\xce\x22\x68\x95\x2f\xfc\x9a\x6a\xfc\x84\xf2\x67\xa0\x5e\x54\x4a\xc2\x75\x4a\x4b\x2f\x7c\x95\xf2\x33\xca\x1f\xf4\xc2\x89\x72\xd1\xcb\xeb\x7f\x52\xfb\x57\xab\x17\x2f\xdf\xf2\x99\x6c\x9c\xe3\xe3\x33\xf9\x7a\xbd\xfe\x04\x00\x00\xff\xff\x5d\xa7\x77\xca\x5f\x05\x00\x00")
+var _webTemplateDayHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x51\x6f\xdb\x38\x0c\x7e\xcf\xaf\xe0\xe9\x8a\x43\x8b\x3b\xc7\xed\x75\x7b\xe9\x64\xbf\xac\xeb\xd3\x56\x14\x68\xbb\x77\x25\xa2\x63\x61\x8e\x64\x48\x4c\x52\xc3\xc8\x7f\x1f\x24\x3b\x8d\x9d\x74\x71\x02\x04\x32\x90\x98\xfa\x3e\x92\x26\x3f\xd3\xaa\x6b\x90\x98\x29\x8d\xc0\xa4\xa8\x18\xac\xd7\xa3\x51\x5d\x03\xe1\xbc\x2c\x04\x21\xb0\x1c\x85\x44\xcb\x60\x1c\xb6\xf8\x5f\x51\x04\x3f\x94\x94\x05\x42\x14\xa5\xa3\x11\x97\x6a\x09\x4a\x26\x6c\x1e\x8c\x91\xc3\x29\x29\xa3\x59\x3a\x1a\x01\x00\xf0\xfc\x36\xbd\x17\xaa\xa8\x00\x97\xa8\xc9\x41\x66\x2c\xd4\x35\x8c\xa5\xa8\x1c\xac\xd7\x3c\xce\x6f\x37\x50\xef\x69\x5a\x08\xe7\x12\x86\xd6\x1a\x1b\xcd\xdd\x8c\xa5\x3c\x96\x6a\xd9\x85\x84\x60\x48\x22\x2a\x85\xc6\x82\xa5\x61\xc7\x5f\x3c\x33\x76\xbe\xbd\xf5\x8b\x2b\x5d\x2e\x08\xa8\x2a\x31\x61\xb9\x92\x12\x35\x03\x2d\xe6\x98\x30\x87\x05\x4e\x09\xe5\xbd\x20\x64\xe1\x09\xfa\x96\xa5\x28\x16\x98\xb0\x4e\xae\x0c\xe2\xbe\xf7\xef\xca\xd1\x1d\xf4\x03\x36\x4e\x82\xbf\x42\x39\x8a\x9a\x7b\x63\x3b\x79\x6e\x56\x5d\x83\x15\x7a\x86\x70\xf1\x0b\xab\xff\xe0\x22\x44\x84\xbb\x04\xc6\x9e\xf9\x52\x95\xe8\x2b\xb4\x47\xf3\x17\x37\xa5\xaf\x72\x27\x49\xef\xc3\xe7\x98\xfa\xff\xc1\x1c\xaa\xdb\xe0\x3e\x8c\x8d\x5a\xee\xba\xe7\x71\x93\xee\x16\xcf\xe3\x6d\x51\xbb\x9d\xa8\x6b\x50\x19\xcc\x08\x2e\x0b\xd4\x30\x76\x5a\x94\x2e\x37\xe4\xae\xe0\x66\xe3\x34\x48\xe5\x79\xb3\x01\x97\xd3\xdc\x3f\xac\x83\x49\x05\x25\x5a\x65\x24\x98\x0c\x28\x47\x90\xa2\xba\x0a\x62\xda\x55\x81\x2f\x43\x44\x62\x52\x60\xb4\xb2\xa2\x2c\xb1\x5b\x45\x1e\x36\xf6\xa1\x6d\x2f\x37\x71\x5b\xe3\x96\xe7\x17\x27\xaf\xea\xbe\xcd\x2f\x4e\x76\xdf\xd8\x12\xd2\x67\xa5\xa7\xc8\x63\xca\xff\x0c\x79\x30\x45\x61\x56\x68\xdd\x51\x30\x79\x18\xf5\xaa\xb3\xa3\x70\x0f\x56\xa1\x96\x43\x21\x03\x68\xc8\xd5\xab\xce\x0e\xe2\x78\xbc\x5b\x20\x1e\x7f\x50\x4a\x4e\x13\x23\xab\x0f\x45\xd7\x08\x7e\xab\x97\x5d\x05\x0e\x74\x41\x7a\x79\x8f\x9f\x82\x7c\x9e\x49\x58\x1a\x3f\x18\x3b\x17\x04\xec\xe6\xf3\xdd\xf5\x27\x3f\xbe\x78\x4c\xf2\x30\x7d\xd3\xa5\xaf\x66\xa1\x69\x90\xf1\xaf\x8f\xf8\x88\xab\xd3\x58\x51\xcb\x7a\x6f\xe2\x91\x3c\x4f\x6b\x1b\x7a\x62\x7a\xa7\x90\x3a\xd9\xb5\xfd\x3e\xcc\xdb\xef\xfb\xa1\x19\xb2\xd3\x7d\x1e\x87\x57\xb0\x37\x43\xfa\xf4\xed\xb8\x78\xf1\xc8\x73\xcf\x82\xe6\xcb\x73\xbe\x41\xf0\x8f\x9e\xb8\xf2\xcb\xe1\x37\xe9\x08\x4c\x98\x53\x8d\x3a\x7e\xa2\x9d\xb0\x76\x2c\x5c\xba\x18\xe5\xd5\x39\x5e\xf9\x56\x78\x03\xb0\x97\x15\x22\x0d\x60\xfc\x87\xee\x4c\x33\xe1\x74\xad\xec\x49\xa1\x14\x33\x8c\xb4\x58\x76\x05\x20\x20\xb7\x98\x25\xec\xef\xa6\xe5\x52\x54\x51\x90\x41\x69\x71\xc9\x7a\xc4\xc9\x82\xc8\x68\x06\x52\x84\x23\xc4\x0c\x13\x76\xcd\xd2\x27\x8b\x4b\x1e\x8b\x14\x86\x5d\x6a\x7c\xa3\x41\x97\xff\xb3\xf4\x11\xdf\xc8\xbb\xec\x3d\xcb\xfb\xaf\x57\xfb\x37\x2d\x7b\x67\xa9\xfe\xc1\x2b\x33\x86\xb6\x07\xaf\xba\x06\xd4\x12\xd6\xeb\xdf\x03\x00\x14\x49\x9c\xcf\xb1\x09\x00\x00")
 
 func webTemplateDayHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -374,12 +379,52 @@ func webTemplateDayHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "web/template/day.html", size: 1375, mode: os.FileMode(420), modTime: time.Unix(1609630073, 0)}
+	info := bindataFileInfo{name: "web/template/day.html", size: 2481, mode: os.FileMode(436), modTime: time.Unix(1792317840, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _webTemplateErrorHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x90\xbd\x6e\xc4\x20\x10\x84\x7b\x3f\xc5\x88\xfe\xec\xe2\x5a\xe2\x2e\x45\xea\x3c\x81\x75\x3b\x3e\x23\x61\xd6\x5a\x90\x1b\xe4\x77\x8f\xc8\xcf\xc5\xa7\xa3\x41\xfa\x98\x61\x76\xa7\x56\x08\xe7\x90\x08\x47\x33\x35\x87\xe3\xe8\x6a\x45\xe1\xba\xc5\xa9\x10\x6e\xe1\x24\x34\x87\xbe\xbd\x74\x5e\xc2\x8e\x20\x6f\x6e\x0d\x22\x91\x97\xcc\x5b\x09\x9a\xdc\xd8\x01\x80\x5f\xae\xe3\x7b\xfb\xc6\x0f\xcb\xf5\x17\x6d\x3f\x77\x3b\xb5\xa2\xbf\xa9\x10\xc7\x81\xcb\x89\x86\x19\xfd\x9a\xef\x2d\xe0\x2c\x7d\x45\x8c\x99\x67\xf4\x91\x0a\x2d\x4d\x11\x99\xb6\xd3\xf0\xbd\xc2\x93\x21\xc9\x9f\xde\x0f\xdb\xeb\x44\x9f\x24\xa2\xde\x33\x66\x35\xac\x6a\x84\xb0\x4c\x21\xe6\xfe\xdf\xe3\x07\x09\xfb\xd8\x3d\xb7\x32\xab\x96\x47\x2b\x8f\xa0\xaf\x00\x00\x00\xff\xff\xaa\x25\x55\x6f\x4e\x01\x00\x00")
+var _webTemplateDigestHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x5f\x8f\xdb\x36\x0c\x7f\xcf\xa7\xe0\x3c\x6c\xe8\x61\x17\x3b\xcd\x6d\xc0\xcd\x56\x8c\x15\xed\x8a\x16\xe8\xda\xc3\xd6\x3e\xdc\xa3\x6c\xd1\xb1\x06\x59\xf2\x24\xa5\xb9\x9b\xe0\xef\x3e\xc8\xb6\x6c\xe7\xfe\xed\x80\x4a\x01\x22\x93\x14\xf9\x23\x45\xfe\x9c\x03\x86\x15\x97\x08\x11\xe3\x7b\x34\x36\x82\xae\x23\xdf\xbd\xf9\xf4\xfa\xf3\xf5\xd5\xef\x50\xdb\x46\xe4\x2b\x12\xfe\x90\xb2\x7c\x05\x00\x40\x1a\xb4\x14\xca\x9a\x6a\x83\x76\x17\x1d\x6c\xb5\xbe\x8c\x46\x95\xe5\x56\x60\xee\x1c\xc4\x7f\x1d\x8a\xbf\xb1\xb4\xde\x63\x32\x48\x57\x24\x19\x9c\x90\x42\xb1\x5b\x30\xf6\x56\xe0\x2e\xaa\x94\xb4\xeb\x8a\x36\x5c\xdc\xa6\xf0\x0e\xc5\x57\xb4\xbc\xa4\xe7\xf0\x4a\x73\x2a\xce\xc1\x50\x69\xd6\x06\x35\xaf\x32\x28\x95\x50\x3a\x85\xef\x2f\xfa\x95\x41\x43\xf5\x9e\xcb\x14\x36\x19\xb4\x94\x31\x2e\xf7\x29\x6c\x37\xed\x4d\x16\xe5\xab\x01\x4e\xbd\x3d\x09\x73\x44\xbe\xaf\x6d\x0a\x52\xe9\x86\x8a\x6c\x04\xed\x37\xa1\x50\x6b\xac\x76\x51\x6d\x6d\x6b\xd2\x24\xb1\x47\x6e\x2d\xea\xb8\x54\x4d\xe2\xd3\xf9\x62\x50\x4b\xda\x20\x74\x5d\x14\x7c\x06\x3c\x2f\x19\x7d\x59\x6d\xb3\x28\xff\xed\x8e\x25\x49\x68\x7e\x0e\x5e\x78\x85\x9a\x2b\x06\x5d\x37\xe0\x4a\xea\x6d\x80\xd8\xce\x20\xde\x2a\x21\xd4\x11\xb5\x49\x81\x14\x7d\x11\x83\xe4\xb5\x3a\xc8\xa1\x94\xc5\x6c\xfe\xe2\x27\x6f\xf2\x11\x8f\xd3\xbd\x38\xd8\x41\x02\x6b\xaf\xfc\xa0\x8c\xbd\xaf\x3d\x9b\x5c\xfc\x28\x0b\xd3\x66\xd3\xe7\xb5\x3a\x0c\x92\xe1\xce\x0c\x43\x73\x94\xec\x7f\x40\xf4\x36\x8f\x40\xb8\xa3\x3b\x1b\xab\xd0\x8e\x45\x70\x0e\x2c\x36\xad\xa0\x76\x6a\xc5\xb5\xe0\xbe\x1f\x4f\xd2\x0b\xe5\x7b\xc2\xfc\x24\xe1\x67\xd8\xcf\xc0\x9f\xeb\x7c\xb6\x0e\xe6\xbc\x82\xf8\x55\xdb\x7e\xf9\xf3\x43\xf0\x41\xda\x7c\x6a\x28\xe7\x16\xda\xc7\x5b\xe7\x53\x8b\x12\x18\x35\x75\xa1\xa8\x66\xbe\x71\xfa\xf2\x8c\x21\x50\xb2\x29\x22\x69\xef\x3a\xf9\xb5\x5f\x19\xf4\x3d\x6e\xf8\xbf\x98\xc2\x26\xbe\xc4\x66\xd9\xe0\xd7\xea\x00\x1a\x4b\xe4\x5f\x11\x6c\xcd\x8d\x07\x1e\xbf\xd5\xf8\xcf\x01\x65\x79\x0b\x5d\x07\x03\x05\x40\xa5\x55\x03\x55\xff\xfe\x0d\x9e\xfb\x31\x97\x7b\x04\x6e\x41\x49\xb0\x35\xce\x18\xe3\xc5\x23\x92\xc4\x8f\x74\x3f\xe1\x3d\x5b\x2c\x20\xdf\x23\x99\xb1\x9a\x5d\x37\x96\x2e\x34\xc5\x38\xb1\x17\x4f\x4f\xac\x87\xfd\xd9\xd3\x89\x87\xfc\xc2\xb9\xf9\xfe\x19\x49\xea\x8b\x40\x43\xb4\x10\x18\x1c\x15\x4a\x33\xd4\xeb\x52\x09\x41\x5b\x83\x29\x84\xd3\xb2\x3e\xce\x81\xee\x33\x8d\xaf\xb4\xaa\xb8\xc0\xa9\x21\xfc\x8f\x58\x3d\x9b\xfa\x4d\x2c\x0b\xee\x27\xea\xf9\xb9\xbd\x81\xcb\xf6\xa6\xff\xdf\x2c\x7d\x87\x35\x26\x3c\x06\x78\xdf\xd0\xbd\xa7\x13\xc2\x9b\x3d\x18\x5d\xee\x22\xe7\xee\x29\x23\x38\x72\x66\xeb\x5d\x74\xb1\x8d\xa0\xee\x8b\x31\x9c\xa9\xb0\xbb\x28\xba\x93\xa2\xa6\x8c\x1f\x4c\x0a\xbf\x6c\x7e\xc8\xa2\x7c\x7e\x84\x00\xc0\x6f\x92\x58\x96\x9f\x4a\x1e\xc9\xe5\xa9\x1c\x16\x24\x77\xcf\xe4\x9b\xb8\x74\xe4\xf6\x1e\xbd\x0f\xf4\x8e\x9a\x8f\x23\x99\x0e\x94\x18\xbe\x92\x22\x9f\x27\xe3\x21\xe2\x7d\x08\x3a\x0a\xf3\x20\x64\x9f\x8e\xd7\xc7\xef\xdf\x3c\xa4\x7e\x4e\x25\x49\xb2\xec\x91\xd3\x1b\x24\xe9\x1b\x72\x1a\x67\x9f\xd8\x1f\x4a\x7b\x28\xa4\xcd\xe3\x38\xa6\x92\xf5\xe1\x47\x21\x34\x4a\xa3\x9f\xff\xd9\xcd\x74\x72\x0e\x50\x32\xe8\xba\xd5\x7f\x03\x00\xdc\xf2\xf1\x04\xbf\x07\x00\x00")
+
+func webTemplateDigestHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_webTemplateDigestHtml,
+		"web/template/digest.html",
+	)
+}
+
+func webTemplateDigestHtml() (*asset, error) {
+	bytes, err := webTemplateDigestHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "web/template/digest.html", size: 1983, mode: os.FileMode(420), modTime: time.Unix(1792316698, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _webTemplateDigestTxt = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\xc1\x4e\xeb\x30\x10\xbc\xfb\x2b\x56\x3d\x35\x7a\xad\xdf\xbd\x27\x10\x15\x02\xa9\x54\x15\xa2\x07\x8e\xa1\xde\x34\x96\x1c\xbb\xd8\x2e\x05\x59\xfe\x77\xb4\x8e\x93\x90\x52\x89\xde\xec\x99\xf1\xec\xec\x7a\x43\x00\x81\x95\xd4\x08\x13\x21\xf7\xe8\xfc\xdc\xe3\xa7\x9f\x40\x8c\x37\x21\x00\xdf\x3a\xb4\xba\x6c\x10\x62\x9c\x01\x01\x1b\xb4\xd2\x08\x88\x91\xb1\x7b\xa3\x94\x39\xa1\x75\x8b\xc4\x74\xd7\x3b\x73\xd4\x1e\x62\x84\xe9\x3f\x82\xd7\x78\xea\x85\xbc\xe7\xfe\xc3\x9c\xc8\x95\x71\xfe\x37\x5b\xb0\x57\x73\x84\x16\xcf\xde\x56\xa2\x16\x17\x9c\x13\x3e\xbc\x1c\xf9\x9e\x71\x05\x0b\x01\x3c\x36\x07\x55\xfa\x71\xb7\x73\x25\x9d\x9f\x8c\xa3\x42\x8c\x7f\xc9\x47\xe1\xaf\xd0\x0f\x79\xaf\x35\xef\xd5\x14\x5d\x56\xc0\x6f\x0f\x87\xed\xf3\x0a\x62\x5c\x96\xae\x7e\x33\xa5\x15\xed\x7c\x7a\x9c\x91\x12\x35\x7d\x10\xcd\xd0\xe2\x0e\xe5\x07\x82\xaf\xa5\xcb\x83\xc4\xf7\x23\xea\xdd\x17\x7d\x50\x5b\x16\x2a\x6b\x1a\xa8\x52\xe3\x0d\xce\x60\x57\x97\x7a\x8f\x20\x3d\x18\x0d\xbe\x46\x10\x5d\x2d\x3e\x98\x33\x76\x79\x71\x72\xfc\xd4\x1f\x05\xee\x86\x4f\x72\xfe\x22\xbd\xa2\x4d\x82\x69\x08\x03\x55\x2c\x88\xb4\xa9\x28\xdf\x58\x53\x49\x85\x8e\x54\x90\x3d\x7e\xec\xe0\xf9\x4e\x66\xc5\x43\xe9\xd6\x2d\xd0\x5a\xe7\x4b\xd1\xc7\xa5\x83\x72\x24\x20\x33\x32\xe6\x8f\xcb\x8c\x27\xc1\xd0\x59\xb6\x7c\x32\x96\xe4\x00\x9c\xf3\x52\x8b\xf4\xa4\xc3\x1a\x63\x71\xf4\xe0\xec\xc0\xbe\x07\x00\xaa\xb1\x53\x10\x55\x03\x00\x00")
+
+func webTemplateDigestTxtBytes() ([]byte, error) {
+	return bindataRead(
+		_webTemplateDigestTxt,
+		"web/template/digest.txt",
+	)
+}
+
+func webTemplateDigestTxt() (*asset, error) {
+	bytes, err := webTemplateDigestTxtBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "web/template/digest.txt", size: 853, mode: os.FileMode(420), modTime: time.Unix(1792316698, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _webTemplateErrorHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\xb1\x6e\xc3\x30\x0c\x44\x77\x7f\xc5\x41\x7b\xec\x21\xab\xea\xad\x43\xe7\x7e\x81\x11\x9e\x63\x01\xb2\x14\x50\x82\x17\x42\xff\x5e\xb8\x4d\x1b\x1b\x28\x34\x08\x78\xe4\x91\xc7\x33\x83\x70\x0e\x89\x70\x54\xcd\xea\xd0\x5a\x67\x86\xca\xf5\x11\xa7\x4a\xb8\x85\x93\x50\x1d\xfa\xbd\xd2\x79\x09\x1b\x82\xbc\xb9\x35\x88\x44\x5e\x0a\x6f\x35\xe4\xe4\xc6\x0e\x00\xfc\x72\x1d\xdf\xf7\x31\x7e\x58\xae\x4f\xf4\xf8\xf9\xf7\x67\x86\xfe\x96\x85\x68\x0d\x97\x03\x0d\x33\xfa\xb5\xdc\xf7\x05\x2f\xf8\x1f\x62\x2c\x3c\xa2\x8f\x54\xa9\x69\x8a\x28\xd4\x8d\x8a\xef\x13\x4e\x82\x24\xbf\xfd\x7e\x78\x3a\x39\x3a\xfa\x24\x11\xf3\xbd\x60\xce\x8a\x35\x2b\x21\xac\x53\x88\xa5\x7f\x69\xfc\x20\x61\x1b\xbb\x73\x2a\x73\xce\xf5\x2f\x15\x33\x30\x09\x5a\xfb\x1a\x00\xaa\x25\x55\x6f\x4e\x01\x00\x00")
 
 func webTemplateErrorHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -394,12 +439,12 @@ func webTemplateErrorHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "web/template/error.html", size: 334, mode: os.FileMode(493), modTime: time.Unix(1609252321, 0)}
+	info := bindataFileInfo{name: "web/template/error.html", size: 334, mode: os.FileMode(509), modTime: time.Unix(1610636534, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _webTemplateFooterHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8e\x4b\x6a\xc6\x20\x14\x85\xe7\xae\xe2\xd4\xb9\xbf\xf3\x60\xa4\xa3\x2e\xa0\x1b\x28\x26\xde\x44\x21\x3e\x30\x36\x25\x58\xf7\x5e\x6a\x1f\xa3\xef\xbb\xdc\xc3\xe1\xb4\x06\x4b\x9b\x8f\x04\xbe\xa5\x54\xa9\x70\xf4\xce\xd4\x93\x10\x78\x19\x37\x84\xd0\x4c\x59\x7f\xc1\xdb\x99\x67\xb3\x93\xf8\x0d\x6a\x06\x00\x2a\xff\x70\xb8\x81\x2b\xb4\xcd\xdc\xd5\x9a\xcf\x49\xca\xdd\x57\xf7\xbe\x3c\xd6\x14\x64\x58\x5d\x30\x25\xde\x1c\xd5\x94\x9d\xea\xcc\xdf\x22\x7d\x70\xfd\xfc\xf7\x50\xd2\x68\x7c\xe2\x95\x0e\x32\x27\x4d\x68\x0d\x8f\x8b\xca\xe9\x53\xfc\x9e\x34\xfa\x65\xd6\x4c\x49\xeb\xaf\x7f\x30\x25\x97\x64\xef\x21\xae\x86\x43\xb3\xd6\x40\xd1\xa2\xf7\xaf\x00\x00\x00\xff\xff\x41\x63\x81\xde\xdb\x00\x00\x00")
+var _webTemplateFooterHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8e\x41\x6a\xc3\x30\x14\x44\xf7\xff\x14\x53\xed\x95\xbf\x0f\xdf\xa2\xab\x1e\xa0\x17\x28\x4a\xf4\x6d\x09\x62\xc9\xc8\xaa\x4b\x50\x7d\xf7\x12\xb7\x0d\xb3\x78\x0c\x33\x0c\xd3\x3b\x82\x8e\x29\x2b\xcc\x58\x4a\xd3\x6a\xb0\xef\x24\x2f\xd6\xe2\xed\xf0\xb0\xd6\x91\x84\xb4\x21\x85\xc1\x2c\x7e\x52\xfb\x57\x74\x04\x00\xb2\xfc\xf2\x21\xf1\x88\x55\xc7\xc1\xc4\xd6\x96\xf5\xcc\x3c\xa5\x16\x3f\x2f\xa7\x6b\x99\x79\xbe\xc6\xd9\xd7\x7c\x37\x68\xbe\x4e\xda\x06\xf3\x91\xf5\xcb\xb8\xd7\xff\x40\xd8\x3b\x7c\xe3\x5d\x6f\xea\x57\x3d\xa3\x77\x9c\x36\xad\x6b\x2a\xf9\x71\xe9\xd8\xe7\xc5\x91\x70\x48\xdb\x13\x24\x7c\x29\xe1\xee\x88\x84\x63\x9b\x6f\x8e\x7a\x87\xe6\x80\x7d\xff\x19\x00\x41\x63\x81\xde\xdb\x00\x00\x00")
 
 func webTemplateFooterHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -414,12 +459,12 @@ func webTemplateFooterHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "web/template/footer.html", size: 219, mode: os.FileMode(493), modTime: time.Unix(1609252321, 0)}
+	info := bindataFileInfo{name: "web/template/footer.html", size: 219, mode: os.FileMode(509), modTime: time.Unix(1610636534, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _webTemplateHeaderHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x4f\x6f\xdb\x3e\x0c\xbd\xe7\x53\xb0\x3a\xc7\xd6\xef\x77\x1b\x06\xdb\x1b\xb6\xb6\xd8\x80\x61\xeb\xa1\x3d\xec\x34\x28\x12\x63\xab\x91\x25\x43\x62\x1c\x64\x5e\xbe\xfb\x20\xdb\x69\xfe\x79\x6d\x87\x9d\x12\x91\x7c\x4f\xf4\x23\x9f\xba\x0e\x14\x2e\xb5\x45\x60\x15\x0a\x85\x9e\xc1\x6e\x37\xcb\xae\xae\xbf\x7d\xbc\xff\x7e\x77\x03\x15\xd5\xa6\x98\x65\xf1\x07\x8c\xb0\x65\xce\xd0\xb2\x62\x36\xcb\x62\x75\x31\x03\x00\xc8\x48\x93\xc1\x82\x36\x88\x54\x69\x5b\xfe\xcc\xf8\x10\x19\xb2\x35\x92\x00\x59\x09\x1f\x90\x72\xf6\x70\x7f\x9b\xbc\x61\xc7\x29\x2b\x6a\xcc\x99\xc2\x20\xbd\x6e\x48\x3b\xcb\x40\x3a\x4b\x68\x29\x67\x07\xce\x09\xc8\x0a\xb7\x1b\xe7\x55\x38\xa9\xd7\x44\xe8\xe7\x10\xd0\xb7\x5a\xe2\x1c\x44\xa3\x27\xa0\xad\xc6\x4d\xe3\x3c\x1d\x41\x37\x5a\x51\x95\x2b\x8c\xb0\xa4\x3f\xcc\x41\x5b\x4d\x5a\x98\x24\x48\x61\x30\xff\x3f\xfd\x6f\x4f\x65\xb4\x5d\x41\xe5\x71\x99\x33\x1e\x48\x90\x96\x5c\xd7\x25\x5f\x8a\x56\x4b\x67\x53\x2d\x1d\x03\x8f\x26\x67\xa1\x72\x9e\xe4\x9a\x20\xc6\x19\xf0\x63\xfc\x50\x40\x5b\x83\xa1\x42\x24\x76\x46\x28\x43\xe0\xa2\x69\x52\x19\xc2\xbb\x16\x7d\xd0\xce\xe6\x5d\x07\xe9\xf8\x1f\x76\xbb\xbf\xe7\x8b\x63\xa0\x57\x31\x0e\xd3\x80\xe0\xe5\x81\xe1\x31\x70\xa3\x17\xe9\xe3\x1f\xd1\x45\xc6\x07\xdc\xf3\x24\x43\x17\xff\x4c\x13\xc5\x79\x15\x49\xc6\x87\x65\x9d\x65\x0b\xa7\xb6\x23\xa9\xd2\x2d\x68\x95\xb3\x8d\x17\x4d\x83\x7e\x9c\x6c\x9f\xba\x4a\x12\xf8\xd4\x9b\x01\x92\xe4\x28\xbe\x87\x34\xa2\xc4\x64\x74\xcb\x21\xdd\x97\x88\xbd\xe8\x67\x89\x3e\xa9\xeb\xf2\xf4\x23\xe2\xce\x1c\x56\x3c\x31\xae\x74\x69\x68\x4b\xd6\xdf\x12\x4f\x0c\x7a\x27\x1d\x1b\x01\x86\x38\x3f\xbb\x98\x8b\xb3\xc0\xbe\xd9\xa1\xcf\xa4\xe7\x99\x68\xea\xd6\x19\xe3\x36\x35\xce\x61\xeb\xd6\x1e\xee\x07\x03\xc1\xb2\x0f\xa3\x0f\x67\xb7\x28\xdd\x9e\x72\x74\x1d\xe8\x25\xa4\xeb\x80\x3e\x3e\x1c\xcf\xb5\x60\x45\x3b\xa5\xca\xa2\x78\x52\xad\x22\x6a\xc2\x5b\xce\x47\x1b\xa7\xd2\xd5\x3c\x8e\x35\xb2\xa7\x0f\x01\x7d\x74\x6f\xbf\xa6\x24\x7c\x19\x9f\x93\x1f\x0b\x23\xec\x8a\x15\xef\xa7\xca\xa2\x28\x19\x5f\x4c\xdd\xe9\xcf\x05\x3c\x9d\x5e\x7c\x1f\xb8\x12\xa1\x62\xc5\xb5\x08\xd5\xc2\x09\xaf\x22\x1b\xfc\x7a\x09\xe4\xb1\x7f\x57\x8a\xaf\x6e\x94\x16\x3e\x08\xb9\x0a\x2f\x82\xc5\x9a\x2a\x1e\x47\xbb\x26\x56\x7c\x71\x25\xb8\x35\x5d\x0e\xf5\x52\xff\xc3\x52\x3d\x29\x70\xe7\xdd\x52\x1b\xfc\x5c\x8b\x72\x10\xeb\x68\x06\x8d\x96\x0c\xa4\x11\x21\xe4\xac\x19\xea\x12\x1d\x0b\xd9\x45\x6f\xe3\xe6\x8d\x6c\xd0\x57\xc1\xd2\x79\x98\x9e\x08\xbf\xd8\x0b\xb4\xea\x78\x25\xc6\xe6\x4f\x6d\x76\x63\xd5\x94\xd5\xba\x0e\xad\xda\xed\x7e\x07\x00\x00\xff\xff\x5a\x18\x7a\x19\x9d\x06\x00\x00")
+var _webTemplateHeaderHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x51\x6f\xe4\x34\x10\x7e\xdf\x5f\x31\xe7\xe7\x26\x86\x37\x84\x9c\x80\xe0\x5a\x40\x42\x70\xd2\xf5\x1e\x78\x42\x5e\x7b\x92\xf8\xea\xd8\xc1\x9e\x64\x59\xc2\xfe\x77\xe4\x24\xdb\xcd\xb6\xa1\x2d\x42\xb6\x94\x78\x3c\xdf\x97\xc9\xcc\x37\x33\x8e\xa0\xb1\x32\x0e\x81\x35\x28\x35\x06\x06\xa7\xd3\x4e\xbc\x7b\xff\xeb\xf7\xf7\xbf\x7d\xb8\x85\x86\x5a\x5b\xee\x44\x7a\x80\x95\xae\x2e\x18\x3a\x56\xee\x76\x22\x79\x97\x3b\x00\x00\x41\x86\x2c\x96\x74\x40\xa4\xc6\xb8\xfa\x2f\xc1\x67\xcb\x7c\xdb\x22\x49\x50\x8d\x0c\x11\xa9\x60\x9f\xee\xef\xb2\xaf\xd8\xfa\xca\xc9\x16\x0b\xa6\x31\xaa\x60\x3a\x32\xde\x31\x50\xde\x11\x3a\x2a\xd8\x85\x73\x03\xf2\x80\xc7\x83\x0f\x3a\x5e\xf9\x1b\x22\x0c\x37\x10\x31\x0c\x46\xe1\x0d\xc8\xce\x6c\x40\x07\x83\x87\xce\x07\x5a\x41\x0f\x46\x53\x53\x68\x4c\xb0\x6c\x3a\xdc\x80\x71\x86\x8c\xb4\x59\x54\xd2\x62\xf1\x65\xfe\xc5\x99\xca\x1a\xf7\x00\x4d\xc0\xaa\x60\x3c\x92\x24\xa3\xb8\x69\x6b\x5e\xc9\xc1\x28\xef\x72\xa3\x3c\x83\x80\xb6\x60\xb1\xf1\x81\x54\x4f\x90\xec\x0c\xf8\x1a\x3f\x3b\xd0\xd1\x62\x6c\x10\x89\x3d\x21\x54\x31\x72\xd9\x75\xb9\x8a\xf1\x9b\x01\x43\x34\xde\x15\xe3\x08\xf9\xf2\x0e\xa7\xd3\x7f\xe7\x4b\x65\xa0\x37\x31\xce\xd5\x80\x18\xd4\x85\xe1\x73\xe4\xd6\xec\xf3\xcf\xff\x8a\x2e\x05\x9f\x71\x2f\x93\xcc\x51\xfc\x6f\x9a\x94\x9c\x37\x91\x08\x3e\x8b\x75\x27\xf6\x5e\x1f\x17\x52\x6d\x06\x30\xba\x60\x87\x20\xbb\x0e\xc3\x52\xd9\xb4\xc5\xbb\x2c\x83\x1f\xa7\x66\x80\x2c\x5b\xd9\xcf\x90\x4e\xd6\x98\x2d\xdd\x72\xb9\x4e\x4b\xc8\x73\xd2\x57\x7c\xe7\x25\x4c\x5b\x5f\xff\x44\xd2\xcc\x45\xe2\x99\xf5\xb5\xcf\xe3\x50\xb3\x29\xb0\x74\x62\x30\x75\xd2\xba\x11\x60\xb6\x2f\x75\x3a\x2f\xc1\xe5\x13\xc3\x39\xd8\x39\xce\x6c\xe2\xd9\x08\xea\xce\x5b\xeb\x0f\x2d\xde\xc0\xd1\xf7\x01\xee\xe7\x06\x82\x6a\x32\x63\x88\xd7\xa4\x5c\x9b\xe1\x9a\x63\x1c\xc1\x54\x90\xf7\x11\x43\x1a\x1c\x2f\x85\xe0\xe4\xb0\x95\x95\x7d\xf9\x98\xb5\x86\xa8\x8b\x5f\x73\xbe\xb4\x71\xae\x7c\xcb\x93\xc4\x12\x7b\xfe\x29\x62\x48\xb3\x22\x15\x17\x48\x86\x3a\x8d\x93\xdf\xf7\x56\xba\x07\x56\x7e\xbb\xe5\x96\x92\x22\xf8\x7e\xeb\x9b\xe1\x69\x02\xaf\xab\x97\xe6\x03\xd7\x32\x36\xac\x7c\x2f\x63\xb3\xf7\x32\xe8\xc4\x06\x7f\xbf\x06\x0a\x38\xcd\x95\xf2\x17\x0f\x77\x53\x0e\xe1\x3b\xa9\x1e\xe2\xdb\xc0\xa9\x45\xfa\xc8\xca\x8f\xd3\xf3\x55\x8c\xec\xa9\xe1\x49\x0e\x3d\xb1\xf2\x67\x5f\x83\xef\xe9\x99\x10\xd2\x16\x95\x0f\x2d\x48\x95\xa6\xeb\xe3\xb7\x50\x06\xd5\x30\x68\x91\x1a\xaf\x0b\xf6\xc3\xed\x3d\x5b\x57\x6b\xb9\x7f\x4e\x96\x96\x30\xae\xeb\x09\xe8\xd8\x61\xc1\x08\xff\x24\xb6\x0c\xd6\x3f\x18\x74\x56\x2a\x6c\xbc\xd5\x18\x0a\xf6\x71\x62\x01\xa9\x94\xef\x1d\xc5\x67\xba\x4d\x5b\xf0\x14\x5e\xf9\x9a\xd2\x2e\xed\xf3\x58\xeb\x0f\xc1\x57\xc6\xe2\x4f\xad\xac\x53\xbd\xaf\xe2\xef\x8c\x62\xa0\xac\x8c\xb1\x60\xdd\xec\x97\x99\xe4\xc8\xae\x58\xd3\x5e\x7a\x6c\x61\x83\xc9\x0b\x2a\x1f\x60\x4b\x54\xcf\x7e\x61\x1c\x01\x9d\x5e\x8b\x7f\x09\xfe\x72\x4e\x03\xe5\xd6\xe9\xad\xa1\x32\x8e\xe8\xf4\xe9\xf4\xcf\x00\x4f\xe3\xcf\xe6\x87\x07\x00\x00")
 
 func webTemplateHeaderHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -434,12 +479,12 @@ func webTemplateHeaderHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "web/template/header.html", size: 1693, mode: os.FileMode(493), modTime: time.Unix(1609629264, 0)}
+	info := bindataFileInfo{name: "web/template/header.html", size: 1927, mode: os.FileMode(509), modTime: time.Unix(1792318709, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _webTemplateIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\x41\x4e\xc3\x30\x10\x45\xf7\x3e\xc5\x67\x56\xb0\x30\xb9\x40\x12\x89\x05\x4b\x56\x70\x01\xd3\x99\x34\x23\xa5\x63\x94\x4c\x5b\x24\xab\x77\x47\x71\x8b\x02\x52\xff\xc6\x96\xde\xd3\xe8\xff\x52\xc0\x32\xa8\x09\x48\x8d\xe5\x9b\x70\xb9\x84\x50\x0a\x5c\x0e\x5f\x53\x72\x01\x8d\x92\x58\x66\xc2\x73\x45\xed\x43\x8c\x78\x53\xe6\x49\xf0\xf8\x62\xd9\x9e\x10\x63\x1f\x5a\xd6\x13\x94\x3b\x3a\x54\x14\x17\xd9\xb9\x66\xa3\x3e\x04\x00\xa8\x78\x37\xa5\x65\xe9\x68\xca\x7b\x5d\x01\x6e\x69\x13\xc6\x59\x86\x8e\x9a\x74\xf4\xb1\xb9\xe2\x5f\xf9\xf3\xe8\x9e\xff\xda\x6b\xde\x75\x6f\x50\xc3\x59\x7d\xc4\xc7\x59\xdd\x65\xde\xce\x35\xe9\x6a\xb7\x0d\xeb\xa9\x0f\xe1\xf6\xd6\xe2\xaf\xc6\x77\xca\xff\xdf\x3b\xe4\xec\xdb\xde\x95\x89\x71\xfd\xff\x04\x00\x00\xff\xff\x69\xde\x93\xd0\x2d\x01\x00\x00")
+var _webTemplateIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\x41\x6a\xc3\x50\x0c\x44\xf7\x3a\xc5\x54\xab\x76\xf1\xeb\x0b\xd8\x86\x2e\xba\xec\xaa\xbd\xc0\x6f\x24\xc7\x02\x47\xbf\xd8\x4a\x52\x30\xbe\x7b\xb1\x93\x92\x16\x22\x04\xb3\x78\xc3\x30\x33\xcf\x10\xed\xcc\x15\x6c\x2e\xfa\xcd\x58\x16\xa2\x79\x46\xe8\xe1\x6b\xc8\xa1\xe0\x5e\xb3\xe8\xc8\x78\xde\x50\xfd\x90\x12\xde\x4c\x64\x50\x3c\xbe\x78\xf1\x27\xa4\xd4\x52\x2d\x76\x82\x49\xc3\x87\x0d\xa5\x49\x77\x61\xc5\xb9\x25\x02\x80\x0d\xef\x86\x3c\x4d\x0d\x0f\x65\x6f\x2b\xc0\xf5\xea\x8c\x7e\xd4\xae\xe1\x2a\x1f\xa3\xaf\x2e\xf8\xd7\xfc\x79\x8c\x28\x7f\xdd\xeb\xbf\xdb\xde\x61\x8e\xb3\x45\x8f\x8f\xb3\x45\xe8\x78\x8b\xab\xf2\x25\xbb\xae\xc4\x4e\x2d\xd1\x55\xb7\xe2\xaf\x2e\x77\xca\xff\xdf\xdb\x95\x12\xb7\xbd\x2b\x53\x17\x2c\x0b\xd1\xcf\x00\x69\xde\x93\xd0\x2d\x01\x00\x00")
 
 func webTemplateIndexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -454,12 +499,12 @@ func webTemplateIndexHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "web/template/index.html", size: 301, mode: os.FileMode(493), modTime: time.Unix(1609252321, 0)}
+	info := bindataFileInfo{name: "web/template/index.html", size: 301, mode: os.FileMode(509), modTime: time.Unix(1610636534, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _webTemplateReportHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x93\x31\x6f\xfa\x30\x10\xc5\xf7\x7c\x8a\xfb\xfb\x2f\x75\x73\x53\x89\xb1\x26\x43\xa5\x32\x95\x4e\x2c\x1d\x1d\x7c\x10\xab\x89\x1d\x9d\x0f\x50\x15\xe5\xbb\x57\x76\x42\x49\xa0\x12\x43\xa7\x48\xef\x7e\x2f\xe7\xbb\x67\x77\x1d\x18\xdc\x59\x87\x20\x08\x5b\x4f\x2c\xa0\xef\xb3\xac\xeb\x80\xb1\x69\x6b\xcd\x08\xa2\x42\x6d\x90\x04\x3c\xa6\x92\xfa\x27\x25\xac\xad\x31\x35\x82\x94\x45\x96\x29\x63\x8f\x60\xcd\x52\x34\x49\x94\x01\xb7\x6c\xbd\x13\x45\x96\x01\x00\xa8\x6a\x51\xbc\x7b\x86\x95\xaf\x6b\x7f\xb2\x6e\x0f\x1f\xfe\x00\x2f\x7a\xfb\xa9\xf2\x6a\x71\x86\xe2\x3f\xb6\xb5\x0e\x61\x29\x90\xc8\x93\x6c\xc2\x5e\x14\x2a\x37\xf6\x78\x46\x62\xdf\x8d\x2e\xc7\xb6\xd7\xae\xda\x06\x96\x1c\xcb\xf2\x44\xba\x6d\x91\xc4\x00\x25\x30\x15\x6e\x51\x91\xce\x3d\x0c\x3e\x2a\x17\xd3\x60\x8c\xc3\xcf\xb5\x41\xa7\x5b\x71\x34\x14\x0f\xae\x0c\xed\xb3\xca\xb9\xfa\x1b\xb3\x22\x8b\xce\x84\x3b\x50\x5a\x2b\xd2\x1d\x6c\x73\x42\xe4\x3b\xcc\x9b\x0d\x8c\xe6\x77\x46\xe5\xd7\x13\x47\xee\x66\x37\x8a\x4b\x6f\xbe\xc6\xc4\x26\xe4\xa0\x4e\x84\xb8\xeb\x31\xc4\x69\xc6\x93\x40\x5b\xbd\x47\xe9\xf4\x71\x1a\xa3\x86\x8a\x70\xb7\x14\xff\x67\xc1\xa5\x3c\x1b\x4f\x28\x66\xde\xf2\xc0\xec\x9d\x00\xa3\x59\xcb\x88\x3f\x89\x62\xed\x09\x55\xae\xe7\x9d\x7f\xbe\xf1\x86\xbd\x3a\x33\xbb\xdd\xf3\xa7\xb0\xf3\x9e\x2f\x4f\xa1\xeb\x00\x9d\x81\xbe\xff\x0e\x00\x00\xff\xff\xbe\xca\xf8\x8f\x46\x03\x00\x00")
+var _webTemplateReportHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x93\x41\x6b\xe3\x30\x10\x85\xef\xfe\x15\xb3\x5a\xd8\x9b\xd6\x0b\x39\xae\xa2\x43\xa1\x39\x35\x3d\xe5\xd2\xa3\x1c\x4d\x62\x51\x5b\x32\xa3\x49\x42\x31\xfe\xef\x45\xae\xd3\x58\x49\x20\x87\x22\x83\xe1\xe9\x7b\x1e\xcf\x3c\xa9\xef\xc1\xe2\xce\x79\x04\x41\xd8\x05\x62\x01\xc3\x50\x14\x7d\x0f\x8c\x6d\xd7\x18\x46\x10\x35\x1a\x8b\x24\xe0\xef\xb8\xa5\x7e\x49\x09\x6b\x67\x6d\x83\x20\xa5\x2e\x0a\x65\xdd\x11\x9c\x5d\x8a\x76\x14\x65\xc4\x2d\xbb\xe0\x85\x2e\x0a\x00\x00\x55\x2f\xf4\x6b\x60\x58\x85\xa6\x09\x27\xe7\xf7\xf0\x16\x0e\xf0\x64\xb6\xef\xaa\xac\x17\x67\x28\x7d\x63\xdb\x98\x18\x97\x02\x89\x02\xc9\x36\xee\x85\x56\xa5\x75\xc7\x33\x92\xea\x6e\x4c\x35\x95\xbd\x76\x35\x2e\xb2\xe4\xb4\x2d\x4f\x64\xba\x0e\x49\xe8\xd1\x97\x1e\x35\x6e\xdc\xa2\x62\xfc\xef\xaf\xc6\x27\xe5\x62\x4a\x4b\x71\x6a\x3e\xd7\xd2\x52\x4c\xb7\xe2\x64\xd0\x7f\x7c\x15\xbb\xff\xaa\xe4\xfa\x67\xcc\x8a\x1c\x7a\x1b\x1f\x40\xe3\x58\x91\x1e\x60\x9b\x13\x22\x3f\x60\x5e\x5c\x64\xb4\xf7\x19\x55\x5e\x77\xac\xca\x3b\xb3\x51\x5c\x05\xfb\xa1\x8b\x5c\x2c\x27\x75\x26\xa4\x59\x4f\x21\xce\x33\x9e\x05\xda\x99\x3d\x4a\x6f\x8e\xf3\x18\x0d\xd4\x84\xbb\xa5\xf8\x9d\x05\x37\xe6\xd9\x06\x42\x91\x79\xab\x03\x73\xf0\x02\xac\x61\x23\x13\xfe\x4f\xe8\x75\x20\x54\xa5\xc9\x2b\x7f\xbf\xd3\x09\x7b\xf6\x36\x3b\xdd\xf9\x55\xd8\x85\xc0\x97\xab\xd0\xf7\x80\xde\xc2\x30\x7c\x0e\x00\xbe\xca\xf8\x8f\x46\x03\x00\x00")
 
 func webTemplateReportHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -474,7 +519,67 @@ func webTemplateReportHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "web/template/report.html", size: 838, mode: os.FileMode(420), modTime: time.Unix(1609630143, 0)}
+	info := bindataFileInfo{name: "web/template/report.html", size: 838, mode: os.FileMode(436), modTime: time.Unix(1610636534, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _webTemplateSearchHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x56\xcd\x6e\xe3\x36\x10\xbe\xeb\x29\xa6\x44\xd1\xd3\xca\x3a\x2c\x7a\x49\x29\xa1\x40\x37\x59\x04\x48\xb7\x45\x93\xed\x9d\x16\x47\x16\x51\x4a\x54\xc8\x71\xb2\x86\xc0\x77\x2f\x48\x49\xb6\x14\xcb\xde\xac\x2d\xc0\xd6\xcc\x37\xc3\x6f\x38\x3f\x64\xdf\x83\xc4\x4a\xb5\x08\xcc\xa1\xb0\x65\xcd\xc0\xfb\x24\xe9\x7b\x20\x6c\x3a\x2d\x08\x81\xd5\x28\x24\x5a\x06\x9b\xa8\xe2\x3f\xa5\x29\xfc\xa9\xa4\xd4\x08\x69\x5a\x24\x09\x97\xea\x05\x94\xcc\x59\x13\x85\xa9\xc3\x92\x94\x69\x59\x91\x24\x00\x00\xbc\xfe\x58\x3c\x46\xd7\x20\xca\xd2\xec\x5b\x72\x3c\xab\x3f\x4e\xda\xa3\x31\x92\x48\x3b\xd1\xa2\x66\x45\xd4\x84\x87\x57\xc6\x36\x20\xa2\xbf\x9c\x65\x2f\x0a\x5f\xb3\x89\x66\x83\x54\x1b\x99\xb3\xcf\xb7\x4f\x2c\x2e\x3f\x28\xd2\x60\x32\x73\x11\x1e\xae\xda\x6e\x4f\x40\x87\x0e\x73\x46\xf8\x8d\x18\xb4\xa2\xc1\x9c\x3d\x33\x78\x11\x7a\x8f\x39\xeb\x7b\xd8\x3c\xef\xd1\x1e\x36\x4f\xf8\x8d\xc0\x7b\x06\x9d\x16\x25\xd6\x46\x4b\xb4\x39\xdb\x3b\xb4\xc1\xe6\x43\xb4\xfc\x00\x12\x5d\x69\x55\x17\x88\x81\xb1\xa0\x4d\x29\xc2\x7f\x06\xd9\x72\xe9\x7f\x50\x47\xc5\xcd\x92\x90\x43\x8d\x25\x8d\x2c\xec\x88\x79\xc3\x3a\x3c\x7d\x0f\x3f\x4f\x6a\xb8\xc9\x27\x8e\x93\xd7\x90\x90\x09\x3a\x7d\xfa\x1e\xac\x68\x77\x08\x9b\xc9\xd0\xad\xc1\xb8\x19\xc8\xcf\xe2\xff\x37\xfc\x8d\xa1\xf7\x3d\xa8\x0a\xf0\x79\x92\x9d\x38\x78\x3f\x50\x47\xd9\xf7\x80\xad\x04\xef\x8b\xb0\x77\x0f\x62\x8b\x1a\xbc\xe7\xd9\xe0\x77\x35\x94\x01\xbf\xd0\xf0\x6c\xf0\xb7\xc4\x3f\x08\x47\x50\xd6\x31\x8c\xca\x9a\xe6\xe6\x72\x3a\xa5\x20\x9c\xd2\x19\xa0\x2b\x19\xfd\x23\x3a\x92\x77\xd6\x34\x31\xba\x37\x29\x22\xf3\x4e\xf7\x64\x2e\x3b\x7f\x32\x6b\xae\x17\xae\xdc\x7e\xdb\x28\x3a\xba\x78\x1c\xeb\x78\x66\xc2\xb3\x50\xbc\xc3\x3b\xcf\xa4\x7a\x19\x9b\x64\x48\xc7\x66\x28\x70\x3c\xee\x61\xec\xc4\x27\xb1\x1d\x1b\xf1\xd8\x4e\xa5\x16\xce\xe5\x4c\x2b\x47\x29\x05\x75\xfa\x6a\x45\xd7\xa1\x9d\x15\x18\x8f\x8a\x73\xe8\xa2\x95\x06\xc9\xc9\x28\x7c\x39\x85\x71\xb0\x94\x85\x2f\x27\x7b\x2e\x1c\x0d\x8a\x5f\xda\xad\xeb\x7e\xe3\x19\xd5\x97\x31\x5f\x1d\xda\xeb\x88\x3b\xa3\xb5\x79\x75\xd0\xe0\x75\xdc\x3d\x54\x11\x79\x1d\x15\x6b\x0c\x5f\xb0\xa5\x75\x1c\xcf\xde\x46\xc4\xb3\x95\xd8\x39\x6d\x8d\x3c\xac\x96\xfb\xb1\x0d\xdd\x5e\xd3\x7a\x13\x5e\xde\x34\x39\xe5\x26\x4c\x9e\x54\x35\x3b\x56\x70\xd5\xec\xc0\xd9\x72\x98\x56\x7f\x5b\x53\x29\x8d\xd3\xef\x7d\x23\x76\x18\x6b\x70\xb4\xeb\x06\x79\xaa\x82\x22\x94\x19\xcf\x48\xbe\x6f\xb5\xd0\x4d\x6c\x1d\x1a\x1e\x2e\xa0\xb6\x58\x4d\x03\x39\x98\x64\x73\x46\xf7\x9f\x22\x0f\x52\xa4\xc7\x56\x99\x34\x9f\x66\x53\xd3\x7b\x56\xfc\x3e\x57\x7e\x1d\x47\x6c\x9c\x22\xe2\xca\xf2\xa1\x31\xe6\x86\x5f\x46\xa3\xad\x85\x6c\xa1\x78\x18\xa7\x72\xf4\x18\xac\x56\x7d\x5e\xdd\x97\x62\xec\xbd\xa1\xf6\xd0\x82\xf7\x07\x74\x61\x96\x69\x87\xa3\xc6\x36\x68\x67\xfa\xd6\x10\x88\xf6\xd0\x18\x8b\x13\xce\xfb\xd6\x1c\xe7\xdf\xfb\xd6\xb3\x6a\x98\x96\xb3\xd5\x7e\xc0\xcb\xaa\x62\x36\x4a\x42\xf1\xdf\x86\xda\xff\x6b\xf5\x0c\xb9\x90\x69\x29\x0e\x31\xd1\x4b\x6b\x56\x9c\xcb\xae\x26\x70\x01\xdf\x7c\x46\xba\x33\xb6\x11\x44\x28\xa3\xe4\xe9\xd0\xe1\x35\x52\xa7\xed\xb8\x08\xd9\x62\x65\x2c\x02\x59\x51\xfe\xa7\xda\x1d\x38\x12\x96\x50\x5e\x75\x79\x7e\x36\x4d\x9f\xf5\xad\x3e\x9f\x0f\xdf\x21\x77\xbd\xd9\x8d\x76\x9d\x68\x73\xf6\x2b\x2b\xbe\x18\x68\x04\x95\x75\x60\x7e\xba\x32\xfd\x18\x87\xb5\x93\xf6\xcd\xa4\xe2\x59\x9c\xef\x8b\xe3\x66\x34\x57\x15\xec\x08\x36\x64\x48\x68\xd8\x68\xd5\x28\x9a\xdc\xf1\xae\x78\xac\xcd\x6b\xe0\x46\x35\x42\xa5\xac\xa3\xb0\x7f\x47\x14\x98\x2a\xbe\x0f\xc6\xde\x1f\x43\x08\x57\x27\x6b\xcd\x6b\xb4\x1b\x4e\x18\x20\x03\x0e\x31\x4a\x2c\x3a\xe2\x59\x57\x24\xe7\x21\x9c\xde\x92\x91\x67\x12\xcf\xbe\xdb\x56\x2e\x6e\xa2\xcb\x6b\x6b\x65\x0c\x9d\xae\xad\x7d\x0f\xd8\x4a\xf0\x3e\xf9\x7f\x00\x02\x1f\xce\x28\xf3\x0a\x00\x00")
+
+func webTemplateSearchHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_webTemplateSearchHtml,
+		"web/template/search.html",
+	)
+}
+
+func webTemplateSearchHtml() (*asset, error) {
+	bytes, err := webTemplateSearchHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "web/template/search.html", size: 2803, mode: os.FileMode(420), modTime: time.Unix(1792319272, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _webTemplateStatusHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x56\x7f\x6f\xdb\x38\x0c\xfd\xdf\x9f\x82\x27\x04\x87\x3b\xb4\x8e\x7b\xd7\xad\xc0\x3a\xc5\x40\xb1\xb5\xc0\x86\xb5\x28\xda\x6e\xfb\x5b\x89\x98\x46\x98\x22\x19\x12\xdd\xac\x30\xfc\xdd\x07\xcb\xaa\xf3\xa3\xa9\x93\x6e\x70\x80\x24\xe4\x7b\x14\x45\x3e\x8b\xaa\x2a\x90\x38\x55\x06\x81\x79\x12\x54\x7a\x06\x75\x9d\x24\x55\x05\x84\xf3\x42\x0b\x42\x60\x33\x14\x12\x1d\x83\x61\x70\xf1\xbf\xd2\x14\x2e\x95\x94\x1a\x21\x4d\xf3\x24\xe1\x52\x3d\x80\x92\x23\x36\x0f\xc6\xd4\xe3\x84\x94\x35\x2c\x4f\x12\x00\x00\x3e\x3b\xce\xbf\x5b\xf7\x03\x1d\xdc\x94\xc6\xf3\x6c\x76\x1c\x3d\x55\x05\x6a\x0a\x43\x27\x08\xbf\xa8\xb9\xa2\x26\x7c\x60\x74\x01\x91\x44\x5a\x08\x83\x9a\xe5\x81\xd1\x7c\xee\x16\x8a\x08\x1d\x9c\x5d\x7f\x02\x1d\x68\x0e\xc5\x64\x86\xb2\x43\x6c\xc6\x1d\x5e\xa3\x91\xca\xdc\x43\x5d\xff\x53\x08\x47\x4a\x68\xfd\x08\xda\x0a\x89\xf2\x14\xaa\x0a\x9c\x30\xf7\x08\x03\x75\x08\x03\x0d\xa7\xa3\xed\xd4\x36\xea\x40\x41\x5d\x1f\x36\x24\x34\xb2\xb5\x0e\x74\xfb\xdd\x1a\xfe\xed\x7e\x1d\xbe\x9c\xd0\x0d\x7a\xa4\xb3\x66\xc3\x0e\xa7\x0e\xfd\x0c\xc4\xb4\xd9\x13\x1f\xe7\x55\xb5\x1d\xc8\xb3\xe0\x43\xed\x71\x85\x66\x0d\x18\xfc\x49\x50\x16\x52\x10\x76\x4b\x87\x95\x79\x26\xd5\x43\xfe\x54\xe9\xe8\x08\x7f\x43\x0b\xef\xc4\x38\x76\xb0\xab\xf9\x44\x0b\xef\x47\x4c\x2b\x4f\x29\x35\xee\x74\xe1\x44\x51\xa0\x5b\x29\x3f\x0f\x8e\xe7\x50\x16\x24\xd0\x6a\x28\x5a\x96\xa4\xe6\xe1\xd4\xe8\x68\xdd\xd6\x3c\x9c\xdc\x73\x63\x24\xe4\xb7\x24\x1c\xa1\xe4\x19\xcd\x7a\x41\x54\xfa\x7e\xcc\xc7\xd2\x89\x46\x95\xfd\xa8\x0b\xab\xb5\x5d\xa0\xdb\x11\xec\x20\x4b\x77\xc4\x71\x0a\x8d\xfc\xd3\x28\xdf\xd0\xf9\x9d\x29\x9f\x3b\x67\xdd\x76\x08\xcf\x36\x4b\xcb\xb3\x2d\x4d\xe0\x34\xb6\xf2\x71\xdd\x16\x45\xd3\xbe\x18\x43\x57\x1a\xff\xa4\xaa\xbd\x5a\x17\x05\x7f\x83\xbe\xd4\xdd\x6b\xbd\xf9\x70\x92\x8d\xa2\x23\x6a\x78\x61\xdd\x5c\x10\xa1\x8c\x3d\x8f\xaa\x27\x99\xef\xc3\x6e\x35\xf0\x1a\x46\xb7\xde\x93\x34\x5e\x47\x6e\x85\xf2\xc1\x96\x66\x77\x9e\x07\x2b\xcc\x2b\x5c\x6c\x92\x21\x83\x74\x1d\xf1\xd5\x4c\x5f\xb5\xc0\x0a\xbb\x95\xde\xef\xe5\xb5\x46\xdd\x9a\x55\x40\xe0\x9e\xe1\x03\xbb\x34\xc3\xa8\xe3\xfd\xf0\x6d\x36\x41\xd5\xbd\x84\xe5\x59\xd8\x1f\xaf\x34\xc3\x4e\x50\xb1\xe7\xc0\x8e\xfe\x87\xcf\xc2\xc0\xd1\x09\xfc\xf7\xf6\xf4\xe8\x0d\x5c\xde\xde\xb1\x9d\xe9\x4d\x85\xd2\x28\x7b\x31\x30\xb1\xda\x17\xc2\x8c\xd8\x09\xcb\xff\x36\x63\x5f\xbc\xdf\xaf\x44\xfb\xed\x77\x79\xb6\xf7\xbf\xe6\x3b\xea\xd3\x73\xe2\xae\xec\xe0\x1d\xcb\xaf\x2c\x2c\xda\xc9\x1d\x4e\x00\x87\x13\xeb\x24\x4a\x78\x44\xda\x9e\xe8\xcb\xa9\x3c\x4f\x9d\x67\x1b\xe7\x0e\xcf\xc2\xd8\x88\xf3\xa8\x1d\x5e\x49\x1c\x62\x49\x18\x59\xe7\x46\xae\xdd\x3c\xd6\xaf\x29\x53\x6b\x69\x79\x4d\xa9\x2a\x40\x23\xa1\xae\x93\x5f\x03\x00\xa4\x71\x07\xf0\xe3\x08\x00\x00")
+
+func webTemplateStatusHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_webTemplateStatusHtml,
+		"web/template/status.html",
+	)
+}
+
+func webTemplateStatusHtml() (*asset, error) {
+	bytes, err := webTemplateStatusHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "web/template/status.html", size: 2275, mode: os.FileMode(420), modTime: time.Unix(1792316132, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _webTemplateUserHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x41\x6f\xdb\x3c\x0c\xbd\xe7\x57\xf0\xd3\xe9\x1b\x30\xc7\x87\xde\x06\xc5\xd8\x80\xb4\x43\x0f\xed\x06\x2c\x3d\xec\x34\x28\x16\x1d\x0b\xb5\x25\x43\xa6\x93\x19\x86\xfe\xfb\x20\xc5\x76\xec\x26\xe9\x56\x60\x83\x7d\x22\xf9\x9e\x44\xf2\x91\xea\x3a\x90\x98\x29\x8d\xc0\x9a\x1a\x2d\x03\xe7\x16\x8b\xae\x03\xc2\xb2\x2a\x04\x21\xb0\x1c\x85\xf4\x8e\x65\x70\xf1\xff\xa2\x08\x1e\x94\x94\x05\x42\x14\x25\x8b\x05\x97\x6a\x0f\x4a\xae\x58\x19\x8c\x51\x8d\x29\x29\xa3\x59\xb2\x58\x00\x00\x74\x1d\xa8\x0c\x96\x22\x4d\x4d\xa3\xc9\x53\x78\x2b\xcf\x6f\x92\xe0\xf6\x3f\x57\xe5\x0e\x6a\x9b\xae\x58\xd7\x8d\x91\xcb\xaf\xd6\x64\xaa\xc0\xfb\x52\xec\x10\x9c\x63\x90\x16\xa2\xae\x57\xac\x3a\xda\x23\xe5\x1d\x0c\xe2\x09\x8f\x80\xdc\x62\xb6\x62\x39\x51\x55\x7f\x88\x63\x3a\x28\x22\xb4\xcb\xd4\x94\xf1\x94\xfa\xa9\x46\xab\x45\x79\xa4\x25\x61\x77\x48\x2b\xf6\x63\x5b\x08\xfd\xcc\x92\x8f\x57\x22\x79\x2c\x4e\x47\x4d\x63\x1e\x8f\x4c\xc1\xc7\xe3\x21\xb1\xae\x03\x2c\xea\x93\x23\xbf\x49\x3e\xf5\x35\xf0\x60\x52\x25\x16\x4a\xe3\xf2\x7e\x1d\xb8\xa7\x30\x2d\x3d\xea\x08\x1b\x8b\x8b\x24\xa2\x4a\x68\x2c\xd8\xec\x16\x2a\x03\xa1\xe5\x84\xef\xce\x14\x85\x39\xa0\x9d\x9a\xac\x3a\x72\x7e\x37\x0d\x64\xc1\x0f\x28\xd2\x1c\x0c\xe5\x68\xa7\x6c\xe1\xc6\x2a\x9b\x62\x07\x3a\xe7\x36\x39\xb6\x03\xbc\x35\xcd\x6f\x70\x17\xce\xa4\x1c\xcb\x33\x94\x73\x8f\x06\xd2\xc6\x5a\xd4\x04\x16\x0b\xe1\xc5\x53\xe7\xaa\x1a\x2b\xf1\x7e\xc4\x94\x0d\x35\xa2\x18\xe8\x32\x63\x81\x6f\x93\x59\x35\x1f\x42\xc4\x5a\xb4\x75\xa8\xea\x36\x01\x29\xda\x7a\x24\xf8\x9f\xac\x48\x9f\x51\xc2\x0c\xb4\x39\x1a\xbf\x29\x9d\xfa\x7e\x01\x99\xb9\xff\xa9\x92\x82\x50\x7e\xd1\xe0\xdc\xbb\xbe\xcd\x52\xed\x7b\x81\x87\x81\xd8\x88\x6d\x3f\x0f\x63\xd7\x7a\xb9\x16\xaa\xa6\x88\xbc\x3b\x3a\x58\x51\x55\x68\x27\x0d\xe4\xc1\x71\x1e\xca\xc2\x40\x0d\x17\xe8\x6d\x27\x98\xff\x38\xf9\xb9\x9c\xdb\xfc\xc7\xc9\x9e\x1b\x7b\x40\xb2\x16\x2d\x8f\x29\xbf\x1e\x70\xbb\x47\x4d\x97\x43\x78\xfc\x92\x99\xc7\x17\xee\xc0\x69\x6b\x64\x3b\xb7\xf5\xfd\x9e\x09\xe4\xf6\xa7\xaa\x49\xe9\xdd\x44\x60\x6f\xc9\x45\x26\x5b\xcc\x8c\xc5\x57\x3b\xc9\x63\x92\xd7\x09\xc8\xeb\x59\x14\x16\x85\x1c\x74\x8d\x12\x5a\xd3\x5c\x86\x9d\xe7\x3f\xc8\x38\xa8\xf4\xcf\x13\xb6\xea\x0a\xe2\xdf\xa6\xdb\x9a\xe6\x3c\x5b\x3f\x92\x7f\x2d\x5d\x2b\xf4\x0e\xa7\x19\x7b\x31\xd5\x6f\xce\x74\x5c\xe3\xf1\x5e\xe1\x21\x96\xa2\x0d\xcb\x3b\x48\x73\xed\x5f\x24\xe7\x58\xf2\xd2\xe2\xb7\xf3\xeb\xf9\x7b\xc4\x67\xa4\x3b\x63\x4b\x41\x84\x32\xf0\x6d\xda\xea\x7a\xe5\xde\x52\x02\x1e\xbf\xd0\x3d\x8f\xc3\xd4\xf6\x0b\xa1\xdf\x17\xc3\xde\x08\x3b\xe3\x56\xcb\xd9\x43\x3a\x7f\x75\x33\x63\xe8\xf4\xea\x76\x1d\xa0\x96\xe0\xdc\xe2\xd7\x00\x3f\x24\x5a\x37\xb0\x07\x00\x00")
+
+func webTemplateUserHtmlBytes() ([]byte, error) {
+	return bindataRead(
+		_webTemplateUserHtml,
+		"web/template/user.html",
+	)
+}
+
+func webTemplateUserHtml() (*asset, error) {
+	bytes, err := webTemplateUserHtmlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "web/template/user.html", size: 1968, mode: os.FileMode(420), modTime: time.Unix(1792319213, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -541,11 +646,16 @@ var _bindata = map[string]func() (*asset, error){
 	"js/lib.js":                jsLibJs,
 	"web/template/dash.html":   webTemplateDashHtml,
 	"web/template/day.html":    webTemplateDayHtml,
+	"web/template/digest.html": webTemplateDigestHtml,
+	"web/template/digest.txt":  webTemplateDigestTxt,
 	"web/template/error.html":  webTemplateErrorHtml,
 	"web/template/footer.html": webTemplateFooterHtml,
 	"web/template/header.html": webTemplateHeaderHtml,
 	"web/template/index.html":  webTemplateIndexHtml,
 	"web/template/report.html": webTemplateReportHtml,
+	"web/template/search.html": webTemplateSearchHtml,
+	"web/template/status.html": webTemplateStatusHtml,
+	"web/template/user.html":   webTemplateUserHtml,
 }
 
 // AssetDir returns the file names below a certain
@@ -607,11 +717,16 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"template": &bintree{nil, map[string]*bintree{
 			"dash.html":   &bintree{webTemplateDashHtml, map[string]*bintree{}},
 			"day.html":    &bintree{webTemplateDayHtml, map[string]*bintree{}},
+			"digest.html": &bintree{webTemplateDigestHtml, map[string]*bintree{}},
+			"digest.txt":  &bintree{webTemplateDigestTxt, map[string]*bintree{}},
 			"error.html":  &bintree{webTemplateErrorHtml, map[string]*bintree{}},
 			"footer.html": &bintree{webTemplateFooterHtml, map[string]*bintree{}},
 			"header.html": &bintree{webTemplateHeaderHtml, map[string]*bintree{}},
 			"index.html":  &bintree{webTemplateIndexHtml, map[string]*bintree{}},
 			"report.html": &bintree{webTemplateReportHtml, map[string]*bintree{}},
+			"search.html": &bintree{webTemplateSearchHtml, map[string]*bintree{}},
+			"status.html": &bintree{webTemplateStatusHtml, map[string]*bintree{}},
+			"user.html":   &bintree{webTemplateUserHtml, map[string]*bintree{}},
 		}},
 	}},
 }}
@@ -0,0 +1,57 @@
+package app
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mchmarny/followme/internal/data"
+)
+
+const (
+	statusRunLimit = 30
+)
+
+// statusRow is a worker run as seen by a single user
+type statusRow struct {
+	Run    *data.RunReport
+	Result *data.UserRunResult
+}
+
+func (a *App) statusHandler(c *gin.Context) {
+	profile, err := a.getUserProfile(c)
+	if err != nil {
+		a.logger.Printf("error getting profile: %v", err)
+		a.logOutHandler(c)
+		return
+	}
+
+	reports, err := data.GetRunReports(a.db, statusRunLimit)
+	if err != nil {
+		a.viewErrorHandler(c, http.StatusInternalServerError, err, "Error getting worker runs")
+		return
+	}
+
+	// runs which included the user, or failed before updating any user
+	rows := make([]*statusRow, 0)
+	for _, r := range reports {
+		if u := r.GetUser(profile.Username); u != nil {
+			rows = append(rows, &statusRow{Run: r, Result: u})
+		} else if r.Error != "" && len(r.Users) == 0 {
+			rows = append(rows, &statusRow{Run: r})
+		}
+	}
+
+	rateLimit, err := a.getRateLimitStatus(profile.Username, time.Now().UTC())
+	if err != nil {
+		a.viewErrorHandler(c, http.StatusInternalServerError, err, "Error getting rate limit status")
+		return
+	}
+
+	c.HTML(http.StatusOK, "status", gin.H{
+		"user":      profile,
+		"version":   a.appVersion,
+		"runs":      rows,
+		"rateLimit": rateLimit,
+	})
+}
@@ -0,0 +1,87 @@
+package app
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/internal/twitter/twittertest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatus(t *testing.T) {
+	s := twittertest.NewServer()
+	defer s.Close()
+	s.AddProfile(&twittertest.Profile{ID: 1, Username: "tester", Name: "Tester"})
+	s.SetLoginUser("tester")
+
+	a, r := getTestApp(t, s)
+	defer a.db.Close()
+	cookies := login(t, s, r)
+
+	w := serve(r, http.MethodGet, "/view/status", cookies...)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "No worker runs recorded yet")
+
+	now := time.Now().UTC()
+	assert.NoError(t, data.SaveRunReport(a.db, &data.RunReport{
+		Version:    "v0.0.1-test",
+		StartedAt:  now,
+		FinishedAt: now.Add(time.Second),
+		Users: []*data.UserRunResult{
+			{Username: "tester", Status: data.UserRunFailed, Error: "tester-error", StartedAt: now},
+			{Username: "other", Status: data.UserRunFailed, Error: "other-error", StartedAt: now},
+		},
+	}))
+
+	w = serve(r, http.MethodGet, "/view/status", cookies...)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "tester-error")
+	assert.NotContains(t, w.Body.String(), "other-error")
+	assert.NotContains(t, w.Body.String(), "Twitter API limit reached")
+
+	// rate limit reached by worker in another process
+	assert.NoError(t, data.NewRateLimitStore(a.db).SaveRateLimit(&data.RateLimit{
+		Username:  "tester",
+		Endpoint:  "followers/ids",
+		ResetAt:   now.Add(time.Hour),
+		UpdatedAt: now,
+	}))
+	w = serve(r, http.MethodGet, "/view/status", cookies...)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Twitter API limit reached")
+}
+
+func TestMetricsRoute(t *testing.T) {
+	s := twittertest.NewServer()
+	defer s.Close()
+	s.AddProfile(&twittertest.Profile{ID: 1, Username: "tester", Name: "Tester"})
+	s.SetLoginUser("tester")
+
+	a, r := getTestApp(t, s)
+	defer a.db.Close()
+	login(t, s, r)
+
+	now := time.Now().UTC()
+	assert.NoError(t, a.db.Save(&data.DailyState{
+		Key:           data.GetDailyStateKey("tester", now),
+		Username:      "tester",
+		FollowerCount: 42,
+		UpdatedOn:     now,
+	}))
+
+	// not served unless enabled
+	assert.Equal(t, http.StatusNotFound, serve(r, http.MethodGet, "/metrics").Code)
+
+	a.metricsEnabled = true
+	r, err := a.getRouter()
+	assert.NoError(t, err)
+
+	// no auth required for scrapers
+	w := serve(r, http.MethodGet, "/metrics")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `followme_followers{username="tester"} 42`)
+	assert.Contains(t, w.Body.String(), "followme_twitter_api_requests_total")
+	assert.Contains(t, w.Body.String(), "go_goroutines")
+}
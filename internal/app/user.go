@@ -0,0 +1,79 @@
+package app
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/pkg/errors"
+)
+
+var (
+	errInvalidAccountID = errors.New("invalid account ID")
+	errNoAccountHistory = errors.New("no history with the account")
+)
+
+// getTimeline returns relationship timeline of the user with the account and its profile,
+// profile is nil when it can't be loaded
+func (a *App) getTimeline(ctx context.Context, forUser *data.User, idStr string) (*data.Timeline, *data.Profile, error) {
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || id <= 0 {
+		return nil, nil, errInvalidAccountID
+	}
+
+	t, err := data.GetTimeline(a.db, forUser.Username, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !t.HasHistory() {
+		return nil, nil, errNoAccountHistory
+	}
+
+	profiles, err := a.getProfiles(ctx, forUser, []int64{id})
+	if err != nil {
+		a.logger.Printf("error getting profile of %d: %v", id, err)
+		return t, nil, nil
+	}
+	if len(profiles) == 0 {
+		return t, nil, nil
+	}
+	return t, profiles[0], nil
+}
+
+func (a *App) userTimelineHandler(c *gin.Context) {
+	forUser, err := a.getUser(c)
+	if err != nil {
+		a.logger.Printf("error getting user from context: %v", err)
+		a.logOutHandler(c)
+		return
+	}
+
+	t, account, err := a.getTimeline(c.Request.Context(), forUser, c.Param("id"))
+	switch err {
+	case nil:
+	case errInvalidAccountID:
+		a.viewErrorHandler(c, http.StatusBadRequest, err, "Invalid account ID (param: id)")
+		return
+	case errNoAccountHistory:
+		a.viewErrorHandler(c, http.StatusNotFound, err, "No history with this account")
+		return
+	default:
+		a.viewErrorHandler(c, http.StatusInternalServerError, err, "Error getting account history")
+		return
+	}
+
+	var profile data.Profile
+	if err := a.db.One("Username", forUser.Username, &profile); err != nil || profile.Username == "" {
+		a.viewErrorHandler(c, http.StatusBadRequest, err, "Error getting user profile")
+		return
+	}
+
+	c.HTML(http.StatusOK, "user", gin.H{
+		"user":     profile,
+		"version":  a.appVersion,
+		"account":  account,
+		"timeline": t,
+	})
+}
@@ -9,8 +9,7 @@ import (
 )
 
 func (a *App) defaultHandler(c *gin.Context) {
-	uid, _ := c.Cookie(userIDCookieName)
-	if uid != "" {
+	if _, err := a.getSessionUsername(c); err == nil {
 		// a.logger.Printf("user already authenticated -> view")
 		c.Redirect(http.StatusSeeOther, "/view/dash")
 		return
@@ -22,9 +21,9 @@ func (a *App) defaultHandler(c *gin.Context) {
 }
 
 func (a *App) getUserProfile(c *gin.Context) (*data.Profile, error) {
-	username, _ := c.Cookie(userIDCookieName)
+	username := c.GetString(userContextKey)
 	if username == "" {
-		return nil, errors.New("no auth cookie")
+		return nil, errors.New("no authenticated user")
 	}
 
 	var p data.Profile
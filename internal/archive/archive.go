@@ -0,0 +1,197 @@
+// Package archive exports followme history into files with stable schema.
+package archive
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/mchmarny/followme/internal/data"
+)
+
+const (
+	// CSVFormat writes comma separated values with header row
+	CSVFormat = "csv"
+
+	// JSONLFormat writes one JSON object per line
+	JSONLFormat = "jsonl"
+
+	// SchemaVersion is incremented on any incompatible change to the records
+	SchemaVersion = 1
+
+	daysFilePrefix   = "days"
+	eventsFilePrefix = "events"
+)
+
+// Formats lists supported file formats
+var Formats = []string{CSVFormat, JSONLFormat}
+
+// IsValidFormat checks if the format is supported
+func IsValidFormat(format string) bool {
+	for _, f := range Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// DayRecord represents per-day counts of a single user
+type DayRecord struct {
+	Date               string `json:"date"`
+	Username           string `json:"username"`
+	UpdatedOn          string `json:"updated_on"`
+	BaselineOn         string `json:"baseline_on"`
+	GapDays            int    `json:"gap_days"`
+	FollowerCount      int    `json:"follower_count"`
+	NewFollowerCount   int    `json:"new_follower_count"`
+	NewUnfollowerCount int    `json:"new_unfollower_count"`
+	FriendCount        int    `json:"friend_count"`
+	NewFriendCount     int    `json:"new_friend_count"`
+	NewUnfriendedCount int    `json:"new_unfriended_count"`
+	HasEvents          bool   `json:"has_events"`
+	Timezone           string `json:"timezone"`
+}
+
+var dayHeader = []string{
+	"date",
+	"username",
+	"updated_on",
+	"baseline_on",
+	"gap_days",
+	"follower_count",
+	"new_follower_count",
+	"new_unfollower_count",
+	"friend_count",
+	"new_friend_count",
+	"new_unfriended_count",
+	"has_events",
+	"timezone",
+}
+
+func (r *DayRecord) row() []string {
+	return []string{
+		r.Date,
+		r.Username,
+		r.UpdatedOn,
+		r.BaselineOn,
+		strconv.Itoa(r.GapDays),
+		strconv.Itoa(r.FollowerCount),
+		strconv.Itoa(r.NewFollowerCount),
+		strconv.Itoa(r.NewUnfollowerCount),
+		strconv.Itoa(r.FriendCount),
+		strconv.Itoa(r.NewFriendCount),
+		strconv.Itoa(r.NewUnfriendedCount),
+		strconv.FormatBool(r.HasEvents),
+		r.Timezone,
+	}
+}
+
+// EventRecord represents single follow or friend event,
+// profile fields are empty when the profile is not cached locally
+type EventRecord struct {
+	Date           string `json:"date"`
+	Username       string `json:"username"`
+	EventType      string `json:"event_type"`
+	UserID         int64  `json:"user_id"`
+	ScreenName     string `json:"screen_name"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Location       string `json:"location"`
+	Lang           string `json:"lang"`
+	ProfileImage   string `json:"profile_image"`
+	CreatedAt      string `json:"created_at"`
+	FollowerCount  int    `json:"followers_count"`
+	FriendCount    int    `json:"friend_count"`
+	PostCount      int    `json:"post_count"`
+	ListedCount    int    `json:"listed_count"`
+	ProfileUpdated string `json:"profile_updated_at"`
+}
+
+var eventHeader = []string{
+	"date",
+	"username",
+	"event_type",
+	"user_id",
+	"screen_name",
+	"name",
+	"description",
+	"location",
+	"lang",
+	"profile_image",
+	"created_at",
+	"followers_count",
+	"friend_count",
+	"post_count",
+	"listed_count",
+	"profile_updated_at",
+}
+
+func (r *EventRecord) row() []string {
+	return []string{
+		r.Date,
+		r.Username,
+		r.EventType,
+		strconv.FormatInt(r.UserID, 10),
+		r.ScreenName,
+		r.Name,
+		r.Description,
+		r.Location,
+		r.Lang,
+		r.ProfileImage,
+		r.CreatedAt,
+		strconv.Itoa(r.FollowerCount),
+		strconv.Itoa(r.FriendCount),
+		strconv.Itoa(r.PostCount),
+		strconv.Itoa(r.ListedCount),
+		r.ProfileUpdated,
+	}
+}
+
+func newDayRecord(s *data.DailyState, timezone string) *DayRecord {
+	return &DayRecord{
+		Date:               s.StateOn,
+		Username:           s.Username,
+		UpdatedOn:          formatTime(s.UpdatedOn),
+		BaselineOn:         s.BaselineOn,
+		GapDays:            s.GapDays,
+		FollowerCount:      s.FollowerCount,
+		NewFollowerCount:   s.NewFollowerCount,
+		NewUnfollowerCount: s.NewUnfollowerCount,
+		FriendCount:        s.FriendsCount,
+		NewFriendCount:     s.NewFriendsCount,
+		NewUnfriendedCount: s.NewUnfriendedCount,
+		HasEvents:          !s.Compacted,
+		Timezone:           timezone,
+	}
+}
+
+func newEventRecord(s *data.DailyState, eventType string, id int64, p *data.Profile) *EventRecord {
+	r := &EventRecord{
+		Date:      s.StateOn,
+		Username:  s.Username,
+		EventType: eventType,
+		UserID:    id,
+	}
+	if p != nil {
+		r.ScreenName = p.Username
+		r.Name = p.Name
+		r.Description = p.Description
+		r.Location = p.Location
+		r.Lang = p.Lang
+		r.ProfileImage = p.ProfileImage
+		r.CreatedAt = formatTime(p.CreatedAt)
+		r.FollowerCount = p.FollowerCount
+		r.FriendCount = p.FriendCount
+		r.PostCount = p.PostCount
+		r.ListedCount = p.ListedCount
+		r.ProfileUpdated = formatTime(p.UpdatedAt)
+	}
+	return r
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
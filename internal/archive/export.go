@@ -0,0 +1,198 @@
+package archive
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/pkg/errors"
+)
+
+// ExportOptions defines what and where to export
+type ExportOptions struct {
+	// Username limits the export to single user, all users when empty
+	Username string
+	// From is the first day to export, first day with data when zero
+	From time.Time
+	// To is the last day to export, last day with data when zero
+	To time.Time
+	// Format is one of Formats
+	Format string
+	// Dir is the directory where files will be written
+	Dir string
+}
+
+// ExportResult describes the written files
+type ExportResult struct {
+	DaysFile   string `json:"days_file"`
+	EventsFile string `json:"events_file"`
+	Days       int    `json:"days"`
+	Events     int    `json:"events"`
+}
+
+// Export writes per-day counts and per-event records into days and events files
+func Export(db *storm.DB, opt *ExportOptions) (*ExportResult, error) {
+	if opt == nil {
+		return nil, errors.New("options required")
+	}
+	if !IsValidFormat(opt.Format) {
+		return nil, errors.Errorf("invalid format %s, expected one of %v", opt.Format, Formats)
+	}
+	if !opt.From.IsZero() && !opt.To.IsZero() && opt.From.After(opt.To) {
+		return nil, errors.Errorf("from date (%v) must be before to date (%v)", opt.From, opt.To)
+	}
+
+	usernames, err := getUsernames(db, opt.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &ExportResult{
+		DaysFile:   filepath.Join(opt.Dir, fmt.Sprintf("%s.%s", daysFilePrefix, opt.Format)),
+		EventsFile: filepath.Join(opt.Dir, fmt.Sprintf("%s.%s", eventsFilePrefix, opt.Format)),
+	}
+
+	days, err := newRecordWriter(r.DaysFile, opt.Format, dayHeader)
+	if err != nil {
+		return nil, err
+	}
+	defer days.Close()
+
+	events, err := newRecordWriter(r.EventsFile, opt.Format, eventHeader)
+	if err != nil {
+		return nil, err
+	}
+	defer events.Close()
+
+	e := &exporter{
+		db:       db,
+		days:     days,
+		events:   events,
+		profiles: make(map[int64]*data.Profile),
+		result:   r,
+	}
+
+	for _, username := range usernames {
+		if err := e.exportUser(username, opt.From, opt.To); err != nil {
+			return nil, errors.Wrapf(err, "error exporting %s", username)
+		}
+	}
+
+	if err := days.Close(); err != nil {
+		return nil, errors.Wrapf(err, "error closing file: %s", r.DaysFile)
+	}
+	if err := events.Close(); err != nil {
+		return nil, errors.Wrapf(err, "error closing file: %s", r.EventsFile)
+	}
+
+	return r, nil
+}
+
+func getUsernames(db *storm.DB, username string) ([]string, error) {
+	if username != "" {
+		return []string{username}, nil
+	}
+	var users []data.User
+	if err := db.All(&users); err != nil {
+		return nil, errors.Wrap(err, "error getting users")
+	}
+	list := make([]string, 0, len(users))
+	for _, u := range users {
+		list = append(list, u.Username)
+	}
+	return list, nil
+}
+
+type exporter struct {
+	db       *storm.DB
+	days     recordWriter
+	events   recordWriter
+	profiles map[int64]*data.Profile
+	result   *ExportResult
+}
+
+func (e *exporter) exportUser(username string, from, to time.Time) error {
+	first, last, err := data.GetStateDateRange(e.db, username)
+	if err != nil || first.IsZero() {
+		return err
+	}
+	if from = toDay(from); !from.IsZero() && from.After(first) {
+		first = from
+	}
+	if to = toDay(to); !to.IsZero() && to.Before(last) {
+		last = to
+	}
+	settings, err := data.GetUserSettings(e.db, username)
+	if err != nil {
+		return err
+	}
+
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		var s data.DailyState
+		if err := e.db.One("Key", data.GetDailyStateKey(username, day), &s); err != nil {
+			if err == storm.ErrNotFound {
+				continue
+			}
+			return errors.Wrapf(err, "error getting state for %v", day)
+		}
+		if !s.HasData() {
+			continue
+		}
+
+		if err := e.days.Write(newDayRecord(&s, settings.Timezone)); err != nil {
+			return errors.Wrap(err, "error writing day record")
+		}
+		e.result.Days++
+
+		lists := []struct {
+			eventType string
+			ids       []int64
+		}{
+			{data.FollowedEventType, s.NewFollowers},
+			{data.UnfollowedEventType, s.NewUnfollowers},
+			{data.FriendedEventType, s.NewFriends},
+			{data.UnfriendedEventType, s.NewUnfriended},
+		}
+		for _, l := range lists {
+			for _, id := range l.ids {
+				p, err := e.getProfile(id)
+				if err != nil {
+					return err
+				}
+				if err := e.events.Write(newEventRecord(&s, l.eventType, id, p)); err != nil {
+					return errors.Wrap(err, "error writing event record")
+				}
+				e.result.Events++
+			}
+		}
+	}
+	return nil
+}
+
+// getProfile returns locally stored profile, nil if none
+func (e *exporter) getProfile(id int64) (*data.Profile, error) {
+	if p, ok := e.profiles[id]; ok {
+		return p, nil
+	}
+	var p data.Profile
+	if err := e.db.One("ID", id, &p); err != nil {
+		if err != storm.ErrNotFound {
+			return nil, errors.Wrapf(err, "error getting profile %d", id)
+		}
+		e.profiles[id] = nil
+		return nil, nil
+	}
+	e.profiles[id] = &p
+	return &p, nil
+}
+
+// toDay truncates time to the start of its UTC day
+func toDay(t time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,98 @@
+package archive
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExport(t *testing.T) {
+	db, err := data.GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	username := "tester"
+	assert.NoError(t, db.Save(&data.User{Username: username}))
+	assert.NoError(t, db.Save(&data.Profile{ID: 2, Username: "friend", Name: "Friend, Jr."}))
+
+	day1 := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		d := day1.AddDate(0, 0, i)
+		assert.NoError(t, db.Save(&data.DailyState{
+			Key:              data.GetDailyStateKey(username, d),
+			Username:         username,
+			StateOn:          format.ToISODate(d),
+			UpdatedOn:        d,
+			FollowerCount:    10 + i,
+			NewFollowers:     []int64{int64(i + 1)},
+			NewFollowerCount: 1,
+			NewUnfriended:    []int64{100},
+		}))
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		dir := t.TempDir()
+		r, err := Export(db, &ExportOptions{Format: CSVFormat, Dir: dir, From: day1.AddDate(0, 0, 1)})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, r.Days)
+		assert.Equal(t, 4, r.Events)
+
+		rows := readCSV(t, r.DaysFile)
+		assert.Len(t, rows, 3)
+		assert.Equal(t, dayHeader, rows[0])
+		assert.Equal(t, []string{"2021-01-11", username, "2021-01-11T12:00:00Z", "", "0",
+			"11", "1", "0", "0", "0", "0", "true", ""}, rows[1])
+
+		rows = readCSV(t, r.EventsFile)
+		assert.Len(t, rows, 5)
+		assert.Equal(t, eventHeader, rows[0])
+		assert.Equal(t, "followed", rows[1][2])
+		assert.Equal(t, "2", rows[1][3])
+		assert.Equal(t, "friend", rows[1][4])
+		assert.Equal(t, "Friend, Jr.", rows[1][5])
+		assert.Equal(t, "unfriended", rows[2][2])
+		assert.Empty(t, rows[2][4])
+	})
+
+	t.Run("jsonl", func(t *testing.T) {
+		dir := t.TempDir()
+		r, err := Export(db, &ExportOptions{Username: username, Format: JSONLFormat, Dir: dir})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, r.Days)
+		assert.Equal(t, 6, r.Events)
+
+		f, err := os.Open(r.DaysFile)
+		assert.NoError(t, err)
+		defer f.Close()
+		scanner := bufio.NewScanner(f)
+		assert.True(t, scanner.Scan())
+		var rec DayRecord
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &rec))
+		assert.Equal(t, "2021-01-10", rec.Date)
+		assert.Equal(t, 10, rec.FollowerCount)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := Export(db, &ExportOptions{Format: "xml", Dir: t.TempDir()})
+		assert.Error(t, err)
+		_, err = Export(db, &ExportOptions{Format: CSVFormat, Dir: t.TempDir(), From: day1, To: day1.AddDate(0, 0, -1)})
+		assert.Error(t, err)
+	})
+}
+
+func readCSV(t *testing.T, path string) [][]string {
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	defer f.Close()
+	rows, err := csv.NewReader(f).ReadAll()
+	assert.NoError(t, err)
+	return rows
+}
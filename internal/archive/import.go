@@ -0,0 +1,341 @@
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/pkg/errors"
+)
+
+// Import merges records from either an export directory or another followme DB file into DB.
+// Access tokens are decrypted using the source key and encrypted using the master key of DB.
+func Import(db *storm.DB, srcPath string, srcKey, masterKey []byte, dryRun bool) (*data.MergeReport, error) {
+	info, err := os.Stat(srcPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading import source: %s", srcPath)
+	}
+
+	if info.IsDir() {
+		src, err := NewExportSource(srcPath)
+		if err != nil {
+			return nil, err
+		}
+		return data.Merge(db, src, masterKey, dryRun)
+	}
+
+	srcDB, err := data.GetDB(srcPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening import DB: %s", srcPath)
+	}
+	defer srcDB.Close()
+	return data.Merge(db, data.NewDBMergeSource(srcDB, srcKey), masterKey, dryRun)
+}
+
+// NewExportSource returns merge source reading files previously written by Export.
+// Exported states have no full follower/friend lists so they are imported as compacted.
+func NewExportSource(dir string) (data.MergeSource, error) {
+	s := &exportSource{
+		states:   make(map[string]*data.DailyState),
+		profiles: make(map[int64]*data.Profile),
+		ranges:   make(map[string][2]time.Time),
+		zones:    make(map[string]string),
+	}
+
+	for _, f := range Formats {
+		daysPath := filepath.Join(dir, fmt.Sprintf("%s.%s", daysFilePrefix, f))
+		if _, err := os.Stat(daysPath); err != nil {
+			continue
+		}
+		if err := readRecords(daysPath, f, s.addDay); err != nil {
+			return nil, errors.Wrapf(err, "error reading days from %s", daysPath)
+		}
+		eventsPath := filepath.Join(dir, fmt.Sprintf("%s.%s", eventsFilePrefix, f))
+		if _, err := os.Stat(eventsPath); err == nil {
+			if err := readRecords(eventsPath, f, s.addEvent); err != nil {
+				return nil, errors.Wrapf(err, "error reading events from %s", eventsPath)
+			}
+		}
+		return s, nil
+	}
+
+	return nil, errors.Errorf("no export files found in %s, expected one of %s.%v",
+		dir, daysFilePrefix, Formats)
+}
+
+type exportSource struct {
+	states   map[string]*data.DailyState
+	profiles map[int64]*data.Profile
+	ranges   map[string][2]time.Time
+	zones    map[string]string
+}
+
+func (s *exportSource) Users() ([]data.User, error) {
+	return []data.User{}, nil
+}
+
+func (s *exportSource) Profiles() ([]data.Profile, error) {
+	list := make([]data.Profile, 0, len(s.profiles))
+	for _, p := range s.profiles {
+		list = append(list, *p)
+	}
+	return list, nil
+}
+
+func (s *exportSource) Usernames() ([]string, error) {
+	list := make([]string, 0, len(s.ranges))
+	for u := range s.ranges {
+		list = append(list, u)
+	}
+	sort.Strings(list)
+	return list, nil
+}
+
+// Settings returns the time zone days of the user were exported in, UTC in exports without one
+func (s *exportSource) Settings(username string) (*data.UserSettings, error) {
+	return &data.UserSettings{Username: username, Timezone: s.zones[username]}, nil
+}
+
+func (s *exportSource) StateDateRange(username string) (first, last time.Time, err error) {
+	r := s.ranges[username]
+	return r[0], r[1], nil
+}
+
+func (s *exportSource) State(username string, day time.Time) (*data.DailyState, error) {
+	if st, ok := s.states[data.GetDailyStateKey(username, day)]; ok {
+		c := *st
+		return &c, nil
+	}
+	return nil, nil
+}
+
+func (s *exportSource) addDay(get fieldGetter) error {
+	day, err := time.Parse(format.ISODateLayout, get("date"))
+	if err != nil {
+		return errors.Wrapf(err, "invalid date: %s", get("date"))
+	}
+	username := get("username")
+	if username == "" {
+		return errors.New("username required")
+	}
+	if zone, ok := s.zones[username]; ok && zone != get("timezone") {
+		return errors.Errorf("days of %s exported in different time zones: %s and %s",
+			username, zone, get("timezone"))
+	}
+	s.zones[username] = get("timezone")
+
+	st := &data.DailyState{
+		Key:        data.GetDailyStateKey(username, day),
+		Username:   username,
+		StateOn:    format.ToISODate(day),
+		BaselineOn: get("baseline_on"),
+		Compacted:  true,
+	}
+	if st.UpdatedOn, err = parseTime(get("updated_on")); err != nil {
+		return err
+	}
+	counts := []struct {
+		name string
+		val  *int
+	}{
+		{"gap_days", &st.GapDays},
+		{"follower_count", &st.FollowerCount},
+		{"new_follower_count", &st.NewFollowerCount},
+		{"new_unfollower_count", &st.NewUnfollowerCount},
+		{"friend_count", &st.FriendsCount},
+		{"new_friend_count", &st.NewFriendsCount},
+		{"new_unfriended_count", &st.NewUnfriendedCount},
+	}
+	for _, c := range counts {
+		if *c.val, err = parseInt(c.name, get(c.name)); err != nil {
+			return err
+		}
+	}
+
+	s.states[st.Key] = st
+	r, ok := s.ranges[username]
+	if !ok || day.Before(r[0]) {
+		r[0] = day
+	}
+	if !ok || day.After(r[1]) {
+		r[1] = day
+	}
+	s.ranges[username] = r
+	return nil
+}
+
+func (s *exportSource) addEvent(get fieldGetter) error {
+	day, err := time.Parse(format.ISODateLayout, get("date"))
+	if err != nil {
+		return errors.Wrapf(err, "invalid date: %s", get("date"))
+	}
+	id, err := strconv.ParseInt(get("user_id"), 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid user ID: %s", get("user_id"))
+	}
+
+	st, ok := s.states[data.GetDailyStateKey(get("username"), day)]
+	if !ok {
+		return errors.Errorf("event for %s on %s has no day record", get("username"), get("date"))
+	}
+	switch get("event_type") {
+	case data.FollowedEventType:
+		st.NewFollowers = append(st.NewFollowers, id)
+	case data.UnfollowedEventType:
+		st.NewUnfollowers = append(st.NewUnfollowers, id)
+	case data.FriendedEventType:
+		st.NewFriends = append(st.NewFriends, id)
+	case data.UnfriendedEventType:
+		st.NewUnfriended = append(st.NewUnfriended, id)
+	default:
+		return errors.Errorf("invalid event type: %s", get("event_type"))
+	}
+
+	if get("screen_name") == "" {
+		return nil
+	}
+	p := &data.Profile{
+		ID:           id,
+		Username:     get("screen_name"),
+		Name:         get("name"),
+		Description:  get("description"),
+		Location:     get("location"),
+		Lang:         get("lang"),
+		ProfileImage: get("profile_image"),
+	}
+	if p.CreatedAt, err = parseTime(get("created_at")); err != nil {
+		return err
+	}
+	if p.UpdatedAt, err = parseTime(get("profile_updated_at")); err != nil {
+		return err
+	}
+	counts := []struct {
+		name string
+		val  *int
+	}{
+		{"followers_count", &p.FollowerCount},
+		{"friend_count", &p.FriendCount},
+		{"post_count", &p.PostCount},
+		{"listed_count", &p.ListedCount},
+	}
+	for _, c := range counts {
+		if *c.val, err = parseInt(c.name, get(c.name)); err != nil {
+			return err
+		}
+	}
+	if existing, ok := s.profiles[id]; !ok || p.UpdatedAt.After(existing.UpdatedAt) {
+		s.profiles[id] = p
+	}
+	return nil
+}
+
+// fieldGetter returns value of the named column, empty string if not set
+type fieldGetter func(name string) string
+
+// readRecords reads file in the format and calls fn for each record
+func readRecords(path, fileFormat string, fn func(get fieldGetter) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	switch fileFormat {
+	case CSVFormat:
+		r := csv.NewReader(bufio.NewReader(f))
+		header, err := r.Read()
+		if err != nil {
+			return errors.Wrap(err, "error reading header")
+		}
+		cols := make(map[string]int, len(header))
+		for i, h := range header {
+			cols[h] = i
+		}
+		for n := 2; ; n++ {
+			row, err := r.Read()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			get := func(name string) string {
+				if i, ok := cols[name]; ok && i < len(row) {
+					return row[i]
+				}
+				return ""
+			}
+			if err := fn(get); err != nil {
+				return errors.Wrapf(err, "error on line %d", n)
+			}
+		}
+	case JSONLFormat:
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		n := 0
+		for scanner.Scan() {
+			n++
+			if len(scanner.Bytes()) == 0 {
+				continue
+			}
+			// numbers as strings, IDs don't fit into float
+			var m map[string]interface{}
+			dec := json.NewDecoder(bytes.NewReader(scanner.Bytes()))
+			dec.UseNumber()
+			if err := dec.Decode(&m); err != nil {
+				return errors.Wrapf(err, "error parsing line %d", n)
+			}
+			get := func(name string) string {
+				switch v := m[name].(type) {
+				case nil:
+					return ""
+				case string:
+					return v
+				case json.Number:
+					return v.String()
+				default:
+					return fmt.Sprint(v)
+				}
+			}
+			if err := fn(get); err != nil {
+				return errors.Wrapf(err, "error on line %d", n)
+			}
+		}
+		return scanner.Err()
+	default:
+		return errors.Errorf("invalid format %s, expected one of %v", fileFormat, Formats)
+	}
+}
+
+func parseTime(v string) (time.Time, error) {
+	if v == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return t, errors.Wrapf(err, "invalid time: %s", v)
+	}
+	return t, nil
+}
+
+func parseInt(name, v string) (int, error) {
+	if v == "" {
+		return 0, nil
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid %s: %s", name, v)
+	}
+	return i, nil
+}
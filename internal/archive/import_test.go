@@ -0,0 +1,133 @@
+package archive
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/pkg/envelope"
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImport(t *testing.T) {
+	dir := t.TempDir()
+	srcDB, err := data.GetDB(path.Join(dir, "source.db"))
+	assert.NoError(t, err)
+
+	username := "tester"
+	srcKey := envelope.DeriveKey("source")
+	assert.NoError(t, data.SaveUser(srcDB, &data.User{Username: username, AccessTokenKey: "k", AccessTokenSecret: "s"}, srcKey))
+	zone := "America/Los_Angeles"
+	assert.NoError(t, srcDB.Save(&data.UserSettings{Username: username, Timezone: zone}))
+	assert.NoError(t, srcDB.Save(&data.Profile{ID: 2, Username: "friend", UpdatedAt: time.Now().UTC().Truncate(time.Second)}))
+
+	day1 := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		d := day1.AddDate(0, 0, i)
+		s := &data.DailyState{
+			Key:              data.GetDailyStateKey(username, d),
+			Username:         username,
+			StateOn:          format.ToISODate(d),
+			UpdatedOn:        d,
+			FollowerCount:    i + 1,
+			Followers:        []int64{1},
+			NewFollowers:     []int64{int64(i + 1)},
+			NewFollowerCount: 1,
+		}
+		assert.NoError(t, srcDB.Save(s))
+	}
+
+	for _, f := range Formats {
+		t.Run(f, func(t *testing.T) {
+			exportDir := t.TempDir()
+			_, err := Export(srcDB, &ExportOptions{Format: f, Dir: exportDir})
+			assert.NoError(t, err)
+
+			db, err := data.GetDB(path.Join(t.TempDir(), "target.db"))
+			assert.NoError(t, err)
+			defer db.Close()
+
+			r, err := Import(db, exportDir, nil, nil, true)
+			assert.NoError(t, err)
+			assert.Equal(t, 3, r.States.Added)
+			assert.Equal(t, 1, r.Profiles.Added)
+
+			_, err = data.GetLatestState(db, username, day1)
+			assert.NoError(t, err)
+
+			r, err = Import(db, exportDir, nil, nil, false)
+			assert.NoError(t, err)
+			assert.Equal(t, 3, r.States.Added)
+
+			s, err := data.GetLatestState(db, username, day1.AddDate(0, 0, 1))
+			assert.NoError(t, err)
+			assert.NotNil(t, s)
+			assert.True(t, s.Compacted)
+			assert.Equal(t, 2, s.FollowerCount)
+			assert.Equal(t, []int64{2}, s.NewFollowers)
+
+			var p data.Profile
+			assert.NoError(t, db.One("ID", int64(2), &p))
+			assert.Equal(t, "friend", p.Username)
+
+			r, err = Import(db, exportDir, nil, nil, false)
+			assert.NoError(t, err)
+			assert.Equal(t, 3, r.States.Unchanged)
+			assert.Empty(t, r.Changes)
+
+			// time zone is carried over to user without states
+			settings, err := data.GetUserSettings(db, username)
+			assert.NoError(t, err)
+			assert.Equal(t, zone, settings.Timezone)
+
+			// days of existing states start in different time zone
+			utcDB, err := data.GetDB(path.Join(t.TempDir(), "utc.db"))
+			assert.NoError(t, err)
+			defer utcDB.Close()
+			assert.NoError(t, utcDB.Save(&data.DailyState{
+				Key:      data.GetDailyStateKey(username, day1),
+				Username: username,
+				StateOn:  format.ToISODate(day1),
+			}))
+			_, err = Import(utcDB, exportDir, nil, nil, false)
+			assert.Error(t, err)
+		})
+	}
+
+	t.Run("db", func(t *testing.T) {
+		db, err := data.GetDB(path.Join(t.TempDir(), "target.db"))
+		assert.NoError(t, err)
+		defer db.Close()
+
+		assert.NoError(t, srcDB.Close())
+		key := envelope.DeriveKey("target")
+		r, err := Import(db, path.Join(dir, "source.db"), srcKey, key, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, r.Users.Added)
+		assert.Equal(t, 3, r.States.Added)
+		assert.Contains(t, r.Changes, "settings tester: time zone "+zone)
+
+		// access tokens are encrypted with the target key
+		u, err := data.GetUser(db, username, key)
+		assert.NoError(t, err)
+		assert.Equal(t, "k", u.AccessTokenKey)
+		_, err = data.GetUser(db, username, srcKey)
+		assert.Error(t, err)
+
+		s, err := data.GetFullState(db, username, day1)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1}, s.Followers)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		db, err := data.GetDB(path.Join(t.TempDir(), "target.db"))
+		assert.NoError(t, err)
+		defer db.Close()
+		_, err = Import(db, path.Join(dir, "missing"), nil, nil, false)
+		assert.Error(t, err)
+		_, err = Import(db, t.TempDir(), nil, nil, false)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,84 @@
+package archive
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+type record interface {
+	row() []string
+}
+
+// recordWriter writes records into a single file
+type recordWriter interface {
+	Write(r record) error
+	Close() error
+}
+
+func newRecordWriter(path, format string, header []string) (recordWriter, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating file: %s", path)
+	}
+	buf := bufio.NewWriter(f)
+
+	switch format {
+	case CSVFormat:
+		w := &csvWriter{file: f, buf: buf, csv: csv.NewWriter(buf)}
+		if err := w.csv.Write(header); err != nil {
+			f.Close()
+			return nil, errors.Wrapf(err, "error writing header to: %s", path)
+		}
+		return w, nil
+	case JSONLFormat:
+		return &jsonlWriter{file: f, buf: buf, enc: json.NewEncoder(buf)}, nil
+	default:
+		f.Close()
+		return nil, errors.Errorf("invalid format %s, expected one of %v", format, Formats)
+	}
+}
+
+type csvWriter struct {
+	file *os.File
+	buf  *bufio.Writer
+	csv  *csv.Writer
+}
+
+func (w *csvWriter) Write(r record) error {
+	return w.csv.Write(r.row())
+}
+
+func (w *csvWriter) Close() error {
+	w.csv.Flush()
+	if err := w.csv.Error(); err != nil {
+		w.file.Close()
+		return err
+	}
+	return closeFile(w.file, w.buf)
+}
+
+type jsonlWriter struct {
+	file *os.File
+	buf  *bufio.Writer
+	enc  *json.Encoder
+}
+
+func (w *jsonlWriter) Write(r record) error {
+	return w.enc.Encode(r)
+}
+
+func (w *jsonlWriter) Close() error {
+	return closeFile(w.file, w.buf)
+}
+
+func closeFile(f *os.File, buf *bufio.Writer) error {
+	if err := buf.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,264 @@
+package data
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	compactFileSuffix  = ".compact"
+	compactFillPercent = 1.0
+)
+
+// CompactStates thins out follower and friend ID lists of historical states.
+// States from the last keepDailyDays are kept as is, older states keep one full snapshot
+// per week until keepWeeklyDays, and all the other states keep only the per day counts.
+// The latest state of each user is always kept so the worker can diff against it.
+func CompactStates(db *storm.DB, keepDailyDays, keepWeeklyDays int, now time.Time) (int, error) {
+	if keepDailyDays < 1 {
+		return 0, errors.Errorf("daily retention must be at least 1 day: %d", keepDailyDays)
+	}
+	if keepWeeklyDays < keepDailyDays {
+		return 0, errors.Errorf("weekly retention (%d days) must be greater or equal to daily retention (%d days)",
+			keepWeeklyDays, keepDailyDays)
+	}
+
+	var users []User
+	if err := db.All(&users); err != nil {
+		return 0, errors.Wrap(err, "error getting users")
+	}
+
+	dailyCutoff := format.ToISODate(now.AddDate(0, 0, -keepDailyDays))
+	weeklyCutoff := format.ToISODate(now.AddDate(0, 0, -keepWeeklyDays))
+
+	total := 0
+	for _, u := range users {
+		n, err := compactUserStates(db, u.Username, dailyCutoff, weeklyCutoff)
+		if err != nil {
+			return total, errors.Wrapf(err, "error compacting states for %s", u.Username)
+		}
+		total += n
+	}
+	return total, nil
+}
+
+func compactUserStates(db *storm.DB, username, dailyCutoff, weeklyCutoff string) (int, error) {
+	first, last, err := GetStateDateRange(db, username)
+	if err != nil || first.IsZero() {
+		return 0, err
+	}
+
+	tx, err := db.Begin(true)
+	if err != nil {
+		return 0, errors.Wrap(err, "error starting transaction")
+	}
+	defer tx.Rollback()
+
+	var followers, friends []int64
+	known := false       // full lists of the current state are known
+	chainIntact := false // deltas of the current state can be rebuilt from the last kept snapshot
+	lastSnapshotOn := ""
+	lastWeek := ""
+	count := 0
+
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		var s DailyState
+		if err := tx.One("Key", GetDailyStateKey(username, day), &s); err != nil {
+			if err == storm.ErrNotFound {
+				continue
+			}
+			return 0, errors.Wrapf(err, "error getting state for %v", day)
+		}
+		if s.Compacted {
+			known, chainIntact = false, false
+			// events of imported states follow the same retention
+			if s.StateOn < dailyCutoff && s.hasDeltaLists() {
+				s.compact()
+				if err := tx.Save(&s); err != nil {
+					return 0, errors.Wrapf(err, "error saving state %s", s.Key)
+				}
+				count++
+			}
+			continue
+		}
+		if s.StateOn == "" {
+			s.StateOn = format.ToISODate(day)
+		}
+
+		// full lists as of this state
+		switch {
+		case s.IsSnapshot() || s.Followers != nil || s.Friends != nil:
+			followers, friends = s.Followers, s.Friends
+		case known:
+			followers = applyDelta(followers, s.NewFollowers, s.NewUnfollowers)
+			friends = applyDelta(friends, s.NewFriends, s.NewUnfriended)
+		default:
+			return 0, errors.Errorf("no snapshot found before %s, run migrate first", s.Key)
+		}
+		known = true
+
+		changed := false
+		year, week := day.ISOWeek()
+		weekKey := fmt.Sprintf("%d-%d", year, week)
+
+		switch {
+		case s.StateOn >= dailyCutoff || day.Equal(last):
+			// keep as is, only make sure it can still be rebuilt
+			if !s.IsSnapshot() && !chainIntact {
+				s.makeSnapshot(followers, friends)
+				changed = true
+			} else if !s.IsSnapshot() && s.SnapshotOn != lastSnapshotOn {
+				s.SnapshotOn = lastSnapshotOn
+				changed = true
+			}
+		case s.StateOn >= weeklyCutoff && weekKey != lastWeek:
+			// first state of the week, keep the full lists only
+			if !s.IsSnapshot() {
+				s.makeSnapshot(followers, friends)
+				changed = true
+			}
+			if s.hasDeltaLists() {
+				s.NewFollowers, s.NewUnfollowers, s.NewFriends, s.NewUnfriended = nil, nil, nil, nil
+				changed = true
+			}
+			lastWeek = weekKey
+		default:
+			s.compact()
+			changed = true
+		}
+
+		if s.Compacted {
+			chainIntact = false
+		} else if s.IsSnapshot() {
+			chainIntact = true
+			lastSnapshotOn = s.StateOn
+		}
+
+		if !changed {
+			continue
+		}
+		if err := tx.Save(&s); err != nil {
+			return 0, errors.Wrapf(err, "error saving state %s", s.Key)
+		}
+		count++
+	}
+
+	// intra-day changes follow the daily retention, the daily states keep their sum
+	if _, err := deleteSnapshotsBefore(tx, username, dailyCutoff); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, errors.Wrap(err, "error committing compacted states")
+	}
+	return count, nil
+}
+
+// makeSnapshot stores the full lists in the state so it no longer depends on its baseline
+func (s *DailyState) makeSnapshot(followers, friends []int64) {
+	s.SnapshotOn = s.StateOn
+	s.Followers = followers
+	s.Friends = friends
+}
+
+func (s *DailyState) hasDeltaLists() bool {
+	return s.NewFollowers != nil || s.NewUnfollowers != nil || s.NewFriends != nil || s.NewUnfriended != nil
+}
+
+// compact removes all ID lists from the state, keeps only the counts
+func (s *DailyState) compact() {
+	s.Compacted = true
+	s.SnapshotOn = ""
+	s.Followers, s.Friends = nil, nil
+	s.NewFollowers, s.NewUnfollowers, s.NewFriends, s.NewUnfriended = nil, nil, nil, nil
+}
+
+// CompactDB rewrites DB file to reclaim the space freed by removed data.
+// DB must not be opened by any other process. Returns file size before and after.
+func CompactDB(dbPath string) (before, after int64, err error) {
+	if dbPath == "" {
+		dbPath = GetDefaultDBFilePath()
+	}
+
+	info, err := os.Stat(dbPath)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "error getting DB file info: %s", dbPath)
+	}
+	before = info.Size()
+
+	tmpPath := dbPath + compactFileSuffix
+	if err := copyDB(dbPath, tmpPath); err != nil {
+		os.Remove(tmpPath)
+		return before, 0, err
+	}
+
+	if err := os.Rename(tmpPath, dbPath); err != nil {
+		return before, 0, errors.Wrapf(err, "error replacing DB file: %s", dbPath)
+	}
+
+	if info, err = os.Stat(dbPath); err != nil {
+		return before, 0, errors.Wrapf(err, "error getting DB file info: %s", dbPath)
+	}
+	return before, info.Size(), nil
+}
+
+func copyDB(srcPath, dstPath string) error {
+	src, err := bolt.Open(srcPath, dbFileMode, &bolt.Options{Timeout: dbLockTimeout, ReadOnly: true})
+	if err != nil {
+		if err == bolt.ErrTimeout {
+			return errors.Errorf("DB %s is locked by another process, stop it before compacting", srcPath)
+		}
+		return errors.Wrapf(err, "error opening DB: %s", srcPath)
+	}
+	defer src.Close()
+
+	if err := os.Remove(dstPath); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "error removing previous compacted DB: %s", dstPath)
+	}
+
+	dst, err := bolt.Open(dstPath, dbFileMode, nil)
+	if err != nil {
+		return errors.Wrapf(err, "error creating compacted DB: %s", dstPath)
+	}
+	defer dst.Close()
+
+	err = src.View(func(srcTx *bolt.Tx) error {
+		return srcTx.ForEach(func(name []byte, srcBucket *bolt.Bucket) error {
+			return dst.Update(func(dstTx *bolt.Tx) error {
+				dstBucket, err := dstTx.CreateBucket(name)
+				if err != nil {
+					return errors.Wrapf(err, "error creating bucket %s", name)
+				}
+				return copyBucket(srcBucket, dstBucket)
+			})
+		})
+	})
+	if err != nil {
+		return errors.Wrap(err, "error copying DB")
+	}
+	return dst.Close()
+}
+
+// copyBucket recursively copies all keys and nested buckets
+func copyBucket(src, dst *bolt.Bucket) error {
+	dst.FillPercent = compactFillPercent
+	if err := dst.SetSequence(src.Sequence()); err != nil {
+		return err
+	}
+	return src.ForEach(func(k, v []byte) error {
+		if v == nil {
+			child, err := dst.CreateBucket(k)
+			if err != nil {
+				return errors.Wrapf(err, "error creating bucket %s", k)
+			}
+			return copyBucket(src.Bucket(k), child)
+		}
+		return dst.Put(k, v)
+	})
+}
@@ -0,0 +1,122 @@
+package data
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompact(t *testing.T) {
+	dbPath := path.Join(t.TempDir(), "test.db")
+	db, err := GetDB(dbPath)
+	assert.NoError(t, err)
+
+	username := "tester"
+	assert.NoError(t, db.Save(&User{Username: username}))
+
+	// 60 days of states, one new follower a day, snapshot every week
+	start := time.Date(2021, 1, 4, 0, 0, 0, 0, time.UTC)
+	now := start.AddDate(0, 0, 59)
+	followers := make([]int64, 0)
+	for i := 0; i < 60; i++ {
+		d := start.AddDate(0, 0, i)
+		id := int64(i + 1000)
+		followers = append([]int64{id}, followers...)
+		s := &DailyState{
+			Key:              GetDailyStateKey(username, d),
+			Username:         username,
+			StateOn:          d.Format(format.ISODateLayout),
+			UpdatedOn:        d,
+			FollowerCount:    len(followers),
+			NewFollowers:     []int64{id},
+			NewFollowerCount: 1,
+		}
+		if i > 0 {
+			s.BaselineOn = start.AddDate(0, 0, i-1).Format(format.ISODateLayout)
+		}
+		s.SnapshotOn = start.AddDate(0, 0, i-i%SnapshotIntervalDays).Format(format.ISODateLayout)
+		if s.SnapshotOn == s.StateOn {
+			s.Followers = append([]int64{}, followers...)
+		}
+		assert.NoError(t, db.Save(s))
+	}
+
+	_, err = CompactStates(db, 0, 10, now)
+	assert.Error(t, err)
+	_, err = CompactStates(db, 10, 5, now)
+	assert.Error(t, err)
+
+	n, err := CompactStates(db, 10, 30, now)
+	assert.NoError(t, err)
+	assert.Greater(t, n, 0)
+
+	getState := func(i int) *DailyState {
+		var s DailyState
+		assert.NoError(t, db.One("Key", GetDailyStateKey(username, start.AddDate(0, 0, i)), &s))
+		return &s
+	}
+
+	t.Run("counts", func(t *testing.T) {
+		for i := 0; i < 60; i++ {
+			s := getState(i)
+			assert.Equal(t, i+1, s.FollowerCount)
+			assert.Equal(t, 1, s.NewFollowerCount)
+		}
+	})
+
+	t.Run("old", func(t *testing.T) {
+		s := getState(5)
+		assert.True(t, s.Compacted)
+		assert.Nil(t, s.NewFollowers)
+		_, err := GetFullState(db, username, start.AddDate(0, 0, 5))
+		assert.Error(t, err)
+	})
+
+	t.Run("weekly", func(t *testing.T) {
+		weekly := 0
+		for i := 29; i < 49; i++ {
+			s := getState(i)
+			if !s.Compacted {
+				weekly++
+				assert.True(t, s.IsSnapshot())
+				assert.Len(t, s.Followers, i+1)
+				assert.Nil(t, s.NewFollowers)
+			}
+		}
+		assert.Equal(t, 3, weekly)
+	})
+
+	t.Run("daily", func(t *testing.T) {
+		for i := 49; i < 60; i++ {
+			s, err := GetFullState(db, username, start.AddDate(0, 0, i))
+			assert.NoError(t, err)
+			assert.Len(t, s.Followers, i+1)
+			assert.Equal(t, []int64{int64(i + 1000)}, s.NewFollowers)
+		}
+	})
+
+	n, err = CompactStates(db, 10, 30, now)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	assert.NoError(t, db.Close())
+	_, _, err = CompactDB(path.Join(t.TempDir(), "missing.db"))
+	assert.Error(t, err)
+
+	before, after, err := CompactDB(dbPath)
+	assert.NoError(t, err)
+	assert.Less(t, after, before)
+	_, err = os.Stat(dbPath + compactFileSuffix)
+	assert.True(t, os.IsNotExist(err))
+
+	db, err = GetDB(dbPath)
+	assert.NoError(t, err)
+	defer db.Close()
+	s, err := GetFullState(db, username, now)
+	assert.NoError(t, err)
+	assert.Len(t, s.Followers, 60)
+}
@@ -0,0 +1,91 @@
+package data
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/pkg/errors"
+)
+
+const (
+	// FollowerList identifies the list of follower IDs
+	FollowerList = "followers"
+	// FriendList identifies the list of friend IDs
+	FriendList = "friends"
+
+	// MaxCursorAge is the time after which partially loaded lists are discarded
+	// as they would no longer match the rest of the list
+	MaxCursorAge = 24 * time.Hour
+)
+
+// ListCursor represents progress of paging through follower or friend IDs of a user
+type ListCursor struct {
+	Key         string    `storm:"id" json:"key"`
+	Username    string    `storm:"index" json:"username"`
+	List        string    `json:"list"`
+	Next        int64     `json:"next"`
+	IDs         []int64   `json:"ids"`
+	ResumeAfter time.Time `json:"resume_after"`
+	UpdatedOn   time.Time `json:"updated_on"`
+}
+
+// GetListCursorKey returns key of the list cursor
+func GetListCursorKey(username, list string) string {
+	return fmt.Sprintf("%s-%s", username, list)
+}
+
+// CursorStore persists list paging progress so it can be resumed by a later run
+type CursorStore interface {
+	// GetCursor returns cursor of the list, nil if none
+	GetCursor(username, list string) (*ListCursor, error)
+	// SaveCursor saves the cursor
+	SaveCursor(c *ListCursor) error
+	// DeleteCursor deletes cursor of the list, if any
+	DeleteCursor(username, list string) error
+}
+
+// NewCursorStore returns cursor store backed by the DB
+func NewCursorStore(db *storm.DB) CursorStore {
+	return &dbCursorStore{db: db}
+}
+
+type dbCursorStore struct {
+	db *storm.DB
+}
+
+func (s *dbCursorStore) GetCursor(username, list string) (*ListCursor, error) {
+	var c ListCursor
+	if err := s.db.One("Key", GetListCursorKey(username, list), &c); err != nil {
+		if err == storm.ErrNotFound {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "error getting %s cursor for %s", list, username)
+	}
+	return &c, nil
+}
+
+func (s *dbCursorStore) SaveCursor(c *ListCursor) error {
+	c.Key = GetListCursorKey(c.Username, c.List)
+	if err := s.db.Save(c); err != nil {
+		return errors.Wrapf(err, "error saving %s cursor for %s", c.List, c.Username)
+	}
+	return nil
+}
+
+func (s *dbCursorStore) DeleteCursor(username, list string) error {
+	err := s.db.DeleteStruct(&ListCursor{Key: GetListCursorKey(username, list)})
+	if err != nil && err != storm.ErrNotFound {
+		return errors.Wrapf(err, "error deleting %s cursor for %s", list, username)
+	}
+	return nil
+}
+
+// GetCursors returns all pending list cursors of the user
+func GetCursors(db *storm.DB, username string) ([]*ListCursor, error) {
+	list := make([]*ListCursor, 0)
+	if err := db.Find("Username", username, &list); err != nil && err != storm.ErrNotFound {
+		return nil, errors.Wrapf(err, "error getting cursors for %s", username)
+	}
+	return list, nil
+}
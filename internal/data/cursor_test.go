@@ -0,0 +1,43 @@
+package data
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorStore(t *testing.T) {
+	db, err := GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	s := NewCursorStore(db)
+
+	c, err := s.GetCursor("tester", FollowerList)
+	assert.NoError(t, err)
+	assert.Nil(t, c)
+
+	now := time.Now().UTC()
+	assert.NoError(t, s.SaveCursor(&ListCursor{Username: "tester", List: FollowerList, Next: 2, IDs: []int64{1, 2}, UpdatedOn: now}))
+	assert.NoError(t, s.SaveCursor(&ListCursor{Username: "tester", List: FriendList, Next: 3, IDs: []int64{3}, UpdatedOn: now}))
+	assert.NoError(t, s.SaveCursor(&ListCursor{Username: "other", List: FriendList, Next: 4, UpdatedOn: now}))
+
+	c, err = s.GetCursor("tester", FollowerList)
+	assert.NoError(t, err)
+	assert.NotNil(t, c)
+	assert.Equal(t, int64(2), c.Next)
+	assert.Equal(t, []int64{1, 2}, c.IDs)
+
+	list, err := GetCursors(db, "tester")
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	assert.NoError(t, s.DeleteCursor("tester", FollowerList))
+	assert.NoError(t, s.DeleteCursor("tester", FollowerList))
+	list, err = GetCursors(db, "tester")
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, FriendList, list[0].List)
+}
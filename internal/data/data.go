@@ -3,13 +3,17 @@ package data
 import (
 	"os/user"
 	"path"
+	"time"
 
 	"github.com/asdine/storm/v3"
 	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
 )
 
 const (
-	dbFileName = ".followme.db"
+	dbFileName    = ".followme.db"
+	dbFileMode    = 0600
+	dbLockTimeout = 3 * time.Second
 )
 
 // GetDefaultDBFilePath uses current user to build default file path
@@ -28,8 +32,12 @@ func GetDB(dbPath string) (*storm.DB, error) {
 		dbPath = GetDefaultDBFilePath()
 	}
 
-	db, err := storm.Open(dbPath)
+	db, err := storm.Open(dbPath, storm.BoltOptions(dbFileMode, &bolt.Options{Timeout: dbLockTimeout}))
 	if err != nil {
+		if errors.Cause(err) == bolt.ErrTimeout {
+			return nil, errors.Errorf("DB %s is locked by another process, "+
+				"stop it or use the app --worker-every flag to run worker inside of the app", dbPath)
+		}
 		return nil, errors.Wrapf(err, "error opening DB: %s", dbPath)
 	}
 
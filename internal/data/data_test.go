@@ -0,0 +1,27 @@
+package data
+
+import (
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDB(t *testing.T) {
+	dbPath := path.Join(t.TempDir(), "test.db")
+
+	db, err := GetDB(dbPath)
+	assert.NoError(t, err)
+	assert.NotNil(t, db)
+
+	t.Run("locked", func(t *testing.T) {
+		_, err := GetDB(dbPath)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "locked")
+	})
+
+	assert.NoError(t, db.Close())
+	db, err = GetDB(dbPath)
+	assert.NoError(t, err)
+	assert.NoError(t, db.Close())
+}
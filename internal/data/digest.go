@@ -0,0 +1,103 @@
+package data
+
+import (
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DigestOff disables the email digest
+	DigestOff = "off"
+	// DigestDaily sends digest of the previous day
+	DigestDaily = "daily"
+	// DigestWeekly sends digest of the previous 7 days
+	DigestWeekly = "weekly"
+)
+
+var (
+	// DigestFrequencies lists supported digest frequencies
+	DigestFrequencies = []string{DigestOff, DigestDaily, DigestWeekly}
+)
+
+// IsValidDigestFrequency checks if the digest frequency is supported
+func IsValidDigestFrequency(frequency string) bool {
+	for _, f := range DigestFrequencies {
+		if f == frequency {
+			return true
+		}
+	}
+	return false
+}
+
+// DigestSettings represents the email digest preferences of a user
+type DigestSettings struct {
+	Username  string `storm:"id" json:"username"`
+	Email     string `json:"email"`
+	Frequency string `json:"frequency"`
+	// SentThrough is the last day (ISO) included in a sent digest
+	SentThrough string    `json:"sent_through,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// IsEnabled indicates if the digest should be sent
+func (s *DigestSettings) IsEnabled() bool {
+	return s.Email != "" && (s.Frequency == DigestDaily || s.Frequency == DigestWeekly)
+}
+
+// GetDuePeriod returns the first and last day of the digest due on the date.
+// Digests cover only complete days, so the last day is always the day before (in the location of now).
+func (s *DigestSettings) GetDuePeriod(now time.Time) (from, to time.Time, due bool) {
+	if !s.IsEnabled() {
+		return from, to, false
+	}
+
+	days := 1
+	if s.Frequency == DigestWeekly {
+		days = 7
+	}
+
+	// day of now in its location, the returned days are UTC dates
+	to = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+	if s.SentThrough != "" {
+		last, err := time.Parse(format.ISODateLayout, s.SentThrough)
+		if err == nil && to.Before(last.AddDate(0, 0, days)) {
+			return from, to, false
+		}
+	}
+	return to.AddDate(0, 0, 1-days), to, true
+}
+
+// GetDigestSettings returns digest settings of the user, disabled when not set
+func GetDigestSettings(db *storm.DB, username string) (*DigestSettings, error) {
+	var s DigestSettings
+	if err := db.One("Username", username, &s); err != nil {
+		if err != storm.ErrNotFound {
+			return nil, errors.Wrapf(err, "error getting digest settings for %s", username)
+		}
+		return &DigestSettings{Username: username, Frequency: DigestOff}, nil
+	}
+	return &s, nil
+}
+
+// GetAllDigestSettings returns digest settings of all users
+func GetAllDigestSettings(db *storm.DB) ([]*DigestSettings, error) {
+	list := make([]*DigestSettings, 0)
+	if err := db.All(&list); err != nil && err != storm.ErrNotFound {
+		return nil, errors.Wrap(err, "error getting digest settings")
+	}
+	return list, nil
+}
+
+// SaveDigestSettings saves digest settings of the user
+func SaveDigestSettings(db *storm.DB, s *DigestSettings) error {
+	if !IsValidDigestFrequency(s.Frequency) {
+		return errors.Errorf("invalid digest frequency %s, expected one of %v", s.Frequency, DigestFrequencies)
+	}
+	if err := db.Save(s); err != nil {
+		return errors.Wrapf(err, "error saving digest settings for %s", s.Username)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package data
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigestSettings(t *testing.T) {
+	db, err := GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	s, err := GetDigestSettings(db, "tester")
+	assert.NoError(t, err)
+	assert.Equal(t, DigestOff, s.Frequency)
+	assert.False(t, s.IsEnabled())
+
+	s.Email = "tester@example.com"
+	s.Frequency = "hourly"
+	assert.Error(t, SaveDigestSettings(db, s))
+	s.Frequency = DigestDaily
+	assert.NoError(t, SaveDigestSettings(db, s))
+
+	list, err := GetAllDigestSettings(db)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, "tester@example.com", list[0].Email)
+
+	now := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+	iso := func(t time.Time) string { return format.ToISODate(t) }
+
+	t.Run("daily", func(t *testing.T) {
+		s := &DigestSettings{Email: "tester@example.com", Frequency: DigestDaily}
+		from, to, due := s.GetDuePeriod(now)
+		assert.True(t, due)
+		assert.Equal(t, "2021-01-09", iso(from))
+		assert.Equal(t, "2021-01-09", iso(to))
+
+		s.SentThrough = "2021-01-09"
+		_, _, due = s.GetDuePeriod(now)
+		assert.False(t, due)
+		_, to, due = s.GetDuePeriod(now.AddDate(0, 0, 1))
+		assert.True(t, due)
+		assert.Equal(t, "2021-01-10", iso(to))
+	})
+
+	t.Run("weekly", func(t *testing.T) {
+		s := &DigestSettings{Email: "tester@example.com", Frequency: DigestWeekly}
+		from, to, due := s.GetDuePeriod(now)
+		assert.True(t, due)
+		assert.Equal(t, "2021-01-03", iso(from))
+		assert.Equal(t, "2021-01-09", iso(to))
+
+		s.SentThrough = "2021-01-09"
+		_, _, due = s.GetDuePeriod(now.AddDate(0, 0, 6))
+		assert.False(t, due)
+		from, _, due = s.GetDuePeriod(now.AddDate(0, 0, 7))
+		assert.True(t, due)
+		assert.Equal(t, "2021-01-10", iso(from))
+	})
+}
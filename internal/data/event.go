@@ -12,6 +12,9 @@ const (
 
 	// UnfriendedEventType when user unfriends
 	UnfriendedEventType = "unfriended"
+
+	// RefollowedEventType when user follows again after unfollowing
+	RefollowedEventType = "refollowed"
 )
 
 // DayEvent represents day/label where day is not unique
@@ -27,6 +30,8 @@ func (e *DayEvent) GetFormattedEventType() string {
 		return "they followed you"
 	case UnfollowedEventType:
 		return "they unfollowed you"
+	case RefollowedEventType:
+		return "they followed you again"
 	case FriendedEventType:
 		return "you followed them"
 	case UnfriendedEventType:
@@ -39,6 +44,8 @@ func (e *DayEvent) GetFormattedEventType() string {
 // UserEvent wraps simple twitter user as an time event
 type UserEvent struct {
 	*Profile
+	// IDStr is the profile ID as string, IDs exceed precision of JavaScript numbers
+	IDStr           string `json:"id_str"`
 	EventDate       string `json:"event_at"`
 	EventType       string `json:"event_type"`
 	EventUser       string `json:"event_user"`
@@ -0,0 +1,31 @@
+package data
+
+import (
+	"context"
+)
+
+// GraphProvider provides social graph data on behalf of authenticated user
+type GraphProvider interface {
+	// GetUserDetails returns profile of the authenticated user
+	GetUserDetails(ctx context.Context, byUser *User) (*Profile, error)
+
+	// GetUserDetailsFromIDs returns profiles for the provided IDs
+	GetUserDetailsFromIDs(ctx context.Context, byUser *User, ids []int64) ([]*Profile, error)
+
+	// GetFollowerIDs returns IDs of all users who follow the authenticated user
+	GetFollowerIDs(ctx context.Context, byUser *User) ([]int64, error)
+
+	// GetFriendIDs returns IDs of all users the authenticated user follows
+	GetFriendIDs(ctx context.Context, byUser *User) ([]int64, error)
+
+	// GetRelationships returns relationships between the authenticated user and each of the targets,
+	// targets which no longer exist are not included
+	GetRelationships(ctx context.Context, byUser *User, targetIDs []int64) ([]*Relationship, error)
+}
+
+// Relationship represents relationship between the authenticated user and the target user
+type Relationship struct {
+	TargetID   int64 `json:"target_id"`
+	Following  bool  `json:"following"`
+	FollowedBy bool  `json:"followed_by"`
+}
@@ -0,0 +1,387 @@
+package data
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/pkg/errors"
+)
+
+const (
+	mergeOriginTarget = iota
+	mergeOriginSource
+)
+
+// MergeSource provides records to merge into DB
+type MergeSource interface {
+	// Users returns users with decrypted access tokens,
+	// users which tokens can't be decrypted are returned encrypted
+	Users() ([]User, error)
+	// Profiles returns all profiles
+	Profiles() ([]Profile, error)
+	// Usernames returns names of all users with state
+	Usernames() ([]string, error)
+	// Settings returns settings of the user, with the time zone the days of the user start in
+	Settings(username string) (*UserSettings, error)
+	// StateDateRange returns dates of the first and last state of the user, zero if none
+	StateDateRange(username string) (first, last time.Time, err error)
+	// State returns state of the user on the day, nil if none
+	State(username string, day time.Time) (*DailyState, error)
+}
+
+// MergeCounts counts merge results of a single record type
+type MergeCounts struct {
+	Added     int `json:"added"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+}
+
+// MergeReport describes what was (or in dry run, would be) changed
+type MergeReport struct {
+	Users    MergeCounts `json:"users"`
+	Profiles MergeCounts `json:"profiles"`
+	States   MergeCounts `json:"states"`
+	Changes  []string    `json:"changes"`
+	// Relogin lists users which access tokens weren't imported
+	Relogin []string `json:"relogin,omitempty"`
+}
+
+func (r *MergeReport) change(format string, args ...interface{}) {
+	r.Changes = append(r.Changes, fmt.Sprintf(format, args...))
+}
+
+// Merge merges users, profiles, and daily states from source into DB.
+// On conflict, the record updated last wins (the existing one when equal).
+// Access tokens of merged users are encrypted with the master key when set.
+// Delta states which baseline changed are converted into snapshots so all states can still be rebuilt.
+// In dry run nothing is saved.
+func Merge(db *storm.DB, src MergeSource, masterKey []byte, dryRun bool) (*MergeReport, error) {
+	tx, err := db.Begin(true)
+	if err != nil {
+		return nil, errors.Wrap(err, "error starting transaction")
+	}
+	defer tx.Rollback()
+
+	r := &MergeReport{Changes: make([]string, 0)}
+
+	if err := mergeUsers(tx, src, masterKey, r); err != nil {
+		return nil, err
+	}
+	if err := mergeProfiles(tx, src, r); err != nil {
+		return nil, err
+	}
+
+	usernames, err := src.Usernames()
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting source usernames")
+	}
+	for _, username := range usernames {
+		if err := mergeUserSettings(tx, src, username, r); err != nil {
+			return nil, err
+		}
+		if err := mergeUserStates(tx, src, username, r); err != nil {
+			return nil, errors.Wrapf(err, "error merging states for %s", username)
+		}
+	}
+
+	if dryRun {
+		return r, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrap(err, "error committing merge")
+	}
+	return r, nil
+}
+
+func mergeUsers(tx storm.Node, src MergeSource, masterKey []byte, r *MergeReport) error {
+	users, err := src.Users()
+	if err != nil {
+		return errors.Wrap(err, "error getting source users")
+	}
+	for i := range users {
+		u := &users[i]
+		// tokens sealed with unknown key can't be re-encrypted for this DB
+		if u.IsEncrypted() {
+			r.Users.Unchanged++
+			r.Relogin = append(r.Relogin, u.Username)
+			r.change("user %s: skipped, access tokens can't be decrypted, re-login required", u.Username)
+			continue
+		}
+		var existing User
+		err := tx.One("Username", u.Username, &existing)
+		switch {
+		case err == storm.ErrNotFound:
+			r.Users.Added++
+			r.change("user %s: added", u.Username)
+		case err != nil:
+			return errors.Wrapf(err, "error getting user %s", u.Username)
+		case u.UpdatedAt.After(existing.UpdatedAt):
+			r.Users.Updated++
+			r.change("user %s: updated", u.Username)
+		default:
+			r.Users.Unchanged++
+			continue
+		}
+		// existing data key is kept, webhook secrets are encrypted with it
+		if err := SaveUser(tx, u, masterKey); err != nil {
+			return errors.Wrapf(err, "error saving user %s", u.Username)
+		}
+	}
+	return nil
+}
+
+func mergeProfiles(tx storm.Node, src MergeSource, r *MergeReport) error {
+	profiles, err := src.Profiles()
+	if err != nil {
+		return errors.Wrap(err, "error getting source profiles")
+	}
+	for i := range profiles {
+		p := &profiles[i]
+		var existing Profile
+		err := tx.One("ID", p.ID, &existing)
+		added := err == storm.ErrNotFound
+		switch {
+		case added:
+		case err != nil:
+			return errors.Wrapf(err, "error getting profile %d", p.ID)
+		case !p.UpdatedAt.After(existing.UpdatedAt):
+			r.Profiles.Unchanged++
+			continue
+		}
+		if err := tx.Save(p); err != nil {
+			if err == storm.ErrAlreadyExists {
+				// username now used by another account
+				r.Profiles.Unchanged++
+				r.change("profile %d (%s): skipped, username used by another profile", p.ID, p.Username)
+				continue
+			}
+			return errors.Wrapf(err, "error saving profile %d", p.ID)
+		}
+		if added {
+			r.Profiles.Added++
+		} else {
+			r.Profiles.Updated++
+		}
+	}
+	return nil
+}
+
+// mergeUserSettings makes sure states of both origins start their days in the same time zone.
+// Source settings are used when the target has no states of the user yet.
+func mergeUserSettings(tx storm.Node, src MergeSource, username string, r *MergeReport) error {
+	srcSettings, err := src.Settings(username)
+	if err != nil {
+		return errors.Wrapf(err, "error getting source settings for %s", username)
+	}
+	settings, err := GetUserSettings(tx, username)
+	if err != nil {
+		return err
+	}
+	if srcSettings.Timezone == settings.Timezone {
+		return nil
+	}
+
+	first, _, err := GetStateDateRange(tx, username)
+	if err != nil {
+		return err
+	}
+	if !first.IsZero() {
+		return errors.Errorf("time zone of %s is %s in source and %s in target, set the same time zone using the timezone command before merging",
+			username, zoneName(srcSettings.Timezone), zoneName(settings.Timezone))
+	}
+
+	s := &UserSettings{Username: username, Timezone: srcSettings.Timezone, UpdatedAt: time.Now().UTC()}
+	if err := tx.Save(s); err != nil {
+		return errors.Wrapf(err, "error saving settings for %s", username)
+	}
+	r.change("settings %s: time zone %s", username, zoneName(s.Timezone))
+	return nil
+}
+
+// zoneName returns the name of the time zone, UTC when empty
+func zoneName(timezone string) string {
+	if timezone == "" {
+		return time.UTC.String()
+	}
+	return timezone
+}
+
+// listTracker follows full lists of states from a single origin in date order
+type listTracker struct {
+	followers []int64
+	friends   []int64
+	known     bool
+}
+
+func (t *listTracker) apply(s *DailyState) {
+	switch {
+	case s == nil:
+	case s.Compacted:
+		t.known = false
+	case s.IsSnapshot() || s.Followers != nil || s.Friends != nil:
+		t.followers, t.friends, t.known = s.Followers, s.Friends, true
+	case t.known:
+		t.followers = applyDelta(t.followers, s.NewFollowers, s.NewUnfollowers)
+		t.friends = applyDelta(t.friends, s.NewFriends, s.NewUnfriended)
+	}
+}
+
+func mergeUserStates(tx storm.Node, src MergeSource, username string, r *MergeReport) error {
+	srcFirst, srcLast, err := src.StateDateRange(username)
+	if err != nil || srcFirst.IsZero() {
+		return err
+	}
+	first, last, err := GetStateDateRange(tx, username)
+	if err != nil {
+		return err
+	}
+	if first.IsZero() || srcFirst.Before(first) {
+		first = srcFirst
+	}
+	if srcLast.After(last) {
+		last = srcLast
+	}
+
+	trackers := map[int]*listTracker{
+		mergeOriginTarget: {},
+		mergeOriginSource: {},
+	}
+
+	prevOrigin := -1
+	prevOn := ""
+	prevCompacted := false
+	lastSnapshotOn := ""
+
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		key := GetDailyStateKey(username, day)
+		srcState, err := src.State(username, day)
+		if err != nil {
+			return errors.Wrapf(err, "error getting source state %s", key)
+		}
+		var target *DailyState
+		var s DailyState
+		if err := tx.One("Key", key, &s); err == nil {
+			target = &s
+		} else if err != storm.ErrNotFound {
+			return errors.Wrapf(err, "error getting state %s", key)
+		}
+		if srcState == nil && target == nil {
+			continue
+		}
+
+		trackers[mergeOriginSource].apply(srcState)
+		trackers[mergeOriginTarget].apply(target)
+
+		out, origin := target, mergeOriginTarget
+		action := ""
+		switch {
+		case target == nil:
+			out, origin = srcState, mergeOriginSource
+			action = "added"
+			r.States.Added++
+		case srcState != nil && srcState.UpdatedOn.After(target.UpdatedOn):
+			out, origin = srcState, mergeOriginSource
+			action = "updated"
+			r.States.Updated++
+		}
+
+		// delta states must follow their own baseline
+		if !out.Compacted && !out.IsSnapshot() {
+			relinked := true
+			intact := prevOrigin == origin && prevOn == out.BaselineOn && !prevCompacted
+			switch {
+			case !intact && trackers[origin].known:
+				out.makeSnapshot(trackers[origin].followers, trackers[origin].friends)
+			case !intact:
+				out.Compacted = true
+				out.SnapshotOn = ""
+			case out.SnapshotOn != lastSnapshotOn:
+				out.SnapshotOn = lastSnapshotOn
+			default:
+				relinked = false
+			}
+			if relinked && action == "" {
+				action = "relinked"
+				r.States.Updated++
+			}
+		}
+
+		if action == "" {
+			r.States.Unchanged++
+		} else {
+			r.change("state %s: %s", key, action)
+			if err := tx.Save(out); err != nil {
+				return errors.Wrapf(err, "error saving state %s", key)
+			}
+		}
+
+		prevOrigin, prevOn, prevCompacted = origin, out.StateOn, out.Compacted
+		if out.IsSnapshot() {
+			lastSnapshotOn = out.StateOn
+		}
+	}
+	return nil
+}
+
+// NewDBMergeSource returns merge source reading from another DB,
+// masterKey is the key access tokens are encrypted with in that DB
+func NewDBMergeSource(db *storm.DB, masterKey []byte) MergeSource {
+	return &dbMergeSource{db: db, masterKey: masterKey}
+}
+
+type dbMergeSource struct {
+	db        *storm.DB
+	masterKey []byte
+}
+
+func (s *dbMergeSource) Users() ([]User, error) {
+	var list []User
+	if err := s.db.All(&list); err != nil {
+		return nil, err
+	}
+	for i := range list {
+		// on error user stays encrypted
+		_ = list[i].Decrypt(s.masterKey)
+	}
+	return list, nil
+}
+
+func (s *dbMergeSource) Profiles() ([]Profile, error) {
+	var list []Profile
+	if err := s.db.All(&list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (s *dbMergeSource) Usernames() ([]string, error) {
+	var users []User
+	if err := s.db.All(&users); err != nil {
+		return nil, err
+	}
+	list := make([]string, 0, len(users))
+	for _, u := range users {
+		list = append(list, u.Username)
+	}
+	return list, nil
+}
+
+func (s *dbMergeSource) Settings(username string) (*UserSettings, error) {
+	return GetUserSettings(s.db, username)
+}
+
+func (s *dbMergeSource) StateDateRange(username string) (first, last time.Time, err error) {
+	return GetStateDateRange(s.db, username)
+}
+
+func (s *dbMergeSource) State(username string, day time.Time) (*DailyState, error) {
+	var st DailyState
+	if err := s.db.One("Key", GetDailyStateKey(username, day), &st); err != nil {
+		if err == storm.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &st, nil
+}
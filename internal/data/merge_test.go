@@ -0,0 +1,183 @@
+package data
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/mchmarny/followme/pkg/envelope"
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/mchmarny/followme/pkg/list"
+	"github.com/stretchr/testify/assert"
+)
+
+// saveTestStates saves one state a day with snapshot on the first day and deltas after
+func saveTestStates(t *testing.T, db *storm.DB, username string, start time.Time, updated time.Time, lists ...[]int64) {
+	var prev []int64
+	for i, ids := range lists {
+		d := start.AddDate(0, 0, i)
+		s := &DailyState{
+			Key:           GetDailyStateKey(username, d),
+			Username:      username,
+			StateOn:       format.ToISODate(d),
+			UpdatedOn:     updated,
+			FollowerCount: len(ids),
+			SnapshotOn:    format.ToISODate(start),
+		}
+		if i == 0 {
+			s.Followers = ids
+		} else {
+			s.BaselineOn = format.ToISODate(d.AddDate(0, 0, -1))
+			s.NewFollowers = list.GetDiff(prev, ids)
+			s.NewUnfollowers = list.GetDiff(ids, prev)
+		}
+		prev = ids
+		assert.NoError(t, db.Save(s))
+	}
+}
+
+func TestMerge(t *testing.T) {
+	dir := t.TempDir()
+	db, err := GetDB(path.Join(dir, "target.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+	srcDB, err := GetDB(path.Join(dir, "source.db"))
+	assert.NoError(t, err)
+	defer srcDB.Close()
+
+	username := "tester"
+	day1 := time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)
+	old := day1
+	newer := day1.Add(time.Hour)
+
+	// target has days 1-3, source has days 2-5 with newer day 3
+	assert.NoError(t, db.Save(&User{Username: username, UpdatedAt: old}))
+	assert.NoError(t, db.Save(&Profile{ID: 1, Username: username, Name: "old", UpdatedAt: old}))
+	saveTestStates(t, db, username, day1, old, []int64{1}, []int64{1, 2}, []int64{1, 2, 3})
+
+	assert.NoError(t, srcDB.Save(&User{Username: username, UpdatedAt: newer}))
+	assert.NoError(t, srcDB.Save(&Profile{ID: 1, Username: username, Name: "new", UpdatedAt: newer}))
+	assert.NoError(t, srcDB.Save(&Profile{ID: 2, Username: "other", UpdatedAt: newer}))
+	saveTestStates(t, srcDB, username, day1.AddDate(0, 0, 1), newer,
+		[]int64{1, 2}, []int64{2, 3, 4}, []int64{3, 4}, []int64{3, 4, 5})
+
+	src := NewDBMergeSource(srcDB, nil)
+
+	r, err := Merge(db, src, nil, true)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, r.Users.Updated)
+	assert.Equal(t, MergeCounts{Added: 1, Updated: 1}, r.Profiles)
+	assert.Equal(t, 2, r.States.Added)
+	assert.Equal(t, 2, r.States.Updated)
+	assert.NotEmpty(t, r.Changes)
+
+	// dry run
+	var s DailyState
+	assert.Equal(t, storm.ErrNotFound, db.One("Key", GetDailyStateKey(username, day1.AddDate(0, 0, 4)), &s))
+
+	r2, err := Merge(db, src, nil, false)
+	assert.NoError(t, err)
+	assert.Equal(t, r, r2)
+
+	var p Profile
+	assert.NoError(t, db.One("ID", int64(1), &p))
+	assert.Equal(t, "new", p.Name)
+
+	expected := [][]int64{{1}, {1, 2}, {2, 3, 4}, {3, 4}, {3, 4, 5}}
+	for i, ids := range expected {
+		full, err := GetFullState(db, username, day1.AddDate(0, 0, i))
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, ids, full.Followers, "day %d", i)
+	}
+
+	// merging again changes nothing
+	r, err = Merge(db, src, nil, false)
+	assert.NoError(t, err)
+	assert.Empty(t, r.Changes)
+	assert.Equal(t, 5, r.States.Unchanged)
+
+	t.Run("relink", func(t *testing.T) {
+		src2DB, err := GetDB(path.Join(dir, "source2.db"))
+		assert.NoError(t, err)
+		defer src2DB.Close()
+
+		// newer day 4 which baseline (day 3) is older than the one in target
+		saveTestStates(t, src2DB, username, day1.AddDate(0, 0, 2), newer.Add(time.Hour), []int64{9}, []int64{9, 10})
+		assert.NoError(t, src2DB.UpdateField(&DailyState{Key: GetDailyStateKey(username, day1.AddDate(0, 0, 2))}, "UpdatedOn", old))
+		assert.NoError(t, src2DB.Save(&User{Username: username, UpdatedAt: old}))
+
+		r, err := Merge(db, NewDBMergeSource(src2DB, nil), nil, false)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, r.States.Updated)
+		assert.Contains(t, r.Changes, "state tester-2021-01-14: relinked")
+
+		expected := [][]int64{{1}, {1, 2}, {2, 3, 4}, {9, 10}, {3, 4, 5}}
+		for i, ids := range expected {
+			full, err := GetFullState(db, username, day1.AddDate(0, 0, i))
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, ids, full.Followers, "day %d", i)
+		}
+	})
+}
+
+func TestMergeEncryptedUsers(t *testing.T) {
+	dir := t.TempDir()
+	db, err := GetDB(path.Join(dir, "target.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+	srcDB, err := GetDB(path.Join(dir, "source.db"))
+	assert.NoError(t, err)
+	defer srcDB.Close()
+
+	key := envelope.DeriveKey("target")
+	srcKey := envelope.DeriveKey("source")
+	old := time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)
+	newer := old.Add(time.Hour)
+
+	assert.NoError(t, SaveUser(db, &User{Username: "tester", AccessTokenKey: "k1", AccessTokenSecret: "s1", UpdatedAt: old}, key))
+	assert.NoError(t, SaveWebhook(db, &Webhook{Username: "tester", URL: "https://example.com/hook", Secret: "shh"}, key))
+	var before User
+	assert.NoError(t, db.One("Username", "tester", &before))
+
+	assert.NoError(t, SaveUser(srcDB, &User{Username: "tester", AccessTokenKey: "k2", AccessTokenSecret: "s2", UpdatedAt: newer}, srcKey))
+	assert.NoError(t, SaveUser(srcDB, &User{Username: "other", AccessTokenKey: "k3", AccessTokenSecret: "s3", UpdatedAt: newer}, srcKey))
+
+	t.Run("unknown source key", func(t *testing.T) {
+		r, err := Merge(db, NewDBMergeSource(srcDB, nil), key, true)
+		assert.NoError(t, err)
+		assert.Equal(t, MergeCounts{Unchanged: 2}, r.Users)
+		assert.ElementsMatch(t, []string{"tester", "other"}, r.Relogin)
+	})
+
+	t.Run("invalid target key", func(t *testing.T) {
+		_, err := Merge(db, NewDBMergeSource(srcDB, srcKey), envelope.DeriveKey("other"), false)
+		assert.Error(t, err)
+		_, err = Merge(db, NewDBMergeSource(srcDB, srcKey), nil, false)
+		assert.Error(t, err)
+	})
+
+	t.Run("re-encrypt", func(t *testing.T) {
+		r, err := Merge(db, NewDBMergeSource(srcDB, srcKey), key, false)
+		assert.NoError(t, err)
+		assert.Equal(t, MergeCounts{Added: 1, Updated: 1}, r.Users)
+		assert.Empty(t, r.Relogin)
+
+		u, err := GetUser(db, "tester", key)
+		assert.NoError(t, err)
+		assert.Equal(t, "k2", u.AccessTokenKey)
+		assert.Equal(t, "s2", u.AccessTokenSecret)
+
+		// existing data key is kept so webhook secret can still be decrypted
+		var after User
+		assert.NoError(t, db.One("Username", "tester", &after))
+		assert.Equal(t, before.DataKey, after.DataKey)
+		hooks, err := GetWebhooks(db, "tester", key)
+		assert.NoError(t, err)
+		assert.Equal(t, "shh", hooks[0].Secret)
+
+		u, err = GetUser(db, "other", key)
+		assert.NoError(t, err)
+		assert.Equal(t, "k3", u.AccessTokenKey)
+	})
+}
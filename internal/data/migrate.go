@@ -0,0 +1,181 @@
+package data
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/asdine/storm/v3/index"
+	"github.com/mchmarny/followme/pkg/date"
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/mchmarny/followme/pkg/list"
+	"github.com/pkg/errors"
+)
+
+// MigrateStates converts daily states of all users with full follower and friend lists
+// into a full snapshot every SnapshotIntervalDays and daily deltas in between.
+// Deltas are recomputed against the previous existing state. Safe to run multiple times.
+func MigrateStates(db *storm.DB) (int, error) {
+	var users []User
+	if err := db.All(&users); err != nil {
+		return 0, errors.Wrap(err, "error getting users")
+	}
+
+	total := 0
+	for _, u := range users {
+		n, err := migrateUserStates(db, u.Username)
+		if err != nil {
+			return total, errors.Wrapf(err, "error migrating states for %s", u.Username)
+		}
+		total += n
+	}
+	return total, nil
+}
+
+func migrateUserStates(db *storm.DB, username string) (int, error) {
+	tx, err := db.Begin(true)
+	if err != nil {
+		return 0, errors.Wrap(err, "error starting transaction")
+	}
+	defer tx.Rollback()
+
+	count, err := relinkUserStates(tx, username)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, errors.Wrap(err, "error committing migrated states")
+	}
+	return count, nil
+}
+
+// relinkUserStates recomputes deltas of the user states against the previous existing state
+// and stores full lists every SnapshotIntervalDays, returns number of states saved
+func relinkUserStates(tx storm.Node, username string) (int, error) {
+	first, last, err := GetStateDateRange(tx, username)
+	if err != nil || first.IsZero() {
+		return 0, err
+	}
+
+	var prev *DailyState
+	count := 0
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		var s DailyState
+		if err := tx.One("Key", GetDailyStateKey(username, day), &s); err != nil {
+			if err == storm.ErrNotFound {
+				continue
+			}
+			return 0, errors.Wrapf(err, "error getting state for %v", day)
+		}
+		// lists removed by compaction, next state is a snapshot
+		if s.Compacted {
+			prev = nil
+			continue
+		}
+		if s.StateOn == "" {
+			s.StateOn = format.ToISODate(day)
+		}
+
+		// full lists of this state, deltas of already migrated states are applied to previous lists
+		followers, friends := s.Followers, s.Friends
+		if !s.IsSnapshot() && s.Followers == nil && s.Friends == nil {
+			if prev == nil {
+				return 0, errors.Errorf("delta state %s has no baseline", s.Key)
+			}
+			followers = applyDelta(prev.Followers, s.NewFollowers, s.NewUnfollowers)
+			friends = applyDelta(prev.Friends, s.NewFriends, s.NewUnfriended)
+		}
+
+		snapshot := prev == nil
+		if prev != nil {
+			gap, err := date.GetDaysBetween(prev.StateOn, s.StateOn)
+			if err != nil {
+				return 0, err
+			}
+			sinceSnapshot, err := date.GetDaysBetween(prev.LastSnapshotOn(), s.StateOn)
+			if err != nil {
+				return 0, err
+			}
+			snapshot = sinceSnapshot >= SnapshotIntervalDays
+
+			s.BaselineOn = prev.StateOn
+			s.GapDays = gap - 1
+			s.NewFollowers = list.GetDiff(prev.Followers, followers)
+			s.NewUnfollowers = list.GetDiff(followers, prev.Followers)
+			s.NewFriends = list.GetDiff(prev.Friends, friends)
+			s.NewUnfriended = list.GetDiff(friends, prev.Friends)
+			s.NewFollowerCount = len(s.NewFollowers)
+			s.NewUnfollowerCount = len(s.NewUnfollowers)
+			s.NewFriendsCount = len(s.NewFriends)
+			s.NewUnfriendedCount = len(s.NewUnfriended)
+		}
+
+		s.FollowerCount = len(followers)
+		s.FriendsCount = len(friends)
+		if snapshot {
+			s.SnapshotOn = s.StateOn
+			s.Followers = followers
+			s.Friends = friends
+		} else {
+			s.SnapshotOn = prev.LastSnapshotOn()
+			s.Followers = nil
+			s.Friends = nil
+		}
+
+		if err := tx.Save(&s); err != nil {
+			return 0, errors.Wrapf(err, "error saving state %s", s.Key)
+		}
+		count++
+
+		prev = &DailyState{
+			StateOn:    s.StateOn,
+			BaselineOn: s.BaselineOn,
+			SnapshotOn: s.SnapshotOn,
+			Followers:  followers,
+			Friends:    friends,
+		}
+	}
+	return count, nil
+}
+
+// GetStateDateRange returns dates of the first and last state of the user, zero if user has none
+func GetStateDateRange(db storm.Node, username string) (first, last time.Time, err error) {
+	prefix := fmt.Sprintf("%s-", format.NormalizeString(username))
+
+	if first, err = getEdgeStateDate(db, prefix, false); err != nil {
+		return first, last, errors.Wrapf(err, "error getting first state for %s", username)
+	}
+	if first.IsZero() {
+		return first, last, nil
+	}
+	if last, err = getEdgeStateDate(db, prefix, true); err != nil {
+		return first, last, errors.Wrapf(err, "error getting last state for %s", username)
+	}
+	return first, last, nil
+}
+
+// getEdgeStateDate returns date of the first (or last when reversed) state with the prefix,
+// skipping states of other users matched by the prefix, zero if there is none
+func getEdgeStateDate(db storm.Node, prefix string, reverse bool) (time.Time, error) {
+	for skip := 0; ; skip++ {
+		opts := []func(*index.Options){storm.Limit(1), storm.Skip(skip)}
+		if reverse {
+			opts = append(opts, storm.Reverse())
+		}
+
+		var states []DailyState
+		if err := db.Prefix("Key", prefix, &states, opts...); err != nil {
+			if err == storm.ErrNotFound {
+				return time.Time{}, nil
+			}
+			return time.Time{}, err
+		}
+		if len(states) == 0 {
+			return time.Time{}, nil
+		}
+		if isUserKey(states[0].Key, prefix, format.ISODateLayout) {
+			return time.Parse(format.ISODateLayout, states[0].Key[len(prefix):])
+		}
+	}
+}
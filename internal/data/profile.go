@@ -1,7 +1,16 @@
 package data
 
 import (
+	"context"
 	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultProfileTTL is the default time after which cached profiles are refreshed
+	DefaultProfileTTL = 24 * time.Hour
 )
 
 // Profile represents simplified Twitter user profile
@@ -44,3 +53,94 @@ func (p *Profile) FormattedUpdatedAt() string {
 	}
 	return p.UpdatedAt.Format(time.RFC822)
 }
+
+// IsStale checks if the profile was updated more than ttl before now
+func (p *Profile) IsStale(ttl time.Duration, now time.Time) bool {
+	return now.Sub(p.UpdatedAt) > ttl
+}
+
+// GetCachedProfiles returns stored profiles by ID, IDs without profile are not included
+func GetCachedProfiles(db *storm.DB, ids []int64) (map[int64]*Profile, error) {
+	m := make(map[int64]*Profile, len(ids))
+	for _, id := range ids {
+		var p Profile
+		if err := db.One("ID", id, &p); err != nil {
+			if err == storm.ErrNotFound {
+				continue
+			}
+			return nil, errors.Wrapf(err, "error getting profile %d", id)
+		}
+		m[id] = &p
+	}
+	return m, nil
+}
+
+// SaveProfiles saves profiles, stored profile with the same username
+// but different ID is removed as Twitter usernames can be reused
+func SaveProfiles(db *storm.DB, profiles []*Profile) error {
+	tx, err := db.Begin(true)
+	if err != nil {
+		return errors.Wrap(err, "error starting transaction")
+	}
+	defer tx.Rollback()
+
+	for _, p := range profiles {
+		var existing Profile
+		err := tx.One("Username", p.Username, &existing)
+		if err != nil && err != storm.ErrNotFound {
+			return errors.Wrapf(err, "error getting profile %s", p.Username)
+		}
+		if err == nil && existing.ID != p.ID {
+			if err := tx.DeleteStruct(&existing); err != nil {
+				return errors.Wrapf(err, "error deleting profile %d", existing.ID)
+			}
+		}
+		if err := tx.Save(p); err != nil {
+			return errors.Wrapf(err, "error saving profile %d", p.ID)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetProfiles returns profiles for the IDs in the same order. Cached profiles
+// older than ttl are refreshed from the provider. Stale profiles are still returned
+// when the provider no longer does (e.g. suspended or deleted accounts).
+// On provider error, the cached profiles are returned along with the error.
+func GetProfiles(ctx context.Context, db *storm.DB, provider GraphProvider, byUser *User,
+	ids []int64, ttl time.Duration, now time.Time) ([]*Profile, error) {
+	cached, err := GetCachedProfiles(db, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	refresh := make([]int64, 0)
+	for _, id := range ids {
+		if p, ok := cached[id]; !ok || p.IsStale(ttl, now) {
+			refresh = append(refresh, id)
+		}
+	}
+
+	var providerErr error
+	if len(refresh) > 0 {
+		fresh, err := provider.GetUserDetailsFromIDs(ctx, byUser, refresh)
+		if err != nil {
+			providerErr = errors.Wrap(err, "error getting profiles")
+		} else if len(fresh) > 0 {
+			if err := SaveProfiles(db, fresh); err != nil {
+				return nil, errors.Wrap(err, "error caching profiles")
+			}
+			for _, p := range fresh {
+				cached[p.ID] = p
+			}
+		}
+	}
+
+	list := make([]*Profile, 0, len(ids))
+	for _, id := range ids {
+		if p, ok := cached[id]; ok {
+			list = append(list, p)
+		}
+	}
+	return list, providerErr
+}
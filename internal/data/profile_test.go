@@ -0,0 +1,79 @@
+package data
+
+import (
+	"context"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type testProfileProvider struct {
+	GraphProvider
+	profiles map[int64]*Profile
+	calls    [][]int64
+	err      error
+}
+
+func (p *testProfileProvider) GetUserDetailsFromIDs(ctx context.Context, byUser *User, ids []int64) ([]*Profile, error) {
+	p.calls = append(p.calls, ids)
+	if p.err != nil {
+		return nil, p.err
+	}
+	list := make([]*Profile, 0)
+	for _, id := range ids {
+		if v, ok := p.profiles[id]; ok {
+			c := *v
+			list = append(list, &c)
+		}
+	}
+	return list, nil
+}
+
+func TestProfileCache(t *testing.T) {
+	db, err := GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	now := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+	p := &testProfileProvider{profiles: map[int64]*Profile{
+		1: {ID: 1, Username: "one", UpdatedAt: now},
+		2: {ID: 2, Username: "two", UpdatedAt: now},
+	}}
+	ctx := context.Background()
+	u := &User{Username: "tester"}
+
+	list, err := GetProfiles(ctx, db, p, u, []int64{2, 1}, time.Hour, now)
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, int64(2), list[0].ID)
+	assert.Len(t, p.calls, 1)
+
+	// cached
+	list, err = GetProfiles(ctx, db, p, u, []int64{1, 2}, time.Hour, now.Add(time.Minute))
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Len(t, p.calls, 1)
+
+	// account 2 suspended, stale profile still returned
+	delete(p.profiles, 2)
+	list, err = GetProfiles(ctx, db, p, u, []int64{1, 2}, time.Hour, now.Add(2*time.Hour))
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Len(t, p.calls, 2)
+
+	// provider error, cached profiles returned with error
+	p.err = errors.New("rate limit")
+	list, err = GetProfiles(ctx, db, p, u, []int64{1, 2, 3}, time.Hour, now.Add(3*time.Hour))
+	assert.Error(t, err)
+	assert.Len(t, list, 2)
+
+	// username reused by another account
+	assert.NoError(t, SaveProfiles(db, []*Profile{{ID: 3, Username: "one", UpdatedAt: now}}))
+	cached, err := GetCachedProfiles(db, []int64{1, 3})
+	assert.NoError(t, err)
+	assert.Len(t, cached, 1)
+	assert.Equal(t, "one", cached[3].Username)
+}
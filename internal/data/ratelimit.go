@@ -0,0 +1,95 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/pkg/errors"
+)
+
+type rateLimitWaitKey struct{}
+
+// RateLimit represents the API rate limit state of a single endpoint for a user
+type RateLimit struct {
+	Key       string    `storm:"id" json:"key"`
+	Username  string    `storm:"index" json:"username"`
+	Endpoint  string    `json:"endpoint"`
+	Limit     int       `json:"limit"`
+	Remaining int       `json:"remaining"`
+	ResetAt   time.Time `json:"reset_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IsExhausted checks if no requests remain before the limit resets
+func (l *RateLimit) IsExhausted(now time.Time) bool {
+	return l.Remaining <= 0 && l.ResetAt.After(now)
+}
+
+// RateLimitError indicates the API rate limit of the endpoint was reached
+type RateLimitError struct {
+	Endpoint string
+	ResetAt  time.Time
+}
+
+// Error returns the error message
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limit of %s reached, resets at %s",
+		e.Endpoint, e.ResetAt.UTC().Format(time.RFC3339))
+}
+
+// GetRateLimitKey returns key of the endpoint rate limit
+func GetRateLimitKey(username, endpoint string) string {
+	return fmt.Sprintf("%s-%s", username, endpoint)
+}
+
+// RateLimitStore persists the last known API rate limits so they are visible to other processes
+type RateLimitStore interface {
+	// SaveRateLimit saves the rate limit, replacing the previous one of the endpoint
+	SaveRateLimit(l *RateLimit) error
+}
+
+// NewRateLimitStore returns rate limit store backed by the DB
+func NewRateLimitStore(db *storm.DB) RateLimitStore {
+	return &dbRateLimitStore{db: db}
+}
+
+type dbRateLimitStore struct {
+	db *storm.DB
+}
+
+func (s *dbRateLimitStore) SaveRateLimit(l *RateLimit) error {
+	l.Key = GetRateLimitKey(l.Username, l.Endpoint)
+	if err := s.db.Save(l); err != nil {
+		return errors.Wrapf(err, "error saving %s rate limit for %s", l.Endpoint, l.Username)
+	}
+	return nil
+}
+
+// GetRateLimits returns the last known rate limits of the user sorted by endpoint
+func GetRateLimits(db *storm.DB, username string) ([]*RateLimit, error) {
+	list := make([]*RateLimit, 0)
+	if err := db.Find("Username", username, &list); err != nil && err != storm.ErrNotFound {
+		return nil, errors.Wrapf(err, "error getting rate limits for %s", username)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Endpoint < list[j].Endpoint
+	})
+	return list, nil
+}
+
+// WithRateLimitWait returns context allowing provider to wait up to d for rate limit to reset
+// before failing with RateLimitError
+func WithRateLimitWait(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, rateLimitWaitKey{}, d)
+}
+
+// GetRateLimitWait returns max rate limit wait set on context, 0 when not set
+func GetRateLimitWait(ctx context.Context) time.Duration {
+	if d, ok := ctx.Value(rateLimitWaitKey{}).(time.Duration); ok {
+		return d
+	}
+	return 0
+}
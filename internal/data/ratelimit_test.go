@@ -0,0 +1,37 @@
+package data
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimitStore(t *testing.T) {
+	db, err := GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	s := NewRateLimitStore(db)
+	now := time.Now().UTC()
+
+	list, err := GetRateLimits(db, "tester")
+	assert.NoError(t, err)
+	assert.Empty(t, list)
+
+	assert.NoError(t, s.SaveRateLimit(&RateLimit{Username: "tester", Endpoint: "friends/ids", Limit: 15, Remaining: 3, UpdatedAt: now}))
+	assert.NoError(t, s.SaveRateLimit(&RateLimit{Username: "tester", Endpoint: "followers/ids", Limit: 15, Remaining: 5, UpdatedAt: now}))
+	assert.NoError(t, s.SaveRateLimit(&RateLimit{Username: "other", Endpoint: "followers/ids", Limit: 15, UpdatedAt: now}))
+
+	// replaces the previous limit of the endpoint
+	assert.NoError(t, s.SaveRateLimit(&RateLimit{Username: "tester", Endpoint: "followers/ids", Limit: 15, ResetAt: now.Add(time.Minute), UpdatedAt: now}))
+
+	list, err = GetRateLimits(db, "tester")
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, "followers/ids", list[0].Endpoint)
+	assert.True(t, list[0].IsExhausted(now))
+	assert.Equal(t, "friends/ids", list[1].Endpoint)
+	assert.False(t, list[1].IsExhausted(now))
+}
@@ -0,0 +1,147 @@
+package data
+
+import (
+	"fmt"
+
+	"github.com/asdine/storm/v3"
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/pkg/errors"
+)
+
+// relationChange is a change of the relationship with a single account
+type relationChange struct {
+	On string
+	// Follower is set for changes of the follower list, friend list otherwise
+	Follower bool
+	// In is set when the account was added to the list, removed otherwise
+	In bool
+	// Existing is set for accounts found in the first full lists without an earlier change,
+	// the relationship existed before tracking started so the change has no real date
+	Existing bool
+}
+
+// relationReplay is the relationship of the user with each account, built by replaying the states in date order
+type relationReplay struct {
+	// TrackedSince is the date of the first state with full lists
+	TrackedSince string
+	// UpdatedOn is the date of the last state
+	UpdatedOn string
+	Changes   map[int64][]*relationChange
+
+	keep      func(id int64) bool
+	followers map[int64]bool
+	friends   map[int64]bool
+}
+
+// newRelationReplay creates replay of the accounts keep returns true for, all accounts when keep is nil
+func newRelationReplay(keep func(id int64) bool) *relationReplay {
+	if keep == nil {
+		keep = func(int64) bool { return true }
+	}
+	return &relationReplay{
+		Changes:   make(map[int64][]*relationChange),
+		keep:      keep,
+		followers: make(map[int64]bool),
+		friends:   make(map[int64]bool),
+	}
+}
+
+// replayUser applies all states of the user, read in a single scan in key (date) order
+func (r *relationReplay) replayUser(db storm.Node, username string) error {
+	prefix := fmt.Sprintf("%s-", format.NormalizeString(username))
+	var states []DailyState
+	if err := db.Prefix("Key", prefix, &states); err != nil {
+		if err == storm.ErrNotFound {
+			return nil
+		}
+		return errors.Wrapf(err, "error getting states of %s", username)
+	}
+	for i := range states {
+		if !isUserKey(states[i].Key, prefix, format.ISODateLayout) {
+			continue
+		}
+		r.apply(states[i].Key[len(prefix):], &states[i])
+	}
+	return nil
+}
+
+// apply adds changes of the state. Deltas date the changes, full lists are authoritative and
+// catch changes the deltas don't have (e.g. states compacted in between).
+func (r *relationReplay) apply(on string, s *DailyState) {
+	r.UpdatedOn = on
+	full := s.hasLists()
+	existing := full && r.TrackedSince == ""
+	if existing {
+		r.TrackedSince = on
+	}
+	r.applyList(on, true, r.followers, full, existing, s.Followers, s.NewFollowers, s.NewUnfollowers)
+	r.applyList(on, false, r.friends, full, existing, s.Friends, s.NewFriends, s.NewUnfriended)
+}
+
+func (r *relationReplay) applyList(on string, follower bool, set map[int64]bool, full, existing bool, ids, added, removed []int64) {
+	change := func(id int64, in, existing bool) {
+		if in {
+			set[id] = true
+		} else {
+			delete(set, id)
+		}
+		r.Changes[id] = append(r.Changes[id], &relationChange{On: on, Follower: follower, In: in, Existing: existing})
+	}
+
+	for _, id := range added {
+		if r.keep(id) && !set[id] {
+			change(id, true, false)
+		}
+	}
+	// before the first full lists the set is incomplete, removals are still real changes
+	for _, id := range removed {
+		if r.keep(id) && (set[id] || r.TrackedSince == "") {
+			change(id, false, false)
+		}
+	}
+	if !full {
+		return
+	}
+
+	listed := make(map[int64]bool)
+	for _, id := range ids {
+		if !r.keep(id) {
+			continue
+		}
+		listed[id] = true
+		if !set[id] {
+			change(id, true, existing)
+		}
+	}
+	for id := range set {
+		if !listed[id] {
+			change(id, false, false)
+		}
+	}
+}
+
+// relationEvents returns dated events of the changes, refollows are the follows after an unfollow
+func relationEvents(changes []*relationChange) []*DayEvent {
+	events := make([]*DayEvent, 0, len(changes))
+	unfollowed := false
+	for _, c := range changes {
+		if c.Existing {
+			continue
+		}
+		var eventType string
+		switch {
+		case c.Follower && c.In && unfollowed:
+			eventType = RefollowedEventType
+		case c.Follower && c.In:
+			eventType = FollowedEventType
+		case c.Follower:
+			eventType, unfollowed = UnfollowedEventType, true
+		case c.In:
+			eventType = FriendedEventType
+		default:
+			eventType = UnfriendedEventType
+		}
+		events = append(events, &DayEvent{EventDate: c.On, EventType: eventType})
+	}
+	return events
+}
@@ -0,0 +1,116 @@
+package data
+
+import (
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/pkg/errors"
+)
+
+const (
+	// UserRunUpdated indicates the user state was updated
+	UserRunUpdated = "updated"
+	// UserRunSkipped indicates the user state was fresh so it wasn't updated
+	UserRunSkipped = "skipped"
+	// UserRunFailed indicates the user state update failed
+	UserRunFailed = "failed"
+
+	// MaxRunReports is the number of most recent worker run reports kept in DB
+	MaxRunReports = 100
+)
+
+// UserRunResult represents outcome of a single user update in a worker run
+type UserRunResult struct {
+	Username           string        `json:"username"`
+	Status             string        `json:"status"`
+	Error              string        `json:"error,omitempty"`
+	StartedAt          time.Time     `json:"started_at"`
+	Duration           time.Duration `json:"duration"`
+	FollowerCount      int           `json:"follower_count"`
+	FriendCount        int           `json:"friend_count"`
+	NewFollowerCount   int           `json:"new_follower_count"`
+	NewUnfollowerCount int           `json:"new_unfollower_count"`
+	NewFriendCount     int           `json:"new_friend_count"`
+	NewUnfriendedCount int           `json:"new_unfriended_count"`
+}
+
+// FormattedStartedAt returns RFC822 formatted StartedAt
+func (r *UserRunResult) FormattedStartedAt() string {
+	return r.StartedAt.Format(time.RFC822)
+}
+
+// FormattedDuration returns Duration rounded to milliseconds
+func (r *UserRunResult) FormattedDuration() string {
+	return r.Duration.Round(time.Millisecond).String()
+}
+
+// RunReport represents outcome of a single worker run
+type RunReport struct {
+	ID         int              `storm:"id,increment" json:"id"`
+	Version    string           `json:"version"`
+	StartedAt  time.Time        `json:"started_at"`
+	FinishedAt time.Time        `json:"finished_at"`
+	Error      string           `json:"error,omitempty"`
+	Users      []*UserRunResult `json:"users"`
+}
+
+// Count returns the number of users with the status
+func (r *RunReport) Count(status string) int {
+	n := 0
+	for _, u := range r.Users {
+		if u.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// Duration returns the time the run took
+func (r *RunReport) Duration() time.Duration {
+	return r.FinishedAt.Sub(r.StartedAt)
+}
+
+// GetUser returns result of the user, nil if the user wasn't part of the run
+func (r *RunReport) GetUser(username string) *UserRunResult {
+	for _, u := range r.Users {
+		if u.Username == username {
+			return u
+		}
+	}
+	return nil
+}
+
+// SaveRunReport saves the report and deletes the ones older than the MaxRunReports most recent
+func SaveRunReport(db *storm.DB, r *RunReport) error {
+	tx, err := db.Begin(true)
+	if err != nil {
+		return errors.Wrap(err, "error starting transaction")
+	}
+	defer tx.Rollback()
+
+	if err := tx.Save(r); err != nil {
+		return errors.Wrap(err, "error saving run report")
+	}
+
+	var old []*RunReport
+	err = tx.All(&old, storm.Reverse(), storm.Skip(MaxRunReports))
+	if err != nil && err != storm.ErrNotFound {
+		return errors.Wrap(err, "error getting old run reports")
+	}
+	for _, o := range old {
+		if err := tx.DeleteStruct(o); err != nil {
+			return errors.Wrapf(err, "error deleting run report %d", o.ID)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetRunReports returns up to limit most recent run reports, newest first
+func GetRunReports(db *storm.DB, limit int) ([]*RunReport, error) {
+	list := make([]*RunReport, 0)
+	if err := db.All(&list, storm.Reverse(), storm.Limit(limit)); err != nil && err != storm.ErrNotFound {
+		return nil, errors.Wrap(err, "error getting run reports")
+	}
+	return list, nil
+}
@@ -0,0 +1,50 @@
+package data
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunReports(t *testing.T) {
+	db, err := GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	list, err := GetRunReports(db, 10)
+	assert.NoError(t, err)
+	assert.Empty(t, list)
+
+	start := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < MaxRunReports+5; i++ {
+		r := &RunReport{
+			Version:    "v0.0.1-test",
+			StartedAt:  start.Add(time.Duration(i) * time.Hour),
+			FinishedAt: start.Add(time.Duration(i)*time.Hour + time.Minute),
+			Users: []*UserRunResult{
+				{Username: "tester", Status: UserRunUpdated},
+				{Username: "other", Status: UserRunFailed, Error: "test"},
+			},
+		}
+		assert.NoError(t, SaveRunReport(db, r))
+		assert.Equal(t, i+1, r.ID)
+	}
+
+	list, err = GetRunReports(db, 3)
+	assert.NoError(t, err)
+	assert.Len(t, list, 3)
+	assert.Equal(t, MaxRunReports+5, list[0].ID)
+	assert.Equal(t, MaxRunReports+3, list[2].ID)
+	assert.Equal(t, time.Minute, list[0].Duration())
+	assert.Equal(t, 1, list[0].Count(UserRunFailed))
+	assert.Equal(t, "test", list[0].GetUser("other").Error)
+	assert.Nil(t, list[0].GetUser("nobody"))
+
+	// only the most recent ones are kept
+	list, err = GetRunReports(db, MaxRunReports*2)
+	assert.NoError(t, err)
+	assert.Len(t, list, MaxRunReports)
+	assert.Equal(t, 6, list[len(list)-1].ID)
+}
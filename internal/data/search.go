@@ -0,0 +1,179 @@
+package data
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/asdine/storm/v3"
+	"github.com/asdine/storm/v3/q"
+	"github.com/pkg/errors"
+)
+
+const (
+	// FollowerRelation matches accounts which follow the user
+	FollowerRelation = "follower"
+	// FriendRelation matches accounts the user follows
+	FriendRelation = "friend"
+	// MutualRelation matches accounts which follow the user and the user follows
+	MutualRelation = "mutual"
+	// FormerFollowerRelation matches accounts which followed the user before but don't anymore
+	FormerFollowerRelation = "former"
+)
+
+// AccountQuery represents account search criteria, empty fields match all accounts
+type AccountQuery struct {
+	// Text is matched case-insensitively against username, name, description and location
+	Text string
+	// Relation is the current relationship with the user (e.g. FollowerRelation)
+	Relation string
+	// ChangedFrom and ChangedTo are the ISO date range (inclusive) of the last change
+	ChangedFrom string
+	ChangedTo   string
+}
+
+// AccountMatch represents account found by search
+type AccountMatch struct {
+	Profile        *Profile `json:"profile"`
+	Follower       bool     `json:"follower"`
+	Friend         bool     `json:"friend"`
+	FormerFollower bool     `json:"former_follower"`
+	// LastEventOn is the date of the most recent event of the account
+	LastEventOn   string `json:"last_event_on"`
+	LastEventType string `json:"last_event_type"`
+}
+
+// IsValidRelation checks if the relation is one of the supported ones or empty (any)
+func IsValidRelation(relation string) bool {
+	switch relation {
+	case "", FollowerRelation, FriendRelation, MutualRelation, FormerFollowerRelation:
+		return true
+	default:
+		return false
+	}
+}
+
+// SearchAccounts returns accounts the user ever had relationship with, and which have cached
+// profile, matching the query. Results are ordered by the date of the last event, newest first.
+func SearchAccounts(db *storm.DB, username string, q *AccountQuery) ([]*AccountMatch, error) {
+	if q == nil {
+		q = &AccountQuery{}
+	}
+	if !IsValidRelation(q.Relation) {
+		return nil, errors.Errorf("invalid relation: %s", q.Relation)
+	}
+
+	profiles, err := findProfiles(db, q.Text)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int64]*Profile, len(profiles))
+	for i := range profiles {
+		byID[profiles[i].ID] = &profiles[i]
+	}
+
+	// only the accounts with matching profile are replayed
+	r := newRelationReplay(func(id int64) bool { return byID[id] != nil })
+	if err := r.replayUser(db, username); err != nil {
+		return nil, err
+	}
+
+	list := make([]*AccountMatch, 0)
+	for id, changes := range r.Changes {
+		m := newAccountMatch(changes)
+		if m.matchesRelation(q.Relation) && m.matchesChangedOn(q.ChangedFrom, q.ChangedTo) {
+			m.Profile = byID[id]
+			list = append(list, m)
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].LastEventOn != list[j].LastEventOn {
+			return list[i].LastEventOn > list[j].LastEventOn
+		}
+		return list[i].Profile.ID > list[j].Profile.ID
+	})
+	return list, nil
+}
+
+// LastEvent returns the most recent event of the account
+func (m *AccountMatch) LastEvent() *DayEvent {
+	return &DayEvent{EventDate: m.LastEventOn, EventType: m.LastEventType}
+}
+
+func (m *AccountMatch) matchesRelation(relation string) bool {
+	switch relation {
+	case FollowerRelation:
+		return m.Follower
+	case FriendRelation:
+		return m.Friend
+	case MutualRelation:
+		return m.Follower && m.Friend
+	case FormerFollowerRelation:
+		return m.FormerFollower
+	default:
+		return true
+	}
+}
+
+// matchesChangedOn checks if the last event is within the ISO date range, accounts
+// without event (existing relationship) match only when the range is not set
+func (m *AccountMatch) matchesChangedOn(from, to string) bool {
+	if from == "" && to == "" {
+		return true
+	}
+	return m.LastEventOn != "" && (from == "" || m.LastEventOn >= from) && (to == "" || m.LastEventOn <= to)
+}
+
+// findProfiles returns cached profiles with username, name, description or location
+// containing the text (case-insensitive), all profiles when text is empty
+func findProfiles(db *storm.DB, text string) ([]Profile, error) {
+	text = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(text), "@"))
+	var list []Profile
+	if text == "" {
+		if err := db.All(&list); err != nil {
+			return nil, errors.Wrap(err, "error getting profiles")
+		}
+		return list, nil
+	}
+
+	m := containsMatcher(text)
+	query := db.Select(q.Or(
+		q.NewFieldMatcher("Username", m),
+		q.NewFieldMatcher("Name", m),
+		q.NewFieldMatcher("Description", m),
+		q.NewFieldMatcher("Location", m),
+	))
+	if err := query.Find(&list); err != nil && err != storm.ErrNotFound {
+		return nil, errors.Wrap(err, "error searching profiles")
+	}
+	return list, nil
+}
+
+// containsMatcher matches string fields containing the lower case text, case-insensitive
+type containsMatcher string
+
+func (m containsMatcher) MatchField(v interface{}) (bool, error) {
+	s, ok := v.(string)
+	return ok && strings.Contains(strings.ToLower(s), string(m)), nil
+}
+
+// newAccountMatch creates match from the relationship changes of the account
+func newAccountMatch(changes []*relationChange) *AccountMatch {
+	m := &AccountMatch{}
+	for _, c := range changes {
+		if c.Follower {
+			m.Follower = c.In
+			m.FormerFollower = m.FormerFollower || !c.In
+		} else {
+			m.Friend = c.In
+		}
+	}
+	m.FormerFollower = m.FormerFollower && !m.Follower
+
+	// existing relationships have no event, their date is unknown
+	if events := relationEvents(changes); len(events) > 0 {
+		last := events[len(events)-1]
+		m.LastEventOn, m.LastEventType = last.EventDate, last.EventType
+	}
+	return m
+}
@@ -0,0 +1,96 @@
+package data
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchAccounts(t *testing.T) {
+	db, err := GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	username := "tester"
+	day1 := time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)
+	save := func(offset int, s *DailyState) {
+		on := day1.AddDate(0, 0, offset)
+		s.Key = GetDailyStateKey(username, on)
+		s.Username = username
+		s.StateOn = on.Format("2006-01-02")
+		s.UpdatedOn = on
+		assert.NoError(t, db.Save(s))
+	}
+
+	// 1 mutual, 2 unfollowed on day 2, 3 friended on day 3 (seen only in the snapshot),
+	// 4 unfollowed and refollowed, 5 has no cached profile
+	save(0, &DailyState{SnapshotOn: "2021-01-10", Followers: []int64{1, 2, 4, 5}, Friends: []int64{1}})
+	save(1, &DailyState{BaselineOn: "2021-01-10", SnapshotOn: "2021-01-10", NewUnfollowers: []int64{2, 4}})
+	save(2, &DailyState{BaselineOn: "2021-01-11", SnapshotOn: "2021-01-12", Followers: []int64{1, 5}, Friends: []int64{1, 3}})
+	save(3, &DailyState{BaselineOn: "2021-01-12", SnapshotOn: "2021-01-12", NewFollowers: []int64{4}})
+
+	for _, p := range []*Profile{
+		{ID: 1, Username: "mutual", Name: "Mutual Friend", Location: "Portland"},
+		{ID: 2, Username: "gone", Description: "Left in January"},
+		{ID: 3, Username: "followed", Location: "Seattle"},
+		{ID: 4, Username: "back", Name: "Came Back"},
+		{ID: 6, Username: "stranger"},
+	} {
+		assert.NoError(t, db.Save(p))
+	}
+
+	ids := func(list []*AccountMatch) []int64 {
+		out := make([]int64, 0)
+		for _, m := range list {
+			out = append(out, m.Profile.ID)
+		}
+		return out
+	}
+
+	list, err := SearchAccounts(db, username, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 3, 2, 1}, ids(list))
+	assert.Equal(t, RefollowedEventType, list[0].LastEventType)
+	assert.Equal(t, "2021-01-13", list[0].LastEventOn)
+	assert.Equal(t, FriendedEventType, list[1].LastEventType)
+	// relationship with 1 existed before tracking started, it has no dated event
+	assert.Equal(t, "", list[3].LastEventOn)
+	assert.True(t, list[3].Follower)
+
+	list, err = SearchAccounts(db, username, &AccountQuery{Text: "@MUT"})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1}, ids(list))
+
+	list, err = SearchAccounts(db, username, &AccountQuery{Text: "january"})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2}, ids(list))
+
+	list, err = SearchAccounts(db, username, &AccountQuery{Text: "seattle", Relation: FriendRelation})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3}, ids(list))
+
+	list, err = SearchAccounts(db, username, &AccountQuery{Relation: MutualRelation})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{1}, ids(list))
+
+	list, err = SearchAccounts(db, username, &AccountQuery{Relation: FollowerRelation})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 1}, ids(list))
+
+	list, err = SearchAccounts(db, username, &AccountQuery{Relation: FormerFollowerRelation})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2}, ids(list))
+
+	list, err = SearchAccounts(db, username, &AccountQuery{ChangedFrom: "2021-01-11", ChangedTo: "2021-01-12"})
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3, 2}, ids(list))
+
+	list, err = SearchAccounts(db, username, &AccountQuery{ChangedTo: "2021-01-10"})
+	assert.NoError(t, err)
+	assert.Empty(t, list)
+
+	_, err = SearchAccounts(db, username, &AccountQuery{Relation: "enemy"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,189 @@
+package data
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/mchmarny/followme/pkg/list"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultSnapshotInterval is the period of the day changes are grouped by when not set (one snapshot a day)
+	DefaultSnapshotInterval = 24 * time.Hour
+
+	snapshotPeriodLayout = "2006-01-02T15"
+)
+
+// Snapshot represents changes of the user followers and friends within a period of the day,
+// each worker run diffs against the previous run and adds its changes to the snapshot of the period.
+// The daily state holds the changes of all snapshots of that day.
+type Snapshot struct {
+	Key         string    `storm:"id" json:"key"`
+	Username    string    `json:"username"`
+	StateOn     string    `json:"date"`
+	PeriodStart time.Time `json:"period_start"`
+	UpdatedOn   time.Time `json:"updated_on"`
+	// Runs is the number of worker runs within the period
+	Runs int `json:"runs"`
+
+	FollowerCount      int     `json:"follower_count"`
+	NewFollowers       []int64 `json:"new_followers"`
+	NewFollowerCount   int     `json:"new_follower_count"`
+	NewUnfollowers     []int64 `json:"new_unfollowers"`
+	NewUnfollowerCount int     `json:"new_unfollower_count"`
+
+	FriendsCount       int     `json:"friend_count"`
+	NewFriends         []int64 `json:"new_friends"`
+	NewFriendsCount    int     `json:"new_friend_count"`
+	NewUnfriended      []int64 `json:"new_unfriended"`
+	NewUnfriendedCount int     `json:"new_unfriended_count"`
+}
+
+// ValidateSnapshotInterval checks that the interval is whole number of hours which divides the day
+func ValidateSnapshotInterval(interval time.Duration) error {
+	if interval < time.Hour || interval > 24*time.Hour || interval%time.Hour != 0 || 24%int(interval/time.Hour) != 0 {
+		return errors.Errorf("invalid snapshot interval %v, expected 1h, 2h, 3h, 4h, 6h, 8h, 12h or 24h", interval)
+	}
+	return nil
+}
+
+// GetSnapshotPeriodStart returns start of the period of the day the time falls in, in the location of the time
+func GetSnapshotPeriodStart(t time.Time, interval time.Duration) time.Time {
+	hours := int(interval / time.Hour)
+	if hours < 1 {
+		hours = 24
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour()/hours*hours, 0, 0, 0, t.Location())
+}
+
+// GetSnapshotKey returns key of the snapshot for the period start
+func GetSnapshotKey(username string, periodStart time.Time) string {
+	return fmt.Sprintf("%s-%s", format.NormalizeString(username), periodStart.Format(snapshotPeriodLayout))
+}
+
+// GetSnapshot returns snapshot of the user for the period start, new empty snapshot if none exists
+func GetSnapshot(db storm.Node, username string, periodStart time.Time) (*Snapshot, error) {
+	key := GetSnapshotKey(username, periodStart)
+	var s Snapshot
+	if err := db.One("Key", key, &s); err != nil {
+		if err != storm.ErrNotFound {
+			return nil, errors.Wrapf(err, "error getting snapshot %s", key)
+		}
+		return &Snapshot{
+			Key:         key,
+			Username:    username,
+			StateOn:     format.ToISODate(periodStart),
+			PeriodStart: periodStart,
+		}, nil
+	}
+	return &s, nil
+}
+
+// GetSnapshots returns snapshots of the user on the ISO date, in time order
+func GetSnapshots(db storm.Node, username, isoDate string) ([]*Snapshot, error) {
+	prefix := fmt.Sprintf("%s-%sT", format.NormalizeString(username), isoDate)
+	list := make([]*Snapshot, 0)
+	if err := db.Prefix("Key", prefix, &list); err != nil && err != storm.ErrNotFound {
+		return nil, errors.Wrapf(err, "error getting snapshots of %s on %s", username, isoDate)
+	}
+	return list, nil
+}
+
+// AddChanges adds changes found by a worker run to the snapshot
+func (s *Snapshot) AddChanges(newFollowers, newUnfollowers, newFriends, newUnfriended []int64) {
+	s.NewFollowerCount, s.NewUnfollowerCount, s.NewFriendsCount, s.NewUnfriendedCount = addChanges(
+		&s.NewFollowers, &s.NewUnfollowers, &s.NewFriends, &s.NewUnfriended,
+		newFollowers, newUnfollowers, newFriends, newUnfriended)
+}
+
+// AddChanges adds changes found by a worker run to the daily state
+func (s *DailyState) AddChanges(newFollowers, newUnfollowers, newFriends, newUnfriended []int64) {
+	s.NewFollowerCount, s.NewUnfollowerCount, s.NewFriendsCount, s.NewUnfriendedCount = addChanges(
+		&s.NewFollowers, &s.NewUnfollowers, &s.NewFriends, &s.NewUnfriended,
+		newFollowers, newUnfollowers, newFriends, newUnfriended)
+}
+
+// addChanges merges changes of a later worker run into the change lists, returns their new lengths
+func addChanges(followers, unfollowers, friends, unfriended *[]int64,
+	newFollowers, newUnfollowers, newFriends, newUnfriended []int64) (int, int, int, int) {
+	*followers, *unfollowers = list.MergeChanges(*followers, *unfollowers, newFollowers, newUnfollowers)
+	*friends, *unfriended = list.MergeChanges(*friends, *unfriended, newFriends, newUnfriended)
+	return len(*followers), len(*unfollowers), len(*friends), len(*unfriended)
+}
+
+// rekeyUserSnapshots moves snapshots of the user to the period start in the location,
+// snapshots which end up in the same period (e.g. on daylight saving time change) are merged
+func rekeyUserSnapshots(tx storm.Node, username string, loc *time.Location) error {
+	prefix := fmt.Sprintf("%s-", format.NormalizeString(username))
+	var snapshots []Snapshot
+	if err := tx.Prefix("Key", prefix, &snapshots); err != nil {
+		if err == storm.ErrNotFound {
+			return nil
+		}
+		return errors.Wrap(err, "error getting snapshots")
+	}
+
+	moved := make([]*Snapshot, 0)
+	for i := range snapshots {
+		s := &snapshots[i]
+		if !isUserKey(s.Key, prefix, snapshotPeriodLayout) {
+			continue
+		}
+		start := s.PeriodStart.In(loc)
+		if GetSnapshotKey(username, start) == s.Key {
+			continue
+		}
+		if err := tx.DeleteStruct(s); err != nil {
+			return errors.Wrapf(err, "error deleting snapshot %s", s.Key)
+		}
+		s.Key, s.StateOn, s.PeriodStart = GetSnapshotKey(username, start), format.ToISODate(start), start
+		moved = append(moved, s)
+	}
+
+	for _, s := range moved {
+		var existing Snapshot
+		if err := tx.One("Key", s.Key, &existing); err == nil {
+			existing.AddChanges(s.NewFollowers, s.NewUnfollowers, s.NewFriends, s.NewUnfriended)
+			existing.Runs += s.Runs
+			if s.UpdatedOn.After(existing.UpdatedOn) {
+				existing.UpdatedOn, existing.FollowerCount, existing.FriendsCount = s.UpdatedOn, s.FollowerCount, s.FriendsCount
+			}
+			s = &existing
+		} else if err != storm.ErrNotFound {
+			return errors.Wrapf(err, "error getting snapshot %s", s.Key)
+		}
+		if err := tx.Save(s); err != nil {
+			return errors.Wrapf(err, "error saving snapshot %s", s.Key)
+		}
+	}
+	return nil
+}
+
+// deleteSnapshotsBefore removes snapshots of the user on days before the ISO date
+func deleteSnapshotsBefore(tx storm.Node, username, isoDate string) (int, error) {
+	prefix := fmt.Sprintf("%s-", format.NormalizeString(username))
+	var snapshots []Snapshot
+	if err := tx.Prefix("Key", prefix, &snapshots); err != nil {
+		if err == storm.ErrNotFound {
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, "error getting snapshots")
+	}
+	count := 0
+	for i := range snapshots {
+		if !isUserKey(snapshots[i].Key, prefix, snapshotPeriodLayout) {
+			continue
+		}
+		if snapshots[i].StateOn >= isoDate {
+			break
+		}
+		if err := tx.DeleteStruct(&snapshots[i]); err != nil {
+			return count, errors.Wrapf(err, "error deleting snapshot %s", snapshots[i].Key)
+		}
+		count++
+	}
+	return count, nil
+}
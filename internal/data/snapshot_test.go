@@ -0,0 +1,94 @@
+package data
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshot(t *testing.T) {
+	db, err := GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	t.Run("interval", func(t *testing.T) {
+		for _, v := range []time.Duration{time.Hour, 6 * time.Hour, 24 * time.Hour} {
+			assert.NoError(t, ValidateSnapshotInterval(v))
+		}
+		for _, v := range []time.Duration{0, 30 * time.Minute, 5 * time.Hour, 48 * time.Hour} {
+			assert.Error(t, ValidateSnapshotInterval(v))
+		}
+
+		on := time.Date(2021, 3, 1, 14, 35, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC), GetSnapshotPeriodStart(on, 6*time.Hour))
+		assert.Equal(t, time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC), GetSnapshotPeriodStart(on, 24*time.Hour))
+		assert.Equal(t, "tester-2021-03-01T12", GetSnapshotKey("Tester", GetSnapshotPeriodStart(on, 6*time.Hour)))
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		start := time.Date(2021, 3, 1, 18, 0, 0, 0, time.UTC)
+		s, err := GetSnapshot(db, "tester", start)
+		assert.NoError(t, err)
+		assert.Equal(t, "2021-03-01", s.StateOn)
+
+		s.AddChanges([]int64{1, 2}, nil, []int64{3}, nil)
+		s.AddChanges([]int64{4}, []int64{1}, nil, []int64{3})
+		assert.Equal(t, []int64{4, 2}, s.NewFollowers)
+		assert.Empty(t, s.NewUnfollowers)
+		assert.Equal(t, 2, s.NewFollowerCount)
+		assert.Empty(t, s.NewFriends)
+		assert.Zero(t, s.NewUnfriendedCount)
+		assert.NoError(t, db.Save(s))
+
+		early, err := GetSnapshot(db, "tester", start.Add(-12*time.Hour))
+		assert.NoError(t, err)
+		assert.NoError(t, db.Save(early))
+		next, err := GetSnapshot(db, "tester", start.Add(12*time.Hour))
+		assert.NoError(t, err)
+		assert.NoError(t, db.Save(next))
+
+		list, err := GetSnapshots(db, "tester", "2021-03-01")
+		assert.NoError(t, err)
+		assert.Len(t, list, 2)
+		assert.Equal(t, "tester-2021-03-01T06", list[0].Key)
+		assert.Equal(t, "tester-2021-03-01T18", list[1].Key)
+	})
+
+	t.Run("rekey", func(t *testing.T) {
+		// user whose name starts with the other one and a dash
+		other, err := GetSnapshot(db, "tester-x", time.Date(2021, 2, 1, 6, 0, 0, 0, time.UTC))
+		assert.NoError(t, err)
+		assert.NoError(t, db.Save(other))
+
+		loc, err := time.LoadLocation("America/New_York")
+		assert.NoError(t, err)
+		assert.NoError(t, rekeyUserSnapshots(db, "tester", loc))
+
+		list, err := GetSnapshots(db, "tester", "2021-03-01")
+		assert.NoError(t, err)
+		assert.Len(t, list, 2)
+		assert.Equal(t, "tester-2021-03-01T01", list[0].Key)
+		assert.Equal(t, "tester-2021-03-01T13", list[1].Key)
+		assert.Equal(t, []int64{4, 2}, list[1].NewFollowers)
+
+		list, err = GetSnapshots(db, "tester", "2021-03-02")
+		assert.NoError(t, err)
+		assert.Len(t, list, 1)
+		assert.Equal(t, "tester-2021-03-02T01", list[0].Key)
+		assert.Equal(t, "2021-03-02", list[0].StateOn)
+
+		n, err := deleteSnapshotsBefore(db, "tester", "2021-03-01")
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+		n, err = deleteSnapshotsBefore(db, "tester", "2021-03-02")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+
+		list, err = GetSnapshots(db, "tester-x", "2021-02-01")
+		assert.NoError(t, err)
+		assert.Len(t, list, 1)
+		assert.Equal(t, "tester-x-2021-02-01T06", list[0].Key)
+	})
+}
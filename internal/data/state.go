@@ -4,7 +4,17 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/asdine/storm/v3"
 	"github.com/mchmarny/followme/pkg/format"
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxBaselineDays is the max number of days to look back for previous state
+	MaxBaselineDays = 366
+
+	// SnapshotIntervalDays is the max number of days between full follower/friend snapshots
+	SnapshotIntervalDays = 7
 )
 
 // DailyState represents daily user state
@@ -15,6 +25,18 @@ type DailyState struct {
 	StateOn   string    `json:"date"`
 	UpdatedOn time.Time `json:"updated_on"`
 
+	// baseline (previous existing state the deltas were computed against)
+	BaselineOn string `json:"baseline_on,omitempty"`
+	GapDays    int    `json:"gap_days"`
+
+	// snapshot (date of the last state with full follower/friend lists),
+	// states in between store only deltas, use RebuildState to restore the lists
+	SnapshotOn string `json:"snapshot_on,omitempty"`
+
+	// compacted states can't be rebuilt, full lists were removed by retention
+	// or never existed (imported from export), only counts and events are kept
+	Compacted bool `json:"compacted,omitempty"`
+
 	// follower
 	Followers     []int64 `json:"followers"`
 	FollowerCount int     `json:"follower_count"`
@@ -45,3 +67,122 @@ func GetDailyStateKey(username string, date time.Time) string {
 func GetDailyStateKeyISO(username, isoDate string) string {
 	return fmt.Sprintf("%s-%s", format.NormalizeString(username), isoDate)
 }
+
+// isUserKey checks if the key found by the user prefix ends with a date in the layout,
+// the prefix also matches keys of users whose name starts with this one and a dash
+func isUserKey(key, prefix, layout string) bool {
+	_, err := time.Parse(layout, key[len(prefix):])
+	return err == nil
+}
+
+// HasData indicates if the state was collected by worker
+func (s *DailyState) HasData() bool {
+	return !s.UpdatedOn.IsZero()
+}
+
+// IsSnapshot indicates if the state holds full follower and friend lists.
+// States saved before delta storage (no baseline) always hold full lists.
+func (s *DailyState) IsSnapshot() bool {
+	return !s.Compacted && (s.BaselineOn == "" || s.SnapshotOn == s.StateOn)
+}
+
+// LastSnapshotOn returns the date of the last state with full lists on or before this state
+func (s *DailyState) LastSnapshotOn() string {
+	if s.IsSnapshot() {
+		return s.StateOn
+	}
+	return s.SnapshotOn
+}
+
+// GetLatestState returns the most recent state on or before the date,
+// looks back up to MaxBaselineDays, nil if none found
+func GetLatestState(db *storm.DB, username string, date time.Time) (*DailyState, error) {
+	for i := 0; i <= MaxBaselineDays; i++ {
+		day := date.AddDate(0, 0, -i)
+		key := GetDailyStateKey(username, day)
+		var s DailyState
+		if err := db.One("Key", key, &s); err != nil {
+			if err == storm.ErrNotFound {
+				continue
+			}
+			return nil, errors.Wrapf(err, "error getting state for %s", key)
+		}
+		if s.StateOn == "" {
+			s.StateOn = day.Format(format.ISODateLayout)
+		}
+		return &s, nil
+	}
+	return nil, nil
+}
+
+// GetPreviousState returns the most recent state before the date, nil if none found
+func GetPreviousState(db *storm.DB, username string, date time.Time) (*DailyState, error) {
+	return GetLatestState(db, username, date.AddDate(0, 0, -1))
+}
+
+// GetFullState returns the most recent state on or before the date with full follower
+// and friend lists rebuilt from the last snapshot, nil if none found
+func GetFullState(db *storm.DB, username string, date time.Time) (*DailyState, error) {
+	s, err := GetLatestState(db, username, date)
+	if err != nil || s == nil {
+		return s, err
+	}
+	if err := RebuildState(db, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// RebuildState restores full follower and friend lists of a delta state
+// by applying the deltas of each state since the last snapshot
+func RebuildState(db *storm.DB, s *DailyState) error {
+	chain := make([]*DailyState, 0)
+	cur := s
+	for !cur.IsSnapshot() {
+		if cur.Compacted {
+			return errors.Errorf("full lists of %s are not available", cur.Key)
+		}
+		if len(chain) > MaxBaselineDays {
+			return errors.Errorf("no snapshot found within %d states of %s", MaxBaselineDays, s.Key)
+		}
+		chain = append(chain, cur)
+		key := GetDailyStateKeyISO(cur.Username, cur.BaselineOn)
+		var prev DailyState
+		if err := db.One("Key", key, &prev); err != nil {
+			return errors.Wrapf(err, "error getting baseline state %s of %s", key, cur.Key)
+		}
+		cur = &prev
+	}
+
+	followers, friends := cur.Followers, cur.Friends
+	for i := len(chain) - 1; i >= 0; i-- {
+		followers = applyDelta(followers, chain[i].NewFollowers, chain[i].NewUnfollowers)
+		friends = applyDelta(friends, chain[i].NewFriends, chain[i].NewUnfriended)
+	}
+
+	s.Followers = followers
+	s.Friends = friends
+	return nil
+}
+
+// applyDelta returns new list of IDs with the added IDs first followed by
+// the IDs from list that were not removed (same as the Twitter API ordering)
+func applyDelta(ids, added, removed []int64) []int64 {
+	skip := make(map[int64]bool, len(removed))
+	for _, id := range removed {
+		skip[id] = true
+	}
+	result := make([]int64, 0, len(ids)+len(added))
+	result = append(result, added...)
+	for _, id := range ids {
+		if !skip[id] {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// hasLists indicates if the state holds full follower and friend lists
+func (s *DailyState) hasLists() bool {
+	return !s.Compacted && (s.IsSnapshot() || s.Followers != nil || s.Friends != nil)
+}
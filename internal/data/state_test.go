@@ -0,0 +1,107 @@
+package data
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLatestState(t *testing.T) {
+	db, err := GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	day1 := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+	assert.NoError(t, db.Save(&DailyState{
+		Key:       GetDailyStateKey("tester", day1),
+		Username:  "tester",
+		StateOn:   "2021-01-10",
+		UpdatedOn: day1,
+	}))
+
+	day4 := day1.AddDate(0, 0, 3)
+
+	s, err := GetLatestState(db, "tester", day4)
+	assert.NoError(t, err)
+	assert.NotNil(t, s)
+	assert.Equal(t, "2021-01-10", s.StateOn)
+	assert.True(t, s.HasData())
+
+	s, err = GetPreviousState(db, "tester", day1)
+	assert.NoError(t, err)
+	assert.Nil(t, s)
+
+	s, err = GetLatestState(db, "other", day4)
+	assert.NoError(t, err)
+	assert.Nil(t, s)
+}
+
+func TestMigrateStates(t *testing.T) {
+	db, err := GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	username := "tester"
+	assert.NoError(t, db.Save(&User{Username: username}))
+
+	// legacy states with full lists, day 3 missing
+	day1 := time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)
+	lists := map[int][]int64{
+		0:  {1, 2, 3},
+		1:  {2, 3, 4},
+		3:  {3, 4, 5},
+		4:  {3, 4, 5, 6},
+		9:  {4, 6},
+		10: {4, 6, 7},
+	}
+	for i, ids := range lists {
+		d := day1.AddDate(0, 0, i)
+		assert.NoError(t, db.Save(&DailyState{
+			Key:           GetDailyStateKey(username, d),
+			Username:      username,
+			StateOn:       d.Format(format.ISODateLayout),
+			UpdatedOn:     d,
+			Followers:     ids,
+			FollowerCount: len(ids),
+			Friends:       ids[:1],
+			FriendsCount:  1,
+		}))
+	}
+
+	n, err := MigrateStates(db)
+	assert.NoError(t, err)
+	assert.Equal(t, len(lists), n)
+
+	var s DailyState
+	assert.NoError(t, db.One("Key", GetDailyStateKey(username, day1.AddDate(0, 0, 3)), &s))
+	assert.False(t, s.IsSnapshot())
+	assert.Nil(t, s.Followers)
+	assert.Equal(t, "2021-01-11", s.BaselineOn)
+	assert.Equal(t, 1, s.GapDays)
+	assert.Equal(t, []int64{5}, s.NewFollowers)
+	assert.Equal(t, []int64{2}, s.NewUnfollowers)
+	assert.Equal(t, 3, s.FollowerCount)
+
+	assert.NoError(t, db.One("Key", GetDailyStateKey(username, day1.AddDate(0, 0, 9)), &s))
+	assert.True(t, s.IsSnapshot())
+	assert.Equal(t, []int64{4, 6}, s.Followers)
+
+	// rebuild every day and run again to make sure it is idempotent
+	check := func() {
+		for i, ids := range lists {
+			full, err := GetFullState(db, username, day1.AddDate(0, 0, i))
+			assert.NoError(t, err)
+			assert.ElementsMatch(t, ids, full.Followers, "day %d", i)
+			assert.ElementsMatch(t, ids[:1], full.Friends, "day %d", i)
+		}
+	}
+	check()
+
+	n, err = MigrateStates(db)
+	assert.NoError(t, err)
+	assert.Equal(t, len(lists), n)
+	check()
+}
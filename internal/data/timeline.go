@@ -0,0 +1,71 @@
+package data
+
+import (
+	"github.com/asdine/storm/v3"
+	"github.com/mchmarny/followme/pkg/date"
+)
+
+// Timeline represents history of the relationship between the user and a single account
+type Timeline struct {
+	Username string `json:"username"`
+	ID       int64  `json:"id"`
+	// TrackedSince is the date of the first state with full lists, relationships which
+	// existed on that date without an earlier event are marked as existing instead of dated
+	TrackedSince     string      `json:"tracked_since"`
+	UpdatedOn        string      `json:"updated_on"`
+	ExistingFollower bool        `json:"existing_follower"`
+	ExistingFriend   bool        `json:"existing_friend"`
+	Events           []*DayEvent `json:"events"`
+	Follower         bool        `json:"follower"`
+	Friend           bool        `json:"friend"`
+	// MutualDays is the number of days both the account followed the user and the user followed the account
+	MutualDays int `json:"mutual_days"`
+}
+
+// GetTimeline reconstructs relationship of the user with the account from all daily states of the user
+func GetTimeline(db storm.Node, username string, id int64) (*Timeline, error) {
+	r := newRelationReplay(func(v int64) bool { return v == id })
+	if err := r.replayUser(db, username); err != nil {
+		return nil, err
+	}
+
+	changes := r.Changes[id]
+	t := &Timeline{
+		Username:     username,
+		ID:           id,
+		TrackedSince: r.TrackedSince,
+		UpdatedOn:    r.UpdatedOn,
+		Events:       relationEvents(changes),
+	}
+
+	// days without state keep the relationship of the previous day
+	lastOn := ""
+	for _, c := range changes {
+		if t.Follower && t.Friend {
+			days, err := date.GetDaysBetween(lastOn, c.On)
+			if err != nil {
+				return nil, err
+			}
+			t.MutualDays += days
+		}
+		if c.Follower {
+			t.Follower, t.ExistingFollower = c.In, t.ExistingFollower || c.Existing
+		} else {
+			t.Friend, t.ExistingFriend = c.In, t.ExistingFriend || c.Existing
+		}
+		lastOn = c.On
+	}
+	if t.Follower && t.Friend {
+		days, err := date.GetDaysBetween(lastOn, t.UpdatedOn)
+		if err != nil {
+			return nil, err
+		}
+		t.MutualDays += days + 1
+	}
+	return t, nil
+}
+
+// HasHistory indicates if the user ever had relationship with the account
+func (t *Timeline) HasHistory() bool {
+	return len(t.Events) > 0 || t.ExistingFollower || t.ExistingFriend
+}
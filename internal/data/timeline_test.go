@@ -0,0 +1,109 @@
+package data
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTimeline(t *testing.T) {
+	db, err := GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	username := "tester"
+	day1 := time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)
+	save := func(offset int, s *DailyState) {
+		on := day1.AddDate(0, 0, offset)
+		s.Key = GetDailyStateKey(username, on)
+		s.Username = username
+		s.StateOn = on.Format("2006-01-02")
+		s.UpdatedOn = on
+		assert.NoError(t, db.Save(s))
+	}
+
+	// mutual on day 1, unfollowed on day 4 (day 3 missing),
+	// refollowed and unfriended on day 5, friended again seen only in the day 7 snapshot
+	save(0, &DailyState{SnapshotOn: "2021-01-10", Followers: []int64{1, 2}, Friends: []int64{1}})
+	save(1, &DailyState{BaselineOn: "2021-01-10", SnapshotOn: "2021-01-10"})
+	save(3, &DailyState{BaselineOn: "2021-01-11", SnapshotOn: "2021-01-10", NewUnfollowers: []int64{1}})
+	save(4, &DailyState{BaselineOn: "2021-01-13", SnapshotOn: "2021-01-10", NewFollowers: []int64{1}, NewUnfriended: []int64{1}})
+	save(5, &DailyState{Compacted: true})
+	save(6, &DailyState{BaselineOn: "2021-01-15", SnapshotOn: "2021-01-16", Followers: []int64{1, 2}, Friends: []int64{1}})
+
+	tl, err := GetTimeline(db, username, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "2021-01-10", tl.TrackedSince)
+	assert.Equal(t, "2021-01-16", tl.UpdatedOn)
+	assert.True(t, tl.ExistingFollower)
+	assert.True(t, tl.ExistingFriend)
+	assert.True(t, tl.Follower)
+	assert.True(t, tl.Friend)
+	assert.Equal(t, 4, tl.MutualDays)
+
+	expected := []*DayEvent{
+		{EventDate: "2021-01-13", EventType: UnfollowedEventType},
+		{EventDate: "2021-01-14", EventType: RefollowedEventType},
+		{EventDate: "2021-01-14", EventType: UnfriendedEventType},
+		{EventDate: "2021-01-16", EventType: FriendedEventType},
+	}
+	assert.Equal(t, expected, tl.Events)
+
+	tl, err = GetTimeline(db, username, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, tl.Events)
+	assert.True(t, tl.ExistingFollower)
+	assert.True(t, tl.Follower)
+	assert.False(t, tl.Friend)
+	assert.Equal(t, 0, tl.MutualDays)
+
+	tl, err = GetTimeline(db, "other", 1)
+	assert.NoError(t, err)
+	assert.Empty(t, tl.Events)
+	assert.Equal(t, "", tl.TrackedSince)
+}
+
+func TestGetTimelineCompactedPrefix(t *testing.T) {
+	db, err := GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	username := "tester"
+	day1 := time.Date(2021, 1, 10, 0, 0, 0, 0, time.UTC)
+	save := func(offset int, s *DailyState) {
+		on := day1.AddDate(0, 0, offset)
+		s.Key = GetDailyStateKey(username, on)
+		s.Username = username
+		s.StateOn = on.Format("2006-01-02")
+		s.UpdatedOn = on
+		assert.NoError(t, db.Save(s))
+	}
+
+	// states before the first snapshot were compacted by retention, 3 followed on an imported day
+	save(0, &DailyState{Compacted: true, FollowerCount: 2})
+	save(1, &DailyState{Compacted: true, FollowerCount: 3, NewFollowers: []int64{3}})
+	save(2, &DailyState{Compacted: true, FollowerCount: 3})
+	save(3, &DailyState{SnapshotOn: "2021-01-13", Followers: []int64{1, 2, 3}, Friends: []int64{1}})
+	save(4, &DailyState{BaselineOn: "2021-01-13", SnapshotOn: "2021-01-13", NewUnfollowers: []int64{2}})
+
+	tl, err := GetTimeline(db, username, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "2021-01-13", tl.TrackedSince)
+	assert.True(t, tl.ExistingFollower)
+	assert.True(t, tl.ExistingFriend)
+	assert.Empty(t, tl.Events)
+	assert.Equal(t, 2, tl.MutualDays)
+
+	tl, err = GetTimeline(db, username, 2)
+	assert.NoError(t, err)
+	assert.True(t, tl.ExistingFollower)
+	assert.False(t, tl.Follower)
+	assert.Equal(t, []*DayEvent{{EventDate: "2021-01-14", EventType: UnfollowedEventType}}, tl.Events)
+
+	tl, err = GetTimeline(db, username, 3)
+	assert.NoError(t, err)
+	assert.False(t, tl.ExistingFollower)
+	assert.Equal(t, []*DayEvent{{EventDate: "2021-01-11", EventType: FollowedEventType}}, tl.Events)
+}
@@ -0,0 +1,204 @@
+package data
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/mchmarny/followme/pkg/format"
+	"github.com/pkg/errors"
+)
+
+// UserSettings represents preferences of a tracked user
+type UserSettings struct {
+	Username string `storm:"id" json:"username"`
+	// Timezone is the IANA time zone name (e.g. America/Los_Angeles) days of the user start in, UTC when empty
+	Timezone  string    `json:"timezone,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetUserSettings returns settings of the user, defaults when the user has none
+func GetUserSettings(db storm.Node, username string) (*UserSettings, error) {
+	var s UserSettings
+	if err := db.One("Username", username, &s); err != nil {
+		if err != storm.ErrNotFound {
+			return nil, errors.Wrapf(err, "error getting settings for %s", username)
+		}
+		return &UserSettings{Username: username}, nil
+	}
+	return &s, nil
+}
+
+// GetUserLocation returns time zone the days of the user start in, UTC when not set
+func GetUserLocation(db storm.Node, username string) (*time.Location, error) {
+	s, err := GetUserSettings(db, username)
+	if err != nil {
+		return nil, err
+	}
+	if s.Timezone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid time zone of %s: %s", username, s.Timezone)
+	}
+	return loc, nil
+}
+
+// GetUserNow returns the current time in the time zone of the user
+func GetUserNow(db storm.Node, username string, now time.Time) (time.Time, error) {
+	loc, err := GetUserLocation(db, username)
+	if err != nil {
+		return now, err
+	}
+	return now.In(loc), nil
+}
+
+// SetUserTimezone sets time zone of the user and moves existing states to the day
+// they were last updated on in that time zone. Returns the number of states moved.
+func SetUserTimezone(db *storm.DB, username, timezone string) (int, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid time zone: %s", timezone)
+	}
+	if loc == time.UTC {
+		timezone = ""
+	}
+
+	tx, err := db.Begin(true)
+	if err != nil {
+		return 0, errors.Wrap(err, "error starting transaction")
+	}
+	defer tx.Rollback()
+
+	var u User
+	if err := tx.One("Username", username, &u); err != nil {
+		if err == storm.ErrNotFound {
+			return 0, errors.Errorf("user %s not found", username)
+		}
+		return 0, errors.Wrapf(err, "error getting user %s", username)
+	}
+
+	moved, err := rekeyUserStates(tx, username, loc)
+	if err != nil {
+		return 0, errors.Wrapf(err, "error moving states of %s", username)
+	}
+	if err := rekeyUserSnapshots(tx, username, loc); err != nil {
+		return 0, errors.Wrapf(err, "error moving snapshots of %s", username)
+	}
+
+	s := &UserSettings{Username: username, Timezone: timezone, UpdatedAt: time.Now().UTC()}
+	if err := tx.Save(s); err != nil {
+		return 0, errors.Wrapf(err, "error saving settings for %s", username)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, errors.Wrap(err, "error committing time zone change")
+	}
+	return moved, nil
+}
+
+// rekeyUserStates moves states of the user to the day of their last update in the location.
+// When several states fall on the same day, the last updated one is kept and its deltas
+// are recomputed against the previous kept state, so the dropped changes are included.
+func rekeyUserStates(tx storm.Node, username string, loc *time.Location) (int, error) {
+	prefix := fmt.Sprintf("%s-", format.NormalizeString(username))
+	var states []DailyState
+	if err := tx.Prefix("Key", prefix, &states); err != nil {
+		if err == storm.ErrNotFound {
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, "error getting states")
+	}
+
+	// full lists of each state, in key (date) order so deltas apply to the previous state
+	t := &listTracker{}
+	days := make(map[string]*DailyState)
+	moved := 0
+	// other users' states are neither moved nor deleted
+	own := states[:0]
+	for i := range states {
+		if isUserKey(states[i].Key, prefix, format.ISODateLayout) {
+			own = append(own, states[i])
+		}
+	}
+	states = own
+
+	for i := range states {
+		s := &states[i]
+		t.apply(s)
+
+		keyOn := s.Key[len(prefix):]
+		on := keyOn
+		if !s.UpdatedOn.IsZero() {
+			on = format.ToISODate(s.UpdatedOn.In(loc))
+		}
+		if on != keyOn {
+			moved++
+		}
+
+		out := *s
+		out.Key = GetDailyStateKeyISO(username, on)
+		out.StateOn = on
+		out.BaselineOn, out.GapDays = "", 0
+		if t.known && !s.Compacted {
+			out.makeSnapshot(t.followers, t.friends)
+		} else {
+			out.Compacted = true
+			out.SnapshotOn = ""
+		}
+
+		kept, dropped := &out, days[on]
+		if dropped == nil {
+			days[on] = kept
+			continue
+		}
+		if dropped.UpdatedOn.After(kept.UpdatedOn) {
+			kept, dropped = dropped, kept
+		}
+		// deltas of compacted states can't be recomputed, keep the dropped ones
+		if kept.Compacted {
+			kept.addDeltas(dropped)
+		}
+		days[on] = kept
+	}
+	if moved == 0 {
+		return 0, nil
+	}
+
+	for i := range states {
+		if err := tx.DeleteStruct(&states[i]); err != nil {
+			return 0, errors.Wrapf(err, "error deleting state %s", states[i].Key)
+		}
+	}
+
+	keys := make([]string, 0, len(days))
+	for k := range days {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := tx.Save(days[k]); err != nil {
+			return 0, errors.Wrapf(err, "error saving state %s", days[k].Key)
+		}
+	}
+
+	// all states with lists are snapshots now, convert them back to deltas
+	if _, err := relinkUserStates(tx, username); err != nil {
+		return 0, err
+	}
+	return moved, nil
+}
+
+// addDeltas adds the delta lists and counts of the other state
+func (s *DailyState) addDeltas(o *DailyState) {
+	s.NewFollowers = append(s.NewFollowers, o.NewFollowers...)
+	s.NewFollowerCount += o.NewFollowerCount
+	s.NewUnfollowers = append(s.NewUnfollowers, o.NewUnfollowers...)
+	s.NewUnfollowerCount += o.NewUnfollowerCount
+	s.NewFriends = append(s.NewFriends, o.NewFriends...)
+	s.NewFriendsCount += o.NewFriendsCount
+	s.NewUnfriended = append(s.NewUnfriended, o.NewUnfriended...)
+	s.NewUnfriendedCount += o.NewUnfriendedCount
+}
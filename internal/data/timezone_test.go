@@ -0,0 +1,109 @@
+package data
+
+import (
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserTimezone(t *testing.T) {
+	db, err := GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	loc, err := GetUserLocation(db, "tester")
+	assert.NoError(t, err)
+	assert.Equal(t, time.UTC, loc)
+
+	_, err = SetUserTimezone(db, "tester", "America/Los_Angeles")
+	assert.Error(t, err)
+
+	assert.NoError(t, db.Save(&User{Username: "tester"}))
+	_, err = SetUserTimezone(db, "tester", "Mars/Olympus_Mons")
+	assert.Error(t, err)
+
+	// snapshot, then two deltas
+	assert.NoError(t, db.Save(&DailyState{
+		Key:           "tester-2021-03-01",
+		Username:      "tester",
+		StateOn:       "2021-03-01",
+		SnapshotOn:    "2021-03-01",
+		UpdatedOn:     time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC),
+		Followers:     []int64{1, 2},
+		FollowerCount: 2,
+	}))
+	// 6pm of the previous day in Los Angeles
+	assert.NoError(t, db.Save(&DailyState{
+		Key:              "tester-2021-03-02",
+		Username:         "tester",
+		StateOn:          "2021-03-02",
+		BaselineOn:       "2021-03-01",
+		SnapshotOn:       "2021-03-01",
+		UpdatedOn:        time.Date(2021, 3, 2, 2, 0, 0, 0, time.UTC),
+		FollowerCount:    3,
+		NewFollowers:     []int64{3},
+		NewFollowerCount: 1,
+	}))
+	assert.NoError(t, db.Save(&DailyState{
+		Key:                "tester-2021-03-03",
+		Username:           "tester",
+		StateOn:            "2021-03-03",
+		BaselineOn:         "2021-03-02",
+		SnapshotOn:         "2021-03-01",
+		UpdatedOn:          time.Date(2021, 3, 3, 20, 0, 0, 0, time.UTC),
+		FollowerCount:      3,
+		NewFollowers:       []int64{4},
+		NewFollowerCount:   1,
+		NewUnfollowers:     []int64{1},
+		NewUnfollowerCount: 1,
+	}))
+
+	// user whose name starts with the other one and a dash
+	assert.NoError(t, db.Save(&DailyState{
+		Key:           "tester-x-2021-03-02",
+		Username:      "tester-x",
+		StateOn:       "2021-03-02",
+		UpdatedOn:     time.Date(2021, 3, 2, 2, 0, 0, 0, time.UTC),
+		Followers:     []int64{9},
+		FollowerCount: 1,
+	}))
+
+	n, err := SetUserTimezone(db, "tester", "America/Los_Angeles")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	var other DailyState
+	assert.NoError(t, db.One("Key", "tester-x-2021-03-02", &other))
+	assert.Equal(t, []int64{9}, other.Followers)
+
+	loc, err = GetUserLocation(db, "tester")
+	assert.NoError(t, err)
+	assert.Equal(t, "America/Los_Angeles", loc.String())
+
+	// both earlier states fall on 03-01, the later one is kept
+	var s DailyState
+	assert.NoError(t, db.One("Key", "tester-2021-03-01", &s))
+	assert.True(t, s.IsSnapshot())
+	assert.ElementsMatch(t, []int64{1, 2, 3}, s.Followers)
+	assert.Error(t, db.One("Key", "tester-2021-03-02", &s))
+
+	// delta relinked to the kept state
+	full, err := GetFullState(db, "tester", time.Date(2021, 3, 3, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, "2021-03-01", full.BaselineOn)
+	assert.Equal(t, 1, full.GapDays)
+	assert.ElementsMatch(t, []int64{2, 3, 4}, full.Followers)
+	assert.Equal(t, []int64{4}, full.NewFollowers)
+	assert.Equal(t, []int64{1}, full.NewUnfollowers)
+
+	// same days in the new time zone, nothing to move
+	n, err = SetUserTimezone(db, "tester", "America/Los_Angeles")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	now, err := GetUserNow(db, "tester", time.Date(2021, 3, 4, 3, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, "2021-03-03", now.Format("2006-01-02"))
+}
@@ -0,0 +1,119 @@
+package data
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/pkg/errors"
+)
+
+const (
+	// APITokenPrefix identifies followme API tokens
+	APITokenPrefix = "fm_"
+
+	apiTokenBytes = 32
+	// apiTokenHintLen is the number of token characters stored to tell tokens apart
+	apiTokenHintLen = len(APITokenPrefix) + 4
+)
+
+// APIToken represents personal API token, only the hash of the token is stored
+type APIToken struct {
+	ID         int       `storm:"id,increment" json:"id"`
+	Username   string    `storm:"index" json:"username"`
+	Name       string    `json:"name"`
+	Hash       string    `storm:"unique" json:"hash"`
+	Hint       string    `json:"hint"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+}
+
+// hashAPIToken returns hex encoded SHA-256 of the token,
+// tokens are random so they don't need a slow password hash
+func hashAPIToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
+
+// CreateAPIToken creates new token for an existing user, the returned token value isn't stored
+func CreateAPIToken(db *storm.DB, username, name string) (string, *APIToken, error) {
+	var u User
+	if err := db.One("Username", username, &u); err != nil {
+		if err == storm.ErrNotFound {
+			return "", nil, errors.Errorf("user %s not found, log in to the app first", username)
+		}
+		return "", nil, errors.Wrapf(err, "error getting user %s", username)
+	}
+
+	b := make([]byte, apiTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, errors.Wrap(err, "error generating token")
+	}
+	token := APITokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+
+	t := &APIToken{
+		Username:  username,
+		Name:      name,
+		Hash:      hashAPIToken(token),
+		Hint:      token[:apiTokenHintLen] + "...",
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := db.Save(t); err != nil {
+		return "", nil, errors.Wrapf(err, "error saving token for %s", username)
+	}
+	return token, t, nil
+}
+
+// GetAPITokenByValue returns the token with the value, nil when it doesn't exist
+func GetAPITokenByValue(db *storm.DB, token string) (*APIToken, error) {
+	if !strings.HasPrefix(token, APITokenPrefix) {
+		return nil, nil
+	}
+	var t APIToken
+	if err := db.One("Hash", hashAPIToken(token), &t); err != nil {
+		if err == storm.ErrNotFound {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "error getting token")
+	}
+	return &t, nil
+}
+
+// GetAPITokens returns tokens of the user, or of all users when username is empty
+func GetAPITokens(db *storm.DB, username string) ([]*APIToken, error) {
+	list := make([]*APIToken, 0)
+	var err error
+	if username == "" {
+		err = db.All(&list)
+	} else {
+		err = db.Find("Username", username, &list)
+	}
+	if err != nil && err != storm.ErrNotFound {
+		return nil, errors.Wrap(err, "error getting tokens")
+	}
+	return list, nil
+}
+
+// TouchAPIToken records the time the token was last used
+func TouchAPIToken(db *storm.DB, t *APIToken, now time.Time) error {
+	t.LastUsedAt = now
+	if err := db.UpdateField(&APIToken{ID: t.ID}, "LastUsedAt", now); err != nil {
+		return errors.Wrapf(err, "error updating token %d", t.ID)
+	}
+	return nil
+}
+
+// DeleteAPIToken revokes token with the ID
+func DeleteAPIToken(db *storm.DB, id int) error {
+	if err := db.DeleteStruct(&APIToken{ID: id}); err != nil {
+		if err == storm.ErrNotFound {
+			return errors.Errorf("token %d not found", id)
+		}
+		return errors.Wrapf(err, "error deleting token %d", id)
+	}
+	return nil
+}
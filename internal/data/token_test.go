@@ -0,0 +1,52 @@
+package data
+
+import (
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIToken(t *testing.T) {
+	db, err := GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	_, _, err = CreateAPIToken(db, "tester", "scripts")
+	assert.Error(t, err)
+
+	assert.NoError(t, db.Save(&User{Username: "tester"}))
+	token, created, err := CreateAPIToken(db, "tester", "scripts")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(token, APITokenPrefix))
+	assert.True(t, strings.HasPrefix(created.Hint, token[:6]))
+	assert.NotContains(t, created.Hash, token)
+
+	found, err := GetAPITokenByValue(db, token)
+	assert.NoError(t, err)
+	assert.NotNil(t, found)
+	assert.Equal(t, "tester", found.Username)
+	assert.Equal(t, "scripts", found.Name)
+
+	for _, v := range []string{"", "fm_invalid", token[:len(token)-1]} {
+		found, err := GetAPITokenByValue(db, v)
+		assert.NoError(t, err)
+		assert.Nil(t, found)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	assert.NoError(t, TouchAPIToken(db, created, now))
+	list, err := GetAPITokens(db, "tester")
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, now, list[0].LastUsedAt.UTC())
+	assert.Equal(t, "scripts", list[0].Name)
+
+	assert.NoError(t, DeleteAPIToken(db, created.ID))
+	assert.Error(t, DeleteAPIToken(db, created.ID))
+	found, err = GetAPITokenByValue(db, token)
+	assert.NoError(t, err)
+	assert.Nil(t, found)
+}
@@ -1,7 +1,12 @@
 package data
 
 import (
+	"encoding/base64"
 	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/mchmarny/followme/pkg/envelope"
+	"github.com/pkg/errors"
 )
 
 // User represents authenticated user
@@ -9,5 +14,236 @@ type User struct {
 	Username          string    `storm:"id" json:"username"`
 	AccessTokenKey    string    `json:"access_token_key"`
 	AccessTokenSecret string    `json:"access_token_secret"`
+	DataKey           string    `json:"data_key,omitempty"`
 	UpdatedAt         time.Time `json:"updated_at"`
 }
+
+// IsEncrypted indicates if the access tokens are encrypted
+func (u *User) IsEncrypted() bool {
+	return u.DataKey != ""
+}
+
+// Encrypt encrypts access tokens with a new data key sealed using the master key
+func (u *User) Encrypt(masterKey []byte) error {
+	if u.IsEncrypted() {
+		return errors.Errorf("user %s already encrypted", u.Username)
+	}
+
+	dataKey, err := envelope.NewKey()
+	if err != nil {
+		return errors.Wrap(err, "error creating data key")
+	}
+
+	sealedKey, err := envelope.Encrypt(masterKey, dataKey)
+	if err != nil {
+		return errors.Wrap(err, "error encrypting data key")
+	}
+
+	return u.encryptTokens(dataKey, base64.StdEncoding.EncodeToString(sealedKey))
+}
+
+// encryptTokens encrypts access tokens with the data key, sealed data key is stored with the user
+func (u *User) encryptTokens(dataKey []byte, sealedKey string) error {
+	tokenKey, err := envelope.Encrypt(dataKey, []byte(u.AccessTokenKey))
+	if err != nil {
+		return errors.Wrap(err, "error encrypting access token key")
+	}
+
+	tokenSecret, err := envelope.Encrypt(dataKey, []byte(u.AccessTokenSecret))
+	if err != nil {
+		return errors.Wrap(err, "error encrypting access token secret")
+	}
+
+	u.DataKey = sealedKey
+	u.AccessTokenKey = base64.StdEncoding.EncodeToString(tokenKey)
+	u.AccessTokenSecret = base64.StdEncoding.EncodeToString(tokenSecret)
+	return nil
+}
+
+// Decrypt decrypts access tokens using data key sealed with the master key
+func (u *User) Decrypt(masterKey []byte) error {
+	if !u.IsEncrypted() {
+		return nil
+	}
+
+	dataKey, err := u.openDataKey(masterKey)
+	if err != nil {
+		return err
+	}
+
+	tokenKey, err := decryptString(dataKey, u.AccessTokenKey)
+	if err != nil {
+		return errors.Wrapf(err, "error decrypting access token key for %s", u.Username)
+	}
+
+	tokenSecret, err := decryptString(dataKey, u.AccessTokenSecret)
+	if err != nil {
+		return errors.Wrapf(err, "error decrypting access token secret for %s", u.Username)
+	}
+
+	u.DataKey = ""
+	u.AccessTokenKey = tokenKey
+	u.AccessTokenSecret = tokenSecret
+	return nil
+}
+
+// RotateKey re-seals data key using the new master key.
+// Plain text users are encrypted and, when new key is nil, encrypted users are decrypted.
+func (u *User) RotateKey(oldKey, newKey []byte) error {
+	if !u.IsEncrypted() {
+		if newKey == nil {
+			return nil
+		}
+		return u.Encrypt(newKey)
+	}
+
+	if newKey == nil {
+		return u.Decrypt(oldKey)
+	}
+
+	dataKey, err := u.openDataKey(oldKey)
+	if err != nil {
+		return err
+	}
+
+	sealedKey, err := envelope.Encrypt(newKey, dataKey)
+	if err != nil {
+		return errors.Wrap(err, "error encrypting data key")
+	}
+
+	u.DataKey = base64.StdEncoding.EncodeToString(sealedKey)
+	return nil
+}
+
+func (u *User) openDataKey(masterKey []byte) ([]byte, error) {
+	if masterKey == nil {
+		return nil, errors.Errorf("encryption key required to access user %s", u.Username)
+	}
+	sealedKey, err := base64.StdEncoding.DecodeString(u.DataKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decoding data key for %s", u.Username)
+	}
+	dataKey, err := envelope.Decrypt(masterKey, sealedKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error decrypting data key for %s, invalid encryption key?", u.Username)
+	}
+	return dataKey, nil
+}
+
+func decryptString(key []byte, val string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(val)
+	if err != nil {
+		return "", err
+	}
+	plain, err := envelope.Decrypt(key, b)
+	if err != nil {
+		return "", err
+	}
+	return string(plain), nil
+}
+
+// SaveUser saves user, encrypts access tokens when the master key is set.
+// Existing encrypted user is only saved when the master key opens its data key.
+func SaveUser(db storm.Node, u *User, masterKey []byte) error {
+	usr := *u
+
+	var existing User
+	err := db.One("Username", usr.Username, &existing)
+	if err != nil && err != storm.ErrNotFound {
+		return errors.Wrapf(err, "error getting user %s", usr.Username)
+	}
+
+	// keep the data key of existing user, webhook secrets are encrypted with it
+	if err == nil && existing.IsEncrypted() {
+		dataKey, err := existing.openDataKey(masterKey)
+		if err != nil {
+			return err
+		}
+		if usr.IsEncrypted() {
+			if usr.DataKey != existing.DataKey {
+				return errors.Errorf("user %s encrypted with different data key", usr.Username)
+			}
+			return db.Save(&usr)
+		}
+		if err := usr.encryptTokens(dataKey, existing.DataKey); err != nil {
+			return errors.Wrapf(err, "error encrypting user %s", usr.Username)
+		}
+		return db.Save(&usr)
+	}
+
+	if masterKey == nil || usr.IsEncrypted() {
+		return db.Save(&usr)
+	}
+
+	if err := usr.Encrypt(masterKey); err != nil {
+		return errors.Wrapf(err, "error encrypting user %s", usr.Username)
+	}
+	return db.Save(&usr)
+}
+
+// GetUser returns user with decrypted access tokens
+func GetUser(db *storm.DB, username string, masterKey []byte) (*User, error) {
+	var u User
+	if err := db.One("Username", username, &u); err != nil {
+		return nil, err
+	}
+	if err := u.Decrypt(masterKey); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// GetUsers returns all users with decrypted access tokens,
+// users which can't be decrypted aren't returned, their errors are keyed by username
+func GetUsers(db *storm.DB, masterKey []byte) ([]User, map[string]error, error) {
+	var all []User
+	if err := db.All(&all); err != nil {
+		return nil, nil, err
+	}
+	users := make([]User, 0, len(all))
+	failed := map[string]error{}
+	for i := range all {
+		if err := all[i].Decrypt(masterKey); err != nil {
+			failed[all[i].Username] = err
+			continue
+		}
+		users = append(users, all[i])
+	}
+	return users, failed, nil
+}
+
+// RotateUserKeys re-seals access tokens of all users from old to new master key
+func RotateUserKeys(db *storm.DB, oldKey, newKey []byte) (int, error) {
+	var users []User
+	if err := db.All(&users); err != nil {
+		return 0, errors.Wrap(err, "error getting users")
+	}
+
+	tx, err := db.Begin(true)
+	if err != nil {
+		return 0, errors.Wrap(err, "error starting transaction")
+	}
+	defer tx.Rollback()
+
+	for i := range users {
+		u := &users[i]
+		// webhook secrets are encrypted with the data key which is removed on decryption
+		if u.IsEncrypted() && newKey == nil {
+			if err := decryptWebhooks(tx, u, oldKey); err != nil {
+				return 0, err
+			}
+		}
+		if err := u.RotateKey(oldKey, newKey); err != nil {
+			return 0, errors.Wrapf(err, "error rotating key for %s", u.Username)
+		}
+		if err := tx.Save(u); err != nil {
+			return 0, errors.Wrapf(err, "error saving %s", u.Username)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, errors.Wrap(err, "error committing rotated keys")
+	}
+
+	return len(users), nil
+}
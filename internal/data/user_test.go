@@ -0,0 +1,88 @@
+package data
+
+import (
+	"path"
+	"testing"
+
+	"github.com/mchmarny/followme/pkg/envelope"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserEncryption(t *testing.T) {
+	db, err := GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	key1 := envelope.DeriveKey("key1")
+	key2 := envelope.DeriveKey("key2")
+
+	assert.NoError(t, SaveUser(db, &User{Username: "plain", AccessTokenKey: "k1", AccessTokenSecret: "s1"}, nil))
+	assert.NoError(t, SaveUser(db, &User{Username: "secure", AccessTokenKey: "k2", AccessTokenSecret: "s2"}, key1))
+
+	t.Run("stored", func(t *testing.T) {
+		var u User
+		assert.NoError(t, db.One("Username", "secure", &u))
+		assert.True(t, u.IsEncrypted())
+		assert.NotEqual(t, "k2", u.AccessTokenKey)
+		assert.NotEqual(t, "s2", u.AccessTokenSecret)
+	})
+
+	t.Run("get", func(t *testing.T) {
+		u, err := GetUser(db, "secure", key1)
+		assert.NoError(t, err)
+		assert.Equal(t, "k2", u.AccessTokenKey)
+		assert.Equal(t, "s2", u.AccessTokenSecret)
+
+		_, err = GetUser(db, "secure", nil)
+		assert.Error(t, err)
+		_, err = GetUser(db, "secure", key2)
+		assert.Error(t, err)
+	})
+
+	t.Run("save", func(t *testing.T) {
+		// re-login without the key or with a different one would orphan the data key
+		assert.Error(t, SaveUser(db, &User{Username: "secure", AccessTokenKey: "k3", AccessTokenSecret: "s3"}, nil))
+		assert.Error(t, SaveUser(db, &User{Username: "secure", AccessTokenKey: "k3", AccessTokenSecret: "s3"}, key2))
+
+		var before User
+		assert.NoError(t, db.One("Username", "secure", &before))
+		assert.NoError(t, SaveUser(db, &User{Username: "secure", AccessTokenKey: "k3", AccessTokenSecret: "s3"}, key1))
+		u, err := GetUser(db, "secure", key1)
+		assert.NoError(t, err)
+		assert.Equal(t, "k3", u.AccessTokenKey)
+
+		var after User
+		assert.NoError(t, db.One("Username", "secure", &after))
+		assert.Equal(t, before.DataKey, after.DataKey)
+	})
+
+	t.Run("rotate", func(t *testing.T) {
+		n, err := RotateUserKeys(db, key1, key2)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+
+		users, failed, err := GetUsers(db, key2)
+		assert.NoError(t, err)
+		assert.Empty(t, failed)
+		assert.Len(t, users, 2)
+		for _, u := range users {
+			assert.NotEmpty(t, u.AccessTokenKey)
+			assert.False(t, u.IsEncrypted())
+		}
+
+		// rotation encrypted the plain text user too
+		users, failed, err = GetUsers(db, key1)
+		assert.NoError(t, err)
+		assert.Empty(t, users)
+		assert.Len(t, failed, 2)
+		assert.Error(t, failed["secure"])
+
+		n, err = RotateUserKeys(db, key2, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+
+		u, err := GetUser(db, "plain", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "k1", u.AccessTokenKey)
+	})
+}
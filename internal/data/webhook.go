@@ -0,0 +1,154 @@
+package data
+
+import (
+	"encoding/base64"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/mchmarny/followme/pkg/envelope"
+	"github.com/pkg/errors"
+)
+
+// Webhook represents outbound notification of the user's follower churn
+type Webhook struct {
+	ID        int       `storm:"id,increment" json:"id"`
+	Username  string    `storm:"index" json:"username"`
+	URL       string    `json:"url"`
+	Format    string    `json:"format"`
+	Events    []string  `json:"events"`
+	Template  string    `json:"template,omitempty"`
+	Secret    string    `json:"secret,omitempty"`
+	Encrypted bool      `json:"encrypted"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// HasEvent checks if the webhook is subscribed to the event type
+func (w *Webhook) HasEvent(eventType string) bool {
+	for _, e := range w.Events {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// SaveWebhook saves the webhook of an existing user.
+// When the user is encrypted, the secret is encrypted using the user's data key,
+// so it doesn't have to be re-encrypted when the master key is rotated.
+func SaveWebhook(db *storm.DB, w *Webhook, masterKey []byte) error {
+	hook := *w
+	if hook.Secret != "" && !hook.Encrypted {
+		dataKey, err := getUserDataKey(db, hook.Username, masterKey)
+		if err != nil {
+			return err
+		}
+		if dataKey != nil {
+			sealed, err := envelope.Encrypt(dataKey, []byte(hook.Secret))
+			if err != nil {
+				return errors.Wrap(err, "error encrypting webhook secret")
+			}
+			hook.Secret = base64.StdEncoding.EncodeToString(sealed)
+			hook.Encrypted = true
+		}
+	}
+	if err := db.Save(&hook); err != nil {
+		return errors.Wrapf(err, "error saving webhook for %s", hook.Username)
+	}
+	w.ID = hook.ID
+	return nil
+}
+
+// GetWebhooks returns webhooks of the user with decrypted secrets
+func GetWebhooks(db *storm.DB, username string, masterKey []byte) ([]*Webhook, error) {
+	list := make([]*Webhook, 0)
+	if err := db.Find("Username", username, &list); err != nil && err != storm.ErrNotFound {
+		return nil, errors.Wrapf(err, "error getting webhooks for %s", username)
+	}
+
+	var dataKey []byte
+	for _, w := range list {
+		if !w.Encrypted {
+			continue
+		}
+		if dataKey == nil {
+			k, err := getUserDataKey(db, username, masterKey)
+			if err != nil {
+				return nil, err
+			}
+			if k == nil {
+				return nil, errors.Errorf("user %s no longer encrypted, can't decrypt webhook secrets", username)
+			}
+			dataKey = k
+		}
+		secret, err := decryptString(dataKey, w.Secret)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error decrypting webhook %d secret", w.ID)
+		}
+		w.Secret = secret
+		w.Encrypted = false
+	}
+	return list, nil
+}
+
+// GetAllWebhooks returns webhooks of all users, secrets are not decrypted
+func GetAllWebhooks(db *storm.DB) ([]*Webhook, error) {
+	list := make([]*Webhook, 0)
+	if err := db.All(&list); err != nil && err != storm.ErrNotFound {
+		return nil, errors.Wrap(err, "error getting webhooks")
+	}
+	return list, nil
+}
+
+// DeleteWebhook deletes webhook with the ID
+func DeleteWebhook(db *storm.DB, id int) error {
+	if err := db.DeleteStruct(&Webhook{ID: id}); err != nil {
+		if err == storm.ErrNotFound {
+			return errors.Errorf("webhook %d not found", id)
+		}
+		return errors.Wrapf(err, "error deleting webhook %d", id)
+	}
+	return nil
+}
+
+// decryptWebhooks stores webhook secrets of the user in plain text
+func decryptWebhooks(tx storm.Node, u *User, masterKey []byte) error {
+	var list []*Webhook
+	if err := tx.Find("Username", u.Username, &list); err != nil {
+		if err == storm.ErrNotFound {
+			return nil
+		}
+		return errors.Wrapf(err, "error getting webhooks for %s", u.Username)
+	}
+	dataKey, err := u.openDataKey(masterKey)
+	if err != nil {
+		return err
+	}
+	for _, w := range list {
+		if !w.Encrypted {
+			continue
+		}
+		if w.Secret, err = decryptString(dataKey, w.Secret); err != nil {
+			return errors.Wrapf(err, "error decrypting webhook %d secret", w.ID)
+		}
+		w.Encrypted = false
+		if err := tx.Save(w); err != nil {
+			return errors.Wrapf(err, "error saving webhook %d", w.ID)
+		}
+	}
+	return nil
+}
+
+// getUserDataKey returns data key of the user, nil when the user isn't encrypted
+func getUserDataKey(db storm.Node, username string, masterKey []byte) ([]byte, error) {
+	var u User
+	if err := db.One("Username", username, &u); err != nil {
+		if err == storm.ErrNotFound {
+			return nil, errors.Errorf("user %s not found", username)
+		}
+		return nil, errors.Wrapf(err, "error getting user %s", username)
+	}
+	if !u.IsEncrypted() {
+		return nil, nil
+	}
+	return u.openDataKey(masterKey)
+}
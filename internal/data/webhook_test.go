@@ -0,0 +1,63 @@
+package data
+
+import (
+	"path"
+	"testing"
+
+	"github.com/mchmarny/followme/pkg/envelope"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhook(t *testing.T) {
+	db, err := GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	key1 := envelope.DeriveKey("key1")
+	key2 := envelope.DeriveKey("key2")
+	assert.NoError(t, SaveUser(db, &User{Username: "tester", AccessTokenKey: "k", AccessTokenSecret: "s"}, key1))
+
+	hook := &Webhook{Username: "tester", URL: "https://example.com/hook", Format: "json", Secret: "shh"}
+	assert.NoError(t, SaveWebhook(db, hook, key1))
+	assert.NotZero(t, hook.ID)
+	assert.Error(t, SaveWebhook(db, &Webhook{Username: "nobody", Secret: "shh"}, key1))
+
+	all, err := GetAllWebhooks(db)
+	assert.NoError(t, err)
+	assert.Len(t, all, 1)
+	assert.True(t, all[0].Encrypted)
+	assert.NotEqual(t, "shh", all[0].Secret)
+
+	getSecret := func(key []byte) string {
+		list, err := GetWebhooks(db, "tester", key)
+		assert.NoError(t, err)
+		assert.Len(t, list, 1)
+		return list[0].Secret
+	}
+	assert.Equal(t, "shh", getSecret(key1))
+
+	// re-login keeps the data key
+	assert.NoError(t, SaveUser(db, &User{Username: "tester", AccessTokenKey: "k2", AccessTokenSecret: "s2"}, key1))
+	assert.Equal(t, "shh", getSecret(key1))
+
+	// master key rotation doesn't touch the secret
+	_, err = RotateUserKeys(db, key1, key2)
+	assert.NoError(t, err)
+	assert.Equal(t, "shh", getSecret(key2))
+	_, err = GetWebhooks(db, "tester", key1)
+	assert.Error(t, err)
+
+	// decrypting the user decrypts the secret
+	_, err = RotateUserKeys(db, key2, nil)
+	assert.NoError(t, err)
+	all, err = GetAllWebhooks(db)
+	assert.NoError(t, err)
+	assert.False(t, all[0].Encrypted)
+	assert.Equal(t, "shh", getSecret(nil))
+
+	assert.NoError(t, DeleteWebhook(db, hook.ID))
+	assert.Error(t, DeleteWebhook(db, hook.ID))
+	list, err := GetWebhooks(db, "tester", nil)
+	assert.NoError(t, err)
+	assert.Empty(t, list)
+}
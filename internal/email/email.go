@@ -0,0 +1,141 @@
+// Package email sends multipart (text and HTML) email messages over SMTP.
+package email
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultPort is the SMTP submission port
+	DefaultPort = 587
+)
+
+// Config represents the SMTP server configuration
+type Config struct {
+	// Host is the SMTP server host
+	Host string
+	// Port is the SMTP server port (DefaultPort when not set), STARTTLS is used when the server supports it
+	Port int
+	// Username is the SMTP user, no authentication when not set
+	Username string
+	// Password is the SMTP user password
+	Password string
+	// From is the sender address
+	From string
+}
+
+// Message represents an email message
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// NewSender creates a new instance of the email sender
+func NewSender(cfg *Config) (*Sender, error) {
+	if cfg == nil || cfg.Host == "" || cfg.From == "" {
+		return nil, errors.New("SMTP host and from address required")
+	}
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid from address: %s", cfg.From)
+	}
+	port := cfg.Port
+	if port == 0 {
+		port = DefaultPort
+	}
+
+	var auth smtp.Auth
+	if cfg.Username != "" {
+		auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+
+	return &Sender{
+		addr: net.JoinHostPort(cfg.Host, strconv.Itoa(port)),
+		auth: auth,
+		from: from,
+		now:  time.Now,
+	}, nil
+}
+
+// Sender sends email messages
+type Sender struct {
+	addr string
+	auth smtp.Auth
+	from *mail.Address
+	now  func() time.Time
+}
+
+// Send sends the message
+func (s *Sender) Send(m *Message) error {
+	to, err := mail.ParseAddress(m.To)
+	if err != nil {
+		return errors.Wrapf(err, "invalid recipient address: %s", m.To)
+	}
+	b, err := s.build(to, m)
+	if err != nil {
+		return err
+	}
+	if err := smtp.SendMail(s.addr, s.auth, s.from.Address, []string{to.Address}, b); err != nil {
+		return errors.Wrapf(err, "error sending email to %s", to.Address)
+	}
+	return nil
+}
+
+// build returns the message in multipart/alternative MIME format
+func (s *Sender) build(to *mail.Address, m *Message) ([]byte, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	parts := []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", m.Text},
+		{"text/html; charset=utf-8", m.HTML},
+	}
+	for _, p := range parts {
+		if p.content == "" {
+			continue
+		}
+		pw, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {p.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating message part")
+		}
+		qw := quotedprintable.NewWriter(pw)
+		if _, err := qw.Write([]byte(p.content)); err != nil {
+			return nil, errors.Wrap(err, "error writing message part")
+		}
+		if err := qw.Close(); err != nil {
+			return nil, errors.Wrap(err, "error writing message part")
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, errors.Wrap(err, "error closing message")
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", s.from.String())
+	fmt.Fprintf(&msg, "To: %s\r\n", to.String())
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", s.now().Format(time.RFC1123Z))
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", mw.Boundary())
+	msg.Write(body.Bytes())
+	return msg.Bytes(), nil
+}
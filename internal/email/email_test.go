@@ -0,0 +1,50 @@
+package email
+
+import (
+	"mime"
+	"testing"
+
+	"github.com/mchmarny/followme/internal/email/emailtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSend(t *testing.T) {
+	s := emailtest.NewServer()
+	defer s.Close()
+
+	_, err := NewSender(&Config{Host: s.Host(), Port: s.Port()})
+	assert.Error(t, err)
+
+	sender, err := NewSender(&Config{Host: s.Host(), Port: s.Port(), From: "followme <followme@example.com>"})
+	assert.NoError(t, err)
+
+	assert.Error(t, sender.Send(&Message{To: "invalid", Subject: "test"}))
+
+	err = sender.Send(&Message{
+		To:      "tester@example.com",
+		Subject: "Followers digest: +1/-0 ✓",
+		Text:    "@follower followed you",
+		HTML:    "<p>@follower followed you</p>",
+	})
+	assert.NoError(t, err)
+
+	list := s.Messages()
+	assert.Len(t, list, 1)
+	assert.Equal(t, "followme@example.com", list[0].From)
+	assert.Equal(t, []string{"tester@example.com"}, list[0].To)
+
+	m, err := list[0].Parse()
+	assert.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Followers digest: +1/-0 ✓", subject)
+
+	mediaType, _, err := mime.ParseMediaType(m.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	parts, err := list[0].Parts()
+	assert.NoError(t, err)
+	assert.Equal(t, "@follower followed you", parts["text/plain"])
+	assert.Equal(t, "<p>@follower followed you</p>", parts["text/html"])
+}
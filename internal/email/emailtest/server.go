@@ -0,0 +1,168 @@
+// Package emailtest provides local SMTP sink for testing email senders.
+package emailtest
+
+import (
+	"bufio"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Message represents message received by the server
+type Message struct {
+	From string
+	To   []string
+	Data []byte
+}
+
+// Parse parses the message data
+func (m *Message) Parse() (*mail.Message, error) {
+	return mail.ReadMessage(strings.NewReader(string(m.Data)))
+}
+
+// Parts returns decoded parts of multipart message by content type (e.g. text/plain)
+func (m *Message) Parts() (map[string]string, error) {
+	msg, err := m.Parse()
+	if err != nil {
+		return nil, err
+	}
+	_, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+	parts := make(map[string]string)
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			return parts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		b, err := ioutil.ReadAll(p)
+		if err != nil {
+			return nil, err
+		}
+		contentType, _, _ := mime.ParseMediaType(p.Header.Get("Content-Type"))
+		parts[contentType] = string(b)
+	}
+}
+
+// Server is a SMTP server accepting all messages without authentication or TLS
+type Server struct {
+	ln       net.Listener
+	wg       sync.WaitGroup
+	mu       sync.Mutex
+	messages []*Message
+}
+
+// NewServer starts a new SMTP server on a local port, panics when the port can't be opened
+func NewServer() *Server {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic("emailtest: failed to listen on a port: " + err.Error())
+	}
+	s := &Server{ln: ln, messages: make([]*Message, 0)}
+	s.wg.Add(1)
+	go s.serve()
+	return s
+}
+
+// Host returns the server host
+func (s *Server) Host() string {
+	return s.ln.Addr().(*net.TCPAddr).IP.String()
+}
+
+// Port returns the server port
+func (s *Server) Port() int {
+	return s.ln.Addr().(*net.TCPAddr).Port
+}
+
+// Messages returns messages received so far
+func (s *Server) Messages() []*Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	list := make([]*Message, len(s.messages))
+	copy(list, s.messages)
+	return list
+}
+
+// Close stops the server and waits for open connections to finish
+func (s *Server) Close() {
+	s.ln.Close()
+	s.wg.Wait()
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.handle(textproto.NewConn(conn))
+		}()
+	}
+}
+
+func (s *Server) handle(c *textproto.Conn) {
+	_ = c.PrintfLine("220 localhost ESMTP emailtest")
+	msg := &Message{}
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		cmd := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			_ = c.PrintfLine("250 localhost")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			msg = &Message{From: getAddress(line)}
+			_ = c.PrintfLine("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			msg.To = append(msg.To, getAddress(line))
+			_ = c.PrintfLine("250 OK")
+		case cmd == "DATA":
+			_ = c.PrintfLine("354 End data with <CR><LF>.<CR><LF>")
+			b, err := ioutil.ReadAll(bufio.NewReader(c.DotReader()))
+			if err != nil {
+				return
+			}
+			msg.Data = b
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			_ = c.PrintfLine("250 OK: queued as " + strconv.Itoa(len(s.Messages())))
+		case cmd == "RSET", cmd == "NOOP":
+			_ = c.PrintfLine("250 OK")
+		case cmd == "QUIT":
+			_ = c.PrintfLine("221 Bye")
+			return
+		default:
+			_ = c.PrintfLine("502 Command not implemented")
+		}
+	}
+}
+
+// getAddress returns address from the MAIL FROM or RCPT TO command
+func getAddress(line string) string {
+	v := line[strings.Index(line, ":")+1:]
+	v = strings.TrimSpace(v)
+	if i := strings.Index(v, " "); i > 0 {
+		v = v[:i]
+	}
+	return strings.Trim(v, "<>")
+}
@@ -0,0 +1,165 @@
+// Package metrics exposes Prometheus metrics of the app and worker.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+)
+
+const (
+	namespace = "followme"
+
+	// WorkerJob is the job name of metrics pushed by the worker
+	WorkerJob = "followme_worker"
+
+	runSuccess = "success"
+	runFailure = "failure"
+)
+
+var (
+	apiRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "twitter_api",
+		Name:      "requests_total",
+		Help:      "Number of Twitter API requests by endpoint and response status code (0 when no response).",
+	}, []string{"endpoint", "code"})
+
+	apiErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "twitter_api",
+		Name:      "errors_total",
+		Help:      "Number of failed Twitter API requests (network errors and non 2xx responses) by endpoint.",
+	}, []string{"endpoint"})
+
+	apiDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "twitter_api",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of Twitter API requests by endpoint.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"endpoint"})
+
+	changes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "changes_total",
+		Help:      "Number of follower and friend changes found by the worker by user and type (followers_gained, followers_lost, friends_gained, friends_lost).",
+	}, []string{"username", "type"})
+
+	workerRuns = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "worker",
+		Name:      "runs_total",
+		Help:      "Number of worker runs by result (success, failure).",
+	}, []string{"result"})
+
+	workerUserUpdates = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "worker",
+		Name:      "user_updates_total",
+		Help:      "Number of user updates by status (updated, skipped, failed).",
+	}, []string{"status"})
+
+	workerRunDuration = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "worker",
+		Name:      "last_run_duration_seconds",
+		Help:      "Duration of the last worker run.",
+	})
+
+	workerLastRun = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "worker",
+		Name:      "last_run_timestamp_seconds",
+		Help:      "Time the last worker run finished.",
+	})
+
+	workerLastSuccess = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "worker",
+		Name:      "last_success_timestamp_seconds",
+		Help:      "Time the last worker run without errors finished.",
+	})
+
+	collectors = []prometheus.Collector{
+		apiRequests,
+		apiErrors,
+		apiDuration,
+		changes,
+		workerRuns,
+		workerUserUpdates,
+		workerRunDuration,
+		workerLastRun,
+		workerLastSuccess,
+	}
+)
+
+// NewRegistry creates registry with the process metrics and the user state metrics from the DB
+func NewRegistry(db *storm.DB) (*prometheus.Registry, error) {
+	r := prometheus.NewRegistry()
+	all := append([]prometheus.Collector{
+		prometheus.NewGoCollector(),
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		NewStateCollector(db),
+	}, collectors...)
+	for _, c := range all {
+		if err := r.Register(c); err != nil {
+			return nil, errors.Wrap(err, "error registering metrics")
+		}
+	}
+	return r, nil
+}
+
+// ObserveAPIRequest records Twitter API request, code is 0 when the request failed without response
+func ObserveAPIRequest(endpoint string, code int, d time.Duration) {
+	apiRequests.WithLabelValues(endpoint, strconv.Itoa(code)).Inc()
+	apiDuration.WithLabelValues(endpoint).Observe(d.Seconds())
+	if code < http.StatusOK || code >= http.StatusMultipleChoices {
+		apiErrors.WithLabelValues(endpoint).Inc()
+	}
+}
+
+// AddChanges records follower and friend changes of the user
+func AddChanges(username string, followersGained, followersLost, friendsGained, friendsLost int) {
+	changes.WithLabelValues(username, "followers_gained").Add(float64(followersGained))
+	changes.WithLabelValues(username, "followers_lost").Add(float64(followersLost))
+	changes.WithLabelValues(username, "friends_gained").Add(float64(friendsGained))
+	changes.WithLabelValues(username, "friends_lost").Add(float64(friendsLost))
+}
+
+// ObserveRun records the worker run
+func ObserveRun(r *data.RunReport) {
+	workerRunDuration.Set(r.Duration().Seconds())
+	workerLastRun.Set(float64(r.FinishedAt.UnixNano()) / 1e9)
+	for _, status := range []string{data.UserRunUpdated, data.UserRunSkipped, data.UserRunFailed} {
+		workerUserUpdates.WithLabelValues(status).Add(float64(r.Count(status)))
+	}
+	if r.Error != "" {
+		workerRuns.WithLabelValues(runFailure).Inc()
+		return
+	}
+	workerRuns.WithLabelValues(runSuccess).Inc()
+	workerLastSuccess.Set(float64(r.FinishedAt.UnixNano()) / 1e9)
+}
+
+// WriteTextfile writes metrics of the registry to file in the text format (e.g. for node_exporter textfile collector)
+func WriteTextfile(g prometheus.Gatherer, path string) error {
+	if err := prometheus.WriteToTextfile(path, g); err != nil {
+		return errors.Wrapf(err, "error writing metrics to %s", path)
+	}
+	return nil
+}
+
+// Push pushes metrics of the registry to Prometheus Pushgateway, replacing metrics of the job
+func Push(g prometheus.Gatherer, url, job string) error {
+	if err := push.New(url, job).Gatherer(g).Push(); err != nil {
+		return errors.Wrapf(err, "error pushing metrics to %s", url)
+	}
+	return nil
+}
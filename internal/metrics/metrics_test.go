@@ -0,0 +1,129 @@
+package metrics
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	db, err := data.GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	now := time.Now().UTC()
+	assert.NoError(t, db.Save(&data.User{Username: "tester"}))
+	assert.NoError(t, db.Save(&data.User{Username: "new"}))
+	assert.NoError(t, db.Save(&data.DailyState{
+		Key:           data.GetDailyStateKey("tester", now.AddDate(0, 0, -1)),
+		Username:      "tester",
+		FollowerCount: 10,
+		FriendsCount:  5,
+		UpdatedOn:     now.AddDate(0, 0, -1),
+	}))
+
+	reg, err := NewRegistry(db)
+	assert.NoError(t, err)
+
+	// second registry of the same process, e.g. app and worker
+	_, err = NewRegistry(db)
+	assert.NoError(t, err)
+
+	ObserveAPIRequest("followers/ids", http.StatusOK, time.Millisecond)
+	ObserveAPIRequest("followers/ids", http.StatusTooManyRequests, time.Millisecond)
+	ObserveAPIRequest("followers/ids", 0, time.Millisecond)
+	AddChanges("tester", 2, 1, 0, 0)
+	ObserveRun(&data.RunReport{
+		StartedAt:  now,
+		FinishedAt: now.Add(time.Second),
+		Users:      []*data.UserRunResult{{Username: "tester", Status: data.UserRunUpdated}},
+	})
+	ObserveRun(&data.RunReport{StartedAt: now, FinishedAt: now.Add(2 * time.Second), Error: "failed"})
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(apiErrors.WithLabelValues("followers/ids")))
+	assert.Equal(t, float64(2), testutil.ToFloat64(changes.WithLabelValues("tester", "followers_gained")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(workerRuns.WithLabelValues(runFailure)))
+	assert.InDelta(t, float64(now.Add(time.Second).UnixNano())/1e9, testutil.ToFloat64(workerLastSuccess), 0.001)
+	assert.Equal(t, float64(2), testutil.ToFloat64(workerRunDuration))
+
+	expected := `
+# HELP followme_followers Number of followers of the user in the latest state.
+# TYPE followme_followers gauge
+followme_followers{username="tester"} 10
+# HELP followme_friends Number of accounts the user follows in the latest state.
+# TYPE followme_friends gauge
+followme_friends{username="tester"} 5
+# HELP followme_state_scrape_error 1 if reading user states from the data file failed.
+# TYPE followme_state_scrape_error gauge
+followme_state_scrape_error 0
+`
+	assert.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected),
+		"followme_followers", "followme_friends", "followme_state_scrape_error"))
+
+	t.Run("textfile", func(t *testing.T) {
+		p := path.Join(t.TempDir(), "followme.prom")
+		assert.NoError(t, WriteTextfile(reg, p))
+		b, err := ioutil.ReadFile(p)
+		assert.NoError(t, err)
+		assert.Contains(t, string(b), `followme_twitter_api_requests_total{code="429",endpoint="followers/ids"} 1`)
+		assert.Contains(t, string(b), "followme_worker_last_success_timestamp_seconds")
+	})
+
+	t.Run("push", func(t *testing.T) {
+		var pushed string
+		s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			pushed = r.Method + " " + r.URL.Path
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer s.Close()
+
+		assert.NoError(t, Push(reg, s.URL, WorkerJob))
+		assert.Equal(t, "PUT /metrics/job/followme_worker", pushed)
+	})
+}
+
+func TestStateCollectorCache(t *testing.T) {
+	db, err := data.GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	now := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+	saveState := func(followers int) {
+		assert.NoError(t, db.Save(&data.DailyState{
+			Key:           data.GetDailyStateKey("tester", now),
+			Username:      "tester",
+			FollowerCount: followers,
+			UpdatedOn:     now,
+		}))
+	}
+	assert.NoError(t, db.Save(&data.User{Username: "tester"}))
+	saveState(10)
+
+	c := &stateCollector{db: db, now: func() time.Time { return now }}
+	followers := func() int {
+		states, err := c.getStates()
+		assert.NoError(t, err)
+		assert.Len(t, states, 1)
+		return states[0].followers
+	}
+	assert.Equal(t, 10, followers())
+
+	// cached until the next worker run
+	saveState(11)
+	assert.Equal(t, 10, followers())
+	assert.NoError(t, data.SaveRunReport(db, &data.RunReport{StartedAt: now, FinishedAt: now}))
+	assert.Equal(t, 11, followers())
+
+	// or until the cache expires
+	saveState(12)
+	now = now.Add(stateCacheTTL)
+	assert.Equal(t, 12, followers())
+}
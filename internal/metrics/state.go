@@ -0,0 +1,155 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	followersDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "followers"),
+		"Number of followers of the user in the latest state.",
+		[]string{"username"}, nil,
+	)
+	friendsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "friends"),
+		"Number of accounts the user follows in the latest state.",
+		[]string{"username"}, nil,
+	)
+	stateUpdatedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "state_updated_timestamp_seconds"),
+		"Time the latest state of the user was updated by the worker.",
+		[]string{"username"}, nil,
+	)
+	stateErrorDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "state_scrape_error"),
+		"1 if reading user states from the data file failed.",
+		nil, nil,
+	)
+)
+
+const (
+	// stateCacheTTL is the max time user states are cached between worker runs,
+	// states can also be changed by commands running in other processes (e.g. import)
+	stateCacheTTL = 15 * time.Minute
+)
+
+// NewStateCollector creates collector of the user metrics from the latest state in the DB
+func NewStateCollector(db *storm.DB) prometheus.Collector {
+	return &stateCollector{db: db, now: time.Now}
+}
+
+// userState is the latest state of a single user
+type userState struct {
+	username  string
+	followers int
+	friends   int
+	updatedOn time.Time
+}
+
+// stateCollector reads the latest user states from the DB so the metrics are available
+// even when the worker runs in a different process. States are cached until the next
+// worker run (or stateCacheTTL) so scrapes don't read the states of all users each time.
+type stateCollector struct {
+	db  *storm.DB
+	now func() time.Time
+
+	mu       sync.Mutex
+	states   []*userState
+	cacheRun int
+	cachedAt time.Time
+}
+
+// Describe implements prometheus.Collector
+func (c *stateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- followersDesc
+	ch <- friendsDesc
+	ch <- stateUpdatedDesc
+	ch <- stateErrorDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *stateCollector) Collect(ch chan<- prometheus.Metric) {
+	states, err := c.getStates()
+	var failed float64
+	if err != nil {
+		failed = 1
+	}
+	ch <- prometheus.MustNewConstMetric(stateErrorDesc, prometheus.GaugeValue, failed)
+
+	for _, s := range states {
+		ch <- prometheus.MustNewConstMetric(followersDesc, prometheus.GaugeValue, float64(s.followers), s.username)
+		ch <- prometheus.MustNewConstMetric(friendsDesc, prometheus.GaugeValue, float64(s.friends), s.username)
+		if !s.updatedOn.IsZero() {
+			ch <- prometheus.MustNewConstMetric(stateUpdatedDesc, prometheus.GaugeValue,
+				float64(s.updatedOn.UnixNano())/1e9, s.username)
+		}
+	}
+}
+
+// getStates returns the cached states, reloads them after a worker run or when the cache expired.
+// States which failed to load are skipped and the cache isn't kept so they are retried on next scrape.
+func (c *stateCollector) getStates() ([]*userState, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	reports, err := data.GetRunReports(c.db, 1)
+	if err != nil {
+		return c.states, err
+	}
+	lastRun := 0
+	if len(reports) > 0 {
+		lastRun = reports[0].ID
+	}
+	if !c.cachedAt.IsZero() && lastRun == c.cacheRun && now.Sub(c.cachedAt) < stateCacheTTL {
+		return c.states, nil
+	}
+
+	states, err := c.loadStates(now)
+	c.states = states
+	c.cacheRun, c.cachedAt = lastRun, now
+	if err != nil {
+		c.cachedAt = time.Time{}
+	}
+	return states, err
+}
+
+// loadStates reads the latest state of each user, returns the states which loaded and the last error
+func (c *stateCollector) loadStates(now time.Time) ([]*userState, error) {
+	// tokens aren't needed, so users aren't decrypted
+	var users []data.User
+	if err := c.db.All(&users); err != nil && err != storm.ErrNotFound {
+		return nil, errors.Wrap(err, "error getting users")
+	}
+
+	var lastErr error
+	states := make([]*userState, 0, len(users))
+	for _, u := range users {
+		userNow, err := data.GetUserNow(c.db, u.Username, now)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		s, err := data.GetLatestState(c.db, u.Username, userNow)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if s == nil {
+			continue
+		}
+		states = append(states, &userState{
+			username:  u.Username,
+			followers: s.FollowerCount,
+			friends:   s.FriendsCount,
+			updatedOn: s.UpdatedOn,
+		})
+	}
+	return states, lastErr
+}
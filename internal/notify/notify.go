@@ -0,0 +1,226 @@
+// Package notify sends follower churn notifications to webhooks.
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/template"
+
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/pkg/errors"
+)
+
+const (
+	// JSONFormat posts the full payload as JSON
+	JSONFormat = "json"
+	// SlackFormat posts Slack incoming webhook compatible message
+	SlackFormat = "slack"
+	// DiscordFormat posts Discord webhook compatible message
+	DiscordFormat = "discord"
+
+	// maxMessageEvents is the max number of events listed in chat messages
+	maxMessageEvents = 20
+	// maxDiscordContent is the max length of Discord message content
+	maxDiscordContent = 2000
+
+	// DefaultTemplate is the text/template of chat messages
+	DefaultTemplate = `*@{{ .Username }}* on {{ .Date }}: followers +{{ .NewFollowerCount }}/-{{ .NewUnfollowerCount }}, ` +
+		`friends +{{ .NewFriendCount }}/-{{ .NewUnfriendedCount }}` +
+		`{{ range .Events }}
+{{ .Message }}{{ end }}{{ if .More }}
+...and {{ .More }} more{{ end }}`
+)
+
+var (
+	// Formats lists supported webhook formats
+	Formats = []string{JSONFormat, SlackFormat, DiscordFormat}
+
+	// DefaultEvents lists event types sent when webhook doesn't specify any
+	DefaultEvents = []string{data.FollowedEventType, data.UnfollowedEventType}
+
+	// EventTypes lists all event types webhook can subscribe to
+	EventTypes = []string{
+		data.FollowedEventType,
+		data.UnfollowedEventType,
+		data.FriendedEventType,
+		data.UnfriendedEventType,
+	}
+)
+
+// IsValidFormat checks if the format is supported
+func IsValidFormat(format string) bool {
+	for _, f := range Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidEventType checks if webhook can subscribe to the event type
+func IsValidEventType(eventType string) bool {
+	for _, e := range EventTypes {
+		if e == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseTemplate parses message template, DefaultTemplate when empty
+func ParseTemplate(text string) (*template.Template, error) {
+	if text == "" {
+		text = DefaultTemplate
+	}
+	t, err := template.New("message").Parse(text)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing message template")
+	}
+	return t, nil
+}
+
+// Event represents a single follower churn event
+type Event struct {
+	Type    string        `json:"event_type"`
+	Profile *data.Profile `json:"profile"`
+}
+
+// Message returns human readable description of the event
+func (e *Event) Message() string {
+	name := fmt.Sprintf("user %d", e.Profile.ID)
+	if e.Profile.Username != "" {
+		name = "@" + e.Profile.Username
+	}
+	switch e.Type {
+	case data.FollowedEventType:
+		return fmt.Sprintf("%s followed you", name)
+	case data.UnfollowedEventType:
+		return fmt.Sprintf("%s unfollowed you", name)
+	case data.FriendedEventType:
+		return fmt.Sprintf("You followed %s", name)
+	case data.UnfriendedEventType:
+		return fmt.Sprintf("You unfollowed %s", name)
+	default:
+		return fmt.Sprintf("%s %s", name, e.Type)
+	}
+}
+
+// Payload represents the day's follower churn of a user
+type Payload struct {
+	Username           string   `json:"username"`
+	Date               string   `json:"date"`
+	FollowerCount      int      `json:"follower_count"`
+	FriendCount        int      `json:"friend_count"`
+	NewFollowerCount   int      `json:"new_follower_count"`
+	NewUnfollowerCount int      `json:"new_unfollower_count"`
+	NewFriendCount     int      `json:"new_friend_count"`
+	NewUnfriendedCount int      `json:"new_unfriended_count"`
+	Events             []*Event `json:"events"`
+}
+
+// NewPayload creates payload from the state deltas, IDs without profile have only the ID set
+func NewPayload(s *data.DailyState, profiles map[int64]*data.Profile) *Payload {
+	p := &Payload{
+		Username:           s.Username,
+		Date:               s.StateOn,
+		FollowerCount:      s.FollowerCount,
+		FriendCount:        s.FriendsCount,
+		NewFollowerCount:   s.NewFollowerCount,
+		NewUnfollowerCount: s.NewUnfollowerCount,
+		NewFriendCount:     s.NewFriendsCount,
+		NewUnfriendedCount: s.NewUnfriendedCount,
+		Events:             make([]*Event, 0),
+	}
+	lists := []struct {
+		eventType string
+		ids       []int64
+	}{
+		{data.FollowedEventType, s.NewFollowers},
+		{data.UnfollowedEventType, s.NewUnfollowers},
+		{data.FriendedEventType, s.NewFriends},
+		{data.UnfriendedEventType, s.NewUnfriended},
+	}
+	for _, l := range lists {
+		for _, id := range l.ids {
+			profile, ok := profiles[id]
+			if !ok {
+				profile = &data.Profile{ID: id}
+			}
+			p.Events = append(p.Events, &Event{Type: l.eventType, Profile: profile})
+		}
+	}
+	return p
+}
+
+// Filter returns copy of the payload with only the events of the webhook types, nil when none
+func (p *Payload) Filter(eventTypes []string) *Payload {
+	if len(eventTypes) == 0 {
+		eventTypes = DefaultEvents
+	}
+	f := *p
+	f.Events = make([]*Event, 0)
+	for _, e := range p.Events {
+		for _, t := range eventTypes {
+			if e.Type == t {
+				f.Events = append(f.Events, e)
+				break
+			}
+		}
+	}
+	if len(f.Events) == 0 {
+		return nil
+	}
+	return &f
+}
+
+// messageView is the template data of chat messages, events are limited to maxMessageEvents
+type messageView struct {
+	*Payload
+	Events []*Event
+	More   int
+}
+
+// Render returns the webhook request body for the format
+func Render(format, messageTemplate string, p *Payload) ([]byte, error) {
+	if format == JSONFormat {
+		b, err := json.Marshal(p)
+		if err != nil {
+			return nil, errors.Wrap(err, "error marshaling payload")
+		}
+		return b, nil
+	}
+
+	t, err := ParseTemplate(messageTemplate)
+	if err != nil {
+		return nil, err
+	}
+	v := &messageView{Payload: p, Events: p.Events}
+	if len(v.Events) > maxMessageEvents {
+		v.Events = v.Events[:maxMessageEvents]
+		v.More = len(p.Events) - maxMessageEvents
+	}
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, v); err != nil {
+		return nil, errors.Wrap(err, "error executing message template")
+	}
+	msg := buf.String()
+
+	var body interface{}
+	switch format {
+	case SlackFormat:
+		body = map[string]string{"text": msg}
+	case DiscordFormat:
+		if r := []rune(msg); len(r) > maxDiscordContent {
+			msg = string(r[:maxDiscordContent-3]) + "..."
+		}
+		body = map[string]string{"content": msg}
+	default:
+		return nil, errors.Errorf("invalid format %s, expected one of %v", format, Formats)
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "error marshaling message")
+	}
+	return b, nil
+}
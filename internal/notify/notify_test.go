@@ -0,0 +1,105 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func getTestPayload() *Payload {
+	s := &data.DailyState{
+		Username:           "tester",
+		StateOn:            "2021-01-10",
+		FollowerCount:      10,
+		NewFollowerCount:   1,
+		NewUnfollowerCount: 1,
+		NewFriendsCount:    1,
+		NewFollowers:       []int64{2},
+		NewUnfollowers:     []int64{3},
+		NewFriends:         []int64{4},
+	}
+	return NewPayload(s, map[int64]*data.Profile{2: {ID: 2, Username: "follower"}})
+}
+
+func TestRender(t *testing.T) {
+	p := getTestPayload()
+	assert.Len(t, p.Events, 3)
+
+	b, err := Render(JSONFormat, "", p)
+	assert.NoError(t, err)
+	var jp Payload
+	assert.NoError(t, json.Unmarshal(b, &jp))
+	assert.Equal(t, "tester", jp.Username)
+	assert.Len(t, jp.Events, 3)
+
+	b, err = Render(SlackFormat, "", p.Filter(nil))
+	assert.NoError(t, err)
+	var slack map[string]string
+	assert.NoError(t, json.Unmarshal(b, &slack))
+	assert.Contains(t, slack["text"], "*@tester* on 2021-01-10: followers +1/-1")
+	assert.Contains(t, slack["text"], "@follower followed you")
+	assert.Contains(t, slack["text"], "user 3 unfollowed you")
+	assert.NotContains(t, slack["text"], "You followed")
+
+	b, err = Render(DiscordFormat, "{{ .Username }}: {{ len .Events }}", p)
+	assert.NoError(t, err)
+	var discord map[string]string
+	assert.NoError(t, json.Unmarshal(b, &discord))
+	assert.Equal(t, "tester: 3", discord["content"])
+
+	_, err = Render(SlackFormat, "{{ .Bogus", p)
+	assert.Error(t, err)
+	_, err = Render("bogus", "", p)
+	assert.Error(t, err)
+
+	assert.Nil(t, p.Filter([]string{data.UnfriendedEventType}))
+}
+
+func TestNotify(t *testing.T) {
+	var calls int32
+	var status int32 = http.StatusOK
+	var body []byte
+	var sig string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		body, _ = ioutil.ReadAll(r.Body)
+		sig = r.Header.Get(SignatureHeader)
+		w.WriteHeader(int(atomic.LoadInt32(&status)))
+	}))
+	defer s.Close()
+
+	n := NewNotifier("followme/test")
+	n.backoff = time.Millisecond
+	ctx := context.Background()
+	hook := &data.Webhook{ID: 1, URL: s.URL, Format: SlackFormat, Secret: "shh"}
+
+	assert.NoError(t, n.Notify(ctx, hook, getTestPayload()))
+	assert.EqualValues(t, 1, calls)
+	assert.Equal(t, Sign("shh", body), sig)
+	assert.True(t, strings.HasPrefix(sig, "sha256="))
+
+	// no subscribed events, nothing sent
+	hook.Events = []string{data.UnfriendedEventType}
+	assert.NoError(t, n.Notify(ctx, hook, getTestPayload()))
+	assert.EqualValues(t, 1, calls)
+	hook.Events = nil
+
+	// retried on server errors
+	atomic.StoreInt32(&status, http.StatusInternalServerError)
+	assert.Error(t, n.Notify(ctx, hook, getTestPayload()))
+	assert.EqualValues(t, 1+maxAttempts, calls)
+
+	// rejected requests are not retried
+	atomic.StoreInt32(&status, http.StatusBadRequest)
+	assert.Error(t, n.Notify(ctx, hook, getTestPayload()))
+	assert.EqualValues(t, 2+maxAttempts, calls)
+}
@@ -0,0 +1,113 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/pkg/errors"
+)
+
+const (
+	// SignatureHeader carries HMAC-SHA256 of the request body keyed with the webhook secret
+	SignatureHeader = "X-Followme-Signature"
+	// EventHeader carries the notification type
+	EventHeader = "X-Followme-Event"
+
+	churnEvent = "churn"
+
+	maxAttempts    = 3
+	defaultBackoff = time.Second
+	defaultTimeout = 10 * time.Second
+)
+
+// NewNotifier creates a new instance of the webhook notifier
+func NewNotifier(userAgent string) *Notifier {
+	return &Notifier{
+		client:    &http.Client{Timeout: defaultTimeout},
+		userAgent: userAgent,
+		backoff:   defaultBackoff,
+	}
+}
+
+// Notifier posts payloads to webhooks
+type Notifier struct {
+	client    *http.Client
+	userAgent string
+	backoff   time.Duration
+}
+
+// Sign returns signature header value of the body
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Notify posts the events the webhook subscribes to, does nothing when there are none.
+// Failed requests are retried unless the webhook rejected them (4xx other than 429).
+func (n *Notifier) Notify(ctx context.Context, w *data.Webhook, p *Payload) error {
+	p = p.Filter(w.Events)
+	if p == nil {
+		return nil
+	}
+
+	body, err := Render(w.Format, w.Template, p)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(n.backoff << (attempt - 1)):
+			}
+		}
+		retry, err := n.post(ctx, w, body)
+		if err == nil {
+			return nil
+		}
+		lastErr = err
+		if !retry {
+			break
+		}
+	}
+	return errors.Wrapf(lastErr, "error posting to webhook %d", w.ID)
+}
+
+// post sends the body to the webhook, returns whether failed request should be retried
+func (n *Notifier) post(ctx context.Context, w *data.Webhook, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.Wrap(err, "error creating request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", n.userAgent)
+	req.Header.Set(EventHeader, churnEvent)
+	if w.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(w.Secret, body))
+	}
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+	return retry, errors.Errorf("unexpected response: %s", resp.Status)
+}
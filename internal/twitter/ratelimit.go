@@ -0,0 +1,252 @@
+package twitter
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/internal/metrics"
+)
+
+const (
+	// maxRetries is the max number of times a failed request is retried
+	maxRetries = 3
+	// defaultBackoff is the delay before the first retry, doubled on each next one
+	defaultBackoff = time.Second
+	// defaultRateLimitWindow is used when rate limited response has no reset header
+	defaultRateLimitWindow = 15 * time.Minute
+
+	rateLimitHeader          = "x-rate-limit-limit"
+	rateLimitRemainingHeader = "x-rate-limit-remaining"
+	rateLimitResetHeader     = "x-rate-limit-reset"
+)
+
+var (
+	appLimitsMu sync.Mutex
+	appLimits   = make(map[string]*rateLimits)
+)
+
+// getAppRateLimits returns rate limits of the app key,
+// shared by all clients using the key so parallel updates see each other's limits
+func getAppRateLimits(key string) *rateLimits {
+	appLimitsMu.Lock()
+	defer appLimitsMu.Unlock()
+	l, ok := appLimits[key]
+	if !ok {
+		l = &rateLimits{limits: make(map[string]*data.RateLimit)}
+		appLimits[key] = l
+	}
+	return l
+}
+
+// rateLimits tracks the last known API rate limits per user and endpoint
+type rateLimits struct {
+	mu     sync.Mutex
+	limits map[string]*data.RateLimit
+}
+
+func rateLimitKey(username, endpoint string) string {
+	return username + " " + endpoint
+}
+
+// get returns copy of the endpoint rate limit, nil if unknown
+func (r *rateLimits) get(username, endpoint string) *data.RateLimit {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	l, ok := r.limits[rateLimitKey(username, endpoint)]
+	if !ok {
+		return nil
+	}
+	c := *l
+	return &c
+}
+
+// update sets endpoint rate limit from response headers, ignored when headers are missing
+func (r *rateLimits) update(username, endpoint string, h http.Header, now time.Time) {
+	remaining, err := strconv.Atoi(h.Get(rateLimitRemainingHeader))
+	if err != nil {
+		return
+	}
+	limit, _ := strconv.Atoi(h.Get(rateLimitHeader))
+	r.set(&data.RateLimit{
+		Username:  username,
+		Endpoint:  endpoint,
+		Limit:     limit,
+		Remaining: remaining,
+		ResetAt:   parseReset(h, now),
+		UpdatedAt: now,
+	})
+}
+
+// exhaust marks endpoint rate limit as reached until resetAt
+func (r *rateLimits) exhaust(username, endpoint string, resetAt, now time.Time) {
+	l := r.get(username, endpoint)
+	if l == nil {
+		l = &data.RateLimit{Username: username, Endpoint: endpoint}
+	}
+	l.Remaining = 0
+	l.ResetAt = resetAt
+	l.UpdatedAt = now
+	r.set(l)
+}
+
+func (r *rateLimits) set(l *data.RateLimit) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.limits[rateLimitKey(l.Username, l.Endpoint)] = l
+}
+
+// parseReset returns reset time from the response headers, end of the default window if not set
+func parseReset(h http.Header, now time.Time) time.Time {
+	if sec, err := strconv.ParseInt(h.Get(rateLimitResetHeader), 10, 64); err == nil && sec > 0 {
+		return time.Unix(sec, 0).UTC()
+	}
+	return now.Add(defaultRateLimitWindow).UTC()
+}
+
+// getEndpoint returns API endpoint name from the request path (e.g. followers/ids)
+func getEndpoint(path string) string {
+	path = strings.TrimSuffix(path, ".json")
+	if i := strings.Index(path, "/1.1/"); i >= 0 {
+		return path[i+len("/1.1/"):]
+	}
+	return strings.TrimPrefix(path, "/")
+}
+
+// rateLimitTransport tracks rate limit headers of the user's requests,
+// waits for limit reset when allowed by the context (see data.WithRateLimitWait),
+// and retries rate limited and failed requests with exponential backoff
+type rateLimitTransport struct {
+	// ctx is the context of the provider call, requests created by the Twitter client don't carry it
+	ctx      context.Context
+	username string
+	limits   *rateLimits
+	store    data.RateLimitStore
+	backoff  time.Duration
+	logger   *log.Logger
+	now      func() time.Time
+	next     http.RoundTripper
+}
+
+// RoundTrip sends the request, retrying it when it can be resent
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := t.ctx
+	endpoint := getEndpoint(req.URL.Path)
+	maxWait := data.GetRateLimitWait(ctx)
+	canRetry := req.Body == nil || req.GetBody != nil
+
+	for attempt := 0; ; attempt++ {
+		if l := t.limits.get(t.username, endpoint); l != nil && l.IsExhausted(t.now()) {
+			wait := l.ResetAt.Sub(t.now())
+			if wait > maxWait {
+				return nil, &data.RateLimitError{Endpoint: endpoint, ResetAt: l.ResetAt}
+			}
+			t.logf("Rate limit of %s reached for %s, waiting %v", endpoint, t.username, wait.Round(time.Second))
+			if err := sleep(ctx, wait); err != nil {
+				return nil, err
+			}
+		}
+
+		// requests created by the Twitter client have no context
+		r := req.WithContext(ctx)
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r = req.Clone(ctx)
+			r.Body = body
+		}
+
+		retry := canRetry && attempt < maxRetries
+		start := time.Now()
+		resp, err := t.next.RoundTrip(r)
+		code := 0
+		if resp != nil {
+			code = resp.StatusCode
+		}
+		metrics.ObserveAPIRequest(endpoint, code, time.Since(start))
+		if err != nil {
+			if !retry || ctx.Err() != nil {
+				return nil, err
+			}
+			t.logf("Request to %s failed, retrying: %v", endpoint, err)
+			if err := sleep(ctx, t.backoff<<attempt); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		now := t.now()
+		t.limits.update(t.username, endpoint, resp.Header, now)
+		t.saveLimit(endpoint)
+
+		switch {
+		case resp.StatusCode == http.StatusTooManyRequests:
+			resetAt := parseReset(resp.Header, now)
+			if !resetAt.After(now) {
+				// reset already passed, clocks may differ
+				resetAt = now.Add(t.backoff << attempt)
+			}
+			t.limits.exhaust(t.username, endpoint, resetAt, now)
+			t.saveLimit(endpoint)
+			drain(resp.Body)
+			if !retry {
+				return nil, &data.RateLimitError{Endpoint: endpoint, ResetAt: resetAt}
+			}
+			// next attempt either waits for the reset or fails
+		case resp.StatusCode >= http.StatusInternalServerError && retry:
+			drain(resp.Body)
+			t.logf("Request to %s failed (%s), retrying", endpoint, resp.Status)
+			if err := sleep(ctx, t.backoff<<attempt); err != nil {
+				return nil, err
+			}
+		default:
+			return resp, nil
+		}
+	}
+}
+
+// saveLimit saves the last known rate limit of the endpoint when store is set, errors are logged
+func (t *rateLimitTransport) saveLimit(endpoint string) {
+	if t.store == nil {
+		return
+	}
+	l := t.limits.get(t.username, endpoint)
+	if l == nil {
+		return
+	}
+	if err := t.store.SaveRateLimit(l); err != nil {
+		t.logf("Error saving rate limit of %s: %v", endpoint, err)
+	}
+}
+
+func (t *rateLimitTransport) logf(format string, v ...interface{}) {
+	if t.logger != nil {
+		t.logger.Printf(format, v...)
+	}
+}
+
+// sleep waits for d or until the context is canceled
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func drain(body io.ReadCloser) {
+	_, _ = io.Copy(ioutil.Discard, body)
+	body.Close()
+}
@@ -0,0 +1,112 @@
+package twitter
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/internal/twitter/twittertest"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimit(t *testing.T) {
+	s := twittertest.NewServer()
+	defer s.Close()
+
+	s.PageSize = 2
+	s.AddProfile(&twittertest.Profile{ID: 1, Username: "tester", Name: "Tester"})
+	s.SetFollowers(1, 2, 3, 4, 5, 6, 7)
+
+	db, err := data.GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+	cursors := data.NewCursorStore(db)
+	limits := data.NewRateLimitStore(db)
+
+	// rate limits are shared per app key, unique keys isolate the subtests
+	newClient := func(key string) *Twitter {
+		c, err := NewTwitter(key, "secret", s.URL(), cursors, limits, nil)
+		assert.NoError(t, err)
+		c.backoff = time.Millisecond
+		return c
+	}
+
+	ctx := context.Background()
+	u := &data.User{Username: "tester", AccessTokenKey: "k", AccessTokenSecret: "s"}
+	idsPath := "/1.1/followers/ids.json"
+
+	t.Run("retry", func(t *testing.T) {
+		c := newClient("retry-key")
+		s.FailNext(idsPath, http.StatusServiceUnavailable, 2)
+		calls := s.Calls(idsPath)
+
+		ids, err := c.GetFollowerIDs(ctx, u)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 3, 4, 5, 6, 7}, ids)
+		assert.Equal(t, calls+5, s.Calls(idsPath))
+
+		s.FailNext(idsPath, http.StatusServiceUnavailable, maxRetries+1)
+		_, err = c.GetFollowerIDs(ctx, u)
+		assert.Error(t, err)
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		c := newClient("resume-key")
+		resetAt := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+		s.SetRateLimit(idsPath, 1, resetAt)
+
+		_, err := c.GetFollowerIDs(ctx, u)
+		var rateErr *data.RateLimitError
+		assert.True(t, errors.As(err, &rateErr))
+		assert.Equal(t, "followers/ids", rateErr.Endpoint)
+		assert.Equal(t, resetAt, rateErr.ResetAt)
+
+		cur, err := cursors.GetCursor(u.Username, data.FollowerList)
+		assert.NoError(t, err)
+		assert.NotNil(t, cur)
+		assert.Equal(t, []int64{2, 3}, cur.IDs)
+		assert.Equal(t, resetAt, cur.ResumeAfter.UTC())
+
+		// saved for other processes
+		saved, err := data.GetRateLimits(db, u.Username)
+		assert.NoError(t, err)
+		assert.Len(t, saved, 1)
+		assert.Equal(t, "followers/ids", saved[0].Endpoint)
+		assert.True(t, saved[0].IsExhausted(time.Now()))
+
+		// known limit, fails without calling the API, also for other clients with the same key
+		calls := s.Calls(idsPath)
+		_, err = c.GetFollowerIDs(ctx, u)
+		assert.True(t, errors.As(err, &rateErr))
+		_, err = newClient("resume-key").GetFollowerIDs(ctx, u)
+		assert.True(t, errors.As(err, &rateErr))
+		assert.Equal(t, calls, s.Calls(idsPath))
+
+		// after reset, resumes from the saved cursor
+		s.SetRateLimit(idsPath, 10, resetAt)
+		ids, err := newClient("resumed-key").GetFollowerIDs(ctx, u)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 3, 4, 5, 6, 7}, ids)
+		assert.Equal(t, calls+2, s.Calls(idsPath))
+
+		cur, err = cursors.GetCursor(u.Username, data.FollowerList)
+		assert.NoError(t, err)
+		assert.Nil(t, cur)
+	})
+
+	t.Run("wait", func(t *testing.T) {
+		c := newClient("wait-key")
+		s.SetRateLimit(idsPath, 1, time.Now().Add(time.Second))
+
+		_, err := c.GetFollowerIDs(ctx, u)
+		assert.Error(t, err)
+
+		ids, err := c.GetFollowerIDs(data.WithRateLimitWait(ctx, 3*time.Second), u)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 3, 4, 5, 6, 7}, ids)
+	})
+}
@@ -2,8 +2,12 @@ package twitter
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
@@ -16,24 +20,116 @@ import (
 	"github.com/pkg/errors"
 )
 
-// NewTwitter creates a new instance of Twitter
-func NewTwitter(key, secret string, logger *log.Logger) *Twitter {
-	return &Twitter{
+const (
+	// DefaultAPIURL is the base URL of the Twitter API
+	DefaultAPIURL = "https://api.twitter.com"
+
+	defaultAPIHost = "api.twitter.com"
+
+	relationshipLookupPageSize = 100
+)
+
+// Twitter implements social graph provider
+var _ data.GraphProvider = (*Twitter)(nil)
+
+// NewTwitter creates a new instance of Twitter.
+// When apiURL is empty the DefaultAPIURL is used.
+// When cursors is set, follower and friend paging interrupted by errors is resumed by the next call.
+// When limits is set, the last known API rate limits are saved in it.
+func NewTwitter(key, secret, apiURL string, cursors data.CursorStore, limits data.RateLimitStore, logger *log.Logger) (*Twitter, error) {
+	t := &Twitter{
 		oauthConfig: oauth1.NewConfig(key, secret),
 		logger:      logger,
+		cursors:     cursors,
+		limits:      getAppRateLimits(key),
+		limitStore:  limits,
+		backoff:     defaultBackoff,
+		now:         time.Now,
+		apiURL:      DefaultAPIURL,
+	}
+
+	if apiURL == "" || apiURL == DefaultAPIURL {
+		return t, nil
+	}
+
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing API URL: %s", apiURL)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, errors.Errorf("invalid API URL: %s", apiURL)
 	}
+	t.apiURL = strings.TrimSuffix(apiURL, "/")
+
+	t.httpClient = &http.Client{
+		Transport: &baseURLTransport{
+			baseURL: u,
+			next:    http.DefaultTransport,
+		},
+	}
+
+	return t, nil
 }
 
 // Twitter does Tiwtter things
 type Twitter struct {
 	oauthConfig *oauth1.Config
+	httpClient  *http.Client
 	logger      *log.Logger
+	cursors     data.CursorStore
+	limits      *rateLimits
+	limitStore  data.RateLimitStore
+	backoff     time.Duration
+	now         func() time.Time
+	// apiURL is the base URL of the API endpoints not supported by the Twitter client
+	apiURL string
 }
 
 func (t *Twitter) getClient(ctx context.Context, byUser *data.User) (client *tw.Client, err error) {
+	return tw.NewClient(t.getHTTPClient(ctx, byUser)), nil
+}
+
+// getHTTPClient returns rate limit aware client signing requests on behalf of the user,
+// also used for API endpoints not supported by the Twitter client
+func (t *Twitter) getHTTPClient(ctx context.Context, byUser *data.User) *http.Client {
 	token := oauth1.NewToken(byUser.AccessTokenKey, byUser.AccessTokenSecret)
-	httpClient := t.oauthConfig.Client(oauth1.NoContext, token)
-	return tw.NewClient(httpClient), nil
+	oauthCtx := oauth1.NoContext
+	if t.httpClient != nil {
+		oauthCtx = context.WithValue(oauthCtx, oauth1.HTTPClient, t.httpClient)
+	}
+	return &http.Client{
+		Transport: &rateLimitTransport{
+			ctx:      ctx,
+			username: byUser.Username,
+			limits:   t.limits,
+			store:    t.limitStore,
+			backoff:  t.backoff,
+			logger:   t.logger,
+			now:      t.now,
+			next:     t.oauthConfig.Client(oauthCtx, token).Transport,
+		},
+	}
+}
+
+// baseURLTransport redirects signed requests of the Twitter client, which always
+// uses DefaultAPIURL, to a different base URL
+type baseURLTransport struct {
+	baseURL *url.URL
+	next    http.RoundTripper
+}
+
+// RoundTrip rewrites the request scheme, host, and path prefix of requests to DefaultAPIURL before sending it,
+// requests built from the configured base URL are sent as is
+func (t *baseURLTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host != defaultAPIHost {
+		return t.next.RoundTrip(req)
+	}
+	r := req.Clone(req.Context())
+	r.URL.Scheme = t.baseURL.Scheme
+	r.URL.Host = t.baseURL.Host
+	r.URL.Path = strings.TrimSuffix(t.baseURL.Path, "/") + req.URL.Path
+	r.Host = t.baseURL.Host
+	return t.next.RoundTrip(r)
 }
 
 // GetUserDetails retreaves details about the user
@@ -100,10 +196,10 @@ func (t *Twitter) getUsersByParams(ctx context.Context, byUser *data.User, listP
 	items, resp, err := client.Users.Lookup(listParam)
 	if err != nil {
 		// TODO: find cleaner way of parsing error status code (17) from API error
-		if resp.StatusCode == 404 && strings.Contains(err.Error(), "No user matches") {
+		if resp != nil && resp.StatusCode == 404 && strings.Contains(err.Error(), "No user matches") {
 			return users, nil
 		}
-		return nil, errors.Wrapf(err, "error paging followers (%s): %v", resp.Status, err)
+		return nil, errors.Wrapf(err, "error getting users (%s)", getStatus(resp))
 	}
 
 	for _, u := range items {
@@ -150,33 +246,17 @@ func (t *Twitter) GetFollowerIDs(ctx context.Context, byUser *data.User) (ids []
 		return nil, errors.Wrap(err, "error initializing client")
 	}
 
-	listParam := &tw.FollowerIDParams{
-		ScreenName: byUser.Username,
-		Count:      5000, // max per page
-	}
-
-	ids = make([]int64, 0)
-	for {
-		page, resp, err := client.Followers.IDs(listParam)
+	return t.getIDs(ctx, byUser, data.FollowerList, func(cursor int64) ([]int64, int64, *http.Response, error) {
+		page, resp, err := client.Followers.IDs(&tw.FollowerIDParams{
+			ScreenName: byUser.Username,
+			Count:      5000, // max per page
+			Cursor:     cursor,
+		})
 		if err != nil {
-			return nil, errors.Wrapf(err, "error paging follower IDs (%s): %v", resp.Status, err)
-		}
-
-		// debug
-		// logger.Printf("Page size:%d, Next:%d", len(page.IDs), page.NextCursor)
-
-		ids = append(ids, page.IDs...)
-
-		// has more IDs?
-		if page.NextCursor < 1 {
-			break
+			return nil, 0, resp, err
 		}
-
-		// reset cursor
-		listParam.Cursor = page.NextCursor
-	}
-
-	return
+		return page.IDs, page.NextCursor, resp, nil
+	})
 }
 
 // GetFriendIDs returns all IDs users following authed user
@@ -186,51 +266,185 @@ func (t *Twitter) GetFriendIDs(ctx context.Context, byUser *data.User) (ids []in
 		return nil, errors.Wrap(err, "error initializing client")
 	}
 
-	listParam := &tw.FriendIDParams{
-		ScreenName: byUser.Username,
-		Count:      5000, // max per page
+	return t.getIDs(ctx, byUser, data.FriendList, func(cursor int64) ([]int64, int64, *http.Response, error) {
+		page, resp, err := client.Friends.IDs(&tw.FriendIDParams{
+			ScreenName: byUser.Username,
+			Count:      5000, // max per page
+			Cursor:     cursor,
+		})
+		if err != nil {
+			return nil, 0, resp, err
+		}
+		return page.IDs, page.NextCursor, resp, nil
+	})
+}
+
+// getIDs pages through all IDs of the list starting from the saved cursor, if any.
+// On error, IDs fetched so far are saved so the next call can resume from where this one stopped.
+func (t *Twitter) getIDs(ctx context.Context, byUser *data.User, list string, page func(cursor int64) (ids []int64, next int64, resp *http.Response, err error)) ([]int64, error) {
+	ids := make([]int64, 0)
+	var cursor int64
+
+	if t.cursors != nil {
+		c, err := t.cursors.GetCursor(byUser.Username, list)
+		if err != nil {
+			return nil, err
+		}
+		if c != nil && t.now().Sub(c.UpdatedOn) < data.MaxCursorAge {
+			t.logf("Resuming %s of %s (IDs:%d)", list, byUser.Username, len(c.IDs))
+			ids = append(ids, c.IDs...)
+			cursor = c.Next
+		}
 	}
 
-	ids = make([]int64, 0)
 	for {
-		page, resp, err := client.Friends.IDs(listParam)
+		pageIDs, next, resp, err := page(cursor)
 		if err != nil {
-			return nil, errors.Wrapf(err, "error paging following IDs (%s): %v", resp.Status, err)
+			t.saveCursor(byUser.Username, list, cursor, ids, err)
+			return nil, errors.Wrapf(err, "error paging %s IDs (%s)", list, getStatus(resp))
 		}
 
 		// debug
-		// logger.Printf("Page size:%d, Next:%d", len(page.IDs), page.NextCursor)
+		// logger.Printf("Page size:%d, Next:%d", len(pageIDs), next)
 
-		ids = append(ids, page.IDs...)
+		ids = append(ids, pageIDs...)
 
 		// has more IDs?
-		if page.NextCursor < 1 {
+		if next < 1 {
 			break
 		}
 
 		// reset cursor
-		listParam.Cursor = page.NextCursor
+		cursor = next
 	}
 
-	return
+	if t.cursors != nil {
+		if err := t.cursors.DeleteCursor(byUser.Username, list); err != nil {
+			t.logf("error deleting %s cursor of %s: %v", list, byUser.Username, err)
+		}
+	}
+	return ids, nil
 }
 
-// GetRelationship returns relationship between the source and the target
-func (t *Twitter) GetRelationship(ctx context.Context, byUser *data.User, sourceID, targetID int64) (*tw.Relationship, error) {
-	client, err := t.getClient(ctx, byUser)
+// saveCursor saves paging progress, errors are logged as the paging error is more relevant
+func (t *Twitter) saveCursor(username, list string, next int64, ids []int64, pageErr error) {
+	if t.cursors == nil || len(ids) == 0 {
+		return
+	}
+	c := &data.ListCursor{
+		Username:    username,
+		List:        list,
+		Next:        next,
+		IDs:         ids,
+		ResumeAfter: t.now().UTC(),
+		UpdatedOn:   t.now().UTC(),
+	}
+	var rateErr *data.RateLimitError
+	if errors.As(pageErr, &rateErr) {
+		c.ResumeAfter = rateErr.ResetAt
+	}
+	if err := t.cursors.SaveCursor(c); err != nil {
+		t.logf("error saving %s cursor of %s: %v", list, username, err)
+		return
+	}
+	t.logf("Saved %s of %s to resume after %s (IDs:%d)",
+		list, username, c.ResumeAfter.Format(time.RFC3339), len(ids))
+}
+
+func (t *Twitter) logf(format string, v ...interface{}) {
+	if t.logger != nil {
+		t.logger.Printf(format, v...)
+	}
+}
+
+// getStatus returns response status, if any
+func getStatus(resp *http.Response) string {
+	if resp == nil {
+		return "no response"
+	}
+	return resp.Status
+}
+
+// relationshipLookup is a single item of the friendships/lookup response
+type relationshipLookup struct {
+	ID          int64    `json:"id"`
+	Connections []string `json:"connections"`
+}
+
+// GetRelationships returns relationships between the authenticated user and each of the targets
+// using the friendships/lookup endpoint (100 IDs per request)
+func (t *Twitter) GetRelationships(ctx context.Context, byUser *data.User, targetIDs []int64) ([]*data.Relationship, error) {
+	if byUser == nil {
+		return nil, errors.New("user required")
+	}
+
+	client := t.getHTTPClient(ctx, byUser)
+	list := make([]*data.Relationship, 0, len(targetIDs))
+
+	p, err := pager.GetInt64ArrayPager(targetIDs, relationshipLookupPageSize, 0)
 	if err != nil {
-		return nil, errors.Wrap(err, "error initializing client")
+		return nil, errors.Wrap(err, "error creating pager")
+	}
+	for {
+		ids := p.Next()
+		if ids == nil {
+			return list, nil
+		}
+
+		items, err := t.lookupRelationships(ctx, client, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			rel := &data.Relationship{TargetID: item.ID}
+			for _, c := range item.Connections {
+				switch c {
+				case "following":
+					rel.Following = true
+				case "followed_by":
+					rel.FollowedBy = true
+				}
+			}
+			list = append(list, rel)
+		}
 	}
+}
 
-	params := &tw.FriendshipShowParams{
-		SourceID: sourceID,
-		TargetID: targetID,
+func (t *Twitter) lookupRelationships(ctx context.Context, client *http.Client, ids []int64) ([]*relationshipLookup, error) {
+	strIDs := make([]string, len(ids))
+	for i, id := range ids {
+		strIDs[i] = strconv.FormatInt(id, 10)
 	}
 
-	rel, resp, err := client.Friendships.Show(params)
+	reqURL := fmt.Sprintf("%s/1.1/friendships/lookup.json?%s", t.apiURL,
+		url.Values{"user_id": {strings.Join(strIDs, ",")}}.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
 	if err != nil {
-		return nil, errors.Wrapf(err, "error paging following IDs (%s): %v", resp.Status, err)
+		return nil, errors.Wrap(err, "error creating relationship lookup request")
 	}
 
-	return rel, nil
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "error looking up relationships")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		apiErr := &tw.APIError{}
+		if err := json.NewDecoder(resp.Body).Decode(apiErr); err == nil && !apiErr.Empty() {
+			// no user matches the IDs
+			if resp.StatusCode == http.StatusNotFound {
+				return []*relationshipLookup{}, nil
+			}
+			return nil, errors.Wrapf(apiErr, "error looking up relationships (%s)", resp.Status)
+		}
+		return nil, errors.Errorf("error looking up relationships (%s)", resp.Status)
+	}
+
+	items := make([]*relationshipLookup, 0)
+	if err := json.NewDecoder(resp.Body).Decode(&items); err != nil {
+		return nil, errors.Wrap(err, "error decoding relationships")
+	}
+	return items, nil
 }
@@ -0,0 +1,133 @@
+package twitter
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/internal/twitter/twittertest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTwitter(t *testing.T) {
+	s := twittertest.NewServer()
+	defer s.Close()
+
+	s.PageSize = 2
+	s.AddProfile(&twittertest.Profile{ID: 1, Username: "tester", Name: "Tester"})
+	s.AddProfile(&twittertest.Profile{ID: 2, Username: "friend", Name: "Friend"})
+	s.SetFollowers(1, 2, 3, 4, 5, 6)
+	s.SetFriends(1, 2, 7)
+
+	c, err := NewTwitter("key", "secret", s.URL(), nil, nil, log.New(os.Stdout, "", 0))
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	u := &data.User{Username: "tester", AccessTokenKey: "k", AccessTokenSecret: "s"}
+
+	t.Run("invalid url", func(t *testing.T) {
+		_, err := NewTwitter("key", "secret", "not-a-url", nil, nil, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("user", func(t *testing.T) {
+		p, err := c.GetUserDetails(ctx, u)
+		assert.NoError(t, err)
+		assert.NotNil(t, p)
+		assert.Equal(t, int64(1), p.ID)
+		assert.Equal(t, 5, p.FollowerCount)
+	})
+
+	t.Run("followers", func(t *testing.T) {
+		ids, err := c.GetFollowerIDs(ctx, u)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 3, 4, 5, 6}, ids)
+		assert.Equal(t, 3, s.Calls("/1.1/followers/ids.json"))
+	})
+
+	t.Run("friends", func(t *testing.T) {
+		ids, err := c.GetFriendIDs(ctx, u)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 7}, ids)
+	})
+
+	t.Run("profiles", func(t *testing.T) {
+		list, err := c.GetUserDetailsFromIDs(ctx, u, []int64{2, 99})
+		assert.NoError(t, err)
+		assert.Len(t, list, 1)
+		assert.Equal(t, "friend", list[0].Username)
+
+		list, err = c.GetUserDetailsFromIDs(ctx, u, []int64{99})
+		assert.NoError(t, err)
+		assert.Empty(t, list)
+	})
+
+	t.Run("relationships", func(t *testing.T) {
+		s.SetLoginUser("tester")
+		s.AddProfile(&twittertest.Profile{ID: 3, Username: "follower"})
+		s.AddProfile(&twittertest.Profile{ID: 7, Username: "celebrity"})
+
+		ids := []int64{2, 3, 7, 99}
+		for i := int64(100); i < 250; i++ {
+			ids = append(ids, i)
+		}
+		list, err := c.GetRelationships(ctx, u, ids)
+		assert.NoError(t, err)
+		assert.Len(t, list, 3)
+		assert.Equal(t, 2, s.Calls("/1.1/friendships/lookup.json"))
+
+		rels := make(map[int64]*data.Relationship)
+		for _, r := range list {
+			rels[r.TargetID] = r
+		}
+		assert.True(t, rels[2].Following)
+		assert.True(t, rels[2].FollowedBy)
+		assert.False(t, rels[3].Following)
+		assert.True(t, rels[3].FollowedBy)
+		assert.True(t, rels[7].Following)
+		assert.False(t, rels[7].FollowedBy)
+
+		list, err = c.GetRelationships(ctx, u, []int64{99})
+		assert.NoError(t, err)
+		assert.Empty(t, list)
+	})
+}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestBaseURLTransport(t *testing.T) {
+	var sent []string
+	base, err := url.Parse("http://127.0.0.1:9090/twitter")
+	assert.NoError(t, err)
+	tr := &baseURLTransport{
+		baseURL: base,
+		next: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			sent = append(sent, req.URL.String())
+			return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+		}),
+	}
+
+	for _, u := range []string{
+		DefaultAPIURL + "/1.1/followers/ids.json?count=1",
+		// built from the configured base URL, not rewritten again
+		"http://127.0.0.1:9090/twitter/1.1/friendships/lookup.json",
+	} {
+		req, err := http.NewRequest(http.MethodGet, u, nil)
+		assert.NoError(t, err)
+		_, err = tr.RoundTrip(req)
+		assert.NoError(t, err)
+	}
+
+	assert.Equal(t, []string{
+		"http://127.0.0.1:9090/twitter/1.1/followers/ids.json?count=1",
+		"http://127.0.0.1:9090/twitter/1.1/friendships/lookup.json",
+	}, sent)
+}
@@ -0,0 +1,507 @@
+// Package twittertest provides an in-process stand-in for the Twitter API
+// so the twitter client, worker, and app can be tested offline.
+package twittertest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mchmarny/followme/pkg/format"
+)
+
+const (
+	// DefaultPageSize is the default number of IDs returned per cursor page
+	DefaultPageSize = 5000
+
+	requestToken       = "test-request-token"
+	requestTokenSecret = "test-request-secret"
+	verifier           = "test-verifier"
+)
+
+// NewServer creates and starts a new fake Twitter API server.
+// Caller is responsible for closing the server.
+func NewServer() *Server {
+	s := &Server{
+		PageSize:  DefaultPageSize,
+		profiles:  make(map[int64]*Profile),
+		followers: make(map[int64][]int64),
+		friends:   make(map[int64][]int64),
+		calls:     make(map[string]int),
+		limits:    make(map[string]*rateLimit),
+		failures:  make(map[string]*failure),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth/request_token", s.requestTokenHandler)
+	mux.HandleFunc("/oauth/authorize", s.authorizeHandler)
+	mux.HandleFunc("/oauth/access_token", s.accessTokenHandler)
+	mux.HandleFunc("/1.1/followers/ids.json", s.idsHandler(s.followers))
+	mux.HandleFunc("/1.1/friends/ids.json", s.idsHandler(s.friends))
+	mux.HandleFunc("/1.1/users/lookup.json", s.lookupHandler)
+	mux.HandleFunc("/1.1/friendships/show.json", s.showHandler)
+	mux.HandleFunc("/1.1/friendships/lookup.json", s.relationshipLookupHandler)
+
+	s.server = httptest.NewServer(s.count(mux))
+	return s
+}
+
+// Profile represents the subset of Twitter user served by the fake API
+type Profile struct {
+	ID          int64
+	Username    string
+	Name        string
+	Description string
+	Location    string
+}
+
+// Server is a scriptable fake Twitter API
+type Server struct {
+	// PageSize is the max number of IDs returned per cursor page
+	PageSize int
+
+	mu          sync.Mutex
+	server      *httptest.Server
+	loginUser   string
+	callbackURL string
+	profiles    map[int64]*Profile
+	followers   map[int64][]int64
+	friends     map[int64][]int64
+	calls       map[string]int
+	limits      map[string]*rateLimit
+	failures    map[string]*failure
+}
+
+type rateLimit struct {
+	limit     int
+	remaining int
+	resetAt   time.Time
+}
+
+type failure struct {
+	status int
+	count  int
+}
+
+// URL returns the base URL of the server
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts down the server
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// AddProfile registers user profile with the server
+func (s *Server) AddProfile(p *Profile) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.profiles[p.ID] = p
+}
+
+// SetLoginUser sets the username returned at the end of the OAuth flow
+func (s *Server) SetLoginUser(username string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.loginUser = username
+}
+
+// SetFollowers replaces the list of follower IDs for user with the ID
+func (s *Server) SetFollowers(id int64, ids ...int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.followers[id] = ids
+}
+
+// SetFriends replaces the list of friend IDs for user with the ID
+func (s *Server) SetFriends(id int64, ids ...int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.friends[id] = ids
+}
+
+// Calls returns the number of requests the server received for the path
+func (s *Server) Calls(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[path]
+}
+
+// SetRateLimit limits the number of requests to the path until resetAt,
+// requests over the limit fail with 429 Too Many Requests
+func (s *Server) SetRateLimit(path string, limit int, resetAt time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// reset is reported in seconds
+	s.limits[path] = &rateLimit{limit: limit, remaining: limit, resetAt: resetAt.Truncate(time.Second)}
+}
+
+// FailNext makes the next n requests to the path fail with the status
+func (s *Server) FailNext(path string, status, n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[path] = &failure{status: status, count: n}
+}
+
+func (s *Server) count(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.calls[r.URL.Path]++
+		l := s.limits[r.URL.Path]
+		if l != nil && !time.Now().Before(l.resetAt) {
+			delete(s.limits, r.URL.Path)
+			l = nil
+		}
+		limited := false
+		if l != nil {
+			limited = l.remaining <= 0
+			if !limited {
+				l.remaining--
+			}
+			w.Header().Set("x-rate-limit-limit", strconv.Itoa(l.limit))
+			w.Header().Set("x-rate-limit-remaining", strconv.Itoa(l.remaining))
+			w.Header().Set("x-rate-limit-reset", strconv.FormatInt(l.resetAt.Unix(), 10))
+		}
+		status := 0
+		if f := s.failures[r.URL.Path]; f != nil && f.count > 0 {
+			f.count--
+			status = f.status
+		}
+		s.mu.Unlock()
+
+		switch {
+		case limited:
+			writeError(w, http.StatusTooManyRequests, 88, "Rate limit exceeded")
+		case status > 0:
+			writeError(w, status, 131, http.StatusText(status))
+		default:
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+func (s *Server) requestTokenHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, 0, err.Error())
+		return
+	}
+	s.mu.Lock()
+	s.callbackURL = r.Form.Get("oauth_callback")
+	s.mu.Unlock()
+
+	writeForm(w, url.Values{
+		"oauth_token":              {requestToken},
+		"oauth_token_secret":       {requestTokenSecret},
+		"oauth_callback_confirmed": {"true"},
+	})
+}
+
+func (s *Server) authorizeHandler(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Query().Get("oauth_token") != requestToken {
+		writeError(w, http.StatusUnauthorized, 89, "Invalid or expired token.")
+		return
+	}
+	s.mu.Lock()
+	callback := s.callbackURL
+	s.mu.Unlock()
+
+	q := url.Values{
+		"oauth_token":    {requestToken},
+		"oauth_verifier": {verifier},
+	}
+	http.Redirect(w, r, fmt.Sprintf("%s?%s", callback, q.Encode()), http.StatusFound)
+}
+
+func (s *Server) accessTokenHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, 0, err.Error())
+		return
+	}
+	if r.Form.Get("oauth_verifier") != verifier {
+		writeError(w, http.StatusUnauthorized, 89, "Invalid or expired token.")
+		return
+	}
+
+	s.mu.Lock()
+	username := s.loginUser
+	p := s.findByUsername(username)
+	s.mu.Unlock()
+
+	if p == nil {
+		writeError(w, http.StatusUnauthorized, 32, "Could not authenticate you.")
+		return
+	}
+
+	writeForm(w, url.Values{
+		"oauth_token":        {fmt.Sprintf("%s-token", p.Username)},
+		"oauth_token_secret": {fmt.Sprintf("%s-secret", p.Username)},
+		"user_id":            {strconv.FormatInt(p.ID, 10)},
+		"screen_name":        {p.Username},
+	})
+}
+
+func (s *Server) idsHandler(graph map[int64][]int64) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		p := s.findByUsername(q.Get("screen_name"))
+		if p == nil {
+			writeError(w, http.StatusNotFound, 34, "Sorry, that page does not exist.")
+			return
+		}
+
+		ids := graph[p.ID]
+		start := 0
+		if c, err := strconv.Atoi(q.Get("cursor")); err == nil && c > 0 {
+			start = c
+		}
+		if start > len(ids) {
+			start = len(ids)
+		}
+
+		size := s.PageSize
+		if c, err := strconv.Atoi(q.Get("count")); err == nil && c > 0 && c < size {
+			size = c
+		}
+
+		stop := start + size
+		next := stop
+		if stop >= len(ids) {
+			stop = len(ids)
+			next = 0
+		}
+
+		page := make([]int64, 0)
+		page = append(page, ids[start:stop]...)
+
+		writeJSON(w, map[string]interface{}{
+			"ids":                 page,
+			"next_cursor":         next,
+			"next_cursor_str":     strconv.Itoa(next),
+			"previous_cursor":     0,
+			"previous_cursor_str": "0",
+		})
+	}
+}
+
+func (s *Server) lookupHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, 0, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	users := make([]map[string]interface{}, 0)
+	for _, v := range splitList(r.Form.Get("user_id")) {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, 0, err.Error())
+			return
+		}
+		if p, ok := s.profiles[id]; ok {
+			users = append(users, s.toUser(p))
+		}
+	}
+	for _, v := range splitList(r.Form.Get("screen_name")) {
+		if p := s.findByUsername(v); p != nil {
+			users = append(users, s.toUser(p))
+		}
+	}
+
+	if len(users) == 0 {
+		writeError(w, http.StatusNotFound, 17, "No user matches for specified terms.")
+		return
+	}
+
+	writeJSON(w, users)
+}
+
+func (s *Server) showHandler(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	sourceID, err := strconv.ParseInt(q.Get("source_id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, 0, err.Error())
+		return
+	}
+	targetID, err := strconv.ParseInt(q.Get("target_id"), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, 0, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	following := s.follows(sourceID, targetID)
+	followedBy := s.follows(targetID, sourceID)
+
+	writeJSON(w, map[string]interface{}{
+		"relationship": map[string]interface{}{
+			"source": map[string]interface{}{
+				"id":          sourceID,
+				"following":   following,
+				"followed_by": followedBy,
+			},
+			"target": map[string]interface{}{
+				"id":          targetID,
+				"following":   followedBy,
+				"followed_by": following,
+			},
+		},
+	})
+}
+
+func (s *Server) relationshipLookupHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest, 0, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	source := s.authUser(r)
+	if source == nil {
+		writeError(w, http.StatusUnauthorized, 32, "Could not authenticate you.")
+		return
+	}
+
+	items := make([]map[string]interface{}, 0)
+	for _, v := range splitList(r.Form.Get("user_id")) {
+		id, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, 0, err.Error())
+			return
+		}
+		p, ok := s.profiles[id]
+		if !ok {
+			continue
+		}
+		connections := make([]string, 0)
+		if s.follows(source.ID, id) {
+			connections = append(connections, "following")
+		}
+		if s.follows(id, source.ID) {
+			connections = append(connections, "followed_by")
+		}
+		if len(connections) == 0 {
+			connections = append(connections, "none")
+		}
+		items = append(items, map[string]interface{}{
+			"id":          p.ID,
+			"id_str":      strconv.FormatInt(p.ID, 10),
+			"screen_name": p.Username,
+			"name":        p.Name,
+			"connections": connections,
+		})
+	}
+
+	if len(items) == 0 {
+		writeError(w, http.StatusNotFound, 17, "No user matches for specified terms.")
+		return
+	}
+
+	writeJSON(w, items)
+}
+
+// authUser returns profile of the user the request was signed for,
+// tokens issued by the server identify the user, any other token the login user
+func (s *Server) authUser(r *http.Request) *Profile {
+	auth := r.Header.Get("Authorization")
+	for _, param := range strings.Split(strings.TrimPrefix(auth, "OAuth "), ",") {
+		kv := strings.SplitN(strings.TrimSpace(param), "=", 2)
+		if len(kv) != 2 || kv[0] != "oauth_token" {
+			continue
+		}
+		token, err := url.QueryUnescape(strings.Trim(kv[1], `"`))
+		if err != nil {
+			break
+		}
+		if p := s.findByUsername(strings.TrimSuffix(token, "-token")); p != nil {
+			return p
+		}
+	}
+	return s.findByUsername(s.loginUser)
+}
+
+// follows checks if source follows target using either side of the graph
+func (s *Server) follows(sourceID, targetID int64) bool {
+	for _, id := range s.friends[sourceID] {
+		if id == targetID {
+			return true
+		}
+	}
+	for _, id := range s.followers[targetID] {
+		if id == sourceID {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Server) findByUsername(username string) *Profile {
+	username = format.NormalizeString(username)
+	for _, p := range s.profiles {
+		if format.NormalizeString(p.Username) == username {
+			return p
+		}
+	}
+	return nil
+}
+
+func (s *Server) toUser(p *Profile) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                      p.ID,
+		"id_str":                  strconv.FormatInt(p.ID, 10),
+		"screen_name":             p.Username,
+		"name":                    p.Name,
+		"description":             p.Description,
+		"location":                p.Location,
+		"created_at":              time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC).Format(time.RubyDate),
+		"followers_count":         len(s.followers[p.ID]),
+		"friends_count":           len(s.friends[p.ID]),
+		"profile_image_url_https": fmt.Sprintf("https://pbs.twimg.com/profile_images/%d.png", p.ID),
+	}
+}
+
+func splitList(v string) []string {
+	list := make([]string, 0)
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func writeForm(w http.ResponseWriter, v url.Values) {
+	w.Header().Set("Content-Type", "application/x-www-form-urlencoded")
+	fmt.Fprint(w, v.Encode())
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": []map[string]interface{}{
+			{"code": code, "message": msg},
+		},
+	})
+}
@@ -2,55 +2,174 @@ package worker
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"math/rand"
 	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/asdine/storm/v3"
 	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/internal/metrics"
+	"github.com/mchmarny/followme/internal/notify"
 	"github.com/mchmarny/followme/internal/twitter"
+	"github.com/mchmarny/followme/pkg/date"
 	"github.com/mchmarny/followme/pkg/format"
 	"github.com/mchmarny/followme/pkg/list"
+	"github.com/mchmarny/followme/pkg/schedule"
 	"github.com/pkg/errors"
 )
 
+// Config represents the worker configuration
+type Config struct {
+	// DBPath is the path to the data file
+	DBPath string
+	// Key is the Twitter API key
+	Key string
+	// Secret is the Twitter API secret
+	Secret string
+	// APIURL is the Twitter API base URL
+	APIURL string
+	// EncryptionKey is the master key used to encrypt stored access tokens
+	EncryptionKey []byte
+	// Version is the app version
+	Version string
+	// Freshness skips users whose state was updated within this period
+	Freshness time.Duration
+	// ProfileTTL is the time after which cached profiles are refreshed (data.DefaultProfileTTL when not set)
+	ProfileTTL time.Duration
+	// RateLimitWait is the max time to wait for Twitter API rate limit reset, fails immediately when not set
+	RateLimitWait time.Duration
+	// Concurrency is the max number of users updated in parallel (1 when not set)
+	Concurrency int
+	// UserTimeout is the max time for a single user update, no limit when not set
+	UserTimeout time.Duration
+	// AfterRun is called with the worker DB after each run which wasn't canceled (e.g. to send digests)
+	AfterRun func(ctx context.Context, db *storm.DB) error
+	// MetricsTextfile is the path of file metrics are written to after each run (e.g. for node_exporter)
+	MetricsTextfile string
+	// MetricsPushURL is the Prometheus Pushgateway URL metrics are pushed to after each run
+	MetricsPushURL string
+	// SnapshotInterval is the period of the day changes are grouped by, whole hours dividing the day
+	// (data.DefaultSnapshotInterval when not set)
+	SnapshotInterval time.Duration
+}
+
 // NewWorker creates a new instance of the worker
-func NewWorker(dbPath, key, secret, url, version string) (*Worker, error) {
-	if key == "" || secret == "" || version == "" {
+func NewWorker(cfg *Config) (*Worker, error) {
+	if cfg == nil || cfg.Key == "" || cfg.Secret == "" || cfg.Version == "" {
 		return nil, errors.New("key, secret, and version required")
 	}
+	if err := validateConfig(cfg); err != nil {
+		return nil, err
+	}
 
 	// log
 	logger := log.New(os.Stdout, "worker: ", 0)
 
 	// data
-	db, err := data.GetDB(dbPath)
+	db, err := data.GetDB(cfg.DBPath)
 	if err != nil {
 		return nil, errors.Wrap(err, "error getting DB")
 	}
 
 	// twitter
-	t := twitter.NewTwitter(key, secret, logger)
+	t, err := twitter.NewTwitter(cfg.Key, cfg.Secret, cfg.APIURL, data.NewCursorStore(db), data.NewRateLimitStore(db), logger)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating Twitter client")
+	}
+
+	return newWorker(db, t, logger, cfg), nil
+}
+
+// NewWorkerWithProvider creates a new instance of the worker using the provided DB and graph provider.
+// The DB path and Twitter settings in config are ignored.
+func NewWorkerWithProvider(db *storm.DB, provider data.GraphProvider, cfg *Config) (*Worker, error) {
+	if db == nil || provider == nil || cfg == nil || cfg.Version == "" {
+		return nil, errors.New("db, provider, and version required")
+	}
+	if err := validateConfig(cfg); err != nil {
+		return nil, err
+	}
+	return newWorker(db, provider, log.New(os.Stdout, "worker: ", 0), cfg), nil
+}
 
+// validateConfig checks the optional settings
+func validateConfig(cfg *Config) error {
+	if cfg.SnapshotInterval != 0 {
+		return data.ValidateSnapshotInterval(cfg.SnapshotInterval)
+	}
+	return nil
+}
+
+func newWorker(db *storm.DB, provider data.GraphProvider, logger *log.Logger, cfg *Config) *Worker {
+	profileTTL := cfg.ProfileTTL
+	if profileTTL <= 0 {
+		profileTTL = data.DefaultProfileTTL
+	}
+	concurrency := cfg.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	snapshotInterval := cfg.SnapshotInterval
+	if snapshotInterval <= 0 {
+		snapshotInterval = data.DefaultSnapshotInterval
+	}
 	return &Worker{
-		db:         db,
-		twClient:   t,
-		logger:     logger,
-		appVersion: version,
-	}, nil
+		db:               db,
+		graph:            provider,
+		logger:           logger,
+		appVersion:       cfg.Version,
+		encryptionKey:    cfg.EncryptionKey,
+		freshness:        cfg.Freshness,
+		profileTTL:       profileTTL,
+		rateLimitWait:    cfg.RateLimitWait,
+		concurrency:      concurrency,
+		userTimeout:      cfg.UserTimeout,
+		notifier:         notify.NewNotifier(fmt.Sprintf("followme/%s", cfg.Version)),
+		afterRun:         cfg.AfterRun,
+		metricsFile:      cfg.MetricsTextfile,
+		metricsPush:      cfg.MetricsPushURL,
+		snapshotInterval: snapshotInterval,
+		now:              time.Now,
+	}
 }
 
 // Worker represents the app worker
 type Worker struct {
-	db         *storm.DB
-	twClient   *twitter.Twitter
-	logger     *log.Logger
-	appVersion string
+	db               *storm.DB
+	graph            data.GraphProvider
+	logger           *log.Logger
+	appVersion       string
+	encryptionKey    []byte
+	freshness        time.Duration
+	profileTTL       time.Duration
+	rateLimitWait    time.Duration
+	concurrency      int
+	userTimeout      time.Duration
+	notifier         *notify.Notifier
+	afterRun         func(ctx context.Context, db *storm.DB) error
+	metricsFile      string
+	metricsPush      string
+	snapshotInterval time.Duration
+	now              func() time.Time
+}
+
+// withUserLogger returns copy of the worker prefixing logs with the username,
+// so logs of users updated in parallel can be told apart
+func (w *Worker) withUserLogger(username string) *Worker {
+	uw := *w
+	uw.logger = log.New(w.logger.Writer(), fmt.Sprintf("%s%s: ", w.logger.Prefix(), username), w.logger.Flags())
+	return &uw
 }
 
-func (w *Worker) updateUser(ctx context.Context, forUser data.User) error {
+func (w *Worker) updateUser(ctx context.Context, forUser data.User) (*data.DailyState, error) {
 	if forUser.Username == "" {
-		return errors.New("user parameter required")
+		return nil, errors.New("user parameter required")
 	}
 
 	w.logger.Printf("Starting processing for: %s...", forUser.Username)
@@ -58,22 +177,22 @@ func (w *Worker) updateUser(ctx context.Context, forUser data.User) error {
 	// ============================================================================
 	// Twitter Details
 	// ============================================================================
-	userProfile, err := w.twClient.GetUserDetails(ctx, &forUser)
+	userProfile, err := w.graph.GetUserDetails(ctx, &forUser)
 	if err != nil {
-		return errors.Wrapf(err, "error getting twitter %s deails", forUser.Username)
+		return nil, errors.Wrapf(err, "error getting twitter %s deails", forUser.Username)
 	}
 
-	if err := w.db.Save(userProfile); err != nil {
-		return errors.Wrapf(err, "error saving %s profile", forUser.Username)
+	if err := data.SaveProfiles(w.db, []*data.Profile{userProfile}); err != nil {
+		return nil, errors.Wrapf(err, "error saving %s profile", forUser.Username)
 	}
 
 	// ============================================================================
 	// IDs of all followers from Twitter (users who follow this user)
 	// ============================================================================
 	w.logger.Println("Processing followers...")
-	followerIDs, err := w.twClient.GetFollowerIDs(ctx, &forUser)
+	followerIDs, err := w.graph.GetFollowerIDs(ctx, &forUser)
 	if err != nil {
-		return errors.Wrap(err, "error getting follower IDs")
+		return nil, errors.Wrap(err, "error getting follower IDs")
 	}
 	w.logger.Printf("Follower counts for %s (Profile:%d, IDs:%d)",
 		userProfile.Username, userProfile.FollowerCount, len(followerIDs))
@@ -82,22 +201,23 @@ func (w *Worker) updateUser(ctx context.Context, forUser data.User) error {
 	// IDs of all friends from Twitter (users who this user follows)
 	// ============================================================================
 	w.logger.Println("Processing friends...")
-	friendIDs, err := w.twClient.GetFriendIDs(ctx, &forUser)
+	friendIDs, err := w.graph.GetFriendIDs(ctx, &forUser)
 	if err != nil {
-		return errors.Wrap(err, "error getting friend IDs")
+		return nil, errors.Wrap(err, "error getting friend IDs")
 	}
 	w.logger.Printf("Friend counts for %s (Profile:%d, IDs:%d)",
 		userProfile.Username, userProfile.FriendCount, len(friendIDs))
 
 	// ============================================================================
-	// Yesterday State
+	// Baseline State (most recent state before today, yesterday unless worker missed days)
 	// ============================================================================
-	today := time.Now().UTC()
-	yesterday := today.AddDate(0, 0, -1)
-
-	yesterdayState, err := w.getState(forUser.Username, "Yesterday", yesterday)
+	today, err := data.GetUserNow(w.db, forUser.Username, w.now())
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting user time zone")
+	}
+	baselineState, err := w.getBaselineState(forUser.Username, today)
 	if err != nil {
-		return errors.Wrap(err, "error getting yesterday's state")
+		return nil, errors.Wrap(err, "error getting baseline state")
 	}
 
 	// ============================================================================
@@ -105,71 +225,257 @@ func (w *Worker) updateUser(ctx context.Context, forUser data.User) error {
 	// ============================================================================
 	todayState, err := w.getState(forUser.Username, "Today", today)
 	if err != nil {
-		return errors.Wrap(err, "error getting today's state")
+		return nil, errors.Wrap(err, "error getting today's state")
+	}
+
+	// lists as of the previous run, changes are diffed against them
+	prevState := baselineState
+	if todayState.HasData() {
+		prev := *todayState
+		if err := data.RebuildState(w.db, &prev); err != nil {
+			return nil, errors.Wrap(err, "error rebuilding today's state")
+		}
+		prevState = &prev
+	}
+
+	var newFollowerIDs, newUnfollowerIDs, newFriendsIDs, newUnfriendsIDs []int64
+	todayState.BaselineOn = ""
+	todayState.GapDays = 0
+	todayState.SnapshotOn = todayState.StateOn
+
+	if baselineState != nil {
+		gap, err := date.GetDaysBetween(baselineState.StateOn, todayState.StateOn)
+		if err != nil {
+			return nil, errors.Wrap(err, "error calculating days since baseline")
+		}
+		todayState.BaselineOn = baselineState.StateOn
+		todayState.GapDays = gap - 1
+		if todayState.GapDays > 0 {
+			w.logger.Printf("Missing %d days since %s, using it as baseline",
+				todayState.GapDays, baselineState.StateOn)
+		}
+
+		// full lists only every few days, deltas in between
+		sinceSnapshot, err := date.GetDaysBetween(baselineState.LastSnapshotOn(), todayState.StateOn)
+		if err != nil {
+			return nil, errors.Wrap(err, "error calculating days since last snapshot")
+		}
+		if sinceSnapshot < data.SnapshotIntervalDays {
+			todayState.SnapshotOn = baselineState.LastSnapshotOn()
+		}
+	}
+
+	if prevState != nil {
+		// ============================================================================
+		// New Followers
+		// ============================================================================
+		newFollowerIDs = list.GetDiff(prevState.Followers, followerIDs)
+		w.logger.Printf("New Followers    (p:%5d, t:+%5d)", prevState.FollowerCount, len(newFollowerIDs))
+
+		// ============================================================================
+		// New Unfollowers
+		// ============================================================================
+		newUnfollowerIDs = list.GetDiff(followerIDs, prevState.Followers)
+		w.logger.Printf("New Unfollowers  (p:%5d, t:-%5d)", prevState.FollowerCount, len(newUnfollowerIDs))
+
+		// ============================================================================
+		// New Friends
+		// ============================================================================
+		newFriendsIDs = list.GetDiff(prevState.Friends, friendIDs)
+		w.logger.Printf("Newly Friended   (p:%5d, t:+%5d)", prevState.FriendsCount, len(newFriendsIDs))
+
+		// ============================================================================
+		// New Unfriends
+		// ============================================================================
+		newUnfriendsIDs = list.GetDiff(friendIDs, prevState.Friends)
+		w.logger.Printf("Newly Unfriended (p:%5d, t:-%5d)", prevState.FriendsCount, len(newUnfriendsIDs))
+	} else {
+		w.logger.Printf("No previous state for %s, saving initial state", forUser.Username)
 	}
 
 	// ============================================================================
-	// New Followers
+	// Update Snapshot (changes within the period of the day since the previous run)
 	// ============================================================================
-	newFollowerIDs := list.GetDiff(yesterdayState.Followers, followerIDs)
-	w.logger.Printf("New Followers    (y:%5d, t:+%5d)", yesterdayState.FollowerCount, len(newFollowerIDs))
+	snapshot, err := data.GetSnapshot(w.db, forUser.Username, data.GetSnapshotPeriodStart(today, w.snapshotInterval))
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting snapshot")
+	}
+	snapshot.AddChanges(newFollowerIDs, newUnfollowerIDs, newFriendsIDs, newUnfriendsIDs)
+	snapshot.FollowerCount = len(followerIDs)
+	snapshot.FriendsCount = len(friendIDs)
+	snapshot.UpdatedOn = w.now().UTC()
+	snapshot.Runs++
 
 	// ============================================================================
-	// New Unfollowers
+	// Update State (daily rollup of the snapshots)
 	// ============================================================================
-	newUnfollowerIDs := list.GetDiff(followerIDs, yesterdayState.Followers)
-	w.logger.Printf("New Unfollowers  (y:%5d, t:-%5d)", yesterdayState.FollowerCount, len(newUnfollowerIDs))
+	todayState.Followers = nil
+	todayState.Friends = nil
+	if todayState.IsSnapshot() {
+		todayState.Followers = followerIDs
+		todayState.Friends = friendIDs
+	}
+
+	todayState.FollowerCount = len(followerIDs)
+	todayState.FriendsCount = len(friendIDs)
+	todayState.AddChanges(newFollowerIDs, newUnfollowerIDs, newFriendsIDs, newUnfriendsIDs)
+	todayState.UpdatedOn = snapshot.UpdatedOn
 
 	// ============================================================================
-	// New Friends
+	// Save State
 	// ============================================================================
-	newFriendsIDs := list.GetDiff(yesterdayState.Friends, friendIDs)
-	w.logger.Printf("Newly Friended   (y:%5d, t:+%5d)", yesterdayState.FriendsCount, len(newFriendsIDs))
+	if err := w.saveState(todayState, snapshot); err != nil {
+		return nil, err
+	}
 
 	// ============================================================================
-	// New Unfriends
+	// Cache Profiles (so day lists don't query Twitter and unfollowers remain viewable)
 	// ============================================================================
-	newUnfriendsIDs := list.GetDiff(friendIDs, yesterdayState.Friends)
-	w.logger.Printf("Newly Unfriended (y:%5d, t:-%5d)", yesterdayState.FriendsCount, len(newUnfriendsIDs))
+	w.cacheProfiles(ctx, &forUser, newFollowerIDs, newUnfollowerIDs, newFriendsIDs, newUnfriendsIDs)
+	if todayState.IsSnapshot() {
+		// accounts which never changed, starting with the first run, are searchable too
+		w.seedProfiles(ctx, &forUser, followerIDs, friendIDs)
+	}
 
 	// ============================================================================
-	// Update State
+	// Report changes since the previous run (webhooks and metrics)
 	// ============================================================================
-	todayState.Followers = followerIDs
-	todayState.FollowerCount = len(followerIDs)
+	events := *todayState
+	events.NewFollowers = newFollowerIDs
+	events.NewUnfollowers = newUnfollowerIDs
+	events.NewFriends = newFriendsIDs
+	events.NewUnfriended = newUnfriendsIDs
+	metrics.AddChanges(forUser.Username, len(newFollowerIDs), len(newUnfollowerIDs),
+		len(newFriendsIDs), len(newUnfriendsIDs))
+	w.notify(ctx, &events)
 
-	todayState.NewFollowers = newFollowerIDs
-	todayState.NewFollowerCount = len(newFollowerIDs)
-	todayState.NewUnfollowers = newUnfollowerIDs
-	todayState.NewUnfollowerCount = len(newUnfollowerIDs)
+	w.logger.Printf("Done processing state for: %s", forUser.Username)
+	return todayState, nil
+}
 
-	todayState.Friends = friendIDs
-	todayState.FriendsCount = len(friendIDs)
+// cacheProfiles stores profiles of the users in the lists, errors are logged as the state is already saved
+func (w *Worker) cacheProfiles(ctx context.Context, forUser *data.User, lists ...[]int64) {
+	ids := make([]int64, 0)
+	for _, l := range lists {
+		ids = append(ids, l...)
+	}
+	if len(ids) == 0 {
+		return
+	}
+	profiles, err := data.GetProfiles(ctx, w.db, w.graph, forUser, ids, w.profileTTL, w.now().UTC())
+	if err != nil {
+		w.logger.Printf("error caching profiles for %s: %v", forUser.Username, err)
+		return
+	}
+	w.logger.Printf("Cached profiles for %s (IDs:%d, profiles:%d)", forUser.Username, len(ids), len(profiles))
+}
+
+// seedProfiles stores profiles of the users in the lists which aren't cached yet,
+// errors are logged as the state is already saved
+func (w *Worker) seedProfiles(ctx context.Context, forUser *data.User, lists ...[]int64) {
+	ids := make([]int64, 0)
+	for _, l := range lists {
+		ids = append(ids, l...)
+	}
+	cached, err := data.GetCachedProfiles(w.db, ids)
+	if err != nil {
+		w.logger.Printf("error getting cached profiles for %s: %v", forUser.Username, err)
+		return
+	}
+	missing := make([]int64, 0)
+	for _, id := range ids {
+		if _, ok := cached[id]; !ok {
+			missing = append(missing, id)
+			// mutual accounts are in both lists
+			cached[id] = nil
+		}
+	}
+	w.cacheProfiles(ctx, forUser, missing)
+}
 
-	todayState.NewFriends = newFriendsIDs
-	todayState.NewFriendsCount = len(newFriendsIDs)
-	todayState.NewUnfriended = newUnfriendsIDs
-	todayState.NewUnfriendedCount = len(newUnfriendsIDs)
+// saveState saves the daily state with the snapshot of the period
+func (w *Worker) saveState(s *data.DailyState, snapshot *data.Snapshot) error {
+	tx, err := w.db.Begin(true)
+	if err != nil {
+		return errors.Wrap(err, "error starting transaction")
+	}
+	defer tx.Rollback()
 
-	todayState.UpdatedOn = time.Now().UTC()
-	// ============================================================================
-	// Save State
-	// ============================================================================
-	if err := w.db.Save(todayState); err != nil {
+	if err := tx.Save(s); err != nil {
 		return errors.Wrap(err, "error saving daily state")
 	}
-
-	w.logger.Printf("Done processing state for: %s", forUser.Username)
+	if err := tx.Save(snapshot); err != nil {
+		return errors.Wrap(err, "error saving snapshot")
+	}
+	if err := tx.Commit(); err != nil {
+		return errors.Wrap(err, "error committing daily state")
+	}
 	return nil
 }
 
+// notify posts the state delta events to the user's webhooks,
+// errors are logged as the state is already saved
+func (w *Worker) notify(ctx context.Context, events *data.DailyState) {
+	hooks, err := data.GetWebhooks(w.db, events.Username, w.encryptionKey)
+	if err != nil {
+		w.logger.Printf("error getting webhooks for %s: %v", events.Username, err)
+		return
+	}
+	if len(hooks) == 0 {
+		return
+	}
+
+	ids := make([]int64, 0)
+	for _, l := range [][]int64{events.NewFollowers, events.NewUnfollowers, events.NewFriends, events.NewUnfriended} {
+		ids = append(ids, l...)
+	}
+	if len(ids) == 0 {
+		return
+	}
+	profiles, err := data.GetCachedProfiles(w.db, ids)
+	if err != nil {
+		w.logger.Printf("error getting profiles for %s notifications: %v", events.Username, err)
+	}
+
+	p := notify.NewPayload(events, profiles)
+	for _, h := range hooks {
+		if err := w.notifier.Notify(ctx, h, p); err != nil {
+			w.logger.Printf("error notifying webhook %d of %s: %v", h.ID, events.Username, err)
+			continue
+		}
+	}
+	w.logger.Printf("Notified %d webhooks of %s (events:%d)", len(hooks), events.Username, len(ids))
+}
+
+// getBaselineState returns the most recent state before the date with full lists,
+// nil if user has no prior state
+func (w *Worker) getBaselineState(username string, date time.Time) (*data.DailyState, error) {
+	s, err := data.GetPreviousState(w.db, username, date)
+	if err != nil || s == nil {
+		return nil, err
+	}
+	// imported from export, no lists to diff against
+	if s.Compacted {
+		w.logger.Printf("Previous state of %s (%s) has no follower lists, starting over", username, s.StateOn)
+		return nil, nil
+	}
+	if err := data.RebuildState(w.db, s); err != nil {
+		return nil, err
+	}
+	w.logger.Printf("%-9s state (day:%s, follower:%d, +%5d, -%5d, friend:%5d, +%5d, -%5d)",
+		"Baseline", s.StateOn,
+		s.FollowerCount, s.NewFollowerCount, s.NewUnfollowerCount,
+		s.FriendsCount, s.NewFriendsCount, s.NewUnfriendedCount)
+	return s, nil
+}
+
 func (w *Worker) getState(username, day string, date time.Time) (*data.DailyState, error) {
 	key := data.GetDailyStateKey(username, date)
 	ds := format.ToISODate(date)
 	var s data.DailyState
 	if err := w.db.One("Key", key, &s); err != nil {
 		if err != storm.ErrNotFound {
-			return nil, errors.Wrap(err, "error getting yesterday's state")
+			return nil, errors.Wrapf(err, "error getting %s state", day)
 		}
 		s = data.DailyState{
 			Key:      key,
@@ -186,26 +492,246 @@ func (w *Worker) getState(username, day string, date time.Time) (*data.DailyStat
 
 // Run run the update
 func (w *Worker) Run() error {
-	ctx := context.Background()
+	_, err := w.run(context.Background())
+	return err
+}
+
+// RunDaemon runs the update on schedule until SIGINT or SIGTERM is received.
+// Update in progress is allowed to complete before returning.
+func (w *Worker) RunDaemon(sched schedule.Schedule, jitter time.Duration) error {
+	defer w.db.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan os.Signal, 1)
+	signal.Notify(done, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(done)
+
+	go func() {
+		select {
+		case sig := <-done:
+			w.logger.Printf("Closing: %v", sig)
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return w.Start(ctx, sched, jitter)
+}
+
+// Start runs the update immediately and then on schedule until the context is canceled.
+// Random delay of up to jitter is added to each scheduled run.
+func (w *Worker) Start(ctx context.Context, sched schedule.Schedule, jitter time.Duration) error {
+	if sched == nil {
+		return errors.New("worker schedule required")
+	}
+	if jitter < 0 {
+		return errors.Errorf("invalid worker jitter: %v", jitter)
+	}
+
+	w.logger.Printf("Starting scheduled worker (schedule: %v, jitter: %v)", sched, jitter)
+	rnd := rand.New(rand.NewSource(w.now().UnixNano()))
+	for {
+		if _, err := w.run(ctx); err != nil {
+			w.logger.Printf("error while running worker: %v", err)
+		}
+
+		now := w.now()
+		next := sched.Next(now)
+		if next.IsZero() {
+			return errors.Errorf("no next run time for schedule: %v", sched)
+		}
+		if jitter > 0 {
+			next = next.Add(time.Duration(rnd.Int63n(int64(jitter))))
+		}
+		w.logger.Printf("Next run: %s", next.Format(time.RFC1123))
+
+		select {
+		case <-ctx.Done():
+			w.logger.Println("Scheduled worker stopped")
+			return nil
+		case <-time.After(next.Sub(now)):
+		}
+	}
+}
+
+// run updates all users and saves the run report, which is returned also when the run fails
+func (w *Worker) run(ctx context.Context) (report *data.RunReport, err error) {
+	report = &data.RunReport{
+		Version:   w.appVersion,
+		StartedAt: w.now().UTC(),
+		Users:     make([]*data.UserRunResult, 0),
+	}
 	w.logger.Println("Starting worker run...")
+	ctx = data.WithRateLimitWait(ctx, w.rateLimitWait)
+
+	defer func() {
+		report.FinishedAt = w.now().UTC()
+		if err != nil {
+			report.Error = err.Error()
+		}
+		w.logger.Printf("Run summary (users:%d, updated:%d, skipped:%d, failed:%d, duration:%v)",
+			len(report.Users), report.Count(data.UserRunUpdated), report.Count(data.UserRunSkipped),
+			report.Count(data.UserRunFailed), report.Duration().Round(time.Millisecond))
+		if saveErr := data.SaveRunReport(w.db, report); saveErr != nil {
+			w.logger.Printf("error saving run report: %v", saveErr)
+		}
+		metrics.ObserveRun(report)
+		w.exportMetrics()
+	}()
+
+	users, failed, err := data.GetUsers(w.db, w.encryptionKey)
+	if err != nil {
+		return report, errors.Wrap(err, "error while getting users")
+	}
+	w.logger.Printf("Found %d users (concurrency: %d)", len(users)+len(failed), w.concurrency)
+
+	// users which can't be decrypted fail without holding up the rest
+	failedNames := make([]string, 0, len(failed))
+	for username := range failed {
+		failedNames = append(failedNames, username)
+	}
+	sort.Strings(failedNames)
+	for _, username := range failedNames {
+		decErr := failed[username]
+		w.logger.Printf("error while decrypting user: %s - %v", username, decErr)
+		report.Users = append(report.Users, &data.UserRunResult{
+			Username:  username,
+			Status:    data.UserRunFailed,
+			Error:     decErr.Error(),
+			StartedAt: w.now().UTC(),
+		})
+	}
 
-	var users []data.User
-	if err := w.db.All(&users); err != nil {
-		return errors.Wrap(err, "error while getting users")
+	// users are independent, each result slot is written by a single goroutine
+	results := make([]*data.UserRunResult, len(users))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < w.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				results[j] = w.runUser(ctx, users[j])
+			}
+		}()
 	}
-	w.logger.Printf("Found %d users", len(users))
 
-	subErrors := 0
-	for _, u := range users {
-		if err := w.updateUser(ctx, u); err != nil {
-			w.logger.Printf("error while updating user: %s - %v", u.Username, err)
-			subErrors++
+feed:
+	for i := range users {
+		select {
+		case <-ctx.Done():
+			break feed
+		case jobs <- i:
 		}
 	}
+	close(jobs)
+	wg.Wait()
 
-	if subErrors > 0 {
-		return errors.Errorf("%d worker errors, see logs for details", subErrors)
+	for _, r := range results {
+		// nil when canceled before the user was started
+		if r != nil {
+			report.Users = append(report.Users, r)
+		}
 	}
 
-	return nil
+	if ctx.Err() != nil {
+		return report, errors.Wrap(ctx.Err(), "worker run canceled")
+	}
+
+	// failed users shouldn't hold up the rest, errors of after run are only logged
+	if w.afterRun != nil {
+		if err := w.afterRun(ctx, w.db); err != nil {
+			w.logger.Printf("error after worker run: %v", err)
+		}
+	}
+	if n := report.Count(data.UserRunFailed); n > 0 {
+		return report, errors.Errorf("%d worker errors, see logs for details", n)
+	}
+
+	return report, nil
+}
+
+// exportMetrics writes and pushes metrics when configured, errors are logged
+func (w *Worker) exportMetrics() {
+	if w.metricsFile == "" && w.metricsPush == "" {
+		return
+	}
+	reg, err := metrics.NewRegistry(w.db)
+	if err != nil {
+		w.logger.Printf("error creating metrics registry: %v", err)
+		return
+	}
+	if w.metricsFile != "" {
+		if err := metrics.WriteTextfile(reg, w.metricsFile); err != nil {
+			w.logger.Printf("error exporting metrics: %v", err)
+		}
+	}
+	if w.metricsPush != "" {
+		if err := metrics.Push(reg, w.metricsPush, metrics.WorkerJob); err != nil {
+			w.logger.Printf("error exporting metrics: %v", err)
+		}
+	}
+}
+
+// runUser updates the user unless its state is fresh, within the user timeout if set
+func (w *Worker) runUser(ctx context.Context, u data.User) *data.UserRunResult {
+	r := &data.UserRunResult{
+		Username:  u.Username,
+		StartedAt: w.now().UTC(),
+	}
+
+	fresh, err := w.isFresh(u.Username)
+	if err != nil {
+		w.logger.Printf("error while checking user state: %s - %v", u.Username, err)
+	}
+	if fresh {
+		w.logger.Printf("Skipping %s, updated within last %v", u.Username, w.freshness)
+		r.Status = data.UserRunSkipped
+		return r
+	}
+
+	if w.userTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, w.userTimeout)
+		defer cancel()
+	}
+
+	state, err := w.withUserLogger(u.Username).updateUser(ctx, u)
+	r.Duration = w.now().Sub(r.StartedAt)
+	if err != nil {
+		w.logger.Printf("error while updating user: %s - %v", u.Username, err)
+		r.Status = data.UserRunFailed
+		r.Error = err.Error()
+		return r
+	}
+
+	r.Status = data.UserRunUpdated
+	r.FollowerCount = state.FollowerCount
+	r.FriendCount = state.FriendsCount
+	r.NewFollowerCount = state.NewFollowerCount
+	r.NewUnfollowerCount = state.NewUnfollowerCount
+	r.NewFriendCount = state.NewFriendsCount
+	r.NewUnfriendedCount = state.NewUnfriendedCount
+	return r
+}
+
+// isFresh checks if today's state of the user was updated within the freshness period
+func (w *Worker) isFresh(username string) (bool, error) {
+	if w.freshness <= 0 {
+		return false, nil
+	}
+	now, err := data.GetUserNow(w.db, username, w.now())
+	if err != nil {
+		return false, err
+	}
+	var s data.DailyState
+	if err := w.db.One("Key", data.GetDailyStateKey(username, now), &s); err != nil {
+		if err == storm.ErrNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return now.Sub(s.UpdatedOn) < w.freshness, nil
 }
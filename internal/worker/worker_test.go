@@ -0,0 +1,492 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/asdine/storm/v3"
+	"github.com/mchmarny/followme/internal/data"
+	"github.com/mchmarny/followme/internal/notify"
+	"github.com/mchmarny/followme/internal/twitter/twittertest"
+	"github.com/mchmarny/followme/pkg/envelope"
+	"github.com/mchmarny/followme/pkg/schedule"
+	"github.com/stretchr/testify/assert"
+)
+
+type testProvider struct {
+	followers []int64
+	friends   []int64
+}
+
+func (p *testProvider) GetUserDetails(ctx context.Context, byUser *data.User) (*data.Profile, error) {
+	return &data.Profile{
+		ID:            1,
+		Username:      byUser.Username,
+		FollowerCount: len(p.followers),
+		FriendCount:   len(p.friends),
+	}, nil
+}
+
+func (p *testProvider) GetUserDetailsFromIDs(ctx context.Context, byUser *data.User, ids []int64) ([]*data.Profile, error) {
+	list := make([]*data.Profile, 0)
+	for _, id := range ids {
+		list = append(list, &data.Profile{ID: id})
+	}
+	return list, nil
+}
+
+func (p *testProvider) GetFollowerIDs(ctx context.Context, byUser *data.User) ([]int64, error) {
+	return p.followers, nil
+}
+
+func (p *testProvider) GetFriendIDs(ctx context.Context, byUser *data.User) ([]int64, error) {
+	return p.friends, nil
+}
+
+func (p *testProvider) GetRelationships(ctx context.Context, byUser *data.User, targetIDs []int64) ([]*data.Relationship, error) {
+	list := make([]*data.Relationship, 0, len(targetIDs))
+	for _, id := range targetIDs {
+		list = append(list, &data.Relationship{TargetID: id})
+	}
+	return list, nil
+}
+
+func TestWorker(t *testing.T) {
+	db, err := data.GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	username := "tester"
+	assert.NoError(t, db.Save(&data.User{Username: username}))
+
+	yesterday := &data.DailyState{
+		Key:           data.GetDailyStateKey(username, time.Now().UTC().AddDate(0, 0, -1)),
+		Username:      username,
+		Followers:     []int64{1, 2, 3},
+		FollowerCount: 3,
+		Friends:       []int64{4, 5},
+		FriendsCount:  2,
+	}
+	assert.NoError(t, db.Save(yesterday))
+
+	payloads := make([]*notify.Payload, 0)
+	hs := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var p notify.Payload
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&p))
+		payloads = append(payloads, &p)
+	}))
+	defer hs.Close()
+	assert.NoError(t, data.SaveWebhook(db, &data.Webhook{Username: username, URL: hs.URL, Format: notify.JSONFormat}, nil))
+
+	p := &testProvider{
+		followers: []int64{2, 3, 6},
+		friends:   []int64{4, 5, 7},
+	}
+
+	var afterRuns int
+	metricsFile := path.Join(t.TempDir(), "followme.prom")
+	w, err := NewWorkerWithProvider(db, p, &Config{
+		Version:         "v0.0.1-test",
+		MetricsTextfile: metricsFile,
+		AfterRun: func(ctx context.Context, afterDB *storm.DB) error {
+			assert.Equal(t, db, afterDB)
+			afterRuns++
+			return nil
+		},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, w.Run())
+	assert.Equal(t, 1, afterRuns)
+
+	b, err := ioutil.ReadFile(metricsFile)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `followme_followers{username="tester"} 3`)
+	assert.Contains(t, string(b), `followme_changes_total{type="followers_gained",username="tester"}`)
+	assert.Contains(t, string(b), "followme_worker_last_success_timestamp_seconds")
+
+	// default events only, friend changes are not sent
+	assert.Len(t, payloads, 1)
+	assert.Equal(t, username, payloads[0].Username)
+	assert.Len(t, payloads[0].Events, 2)
+
+	// second run the same day doesn't resend the events
+	assert.NoError(t, w.Run())
+	assert.Len(t, payloads, 1)
+	p.followers = []int64{2, 3, 6, 8}
+	assert.NoError(t, w.Run())
+	assert.Len(t, payloads, 2)
+	assert.Len(t, payloads[1].Events, 1)
+	assert.Equal(t, int64(8), payloads[1].Events[0].Profile.ID)
+
+	var s data.DailyState
+	err = db.One("Key", data.GetDailyStateKey(username, time.Now().UTC()), &s)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, s.FollowerCount)
+	// changes of each run, newest first
+	assert.Equal(t, []int64{8, 6}, s.NewFollowers)
+	assert.Equal(t, []int64{1}, s.NewUnfollowers)
+	assert.Equal(t, []int64{7}, s.NewFriends)
+	assert.Empty(t, s.NewUnfriended)
+}
+
+func TestWorkerRun(t *testing.T) {
+	s := twittertest.NewServer()
+	defer s.Close()
+
+	s.PageSize = 2
+	s.AddProfile(&twittertest.Profile{ID: 1, Username: "tester"})
+	s.AddProfile(&twittertest.Profile{ID: 13, Username: "follower13", Name: "Follower 13"})
+	s.SetFollowers(1, 10, 11, 12)
+	s.SetFriends(1, 10, 20)
+
+	dbPath := path.Join(t.TempDir(), "test.db")
+	db, err := data.GetDB(dbPath)
+	assert.NoError(t, err)
+	assert.NoError(t, db.Save(&data.User{
+		Username:          "tester",
+		AccessTokenKey:    "tester-token",
+		AccessTokenSecret: "tester-secret",
+	}))
+	assert.NoError(t, db.Close())
+
+	w, err := NewWorker(&Config{
+		DBPath:  dbPath,
+		Key:     "key",
+		Secret:  "secret",
+		APIURL:  s.URL(),
+		Version: "v0.0.1-test",
+	})
+	assert.NoError(t, err)
+	defer w.db.Close()
+
+	day1 := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+
+	// day 1
+	w.now = func() time.Time { return day1 }
+	assert.NoError(t, w.Run())
+
+	var s1 data.DailyState
+	assert.NoError(t, w.db.One("Key", data.GetDailyStateKey("tester", day1), &s1))
+	assert.Equal(t, 3, s1.FollowerCount)
+	assert.Equal(t, 2, s1.FriendsCount)
+	assert.Empty(t, s1.NewFollowers)
+	assert.Empty(t, s1.BaselineOn)
+
+	var p data.Profile
+	assert.NoError(t, w.db.One("Username", "tester", &p))
+	assert.Equal(t, int64(1), p.ID)
+
+	// day 2, churn
+	s.SetFollowers(1, 11, 12, 13, 14)
+	s.SetFriends(1, 20)
+	w.now = func() time.Time { return day2 }
+	assert.NoError(t, w.Run())
+
+	var s2 data.DailyState
+	assert.NoError(t, w.db.One("Key", data.GetDailyStateKey("tester", day2), &s2))
+	assert.Equal(t, 4, s2.FollowerCount)
+	assert.Equal(t, []int64{13, 14}, s2.NewFollowers)
+	assert.Equal(t, []int64{10}, s2.NewUnfollowers)
+	assert.Empty(t, s2.NewFriends)
+	assert.Equal(t, []int64{10}, s2.NewUnfriended)
+	assert.Equal(t, "2021-01-10", s2.BaselineOn)
+	assert.Equal(t, 0, s2.GapDays)
+
+	var cached data.Profile
+	assert.NoError(t, w.db.One("ID", int64(13), &cached))
+	assert.Equal(t, "Follower 13", cached.Name)
+	assert.Nil(t, s2.Followers)
+	assert.Equal(t, "2021-01-10", s2.SnapshotOn)
+
+	// day 5, missed days 3 and 4 so diff against day 2
+	day5 := day2.AddDate(0, 0, 3)
+	s.SetFollowers(1, 12, 13, 14, 15)
+	w.now = func() time.Time { return day5 }
+	assert.NoError(t, w.Run())
+
+	var s5 data.DailyState
+	assert.NoError(t, w.db.One("Key", data.GetDailyStateKey("tester", day5), &s5))
+	assert.Equal(t, []int64{15}, s5.NewFollowers)
+	assert.Equal(t, []int64{11}, s5.NewUnfollowers)
+	assert.Equal(t, "2021-01-11", s5.BaselineOn)
+	assert.Equal(t, 2, s5.GapDays)
+
+	full, err := data.GetFullState(w.db, "tester", day5)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{12, 13, 14, 15}, full.Followers)
+	assert.ElementsMatch(t, []int64{20}, full.Friends)
+
+	// day 10, full snapshot again
+	day10 := day1.AddDate(0, 0, data.SnapshotIntervalDays+2)
+	w.now = func() time.Time { return day10 }
+	assert.NoError(t, w.Run())
+
+	var s10 data.DailyState
+	assert.NoError(t, w.db.One("Key", data.GetDailyStateKey("tester", day10), &s10))
+	assert.True(t, s10.IsSnapshot())
+	assert.ElementsMatch(t, []int64{12, 13, 14, 15}, s10.Followers)
+}
+
+func TestWorkerStart(t *testing.T) {
+	db, err := data.GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+	assert.NoError(t, db.Save(&data.User{Username: "tester"}))
+
+	w, err := NewWorkerWithProvider(db, &testProvider{}, &Config{Version: "v0.0.1-test"})
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	assert.Error(t, w.Start(ctx, nil, 0))
+	assert.Error(t, w.Start(ctx, schedule.Every(time.Hour), -time.Second))
+
+	done := make(chan error, 1)
+	go func() {
+		done <- w.Start(ctx, schedule.Every(time.Hour), time.Minute)
+	}()
+
+	assert.Eventually(t, func() bool {
+		var s data.DailyState
+		return db.One("Key", data.GetDailyStateKey("tester", time.Now().UTC()), &s) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func TestWorkerFreshness(t *testing.T) {
+	db, err := data.GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+	assert.NoError(t, db.Save(&data.User{Username: "tester"}))
+
+	w, err := NewWorkerWithProvider(db, &testProvider{}, &Config{
+		Version:   "v0.0.1-test",
+		Freshness: time.Hour,
+	})
+	assert.NoError(t, err)
+
+	now := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+	w.now = func() time.Time { return now }
+
+	r, err := w.run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, r.Count(data.UserRunUpdated))
+
+	now = now.Add(30 * time.Minute)
+	r, err = w.run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, r.Count(data.UserRunUpdated))
+	assert.Equal(t, 1, r.Count(data.UserRunSkipped))
+
+	now = now.Add(time.Hour)
+	r, err = w.run(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, r.Count(data.UserRunUpdated))
+}
+
+func TestWorkerSnapshots(t *testing.T) {
+	db, err := data.GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+	assert.NoError(t, db.Save(&data.User{Username: "tester"}))
+
+	_, err = NewWorkerWithProvider(db, &testProvider{}, &Config{Version: "v0.0.1-test", SnapshotInterval: 5 * time.Hour})
+	assert.Error(t, err)
+
+	p := &testProvider{followers: []int64{1, 2}}
+	w, err := NewWorkerWithProvider(db, p, &Config{Version: "v0.0.1-test", SnapshotInterval: 6 * time.Hour})
+	assert.NoError(t, err)
+
+	run := func(hour int) {
+		w.now = func() time.Time { return time.Date(2021, 1, 10, hour, 0, 0, 0, time.UTC) }
+		assert.NoError(t, w.Run())
+	}
+
+	run(1)
+	// 3 follows in the morning, unfollows in the afternoon when 4 follows
+	p.followers = []int64{3, 1, 2}
+	run(8)
+	p.followers = []int64{4, 1, 2}
+	run(14)
+	run(15)
+
+	list, err := data.GetSnapshots(db, "tester", "2021-01-10")
+	assert.NoError(t, err)
+	assert.Len(t, list, 3)
+	assert.Empty(t, list[0].NewFollowers)
+	assert.Equal(t, []int64{3}, list[1].NewFollowers)
+	assert.Equal(t, []int64{4}, list[2].NewFollowers)
+	assert.Equal(t, []int64{3}, list[2].NewUnfollowers)
+	assert.Equal(t, 2, list[2].Runs)
+
+	// daily rollup holds the net changes of all snapshots
+	var s data.DailyState
+	assert.NoError(t, db.One("Key", "tester-2021-01-10", &s))
+	assert.Equal(t, []int64{4}, s.NewFollowers)
+	assert.Empty(t, s.NewUnfollowers)
+	assert.Equal(t, 3, s.FollowerCount)
+}
+
+func TestWorkerTimezone(t *testing.T) {
+	db, err := data.GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+	assert.NoError(t, db.Save(&data.User{Username: "tester"}))
+	_, err = data.SetUserTimezone(db, "tester", "America/New_York")
+	assert.NoError(t, err)
+
+	w, err := NewWorkerWithProvider(db, &testProvider{}, &Config{Version: "v0.0.1-test"})
+	assert.NoError(t, err)
+
+	// 10pm of the 9th in New York
+	w.now = func() time.Time { return time.Date(2021, 1, 10, 3, 0, 0, 0, time.UTC) }
+	assert.NoError(t, w.Run())
+
+	var s data.DailyState
+	assert.NoError(t, db.One("Key", "tester-2021-01-09", &s))
+	assert.Equal(t, "2021-01-09", s.StateOn)
+}
+
+// slowProvider blocks follower paging of the slow user until the context is done
+type slowProvider struct {
+	testProvider
+	slowUser string
+}
+
+func (p *slowProvider) GetFollowerIDs(ctx context.Context, byUser *data.User) ([]int64, error) {
+	if byUser.Username == p.slowUser {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	}
+	return p.testProvider.GetFollowerIDs(ctx, byUser)
+}
+
+func TestWorkerConcurrency(t *testing.T) {
+	db, err := data.GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	usernames := []string{"user1", "user2", "slow", "user3", "user4"}
+	for _, u := range usernames {
+		assert.NoError(t, db.Save(&data.User{Username: u}))
+	}
+
+	p := &slowProvider{
+		testProvider: testProvider{followers: []int64{1, 2}, friends: []int64{3}},
+		slowUser:     "slow",
+	}
+	w, err := NewWorkerWithProvider(db, p, &Config{
+		Version:     "v0.0.1-test",
+		Concurrency: 3,
+		UserTimeout: 100 * time.Millisecond,
+	})
+	assert.NoError(t, err)
+
+	r, err := w.run(context.Background())
+	assert.Error(t, err)
+	assert.NotNil(t, r)
+	assert.Equal(t, "v0.0.1-test", r.Version)
+	assert.Len(t, r.Users, len(usernames))
+	assert.Equal(t, 4, r.Count(data.UserRunUpdated))
+	assert.Equal(t, 1, r.Count(data.UserRunFailed))
+	for _, u := range r.Users {
+		if u.Username == "slow" {
+			assert.Equal(t, data.UserRunFailed, u.Status)
+			assert.Contains(t, u.Error, "deadline exceeded")
+		}
+	}
+
+	saved, err := data.GetRunReports(db, 1)
+	assert.NoError(t, err)
+	assert.Len(t, saved, 1)
+	assert.Equal(t, r.ID, saved[0].ID)
+	assert.NotEmpty(t, saved[0].Error)
+	assert.Equal(t, 2, saved[0].GetUser("user1").FollowerCount)
+	assert.Equal(t, data.UserRunFailed, saved[0].GetUser("slow").Status)
+
+	for _, u := range usernames {
+		var s data.DailyState
+		err := db.One("Key", data.GetDailyStateKey(u, time.Now().UTC()), &s)
+		if u == "slow" {
+			assert.Error(t, err)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, 2, s.FollowerCount)
+	}
+}
+
+func TestWorkerDecryptionFailure(t *testing.T) {
+	db, err := data.GetDB(path.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	assert.NoError(t, data.SaveUser(db, &data.User{Username: "plain"}, nil))
+	assert.NoError(t, data.SaveUser(db, &data.User{Username: "secure"}, envelope.DeriveKey("other")))
+
+	p := &testProvider{followers: []int64{1, 2}, friends: []int64{3}}
+	w, err := NewWorkerWithProvider(db, p, &Config{
+		Version:       "v0.0.1-test",
+		EncryptionKey: envelope.DeriveKey("key"),
+	})
+	assert.NoError(t, err)
+
+	r, err := w.run(context.Background())
+	assert.Error(t, err)
+	assert.Len(t, r.Users, 2)
+	assert.Equal(t, data.UserRunFailed, r.GetUser("secure").Status)
+	assert.Contains(t, r.GetUser("secure").Error, "invalid encryption key")
+	assert.Equal(t, data.UserRunUpdated, r.GetUser("plain").Status)
+}
+
+func TestWorkerSeedProfiles(t *testing.T) {
+	s := twittertest.NewServer()
+	defer s.Close()
+
+	s.AddProfile(&twittertest.Profile{ID: 1, Username: "tester"})
+	s.AddProfile(&twittertest.Profile{ID: 10, Username: "existing", Name: "Existing Follower"})
+	s.AddProfile(&twittertest.Profile{ID: 20, Username: "friend", Name: "Existing Friend"})
+	s.SetFollowers(1, 10, 20)
+	s.SetFriends(1, 20)
+
+	dbPath := path.Join(t.TempDir(), "test.db")
+	db, err := data.GetDB(dbPath)
+	assert.NoError(t, err)
+	assert.NoError(t, db.Save(&data.User{Username: "tester", AccessTokenKey: "k", AccessTokenSecret: "s"}))
+	assert.NoError(t, db.Close())
+
+	w, err := NewWorker(&Config{DBPath: dbPath, Key: "key", Secret: "secret", APIURL: s.URL(), Version: "v0.0.1-test"})
+	assert.NoError(t, err)
+	defer w.db.Close()
+
+	day1 := time.Date(2021, 1, 10, 12, 0, 0, 0, time.UTC)
+	w.now = func() time.Time { return day1 }
+	assert.NoError(t, w.Run())
+
+	// follower since before tracking started is searchable after the first run
+	list, err := data.SearchAccounts(w.db, "tester", &data.AccountQuery{Text: "existing follower"})
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, "existing", list[0].Profile.Username)
+	assert.True(t, list[0].Follower)
+	assert.False(t, list[0].Friend)
+
+	list, err = data.SearchAccounts(w.db, "tester", &data.AccountQuery{Relation: data.MutualRelation})
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, "friend", list[0].Profile.Username)
+
+	// cached profiles aren't looked up again until the next snapshot
+	calls := s.Calls("/1.1/users/lookup.json")
+	w.now = func() time.Time { return day1.AddDate(0, 0, 1) }
+	assert.NoError(t, w.Run())
+	assert.Equal(t, calls+1, s.Calls("/1.1/users/lookup.json"))
+}
@@ -1,17 +1,21 @@
 package date
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/mchmarny/followme/pkg/format"
 )
 
-// GetDateRange returns a list of date ranges since the time
+// GetDateRange returns a list of dates since the time through today, in the location of the time
 func GetDateRange(since time.Time) []time.Time {
 	r := make([]time.Time, 0)
-	today := format.ToISODate(time.Now().UTC())
+	now := time.Now().In(since.Location())
+	today := format.ToISODate(now)
 	if format.ToISODate(since) > today {
-		since = time.Now().UTC()
+		since = now
 	}
 
 	for {
@@ -23,3 +27,43 @@ func GetDateRange(since time.Time) []time.Time {
 	}
 	return r
 }
+
+// GetDaysBetween returns the number of calendar days between two ISO dates
+func GetDaysBetween(fromISODate, toISODate string) (int, error) {
+	from, err := time.Parse(format.ISODateLayout, fromISODate)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing date %s: %v", fromISODate, err)
+	}
+	to, err := time.Parse(format.ISODateLayout, toISODate)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing date %s: %v", toISODate, err)
+	}
+	return int(to.Sub(from).Hours() / 24), nil
+}
+
+var dayUnits = map[string]int{
+	"d": 1,
+	"w": 7,
+	"y": 365,
+}
+
+// ParseDays parses period like 90d, 12w, or 2y into number of days.
+// Number without unit is treated as number of days.
+func ParseDays(spec string) (int, error) {
+	v := strings.ToLower(strings.TrimSpace(spec))
+	if v == "" {
+		return 0, fmt.Errorf("period required")
+	}
+
+	unit := 1
+	if u, ok := dayUnits[v[len(v)-1:]]; ok {
+		unit = u
+		v = v[:len(v)-1]
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid period, expected number of days, weeks, or years (e.g. 90d, 12w, 2y): %s", spec)
+	}
+	return n * unit, nil
+}
@@ -13,4 +13,27 @@ func TestDate(t *testing.T) {
 		assert.NotNil(t, list)
 		assert.Len(t, list, 3)
 	})
+
+	t.Run("between", func(t *testing.T) {
+		d, err := GetDaysBetween("2020-12-30", "2021-01-02")
+		assert.NoError(t, err)
+		assert.Equal(t, 3, d)
+		d, err = GetDaysBetween("2021-01-02", "2021-01-02")
+		assert.NoError(t, err)
+		assert.Equal(t, 0, d)
+		_, err = GetDaysBetween("2021-01-02", "bad")
+		assert.Error(t, err)
+	})
+
+	t.Run("days", func(t *testing.T) {
+		for spec, days := range map[string]int{"90d": 90, "12w": 84, "2y": 730, "5": 5, " 1D ": 1} {
+			d, err := ParseDays(spec)
+			assert.NoError(t, err, spec)
+			assert.Equal(t, days, d, spec)
+		}
+		for _, spec := range []string{"", "d", "1h", "-1d", "x2y"} {
+			_, err := ParseDays(spec)
+			assert.Error(t, err, spec)
+		}
+	})
 }
@@ -0,0 +1,48 @@
+package envelope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvelope(t *testing.T) {
+	t.Run("key", func(t *testing.T) {
+		k1, err := NewKey()
+		assert.NoError(t, err)
+		assert.Len(t, k1, KeySize)
+		k2, err := NewKey()
+		assert.NoError(t, err)
+		assert.NotEqual(t, k1, k2)
+	})
+
+	t.Run("derive", func(t *testing.T) {
+		assert.Nil(t, DeriveKey(""))
+		k1 := DeriveKey("secret")
+		assert.Len(t, k1, KeySize)
+		assert.Equal(t, k1, DeriveKey("secret"))
+		assert.NotEqual(t, k1, DeriveKey("other"))
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		k, err := NewKey()
+		assert.NoError(t, err)
+		sealed, err := Encrypt(k, []byte("test"))
+		assert.NoError(t, err)
+		assert.NotEqual(t, []byte("test"), sealed)
+		plain, err := Decrypt(k, sealed)
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("test"), plain)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		sealed, err := Encrypt(DeriveKey("a"), []byte("test"))
+		assert.NoError(t, err)
+		_, err = Decrypt(DeriveKey("b"), sealed)
+		assert.Error(t, err)
+		_, err = Decrypt(DeriveKey("a"), sealed[:4])
+		assert.Error(t, err)
+		_, err = Encrypt([]byte("short"), []byte("test"))
+		assert.Error(t, err)
+	})
+}
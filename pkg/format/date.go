@@ -4,7 +4,12 @@ import (
 	"time"
 )
 
+const (
+	// ISODateLayout is the layout of ISO date
+	ISODateLayout = "2006-01-02"
+)
+
 // ToISODate formats time into 2006-01-02
 func ToISODate(t time.Time) string {
-	return t.Format("2006-01-02")
+	return t.Format(ISODateLayout)
 }
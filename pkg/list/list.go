@@ -27,3 +27,11 @@ func Contains(list []int64, val int64) bool {
 	}
 	return false
 }
+
+// MergeChanges combines items added and removed with the changes which followed them.
+// Items added and then removed (or removed and then added back) cancel out, newer items come first.
+func MergeChanges(added, removed, nextAdded, nextRemoved []int64) (allAdded, allRemoved []int64) {
+	allAdded = append(GetDiff(removed, nextAdded), GetDiff(nextRemoved, added)...)
+	allRemoved = append(GetDiff(added, nextRemoved), GetDiff(nextAdded, removed)...)
+	return
+}
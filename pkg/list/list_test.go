@@ -21,4 +21,15 @@ func TestList(t *testing.T) {
 		d2 := GetDiff(list2, list)
 		assert.Len(t, d2, 3)
 	})
+
+	t.Run("merge", func(t *testing.T) {
+		// 1 followed and unfollowed, 2 unfollowed and came back, 3 and 4 new
+		added, removed := MergeChanges([]int64{1, 3}, []int64{2, 5}, []int64{2, 4}, []int64{1})
+		assert.Equal(t, []int64{4, 3}, added)
+		assert.Equal(t, []int64{5}, removed)
+
+		added, removed = MergeChanges(nil, nil, []int64{1}, nil)
+		assert.Equal(t, []int64{1}, added)
+		assert.Empty(t, removed)
+	})
 }
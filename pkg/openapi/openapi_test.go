@@ -0,0 +1,56 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testItem struct {
+	ID      int64     `json:"id" doc:"Item ID"`
+	Name    string    `json:"name,omitempty"`
+	Tags    []string  `json:"tags"`
+	On      time.Time `json:"on"`
+	Parent  *testItem `json:"parent,omitempty"`
+	Count   *int      `json:"count"`
+	Ignored string    `json:"-"`
+	private string
+}
+
+type testList struct {
+	Items []*testItem `json:"items"`
+}
+
+func TestSchema(t *testing.T) {
+	doc := NewDocument(&Info{Title: "test", Version: "v1"})
+	doc.AddOperation("/items", http.MethodGet, &Operation{
+		OperationID: "listItems",
+		Summary:     "Lists items",
+		Responses:   map[string]*Response{"200": doc.JSONResponse("OK", testList{})},
+	})
+
+	assert.Contains(t, doc.Paths, "/items")
+	assert.Contains(t, *doc.Paths["/items"], "get")
+
+	list := doc.Components.Schemas["testList"]
+	assert.NotNil(t, list)
+	assert.Equal(t, "array", list.Properties["items"].Type)
+	assert.Equal(t, "#/components/schemas/testItem", list.Properties["items"].Items.Ref)
+
+	item := doc.Components.Schemas["testItem"]
+	assert.NotNil(t, item)
+	assert.Len(t, item.Properties, 6)
+	assert.Equal(t, "integer", item.Properties["id"].Type)
+	assert.Equal(t, "int64", item.Properties["id"].Format)
+	assert.Equal(t, "Item ID", item.Properties["id"].Description)
+	assert.Equal(t, "date-time", item.Properties["on"].Format)
+	assert.Equal(t, "#/components/schemas/testItem", item.Properties["parent"].Ref)
+	assert.True(t, item.Properties["count"].Nullable)
+	assert.Equal(t, []string{"id", "tags", "on", "count"}, item.Required)
+
+	_, err := json.Marshal(doc)
+	assert.NoError(t, err)
+}
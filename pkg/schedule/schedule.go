@@ -0,0 +1,220 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// maxSearchYears limits how far the cron schedule looks for next match
+	maxSearchYears = 5
+)
+
+var descriptors = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+}
+
+// Schedule computes the time of the next run
+type Schedule interface {
+	// Next returns the next run time after from, zero time if none
+	Next(from time.Time) time.Time
+}
+
+// Parse parses either a duration (e.g. 6h), a descriptor (e.g. @daily),
+// or a standard 5-field cron expression (e.g. "0 */6 * * *")
+func Parse(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, fmt.Errorf("schedule required")
+	}
+
+	if d, err := time.ParseDuration(spec); err == nil {
+		if d <= 0 {
+			return nil, fmt.Errorf("schedule interval must be positive: %s", spec)
+		}
+		return Every(d), nil
+	}
+
+	if v, ok := descriptors[strings.ToLower(spec)]; ok {
+		spec = v
+	}
+
+	return parseCron(spec)
+}
+
+// Every returns schedule which runs on fixed interval
+func Every(d time.Duration) Schedule {
+	return interval(d)
+}
+
+type interval time.Duration
+
+// Next returns from plus the interval
+func (i interval) Next(from time.Time) time.Time {
+	return from.Add(time.Duration(i))
+}
+
+// String returns the interval duration
+func (i interval) String() string {
+	return time.Duration(i).String()
+}
+
+type cron struct {
+	spec   string
+	minute uint64
+	hour   uint64
+	dom    uint64
+	month  uint64
+	dow    uint64
+	anyDom bool
+	anyDow bool
+}
+
+type cronField struct {
+	min, max int
+}
+
+var (
+	minuteField = cronField{0, 59}
+	hourField   = cronField{0, 23}
+	domField    = cronField{1, 31}
+	monthField  = cronField{1, 12}
+	dowField    = cronField{0, 7}
+)
+
+func parseCron(spec string) (*cron, error) {
+	parts := strings.Fields(spec)
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("invalid schedule, expected duration or 5-field cron expression: %s", spec)
+	}
+
+	c := &cron{
+		spec:   spec,
+		anyDom: parts[2] == "*",
+		anyDow: parts[4] == "*",
+	}
+
+	var err error
+	if c.minute, err = parseField(parts[0], minuteField); err != nil {
+		return nil, fmt.Errorf("invalid minute in %s: %v", spec, err)
+	}
+	if c.hour, err = parseField(parts[1], hourField); err != nil {
+		return nil, fmt.Errorf("invalid hour in %s: %v", spec, err)
+	}
+	if c.dom, err = parseField(parts[2], domField); err != nil {
+		return nil, fmt.Errorf("invalid day of month in %s: %v", spec, err)
+	}
+	if c.month, err = parseField(parts[3], monthField); err != nil {
+		return nil, fmt.Errorf("invalid month in %s: %v", spec, err)
+	}
+	if c.dow, err = parseField(parts[4], dowField); err != nil {
+		return nil, fmt.Errorf("invalid day of week in %s: %v", spec, err)
+	}
+	// 7 is also Sunday
+	if has(c.dow, 7) {
+		c.dow |= 1
+		c.dow &^= 1 << 7
+	}
+
+	return c, nil
+}
+
+// parseField parses comma separated list of values, ranges, and steps into bit set
+func parseField(v string, f cronField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(v, ",") {
+		step := 1
+		if i := strings.Index(item, "/"); i >= 0 {
+			s, err := strconv.Atoi(item[i+1:])
+			if err != nil || s < 1 {
+				return 0, fmt.Errorf("invalid step: %s", item)
+			}
+			step = s
+			item = item[:i]
+		}
+
+		start, stop := f.min, f.max
+		switch {
+		case item == "*":
+		case strings.Contains(item, "-"):
+			r := strings.SplitN(item, "-", 2)
+			a, err1 := strconv.Atoi(r[0])
+			b, err2 := strconv.Atoi(r[1])
+			if err1 != nil || err2 != nil || a > b {
+				return 0, fmt.Errorf("invalid range: %s", item)
+			}
+			start, stop = a, b
+		default:
+			a, err := strconv.Atoi(item)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value: %s", item)
+			}
+			start = a
+			if step == 1 {
+				stop = a
+			}
+		}
+
+		if start < f.min || stop > f.max {
+			return 0, fmt.Errorf("value out of range [%d-%d]: %s", f.min, f.max, item)
+		}
+
+		for i := start; i <= stop; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+// Next returns the next time matching the cron expression after from
+func (c *cron) Next(from time.Time) time.Time {
+	t := from.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(maxSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if !has(c.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !has(c.hour, t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if !has(c.minute, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+// String returns the cron expression
+func (c *cron) String() string {
+	return c.spec
+}
+
+// dayMatches uses the standard cron rule: when both day fields are restricted either can match
+func (c *cron) dayMatches(t time.Time) bool {
+	domMatch := has(c.dom, t.Day())
+	dowMatch := has(c.dow, int(t.Weekday()))
+	if c.anyDom || c.anyDow {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+func has(bits uint64, v int) bool {
+	return bits&(1<<uint(v)) > 0
+}
@@ -0,0 +1,65 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedule(t *testing.T) {
+	from := time.Date(2021, 1, 15, 10, 30, 15, 0, time.UTC) // Friday
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, v := range []string{"", "-1h", "0s", "* * *", "60 * * * *", "* 24 * * *", "5-1 * * * *", "*/0 * * * *", "a * * * *"} {
+			_, err := Parse(v)
+			assert.Error(t, err, v)
+		}
+	})
+
+	t.Run("interval", func(t *testing.T) {
+		s, err := Parse("6h")
+		assert.NoError(t, err)
+		assert.Equal(t, from.Add(6*time.Hour), s.Next(from))
+	})
+
+	t.Run("descriptor", func(t *testing.T) {
+		s, err := Parse("@daily")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2021, 1, 16, 0, 0, 0, 0, time.UTC), s.Next(from))
+	})
+
+	t.Run("step", func(t *testing.T) {
+		s, err := Parse("0 */6 * * *")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2021, 1, 15, 12, 0, 0, 0, time.UTC), s.Next(from))
+	})
+
+	t.Run("list", func(t *testing.T) {
+		s, err := Parse("15,45 9-17 * * *")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2021, 1, 15, 10, 45, 0, 0, time.UTC), s.Next(from))
+	})
+
+	t.Run("weekday", func(t *testing.T) {
+		s, err := Parse("0 8 * * 1")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2021, 1, 18, 8, 0, 0, 0, time.UTC), s.Next(from))
+
+		s, err = Parse("0 8 * * 7")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2021, 1, 17, 8, 0, 0, 0, time.UTC), s.Next(from))
+	})
+
+	t.Run("month", func(t *testing.T) {
+		s, err := Parse("0 0 1 3 *")
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2021, 3, 1, 0, 0, 0, 0, time.UTC), s.Next(from))
+	})
+
+	t.Run("never", func(t *testing.T) {
+		s, err := Parse("0 0 31 2 *")
+		assert.NoError(t, err)
+		assert.True(t, s.Next(from).IsZero())
+	})
+}
@@ -26,6 +26,14 @@ $(function () {
         }
     };
 
+    if ($("#digest-form").length) {
+        loadDigest();
+        $("#digest-form").submit(function(e){
+            e.preventDefault();
+            saveDigest();
+        });
+    };
+
     if ($("#report-table").length) {
         $("#report-list-more").click(function(e){
             e.preventDefault();
@@ -141,7 +149,7 @@ function loadDay(listType, page) {
                     </a>
                 </td>`);
             row.append(`<td class="user-name">
-                <a href="#" class="no-link" 
+                <a href="/view/user/${e.id_str}" 
                    title="${e.description} - (updated: ${e.updated_at})">
                     @${e.username}</a><div>${e.name}<br />${e.location}</div>
                 </td>`);
@@ -174,6 +182,31 @@ function loadDashboard(days) {
         $("#post-count .data").text(data.user.post_count).digits();
         $("#meta-updated-on").text(data.updated_on);
 
+        // days the worker didn't run, charts show them as breaks
+        if (data.series.gaps.length) {
+            $("#meta-gaps").text(data.series.gaps.join(", "));
+            $("#meta-gaps-panel").show();
+        }else{
+            $("#meta-gaps-panel").hide();
+        }
+
+        // Twitter API limits reached, data refreshed by a later worker run
+        if (data.rate_limit) {
+            var msg = "Twitter API limit reached";
+            if (data.rate_limit.pending.length) {
+                msg = "Partially loaded " + data.rate_limit.pending.join(", ");
+            }
+            if (data.rate_limit.reset_at) {
+                msg += ", refresh after " + data.rate_limit.reset_at;
+            } else {
+                msg += ", refresh on next update";
+            }
+            $("#meta-limit").text(msg);
+            $("#meta-limit-panel").show();
+        }else{
+            $("#meta-limit-panel").hide();
+        }
+
         $(".wait-load").hide();
 
         // follower count chart
@@ -344,6 +377,37 @@ function loadDashboard(days) {
     });
 }
 
+function loadDigest() {
+    $.get("/data/digest", function (data) {
+        $("#digest-frequency").val(data.settings.frequency);
+        $("#digest-email").val(data.settings.email);
+    }).fail(function(jqXHR) {
+        handleError(jqXHR)
+    });
+}
+
+function saveDigest() {
+    $("#digest-msg").text("");
+    $.ajax({
+        url: "/data/digest",
+        type: "POST",
+        contentType: "application/json",
+        data: JSON.stringify({
+            frequency: $("#digest-frequency").val(),
+            email: $("#digest-email").val()
+        })
+    }).done(function (data) {
+        $("#digest-email").val(data.settings.email);
+        $("#digest-msg").text("Saved");
+    }).fail(function(jqXHR) {
+        if (jqXHR.responseJSON) {
+            $("#digest-msg").text(jqXHR.responseJSON.message);
+            return;
+        }
+        handleError(jqXHR)
+    });
+}
+
 $.fn.digits = function () {
     return this.each(function () {
         $(this).text($(this).text().replace(/(\d)(?=(\d\d\d)+(?!\d))/g, "$1,"));